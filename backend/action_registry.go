@@ -0,0 +1,334 @@
+/*
+ * backend/action_registry.go
+ *
+ * Lists every action the app can run on an object — navigation, the object
+ * actions, shell exec, YAML apply and the user's custom actions — with the
+ * arguments and permissions
+ * each one needs, and dispatches them through one InvokeAction entry point.
+ * The command palette and scripting use it; the object actions still run
+ * through RunObjectAction so every caller shares the same checks.
+ */
+
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/objectaction"
+)
+
+// Action categories.
+const (
+	ActionCategoryNavigate = "navigate"
+	ActionCategoryObject   = "object"
+	ActionCategoryExec     = "exec"
+	ActionCategoryApply    = "apply"
+	ActionCategoryCustom   = "custom"
+)
+
+// Registry IDs of the actions that are not object action definitions.
+const (
+	actionIDDebugContainer = "createDebugContainer"
+	actionIDExec           = "exec"
+	actionIDApply          = "apply"
+)
+
+// ActionArgument describes one argument an action takes besides its target.
+type ActionArgument struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+// ActionPermission is a permission the backend checks before running an
+// action. An empty Kind means the target's own kind.
+type ActionPermission struct {
+	Verb        string `json:"verb"`
+	Group       string `json:"group,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// ActionDescriptor is one registry entry.
+type ActionDescriptor struct {
+	ID       string `json:"id"`
+	Label    string `json:"label"`
+	Category string `json:"category"`
+	// Mutating actions change cluster state and are refused for read-only
+	// clusters.
+	Mutating    bool               `json:"mutating"`
+	Arguments   []ActionArgument   `json:"arguments,omitempty"`
+	Permissions []ActionPermission `json:"permissions,omitempty"`
+	// PermissionSummary describes the permission check in words.
+	PermissionSummary string `json:"permissionSummary,omitempty"`
+	// Target limits a custom action to matching objects.
+	Target *ActionKindSelector `json:"target,omitempty"`
+}
+
+// ActionInvokeRequest runs a registry action against a target. Arguments are
+// keyed by ActionArgument.Name.
+type ActionInvokeRequest struct {
+	ID        string                 `json:"id"`
+	Target    ObjectActionTargetRef  `json:"target"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+	// Confirmation is the typed target name protected namespaces require.
+	Confirmation string `json:"confirmation,omitempty"`
+}
+
+// ActionInvokeResponse carries whichever result the action produced.
+// Navigation actions only validate the target and echo it back in Navigate;
+// the frontend performs the navigation.
+type ActionInvokeResponse struct {
+	Navigate     *ObjectActionTargetRef      `json:"navigate,omitempty"`
+	ObjectAction *ObjectActionResponse       `json:"objectAction,omitempty"`
+	ShellSession *ShellSession               `json:"shellSession,omitempty"`
+	Apply        *ObjectYAMLMutationResponse `json:"apply,omitempty"`
+	Custom       *CustomActionResult         `json:"custom,omitempty"`
+}
+
+// payloadArguments describes the object action payload fields as arguments.
+var payloadArguments = map[objectaction.PayloadField]ActionArgument{
+	"replicas":       {Name: "replicas", Type: "integer", Required: true, Description: "Desired replica count."},
+	"suspend":        {Name: "suspend", Type: "boolean", Required: true, Description: "Whether the CronJob is suspended."},
+	"revision":       {Name: "revision", Type: "integer", Required: true, Description: "Revision to roll back to."},
+	"drainOptions":   {Name: "drainOptions", Type: "object", Description: "Drain options: gracePeriodSeconds, timeoutSeconds, ignoreDaemonSets, deleteEmptyDirData, force, disableEviction, skipWaitForPodsToTerminate."},
+	"portForward":    {Name: "portForward", Type: "object", Required: true, Description: "containerPort and localPort."},
+	"debugContainer": {Name: "debugContainer", Type: "object", Required: true, Description: "image and optional targetContainer."},
+}
+
+// presetArguments fixes payload fields that an action implies, so "Scale to
+// 0" needs no replicas argument.
+var presetArguments = map[string]map[string]interface{}{
+	"suspend":     {"suspend": true},
+	"resume":      {"suspend": false},
+	"scaleToZero": {"replicas": 0},
+}
+
+var actionRegistry = buildActionRegistry()
+
+func buildActionRegistry() []ActionDescriptor {
+	registry := make([]ActionDescriptor, 0, len(objectaction.Definitions)+3)
+	for _, definition := range objectaction.Definitions {
+		descriptor := ActionDescriptor{ID: definition.Key, Label: definition.Label, Category: ActionCategoryNavigate}
+		if definition.BackendAction != "" {
+			descriptor.Category = ActionCategoryObject
+			descriptor.Mutating = definition.BackendAction != ObjectActionStartPortForward
+			descriptor.PermissionSummary = definition.FrontendPermission
+			for _, field := range definition.PayloadFields {
+				if _, preset := presetArguments[definition.Key][string(field)]; preset {
+					continue
+				}
+				descriptor.Arguments = append(descriptor.Arguments, payloadArguments[field])
+			}
+			if permission := definition.Permission; permission != nil {
+				descriptor.Permissions = []ActionPermission{{
+					Verb:        permission.Verb,
+					Group:       derefString(permission.Group),
+					Version:     permission.Version,
+					Kind:        permission.ResourceKind,
+					Subresource: permission.Subresource,
+				}}
+			}
+		}
+		registry = append(registry, descriptor)
+	}
+	return append(registry,
+		ActionDescriptor{
+			ID:                actionIDDebugContainer,
+			Label:             "Debug Container",
+			Category:          ActionCategoryObject,
+			Mutating:          true,
+			Arguments:         []ActionArgument{payloadArguments["debugContainer"]},
+			Permissions:       []ActionPermission{{Verb: "update", Version: "v1", Kind: "Pod", Subresource: "ephemeralcontainers"}},
+			PermissionSummary: "core/v1 Pod ephemeralcontainers update",
+		},
+		ActionDescriptor{
+			ID:       actionIDExec,
+			Label:    "Shell",
+			Category: ActionCategoryExec,
+			Mutating: true,
+			Arguments: []ActionArgument{
+				{Name: "container", Type: "string", Description: "Container to exec into; defaults to the first."},
+				{Name: "command", Type: "string[]", Description: "Command to run; defaults to a shell."},
+			},
+			Permissions: []ActionPermission{
+				{Verb: "get", Version: "v1", Kind: "Pod"},
+				{Verb: "create", Version: "v1", Kind: "Pod", Subresource: "exec"},
+			},
+			PermissionSummary: "core/v1 Pod get and Pod exec create",
+		},
+		ActionDescriptor{
+			ID:       actionIDApply,
+			Label:    "Apply YAML",
+			Category: ActionCategoryApply,
+			Mutating: true,
+			Arguments: []ActionArgument{
+				{Name: "yaml", Type: "string", Required: true, Description: "Edited object YAML."},
+				{Name: "baseYAML", Type: "string", Required: true, Description: "YAML the edit started from."},
+				{Name: "resourceVersion", Type: "string", Required: true, Description: "resourceVersion of baseYAML."},
+				{Name: "uid", Type: "string", Description: "UID of the object being edited."},
+			},
+			Permissions:       []ActionPermission{{Verb: "patch"}},
+			PermissionSummary: "target object patch",
+		},
+	)
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func findAction(id string) (ActionDescriptor, bool) {
+	index := slices.IndexFunc(actionRegistry, func(descriptor ActionDescriptor) bool { return descriptor.ID == id })
+	if index < 0 {
+		return ActionDescriptor{}, false
+	}
+	return actionRegistry[index], true
+}
+
+// ListActions returns every action in the registry, followed by the custom
+// actions from actions.yaml.
+func (a *App) ListActions() []ActionDescriptor {
+	actions := make([]ActionDescriptor, len(actionRegistry))
+	for i, descriptor := range actionRegistry {
+		descriptor.Arguments = slices.Clone(descriptor.Arguments)
+		descriptor.Permissions = slices.Clone(descriptor.Permissions)
+		actions[i] = descriptor
+	}
+	return append(actions, a.customActionDescriptors()...)
+}
+
+// InvokeAction validates the arguments against the registry entry and runs
+// the action through the same backend path its dedicated method uses.
+func (a *App) InvokeAction(req ActionInvokeRequest) (ActionInvokeResponse, error) {
+	id := strings.TrimSpace(req.ID)
+	descriptor, ok := findAction(id)
+	custom, isCustom := a.findCustomAction(id)
+	if isCustom {
+		descriptor = custom.descriptor()
+	} else if !ok {
+		return ActionInvokeResponse{}, fmt.Errorf("unknown action %q", req.ID)
+	}
+	if err := checkActionArguments(descriptor, req.Arguments); err != nil {
+		return ActionInvokeResponse{}, err
+	}
+	target, err := validateObjectActionTarget(req.Target)
+	if err != nil {
+		return ActionInvokeResponse{}, err
+	}
+
+	switch descriptor.Category {
+	case ActionCategoryNavigate:
+		return ActionInvokeResponse{Navigate: &target}, nil
+	case ActionCategoryObject:
+		var objectReq ObjectActionRequest
+		arguments := maps.Clone(req.Arguments)
+		if arguments == nil {
+			arguments = map[string]interface{}{}
+		}
+		maps.Copy(arguments, presetArguments[descriptor.ID])
+		if err := decodeActionArguments(descriptor.ID, arguments, &objectReq); err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		objectReq.Action = objectActionForRegistryID(descriptor.ID)
+		objectReq.Target = target
+		objectReq.Confirmation = req.Confirmation
+		response, err := a.RunObjectAction(objectReq)
+		if err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		return ActionInvokeResponse{ObjectAction: &response}, nil
+	case ActionCategoryExec:
+		if target.Group != "" || target.Version != "v1" || target.Kind != "Pod" {
+			return ActionInvokeResponse{}, errUnsupportedActionTarget(descriptor.ID, target, "v1", "Pod")
+		}
+		shellReq := ShellSessionRequest{Namespace: target.Namespace, PodName: target.Name}
+		if err := decodeActionArguments(descriptor.ID, req.Arguments, &shellReq); err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		session, err := a.StartShellSession(target.ClusterID, shellReq)
+		if err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		return ActionInvokeResponse{ShellSession: session}, nil
+	case ActionCategoryApply:
+		applyReq := ObjectYAMLMutationRequest{
+			Kind:       target.Kind,
+			APIVersion: objectActionTargetGVK(target).GroupVersion().String(),
+			Namespace:  target.Namespace,
+			Name:       target.Name,
+		}
+		if err := decodeActionArguments(descriptor.ID, req.Arguments, &applyReq); err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		response, err := a.ApplyObjectYaml(target.ClusterID, applyReq)
+		if err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		return ActionInvokeResponse{Apply: response}, nil
+	case ActionCategoryCustom:
+		result, err := a.runCustomAction(custom, target)
+		if err != nil {
+			return ActionInvokeResponse{}, err
+		}
+		return ActionInvokeResponse{Custom: &result}, nil
+	default:
+		return ActionInvokeResponse{}, fmt.Errorf("action %q has no handler", descriptor.ID)
+	}
+}
+
+// objectActionForRegistryID maps a registry ID to its RunObjectAction name.
+func objectActionForRegistryID(id string) string {
+	if id == actionIDDebugContainer {
+		return ObjectActionCreateDebugContainer
+	}
+	for _, definition := range objectaction.Definitions {
+		if definition.Key == id {
+			return definition.BackendAction
+		}
+	}
+	return ""
+}
+
+// checkActionArguments rejects unknown arguments and missing required ones.
+func checkActionArguments(descriptor ActionDescriptor, arguments map[string]interface{}) error {
+	for name := range arguments {
+		if !slices.ContainsFunc(descriptor.Arguments, func(argument ActionArgument) bool { return argument.Name == name }) {
+			return fmt.Errorf("action %q does not take argument %q", descriptor.ID, name)
+		}
+	}
+	for _, argument := range descriptor.Arguments {
+		if _, ok := arguments[argument.Name]; argument.Required && !ok {
+			return fmt.Errorf("action %q requires argument %q", descriptor.ID, argument.Name)
+		}
+	}
+	return nil
+}
+
+// decodeActionArguments fills the request struct's matching JSON fields from
+// the arguments, rejecting values of the wrong type.
+func decodeActionArguments(id string, arguments map[string]interface{}, into interface{}) error {
+	if len(arguments) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(arguments)
+	if err != nil {
+		return fmt.Errorf("action %q arguments: %w", id, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(into); err != nil {
+		return fmt.Errorf("action %q arguments: %w", id, err)
+	}
+	return nil
+}
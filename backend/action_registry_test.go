@@ -0,0 +1,108 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+	cgotesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/objectaction"
+)
+
+func TestActionRegistryCoversEveryBackendAction(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	actions := app.ListActions()
+
+	ids := make(map[string]ActionDescriptor, len(actions))
+	for _, action := range actions {
+		require.NotContains(t, ids, action.ID, "registry IDs are unique")
+		ids[action.ID] = action
+	}
+	for _, definition := range objectaction.Definitions {
+		require.Contains(t, ids, definition.Key)
+	}
+	for _, backendAction := range objectaction.FrontendBackendActions {
+		found := false
+		for _, action := range actions {
+			if action.Category == ActionCategoryObject && objectActionForRegistryID(action.ID) == backendAction.Action {
+				found = true
+			}
+		}
+		require.True(t, found, "backend action %q is reachable through the registry", backendAction.Action)
+	}
+	require.Equal(t, ActionCategoryExec, ids["exec"].Category)
+	require.Equal(t, ActionCategoryApply, ids["apply"].Category)
+	require.Equal(t, ActionCategoryNavigate, ids["viewDetails"].Category)
+	require.Empty(t, ids["scaleToZero"].Arguments, "preset payload fields are not arguments")
+	require.False(t, ids["portForward"].Mutating)
+}
+
+func TestInvokeActionValidatesArguments(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	target := objectActionTarget(workloadClusterID, "apps", "v1", "Deployment", "default", "demo")
+
+	_, err := app.InvokeAction(ActionInvokeRequest{ID: "teleport", Target: target})
+	require.ErrorContains(t, err, "unknown action")
+
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "scale", Target: target})
+	require.ErrorContains(t, err, `requires argument "replicas"`)
+
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "restart", Target: target, Arguments: map[string]interface{}{"replicas": 2}})
+	require.ErrorContains(t, err, `does not take argument "replicas"`)
+
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "scale", Target: target, Arguments: map[string]interface{}{"replicas": "two"}})
+	require.ErrorContains(t, err, "arguments")
+
+	response, err := app.InvokeAction(ActionInvokeRequest{ID: "viewDetails", Target: target})
+	require.NoError(t, err)
+	require.Equal(t, target, *response.Navigate)
+
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "exec", Target: target})
+	require.ErrorContains(t, err, "requires v1 Pod target")
+}
+
+func TestInvokeActionDispatchesObjectActions(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset()
+	allowSelfSubjectAccessReviews(client)
+	var replicas int32 = -1
+	client.Fake.PrependReactor("update", "deployments", func(action cgotesting.Action) (bool, runtime.Object, error) {
+		update, ok := action.(cgotesting.UpdateAction)
+		if !ok || action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := update.GetObject().(*autoscalingv1.Scale)
+		replicas = scale.Spec.Replicas
+		return true, scale, nil
+	})
+	app := newTestAppWithDefaults(t)
+	app.responseCache = newResponseCache(time.Minute, 10)
+	app.clusterClients = map[string]*clusterClients{
+		workloadClusterID: {
+			meta:              ClusterMeta{ID: workloadClusterID, Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	_, err := app.InvokeAction(ActionInvokeRequest{
+		ID:     "scaleToZero",
+		Target: objectActionTarget(workloadClusterID, "apps", "v1", "Deployment", "default", "demo"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), replicas)
+
+	require.NoError(t, app.SetClusterReadOnly(workloadClusterID, true))
+	_, err = app.InvokeAction(ActionInvokeRequest{
+		ID:        "scale",
+		Target:    objectActionTarget(workloadClusterID, "apps", "v1", "Deployment", "default", "demo"),
+		Arguments: map[string]interface{}{"replicas": 3},
+	})
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
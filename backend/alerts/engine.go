@@ -0,0 +1,431 @@
+package alerts
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Alert is one firing of a rule for one object.
+type Alert struct {
+	ID             string                    `json:"id"`
+	RuleID         string                    `json:"ruleId"`
+	RuleName       string                    `json:"ruleName"`
+	ClusterID      string                    `json:"clusterId"`
+	Ref            resourcemodel.ResourceRef `json:"ref"`
+	Value          int                       `json:"value"`
+	Message        string                    `json:"message"`
+	FiredAt        time.Time                 `json:"firedAt"`
+	ResolvedAt     *time.Time                `json:"resolvedAt,omitempty"`
+	AcknowledgedAt *time.Time                `json:"acknowledgedAt,omitempty"`
+}
+
+// Active reports whether the alert's condition still holds.
+func (a Alert) Active() bool {
+	return a.ResolvedAt == nil
+}
+
+// restartSample is an object's restart count as first seen and at each change.
+type restartSample struct {
+	at       time.Time
+	restarts int
+}
+
+// object is the engine's view of one streamed row.
+type object struct {
+	ref           resourcemodel.ResourceRef
+	samples       []restartSample
+	notReadySince time.Time
+	// quotaUsed is a ResourceQuota's highest used percentage; nil when the
+	// quota has no hard limits or the object is not a quota.
+	quotaUsed *int
+}
+
+// rowState is what the engine reads from one streamed row.
+type rowState struct {
+	ref       resourcemodel.ResourceRef
+	restarts  int
+	ready     bool
+	quotaUsed *int
+}
+
+// Engine records streamed Pod, workload and ResourceQuota rows from ingest bundle sinks and
+// evaluates the rules against them. Sinks only record state, since they run
+// under the ingest store's lock; Evaluate, called periodically, raises and
+// resolves alerts. It is safe for concurrent use.
+type Engine struct {
+	mu           sync.Mutex
+	now          func() time.Time
+	historyLimit int
+	rules        []Rule
+	objects      map[string]*object
+	// firing maps rule ID + object key to the ID of its active alert, so a
+	// condition raises one alert while it holds.
+	firing  map[string]string
+	history []Alert
+	nextID  uint64
+}
+
+// NewEngine returns an engine that keeps at most historyLimit alerts.
+func NewEngine(historyLimit int, now func() time.Time) *Engine {
+	if now == nil {
+		now = time.Now
+	}
+	return &Engine{
+		now:          now,
+		historyLimit: historyLimit,
+		objects:      make(map[string]*object),
+		firing:       make(map[string]string),
+	}
+}
+
+// SetRules replaces the rules. Active alerts of removed or disabled rules
+// resolve at the next Evaluate.
+func (e *Engine) SetRules(rules []Rule) {
+	e.mu.Lock()
+	e.rules = slices.Clone(rules)
+	e.mu.Unlock()
+}
+
+// Rules returns the current rules.
+func (e *Engine) Rules() []Rule {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return slices.Clone(e.rules)
+}
+
+// Sink returns the ingest bundle sink that feeds one cluster's rows of one
+// kind into the engine.
+func (e *Engine) Sink(clusterID, kind string) ingest.BundleSink {
+	return engineSink{engine: e, clusterID: clusterID, kind: kind}
+}
+
+// ForgetCluster drops a cluster's objects; their active alerts resolve at the
+// next Evaluate.
+func (e *Engine) ForgetCluster(clusterID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	prefix := clusterID + "|"
+	for key := range e.objects {
+		if strings.HasPrefix(key, prefix) {
+			delete(e.objects, key)
+		}
+	}
+}
+
+// Evaluate checks every rule against every object and returns the alerts it
+// raised and resolved.
+func (e *Engine) Evaluate() (fired, resolved []Alert) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.now()
+	window := e.longestWindow()
+	holding := make(map[string]struct{}, len(e.firing))
+	for key, obj := range e.objects {
+		obj.trim(now, window)
+		for _, rule := range e.rules {
+			if !rule.appliesTo(obj) {
+				continue
+			}
+			value, ok := obj.value(rule, now)
+			if !ok || !rule.compare(value) {
+				continue
+			}
+			firingKey := rule.ID + "#" + key
+			holding[firingKey] = struct{}{}
+			if _, active := e.firing[firingKey]; active {
+				continue
+			}
+			e.nextID++
+			alert := Alert{
+				ID:        strconv.FormatUint(e.nextID, 10),
+				RuleID:    rule.ID,
+				RuleName:  rule.Name,
+				ClusterID: obj.ref.ClusterID,
+				Ref:       obj.ref,
+				Value:     value,
+				Message:   fmt.Sprintf("%s %s: %s", obj.ref.Kind, displayName(obj.ref), rule.describe(value)),
+				FiredAt:   now,
+			}
+			e.firing[firingKey] = alert.ID
+			e.history = append(e.history, alert)
+			fired = append(fired, alert)
+		}
+	}
+	for firingKey, alertID := range e.firing {
+		if _, ok := holding[firingKey]; ok {
+			continue
+		}
+		delete(e.firing, firingKey)
+		if alert := e.findLocked(alertID); alert != nil {
+			resolvedAt := now
+			alert.ResolvedAt = &resolvedAt
+			resolved = append(resolved, *alert)
+		}
+	}
+	e.trimHistoryLocked()
+	slices.SortFunc(fired, func(a, b Alert) int { return strings.Compare(a.Message, b.Message) })
+	return fired, resolved
+}
+
+// History returns the recorded alerts, newest first.
+func (e *Engine) History() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	history := make([]Alert, len(e.history))
+	for i, alert := range e.history {
+		history[len(e.history)-1-i] = alert
+	}
+	return history
+}
+
+// Acknowledge marks an alert as seen. Acknowledging twice keeps the first
+// time.
+func (e *Engine) Acknowledge(id string) (Alert, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	alert := e.findLocked(id)
+	if alert == nil {
+		return Alert{}, fmt.Errorf("alert %q not found", id)
+	}
+	if alert.AcknowledgedAt == nil {
+		now := e.now()
+		alert.AcknowledgedAt = &now
+	}
+	return *alert, nil
+}
+
+// ClearResolved drops resolved alerts from the history.
+func (e *Engine) ClearResolved() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.history = slices.DeleteFunc(e.history, func(alert Alert) bool { return !alert.Active() })
+}
+
+func (e *Engine) findLocked(id string) *Alert {
+	for i := range e.history {
+		if e.history[i].ID == id {
+			return &e.history[i]
+		}
+	}
+	return nil
+}
+
+// trimHistoryLocked drops the oldest resolved alerts past the history limit.
+// Active alerts are kept so they can still resolve.
+func (e *Engine) trimHistoryLocked() {
+	excess := len(e.history) - e.historyLimit
+	if e.historyLimit <= 0 || excess <= 0 {
+		return
+	}
+	e.history = slices.DeleteFunc(e.history, func(alert Alert) bool {
+		if excess > 0 && !alert.Active() {
+			excess--
+			return true
+		}
+		return false
+	})
+}
+
+func (e *Engine) longestWindow() time.Duration {
+	var longest time.Duration
+	for _, rule := range e.rules {
+		if rule.Metric == MetricRestarts {
+			longest = max(longest, rule.window())
+		}
+	}
+	return longest
+}
+
+func (e *Engine) upsert(clusterID, kind string, bundle ingest.Bundle) {
+	state, ok := readRow(kind, bundle.Table)
+	if !ok {
+		return
+	}
+	state.ref.ClusterID = clusterID
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.observeLocked(state, e.now())
+}
+
+func (e *Engine) observeLocked(state rowState, now time.Time) {
+	key := objectKey(state.ref)
+	obj, ok := e.objects[key]
+	if !ok {
+		obj = &object{ref: state.ref}
+		e.objects[key] = obj
+	}
+	if n := len(obj.samples); n == 0 || obj.samples[n-1].restarts != state.restarts {
+		obj.samples = append(obj.samples, restartSample{at: now, restarts: state.restarts})
+	}
+	obj.quotaUsed = state.quotaUsed
+	switch {
+	case state.ready:
+		obj.notReadySince = time.Time{}
+	case obj.notReadySince.IsZero():
+		obj.notReadySince = now
+	}
+}
+
+func (e *Engine) remove(clusterID, kind string, bundle ingest.Bundle) {
+	state, ok := readRow(kind, bundle.Table)
+	if !ok {
+		return
+	}
+	state.ref.ClusterID = clusterID
+	e.mu.Lock()
+	delete(e.objects, objectKey(state.ref))
+	e.mu.Unlock()
+}
+
+func (e *Engine) replace(clusterID, kind string, bundles []ingest.Bundle) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.now()
+	present := make(map[string]struct{}, len(bundles))
+	for _, bundle := range bundles {
+		state, ok := readRow(kind, bundle.Table)
+		if !ok {
+			continue
+		}
+		state.ref.ClusterID = clusterID
+		present[objectKey(state.ref)] = struct{}{}
+		e.observeLocked(state, now)
+	}
+	for key, obj := range e.objects {
+		if obj.ref.ClusterID != clusterID || obj.ref.Kind != kind {
+			continue
+		}
+		if _, ok := present[key]; !ok {
+			delete(e.objects, key)
+		}
+	}
+}
+
+// value computes the rule's metric for the object.
+func (o *object) value(rule Rule, now time.Time) (int, bool) {
+	switch rule.Metric {
+	case MetricRestarts:
+		if len(o.samples) == 0 {
+			return 0, false
+		}
+		// The baseline is the last count seen at or before the window
+		// start, or the first count seen when the object is newer.
+		start := now.Add(-rule.window())
+		baseline := o.samples[0].restarts
+		for _, sample := range o.samples {
+			if sample.at.After(start) {
+				break
+			}
+			baseline = sample.restarts
+		}
+		return o.samples[len(o.samples)-1].restarts - baseline, true
+	case MetricNotReadyMinutes:
+		if o.notReadySince.IsZero() {
+			return 0, false
+		}
+		return int(now.Sub(o.notReadySince) / time.Minute), true
+	case MetricQuotaUsedPercent:
+		if o.quotaUsed == nil {
+			return 0, false
+		}
+		return *o.quotaUsed, true
+	}
+	return 0, false
+}
+
+// trim drops samples older than the longest window, keeping the newest one
+// at or before it as the baseline.
+func (o *object) trim(now time.Time, window time.Duration) {
+	start := now.Add(-window)
+	keepFrom := 0
+	for i, sample := range o.samples {
+		if sample.at.After(start) {
+			break
+		}
+		keepFrom = i
+	}
+	if keepFrom > 0 {
+		o.samples = slices.Delete(o.samples, 0, keepFrom)
+	}
+}
+
+// readRow reads the engine's state from a streamed row.
+func readRow(kind string, row interface{}) (rowState, bool) {
+	switch typed := row.(type) {
+	case streamrows.PodSummary:
+		// A finished pod has no ready containers but is not unhealthy.
+		finished := typed.Status == "Completed" || typed.Status == "Succeeded"
+		return rowState{ref: typed.Ref, restarts: int(typed.Restarts), ready: finished || readyCountsMet(typed.Ready)}, true
+	case streamrows.WorkloadSummary:
+		if typed.Ref.Kind != kind {
+			return rowState{}, false
+		}
+		return rowState{ref: typed.Ref, restarts: int(typed.Restarts), ready: readyCountsMet(typed.Ready)}, true
+	case streamrows.QuotaSummary:
+		// The namespace-quotas row type is shared with LimitRange and PDB.
+		if typed.Ref.Kind != kind || kind != quotaKind {
+			return rowState{}, false
+		}
+		return rowState{ref: typed.Ref, ready: true, quotaUsed: typed.HighestUsedPercentage}, true
+	}
+	return rowState{}, false
+}
+
+// readyCountsMet parses a "ready/desired" column. Values it cannot parse
+// count as ready so they never alert.
+func readyCountsMet(ready string) bool {
+	have, want, found := strings.Cut(ready, "/")
+	if !found {
+		return true
+	}
+	readyCount, err := strconv.Atoi(strings.TrimSpace(have))
+	if err != nil {
+		return true
+	}
+	wantCount, err := strconv.Atoi(strings.TrimSpace(want))
+	if err != nil {
+		return true
+	}
+	return readyCount >= wantCount
+}
+
+func objectKey(ref resourcemodel.ResourceRef) string {
+	return strings.Join([]string{ref.ClusterID, ref.Kind, ref.Namespace, ref.Name, ref.UID}, "|")
+}
+
+func displayName(ref resourcemodel.ResourceRef) string {
+	if ref.Namespace == "" {
+		return ref.Name
+	}
+	return ref.Namespace + "/" + ref.Name
+}
+
+// engineSink adapts the engine to one cluster's ingest store for one kind.
+type engineSink struct {
+	engine    *Engine
+	clusterID string
+	kind      string
+}
+
+func (s engineSink) UpsertBundle(bundle ingest.Bundle) {
+	s.engine.upsert(s.clusterID, s.kind, bundle)
+}
+
+func (s engineSink) DeleteBundle(bundle ingest.Bundle) {
+	s.engine.remove(s.clusterID, s.kind, bundle)
+}
+
+func (s engineSink) ReplaceBundles(bundles []ingest.Bundle) {
+	s.engine.replace(s.clusterID, s.kind, bundles)
+}
+
+var _ ingest.BundleSink = engineSink{}
+var _ ingest.BundleReplaceSink = engineSink{}
@@ -0,0 +1,174 @@
+package alerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/alerts"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func podBundle(namespace, name, ready string, restarts int32) ingest.Bundle {
+	return ingest.Bundle{Table: streamrows.PodSummary{
+		Ref:      resourcemodel.ResourceRef{Version: "v1", Kind: "Pod", Namespace: namespace, Name: name, UID: name + "-uid"},
+		Status:   "Running",
+		Ready:    ready,
+		Restarts: restarts,
+	}}
+}
+
+func restartRule() alerts.Rule {
+	return alerts.Rule{
+		ID:         "restarts",
+		Name:       "Restarting pods",
+		Enabled:    true,
+		Kind:       "Pod",
+		Metric:     alerts.MetricRestarts,
+		Operator:   ">",
+		Threshold:  5,
+		Window:     "10m",
+		Namespaces: []string{"prod"},
+	}
+}
+
+func TestNormalizeRules(t *testing.T) {
+	rules, err := alerts.NormalizeRules([]alerts.Rule{{
+		Name: " Not ready ", Kind: "Deployment", Metric: alerts.MetricNotReadyMinutes, Operator: ">=", Threshold: 5, Window: "1h",
+		Namespaces: []string{"prod", " prod "},
+	}}, func() string { return "generated" })
+	require.NoError(t, err)
+	require.Equal(t, "generated", rules[0].ID)
+	require.Equal(t, "Not ready", rules[0].Name)
+	require.Empty(t, rules[0].Window, "only restart rules keep a window")
+	require.Equal(t, []string{"prod"}, rules[0].Namespaces)
+
+	invalid := restartRule()
+	invalid.Window = "forever"
+	_, err = alerts.NormalizeRules([]alerts.Rule{invalid}, nil)
+	require.ErrorContains(t, err, "window")
+
+	invalid = restartRule()
+	invalid.Kind = "Service"
+	_, err = alerts.NormalizeRules([]alerts.Rule{invalid}, nil)
+	require.ErrorContains(t, err, "kind")
+
+	_, err = alerts.NormalizeRules([]alerts.Rule{restartRule(), restartRule()}, nil)
+	require.ErrorContains(t, err, "duplicate")
+}
+
+func TestEngineRestartsWithinWindow(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	engine := alerts.NewEngine(100, c.Now)
+	engine.SetRules([]alerts.Rule{restartRule()})
+	sink := engine.Sink("config:ctx", "Pod")
+
+	// Restarts from before the pod was first seen are not counted.
+	sink.UpsertBundle(podBundle("prod", "api", "1/1", 40))
+	sink.UpsertBundle(podBundle("staging", "api", "1/1", 0))
+	fired, _ := engine.Evaluate()
+	require.Empty(t, fired)
+
+	c.now = c.now.Add(4 * time.Minute)
+	sink.UpsertBundle(podBundle("prod", "api", "1/1", 46))
+	sink.UpsertBundle(podBundle("staging", "api", "1/1", 10))
+	fired, _ = engine.Evaluate()
+	require.Len(t, fired, 1, "other namespaces are not watched")
+	require.Equal(t, "config:ctx", fired[0].Ref.ClusterID)
+	require.Equal(t, 6, fired[0].Value)
+	require.Equal(t, "Pod prod/api: 6 restarts in 10m (> 5)", fired[0].Message)
+
+	fired, _ = engine.Evaluate()
+	require.Empty(t, fired, "an active alert is raised once")
+
+	// Once the restarts fall out of the window the alert resolves.
+	c.now = c.now.Add(11 * time.Minute)
+	_, resolved := engine.Evaluate()
+	require.Len(t, resolved, 1)
+	require.False(t, resolved[0].Active())
+
+	history := engine.History()
+	require.Len(t, history, 1)
+	acknowledged, err := engine.Acknowledge(history[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, acknowledged.AcknowledgedAt)
+	_, err = engine.Acknowledge("missing")
+	require.Error(t, err)
+
+	engine.ClearResolved()
+	require.Empty(t, engine.History())
+}
+
+func TestEngineNotReadyAndDeletes(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	engine := alerts.NewEngine(100, c.Now)
+	engine.SetRules([]alerts.Rule{{
+		ID: "not-ready", Name: "Not ready", Enabled: true, Kind: "Pod",
+		Metric: alerts.MetricNotReadyMinutes, Operator: ">=", Threshold: 5,
+	}})
+	sink := engine.Sink("config:ctx", "Pod").(ingest.BundleReplaceSink)
+
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("prod", "web", "0/1", 0), podBundle("prod", "db", "1/1", 0)})
+	c.now = c.now.Add(5 * time.Minute)
+	fired, _ := engine.Evaluate()
+	require.Len(t, fired, 1)
+	require.Equal(t, "web", fired[0].Ref.Name)
+
+	// A relist without the pod drops it and resolves its alert.
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("prod", "db", "1/1", 0)})
+	_, resolved := engine.Evaluate()
+	require.Len(t, resolved, 1)
+
+	// Disabling the rule resolves what it raised.
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("prod", "db", "0/1", 0)})
+	c.now = c.now.Add(10 * time.Minute)
+	fired, _ = engine.Evaluate()
+	require.Len(t, fired, 1)
+	engine.SetRules(nil)
+	_, resolved = engine.Evaluate()
+	require.Len(t, resolved, 1)
+}
+
+func quotaBundle(name string, highest *int) ingest.Bundle {
+	return ingest.Bundle{Table: streamrows.QuotaSummary{
+		Ref:                   resourcemodel.ResourceRef{Version: "v1", Kind: "ResourceQuota", Namespace: "prod", Name: name, UID: name + "-uid"},
+		HighestUsedPercentage: highest,
+	}}
+}
+
+func TestEngineQuotaUsage(t *testing.T) {
+	_, err := alerts.NormalizeRules([]alerts.Rule{{
+		Name: "Quota", Kind: "Pod", Metric: alerts.MetricQuotaUsedPercent, Operator: ">=", Threshold: 80,
+	}}, func() string { return "id" })
+	require.ErrorContains(t, err, "does not apply")
+
+	engine := alerts.NewEngine(100, nil)
+	engine.SetRules([]alerts.Rule{{
+		ID: "quota", Name: "Quota nearly full", Enabled: true, Kind: "ResourceQuota",
+		Metric: alerts.MetricQuotaUsedPercent, Operator: ">=", Threshold: 80,
+	}})
+	sink := engine.Sink("config:ctx", "ResourceQuota")
+	used := func(percent int) *int { return &percent }
+
+	sink.UpsertBundle(quotaBundle("compute", used(92)))
+	sink.UpsertBundle(quotaBundle("objects", used(40)))
+	sink.UpsertBundle(quotaBundle("unlimited", nil))
+	// LimitRange rows share the row type and are ignored.
+	sink.UpsertBundle(ingest.Bundle{Table: streamrows.QuotaSummary{
+		Ref: resourcemodel.ResourceRef{Kind: "LimitRange", Namespace: "prod", Name: "limits"},
+	}})
+	fired, _ := engine.Evaluate()
+	require.Len(t, fired, 1)
+	require.Equal(t, "ResourceQuota prod/compute: 92% of quota used (>= 80)", fired[0].Message)
+
+	sink.UpsertBundle(quotaBundle("compute", used(60)))
+	_, resolved := engine.Evaluate()
+	require.Len(t, resolved, 1)
+}
@@ -0,0 +1,176 @@
+// Package alerts is a small rules engine over the streamed Pod, workload and
+// ResourceQuota rows. A rule such as "pod restarts > 5 in 10m in namespace prod" is checked
+// against every matching object; an object that meets the condition raises an
+// alert, which stays active until the condition clears and is kept in a
+// bounded history that the user can acknowledge.
+package alerts
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Metrics a rule can compare.
+const (
+	// MetricRestarts is the number of container restarts within the rule's
+	// window. Restarts from before the object was first seen do not count.
+	MetricRestarts = "restarts"
+	// MetricNotReadyMinutes is how long, in whole minutes, the object has
+	// had fewer ready replicas or containers than it wants.
+	MetricNotReadyMinutes = "notReadyMinutes"
+	// MetricQuotaUsedPercent is a ResourceQuota's highest used percentage
+	// across the resources it limits. It is the only ResourceQuota metric.
+	MetricQuotaUsedPercent = "quotaUsedPercent"
+)
+
+// quotaKind is the kind MetricQuotaUsedPercent applies to.
+const quotaKind = "ResourceQuota"
+
+// Kinds rules can watch.
+var supportedKinds = []string{"Pod", "Deployment", "StatefulSet", "DaemonSet", quotaKind}
+
+var operators = []string{">", ">=", "<", "<=", "=="}
+
+// maxWindow bounds a restarts window so per-object samples stay small.
+const maxWindow = 24 * time.Hour
+
+// Rule is one user-defined alert condition.
+type Rule struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	// Kind is the object kind the rule watches: Pod, Deployment, StatefulSet,
+	// DaemonSet or ResourceQuota.
+	Kind      string `json:"kind"`
+	Metric    string `json:"metric"`
+	Operator  string `json:"operator"`
+	Threshold int    `json:"threshold"`
+	// Window is the restarts lookback as a Go duration such as "10m". It is
+	// required for MetricRestarts and ignored otherwise.
+	Window string `json:"window,omitempty"`
+	// Namespaces limits the rule to these namespaces. Empty watches all.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ClusterIDs limits the rule to these clusters. Empty watches all.
+	ClusterIDs []string `json:"clusterIds,omitempty"`
+	// Notify also raises a desktop notification when the rule fires.
+	Notify bool `json:"notify"`
+}
+
+// NormalizeRules trims, validates and assigns missing IDs to rules. newID is
+// called for each rule without an ID.
+func NormalizeRules(rules []Rule, newID func() string) ([]Rule, error) {
+	normalized := make([]Rule, 0, len(rules))
+	seen := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		rule, err := rule.normalize()
+		if err != nil {
+			return nil, err
+		}
+		if rule.ID == "" {
+			rule.ID = newID()
+		}
+		if _, dup := seen[rule.ID]; dup {
+			return nil, fmt.Errorf("duplicate alert rule id %q", rule.ID)
+		}
+		seen[rule.ID] = struct{}{}
+		normalized = append(normalized, rule)
+	}
+	return normalized, nil
+}
+
+func (r Rule) normalize() (Rule, error) {
+	r.ID = strings.TrimSpace(r.ID)
+	r.Name = strings.TrimSpace(r.Name)
+	r.Window = strings.TrimSpace(r.Window)
+	r.Namespaces = normalizeList(r.Namespaces)
+	r.ClusterIDs = normalizeList(r.ClusterIDs)
+	if r.Name == "" {
+		return r, fmt.Errorf("alert rule name is required")
+	}
+	if !slices.Contains(supportedKinds, r.Kind) {
+		return r, fmt.Errorf("alert rule %q: unsupported kind %q", r.Name, r.Kind)
+	}
+	if !slices.Contains(operators, r.Operator) {
+		return r, fmt.Errorf("alert rule %q: unsupported operator %q", r.Name, r.Operator)
+	}
+	if r.Threshold < 0 {
+		return r, fmt.Errorf("alert rule %q: threshold must not be negative", r.Name)
+	}
+	if (r.Kind == quotaKind) != (r.Metric == MetricQuotaUsedPercent) {
+		return r, fmt.Errorf("alert rule %q: metric %q does not apply to kind %q", r.Name, r.Metric, r.Kind)
+	}
+	switch r.Metric {
+	case MetricRestarts:
+		window, err := time.ParseDuration(r.Window)
+		if err != nil || window <= 0 {
+			return r, fmt.Errorf("alert rule %q: window %q must be a positive duration such as 10m", r.Name, r.Window)
+		}
+		if window > maxWindow {
+			return r, fmt.Errorf("alert rule %q: window must be at most %s", r.Name, maxWindow)
+		}
+	case MetricNotReadyMinutes, MetricQuotaUsedPercent:
+		r.Window = ""
+	default:
+		return r, fmt.Errorf("alert rule %q: unsupported metric %q", r.Name, r.Metric)
+	}
+	return r, nil
+}
+
+// window returns the parsed restarts window; rules are normalized first.
+func (r Rule) window() time.Duration {
+	window, _ := time.ParseDuration(r.Window)
+	return window
+}
+
+func (r Rule) appliesTo(obj *object) bool {
+	if !r.Enabled || r.Kind != obj.ref.Kind {
+		return false
+	}
+	if len(r.ClusterIDs) > 0 && !slices.Contains(r.ClusterIDs, obj.ref.ClusterID) {
+		return false
+	}
+	return len(r.Namespaces) == 0 || slices.Contains(r.Namespaces, obj.ref.Namespace)
+}
+
+func (r Rule) compare(value int) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	default:
+		return value == r.Threshold
+	}
+}
+
+// describe renders the rule's condition for alert messages, for example
+// "12 restarts in 10m (> 5)".
+func (r Rule) describe(value int) string {
+	switch r.Metric {
+	case MetricRestarts:
+		return fmt.Sprintf("%d restarts in %s (%s %d)", value, r.Window, r.Operator, r.Threshold)
+	case MetricQuotaUsedPercent:
+		return fmt.Sprintf("%d%% of quota used (%s %d)", value, r.Operator, r.Threshold)
+	}
+	return fmt.Sprintf("not ready for %dm (%s %d)", value, r.Operator, r.Threshold)
+}
+
+func normalizeList(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
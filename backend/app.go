@@ -8,11 +8,18 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/luxury-yacht/app/backend/alerts"
 	"github.com/luxury-yacht/app/backend/capabilities"
+	"github.com/luxury-yacht/app/backend/confighistory"
+	"github.com/luxury-yacht/app/backend/crashhistory"
+	"github.com/luxury-yacht/app/backend/gitdrift"
+	"github.com/luxury-yacht/app/backend/hpahistory"
+	"github.com/luxury-yacht/app/backend/imageindex"
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/containerlogsstream"
 	"github.com/luxury-yacht/app/backend/refresh/system"
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+	"github.com/luxury-yacht/app/backend/vulnscan"
 	apiextinformers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
 	informers "k8s.io/client-go/informers"
 )
@@ -30,7 +37,9 @@ type App struct {
 	appSettings          *AppSettings
 	logger               *Logger
 	// responseCache stores short-lived detail/YAML/helm GET responses.
-	responseCache           *responseCache
+	responseCache *responseCache
+	// objectRenderCache stores rendered object YAML/details by resourceVersion.
+	objectRenderCache       *objectRenderCache
 	sidebarVisible          bool
 	diagnosticsPanelVisible bool
 	appLogsPanelVisible     bool
@@ -92,6 +101,8 @@ type App struct {
 
 	// persistenceMu guards persistence.json read/write operations.
 	persistenceMu sync.Mutex
+	// settingsSync tracks the settings sync directory and its pending write.
+	settingsSync settingsSyncState
 
 	// kubeconfigsMu guards availableKubeconfigs and selectedKubeconfigs reads/writes.
 	kubeconfigsMu sync.RWMutex
@@ -120,6 +131,15 @@ type App struct {
 	selectionDiag       selectionDiagnosticsState
 	// settingsMu guards appSettings access in runtime watcher/selection/settings flows.
 	settingsMu sync.Mutex
+	// secretRevealAuditMu serializes appends to the secret reveal audit log.
+	secretRevealAuditMu sync.Mutex
+	// recycleBinMu guards recycle-bin.json read/modify/write cycles.
+	recycleBinMu sync.Mutex
+	// imageScanCache holds Trivy results by image digest across clusters;
+	// imageScanRunnerFactory overrides the Trivy runner (tests inject a fake).
+	imageScanCacheOnce     sync.Once
+	imageScanCache         *vulnscan.Cache
+	imageScanRunnerFactory func(path, serverURL string) vulnscan.Runner
 	// attentionRulesMu serializes persisted Attention mutations with their live
 	// index updates so cluster-scoped and global rules cannot be applied out of
 	// order across multiple cluster runtimes.
@@ -132,6 +152,44 @@ type App struct {
 	// yet started, so rapid successive scope edits coalesce into one rebuild
 	// that reads the latest persisted scope.
 	scopeRebuildQueued sync.Map
+	// clusterRequestTimeouts caches each connected cluster's request timeout
+	// (time.Duration) for its transport, which reads it on every request.
+	clusterRequestTimeouts sync.Map
+	// notificationConfig caches the notification rules and per-cluster
+	// opt-ins for the notification loop; the setters replace it after
+	// persisting. notificationsInit starts the platform notification service
+	// on first use; notificationsStarted tells Shutdown to clean it up.
+	notificationConfig   atomic.Pointer[notificationConfig]
+	notificationsInit    sync.Once
+	notificationsInitErr error
+	notificationsStarted atomic.Bool
+	// alertEngine evaluates the user-defined alert rules over every cluster's
+	// streamed rows; created on first use by alertsEngine.
+	alertEngineOnce sync.Once
+	alertEngine     *alerts.Engine
+	// crashRecorder keeps container terminations from every cluster's pod
+	// stream; created on first use by crashHistory.
+	crashRecorderOnce sync.Once
+	crashRecorder     *crashhistory.Recorder
+	// hpaRecorder keeps HPA status samples and events from every cluster's
+	// informers; created on first use by hpaHistory.
+	hpaRecorderOnce sync.Once
+	hpaRecorder     *hpahistory.Recorder
+	// configRecorder keeps ConfigMap and Secret data revisions from every
+	// cluster's stream; created on first use by configHistory.
+	configRecorderOnce sync.Once
+	configRecorder     *confighistory.Recorder
+	// images indexes the container images of every cluster's pod stream;
+	// created on first use by imageIndex.
+	imageIndexOnce sync.Once
+	images         *imageindex.Index
+	// pendingDeepLink is the last luxury-yacht:// link opened, kept until the
+	// frontend takes it so a link that launched the app is not lost.
+	deepLinkMu      sync.Mutex
+	pendingDeepLink string
+	// healthSummary is the latest per-cluster health summary behind the
+	// Clusters menu; the health summary loop replaces it.
+	healthSummary atomic.Pointer[[]ClusterHealthSummary]
 
 	clusterClientsMu sync.Mutex
 	clusterClients   map[string]*clusterClients
@@ -142,6 +200,14 @@ type App struct {
 	shellSessions   map[string]*shellSession
 	shellSessionsMu sync.Mutex
 
+	localTerminals   map[string]*localTerminalSession
+	localTerminalsMu sync.Mutex
+
+	// podProcessSamples keeps each container's last process CPU sample so the
+	// next GetPodProcesses call reports usage over the refresh interval.
+	podProcessSamplesMu sync.Mutex
+	podProcessSamples   map[string]podProcessSample
+
 	portForwardSessions   map[string]*portForwardSessionInternal
 	portForwardSessionsMu sync.Mutex
 
@@ -151,6 +217,26 @@ type App struct {
 	updateCheckOnce sync.Once
 	updateCheckMu   sync.RWMutex
 	updateInfo      *UpdateInfo
+	// updateAsset is the platform package of the available update; the
+	// staged update is that package once downloaded and verified.
+	updateAsset      *githubReleaseAsset
+	stagedUpdate     *stagedUpdate
+	updateDownloadMu sync.Mutex
+
+	// headless keeps the window hidden and the local API on (--headless).
+	headless bool
+	localAPI localAPIState
+
+	externalEditsMu sync.Mutex
+	externalEdits   map[string]*externalEditSession
+
+	// finishedCleanups cancels each running finished object cleanup by job ID.
+	finishedCleanupsMu sync.Mutex
+	finishedCleanups   map[string]context.CancelFunc
+
+	// gitDrift holds the latest git drift report per cluster and namespace.
+	gitDriftMu sync.Mutex
+	gitDrift   map[string]map[string]gitdrift.Report
 
 	// Per-cluster auth recovery scheduling.
 	// Tracks auth recovery scheduling per-cluster, allowing isolated
@@ -171,6 +257,14 @@ type App struct {
 	clusterWorkspaceRevision atomic.Uint64
 	clusterHealth            map[string]ClusterHealthState
 	clusterScopeRevisions    map[string]uint64
+	// clusterConnectivityFailures counts consecutive connectivity failures per
+	// cluster; a success after any failure resyncs the cluster's streams.
+	clusterConnectivityFailures map[string]int
+
+	// clusterHealthProbes records when a watch failure last triggered an
+	// out-of-band health probe per cluster (see probeClusterHealthSoon).
+	clusterHealthProbesMu sync.Mutex
+	clusterHealthProbes   map[string]time.Time
 
 	listenLoopback func() (net.Listener, error)
 
@@ -185,6 +279,7 @@ func NewApp() *App {
 	app := &App{
 		logger:                   NewLogger(1000),
 		responseCache:            newDefaultResponseCache(),
+		objectRenderCache:        newDefaultObjectRenderCache(),
 		sidebarVisible:           true,
 		appLogsPanelVisible:      false,
 		refreshSubsystems:        make(map[string]*system.Subsystem),
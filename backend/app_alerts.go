@@ -0,0 +1,180 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/alerts"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/notifications"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+// User-defined alert rules. The rules are persisted in the alertRules section
+// of settings.json and apply to every connected cluster unless a rule lists
+// its clusters. Each cluster's ingest stores feed the Pod and workload rows
+// into one shared engine, which a loop started with the refresh subsystem
+// evaluates; alerts live in memory for the session.
+
+// alertKinds are the streamed kinds alert rules can watch.
+var alertKinds = []struct {
+	kind string
+	gvr  schema.GroupVersionResource
+}{
+	{"Pod", snapshot.PodGVR},
+	{"Deployment", snapshot.DeploymentGVR},
+	{"StatefulSet", snapshot.StatefulSetGVR},
+	{"DaemonSet", snapshot.DaemonSetGVR},
+	{"ResourceQuota", snapshot.ResourceQuotaGVR},
+}
+
+// GetAlertRules returns the persisted alert rules.
+func (a *App) GetAlertRules() ([]alerts.Rule, error) {
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	return append([]alerts.Rule{}, settings.AlertRules...), nil
+}
+
+// SetAlertRules validates and persists the alert rules, assigning IDs to new
+// ones, and returns the normalized list. The whole list is rejected on the
+// first invalid rule.
+func (a *App) SetAlertRules(rules []alerts.Rule) ([]alerts.Rule, error) {
+	normalized, err := alerts.NormalizeRules(rules, uuid.NewString)
+	if err != nil {
+		return nil, err
+	}
+	engine := a.alertsEngine()
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return nil, err
+	}
+	settings.AlertRules = normalized
+	err = a.saveSettingsFile(settings)
+	a.settingsMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	engine.SetRules(normalized)
+	return normalized, nil
+}
+
+// GetAlertHistory returns this session's alerts, newest first.
+func (a *App) GetAlertHistory() []alerts.Alert {
+	return a.alertsEngine().History()
+}
+
+// AcknowledgeAlert marks an alert as seen.
+func (a *App) AcknowledgeAlert(id string) (*alerts.Alert, error) {
+	alert, err := a.alertsEngine().Acknowledge(id)
+	if err != nil {
+		return nil, err
+	}
+	a.emitEvent("alerts:changed")
+	return &alert, nil
+}
+
+// ClearResolvedAlerts removes resolved alerts from the history.
+func (a *App) ClearResolvedAlerts() {
+	a.alertsEngine().ClearResolved()
+	a.emitEvent("alerts:changed")
+}
+
+func (a *App) alertsEngine() *alerts.Engine {
+	a.alertEngineOnce.Do(func() {
+		a.alertEngine = alerts.NewEngine(config.AlertHistoryLimit, time.Now)
+	})
+	return a.alertEngine
+}
+
+// reloadAlertRules applies the persisted rules to the engine, at startup and
+// after a settings import.
+func (a *App) reloadAlertRules() {
+	rules, err := a.GetAlertRules()
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Could not read alert rules: %v", err), logsources.Settings)
+		return
+	}
+	a.alertsEngine().SetRules(rules)
+}
+
+// registerAlertSinks feeds the cluster's Pod and workload rows into the alert
+// engine. Like every bundle sink it must be added before the ingest manager
+// starts.
+func (a *App) registerAlertSinks(subsystem *system.Subsystem, clusterID string) {
+	if subsystem == nil || subsystem.IngestManager == nil {
+		return
+	}
+	engine := a.alertsEngine()
+	for _, kind := range alertKinds {
+		subsystem.IngestManager.AddBundleSink(kind.gvr, engine.Sink(clusterID, kind.kind))
+	}
+}
+
+// forgetClusterAlerts drops a removed cluster's rows so its alerts resolve.
+func (a *App) forgetClusterAlerts(clusterID string) {
+	a.alertsEngine().ForgetCluster(clusterID)
+}
+
+// startAlertLoop evaluates the alert rules until ctx is done.
+func (a *App) startAlertLoop(ctx context.Context) {
+	a.reloadAlertRules()
+	ticker := time.NewTicker(config.AlertEvaluationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.runAlertEvaluation(time.Now())
+		}
+	}
+}
+
+// runAlertEvaluation raises and resolves alerts, tells the frontend, and sends
+// desktop notifications for rules that ask for them outside quiet hours.
+func (a *App) runAlertEvaluation(now time.Time) {
+	engine := a.alertsEngine()
+	fired, resolved := engine.Evaluate()
+	if len(fired) == 0 && len(resolved) == 0 {
+		return
+	}
+	a.emitEvent("alerts:changed")
+
+	notify := make(map[string]bool)
+	for _, rule := range engine.Rules() {
+		notify[rule.ID] = rule.Notify
+	}
+	quiet := false
+	if cfg, err := a.currentNotificationConfig(); err == nil {
+		quiet = cfg.settings.QuietHours.Contains(now)
+	}
+	raised := make(map[string][]notifications.Notification)
+	for _, alert := range fired {
+		a.logger.Info(fmt.Sprintf("Alert %q fired: %s", alert.RuleName, alert.Message), logsources.App, alert.ClusterID, a.clusterNameForID(alert.ClusterID))
+		if !notify[alert.RuleID] || quiet {
+			continue
+		}
+		raised[alert.ClusterID] = append(raised[alert.ClusterID], notifications.Notification{
+			ClusterID: alert.ClusterID,
+			Rule:      notifications.RuleAlert,
+			Ref:       alert.Ref,
+			Title:     alert.RuleName,
+			Body:      alert.Message,
+		})
+	}
+	for clusterID, batch := range raised {
+		a.sendClusterNotifications(clusterID, batch)
+	}
+}
@@ -0,0 +1,79 @@
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/alerts"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestAlertRulesPersist(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	saved, err := app.SetAlertRules([]alerts.Rule{{
+		Name: "Restarting pods", Enabled: true, Kind: "Pod",
+		Metric: alerts.MetricRestarts, Operator: ">", Threshold: 5, Window: "10m",
+	}})
+	require.NoError(t, err)
+	require.NotEmpty(t, saved[0].ID)
+
+	rules, err := app.GetAlertRules()
+	require.NoError(t, err)
+	require.Equal(t, saved, rules)
+	require.Equal(t, saved, app.alertsEngine().Rules())
+
+	_, err = app.SetAlertRules([]alerts.Rule{{Name: "Broken", Kind: "Pod", Metric: "cpu", Operator: ">"}})
+	require.Error(t, err)
+	rules, err = app.GetAlertRules()
+	require.NoError(t, err)
+	require.Equal(t, saved, rules, "an invalid list is not persisted")
+}
+
+func TestAlertEvaluationNotifiesAndRecordsHistory(t *testing.T) {
+	setTestConfigEnv(t)
+	sent := stubDesktopNotifications(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	var events []string
+	app.eventEmitter = func(_ context.Context, name string, _ ...interface{}) { events = append(events, name) }
+
+	_, err := app.SetAlertRules([]alerts.Rule{{
+		ID: "not-ready", Name: "Pods not ready", Enabled: true, Kind: "Pod",
+		Metric: alerts.MetricNotReadyMinutes, Operator: ">=", Threshold: 0, Notify: true,
+	}})
+	require.NoError(t, err)
+
+	sink := app.alertsEngine().Sink("config:ctx", "Pod")
+	sink.UpsertBundle(ingest.Bundle{Table: streamrows.PodSummary{
+		Ref:    resourcemodel.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "prod", Name: "web", UID: "web-uid"},
+		Status: "Running",
+		Ready:  "0/1",
+	}})
+
+	app.runAlertEvaluation(time.Now())
+	require.Equal(t, []string{"alerts:changed", "notification:sent"}, events)
+	require.Len(t, *sent, 1)
+	require.Equal(t, "Pods not ready", (*sent)[0].Title)
+	require.Equal(t, "Pod prod/web: not ready for 0m (>= 0)", (*sent)[0].Body)
+
+	history := app.GetAlertHistory()
+	require.Len(t, history, 1)
+	require.True(t, history[0].Active())
+	acknowledged, err := app.AcknowledgeAlert(history[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, acknowledged.AcknowledgedAt)
+
+	// Removing the cluster resolves its alerts.
+	app.forgetClusterAlerts("config:ctx")
+	app.runAlertEvaluation(time.Now())
+	require.False(t, app.GetAlertHistory()[0].Active())
+	app.ClearResolvedAlerts()
+	require.Empty(t, app.GetAlertHistory())
+}
@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/informer"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+// Cache introspection. Lists what a cluster keeps in memory: the shared
+// informers caching typed objects and the ingest reflectors keeping projected
+// rows, including the custom resource reflectors the catalog promotes on
+// demand, each with its object count, estimated size, last event and sync
+// state, so a large memory footprint can be traced to the kinds behind it.
+// Sizes are estimates from a sample of each cache.
+
+// CacheIntrospection is one cluster's in-memory caches, largest first.
+type CacheIntrospection struct {
+	ClusterID   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	// Cooled clusters have stopped their informers to reclaim memory.
+	Cooled     bool                     `json:"cooled,omitempty"`
+	Informers  []informer.CacheStatus   `json:"informers"`
+	Reflectors []ingest.ReflectorStatus `json:"reflectors"`
+	// EstimatedBytes totals the informers' and reflectors' estimates.
+	EstimatedBytes int64 `json:"estimatedBytes"`
+	// HeapBytes is the whole app's heap in use, for comparison.
+	HeapBytes   uint64 `json:"heapBytes"`
+	GeneratedAt int64  `json:"generatedAt"`
+}
+
+// GetCacheIntrospection reports a connected cluster's informer and ingest
+// caches.
+func (a *App) GetCacheIntrospection(clusterID string) (*CacheIntrospection, error) {
+	clusterID = strings.TrimSpace(clusterID)
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	subsystem := a.getRefreshSubsystem(clusterID)
+	if subsystem == nil {
+		return nil, fmt.Errorf("cluster %s has no refresh subsystem", clusterID)
+	}
+	result := &CacheIntrospection{
+		ClusterID:   clusterID,
+		ClusterName: subsystem.ClusterMeta.ClusterName,
+		Cooled:      subsystem.Cooled,
+		Informers:   subsystem.InformerFactory.CacheStatus(config.CacheIntrospectionSampleSize),
+		Reflectors:  subsystem.IngestManager.CacheStatus(config.CacheIntrospectionSampleSize),
+		GeneratedAt: time.Now().UnixMilli(),
+	}
+	if result.Informers == nil {
+		result.Informers = []informer.CacheStatus{}
+	}
+	if result.Reflectors == nil {
+		result.Reflectors = []ingest.ReflectorStatus{}
+	}
+	for _, status := range result.Informers {
+		result.EstimatedBytes += status.EstimatedBytes
+	}
+	for _, status := range result.Reflectors {
+		result.EstimatedBytes += status.EstimatedBytes
+	}
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	result.HeapBytes = stats.HeapAlloc
+	return result, nil
+}
+
+// handleLocalAPICaches serves GetCacheIntrospection on the local API.
+func (a *App) handleLocalAPICaches(w http.ResponseWriter, r *http.Request) {
+	result, err := a.GetCacheIntrospection(r.URL.Query().Get("clusterId"))
+	if err != nil {
+		writeLocalAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeLocalAPIJSON(w, result)
+}
@@ -0,0 +1,39 @@
+package backend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+func TestGetCacheIntrospection(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.GetCacheIntrospection(" ")
+	require.ErrorContains(t, err, "clusterID is required")
+	_, err = app.GetCacheIntrospection("missing")
+	require.ErrorContains(t, err, "no refresh subsystem")
+
+	app.setRefreshSubsystem("cluster-a", &system.Subsystem{
+		ClusterMeta: snapshot.ClusterMeta{ClusterID: "cluster-a", ClusterName: "alpha"},
+		Cooled:      true,
+	})
+	result, err := app.GetCacheIntrospection("cluster-a")
+	require.NoError(t, err)
+	require.Equal(t, "alpha", result.ClusterName)
+	require.True(t, result.Cooled)
+	// A cooled cluster has no caches, but still reports empty lists.
+	require.NotNil(t, result.Informers)
+	require.NotNil(t, result.Reflectors)
+	require.Zero(t, result.EstimatedBytes)
+	require.NotZero(t, result.HeapBytes)
+
+	app.localAPI.token = "secret"
+	handler := app.localAPIHandler()
+	require.Equal(t, http.StatusOK, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/caches?clusterId=cluster-a", "secret").Code)
+	require.Equal(t, http.StatusBadRequest, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/caches", "secret").Code)
+}
@@ -0,0 +1,50 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/certexpiry"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// GetCertificateExpiryReport scans one cluster's TLS Secrets, webhook and CRD
+// conversion caBundles, and cert-manager Certificates, returning every
+// certificate that has expired or expires within warningDays (30 when <= 0).
+// Findings carry full object refs so tables can badge the matching rows.
+// Namespaced sources honour the cluster's allowed-namespace scope.
+func (a *App) GetCertificateExpiryReport(clusterID string, warningDays int) (*certexpiry.Report, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Certificate expiry"); err != nil {
+		return nil, err
+	}
+
+	window := certexpiry.DefaultWarningWindow
+	if warningDays > 0 {
+		window = time.Duration(warningDays) * 24 * time.Hour
+	}
+	scanner := certexpiry.Scanner{
+		ClusterID:     deps.ClusterID,
+		Client:        deps.KubernetesClient,
+		Dynamic:       deps.DynamicClient,
+		APIExtensions: deps.APIExtensionsClient,
+	}
+	report, err := scanner.Scan(deps.Context, certexpiry.Options{
+		WarningWindow: window,
+		Namespaces:    a.allowedNamespacesForCluster(deps.ClusterID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if report.ExpiredCount > 0 || report.ExpiringCount > 0 {
+		a.logger.Info(
+			fmt.Sprintf("Certificate expiry scan: %d expired, %d expiring within %d days", report.ExpiredCount, report.ExpiringCount, report.WarningWindowDays),
+			logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+		)
+	}
+	return report, nil
+}
@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/certexpiry"
+)
+
+func expiringTLSSecret(t *testing.T, name string, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})},
+	}
+}
+
+func TestGetCertificateExpiryReportUsesWarningWindow(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		expiringTLSSecret(t, "soon", time.Now().Add(10*24*time.Hour)),
+		expiringTLSSecret(t, "later", time.Now().Add(60*24*time.Hour)),
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	report, err := app.GetCertificateExpiryReport("config:ctx", 0)
+	require.NoError(t, err)
+	require.Equal(t, "config:ctx", report.ClusterID)
+	require.Equal(t, 30, report.WarningWindowDays)
+	require.Len(t, report.Findings, 1)
+	require.Equal(t, "soon", report.Findings[0].Ref.Name)
+	require.Equal(t, certexpiry.StatusExpiring, report.Findings[0].Status)
+
+	report, err = app.GetCertificateExpiryReport("config:ctx", 90)
+	require.NoError(t, err)
+	require.Len(t, report.Findings, 2)
+	require.Equal(t, 2, report.ExpiringCount)
+}
+
+func TestGetCertificateExpiryReportRequiresCluster(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	_, err := app.GetCertificateExpiryReport("", 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,238 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// Per-cluster Kubernetes client limits. The app-wide QPS and Burst
+// preferences apply to every cluster unless its section in settings.json
+// overrides them, so a rate-limited managed control plane can be throttled
+// down and a large cluster raised without touching the others. A request
+// timeout bounds ordinary requests; watches, followed logs and upgraded
+// connections (exec, attach, port-forward) are long-lived by design and are
+// never cut off. Changes apply to a connected cluster immediately.
+
+// ClusterClientLimits is one cluster's client limit overrides.
+type ClusterClientLimits struct {
+	// QPS and Burst override the app-wide client rate limits; 0 uses them.
+	QPS   int `json:"qps"`
+	Burst int `json:"burst"`
+	// RequestTimeoutSeconds bounds non-streaming requests; 0 means no timeout.
+	RequestTimeoutSeconds int `json:"requestTimeoutSeconds"`
+	// DefaultQPS and DefaultBurst are the app-wide limits zero falls back to.
+	DefaultQPS   int `json:"defaultQPS"`
+	DefaultBurst int `json:"defaultBurst"`
+}
+
+// GetClusterClientLimits returns the cluster's persisted client limit
+// overrides alongside the app-wide defaults.
+func (a *App) GetClusterClientLimits(clusterID string) (*ClusterClientLimits, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return a.clusterClientLimitsResult(settings.Clusters[clusterID].KubernetesAPI), nil
+}
+
+// SetClusterClientLimits validates and persists the cluster's client limit
+// overrides, then applies them to the cluster's live clients. Zero clears an
+// override; out-of-range values are rejected rather than clamped so the user
+// sees what was saved.
+func (a *App) SetClusterClientLimits(clusterID string, qps int, burst int, requestTimeoutSeconds int) (*ClusterClientLimits, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	if qps != 0 && (qps < minKubernetesClientQPS || qps > maxKubernetesClientQPS) {
+		return nil, fmt.Errorf("QPS must be between %d and %d, or 0 for the app default", minKubernetesClientQPS, maxKubernetesClientQPS)
+	}
+	if burst != 0 && (burst < minKubernetesClientBurst || burst > maxKubernetesClientBurst) {
+		return nil, fmt.Errorf("burst must be between %d and %d, or 0 for the app default", minKubernetesClientBurst, maxKubernetesClientBurst)
+	}
+	if requestTimeoutSeconds < 0 || requestTimeoutSeconds > maxKubernetesRequestTimeoutSeconds {
+		return nil, fmt.Errorf("request timeout must be between 0 and %d seconds", maxKubernetesRequestTimeoutSeconds)
+	}
+	var overrides *settingsClusterKubernetesAPI
+	if qps != 0 || burst != 0 || requestTimeoutSeconds != 0 {
+		overrides = &settingsClusterKubernetesAPI{
+			ClientQPS:             qps,
+			ClientBurst:           burst,
+			RequestTimeoutSeconds: requestTimeoutSeconds,
+		}
+	}
+
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return nil, err
+	}
+	section := settings.Clusters[clusterID]
+	section.KubernetesAPI = overrides
+	if clusterSettingsSectionEmpty(section) {
+		delete(settings.Clusters, clusterID)
+	} else {
+		if settings.Clusters == nil {
+			settings.Clusters = map[string]settingsClusterSection{}
+		}
+		settings.Clusters[clusterID] = section
+	}
+	if err := a.saveSettingsFile(settings); err != nil {
+		a.settingsMu.Unlock()
+		return nil, err
+	}
+	a.settingsMu.Unlock()
+
+	effectiveQPS, effectiveBurst, timeout := a.kubernetesClientLimitsForCluster(clusterID)
+	a.applyClusterClientLimits(clusterID, effectiveQPS, effectiveBurst, timeout)
+	a.logger.Info(
+		fmt.Sprintf("Kubernetes client limits for cluster %s: QPS %d, burst %d, request timeout %s", clusterID, effectiveQPS, effectiveBurst, formatRequestTimeout(timeout)),
+		logsources.Settings, clusterID, a.clusterNameForID(clusterID),
+	)
+	return a.clusterClientLimitsResult(overrides), nil
+}
+
+func (a *App) clusterClientLimitsResult(overrides *settingsClusterKubernetesAPI) *ClusterClientLimits {
+	defaultQPS, defaultBurst := a.kubernetesClientRateLimits()
+	result := &ClusterClientLimits{DefaultQPS: defaultQPS, DefaultBurst: defaultBurst}
+	if overrides != nil {
+		result.QPS = overrides.ClientQPS
+		result.Burst = overrides.ClientBurst
+		result.RequestTimeoutSeconds = overrides.RequestTimeoutSeconds
+	}
+	return result
+}
+
+// clusterKubernetesAPIOverrides reads the cluster's persisted overrides. A
+// settings read failure degrades to the app-wide limits.
+func (a *App) clusterKubernetesAPIOverrides(clusterID string) settingsClusterKubernetesAPI {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil {
+		a.logger.Warn(
+			fmt.Sprintf("Could not read client limits for cluster %s (using app defaults): %v", clusterID, err),
+			logsources.Settings, clusterID, clusterID,
+		)
+		return settingsClusterKubernetesAPI{}
+	}
+	if overrides := settings.Clusters[clusterID].KubernetesAPI; overrides != nil {
+		return *overrides
+	}
+	return settingsClusterKubernetesAPI{}
+}
+
+// kubernetesClientLimitsForCluster resolves the cluster's effective QPS,
+// burst and request timeout: its overrides, else the app-wide limits.
+func (a *App) kubernetesClientLimitsForCluster(clusterID string) (qps int, burst int, timeout time.Duration) {
+	qps, burst = a.kubernetesClientRateLimits()
+	overrides := a.clusterKubernetesAPIOverrides(clusterID)
+	if overrides.ClientQPS > 0 {
+		qps = clampKubernetesClientQPS(overrides.ClientQPS)
+	}
+	if overrides.ClientBurst > 0 {
+		burst = clampKubernetesClientBurst(overrides.ClientBurst)
+	}
+	timeout = time.Duration(overrides.RequestTimeoutSeconds) * time.Second
+	return qps, burst, timeout
+}
+
+// applyClusterClientLimits updates a connected cluster's rate limiter and
+// request timeout in place.
+func (a *App) applyClusterClientLimits(clusterID string, qps int, burst int, timeout time.Duration) {
+	a.clusterRequestTimeouts.Store(clusterID, timeout)
+	clients := a.clusterClientsForID(clusterID)
+	if clients == nil {
+		return
+	}
+	if clients.rateLimiter != nil {
+		clients.rateLimiter.Set(qps, burst)
+	}
+	a.ensureKubernetesAPIMetricsRegistry().getOrCreate(clients.meta, qps, burst)
+	if clients.restConfig != nil {
+		clients.restConfig.QPS = float32(qps)
+		clients.restConfig.Burst = burst
+	}
+}
+
+// clusterRequestTimeout is the cluster's current request timeout; zero means
+// none.
+func (a *App) clusterRequestTimeout(clusterID string) time.Duration {
+	if value, ok := a.clusterRequestTimeouts.Load(clusterID); ok {
+		return value.(time.Duration)
+	}
+	return 0
+}
+
+func formatRequestTimeout(timeout time.Duration) string {
+	if timeout <= 0 {
+		return "none"
+	}
+	return timeout.String()
+}
+
+// kubernetesRequestTimeoutTransport bounds each non-streaming request by the
+// timeout current when it starts. The deadline covers reading the body, so
+// it is released when the body is closed.
+type kubernetesRequestTimeoutTransport struct {
+	base    http.RoundTripper
+	timeout func() time.Duration
+}
+
+func (t *kubernetesRequestTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	timeout := t.timeout()
+	if timeout <= 0 || isStreamingKubernetesRequest(req) {
+		return base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// isStreamingKubernetesRequest reports requests meant to stay open: watches,
+// followed logs, and connection upgrades.
+func isStreamingKubernetesRequest(req *http.Request) bool {
+	query := req.URL.Query()
+	if watch := query.Get("watch"); watch == "true" || watch == "1" {
+		return true
+	}
+	if query.Get("follow") == "true" {
+		return true
+	}
+	if req.Header.Get("Upgrade") != "" {
+		return true
+	}
+	path := req.URL.Path
+	return strings.HasSuffix(path, "/exec") || strings.HasSuffix(path, "/attach") || strings.HasSuffix(path, "/portforward")
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
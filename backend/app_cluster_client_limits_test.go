@@ -0,0 +1,105 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetClusterClientLimitsPersistsAndAppliesToLiveClients(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	limiter := newMutableKubernetesRateLimiter(defaultKubernetesClientQPS, defaultKubernetesClientBurst)
+	app.clusterClients = map[string]*clusterClients{
+		"kc:eks": {meta: ClusterMeta{ID: "kc:eks", Name: "eks"}, rateLimiter: limiter},
+		"kc:big": {meta: ClusterMeta{ID: "kc:big", Name: "big"}, rateLimiter: newMutableKubernetesRateLimiter(defaultKubernetesClientQPS, defaultKubernetesClientBurst)},
+	}
+
+	limits, err := app.GetClusterClientLimits("kc:eks")
+	require.NoError(t, err)
+	require.Equal(t, &ClusterClientLimits{DefaultQPS: defaultKubernetesClientQPS, DefaultBurst: defaultKubernetesClientBurst}, limits)
+
+	limits, err = app.SetClusterClientLimits("kc:eks", 5, 10, 30)
+	require.NoError(t, err)
+	require.Equal(t, 5, limits.QPS)
+	require.Equal(t, 10, limits.Burst)
+	require.Equal(t, 30, limits.RequestTimeoutSeconds)
+	qps, burst := limiter.Limits()
+	require.Equal(t, 5, qps)
+	require.Equal(t, 10, burst)
+	require.Equal(t, 30*time.Second, app.clusterRequestTimeout("kc:eks"))
+
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Equal(t, &settingsClusterKubernetesAPI{ClientQPS: 5, ClientBurst: 10, RequestTimeoutSeconds: 30}, file.Clusters["kc:eks"].KubernetesAPI)
+
+	// An app-wide change leaves the overridden cluster alone.
+	app.applyKubernetesClientRateLimits(200, 400)
+	qps, burst = limiter.Limits()
+	require.Equal(t, 5, qps)
+	require.Equal(t, 10, burst)
+	qps, _ = app.clusterClients["kc:big"].rateLimiter.Limits()
+	require.Equal(t, 200, qps)
+
+	// Zero clears the overrides and drops the otherwise empty section.
+	_, err = app.SetClusterClientLimits("kc:eks", 0, 0, 0)
+	require.NoError(t, err)
+	qps, _ = limiter.Limits()
+	require.Equal(t, defaultKubernetesClientQPS, qps)
+	require.Zero(t, app.clusterRequestTimeout("kc:eks"))
+	file, err = app.loadSettingsFile()
+	require.NoError(t, err)
+	require.NotContains(t, file.Clusters, "kc:eks")
+}
+
+func TestSetClusterClientLimitsRejectsOutOfRangeValues(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetClusterClientLimits("kc:eks", maxKubernetesClientQPS+1, 0, 0)
+	require.ErrorContains(t, err, "QPS")
+	_, err = app.SetClusterClientLimits("kc:eks", 0, -1, 0)
+	require.ErrorContains(t, err, "burst")
+	_, err = app.SetClusterClientLimits("kc:eks", 0, 0, maxKubernetesRequestTimeoutSeconds+1)
+	require.ErrorContains(t, err, "timeout")
+	_, err = app.SetClusterClientLimits("", 1, 1, 1)
+	require.Error(t, err)
+
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.NotContains(t, file.Clusters, "kc:eks")
+}
+
+func TestKubernetesRequestTimeoutTransportSkipsStreams(t *testing.T) {
+	slow := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(200 * time.Millisecond):
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+		}
+	})
+	transport := &kubernetesRequestTimeoutTransport{
+		base:    slow,
+		timeout: func() time.Duration { return 20 * time.Millisecond },
+	}
+
+	_, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "https://cluster.example/api/v1/pods", nil))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	for _, url := range []string{
+		"https://cluster.example/api/v1/pods?watch=true",
+		"https://cluster.example/api/v1/namespaces/default/pods/web/log?follow=true",
+		"https://cluster.example/api/v1/namespaces/default/pods/web/exec?command=sh",
+	} {
+		resp, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, url, nil))
+		require.NoError(t, err, url)
+		require.NoError(t, resp.Body.Close())
+	}
+}
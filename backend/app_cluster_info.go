@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/clusterinfo"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// GetClusterInfo summarizes one cluster for the cluster info panel: server
+// version and platform, served API groups, the kubelet version spread across
+// nodes, and nodes whose kubelet is outside the supported version skew.
+func (a *App) GetClusterInfo(clusterID string) (*clusterinfo.Info, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Cluster info"); err != nil {
+		return nil, err
+	}
+
+	collector := clusterinfo.Collector{
+		ClusterID: deps.ClusterID,
+		Client:    deps.KubernetesClient,
+	}
+	info, err := collector.Collect(deps.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	unsupported := 0
+	for _, finding := range info.Skew {
+		if finding.Severity == clusterinfo.SkewUnsupported {
+			unsupported += len(finding.Nodes)
+		}
+	}
+	if unsupported > 0 {
+		a.logger.Warn(
+			fmt.Sprintf("Cluster info: %d node(s) outside the supported kubelet version skew from %s", unsupported, info.Server.GitVersion),
+			logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+		)
+	}
+	return info, nil
+}
@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	versioninfo "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/clusterinfo"
+)
+
+func TestGetClusterInfoFlagsUnsupportedSkew(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "old"},
+		Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.26.4"}},
+	})
+	client.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &versioninfo.Info{GitVersion: "v1.31.0", Platform: "linux/arm64"}
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	info, err := app.GetClusterInfo("config:ctx")
+	require.NoError(t, err)
+	require.Equal(t, "config:ctx", info.ClusterID)
+	require.Equal(t, "linux/arm64", info.Server.Platform)
+	require.Equal(t, 1, info.NodeCount)
+	require.Len(t, info.Skew, 1)
+	require.Equal(t, clusterinfo.SkewUnsupported, info.Skew[0].Severity)
+	require.Equal(t, []string{"old"}, info.Skew[0].Nodes)
+}
+
+func TestGetClusterInfoRequiresCluster(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	_, err := app.GetClusterInfo("")
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetClusterReadOnlyPersistsAndEmits(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	var events []string
+	app.eventEmitter = func(_ context.Context, name string, _ ...interface{}) {
+		events = append(events, name)
+	}
+
+	readOnly, err := app.GetClusterReadOnly("kc:prod")
+	require.NoError(t, err)
+	require.False(t, readOnly)
+
+	require.NoError(t, app.SetClusterReadOnly("kc:prod", true))
+	readOnly, err = app.GetClusterReadOnly("kc:prod")
+	require.NoError(t, err)
+	require.True(t, readOnly)
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.True(t, file.Clusters["kc:prod"].ReadOnly)
+
+	// Unchanged values neither save nor emit.
+	require.NoError(t, app.SetClusterReadOnly("kc:prod", true))
+	require.Equal(t, []string{"cluster:readonly:changed"}, events)
+
+	// Clearing the only per-cluster setting drops the section.
+	require.NoError(t, app.SetClusterReadOnly("kc:prod", false))
+	file, err = app.loadSettingsFile()
+	require.NoError(t, err)
+	require.NotContains(t, file.Clusters, "kc:prod")
+
+	require.Error(t, app.SetClusterReadOnly("", true))
+}
+
+func TestReadOnlyClusterRejectsMutations(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	require.NoError(t, app.SetClusterReadOnly("kc:prod", true))
+
+	deployment := objectActionTarget("kc:prod", "apps", "v1", "Deployment", "default", "api")
+	for _, req := range []ObjectActionRequest{
+		{Action: ObjectActionDelete, Target: deployment},
+		{Action: ObjectActionRestart, Target: deployment},
+		{Action: ObjectActionScale, Target: deployment, Replicas: intPtr(0)},
+		{Action: ObjectActionCordon, Target: objectActionTarget("kc:prod", "", "v1", "Node", "", "node-1")},
+	} {
+		_, err := app.RunObjectAction(req)
+		require.ErrorIs(t, err, errClusterReadOnly, req.Action)
+	}
+
+	_, err := app.ApplyObjectYaml("kc:prod", ObjectYAMLMutationRequest{})
+	require.ErrorIs(t, err, errClusterReadOnly)
+	_, err = app.StartShellSession("kc:prod", ShellSessionRequest{Namespace: "default", PodName: "api-0"})
+	require.ErrorIs(t, err, errClusterReadOnly)
+
+	// Port-forwarding does not change cluster state and stays available.
+	_, err = app.RunObjectAction(ObjectActionRequest{
+		Action:      ObjectActionStartPortForward,
+		Target:      objectActionTarget("kc:prod", "", "v1", "Pod", "default", "api-0"),
+		PortForward: &ObjectActionPortForwardOptions{ContainerPort: 8080, LocalPort: 8080},
+	})
+	require.NotErrorIs(t, err, errClusterReadOnly)
+
+	// Other clusters are unaffected.
+	_, err = app.RunObjectAction(ObjectActionRequest{Action: ObjectActionDelete, Target: objectActionTarget("kc:dev", "apps", "v1", "Deployment", "default", "api")})
+	require.NotErrorIs(t, err, errClusterReadOnly)
+}
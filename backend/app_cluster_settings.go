@@ -81,7 +81,8 @@ func (a *App) SetClusterAllowedNamespaces(clusterID string, namespaces []string)
 }
 
 func clusterSettingsSectionEmpty(section settingsClusterSection) bool {
-	return len(section.AllowedNamespaces) == 0 &&
+	return len(section.AllowedNamespaces) == 0 && !section.ReadOnly && !section.Notifications && len(section.GitDrift) == 0 &&
+		len(section.PinnedNamespaces) == 0 && len(section.PinnedResources) == 0 && section.KubernetesAPI == nil &&
 		(section.Attention == nil ||
 			(len(section.Attention.ObjectFindings) == 0 && len(section.Attention.FindingTypes) == 0))
 }
@@ -0,0 +1,79 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/confighistory"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+	"github.com/luxury-yacht/app/backend/resourcekind"
+	"github.com/luxury-yacht/app/backend/resources/configmap"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+)
+
+// ConfigMap and Secret change history. Each cluster's ConfigMap and Secret
+// ingest stores feed per-key data digests (and small ConfigMaps' values) into
+// one shared recorder, kept in memory for the session, so changes can be listed
+// and diffed without GitOps.
+
+// configHistoryKinds are the kinds whose data changes are recorded.
+var configHistoryKinds = map[string]resourcekind.Identity{
+	configmap.Identity.Kind: configmap.Identity,
+	secret.Identity.Kind:    secret.Identity,
+}
+
+// GetConfigHistory returns the data revisions recorded for a ConfigMap or
+// Secret, newest first.
+func (a *App) GetConfigHistory(clusterID, namespace, kind, name string) (*confighistory.History, error) {
+	if err := validateConfigHistoryTarget(clusterID, namespace, kind, name); err != nil {
+		return nil, err
+	}
+	history := a.configHistory().History(clusterID, namespace, kind, name)
+	return &history, nil
+}
+
+// DiffConfigRevisions compares two recorded revisions of a ConfigMap or Secret
+// key by key. Values are included for ConfigMaps whose values were kept;
+// Secret diffs name the keys only.
+func (a *App) DiffConfigRevisions(clusterID, namespace, kind, name string, fromRevision, toRevision int) (*confighistory.Diff, error) {
+	if err := validateConfigHistoryTarget(clusterID, namespace, kind, name); err != nil {
+		return nil, err
+	}
+	diff, err := a.configHistory().Diff(clusterID, namespace, kind, name, fromRevision, toRevision)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s/%s: %w", kind, namespace, name, err)
+	}
+	return &diff, nil
+}
+
+func validateConfigHistoryTarget(clusterID, namespace, kind, name string) error {
+	if strings.TrimSpace(clusterID) == "" {
+		return fmt.Errorf("cluster ID is required")
+	}
+	if _, ok := configHistoryKinds[kind]; !ok {
+		return fmt.Errorf("config history is not available for kind %q", kind)
+	}
+	return requireNamespacedObject(namespace, name)
+}
+
+func (a *App) configHistory() *confighistory.Recorder {
+	a.configRecorderOnce.Do(func() {
+		a.configRecorder = confighistory.NewRecorder(config.ConfigHistoryObjectLimit, time.Now)
+	})
+	return a.configRecorder
+}
+
+// registerConfigHistorySinks feeds the cluster's ConfigMaps and Secrets into the
+// config-history recorder. Like every bundle sink they must be added before the
+// ingest manager starts.
+func (a *App) registerConfigHistorySinks(subsystem *system.Subsystem, clusterID string) {
+	if subsystem == nil || subsystem.IngestManager == nil {
+		return
+	}
+	recorder := a.configHistory()
+	for kind, identity := range configHistoryKinds {
+		subsystem.IngestManager.AddBundleSink(identity.GVR(), recorder.Sink(clusterID, kind))
+	}
+}
@@ -0,0 +1,48 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func TestConfigHistoryReadsRecordedRevisions(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	sink := app.configHistory().Sink("config:ctx", "ConfigMap")
+	for _, value := range []string{"info", "debug"} {
+		sink.UpsertBundle(ingest.Bundle{Aggregate: streamrows.ConfigDataAggregate{
+			Namespace: "prod",
+			Name:      "app",
+			Hashes:    map[string]string{"LOG_LEVEL": streamrows.HashConfigValue([]byte(value))},
+			Values:    map[string]string{"LOG_LEVEL": value},
+		}})
+	}
+
+	history, err := app.GetConfigHistory("config:ctx", "prod", "ConfigMap", "app")
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 2)
+	require.Equal(t, []string{"LOG_LEVEL"}, history.Revisions[0].Changed)
+
+	diff, err := app.DiffConfigRevisions("config:ctx", "prod", "ConfigMap", "app", 1, 2)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 1)
+	require.Equal(t, "debug", diff.Changes[0].To)
+
+	_, err = app.DiffConfigRevisions("config:ctx", "prod", "ConfigMap", "app", 1, 5)
+	require.ErrorContains(t, err, "ConfigMap prod/app")
+	_, err = app.GetConfigHistory("config:ctx", "prod", "Deployment", "app")
+	require.Error(t, err)
+	_, err = app.GetConfigHistory("config:ctx", "", "ConfigMap", "app")
+	require.Error(t, err)
+
+	// Removing the cluster drops its history.
+	app.removeClusterWorkspaceState("config:ctx")
+	history, err = app.GetConfigHistory("config:ctx", "prod", "ConfigMap", "app")
+	require.NoError(t, err)
+	require.Empty(t, history.Revisions)
+}
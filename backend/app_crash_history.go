@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/crashhistory"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+// Workload crash history. Each cluster's pod ingest store feeds container
+// terminations (exit code, reason such as OOMKilled, timestamps) into one
+// shared recorder, kept in memory for the session, so a workload's restarts
+// can be read back as a timeline.
+
+// crashHistoryKinds are the kinds GetWorkloadCrashHistory accepts: the pod
+// owners the pod stream groups by, plus Pod for a single pod.
+var crashHistoryKinds = map[string]struct{}{
+	"Deployment":  {},
+	"StatefulSet": {},
+	"DaemonSet":   {},
+	"Job":         {},
+	"ReplicaSet":  {},
+	"Pod":         {},
+}
+
+// GetWorkloadCrashHistory returns the container terminations recorded for a
+// workload's pods (or one pod), newest first.
+func (a *App) GetWorkloadCrashHistory(clusterID, namespace, kind, name string) (*crashhistory.Timeline, error) {
+	if strings.TrimSpace(clusterID) == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	if _, ok := crashHistoryKinds[kind]; !ok {
+		return nil, fmt.Errorf("crash history is not available for kind %q", kind)
+	}
+	if err := requireObjectName(name); err != nil {
+		return nil, err
+	}
+	timeline := a.crashHistory().Timeline(clusterID, namespace, kind, name)
+	return &timeline, nil
+}
+
+func (a *App) crashHistory() *crashhistory.Recorder {
+	a.crashRecorderOnce.Do(func() {
+		a.crashRecorder = crashhistory.NewRecorder(config.CrashHistoryRetention, config.CrashHistoryWorkloadLimit, time.Now)
+	})
+	return a.crashRecorder
+}
+
+// registerCrashHistorySink feeds the cluster's pods into the crash-history
+// recorder. Like every bundle sink it must be added before the ingest manager
+// starts.
+func (a *App) registerCrashHistorySink(subsystem *system.Subsystem, clusterID string) {
+	if subsystem == nil || subsystem.IngestManager == nil {
+		return
+	}
+	subsystem.IngestManager.AddBundleSink(snapshot.PodGVR, a.crashHistory().Sink(clusterID))
+}
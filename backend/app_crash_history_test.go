@@ -0,0 +1,40 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func TestWorkloadCrashHistoryReadsRecordedTerminations(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	finished := time.Now().Add(-time.Hour).UTC()
+	app.crashHistory().Sink("config:ctx").UpsertBundle(ingest.Bundle{Aggregate: streamrows.PodAggregate{
+		Namespace: "prod",
+		Name:      "api-1",
+		OwnerKey:  "prod/Deployment/api",
+		LastTerminations: []streamrows.ContainerTermination{{
+			Container: "app", RestartCount: 3, ExitCode: 137, Reason: "OOMKilled", FinishedAt: finished,
+		}},
+	}})
+
+	timeline, err := app.GetWorkloadCrashHistory("config:ctx", "prod", "Deployment", "api")
+	require.NoError(t, err)
+	require.Len(t, timeline.Events, 1)
+	require.Equal(t, "OOMKilled", timeline.Events[0].Reason)
+
+	_, err = app.GetWorkloadCrashHistory("config:ctx", "prod", "ConfigMap", "api")
+	require.Error(t, err)
+
+	// Removing the cluster drops its history.
+	app.removeClusterWorkspaceState("config:ctx")
+	timeline, err = app.GetWorkloadCrashHistory("config:ctx", "prod", "Deployment", "api")
+	require.NoError(t, err)
+	require.Empty(t, timeline.Events)
+}
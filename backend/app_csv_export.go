@@ -61,14 +61,19 @@ func (a *App) SaveCsvFile(defaultFilename string, content string) (CatalogQueryC
 	return CatalogQueryCSVExport{Path: path, Bytes: info.Size()}, nil
 }
 
-// writeCSVFileAtomically writes content to a sibling temp file, fsyncs it (the
-// point of write-then-rename is surviving a crash; without the sync the rename
-// can land before the data), makes it user-readable (CreateTemp creates 0600),
-// and renames it into place.
+// writeCSVFileAtomically writes a CSV export into place; see writeExportFileAtomically.
 func writeCSVFileAtomically(path string, content string) (os.FileInfo, error) {
+	return writeExportFileAtomically(path, content, "CSV export")
+}
+
+// writeExportFileAtomically writes content to a sibling temp file, fsyncs it
+// (the point of write-then-rename is surviving a crash; without the sync the
+// rename can land before the data), makes it user-readable (CreateTemp creates
+// 0600), and renames it into place. label names the export in errors.
+func writeExportFileAtomically(path string, content string, label string) (os.FileInfo, error) {
 	tempFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
 	if err != nil {
-		return nil, fmt.Errorf("create CSV export: %w", err)
+		return nil, fmt.Errorf("create %s: %w", label, err)
 	}
 	tempPath := tempFile.Name()
 	cleanup := true
@@ -80,24 +85,24 @@ func writeCSVFileAtomically(path string, content string) (os.FileInfo, error) {
 
 	if _, err := tempFile.WriteString(content); err != nil {
 		_ = tempFile.Close()
-		return nil, fmt.Errorf("write CSV export: %w", err)
+		return nil, fmt.Errorf("write %s: %w", label, err)
 	}
 	if err := tempFile.Sync(); err != nil {
 		_ = tempFile.Close()
-		return nil, fmt.Errorf("sync CSV export: %w", err)
+		return nil, fmt.Errorf("sync %s: %w", label, err)
 	}
 	if err := tempFile.Close(); err != nil {
-		return nil, fmt.Errorf("close CSV export: %w", err)
+		return nil, fmt.Errorf("close %s: %w", label, err)
 	}
 	if err := os.Chmod(tempPath, 0o644); err != nil {
-		return nil, fmt.Errorf("set CSV export permissions: %w", err)
+		return nil, fmt.Errorf("set %s permissions: %w", label, err)
 	}
 	info, err := os.Stat(tempPath)
 	if err != nil {
-		return nil, fmt.Errorf("stat CSV export: %w", err)
+		return nil, fmt.Errorf("stat %s: %w", label, err)
 	}
 	if err := os.Rename(tempPath, path); err != nil {
-		return nil, fmt.Errorf("move CSV export into place: %w", err)
+		return nil, fmt.Errorf("move %s into place: %w", label, err)
 	}
 	cleanup = false
 	return info, nil
@@ -0,0 +1,634 @@
+package backend
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/informer"
+	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Diagnostic bundle. One zip with what a bug report needs to be reproduced:
+// versions, the app log, settings, per-cluster stream and snapshot telemetry,
+// informer sync states and the catalog and API client diagnostics. Cluster,
+// namespace and path details, and the objects named in refresh scopes, are
+// replaced by stable placeholders in every file. Collection problems are recorded in the manifest rather than
+// failing the bundle.
+
+// DiagnosticBundleResult reports where a diagnostic bundle was written.
+type DiagnosticBundleResult struct {
+	Path  string   `json:"path"`
+	Bytes int64    `json:"bytes"`
+	Files []string `json:"files"`
+}
+
+// diagnosticBundleManifest is manifest.json: versions and collection errors.
+type diagnosticBundleManifest struct {
+	GeneratedAt   string   `json:"generatedAt"`
+	AppVersion    string   `json:"appVersion"`
+	BuildTime     string   `json:"buildTime"`
+	GitCommit     string   `json:"gitCommit"`
+	IsBeta        bool     `json:"isBeta"`
+	GoVersion     string   `json:"goVersion"`
+	WailsVersion  string   `json:"wailsVersion,omitempty"`
+	OS            string   `json:"os"`
+	Arch          string   `json:"arch"`
+	UpdateChannel string   `json:"updateChannel"`
+	Clusters      int      `json:"clusters"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+// GenerateDiagnosticBundle asks for a destination and writes a diagnostic
+// bundle there, ready to attach to a GitHub issue.
+func (a *App) GenerateDiagnosticBundle() (DiagnosticBundleResult, error) {
+	var empty DiagnosticBundleResult
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:           "Save Diagnostic Bundle",
+		DefaultFilename: fmt.Sprintf("luxury-yacht-diagnostics-%s.zip", time.Now().Format("20060102-150405")),
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "Zip archives (*.zip)", Pattern: "*.zip"},
+		},
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select diagnostic bundle file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("diagnostic bundle canceled")
+	}
+	return a.writeDiagnosticBundle(path)
+}
+
+// generateDiagnosticBundleFromMenu runs GenerateDiagnosticBundle for the menu
+// item and tells the frontend where the bundle went.
+func (a *App) generateDiagnosticBundleFromMenu() {
+	result, err := a.GenerateDiagnosticBundle()
+	if err != nil {
+		if !strings.HasSuffix(err.Error(), "canceled") {
+			a.logger.Warn(fmt.Sprintf("Diagnostic bundle failed: %v", err), logsources.App)
+		}
+		return
+	}
+	a.emitEvent("diagnostic-bundle:created", result)
+}
+
+// writeDiagnosticBundle collects the diagnostics and writes the zip to path.
+// Every file goes through one redactor, so a cluster, namespace or object
+// gets the same placeholder in each of them.
+func (a *App) writeDiagnosticBundle(path string) (DiagnosticBundleResult, error) {
+	var empty DiagnosticBundleResult
+	home, _ := os.UserHomeDir()
+	redact := newDiagnosticRedactor(home)
+	manifest := a.diagnosticBundleManifest()
+	files := map[string]func() (any, error){
+		"settings.json": func() (any, error) { return a.anonymizedSettings(redact) },
+		"telemetry.json": func() (any, error) {
+			return byClusterPlaceholder(redact, a.diagnosticTelemetry()), nil
+		},
+		"informers.json": func() (any, error) {
+			return byClusterPlaceholder(redact, a.diagnosticInformerSyncStates()), nil
+		},
+		"catalog.json": func() (any, error) { return a.GetCatalogDiagnostics() },
+		"kubernetes-api.json": func() (any, error) {
+			return a.GetKubernetesAPIClientDiagnostics()
+		},
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Learn every identifier before rewriting anything, so a value first
+	// seen in one file is also replaced in the files collected before it.
+	a.registerDiagnosticClusters(redact)
+	logs := a.GetAppLogs()
+	for _, entry := range logs {
+		redact.cluster(entry.ClusterID, entry.ClusterName)
+	}
+	values := make(map[string]any, len(files))
+	for _, name := range names {
+		value, err := files[name]()
+		if err == nil {
+			value, err = redact.collect(value)
+		}
+		if err != nil {
+			manifest.Errors = append(manifest.Errors, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		values[name] = value
+	}
+
+	contents := map[string][]byte{"logs.txt": diagnosticLogText(logs, redact)}
+	for _, name := range names {
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		data, err := json.MarshalIndent(redact.value(value), "", "  ")
+		if err != nil {
+			manifest.Errors = append(manifest.Errors, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		contents[name] = data
+	}
+	for i, message := range manifest.Errors {
+		manifest.Errors[i] = redact.text(message)
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return empty, err
+	}
+	contents["manifest.json"] = manifestData
+
+	written := make([]string, 0, len(contents))
+	for name := range contents {
+		written = append(written, name)
+	}
+	sort.Strings(written)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return empty, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return empty, err
+	}
+	archive := zip.NewWriter(file)
+	for _, name := range written {
+		entry, err := archive.Create(name)
+		if err == nil {
+			_, err = entry.Write(contents[name])
+		}
+		if err != nil {
+			_ = archive.Close()
+			_ = file.Close()
+			return empty, fmt.Errorf("write %s: %w", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		_ = file.Close()
+		return empty, err
+	}
+	if err := file.Close(); err != nil {
+		return empty, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return empty, err
+	}
+	a.logger.Info(fmt.Sprintf("Diagnostic bundle written to %s", path), logsources.App)
+	return DiagnosticBundleResult{Path: path, Bytes: info.Size(), Files: written}, nil
+}
+
+func (a *App) diagnosticBundleManifest() diagnosticBundleManifest {
+	manifest := diagnosticBundleManifest{
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+		AppVersion:    Version,
+		BuildTime:     BuildTime,
+		GitCommit:     GitCommit,
+		IsBeta:        IsBetaBuild == "true",
+		GoVersion:     goruntime.Version(),
+		OS:            goruntime.GOOS,
+		Arch:          goruntime.GOARCH,
+		UpdateChannel: a.updateChannel(),
+		Clusters:      len(a.snapshotRefreshSubsystems()),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/wailsapp/wails/v2" {
+				manifest.WailsVersion = dep.Version
+			}
+		}
+	}
+	return manifest
+}
+
+// registerDiagnosticClusters teaches the redactor every known cluster: the
+// open ones and each context in the loaded kubeconfigs, with the API server
+// each context points at.
+func (a *App) registerDiagnosticClusters(redact *diagnosticRedactor) {
+	for clusterID, subsystem := range a.snapshotRefreshSubsystems() {
+		name := a.clusterNameForID(clusterID)
+		if subsystem != nil && subsystem.ClusterMeta.ClusterName != "" {
+			name = subsystem.ClusterMeta.ClusterName
+		}
+		redact.cluster(clusterID, name)
+	}
+	a.kubeconfigsMu.RLock()
+	kubeconfigs := append([]KubeconfigInfo(nil), a.availableKubeconfigs...)
+	a.kubeconfigsMu.RUnlock()
+	files := make(map[string]*clientcmdapi.Config)
+	for _, kc := range kubeconfigs {
+		redact.cluster(fmt.Sprintf("%s:%s", kc.Name, kc.Context), kc.Context)
+		redact.kubeconfig(kubeconfigSelection{Path: kc.Path, Context: kc.Context}.String())
+		config, loaded := files[kc.Path]
+		if !loaded {
+			// A file that no longer parses has no servers to learn; the rest
+			// of the bundle does not depend on it.
+			config, _ = clientcmd.LoadFromFile(kc.Path)
+			files[kc.Path] = config
+		}
+		if config == nil {
+			continue
+		}
+		if context, ok := config.Contexts[kc.Context]; ok && context != nil {
+			if cluster, ok := config.Clusters[context.Cluster]; ok && cluster != nil {
+				redact.server(cluster.Server)
+			}
+		}
+	}
+}
+
+// diagnosticLogText renders the app log one entry per line, with clusters,
+// namespaces and objects replaced and the home directory shortened to "~".
+func diagnosticLogText(entries []LogEntry, redact *diagnosticRedactor) []byte {
+	var b strings.Builder
+	for _, entry := range entries {
+		fmt.Fprintf(&b, "%s %-5s", entry.Timestamp, strings.ToUpper(entry.Level))
+		if entry.Source != "" {
+			fmt.Fprintf(&b, " [%s]", entry.Source)
+		}
+		if entry.ClusterID != "" {
+			fmt.Fprintf(&b, " (%s)", redact.cluster(entry.ClusterID, entry.ClusterName))
+		}
+		b.WriteString(" ")
+		b.WriteString(redact.text(entry.Message))
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
+
+// diagnosticTelemetry returns each open cluster's telemetry summary.
+func (a *App) diagnosticTelemetry() map[string]telemetry.Summary {
+	result := make(map[string]telemetry.Summary)
+	for clusterID, subsystem := range a.snapshotRefreshSubsystems() {
+		if subsystem != nil && subsystem.Telemetry != nil {
+			result[clusterID] = subsystem.Telemetry.SnapshotSummary()
+		}
+	}
+	return result
+}
+
+// diagnosticInformerSyncStates returns each open cluster's informer states.
+func (a *App) diagnosticInformerSyncStates() map[string][]informer.InformerSyncStatus {
+	result := make(map[string][]informer.InformerSyncStatus)
+	for clusterID, subsystem := range a.snapshotRefreshSubsystems() {
+		if subsystem != nil && subsystem.InformerFactory != nil {
+			result[clusterID] = subsystem.InformerFactory.SyncStatus()
+		}
+	}
+	return result
+}
+
+// anonymizedSettings returns settings.json with identifying values replaced.
+// Cluster IDs, kubeconfig selections, namespaces and cluster patterns become
+// stable placeholders ("cluster-1"), so the same cluster reads the same
+// everywhere in the bundle. Object-level Attention ignores, pinned objects,
+// the sync directory and the Trivy server are dropped.
+func (a *App) anonymizedSettings(redact *diagnosticRedactor) (*settingsFile, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	home := redact.home
+	kubeconfig := &settings.Kubeconfig
+	for i, selected := range kubeconfig.Selected {
+		kubeconfig.Selected[i] = redact.kubeconfig(selected)
+	}
+	if kubeconfig.Active != "" {
+		kubeconfig.Active = redact.kubeconfig(kubeconfig.Active)
+	}
+	for i, searchPath := range kubeconfig.SearchPaths {
+		kubeconfig.SearchPaths[i] = shortenHomePath(searchPath, home)
+	}
+
+	if len(settings.Clusters) > 0 {
+		clusters := make(map[string]settingsClusterSection, len(settings.Clusters))
+		for clusterID, section := range settings.Clusters {
+			for i, namespace := range section.AllowedNamespaces {
+				section.AllowedNamespaces[i] = redact.namespace(namespace)
+			}
+			if section.Attention != nil {
+				section.Attention.ObjectFindings = nil
+			}
+			for i := range section.GitDrift {
+				section.GitDrift[i].Namespace = redact.namespace(section.GitDrift[i].Namespace)
+				section.GitDrift[i].Repo = shortenHomePath(section.GitDrift[i].Repo, home)
+			}
+			for i, namespace := range section.PinnedNamespaces {
+				section.PinnedNamespaces[i] = redact.namespace(namespace)
+			}
+			section.PinnedResources = nil
+			clusters[redact.cluster(clusterID, "")] = section
+		}
+		settings.Clusters = clusters
+	}
+	for i := range settings.AlertRules {
+		rule := &settings.AlertRules[i]
+		for j, clusterID := range rule.ClusterIDs {
+			rule.ClusterIDs[j] = redact.cluster(clusterID, "")
+		}
+		for j, namespace := range rule.Namespaces {
+			rule.Namespaces[j] = redact.namespace(namespace)
+		}
+	}
+	for i := range settings.ShellCommands {
+		rule := &settings.ShellCommands[i]
+		if rule.Image != "" {
+			rule.Image = redact.pattern(rule.Image)
+		}
+		if rule.Namespace != "" {
+			rule.Namespace = redact.pattern(rule.Namespace)
+		}
+	}
+	if settings.Notifications != nil {
+		for i, namespace := range settings.Notifications.Rules.Namespaces {
+			settings.Notifications.Rules.Namespaces[i] = redact.namespace(namespace)
+		}
+	}
+
+	preferences := &settings.Preferences
+	for i, pattern := range preferences.ProtectedNamespaces {
+		preferences.ProtectedNamespaces[i] = redact.pattern(pattern)
+	}
+	for i := range preferences.Themes {
+		if preferences.Themes[i].ClusterPattern != "" {
+			preferences.Themes[i].ClusterPattern = redact.pattern(preferences.Themes[i].ClusterPattern)
+		}
+	}
+	if preferences.VulnerabilityScan != nil {
+		preferences.VulnerabilityScan.TrivyPath = shortenHomePath(preferences.VulnerabilityScan.TrivyPath, home)
+		preferences.VulnerabilityScan.TrivyServerURL = ""
+	}
+	settings.Sync = nil
+	return settings, nil
+}
+
+// byClusterPlaceholder re-keys a per-cluster map by cluster placeholder.
+func byClusterPlaceholder[T any](redact *diagnosticRedactor, byCluster map[string]T) map[string]T {
+	result := make(map[string]T, len(byCluster))
+	for clusterID, value := range byCluster {
+		result[redact.cluster(clusterID, "")] = value
+	}
+	return result
+}
+
+// placeholderMap hands out stable placeholders per kind of value.
+type placeholderMap struct {
+	labels map[string]string
+	counts map[string]int
+}
+
+func newPlaceholderMap() *placeholderMap {
+	return &placeholderMap{labels: make(map[string]string), counts: make(map[string]int)}
+}
+
+func (p *placeholderMap) label(kind, value string) string {
+	key := kind + "\x00" + value
+	if label, ok := p.labels[key]; ok {
+		return label
+	}
+	p.counts[kind]++
+	label := fmt.Sprintf("%s-%d", kind, p.counts[kind])
+	p.labels[key] = label
+	return label
+}
+
+// diagnosticRedactor replaces the clusters, API servers, kubeconfigs,
+// namespaces and objects a bundle mentions with placeholders. Values are
+// learned from the structured fields that carry them (clusterId, namespace,
+// scope) and the loaded kubeconfigs, and then replaced wherever they appear,
+// including free text such as log messages and errors.
+type diagnosticRedactor struct {
+	home string
+	anon *placeholderMap
+	// known maps each raw value to its placeholder. byFirst indexes the raw
+	// values by first byte, longest first, and is rebuilt when known grows.
+	known   map[string]string
+	byFirst map[byte][]string
+}
+
+func newDiagnosticRedactor(home string) *diagnosticRedactor {
+	return &diagnosticRedactor{home: home, anon: newPlaceholderMap(), known: make(map[string]string)}
+}
+
+func (r *diagnosticRedactor) learn(kind, value string) string {
+	if value == "" {
+		return ""
+	}
+	if label, ok := r.known[value]; ok {
+		return label
+	}
+	label := r.anon.label(kind, value)
+	r.known[value] = label
+	r.byFirst = nil
+	return label
+}
+
+// cluster returns the cluster's placeholder. The context name, given or
+// taken from the "<kubeconfig>:<context>" ID, is replaced by the same one.
+func (r *diagnosticRedactor) cluster(clusterID, name string) string {
+	label := r.learn("cluster", clusterID)
+	if name == "" {
+		if _, context, ok := strings.Cut(clusterID, ":"); ok {
+			name = context
+		}
+	}
+	if label != "" && name != "" {
+		if _, ok := r.known[name]; !ok {
+			r.known[name] = label
+			r.byFirst = nil
+		}
+	}
+	return label
+}
+
+// server returns the placeholder for an API server URL. The URL's host, with
+// and without its port, is replaced by the same one.
+func (r *diagnosticRedactor) server(server string) string {
+	label := r.learn("server", server)
+	parsed, err := url.Parse(server)
+	if label == "" || err != nil {
+		return label
+	}
+	for _, host := range []string{parsed.Host, parsed.Hostname()} {
+		if _, ok := r.known[host]; host != "" && !ok {
+			r.known[host] = label
+			r.byFirst = nil
+		}
+	}
+	return label
+}
+
+func (r *diagnosticRedactor) kubeconfig(selection string) string {
+	return r.learn("kubeconfig", selection)
+}
+
+func (r *diagnosticRedactor) namespace(namespace string) string {
+	return r.learn("namespace", namespace)
+}
+
+func (r *diagnosticRedactor) object(name string) string {
+	return r.learn("object", name)
+}
+
+func (r *diagnosticRedactor) pattern(pattern string) string {
+	return r.learn("pattern", pattern)
+}
+
+// scope learns the clusters, namespace and object named by a refresh scope.
+func (r *diagnosticRedactor) scope(scope string) {
+	clusterIDs, rest := refresh.SplitClusterScopeList(scope)
+	for _, clusterID := range clusterIDs {
+		r.cluster(clusterID, "")
+	}
+	if identity, err := refresh.ParseObjectScope(rest); err == nil {
+		r.namespace(identity.Namespace)
+		r.object(identity.Name)
+		return
+	}
+	if namespace, ok := strings.CutPrefix(rest, "namespace:"); ok && namespace != "all" {
+		r.namespace(namespace)
+	}
+}
+
+// collect converts value to its JSON form and learns the identifiers in it.
+func (r *diagnosticRedactor) collect(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	r.learnFields(generic)
+	return generic, nil
+}
+
+func (r *diagnosticRedactor) learnFields(value any) {
+	switch typed := value.(type) {
+	case map[string]any:
+		if clusterID, ok := typed["clusterId"].(string); ok {
+			name, _ := typed["clusterName"].(string)
+			r.cluster(clusterID, name)
+		}
+		if namespace, ok := typed["namespace"].(string); ok {
+			r.namespace(namespace)
+		}
+		if scope, ok := typed["scope"].(string); ok {
+			r.scope(scope)
+		}
+		for _, child := range typed {
+			r.learnFields(child)
+		}
+	case []any:
+		for _, child := range typed {
+			r.learnFields(child)
+		}
+	}
+}
+
+// value returns a collected value with every string redacted. Keys are left
+// alone: they are field names, or placeholders already.
+func (r *diagnosticRedactor) value(value any) any {
+	switch typed := value.(type) {
+	case string:
+		return r.text(typed)
+	case map[string]any:
+		redacted := make(map[string]any, len(typed))
+		for key, child := range typed {
+			redacted[key] = r.value(child)
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(typed))
+		for i, child := range typed {
+			redacted[i] = r.value(child)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// text replaces every learned value that stands as a whole word in s, and
+// shortens the home directory. A value inside a longer name ("prod" in
+// "prod-east") is left alone, so placeholders are never replaced again.
+func (r *diagnosticRedactor) text(s string) string {
+	s = shortenHomePath(s, r.home)
+	if len(r.known) == 0 || s == "" {
+		return s
+	}
+	if r.byFirst == nil {
+		r.byFirst = make(map[byte][]string)
+		for raw := range r.known {
+			r.byFirst[raw[0]] = append(r.byFirst[raw[0]], raw)
+		}
+		for _, values := range r.byFirst {
+			sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if i == 0 || !isRedactionWordByte(s[i-1]) {
+			if raw := r.matchAt(s, i); raw != "" {
+				b.WriteString(r.known[raw])
+				i += len(raw)
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+func (r *diagnosticRedactor) matchAt(s string, i int) string {
+	for _, raw := range r.byFirst[s[i]] {
+		end := i + len(raw)
+		if strings.HasPrefix(s[i:], raw) && (end == len(s) || !isRedactionWordByte(s[end])) {
+			return raw
+		}
+	}
+	return ""
+}
+
+// isRedactionWordByte reports whether c can continue a Kubernetes name.
+func isRedactionWordByte(c byte) bool {
+	return c == '-' || c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// shortenHomePath replaces the home directory with "~" so paths do not carry
+// the user name.
+func shortenHomePath(value, home string) string {
+	if home == "" || value == "" {
+		return value
+	}
+	return strings.ReplaceAll(value, home, "~")
+}
@@ -0,0 +1,165 @@
+package backend
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/refresh/informer"
+	"github.com/luxury-yacht/app/backend/refresh/permissions"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+)
+
+func TestWriteDiagnosticBundleAnonymizesSettings(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	require.NoError(t, app.SetClusterReadOnly("prod-config:prod-east", true))
+	_, err := app.SetClusterAllowedNamespaces("prod-config:prod-east", []string{"payments"})
+	require.NoError(t, err)
+	require.NoError(t, app.SetKubeconfigSearchPaths([]string{"/machine-a/kube"}))
+	app.logger.Info("connected to prod-east", "App")
+
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	result, err := app.writeDiagnosticBundle(path)
+	require.NoError(t, err)
+	require.Equal(t, path, result.Path)
+	require.Positive(t, result.Bytes)
+	require.Equal(t, []string{
+		"catalog.json", "informers.json", "kubernetes-api.json", "logs.txt",
+		"manifest.json", "settings.json", "telemetry.json",
+	}, result.Files)
+
+	files := readZipFiles(t, path)
+	require.Contains(t, files["logs.txt"], "connected to cluster-1")
+
+	var manifest diagnosticBundleManifest
+	require.NoError(t, json.Unmarshal([]byte(files["manifest.json"]), &manifest))
+	require.Equal(t, Version, manifest.AppVersion)
+	require.Equal(t, updateChannelStable, manifest.UpdateChannel)
+
+	settings := files["settings.json"]
+	require.NotContains(t, settings, "prod-east")
+	require.NotContains(t, settings, "payments")
+	var decoded settingsFile
+	require.NoError(t, json.Unmarshal([]byte(settings), &decoded))
+	section, ok := decoded.Clusters["cluster-1"]
+	require.True(t, ok, "expected the cluster section under a placeholder")
+	require.True(t, section.ReadOnly)
+	require.Equal(t, []string{"namespace-1"}, section.AllowedNamespaces)
+	require.Equal(t, []string{"/machine-a/kube"}, decoded.Kubeconfig.SearchPaths)
+}
+
+func TestWriteDiagnosticBundleRedactsEveryFile(t *testing.T) {
+	const clusterID = "acme-config:acme-prod"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	_, err := app.SetClusterAllowedNamespaces(clusterID, []string{"payments"})
+	require.NoError(t, err)
+
+	recorder := telemetry.NewRecorder()
+	recorder.SetClusterMeta(clusterID, "acme-prod")
+	recorder.RecordSnapshot("object-details", clusterID+"|ledger:apps/v1:Deployment:checkout-api", clusterID, "acme-prod",
+		time.Millisecond, errors.New(`deployments.apps "checkout-api" not found`), false, 0, nil, 0, 1, 0, true, 0, 0)
+	recorder.RecordSnapshot("pods", clusterID+"|namespace:payments", clusterID, "acme-prod",
+		time.Millisecond, nil, false, 3, nil, 0, 1, 3, true, 0, 0)
+	checker := permissions.NewCheckerWithReview(clusterID, time.Minute, func(context.Context, string, string, string, string) (bool, error) {
+		return true, nil
+	})
+	app.setRefreshSubsystem(clusterID, &system.Subsystem{
+		ClusterMeta:     snapshot.ClusterMeta{ClusterID: clusterID, ClusterName: "acme-prod"},
+		Telemetry:       recorder,
+		InformerFactory: informer.New(cgofake.NewClientset(), nil, time.Minute, checker),
+	})
+	app.ensureKubernetesAPIMetricsRegistry().getOrCreate(ClusterMeta{ID: clusterID, Name: "acme-prod"}, 50, 100)
+	app.logger.Info("Deleted pod ledger/checkout-api", "App", clusterID, "acme-prod")
+	app.logger.Warn("acme-prod: namespace payments is protected", "App")
+
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	_, err = app.writeDiagnosticBundle(path)
+	require.NoError(t, err)
+	files := readZipFiles(t, path)
+	for _, name := range []string{"logs.txt", "settings.json", "telemetry.json", "informers.json", "kubernetes-api.json", "catalog.json", "manifest.json"} {
+		for _, raw := range []string{"acme-config", "acme-prod", "payments", "ledger", "checkout-api"} {
+			require.NotContains(t, files[name], raw, "%s leaks %q", name, raw)
+		}
+	}
+
+	// One placeholder per value across the bundle.
+	require.Contains(t, files["logs.txt"], "(cluster-1) Deleted pod namespace-2/object-1")
+	require.Contains(t, files["logs.txt"], "cluster-1: namespace namespace-1 is protected")
+	require.Contains(t, files["settings.json"], `"cluster-1"`)
+	require.Contains(t, files["informers.json"], `"cluster-1"`)
+	require.Contains(t, files["kubernetes-api.json"], `"clusterId": "cluster-1"`)
+	var summaries map[string]telemetry.Summary
+	require.NoError(t, json.Unmarshal([]byte(files["telemetry.json"]), &summaries))
+	scopes := []string{}
+	for _, snap := range summaries["cluster-1"].Snapshots {
+		scopes = append(scopes, snap.Scope)
+	}
+	require.ElementsMatch(t, []string{"cluster-1|namespace-2:apps/v1:Deployment:object-1", "cluster-1|namespace:namespace-1"}, scopes)
+}
+
+func TestWriteDiagnosticBundleRedactsAPIServers(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	kubeconfigPath := filepath.Join(t.TempDir(), "acme-config")
+	require.NoError(t, os.WriteFile(kubeconfigPath, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: acme
+  cluster:
+    server: https://api.acme.example.com:6443
+contexts:
+- name: acme-prod
+  context:
+    cluster: acme
+    user: admin
+users:
+- name: admin
+  user:
+    token: secret
+`), 0o600))
+	app.availableKubeconfigs = []KubeconfigInfo{{Name: "acme-config", Path: kubeconfigPath, Context: "acme-prod"}}
+	app.logger.Warn(`Get "https://api.acme.example.com:6443/api/v1/pods": dial tcp: lookup api.acme.example.com: no such host`, "App")
+
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	_, err := app.writeDiagnosticBundle(path)
+	require.NoError(t, err)
+	logs := readZipFiles(t, path)["logs.txt"]
+	require.NotContains(t, logs, "acme.example.com")
+	require.Contains(t, logs, `Get "server-1/api/v1/pods": dial tcp: lookup server-1: no such host`)
+}
+
+func TestShortenHomePath(t *testing.T) {
+	require.Equal(t, "~/.kube/config", shortenHomePath("/home/alex/.kube/config", "/home/alex"))
+	require.Equal(t, "/etc/kube", shortenHomePath("/etc/kube", "/home/alex"))
+	require.Equal(t, "/etc/kube", shortenHomePath("/etc/kube", ""))
+}
+
+func readZipFiles(t *testing.T, path string) map[string]string {
+	t.Helper()
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, rc.Close())
+		require.NoError(t, err)
+		files[file.Name] = string(data)
+	}
+	return files
+}
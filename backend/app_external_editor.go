@@ -0,0 +1,307 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/uuid"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// External editor. The object's YAML goes to a temp file that the user's
+// editor opens; every save is applied through ApplyObjectYaml, with the same
+// validation, conflict detection and read-only checks as the in-app editor.
+// Each outcome is reported as an "external-edit:status" event. A session
+// ends when the frontend stops it, after an hour without saves, or when the
+// app quits; its temp file is removed then.
+
+// Outcomes reported in ExternalEditEvent.State.
+const (
+	ExternalEditStateApplied = "applied"
+	ExternalEditStateFailed  = "failed"
+	ExternalEditStateClosed  = "closed"
+)
+
+// terminalEditors need a terminal and cannot be started from the app, so a
+// $VISUAL or $EDITOR naming one is skipped.
+var terminalEditors = map[string]struct{}{
+	"vi": {}, "vim": {}, "nvim": {}, "nano": {}, "pico": {}, "micro": {},
+	"hx": {}, "helix": {}, "kak": {}, "ed": {}, "joe": {}, "mg": {},
+}
+
+// ExternalEditSession describes a running external edit.
+type ExternalEditSession struct {
+	ID     string                `json:"id"`
+	Target ObjectActionTargetRef `json:"target"`
+	Path   string                `json:"path"`
+	Editor string                `json:"editor"`
+}
+
+// ExternalEditEvent reports what happened to one save.
+type ExternalEditEvent struct {
+	SessionID       string   `json:"sessionId"`
+	State           string   `json:"state"`
+	ResourceVersion string   `json:"resourceVersion,omitempty"`
+	Code            string   `json:"code,omitempty"`
+	Message         string   `json:"message,omitempty"`
+	Causes          []string `json:"causes,omitempty"`
+}
+
+type externalEditSession struct {
+	ExternalEditSession
+	dir     string
+	watcher *fsnotify.Watcher
+	stop    chan struct{}
+	done    chan struct{}
+
+	// Touched only by the session loop.
+	baseYAML        string
+	resourceVersion string
+	uid             string
+}
+
+// OpenInExternalEditor writes target's YAML to a temp file and opens it in
+// the configured editor. Saves are applied until StopExternalEdit.
+func (a *App) OpenInExternalEditor(target ObjectActionTargetRef) (ExternalEditSession, error) {
+	target, err := validateObjectActionTarget(target)
+	if err != nil {
+		return ExternalEditSession{}, err
+	}
+	if err := a.requireClusterWritable(target.ClusterID, "external edit"); err != nil {
+		return ExternalEditSession{}, err
+	}
+	apiVersion := objectActionTargetGVK(target).GroupVersion().String()
+	content, err := a.GetObjectYAMLByGVK(target.ClusterID, apiVersion, target.Kind, target.Namespace, target.Name)
+	if err != nil {
+		return ExternalEditSession{}, err
+	}
+	obj, err := parseYAMLToUnstructured(content)
+	if err != nil {
+		return ExternalEditSession{}, err
+	}
+	command, err := resolveExternalEditor(a.externalEditorPreference(), os.Getenv, exec.LookPath, goruntime.GOOS)
+	if err != nil {
+		return ExternalEditSession{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "luxury-yacht-edit-")
+	if err != nil {
+		return ExternalEditSession{}, err
+	}
+	path := filepath.Join(dir, strings.ToLower(target.Kind)+"-"+target.Name+".yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		_ = os.RemoveAll(dir)
+		return ExternalEditSession{}, err
+	}
+	// Editors often save by writing a new file and renaming it over the old
+	// one, so the directory is watched rather than the file.
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		err = watcher.Add(dir)
+	}
+	if err != nil {
+		if watcher != nil {
+			_ = watcher.Close()
+		}
+		_ = os.RemoveAll(dir)
+		return ExternalEditSession{}, fmt.Errorf("watch %s: %w", path, err)
+	}
+
+	session := &externalEditSession{
+		ExternalEditSession: ExternalEditSession{
+			ID:     uuid.NewString(),
+			Target: target,
+			Path:   path,
+			Editor: strings.Join(command, " "),
+		},
+		dir:             dir,
+		watcher:         watcher,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+		baseYAML:        content,
+		resourceVersion: obj.GetResourceVersion(),
+		uid:             string(obj.GetUID()),
+	}
+	cmd := exec.Command(command[0], append(command[1:], path)...)
+	if err := cmd.Start(); err != nil {
+		_ = watcher.Close()
+		_ = os.RemoveAll(dir)
+		return ExternalEditSession{}, fmt.Errorf("start editor %s: %w", command[0], err)
+	}
+	go func() { _ = cmd.Wait() }()
+
+	a.externalEditsMu.Lock()
+	if a.externalEdits == nil {
+		a.externalEdits = make(map[string]*externalEditSession)
+	}
+	a.externalEdits[session.ID] = session
+	a.externalEditsMu.Unlock()
+	go a.runExternalEdit(session)
+
+	a.logger.Info(fmt.Sprintf("Opened %s %s in %s", target.Kind, target.Name, command[0]), logsources.App, target.ClusterID, a.clusterNameForID(target.ClusterID))
+	return session.ExternalEditSession, nil
+}
+
+// StopExternalEdit ends a session; later saves are no longer applied.
+func (a *App) StopExternalEdit(sessionID string) error {
+	a.externalEditsMu.Lock()
+	session := a.externalEdits[sessionID]
+	delete(a.externalEdits, sessionID)
+	a.externalEditsMu.Unlock()
+	if session == nil {
+		return fmt.Errorf("external edit session %s not found", sessionID)
+	}
+	close(session.stop)
+	<-session.done
+	return nil
+}
+
+// stopExternalEdits ends every session at shutdown.
+func (a *App) stopExternalEdits() {
+	a.externalEditsMu.Lock()
+	ids := make([]string, 0, len(a.externalEdits))
+	for id := range a.externalEdits {
+		ids = append(ids, id)
+	}
+	a.externalEditsMu.Unlock()
+	for _, id := range ids {
+		_ = a.StopExternalEdit(id)
+	}
+}
+
+func (a *App) externalEditorPreference() string {
+	settings, err := a.GetAppSettings()
+	if err != nil || settings == nil {
+		return ""
+	}
+	return settings.ExternalEditor
+}
+
+// runExternalEdit applies saves until the session stops or goes idle.
+func (a *App) runExternalEdit(session *externalEditSession) {
+	defer close(session.done)
+	defer func() {
+		_ = session.watcher.Close()
+		_ = os.RemoveAll(session.dir)
+		a.emitEvent("external-edit:status", ExternalEditEvent{SessionID: session.ID, State: ExternalEditStateClosed})
+	}()
+
+	name := filepath.Base(session.Path)
+	idle := time.NewTimer(config.ExternalEditIdleTimeout)
+	defer idle.Stop()
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-session.stop:
+			return
+		case <-idle.C:
+			a.externalEditsMu.Lock()
+			delete(a.externalEdits, session.ID)
+			a.externalEditsMu.Unlock()
+			return
+		case event, ok := <-session.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(event.Name) == name && event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename) != 0 {
+				debounce = time.After(config.ExternalEditSaveDebounce)
+			}
+		case err, ok := <-session.watcher.Errors:
+			if !ok {
+				return
+			}
+			a.logger.Warn(fmt.Sprintf("External edit watcher error: %v", err), logsources.App)
+		case <-debounce:
+			debounce = nil
+			idle.Reset(config.ExternalEditIdleTimeout)
+			if event, changed := a.applyExternalEdit(session); changed {
+				a.emitEvent("external-edit:status", event)
+			}
+		}
+	}
+}
+
+// applyExternalEdit applies the saved file when it differs from the last
+// applied YAML. A successful apply becomes the baseline for the next save.
+func (a *App) applyExternalEdit(session *externalEditSession) (ExternalEditEvent, bool) {
+	event := ExternalEditEvent{SessionID: session.ID}
+	data, err := os.ReadFile(session.Path)
+	if err != nil {
+		// A rename-style save can briefly leave no file; the next event
+		// brings it back.
+		return event, false
+	}
+	content := string(data)
+	if strings.TrimSpace(content) == strings.TrimSpace(session.baseYAML) {
+		return event, false
+	}
+	target := session.Target
+	response, err := a.ApplyObjectYaml(target.ClusterID, ObjectYAMLMutationRequest{
+		BaseYAML:        session.baseYAML,
+		YAML:            content,
+		Kind:            target.Kind,
+		APIVersion:      objectActionTargetGVK(target).GroupVersion().String(),
+		Namespace:       target.Namespace,
+		Name:            target.Name,
+		UID:             session.uid,
+		ResourceVersion: session.resourceVersion,
+	})
+	if err != nil {
+		event.State = ExternalEditStateFailed
+		event.Message = err.Error()
+		var yamlErr *objectYAMLError
+		if errors.As(err, &yamlErr) {
+			event.Code = yamlErr.Code
+			event.Message = yamlErr.Message
+			event.Causes = yamlErr.Causes
+		}
+		return event, true
+	}
+	session.baseYAML = content
+	session.resourceVersion = response.ResourceVersion
+	event.State = ExternalEditStateApplied
+	event.ResourceVersion = response.ResourceVersion
+	a.logger.Info(fmt.Sprintf("Applied external edit of %s %s", target.Kind, target.Name), logsources.App, target.ClusterID, a.clusterNameForID(target.ClusterID))
+	return event, true
+}
+
+// resolveExternalEditor picks the editor command: the preference, then
+// $VISUAL and $EDITOR unless they name a terminal editor, then VS Code, then
+// the platform's default text editor.
+func resolveExternalEditor(preference string, getenv func(string) string, lookPath func(string) (string, error), goos string) ([]string, error) {
+	if fields := strings.Fields(preference); len(fields) > 0 {
+		return fields, nil
+	}
+	for _, variable := range []string{"VISUAL", "EDITOR"} {
+		fields := strings.Fields(getenv(variable))
+		if len(fields) == 0 {
+			continue
+		}
+		if _, terminal := terminalEditors[strings.TrimSuffix(filepath.Base(fields[0]), ".exe")]; !terminal {
+			return fields, nil
+		}
+	}
+	if _, err := lookPath("code"); err == nil {
+		return []string{"code"}, nil
+	}
+	switch goos {
+	case "darwin":
+		return []string{"open", "-t"}, nil
+	case "windows":
+		return []string{"notepad"}, nil
+	default:
+		if _, err := lookPath("xdg-open"); err == nil {
+			return []string{"xdg-open"}, nil
+		}
+	}
+	return nil, errors.New("no external editor found; set one in Settings")
+}
@@ -0,0 +1,109 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestResolveExternalEditor(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+	found := map[string]bool{}
+	lookPath := func(name string) (string, error) {
+		if found[name] {
+			return "/usr/bin/" + name, nil
+		}
+		return "", errors.New("not found")
+	}
+	resolve := func(preference, goos string) []string {
+		command, err := resolveExternalEditor(preference, getenv, lookPath, goos)
+		if err != nil {
+			return nil
+		}
+		return command
+	}
+
+	require.Equal(t, []string{"subl", "-w"}, resolve(" subl -w ", "linux"))
+	require.Equal(t, []string{"open", "-t"}, resolve("", "darwin"))
+	require.Equal(t, []string{"notepad"}, resolve("", "windows"))
+	require.Nil(t, resolve("", "linux"))
+
+	env["EDITOR"] = "/usr/bin/vim"
+	found["code"] = true
+	require.Equal(t, []string{"code"}, resolve("", "linux"), "terminal editors are skipped")
+	env["VISUAL"] = "gedit --new-window"
+	require.Equal(t, []string{"gedit", "--new-window"}, resolve("", "linux"))
+}
+
+func TestExternalEditAppliesEachSave(t *testing.T) {
+	const clusterID = "external-edit"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	var mu sync.Mutex
+	var events []ExternalEditEvent
+	app.eventEmitter = func(_ context.Context, name string, args ...interface{}) {
+		if name != "external-edit:status" {
+			return
+		}
+		mu.Lock()
+		events = append(events, args[0].(ExternalEditEvent))
+		mu.Unlock()
+	}
+	lastEvent := func() ExternalEditEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(events) == 0 {
+			return ExternalEditEvent{}
+		}
+		return events[len(events)-1]
+	}
+	editor, err := os.Executable()
+	require.NoError(t, err)
+	// The test binary with an unknown -test.run pattern exits at once,
+	// standing in for an editor that opens the file and returns.
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceExternalEditor, Value: editor + " -test.run=^$"},
+	}})
+	require.NoError(t, err)
+
+	target := objectActionTarget(clusterID, "rds.services.k8s.aws", "v1alpha1", "DBInstance", "default", "my-db")
+	session, err := app.OpenInExternalEditor(target)
+	require.NoError(t, err)
+	require.FileExists(t, session.Path)
+	original, err := os.ReadFile(session.Path)
+	require.NoError(t, err)
+	require.Contains(t, string(original), "source: ack-rds")
+
+	edited := strings.Replace(string(original), "source: ack-rds", "source: edited", 1)
+	require.NoError(t, os.WriteFile(session.Path, []byte(edited), 0o600))
+	require.Eventually(t, func() bool { return lastEvent().State == ExternalEditStateApplied }, 5*time.Second, 20*time.Millisecond)
+
+	dynamicClient := app.clusterClients[clusterID].dynamicClient.(*dynamicfake.FakeDynamicClient)
+	gvr := schema.GroupVersionResource{Group: "rds.services.k8s.aws", Version: "v1alpha1", Resource: "dbinstances"}
+	obj, err := dynamicClient.Resource(gvr).Namespace("default").Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "edited", obj.Object["spec"].(map[string]any)["source"])
+
+	broken := strings.Replace(edited, "name: my-db", "name: other-db", 1)
+	require.NoError(t, os.WriteFile(session.Path, []byte(broken), 0o600))
+	require.Eventually(t, func() bool { return lastEvent().State == ExternalEditStateFailed }, 5*time.Second, 20*time.Millisecond)
+
+	require.NoError(t, app.StopExternalEdit(session.ID))
+	require.Equal(t, ExternalEditStateClosed, lastEvent().State)
+	require.NoDirExists(t, filepath.Dir(session.Path))
+	require.Error(t, app.StopExternalEdit(session.ID))
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.OpenInExternalEditor(target)
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
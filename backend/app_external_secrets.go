@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/luxury-yacht/app/backend/externalsecrets"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// RefreshExternalSecret asks the External Secrets Operator to re-sync an
+// ExternalSecret now instead of waiting for its refreshInterval. It sets the
+// force-sync annotation and leaves the spec alone.
+func (a *App) RefreshExternalSecret(clusterID, namespace, name string) error {
+	if namespace == "" || name == "" {
+		return fmt.Errorf("namespace and name are required")
+	}
+	if err := a.requireClusterWritable(clusterID, "External Secrets refresh"); err != nil {
+		return err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return err
+	}
+	if deps.DynamicClient == nil {
+		return fmt.Errorf("dynamic client not initialized")
+	}
+	// RBAC does not distinguish API versions, so one check covers them all.
+	checkGVR := externalsecrets.ExternalSecretGVR(externalsecrets.Versions[0])
+	if err := a.requireResolvedResourcePermission(deps.Context, deps, checkGVR, true, resourcePermissionCheck{
+		Group:     checkGVR.Group,
+		Version:   checkGVR.Version,
+		Kind:      externalsecrets.KindExternalSecret,
+		Namespace: namespace,
+		Verb:      "patch",
+	}); err != nil {
+		return err
+	}
+
+	patch := externalsecrets.ForceSyncPatch(time.Now())
+	// Older operators only serve v1beta1; a NotFound at one version moves on
+	// to the next.
+	for _, version := range externalsecrets.Versions {
+		gvr := externalsecrets.ExternalSecretGVR(version)
+		_, err = deps.DynamicClient.Resource(gvr).Namespace(namespace).Patch(deps.Context, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return wrapKubernetesError(err, "failed to patch ExternalSecret")
+		}
+		a.invalidateResponseCacheForGVK(selectionKey, gvr.GroupVersion().WithKind(externalsecrets.KindExternalSecret), namespace, name)
+		a.logger.Info(fmt.Sprintf("Requested refresh of ExternalSecret %s/%s", namespace, name), logsources.App, clusterID, a.clusterNameForID(clusterID))
+		return nil
+	}
+	return wrapKubernetesError(err, "failed to patch ExternalSecret")
+}
@@ -0,0 +1,57 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/externalsecrets"
+)
+
+func TestRefreshExternalSecretSetsForceSyncAnnotation(t *testing.T) {
+	const clusterID = "eso"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+
+	kubeClient := kubernetesfake.NewClientset()
+	allowSelfSubjectAccessReviews(kubeClient)
+	// Only v1beta1 is served, as on older operators.
+	legacyGVR := externalsecrets.ExternalSecretGVR("v1beta1")
+	externalSecret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "external-secrets.io/v1beta1",
+		"kind":       externalsecrets.KindExternalSecret,
+		"metadata":   map[string]any{"name": "db-creds", "namespace": "apps"},
+		"spec":       map[string]any{"refreshInterval": "1h"},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		externalsecrets.ExternalSecretGVR("v1"): "ExternalSecretList",
+		legacyGVR:                               "ExternalSecretList",
+	}, externalSecret)
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+		dynamicClient:     dynamicClient,
+	})
+
+	require.NoError(t, app.RefreshExternalSecret(clusterID, "apps", "db-creds"))
+	obj, err := dynamicClient.Resource(legacyGVR).Namespace("apps").Get(context.Background(), "db-creds", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, obj.GetAnnotations()[externalsecrets.ForceSyncAnnotation])
+	require.Equal(t, "1h", obj.Object["spec"].(map[string]any)["refreshInterval"], "the patch leaves the spec alone")
+
+	require.Error(t, app.RefreshExternalSecret(clusterID, "apps", "missing"))
+	require.ErrorContains(t, app.RefreshExternalSecret(clusterID, "", "db-creds"), "namespace and name are required")
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	require.ErrorIs(t, app.RefreshExternalSecret(clusterID, "apps", "db-creds"), errClusterReadOnly)
+}
@@ -377,6 +377,7 @@ func (a *App) saveFavoritesFile(state *favoritesFile) error {
 	if err := writeFileAtomic(path, data, 0o644); err != nil {
 		return fmt.Errorf("failed to write favorites file: %w", err)
 	}
+	a.scheduleSettingsSync()
 	return nil
 }
 
@@ -0,0 +1,203 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/luxury-yacht/app/backend/finishedcleanup"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	"github.com/luxury-yacht/app/backend/resources/job"
+	"github.com/luxury-yacht/app/backend/resources/pods"
+)
+
+// Finished object cleanup. A preview lists the Succeeded/Failed pods and
+// finished Jobs a filter selects; a run deletes them in the background,
+// reporting progress on finishedCleanupProgressEventName and in the runtime
+// operations list. Objects in protected namespaces are never deleted in bulk:
+// they are listed as protected and left for a typed-confirmation delete.
+
+const finishedCleanupProgressEventName = "finished-cleanup:progress"
+
+// RuntimeOperationCleanup is a running finished object cleanup.
+const RuntimeOperationCleanup RuntimeOperationType = "cleanup"
+
+// FinishedCleanupPreview is what a cleanup with the filter would delete.
+type FinishedCleanupPreview struct {
+	ClusterID  string                      `json:"clusterId"`
+	Candidates []finishedcleanup.Candidate `json:"candidates"`
+	// Protected are matches in protected namespaces, which a run skips.
+	Protected []finishedcleanup.Candidate `json:"protected,omitempty"`
+}
+
+// FinishedCleanupProgress is one progress event of a cleanup run.
+type FinishedCleanupProgress struct {
+	JobID     string `json:"jobId"`
+	ClusterID string `json:"clusterId"`
+	finishedcleanup.Progress
+}
+
+// PreviewFinishedCleanup lists the finished pods and Jobs the filter selects,
+// oldest first, without deleting anything.
+func (a *App) PreviewFinishedCleanup(clusterID string, filter finishedcleanup.Filter) (*FinishedCleanupPreview, error) {
+	deps, err := a.finishedCleanupDependencies(clusterID, filter, "list")
+	if err != nil {
+		return nil, err
+	}
+	return a.finishedCleanupPreview(deps, filter)
+}
+
+// StartFinishedCleanup deletes the finished pods and Jobs the filter selects
+// in the background and returns the run's job ID. Progress is emitted as
+// finished-cleanup:progress events until one reports done.
+func (a *App) StartFinishedCleanup(clusterID string, filter finishedcleanup.Filter) (string, error) {
+	if err := a.requireClusterWritable(strings.TrimSpace(clusterID), "Finished object cleanup"); err != nil {
+		return "", err
+	}
+	deps, err := a.finishedCleanupDependencies(clusterID, filter, "delete")
+	if err != nil {
+		return "", err
+	}
+	preview, err := a.finishedCleanupPreview(deps, filter)
+	if err != nil {
+		return "", err
+	}
+
+	jobID := uuid.NewString()
+	ctx, cancel := context.WithCancel(deps.Context)
+	a.finishedCleanupsMu.Lock()
+	if a.finishedCleanups == nil {
+		a.finishedCleanups = make(map[string]context.CancelFunc)
+	}
+	a.finishedCleanups[jobID] = cancel
+	a.finishedCleanupsMu.Unlock()
+
+	operation := RuntimeOperation{
+		ID:          jobID,
+		Type:        RuntimeOperationCleanup,
+		ClusterID:   deps.ClusterID,
+		ClusterName: deps.ClusterName,
+		Status:      "running",
+		StartedAt:   time.Now().Format(time.RFC3339),
+		DisplayName: fmt.Sprintf("Clean up %d finished objects", len(preview.Candidates)),
+		Summary:     map[string]string{"total": strconv.Itoa(len(preview.Candidates))},
+	}
+	a.registerRuntimeOperation(operation, func(string) error {
+		cancel()
+		return nil
+	})
+
+	go func() {
+		defer a.finishFinishedCleanup(jobID)
+		captureDeps := deps
+		captureDeps.Context = ctx
+		capture := func(candidate finishedcleanup.Candidate) {
+			a.captureDeletedObject(captureDeps, finishedCleanupTarget(deps.ClusterID, candidate))
+		}
+		result := finishedcleanup.Run(ctx, deps.KubernetesClient, preview.Candidates, capture, func(progress finishedcleanup.Progress) {
+			a.emitEvent(finishedCleanupProgressEventName, FinishedCleanupProgress{JobID: jobID, ClusterID: deps.ClusterID, Progress: progress})
+		})
+		a.logger.Info(fmt.Sprintf("Finished object cleanup deleted %d of %d objects (%d failed)", result.Deleted, result.Total, len(result.Failed)), logsources.App, deps.ClusterID, deps.ClusterName)
+	}()
+	return jobID, nil
+}
+
+// finishedCleanupTarget identifies a cleanup candidate for the recycle bin.
+func finishedCleanupTarget(clusterID string, candidate finishedcleanup.Candidate) ObjectActionTargetRef {
+	identity := pods.Identity
+	if candidate.Kind == job.Identity.Kind {
+		identity = job.Identity
+	}
+	return objectActionTarget(clusterID, identity.Group, identity.Version, identity.Kind, candidate.Namespace, candidate.Name)
+}
+
+// CancelFinishedCleanup stops a cleanup run before its next delete.
+func (a *App) CancelFinishedCleanup(jobID string) error {
+	a.finishedCleanupsMu.Lock()
+	cancel := a.finishedCleanups[jobID]
+	a.finishedCleanupsMu.Unlock()
+	if cancel == nil {
+		return fmt.Errorf("cleanup job %s not found", jobID)
+	}
+	cancel()
+	return nil
+}
+
+func (a *App) finishFinishedCleanup(jobID string) {
+	a.finishedCleanupsMu.Lock()
+	cancel := a.finishedCleanups[jobID]
+	delete(a.finishedCleanups, jobID)
+	a.finishedCleanupsMu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	a.unregisterRuntimeOperation(jobID)
+}
+
+// finishedCleanupDependencies validates the filter and checks verb on the
+// selected kinds in each selected namespace, or cluster-wide.
+func (a *App) finishedCleanupDependencies(clusterID string, filter finishedcleanup.Filter, verb string) (common.Dependencies, error) {
+	if strings.TrimSpace(clusterID) == "" {
+		return common.Dependencies{}, fmt.Errorf("cluster ID is required")
+	}
+	if err := filter.Validate(); err != nil {
+		return common.Dependencies{}, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return common.Dependencies{}, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Finished object cleanup"); err != nil {
+		return common.Dependencies{}, err
+	}
+	if deps.Context == nil {
+		deps.Context = context.Background()
+	}
+	namespaces := filter.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	for _, namespace := range namespaces {
+		if filter.Pods {
+			if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{Version: "v1", Kind: "Pod", Namespace: namespace, Verb: verb}); err != nil {
+				return common.Dependencies{}, err
+			}
+		}
+		if filter.Jobs {
+			if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{Group: "batch", Version: "v1", Kind: "Job", Namespace: namespace, Verb: verb}); err != nil {
+				return common.Dependencies{}, err
+			}
+		}
+	}
+	return deps, nil
+}
+
+// finishedCleanupPreview finds the candidates and sets aside those in
+// protected namespaces.
+func (a *App) finishedCleanupPreview(deps common.Dependencies, filter finishedcleanup.Filter) (*FinishedCleanupPreview, error) {
+	settings, err := a.GetAppSettings()
+	if err != nil {
+		return nil, fmt.Errorf("could not read protected namespaces: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(deps.Context, config.FinishedCleanupListTimeout)
+	defer cancel()
+	candidates, err := finishedcleanup.Find(ctx, deps.KubernetesClient, filter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	preview := &FinishedCleanupPreview{ClusterID: deps.ClusterID, Candidates: []finishedcleanup.Candidate{}}
+	for _, candidate := range candidates {
+		if _, protected := matchProtectedNamespace(settings.ProtectedNamespaces, candidate.Namespace); protected {
+			preview.Protected = append(preview.Protected, candidate)
+			continue
+		}
+		preview.Candidates = append(preview.Candidates, candidate)
+	}
+	return preview, nil
+}
@@ -0,0 +1,104 @@
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/finishedcleanup"
+)
+
+func TestPreviewFinishedCleanupSetsAsideProtectedNamespaces(t *testing.T) {
+	const clusterID = "cleanup"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{"kube-*"}},
+	}})
+	require.NoError(t, err)
+
+	kubeClient := kubernetesfake.NewClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "dev"}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "kube-system"}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "dev"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	)
+	allowSelfSubjectAccessReviews(kubeClient)
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+	})
+
+	preview, err := app.PreviewFinishedCleanup(clusterID, finishedcleanup.Filter{Pods: true})
+	require.NoError(t, err)
+	require.Len(t, preview.Candidates, 1)
+	require.Equal(t, "dev", preview.Candidates[0].Namespace)
+	require.Len(t, preview.Protected, 1)
+	require.Equal(t, "kube-system", preview.Protected[0].Namespace)
+
+	_, err = app.PreviewFinishedCleanup(clusterID, finishedcleanup.Filter{})
+	require.Error(t, err)
+	_, err = app.PreviewFinishedCleanup("", finishedcleanup.Filter{Pods: true})
+	require.Error(t, err)
+	require.Error(t, app.CancelFinishedCleanup("missing"))
+}
+
+func TestStartFinishedCleanupCapturesDeletedObjects(t *testing.T) {
+	const clusterID = "cleanup"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	done := make(chan finishedcleanup.Progress, 1)
+	app.eventEmitter = func(_ context.Context, name string, args ...interface{}) {
+		if name != finishedCleanupProgressEventName || len(args) == 0 {
+			return
+		}
+		if progress := args[0].(FinishedCleanupProgress); progress.Done {
+			done <- progress.Progress
+		}
+	}
+
+	kubeClient := kubernetesfake.NewClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "dev"}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+	)
+	allowSelfSubjectAccessReviews(kubeClient)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]any{"name": "done", "namespace": "dev", "resourceVersion": "5"},
+		"spec":       map[string]any{"restartPolicy": "Never"},
+	}})
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+		dynamicClient:     dynamicClient,
+	})
+
+	_, err := app.StartFinishedCleanup(clusterID, finishedcleanup.Filter{Pods: true})
+	require.NoError(t, err)
+	select {
+	case progress := <-done:
+		require.Equal(t, 1, progress.Deleted)
+	case <-time.After(5 * time.Second):
+		t.Fatal("cleanup did not finish")
+	}
+
+	entries, err := app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "Pod", entries[0].Kind)
+	require.Equal(t, "done", entries[0].Name)
+	require.Contains(t, entries[0].Manifest, "restartPolicy: Never")
+}
@@ -0,0 +1,65 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/luxury-yacht/app/backend/flux"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// SuspendFluxObject stops Flux reconciling a HelmRelease or Kustomization
+// until it is resumed.
+func (a *App) SuspendFluxObject(clusterID, kind, namespace, name string) error {
+	return a.patchFluxObject(clusterID, kind, namespace, name, "Flux suspend", "Suspended", flux.SuspendPatch(true, time.Now()))
+}
+
+// ResumeFluxObject resumes a suspended HelmRelease or Kustomization and
+// requests an immediate reconcile.
+func (a *App) ResumeFluxObject(clusterID, kind, namespace, name string) error {
+	return a.patchFluxObject(clusterID, kind, namespace, name, "Flux resume", "Resumed", flux.SuspendPatch(false, time.Now()))
+}
+
+// ReconcileFluxObject asks Flux to reconcile a HelmRelease or Kustomization
+// now instead of waiting for its interval, the same as `flux reconcile`.
+func (a *App) ReconcileFluxObject(clusterID, kind, namespace, name string) error {
+	return a.patchFluxObject(clusterID, kind, namespace, name, "Flux reconcile", "Requested reconcile of", flux.ReconcilePatch(time.Now()))
+}
+
+func (a *App) patchFluxObject(clusterID, kind, namespace, name, operation, verb string, patch []byte) error {
+	gvr, ok := flux.GVRForKind(kind)
+	if !ok {
+		return fmt.Errorf("unsupported Flux kind %q", kind)
+	}
+	if namespace == "" || name == "" {
+		return fmt.Errorf("namespace and name are required")
+	}
+	if err := a.requireClusterWritable(clusterID, operation); err != nil {
+		return err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return err
+	}
+	if deps.DynamicClient == nil {
+		return fmt.Errorf("dynamic client not initialized")
+	}
+	if err := a.requireResolvedResourcePermission(deps.Context, deps, gvr, true, resourcePermissionCheck{
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Kind:      kind,
+		Namespace: namespace,
+		Verb:      "patch",
+	}); err != nil {
+		return err
+	}
+	if _, err := deps.DynamicClient.Resource(gvr).Namespace(namespace).Patch(deps.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return wrapKubernetesError(err, fmt.Sprintf("failed to patch %s", kind))
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, gvr.GroupVersion().WithKind(kind), namespace, name)
+	a.logger.Info(fmt.Sprintf("%s Flux %s %s/%s", verb, kind, namespace, name), logsources.App, clusterID, a.clusterNameForID(clusterID))
+	return nil
+}
@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/flux"
+)
+
+func TestFluxActionsPatchSuspendAndReconcile(t *testing.T) {
+	const clusterID = "flux"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+
+	kubeClient := kubernetesfake.NewClientset()
+	allowSelfSubjectAccessReviews(kubeClient)
+	kustomization := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       flux.KindKustomization,
+		"metadata":   map[string]any{"name": "infra", "namespace": "flux-system"},
+		"spec":       map[string]any{"path": "./infra"},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		flux.HelmReleaseGVR:   "HelmReleaseList",
+		flux.KustomizationGVR: "KustomizationList",
+	}, kustomization)
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+		dynamicClient:     dynamicClient,
+	})
+	get := func() *unstructured.Unstructured {
+		obj, err := dynamicClient.Resource(flux.KustomizationGVR).Namespace("flux-system").Get(context.Background(), "infra", metav1.GetOptions{})
+		require.NoError(t, err)
+		return obj
+	}
+
+	require.NoError(t, app.SuspendFluxObject(clusterID, flux.KindKustomization, "flux-system", "infra"))
+	suspended, _, _ := unstructured.NestedBool(get().Object, "spec", "suspend")
+	require.True(t, suspended)
+
+	require.NoError(t, app.ResumeFluxObject(clusterID, flux.KindKustomization, "flux-system", "infra"))
+	obj := get()
+	suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+	require.False(t, suspended)
+	resumedAt := obj.GetAnnotations()[flux.ReconcileRequestedAtAnnotation]
+	require.NotEmpty(t, resumedAt)
+
+	require.NoError(t, app.ReconcileFluxObject(clusterID, flux.KindKustomization, "flux-system", "infra"))
+	obj = get()
+	require.NotEqual(t, resumedAt, obj.GetAnnotations()[flux.ReconcileRequestedAtAnnotation])
+	require.Equal(t, "./infra", obj.Object["spec"].(map[string]any)["path"], "the patch leaves the spec alone")
+
+	require.ErrorContains(t, app.ReconcileFluxObject(clusterID, "GitRepository", "flux-system", "infra"), "unsupported Flux kind")
+	require.Error(t, app.ReconcileFluxObject(clusterID, flux.KindHelmRelease, "flux-system", "missing"))
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	require.ErrorIs(t, app.SuspendFluxObject(clusterID, flux.KindKustomization, "flux-system", "infra"), errClusterReadOnly)
+}
@@ -0,0 +1,185 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/gitdrift"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+// Git drift detection. Each cluster can tie namespaces to manifest
+// directories of local git working copies (persisted in the cluster's
+// settings section). A loop started with the refresh subsystem checks every
+// open cluster's sources on an interval, keeps the latest report per
+// namespace, and tells the frontend with a git-drift:changed event carrying
+// the cluster ID. CheckGitDrift runs the same check on demand.
+
+const gitDriftChangedEventName = "git-drift:changed"
+
+// GetGitDriftSources returns the cluster's git sources, ordered by namespace.
+func (a *App) GetGitDriftSources(clusterID string) ([]gitdrift.Source, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	return append([]gitdrift.Source{}, settings.Clusters[clusterID].GitDrift...), nil
+}
+
+// SetGitDriftSources validates and persists the cluster's git sources,
+// replacing the previous set, and returns them normalized. Each namespace may
+// have one source. Reports of namespaces no longer configured are dropped.
+func (a *App) SetGitDriftSources(clusterID string, sources []gitdrift.Source) ([]gitdrift.Source, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	normalized := make([]gitdrift.Source, 0, len(sources))
+	seen := map[string]bool{}
+	for _, source := range sources {
+		if err := source.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[source.Namespace] {
+			return nil, fmt.Errorf("namespace %s has more than one git source", source.Namespace)
+		}
+		seen[source.Namespace] = true
+		normalized = append(normalized, source)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Namespace < normalized[j].Namespace })
+
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return nil, err
+	}
+	section := settings.Clusters[clusterID]
+	section.GitDrift = normalized
+	if len(normalized) == 0 {
+		section.GitDrift = nil
+	}
+	if clusterSettingsSectionEmpty(section) {
+		delete(settings.Clusters, clusterID)
+	} else {
+		if settings.Clusters == nil {
+			settings.Clusters = map[string]settingsClusterSection{}
+		}
+		settings.Clusters[clusterID] = section
+	}
+	if err := a.saveSettingsFile(settings); err != nil {
+		a.settingsMu.Unlock()
+		return nil, err
+	}
+	a.settingsMu.Unlock()
+
+	a.gitDriftMu.Lock()
+	for namespace := range a.gitDrift[clusterID] {
+		if !seen[namespace] {
+			delete(a.gitDrift[clusterID], namespace)
+		}
+	}
+	a.gitDriftMu.Unlock()
+	return normalized, nil
+}
+
+// GetGitDriftReports returns the latest report of each of the cluster's git
+// sources, ordered by namespace. Sources not checked yet have no report.
+func (a *App) GetGitDriftReports(clusterID string) []gitdrift.Report {
+	a.gitDriftMu.Lock()
+	defer a.gitDriftMu.Unlock()
+	reports := make([]gitdrift.Report, 0, len(a.gitDrift[clusterID]))
+	for _, report := range a.gitDrift[clusterID] {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Namespace < reports[j].Namespace })
+	return reports
+}
+
+// CheckGitDrift compares every git source of the cluster with the cluster now
+// and returns the new reports.
+func (a *App) CheckGitDrift(clusterID string) ([]gitdrift.Report, error) {
+	clusterID = strings.TrimSpace(clusterID)
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	if err := a.checkClusterGitDrift(a.CtxOrBackground(), clusterID); err != nil {
+		return nil, err
+	}
+	return a.GetGitDriftReports(clusterID), nil
+}
+
+// startGitDriftLoop checks every open cluster's git sources until ctx is done.
+func (a *App) startGitDriftLoop(ctx context.Context) {
+	ticker := time.NewTicker(config.GitDriftCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.clusterClientsMu.Lock()
+			clusterIDs := make([]string, 0, len(a.clusterClients))
+			for clusterID := range a.clusterClients {
+				clusterIDs = append(clusterIDs, clusterID)
+			}
+			a.clusterClientsMu.Unlock()
+			for _, clusterID := range clusterIDs {
+				if err := a.checkClusterGitDrift(ctx, clusterID); err != nil {
+					a.logger.Warn(fmt.Sprintf("Git drift check failed: %v", err), logsources.App, clusterID, a.clusterNameForID(clusterID))
+				}
+			}
+		}
+	}
+}
+
+// checkClusterGitDrift checks each configured source and stores its report.
+// A cluster without sources is a no-op.
+func (a *App) checkClusterGitDrift(parent context.Context, clusterID string) error {
+	sources, err := a.GetGitDriftSources(clusterID)
+	if err != nil || len(sources) == 0 {
+		return err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return err
+	}
+	if deps.DynamicClient == nil {
+		return fmt.Errorf("dynamic client not initialized")
+	}
+	ctx, cancel := context.WithTimeout(parent, config.GitDriftCheckTimeout)
+	defer cancel()
+	resolve := func(ctx context.Context, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+		return getGVRForGVKWithDependencies(ctx, deps, selectionKey, gvk)
+	}
+
+	reports := make(map[string]gitdrift.Report, len(sources))
+	for _, source := range sources {
+		reports[source.Namespace] = gitdrift.Check(ctx, deps.DynamicClient, resolve, source, time.Now())
+	}
+	a.gitDriftMu.Lock()
+	if a.gitDrift == nil {
+		a.gitDrift = make(map[string]map[string]gitdrift.Report)
+	}
+	a.gitDrift[clusterID] = reports
+	a.gitDriftMu.Unlock()
+	a.emitEvent(gitDriftChangedEventName, clusterID)
+	return nil
+}
+
+// forgetClusterGitDrift drops a removed cluster's reports.
+func (a *App) forgetClusterGitDrift(clusterID string) {
+	a.gitDriftMu.Lock()
+	delete(a.gitDrift, clusterID)
+	a.gitDriftMu.Unlock()
+}
@@ -0,0 +1,60 @@
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/gitdrift"
+)
+
+func TestGitDriftSourcesPersistAndPruneReports(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	repo := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(repo, ".git"), 0o755))
+
+	_, err := app.SetGitDriftSources("", nil)
+	require.Error(t, err)
+	_, err = app.SetGitDriftSources("c1", []gitdrift.Source{{Namespace: "app", Repo: t.TempDir()}})
+	require.ErrorContains(t, err, "not a git working copy")
+	_, err = app.SetGitDriftSources("c1", []gitdrift.Source{{Namespace: "app", Repo: repo}, {Namespace: "app", Repo: repo, Path: "other"}})
+	require.ErrorContains(t, err, "more than one git source")
+
+	saved, err := app.SetGitDriftSources("c1", []gitdrift.Source{
+		{Namespace: "web", Repo: repo, Path: "web/"},
+		{Namespace: "app", Repo: repo, Path: "./app", Pull: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []gitdrift.Source{
+		{Namespace: "app", Repo: repo, Path: "app", Pull: true},
+		{Namespace: "web", Repo: repo, Path: "web"},
+	}, saved)
+	loaded, err := app.GetGitDriftSources("c1")
+	require.NoError(t, err)
+	require.Equal(t, saved, loaded)
+
+	app.gitDrift = map[string]map[string]gitdrift.Report{"c1": {
+		"app": {Source: saved[0]},
+		"web": {Source: saved[1]},
+	}}
+	_, err = app.SetGitDriftSources("c1", saved[:1])
+	require.NoError(t, err)
+	reports := app.GetGitDriftReports("c1")
+	require.Len(t, reports, 1)
+	require.Equal(t, "app", reports[0].Namespace)
+
+	_, err = app.SetGitDriftSources("c1", nil)
+	require.NoError(t, err)
+	loaded, err = app.GetGitDriftSources("c1")
+	require.NoError(t, err)
+	require.Empty(t, loaded)
+
+	app.forgetClusterGitDrift("c1")
+	require.Empty(t, app.GetGitDriftReports("c1"))
+	checked, err := app.CheckGitDrift("c1")
+	require.NoError(t, err)
+	require.Empty(t, checked)
+}
@@ -0,0 +1,173 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+)
+
+// Cluster health summary. A light poller started with the refresh subsystem
+// folds each open cluster's heartbeat state and Attention findings into a
+// per-cluster summary. It backs the Clusters menu, which shows the summary at
+// a glance and brings the app up on a chosen cluster. Wails v2 has no tray
+// API, so the application menu is the menu-bar presence.
+
+// ClusterHealthSummary is one open cluster's health at a glance.
+type ClusterHealthSummary struct {
+	ClusterID        string             `json:"clusterId"`
+	ClusterName      string             `json:"clusterName"`
+	Context          string             `json:"context"`
+	Health           ClusterHealthState `json:"health"`
+	Reachable        bool               `json:"reachable"`
+	WarningEvents    int                `json:"warningEvents"`
+	FailingWorkloads int                `json:"failingWorkloads"`
+}
+
+// failingWorkloadKinds are the Attention finding kinds counted as failing
+// workloads.
+var failingWorkloadKinds = []string{"Pod", "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job", "CronJob"}
+
+// GetClusterHealthSummary returns the latest summary, ordered by cluster name.
+func (a *App) GetClusterHealthSummary() []ClusterHealthSummary {
+	summary := a.healthSummary.Load()
+	if summary == nil {
+		return []ClusterHealthSummary{}
+	}
+	return slices.Clone(*summary)
+}
+
+// startHealthSummaryLoop recomputes the summary until ctx is done.
+func (a *App) startHealthSummaryLoop(ctx context.Context) {
+	ticker := time.NewTicker(config.HealthSummaryInterval)
+	defer ticker.Stop()
+	a.refreshHealthSummary()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.refreshHealthSummary()
+		}
+	}
+}
+
+// refreshHealthSummary stores a new summary and, when it changed, tells the
+// frontend and rebuilds the menu.
+func (a *App) refreshHealthSummary() {
+	next := a.buildHealthSummary()
+	if previous := a.healthSummary.Load(); previous != nil && slices.Equal(*previous, next) {
+		return
+	}
+	a.healthSummary.Store(&next)
+	a.emitEvent("cluster-health:summary", next)
+	a.emitEvent("update-menu")
+}
+
+func (a *App) buildHealthSummary() []ClusterHealthSummary {
+	a.clusterClientsMu.Lock()
+	summaries := make([]ClusterHealthSummary, 0, len(a.clusterClients))
+	for clusterID, clients := range a.clusterClients {
+		if clients == nil {
+			continue
+		}
+		summaries = append(summaries, ClusterHealthSummary{
+			ClusterID:   clusterID,
+			ClusterName: clients.meta.Name,
+			Context:     clients.kubeconfigContext,
+		})
+	}
+	a.clusterClientsMu.Unlock()
+
+	health, _ := a.clusterWorkspaceRuntimeState()
+	a.refreshSubsystemsMu.RLock()
+	indexes := make(map[string]*snapshot.ClusterAttentionIndex, len(a.refreshSubsystems))
+	for clusterID, subsystem := range a.refreshSubsystems {
+		if subsystem != nil && subsystem.AttentionIndex != nil {
+			indexes[clusterID] = subsystem.AttentionIndex
+		}
+	}
+	a.refreshSubsystemsMu.RUnlock()
+
+	for i := range summaries {
+		summary := &summaries[i]
+		summary.Health = health[summary.ClusterID]
+		if summary.Health == "" {
+			summary.Health = ClusterHealthUnknown
+		}
+		summary.Reachable = summary.Health == ClusterHealthHealthy || summary.Health == ClusterHealthDegraded
+		if index := indexes[summary.ClusterID]; index != nil {
+			summary.WarningEvents, summary.FailingWorkloads = countHealthFindings(index.Snapshot())
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].ClusterName != summaries[j].ClusterName {
+			return summaries[i].ClusterName < summaries[j].ClusterName
+		}
+		return summaries[i].ClusterID < summaries[j].ClusterID
+	})
+	return summaries
+}
+
+// countHealthFindings counts Warning events and workloads with error findings.
+func countHealthFindings(findings []snapshot.AttentionFinding) (warningEvents, failingWorkloads int) {
+	for _, finding := range findings {
+		switch {
+		case finding.Ref.Kind == "Event" && strings.EqualFold(finding.Status, "Warning"):
+			warningEvents++
+		case finding.Severity == snapshot.AttentionSeverityError && slices.Contains(failingWorkloadKinds, finding.Ref.Kind):
+			failingWorkloads++
+		}
+	}
+	return warningEvents, failingWorkloads
+}
+
+// healthSummaryLabel renders one cluster's menu entry.
+func healthSummaryLabel(summary ClusterHealthSummary) string {
+	parts := []string{string(summary.Health)}
+	if summary.WarningEvents > 0 {
+		parts = append(parts, pluralize(summary.WarningEvents, "warning event", "warning events"))
+	}
+	if summary.FailingWorkloads > 0 {
+		parts = append(parts, pluralize(summary.FailingWorkloads, "failing workload", "failing workloads"))
+	}
+	return fmt.Sprintf("%s — %s", summary.ClusterName, strings.Join(parts, ", "))
+}
+
+// healthSummaryTotals renders the aggregate line at the top of the menu.
+func healthSummaryTotals(summaries []ClusterHealthSummary) string {
+	reachable, warnings, failing := 0, 0, 0
+	for _, summary := range summaries {
+		if summary.Reachable {
+			reachable++
+		}
+		warnings += summary.WarningEvents
+		failing += summary.FailingWorkloads
+	}
+	return fmt.Sprintf("%d of %d reachable · %s · %s", reachable, len(summaries),
+		pluralize(warnings, "warning event", "warning events"),
+		pluralize(failing, "failing workload", "failing workloads"))
+}
+
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+	return fmt.Sprintf("%d %s", count, plural)
+}
+
+// openClusterFromMenu brings the app up on the cluster through the same path
+// as a luxury-yacht:// link.
+func (a *App) openClusterFromMenu(summary ClusterHealthSummary) {
+	link := DeepLinkScheme + "://cluster/" + url.PathEscape(summary.Context)
+	if err := a.queueDeepLink(link); err != nil {
+		a.logger.Warn(fmt.Sprintf("Could not open cluster %s: %v", summary.ClusterName, err), logsources.App, summary.ClusterID, summary.ClusterName)
+	}
+}
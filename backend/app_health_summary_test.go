@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+	"k8s.io/client-go/informers"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestCountHealthFindings(t *testing.T) {
+	warnings, failing := countHealthFindings([]snapshot.AttentionFinding{
+		{Ref: resourcemodel.ResourceRef{Kind: "Event"}, Status: "Warning", Severity: snapshot.AttentionSeverityWarning},
+		{Ref: resourcemodel.ResourceRef{Kind: "Pod"}, Status: "CrashLoopBackOff", Severity: snapshot.AttentionSeverityError},
+		{Ref: resourcemodel.ResourceRef{Kind: "Deployment"}, Status: "Progressing", Severity: snapshot.AttentionSeverityWarning},
+		{Ref: resourcemodel.ResourceRef{Kind: "Node"}, Status: "NotReady", Severity: snapshot.AttentionSeverityError},
+	})
+	require.Equal(t, 1, warnings)
+	require.Equal(t, 1, failing, "only workload kinds with error findings count as failing")
+}
+
+func TestHealthSummaryFeedsClustersMenu(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	var (
+		eventsMu sync.Mutex
+		events   []string
+	)
+	app.eventEmitter = func(_ context.Context, name string, _ ...interface{}) {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+		events = append(events, name)
+	}
+
+	factory := informers.NewSharedInformerFactory(kubernetesfake.NewClientset(warningEventObject("pull", "BackOff")), 0)
+	index, err := snapshot.RegisterClusterAttentionDomain(domain.New(), factory,
+		snapshot.ClusterAttentionPermissions{IncludeEvents: true},
+		snapshot.ClusterMeta{ClusterID: "config:prod", ClusterName: "prod"}, nil, snapshot.ClusterAttentionOptions{})
+	require.NoError(t, err)
+	t.Cleanup(index.Stop)
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+	require.Eventually(t, func() bool { return len(index.Snapshot()) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	app.clusterClients = map[string]*clusterClients{
+		"config:prod":    {meta: ClusterMeta{ID: "config:prod", Name: "prod"}, kubeconfigContext: "prod"},
+		"config:staging": {meta: ClusterMeta{ID: "config:staging", Name: "staging"}, kubeconfigContext: "staging"},
+	}
+	app.refreshSubsystems = map[string]*system.Subsystem{"config:prod": {AttentionIndex: index}}
+	app.setClusterHealth("config:prod", ClusterHealthHealthy)
+	app.setClusterHealth("config:staging", ClusterHealthReconnecting)
+
+	app.refreshHealthSummary()
+	require.Equal(t, []string{"cluster-health:summary", "update-menu"}, events)
+	require.Equal(t, []ClusterHealthSummary{
+		{ClusterID: "config:prod", ClusterName: "prod", Context: "prod", Health: ClusterHealthHealthy, Reachable: true, WarningEvents: 1},
+		{ClusterID: "config:staging", ClusterName: "staging", Context: "staging", Health: ClusterHealthReconnecting},
+	}, app.GetClusterHealthSummary())
+
+	app.refreshHealthSummary()
+	require.Len(t, events, 2, "an unchanged summary is not re-sent")
+
+	clusters := findSubmenu(t, CreateMenu(app), "Clusters")
+	labels := make([]string, 0, len(clusters.Items))
+	for _, item := range clusters.Items {
+		labels = append(labels, item.Label)
+	}
+	require.Equal(t, []string{
+		"1 of 2 reachable · 1 warning event · 0 failing workloads",
+		"",
+		"prod — healthy, 1 warning event",
+		"staging — reconnecting",
+	}, labels)
+
+	// Picking a cluster opens it through the deep link path.
+	app.availableKubeconfigs = []KubeconfigInfo{{Name: "config", Path: "/p/config", Context: "staging"}}
+	originalShow := runtimeWindowShow
+	runtimeWindowShow = func(context.Context) {}
+	t.Cleanup(func() { runtimeWindowShow = originalShow })
+	clusters.Items[3].Click(&menu.CallbackData{MenuItem: clusters.Items[3]})
+	require.Eventually(t, func() bool {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+		return len(events) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, "deeplink:open", events[2])
+	target, err := app.TakePendingDeepLink()
+	require.NoError(t, err)
+	require.Equal(t, "config:staging", target.ClusterID)
+}
+
+func TestClustersMenuWithoutClusters(t *testing.T) {
+	clusters := findSubmenu(t, CreateMenu(&App{}), "Clusters")
+	require.Len(t, clusters.Items, 1)
+	require.True(t, clusters.Items[0].Disabled)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/credentialerrors"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh"
 )
 
 // healthStatus distinguishes the outcome of a cluster health check.
@@ -22,8 +23,9 @@ const (
 // This is the per-cluster heartbeat that:
 // 1. Skips clusters with invalid auth (they need recovery, not heartbeat checks)
 // 2. Checks health via the /readyz endpoint for each cluster
-// 3. Emits cluster-specific health events (cluster:health:healthy or cluster:health:degraded)
+// 3. Emits cluster-specific health events (cluster:health:healthy, :degraded or :reconnecting)
 // 4. Reports auth failures to the cluster's auth manager (connectivity failures are ignored by auth)
+// 5. Resyncs the cluster's streams when it answers again after connectivity failures
 //
 // IMPORTANT: This function does NOT call:
 // - recordTransportFailure() - this triggers global recovery
@@ -42,56 +44,160 @@ func (a *App) runHeartbeatIteration() {
 	a.clusterClientsMu.Unlock()
 
 	for clusterID, cc := range clients {
-		// Skip if cluster has no clients
-		if cc == nil {
-			continue
-		}
+		a.runClusterHeartbeat(clusterID, cc)
+	}
+}
 
-		// Skip health checks while auth is not valid: requests through the
-		// cluster's transport are blocked in that state, and the auth
-		// manager's recovery loop keeps probing on its own cadence.
-		if cc.authManager != nil && !cc.authManager.IsValid() {
-			a.logger.Debug("Skipping heartbeat for cluster "+cc.meta.Name+" (auth invalid)", logsources.Heartbeat, clusterID, cc.meta.Name)
-			continue
-		}
+// runClusterHeartbeat checks one cluster's health and reports the outcome.
+func (a *App) runClusterHeartbeat(clusterID string, cc *clusterClients) {
+	// Skip if cluster has no clients
+	if cc == nil {
+		return
+	}
 
-		// Check health and distinguish failure type
-		status := a.checkClusterHealth(cc)
+	// Skip health checks while auth is not valid: requests through the
+	// cluster's transport are blocked in that state, and the auth
+	// manager's recovery loop keeps probing on its own cadence.
+	if cc.authManager != nil && !cc.authManager.IsValid() {
+		a.logger.Debug("Skipping heartbeat for cluster "+cc.meta.Name+" (auth invalid)", logsources.Heartbeat, clusterID, cc.meta.Name)
+		return
+	}
 
-		// Build event data with cluster info
-		eventData := map[string]any{
-			"clusterId":   clusterID,
-			"clusterName": cc.meta.Name,
-		}
+	// Check health and distinguish failure type
+	status := a.checkClusterHealth(cc)
+	health, reconnected := a.recordClusterHealthStatus(clusterID, status)
+	a.recordClusterFreshness(clusterID, status)
+
+	// Build event data with cluster info
+	eventData := map[string]any{
+		"clusterId":   clusterID,
+		"clusterName": cc.meta.Name,
+	}
 
-		switch status {
-		case healthOK:
-			a.setClusterHealth(clusterID, ClusterHealthHealthy)
-			a.emitEvent("cluster:health:healthy", eventData)
+	switch status {
+	case healthOK:
+		if reconnected {
+			eventData["reconnected"] = true
+		}
+		a.emitEvent("cluster:health:healthy", eventData)
 
+		if reconnected {
+			a.logger.Info("Connection to cluster "+cc.meta.Name+" restored; resyncing streams", logsources.Heartbeat, clusterID, cc.meta.Name)
+			a.resyncClusterStreams(clusterID)
+		} else {
 			a.logger.Debug("Heartbeat healthy for cluster "+cc.meta.Name, logsources.Heartbeat, clusterID, cc.meta.Name)
+		}
 
-		case healthAuthFailure:
-			a.setClusterHealth(clusterID, ClusterHealthDegraded)
-			eventData["reason"] = "auth"
-			a.emitEvent("cluster:health:degraded", eventData)
+	case healthAuthFailure:
+		eventData["reason"] = "auth"
+		a.emitEvent("cluster:health:degraded", eventData)
 
-			a.logger.Warn("Heartbeat auth failure for cluster "+cc.meta.Name, logsources.Heartbeat, clusterID, cc.meta.Name)
+		a.logger.Warn("Heartbeat auth failure for cluster "+cc.meta.Name, logsources.Heartbeat, clusterID, cc.meta.Name)
 
-			// Only report to auth manager for genuine auth failures.
-			if cc.authManager != nil {
-				cc.authManager.ReportFailure("heartbeat auth failure")
-			}
+		// Only report to auth manager for genuine auth failures.
+		if cc.authManager != nil {
+			cc.authManager.ReportFailure("heartbeat auth failure")
+		}
 
-		case healthConnectivityFailure:
-			a.setClusterHealth(clusterID, ClusterHealthDegraded)
-			eventData["reason"] = "connectivity"
+	case healthConnectivityFailure:
+		eventData["reason"] = "connectivity"
+		if health == ClusterHealthReconnecting {
+			a.emitEvent("cluster:health:reconnecting", eventData)
+		} else {
 			a.emitEvent("cluster:health:degraded", eventData)
+		}
 
-			a.logger.Warn("Heartbeat connectivity failure for cluster "+cc.meta.Name, logsources.Heartbeat, clusterID, cc.meta.Name)
-			// Do NOT report to auth manager — this is a network issue, not an auth issue.
+		a.logger.Warn("Heartbeat connectivity failure for cluster "+cc.meta.Name, logsources.Heartbeat, clusterID, cc.meta.Name)
+		// Do NOT report to auth manager — this is a network issue, not an auth issue.
+	}
+}
+
+// recordClusterHealthStatus folds one health check into the cluster's health
+// state. Consecutive connectivity failures move the cluster from degraded to
+// reconnecting; reconnected is true when a successful check ends a run of
+// connectivity failures.
+func (a *App) recordClusterHealthStatus(clusterID string, status healthStatus) (health ClusterHealthState, reconnected bool) {
+	a.clusterWorkspaceMu.Lock()
+	defer a.clusterWorkspaceMu.Unlock()
+	if a.clusterConnectivityFailures == nil {
+		a.clusterConnectivityFailures = make(map[string]int)
+	}
+	failures := a.clusterConnectivityFailures[clusterID]
+	switch status {
+	case healthOK:
+		health = ClusterHealthHealthy
+		reconnected = failures > 0
+		delete(a.clusterConnectivityFailures, clusterID)
+	case healthAuthFailure:
+		health = ClusterHealthDegraded
+	default:
+		failures++
+		a.clusterConnectivityFailures[clusterID] = failures
+		health = ClusterHealthDegraded
+		if failures >= config.ClusterHealthReconnectingFailures {
+			health = ClusterHealthReconnecting
 		}
 	}
+	if a.clusterHealth == nil {
+		a.clusterHealth = make(map[string]ClusterHealthState)
+	}
+	if a.clusterHealth[clusterID] != health {
+		a.clusterHealth[clusterID] = health
+		a.markClusterWorkspaceChanged()
+	}
+	return health, reconnected
+}
+
+// recordClusterFreshness feeds a health check into the freshness reported with
+// the cluster's snapshots and stream ACKs. A cooled cluster keeps reporting
+// frozen data whatever the health check says, since its informers are stopped.
+func (a *App) recordClusterFreshness(clusterID string, status healthStatus) {
+	subsystem := a.getRefreshSubsystem(clusterID)
+	if subsystem == nil || subsystem.Cooled {
+		return
+	}
+	switch status {
+	case healthOK:
+		subsystem.Freshness.MarkHealthy()
+	case healthAuthFailure:
+		subsystem.Freshness.MarkDegraded(refresh.FreshnessReasonAuth)
+	default:
+		subsystem.Freshness.MarkDegraded(refresh.FreshnessReasonConnectivity)
+	}
+}
+
+// resyncClusterStreams tells every resource stream subscriber of the cluster to
+// refetch its snapshot. Informers relist on their own once the API server is
+// back, but updates sent while the connection was down never reached clients.
+func (a *App) resyncClusterStreams(clusterID string) {
+	subsystem := a.getRefreshSubsystem(clusterID)
+	if subsystem == nil || subsystem.ResourceStream == nil {
+		return
+	}
+	subsystem.ResourceStream.ResyncSubscribers()
+}
+
+// probeClusterHealthSoon runs an out-of-band heartbeat for a cluster whose
+// informer watch just failed with a connectivity error, so a dropped connection
+// is reported without waiting for the next heartbeat tick. Probes for the same
+// cluster are spaced by config.ClusterHealthProbeMinInterval.
+func (a *App) probeClusterHealthSoon(clusterID string) {
+	if a == nil || clusterID == "" {
+		return
+	}
+	now := time.Now()
+	a.clusterHealthProbesMu.Lock()
+	if a.clusterHealthProbes == nil {
+		a.clusterHealthProbes = make(map[string]time.Time)
+	}
+	if last, ok := a.clusterHealthProbes[clusterID]; ok && now.Sub(last) < config.ClusterHealthProbeMinInterval {
+		a.clusterHealthProbesMu.Unlock()
+		return
+	}
+	a.clusterHealthProbes[clusterID] = now
+	a.clusterHealthProbesMu.Unlock()
+
+	go a.runClusterHeartbeat(clusterID, a.clusterClientsForID(clusterID))
 }
 
 // checkClusterHealth checks if a cluster is healthy by calling the /readyz endpoint.
@@ -15,10 +15,12 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/luxury-yacht/app/backend/internal/authstate"
+	"github.com/luxury-yacht/app/backend/internal/config"
 	"k8s.io/client-go/discovery"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	cgofake "k8s.io/client-go/kubernetes/fake"
@@ -399,6 +401,90 @@ func TestPerClusterHeartbeatEmitsDegradedEvent(t *testing.T) {
 	}
 }
 
+// TestPerClusterHeartbeatReconnectLifecycle walks a cluster through a dropped
+// connection: degraded on the first failures, reconnecting once they persist,
+// and healthy with reconnected set when /readyz answers again.
+func TestPerClusterHeartbeatReconnectLifecycle(t *testing.T) {
+	app := NewApp()
+	app.logger = NewLogger(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app.Ctx = ctx
+
+	var events []string
+	var payloads []map[string]any
+	var eventsMu sync.Mutex
+	app.eventEmitter = func(_ context.Context, name string, args ...interface{}) {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+		if data, ok := args[0].(map[string]any); ok && strings.HasPrefix(name, "cluster:health:") {
+			events = append(events, name)
+			payloads = append(payloads, data)
+		}
+	}
+
+	var reachable atomic.Bool
+	disco := &heartbeatDiscovery{
+		FakeDiscovery: &fakediscovery.FakeDiscovery{Fake: &cgotesting.Fake{}},
+		restClient: &restfake.RESTClient{
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+			Client: restfake.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+				if !reachable.Load() {
+					return nil, errors.New("connection refused")
+				}
+				return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+			}),
+		},
+	}
+	app.clusterClientsMu.Lock()
+	app.clusterClients = map[string]*clusterClients{
+		"cluster-a": {
+			meta:   ClusterMeta{ID: "cluster-a", Name: "Cluster A"},
+			client: &heartbeatClientSet{Clientset: cgofake.NewClientset(), disco: disco},
+		},
+	}
+	app.clusterClientsMu.Unlock()
+
+	health := func() ClusterHealthState {
+		app.clusterWorkspaceMu.RLock()
+		defer app.clusterWorkspaceMu.RUnlock()
+		return app.clusterHealth["cluster-a"]
+	}
+
+	for range config.ClusterHealthReconnectingFailures {
+		app.runHeartbeatIteration()
+	}
+	if got := health(); got != ClusterHealthReconnecting {
+		t.Fatalf("expected reconnecting after repeated failures, got %q", got)
+	}
+
+	reachable.Store(true)
+	app.runHeartbeatIteration()
+	if got := health(); got != ClusterHealthHealthy {
+		t.Fatalf("expected healthy after recovery, got %q", got)
+	}
+	app.runHeartbeatIteration()
+
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	want := []string{
+		"cluster:health:degraded",
+		"cluster:health:degraded",
+		"cluster:health:reconnecting",
+		"cluster:health:healthy",
+		"cluster:health:healthy",
+	}
+	if strings.Join(events, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected events: %v", events)
+	}
+	if payloads[3]["reconnected"] != true {
+		t.Errorf("expected the first healthy event after failures to carry reconnected, got %v", payloads[3])
+	}
+	if _, ok := payloads[4]["reconnected"]; ok {
+		t.Errorf("expected a steady healthy event without reconnected, got %v", payloads[4])
+	}
+}
+
 // TestCheckClusterHealth tests the health check function for a cluster.
 func TestCheckClusterHealth(t *testing.T) {
 	app := NewApp()
@@ -0,0 +1,56 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/hpahistory"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+// HPA scaling history. Each cluster's autoscaling/v2 HPA informer and event
+// informer feed one shared recorder, kept in memory for the session, so an
+// autoscaler's replica and metric changes can be read back as a timeline
+// alongside the events the controller reported.
+
+// GetHPAScalingHistory returns the status samples and events recorded for an
+// HPA, newest first, with counts of rescales and scaling reversals.
+func (a *App) GetHPAScalingHistory(clusterID, namespace, name string) (*hpahistory.Timeline, error) {
+	if strings.TrimSpace(clusterID) == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	timeline := a.hpaHistory().Timeline(clusterID, namespace, name)
+	return &timeline, nil
+}
+
+func (a *App) hpaHistory() *hpahistory.Recorder {
+	a.hpaRecorderOnce.Do(func() {
+		a.hpaRecorder = hpahistory.NewRecorder(config.HPAHistoryRetention, config.HPAHistoryLimit, config.HPAHistoryMetricInterval, time.Now)
+	})
+	return a.hpaRecorder
+}
+
+// registerHPAHistoryHandlers feeds the cluster's HPAs and events into the HPA
+// history recorder. Permissions are checked first so no informer is created
+// for a resource the user cannot list and watch.
+func (a *App) registerHPAHistoryHandlers(subsystem *system.Subsystem, clusterID string) {
+	if subsystem == nil || subsystem.InformerFactory == nil {
+		return
+	}
+	shared := subsystem.InformerFactory.SharedInformerFactory()
+	if shared == nil {
+		return
+	}
+	recorder := a.hpaHistory()
+	if subsystem.InformerFactory.CanListWatch("autoscaling", "horizontalpodautoscalers") {
+		shared.Autoscaling().V2().HorizontalPodAutoscalers().Informer().AddEventHandler(recorder.HPAHandler(clusterID))
+	}
+	if subsystem.InformerFactory.CanListWatch("", "events") {
+		shared.Core().V1().Events().Informer().AddEventHandler(recorder.EventHandler(clusterID))
+	}
+}
@@ -0,0 +1,35 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHPAScalingHistoryReadsRecordedSamples(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	app.hpaHistory().ObserveHPA("config:ctx", &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "api"},
+		Spec:       autoscalingv2.HorizontalPodAutoscalerSpec{MaxReplicas: 5},
+		Status:     autoscalingv2.HorizontalPodAutoscalerStatus{CurrentReplicas: 2, DesiredReplicas: 3},
+	})
+
+	timeline, err := app.GetHPAScalingHistory("config:ctx", "prod", "api")
+	require.NoError(t, err)
+	require.Len(t, timeline.Samples, 1)
+	require.Equal(t, int32(3), timeline.Samples[0].DesiredReplicas)
+	require.Equal(t, int32(1), timeline.Samples[0].MinReplicas)
+
+	_, err = app.GetHPAScalingHistory("config:ctx", "", "api")
+	require.Error(t, err)
+
+	// Removing the cluster drops its history.
+	app.removeClusterWorkspaceState("config:ctx")
+	timeline, err = app.GetHPAScalingHistory("config:ctx", "prod", "api")
+	require.NoError(t, err)
+	require.Empty(t, timeline.Samples)
+}
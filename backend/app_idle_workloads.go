@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/idleworkloads"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+)
+
+// DetectIdleWorkloads lists the workloads in a cluster whose running pods
+// all stayed at or under the CPU threshold, without a container restart,
+// across the window, as candidates for scaling to zero or deletion. Verdicts
+// come from the metrics history the cluster recorded this session.
+func (a *App) DetectIdleWorkloads(clusterID string, opts idleworkloads.Options) (*idleworkloads.Report, error) {
+	clusterID = strings.TrimSpace(clusterID)
+	if clusterID == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	if opts.WindowMinutes < 0 || opts.CPUThresholdMilli < 0 {
+		return nil, fmt.Errorf("window and CPU threshold must not be negative")
+	}
+	window := time.Duration(opts.WindowMinutes) * time.Minute
+	if window == 0 {
+		window = config.IdleWorkloadDefaultWindow
+	}
+	if window > config.MetricsHistoryRetention {
+		return nil, fmt.Errorf("window must not exceed the %s metrics history", config.MetricsHistoryRetention)
+	}
+	threshold := opts.CPUThresholdMilli
+	if threshold == 0 {
+		threshold = config.IdleWorkloadDefaultCPUThresholdMilli
+	}
+
+	subsystem := a.getRefreshSubsystem(clusterID)
+	if subsystem == nil {
+		return nil, fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	if subsystem.MetricsHistory == nil {
+		return nil, fmt.Errorf("metrics are not available for cluster %s", clusterID)
+	}
+	pods := snapshot.PodAggregates(subsystem.IngestManager)
+	report := idleworkloads.Detect(clusterID, pods, subsystem.MetricsHistory, window, threshold, time.Now())
+	return &report, nil
+}
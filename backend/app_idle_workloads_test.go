@@ -0,0 +1,39 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/idleworkloads"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh/metrics"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+func TestDetectIdleWorkloadsAppliesDefaultsAndValidates(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.DetectIdleWorkloads("", idleworkloads.Options{})
+	require.ErrorContains(t, err, "cluster ID is required")
+	_, err = app.DetectIdleWorkloads("c1", idleworkloads.Options{CPUThresholdMilli: -1})
+	require.Error(t, err)
+	_, err = app.DetectIdleWorkloads("c1", idleworkloads.Options{WindowMinutes: 24 * 60})
+	require.ErrorContains(t, err, "metrics history")
+	_, err = app.DetectIdleWorkloads("c1", idleworkloads.Options{})
+	require.ErrorContains(t, err, "not connected")
+
+	app.setRefreshSubsystem("c1", &system.Subsystem{})
+	_, err = app.DetectIdleWorkloads("c1", idleworkloads.Options{})
+	require.ErrorContains(t, err, "metrics are not available")
+
+	app.setRefreshSubsystem("c1", &system.Subsystem{
+		MetricsHistory: metrics.NewHistory(config.MetricsHistoryRetention, config.MetricsHistoryResolution),
+	})
+	report, err := app.DetectIdleWorkloads("c1", idleworkloads.Options{})
+	require.NoError(t, err)
+	require.Equal(t, "c1", report.ClusterID)
+	require.Equal(t, int(config.IdleWorkloadDefaultWindow.Minutes()), report.WindowMinutes)
+	require.EqualValues(t, config.IdleWorkloadDefaultCPUThresholdMilli, report.CPUThresholdMilli)
+	require.Empty(t, report.Candidates)
+}
@@ -0,0 +1,236 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	"github.com/luxury-yacht/app/backend/vulnscan"
+)
+
+// imageScanTimeout bounds one image scan; a cold Trivy DB download plus a
+// large image can legitimately take minutes.
+const imageScanTimeout = 10 * time.Minute
+
+// ContainerImageScan is the vulnerability summary for one container's image.
+// Scanned is false when no result is cached yet (or the scan failed, in which
+// case Error explains why).
+type ContainerImageScan struct {
+	Container string          `json:"container"`
+	Init      bool            `json:"init,omitempty"`
+	Image     string          `json:"image"`
+	Digest    string          `json:"digest,omitempty"`
+	Scanned   bool            `json:"scanned"`
+	Counts    vulnscan.Counts `json:"counts"`
+	ScannedAt *time.Time      `json:"scannedAt,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// WorkloadImageScan aggregates image scan results for a Pod or workload.
+// Totals counts each distinct image once, however many containers share it.
+type WorkloadImageScan struct {
+	Ref        resourcemodel.ResourceRef `json:"ref"`
+	Containers []ContainerImageScan      `json:"containers"`
+	Totals     vulnscan.Counts           `json:"totals"`
+}
+
+// GetWorkloadImageScan returns cached vulnerability counts for the images of a
+// Pod or workload without running the scanner, so object details can show the
+// last known counts cheaply.
+func (a *App) GetWorkloadImageScan(clusterID, group, version, kind, namespace, name string) (*WorkloadImageScan, error) {
+	return a.workloadImageScan(clusterID, group, version, kind, namespace, name, false, false)
+}
+
+// ScanWorkloadImages scans every image referenced by a Pod or workload with
+// Trivy, reusing cached results by digest unless force is set. A failure on
+// one image (e.g. an unreachable private registry) is reported on that
+// container and does not abort the others.
+func (a *App) ScanWorkloadImages(clusterID, group, version, kind, namespace, name string, force bool) (*WorkloadImageScan, error) {
+	return a.workloadImageScan(clusterID, group, version, kind, namespace, name, true, force)
+}
+
+func (a *App) workloadImageScan(clusterID, group, version, kind, namespace, name string, scan, force bool) (*WorkloadImageScan, error) {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, kind); err != nil {
+		return nil, err
+	}
+	ctx := deps.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ref, spec, digests, err := fetchWorkloadPodSpec(ctx, deps.KubernetesClient, deps.ClusterID, group, version, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var runner vulnscan.Runner
+	if scan {
+		settings, err := a.GetAppSettings()
+		if err != nil {
+			return nil, err
+		}
+		runner = a.imageScanRunner(settings.TrivyPath, settings.TrivyServerURL)
+	}
+	cache := a.imageScanCacheInstance()
+
+	result := &WorkloadImageScan{Ref: ref, Containers: []ContainerImageScan{}}
+	counted := make(map[string]struct{})
+	scanned := 0
+	add := func(container corev1.Container, init bool) error {
+		entry := ContainerImageScan{Container: container.Name, Init: init, Image: container.Image, Digest: digests[container.Name]}
+		var (
+			image vulnscan.ImageResult
+			ok    bool
+		)
+		if runner != nil {
+			scanCtx, cancel := context.WithTimeout(ctx, imageScanTimeout)
+			var scanErr error
+			image, scanErr = vulnscan.Scan(scanCtx, runner, cache, entry.Image, entry.Digest, force)
+			cancel()
+			if errors.Is(scanErr, vulnscan.ErrTrivyNotFound) {
+				return scanErr
+			}
+			if scanErr != nil {
+				entry.Error = scanErr.Error()
+			} else {
+				ok = true
+				scanned++
+			}
+		} else {
+			image, ok = cache.Lookup(entry.Image, entry.Digest)
+		}
+		if ok {
+			scannedAt := image.ScannedAt
+			entry.Scanned = true
+			entry.Digest = image.Digest
+			entry.Counts = image.Counts
+			entry.ScannedAt = &scannedAt
+			key := image.Digest
+			if key == "" {
+				key = image.Image
+			}
+			if _, seen := counted[key]; !seen {
+				counted[key] = struct{}{}
+				result.Totals = result.Totals.Add(image.Counts)
+			}
+		}
+		result.Containers = append(result.Containers, entry)
+		return nil
+	}
+	for _, container := range spec.InitContainers {
+		if err := add(container, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, container := range spec.Containers {
+		if err := add(container, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if runner != nil {
+		a.logger.Info(
+			fmt.Sprintf("Scanned %d of %d image(s) for %s %s/%s", scanned, len(result.Containers), ref.Kind, namespace, name),
+			logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+		)
+	}
+	return result, nil
+}
+
+// imageScanRunner builds the scanner for the current settings; tests replace
+// imageScanRunnerFactory with a fake.
+func (a *App) imageScanRunner(path, serverURL string) vulnscan.Runner {
+	if a.imageScanRunnerFactory != nil {
+		return a.imageScanRunnerFactory(path, serverURL)
+	}
+	return vulnscan.TrivyRunner{Path: path, ServerURL: serverURL}
+}
+
+// imageScanCacheInstance returns the process-wide scan cache. Results are
+// keyed by digest, which is cluster-independent, so all clusters share it.
+func (a *App) imageScanCacheInstance() *vulnscan.Cache {
+	a.imageScanCacheOnce.Do(func() {
+		a.imageScanCache = vulnscan.NewCache(vulnscan.DefaultCacheTTL)
+	})
+	return a.imageScanCache
+}
+
+// fetchWorkloadPodSpec resolves the pod spec a Pod or workload runs. For Pods
+// it also returns each container's running digest from status, so scans
+// target exactly the image the node pulled.
+func fetchWorkloadPodSpec(ctx context.Context, client kubernetes.Interface, clusterID, group, version, kind, namespace, name string) (resourcemodel.ResourceRef, corev1.PodSpec, map[string]string, error) {
+	group, version, kind = strings.TrimSpace(group), strings.TrimSpace(version), strings.TrimSpace(kind)
+	get := metav1.GetOptions{}
+	newRef := func(resource, uid string) resourcemodel.ResourceRef {
+		return resourcemodel.NewResourceRef(clusterID, group, version, kind, resource, namespace, name, uid)
+	}
+	switch {
+	case group == "" && version == "v1" && kind == "Pod":
+		pod, err := client.CoreV1().Pods(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		digests := make(map[string]string)
+		for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+			for _, status := range statuses {
+				if digest := vulnscan.DigestFromImageID(status.ImageID); digest != "" {
+					digests[status.Name] = digest
+				}
+			}
+		}
+		return newRef("pods", string(pod.UID)), pod.Spec, digests, nil
+	case group == "apps" && version == "v1" && kind == "Deployment":
+		obj, err := client.AppsV1().Deployments(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("deployments", string(obj.UID)), obj.Spec.Template.Spec, nil, nil
+	case group == "apps" && version == "v1" && kind == "StatefulSet":
+		obj, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("statefulsets", string(obj.UID)), obj.Spec.Template.Spec, nil, nil
+	case group == "apps" && version == "v1" && kind == "DaemonSet":
+		obj, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("daemonsets", string(obj.UID)), obj.Spec.Template.Spec, nil, nil
+	case group == "apps" && version == "v1" && kind == "ReplicaSet":
+		obj, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("replicasets", string(obj.UID)), obj.Spec.Template.Spec, nil, nil
+	case group == "batch" && version == "v1" && kind == "Job":
+		obj, err := client.BatchV1().Jobs(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("jobs", string(obj.UID)), obj.Spec.Template.Spec, nil, nil
+	case group == "batch" && version == "v1" && kind == "CronJob":
+		obj, err := client.BatchV1().CronJobs(namespace).Get(ctx, name, get)
+		if err != nil {
+			return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, err
+		}
+		return newRef("cronjobs", string(obj.UID)), obj.Spec.JobTemplate.Spec.Template.Spec, nil, nil
+	}
+	return resourcemodel.ResourceRef{}, corev1.PodSpec{}, nil, fmt.Errorf("image scanning not supported for %s", schema.GroupVersionKind{Group: group, Version: version, Kind: kind}.String())
+}
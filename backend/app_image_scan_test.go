@@ -0,0 +1,174 @@
+package backend
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/vulnscan"
+)
+
+type recordingImageScanRunner struct {
+	targets []string
+	fail    map[string]error
+}
+
+func (r *recordingImageScanRunner) Run(_ context.Context, image string) ([]byte, error) {
+	r.targets = append(r.targets, image)
+	if err := r.fail[image]; err != nil {
+		return nil, err
+	}
+	return []byte(`{"Metadata":{"RepoDigests":["x@sha256:` + image + `"]},"Results":[{"Vulnerabilities":[{"Severity":"HIGH"},{"Severity":"CRITICAL"}]}]}`), nil
+}
+
+func newImageScanApp(t *testing.T, runner *recordingImageScanRunner) *App {
+	t.Helper()
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", UID: "pod-uid"},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init", Image: "busybox:1.36"}},
+				Containers:     []corev1.Container{{Name: "app", Image: "nginx:1.25"}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "app", ImageID: "docker.io/library/nginx@sha256:abc"}},
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app", Image: "nginx:1.25"}, {Name: "sidecar", Image: "nginx:1.25"}},
+			}}},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+	app.imageScanRunnerFactory = func(string, string) vulnscan.Runner { return runner }
+	return app
+}
+
+func TestScanWorkloadImagesPinsPodDigestsAndCaches(t *testing.T) {
+	runner := &recordingImageScanRunner{}
+	app := newImageScanApp(t, runner)
+
+	cached, err := app.GetWorkloadImageScan("config:ctx", "", "v1", "Pod", "default", "web-1")
+	require.NoError(t, err)
+	require.Len(t, cached.Containers, 2)
+	require.False(t, cached.Containers[0].Scanned)
+	require.Empty(t, runner.targets, "cached lookups must never run the scanner")
+
+	result, err := app.ScanWorkloadImages("config:ctx", "", "v1", "Pod", "default", "web-1", false)
+	require.NoError(t, err)
+	require.Equal(t, "config:ctx", result.Ref.ClusterID)
+	require.Equal(t, "Pod", result.Ref.Kind)
+	require.Equal(t, []string{"busybox:1.36", "nginx@sha256:abc"}, runner.targets)
+	require.True(t, result.Containers[0].Init)
+	require.Equal(t, "sha256:abc", result.Containers[1].Digest)
+	require.Equal(t, vulnscan.Counts{Critical: 2, High: 2}, result.Totals)
+
+	cached, err = app.GetWorkloadImageScan("config:ctx", "", "v1", "Pod", "default", "web-1")
+	require.NoError(t, err)
+	require.True(t, cached.Containers[0].Scanned)
+	require.True(t, cached.Containers[1].Scanned)
+	require.Len(t, runner.targets, 2)
+}
+
+func TestScanWorkloadImagesCountsSharedImagesOnceAndReportsFailures(t *testing.T) {
+	runner := &recordingImageScanRunner{}
+	app := newImageScanApp(t, runner)
+
+	result, err := app.ScanWorkloadImages("config:ctx", "apps", "v1", "Deployment", "default", "web", false)
+	require.NoError(t, err)
+	require.Len(t, result.Containers, 2)
+	require.Equal(t, vulnscan.Counts{Critical: 1, High: 1}, result.Totals)
+	require.Len(t, runner.targets, 1, "the second container reuses the cached tag result")
+
+	runner.fail = map[string]error{"nginx:1.25": context.DeadlineExceeded}
+	result, err = app.ScanWorkloadImages("config:ctx", "apps", "v1", "Deployment", "default", "web", true)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Containers[0].Error)
+	require.False(t, result.Containers[0].Scanned)
+}
+
+func TestScanWorkloadImagesRejectsUnsupportedKinds(t *testing.T) {
+	app := newImageScanApp(t, &recordingImageScanRunner{})
+
+	_, err := app.ScanWorkloadImages("config:ctx", "", "v1", "Service", "default", "web", false)
+	require.ErrorContains(t, err, "not supported")
+}
+
+func TestScanWorkloadImagesFailsWithoutTrivy(t *testing.T) {
+	app := newImageScanApp(t, nil)
+	app.imageScanRunnerFactory = nil
+	settings, err := app.GetAppSettings()
+	require.NoError(t, err)
+	// A path saved before validation existed, or edited into settings.json.
+	settings.TrivyPath = "/nonexistent/trivy"
+	app.appSettings = settings
+
+	_, err = app.ScanWorkloadImages("config:ctx", "", "v1", "Pod", "default", "web-1", false)
+	require.ErrorIs(t, err, vulnscan.ErrTrivyNotFound)
+}
+
+func TestTrivyServerURLPreferenceValidation(t *testing.T) {
+	app := newImageScanApp(t, nil)
+
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceTrivyServerURL, Value: "trivy:4954"},
+	}})
+	require.ErrorContains(t, err, "invalid URL")
+
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceTrivyServerURL, Value: " http://trivy.local:4954 "},
+	}})
+	require.NoError(t, err)
+	settings, err := app.GetAppSettings()
+	require.NoError(t, err)
+	require.Equal(t, "http://trivy.local:4954", settings.TrivyServerURL)
+}
+
+func TestTrivyPathPreferenceValidation(t *testing.T) {
+	app := newImageScanApp(t, nil)
+	dir := t.TempDir()
+	notTrivy := filepath.Join(dir, "not-trivy")
+	require.NoError(t, os.WriteFile(notTrivy, []byte("#!/bin/sh\necho hello\n"), 0o755))
+
+	for _, value := range []string{"trivy", "/nonexistent/trivy", dir, notTrivy} {
+		_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+			{Key: appPreferenceTrivyPath, Value: value},
+		}})
+		require.Error(t, err, value)
+	}
+	settings, err := app.GetAppSettings()
+	require.NoError(t, err)
+	require.Empty(t, settings.TrivyPath)
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	trivy := filepath.Join(dir, "trivy")
+	require.NoError(t, os.WriteFile(trivy, []byte("#!/bin/sh\necho 'Version: 0.50.0'\n"), 0o755))
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceTrivyPath, Value: trivy},
+	}})
+	require.NoError(t, err)
+	settings, err = app.GetAppSettings()
+	require.NoError(t, err)
+	require.Equal(t, trivy, settings.TrivyPath)
+}
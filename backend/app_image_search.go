@@ -0,0 +1,111 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"github.com/luxury-yacht/app/backend/imageindex"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+// Container image search. Each cluster's pod ingest store feeds its pods'
+// container images into one shared index, so "where is this image running"
+// is answered from memory instead of listing every pod.
+
+// SearchContainerImages returns the pods and workloads in a cluster running an
+// image that matches query (registry/repo[:tag|@digest], with * wildcards).
+func (a *App) SearchContainerImages(clusterID, query string) (*imageindex.Result, error) {
+	if strings.TrimSpace(clusterID) == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	parsed, err := imageindex.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	result := a.imageIndex().Search(clusterID, parsed, config.ImageSearchPodLimit)
+	return &result, nil
+}
+
+// GetImageInventory lists the unique images running in a cluster with their
+// container and pod counts, namespaces, pull policies and whether the tag is
+// mutable.
+func (a *App) GetImageInventory(clusterID string) (*imageindex.Inventory, error) {
+	if strings.TrimSpace(clusterID) == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	inventory := a.imageIndex().Inventory(clusterID)
+	return &inventory, nil
+}
+
+// ExportImageInventoryCSV asks for a destination file and writes the cluster's
+// image inventory to it as CSV.
+func (a *App) ExportImageInventoryCSV(clusterID string) (CatalogQueryCSVExport, error) {
+	var empty CatalogQueryCSVExport
+	if a == nil {
+		return empty, fmt.Errorf("app is not initialised")
+	}
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	if strings.TrimSpace(clusterID) == "" {
+		return empty, fmt.Errorf("cluster ID is required")
+	}
+
+	filename := "image-inventory"
+	if name := a.clusterNameForID(clusterID); name != "" {
+		filename = name + "-" + filename
+	}
+	path, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:           "Export Image Inventory",
+		DefaultFilename: sanitizeCsvFilename(filename),
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "CSV files (*.csv)", Pattern: "*.csv"},
+		},
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select CSV export file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("CSV export canceled")
+	}
+	return a.exportImageInventoryCSV(clusterID, path)
+}
+
+// exportImageInventoryCSV renders the cluster's image inventory and writes it to path.
+func (a *App) exportImageInventoryCSV(clusterID, path string) (CatalogQueryCSVExport, error) {
+	var empty CatalogQueryCSVExport
+	inventory := a.imageIndex().Inventory(clusterID)
+	content, err := imageindex.RenderInventoryCSV(inventory)
+	if err != nil {
+		return empty, err
+	}
+	info, err := writeCSVFileAtomically(path, content)
+	if err != nil {
+		return empty, err
+	}
+	a.logger.Info(fmt.Sprintf("Exported %d images as CSV to %s", len(inventory.Images), path), logsources.App, clusterID, a.clusterNameForID(clusterID))
+	return CatalogQueryCSVExport{Path: path, Bytes: info.Size()}, nil
+}
+
+func (a *App) imageIndex() *imageindex.Index {
+	a.imageIndexOnce.Do(func() {
+		a.images = imageindex.NewIndex()
+	})
+	return a.images
+}
+
+// registerImageIndexSink feeds the cluster's pods into the image index. Like
+// every bundle sink it must be added before the ingest manager starts.
+func (a *App) registerImageIndexSink(subsystem *system.Subsystem, clusterID string) {
+	if subsystem == nil || subsystem.IngestManager == nil {
+		return
+	}
+	subsystem.IngestManager.AddBundleSink(snapshot.PodGVR, a.imageIndex().Sink(clusterID))
+}
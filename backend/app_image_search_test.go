@@ -0,0 +1,71 @@
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func TestSearchContainerImagesReadsIndexedPods(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	app.imageIndex().Sink("config:ctx").UpsertBundle(ingest.Bundle{Aggregate: streamrows.PodAggregate{
+		Namespace: "prod",
+		Name:      "api-1",
+		OwnerKey:  "prod/Deployment/api",
+		Images:    []streamrows.ContainerImage{{Container: "app", Image: "registry.example.com/api:1.4"}},
+	}})
+
+	result, err := app.SearchContainerImages("config:ctx", "registry.example.com/api:1.*")
+	require.NoError(t, err)
+	require.Len(t, result.Pods, 1)
+	require.Equal(t, "api", result.Workloads[0].Name)
+
+	_, err = app.SearchContainerImages("config:ctx", " ")
+	require.Error(t, err)
+	_, err = app.SearchContainerImages("", "nginx")
+	require.Error(t, err)
+
+	// Removing the cluster drops its pods from the index.
+	app.removeClusterWorkspaceState("config:ctx")
+	result, err = app.SearchContainerImages("config:ctx", "registry.example.com/api:1.*")
+	require.NoError(t, err)
+	require.Empty(t, result.Pods)
+}
+
+func TestImageInventoryListsAndExportsIndexedImages(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	app.imageIndex().Sink("config:ctx").UpsertBundle(ingest.Bundle{Aggregate: streamrows.PodAggregate{
+		Namespace: "prod",
+		Name:      "api-1",
+		Images: []streamrows.ContainerImage{
+			{Container: "app", Image: "registry.example.com/api:1.4", PullPolicy: "IfNotPresent"},
+			{Container: "proxy", Image: "nginx", PullPolicy: "Always"},
+		},
+	}})
+
+	inventory, err := app.GetImageInventory("config:ctx")
+	require.NoError(t, err)
+	require.Len(t, inventory.Images, 2)
+	require.Equal(t, "nginx", inventory.Images[0].Image)
+	require.True(t, inventory.Images[0].MutableTag)
+	_, err = app.GetImageInventory("")
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "images.csv")
+	export, err := app.exportImageInventoryCSV("config:ctx", path)
+	require.NoError(t, err)
+	require.Equal(t, path, export.Path)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "nginx,1,1,prod,Always,true,\n")
+	require.Equal(t, int64(len(content)), export.Bytes)
+}
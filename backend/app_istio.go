@@ -0,0 +1,31 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/resources/istio"
+)
+
+// GetIstioDetails returns the tailored detail payload for an Istio
+// VirtualService, Gateway, DestinationRule or PeerAuthentication: hosts,
+// routes with their weighted destinations, subsets, servers and TLS/mTLS
+// modes. Other kinds keep the generic custom-resource details.
+func (a *App) GetIstioDetails(target ObjectActionTargetRef) (*istio.Details, error) {
+	target, err := validateObjectActionTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	gvk := objectActionTargetGVK(target)
+	if !istio.Supports(gvk.Group, gvk.Kind) {
+		return nil, fmt.Errorf("%s is not a supported Istio kind", gvk.GroupKind())
+	}
+	deps, _, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := fetchObjectByGVK(deps.Context, deps, gvk, target.Namespace, target.Name)
+	if err != nil {
+		return nil, err
+	}
+	return istio.BuildDetails(obj, gvk.Group), nil
+}
@@ -0,0 +1,51 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetIstioDetailsReadsVirtualService(t *testing.T) {
+	const clusterID = "istio"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+
+	kubeClient := kubernetesfake.NewClientset()
+	kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: "networking.istio.io/v1",
+		APIResources: []metav1.APIResource{{Name: "virtualservices", SingularName: "virtualservice", Namespaced: true, Kind: "VirtualService", Verbs: metav1.Verbs{"get", "list"}}},
+	}}
+	virtualService := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "networking.istio.io/v1",
+		"kind":       "VirtualService",
+		"metadata":   map[string]any{"name": "reviews", "namespace": "bookinfo"},
+		"spec": map[string]any{
+			"hosts": []any{"reviews"},
+			"http":  []any{map[string]any{"route": []any{map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v1"}}}}},
+		},
+	}}
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+		dynamicClient:     dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), virtualService),
+	})
+
+	details, err := app.GetIstioDetails(objectActionTarget(clusterID, "networking.istio.io", "v1", "VirtualService", "bookinfo", "reviews"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"reviews"}, details.Summary.Hosts)
+	require.Equal(t, []string{"* -> reviews(v1)"}, details.Summary.Routes)
+
+	_, err = app.GetIstioDetails(objectActionTarget(clusterID, "gateway.networking.k8s.io", "v1", "Gateway", "bookinfo", "reviews"))
+	require.ErrorContains(t, err, "not a supported Istio kind")
+}
@@ -0,0 +1,441 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Remappable keyboard shortcuts. keybindingRegistry is the source of truth for
+// action IDs and default chords; settings.json stores only the overrides, so a
+// changed default still reaches users who never remapped that action.
+
+// keybindingsExportVersion is the format version written by ExportKeybindings.
+const keybindingsExportVersion = 1
+
+type keybindingDefinition struct {
+	action      string
+	description string
+	category    string
+	// global actions fire from any view, so they conflict with every other
+	// action; view actions only conflict within their category.
+	global      bool
+	destructive bool
+	binding     KeyBinding
+}
+
+var keybindingRegistry = []keybindingDefinition{
+	{action: "app.showShortcuts", description: "Show keyboard shortcuts help", category: "Global", global: true, binding: KeyBinding{Key: "?", Shift: true}},
+	{action: "app.toggleSidebar", description: "Toggle sidebar", category: "Global", global: true, binding: KeyBinding{Key: "b", Mod: true}},
+	{action: "app.toggleAppLogsPanel", description: "Toggle Application Logs Panel", category: "Global", global: true, binding: KeyBinding{Key: "l", Ctrl: true, Shift: true}},
+	{action: "app.toggleSettings", description: "Toggle settings", category: "Global", global: true, binding: KeyBinding{Key: ",", Mod: true}},
+	{action: "app.toggleObjectDiff", description: "Toggle object diff viewer", category: "Global", global: true, binding: KeyBinding{Key: "d", Mod: true}},
+	{action: "app.toggleDiagnostics", description: "Toggle diagnostics panel", category: "Global", global: true, binding: KeyBinding{Key: "d", Ctrl: true, Shift: true}},
+	{action: "app.commandPalette", description: "Open command palette", category: "Global", global: true, binding: KeyBinding{Key: "p", Mod: true, Shift: true}},
+	{action: "app.selectNamespace", description: "Select namespace", category: "Global", global: true, binding: KeyBinding{Key: "n", Mod: true, Shift: true}},
+	{action: "view.refresh", description: "Refresh current view", category: "Navigation", global: true, binding: KeyBinding{Key: "r", Mod: true}},
+	{action: "view.zoomIn", description: "Zoom in", category: "View", global: true, binding: KeyBinding{Key: "=", Mod: true}},
+	{action: "view.zoomOut", description: "Zoom out", category: "View", global: true, binding: KeyBinding{Key: "-", Mod: true}},
+	{action: "view.resetZoom", description: "Reset zoom", category: "View", global: true, binding: KeyBinding{Key: "0", Mod: true}},
+	{action: "cluster.previousTab", description: "Switch to previous cluster tab", category: "Navigation", global: true, binding: KeyBinding{Key: "ArrowLeft", Mod: true, Alt: true}},
+	{action: "cluster.nextTab", description: "Switch to next cluster tab", category: "Navigation", global: true, binding: KeyBinding{Key: "ArrowRight", Mod: true, Alt: true}},
+	{action: "yaml.toggleManagedFields", description: "Toggle managedFields", category: "YAML Tab", binding: KeyBinding{Key: "m"}},
+	{action: "yaml.save", description: "Save YAML changes", category: "YAML Tab", destructive: true, binding: KeyBinding{Key: "s", Mod: true}},
+}
+
+// reservedKeyChords are owned by the webview, focus navigation, or the native
+// menu and cannot be assigned to an action.
+var reservedKeyChords = []struct {
+	binding KeyBinding
+	reason  string
+}{
+	{KeyBinding{Key: "Escape"}, "closes overlays and panels"},
+	{KeyBinding{Key: "Tab"}, "moves focus"},
+	{KeyBinding{Key: "Tab", Shift: true}, "moves focus"},
+	{KeyBinding{Key: "a", Mod: true}, "selects all"},
+	{KeyBinding{Key: "c", Mod: true}, "copies"},
+	{KeyBinding{Key: "v", Mod: true}, "pastes"},
+	{KeyBinding{Key: "x", Mod: true}, "cuts"},
+	{KeyBinding{Key: "z", Mod: true}, "undoes"},
+	{KeyBinding{Key: "z", Mod: true, Shift: true}, "redoes"},
+	{KeyBinding{Key: "o", Mod: true}, "opens a cluster from the menu"},
+	{KeyBinding{Key: "w", Mod: true}, "closes the cluster tab from the menu"},
+	{KeyBinding{Key: "q", Mod: true}, "quits the app"},
+}
+
+// namedKeys maps accepted spellings of non-character keys to their
+// KeyboardEvent.key value.
+var namedKeys = func() map[string]string {
+	keys := map[string]string{"esc": "Escape", "space": " ", "del": "Delete"}
+	for _, name := range []string{
+		"Escape", "Enter", "Tab", "Delete", "Backspace", "Home", "End", "PageUp", "PageDown",
+		"ArrowUp", "ArrowDown", "ArrowLeft", "ArrowRight",
+	} {
+		keys[strings.ToLower(name)] = name
+	}
+	for i := 1; i <= 12; i++ {
+		keys[fmt.Sprintf("f%d", i)] = fmt.Sprintf("F%d", i)
+	}
+	return keys
+}()
+
+type keybindingsExport struct {
+	Version  int          `json:"version"`
+	Bindings []KeyBinding `json:"bindings"`
+}
+
+// GetKeybindings returns every remappable action with its effective binding.
+func (a *App) GetKeybindings() ([]KeyBindingAction, error) {
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, fmt.Errorf("loading settings: %w", err)
+	}
+	return keybindingActions(keybindingOverrides(settings.Preferences.Keybindings)), nil
+}
+
+// SetKeybindings remaps the listed actions. The resulting set is validated as
+// a whole (reserved chords, modifier requirements, conflicts); on any error
+// nothing is persisted.
+func (a *App) SetKeybindings(bindings []KeyBinding) ([]KeyBindingAction, error) {
+	normalized, err := normalizeKeyBindingBatch(bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, fmt.Errorf("loading settings: %w", err)
+	}
+	overrides := keybindingOverrides(settings.Preferences.Keybindings)
+	for _, binding := range normalized {
+		overrides[binding.Action] = binding
+	}
+	return a.saveKeybindingOverridesLocked(settings, overrides)
+}
+
+// ResetKeybindings restores the default binding of the listed actions, or of
+// every action when none are listed.
+func (a *App) ResetKeybindings(actions []string) ([]KeyBindingAction, error) {
+	for _, action := range actions {
+		if _, ok := keybindingDefinitionFor(action); !ok {
+			return nil, fmt.Errorf("unknown keybinding action %q", action)
+		}
+	}
+
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, fmt.Errorf("loading settings: %w", err)
+	}
+	overrides := keybindingOverrides(settings.Preferences.Keybindings)
+	if len(actions) == 0 {
+		overrides = map[string]KeyBinding{}
+	}
+	for _, action := range actions {
+		delete(overrides, action)
+	}
+	return a.saveKeybindingOverridesLocked(settings, overrides)
+}
+
+// ExportKeybindings returns the customized bindings as a JSON document that
+// ImportKeybindings accepts.
+func (a *App) ExportKeybindings() (string, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("loading settings: %w", err)
+	}
+	doc := keybindingsExport{
+		Version:  keybindingsExportVersion,
+		Bindings: storedKeybindings(keybindingOverrides(settings.Preferences.Keybindings)),
+	}
+	if doc.Bindings == nil {
+		doc.Bindings = []KeyBinding{}
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal keybindings: %w", err)
+	}
+	return string(data), nil
+}
+
+// ImportKeybindings replaces all customized bindings with those in an
+// exported document. Actions the document does not list revert to their
+// defaults; the document is rejected whole if any binding is invalid.
+func (a *App) ImportKeybindings(data string) ([]KeyBindingAction, error) {
+	var doc keybindingsExport
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse keybindings: %w", err)
+	}
+	if doc.Version > keybindingsExportVersion {
+		return nil, fmt.Errorf("keybindings format version %d is newer than this app supports", doc.Version)
+	}
+	normalized, err := normalizeKeyBindingBatch(doc.Bindings)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]KeyBinding, len(normalized))
+	for _, binding := range normalized {
+		overrides[binding.Action] = binding
+	}
+
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, fmt.Errorf("loading settings: %w", err)
+	}
+	return a.saveKeybindingOverridesLocked(settings, overrides)
+}
+
+// saveKeybindingOverridesLocked validates and persists overrides. Callers
+// hold settingsMu.
+func (a *App) saveKeybindingOverridesLocked(settings *settingsFile, overrides map[string]KeyBinding) ([]KeyBindingAction, error) {
+	if err := validateKeybindings(overrides); err != nil {
+		return nil, err
+	}
+	settings.Preferences.Keybindings = storedKeybindings(overrides)
+	if err := a.saveSettingsFile(settings); err != nil {
+		return nil, err
+	}
+	return keybindingActions(overrides), nil
+}
+
+func keybindingDefinitionFor(action string) (keybindingDefinition, bool) {
+	for _, def := range keybindingRegistry {
+		if def.action == action {
+			return def, true
+		}
+	}
+	return keybindingDefinition{}, false
+}
+
+// keybindingOverrides indexes persisted overrides by action. Overrides for
+// actions this version no longer knows are dropped.
+func keybindingOverrides(stored []KeyBinding) map[string]KeyBinding {
+	overrides := make(map[string]KeyBinding, len(stored))
+	for _, binding := range stored {
+		if _, ok := keybindingDefinitionFor(binding.Action); !ok {
+			continue
+		}
+		if normalized, err := normalizeKeyBinding(binding); err == nil {
+			overrides[normalized.Action] = normalized
+		}
+	}
+	return overrides
+}
+
+// storedKeybindings lists overrides in registry order, omitting those equal
+// to the default.
+func storedKeybindings(overrides map[string]KeyBinding) []KeyBinding {
+	var stored []KeyBinding
+	for _, def := range keybindingRegistry {
+		binding, ok := overrides[def.action]
+		if !ok || binding == defaultKeyBinding(def) {
+			continue
+		}
+		stored = append(stored, binding)
+	}
+	return stored
+}
+
+func defaultKeyBinding(def keybindingDefinition) KeyBinding {
+	binding := def.binding
+	binding.Action = def.action
+	return binding
+}
+
+func effectiveKeyBinding(def keybindingDefinition, overrides map[string]KeyBinding) KeyBinding {
+	if binding, ok := overrides[def.action]; ok {
+		return binding
+	}
+	return defaultKeyBinding(def)
+}
+
+func keybindingActions(overrides map[string]KeyBinding) []KeyBindingAction {
+	actions := make([]KeyBindingAction, 0, len(keybindingRegistry))
+	for _, def := range keybindingRegistry {
+		binding := effectiveKeyBinding(def, overrides)
+		actions = append(actions, KeyBindingAction{
+			Action:      def.action,
+			Description: def.description,
+			Category:    def.category,
+			Global:      def.global,
+			Destructive: def.destructive,
+			Default:     defaultKeyBinding(def),
+			Binding:     binding,
+			Customized:  binding != defaultKeyBinding(def),
+		})
+	}
+	return actions
+}
+
+// normalizeKeyBindingBatch normalizes each binding and rejects unknown or
+// repeated actions.
+func normalizeKeyBindingBatch(bindings []KeyBinding) ([]KeyBinding, error) {
+	seen := make(map[string]bool, len(bindings))
+	normalized := make([]KeyBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		next, err := normalizeKeyBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keybindingDefinitionFor(next.Action); !ok {
+			return nil, fmt.Errorf("unknown keybinding action %q", next.Action)
+		}
+		if seen[next.Action] {
+			return nil, fmt.Errorf("keybinding action %q is listed more than once", next.Action)
+		}
+		seen[next.Action] = true
+		normalized = append(normalized, next)
+	}
+	return normalized, nil
+}
+
+// normalizeKeyBinding canonicalizes the key: single characters are
+// lower-cased and named keys take their KeyboardEvent.key spelling. An empty
+// key unbinds the action and clears its modifiers.
+func normalizeKeyBinding(binding KeyBinding) (KeyBinding, error) {
+	binding.Action = strings.TrimSpace(binding.Action)
+	if binding.Action == "" {
+		return binding, fmt.Errorf("keybinding action is required")
+	}
+	key := binding.Key
+	if key != " " {
+		key = strings.TrimSpace(key)
+	}
+	switch {
+	case key == "":
+		return KeyBinding{Action: binding.Action}, nil
+	case utf8.RuneCountInString(key) == 1:
+		key = strings.ToLower(key)
+	default:
+		named, ok := namedKeys[strings.ToLower(key)]
+		if !ok {
+			return binding, fmt.Errorf("%s: unsupported key %q", binding.Action, binding.Key)
+		}
+		key = named
+	}
+	if binding.Mod && (binding.Ctrl || binding.Meta) {
+		return binding, fmt.Errorf("%s: Cmd/Ctrl cannot be combined with Ctrl or Meta", binding.Action)
+	}
+	binding.Key = key
+	return binding, nil
+}
+
+// validateKeybindings checks the effective binding of every action and
+// reports all problems at once.
+func validateKeybindings(overrides map[string]KeyBinding) error {
+	var errs []error
+	effective := make([]KeyBinding, len(keybindingRegistry))
+	for i, def := range keybindingRegistry {
+		binding := effectiveKeyBinding(def, overrides)
+		effective[i] = binding
+		if binding.Key == "" {
+			continue
+		}
+		for _, reserved := range reservedKeyChords {
+			if keyChordsCollide(binding, reserved.binding) {
+				errs = append(errs, fmt.Errorf("%s: %s is reserved (it %s)", def.action, formatKeyBinding(binding), reserved.reason))
+			}
+		}
+		if err := checkKeybindingModifiers(def, binding); err != nil {
+			errs = append(errs, err)
+		}
+		for j := 0; j < i; j++ {
+			other := keybindingRegistry[j]
+			if effective[j].Key == "" || !(def.global || other.global || def.category == other.category) {
+				continue
+			}
+			if keyChordsCollide(binding, effective[j]) {
+				errs = append(errs, fmt.Errorf("%s: %s is already bound to %s", def.action, formatKeyBinding(binding), other.action))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkKeybindingModifiers keeps global and destructive actions off plain
+// keys. Letters and digits need Cmd/Ctrl, Alt, or Meta because Shift alone
+// still fires while typing; destructive actions need one for every key.
+// Global actions may use Shift with punctuation and function keys alone.
+func checkKeybindingModifiers(def keybindingDefinition, binding KeyBinding) error {
+	if !def.global && !def.destructive {
+		return nil
+	}
+	if binding.Mod || binding.Ctrl || binding.Alt || binding.Meta {
+		return nil
+	}
+	key, _ := utf8.DecodeRuneInString(binding.Key)
+	switch {
+	case def.destructive:
+		return fmt.Errorf("%s: destructive actions need Cmd/Ctrl, Alt, or Meta", def.action)
+	case utf8.RuneCountInString(binding.Key) == 1 && (unicode.IsLetter(key) || unicode.IsDigit(key)):
+		return fmt.Errorf("%s: global actions on letters and digits need Cmd/Ctrl, Alt, or Meta", def.action)
+	case !binding.Shift && !strings.HasPrefix(binding.Key, "F"):
+		return fmt.Errorf("%s: global actions need a modifier", def.action)
+	}
+	return nil
+}
+
+type keyChord struct {
+	key                    string
+	ctrl, shift, alt, meta bool
+}
+
+// resolveKeyChord maps Mod to the platform primary modifier.
+func resolveKeyChord(binding KeyBinding, mac bool) keyChord {
+	chord := keyChord{key: binding.Key, ctrl: binding.Ctrl, shift: binding.Shift, alt: binding.Alt, meta: binding.Meta}
+	if binding.Mod {
+		if mac {
+			chord.meta = true
+		} else {
+			chord.ctrl = true
+		}
+	}
+	return chord
+}
+
+// keyChordsCollide reports whether two bindings fire on the same keys on
+// either macOS or other platforms.
+func keyChordsCollide(a, b KeyBinding) bool {
+	return resolveKeyChord(a, true) == resolveKeyChord(b, true) ||
+		resolveKeyChord(a, false) == resolveKeyChord(b, false)
+}
+
+// formatKeyBinding renders a binding for error messages, e.g. "Cmd/Ctrl+Shift+P".
+func formatKeyBinding(binding KeyBinding) string {
+	var parts []string
+	if binding.Mod {
+		parts = append(parts, "Cmd/Ctrl")
+	}
+	if binding.Ctrl {
+		parts = append(parts, "Ctrl")
+	}
+	if binding.Meta {
+		parts = append(parts, "Meta")
+	}
+	if binding.Alt {
+		parts = append(parts, "Alt")
+	}
+	if binding.Shift {
+		parts = append(parts, "Shift")
+	}
+	key := binding.Key
+	switch {
+	case key == " ":
+		key = "Space"
+	case utf8.RuneCountInString(key) == 1:
+		key = strings.ToUpper(key)
+	}
+	return strings.Join(append(parts, key), "+")
+}
@@ -0,0 +1,178 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func keybindingFor(t *testing.T, actions []KeyBindingAction, action string) KeyBindingAction {
+	t.Helper()
+	for _, entry := range actions {
+		if entry.Action == action {
+			return entry
+		}
+	}
+	t.Fatalf("keybinding action %q not found", action)
+	return KeyBindingAction{}
+}
+
+func TestKeybindingRegistryDefaultsAreValid(t *testing.T) {
+	require.NoError(t, validateKeybindings(map[string]KeyBinding{}))
+}
+
+func TestGetKeybindingsReturnsDefaults(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	actions, err := app.GetKeybindings()
+	require.NoError(t, err)
+	require.Len(t, actions, len(keybindingRegistry))
+	sidebar := keybindingFor(t, actions, "app.toggleSidebar")
+	require.Equal(t, KeyBinding{Action: "app.toggleSidebar", Key: "b", Mod: true}, sidebar.Binding)
+	require.False(t, sidebar.Customized)
+	require.True(t, sidebar.Global)
+}
+
+func TestSetKeybindingsPersistsOverrides(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	actions, err := app.SetKeybindings([]KeyBinding{
+		{Action: "app.toggleSidebar", Key: "\\", Mod: true},
+		{Action: "yaml.toggleManagedFields", Key: ""},
+	})
+	require.NoError(t, err)
+	sidebar := keybindingFor(t, actions, "app.toggleSidebar")
+	require.True(t, sidebar.Customized)
+	require.Equal(t, "\\", sidebar.Binding.Key)
+	require.Equal(t, "b", sidebar.Default.Key)
+	require.Empty(t, keybindingFor(t, actions, "yaml.toggleManagedFields").Binding.Key)
+
+	// Only the overrides are stored; setting a default back drops it.
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Len(t, file.Preferences.Keybindings, 2)
+
+	_, err = app.SetKeybindings([]KeyBinding{{Action: "app.toggleSidebar", Key: "B", Mod: true}})
+	require.NoError(t, err)
+	file, err = app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Equal(t, []KeyBinding{{Action: "yaml.toggleManagedFields"}}, file.Preferences.Keybindings)
+}
+
+func TestSetKeybindingsRejectsInvalidBindings(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	cases := map[string]struct {
+		bindings []KeyBinding
+		message  string
+	}{
+		"plain letter on global action": {
+			bindings: []KeyBinding{{Action: "app.toggleSidebar", Key: "b"}},
+			message:  "global actions on letters and digits need",
+		},
+		"shift letter on global action": {
+			bindings: []KeyBinding{{Action: "app.toggleSidebar", Key: "b", Shift: true}},
+			message:  "global actions on letters and digits need",
+		},
+		"destructive without modifier": {
+			bindings: []KeyBinding{{Action: "yaml.save", Key: "F2"}},
+			message:  "destructive actions need",
+		},
+		"reserved chord": {
+			bindings: []KeyBinding{{Action: "app.toggleSidebar", Key: "c", Meta: true}},
+			message:  "Meta+C is reserved (it copies)",
+		},
+		"conflict with global action": {
+			bindings: []KeyBinding{{Action: "yaml.toggleManagedFields", Key: "b", Ctrl: true}},
+			message:  "yaml.toggleManagedFields: Ctrl+B is already bound to app.toggleSidebar",
+		},
+		"unknown action": {
+			bindings: []KeyBinding{{Action: "app.launchRockets", Key: "l", Mod: true}},
+			message:  "unknown keybinding action",
+		},
+		"unsupported key": {
+			bindings: []KeyBinding{{Action: "app.toggleSidebar", Key: "Hyper", Mod: true}},
+			message:  "unsupported key",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := app.SetKeybindings(tc.bindings)
+			require.ErrorContains(t, err, tc.message)
+		})
+	}
+
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Empty(t, file.Preferences.Keybindings, "rejected batches are not persisted")
+}
+
+func TestKeybindingsViewActionsOnlyConflictWithinCategory(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	// Moving the sidebar toggle off Cmd/Ctrl+B frees it; a plain key is fine
+	// for a view-scoped action that shares no category with another binding.
+	_, err := app.SetKeybindings([]KeyBinding{
+		{Action: "app.toggleSidebar", Key: "ArrowDown", Alt: true},
+		{Action: "yaml.toggleManagedFields", Key: "b"},
+	})
+	require.NoError(t, err)
+
+	_, err = app.SetKeybindings([]KeyBinding{{Action: "yaml.toggleManagedFields", Key: "s", Mod: true}})
+	require.ErrorContains(t, err, "already bound to")
+}
+
+func TestResetKeybindings(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetKeybindings([]KeyBinding{
+		{Action: "app.toggleSidebar", Key: "\\", Mod: true},
+		{Action: "view.refresh", Key: "F5"},
+	})
+	require.NoError(t, err)
+
+	actions, err := app.ResetKeybindings([]string{"view.refresh"})
+	require.NoError(t, err)
+	require.False(t, keybindingFor(t, actions, "view.refresh").Customized)
+	require.True(t, keybindingFor(t, actions, "app.toggleSidebar").Customized)
+
+	actions, err = app.ResetKeybindings(nil)
+	require.NoError(t, err)
+	require.False(t, keybindingFor(t, actions, "app.toggleSidebar").Customized)
+
+	_, err = app.ResetKeybindings([]string{"nope"})
+	require.Error(t, err)
+}
+
+func TestExportImportKeybindingsRoundTrip(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetKeybindings([]KeyBinding{{Action: "app.toggleSidebar", Key: "\\", Mod: true}})
+	require.NoError(t, err)
+	exported, err := app.ExportKeybindings()
+	require.NoError(t, err)
+	require.Contains(t, exported, `"version": 1`)
+
+	_, err = app.ResetKeybindings(nil)
+	require.NoError(t, err)
+	_, err = app.SetKeybindings([]KeyBinding{{Action: "view.refresh", Key: "F5"}})
+	require.NoError(t, err)
+
+	actions, err := app.ImportKeybindings(exported)
+	require.NoError(t, err)
+	require.True(t, keybindingFor(t, actions, "app.toggleSidebar").Customized)
+	require.False(t, keybindingFor(t, actions, "view.refresh").Customized, "import replaces all overrides")
+
+	_, err = app.ImportKeybindings(`{"version": 1, "bindings": [{"action": "app.toggleSidebar", "key": "v", "mod": true}]}`)
+	require.ErrorContains(t, err, "reserved")
+	_, err = app.ImportKeybindings(`{"version": 9, "bindings": []}`)
+	require.ErrorContains(t, err, "newer")
+	_, err = app.ImportKeybindings(`not json`)
+	require.Error(t, err)
+}
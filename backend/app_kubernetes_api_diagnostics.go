@@ -3,12 +3,17 @@ package backend
 import (
 	"net/http"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
 const kubernetesAPIMetricsWindowSeconds = 60
 
+// kubernetesAPIThrottleThreshold is the client-side rate limiter wait above
+// which a request counts as throttled; shorter waits are token bucket noise.
+const kubernetesAPIThrottleThreshold = 10 * time.Millisecond
+
 // KubernetesAPIClientDiagnostics reports per-cluster Kubernetes API client usage.
 type KubernetesAPIClientDiagnostics struct {
 	ClusterID       string  `json:"clusterId"`
@@ -27,6 +32,29 @@ type KubernetesAPIClientDiagnostics struct {
 	Status429       int64   `json:"status429"`
 	Errors          int64   `json:"errors"`
 	LastRequestMs   int64   `json:"lastRequestMs,omitempty"`
+	// ThrottledRequests counts requests the client-side rate limiter held
+	// back; ThrottleWaitMs is their total wait.
+	ThrottledRequests int64 `json:"throttledRequests"`
+	ThrottleWaitMs    int64 `json:"throttleWaitMs"`
+	MaxThrottleWaitMs int64 `json:"maxThrottleWaitMs"`
+	LastThrottledMs   int64 `json:"lastThrottledMs,omitempty"`
+	// Operations breaks requests down by verb and resource, busiest first.
+	Operations []KubernetesAPIOperationStats `json:"operations"`
+}
+
+// KubernetesAPIOperationStats is one verb and resource's request count and
+// latency. Resource carries the subresource as "pods/log"; it is empty for
+// discovery and holds the path (for example "/version") for other
+// non-resource requests. Latency runs until response headers, so a watch
+// counts the time to establish it.
+type KubernetesAPIOperationStats struct {
+	Verb         string  `json:"verb"`
+	Group        string  `json:"group"`
+	Resource     string  `json:"resource"`
+	Requests     int64   `json:"requests"`
+	Errors       int64   `json:"errors"`
+	AvgLatencyMs float64 `json:"avgLatencyMs"`
+	MaxLatencyMs float64 `json:"maxLatencyMs"`
 }
 
 type kubernetesAPIMetricsRegistry struct {
@@ -50,6 +78,25 @@ type kubernetesAPIMetrics struct {
 	status429       int64
 	errors          int64
 	lastRequestMs   int64
+	throttled       int64
+	throttleWait    time.Duration
+	maxThrottleWait time.Duration
+	lastThrottledMs int64
+	operations      map[kubernetesAPIOperation]*kubernetesAPIOperationMetrics
+}
+
+// kubernetesAPIOperation identifies a request by verb and resource.
+type kubernetesAPIOperation struct {
+	verb     string
+	group    string
+	resource string
+}
+
+type kubernetesAPIOperationMetrics struct {
+	requests     int64
+	errors       int64
+	totalLatency time.Duration
+	maxLatency   time.Duration
 }
 
 type kubernetesAPIMetricsBucket struct {
@@ -121,25 +168,61 @@ func (m *kubernetesAPIMetrics) snapshot(now time.Time) KubernetesAPIClientDiagno
 	defer m.mu.Unlock()
 	nowSecond := now.Unix()
 	return KubernetesAPIClientDiagnostics{
-		ClusterID:       m.clusterID,
-		ClusterName:     m.clusterName,
-		ConfiguredQPS:   m.configuredQPS,
-		ConfiguredBurst: m.configuredBurst,
-		QPS1s:           float64(m.countWindowLocked(nowSecond, 1)),
-		QPS10s:          float64(m.countWindowLocked(nowSecond, 10)) / 10,
-		QPS60s:          float64(m.countWindowLocked(nowSecond, 60)) / 60,
-		PeakQPS1s:       int(m.peakQPS1s),
-		TotalRequests:   m.totalRequests,
-		Status2xx:       m.status2xx,
-		Status3xx:       m.status3xx,
-		Status4xx:       m.status4xx,
-		Status5xx:       m.status5xx,
-		Status429:       m.status429,
-		Errors:          m.errors,
-		LastRequestMs:   m.lastRequestMs,
+		ClusterID:         m.clusterID,
+		ClusterName:       m.clusterName,
+		ConfiguredQPS:     m.configuredQPS,
+		ConfiguredBurst:   m.configuredBurst,
+		QPS1s:             float64(m.countWindowLocked(nowSecond, 1)),
+		QPS10s:            float64(m.countWindowLocked(nowSecond, 10)) / 10,
+		QPS60s:            float64(m.countWindowLocked(nowSecond, 60)) / 60,
+		PeakQPS1s:         int(m.peakQPS1s),
+		TotalRequests:     m.totalRequests,
+		Status2xx:         m.status2xx,
+		Status3xx:         m.status3xx,
+		Status4xx:         m.status4xx,
+		Status5xx:         m.status5xx,
+		Status429:         m.status429,
+		Errors:            m.errors,
+		LastRequestMs:     m.lastRequestMs,
+		ThrottledRequests: m.throttled,
+		ThrottleWaitMs:    m.throttleWait.Milliseconds(),
+		MaxThrottleWaitMs: m.maxThrottleWait.Milliseconds(),
+		LastThrottledMs:   m.lastThrottledMs,
+		Operations:        m.operationsSnapshotLocked(),
 	}
 }
 
+func (m *kubernetesAPIMetrics) operationsSnapshotLocked() []KubernetesAPIOperationStats {
+	rows := make([]KubernetesAPIOperationStats, 0, len(m.operations))
+	for op, stats := range m.operations {
+		row := KubernetesAPIOperationStats{
+			Verb:         op.verb,
+			Group:        op.group,
+			Resource:     op.resource,
+			Requests:     stats.requests,
+			Errors:       stats.errors,
+			MaxLatencyMs: float64(stats.maxLatency.Microseconds()) / 1000,
+		}
+		if stats.requests > 0 {
+			row.AvgLatencyMs = float64(stats.totalLatency.Microseconds()) / 1000 / float64(stats.requests)
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Requests != rows[j].Requests {
+			return rows[i].Requests > rows[j].Requests
+		}
+		if rows[i].Resource != rows[j].Resource {
+			return rows[i].Resource < rows[j].Resource
+		}
+		if rows[i].Group != rows[j].Group {
+			return rows[i].Group < rows[j].Group
+		}
+		return rows[i].Verb < rows[j].Verb
+	})
+	return rows
+}
+
 func (m *kubernetesAPIMetrics) countWindowLocked(nowSecond int64, seconds int64) int64 {
 	var total int64
 	oldest := nowSecond - seconds + 1
@@ -190,22 +273,133 @@ func (m *kubernetesAPIMetrics) record(statusCode int, requestTime time.Time) {
 	}
 }
 
+// recordOperation adds one request's outcome to its verb and resource.
+// Transport errors and 4xx/5xx responses count as errors.
+func (m *kubernetesAPIMetrics) recordOperation(op kubernetesAPIOperation, statusCode int, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.operations == nil {
+		m.operations = make(map[kubernetesAPIOperation]*kubernetesAPIOperationMetrics)
+	}
+	stats := m.operations[op]
+	if stats == nil {
+		stats = &kubernetesAPIOperationMetrics{}
+		m.operations[op] = stats
+	}
+	stats.requests++
+	if statusCode == 0 || statusCode >= 400 {
+		stats.errors++
+	}
+	stats.totalLatency += latency
+	if latency > stats.maxLatency {
+		stats.maxLatency = latency
+	}
+}
+
+// recordThrottle notes how long the client-side rate limiter held a request.
+func (m *kubernetesAPIMetrics) recordThrottle(wait time.Duration, now time.Time) {
+	if m == nil || wait < kubernetesAPIThrottleThreshold {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.throttled++
+	m.throttleWait += wait
+	if wait > m.maxThrottleWait {
+		m.maxThrottleWait = wait
+	}
+	m.lastThrottledMs = now.UnixMilli()
+}
+
 func (t *kubernetesAPIMetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	base := t.base
 	if base == nil {
 		base = http.DefaultTransport
 	}
+	start := time.Now()
 	resp, err := base.RoundTrip(req)
 	statusCode := 0
 	if resp != nil {
 		statusCode = resp.StatusCode
 	}
 	if t.metrics != nil {
-		t.metrics.record(statusCode, time.Now())
+		now := time.Now()
+		t.metrics.record(statusCode, now)
+		t.metrics.recordOperation(kubernetesAPIOperationFor(req), statusCode, now.Sub(start))
 	}
 	return resp, err
 }
 
+// kubernetesAPIOperationFor derives the verb and resource from a request the
+// way the API server's request info does.
+func kubernetesAPIOperationFor(req *http.Request) kubernetesAPIOperation {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	var op kubernetesAPIOperation
+	var rest []string
+	switch {
+	case parts[0] == "api" && len(parts) > 2:
+		rest = parts[2:]
+	case parts[0] == "apis" && len(parts) > 3:
+		op.group = parts[1]
+		rest = parts[3:]
+	case parts[0] == "api" || parts[0] == "apis":
+		// Discovery.
+		if parts[0] == "apis" && len(parts) > 1 {
+			op.group = parts[1]
+		}
+		op.verb = strings.ToLower(req.Method)
+		return op
+	default:
+		op.verb = strings.ToLower(req.Method)
+		op.resource = "/" + parts[0]
+		return op
+	}
+
+	// namespaces/{ns}/{resource}/... unless it is the namespace's own
+	// status or finalize subresource.
+	if rest[0] == "namespaces" && len(rest) > 2 && rest[2] != "status" && rest[2] != "finalize" {
+		rest = rest[2:]
+	}
+	op.resource = rest[0]
+	name := ""
+	if len(rest) > 1 {
+		name = rest[1]
+	}
+	if len(rest) > 2 {
+		op.resource += "/" + rest[2]
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		switch {
+		case name != "":
+			op.verb = "get"
+		case req.URL.Query().Get("watch") == "true" || req.URL.Query().Get("watch") == "1":
+			op.verb = "watch"
+		default:
+			op.verb = "list"
+		}
+	case http.MethodPost:
+		op.verb = "create"
+	case http.MethodPut:
+		op.verb = "update"
+	case http.MethodPatch:
+		op.verb = "patch"
+	case http.MethodDelete:
+		if name == "" {
+			op.verb = "deletecollection"
+		} else {
+			op.verb = "delete"
+		}
+	default:
+		op.verb = strings.ToLower(req.Method)
+	}
+	return op
+}
+
 func (a *App) ensureKubernetesAPIMetricsRegistry() *kubernetesAPIMetricsRegistry {
 	if a == nil {
 		return nil
@@ -1,8 +1,11 @@
 package backend
 
 import (
+	"context"
 	"math"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -53,3 +56,105 @@ func TestKubernetesAPIMetricsRegistryRemovesCluster(t *testing.T) {
 		t.Fatalf("expected removed cluster to be absent, got %#v", rows)
 	}
 }
+
+func TestKubernetesAPIOperationFor(t *testing.T) {
+	cases := []struct {
+		method string
+		url    string
+		want   kubernetesAPIOperation
+	}{
+		{http.MethodGet, "/api/v1/namespaces/default/pods", kubernetesAPIOperation{verb: "list", resource: "pods"}},
+		{http.MethodGet, "/api/v1/namespaces/default/pods?watch=true&resourceVersion=1", kubernetesAPIOperation{verb: "watch", resource: "pods"}},
+		{http.MethodGet, "/api/v1/namespaces/default/pods/web-0/log", kubernetesAPIOperation{verb: "get", resource: "pods/log"}},
+		{http.MethodGet, "/api/v1/namespaces/default", kubernetesAPIOperation{verb: "get", resource: "namespaces"}},
+		{http.MethodPut, "/api/v1/namespaces/default/finalize", kubernetesAPIOperation{verb: "update", resource: "namespaces/finalize"}},
+		{http.MethodGet, "/api/v1/nodes", kubernetesAPIOperation{verb: "list", resource: "nodes"}},
+		{http.MethodPatch, "/apis/apps/v1/namespaces/default/deployments/web/scale", kubernetesAPIOperation{verb: "patch", group: "apps", resource: "deployments/scale"}},
+		{http.MethodPost, "/apis/batch/v1/namespaces/default/jobs", kubernetesAPIOperation{verb: "create", group: "batch", resource: "jobs"}},
+		{http.MethodDelete, "/apis/batch/v1/namespaces/default/jobs", kubernetesAPIOperation{verb: "deletecollection", group: "batch", resource: "jobs"}},
+		{http.MethodDelete, "/apis/batch/v1/namespaces/default/jobs/nightly", kubernetesAPIOperation{verb: "delete", group: "batch", resource: "jobs"}},
+		{http.MethodGet, "/apis/apps/v1", kubernetesAPIOperation{verb: "get", group: "apps"}},
+		{http.MethodGet, "/api", kubernetesAPIOperation{verb: "get"}},
+		{http.MethodGet, "/version", kubernetesAPIOperation{verb: "get", resource: "/version"}},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, "https://cluster.example"+tc.url, nil)
+		if got := kubernetesAPIOperationFor(req); got != tc.want {
+			t.Errorf("%s %s: got %#v, want %#v", tc.method, tc.url, got, tc.want)
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestKubernetesAPIMetricsTransportRecordsOperations(t *testing.T) {
+	registry := newKubernetesAPIMetricsRegistry()
+	metrics := registry.getOrCreate(ClusterMeta{ID: "cluster-a", Name: "Prod"}, 500, 1000)
+	transport := &kubernetesAPIMetricsTransport{
+		base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/missing") {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}),
+		metrics: metrics,
+	}
+	for _, path := range []string{"/api/v1/pods", "/api/v1/pods", "/api/v1/namespaces/default/pods/missing"} {
+		if _, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "https://cluster.example"+path, nil)); err != nil {
+			t.Fatalf("round trip failed: %v", err)
+		}
+	}
+
+	rows := registry.snapshot(time.Now())
+	operations := rows[0].Operations
+	if len(operations) != 2 {
+		t.Fatalf("expected 2 operations, got %#v", operations)
+	}
+	if operations[0].Verb != "list" || operations[0].Resource != "pods" || operations[0].Requests != 2 || operations[0].Errors != 0 {
+		t.Fatalf("unexpected busiest operation: %#v", operations[0])
+	}
+	if operations[1].Verb != "get" || operations[1].Requests != 1 || operations[1].Errors != 1 {
+		t.Fatalf("unexpected failing operation: %#v", operations[1])
+	}
+	if operations[0].MaxLatencyMs < operations[0].AvgLatencyMs {
+		t.Fatalf("max latency below average: %#v", operations[0])
+	}
+}
+
+func TestKubernetesAPIMetricsRecordsThrottleWaits(t *testing.T) {
+	registry := newKubernetesAPIMetricsRegistry()
+	metrics := registry.getOrCreate(ClusterMeta{ID: "cluster-a", Name: "Prod"}, 500, 1000)
+	now := time.Unix(1_700_000_000, 0)
+
+	metrics.recordThrottle(time.Millisecond, now)
+	metrics.recordThrottle(250*time.Millisecond, now)
+	metrics.recordThrottle(1500*time.Millisecond, now.Add(time.Second))
+
+	row := registry.snapshot(now)[0]
+	if row.ThrottledRequests != 2 || row.ThrottleWaitMs != 1750 || row.MaxThrottleWaitMs != 1500 {
+		t.Fatalf("unexpected throttle counters: %#v", row)
+	}
+	if row.LastThrottledMs != now.Add(time.Second).UnixMilli() {
+		t.Fatalf("unexpected last throttled timestamp: %d", row.LastThrottledMs)
+	}
+}
+
+func TestMutableKubernetesRateLimiterReportsWaits(t *testing.T) {
+	registry := newKubernetesAPIMetricsRegistry()
+	metrics := registry.getOrCreate(ClusterMeta{ID: "cluster-a", Name: "Prod"}, 20, 1)
+	limiter := newMutableKubernetesRateLimiter(20, 1)
+	limiter.metrics = metrics
+
+	// Spend the single burst token so the next Wait queues for ~50ms.
+	limiter.Accept()
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("wait failed: %v", err)
+	}
+
+	row := registry.snapshot(time.Now())[0]
+	if row.ThrottledRequests != 1 || row.ThrottleWaitMs < int64(kubernetesAPIThrottleThreshold/time.Millisecond) {
+		t.Fatalf("expected the queued wait to count as throttled: %#v", row)
+	}
+}
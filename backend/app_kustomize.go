@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"github.com/luxury-yacht/app/backend/kustomizebuild"
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+// Kustomize deploys. A local kustomization directory is built with the
+// kustomize Go API; the rendered manifests can be shown, previewed as a
+// dry-run apply with a diff per object, and server-side applied.
+
+// KustomizeBuildResult is the rendered output of a kustomization.
+type KustomizeBuildResult struct {
+	Dir string `json:"dir"`
+	// Manifests is the multi-document YAML "kustomize build" would print.
+	Manifests string `json:"manifests"`
+	Objects   int    `json:"objects"`
+}
+
+// SelectKustomizationDirectory asks for a kustomization directory.
+func (a *App) SelectKustomizationDirectory() (string, error) {
+	if a == nil || a.Ctx == nil {
+		return "", fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeOpenDirDialog(a.Ctx, wailsruntime.OpenDialogOptions{Title: "Select Kustomization Directory"})
+	if err != nil {
+		return "", fmt.Errorf("select kustomization directory: %w", err)
+	}
+	return strings.TrimSpace(path), nil
+}
+
+// BuildKustomization renders the kustomization in dir without touching a
+// cluster.
+func (a *App) BuildKustomization(dir string) (*KustomizeBuildResult, error) {
+	objects, manifests, err := kustomizebuild.Build(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &KustomizeBuildResult{Dir: strings.TrimSpace(dir), Manifests: manifests, Objects: len(objects)}, nil
+}
+
+// PreviewKustomization builds dir and dry-run applies it, returning what each
+// object would become and a diff against its live state.
+func (a *App) PreviewKustomization(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	opts.DryRun = true
+	return a.applyKustomization(clusterID, dir, opts)
+}
+
+// ApplyKustomization builds dir and server-side applies the result.
+func (a *App) ApplyKustomization(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	opts.DryRun = false
+	return a.applyKustomization(clusterID, dir, opts)
+}
+
+func (a *App) applyKustomization(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	objects, _, err := kustomizebuild.Build(dir)
+	if err != nil {
+		return nil, err
+	}
+	manifests := make([]manifestapply.Manifest, 0, len(objects))
+	for _, obj := range objects {
+		manifests = append(manifests, manifestapply.Manifest{Object: obj})
+	}
+	return a.applyManifests(clusterID, "Kustomize apply", manifests, opts)
+}
@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+func TestKustomizationBuildAndApplyGuards(t *testing.T) {
+	const clusterID = "kustomize"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+	})
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte("configMapGenerator:\n- name: settings\n  literals:\n  - mode=fast\n"), 0o600))
+
+	build, err := app.BuildKustomization(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, build.Objects)
+	require.Contains(t, build.Manifests, "kind: ConfigMap")
+
+	_, err = app.PreviewKustomization("", dir, manifestapply.Options{})
+	require.ErrorContains(t, err, "cluster ID is required")
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.ApplyKustomization(clusterID, dir, manifestapply.Options{})
+	require.ErrorIs(t, err, errClusterReadOnly)
+	_, err = app.ApplyKustomization(clusterID, filepath.Join(dir, "missing"), manifestapply.Options{})
+	require.Error(t, err)
+}
@@ -25,11 +25,18 @@ var (
 	runtimeEventsEmit     = runtime.EventsEmit
 	runtimeMessageDialog  = runtime.MessageDialog
 	runtimeSaveFileDialog = runtime.SaveFileDialog
+	runtimeOpenFileDialog = runtime.OpenFileDialog
+	runtimeOpenDirDialog  = runtime.OpenDirectoryDialog
 	runtimeQuit           = runtime.Quit
 	runtimeWindowSetSize  = runtime.WindowSetSize
 	runtimeWindowSetPos   = runtime.WindowSetPosition
 	runtimeWindowMaximise = runtime.WindowMaximise
 	runtimeWindowShow     = runtime.WindowShow
+
+	runtimeInitializeNotifications = runtime.InitializeNotifications
+	runtimeRequestNotificationAuth = runtime.RequestNotificationAuthorization
+	runtimeSendNotification        = runtime.SendNotification
+	runtimeCleanupNotifications    = runtime.CleanupNotifications
 )
 
 const beforeCloseSelectionFlushTimeout = 2 * time.Second
@@ -118,6 +125,11 @@ func (a *App) Startup(ctx context.Context) {
 	a.setupEnvironment()
 	a.logger.Debug("Environment setup completed", logsources.App)
 
+	// Pick up settings synced from another machine before anything reads them.
+	if _, err := a.pullSettingsSync(false); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to import synced settings: %v", err), logsources.Settings)
+	}
+
 	if settings, err := a.LoadWindowSettings(); err != nil {
 		a.logger.Warn(fmt.Sprintf("Failed to load window settings: %v", err), logsources.App)
 	} else if settings != nil {
@@ -132,7 +144,11 @@ func (a *App) Startup(ctx context.Context) {
 		}
 	}
 
-	runtimeWindowShow(ctx)
+	if a.headless {
+		a.logger.Info("Running headless; the window stays hidden", logsources.App)
+	} else {
+		runtimeWindowShow(ctx)
+	}
 	a.logger.Info("Luxury Yacht - Sail the Seas of Kubernetes In Style", logsources.App)
 
 	a.logger.Info("Discovering kubeconfig files...", logsources.App)
@@ -161,6 +177,9 @@ func (a *App) Startup(ctx context.Context) {
 		a.logger.Warn(fmt.Sprintf("Kubeconfig directory watcher not available: %v", err), logsources.App)
 	}
 
+	// Serve the local API once the startup clusters have catalogs to answer from.
+	a.startLocalAPIFromSettings()
+
 	// Per-cluster heartbeat runs via startHeartbeatLoop, launched by setupRefreshSubsystem.
 	// Run update checks in the background so the UI can surface them on startup.
 	a.startUpdateCheck()
@@ -243,8 +262,20 @@ func (a *App) Shutdown(ctx context.Context) {
 	// Stop the kubeconfig directory watcher before tearing down cluster state.
 	a.stopKubeconfigWatcher()
 
+	// Write any settings change still waiting on the sync debounce.
+	a.stopSettingsSync()
+
+	a.stopLocalAPI()
+	a.stopExternalEdits()
 	a.teardownRefreshSubsystem()
 
+	if a.notificationsStarted.Load() {
+		runtimeCleanupNotifications(ctx)
+	}
+
+	// A downloaded update installs once this process has exited.
+	a.installStagedUpdateOnExit()
+
 	a.logger.Info("Application shutdown completed", logsources.App)
 }
 
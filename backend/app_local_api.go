@@ -0,0 +1,490 @@
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+)
+
+// Local API. An optional read-only HTTP API on 127.0.0.1 that lets scripts
+// and other tools reuse the app's object catalogs and refresh snapshots
+// instead of listing the same objects from the API server again. Every
+// request needs "Authorization: Bearer <token>", where the token is kept in
+// local-api-token next to settings.json (mode 0600). The API is off unless
+// enabled in settings or the app is started with --headless. With metrics
+// turned on it also serves the app's own telemetry at /metrics in the
+// Prometheus text format.
+
+// HeadlessFlag starts the app with its window hidden and the local API on.
+const HeadlessFlag = "--headless"
+
+// localAPIPrefix is the path prefix of every local API route.
+const localAPIPrefix = "/api/local/v1"
+
+const localAPITokenFileName = "local-api-token"
+
+// IsHeadlessLaunch reports whether args ask for headless mode.
+func IsHeadlessLaunch(args []string) bool {
+	for _, arg := range args {
+		if arg == HeadlessFlag {
+			return true
+		}
+	}
+	return false
+}
+
+// EnableHeadless marks app as headless: the window stays hidden and the
+// local API starts with the app whatever the settings say.
+func EnableHeadless(app *App) {
+	app.headless = true
+}
+
+// settingsLocalAPI captures the persisted local API configuration. It is
+// machine-local and is not exported or synced.
+type settingsLocalAPI struct {
+	Enabled bool `json:"enabled"`
+	Port    int  `json:"port,omitempty"`
+	// Metrics serves /metrics while the local API runs.
+	Metrics bool `json:"metrics,omitempty"`
+}
+
+// LocalAPIStatus reports the local API configuration and server state.
+type LocalAPIStatus struct {
+	Enabled  bool   `json:"enabled"`
+	Headless bool   `json:"headless"`
+	Running  bool   `json:"running"`
+	Port     int    `json:"port"`
+	URL      string `json:"url,omitempty"`
+	Metrics  bool   `json:"metrics"`
+	// MetricsURL is set while /metrics is being served.
+	MetricsURL string `json:"metricsUrl,omitempty"`
+	TokenPath  string `json:"tokenPath"`
+	Error      string `json:"error,omitempty"`
+}
+
+// localAPIState holds the running local API server.
+type localAPIState struct {
+	mu      sync.Mutex
+	server  *http.Server
+	url     string
+	token   string
+	lastErr string
+	metrics bool
+}
+
+// localAPICluster is one entry of the /clusters route.
+type localAPICluster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// localAPICatalogPage is one page of the /catalog route.
+type localAPICatalogPage struct {
+	Items    []objectcatalog.Summary `json:"items"`
+	Continue string                  `json:"continue,omitempty"`
+	Total    int                     `json:"total"`
+}
+
+// GetLocalAPIStatus reports whether the local API is enabled and running.
+func (a *App) GetLocalAPIStatus() (LocalAPIStatus, error) {
+	settings, err := a.localAPISettings()
+	if err != nil {
+		return LocalAPIStatus{}, err
+	}
+	return a.localAPIStatus(settings), nil
+}
+
+// SetLocalAPIEnabled turns the local API on or off and persists the choice.
+// A port of 0 keeps the configured port.
+func (a *App) SetLocalAPIEnabled(enabled bool, port int) (LocalAPIStatus, error) {
+	if port < 0 || port > 65535 {
+		return LocalAPIStatus{}, fmt.Errorf("port %d is out of range", port)
+	}
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return LocalAPIStatus{}, err
+	}
+	local := settingsLocalAPI{}
+	if settings.LocalAPI != nil {
+		local = *settings.LocalAPI
+	}
+	local.Enabled = enabled
+	if port != 0 {
+		local.Port = port
+	}
+	settings.LocalAPI = &local
+	err = a.saveSettingsFile(settings)
+	a.settingsMu.Unlock()
+	if err != nil {
+		return LocalAPIStatus{}, err
+	}
+
+	a.stopLocalAPI()
+	a.setLocalAPIMetrics(local.Metrics)
+	if enabled || a.headless {
+		err = a.startLocalAPI(localAPIPort(local))
+	}
+	return a.localAPIStatus(local), err
+}
+
+// RegenerateLocalAPIToken replaces the local API token. Clients holding the
+// old token are refused from then on.
+func (a *App) RegenerateLocalAPIToken() (LocalAPIStatus, error) {
+	token, err := writeLocalAPIToken()
+	if err != nil {
+		return LocalAPIStatus{}, err
+	}
+	a.localAPI.mu.Lock()
+	if a.localAPI.server != nil {
+		a.localAPI.token = token
+	}
+	a.localAPI.mu.Unlock()
+	a.logger.Info("Local API token regenerated", logsources.App)
+	return a.GetLocalAPIStatus()
+}
+
+// startLocalAPIFromSettings starts the local API at startup when it is
+// enabled or the app runs headless.
+func (a *App) startLocalAPIFromSettings() {
+	settings, err := a.localAPISettings()
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to read local API settings: %v", err), logsources.App)
+		return
+	}
+	a.setLocalAPIMetrics(settings.Metrics)
+	if !settings.Enabled && !a.headless {
+		return
+	}
+	if err := a.startLocalAPI(localAPIPort(settings)); err != nil {
+		a.logger.Error(fmt.Sprintf("Failed to start local API: %v", err), logsources.App)
+	}
+}
+
+func (a *App) localAPISettings() (settingsLocalAPI, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil || settings.LocalAPI == nil {
+		return settingsLocalAPI{}, err
+	}
+	return *settings.LocalAPI, nil
+}
+
+func (a *App) localAPIStatus(settings settingsLocalAPI) LocalAPIStatus {
+	tokenPath, _ := localAPITokenPath()
+	a.localAPI.mu.Lock()
+	defer a.localAPI.mu.Unlock()
+	status := LocalAPIStatus{
+		Enabled:   settings.Enabled,
+		Headless:  a.headless,
+		Running:   a.localAPI.server != nil,
+		Port:      localAPIPort(settings),
+		URL:       a.localAPI.url,
+		Metrics:   settings.Metrics,
+		TokenPath: tokenPath,
+		Error:     a.localAPI.lastErr,
+	}
+	if status.Running && a.localAPI.metrics {
+		status.MetricsURL = a.localAPI.metricsURL()
+	}
+	return status
+}
+
+func localAPIPort(settings settingsLocalAPI) int {
+	if settings.Port > 0 {
+		return settings.Port
+	}
+	return config.LocalAPIDefaultPort
+}
+
+// startLocalAPI listens on 127.0.0.1:port and serves the local API.
+func (a *App) startLocalAPI(port int) error {
+	token, err := loadLocalAPIToken()
+	if err != nil {
+		return a.recordLocalAPIError(err)
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		return a.recordLocalAPIError(fmt.Errorf("listen on port %d: %w", port, err))
+	}
+	srv := &http.Server{
+		Handler:           http.TimeoutHandler(a.localAPIHandler(), config.LocalAPIRequestTimeout, "request timed out"),
+		ReadHeaderTimeout: config.LocalAPIRequestTimeout,
+	}
+
+	a.localAPI.mu.Lock()
+	a.localAPI.server = srv
+	a.localAPI.token = token
+	a.localAPI.url = "http://" + listener.Addr().String() + localAPIPrefix
+	a.localAPI.lastErr = ""
+	a.localAPI.mu.Unlock()
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.logger.Error(fmt.Sprintf("Local API server stopped: %v", err), logsources.App)
+		}
+	}()
+	a.logger.Info(fmt.Sprintf("Local API listening on %s", listener.Addr()), logsources.App)
+	return nil
+}
+
+func (a *App) recordLocalAPIError(err error) error {
+	a.localAPI.mu.Lock()
+	a.localAPI.lastErr = err.Error()
+	a.localAPI.mu.Unlock()
+	return err
+}
+
+// stopLocalAPI shuts the local API server down if it is running.
+func (a *App) stopLocalAPI() {
+	a.localAPI.mu.Lock()
+	srv := a.localAPI.server
+	a.localAPI.server = nil
+	a.localAPI.url = ""
+	a.localAPI.token = ""
+	a.localAPI.mu.Unlock()
+	if srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.LocalAPIShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to shut down local API: %v", err), logsources.App)
+	}
+}
+
+// localAPIHandler routes the local API. Every route is GET only and needs
+// the bearer token.
+func (a *App) localAPIHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(localAPIPrefix+"/clusters", a.handleLocalAPIClusters)
+	mux.HandleFunc(localAPIPrefix+"/search", a.handleLocalAPISearch)
+	mux.HandleFunc(localAPIPrefix+"/catalog", a.handleLocalAPICatalog)
+	mux.HandleFunc(localAPIPrefix+"/snapshots/", a.handleLocalAPISnapshot)
+	mux.HandleFunc(localAPIPrefix+"/objects/yaml", a.handleLocalAPIObjectYAML)
+	mux.HandleFunc(localAPIPrefix+"/caches", a.handleLocalAPICaches)
+	mux.HandleFunc(localAPIMetricsPath, a.handleLocalAPIMetrics)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.localAPIAuthorized(r) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeLocalAPIError(w, http.StatusUnauthorized, errors.New("missing or invalid token"))
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeLocalAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (a *App) localAPIAuthorized(r *http.Request) bool {
+	a.localAPI.mu.Lock()
+	token := a.localAPI.token
+	a.localAPI.mu.Unlock()
+	presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(presented)), []byte(token)) == 1
+}
+
+// handleLocalAPIClusters lists the clusters with an object catalog.
+func (a *App) handleLocalAPIClusters(w http.ResponseWriter, _ *http.Request) {
+	clusters := []localAPICluster{}
+	for _, entry := range a.snapshotObjectCatalogEntries() {
+		if entry != nil {
+			clusters = append(clusters, localAPICluster{ID: entry.meta.ID, Name: entry.meta.Name})
+		}
+	}
+	writeLocalAPIJSON(w, clusters)
+}
+
+// handleLocalAPISearch runs SearchObjects across every open cluster.
+func (a *App) handleLocalAPISearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, err := localAPILimit(query.Get("limit"))
+	if err != nil {
+		writeLocalAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	results, err := a.SearchObjects(query.Get("q"), limit)
+	if err != nil {
+		writeLocalAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeLocalAPIJSON(w, results)
+}
+
+// handleLocalAPICatalog pages through one cluster's object catalog. kind,
+// namespace and group may repeat.
+func (a *App) handleLocalAPICatalog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clusterID := strings.TrimSpace(query.Get("clusterId"))
+	if clusterID == "" {
+		writeLocalAPIError(w, http.StatusBadRequest, errors.New("clusterId is required"))
+		return
+	}
+	limit, err := localAPILimit(query.Get("limit"))
+	if err != nil {
+		writeLocalAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	service := a.objectCatalogServiceForCluster(clusterID)
+	if service == nil {
+		writeLocalAPIError(w, http.StatusNotFound, fmt.Errorf("no object catalog for cluster %s", clusterID))
+		return
+	}
+	if limit == 0 {
+		limit = config.ObjectSearchDefaultLimit
+	}
+	result := service.Query(objectcatalog.QueryOptions{
+		Kinds:      query["kind"],
+		Namespaces: query["namespace"],
+		Groups:     query["group"],
+		Search:     query.Get("search"),
+		Limit:      min(limit, config.ObjectSearchMaxLimit),
+		Continue:   query.Get("continue"),
+	})
+	if result.CursorInvalid {
+		writeLocalAPIError(w, http.StatusBadRequest, errors.New("continue token is invalid"))
+		return
+	}
+	items := result.Items
+	if items == nil {
+		items = []objectcatalog.Summary{}
+	}
+	writeLocalAPIJSON(w, localAPICatalogPage{
+		Items:    items,
+		Continue: result.ContinueToken,
+		Total:    result.TotalItems,
+	})
+}
+
+// handleLocalAPISnapshot serves a refresh snapshot by handing the request to
+// the refresh API in process, so callers get the same cached snapshots,
+// ETags and scope rules as the UI.
+func (a *App) handleLocalAPISnapshot(w http.ResponseWriter, r *http.Request) {
+	server := a.refreshHTTPServer
+	if server == nil || server.Handler == nil {
+		writeLocalAPIError(w, http.StatusServiceUnavailable, errors.New("refresh subsystem not initialised"))
+		return
+	}
+	forwarded := r.Clone(r.Context())
+	forwarded.URL.Path = "/api/v2/snapshots/" + strings.TrimPrefix(r.URL.Path, localAPIPrefix+"/snapshots/")
+	forwarded.URL.RawPath = ""
+	forwarded.RequestURI = forwarded.URL.RequestURI()
+	forwarded.Header.Del("Origin")
+	server.Handler.ServeHTTP(w, forwarded)
+}
+
+// handleLocalAPIObjectYAML returns one object as YAML. Unlike the other
+// routes this reads the object from the API server. A Secret is rendered with
+// its data and stringData values withheld, like in the YAML tab: the local
+// API never serves decoded or encoded Secret values.
+func (a *App) handleLocalAPIObjectYAML(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	yaml, err := a.GetObjectYAMLByGVK(
+		query.Get("clusterId"),
+		query.Get("apiVersion"),
+		query.Get("kind"),
+		query.Get("namespace"),
+		query.Get("name"),
+	)
+	if err != nil {
+		writeLocalAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write([]byte(yaml))
+}
+
+func localAPILimit(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid limit %q", value)
+	}
+	return limit, nil
+}
+
+func writeLocalAPIJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+func writeLocalAPIError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// localAPITokenPath returns the token file path next to settings.json.
+func localAPITokenPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find config directory: %w", err)
+	}
+	return filepath.Join(configDir, "luxury-yacht", localAPITokenFileName), nil
+}
+
+// loadLocalAPIToken reads the token, creating one on first use.
+func loadLocalAPIToken() (string, error) {
+	path, err := localAPITokenPath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err == nil {
+		if token := strings.TrimSpace(string(data)); token != "" {
+			return token, nil
+		}
+	} else if !os.IsNotExist(err) {
+		return "", fmt.Errorf("read local API token: %w", err)
+	}
+	return writeLocalAPIToken()
+}
+
+// writeLocalAPIToken stores a new random token readable only by the user.
+func writeLocalAPIToken() (string, error) {
+	path, err := localAPITokenPath()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %w", err)
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("write local API token: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Chmod(path, 0o600); err != nil {
+		return "", err
+	}
+	return token, nil
+}
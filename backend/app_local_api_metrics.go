@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+)
+
+// Local API metrics. /metrics exposes the refresh telemetry counters (stream
+// deliveries and backpressure resets, snapshot builds, catalog syncs, API
+// retries), informer watch errors and the process's memory use in the
+// Prometheus text format, so slowness can be graphed over time. Like every
+// local API route it needs the bearer token; point Prometheus at the token
+// file with authorization.credentials_file.
+
+// localAPIMetricsPath is served at the root, where Prometheus looks by
+// default, rather than under localAPIPrefix.
+const localAPIMetricsPath = "/metrics"
+
+// SetLocalAPIMetricsEnabled turns /metrics on or off and persists the choice.
+// Metrics are only served while the local API runs.
+func (a *App) SetLocalAPIMetricsEnabled(enabled bool) (LocalAPIStatus, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return LocalAPIStatus{}, err
+	}
+	local := settingsLocalAPI{}
+	if settings.LocalAPI != nil {
+		local = *settings.LocalAPI
+	}
+	local.Metrics = enabled
+	settings.LocalAPI = &local
+	err = a.saveSettingsFile(settings)
+	a.settingsMu.Unlock()
+	if err != nil {
+		return LocalAPIStatus{}, err
+	}
+	a.setLocalAPIMetrics(enabled)
+	return a.localAPIStatus(local), nil
+}
+
+func (a *App) setLocalAPIMetrics(enabled bool) {
+	a.localAPI.mu.Lock()
+	a.localAPI.metrics = enabled
+	a.localAPI.mu.Unlock()
+}
+
+// metricsURL derives the /metrics URL from the API URL. Callers hold mu.
+func (s *localAPIState) metricsURL() string {
+	return strings.TrimSuffix(s.url, localAPIPrefix) + localAPIMetricsPath
+}
+
+// handleLocalAPIMetrics writes the metrics exposition.
+func (a *App) handleLocalAPIMetrics(w http.ResponseWriter, _ *http.Request) {
+	a.localAPI.mu.Lock()
+	enabled := a.localAPI.metrics
+	a.localAPI.mu.Unlock()
+	if !enabled {
+		writeLocalAPIError(w, http.StatusNotFound, errors.New("metrics are turned off"))
+		return
+	}
+	exposition := telemetry.NewExposition()
+	telemetry.AddSummaries(exposition, a.diagnosticTelemetry())
+	a.addInformerMetrics(exposition)
+	addRuntimeMetrics(exposition)
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = exposition.WriteTo(w)
+}
+
+// addInformerMetrics adds each open cluster's informer sync state and watch
+// errors.
+func (a *App) addInformerMetrics(e *telemetry.Exposition) {
+	states := a.diagnosticInformerSyncStates()
+	clusterIDs := make([]string, 0, len(states))
+	for clusterID := range states {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+	for _, clusterID := range clusterIDs {
+		for _, state := range states[clusterID] {
+			synced := 0.0
+			if state.Synced {
+				synced = 1
+			}
+			e.Add("luxury_yacht_informer_synced", telemetry.PrometheusGauge, "Whether the informer finished its initial sync.",
+				synced, "cluster", clusterID, "resource", state.Resource)
+			e.Add("luxury_yacht_informer_watch_errors_total", telemetry.PrometheusCounter, "Informer list and watch failures.",
+				float64(state.WatchErrors), "cluster", clusterID, "resource", state.Resource)
+		}
+	}
+}
+
+// addRuntimeMetrics adds the process's memory use and goroutine count.
+func addRuntimeMetrics(e *telemetry.Exposition) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	e.Add("luxury_yacht_memory_heap_bytes", telemetry.PrometheusGauge, "Bytes of allocated heap objects.", float64(stats.HeapAlloc))
+	e.Add("luxury_yacht_memory_sys_bytes", telemetry.PrometheusGauge, "Bytes of memory obtained from the OS.", float64(stats.Sys))
+	e.Add("luxury_yacht_gc_cycles_total", telemetry.PrometheusCounter, "Completed garbage collection cycles.", float64(stats.NumGC))
+	e.Add("luxury_yacht_goroutines", telemetry.PrometheusGauge, "Goroutines that currently exist.", float64(runtime.NumGoroutine()))
+}
@@ -0,0 +1,204 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	goruntime "runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+)
+
+func localAPIRequest(t *testing.T, handler http.Handler, method, path, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLocalAPIRequiresTokenAndGet(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.localAPI.token = "secret"
+	handler := app.localAPIHandler()
+
+	require.Equal(t, http.StatusUnauthorized, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/clusters", "").Code)
+	require.Equal(t, http.StatusUnauthorized, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/clusters", "wrong").Code)
+	require.Equal(t, http.StatusMethodNotAllowed, localAPIRequest(t, handler, http.MethodPost, localAPIPrefix+"/clusters", "secret").Code)
+	require.Equal(t, http.StatusOK, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/clusters", "secret").Code)
+
+	// Without a running server there is no token, so nothing is accepted.
+	app.localAPI.token = ""
+	require.Equal(t, http.StatusUnauthorized, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/clusters", "").Code)
+}
+
+func TestLocalAPIServesCatalogsAndSearch(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.localAPI.token = "secret"
+	app.objectCatalogEntries = map[string]*objectCatalogEntry{
+		"config:prod": {
+			service: objectcatalog.NewService(objectcatalog.Dependencies{}, nil),
+			meta:    ClusterMeta{ID: "config:prod", Name: "prod"},
+		},
+	}
+	handler := app.localAPIHandler()
+
+	rec := localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/clusters", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var clusters []localAPICluster
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &clusters))
+	require.Equal(t, []localAPICluster{{ID: "config:prod", Name: "prod"}}, clusters)
+
+	rec = localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/catalog?clusterId=config:prod&kind=Pod", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var page localAPICatalogPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.NotNil(t, page.Items)
+
+	require.Equal(t, http.StatusBadRequest, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/catalog", "secret").Code)
+	require.Equal(t, http.StatusNotFound, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/catalog?clusterId=missing", "secret").Code)
+	require.Equal(t, http.StatusBadRequest, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/search?q=web&limit=x", "secret").Code)
+
+	rec = localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/search?q=web", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, "[]", rec.Body.String())
+}
+
+func TestLocalAPIObjectYAMLWithholdsSecretValues(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	app.localAPI.token = "secret"
+	kubeClient := kubernetesfake.NewClientset()
+	allowSelfSubjectAccessReviews(kubeClient)
+	registerTestClusterWithClients(app, "config:prod", &clusterClients{
+		meta:              ClusterMeta{ID: "config:prod", Name: "prod"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "prod",
+		client:            kubeClient,
+		dynamicClient:     dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), liveSecretObject()),
+	})
+	handler := app.localAPIHandler()
+
+	rec := localAPIRequest(t, handler, http.MethodGet,
+		localAPIPrefix+"/objects/yaml?clusterId=config:prod&apiVersion=v1&kind=Secret&namespace=default&name=creds", "secret")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	body := rec.Body.String()
+	require.Contains(t, body, "name: creds")
+	require.Contains(t, body, "password: "+withheldSecretData)
+	require.NotContains(t, body, "c3VwZXItc2VjcmV0")
+	require.NotContains(t, body, "YWRtaW4=")
+}
+
+func TestLocalAPIForwardsSnapshotsToRefreshAPI(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.localAPI.token = "secret"
+	handler := app.localAPIHandler()
+
+	require.Equal(t, http.StatusServiceUnavailable, localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/snapshots/pods?scope=c1", "secret").Code)
+
+	refreshMux := http.NewServeMux()
+	refreshMux.HandleFunc("/api/v2/snapshots/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", r.URL.Path, r.URL.Query().Get("scope"))
+	})
+	app.refreshHTTPServer = &http.Server{Handler: refreshMux}
+
+	rec := localAPIRequest(t, handler, http.MethodGet, localAPIPrefix+"/snapshots/pods?scope=c1", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "/api/v2/snapshots/pods c1", rec.Body.String())
+}
+
+func TestSetLocalAPIEnabledStartsServerAndRegeneratesToken(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	t.Cleanup(app.stopLocalAPI)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	status, err := app.SetLocalAPIEnabled(true, port)
+	require.NoError(t, err)
+	require.True(t, status.Enabled)
+	require.True(t, status.Running)
+	require.Equal(t, port, status.Port)
+
+	tokenData, err := os.ReadFile(status.TokenPath)
+	require.NoError(t, err)
+	if goruntime.GOOS != "windows" {
+		info, err := os.Stat(status.TokenPath)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	get := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet, status.URL+"/clusters", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	oldToken := string(tokenData[:len(tokenData)-1])
+	require.Equal(t, http.StatusOK, get(oldToken))
+
+	_, err = app.RegenerateLocalAPIToken()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, get(oldToken))
+
+	settings, err := app.localAPISettings()
+	require.NoError(t, err)
+	require.Equal(t, settingsLocalAPI{Enabled: true, Port: port}, settings)
+
+	status, err = app.SetLocalAPIEnabled(false, 0)
+	require.NoError(t, err)
+	require.False(t, status.Running)
+	require.Equal(t, port, status.Port)
+}
+
+func TestIsHeadlessLaunch(t *testing.T) {
+	require.True(t, IsHeadlessLaunch([]string{"--new-window", HeadlessFlag}))
+	require.False(t, IsHeadlessLaunch([]string{"luxury-yacht://cluster/prod"}))
+}
+
+func TestLocalAPIServesMetricsWhenEnabled(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.localAPI.token = "secret"
+	handler := app.localAPIHandler()
+
+	require.Equal(t, http.StatusNotFound, localAPIRequest(t, handler, http.MethodGet, localAPIMetricsPath, "secret").Code)
+
+	status, err := app.SetLocalAPIMetricsEnabled(true)
+	require.NoError(t, err)
+	require.True(t, status.Metrics)
+	require.Empty(t, status.MetricsURL, "no URL while the server is stopped")
+	require.Equal(t, http.StatusUnauthorized, localAPIRequest(t, handler, http.MethodGet, localAPIMetricsPath, "").Code)
+
+	rec := localAPIRequest(t, handler, http.MethodGet, localAPIMetricsPath, "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	require.Contains(t, rec.Body.String(), "# TYPE luxury_yacht_memory_heap_bytes gauge\n")
+	require.Contains(t, rec.Body.String(), "\nluxury_yacht_goroutines ")
+
+	settings, err := app.localAPISettings()
+	require.NoError(t, err)
+	require.True(t, settings.Metrics)
+}
@@ -0,0 +1,262 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/localterminal"
+)
+
+// Local terminals. Unlike pod shells these run the user's own shell on this
+// machine, with KUBECONFIG pointing at a throwaway kubeconfig that selects
+// the cluster's context and namespace ahead of the cluster's real kubeconfig,
+// so kubectl and friends target the selected cluster without touching the
+// user's files. Output and status reuse the shell event payloads under their
+// own event names. Each terminal is a runtime operation of its cluster, so it
+// is closed when the cluster disconnects or the app quits.
+
+const (
+	localTerminalOutputEventName = "local-terminal:output"
+	localTerminalStatusEventName = "local-terminal:status"
+
+	// RuntimeOperationLocalTerminal marks local terminal runtime operations.
+	RuntimeOperationLocalTerminal RuntimeOperationType = "local-terminal"
+)
+
+// LocalTerminalRequest selects the cluster and namespace a local terminal
+// starts in.
+type LocalTerminalRequest struct {
+	ClusterID string `json:"clusterId"`
+	// Namespace becomes the context's default namespace; empty keeps the
+	// kubeconfig's.
+	Namespace string `json:"namespace,omitempty"`
+	Columns   int    `json:"columns,omitempty"`
+	Rows      int    `json:"rows,omitempty"`
+}
+
+// LocalTerminalSession describes a started local terminal.
+type LocalTerminalSession struct {
+	SessionID   string `json:"sessionId"`
+	ClusterID   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Context     string `json:"context"`
+	Namespace   string `json:"namespace,omitempty"`
+	Shell       string `json:"shell"`
+}
+
+type localTerminalSession struct {
+	id         string
+	clusterID  string
+	term       *localterminal.Terminal
+	kubeconfig string
+	once       sync.Once
+}
+
+// close ends the shell and removes its kubeconfig.
+func (s *localTerminalSession) close() {
+	s.once.Do(func() {
+		_ = s.term.Close()
+		_ = os.Remove(s.kubeconfig)
+	})
+}
+
+// StartLocalTerminal starts the user's shell locally with the cluster's
+// context and namespace selected.
+func (a *App) StartLocalTerminal(req LocalTerminalRequest) (*LocalTerminalSession, error) {
+	clusterID := strings.TrimSpace(req.ClusterID)
+	if clusterID == "" {
+		return nil, fmt.Errorf("clusterID is required")
+	}
+	if req.Columns < 0 || req.Rows < 0 || req.Columns > maxTerminalDimension || req.Rows > maxTerminalDimension {
+		return nil, fmt.Errorf("columns and rows must be between 0 and %d", maxTerminalDimension)
+	}
+	clients := a.clusterClientsForID(clusterID)
+	if clients == nil {
+		return nil, fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	namespace := strings.TrimSpace(req.Namespace)
+	kubeconfig, err := writeContextKubeconfig(clients.kubeconfigPath, clients.kubeconfigContext, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	shell := localterminal.DefaultShell()
+	home, _ := os.UserHomeDir()
+	env := append(os.Environ(),
+		"KUBECONFIG="+kubeconfig+string(os.PathListSeparator)+clients.kubeconfigPath,
+		"TERM=xterm-256color",
+	)
+	term, err := localterminal.Start(localterminal.Options{
+		Command: []string{shell},
+		Dir:     home,
+		Env:     env,
+		Columns: uint16(req.Columns),
+		Rows:    uint16(req.Rows),
+	})
+	if err != nil {
+		_ = os.Remove(kubeconfig)
+		return nil, err
+	}
+
+	sess := &localTerminalSession{id: uuid.NewString(), clusterID: clusterID, term: term, kubeconfig: kubeconfig}
+	a.localTerminalsMu.Lock()
+	if a.localTerminals == nil {
+		a.localTerminals = make(map[string]*localTerminalSession)
+	}
+	a.localTerminals[sess.id] = sess
+	a.localTerminalsMu.Unlock()
+
+	clusterName := clients.meta.Name
+	if clusterName == "" {
+		clusterName = clusterID
+	}
+	a.registerRuntimeOperation(RuntimeOperation{
+		ID:          sess.id,
+		Type:        RuntimeOperationLocalTerminal,
+		ClusterID:   clusterID,
+		ClusterName: clusterName,
+		Status:      "open",
+		StartedAt:   time.Now().Format(time.RFC3339),
+		DisplayName: "Terminal " + clusterName,
+		Summary:     map[string]string{"shell": shell, "namespace": namespace},
+	}, func(reason string) error {
+		a.closeLocalTerminal(sess.id, reason)
+		return nil
+	})
+	go a.pumpLocalTerminal(sess)
+	a.emitEvent(localTerminalStatusEventName, ShellStatusEvent{SessionID: sess.id, ClusterID: clusterID, Status: "open"})
+	a.logger.Info(fmt.Sprintf("Started local terminal %s", sess.id), logsources.ShellSession, clusterID, clusterName)
+
+	return &LocalTerminalSession{
+		SessionID:   sess.id,
+		ClusterID:   clusterID,
+		ClusterName: clusterName,
+		Context:     clients.kubeconfigContext,
+		Namespace:   namespace,
+		Shell:       shell,
+	}, nil
+}
+
+// SendLocalTerminalInput writes keyboard input to a local terminal.
+func (a *App) SendLocalTerminalInput(sessionID, data string) error {
+	if data == "" {
+		return nil
+	}
+	sess := a.localTerminal(sessionID)
+	if sess == nil {
+		return fmt.Errorf("local terminal %q not found", sessionID)
+	}
+	if _, err := sess.term.Write([]byte(data)); err != nil {
+		return fmt.Errorf("failed to send input: %w", err)
+	}
+	return nil
+}
+
+// ResizeLocalTerminal sets a local terminal's size.
+func (a *App) ResizeLocalTerminal(sessionID string, columns, rows int) error {
+	if columns <= 0 || rows <= 0 {
+		return fmt.Errorf("columns and rows must be positive")
+	}
+	if columns > maxTerminalDimension || rows > maxTerminalDimension {
+		return fmt.Errorf("columns and rows must be less than or equal to %d", maxTerminalDimension)
+	}
+	sess := a.localTerminal(sessionID)
+	if sess == nil {
+		return fmt.Errorf("local terminal %q not found", sessionID)
+	}
+	return sess.term.Resize(uint16(columns), uint16(rows))
+}
+
+// CloseLocalTerminal ends a local terminal.
+func (a *App) CloseLocalTerminal(sessionID string) error {
+	if !a.closeLocalTerminal(sessionID, "terminated") {
+		return fmt.Errorf("local terminal %q not found", sessionID)
+	}
+	return nil
+}
+
+func (a *App) localTerminal(sessionID string) *localTerminalSession {
+	a.localTerminalsMu.Lock()
+	defer a.localTerminalsMu.Unlock()
+	return a.localTerminals[sessionID]
+}
+
+// closeLocalTerminal removes and closes the session, reporting whether it
+// was open.
+func (a *App) closeLocalTerminal(sessionID, reason string) bool {
+	a.localTerminalsMu.Lock()
+	sess, ok := a.localTerminals[sessionID]
+	delete(a.localTerminals, sessionID)
+	a.localTerminalsMu.Unlock()
+	if !ok {
+		return false
+	}
+	sess.close()
+	a.unregisterRuntimeOperation(sessionID)
+	a.emitEvent(localTerminalStatusEventName, ShellStatusEvent{SessionID: sessionID, ClusterID: sess.clusterID, Status: "closed", Reason: reason})
+	return true
+}
+
+// pumpLocalTerminal forwards output until the shell exits.
+func (a *App) pumpLocalTerminal(sess *localTerminalSession) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := sess.term.Read(buf)
+		if n > 0 {
+			a.emitEvent(localTerminalOutputEventName, ShellOutputEvent{SessionID: sess.id, ClusterID: sess.clusterID, Stream: "stdout", Data: string(buf[:n])})
+		}
+		if err != nil {
+			break
+		}
+	}
+	reason := "shell exited"
+	var exitErr interface{ ExitCode() int }
+	if err := sess.term.Err(); errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		reason = fmt.Sprintf("shell exited with status %d", exitErr.ExitCode())
+	}
+	a.closeLocalTerminal(sess.id, reason)
+}
+
+// writeContextKubeconfig writes a kubeconfig that selects context, with
+// namespace as its default when set, to be listed before the real kubeconfig
+// in KUBECONFIG. Kubeconfig merging lets the first file win for the current
+// context and for each context entry; clusters and users still come from the
+// real file.
+func writeContextKubeconfig(path, context, namespace string) (string, error) {
+	loaded, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read kubeconfig: %w", err)
+	}
+	entry, ok := loaded.Contexts[context]
+	if !ok {
+		return "", fmt.Errorf("context %q not found in %s", context, path)
+	}
+	selected := entry.DeepCopy()
+	selected.LocationOfOrigin = ""
+	if namespace != "" {
+		selected.Namespace = namespace
+	}
+	overlay := clientcmdapi.NewConfig()
+	overlay.Contexts[context] = selected
+	overlay.CurrentContext = context
+
+	file, err := os.CreateTemp("", "luxury-yacht-kubeconfig-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("create terminal kubeconfig: %w", err)
+	}
+	file.Close()
+	if err := clientcmd.WriteToFile(*overlay, file.Name()); err != nil {
+		_ = os.Remove(file.Name())
+		return "", fmt.Errorf("write terminal kubeconfig: %w", err)
+	}
+	return file.Name(), nil
+}
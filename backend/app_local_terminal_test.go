@@ -0,0 +1,110 @@
+//go:build darwin || linux
+
+package backend
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const localTerminalTestKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster: {server: https://prod.example.com}
+- name: dev
+  cluster: {server: https://dev.example.com}
+users:
+- name: admin
+  user: {token: secret}
+contexts:
+- name: prod
+  context: {cluster: prod, user: admin, namespace: default}
+- name: dev
+  context: {cluster: dev, user: admin}
+current-context: dev
+`
+
+func TestWriteContextKubeconfigSelectsContextAndNamespace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(localTerminalTestKubeconfig), 0o600))
+
+	overlay, err := writeContextKubeconfig(path, "prod", "payments")
+	require.NoError(t, err)
+	defer os.Remove(overlay)
+
+	rules := &clientcmd.ClientConfigLoadingRules{Precedence: []string{overlay, path}}
+	merged, err := rules.Load()
+	require.NoError(t, err)
+	require.Equal(t, "prod", merged.CurrentContext)
+	require.Equal(t, "payments", merged.Contexts["prod"].Namespace)
+	require.Equal(t, "https://prod.example.com", merged.Clusters[merged.Contexts["prod"].Cluster].Server)
+	require.Equal(t, "secret", merged.AuthInfos["admin"].Token)
+
+	_, err = writeContextKubeconfig(path, "missing", "")
+	require.ErrorContains(t, err, `context "missing" not found`)
+}
+
+func TestLocalTerminalRunsWithClusterKubeconfig(t *testing.T) {
+	setTestConfigEnv(t)
+	t.Setenv("SHELL", "/bin/sh")
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(localTerminalTestKubeconfig), 0o600))
+	registerTestClusterWithClients(app, "c1", &clusterClients{
+		meta:              ClusterMeta{ID: "c1", Name: "prod"},
+		kubeconfigPath:    path,
+		kubeconfigContext: "prod",
+	})
+
+	var mu sync.Mutex
+	var output strings.Builder
+	closed := make(chan ShellStatusEvent, 1)
+	app.eventEmitter = func(_ context.Context, name string, args ...interface{}) {
+		switch name {
+		case localTerminalOutputEventName:
+			mu.Lock()
+			output.WriteString(args[0].(ShellOutputEvent).Data)
+			mu.Unlock()
+		case localTerminalStatusEventName:
+			if status := args[0].(ShellStatusEvent); status.Status == "closed" {
+				closed <- status
+			}
+		}
+	}
+
+	_, err := app.StartLocalTerminal(LocalTerminalRequest{})
+	require.ErrorContains(t, err, "clusterID is required")
+	_, err = app.StartLocalTerminal(LocalTerminalRequest{ClusterID: "other"})
+	require.ErrorContains(t, err, "not connected")
+
+	session, err := app.StartLocalTerminal(LocalTerminalRequest{ClusterID: "c1", Namespace: "payments", Columns: 120, Rows: 40})
+	require.NoError(t, err)
+	require.Equal(t, "prod", session.Context)
+	require.Len(t, app.ListRuntimeOperations(), 1)
+	overlay := app.localTerminal(session.SessionID).kubeconfig
+	require.NoError(t, app.ResizeLocalTerminal(session.SessionID, 100, 30))
+	require.NoError(t, app.SendLocalTerminalInput(session.SessionID, "echo \"config=$KUBECONFIG\"; exit 3\n"))
+
+	select {
+	case status := <-closed:
+		require.Equal(t, "shell exited with status 3", status.Reason)
+	case <-time.After(10 * time.Second):
+		t.Fatal("local terminal did not close")
+	}
+	mu.Lock()
+	require.Contains(t, output.String(), "config="+overlay+string(os.PathListSeparator)+path)
+	mu.Unlock()
+	require.NoFileExists(t, overlay)
+	require.Empty(t, app.ListRuntimeOperations())
+	require.Error(t, app.CloseLocalTerminal(session.SessionID))
+}
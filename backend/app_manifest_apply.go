@@ -0,0 +1,62 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+// applyManifests server-side applies rendered manifests to a cluster, or with
+// opts.DryRun previews the apply. operation names the caller in read-only
+// errors and the app log.
+func (a *App) applyManifests(clusterID, operation string, manifests []manifestapply.Manifest, opts manifestapply.Options) (*manifestapply.Report, error) {
+	clusterID = strings.TrimSpace(clusterID)
+	if clusterID == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("no manifests to apply")
+	}
+	if !opts.DryRun {
+		if err := a.requireClusterWritable(clusterID, operation); err != nil {
+			return nil, err
+		}
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if deps.DynamicClient == nil {
+		return nil, fmt.Errorf("dynamic client not initialized")
+	}
+	parent := deps.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, config.ManifestApplyTimeout)
+	defer cancel()
+
+	resolve := func(ctx context.Context, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+		return getGVRForGVKWithDependencies(ctx, deps, selectionKey, gvk)
+	}
+	report := manifestapply.Run(ctx, deps.DynamicClient, resolve, manifests, opts)
+	if opts.DryRun {
+		return &report, nil
+	}
+	for _, result := range report.Results {
+		if result.Action == manifestapply.ActionFailed || result.Action == manifestapply.ActionUnchanged {
+			continue
+		}
+		a.invalidateResponseCacheForGVK(selectionKey, schema.FromAPIVersionAndKind(result.APIVersion, result.Kind), result.Namespace, result.Name)
+	}
+	a.logger.Info(fmt.Sprintf("%s: applied %d objects (%d created, %d updated, %d failed)", operation, len(report.Results),
+		report.Counts[manifestapply.ActionCreate], report.Counts[manifestapply.ActionUpdate], report.Counts[manifestapply.ActionFailed]),
+		logsources.App, deps.ClusterID, deps.ClusterName)
+	return &report, nil
+}
@@ -0,0 +1,68 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+// Folder applies. Every YAML and JSON manifest under a local directory is
+// loaded, validated, and applied in dependency order; each result names the
+// file it came from. Files that fail to load are reported alongside the
+// applied objects rather than failing the whole apply.
+
+// SelectManifestDirectory asks for a directory of manifests.
+func (a *App) SelectManifestDirectory() (string, error) {
+	if a == nil || a.Ctx == nil {
+		return "", fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeOpenDirDialog(a.Ctx, wailsruntime.OpenDialogOptions{Title: "Select Manifest Directory"})
+	if err != nil {
+		return "", fmt.Errorf("select manifest directory: %w", err)
+	}
+	return strings.TrimSpace(path), nil
+}
+
+// PreviewManifestDirectory dry-run applies the manifests under dir, returning
+// what each object would become and a diff against its live state.
+func (a *App) PreviewManifestDirectory(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	opts.DryRun = true
+	return a.applyManifestDirectory(clusterID, dir, opts)
+}
+
+// ApplyManifestDirectory server-side applies the manifests under dir.
+func (a *App) ApplyManifestDirectory(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	opts.DryRun = false
+	return a.applyManifestDirectory(clusterID, dir, opts)
+}
+
+func (a *App) applyManifestDirectory(clusterID, dir string, opts manifestapply.Options) (*manifestapply.Report, error) {
+	manifests, problems, err := manifestapply.LoadDirectory(dir, config.ManifestDirectoryMaxFiles)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 && len(problems) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", strings.TrimSpace(dir))
+	}
+
+	var report *manifestapply.Report
+	if len(manifests) > 0 {
+		if report, err = a.applyManifests(clusterID, "Apply folder", manifests, opts); err != nil {
+			return nil, err
+		}
+	} else {
+		// Nothing loadable: still check the cluster, then report the files.
+		if strings.TrimSpace(clusterID) == "" {
+			return nil, fmt.Errorf("cluster ID is required")
+		}
+		report = &manifestapply.Report{DryRun: opts.DryRun, Results: []manifestapply.Result{}, Counts: map[string]int{}}
+	}
+	for _, problem := range problems {
+		report.Add(problem)
+	}
+	return report, nil
+}
@@ -0,0 +1,42 @@
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+func TestManifestDirectoryApplyGuards(t *testing.T) {
+	const clusterID = "folder"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+	})
+
+	empty := t.TempDir()
+	_, err := app.PreviewManifestDirectory(clusterID, empty, manifestapply.Options{})
+	require.ErrorContains(t, err, "no manifests found")
+
+	broken := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(broken, "bad.yaml"), []byte("kind: [\n"), 0o600))
+	report, err := app.PreviewManifestDirectory(clusterID, broken, manifestapply.Options{})
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Equal(t, 1, report.Counts[manifestapply.ActionFailed])
+	require.Equal(t, "bad.yaml", report.Results[0].Source)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cm.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"), 0o600))
+	_, err = app.PreviewManifestDirectory("", dir, manifestapply.Options{})
+	require.ErrorContains(t, err, "cluster ID is required")
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.ApplyManifestDirectory(clusterID, dir, manifestapply.Options{})
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
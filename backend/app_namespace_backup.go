@@ -0,0 +1,519 @@
+package backend
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Namespace backup and restore. A backup reads every object the catalog
+// lists in a namespace and writes the manifests, without server-managed
+// fields, to a timestamped .tar.gz with a backup.json index. Objects owned by
+// a controller are left out because their owner recreates them. A restore
+// creates the archived objects in a chosen namespace of a chosen cluster;
+// objects that already exist there are left untouched.
+
+// namespaceBackupIndexName is the archive entry describing the backup.
+const namespaceBackupIndexName = "backup.json"
+
+// namespaceBackupFormatVersion is bumped when the archive layout changes.
+const namespaceBackupFormatVersion = 1
+
+// namespaceRestoreKindOrder creates the objects other objects depend on first,
+// so a Deployment does not start before its ServiceAccount or ConfigMap exists.
+// Kinds not listed are created after these, in archive order.
+var namespaceRestoreKindOrder = map[string]int{
+	"ServiceAccount":        0,
+	"ConfigMap":             0,
+	"Secret":                0,
+	"PersistentVolumeClaim": 1,
+	"Role":                  1,
+	"RoleBinding":           2,
+	"Service":               3,
+}
+
+// NamespaceBackupRequest describes a namespace backup.
+type NamespaceBackupRequest struct {
+	ClusterID string `json:"clusterId"`
+	Namespace string `json:"namespace"`
+	// Secrets and Events are left out unless included here.
+	IncludeSecrets bool `json:"includeSecrets"`
+	IncludeEvents  bool `json:"includeEvents"`
+	// ExcludeKinds leaves out further kinds, matched case-insensitively.
+	ExcludeKinds []string `json:"excludeKinds,omitempty"`
+}
+
+// NamespaceBackupResult reports where a backup was written.
+type NamespaceBackupResult struct {
+	Path      string `json:"path"`
+	Namespace string `json:"namespace"`
+	Objects   int    `json:"objects"`
+	Bytes     int64  `json:"bytes"`
+	// Owned counts the controller-owned objects left out of the backup.
+	Owned   int                       `json:"owned,omitempty"`
+	Skipped []YAMLBundleSkippedObject `json:"skipped,omitempty"`
+}
+
+// NamespaceRestoreRequest describes where a backup is restored.
+type NamespaceRestoreRequest struct {
+	ClusterID string `json:"clusterId"`
+	// Namespace is the target namespace; empty restores into the namespace
+	// the backup was taken from.
+	Namespace       string `json:"namespace,omitempty"`
+	CreateNamespace bool   `json:"createNamespace"`
+}
+
+// NamespaceRestoreResult reports the outcome of a restore.
+type NamespaceRestoreResult struct {
+	Path      string                    `json:"path"`
+	Namespace string                    `json:"namespace"`
+	Created   int                       `json:"created"`
+	Existing  []ObjectActionTargetRef   `json:"existing,omitempty"`
+	Failed    []YAMLBundleSkippedObject `json:"failed,omitempty"`
+}
+
+// namespaceBackupIndex is the backup.json archive entry.
+type namespaceBackupIndex struct {
+	FormatVersion int                       `json:"formatVersion"`
+	ClusterID     string                    `json:"clusterId"`
+	ClusterName   string                    `json:"clusterName,omitempty"`
+	Namespace     string                    `json:"namespace"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	Objects       []namespaceBackupIndexRef `json:"objects"`
+}
+
+// namespaceBackupIndexRef points an archived object at its manifest entry.
+type namespaceBackupIndexRef struct {
+	Ref  ObjectActionTargetRef `json:"ref"`
+	Path string                `json:"path"`
+}
+
+// BackupNamespace asks for a destination and writes a backup of the namespace.
+func (a *App) BackupNamespace(req NamespaceBackupRequest) (NamespaceBackupResult, error) {
+	var empty NamespaceBackupResult
+	if a == nil {
+		return empty, fmt.Errorf("app is not initialised")
+	}
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	req.Namespace = strings.TrimSpace(req.Namespace)
+	if req.Namespace == "" {
+		return empty, fmt.Errorf("namespace is required for backup")
+	}
+
+	path, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:           "Back Up Namespace",
+		DefaultFilename: namespaceBackupFilename(req.Namespace, time.Now()),
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "Backup archives (*.tar.gz)", Pattern: "*.tar.gz;*.tgz"},
+		},
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select backup file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("namespace backup canceled")
+	}
+	refs, err := a.namespaceBackupRefs(req)
+	if err != nil {
+		return empty, err
+	}
+	return a.backupNamespace(req, refs, path)
+}
+
+// namespaceBackupRefs lists the namespace's objects from the catalog, without
+// the excluded kinds.
+func (a *App) namespaceBackupRefs(req NamespaceBackupRequest) ([]ObjectActionTargetRef, error) {
+	refs, err := a.yamlBundleRefs(YAMLBundleExportRequest{
+		ClusterID: req.ClusterID,
+		Query:     &YAMLBundleQuery{Namespaces: []string{req.Namespace}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterNamespaceBackupRefs(refs, req), nil
+}
+
+// filterNamespaceBackupRefs drops refs outside the namespace and refs of
+// excluded kinds. Events are matched in both the core and events.k8s.io groups.
+func filterNamespaceBackupRefs(refs []ObjectActionTargetRef, req NamespaceBackupRequest) []ObjectActionTargetRef {
+	excluded := make(map[string]struct{}, len(req.ExcludeKinds)+2)
+	for _, kind := range req.ExcludeKinds {
+		excluded[strings.ToLower(strings.TrimSpace(kind))] = struct{}{}
+	}
+	if !req.IncludeSecrets {
+		excluded["secret"] = struct{}{}
+	}
+	if !req.IncludeEvents {
+		excluded["event"] = struct{}{}
+	}
+	kept := make([]ObjectActionTargetRef, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Namespace != req.Namespace {
+			continue
+		}
+		if _, ok := excluded[strings.ToLower(ref.Kind)]; ok {
+			continue
+		}
+		kept = append(kept, ref)
+	}
+	return kept
+}
+
+// backupNamespace fetches refs and writes the backup archive to path.
+func (a *App) backupNamespace(req NamespaceBackupRequest, refs []ObjectActionTargetRef, path string) (NamespaceBackupResult, error) {
+	var empty NamespaceBackupResult
+	deps, _, err := a.resolveClusterDependencies(req.ClusterID)
+	if err != nil {
+		return empty, err
+	}
+
+	index := namespaceBackupIndex{
+		FormatVersion: namespaceBackupFormatVersion,
+		ClusterID:     req.ClusterID,
+		ClusterName:   a.clusterNameForID(req.ClusterID),
+		Namespace:     req.Namespace,
+		CreatedAt:     time.Now().UTC(),
+	}
+	documents, owned, skipped := fetchNamespaceBackupDocuments(deps, refs)
+	if len(documents) == 0 && len(skipped) > 0 {
+		return empty, fmt.Errorf("none of the %d objects in %s could be backed up: %s", len(refs), req.Namespace, skipped[0].Error)
+	}
+	for _, document := range documents {
+		index.Objects = append(index.Objects, namespaceBackupIndexRef{
+			Ref:  document.ref,
+			Path: namespaceBackupEntryPath(document.ref),
+		})
+	}
+
+	archive, err := writeNamespaceBackupArchive(index, documents)
+	if err != nil {
+		return empty, err
+	}
+	info, err := writeExportFileAtomically(path, archive, "namespace backup")
+	if err != nil {
+		return empty, err
+	}
+
+	a.logger.Info(fmt.Sprintf("Backed up %d objects from namespace %s to %s", len(documents), req.Namespace, path), logsources.App, req.ClusterID, index.ClusterName)
+	return NamespaceBackupResult{
+		Path:      path,
+		Namespace: req.Namespace,
+		Objects:   len(documents),
+		Bytes:     info.Size(),
+		Owned:     owned,
+		Skipped:   skipped,
+	}, nil
+}
+
+// fetchNamespaceBackupDocuments reads each object live and renders it without
+// server-managed fields. Controller-owned objects are counted, not kept.
+func fetchNamespaceBackupDocuments(
+	deps common.Dependencies,
+	refs []ObjectActionTargetRef,
+) ([]yamlBundleDocument, int, []YAMLBundleSkippedObject) {
+	documents := make([]yamlBundleDocument, 0, len(refs))
+	owned := 0
+	var skipped []YAMLBundleSkippedObject
+	for _, ref := range refs {
+		gvk := schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind}
+		obj, err := fetchObjectByGVK(deps.Context, deps, gvk, ref.Namespace, ref.Name)
+		if err != nil {
+			skipped = append(skipped, YAMLBundleSkippedObject{Ref: ref, Error: err.Error()})
+			continue
+		}
+		if metav1.GetControllerOfNoCopy(obj) != nil {
+			owned++
+			continue
+		}
+		// Secrets are only listed when IncludeSecrets is set, and a restore
+		// needs their values.
+		manifest, err := yamlBundleManifest(obj, true, true)
+		if err != nil {
+			skipped = append(skipped, YAMLBundleSkippedObject{Ref: ref, Error: err.Error()})
+			continue
+		}
+		documents = append(documents, yamlBundleDocument{ref: ref, manifest: manifest})
+	}
+	sort.SliceStable(documents, func(i, j int) bool {
+		return yamlBundleSortKey(documents[i].ref) < yamlBundleSortKey(documents[j].ref)
+	})
+	return documents, owned, skipped
+}
+
+// namespaceBackupEntryPath is an object's manifest path inside the archive:
+// <kind>[.<group>]/<name>.yaml, the directory layout of a YAML bundle without
+// the namespace level.
+func namespaceBackupEntryPath(ref ObjectActionTargetRef) string {
+	ref.Namespace = ""
+	relative := strings.ReplaceAll(yamlBundleRelativePath(ref), "\\", "/")
+	return strings.TrimPrefix(relative, yamlBundleClusterScopedDir+"/")
+}
+
+// namespaceBackupFilename names a backup after its namespace and time.
+func namespaceBackupFilename(namespace string, now time.Time) string {
+	return fmt.Sprintf("%s-backup-%s.tar.gz", yamlBundlePathSegment(namespace), now.Format("20060102-150405"))
+}
+
+// writeNamespaceBackupArchive renders the index and manifests as a .tar.gz.
+func writeNamespaceBackupArchive(index namespaceBackupIndex, documents []yamlBundleDocument) (string, error) {
+	indexData, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("serialize backup index: %w", err)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	write := func(name string, data []byte) error {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(data)),
+			ModTime: index.CreatedAt,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("write backup entry %s: %w", name, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("write backup entry %s: %w", name, err)
+		}
+		return nil
+	}
+	if err := write(namespaceBackupIndexName, indexData); err != nil {
+		return "", err
+	}
+	for i, document := range documents {
+		if err := write(index.Objects[i].Path, []byte(document.manifest)); err != nil {
+			return "", err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return "", fmt.Errorf("close backup archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("close backup archive: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// RestoreNamespaceBackup asks for a backup archive and restores it.
+func (a *App) RestoreNamespaceBackup(req NamespaceRestoreRequest) (NamespaceRestoreResult, error) {
+	var empty NamespaceRestoreResult
+	if a == nil {
+		return empty, fmt.Errorf("app is not initialised")
+	}
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeOpenFileDialog(a.Ctx, wailsruntime.OpenDialogOptions{
+		Title: "Restore Namespace Backup",
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "Backup archives (*.tar.gz)", Pattern: "*.tar.gz;*.tgz"},
+		},
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select backup file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("namespace restore canceled")
+	}
+	return a.restoreNamespaceBackup(req, path)
+}
+
+// restoreNamespaceBackup creates the objects archived at path in the target
+// namespace. A failed object does not stop the others.
+func (a *App) restoreNamespaceBackup(req NamespaceRestoreRequest, path string) (NamespaceRestoreResult, error) {
+	var empty NamespaceRestoreResult
+	index, manifests, err := readNamespaceBackupArchive(path)
+	if err != nil {
+		return empty, err
+	}
+	target := strings.TrimSpace(req.Namespace)
+	if target == "" {
+		target = index.Namespace
+	}
+	if err := a.requireClusterWritable(req.ClusterID, "restore"); err != nil {
+		return empty, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(req.ClusterID)
+	if err != nil {
+		return empty, err
+	}
+	if deps.DynamicClient == nil {
+		return empty, fmt.Errorf("dynamic client not initialized")
+	}
+	if req.CreateNamespace {
+		if err := ensureRestoreNamespace(deps, target); err != nil {
+			return empty, err
+		}
+	}
+
+	objects := append([]namespaceBackupIndexRef(nil), index.Objects...)
+	sort.SliceStable(objects, func(i, j int) bool {
+		return namespaceRestoreRank(objects[i].Ref.Kind) < namespaceRestoreRank(objects[j].Ref.Kind)
+	})
+
+	result := NamespaceRestoreResult{Path: path, Namespace: target}
+	for _, object := range objects {
+		ref := object.Ref
+		ref.ClusterID = req.ClusterID
+		ref.Namespace = target
+		err := restoreNamespaceBackupObject(deps, selectionKey, ref, manifests[object.Path])
+		switch {
+		case err == nil:
+			result.Created++
+			a.invalidateResponseCacheForGVK(selectionKey, schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind}, ref.Namespace, ref.Name)
+		case apierrors.IsAlreadyExists(err):
+			result.Existing = append(result.Existing, ref)
+		default:
+			result.Failed = append(result.Failed, YAMLBundleSkippedObject{Ref: ref, Error: err.Error()})
+		}
+	}
+
+	a.logger.Info(fmt.Sprintf("Restored %d of %d objects from %s into namespace %s", result.Created, len(objects), path, target), logsources.App, req.ClusterID, a.clusterNameForID(req.ClusterID))
+	return result, nil
+}
+
+func namespaceRestoreRank(kind string) int {
+	if rank, ok := namespaceRestoreKindOrder[kind]; ok {
+		return rank
+	}
+	return len(namespaceRestoreKindOrder)
+}
+
+// ensureRestoreNamespace creates the target namespace when it is missing.
+func ensureRestoreNamespace(deps common.Dependencies, namespace string) error {
+	if deps.KubernetesClient == nil {
+		return fmt.Errorf("kubernetes client not initialized")
+	}
+	_, err := deps.KubernetesClient.CoreV1().Namespaces().Create(deps.Context, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return wrapKubernetesError(err, fmt.Sprintf("failed to create namespace %s", namespace))
+	}
+	return nil
+}
+
+// restoreNamespaceBackupObject creates one archived object as ref. Owner
+// references are dropped (the owners' UIDs do not exist in the target), as
+// are the fields the API server allocates per object: a Service's cluster IPs
+// and a claim's bound volume.
+func restoreNamespaceBackupObject(deps common.Dependencies, selectionKey string, ref ObjectActionTargetRef, manifest string) error {
+	if manifest == "" {
+		return fmt.Errorf("manifest missing from backup")
+	}
+	obj, err := parseYAMLToUnstructured(manifest)
+	if err != nil {
+		return err
+	}
+	obj = stripServerManagedFields(obj)
+	obj.SetNamespace(ref.Namespace)
+	obj.SetOwnerReferences(nil)
+	switch ref.Kind {
+	case "Service":
+		unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+	case "PersistentVolumeClaim":
+		unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	}
+
+	gvk := schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind}
+	gvr, namespaced, err := getGVRForGVKWithDependencies(deps.Context, deps, selectionKey, gvk)
+	if err != nil {
+		return fmt.Errorf("failed to resolve resource mapping for %s: %w", gvk.String(), err)
+	}
+	var resource dynamic.ResourceInterface = deps.DynamicClient.Resource(gvr)
+	if namespaced {
+		resource = deps.DynamicClient.Resource(gvr).Namespace(ref.Namespace)
+	}
+	if _, err := resource.Create(deps.Context, obj, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return wrapKubernetesError(err, "failed to restore object")
+	}
+	return nil
+}
+
+// readNamespaceBackupArchive reads the index and manifests of a backup
+// archive, keyed by their entry path.
+func readNamespaceBackupArchive(archivePath string) (*namespaceBackupIndex, map[string]string, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open backup: %w", err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(io.LimitReader(file, config.NamespaceBackupMaxArchiveBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read backup: %w", err)
+	}
+	defer gz.Close()
+
+	var index *namespaceBackupIndex
+	manifests := make(map[string]string)
+	var total int64
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read backup: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		total += header.Size
+		if total > config.NamespaceBackupMaxArchiveBytes {
+			return nil, nil, fmt.Errorf("backup is larger than %d bytes", config.NamespaceBackupMaxArchiveBytes)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read backup entry %s: %w", header.Name, err)
+		}
+		name := path.Clean(header.Name)
+		if name == namespaceBackupIndexName {
+			index = &namespaceBackupIndex{}
+			if err := json.Unmarshal(data, index); err != nil {
+				return nil, nil, fmt.Errorf("read backup index: %w", err)
+			}
+			continue
+		}
+		manifests[name] = string(data)
+	}
+	if index == nil {
+		return nil, nil, fmt.Errorf("%s is not a namespace backup: %s is missing", archivePath, namespaceBackupIndexName)
+	}
+	if index.FormatVersion > namespaceBackupFormatVersion {
+		return nil, nil, fmt.Errorf("backup format %d is newer than this version of the app supports", index.FormatVersion)
+	}
+	return index, manifests, nil
+}
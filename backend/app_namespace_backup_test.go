@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestNamespaceBackupRestoresIntoAnotherNamespace(t *testing.T) {
+	const clusterID = "namespace-backup"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	path := filepath.Join(t.TempDir(), namespaceBackupFilename("default", time.Now()))
+
+	backup, err := app.backupNamespace(NamespaceBackupRequest{ClusterID: clusterID, Namespace: "default"}, yamlBundleTestRefs(clusterID), path)
+	require.NoError(t, err)
+	require.Equal(t, 2, backup.Objects)
+	require.Len(t, backup.Skipped, 1)
+	require.Equal(t, "missing", backup.Skipped[0].Ref.Name)
+
+	index, manifests, err := readNamespaceBackupArchive(path)
+	require.NoError(t, err)
+	require.Equal(t, "default", index.Namespace)
+	require.Equal(t, "ctx", index.ClusterName)
+	require.Len(t, index.Objects, 2)
+	ackManifest := manifests["dbinstance.rds.services.k8s.aws/my-db.yaml"]
+	require.Contains(t, ackManifest, "source: ack-rds")
+	require.NotContains(t, ackManifest, "resourceVersion")
+
+	restored, err := app.restoreNamespaceBackup(NamespaceRestoreRequest{ClusterID: clusterID, Namespace: "restored", CreateNamespace: true}, path)
+	require.NoError(t, err)
+	require.Equal(t, "restored", restored.Namespace)
+	require.Equal(t, 2, restored.Created)
+	require.Empty(t, restored.Failed)
+
+	dynamicClient := app.clusterClients[clusterID].dynamicClient.(*dynamicfake.FakeDynamicClient)
+	gvr := schema.GroupVersionResource{Group: "rds.services.k8s.aws", Version: "v1alpha1", Resource: "dbinstances"}
+	obj, err := dynamicClient.Resource(gvr).Namespace("restored").Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "restored", obj.GetNamespace())
+	_, err = app.clusterClients[clusterID].client.CoreV1().Namespaces().Get(context.Background(), "restored", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// Restoring again leaves the existing objects alone.
+	restored, err = app.restoreNamespaceBackup(NamespaceRestoreRequest{ClusterID: clusterID, Namespace: "restored"}, path)
+	require.NoError(t, err)
+	require.Zero(t, restored.Created)
+	require.Len(t, restored.Existing, 2)
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.restoreNamespaceBackup(NamespaceRestoreRequest{ClusterID: clusterID}, path)
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
+
+func TestFilterNamespaceBackupRefsExcludesSecretsAndEventsByDefault(t *testing.T) {
+	refs := []ObjectActionTargetRef{
+		{Version: "v1", Kind: "ConfigMap", Namespace: "apps", Name: "settings"},
+		{Version: "v1", Kind: "Secret", Namespace: "apps", Name: "token"},
+		{Version: "v1", Kind: "Event", Namespace: "apps", Name: "pod.1"},
+		{Group: "events.k8s.io", Version: "v1", Kind: "Event", Namespace: "apps", Name: "pod.2"},
+		{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "apps", Name: "web"},
+		{Version: "v1", Kind: "ConfigMap", Namespace: "other", Name: "settings"},
+	}
+
+	kept := filterNamespaceBackupRefs(refs, NamespaceBackupRequest{Namespace: "apps", ExcludeKinds: []string{"deployment"}})
+	require.Equal(t, []ObjectActionTargetRef{refs[0]}, kept)
+
+	kept = filterNamespaceBackupRefs(refs, NamespaceBackupRequest{Namespace: "apps", IncludeSecrets: true, IncludeEvents: true})
+	require.Len(t, kept, 5)
+}
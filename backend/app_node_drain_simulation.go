@@ -0,0 +1,22 @@
+package backend
+
+import (
+	"github.com/luxury-yacht/app/backend/resources/nodes"
+)
+
+// SimulateNodeDrain predicts a drain of the node with the given options without
+// cordoning or evicting anything: which pods would be evicted, skipped, or block
+// the drain (PDB allowance, no controller, emptyDir data, DaemonSet pods).
+func (a *App) SimulateNodeDrain(clusterID, nodeName string, options DrainNodeOptions) (*nodes.DrainSimulation, error) {
+	if err := requireObjectName(nodeName); err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Node drain simulation"); err != nil {
+		return nil, err
+	}
+	return nodes.NewService(deps).SimulateDrain(nodeName, options)
+}
@@ -0,0 +1,45 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/resources/nodes"
+)
+
+func TestSimulateNodeDrainBlocksUnmanagedPods(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-a"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	simulation, err := app.SimulateNodeDrain("config:ctx", "node-a", DrainNodeOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, simulation.Blocked)
+	require.Equal(t, nodes.DrainOutcomeBlock, simulation.Pods[0].Outcome)
+
+	simulation, err = app.SimulateNodeDrain("config:ctx", "node-a", DrainNodeOptions{Force: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, simulation.Evicted)
+
+	_, err = app.SimulateNodeDrain("config:ctx", "", DrainNodeOptions{})
+	require.Error(t, err)
+}
@@ -0,0 +1,269 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/notifications"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Desktop notifications. The rules and quiet hours are app-wide and persisted
+// in the notifications section of settings.json; each cluster opts in with
+// the notifications flag in its Clusters section. A loop started with the
+// refresh subsystem checks every opted-in cluster's Attention findings and
+// notifies for the ones that are new.
+
+// notificationConfig is the in-memory copy of the persisted notification
+// settings read by the notification loop.
+type notificationConfig struct {
+	settings notifications.Settings
+	clusters map[string]bool
+}
+
+// GetNotificationSettings returns the notification rules and quiet hours.
+func (a *App) GetNotificationSettings() (*notifications.Settings, error) {
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	result := notificationSettingsFromFile(settings)
+	return &result, nil
+}
+
+// SetNotificationSettings validates and persists the notification rules and
+// quiet hours, returning the normalized settings.
+func (a *App) SetNotificationSettings(update notifications.Settings) (*notifications.Settings, error) {
+	normalized, err := update.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	settings.Notifications = &normalized
+	if err := a.saveSettingsFile(settings); err != nil {
+		return nil, err
+	}
+	a.notificationConfig.Store(notificationConfigFromFile(settings))
+	return &normalized, nil
+}
+
+// GetClusterNotificationsEnabled reports whether the cluster sends desktop
+// notifications.
+func (a *App) GetClusterNotificationsEnabled(clusterID string) (bool, error) {
+	if clusterID == "" {
+		return false, fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return false, err
+	}
+	return settings.Clusters[clusterID].Notifications, nil
+}
+
+// SetClusterNotificationsEnabled persists the cluster's notification opt-in.
+func (a *App) SetClusterNotificationsEnabled(clusterID string, enabled bool) error {
+	if clusterID == "" {
+		return fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return err
+	}
+	section := settings.Clusters[clusterID]
+	if section.Notifications == enabled {
+		return nil
+	}
+	section.Notifications = enabled
+	if clusterSettingsSectionEmpty(section) {
+		delete(settings.Clusters, clusterID)
+	} else {
+		if settings.Clusters == nil {
+			settings.Clusters = map[string]settingsClusterSection{}
+		}
+		settings.Clusters[clusterID] = section
+	}
+	if err := a.saveSettingsFile(settings); err != nil {
+		return err
+	}
+	a.notificationConfig.Store(notificationConfigFromFile(settings))
+	return nil
+}
+
+func notificationSettingsFromFile(settings *settingsFile) notifications.Settings {
+	if settings.Notifications == nil {
+		return notifications.DefaultSettings()
+	}
+	return *settings.Notifications
+}
+
+func notificationConfigFromFile(settings *settingsFile) *notificationConfig {
+	cfg := &notificationConfig{
+		settings: notificationSettingsFromFile(settings),
+		clusters: make(map[string]bool),
+	}
+	for clusterID, section := range settings.Clusters {
+		if section.Notifications {
+			cfg.clusters[clusterID] = true
+		}
+	}
+	return cfg
+}
+
+// currentNotificationConfig returns the cached notification settings, loading
+// them on first use and after a settings import.
+func (a *App) currentNotificationConfig() (*notificationConfig, error) {
+	if cfg := a.notificationConfig.Load(); cfg != nil {
+		return cfg, nil
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	cfg := notificationConfigFromFile(settings)
+	a.notificationConfig.Store(cfg)
+	return cfg, nil
+}
+
+// clusterNotificationState is the notification loop's memory of one cluster.
+type clusterNotificationState struct {
+	index    *snapshot.ClusterAttentionIndex
+	revision uint64
+	tracker  notifications.Tracker
+}
+
+// startNotificationLoop checks opted-in clusters for new findings until ctx
+// is done.
+func (a *App) startNotificationLoop(ctx context.Context) {
+	states := make(map[string]*clusterNotificationState)
+	ticker := time.NewTicker(config.NotificationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.runNotificationIteration(states, time.Now())
+		}
+	}
+}
+
+// runNotificationIteration notifies for findings that appeared since the
+// previous iteration. A cluster's first check after it opts in, connects, or
+// rebuilds only records the findings already present. During quiet hours the
+// baseline still advances, so muted findings are not sent when the quiet
+// hours end.
+func (a *App) runNotificationIteration(states map[string]*clusterNotificationState, now time.Time) {
+	cfg, err := a.currentNotificationConfig()
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Could not read notification settings: %v", err), logsources.Settings)
+		return
+	}
+	quiet := cfg.settings.QuietHours.Contains(now)
+
+	a.refreshSubsystemsMu.RLock()
+	indexes := make(map[string]*snapshot.ClusterAttentionIndex, len(a.refreshSubsystems))
+	for clusterID, subsystem := range a.refreshSubsystems {
+		if subsystem != nil && subsystem.AttentionIndex != nil && cfg.clusters[clusterID] {
+			indexes[clusterID] = subsystem.AttentionIndex
+		}
+	}
+	a.refreshSubsystemsMu.RUnlock()
+
+	for clusterID, state := range states {
+		if indexes[clusterID] == nil || state.index != indexes[clusterID] {
+			delete(states, clusterID)
+		}
+	}
+	for clusterID, index := range indexes {
+		state, tracked := states[clusterID]
+		revision := index.Revision()
+		if tracked && state.revision == revision {
+			continue
+		}
+		if !tracked {
+			state = &clusterNotificationState{index: index}
+			states[clusterID] = state
+		}
+		state.revision = revision
+		raised := state.tracker.Observe(cfg.settings.Rules, index.Snapshot())
+		if quiet || len(raised) == 0 {
+			continue
+		}
+		a.sendClusterNotifications(clusterID, raised)
+	}
+}
+
+// sendClusterNotifications sends one cluster's new notifications, folding
+// any beyond config.NotificationMaxPerCluster into one summary.
+func (a *App) sendClusterNotifications(clusterID string, raised []notifications.Notification) {
+	clusterName := a.clusterNameForID(clusterID)
+	limit := min(len(raised), config.NotificationMaxPerCluster)
+	for _, notification := range raised[:limit] {
+		notification.ClusterName = clusterName
+		a.sendDesktopNotification(notification)
+	}
+	if extra := len(raised) - limit; extra > 0 {
+		a.sendDesktopNotification(notifications.Notification{
+			ClusterID:   clusterID,
+			ClusterName: clusterName,
+			Title:       fmt.Sprintf("%d more problems", extra),
+			Body:        "Open Attention to see every finding.",
+		})
+	}
+}
+
+// sendDesktopNotification shows a native notification and mirrors it to the
+// frontend as a notification:sent event. The platform service is initialized
+// and, on macOS, authorization requested on first use.
+func (a *App) sendDesktopNotification(notification notifications.Notification) {
+	if a.Ctx == nil {
+		return
+	}
+	a.notificationsInit.Do(func() {
+		if err := runtimeInitializeNotifications(a.Ctx); err != nil {
+			a.notificationsInitErr = err
+			return
+		}
+		a.notificationsStarted.Store(true)
+		if authorized, err := runtimeRequestNotificationAuth(a.Ctx); err != nil || !authorized {
+			a.notificationsInitErr = fmt.Errorf("notifications are not authorized")
+		}
+	})
+	if a.notificationsInitErr != nil {
+		a.logger.Debug(fmt.Sprintf("Skipping desktop notification: %v", a.notificationsInitErr), logsources.App, notification.ClusterID, notification.ClusterName)
+		return
+	}
+	err := runtimeSendNotification(a.Ctx, runtime.NotificationOptions{
+		ID:       fmt.Sprintf("%s:%s:%d", notification.ClusterID, notification.Rule, time.Now().UnixNano()),
+		Title:    notification.Title,
+		Subtitle: notification.ClusterName,
+		Body:     notification.Body,
+		Data: map[string]interface{}{
+			"clusterId": notification.ClusterID,
+			"rule":      notification.Rule,
+		},
+	})
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Could not send desktop notification %q: %v", strings.TrimSpace(notification.Title), err), logsources.App, notification.ClusterID, notification.ClusterName)
+		return
+	}
+	a.emitEvent("notification:sent", notification)
+}
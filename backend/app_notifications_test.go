@@ -0,0 +1,153 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/notifications"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+)
+
+func stubDesktopNotifications(t *testing.T) *[]wailsruntime.NotificationOptions {
+	t.Helper()
+	origInit := runtimeInitializeNotifications
+	origAuth := runtimeRequestNotificationAuth
+	origSend := runtimeSendNotification
+	t.Cleanup(func() {
+		runtimeInitializeNotifications = origInit
+		runtimeRequestNotificationAuth = origAuth
+		runtimeSendNotification = origSend
+	})
+	var sent []wailsruntime.NotificationOptions
+	runtimeInitializeNotifications = func(context.Context) error { return nil }
+	runtimeRequestNotificationAuth = func(context.Context) (bool, error) { return true, nil }
+	runtimeSendNotification = func(_ context.Context, options wailsruntime.NotificationOptions) error {
+		sent = append(sent, options)
+		return nil
+	}
+	return &sent
+}
+
+func warningEventObject(name, reason string) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "prod", UID: types.UID("uid-" + name)},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "prod", Name: "web"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        "Back-off pulling image",
+		LastTimestamp:  metav1.Now(),
+	}
+}
+
+func TestNotificationSettingsPersist(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	settings, err := app.GetNotificationSettings()
+	require.NoError(t, err)
+	require.Equal(t, notifications.DefaultSettings(), *settings)
+
+	update := notifications.DefaultSettings()
+	update.Rules.EventReasons = []string{" BackOff ", "BackOff"}
+	update.QuietHours.Enabled = true
+	saved, err := app.SetNotificationSettings(update)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BackOff"}, saved.Rules.EventReasons)
+
+	update.QuietHours.End = "7pm"
+	_, err = app.SetNotificationSettings(update)
+	require.Error(t, err)
+
+	require.NoError(t, app.SetClusterNotificationsEnabled("cluster-a", true))
+	enabled, err := app.GetClusterNotificationsEnabled("cluster-a")
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	cfg, err := app.currentNotificationConfig()
+	require.NoError(t, err)
+	require.True(t, cfg.clusters["cluster-a"])
+	require.True(t, cfg.settings.QuietHours.Enabled)
+
+	require.NoError(t, app.SetClusterNotificationsEnabled("cluster-a", false))
+	loaded, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.NotContains(t, loaded.Clusters, "cluster-a", "an empty cluster section is dropped")
+}
+
+func TestNotificationIterationSendsNewWarningEvents(t *testing.T) {
+	setTestConfigEnv(t)
+	sent := stubDesktopNotifications(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	const clusterID = "config:ctx"
+
+	client := kubernetesfake.NewClientset(warningEventObject("standing", "FailedMount"))
+	factory := informers.NewSharedInformerFactory(client, 0)
+	index, err := snapshot.RegisterClusterAttentionDomain(domain.New(), factory,
+		snapshot.ClusterAttentionPermissions{IncludeEvents: true},
+		snapshot.ClusterMeta{ClusterID: clusterID, ClusterName: "ctx"}, nil, snapshot.ClusterAttentionOptions{})
+	require.NoError(t, err)
+	t.Cleanup(index.Stop)
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+	app.refreshSubsystems = map[string]*system.Subsystem{clusterID: {AttentionIndex: index}}
+
+	states := make(map[string]*clusterNotificationState)
+	waitForFindings := func(count int) {
+		t.Helper()
+		require.Eventually(t, func() bool { return len(index.Snapshot()) == count }, 2*time.Second, 10*time.Millisecond)
+	}
+	waitForFindings(1)
+
+	app.runNotificationIteration(states, time.Now())
+	require.Empty(t, states, "clusters that have not opted in are skipped")
+
+	require.NoError(t, app.SetClusterNotificationsEnabled(clusterID, true))
+	app.runNotificationIteration(states, time.Now())
+	require.Empty(t, *sent, "standing findings are not replayed")
+
+	_, err = client.CoreV1().Events("prod").Create(context.Background(), warningEventObject("new", "BackOff"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	waitForFindings(2)
+	app.runNotificationIteration(states, time.Now())
+	require.Len(t, *sent, 1)
+	require.Equal(t, "Warning: BackOff", (*sent)[0].Title)
+	require.Equal(t, "prod: Back-off pulling image", (*sent)[0].Body)
+
+	// Quiet hours advance the baseline without sending.
+	settings := notifications.DefaultSettings()
+	settings.QuietHours = notifications.QuietHours{Enabled: true, Start: "00:00", End: "00:00"}
+	_, err = app.SetNotificationSettings(settings)
+	require.NoError(t, err)
+	_, err = client.CoreV1().Events("prod").Create(context.Background(), warningEventObject("muted", "Unhealthy"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	waitForFindings(3)
+	app.runNotificationIteration(states, time.Now())
+	require.Len(t, *sent, 1)
+
+	// A burst past the per-cluster cap collapses into one summary.
+	_, err = app.SetNotificationSettings(notifications.DefaultSettings())
+	require.NoError(t, err)
+	for i := range 5 {
+		_, err = client.CoreV1().Events("prod").Create(context.Background(), warningEventObject(fmt.Sprintf("burst-%d", i), fmt.Sprintf("Reason%d", i)), metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	waitForFindings(8)
+	app.runNotificationIteration(states, time.Now())
+	require.Len(t, *sent, 5)
+	require.Equal(t, "2 more problems", (*sent)[4].Title)
+}
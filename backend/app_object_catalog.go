@@ -20,6 +20,7 @@ import (
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
 	"github.com/luxury-yacht/app/backend/resources/customresource"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextinformers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -237,7 +238,8 @@ func (a *App) startObjectCatalogForTarget(target catalogTarget) error {
 		},
 	}
 
-	svc := objectcatalog.NewService(deps, nil)
+	catalogOptions := a.currentRefreshTuning().objectCatalog
+	svc := objectcatalog.NewService(deps, &catalogOptions)
 	ctx, cancel := context.WithCancel(a.CtxOrBackground())
 	done := make(chan struct{})
 	if subsystem.ResourceStream != nil {
@@ -683,6 +685,12 @@ func (a *App) HydrateCatalogCustomRows(clusterID string, rows []snapshot.Resourc
 		requests = append(requests, hydrationRequest{row: row, gvr: gvr, name: name})
 	}
 
+	gvrs := make([]schema.GroupVersionResource, 0, len(requests))
+	for _, req := range requests {
+		gvrs = append(gvrs, req.gvr)
+	}
+	printerColumns := catalogCustomPrinterColumns(ctx, clients.apiextensionsClient, gvrs)
+
 	result := make([]snapshot.CustomResourceSummary, len(requests))
 	included := make([]bool, len(requests))
 	sem := make(chan struct{}, catalogCustomHydrationConcurrency)
@@ -699,7 +707,7 @@ func (a *App) HydrateCatalogCustomRows(clusterID string, rows []snapshot.Resourc
 			case <-ctx.Done():
 				return
 			}
-			summary, ok := hydrateCatalogCustomRow(ctx, clients.dynamicClient, meta, req.row, req.gvr, req.name)
+			summary, ok := hydrateCatalogCustomRow(ctx, clients.dynamicClient, meta, req.row, req.gvr, req.name, printerColumns[req.gvr])
 			if !ok {
 				return
 			}
@@ -729,6 +737,7 @@ func hydrateCatalogCustomRow(
 	row snapshot.ResourceQueryRow,
 	gvr schema.GroupVersionResource,
 	name string,
+	printerColumns []customresource.PrinterColumn,
 ) (snapshot.CustomResourceSummary, bool) {
 	resource := client.Resource(gvr)
 	var (
@@ -760,6 +769,7 @@ func hydrateCatalogCustomRow(
 			row.Kind,
 			crdName,
 			row.Namespace,
+			printerColumns...,
 		)), true
 	}
 	return snapshot.CustomResourceSummaryFromCluster(customresource.BuildClusterStreamSummary(
@@ -770,9 +780,37 @@ func hydrateCatalogCustomRow(
 		row.Resource,
 		row.Kind,
 		crdName,
+		printerColumns...,
 	)), true
 }
 
+// catalogCustomPrinterColumns reads the status-like printer columns of each
+// distinct CRD behind a hydration page, one CRD GET apiece. A CRD that cannot
+// be read contributes no columns; row health then rests on conditions and
+// phase alone.
+func catalogCustomPrinterColumns(
+	ctx context.Context,
+	client apiextensionsclientset.Interface,
+	gvrs []schema.GroupVersionResource,
+) map[schema.GroupVersionResource][]customresource.PrinterColumn {
+	columns := make(map[schema.GroupVersionResource][]customresource.PrinterColumn)
+	if client == nil {
+		return columns
+	}
+	for _, gvr := range gvrs {
+		if _, seen := columns[gvr]; seen || gvr.Group == "" {
+			continue
+		}
+		crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, gvr.Resource+"."+gvr.Group, metav1.GetOptions{})
+		if err != nil {
+			columns[gvr] = nil
+			continue
+		}
+		columns[gvr] = customresource.HealthPrinterColumns(crd, gvr.Version)
+	}
+	return columns
+}
+
 func failedCatalogCustomHydrationSummary(meta snapshot.ClusterMeta, row snapshot.ResourceQueryRow) snapshot.CustomResourceSummary {
 	crdName := row.Resource
 	if row.Group != "" {
@@ -0,0 +1,60 @@
+package backend
+
+import (
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// ObjectSearchResult is one ranked match from SearchObjects. Ref carries the
+// cluster identity and is the navigation target.
+type ObjectSearchResult struct {
+	Ref         resourcemodel.ResourceRef `json:"ref"`
+	ClusterName string                    `json:"clusterName"`
+	Score       int                       `json:"score"`
+}
+
+// SearchObjects ranks objects across every active cluster's catalog for the
+// "jump to anything" shortcut. Whitespace-separated terms must all match; bare
+// terms match the name, namespace, kind or a label value, and "kind:",
+// "ns:" and "key=value" terms narrow by field. It is answered from the
+// in-memory catalogs and makes no API calls.
+func (a *App) SearchObjects(query string, limit int) ([]ObjectSearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return []ObjectSearchResult{}, nil
+	}
+	if limit <= 0 {
+		limit = config.ObjectSearchDefaultLimit
+	}
+	limit = min(limit, config.ObjectSearchMaxLimit)
+
+	var matches []objectcatalog.SearchMatch
+	clusterNames := make(map[string]string)
+	for _, entry := range a.snapshotObjectCatalogEntries() {
+		if entry == nil || entry.service == nil {
+			continue
+		}
+		// Each catalog keeps its own best matches; merging those is enough to
+		// rank the overall top results.
+		for _, match := range entry.service.Search(query, limit) {
+			matches = append(matches, match)
+			clusterNames[match.Summary.Ref.ClusterID] = entry.meta.Name
+		}
+	}
+	objectcatalog.SortSearchMatches(matches)
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]ObjectSearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, ObjectSearchResult{
+			Ref:         match.Summary.Ref,
+			ClusterName: clusterNames[match.Summary.Ref.ClusterID],
+			Score:       match.Score,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,51 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestSearchObjectsRanksAcrossClusters(t *testing.T) {
+	app := NewApp()
+	deployment := func(clusterID, name string) objectcatalog.Summary {
+		return objectcatalog.Summary{
+			Ref:   resourcemodel.ResourceRef{ClusterID: clusterID, Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "prod", Name: name},
+			Scope: objectcatalog.ScopeNamespace,
+		}
+	}
+	clusterA := objectcatalog.NewService(objectcatalog.Dependencies{}, nil)
+	setCatalogServiceItems(t, clusterA, map[string]objectcatalog.Summary{
+		"checkout-worker": deployment("cluster-a", "checkout-worker"),
+		"payments":        deployment("cluster-a", "payments"),
+	})
+	clusterB := objectcatalog.NewService(objectcatalog.Dependencies{}, nil)
+	setCatalogServiceItems(t, clusterB, map[string]objectcatalog.Summary{
+		"checkout": deployment("cluster-b", "checkout"),
+	})
+	app.storeObjectCatalogEntry("cluster-a", &objectCatalogEntry{service: clusterA, meta: ClusterMeta{ID: "cluster-a", Name: "Cluster A"}})
+	app.storeObjectCatalogEntry("cluster-b", &objectCatalogEntry{service: clusterB, meta: ClusterMeta{ID: "cluster-b", Name: "Cluster B"}})
+
+	results, err := app.SearchObjects("checkout", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "checkout", results[0].Ref.Name, "the exact name match ranks first")
+	require.Equal(t, "Cluster B", results[0].ClusterName)
+	require.Equal(t, "cluster-a", results[1].Ref.ClusterID)
+	require.Greater(t, results[0].Score, results[1].Score)
+
+	results, err = app.SearchObjects("checkout", 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	results, err = app.SearchObjects("kind:deployment ns:prod", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	results, err = app.SearchObjects("  ", 0)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
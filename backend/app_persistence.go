@@ -119,6 +119,7 @@ func (a *App) savePersistenceFile(state *persistenceFile) error {
 	if err := writeFileAtomic(configFile, data, 0o644); err != nil {
 		return fmt.Errorf("failed to write persistence file: %w", err)
 	}
+	a.scheduleSettingsSync()
 	return nil
 }
 
@@ -0,0 +1,201 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+)
+
+// Pins. Each cluster keeps a list of pinned namespaces and pinned objects in
+// its settings section, in the order they were pinned, so the sidebar's
+// pinned section survives restarts. GetPinnedSummaries resolves every pin
+// against the cluster's object catalog to show what it is now.
+
+// PinnedResource identifies one pinned object.
+type PinnedResource struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// ClusterPins are the namespaces and objects pinned in one cluster.
+type ClusterPins struct {
+	Namespaces []string         `json:"namespaces"`
+	Resources  []PinnedResource `json:"resources"`
+}
+
+// PinnedSummary is one pin with its live catalog entry. Exactly one of
+// Namespace and Resource is set.
+type PinnedSummary struct {
+	Namespace string          `json:"namespace,omitempty"`
+	Resource  *PinnedResource `json:"resource,omitempty"`
+	// Summary is the object's catalog entry; nil when it is missing or the
+	// cluster's catalog is not running.
+	Summary *objectcatalog.Summary `json:"summary,omitempty"`
+	// Missing is set when the catalog is running but does not have the object.
+	Missing bool `json:"missing,omitempty"`
+}
+
+// PinnedSummaries are a cluster's pins resolved against its catalog, in pin
+// order.
+type PinnedSummaries struct {
+	Namespaces []PinnedSummary `json:"namespaces"`
+	Resources  []PinnedSummary `json:"resources"`
+}
+
+func (r *PinnedResource) normalize() error {
+	r.Group = strings.TrimSpace(r.Group)
+	r.Version = strings.TrimSpace(r.Version)
+	r.Kind = strings.TrimSpace(r.Kind)
+	r.Namespace = strings.TrimSpace(r.Namespace)
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Version == "" || r.Kind == "" || r.Name == "" {
+		return fmt.Errorf("pinned resource needs version, kind and name")
+	}
+	return nil
+}
+
+// GetPins returns the cluster's pinned namespaces and objects.
+func (a *App) GetPins(clusterID string) (ClusterPins, error) {
+	if clusterID == "" {
+		return ClusterPins{}, fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return ClusterPins{}, err
+	}
+	return clusterPinsFromSection(settings.Clusters[clusterID]), nil
+}
+
+// SetNamespacePinned pins or unpins a namespace and returns the cluster's
+// pins. A new pin goes last; pinning twice is a no-op.
+func (a *App) SetNamespacePinned(clusterID, namespace string, pinned bool) (ClusterPins, error) {
+	namespace = strings.TrimSpace(namespace)
+	if problems := validation.IsDNS1123Label(namespace); len(problems) > 0 {
+		return ClusterPins{}, fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(problems, "; "))
+	}
+	return a.updatePins(clusterID, func(section *settingsClusterSection) {
+		index := -1
+		for i, existing := range section.PinnedNamespaces {
+			if existing == namespace {
+				index = i
+				break
+			}
+		}
+		switch {
+		case pinned && index < 0:
+			section.PinnedNamespaces = append(section.PinnedNamespaces, namespace)
+		case !pinned && index >= 0:
+			section.PinnedNamespaces = append(section.PinnedNamespaces[:index], section.PinnedNamespaces[index+1:]...)
+		}
+	})
+}
+
+// SetResourcePinned pins or unpins an object and returns the cluster's pins.
+// Objects are matched by group, kind, namespace and name, so re-pinning at
+// another version replaces the stored version.
+func (a *App) SetResourcePinned(clusterID string, resource PinnedResource, pinned bool) (ClusterPins, error) {
+	if err := resource.normalize(); err != nil {
+		return ClusterPins{}, err
+	}
+	return a.updatePins(clusterID, func(section *settingsClusterSection) {
+		index := -1
+		for i, existing := range section.PinnedResources {
+			if existing.Group == resource.Group && existing.Kind == resource.Kind &&
+				existing.Namespace == resource.Namespace && existing.Name == resource.Name {
+				index = i
+				break
+			}
+		}
+		switch {
+		case pinned && index < 0:
+			section.PinnedResources = append(section.PinnedResources, resource)
+		case pinned:
+			section.PinnedResources[index] = resource
+		case index >= 0:
+			section.PinnedResources = append(section.PinnedResources[:index], section.PinnedResources[index+1:]...)
+		}
+	})
+}
+
+// GetPinnedSummaries resolves the cluster's pins against its object catalog.
+// It makes no API calls.
+func (a *App) GetPinnedSummaries(clusterID string) (*PinnedSummaries, error) {
+	pins, err := a.GetPins(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	svc := a.objectCatalogServiceForCluster(clusterID)
+	find := func(namespace, group, version, kind, name string) (*objectcatalog.Summary, bool) {
+		if svc == nil {
+			return nil, false
+		}
+		match, ok := svc.FindExactMatch(namespace, group, version, kind, name)
+		if !ok {
+			return nil, true
+		}
+		return &match, false
+	}
+
+	summaries := &PinnedSummaries{
+		Namespaces: make([]PinnedSummary, 0, len(pins.Namespaces)),
+		Resources:  make([]PinnedSummary, 0, len(pins.Resources)),
+	}
+	for _, namespace := range pins.Namespaces {
+		summary, missing := find("", "", "v1", "Namespace", namespace)
+		summaries.Namespaces = append(summaries.Namespaces, PinnedSummary{Namespace: namespace, Summary: summary, Missing: missing})
+	}
+	for i := range pins.Resources {
+		resource := &pins.Resources[i]
+		summary, missing := find(resource.Namespace, resource.Group, resource.Version, resource.Kind, resource.Name)
+		summaries.Resources = append(summaries.Resources, PinnedSummary{Resource: resource, Summary: summary, Missing: missing})
+	}
+	return summaries, nil
+}
+
+// updatePins applies change to the cluster's settings section and persists it.
+func (a *App) updatePins(clusterID string, change func(*settingsClusterSection)) (ClusterPins, error) {
+	if clusterID == "" {
+		return ClusterPins{}, fmt.Errorf("clusterID is required")
+	}
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return ClusterPins{}, err
+	}
+	section := settings.Clusters[clusterID]
+	change(&section)
+	if len(section.PinnedNamespaces) == 0 {
+		section.PinnedNamespaces = nil
+	}
+	if len(section.PinnedResources) == 0 {
+		section.PinnedResources = nil
+	}
+	if clusterSettingsSectionEmpty(section) {
+		delete(settings.Clusters, clusterID)
+	} else {
+		if settings.Clusters == nil {
+			settings.Clusters = map[string]settingsClusterSection{}
+		}
+		settings.Clusters[clusterID] = section
+	}
+	if err := a.saveSettingsFile(settings); err != nil {
+		return ClusterPins{}, err
+	}
+	return clusterPinsFromSection(section), nil
+}
+
+func clusterPinsFromSection(section settingsClusterSection) ClusterPins {
+	return ClusterPins{
+		Namespaces: append([]string{}, section.PinnedNamespaces...),
+		Resources:  append([]PinnedResource{}, section.PinnedResources...),
+	}
+}
@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestPinsPersistInPinOrder(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetNamespacePinned("", "prod", true)
+	require.Error(t, err)
+	_, err = app.SetNamespacePinned("c1", "Not_Valid", true)
+	require.ErrorContains(t, err, "invalid namespace")
+	_, err = app.SetResourcePinned("c1", PinnedResource{Kind: "Deployment", Name: "web"}, true)
+	require.ErrorContains(t, err, "needs version, kind and name")
+
+	_, err = app.SetNamespacePinned("c1", "prod", true)
+	require.NoError(t, err)
+	_, err = app.SetNamespacePinned("c1", "dev", true)
+	require.NoError(t, err)
+	pins, err := app.SetNamespacePinned("c1", "prod", true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"prod", "dev"}, pins.Namespaces)
+
+	web := PinnedResource{Group: "apps", Version: "v1beta1", Kind: "Deployment", Namespace: "prod", Name: "web"}
+	_, err = app.SetResourcePinned("c1", web, true)
+	require.NoError(t, err)
+	web.Version = "v1"
+	pins, err = app.SetResourcePinned("c1", web, true)
+	require.NoError(t, err)
+	require.Equal(t, []PinnedResource{web}, pins.Resources, "re-pinning replaces the stored version")
+
+	loaded, err := app.GetPins("c1")
+	require.NoError(t, err)
+	require.Equal(t, pins, loaded)
+
+	_, err = app.SetNamespacePinned("c1", "prod", false)
+	require.NoError(t, err)
+	_, err = app.SetNamespacePinned("c1", "dev", false)
+	require.NoError(t, err)
+	_, err = app.SetResourcePinned("c1", web, false)
+	require.NoError(t, err)
+	settings, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.NotContains(t, settings.Clusters, "c1", "an empty section is dropped")
+}
+
+func TestGetPinnedSummariesResolvesAgainstCatalog(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetNamespacePinned("c1", "prod", true)
+	require.NoError(t, err)
+	_, err = app.SetResourcePinned("c1", PinnedResource{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "prod", Name: "web"}, true)
+	require.NoError(t, err)
+	_, err = app.SetResourcePinned("c1", PinnedResource{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "prod", Name: "gone"}, true)
+	require.NoError(t, err)
+
+	// Without a catalog every pin is unresolved but not missing.
+	summaries, err := app.GetPinnedSummaries("c1")
+	require.NoError(t, err)
+	require.Len(t, summaries.Resources, 2)
+	require.Nil(t, summaries.Resources[0].Summary)
+	require.False(t, summaries.Resources[0].Missing)
+
+	svc := objectcatalog.NewService(objectcatalog.Dependencies{}, nil)
+	setCatalogServiceItems(t, svc, map[string]objectcatalog.Summary{
+		"namespace": {
+			Ref:   resourcemodel.ResourceRef{ClusterID: "c1", Version: "v1", Kind: "Namespace", Resource: "namespaces", Name: "prod"},
+			Scope: objectcatalog.ScopeCluster,
+		},
+		"web": {
+			Ref:   resourcemodel.ResourceRef{ClusterID: "c1", Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "prod", Name: "web"},
+			Scope: objectcatalog.ScopeNamespace,
+		},
+	})
+	app.storeObjectCatalogEntry("c1", &objectCatalogEntry{service: svc, meta: ClusterMeta{ID: "c1", Name: "Cluster"}})
+
+	summaries, err = app.GetPinnedSummaries("c1")
+	require.NoError(t, err)
+	require.Len(t, summaries.Namespaces, 1)
+	require.NotNil(t, summaries.Namespaces[0].Summary)
+	require.Equal(t, "prod", summaries.Namespaces[0].Namespace)
+	require.NotNil(t, summaries.Resources[0].Summary)
+	require.Equal(t, "web", summaries.Resources[0].Summary.Ref.Name)
+	require.Nil(t, summaries.Resources[1].Summary)
+	require.True(t, summaries.Resources[1].Missing)
+}
@@ -0,0 +1,131 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	podspkg "github.com/luxury-yacht/app/backend/resources/pods"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Container file browser. Directory listings, previews and downloads all run
+// over exec, so they need only the pods/exec permission a shell does, and the
+// usual tools in the image (sh, stat, head, tar).
+
+// ListPodDirectory lists a directory in a pod container.
+func (a *App) ListPodDirectory(clusterID string, req PodFileRequest) (*podspkg.DirectoryListing, error) {
+	dir, err := podspkg.CleanContainerPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	capture, err := a.capturePodExec(clusterID, "pod-files", req.Namespace, req.PodName, req.Container, podspkg.ListDirectoryCommand(dir))
+	if err != nil {
+		return nil, err
+	}
+	listing := podspkg.ParseDirectoryListing(capture.container, dir, capture.stdout)
+	return &listing, nil
+}
+
+// PreviewPodFile returns the start of a text file in a pod container.
+func (a *App) PreviewPodFile(clusterID string, req PodFileRequest) (*podspkg.FilePreview, error) {
+	file, err := podspkg.CleanContainerPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	limit := config.PodFilePreviewMaxBytes
+	capture, err := a.capturePodExec(clusterID, "pod-files", req.Namespace, req.PodName, req.Container, podspkg.PreviewFileCommand(file, limit))
+	if err != nil {
+		return nil, err
+	}
+	preview := podspkg.ParseFilePreview(capture.container, file, capture.stdout, limit)
+	return &preview, nil
+}
+
+// DownloadPodFiles asks for a destination and saves the selected entries of a
+// container directory there: a single regular file as itself, anything else
+// as a tar archive, the way `kubectl cp` transfers it.
+func (a *App) DownloadPodFiles(clusterID string, req PodFilesDownloadRequest) (*PodFilesDownloadResult, error) {
+	if err := podspkg.ValidateEntryNames(req.Names); err != nil {
+		return nil, err
+	}
+	dir, err := podspkg.CleanContainerPath(req.Directory)
+	if err != nil {
+		return nil, err
+	}
+	if a.Ctx == nil {
+		return nil, fmt.Errorf("application context is not available")
+	}
+	target, err := a.resolvePodExecTarget(clusterID, "pod-files", req.Namespace, req.PodName, req.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	regularFile := false
+	if len(req.Names) == 1 {
+		ctx, cancel := context.WithTimeout(context.Background(), config.PodExecCaptureTimeout)
+		var out strings.Builder
+		err := target.stream(ctx, podspkg.FileTypeCommand(path.Join(dir, req.Names[0])), &out)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		regularFile = strings.TrimSpace(out.String()) == "file"
+	}
+
+	defaultName := fmt.Sprintf("%s-%s.tar", req.PodName, path.Base(dir))
+	filters := []wailsruntime.FileFilter{{DisplayName: "Tar archives (*.tar)", Pattern: "*.tar"}}
+	switch {
+	case regularFile:
+		defaultName, filters = req.Names[0], nil
+	case len(req.Names) == 1:
+		defaultName = req.Names[0] + ".tar"
+	}
+	destination, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:                "Download From Container",
+		DefaultFilename:      defaultName,
+		Filters:              filters,
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("select download destination: %w", err)
+	}
+	destination = strings.TrimSpace(destination)
+	if destination == "" {
+		return nil, fmt.Errorf("download canceled")
+	}
+
+	file, err := os.Create(destination)
+	if err != nil {
+		return nil, fmt.Errorf("create %s: %w", destination, err)
+	}
+	counter := &countingWriter{w: file}
+	ctx, cancel := context.WithTimeout(context.Background(), config.PodFileDownloadTimeout)
+	defer cancel()
+	streamErr := target.stream(ctx, podspkg.DownloadCommand(dir, req.Names, regularFile), counter)
+	closeErr := file.Close()
+	if streamErr != nil || closeErr != nil {
+		_ = os.Remove(destination)
+		if streamErr != nil {
+			return nil, streamErr
+		}
+		return nil, fmt.Errorf("write %s: %w", destination, closeErr)
+	}
+	return &PodFilesDownloadResult{Path: destination, Bytes: counter.n}, nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
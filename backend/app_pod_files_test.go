@@ -0,0 +1,34 @@
+package backend
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodFileRequestsValidatePaths(t *testing.T) {
+	app := NewApp()
+	app.logger = NewLogger(10)
+
+	_, err := app.ListPodDirectory(shellClusterID, PodFileRequest{Namespace: "default", PodName: "demo", Path: "etc"})
+	require.ErrorContains(t, err, "must be absolute")
+
+	_, err = app.PreviewPodFile(shellClusterID, PodFileRequest{Namespace: "default", PodName: "demo", Path: "etc/hosts"})
+	require.ErrorContains(t, err, "must be absolute")
+
+	_, err = app.DownloadPodFiles(shellClusterID, PodFilesDownloadRequest{Namespace: "default", PodName: "demo", Directory: "/etc", Names: []string{"../shadow"}})
+	require.ErrorContains(t, err, "invalid file name")
+
+	_, err = app.DownloadPodFiles(shellClusterID, PodFilesDownloadRequest{Namespace: "default", PodName: "demo", Directory: "/etc"})
+	require.ErrorContains(t, err, "no files selected")
+}
+
+func TestCountingWriterCountsBytes(t *testing.T) {
+	var buf bytes.Buffer
+	counter := &countingWriter{w: &buf}
+	_, _ = counter.Write([]byte("abc"))
+	_, _ = counter.Write([]byte("de"))
+	require.Equal(t, int64(5), counter.n)
+	require.Equal(t, "abcde", buf.String())
+}
@@ -0,0 +1,59 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	podspkg "github.com/luxury-yacht/app/backend/resources/pods"
+)
+
+// podProcessSample is a container's last process CPU sample.
+type podProcessSample struct {
+	sample  podspkg.ProcessSample
+	takenAt time.Time
+}
+
+// GetPodProcesses lists the processes running in a pod container, the
+// equivalent of `kubectl exec ... ps aux`. It execs a /proc reader rather than
+// ps, which many images do not ship. CPU is measured since the previous call
+// for the same container, so polling it yields top-style usage.
+func (a *App) GetPodProcesses(clusterID string, req PodContainerRequest) (*podspkg.ProcessList, error) {
+	capture, err := a.capturePodExec(clusterID, "pod-processes", req.Namespace, req.PodName, req.Container, podspkg.ProcessListCommand)
+	if err != nil {
+		return nil, err
+	}
+	container := capture.container
+
+	key := strings.Join([]string{clusterID, req.Namespace, req.PodName, string(capture.pod.UID), container}, "/")
+	now := time.Now()
+	list, sample, err := podspkg.ParseProcessList(container, capture.stdout, a.takePodProcessSample(key, now), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes in container %q: %w", container, err)
+	}
+	a.storePodProcessSample(key, podProcessSample{sample: sample, takenAt: now})
+	return &list, nil
+}
+
+// takePodProcessSample returns the container's previous sample, dropping any
+// samples too old to give a meaningful delta.
+func (a *App) takePodProcessSample(key string, now time.Time) podspkg.ProcessSample {
+	a.podProcessSamplesMu.Lock()
+	defer a.podProcessSamplesMu.Unlock()
+	for k, entry := range a.podProcessSamples {
+		if now.Sub(entry.takenAt) > config.PodProcessSampleTTL {
+			delete(a.podProcessSamples, k)
+		}
+	}
+	return a.podProcessSamples[key].sample
+}
+
+func (a *App) storePodProcessSample(key string, entry podProcessSample) {
+	a.podProcessSamplesMu.Lock()
+	defer a.podProcessSamplesMu.Unlock()
+	if a.podProcessSamples == nil {
+		a.podProcessSamples = make(map[string]podProcessSample)
+	}
+	a.podProcessSamples[key] = entry
+}
@@ -0,0 +1,65 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetPodProcessesRejectsUnknownContainer(t *testing.T) {
+	app := NewApp()
+	app.logger = NewLogger(10)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	}
+	app.clusterClients = map[string]*clusterClients{
+		shellClusterID: {
+			meta:              ClusterMeta{ID: shellClusterID, Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            fake.NewClientset(pod),
+			restConfig:        &rest.Config{},
+		},
+	}
+
+	_, err := app.GetPodProcesses(shellClusterID, PodContainerRequest{Namespace: "default"})
+	require.Error(t, err)
+
+	_, err = app.GetPodProcesses(shellClusterID, PodContainerRequest{Namespace: "default", PodName: "demo", Container: "sidecar"})
+	require.ErrorContains(t, err, `container "sidecar" not found`)
+}
+
+func TestResolveExecContainerDefaultsToFirstContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		Containers:          []corev1.Container{{Name: "app"}, {Name: "proxy"}},
+		EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug"}}},
+	}}
+
+	container, err := resolveExecContainer(pod, "")
+	require.NoError(t, err)
+	require.Equal(t, "app", container)
+
+	container, err = resolveExecContainer(pod, "debug")
+	require.NoError(t, err)
+	require.Equal(t, "debug", container)
+
+	_, err = resolveExecContainer(&corev1.Pod{}, "")
+	require.ErrorContains(t, err, "no containers")
+}
+
+func TestPodProcessSamplesExpire(t *testing.T) {
+	app := &App{}
+	now := time.Now()
+	app.storePodProcessSample("fresh", podProcessSample{takenAt: now})
+	app.storePodProcessSample("stale", podProcessSample{takenAt: now.Add(-time.Hour)})
+
+	app.takePodProcessSample("fresh", now)
+	require.Contains(t, app.podProcessSamples, "fresh")
+	require.NotContains(t, app.podProcessSamples, "stale")
+}
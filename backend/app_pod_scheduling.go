@@ -0,0 +1,19 @@
+package backend
+
+import (
+	podspkg "github.com/luxury-yacht/app/backend/resources/pods"
+)
+
+// ExplainPodScheduling answers "why is my pod Pending": the pod's
+// FailedScheduling events next to a per-node re-evaluation of cordons,
+// nodeSelector, required node affinity, taints and resource requests.
+func (a *App) ExplainPodScheduling(clusterID, namespace, name string) (*podspkg.SchedulingExplanation, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Pod scheduling"); err != nil {
+		return nil, err
+	}
+	return podspkg.NewService(deps).ExplainScheduling(namespace, name)
+}
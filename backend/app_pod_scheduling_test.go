@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestExplainPodSchedulingReportsNodeReasons(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"disk": "ssd"},
+				Containers:   []corev1.Container{{Name: "app"}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourcePods: resource.MustParse("10"),
+			}},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	explanation, err := app.ExplainPodScheduling("config:ctx", "default", "web")
+	require.NoError(t, err)
+	require.Equal(t, 0, explanation.FittingNodes)
+	require.Len(t, explanation.Nodes, 1)
+	require.Equal(t, []string{"nodeSelector disk=ssd: label missing"}, explanation.Nodes[0].Reasons)
+
+	_, err = app.ExplainPodScheduling("config:ctx", "default", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/podsecurity"
+)
+
+// GetPodSecurityReport evaluates workloads against the baseline and restricted
+// Pod Security Standards and reports violations per namespace and per
+// workload, alongside each namespace's enforce/audit/warn labels. An empty
+// namespace covers the cluster's allowed-namespace scope (or every namespace
+// when unscoped).
+func (a *App) GetPodSecurityReport(clusterID, namespace string) (*podsecurity.Report, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Pod security"); err != nil {
+		return nil, err
+	}
+	ctx := deps.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	namespaces := a.allowedNamespacesForCluster(deps.ClusterID)
+	if namespace = strings.TrimSpace(namespace); namespace != "" {
+		namespaces = []string{namespace}
+	}
+	return podsecurity.Evaluator{ClusterID: deps.ClusterID, Client: deps.KubernetesClient}.Evaluate(ctx, namespaces)
+}
@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/podsecurity"
+)
+
+func TestGetPodSecurityReportForNamespace(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	report, err := app.GetPodSecurityReport("config:ctx", "default")
+	require.NoError(t, err)
+	require.Equal(t, "config:ctx", report.ClusterID)
+	require.Len(t, report.Namespaces, 1)
+	ns := report.Namespaces[0]
+	require.Equal(t, podsecurity.LevelRestricted, ns.Labels[podsecurity.ModeEnforce].Level)
+	require.Len(t, ns.Workloads, 1)
+	require.True(t, ns.Workloads[0].ViolatesEnforced)
+	require.Equal(t, 1, ns.EnforcedViolations)
+
+	report, err = app.GetPodSecurityReport("config:ctx", "")
+	require.NoError(t, err)
+	require.Len(t, report.Namespaces, 2)
+}
@@ -0,0 +1,24 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	podspkg "github.com/luxury-yacht/app/backend/resources/pods"
+)
+
+// GetPodSockets lists the pod's listening sockets and connections as seen from
+// a container, answering "what port is this actually listening on" without ss
+// or netstat in the image. Sockets are shared by every container in the pod;
+// the container only decides which processes can be named as owners.
+func (a *App) GetPodSockets(clusterID string, req PodContainerRequest) (*podspkg.SocketList, error) {
+	capture, err := a.capturePodExec(clusterID, "pod-sockets", req.Namespace, req.PodName, req.Container, podspkg.SocketListCommand)
+	if err != nil {
+		return nil, err
+	}
+	list, err := podspkg.ParseSocketList(capture.container, capture.stdout, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sockets in container %q: %w", capture.container, err)
+	}
+	return &list, nil
+}
@@ -0,0 +1,29 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetPodSocketsRequiresPod(t *testing.T) {
+	app := NewApp()
+	app.logger = NewLogger(10)
+	app.clusterClients = map[string]*clusterClients{
+		shellClusterID: {
+			meta:              ClusterMeta{ID: shellClusterID, Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            fake.NewClientset(),
+			restConfig:        &rest.Config{},
+		},
+	}
+
+	_, err := app.GetPodSockets(shellClusterID, PodContainerRequest{Namespace: "default"})
+	require.Error(t, err)
+
+	_, err = app.GetPodSockets(shellClusterID, PodContainerRequest{Namespace: "default", PodName: "missing"})
+	require.ErrorContains(t, err, "failed to load pod")
+}
@@ -0,0 +1,109 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Protected namespaces. The protectedNamespaces preference lists path.Match
+// globs (e.g. "kube-system", "prod-*"); destructive object actions in a
+// matching namespace — or on a matching Namespace object itself — are refused
+// unless the request's confirmation repeats the target's name, like GitHub's
+// delete-repository guard.
+
+// errConfirmationRequired marks destructive actions refused for a missing or
+// mismatched typed confirmation.
+var errConfirmationRequired = errors.New("typed confirmation required")
+
+// destructiveObjectActions are the actions guarded in protected namespaces.
+var destructiveObjectActions = map[string]struct{}{
+	ObjectActionDelete:      {},
+	ObjectActionForceDelete: {},
+	ObjectActionRestart:     {},
+	ObjectActionScale:       {},
+	ObjectActionRollback:    {},
+}
+
+// validateProtectedNamespacePatterns rejects malformed globs.
+func validateProtectedNamespacePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid namespace pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchProtectedNamespace returns the first pattern matching namespace.
+func matchProtectedNamespace(patterns []string, namespace string) (string, bool) {
+	if namespace == "" {
+		return "", false
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, namespace); err == nil && matched {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// protectedNamespaceForTarget returns the protected namespace a target lives
+// in, or names, when the target is a Namespace.
+func protectedNamespaceForTarget(patterns []string, target ObjectActionTargetRef) (string, bool) {
+	namespace := target.Namespace
+	if namespace == "" && target.Group == "" && target.Kind == "Namespace" {
+		namespace = target.Name
+	}
+	if _, ok := matchProtectedNamespace(patterns, namespace); ok {
+		return namespace, true
+	}
+	return "", false
+}
+
+// IsNamespaceProtected reports whether destructive actions in namespace need
+// a typed confirmation.
+func (a *App) IsNamespaceProtected(namespace string) (bool, error) {
+	settings, err := a.GetAppSettings()
+	if err != nil {
+		return false, err
+	}
+	_, protected := matchProtectedNamespace(settings.ProtectedNamespaces, strings.TrimSpace(namespace))
+	return protected, nil
+}
+
+// requireProtectedNamespaceConfirmation refuses a destructive action in a
+// protected namespace unless confirmation equals the target name.
+func (a *App) requireProtectedNamespaceConfirmation(action string, target ObjectActionTargetRef, confirmation string) error {
+	namespace, protected, err := a.protectedNamespaceForAction(action, target)
+	if err != nil || !protected || strings.TrimSpace(confirmation) == target.Name {
+		return err
+	}
+	return fmt.Errorf("%w: namespace %s is protected; type %q to confirm %s", errConfirmationRequired, namespace, target.Name, action)
+}
+
+// requireBulkProtectedNamespaceConfirmation is the bulk form: one
+// confirmation covers a selection, so it names the protected namespace rather
+// than each object.
+func (a *App) requireBulkProtectedNamespaceConfirmation(action string, target ObjectActionTargetRef, confirmation string) error {
+	namespace, protected, err := a.protectedNamespaceForAction(action, target)
+	if err != nil || !protected || strings.TrimSpace(confirmation) == namespace {
+		return err
+	}
+	return fmt.Errorf("%w: namespace %s is protected; type %q to confirm bulk %s", errConfirmationRequired, namespace, namespace, action)
+}
+
+// protectedNamespaceForAction returns the protected namespace guarding a
+// destructive action on target, if any.
+func (a *App) protectedNamespaceForAction(action string, target ObjectActionTargetRef) (string, bool, error) {
+	if _, destructive := destructiveObjectActions[action]; !destructive {
+		return "", false, nil
+	}
+	settings, err := a.GetAppSettings()
+	if err != nil {
+		return "", false, fmt.Errorf("%s blocked: could not read protected namespaces: %w", action, err)
+	}
+	namespace, protected := protectedNamespaceForTarget(settings.ProtectedNamespaces, target)
+	return namespace, protected, nil
+}
@@ -0,0 +1,72 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtectedNamespacesPreferenceValidatesPatterns(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{"prod-[", "kube-system"}},
+	}})
+	require.ErrorContains(t, err, "invalid namespace pattern")
+
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{" kube-system ", "prod-*", "prod-*"}},
+	}})
+	require.NoError(t, err)
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Equal(t, []string{"kube-system", "prod-*"}, file.Preferences.ProtectedNamespaces)
+
+	protected, err := app.IsNamespaceProtected("prod-eu")
+	require.NoError(t, err)
+	require.True(t, protected)
+	protected, err = app.IsNamespaceProtected("staging")
+	require.NoError(t, err)
+	require.False(t, protected)
+}
+
+func TestProtectedNamespaceRequiresTypedConfirmation(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{"kube-system", "prod-*"}},
+	}})
+	require.NoError(t, err)
+
+	target := objectActionTarget("kc:ctx", "apps", "v1", "Deployment", "prod-eu", "api")
+	cases := []struct {
+		name         string
+		action       string
+		target       ObjectActionTargetRef
+		confirmation string
+		blocked      bool
+	}{
+		{"delete without confirmation", ObjectActionDelete, target, "", true},
+		{"delete with wrong name", ObjectActionDelete, target, "ap", true},
+		{"delete with typed name", ObjectActionDelete, target, " api ", false},
+		{"scale without confirmation", ObjectActionScale, target, "", true},
+		{"non-destructive action", ObjectActionSuspend, target, "", false},
+		{"unprotected namespace", ObjectActionDelete, objectActionTarget("kc:ctx", "apps", "v1", "Deployment", "dev", "api"), "", false},
+		{"protected namespace object", ObjectActionDelete, objectActionTarget("kc:ctx", "", "v1", "Namespace", "", "kube-system"), "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := app.requireProtectedNamespaceConfirmation(tc.action, tc.target, tc.confirmation)
+			if tc.blocked {
+				require.ErrorIs(t, err, errConfirmationRequired)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	_, err = app.RunObjectAction(ObjectActionRequest{Action: ObjectActionDelete, Target: target})
+	require.ErrorIs(t, err, errConfirmationRequired)
+	require.ErrorContains(t, err, `type "api" to confirm delete`)
+}
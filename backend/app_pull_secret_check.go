@@ -0,0 +1,35 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/pullsecretcheck"
+)
+
+// VerifyImagePullSecrets checks that the pull secrets a workload's pods would
+// use (its own and its service account's) can fetch each of its images'
+// manifests from the registry. Passing images checks those instead, against
+// the workload's or a service account's secrets, before a rollout.
+func (a *App) VerifyImagePullSecrets(clusterID string, req pullsecretcheck.Request) (*pullsecretcheck.Report, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Pull secret check"); err != nil {
+		return nil, err
+	}
+	ctx := deps.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, config.PullSecretCheckTimeout)
+	defer cancel()
+
+	checker := pullsecretcheck.Checker{
+		ClusterID:      deps.ClusterID,
+		Client:         deps.KubernetesClient,
+		RequestTimeout: config.PullSecretRegistryRequestTimeout,
+	}
+	return checker.Check(ctx, req)
+}
@@ -0,0 +1,41 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/pullsecretcheck"
+)
+
+func TestVerifyImagePullSecretsResolvesClusterWorkload(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	// The pod has no images, so the check stops before any registry request.
+	_, err := app.VerifyImagePullSecrets("config:ctx", pullsecretcheck.Request{Namespace: "default", Kind: "Pod", Name: "web"})
+	require.ErrorContains(t, err, "no images to check")
+
+	_, err = app.VerifyImagePullSecrets("config:ctx", pullsecretcheck.Request{Namespace: "default", Kind: "Pod", Name: "missing"})
+	require.Error(t, err)
+
+	_, err = app.VerifyImagePullSecrets("", pullsecretcheck.Request{Namespace: "default"})
+	require.Error(t, err)
+}
@@ -0,0 +1,314 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/common"
+)
+
+// Recycle bin. Every object delete first snapshots the live manifest into
+// recycle-bin.json in the app config dir, so an accidental delete can be
+// undone by re-creating the object. Entries expire after config.RecycleBinTTL.
+// Only the object itself is kept: deleting a Namespace does not capture its
+// contents, and Helm release deletes are not captured at all.
+
+// recycleBinFileName holds the captured manifests. The file is owner-only
+// because captured Secrets keep their data.
+const recycleBinFileName = "recycle-bin.json"
+
+// RecycleBinEntry is one deleted object that can still be restored.
+type RecycleBinEntry struct {
+	ID          string    `json:"id"`
+	ClusterID   string    `json:"clusterId"`
+	ClusterName string    `json:"clusterName,omitempty"`
+	Group       string    `json:"group"`
+	Version     string    `json:"version"`
+	Kind        string    `json:"kind"`
+	Namespace   string    `json:"namespace,omitempty"`
+	Name        string    `json:"name"`
+	DeletedAt   time.Time `json:"deletedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	Manifest    string    `json:"manifest"`
+}
+
+type recycleBinFile struct {
+	Entries []RecycleBinEntry `json:"entries"`
+}
+
+// GetRecycleBin returns the restorable entries, newest first.
+func (a *App) GetRecycleBin() ([]RecycleBinEntry, error) {
+	a.recycleBinMu.Lock()
+	defer a.recycleBinMu.Unlock()
+	state, err := a.loadRecycleBinFile()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]RecycleBinEntry, len(state.Entries))
+	copy(entries, state.Entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].DeletedAt.After(entries[j].DeletedAt)
+	})
+	return entries, nil
+}
+
+// RestoreDeletedObject re-creates a recycle bin entry's object and removes the
+// entry. The entry is kept when the create fails, including when an object
+// with the same name already exists.
+func (a *App) RestoreDeletedObject(id string) (*RecycleBinEntry, error) {
+	entry, err := a.findRecycleBinEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(entry.ClusterID, "restore"); err != nil {
+		return nil, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(entry.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if deps.DynamicClient == nil {
+		return nil, fmt.Errorf("dynamic client not initialized")
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Group:     entry.Group,
+		Version:   entry.Version,
+		Kind:      entry.Kind,
+		Namespace: entry.Namespace,
+		Name:      entry.Name,
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+	obj, err := parseYAMLToUnstructured(entry.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	gvk := schema.GroupVersionKind{Group: entry.Group, Version: entry.Version, Kind: entry.Kind}
+	gvr, namespaced, err := getGVRForGVKWithDependencies(deps.Context, deps, selectionKey, gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve resource mapping for %s: %w", gvk.String(), err)
+	}
+	var resource dynamic.ResourceInterface = deps.DynamicClient.Resource(gvr)
+	if namespaced {
+		resource = deps.DynamicClient.Resource(gvr).Namespace(entry.Namespace)
+	}
+	if _, err := resource.Create(deps.Context, obj, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("%s %s already exists; delete it or discard this entry", entry.Kind, entry.Name)
+		}
+		return nil, wrapKubernetesError(err, "failed to restore object")
+	}
+
+	if err := a.removeRecycleBinEntry(entry.ID); err != nil {
+		a.logger.Warn(fmt.Sprintf("Restored %s %s but could not remove its recycle bin entry: %v", entry.Kind, entry.Name, err), logsources.App, entry.ClusterID, entry.ClusterName)
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, gvk, entry.Namespace, entry.Name)
+	a.logger.Info(fmt.Sprintf("Restored %s %s from the recycle bin", entry.Kind, entry.Name), logsources.App, entry.ClusterID, entry.ClusterName)
+	return entry, nil
+}
+
+// DiscardRecycleBinEntry drops an entry without restoring it.
+func (a *App) DiscardRecycleBinEntry(id string) error {
+	if _, err := a.findRecycleBinEntry(id); err != nil {
+		return err
+	}
+	return a.removeRecycleBinEntry(id)
+}
+
+// captureDeletedObject snapshots the object a delete is about to remove. A
+// failed capture is logged and never blocks the delete.
+func (a *App) captureDeletedObject(deps common.Dependencies, target ObjectActionTargetRef) {
+	entry, err := snapshotRecycleBinEntry(deps, target)
+	if err == nil {
+		entry.ClusterName = a.clusterNameForID(target.ClusterID)
+		err = a.addRecycleBinEntry(*entry)
+	}
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("Could not save %s %s to the recycle bin: %v", target.Kind, target.Name, err), logsources.App, target.ClusterID, a.clusterNameForID(target.ClusterID))
+	}
+}
+
+// snapshotRecycleBinEntry reads the live object and strips the fields the API
+// server owns, so the manifest can be created again as-is.
+func snapshotRecycleBinEntry(deps common.Dependencies, target ObjectActionTargetRef) (*RecycleBinEntry, error) {
+	if deps.DynamicClient == nil {
+		return nil, fmt.Errorf("dynamic client not initialized")
+	}
+	gvk := objectActionTargetGVK(target)
+	gvr, namespaced, err := resolveObjectYAMLGVR(deps.Context, deps, gvk, objectYAMLResolverStrict)
+	if err != nil {
+		return nil, err
+	}
+	var resource dynamic.ResourceInterface = deps.DynamicClient.Resource(gvr)
+	if namespaced {
+		resource = deps.DynamicClient.Resource(gvr).Namespace(target.Namespace)
+	}
+	live, err := resource.Get(deps.Context, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := recycleBinManifest(live)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return &RecycleBinEntry{
+		ID:        uuid.NewString(),
+		ClusterID: target.ClusterID,
+		Group:     target.Group,
+		Version:   target.Version,
+		Kind:      target.Kind,
+		Namespace: target.Namespace,
+		Name:      target.Name,
+		DeletedAt: now,
+		ExpiresAt: now.Add(config.RecycleBinTTL),
+		Manifest:  manifest,
+	}, nil
+}
+
+// recycleBinManifest renders obj as YAML without server-populated fields.
+func recycleBinManifest(obj *unstructured.Unstructured) (string, error) {
+	data, err := yaml.Marshal(stripServerManagedFields(obj).Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize manifest: %w", err)
+	}
+	return string(data), nil
+}
+
+// stripServerManagedFields returns a copy of obj without the fields the API
+// server populates, so the result can be created again as-is.
+func stripServerManagedFields(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	sanitized := sanitizeForUpdate(obj, "")
+	unstructured.RemoveNestedField(sanitized.Object, "metadata", "resourceVersion")
+	return sanitized
+}
+
+func (a *App) findRecycleBinEntry(id string) (*RecycleBinEntry, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, fmt.Errorf("recycle bin entry id is required")
+	}
+	a.recycleBinMu.Lock()
+	defer a.recycleBinMu.Unlock()
+	state, err := a.loadRecycleBinFile()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range state.Entries {
+		if entry.ID == id {
+			return &entry, nil
+		}
+	}
+	return nil, fmt.Errorf("recycle bin entry %s not found or expired", id)
+}
+
+func (a *App) addRecycleBinEntry(entry RecycleBinEntry) error {
+	a.recycleBinMu.Lock()
+	defer a.recycleBinMu.Unlock()
+	state, err := a.loadRecycleBinFile()
+	if err != nil {
+		return err
+	}
+	state.Entries = append(state.Entries, entry)
+	if excess := len(state.Entries) - config.RecycleBinMaxEntries; excess > 0 {
+		state.Entries = state.Entries[excess:]
+	}
+	if err := a.saveRecycleBinFile(state); err != nil {
+		return err
+	}
+	a.emitEvent("recyclebin:changed", map[string]any{"clusterId": entry.ClusterID})
+	return nil
+}
+
+func (a *App) removeRecycleBinEntry(id string) error {
+	a.recycleBinMu.Lock()
+	defer a.recycleBinMu.Unlock()
+	state, err := a.loadRecycleBinFile()
+	if err != nil {
+		return err
+	}
+	kept := state.Entries[:0]
+	clusterID := ""
+	for _, entry := range state.Entries {
+		if entry.ID == id {
+			clusterID = entry.ClusterID
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	state.Entries = kept
+	if err := a.saveRecycleBinFile(state); err != nil {
+		return err
+	}
+	a.emitEvent("recyclebin:changed", map[string]any{"clusterId": clusterID})
+	return nil
+}
+
+// recycleBinFilePath returns the recycle bin path in the app config dir.
+func (a *App) recycleBinFilePath() (string, error) {
+	settingsPath, err := a.getSettingsFilePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(settingsPath), recycleBinFileName), nil
+}
+
+// loadRecycleBinFile reads the recycle bin with expired entries dropped.
+// Callers hold recycleBinMu.
+func (a *App) loadRecycleBinFile() (*recycleBinFile, error) {
+	path, err := a.recycleBinFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &recycleBinFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recycle bin: %w", err)
+	}
+	state := &recycleBinFile{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse recycle bin: %w", err)
+	}
+	now := time.Now()
+	live := state.Entries[:0]
+	for _, entry := range state.Entries {
+		if now.Before(entry.ExpiresAt) {
+			live = append(live, entry)
+		}
+	}
+	state.Entries = live
+	return state, nil
+}
+
+func (a *App) saveRecycleBinFile(state *recycleBinFile) error {
+	path, err := a.recycleBinFilePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recycle bin: %w", err)
+	}
+	if err := writeFileAtomic(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write recycle bin: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestDeleteCapturesObjectAndRestoreRecreatesIt(t *testing.T) {
+	const clusterID = "recycle-bin"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	dynamicClient := app.clusterClients[clusterID].dynamicClient.(*dynamicfake.FakeDynamicClient)
+	gvr := schema.GroupVersionResource{Group: "rds.services.k8s.aws", Version: "v1alpha1", Resource: "dbinstances"}
+	resource := dynamicClient.Resource(gvr).Namespace("default")
+
+	require.NoError(t, app.deleteResourceByGVK(clusterID, "rds.services.k8s.aws/v1alpha1", "DBInstance", "default", "my-db"))
+	entries, err := app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	require.Equal(t, "DBInstance", entry.Kind)
+	require.Equal(t, "ctx", entry.ClusterName)
+	require.Contains(t, entry.Manifest, "source: ack-rds")
+	require.NotContains(t, entry.Manifest, "resourceVersion")
+	require.NotContains(t, entry.Manifest, "creationTimestamp")
+
+	restored, err := app.RestoreDeletedObject(entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, restored.ID)
+	obj, err := resource.Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "ack-rds", obj.Object["spec"].(map[string]any)["source"])
+	entries, err = app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// A name that is taken again keeps the entry for a later retry.
+	require.NoError(t, app.deleteResourceByGVK(clusterID, "rds.services.k8s.aws/v1alpha1", "DBInstance", "default", "my-db"))
+	_, err = resource.Create(context.Background(), obj, metav1.CreateOptions{})
+	require.NoError(t, err)
+	entries, err = app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	_, err = app.RestoreDeletedObject(entries[0].ID)
+	require.ErrorContains(t, err, "already exists")
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.RestoreDeletedObject(entries[0].ID)
+	require.ErrorIs(t, err, errClusterReadOnly)
+
+	require.NoError(t, app.DiscardRecycleBinEntry(entries[0].ID))
+	require.Error(t, app.DiscardRecycleBinEntry(entries[0].ID))
+}
+
+func TestRecycleBinDropsExpiredEntries(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	now := time.Now().UTC()
+	require.NoError(t, app.saveRecycleBinFile(&recycleBinFile{Entries: []RecycleBinEntry{
+		{ID: "old", Kind: "ConfigMap", Name: "a", DeletedAt: now.Add(-48 * time.Hour), ExpiresAt: now.Add(-24 * time.Hour)},
+		{ID: "first", Kind: "ConfigMap", Name: "b", DeletedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+		{ID: "second", Kind: "ConfigMap", Name: "c", DeletedAt: now, ExpiresAt: now.Add(time.Hour)},
+	}}))
+
+	entries, err := app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "second", entries[0].ID, "newest first")
+	_, err = app.RestoreDeletedObject("old")
+	require.ErrorContains(t, err, "not found or expired")
+}
@@ -496,6 +496,7 @@ func (a *App) coolClusterToMmapServing(clusterID string) {
 				svc.SetInformerHub(system.NewCooledInformerHub())
 			}
 			a.setCooledClosers(clusterID, closers)
+			subsystem.Freshness.MarkDegraded(refresh.FreshnessReasonFrozen)
 			subsystem.Cooled = true
 		}
 	}
@@ -39,6 +39,12 @@ func (a *App) setupRefreshSubsystem() error {
 	// a.clusterClients, so it must run even if all subsystems fail auth.
 	// Teardown is automatic via a.refreshCancel().
 	go a.startHeartbeatLoop(a.refreshCtx)
+	// Desktop notifications read each subsystem's Attention index; clusters
+	// are picked up as they are built and rebuilt.
+	go a.startNotificationLoop(a.refreshCtx)
+	go a.startAlertLoop(a.refreshCtx)
+	go a.startHealthSummaryLoop(a.refreshCtx)
+	go a.startGitDriftLoop(a.refreshCtx)
 
 	selections, err := a.selectedKubeconfigSelections()
 	if err != nil {
@@ -212,24 +218,29 @@ func (a *App) buildRefreshSubsystemForSelection(
 	clients *clusterClients,
 	clusterMeta ClusterMeta,
 ) (*system.Subsystem, error) {
+	tuning := a.currentRefreshTuning()
 	cfg := system.Config{
-		KubernetesClient:           clients.client,
-		MetricsClient:              clients.metricsClient,
-		RestConfig:                 clients.restConfig,
-		ResyncInterval:             config.RefreshResyncInterval,
-		MetricsInterval:            a.resolveMetricsInterval(),
-		APIExtensionsClient:        clients.apiextensionsClient,
-		GatewayClient:              clients.gatewayClient,
-		GatewayInformerFactory:     clients.gatewayInformerFactory,
-		GatewayAPIPresence:         clients.gatewayAPIPresence,
-		DynamicClient:              clients.dynamicClient,
-		ObjectDetailsProvider:      a.objectDetailProvider(),
-		Logger:                     a.logger,
-		ContainerLogsTargetLimiter: a.sharedContainerLogsTargetLimiter(),
-		ClusterID:                  clusterMeta.ID,
-		ClusterName:                clusterMeta.Name,
-		AllowedNamespaces:          a.allowedNamespacesForCluster(clusterMeta.ID),
-		AttentionIgnoreRules:       a.attentionIgnoreRulesForCluster(clusterMeta.ID),
+		KubernetesClient:                   clients.client,
+		MetricsClient:                      clients.metricsClient,
+		RestConfig:                         clients.restConfig,
+		ResyncInterval:                     tuning.informerResync,
+		MetricsInterval:                    a.resolveMetricsInterval(),
+		APIExtensionsClient:                clients.apiextensionsClient,
+		GatewayClient:                      clients.gatewayClient,
+		GatewayInformerFactory:             clients.gatewayInformerFactory,
+		GatewayAPIPresence:                 clients.gatewayAPIPresence,
+		DynamicClient:                      clients.dynamicClient,
+		MetadataClient:                     clients.metadataClient,
+		ObjectDetailsProvider:              a.objectDetailProvider(),
+		Logger:                             a.logger,
+		ContainerLogsTargetLimiter:         a.sharedContainerLogsTargetLimiter(),
+		ClusterID:                          clusterMeta.ID,
+		ClusterName:                        clusterMeta.Name,
+		AllowedNamespaces:                  a.allowedNamespacesForCluster(clusterMeta.ID),
+		AttentionIgnoreRules:               a.attentionIgnoreRulesForCluster(clusterMeta.ID),
+		ResourceStreamSubscriberBufferSize: tuning.resourceStreamSubscriberBufferSize,
+		ResourceStreamResumeBufferSize:     tuning.resourceStreamResumeBufferSize,
+		CustomInformerIdleTimeout:          tuning.customInformerIdleTimeout,
 		AttentionIgnoredObjectPruner: func(ref resourcemodel.ResourceRef) {
 			if err := a.pruneClusterAttentionIgnoredObject(clusterMeta.ID, ref); err != nil {
 				a.logger.Warn(fmt.Sprintf("Could not prune obsolete Attention ignore for cluster %s: %v", clusterMeta.ID, err), logsources.Settings, clusterMeta.ID, clusterMeta.ID)
@@ -248,6 +259,17 @@ func (a *App) buildRefreshSubsystemForSelection(
 		return nil, err
 	}
 
+	// A watch that fails with a connectivity error marks the cluster's data as
+	// possibly stale and triggers a health probe, so a dropped connection is
+	// reported before the next heartbeat tick.
+	if subsystem.InformerFactory != nil {
+		freshness := subsystem.Freshness
+		subsystem.InformerFactory.SetConnectivityObserver(func(error) {
+			freshness.MarkDegraded(refresh.FreshnessReasonConnectivity)
+			a.probeClusterHealthSoon(clusterMeta.ID)
+		})
+	}
+
 	// Transition to loading now that the subsystem is built and about to
 	// start serving data. This is the single place where loading is set,
 	// regardless of whether the cluster was opened at startup, via the
@@ -257,6 +279,21 @@ func (a *App) buildRefreshSubsystemForSelection(
 	// Watch informer updates to invalidate cached detail/YAML/helm responses.
 	a.registerResponseCacheInvalidation(subsystem, clusterMeta.ID)
 
+	// Feed Pod and workload rows to the user-defined alert rules.
+	a.registerAlertSinks(subsystem, clusterMeta.ID)
+
+	// Record container terminations for the workload crash history.
+	a.registerCrashHistorySink(subsystem, clusterMeta.ID)
+
+	// Record HPA status changes and events for the scaling history.
+	a.registerHPAHistoryHandlers(subsystem, clusterMeta.ID)
+
+	// Record ConfigMap and Secret data changes for the config history.
+	a.registerConfigHistorySinks(subsystem, clusterMeta.ID)
+
+	// Index pod container images for image search.
+	a.registerImageIndexSink(subsystem, clusterMeta.ID)
+
 	// Cluster-Ready self-build rides the namespaces doorbell; wired here so
 	// selector-opened and auth-recovery subsystems get it too.
 	a.wireNamespacesReadinessObserver(clusterMeta.ID, subsystem)
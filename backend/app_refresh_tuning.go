@@ -0,0 +1,198 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+)
+
+// settingsRefreshTuning captures the freshness/load tradeoffs users on slow
+// clusters or small machines may want to tune. Zero fields mean "use the
+// default". Values take effect the next time a cluster connects, because the
+// informer factory, catalog, and resource stream are built per subsystem.
+type settingsRefreshTuning struct {
+	InformerResyncIntervalMs              int `json:"informerResyncIntervalMs,omitempty"`
+	ObjectCatalogResyncIntervalMs         int `json:"objectCatalogResyncIntervalMs,omitempty"`
+	ObjectCatalogPageSize                 int `json:"objectCatalogPageSize,omitempty"`
+	ObjectCatalogPromotionThreshold       int `json:"objectCatalogPromotionThreshold,omitempty"`
+	ObjectCatalogStreamingBatchSize       int `json:"objectCatalogStreamingBatchSize,omitempty"`
+	ObjectCatalogStreamingFlushIntervalMs int `json:"objectCatalogStreamingFlushIntervalMs,omitempty"`
+	ResourceStreamSubscriberBufferSize    int `json:"resourceStreamSubscriberBufferSize,omitempty"`
+	ResourceStreamResumeBufferSize        int `json:"resourceStreamResumeBufferSize,omitempty"`
+	CustomInformerIdleTimeoutMs           int `json:"customInformerIdleTimeoutMs,omitempty"`
+}
+
+// Refresh tuning bounds. The defaults are the compiled-in config values; the
+// bounds keep a hand-edited settings file from starving the cluster with
+// sub-second resyncs or the app with unbounded buffers.
+const (
+	defaultInformerResyncIntervalMs              = int(config.RefreshResyncInterval / time.Millisecond)
+	minInformerResyncIntervalMs                  = 5000
+	maxInformerResyncIntervalMs                  = 600000
+	defaultObjectCatalogResyncIntervalMs         = int(config.ObjectCatalogResyncInterval / time.Millisecond)
+	minObjectCatalogResyncIntervalMs             = 15000
+	maxObjectCatalogResyncIntervalMs             = 3600000
+	defaultObjectCatalogPageSize                 = config.ObjectCatalogPageSize
+	minObjectCatalogPageSize                     = 10
+	maxObjectCatalogPageSize                     = 500
+	defaultObjectCatalogPromotionThreshold       = config.ObjectCatalogInformerPromotionThreshold
+	minObjectCatalogPromotionThreshold           = 500
+	maxObjectCatalogPromotionThreshold           = 100000
+	defaultObjectCatalogStreamingBatchSize       = config.ObjectCatalogStreamingBatchSize
+	minObjectCatalogStreamingBatchSize           = 10
+	maxObjectCatalogStreamingBatchSize           = 1000
+	defaultObjectCatalogStreamingFlushIntervalMs = int(config.ObjectCatalogStreamingFlushInterval / time.Millisecond)
+	minObjectCatalogStreamingFlushIntervalMs     = 50
+	maxObjectCatalogStreamingFlushIntervalMs     = 5000
+	defaultResourceStreamSubscriberBufferSize    = config.ResourceStreamSubscriberBufferSize
+	minResourceStreamSubscriberBufferSize        = 32
+	maxResourceStreamSubscriberBufferSize        = 4096
+	defaultResourceStreamResumeBufferSize        = config.ResourceStreamResumeBufferSize
+	minResourceStreamResumeBufferSize            = 100
+	maxResourceStreamResumeBufferSize            = 10000
+	defaultCustomInformerIdleTimeoutMs           = int(config.ResourceStreamCustomInformerIdleTimeout / time.Millisecond)
+	minCustomInformerIdleTimeoutMs               = 10000
+	maxCustomInformerIdleTimeoutMs               = 3600000
+)
+
+// refreshTuningField ties one tuning value to its persisted field, its
+// AppSettings field, and its bounds, so load/save/normalize walk one table.
+type refreshTuningField struct {
+	key                              string
+	defaultValue, minValue, maxValue int
+	persisted                        func(*settingsRefreshTuning) *int
+	setting                          func(*AppSettings) *int
+}
+
+func refreshTuningFields() []refreshTuningField {
+	return []refreshTuningField{
+		{appPreferenceInformerResyncIntervalMs, defaultInformerResyncIntervalMs, minInformerResyncIntervalMs, maxInformerResyncIntervalMs,
+			func(t *settingsRefreshTuning) *int { return &t.InformerResyncIntervalMs },
+			func(s *AppSettings) *int { return &s.InformerResyncIntervalMs }},
+		{appPreferenceObjectCatalogResyncIntervalMs, defaultObjectCatalogResyncIntervalMs, minObjectCatalogResyncIntervalMs, maxObjectCatalogResyncIntervalMs,
+			func(t *settingsRefreshTuning) *int { return &t.ObjectCatalogResyncIntervalMs },
+			func(s *AppSettings) *int { return &s.ObjectCatalogResyncIntervalMs }},
+		{appPreferenceObjectCatalogPageSize, defaultObjectCatalogPageSize, minObjectCatalogPageSize, maxObjectCatalogPageSize,
+			func(t *settingsRefreshTuning) *int { return &t.ObjectCatalogPageSize },
+			func(s *AppSettings) *int { return &s.ObjectCatalogPageSize }},
+		{appPreferenceObjectCatalogPromotionThreshold, defaultObjectCatalogPromotionThreshold, minObjectCatalogPromotionThreshold, maxObjectCatalogPromotionThreshold,
+			func(t *settingsRefreshTuning) *int { return &t.ObjectCatalogPromotionThreshold },
+			func(s *AppSettings) *int { return &s.ObjectCatalogPromotionThreshold }},
+		{appPreferenceObjectCatalogStreamingBatchSize, defaultObjectCatalogStreamingBatchSize, minObjectCatalogStreamingBatchSize, maxObjectCatalogStreamingBatchSize,
+			func(t *settingsRefreshTuning) *int { return &t.ObjectCatalogStreamingBatchSize },
+			func(s *AppSettings) *int { return &s.ObjectCatalogStreamingBatchSize }},
+		{appPreferenceObjectCatalogStreamingFlushIntervalMs, defaultObjectCatalogStreamingFlushIntervalMs, minObjectCatalogStreamingFlushIntervalMs, maxObjectCatalogStreamingFlushIntervalMs,
+			func(t *settingsRefreshTuning) *int { return &t.ObjectCatalogStreamingFlushIntervalMs },
+			func(s *AppSettings) *int { return &s.ObjectCatalogStreamingFlushIntervalMs }},
+		{appPreferenceResourceStreamSubscriberBufferSize, defaultResourceStreamSubscriberBufferSize, minResourceStreamSubscriberBufferSize, maxResourceStreamSubscriberBufferSize,
+			func(t *settingsRefreshTuning) *int { return &t.ResourceStreamSubscriberBufferSize },
+			func(s *AppSettings) *int { return &s.ResourceStreamSubscriberBufferSize }},
+		{appPreferenceResourceStreamResumeBufferSize, defaultResourceStreamResumeBufferSize, minResourceStreamResumeBufferSize, maxResourceStreamResumeBufferSize,
+			func(t *settingsRefreshTuning) *int { return &t.ResourceStreamResumeBufferSize },
+			func(s *AppSettings) *int { return &s.ResourceStreamResumeBufferSize }},
+		{appPreferenceCustomInformerIdleTimeoutMs, defaultCustomInformerIdleTimeoutMs, minCustomInformerIdleTimeoutMs, maxCustomInformerIdleTimeoutMs,
+			func(t *settingsRefreshTuning) *int { return &t.CustomInformerIdleTimeoutMs },
+			func(s *AppSettings) *int { return &s.CustomInformerIdleTimeoutMs }},
+	}
+}
+
+// resolve returns the clamped value, substituting the default for zero.
+func (f refreshTuningField) resolve(value int) int {
+	if value <= 0 {
+		return f.defaultValue
+	}
+	return clampInt(value, f.minValue, f.maxValue)
+}
+
+// refreshTuningPreferences declares the tuning fields in the app preference
+// table. None has a runtime side effect: running subsystems keep their values
+// until the cluster reconnects.
+func refreshTuningPreferences() []preferenceDescriptor {
+	fields := refreshTuningFields()
+	descriptors := make([]preferenceDescriptor, 0, len(fields))
+	for _, field := range fields {
+		descriptors = append(descriptors, field.preference())
+	}
+	return descriptors
+}
+
+func (f refreshTuningField) preference() preferenceDescriptor {
+	return intPreference(f.key, f.defaultValue, intPtr(f.minValue), intPtr(f.maxValue), false,
+		"", zeroDefaulted(f.defaultValue, clampRange(f.minValue, f.maxValue)), nil, f.setting)
+}
+
+// applyRefreshTuningDefaults fills AppSettings with the compiled-in tuning.
+func applyRefreshTuningDefaults(settings *AppSettings) {
+	for _, field := range refreshTuningFields() {
+		*field.setting(settings) = field.defaultValue
+	}
+}
+
+// loadRefreshTuning copies persisted tuning into AppSettings, clamped.
+func loadRefreshTuning(settings *AppSettings, persisted *settingsRefreshTuning) {
+	if persisted == nil {
+		persisted = &settingsRefreshTuning{}
+	}
+	for _, field := range refreshTuningFields() {
+		*field.setting(settings) = field.resolve(*field.persisted(persisted))
+	}
+}
+
+// saveRefreshTuning persists only the values that differ from the defaults,
+// so a later change to a compiled-in default reaches users who never tuned it.
+func saveRefreshTuning(settings *AppSettings) *settingsRefreshTuning {
+	persisted := &settingsRefreshTuning{}
+	customized := false
+	for _, field := range refreshTuningFields() {
+		value := field.resolve(*field.setting(settings))
+		if value != field.defaultValue {
+			*field.persisted(persisted) = value
+			customized = true
+		}
+	}
+	if !customized {
+		return nil
+	}
+	return persisted
+}
+
+// refreshTuning is the resolved tuning used when building a cluster subsystem.
+type refreshTuning struct {
+	informerResync                     time.Duration
+	objectCatalog                      objectcatalog.Options
+	resourceStreamSubscriberBufferSize int
+	resourceStreamResumeBufferSize     int
+	customInformerIdleTimeout          time.Duration
+}
+
+// currentRefreshTuning reads the tuning from the loaded settings.
+func (a *App) currentRefreshTuning() refreshTuning {
+	settings := getDefaultAppSettings()
+	if a != nil {
+		a.settingsMu.Lock()
+		if a.appSettings != nil {
+			copied := *a.appSettings
+			settings = &copied
+		}
+		a.settingsMu.Unlock()
+	}
+	for _, field := range refreshTuningFields() {
+		*field.setting(settings) = field.resolve(*field.setting(settings))
+	}
+	return refreshTuning{
+		informerResync: time.Duration(settings.InformerResyncIntervalMs) * time.Millisecond,
+		objectCatalog: objectcatalog.Options{
+			ResyncInterval:             time.Duration(settings.ObjectCatalogResyncIntervalMs) * time.Millisecond,
+			PageSize:                   settings.ObjectCatalogPageSize,
+			InformerPromotionThreshold: settings.ObjectCatalogPromotionThreshold,
+			StreamingBatchSize:         settings.ObjectCatalogStreamingBatchSize,
+			StreamingFlushInterval:     time.Duration(settings.ObjectCatalogStreamingFlushIntervalMs) * time.Millisecond,
+			// Options zero values mean "default" except this flag.
+			EnableReactiveUpdates: true,
+		},
+		resourceStreamSubscriberBufferSize: settings.ResourceStreamSubscriberBufferSize,
+		resourceStreamResumeBufferSize:     settings.ResourceStreamResumeBufferSize,
+		customInformerIdleTimeout:          time.Duration(settings.CustomInformerIdleTimeoutMs) * time.Millisecond,
+	}
+}
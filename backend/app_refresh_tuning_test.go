@@ -0,0 +1,79 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+)
+
+func TestRefreshTuningDefaultsMatchConfig(t *testing.T) {
+	tuning := (&App{}).currentRefreshTuning()
+	require.Equal(t, config.RefreshResyncInterval, tuning.informerResync)
+	require.Equal(t, config.ObjectCatalogResyncInterval, tuning.objectCatalog.ResyncInterval)
+	require.Equal(t, config.ObjectCatalogInformerPromotionThreshold, tuning.objectCatalog.InformerPromotionThreshold)
+	require.Equal(t, config.ObjectCatalogStreamingFlushInterval, tuning.objectCatalog.StreamingFlushInterval)
+	require.True(t, tuning.objectCatalog.EnableReactiveUpdates)
+	require.Equal(t, config.ResourceStreamSubscriberBufferSize, tuning.resourceStreamSubscriberBufferSize)
+	require.Equal(t, config.ResourceStreamResumeBufferSize, tuning.resourceStreamResumeBufferSize)
+	require.Equal(t, config.ResourceStreamCustomInformerIdleTimeout, tuning.customInformerIdleTimeout)
+}
+
+func TestRefreshTuningPreferencesClampAndPersist(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceInformerResyncIntervalMs, Value: 60000},
+		{Key: appPreferenceObjectCatalogPromotionThreshold, Value: 1},
+		{Key: appPreferenceResourceStreamResumeBufferSize, Value: 1 << 20},
+	}})
+	require.NoError(t, err)
+
+	tuning := app.currentRefreshTuning()
+	require.Equal(t, time.Minute, tuning.informerResync)
+	require.Equal(t, minObjectCatalogPromotionThreshold, tuning.objectCatalog.InformerPromotionThreshold)
+	require.Equal(t, maxResourceStreamResumeBufferSize, tuning.resourceStreamResumeBufferSize)
+
+	// Only the tuned values are written; the rest follow the defaults.
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Equal(t, &settingsRefreshTuning{
+		InformerResyncIntervalMs:        60000,
+		ObjectCatalogPromotionThreshold: minObjectCatalogPromotionThreshold,
+		ResourceStreamResumeBufferSize:  maxResourceStreamResumeBufferSize,
+	}, file.Preferences.RefreshTuning)
+
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceInformerResyncIntervalMs, Value: 0},
+		{Key: appPreferenceObjectCatalogPromotionThreshold, Value: 0},
+		{Key: appPreferenceResourceStreamResumeBufferSize, Value: 0},
+	}})
+	require.NoError(t, err)
+	file, err = app.loadSettingsFile()
+	require.NoError(t, err)
+	require.Nil(t, file.Preferences.RefreshTuning, "zero resets to the default")
+}
+
+func TestRefreshTuningLoadClampsHandEditedValues(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	file.Preferences.RefreshTuning = &settingsRefreshTuning{
+		InformerResyncIntervalMs:           10,
+		ObjectCatalogStreamingBatchSize:    250,
+		ResourceStreamSubscriberBufferSize: -4,
+	}
+	require.NoError(t, app.saveSettingsFile(file))
+	app.appSettings = nil
+	require.NoError(t, app.loadAppSettings())
+
+	tuning := app.currentRefreshTuning()
+	require.Equal(t, time.Duration(minInformerResyncIntervalMs)*time.Millisecond, tuning.informerResync)
+	require.Equal(t, 250, tuning.objectCatalog.StreamingBatchSize)
+	require.Equal(t, defaultResourceStreamSubscriberBufferSize, tuning.resourceStreamSubscriberBufferSize)
+}
@@ -0,0 +1,25 @@
+package backend
+
+import "github.com/luxury-yacht/app/backend/resources/events"
+
+// GetRelatedEvents returns the most recent Events about one object (a pod,
+// workload, PVC, node, custom resource, ...), newest first, so a details panel
+// can show what happened to it without a search in the events views. A
+// non-positive limit uses events.DefaultRelatedEventsLimit.
+func (a *App) GetRelatedEvents(target ObjectActionTargetRef, limit int) ([]events.Event, error) {
+	target, err := validateObjectActionTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	return events.NewService(deps).RelatedEvents(events.Filter{
+		Namespace:        target.Namespace,
+		ObjectKind:       target.Kind,
+		ObjectName:       target.Name,
+		ObjectAPIVersion: objectActionTargetGVK(target).GroupVersion().String(),
+		ObjectUID:        target.UID,
+	}, limit)
+}
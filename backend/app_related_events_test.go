@@ -0,0 +1,41 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetRelatedEventsReturnsEventsForTarget(t *testing.T) {
+	const clusterID = "events"
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+
+	involved := corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "shop", Name: "cart", UID: "deploy-uid"}
+	other := involved
+	other.Kind = "ReplicaSet"
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client: kubernetesfake.NewClientset(
+			&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "scaled", Namespace: "shop"}, Reason: "ScalingReplicaSet", InvolvedObject: involved},
+			&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "shop"}, Reason: "SuccessfulCreate", InvolvedObject: other},
+		),
+	})
+
+	target := objectActionTarget(clusterID, "apps", "v1", "Deployment", "shop", "cart")
+	target.UID = "deploy-uid"
+	related, err := app.GetRelatedEvents(target, 0)
+	require.NoError(t, err)
+	require.Len(t, related, 1)
+	require.Equal(t, "ScalingReplicaSet", related[0].Reason)
+
+	_, err = app.GetRelatedEvents(objectActionTarget(clusterID, "apps", "v1", "Deployment", "shop", ""), 0)
+	require.Error(t, err)
+}
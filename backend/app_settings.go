@@ -6,11 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"time"
 
+	"github.com/luxury-yacht/app/backend/alerts"
+	"github.com/luxury-yacht/app/backend/gitdrift"
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/containerlogs"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/notifications"
 	"github.com/luxury-yacht/app/backend/refresh/snapshot"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
@@ -35,6 +39,7 @@ const (
 	appPreferenceExclusiveNamespaces                      = "exclusiveNamespaces"
 	appPreferenceAutoRefreshEnabled                       = "autoRefreshEnabled"
 	appPreferenceRefreshBackgroundClustersEnabled         = "refreshBackgroundClustersEnabled"
+	appPreferenceRefreshBinaryEncodingEnabled             = "refreshBinaryEncodingEnabled"
 	appPreferenceMetricsRefreshIntervalMs                 = "metricsRefreshIntervalMs"
 	appPreferenceKubernetesClientQPS                      = "kubernetesClientQPS"
 	appPreferenceKubernetesClientBurst                    = "kubernetesClientBurst"
@@ -63,6 +68,22 @@ const (
 	appPreferenceAccentColorDark                          = "accentColorDark"
 	appPreferenceLinkColorLight                           = "linkColorLight"
 	appPreferenceLinkColorDark                            = "linkColorDark"
+	appPreferenceSecretRevealEnabled                      = "secretRevealEnabled"
+	appPreferenceSecretRedactedKeyPatterns                = "secretRedactedKeyPatterns"
+	appPreferenceTrivyPath                                = "trivyPath"
+	appPreferenceTrivyServerURL                           = "trivyServerURL"
+	appPreferenceInformerResyncIntervalMs                 = "informerResyncIntervalMs"
+	appPreferenceObjectCatalogResyncIntervalMs            = "objectCatalogResyncIntervalMs"
+	appPreferenceObjectCatalogPageSize                    = "objectCatalogPageSize"
+	appPreferenceObjectCatalogPromotionThreshold          = "objectCatalogPromotionThreshold"
+	appPreferenceObjectCatalogStreamingBatchSize          = "objectCatalogStreamingBatchSize"
+	appPreferenceObjectCatalogStreamingFlushIntervalMs    = "objectCatalogStreamingFlushIntervalMs"
+	appPreferenceResourceStreamSubscriberBufferSize       = "resourceStreamSubscriberBufferSize"
+	appPreferenceResourceStreamResumeBufferSize           = "resourceStreamResumeBufferSize"
+	appPreferenceCustomInformerIdleTimeoutMs              = "customInformerIdleTimeoutMs"
+	appPreferenceProtectedNamespaces                      = "protectedNamespaces"
+	appPreferenceUpdateChannel                            = "updateChannel"
+	appPreferenceExternalEditor                           = "externalEditor"
 )
 
 // settingsFile captures the persisted application settings stored in settings.json.
@@ -73,7 +94,12 @@ type settingsFile struct {
 	Kubeconfig    settingsKubeconfig                `json:"kubeconfig"`
 	UI            settingsUI                        `json:"ui"`
 	Attention     *settingsGlobalAttentionRules     `json:"attention,omitempty"`
+	Notifications *notifications.Settings           `json:"notifications,omitempty"`
+	AlertRules    []alerts.Rule                     `json:"alertRules,omitempty"`
+	ShellCommands []ShellCommandRule                `json:"shellCommands,omitempty"`
 	Clusters      map[string]settingsClusterSection `json:"clusters,omitempty"`
+	Sync          *settingsSync                     `json:"sync,omitempty"`
+	LocalAPI      *settingsLocalAPI                 `json:"localApi,omitempty"`
 }
 
 type settingsGlobalAttentionRules struct {
@@ -94,26 +120,53 @@ type settingsClusterSection struct {
 	// data path runs cluster-wide.
 	AllowedNamespaces []string                       `json:"allowedNamespaces,omitempty"`
 	Attention         *settingsClusterAttentionRules `json:"attention,omitempty"`
+	// ReadOnly rejects every mutating backend method for the cluster
+	// regardless of RBAC.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Notifications opts the cluster in to desktop notifications.
+	Notifications bool `json:"notifications,omitempty"`
+	// GitDrift ties namespaces to manifest directories of git working copies.
+	GitDrift []gitdrift.Source `json:"gitDrift,omitempty"`
+	// PinnedNamespaces and PinnedResources back the sidebar's pinned section,
+	// in pin order.
+	PinnedNamespaces []string         `json:"pinnedNamespaces,omitempty"`
+	PinnedResources  []PinnedResource `json:"pinnedResources,omitempty"`
+	// KubernetesAPI overrides the app-wide client limits for the cluster.
+	KubernetesAPI *settingsClusterKubernetesAPI `json:"kubernetesAPI,omitempty"`
+}
+
+// settingsClusterKubernetesAPI holds one cluster's client limit overrides;
+// zero fields fall back to the app-wide preferences.
+type settingsClusterKubernetesAPI struct {
+	ClientQPS             int `json:"clientQPS,omitempty"`
+	ClientBurst           int `json:"clientBurst,omitempty"`
+	RequestTimeoutSeconds int `json:"requestTimeoutSeconds,omitempty"`
 }
 
 // settingsPreferences captures user-configurable preferences.
 type settingsPreferences struct {
-	AppearanceMode                string                 `json:"appearanceMode"`
-	UseShortResourceNames         bool                   `json:"useShortResourceNames"`
-	DimInactiveNamespaces         *bool                  `json:"dimInactiveNamespaces,omitempty"`
-	ExclusiveNamespaces           *bool                  `json:"exclusiveNamespaces,omitempty"`
-	Refresh                       *settingsRefresh       `json:"refresh"`
-	KubernetesAPI                 *settingsKubernetesAPI `json:"kubernetesAPI,omitempty"`
-	ObjPanelLogs                  *settingsObjPanelLogs  `json:"objPanelLogs,omitempty"`
-	GridTablePersistenceMode      string                 `json:"gridTablePersistenceMode"`
-	DefaultTablePageSize          int                    `json:"defaultTablePageSize"`
-	DefaultObjectPanelPosition    string                 `json:"defaultObjectPanelPosition"`
-	ObjectPanelDockedRightWidth   int                    `json:"objectPanelDockedRightWidth"`
-	ObjectPanelDockedBottomHeight int                    `json:"objectPanelDockedBottomHeight"`
-	ObjectPanelFloatingWidth      int                    `json:"objectPanelFloatingWidth"`
-	ObjectPanelFloatingHeight     int                    `json:"objectPanelFloatingHeight"`
-	ObjectPanelFloatingX          int                    `json:"objectPanelFloatingX"`
-	ObjectPanelFloatingY          int                    `json:"objectPanelFloatingY"`
+	AppearanceMode        string                 `json:"appearanceMode"`
+	UseShortResourceNames bool                   `json:"useShortResourceNames"`
+	DimInactiveNamespaces *bool                  `json:"dimInactiveNamespaces,omitempty"`
+	ExclusiveNamespaces   *bool                  `json:"exclusiveNamespaces,omitempty"`
+	Refresh               *settingsRefresh       `json:"refresh"`
+	KubernetesAPI         *settingsKubernetesAPI `json:"kubernetesAPI,omitempty"`
+	ObjPanelLogs          *settingsObjPanelLogs  `json:"objPanelLogs,omitempty"`
+	Secrets               *settingsSecrets       `json:"secrets,omitempty"`
+	VulnerabilityScan     *settingsVulnScan      `json:"vulnerabilityScan,omitempty"`
+	RefreshTuning         *settingsRefreshTuning `json:"refreshTuning,omitempty"`
+	// ProtectedNamespaces are glob patterns; destructive actions in matching
+	// namespaces need a typed confirmation.
+	ProtectedNamespaces           []string `json:"protectedNamespaces,omitempty"`
+	GridTablePersistenceMode      string   `json:"gridTablePersistenceMode"`
+	DefaultTablePageSize          int      `json:"defaultTablePageSize"`
+	DefaultObjectPanelPosition    string   `json:"defaultObjectPanelPosition"`
+	ObjectPanelDockedRightWidth   int      `json:"objectPanelDockedRightWidth"`
+	ObjectPanelDockedBottomHeight int      `json:"objectPanelDockedBottomHeight"`
+	ObjectPanelFloatingWidth      int      `json:"objectPanelFloatingWidth"`
+	ObjectPanelFloatingHeight     int      `json:"objectPanelFloatingHeight"`
+	ObjectPanelFloatingX          int      `json:"objectPanelFloatingX"`
+	ObjectPanelFloatingY          int      `json:"objectPanelFloatingY"`
 
 	// Migration: old single-value palette fields, read-only, omitted when zero.
 	PaletteHue        int `json:"paletteHue,omitempty"`
@@ -134,6 +187,17 @@ type settingsPreferences struct {
 
 	// Saved theme library. Order matters: first match wins for cluster pattern matching.
 	Themes []Theme `json:"themes,omitempty"`
+
+	// Keybinding overrides; actions not listed use their default chord.
+	Keybindings []KeyBinding `json:"keybindings,omitempty"`
+
+	// UpdateChannel picks the releases the update check follows: "stable"
+	// or "beta" (prereleases included).
+	UpdateChannel string `json:"updateChannel,omitempty"`
+
+	// ExternalEditor is the command that opens YAML outside the app; empty
+	// picks $VISUAL, $EDITOR or VS Code.
+	ExternalEditor string `json:"externalEditor,omitempty"`
 }
 
 func (p *settingsPreferences) UnmarshalJSON(data []byte) error {
@@ -165,6 +229,8 @@ type settingsRefresh struct {
 	Auto              bool `json:"auto"`
 	Background        bool `json:"background"`
 	MetricsIntervalMs int  `json:"metricsIntervalMs"`
+	// BinaryEncoding asks for CBOR snapshot and stream payloads.
+	BinaryEncoding bool `json:"binaryEncoding,omitempty"`
 }
 
 // settingsKubernetesAPI captures user-configurable Kubernetes API client settings.
@@ -183,6 +249,20 @@ type settingsObjPanelLogs struct {
 	UseLocalTimeZone    bool   `json:"useLocalTimeZone"`    // Render the Kubernetes API timestamp in the user's local timezone instead of UTC
 }
 
+// settingsSecrets captures the Secret value reveal controls. Reveal is off
+// until the user opts in; redacted key patterns are withheld even on reveal.
+type settingsSecrets struct {
+	RevealEnabled       bool     `json:"revealEnabled"`
+	RedactedKeyPatterns []string `json:"redactedKeyPatterns,omitempty"`
+}
+
+// settingsVulnScan locates the Trivy scanner. An empty path resolves "trivy"
+// from PATH; a server URL switches Trivy to client mode against that server.
+type settingsVulnScan struct {
+	TrivyPath      string `json:"trivyPath,omitempty"`
+	TrivyServerURL string `json:"trivyServerURL,omitempty"`
+}
+
 // Object Panel Logs Tab buffer size bounds. The frontend clamps to the same
 // range, so the client can't push values outside these limits; clamping again
 // in the setter is defence in depth.
@@ -203,6 +283,7 @@ const (
 	defaultKubernetesClientBurst           = config.KubernetesClientBurst
 	minKubernetesClientBurst               = 1
 	maxKubernetesClientBurst               = 10000
+	maxKubernetesRequestTimeoutSeconds     = 3600
 	defaultPermissionSSRRFetchConcurrency  = config.PermissionSSRRFetchConcurrency
 	minPermissionSSRRFetchConcurrency      = 1
 	maxPermissionSSRRFetchConcurrency      = config.PermissionSSRRFetchConcurrency * 8
@@ -294,6 +375,7 @@ func defaultSettingsFile() *settingsFile {
 				APITimestampFormat:  defaultObjPanelLogsAPITimestampFormat,
 			},
 
+			UpdateChannel:                 defaultUpdateChannel,
 			GridTablePersistenceMode:      "shared",
 			DefaultTablePageSize:          defaultTablePageSize,
 			DefaultObjectPanelPosition:    defaultObjectPanelPosition,
@@ -386,6 +468,9 @@ func normalizeSettingsFile(settings *settingsFile) *settingsFile {
 	if settings.Preferences.GridTablePersistenceMode == "" {
 		settings.Preferences.GridTablePersistenceMode = "shared"
 	}
+	if !slices.Contains(updateChannels, settings.Preferences.UpdateChannel) {
+		settings.Preferences.UpdateChannel = defaultUpdateChannel
+	}
 	if settings.Preferences.DefaultObjectPanelPosition == "" {
 		settings.Preferences.DefaultObjectPanelPosition = defaultObjectPanelPosition
 	}
@@ -584,6 +669,12 @@ func (a *App) saveSettingsFile(settings *settingsFile) error {
 	if err := writeSettingsFileAtomic(configFile, data, 0o644); err != nil {
 		return fmt.Errorf("failed to write settings file: %w", err)
 	}
+	syncDirectory := ""
+	if settings.Sync != nil {
+		syncDirectory = settings.Sync.Directory
+	}
+	a.setSettingsSyncDirectory(syncDirectory)
+	a.scheduleSettingsSync()
 	return nil
 }
 
@@ -659,7 +750,7 @@ func (a *App) LoadWindowSettings() (*WindowSettings, error) {
 }
 
 func getDefaultAppSettings() *AppSettings {
-	return &AppSettings{
+	settings := &AppSettings{
 		AppearanceMode:                           "system",
 		SelectedKubeconfigs:                      nil,
 		UseShortResourceNames:                    false,
@@ -677,6 +768,7 @@ func getDefaultAppSettings() *AppSettings {
 		ObjPanelLogsAPITimestampFormat:           defaultObjPanelLogsAPITimestampFormat,
 		ObjPanelLogsAPITimestampUseLocalTimeZone: false,
 		GridTablePersistenceMode:                 "shared",
+		UpdateChannel:                            defaultUpdateChannel,
 		DefaultTablePageSize:                     defaultTablePageSize,
 		DefaultObjectPanelPosition:               defaultObjectPanelPosition,
 		ObjectPanelDockedRightWidth:              defaultObjectPanelDockedRightWidth,
@@ -687,6 +779,8 @@ func getDefaultAppSettings() *AppSettings {
 		ObjectPanelFloatingY:                     defaultObjectPanelFloatingY,
 		Themes:                                   []Theme{defaultTheme()},
 	}
+	applyRefreshTuningDefaults(settings)
+	return settings
 }
 
 func (a *App) loadAppSettings() error {
@@ -738,6 +832,19 @@ func (a *App) loadAppSettings() error {
 		}
 	}
 
+	secretRevealEnabled := false
+	var secretRedactedKeyPatterns []string
+	if settings.Preferences.Secrets != nil {
+		secretRevealEnabled = settings.Preferences.Secrets.RevealEnabled
+		secretRedactedKeyPatterns = append([]string(nil), settings.Preferences.Secrets.RedactedKeyPatterns...)
+	}
+
+	trivyPath, trivyServerURL := "", ""
+	if settings.Preferences.VulnerabilityScan != nil {
+		trivyPath = settings.Preferences.VulnerabilityScan.TrivyPath
+		trivyServerURL = settings.Preferences.VulnerabilityScan.TrivyServerURL
+	}
+
 	a.appSettings = &AppSettings{
 		AppearanceMode:                           settings.Preferences.AppearanceMode,
 		SelectedKubeconfigs:                      append([]string(nil), settings.Kubeconfig.Selected...),
@@ -746,6 +853,7 @@ func (a *App) loadAppSettings() error {
 		ExclusiveNamespaces:                      exclusiveNamespaces,
 		AutoRefreshEnabled:                       settings.Preferences.Refresh.Auto,
 		RefreshBackgroundClustersEnabled:         settings.Preferences.Refresh.Background,
+		RefreshBinaryEncodingEnabled:             settings.Preferences.Refresh.BinaryEncoding,
 		MetricsRefreshIntervalMs:                 settings.Preferences.Refresh.MetricsIntervalMs,
 		KubernetesClientQPS:                      kubernetesClientQPS,
 		KubernetesClientBurst:                    kubernetesClientBurst,
@@ -756,6 +864,8 @@ func (a *App) loadAppSettings() error {
 		ObjPanelLogsAPITimestampFormat:           logAPITimestampFormat,
 		ObjPanelLogsAPITimestampUseLocalTimeZone: logAPITimestampUseLocalTimeZone,
 		GridTablePersistenceMode:                 settings.Preferences.GridTablePersistenceMode,
+		UpdateChannel:                            settings.Preferences.UpdateChannel,
+		ExternalEditor:                           settings.Preferences.ExternalEditor,
 		DefaultTablePageSize:                     settings.Preferences.DefaultTablePageSize,
 		DefaultObjectPanelPosition:               settings.Preferences.DefaultObjectPanelPosition,
 		ObjectPanelDockedRightWidth:              settings.Preferences.ObjectPanelDockedRightWidth,
@@ -774,8 +884,14 @@ func (a *App) loadAppSettings() error {
 		AccentColorDark:                          settings.Preferences.AccentColorDark,
 		LinkColorLight:                           settings.Preferences.LinkColorLight,
 		LinkColorDark:                            settings.Preferences.LinkColorDark,
+		SecretRevealEnabled:                      secretRevealEnabled,
+		SecretRedactedKeyPatterns:                secretRedactedKeyPatterns,
+		TrivyPath:                                trivyPath,
+		TrivyServerURL:                           trivyServerURL,
+		ProtectedNamespaces:                      append([]string(nil), settings.Preferences.ProtectedNamespaces...),
 		Themes:                                   settings.Preferences.Themes,
 	}
+	loadRefreshTuning(a.appSettings, settings.Preferences.RefreshTuning)
 	containerlogs.SetPerScopeTargetLimit(objPanelLogsTargetPerScopeLimit)
 	// The accessor guards the lazy init (subsystem builds run concurrently); creating
 	// on demand here is correct — the limit then applies to the limiter every
@@ -805,6 +921,7 @@ func (a *App) saveAppSettings() error {
 	}
 	settings.Preferences.Refresh.Auto = a.appSettings.AutoRefreshEnabled
 	settings.Preferences.Refresh.Background = a.appSettings.RefreshBackgroundClustersEnabled
+	settings.Preferences.Refresh.BinaryEncoding = a.appSettings.RefreshBinaryEncodingEnabled
 	settings.Preferences.Refresh.MetricsIntervalMs = a.appSettings.MetricsRefreshIntervalMs
 	if settings.Preferences.KubernetesAPI == nil {
 		settings.Preferences.KubernetesAPI = &settingsKubernetesAPI{}
@@ -825,6 +942,8 @@ func (a *App) saveAppSettings() error {
 	}
 	settings.Preferences.ObjPanelLogs.UseLocalTimeZone = a.appSettings.ObjPanelLogsAPITimestampUseLocalTimeZone
 	settings.Preferences.GridTablePersistenceMode = a.appSettings.GridTablePersistenceMode
+	settings.Preferences.UpdateChannel = a.appSettings.UpdateChannel
+	settings.Preferences.ExternalEditor = a.appSettings.ExternalEditor
 	settings.Preferences.DefaultTablePageSize = a.appSettings.DefaultTablePageSize
 	settings.Preferences.DefaultObjectPanelPosition = a.appSettings.DefaultObjectPanelPosition
 	settings.Preferences.ObjectPanelDockedRightWidth = a.appSettings.ObjectPanelDockedRightWidth
@@ -844,6 +963,16 @@ func (a *App) saveAppSettings() error {
 	settings.Preferences.AccentColorDark = a.appSettings.AccentColorDark
 	settings.Preferences.LinkColorLight = a.appSettings.LinkColorLight
 	settings.Preferences.LinkColorDark = a.appSettings.LinkColorDark
+	settings.Preferences.Secrets = &settingsSecrets{
+		RevealEnabled:       a.appSettings.SecretRevealEnabled,
+		RedactedKeyPatterns: append([]string(nil), a.appSettings.SecretRedactedKeyPatterns...),
+	}
+	settings.Preferences.VulnerabilityScan = &settingsVulnScan{
+		TrivyPath:      a.appSettings.TrivyPath,
+		TrivyServerURL: a.appSettings.TrivyServerURL,
+	}
+	settings.Preferences.RefreshTuning = saveRefreshTuning(a.appSettings)
+	settings.Preferences.ProtectedNamespaces = append([]string(nil), a.appSettings.ProtectedNamespaces...)
 	settings.Preferences.Themes = a.appSettings.Themes
 
 	settings.Kubeconfig.Selected = append([]string(nil), a.appSettings.SelectedKubeconfigs...)
@@ -923,10 +1052,7 @@ func (a *App) GetAppSettings() (*AppSettings, error) {
 		}
 	}
 
-	cp := *a.appSettings
-	cp.SelectedKubeconfigs = append([]string(nil), a.appSettings.SelectedKubeconfigs...)
-	cp.Themes = append([]Theme(nil), a.appSettings.Themes...)
-	return &cp, nil
+	return copyAppSettings(a.appSettings), nil
 }
 
 func intPtr(v int) *int {
@@ -947,6 +1073,7 @@ func copyAppSettings(settings *AppSettings) *AppSettings {
 	}
 	cp := *settings
 	cp.SelectedKubeconfigs = append([]string(nil), settings.SelectedKubeconfigs...)
+	cp.SecretRedactedKeyPatterns = append([]string(nil), settings.SecretRedactedKeyPatterns...)
 	cp.Themes = append([]Theme(nil), settings.Themes...)
 	return &cp
 }
@@ -967,6 +1094,27 @@ func stringPreferenceValue(value any) (string, error) {
 	return v, nil
 }
 
+func stringListPreferenceValue(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return append([]string(nil), v...), nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string list value")
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected string list value")
+	}
+}
+
 func intPreferenceValue(value any) (int, error) {
 	switch v := value.(type) {
 	case int:
@@ -996,6 +1144,7 @@ type settingsSideEffects struct {
 	containerLogsPerScopeLimit bool
 	containerLogsGlobalLimit   bool
 	metricsInterval            bool
+	updateChannel              bool
 }
 
 func clampInt(value, minValue, maxValue int) int {
@@ -1077,6 +1226,10 @@ func (a *App) UpdateAppPreferences(request UpdateAppPreferencesRequest) (*Update
 		}
 	}
 
+	if effects.updateChannel {
+		go a.CheckForUpdates()
+	}
+
 	return &UpdateAppPreferencesResponse{
 		Settings:    responseSettings,
 		ChangedKeys: changedKeys,
@@ -1,9 +1,14 @@
 package backend
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	"github.com/luxury-yacht/app/backend/vulnscan"
 )
 
 // preferenceDescriptor declares one app preference in one place: its key,
@@ -120,6 +125,92 @@ func colorPreference(key string, field func(*AppSettings) *string) preferenceDes
 	}
 }
 
+// stringPreference declares a free-form string preference; values are trimmed
+// and validate (optional) rejects malformed input.
+func stringPreference(key, validation string, sideEffect bool, logText string, validate func(string) error, field func(*AppSettings) *string) preferenceDescriptor {
+	return preferenceDescriptor{
+		key: key, valueType: "string", defaultValue: "", validation: validation,
+		runtimeSideEffect: sideEffect, logText: logText, logsValue: logText != "",
+		current: func(s *AppSettings) any { return *field(s) },
+		apply: func(settings *AppSettings, key string, raw any, _ *settingsSideEffects) error {
+			value, err := stringPreferenceValue(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			value = strings.TrimSpace(value)
+			if validate != nil {
+				if err := validate(value); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+			}
+			*field(settings) = value
+			return nil
+		},
+	}
+}
+
+// validateOptionalHTTPURL accepts "" or an absolute http(s) URL.
+func validateOptionalHTTPURL(value string) error {
+	if value == "" {
+		return nil
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid URL %q (expected http:// or https://)", value)
+	}
+	return nil
+}
+
+// validateTrivyPath accepts "" (trivy from PATH) or an absolute path to an
+// executable that answers `trivy --version`.
+func validateTrivyPath(value string) error {
+	_, err := vulnscan.VerifyTrivyBinary(context.Background(), value)
+	return err
+}
+
+// stringListPreference declares a string-list preference. validate (optional)
+// rejects the whole list when any entry is malformed.
+func stringListPreference(key, validation string, sideEffect bool, logText string, validate func([]string) error, field func(*AppSettings) *[]string) preferenceDescriptor {
+	return preferenceDescriptor{
+		key: key, valueType: "stringList", defaultValue: []string{}, validation: validation,
+		runtimeSideEffect: sideEffect, logText: logText, logsValue: logText != "",
+		current: func(s *AppSettings) any { return append([]string{}, *field(s)...) },
+		apply: func(settings *AppSettings, key string, raw any, _ *settingsSideEffects) error {
+			values, err := stringListPreferenceValue(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			values = normalizeStringList(values)
+			if validate != nil {
+				if err := validate(values); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+			}
+			*field(settings) = values
+			return nil
+		},
+	}
+}
+
+// normalizeStringList trims entries and drops empties and duplicates while
+// preserving order.
+func normalizeStringList(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		trimmed := strings.TrimSpace(value)
+		if trimmed == "" {
+			continue
+		}
+		if _, ok := seen[trimmed]; ok {
+			continue
+		}
+		seen[trimmed] = struct{}{}
+		normalized = append(normalized, trimmed)
+	}
+	return normalized
+}
+
 // clampRange returns a clampInt transform over [minValue, maxValue].
 func clampRange(minValue, maxValue int) func(int) int {
 	return func(value int) int { return clampInt(value, minValue, maxValue) }
@@ -168,7 +259,20 @@ func appPreferenceDescriptors() []preferenceDescriptor {
 		},
 	}
 
-	return []preferenceDescriptor{
+	// Switching channel re-runs the update check so the new channel's latest
+	// release shows without a restart.
+	updateChannel := enumPreference(appPreferenceUpdateChannel, defaultUpdateChannel, "update channel", updateChannels, true,
+		"Update channel changed to", func(s *AppSettings) *string { return &s.UpdateChannel })
+	applyUpdateChannel := updateChannel.apply
+	updateChannel.apply = func(settings *AppSettings, key string, raw any, effects *settingsSideEffects) error {
+		if err := applyUpdateChannel(settings, key, raw, effects); err != nil {
+			return err
+		}
+		effects.updateChannel = true
+		return nil
+	}
+
+	descriptors := []preferenceDescriptor{
 		enumPreference(appPreferenceAppearanceMode, "system", "appearance mode", []string{"light", "dark", "system"}, true,
 			"Appearance mode changed to", func(s *AppSettings) *string { return &s.AppearanceMode }),
 		boolPreference(appPreferenceUseShortResourceNames, false, false,
@@ -181,6 +285,8 @@ func appPreferenceDescriptors() []preferenceDescriptor {
 			"Auto refresh enabled changed to", func(s *AppSettings) *bool { return &s.AutoRefreshEnabled }),
 		boolPreference(appPreferenceRefreshBackgroundClustersEnabled, true, true,
 			"Background refresh enabled changed to", func(s *AppSettings) *bool { return &s.RefreshBackgroundClustersEnabled }),
+		boolPreference(appPreferenceRefreshBinaryEncodingEnabled, false, false,
+			"Binary refresh encoding enabled changed to", func(s *AppSettings) *bool { return &s.RefreshBinaryEncodingEnabled }),
 		metricsInterval,
 		intPreference(appPreferenceKubernetesClientQPS, defaultKubernetesClientQPS, intPtr(minKubernetesClientQPS), intPtr(maxKubernetesClientQPS), true,
 			"Kubernetes client QPS changed to", clampKubernetesClientQPS, rateLimitEffect,
@@ -252,7 +358,24 @@ func appPreferenceDescriptors() []preferenceDescriptor {
 		colorPreference(appPreferenceAccentColorDark, func(s *AppSettings) *string { return &s.AccentColorDark }),
 		colorPreference(appPreferenceLinkColorLight, func(s *AppSettings) *string { return &s.LinkColorLight }),
 		colorPreference(appPreferenceLinkColorDark, func(s *AppSettings) *string { return &s.LinkColorDark }),
+		boolPreference(appPreferenceSecretRevealEnabled, false, false,
+			"Secret reveal enabled changed to", func(s *AppSettings) *bool { return &s.SecretRevealEnabled }),
+		stringListPreference(appPreferenceSecretRedactedKeyPatterns, "glob-list", false,
+			"Secret redacted key patterns changed to",
+			func(patterns []string) error { return secret.RedactionPatterns(patterns).Validate() },
+			func(s *AppSettings) *[]string { return &s.SecretRedactedKeyPatterns }),
+		stringPreference(appPreferenceTrivyPath, "path-or-empty", false,
+			"Trivy path changed to", validateTrivyPath, func(s *AppSettings) *string { return &s.TrivyPath }),
+		stringPreference(appPreferenceTrivyServerURL, "url-or-empty", false,
+			"Trivy server URL changed to", validateOptionalHTTPURL, func(s *AppSettings) *string { return &s.TrivyServerURL }),
+		stringListPreference(appPreferenceProtectedNamespaces, "glob-list", false,
+			"Protected namespaces changed to", validateProtectedNamespacePatterns,
+			func(s *AppSettings) *[]string { return &s.ProtectedNamespaces }),
+		updateChannel,
+		stringPreference(appPreferenceExternalEditor, "command-or-empty", false,
+			"External editor changed to", nil, func(s *AppSettings) *string { return &s.ExternalEditor }),
 	}
+	return append(descriptors, refreshTuningPreferences()...)
 }
 
 func applyAppPreferenceChange(settings *AppSettings, change AppPreferenceChange, effects *settingsSideEffects) error {
@@ -0,0 +1,495 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Settings import/export and directory sync. A settings bundle carries
+// settings.json, persistence.json (table layouts, cluster tab order), and
+// favorites.json (saved views) in one document. Machine-local state — window
+// geometry, kubeconfig paths and selection, the sync directory itself, and the
+// machine-local preferences below — is never exported and survives an import.
+
+const (
+	settingsBundleFormat  = "luxury-yacht-settings"
+	settingsBundleVersion = 1
+	// settingsSyncFileName is the bundle written into the sync directory.
+	settingsSyncFileName = "luxury-yacht-settings.json"
+)
+
+// settingsSyncDebounce batches bursts of saves into one sync write.
+var settingsSyncDebounce = 2 * time.Second
+
+// settingsBundle is the portable settings document.
+type settingsBundle struct {
+	Format      string           `json:"format"`
+	Version     int              `json:"version"`
+	ExportedAt  time.Time        `json:"exportedAt"`
+	Settings    *settingsFile    `json:"settings"`
+	Persistence *persistenceFile `json:"persistence,omitempty"`
+	Favorites   *favoritesFile   `json:"favorites,omitempty"`
+}
+
+// settingsSync captures the persisted sync configuration. An empty Directory
+// disables sync.
+type settingsSync struct {
+	Directory string `json:"directory,omitempty"`
+}
+
+// settingsSyncState tracks the in-memory side of directory sync. It has its
+// own lock so saves under settingsMu/persistenceMu can schedule a sync
+// without lock-order concerns.
+type settingsSyncState struct {
+	mu           sync.Mutex
+	directory    string
+	timer        *time.Timer
+	lastSyncedAt time.Time
+	lastError    string
+}
+
+// ExportAppSettings writes a settings bundle to a user-selected file.
+func (a *App) ExportAppSettings() (SettingsTransferResult, error) {
+	var empty SettingsTransferResult
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:           "Export Settings",
+		DefaultFilename: settingsSyncFileName,
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "JSON files (*.json)", Pattern: "*.json"},
+		},
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select settings export file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("settings export canceled")
+	}
+	return a.exportSettingsBundle(path)
+}
+
+// ImportAppSettings replaces the portable settings with those in a
+// user-selected bundle. The frontend reloads afterwards so every view picks
+// up the imported state.
+func (a *App) ImportAppSettings() (SettingsTransferResult, error) {
+	var empty SettingsTransferResult
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	path, err := runtimeOpenFileDialog(a.Ctx, wailsruntime.OpenDialogOptions{
+		Title: "Import Settings",
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "JSON files (*.json)", Pattern: "*.json"},
+		},
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select settings import file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("settings import canceled")
+	}
+	return a.importSettingsBundle(path)
+}
+
+// GetSettingsSync reports the sync directory and the outcome of the last sync.
+func (a *App) GetSettingsSync() (SettingsSyncStatus, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil {
+		return SettingsSyncStatus{}, err
+	}
+	directory := ""
+	if settings.Sync != nil {
+		directory = settings.Sync.Directory
+	}
+	return a.settingsSyncStatus(directory), nil
+}
+
+// SetSettingsSyncDirectory enables sync to directory, or disables it when
+// directory is empty. When the directory already holds a newer bundle it is
+// imported; otherwise the local settings are written there.
+func (a *App) SetSettingsSyncDirectory(directory string) (SettingsSyncStatus, error) {
+	directory = strings.TrimSpace(directory)
+	if directory != "" {
+		if !filepath.IsAbs(directory) {
+			return SettingsSyncStatus{}, fmt.Errorf("sync directory must be an absolute path")
+		}
+		info, err := os.Stat(directory)
+		if err != nil {
+			return SettingsSyncStatus{}, fmt.Errorf("sync directory: %w", err)
+		}
+		if !info.IsDir() {
+			return SettingsSyncStatus{}, fmt.Errorf("sync directory %s is not a directory", directory)
+		}
+		directory = filepath.Clean(directory)
+	}
+
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return SettingsSyncStatus{}, err
+	}
+	previous := ""
+	if settings.Sync != nil {
+		previous = settings.Sync.Directory
+	}
+	if directory == "" {
+		settings.Sync = nil
+	} else {
+		settings.Sync = &settingsSync{Directory: directory}
+	}
+	err = a.saveSettingsFile(settings)
+	a.settingsMu.Unlock()
+	if err != nil {
+		return SettingsSyncStatus{}, err
+	}
+
+	if directory != "" {
+		// Pointing at a new directory adopts the bundle already there, like
+		// checking out a dotfiles repo; the local settings only win when the
+		// directory is empty.
+		imported, err := a.pullSettingsSync(directory != previous)
+		if err != nil {
+			return a.settingsSyncStatus(directory), err
+		}
+		if !imported {
+			a.flushSettingsSync()
+		}
+	}
+	return a.settingsSyncStatus(directory), nil
+}
+
+func (a *App) settingsSyncStatus(directory string) SettingsSyncStatus {
+	status := SettingsSyncStatus{Directory: directory}
+	if directory == "" {
+		return status
+	}
+	status.FilePath = filepath.Join(directory, settingsSyncFileName)
+	a.settingsSync.mu.Lock()
+	defer a.settingsSync.mu.Unlock()
+	if !a.settingsSync.lastSyncedAt.IsZero() {
+		status.LastSyncedAt = a.settingsSync.lastSyncedAt.Format(time.RFC3339)
+	}
+	status.LastError = a.settingsSync.lastError
+	return status
+}
+
+// machineLocalPreferences are the preferences that stay on this machine. The
+// Trivy path names a binary on this filesystem, and Secret reveal, key
+// redaction and protected namespaces are safety controls that an imported or
+// synced bundle must not loosen.
+type machineLocalPreferences struct {
+	secrets             *settingsSecrets
+	trivyPath           string
+	protectedNamespaces []string
+}
+
+// takeMachineLocalPreferences removes the machine-local preferences from
+// prefs and returns them.
+func takeMachineLocalPreferences(prefs *settingsPreferences) machineLocalPreferences {
+	local := machineLocalPreferences{
+		secrets:             prefs.Secrets,
+		protectedNamespaces: prefs.ProtectedNamespaces,
+	}
+	prefs.Secrets = nil
+	prefs.ProtectedNamespaces = nil
+	if scan := prefs.VulnerabilityScan; scan != nil {
+		local.trivyPath = scan.TrivyPath
+		prefs.VulnerabilityScan = nil
+		if scan.TrivyServerURL != "" {
+			prefs.VulnerabilityScan = &settingsVulnScan{TrivyServerURL: scan.TrivyServerURL}
+		}
+	}
+	return local
+}
+
+// restore puts the machine-local preferences back into prefs, replacing
+// whatever prefs carried.
+func (local machineLocalPreferences) restore(prefs *settingsPreferences) {
+	prefs.Secrets = local.secrets
+	prefs.ProtectedNamespaces = local.protectedNamespaces
+	serverURL := ""
+	if prefs.VulnerabilityScan != nil {
+		serverURL = prefs.VulnerabilityScan.TrivyServerURL
+	}
+	prefs.VulnerabilityScan = nil
+	if local.trivyPath != "" || serverURL != "" {
+		prefs.VulnerabilityScan = &settingsVulnScan{TrivyPath: local.trivyPath, TrivyServerURL: serverURL}
+	}
+}
+
+// buildSettingsBundle reads the three settings files from disk. Writes are
+// atomic renames, so no settings lock is needed to read a consistent file.
+func (a *App) buildSettingsBundle() (*settingsBundle, error) {
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	persistence, err := a.loadPersistenceFile()
+	if err != nil {
+		return nil, err
+	}
+	favoritesMu.Lock()
+	favorites, err := a.loadFavoritesFile()
+	favoritesMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	settings.UI = settingsUI{}
+	settings.Kubeconfig = settingsKubeconfig{}
+	settings.Sync = nil
+	settings.LocalAPI = nil
+	takeMachineLocalPreferences(&settings.Preferences)
+	return &settingsBundle{
+		Format:      settingsBundleFormat,
+		Version:     settingsBundleVersion,
+		ExportedAt:  time.Now().UTC(),
+		Settings:    settings,
+		Persistence: persistence,
+		Favorites:   favorites,
+	}, nil
+}
+
+func (a *App) exportSettingsBundle(path string) (SettingsTransferResult, error) {
+	bundle, err := a.buildSettingsBundle()
+	if err != nil {
+		return SettingsTransferResult{}, err
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return SettingsTransferResult{}, fmt.Errorf("failed to marshal settings bundle: %w", err)
+	}
+	if err := writeFileAtomic(path, data, 0o644); err != nil {
+		return SettingsTransferResult{}, fmt.Errorf("failed to write settings bundle: %w", err)
+	}
+	return SettingsTransferResult{Path: path, Bytes: int64(len(data))}, nil
+}
+
+func readSettingsBundle(path string) (*settingsBundle, []byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read settings bundle: %w", err)
+	}
+	bundle := &settingsBundle{}
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse settings bundle: %w", err)
+	}
+	if bundle.Format != settingsBundleFormat {
+		return nil, nil, fmt.Errorf("%s is not a Luxury Yacht settings export", filepath.Base(path))
+	}
+	if bundle.Version > settingsBundleVersion {
+		return nil, nil, fmt.Errorf("settings export version %d is newer than this app supports", bundle.Version)
+	}
+	if bundle.Settings == nil {
+		return nil, nil, fmt.Errorf("settings export has no settings section")
+	}
+	if bundle.Favorites != nil && bundle.Favorites.SchemaVersion != favoritesSchemaVersion {
+		return nil, nil, fmt.Errorf("settings export favorites version %d is not supported", bundle.Favorites.SchemaVersion)
+	}
+	return bundle, data, nil
+}
+
+func (a *App) importSettingsBundle(path string) (SettingsTransferResult, error) {
+	bundle, data, err := readSettingsBundle(path)
+	if err != nil {
+		return SettingsTransferResult{}, err
+	}
+	if err := a.applySettingsBundle(bundle); err != nil {
+		return SettingsTransferResult{}, err
+	}
+	a.logger.Info(fmt.Sprintf("Imported settings from %s", path), logsources.Settings)
+	return SettingsTransferResult{Path: path, Bytes: int64(len(data))}, nil
+}
+
+// applySettingsBundle writes the bundle over the local files, keeping the
+// machine-local settings sections and preferences. Bundles exported before
+// those preferences were excluded may still carry them; they are ignored.
+func (a *App) applySettingsBundle(bundle *settingsBundle) error {
+	a.settingsMu.Lock()
+	local, err := a.loadSettingsFile()
+	if err != nil {
+		a.settingsMu.Unlock()
+		return err
+	}
+	incoming := *bundle.Settings
+	incoming.UI = local.UI
+	incoming.Kubeconfig = local.Kubeconfig
+	incoming.Sync = local.Sync
+	incoming.LocalAPI = local.LocalAPI
+	takeMachineLocalPreferences(&local.Preferences).restore(&incoming.Preferences)
+	err = a.saveSettingsFile(&incoming)
+	if err == nil {
+		// Drop the cached settings so the next read picks up the import.
+		a.appSettings = nil
+		a.notificationConfig.Store(nil)
+	}
+	a.settingsMu.Unlock()
+	if err != nil {
+		return err
+	}
+	a.reloadAlertRules()
+
+	if bundle.Persistence != nil {
+		a.persistenceMu.Lock()
+		err := a.savePersistenceFile(normalizePersistenceFile(bundle.Persistence))
+		a.persistenceMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	if bundle.Favorites != nil {
+		for index := range bundle.Favorites.Favorites {
+			normalizeFavoritePanes(bundle.Favorites.Favorites[index].Panes)
+		}
+		favoritesMu.Lock()
+		err := a.saveFavoritesFile(bundle.Favorites)
+		favoritesMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settingsBundlesEqual compares two bundles ignoring their export times.
+func settingsBundlesEqual(left, right *settingsBundle) bool {
+	normalize := func(bundle *settingsBundle) []byte {
+		copied := *bundle
+		copied.ExportedAt = time.Time{}
+		if copied.Settings != nil {
+			settings := *copied.Settings
+			settings.UpdatedAt = time.Time{}
+			copied.Settings = &settings
+		}
+		if copied.Persistence != nil {
+			persistence := *copied.Persistence
+			persistence.UpdatedAt = time.Time{}
+			copied.Persistence = &persistence
+		}
+		if copied.Favorites != nil {
+			favorites := *copied.Favorites
+			favorites.UpdatedAt = time.Time{}
+			copied.Favorites = &favorites
+		}
+		data, _ := json.Marshal(copied)
+		return data
+	}
+	return string(normalize(left)) == string(normalize(right))
+}
+
+// pullSettingsSync imports the sync directory's bundle when it differs from
+// the local settings and, unless adopt is set, was exported after they last
+// changed. It reports whether anything was imported.
+func (a *App) pullSettingsSync(adopt bool) (bool, error) {
+	a.settingsMu.Lock()
+	settings, err := a.loadSettingsFile()
+	a.settingsMu.Unlock()
+	if err != nil || settings.Sync == nil || settings.Sync.Directory == "" {
+		return false, err
+	}
+	directory := settings.Sync.Directory
+	a.setSettingsSyncDirectory(directory)
+
+	path := filepath.Join(directory, settingsSyncFileName)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+	remote, _, err := readSettingsBundle(path)
+	if err != nil {
+		a.recordSettingsSync(err)
+		return false, err
+	}
+	local, err := a.buildSettingsBundle()
+	if err != nil {
+		return false, err
+	}
+	if (!adopt && !remote.ExportedAt.After(local.Settings.UpdatedAt)) || settingsBundlesEqual(remote, local) {
+		return false, nil
+	}
+	if err := a.applySettingsBundle(remote); err != nil {
+		a.recordSettingsSync(err)
+		return false, err
+	}
+	a.recordSettingsSync(nil)
+	a.logger.Info(fmt.Sprintf("Imported synced settings from %s", path), logsources.Settings)
+	return true, nil
+}
+
+// setSettingsSyncDirectory updates the cached sync directory that save paths
+// consult; an empty directory cancels any pending sync.
+func (a *App) setSettingsSyncDirectory(directory string) {
+	a.settingsSync.mu.Lock()
+	defer a.settingsSync.mu.Unlock()
+	a.settingsSync.directory = directory
+	if directory == "" && a.settingsSync.timer != nil {
+		a.settingsSync.timer.Stop()
+		a.settingsSync.timer = nil
+	}
+}
+
+// scheduleSettingsSync queues a debounced write of the bundle to the sync
+// directory. It is a no-op when sync is disabled.
+func (a *App) scheduleSettingsSync() {
+	a.settingsSync.mu.Lock()
+	defer a.settingsSync.mu.Unlock()
+	if a.settingsSync.directory == "" {
+		return
+	}
+	if a.settingsSync.timer != nil {
+		a.settingsSync.timer.Stop()
+	}
+	a.settingsSync.timer = time.AfterFunc(settingsSyncDebounce, a.flushSettingsSync)
+}
+
+// stopSettingsSync writes any pending sync immediately and disarms the timer.
+func (a *App) stopSettingsSync() {
+	a.settingsSync.mu.Lock()
+	pending := a.settingsSync.timer != nil && a.settingsSync.timer.Stop()
+	a.settingsSync.timer = nil
+	a.settingsSync.mu.Unlock()
+	if pending {
+		a.flushSettingsSync()
+	}
+}
+
+// flushSettingsSync writes the bundle to the sync directory now.
+func (a *App) flushSettingsSync() {
+	a.settingsSync.mu.Lock()
+	directory := a.settingsSync.directory
+	a.settingsSync.timer = nil
+	a.settingsSync.mu.Unlock()
+	if directory == "" {
+		return
+	}
+	_, err := a.exportSettingsBundle(filepath.Join(directory, settingsSyncFileName))
+	a.recordSettingsSync(err)
+	if err != nil && a.logger != nil {
+		a.logger.Warn(fmt.Sprintf("Failed to sync settings to %s: %v", directory, err), logsources.Settings)
+	}
+}
+
+func (a *App) recordSettingsSync(err error) {
+	a.settingsSync.mu.Lock()
+	defer a.settingsSync.mu.Unlock()
+	if err != nil {
+		a.settingsSync.lastError = err.Error()
+		return
+	}
+	a.settingsSync.lastError = ""
+	a.settingsSync.lastSyncedAt = time.Now().UTC()
+}
@@ -0,0 +1,254 @@
+package backend
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// seedPortableSettings writes one value into each settings file plus
+// machine-local state that must not travel.
+func seedPortableSettings(t *testing.T, app *App) {
+	t.Helper()
+	_, err := app.SetKeybindings([]KeyBinding{{Action: "app.toggleSidebar", Key: "\\", Mod: true}})
+	require.NoError(t, err)
+	require.NoError(t, app.SetGridTablePersistence("gridtable:v1:pods", json.RawMessage(`{"columns":["name"]}`)))
+	_, err = app.AddFavorite(Favorite{
+		Name:     "Nodes",
+		ViewType: "cluster",
+		View:     "nodes",
+		Panes:    map[string]FavoritePaneState{"main": defaultFavoritePaneState()},
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.SetKubeconfigSearchPaths([]string{"/machine-a/kube"}))
+}
+
+func TestExportSettingsBundleOmitsMachineLocalState(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	seedPortableSettings(t, app)
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	result, err := app.exportSettingsBundle(path)
+	require.NoError(t, err)
+	require.Equal(t, path, result.Path)
+	require.Positive(t, result.Bytes)
+
+	bundle, _, err := readSettingsBundle(path)
+	require.NoError(t, err)
+	require.Equal(t, settingsBundleFormat, bundle.Format)
+	require.Len(t, bundle.Settings.Preferences.Keybindings, 1)
+	require.Empty(t, bundle.Settings.Kubeconfig.SearchPaths)
+	require.Contains(t, bundle.Persistence.Tables.GridTable[gridTablePersistenceVersionKey], "gridtable:v1:pods")
+	require.Len(t, bundle.Favorites.Favorites, 1)
+}
+
+func TestImportSettingsBundleKeepsMachineLocalState(t *testing.T) {
+	setTestConfigEnv(t)
+	source := newTestAppWithDefaults(t)
+	seedPortableSettings(t, source)
+	path := filepath.Join(t.TempDir(), "settings.json")
+	_, err := source.exportSettingsBundle(path)
+	require.NoError(t, err)
+
+	// A second machine with its own kubeconfig paths.
+	setTestConfigEnv(t)
+	target := newTestAppWithDefaults(t)
+	require.NoError(t, target.SetKubeconfigSearchPaths([]string{"/machine-b/kube"}))
+
+	_, err = target.importSettingsBundle(path)
+	require.NoError(t, err)
+
+	actions, err := target.GetKeybindings()
+	require.NoError(t, err)
+	require.True(t, keybindingFor(t, actions, "app.toggleSidebar").Customized)
+	tables, err := target.GetGridTablePersistence()
+	require.NoError(t, err)
+	require.Contains(t, tables, "gridtable:v1:pods")
+	favorites, err := target.GetFavorites()
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+
+	file, err := target.loadSettingsFile()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/machine-b/kube"}, file.Kubeconfig.SearchPaths)
+}
+
+// seedMachineLocalPreferences sets the preferences that never leave the
+// machine: the Trivy path, Secret reveal and redaction, and protected
+// namespaces.
+func seedMachineLocalPreferences(t *testing.T, app *App, trivyPath string, protected ...any) {
+	t.Helper()
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceSecretRevealEnabled, Value: true},
+		{Key: appPreferenceSecretRedactedKeyPatterns, Value: []any{"*token*"}},
+		{Key: appPreferenceTrivyServerURL, Value: "http://trivy.local:4954"},
+		{Key: appPreferenceProtectedNamespaces, Value: protected},
+	}})
+	require.NoError(t, err)
+	// Set directly: the preference validator requires a real executable.
+	file, err := app.loadSettingsFile()
+	require.NoError(t, err)
+	file.Preferences.VulnerabilityScan.TrivyPath = trivyPath
+	require.NoError(t, app.saveSettingsFile(file))
+}
+
+func TestExportSettingsBundleOmitsMachineLocalPreferences(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	seedMachineLocalPreferences(t, app, "/machine-a/bin/trivy", "prod-*")
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	_, err := app.exportSettingsBundle(path)
+	require.NoError(t, err)
+
+	bundle, _, err := readSettingsBundle(path)
+	require.NoError(t, err)
+	prefs := bundle.Settings.Preferences
+	require.Nil(t, prefs.Secrets)
+	require.Empty(t, prefs.ProtectedNamespaces)
+	require.Equal(t, &settingsVulnScan{TrivyServerURL: "http://trivy.local:4954"}, prefs.VulnerabilityScan)
+}
+
+func TestImportSettingsBundleKeepsMachineLocalPreferences(t *testing.T) {
+	setTestConfigEnv(t)
+	source := newTestAppWithDefaults(t)
+	seedMachineLocalPreferences(t, source, "/machine-a/bin/trivy", "prod-*")
+	path := filepath.Join(t.TempDir(), "settings.json")
+	_, err := source.exportSettingsBundle(path)
+	require.NoError(t, err)
+
+	// An older export may still carry the machine-local preferences; they must
+	// not loosen the target's controls.
+	bundle, _, err := readSettingsBundle(path)
+	require.NoError(t, err)
+	bundle.Settings.Preferences.Secrets = &settingsSecrets{RevealEnabled: true}
+	bundle.Settings.Preferences.VulnerabilityScan.TrivyPath = "/machine-a/bin/trivy"
+	bundle.Settings.Preferences.ProtectedNamespaces = nil
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	setTestConfigEnv(t)
+	target := newTestAppWithDefaults(t)
+	_, err = target.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{"kube-system"}},
+	}})
+	require.NoError(t, err)
+
+	_, err = target.importSettingsBundle(path)
+	require.NoError(t, err)
+
+	file, err := target.loadSettingsFile()
+	require.NoError(t, err)
+	prefs := file.Preferences
+	require.False(t, prefs.Secrets != nil && prefs.Secrets.RevealEnabled, "reveal stays off")
+	require.Equal(t, []string{"kube-system"}, prefs.ProtectedNamespaces)
+	require.Equal(t, &settingsVulnScan{TrivyServerURL: "http://trivy.local:4954"}, prefs.VulnerabilityScan,
+		"the portable server URL is imported, the Trivy path is not")
+	settings, err := target.GetAppSettings()
+	require.NoError(t, err)
+	require.False(t, settings.SecretRevealEnabled)
+	require.Empty(t, settings.TrivyPath)
+}
+
+func TestImportSettingsBundleRejectsOtherFiles(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	dir := t.TempDir()
+
+	notBundle := filepath.Join(dir, "other.json")
+	require.NoError(t, os.WriteFile(notBundle, []byte(`{"schemaVersion":1}`), 0o644))
+	_, err := app.importSettingsBundle(notBundle)
+	require.ErrorContains(t, err, "not a Luxury Yacht settings export")
+
+	newer := filepath.Join(dir, "newer.json")
+	require.NoError(t, os.WriteFile(newer, []byte(`{"format":"luxury-yacht-settings","version":99,"settings":{}}`), 0o644))
+	_, err = app.importSettingsBundle(newer)
+	require.ErrorContains(t, err, "newer than this app supports")
+}
+
+func TestSettingsSyncWritesBundleAfterChanges(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	dir := t.TempDir()
+	t.Cleanup(app.stopSettingsSync)
+
+	status, err := app.SetSettingsSyncDirectory(dir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, settingsSyncFileName), status.FilePath)
+	require.NotEmpty(t, status.LastSyncedAt)
+	require.FileExists(t, status.FilePath)
+
+	_, err = app.SetKeybindings([]KeyBinding{{Action: "view.refresh", Key: "F5"}})
+	require.NoError(t, err)
+	app.stopSettingsSync()
+
+	bundle, _, err := readSettingsBundle(status.FilePath)
+	require.NoError(t, err)
+	require.Equal(t, []KeyBinding{{Action: "view.refresh", Key: "F5"}}, bundle.Settings.Preferences.Keybindings)
+	require.Nil(t, bundle.Settings.Sync, "the sync directory is machine-local")
+
+	status, err = app.SetSettingsSyncDirectory("")
+	require.NoError(t, err)
+	require.Empty(t, status.Directory)
+}
+
+func TestSettingsSyncAdoptsExistingBundle(t *testing.T) {
+	dir := t.TempDir()
+
+	setTestConfigEnv(t)
+	source := newTestAppWithDefaults(t)
+	seedPortableSettings(t, source)
+	_, err := source.exportSettingsBundle(filepath.Join(dir, settingsSyncFileName))
+	require.NoError(t, err)
+
+	setTestConfigEnv(t)
+	target := newTestAppWithDefaults(t)
+	t.Cleanup(target.stopSettingsSync)
+	_, err = target.SetSettingsSyncDirectory(dir)
+	require.NoError(t, err)
+
+	favorites, err := target.GetFavorites()
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+}
+
+func TestPullSettingsSyncIgnoresOlderBundle(t *testing.T) {
+	dir := t.TempDir()
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	t.Cleanup(app.stopSettingsSync)
+	_, err := app.SetSettingsSyncDirectory(dir)
+	require.NoError(t, err)
+
+	// Rewrite the synced bundle as an older export with different content.
+	path := filepath.Join(dir, settingsSyncFileName)
+	bundle, _, err := readSettingsBundle(path)
+	require.NoError(t, err)
+	bundle.ExportedAt = time.Now().Add(-time.Hour)
+	bundle.Settings.Preferences.Keybindings = []KeyBinding{{Action: "view.refresh", Key: "F5"}}
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	imported, err := app.pullSettingsSync(false)
+	require.NoError(t, err)
+	require.False(t, imported)
+
+	bundle.ExportedAt = time.Now().Add(time.Hour)
+	data, err = json.Marshal(bundle)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	imported, err = app.pullSettingsSync(false)
+	require.NoError(t, err)
+	require.True(t, imported)
+	actions, err := app.GetKeybindings()
+	require.NoError(t, err)
+	require.True(t, keybindingFor(t, actions, "view.refresh").Customized)
+}
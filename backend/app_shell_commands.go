@@ -0,0 +1,138 @@
+package backend
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Shell command rules. A shell session started without a command runs the
+// command of the first rule matching the container, else /bin/sh. Rules are
+// persisted in the shellCommands section of settings.json and match on image
+// and namespace globs, where "*" spans any characters including "/" (e.g.
+// "*distroless*", "alpine:*", "team-*"). A rule with no command marks
+// containers that have no shell, so the session fails with a clear message
+// instead of an exec error.
+
+// defaultShellCommand is run when no rule matches.
+var defaultShellCommand = []string{"/bin/sh"}
+
+// ShellCommandRule picks the shell command for matching containers. Patterns
+// left empty match everything, but a rule needs at least one.
+type ShellCommandRule struct {
+	Image     string `json:"image,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Command is run in matching containers; empty means they have no shell.
+	Command []string `json:"command,omitempty"`
+}
+
+// GetShellCommandRules returns the persisted shell command rules in match
+// order.
+func (a *App) GetShellCommandRules() ([]ShellCommandRule, error) {
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	return append([]ShellCommandRule{}, settings.ShellCommands...), nil
+}
+
+// SetShellCommandRules validates and persists the shell command rules,
+// replacing the previous list, and returns them normalized. The whole list is
+// rejected on the first invalid rule.
+func (a *App) SetShellCommandRules(rules []ShellCommandRule) ([]ShellCommandRule, error) {
+	normalized := make([]ShellCommandRule, 0, len(rules))
+	for i, rule := range rules {
+		rule.Image = strings.TrimSpace(rule.Image)
+		rule.Namespace = strings.TrimSpace(rule.Namespace)
+		if rule.Image == "" && rule.Namespace == "" {
+			return nil, fmt.Errorf("shell command rule %d needs an image or namespace pattern", i+1)
+		}
+		command := make([]string, 0, len(rule.Command))
+		for _, arg := range rule.Command {
+			if arg = strings.TrimSpace(arg); arg != "" {
+				command = append(command, arg)
+			}
+		}
+		rule.Command = nil
+		if len(command) > 0 {
+			rule.Command = command
+		}
+		normalized = append(normalized, rule)
+	}
+
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	settings, err := a.loadSettingsFile()
+	if err != nil {
+		return nil, err
+	}
+	settings.ShellCommands = normalized
+	if len(normalized) == 0 {
+		settings.ShellCommands = nil
+	}
+	if err := a.saveSettingsFile(settings); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// defaultShellCommandFor returns the command for a shell session into
+// container of pod when the request names none.
+func (a *App) defaultShellCommandFor(pod *corev1.Pod, container string) ([]string, error) {
+	rules, err := a.GetShellCommandRules()
+	if err != nil {
+		return nil, fmt.Errorf("could not read shell command rules: %w", err)
+	}
+	image := containerImage(pod, container)
+	rule, ok := matchShellCommandRule(rules, image, pod.Namespace)
+	if !ok {
+		return append([]string(nil), defaultShellCommand...), nil
+	}
+	if len(rule.Command) == 0 {
+		return nil, fmt.Errorf("container %s (%s) has no shell; set a command to exec into it", container, image)
+	}
+	return rule.Command, nil
+}
+
+// matchShellCommandRule returns the first rule matching image and namespace.
+func matchShellCommandRule(rules []ShellCommandRule, image, namespace string) (ShellCommandRule, bool) {
+	for _, rule := range rules {
+		if shellPatternMatch(rule.Image, image) && shellPatternMatch(rule.Namespace, namespace) {
+			return rule, true
+		}
+	}
+	return ShellCommandRule{}, false
+}
+
+// shellPatternMatch matches value against a glob where "*" is any run of
+// characters and "?" one character. An empty pattern matches everything.
+func shellPatternMatch(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expr+"$", value)
+	return err == nil && matched
+}
+
+// containerImage returns the image of the named container, including
+// ephemeral ones.
+func containerImage(pod *corev1.Pod, container string) string {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return c.Image
+		}
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		if c.Name == container {
+			return c.Image
+		}
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestShellCommandRulesPickTheFirstMatch(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	_, err := app.SetShellCommandRules([]ShellCommandRule{{Command: []string{"bash"}}})
+	require.ErrorContains(t, err, "needs an image or namespace pattern")
+
+	saved, err := app.SetShellCommandRules([]ShellCommandRule{
+		{Image: " *distroless* ", Command: []string{" "}},
+		{Image: "alpine:*", Command: []string{"/bin/ash"}},
+		{Namespace: "team-*", Command: []string{"/entry.sh", "shell"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "*distroless*", saved[0].Image)
+	require.Nil(t, saved[0].Command)
+	loaded, err := app.GetShellCommandRules()
+	require.NoError(t, err)
+	require.Equal(t, saved, loaded)
+
+	pod := func(namespace, image string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "web"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+		}
+	}
+	command, err := app.defaultShellCommandFor(pod("team-a", "alpine:3.20"), "app")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/ash"}, command, "rules match in order")
+	command, err = app.defaultShellCommandFor(pod("team-a", "registry.local/web:1"), "app")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/entry.sh", "shell"}, command)
+	command, err = app.defaultShellCommandFor(pod("default", "registry.local/web:1"), "app")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh"}, command)
+	_, err = app.defaultShellCommandFor(pod("default", "gcr.io/distroless/static:nonroot"), "app")
+	require.ErrorContains(t, err, "has no shell")
+
+	_, err = app.SetShellCommandRules(nil)
+	require.NoError(t, err)
+	loaded, err = app.GetShellCommandRules()
+	require.NoError(t, err)
+	require.Empty(t, loaded)
+}
@@ -0,0 +1,414 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh"
+)
+
+// Table export. A table view is exported by rebuilding the snapshot the view
+// reads (same domain, same scope, so the same filters, search and sort) and
+// walking every page of it, then writing the rows as CSV or JSON. The columns
+// the view displays are passed in so the file matches the screen.
+
+// Table export formats.
+const (
+	TableExportFormatCSV  = "csv"
+	TableExportFormatJSON = "json"
+)
+
+// tableExportCatalogDomain is the object catalog (browse) domain. Its scope is
+// a bare query string rather than "<base>?<query>".
+const tableExportCatalogDomain = "catalog"
+
+// tableExportRowFields lists the payload fields that hold a table's rows.
+var tableExportRowFields = []string{"rows", "items", "resources"}
+
+// TableExportColumn is one exported column. Key is a dotted path into the row
+// (for example "ref.name"); Label is the header and defaults to Key.
+type TableExportColumn struct {
+	Key   string `json:"key"`
+	Label string `json:"label,omitempty"`
+}
+
+// TableExportRequest describes a table view export.
+type TableExportRequest struct {
+	ClusterID string `json:"clusterId"`
+	// Domain and Scope are the refresh domain and scope the view requests,
+	// for example "pods" with "namespace:default?search=api&sort=name".
+	Domain string `json:"domain"`
+	Scope  string `json:"scope,omitempty"`
+	Format string `json:"format"`
+	// Columns are the view's visible columns in display order. When empty,
+	// every scalar field of the rows is exported.
+	Columns         []TableExportColumn `json:"columns,omitempty"`
+	DefaultFilename string              `json:"defaultFilename,omitempty"`
+}
+
+// TableExportResult reports where a table export was written.
+type TableExportResult struct {
+	Path   string `json:"path"`
+	Format string `json:"format"`
+	Rows   int    `json:"rows"`
+	Bytes  int64  `json:"bytes"`
+	// Truncated is set when the view had more than config.TableExportMaxRows rows.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ExportTableView asks for a destination file and writes the rows of the
+// requested table view to it.
+func (a *App) ExportTableView(req TableExportRequest) (TableExportResult, error) {
+	var empty TableExportResult
+	if a == nil {
+		return empty, fmt.Errorf("app is not initialised")
+	}
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	format, err := normalizeTableExportFormat(req.Format)
+	if err != nil {
+		return empty, err
+	}
+	aggregates := a.refreshAggregates.Load()
+	if aggregates == nil || aggregates.snapshot == nil {
+		return empty, fmt.Errorf("refresh subsystem is not running")
+	}
+
+	filter := wailsruntime.FileFilter{DisplayName: "CSV files (*.csv)", Pattern: "*.csv"}
+	if format == TableExportFormatJSON {
+		filter = wailsruntime.FileFilter{DisplayName: "JSON files (*.json)", Pattern: "*.json"}
+	}
+	path, err := runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+		Title:                "Export Table",
+		DefaultFilename:      sanitizeTableExportFilename(req.DefaultFilename, format),
+		Filters:              []wailsruntime.FileFilter{filter},
+		CanCreateDirectories: true,
+	})
+	if err != nil {
+		return empty, fmt.Errorf("select table export file: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("table export canceled")
+	}
+	req.Format = format
+	return a.exportTableView(a.Ctx, aggregates.snapshot, req, path)
+}
+
+// exportTableView collects the rows of the requested view from service and
+// writes them to path.
+func (a *App) exportTableView(
+	ctx context.Context,
+	service refresh.SnapshotService,
+	req TableExportRequest,
+	path string,
+) (TableExportResult, error) {
+	var empty TableExportResult
+	format, err := normalizeTableExportFormat(req.Format)
+	if err != nil {
+		return empty, err
+	}
+	if strings.TrimSpace(req.ClusterID) == "" {
+		return empty, fmt.Errorf("cluster is required for table export")
+	}
+	if strings.TrimSpace(req.Domain) == "" {
+		return empty, fmt.Errorf("domain is required for table export")
+	}
+
+	rows, truncated, err := collectTableExportRows(ctx, service, req)
+	if err != nil {
+		return empty, err
+	}
+	columns := req.Columns
+	if len(columns) == 0 {
+		columns = tableExportDefaultColumns(rows)
+	}
+
+	var content string
+	if format == TableExportFormatJSON {
+		content, err = renderTableExportJSON(rows, req.Columns)
+	} else {
+		content, err = renderTableExportCSV(rows, columns)
+	}
+	if err != nil {
+		return empty, err
+	}
+	info, err := writeExportFileAtomically(path, content, "table export")
+	if err != nil {
+		return empty, err
+	}
+
+	a.logger.Info(fmt.Sprintf("Exported %d %s rows as %s to %s", len(rows), req.Domain, strings.ToUpper(format), path), logsources.App, req.ClusterID, a.clusterNameForID(req.ClusterID))
+	return TableExportResult{
+		Path:      path,
+		Format:    format,
+		Rows:      len(rows),
+		Bytes:     info.Size(),
+		Truncated: truncated,
+	}, nil
+}
+
+// collectTableExportRows builds the view's snapshot and follows its continue
+// tokens until every matching row has been read or config.TableExportMaxRows
+// is reached. Scopes without a query are not paged and build once.
+func collectTableExportRows(
+	ctx context.Context,
+	service refresh.SnapshotService,
+	req TableExportRequest,
+) ([]map[string]interface{}, bool, error) {
+	_, scope := refresh.SplitClusterScope(req.Scope)
+	var rows []map[string]interface{}
+	seenTokens := make(map[string]struct{})
+	token := ""
+	for {
+		pageScope, paged, err := tableExportPageScope(req.Domain, scope, token)
+		if err != nil {
+			return nil, false, err
+		}
+		snap, err := service.Build(ctx, req.Domain, refresh.JoinClusterScope(req.ClusterID, pageScope))
+		if err != nil {
+			return nil, false, fmt.Errorf("build %s snapshot: %w", req.Domain, err)
+		}
+		if snap == nil {
+			return nil, false, fmt.Errorf("build %s snapshot: no data", req.Domain)
+		}
+		page, next, err := tableExportPayloadRows(snap.Payload)
+		if err != nil {
+			return nil, false, fmt.Errorf("read %s rows: %w", req.Domain, err)
+		}
+		rows = append(rows, page...)
+		if len(rows) > config.TableExportMaxRows {
+			return rows[:config.TableExportMaxRows], true, nil
+		}
+		if !paged || next == "" {
+			return rows, false, nil
+		}
+		if _, ok := seenTokens[next]; ok {
+			return nil, false, fmt.Errorf("%s snapshot repeated a continue token", req.Domain)
+		}
+		seenTokens[next] = struct{}{}
+		token = next
+	}
+}
+
+// tableExportPageScope rewrites the view's scope to request the page after
+// token. Paging and position parameters the view used to show one window are
+// dropped; filters, search and sort are kept. paged is false when the scope
+// carries no query, in which case the snapshot holds every row.
+func tableExportPageScope(domain, scope, token string) (string, bool, error) {
+	base, rawQuery, found := strings.Cut(scope, "?")
+	if domain == tableExportCatalogDomain {
+		base, rawQuery, found = "", scope, true
+	}
+	if !found {
+		return scope, false, nil
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", false, fmt.Errorf("%s export scope: %w", domain, err)
+	}
+	for key := range values {
+		if key == "startRank" || strings.HasPrefix(key, "anchor.") {
+			values.Del(key)
+		}
+	}
+	values.Set("limit", strconv.Itoa(config.TableExportPageLimit))
+	values.Del("continue")
+	if token != "" {
+		values.Set("continue", token)
+	}
+	if domain == tableExportCatalogDomain {
+		return values.Encode(), true, nil
+	}
+	return base + "?" + values.Encode(), true, nil
+}
+
+// tableExportPayloadRows decodes payload through JSON, the shape the frontend
+// renders, and returns its rows plus the continue token for the next page.
+func tableExportPayloadRows(payload interface{}) ([]map[string]interface{}, string, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, "", fmt.Errorf("payload is not an object: %w", err)
+	}
+	var cursorInvalid bool
+	if value, ok := fields["cursorInvalid"]; ok {
+		_ = json.Unmarshal(value, &cursorInvalid)
+	}
+	if cursorInvalid {
+		return nil, "", fmt.Errorf("table changed during export; try again")
+	}
+	var next string
+	if token, ok := fields["continue"]; ok {
+		_ = json.Unmarshal(token, &next)
+	}
+	for _, field := range tableExportRowFields {
+		value, ok := fields[field]
+		if !ok {
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(value))
+		decoder.UseNumber()
+		var rows []map[string]interface{}
+		if err := decoder.Decode(&rows); err != nil {
+			return nil, "", fmt.Errorf("%s is not a list of rows: %w", field, err)
+		}
+		return rows, next, nil
+	}
+	return nil, "", fmt.Errorf("payload has no row list")
+}
+
+// tableExportDefaultColumns lists every top-level scalar field of rows, sorted.
+func tableExportDefaultColumns(rows []map[string]interface{}) []TableExportColumn {
+	keys := make(map[string]struct{})
+	for _, row := range rows {
+		for key, value := range row {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				continue
+			}
+			keys[key] = struct{}{}
+		}
+	}
+	columns := make([]TableExportColumn, 0, len(keys))
+	for key := range keys {
+		columns = append(columns, TableExportColumn{Key: key})
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].Key < columns[j].Key })
+	return columns
+}
+
+// renderTableExportCSV writes a header of column labels and one record per row.
+func renderTableExportCSV(rows []map[string]interface{}, columns []TableExportColumn) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = tableExportColumnLabel(column)
+	}
+	if err := writer.Write(header); err != nil {
+		return "", fmt.Errorf("write CSV header: %w", err)
+	}
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, column := range columns {
+			record[i] = tableExportCellText(tableExportValue(row, column.Key))
+		}
+		if err := writer.Write(record); err != nil {
+			return "", fmt.Errorf("write CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// renderTableExportJSON writes the rows as a JSON array. With columns, each
+// row is projected to an object keyed by column label; without, rows are
+// written whole.
+func renderTableExportJSON(rows []map[string]interface{}, columns []TableExportColumn) (string, error) {
+	out := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		if len(columns) == 0 {
+			out = append(out, row)
+			continue
+		}
+		projected := make(map[string]interface{}, len(columns))
+		for _, column := range columns {
+			projected[tableExportColumnLabel(column)] = tableExportValue(row, column.Key)
+		}
+		out = append(out, projected)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("serialize JSON export: %w", err)
+	}
+	return string(data) + "\n", nil
+}
+
+func tableExportColumnLabel(column TableExportColumn) string {
+	if label := strings.TrimSpace(column.Label); label != "" {
+		return label
+	}
+	return column.Key
+}
+
+// tableExportValue resolves a dotted key path within row.
+func tableExportValue(row map[string]interface{}, key string) interface{} {
+	var current interface{} = row
+	for _, part := range strings.Split(key, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[part]
+	}
+	return current
+}
+
+// tableExportCellText formats a value for one CSV cell. Nested values are
+// written as compact JSON.
+func tableExportCellText(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(data)
+	}
+}
+
+func normalizeTableExportFormat(format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", TableExportFormatCSV:
+		return TableExportFormatCSV, nil
+	case TableExportFormatJSON:
+		return TableExportFormatJSON, nil
+	default:
+		return "", fmt.Errorf("unknown table export format %q", format)
+	}
+}
+
+// sanitizeTableExportFilename returns a safe default filename with the
+// format's extension.
+func sanitizeTableExportFilename(name, format string) string {
+	if format == TableExportFormatCSV {
+		return sanitizeCsvFilename(name)
+	}
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		trimmed = "export"
+	}
+	trimmed = strings.ReplaceAll(trimmed, "/", "-")
+	trimmed = strings.ReplaceAll(trimmed, "\\", "-")
+	if !strings.HasSuffix(strings.ToLower(trimmed), ".json") {
+		trimmed += ".json"
+	}
+	return trimmed
+}
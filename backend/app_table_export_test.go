@@ -0,0 +1,148 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/stretchr/testify/require"
+)
+
+type tableExportTestRow struct {
+	Name     string            `json:"name"`
+	Restarts int               `json:"restarts"`
+	Ref      map[string]string `json:"ref"`
+}
+
+type tableExportTestPage struct {
+	Continue string               `json:"continue,omitempty"`
+	Rows     []tableExportTestRow `json:"rows"`
+}
+
+// pagedTableSnapshotService serves two pages of pod rows and records the
+// scopes it was asked for.
+func pagedTableSnapshotService(scopes *[]string) stubSnapshotService {
+	return stubSnapshotService{
+		build: func(_ context.Context, domain, scope string) (*refresh.Snapshot, error) {
+			*scopes = append(*scopes, scope)
+			_, value := refresh.SplitClusterScope(scope)
+			_, rawQuery, _ := strings.Cut(value, "?")
+			values, _ := url.ParseQuery(rawQuery)
+			if values.Get("continue") == "" {
+				return &refresh.Snapshot{Domain: domain, Payload: tableExportTestPage{
+					Continue: "page-2",
+					Rows: []tableExportTestRow{
+						{Name: "api", Restarts: 2, Ref: map[string]string{"namespace": "default"}},
+					},
+				}}, nil
+			}
+			return &refresh.Snapshot{Domain: domain, Payload: tableExportTestPage{
+				Rows: []tableExportTestRow{
+					{Name: "web, frontend", Restarts: 0, Ref: map[string]string{"namespace": "default"}},
+				},
+			}}, nil
+		},
+	}
+}
+
+func TestExportTableViewWritesEveryPageAsCSV(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	var scopes []string
+	path := filepath.Join(t.TempDir(), "pods.csv")
+
+	result, err := app.exportTableView(context.Background(), pagedTableSnapshotService(&scopes), TableExportRequest{
+		ClusterID: "cluster-a",
+		Domain:    "pods",
+		Scope:     "cluster-a|namespace:default?search=a&sort=name&limit=50&continue=stale&anchor.name=api",
+		Format:    TableExportFormatCSV,
+		Columns: []TableExportColumn{
+			{Key: "name", Label: "Name"},
+			{Key: "ref.namespace", Label: "Namespace"},
+			{Key: "restarts", Label: "Restarts"},
+		},
+	}, path)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Rows)
+	require.False(t, result.Truncated)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "Name,Namespace,Restarts\napi,default,2\n\"web, frontend\",default,0\n", string(content))
+	require.Equal(t, int64(len(content)), result.Bytes)
+
+	require.Len(t, scopes, 2)
+	_, first := refresh.SplitClusterScope(scopes[0])
+	base, rawQuery, _ := strings.Cut(first, "?")
+	require.Equal(t, "namespace:default", base)
+	values, err := url.ParseQuery(rawQuery)
+	require.NoError(t, err)
+	require.Equal(t, "a", values.Get("search"))
+	require.Equal(t, "name", values.Get("sort"))
+	require.Empty(t, values.Get("continue"), "the view's own cursor is not reused")
+	require.Empty(t, values.Get("anchor.name"))
+	require.Equal(t, strconv.Itoa(config.TableExportPageLimit), values.Get("limit"))
+	require.Contains(t, scopes[1], "continue=page-2")
+}
+
+func TestExportTableViewWritesJSON(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	var scopes []string
+	path := filepath.Join(t.TempDir(), "pods.json")
+
+	result, err := app.exportTableView(context.Background(), pagedTableSnapshotService(&scopes), TableExportRequest{
+		ClusterID: "cluster-a",
+		Domain:    "pods",
+		Scope:     "namespace:default?sort=name",
+		Format:    TableExportFormatJSON,
+		Columns:   []TableExportColumn{{Key: "name", Label: "Name"}, {Key: "restarts", Label: "Restarts"}},
+	}, path)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Rows)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(content, &rows))
+	require.Equal(t, []map[string]interface{}{
+		{"Name": "api", "Restarts": float64(2)},
+		{"Name": "web, frontend", "Restarts": float64(0)},
+	}, rows)
+}
+
+func TestExportTableViewBuildsUnqueriedScopeOnce(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	var scopes []string
+	path := filepath.Join(t.TempDir(), "nodes.csv")
+
+	result, err := app.exportTableView(context.Background(), pagedTableSnapshotService(&scopes), TableExportRequest{
+		ClusterID: "cluster-a",
+		Domain:    "nodes",
+	}, path)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Rows)
+	require.Equal(t, []string{"cluster-a|"}, scopes)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "name,restarts\napi,2\n", string(content), "nested fields are left out of default columns")
+}
+
+func TestTableExportPageScopeRewritesCatalogQuery(t *testing.T) {
+	scope, paged, err := tableExportPageScope(tableExportCatalogDomain, "kind=Pod&limit=25&startRank=50", "next")
+	require.NoError(t, err)
+	require.True(t, paged)
+	values, err := url.ParseQuery(scope)
+	require.NoError(t, err)
+	require.Equal(t, url.Values{
+		"kind":     {"Pod"},
+		"limit":    {strconv.Itoa(config.TableExportPageLimit)},
+		"continue": {"next"},
+	}, values)
+}
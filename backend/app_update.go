@@ -1,12 +1,15 @@
 /*
  * backend/app_update.go
  *
- * Handles application update checks and version management.
+ * Handles application update checks and version management. Checks follow
+ * the stable or beta release channel; downloading and applying the platform
+ * package lives in app_update_install.go.
  */
 
 package backend
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,14 +23,26 @@ import (
 )
 
 const (
-	updateRepoAPIBase    = "https://api.github.com/repos/luxury-yacht/app"
-	updateRepoReleaseURL = updateRepoAPIBase + "/releases/latest"
-	updateDownloadsURL   = "https://luxury-yacht.app/#downloads"
-	updateUserAgent      = "LuxuryYachtUpdateCheck/1.0"
+	updateRepoAPIBase     = "https://api.github.com/repos/luxury-yacht/app"
+	updateRepoReleaseURL  = updateRepoAPIBase + "/releases/latest"
+	updateRepoReleasesURL = updateRepoAPIBase + "/releases"
+	updateDownloadsURL    = "https://luxury-yacht.app/#downloads"
+	updateUserAgent       = "LuxuryYachtUpdateCheck/1.0"
 )
 
+// Release channels. Stable follows GitHub's latest release; beta also
+// considers prereleases and takes whichever is newest.
+const (
+	updateChannelStable  = "stable"
+	updateChannelBeta    = "beta"
+	defaultUpdateChannel = updateChannelStable
+)
+
+var updateChannels = []string{updateChannelStable, updateChannelBeta}
+
 type UpdateInfo struct {
 	CurrentVersion string `json:"currentVersion"`
+	Channel        string `json:"channel,omitempty"`
 	LatestVersion  string `json:"latestVersion"`
 	ReleaseURL     string `json:"releaseUrl"`
 	ReleaseName    string `json:"releaseName,omitempty"`
@@ -40,14 +55,31 @@ type UpdateInfo struct {
 	// ReleaseNotes is the raw release body (markdown) shown as a preview in the
 	// update chip's tooltip; the full rendered notes live at the release tag page.
 	ReleaseNotes string `json:"releaseNotes,omitempty"`
-	Error        string `json:"error,omitempty"`
+	// PackageName is the release asset for this platform. Empty when the
+	// release has none, in which case the update is a manual download.
+	PackageName string `json:"packageName,omitempty"`
+	// Staged reports that the package is downloaded and verified and will be
+	// installed when the app quits or restarts.
+	Staged bool   `json:"staged"`
+	Error  string `json:"error,omitempty"`
 }
 
 type githubRelease struct {
-	TagName     string `json:"tag_name"`
+	TagName     string               `json:"tag_name"`
+	Name        string               `json:"name"`
+	PublishedAt string               `json:"published_at"`
+	Body        string               `json:"body"`
+	Draft       bool                 `json:"draft"`
+	Prerelease  bool                 `json:"prerelease"`
+	Assets      []githubReleaseAsset `json:"assets"`
+}
+
+type githubReleaseAsset struct {
 	Name        string `json:"name"`
-	PublishedAt string `json:"published_at"`
-	Body        string `json:"body"`
+	Size        int64  `json:"size"`
+	DownloadURL string `json:"browser_download_url"`
+	// Digest is GitHub's checksum of the uploaded file ("sha256:<hex>").
+	Digest string `json:"digest"`
 }
 
 func (a *App) startUpdateCheck() {
@@ -62,10 +94,32 @@ func (a *App) startUpdateCheck() {
 	})
 }
 
+// CheckForUpdates re-runs the update check on the configured channel and
+// returns the result.
+func (a *App) CheckForUpdates() *UpdateInfo {
+	if a == nil {
+		return nil
+	}
+	a.runUpdateCheck(strings.TrimSpace(Version))
+	return a.getUpdateInfo()
+}
+
+// updateChannel returns the configured release channel.
+func (a *App) updateChannel() string {
+	a.settingsMu.Lock()
+	defer a.settingsMu.Unlock()
+	if a.appSettings == nil || a.appSettings.UpdateChannel == "" {
+		return defaultUpdateChannel
+	}
+	return a.appSettings.UpdateChannel
+}
+
 func (a *App) runUpdateCheck(currentVersion string) {
+	channel := a.updateChannel()
 	if isDevVersion(currentVersion) {
 		a.storeUpdateInfo(&UpdateInfo{
 			CurrentVersion: currentVersion,
+			Channel:        channel,
 			CheckedAt:      time.Now().Format(time.RFC3339),
 			Error:          "update checks are disabled for dev builds",
 		})
@@ -73,10 +127,11 @@ func (a *App) runUpdateCheck(currentVersion string) {
 	}
 
 	checkedAt := time.Now().Format(time.RFC3339)
-	release, err := fetchLatestRelease()
+	release, err := fetchChannelRelease(channel)
 	if err != nil {
 		a.storeUpdateInfo(&UpdateInfo{
 			CurrentVersion: currentVersion,
+			Channel:        channel,
 			CheckedAt:      checkedAt,
 			Error:          err.Error(),
 		})
@@ -84,6 +139,7 @@ func (a *App) runUpdateCheck(currentVersion string) {
 	}
 
 	info := buildReleaseUpdateInfo(currentVersion, checkedAt, release)
+	info.Channel = channel
 	// The tooltip shows the current version's release date next to the new one.
 	// That date lives in a separate release resource, so fetch it by tag — but
 	// only when an update is available (the only time the tooltip shows), and
@@ -101,7 +157,7 @@ func (a *App) runUpdateCheck(currentVersion string) {
 			}
 		}
 	}
-	a.storeUpdateInfo(info)
+	a.storeUpdateRelease(info, release)
 }
 
 // releaseTagForVersion derives the GitHub tag for a version, matching the prefix
@@ -138,7 +194,7 @@ func buildReleaseUpdateInfo(currentVersion, checkedAt string, release *githubRel
 		ReleaseNotes:   release.Body,
 	}
 
-	compare, compareErr := compareVersions(currentVersion, release.TagName)
+	compare, compareErr := compareReleaseVersions(currentVersion, release.TagName)
 	if compareErr != nil {
 		info.Error = compareErr.Error()
 		return info
@@ -183,27 +239,47 @@ func fetchReleaseByTag(tag string) (*githubRelease, error) {
 	return fetchRelease(updateRepoAPIBase + "/releases/tags/" + url.PathEscape(tag))
 }
 
-func fetchRelease(releaseURL string) (*githubRelease, error) {
-	client := &http.Client{Timeout: config.AppUpdateRequestTimeout}
-	req, err := http.NewRequest(http.MethodGet, releaseURL, nil)
-	if err != nil {
-		return nil, err
+// fetchChannelRelease fetches the newest release on channel. The beta
+// channel lists recent releases because GitHub's "latest" skips prereleases.
+func fetchChannelRelease(channel string) (*githubRelease, error) {
+	if channel != updateChannelBeta {
+		return fetchLatestRelease()
 	}
-	req.Header.Set("Accept", "application/vnd.github+json")
-	req.Header.Set("User-Agent", updateUserAgent)
-
-	resp, err := client.Do(req)
-	if err != nil {
+	var releases []githubRelease
+	listURL := fmt.Sprintf("%s?per_page=%d", updateRepoReleasesURL, config.AppUpdateBetaReleaseLimit)
+	if err := fetchGitHubJSON(listURL, &releases); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	release := newestRelease(releases)
+	if release == nil {
+		return nil, fmt.Errorf("update check found no published releases")
+	}
+	return release, nil
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("update check failed with status %s", resp.Status)
+// newestRelease picks the highest-versioned published release. On equal
+// versions a final release beats its prereleases.
+func newestRelease(releases []githubRelease) *githubRelease {
+	var newest *githubRelease
+	for i := range releases {
+		release := &releases[i]
+		if release.Draft || strings.TrimSpace(release.TagName) == "" {
+			continue
+		}
+		if newest == nil {
+			newest = release
+			continue
+		}
+		if compare, err := compareReleaseVersions(newest.TagName, release.TagName); err == nil && compare < 0 {
+			newest = release
+		}
 	}
+	return newest
+}
 
+func fetchRelease(releaseURL string) (*githubRelease, error) {
 	var release githubRelease
-	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+	if err := fetchGitHubJSON(releaseURL, &release); err != nil {
 		return nil, err
 	}
 
@@ -214,6 +290,28 @@ func fetchRelease(releaseURL string) (*githubRelease, error) {
 	return &release, nil
 }
 
+func fetchGitHubJSON(requestURL string, out any) error {
+	client := &http.Client{Timeout: config.AppUpdateRequestTimeout}
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", updateUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("update check failed with status %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
 func compareVersions(current, latest string) (int, error) {
 	currentParsed, err := parseVersionParts(current)
 	if err != nil {
@@ -250,6 +348,52 @@ func compareVersions(current, latest string) (int, error) {
 	return 0, nil
 }
 
+// compareReleaseVersions extends compareVersions with prerelease ordering:
+// "1.2.0-beta.1" < "1.2.0-beta.2" < "1.2.0".
+func compareReleaseVersions(current, latest string) (int, error) {
+	compare, err := compareVersions(current, latest)
+	if err != nil || compare != 0 {
+		return compare, err
+	}
+	return comparePrerelease(prereleaseSuffix(current), prereleaseSuffix(latest)), nil
+}
+
+// prereleaseSuffix returns the part of a version after the first "-", without
+// build metadata.
+func prereleaseSuffix(value string) string {
+	clean := strings.SplitN(strings.TrimSpace(value), " ", 2)[0]
+	clean = strings.SplitN(clean, "+", 2)[0]
+	_, suffix, _ := strings.Cut(clean, "-")
+	return strings.ToLower(suffix)
+}
+
+func comparePrerelease(current, latest string) int {
+	switch {
+	case current == latest:
+		return 0
+	case current == "":
+		return 1
+	case latest == "":
+		return -1
+	}
+	currentParts := strings.Split(current, ".")
+	latestParts := strings.Split(latest, ".")
+	for i := 0; i < len(currentParts) && i < len(latestParts); i++ {
+		currentNum, currentErr := strconv.Atoi(currentParts[i])
+		latestNum, latestErr := strconv.Atoi(latestParts[i])
+		if currentErr == nil && latestErr == nil {
+			if currentNum != latestNum {
+				return cmp.Compare(currentNum, latestNum)
+			}
+			continue
+		}
+		if compare := strings.Compare(currentParts[i], latestParts[i]); compare != 0 {
+			return compare
+		}
+	}
+	return cmp.Compare(len(currentParts), len(latestParts))
+}
+
 func parseVersionParts(value string) ([]int, error) {
 	clean := strings.TrimSpace(value)
 	if clean == "" {
@@ -0,0 +1,342 @@
+/*
+ * backend/app_update_install.go
+ *
+ * Downloads, verifies and installs update packages. The package for this
+ * platform is taken from the release found by the update check, checked
+ * against the sha256 digest GitHub records for the asset, and staged in the
+ * cache directory. A detached helper installs it after the app exits, so the
+ * running binary is never replaced underneath itself.
+ */
+
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+var errNoStagedUpdate = errors.New("no update has been downloaded")
+
+// stagedUpdate is a downloaded and verified package waiting to be installed.
+type stagedUpdate struct {
+	version string
+	path    string
+	format  string
+}
+
+// storeUpdateRelease records the check result together with the release's
+// package for this platform.
+func (a *App) storeUpdateRelease(info *UpdateInfo, release *githubRelease) {
+	asset := selectUpdateAsset(release.Assets, updatePackageFormat(runtime.GOOS), runtime.GOARCH)
+	if asset != nil {
+		info.PackageName = asset.Name
+	}
+
+	a.updateCheckMu.Lock()
+	a.updateAsset = nil
+	if info.IsUpdateAvailable && asset != nil {
+		a.updateAsset = asset
+	}
+	info.Staged = a.stagedUpdate != nil && a.stagedUpdate.version == info.LatestVersion
+	a.updateCheckMu.Unlock()
+
+	a.storeUpdateInfo(info)
+}
+
+// DownloadUpdate downloads and verifies the available update. The update is
+// installed when the app quits, or right away with RestartToUpdate.
+func (a *App) DownloadUpdate() (*UpdateInfo, error) {
+	if !a.updateDownloadMu.TryLock() {
+		return nil, fmt.Errorf("an update download is already running")
+	}
+	defer a.updateDownloadMu.Unlock()
+
+	a.updateCheckMu.RLock()
+	info, asset := a.updateInfo, a.updateAsset
+	a.updateCheckMu.RUnlock()
+	if info == nil || !info.IsUpdateAvailable {
+		return nil, fmt.Errorf("no update is available")
+	}
+	if asset == nil {
+		return nil, fmt.Errorf("release %s has no package for %s/%s", info.LatestVersion, runtime.GOOS, runtime.GOARCH)
+	}
+
+	cacheDir, err := a.cacheDirPath()
+	if err != nil {
+		return nil, err
+	}
+	path, err := downloadUpdateAsset(filepath.Join(cacheDir, "updates"), *asset)
+	if err != nil {
+		a.logger.Warn(fmt.Sprintf("update download failed: %v", err), logsources.UpdateCheck)
+		return nil, err
+	}
+	a.logger.Info(fmt.Sprintf("Update %s downloaded and verified: %s", info.LatestVersion, path), logsources.UpdateCheck)
+
+	a.updateCheckMu.Lock()
+	a.stagedUpdate = &stagedUpdate{
+		version: info.LatestVersion,
+		path:    path,
+		format:  updatePackageFormat(runtime.GOOS),
+	}
+	if a.updateInfo != nil && a.updateInfo.LatestVersion == info.LatestVersion {
+		a.updateInfo.Staged = true
+	}
+	a.updateCheckMu.Unlock()
+
+	updated := a.getUpdateInfo()
+	a.emitEvent("app-update", updated)
+	return updated, nil
+}
+
+// RestartToUpdate quits the app, installs the staged update and starts the
+// new version.
+func (a *App) RestartToUpdate() error {
+	if err := a.startStagedUpdateInstall(true); err != nil {
+		return err
+	}
+	if a.Ctx != nil {
+		runtimeQuit(a.Ctx)
+	}
+	return nil
+}
+
+// installStagedUpdateOnExit hands a staged update to the installer helper
+// during shutdown. The new version runs on the next launch.
+func (a *App) installStagedUpdateOnExit() {
+	if err := a.startStagedUpdateInstall(false); err != nil && !errors.Is(err, errNoStagedUpdate) {
+		a.logger.Warn(fmt.Sprintf("Failed to install update: %v", err), logsources.UpdateCheck)
+	}
+}
+
+// startStagedUpdateInstall starts the helper that waits for this process to
+// exit and then installs the staged package. The staged update is cleared
+// first so quitting after RestartToUpdate does not start a second installer.
+func (a *App) startStagedUpdateInstall(relaunch bool) error {
+	a.updateCheckMu.Lock()
+	staged := a.stagedUpdate
+	a.stagedUpdate = nil
+	a.updateCheckMu.Unlock()
+	if staged == nil {
+		return errNoStagedUpdate
+	}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cmd, err := updateInstallCommand(runtime.GOOS, staged.format, staged.path, exePath, os.Getpid(), relaunch)
+	if err != nil {
+		return err
+	}
+	applyHiddenWindowAttr(cmd)
+	if err := cmd.Start(); err != nil {
+		a.updateCheckMu.Lock()
+		a.stagedUpdate = staged
+		a.updateCheckMu.Unlock()
+		return fmt.Errorf("failed to start update installer: %w", err)
+	}
+	_ = cmd.Process.Release()
+	a.logger.Info(fmt.Sprintf("Installing update %s after exit", staged.version), logsources.UpdateCheck)
+	return nil
+}
+
+// updatePackageFormat is the release package type installed on goos. Linux
+// follows the system package manager; empty means updates are manual.
+func updatePackageFormat(goos string) string {
+	switch goos {
+	case "darwin":
+		return "dmg"
+	case "windows":
+		return "exe"
+	case "linux":
+		if _, err := exec.LookPath("dpkg"); err == nil {
+			return "deb"
+		}
+		if _, err := exec.LookPath("rpm"); err == nil {
+			return "rpm"
+		}
+	}
+	return ""
+}
+
+// selectUpdateAsset finds the release asset for format and goarch, matching
+// the artifact names the release build produces.
+func selectUpdateAsset(assets []githubReleaseAsset, format, goarch string) *githubReleaseAsset {
+	var suffix string
+	switch format {
+	case "dmg":
+		suffix = "-macos-" + goarch + ".dmg"
+	case "exe":
+		suffix = "-windows-" + goarch + "-installer.exe"
+	case "deb":
+		suffix = "_linux_" + goarch + ".deb"
+	case "rpm":
+		arch := map[string]string{"amd64": "x86_64", "arm64": "aarch64"}[goarch]
+		if arch == "" {
+			return nil
+		}
+		suffix = "." + arch + ".rpm"
+	default:
+		return nil
+	}
+	for i := range assets {
+		if strings.HasSuffix(assets[i].Name, suffix) {
+			asset := assets[i]
+			return &asset
+		}
+	}
+	return nil
+}
+
+// assetSHA256 returns the hex sha256 GitHub recorded for the asset.
+func assetSHA256(asset githubReleaseAsset) (string, error) {
+	algorithm, sum, ok := strings.Cut(strings.TrimSpace(asset.Digest), ":")
+	if !ok || algorithm != "sha256" {
+		return "", fmt.Errorf("release asset %s has no sha256 digest", asset.Name)
+	}
+	if decoded, err := hex.DecodeString(sum); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("release asset %s has a malformed sha256 digest", asset.Name)
+	}
+	return strings.ToLower(sum), nil
+}
+
+// downloadUpdateAsset downloads asset into dir and verifies its size and
+// checksum. Earlier downloads in dir are removed first. A package that fails
+// verification is deleted and never staged.
+func downloadUpdateAsset(dir string, asset githubReleaseAsset) (string, error) {
+	want, err := assetSHA256(asset)
+	if err != nil {
+		return "", err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	client := &http.Client{Timeout: config.AppUpdateDownloadTimeout}
+	req, err := http.NewRequest(http.MethodGet, asset.DownloadURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	req.Header.Set("User-Agent", updateUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("update download failed with status %s", resp.Status)
+	}
+
+	path := filepath.Join(dir, filepath.Base(asset.Name))
+	partial := path + ".partial"
+	file, err := os.Create(partial)
+	if err != nil {
+		return "", err
+	}
+	hasher := sha256.New()
+	written, copyErr := io.Copy(io.MultiWriter(file, hasher), resp.Body)
+	if err := errors.Join(copyErr, file.Close()); err != nil {
+		_ = os.Remove(partial)
+		return "", err
+	}
+	if asset.Size > 0 && written != asset.Size {
+		_ = os.Remove(partial)
+		return "", fmt.Errorf("update download is %d bytes, expected %d", written, asset.Size)
+	}
+	if got := hex.EncodeToString(hasher.Sum(nil)); got != want {
+		_ = os.Remove(partial)
+		return "", fmt.Errorf("update checksum mismatch for %s: got %s, want %s", asset.Name, got, want)
+	}
+	if err := os.Rename(partial, path); err != nil {
+		_ = os.Remove(partial)
+		return "", err
+	}
+	return path, nil
+}
+
+// darwinUpdateScript waits for the app to exit, then replaces the app bundle
+// with the one inside the disk image. Arguments: pid, dmg, bundle, relaunch.
+const darwinUpdateScript = `while kill -0 "$1" 2>/dev/null; do sleep 1; done
+mnt=$(mktemp -d) || exit 1
+hdiutil attach -nobrowse -readonly -mountpoint "$mnt" "$2" || exit 1
+app=$(find "$mnt" -maxdepth 1 -name '*.app' | head -n 1)
+status=1
+if [ -n "$app" ] && ditto "$app" "$3.update"; then
+  rm -rf "$3" && mv "$3.update" "$3" && status=0
+fi
+hdiutil detach "$mnt" -quiet
+if [ "$status" = 0 ] && [ "$4" = 1 ]; then open "$3"; fi
+exit "$status"`
+
+// linuxUpdateScript waits for the app to exit, then installs the package
+// through the system package manager, which prompts for privileges with
+// pkexec. Arguments: pid, package, executable, relaunch, install command.
+const linuxUpdateScript = `while kill -0 "$1" 2>/dev/null; do sleep 1; done
+pkexec $5 "$2" || exit 1
+if [ "$4" = 1 ]; then exec "$3"; fi`
+
+// updateInstallCommand builds the detached helper that installs pkgPath once
+// process pid has exited, then starts exePath again when relaunch is set.
+func updateInstallCommand(goos, format, pkgPath, exePath string, pid int, relaunch bool) (*exec.Cmd, error) {
+	relaunchFlag := "0"
+	if relaunch {
+		relaunchFlag = "1"
+	}
+	pidArg := fmt.Sprint(pid)
+
+	switch {
+	case goos == "darwin" && format == "dmg":
+		bundle := macAppBundle(exePath)
+		if bundle == "" {
+			return nil, fmt.Errorf("%s is not inside an app bundle", exePath)
+		}
+		return exec.Command("/bin/sh", "-c", darwinUpdateScript, "sh", pidArg, pkgPath, bundle, relaunchFlag), nil
+	case goos == "linux" && (format == "deb" || format == "rpm"):
+		install := "dpkg -i"
+		if format == "rpm" {
+			install = "rpm -U"
+		}
+		return exec.Command("/bin/sh", "-c", linuxUpdateScript, "sh", pidArg, pkgPath, exePath, relaunchFlag, install), nil
+	case goos == "windows" && format == "exe":
+		// The NSIS installer upgrades in place; /S runs it silently.
+		script := fmt.Sprintf(
+			"Wait-Process -Id %d -ErrorAction SilentlyContinue; "+
+				"$p = Start-Process -FilePath %s -ArgumentList '/S' -Wait -PassThru; "+
+				"if ($p.ExitCode -eq 0 -and $%t) { Start-Process -FilePath %s }",
+			pid, powershellQuote(pkgPath), relaunch, powershellQuote(exePath),
+		)
+		return exec.Command("powershell.exe", "-NoProfile", "-NonInteractive", "-Command", script), nil
+	}
+	return nil, fmt.Errorf("installing %q packages is not supported on %s", format, goos)
+}
+
+// macAppBundle returns the .app directory containing exePath, or "".
+func macAppBundle(exePath string) string {
+	for dir := filepath.Dir(exePath); dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if strings.HasSuffix(dir, ".app") {
+			return dir
+		}
+	}
+	return ""
+}
+
+func powershellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,129 @@
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSelectUpdateAsset(t *testing.T) {
+	assets := []githubReleaseAsset{
+		{Name: "luxury-yacht-v1.12.0-macos-arm64.dmg"},
+		{Name: "luxury-yacht-v1.12.0-macos-amd64.dmg"},
+		{Name: "luxury-yacht-v1.12.0-windows-amd64-installer.exe"},
+		{Name: "luxury-yacht_v1.12.0_linux_amd64.deb"},
+		{Name: "luxury-yacht-1.12.0-1.aarch64.rpm"},
+	}
+	cases := []struct {
+		format, goarch, want string
+	}{
+		{"dmg", "arm64", "luxury-yacht-v1.12.0-macos-arm64.dmg"},
+		{"dmg", "amd64", "luxury-yacht-v1.12.0-macos-amd64.dmg"},
+		{"exe", "amd64", "luxury-yacht-v1.12.0-windows-amd64-installer.exe"},
+		{"deb", "amd64", "luxury-yacht_v1.12.0_linux_amd64.deb"},
+		{"rpm", "arm64", "luxury-yacht-1.12.0-1.aarch64.rpm"},
+		{"deb", "arm64", ""},
+		{"", "amd64", ""},
+	}
+	for _, tc := range cases {
+		got := ""
+		if asset := selectUpdateAsset(assets, tc.format, tc.goarch); asset != nil {
+			got = asset.Name
+		}
+		if got != tc.want {
+			t.Fatalf("selectUpdateAsset(%q, %q) = %q, want %q", tc.format, tc.goarch, got, tc.want)
+		}
+	}
+}
+
+func TestDownloadUpdateAssetVerifiesChecksum(t *testing.T) {
+	payload := []byte("package bytes")
+	sum := sha256.Sum256(payload)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(payload)
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "updates")
+	asset := githubReleaseAsset{
+		Name:        "luxury-yacht_v1.12.0_linux_amd64.deb",
+		Size:        int64(len(payload)),
+		DownloadURL: server.URL,
+		Digest:      "sha256:" + hex.EncodeToString(sum[:]),
+	}
+	path, err := downloadUpdateAsset(dir, asset)
+	if err != nil {
+		t.Fatalf("downloadUpdateAsset: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != string(payload) {
+		t.Fatalf("staged package = %q, %v", data, err)
+	}
+
+	// A checksum mismatch fails and leaves nothing staged.
+	asset.Digest = "sha256:" + strings.Repeat("0", 64)
+	if _, err := downloadUpdateAsset(dir, asset); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("expected no staged files after a failed download, got %d", len(entries))
+	}
+
+	asset.Digest = ""
+	if _, err := downloadUpdateAsset(dir, asset); err == nil || !strings.Contains(err.Error(), "no sha256 digest") {
+		t.Fatalf("expected missing digest error, got %v", err)
+	}
+}
+
+func TestUpdateInstallCommand(t *testing.T) {
+	cmd, err := updateInstallCommand("linux", "rpm", "/cache/app.rpm", "/usr/bin/luxury-yacht", 42, true)
+	if err != nil {
+		t.Fatalf("linux: %v", err)
+	}
+	if got := strings.Join(cmd.Args[3:], " "); got != "sh 42 /cache/app.rpm /usr/bin/luxury-yacht 1 rpm -U" {
+		t.Fatalf("linux args = %q", got)
+	}
+
+	cmd, err = updateInstallCommand("darwin", "dmg", "/cache/app.dmg", "/Applications/Luxury Yacht.app/Contents/MacOS/luxury-yacht", 42, false)
+	if err != nil {
+		t.Fatalf("darwin: %v", err)
+	}
+	if got := cmd.Args[6]; got != "/Applications/Luxury Yacht.app" {
+		t.Fatalf("darwin bundle arg = %q", got)
+	}
+	if _, err := updateInstallCommand("darwin", "dmg", "/cache/app.dmg", "/usr/local/bin/luxury-yacht", 42, false); err == nil {
+		t.Fatalf("expected an error outside an app bundle")
+	}
+
+	cmd, err = updateInstallCommand("windows", "exe", `C:\cache\it's.exe`, `C:\app\luxury-yacht.exe`, 42, true)
+	if err != nil {
+		t.Fatalf("windows: %v", err)
+	}
+	script := cmd.Args[len(cmd.Args)-1]
+	if !strings.Contains(script, "Wait-Process -Id 42") || !strings.Contains(script, `'C:\cache\it''s.exe'`) || !strings.Contains(script, "$true") {
+		t.Fatalf("windows script = %q", script)
+	}
+
+	if _, err := updateInstallCommand("linux", "", "/cache/app", "/usr/bin/luxury-yacht", 42, false); err == nil {
+		t.Fatalf("expected an error without a package format")
+	}
+}
+
+func TestDownloadUpdateRequiresAvailableUpdate(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	if _, err := app.DownloadUpdate(); err == nil {
+		t.Fatalf("expected an error with no update available")
+	}
+	app.storeUpdateInfo(&UpdateInfo{CurrentVersion: "v1.11.1", LatestVersion: "v1.12.0", IsUpdateAvailable: true})
+	if _, err := app.DownloadUpdate(); err == nil || !strings.Contains(err.Error(), "no package") {
+		t.Fatalf("expected a missing package error, got %v", err)
+	}
+	if err := app.RestartToUpdate(); err != errNoStagedUpdate {
+		t.Fatalf("RestartToUpdate = %v, want errNoStagedUpdate", err)
+	}
+}
@@ -124,3 +124,49 @@ func TestReleaseTagForVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestCompareReleaseVersions(t *testing.T) {
+	cases := []struct {
+		current, latest string
+		want            int
+	}{
+		{"v1.12.0-beta.1", "v1.12.0-beta.2", -1},
+		{"v1.12.0-beta.2", "v1.12.0-beta.10", -1},
+		{"v1.12.0-beta.2", "v1.12.0", -1},
+		{"v1.12.0", "v1.12.0-beta.3", 1},
+		{"1.12.0", "v1.12.0", 0},
+		{"v1.11.1", "v1.12.0-beta.1", -1},
+	}
+	for _, tc := range cases {
+		got, err := compareReleaseVersions(tc.current, tc.latest)
+		if err != nil {
+			t.Fatalf("compareReleaseVersions(%q, %q): %v", tc.current, tc.latest, err)
+		}
+		if got != tc.want {
+			t.Fatalf("compareReleaseVersions(%q, %q) = %d, want %d", tc.current, tc.latest, got, tc.want)
+		}
+	}
+}
+
+func TestNewestRelease(t *testing.T) {
+	releases := []githubRelease{
+		{TagName: "v1.11.1"},
+		{TagName: "v1.13.0", Draft: true},
+		{TagName: "v1.12.0-beta.2", Prerelease: true},
+		{TagName: "v1.12.0-beta.1", Prerelease: true},
+		{TagName: ""},
+	}
+	if got := newestRelease(releases); got == nil || got.TagName != "v1.12.0-beta.2" {
+		t.Fatalf("newestRelease = %+v, want v1.12.0-beta.2", got)
+	}
+
+	// The final release beats its own prereleases.
+	releases = append(releases, githubRelease{TagName: "v1.12.0"})
+	if got := newestRelease(releases); got == nil || got.TagName != "v1.12.0" {
+		t.Fatalf("newestRelease = %+v, want v1.12.0", got)
+	}
+
+	if got := newestRelease([]githubRelease{{TagName: "v2.0.0", Draft: true}}); got != nil {
+		t.Fatalf("expected drafts to be ignored, got %+v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	"github.com/luxury-yacht/app/backend/velero"
+)
+
+// TriggerVeleroNamespaceBackup creates an ad-hoc Velero Backup of namespace in
+// Velero's install namespace and returns a reference to it. Its progress shows
+// in the cluster-velero domain.
+func (a *App) TriggerVeleroNamespaceBackup(clusterID, namespace string) (*ObjectActionTargetRef, error) {
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	deps, err := a.veleroDependencies(clusterID, "Velero backup")
+	if err != nil {
+		return nil, err
+	}
+	state, _, err := velero.Collector{ClusterID: clusterID, Dynamic: deps.DynamicClient}.Collect(deps.Context, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Sources.Detected {
+		return nil, fmt.Errorf("velero is not installed in this cluster")
+	}
+	return a.createVeleroObject(deps, clusterID, velero.BackupGVR, velero.NamespaceBackup(state.Sources.Namespace, namespace, time.Now()))
+}
+
+// TriggerVeleroScheduleBackup creates a Backup from a Schedule's template, the
+// same as `velero backup create --from-schedule`.
+func (a *App) TriggerVeleroScheduleBackup(clusterID, scheduleNamespace, scheduleName string) (*ObjectActionTargetRef, error) {
+	deps, err := a.veleroDependencies(clusterID, "Velero backup")
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := deps.DynamicClient.Resource(velero.ScheduleGVR).Namespace(scheduleNamespace).Get(deps.Context, scheduleName, metav1.GetOptions{})
+	if err != nil {
+		return nil, wrapKubernetesError(err, "failed to get schedule")
+	}
+	backup, err := velero.BackupFromSchedule(schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return a.createVeleroObject(deps, clusterID, velero.BackupGVR, backup)
+}
+
+// RestoreVeleroBackup creates a Restore of everything in the named Backup.
+// Velero skips objects that already exist, so restoring over a live
+// namespace only brings back what is missing.
+func (a *App) RestoreVeleroBackup(clusterID, backupNamespace, backupName string) (*ObjectActionTargetRef, error) {
+	deps, err := a.veleroDependencies(clusterID, "Velero restore")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := deps.DynamicClient.Resource(velero.BackupGVR).Namespace(backupNamespace).Get(deps.Context, backupName, metav1.GetOptions{}); err != nil {
+		return nil, wrapKubernetesError(err, "failed to get backup")
+	}
+	return a.createVeleroObject(deps, clusterID, velero.RestoreGVR, velero.RestoreOf(backupNamespace, backupName, time.Now()))
+}
+
+// veleroDependencies resolves the cluster for a Velero action. Every action
+// creates an object, so read-only clusters are refused up front.
+func (a *App) veleroDependencies(clusterID, operation string) (common.Dependencies, error) {
+	if err := a.requireClusterWritable(clusterID, operation); err != nil {
+		return common.Dependencies{}, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return common.Dependencies{}, err
+	}
+	if deps.DynamicClient == nil {
+		return common.Dependencies{}, fmt.Errorf("dynamic client not initialized")
+	}
+	return deps, nil
+}
+
+func (a *App) createVeleroObject(deps common.Dependencies, clusterID string, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (*ObjectActionTargetRef, error) {
+	if err := a.requireResolvedResourcePermission(deps.Context, deps, gvr, true, resourcePermissionCheck{
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+	created, err := deps.DynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Create(deps.Context, obj, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("%s %s already exists; try again in a second", obj.GetKind(), obj.GetName())
+		}
+		return nil, wrapKubernetesError(err, fmt.Sprintf("failed to create %s", obj.GetKind()))
+	}
+	a.logger.Info(fmt.Sprintf("Created Velero %s %s/%s", created.GetKind(), created.GetNamespace(), created.GetName()), logsources.App, clusterID, a.clusterNameForID(clusterID))
+	ref := ObjectActionTargetRef{
+		ClusterID: clusterID,
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Kind:      obj.GetKind(),
+		Resource:  gvr.Resource,
+		Namespace: created.GetNamespace(),
+		Name:      created.GetName(),
+		UID:       string(created.GetUID()),
+	}
+	return &ref, nil
+}
@@ -0,0 +1,88 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/velero"
+)
+
+func newVeleroTestCluster(t *testing.T, clusterID string) (*App, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+
+	kubeClient := kubernetesfake.NewClientset()
+	allowSelfSubjectAccessReviews(kubeClient)
+	location := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "velero.io/v1",
+		"kind":       "BackupStorageLocation",
+		"metadata":   map[string]any{"name": "default", "namespace": "backup-system"},
+		"spec":       map[string]any{"default": true},
+	}}
+	schedule := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "velero.io/v1",
+		"kind":       "Schedule",
+		"metadata":   map[string]any{"name": "nightly", "namespace": "backup-system"},
+		"spec":       map[string]any{"schedule": "0 0 * * *", "template": map[string]any{"includedNamespaces": []any{"apps"}}},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		velero.BackupGVR:          "BackupList",
+		velero.RestoreGVR:         "RestoreList",
+		velero.ScheduleGVR:        "ScheduleList",
+		velero.StorageLocationGVR: "BackupStorageLocationList",
+	}, location, schedule)
+
+	registerTestClusterWithClients(app, clusterID, &clusterClients{
+		meta:              ClusterMeta{ID: clusterID, Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            kubeClient,
+		dynamicClient:     dynamicClient,
+	})
+	return app, dynamicClient
+}
+
+func TestVeleroActionsCreateBackupsAndRestores(t *testing.T) {
+	const clusterID = "velero"
+	app, dynamicClient := newVeleroTestCluster(t, clusterID)
+
+	backup, err := app.TriggerVeleroNamespaceBackup(clusterID, "apps")
+	require.NoError(t, err)
+	require.Equal(t, "backup-system", backup.Namespace, "backups are created where Velero runs")
+	require.Equal(t, "Backup", backup.Kind)
+	created, err := dynamicClient.Resource(velero.BackupGVR).Namespace("backup-system").Get(context.Background(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	included, _, _ := unstructured.NestedStringSlice(created.Object, "spec", "includedNamespaces")
+	require.Equal(t, []string{"apps"}, included)
+
+	fromSchedule, err := app.TriggerVeleroScheduleBackup(clusterID, "backup-system", "nightly")
+	require.NoError(t, err)
+	created, err = dynamicClient.Resource(velero.BackupGVR).Namespace("backup-system").Get(context.Background(), fromSchedule.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "nightly", created.GetLabels()[velero.ScheduleNameLabel])
+
+	restore, err := app.RestoreVeleroBackup(clusterID, "backup-system", backup.Name)
+	require.NoError(t, err)
+	require.Equal(t, "Restore", restore.Kind)
+	created, err = dynamicClient.Resource(velero.RestoreGVR).Namespace("backup-system").Get(context.Background(), restore.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	backupName, _, _ := unstructured.NestedString(created.Object, "spec", "backupName")
+	require.Equal(t, backup.Name, backupName)
+
+	_, err = app.RestoreVeleroBackup(clusterID, "backup-system", "missing")
+	require.Error(t, err)
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.TriggerVeleroNamespaceBackup(clusterID, "apps")
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
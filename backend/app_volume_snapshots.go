@@ -0,0 +1,146 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/volumesnapshot"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GetVolumeSnapshotSupport reports whether the cluster serves the
+// snapshot.storage.k8s.io API and lists its VolumeSnapshotClasses, so the
+// snapshot actions are only offered where they can work.
+func (a *App) GetVolumeSnapshotSupport(clusterID string) (*volumesnapshot.Support, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return volumesnapshot.NewService(deps).Support()
+}
+
+// GetVolumeSnapshotDetails returns the tailored detail payload for a
+// VolumeSnapshot (source claim, readiness, restore size, bound content) or a
+// VolumeSnapshotClass (driver, deletion policy, parameters).
+func (a *App) GetVolumeSnapshotDetails(target ObjectActionTargetRef) (*volumesnapshot.Details, error) {
+	target, err := validateObjectActionTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	gvk := objectActionTargetGVK(target)
+	if !volumesnapshot.Supports(gvk.Group, gvk.Kind) {
+		return nil, fmt.Errorf("%s is not a VolumeSnapshot or VolumeSnapshotClass", gvk.GroupKind())
+	}
+	deps, _, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := fetchObjectByGVK(deps.Context, deps, gvk, target.Namespace, target.Name)
+	if err != nil {
+		return nil, err
+	}
+	return volumesnapshot.BuildDetails(obj, gvk.Group), nil
+}
+
+// CreateVolumeSnapshot snapshots a PersistentVolumeClaim. The snapshot is
+// named after the claim and the current time unless req names it.
+func (a *App) CreateVolumeSnapshot(clusterID string, req volumesnapshot.CreateRequest) (*volumesnapshot.ActionResult, error) {
+	if err := requireNamespacedObject(req.Namespace, req.ClaimName); err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "create volume snapshot"); err != nil {
+		return nil, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "VolumeSnapshot"); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResolvedResourcePermission(ctx, deps, volumesnapshot.VolumeSnapshotGVR, true, resourcePermissionCheck{
+		Group:     volumesnapshot.Group,
+		Version:   volumesnapshot.Version,
+		Kind:      volumesnapshot.KindVolumeSnapshot,
+		Namespace: req.Namespace,
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := volumesnapshot.NewService(deps).Create(req, time.Now())
+	if err != nil {
+		return nil, wrapKubernetesError(err, "failed to create VolumeSnapshot")
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, volumesnapshot.VolumeSnapshotGVR.GroupVersion().WithKind(volumesnapshot.KindVolumeSnapshot), result.Namespace, result.Name)
+
+	a.logger.Info(
+		fmt.Sprintf("Created VolumeSnapshot %s/%s from PVC %s", result.Namespace, result.Name, req.ClaimName),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
+
+// RestoreVolumeSnapshot creates a new PersistentVolumeClaim populated from a
+// ready VolumeSnapshot. Storage class, access modes and size default to the
+// source claim and the snapshot's restore size.
+func (a *App) RestoreVolumeSnapshot(clusterID string, req volumesnapshot.RestoreRequest) (*volumesnapshot.ActionResult, error) {
+	if err := requireNamespacedObject(req.Namespace, req.SnapshotName); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.ClaimName) == "" {
+		return nil, fmt.Errorf("a name for the restored claim is required")
+	}
+	if err := a.requireClusterWritable(clusterID, "restore volume snapshot"); err != nil {
+		return nil, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "PersistentVolumeClaim"); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResolvedResourcePermission(ctx, deps, volumesnapshot.VolumeSnapshotGVR, true, resourcePermissionCheck{
+		Group:     volumesnapshot.Group,
+		Version:   volumesnapshot.Version,
+		Kind:      volumesnapshot.KindVolumeSnapshot,
+		Namespace: req.Namespace,
+		Name:      req.SnapshotName,
+		Verb:      "get",
+	}); err != nil {
+		return nil, err
+	}
+	if err := a.requireResourcePermission(ctx, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      "PersistentVolumeClaim",
+		Namespace: req.Namespace,
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := volumesnapshot.NewService(deps).Restore(req)
+	if err != nil {
+		return nil, wrapKubernetesError(err, "failed to restore VolumeSnapshot")
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}, result.Namespace, result.Name)
+
+	a.logger.Info(
+		fmt.Sprintf("Restored VolumeSnapshot %s/%s to PVC %s", req.Namespace, req.SnapshotName, result.Name),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
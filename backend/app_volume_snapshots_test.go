@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/resources/volumesnapshot"
+	"github.com/luxury-yacht/app/backend/testsupport"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newVolumeSnapshotTestApp(t *testing.T, objects ...runtime.Object) (*App, *cgofake.Clientset, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(testsupport.PersistentVolumeClaimFixture("default", "data"))
+	allowSelfSubjectAccessReviews(client)
+	testsupport.SeedAPIResources(t, client, &metav1.APIResourceList{
+		GroupVersion: "snapshot.storage.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "volumesnapshots", SingularName: "volumesnapshot", Kind: volumesnapshot.KindVolumeSnapshot, Namespaced: true, Verbs: metav1.Verbs{"get", "list", "create"}},
+			{Name: "volumesnapshotclasses", SingularName: "volumesnapshotclass", Kind: volumesnapshot.KindVolumeSnapshotClass, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		volumesnapshot.VolumeSnapshotGVR:      "VolumeSnapshotList",
+		volumesnapshot.VolumeSnapshotClassGVR: "VolumeSnapshotClassList",
+	}, objects...)
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	registerTestClusterWithClients(app, "config:ctx", &clusterClients{
+		meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+		kubeconfigPath:    "/path",
+		kubeconfigContext: "ctx",
+		client:            client,
+		dynamicClient:     dynamicClient,
+	})
+	return app, client, dynamicClient
+}
+
+func TestVolumeSnapshotSupportListsClasses(t *testing.T) {
+	class := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1",
+		"kind":       volumesnapshot.KindVolumeSnapshotClass,
+		"metadata":   map[string]interface{}{"name": "csi-hostpath"},
+		"driver":     "hostpath.csi.k8s.io",
+	}}
+	app, _, _ := newVolumeSnapshotTestApp(t, class)
+
+	support, err := app.GetVolumeSnapshotSupport("config:ctx")
+	require.NoError(t, err)
+	require.True(t, support.Installed)
+	require.Equal(t, "snapshot.storage.k8s.io/v1", support.APIVersion)
+	require.Equal(t, []volumesnapshot.ClassOption{{Name: "csi-hostpath", Driver: "hostpath.csi.k8s.io"}}, support.Classes)
+}
+
+func TestCreateAndRestoreVolumeSnapshot(t *testing.T) {
+	app, client, dynamicClient := newVolumeSnapshotTestApp(t)
+
+	created, err := app.CreateVolumeSnapshot("config:ctx", volumesnapshot.CreateRequest{Namespace: "default", ClaimName: "data", Name: "data-snap"})
+	require.NoError(t, err)
+	require.Equal(t, volumesnapshot.ActionResult{ClusterID: "config:ctx", Kind: volumesnapshot.KindVolumeSnapshot, Namespace: "default", Name: "data-snap"}, *created)
+
+	snapshots := dynamicClient.Resource(volumesnapshot.VolumeSnapshotGVR).Namespace("default")
+	snapshot, err := snapshots.Get(context.Background(), "data-snap", metav1.GetOptions{})
+	require.NoError(t, err)
+	source, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	require.Equal(t, "data", source)
+
+	_, err = app.CreateVolumeSnapshot("config:ctx", volumesnapshot.CreateRequest{Namespace: "default", ClaimName: "missing"})
+	require.ErrorContains(t, err, "not found")
+
+	restore := volumesnapshot.RestoreRequest{Namespace: "default", SnapshotName: "data-snap", ClaimName: "data-restored"}
+	_, err = app.RestoreVolumeSnapshot("config:ctx", restore)
+	require.ErrorContains(t, err, "is not ready to use")
+
+	require.NoError(t, unstructured.SetNestedField(snapshot.Object, map[string]interface{}{"readyToUse": true, "restoreSize": "5Gi"}, "status"))
+	_, err = snapshots.Update(context.Background(), snapshot, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	restored, err := app.RestoreVolumeSnapshot("config:ctx", restore)
+	require.NoError(t, err)
+	require.Equal(t, "data-restored", restored.Name)
+	claim, err := client.CoreV1().PersistentVolumeClaims("default").Get(context.Background(), "data-restored", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "standard", *claim.Spec.StorageClassName, "the source claim's class carries over")
+	require.Equal(t, "data-snap", claim.Spec.DataSource.Name)
+	size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	require.Equal(t, "5Gi", size.String())
+
+	details, err := app.GetVolumeSnapshotDetails(objectActionTarget("config:ctx", volumesnapshot.Group, volumesnapshot.Version, volumesnapshot.KindVolumeSnapshot, "default", "data-snap"))
+	require.NoError(t, err)
+	require.Equal(t, "5Gi", details.Summary.RestoreSize)
+
+	require.NoError(t, app.SetClusterReadOnly("config:ctx", true))
+	_, err = app.CreateVolumeSnapshot("config:ctx", volumesnapshot.CreateRequest{Namespace: "default", ClaimName: "data"})
+	require.ErrorContains(t, err, "read-only")
+	_, err = app.RestoreVolumeSnapshot("config:ctx", volumesnapshot.RestoreRequest{Namespace: "default", SnapshotName: "data-snap", ClaimName: "again"})
+	require.ErrorContains(t, err, "read-only")
+}
@@ -0,0 +1,359 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// YAML bundle export. Selected objects, or every object a catalog query
+// matches, are fetched live through the dynamic client and written either as
+// one multi-document YAML file or as a directory tree with one file per
+// object, laid out for committing to git.
+
+// YAML bundle layouts.
+const (
+	YAMLBundleLayoutFile      = "file"
+	YAMLBundleLayoutDirectory = "directory"
+)
+
+// yamlBundleClusterScopedDir holds cluster-scoped objects in directory layout.
+const yamlBundleClusterScopedDir = "_cluster"
+
+// YAMLBundleQuery selects objects from the cluster's object catalog.
+type YAMLBundleQuery struct {
+	Kinds      []string `json:"kinds,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+	Search     string   `json:"search,omitempty"`
+}
+
+// YAMLBundleExportRequest describes a YAML bundle export. Objects and Query
+// may be combined; an object selected by both is exported once.
+type YAMLBundleExportRequest struct {
+	ClusterID string                  `json:"clusterId"`
+	Objects   []ObjectActionTargetRef `json:"objects,omitempty"`
+	Query     *YAMLBundleQuery        `json:"query,omitempty"`
+	// StripServerFields drops status, managedFields, resourceVersion, uid and
+	// other fields the API server owns, leaving manifests that can be applied.
+	StripServerFields bool `json:"stripServerFields"`
+	// IncludeSecretValues exports Secret data and stringData as stored.
+	// Otherwise each value is written as a placeholder, like the YAML tab.
+	IncludeSecretValues bool   `json:"includeSecretValues"`
+	Layout              string `json:"layout"`
+	DefaultFilename     string `json:"defaultFilename,omitempty"`
+}
+
+// YAMLBundleSkippedObject is an object that could not be exported.
+type YAMLBundleSkippedObject struct {
+	Ref   ObjectActionTargetRef `json:"ref"`
+	Error string                `json:"error"`
+}
+
+// YAMLBundleExportResult reports where a bundle was written.
+type YAMLBundleExportResult struct {
+	Path    string                    `json:"path"`
+	Layout  string                    `json:"layout"`
+	Objects int                       `json:"objects"`
+	Bytes   int64                     `json:"bytes"`
+	Skipped []YAMLBundleSkippedObject `json:"skipped,omitempty"`
+}
+
+// yamlBundleDocument is one exported object.
+type yamlBundleDocument struct {
+	ref      ObjectActionTargetRef
+	manifest string
+}
+
+// ExportYAMLBundle asks for a destination and writes the requested objects to
+// it. File layout prompts for a file; directory layout prompts for a directory
+// and writes one file per object beneath it.
+func (a *App) ExportYAMLBundle(req YAMLBundleExportRequest) (YAMLBundleExportResult, error) {
+	var empty YAMLBundleExportResult
+	if a == nil {
+		return empty, fmt.Errorf("app is not initialised")
+	}
+	if a.Ctx == nil {
+		return empty, fmt.Errorf("application context is not available")
+	}
+	layout, err := normalizeYAMLBundleLayout(req.Layout)
+	if err != nil {
+		return empty, err
+	}
+
+	var path string
+	if layout == YAMLBundleLayoutDirectory {
+		path, err = runtimeOpenDirDialog(a.Ctx, wailsruntime.OpenDialogOptions{
+			Title:                "Export YAML to Directory",
+			CanCreateDirectories: true,
+		})
+	} else {
+		path, err = runtimeSaveFileDialog(a.Ctx, wailsruntime.SaveDialogOptions{
+			Title:           "Export YAML",
+			DefaultFilename: sanitizeYAMLBundleFilename(req.DefaultFilename),
+			Filters: []wailsruntime.FileFilter{
+				{DisplayName: "YAML files (*.yaml)", Pattern: "*.yaml;*.yml"},
+			},
+			CanCreateDirectories: true,
+		})
+	}
+	if err != nil {
+		return empty, fmt.Errorf("select YAML export destination: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return empty, fmt.Errorf("YAML export canceled")
+	}
+	req.Layout = layout
+	return a.exportYAMLBundle(req, path)
+}
+
+// exportYAMLBundle fetches the requested objects and writes them to path.
+func (a *App) exportYAMLBundle(req YAMLBundleExportRequest, path string) (YAMLBundleExportResult, error) {
+	var empty YAMLBundleExportResult
+	layout, err := normalizeYAMLBundleLayout(req.Layout)
+	if err != nil {
+		return empty, err
+	}
+	deps, _, err := a.resolveClusterDependencies(req.ClusterID)
+	if err != nil {
+		return empty, err
+	}
+	refs, err := a.yamlBundleRefs(req)
+	if err != nil {
+		return empty, err
+	}
+	if len(refs) == 0 {
+		return empty, fmt.Errorf("no objects selected for export")
+	}
+
+	documents, skipped := fetchYAMLBundleDocuments(deps.Context, deps, refs, req.StripServerFields, req.IncludeSecretValues)
+	if len(documents) == 0 {
+		return empty, fmt.Errorf("none of the %d selected objects could be exported: %s", len(refs), skipped[0].Error)
+	}
+
+	var bytes int64
+	if layout == YAMLBundleLayoutDirectory {
+		bytes, err = writeYAMLBundleDirectory(path, documents)
+	} else {
+		var info os.FileInfo
+		info, err = writeExportFileAtomically(path, joinYAMLBundleDocuments(documents), "YAML export")
+		if info != nil {
+			bytes = info.Size()
+		}
+	}
+	if err != nil {
+		return empty, err
+	}
+
+	a.logger.Info(fmt.Sprintf("Exported %d objects as YAML to %s", len(documents), path), logsources.App, req.ClusterID, a.clusterNameForID(req.ClusterID))
+	return YAMLBundleExportResult{
+		Path:    path,
+		Layout:  layout,
+		Objects: len(documents),
+		Bytes:   bytes,
+		Skipped: skipped,
+	}, nil
+}
+
+// yamlBundleRefs merges the explicit objects with the catalog query matches,
+// dropping duplicates and enforcing config.YAMLBundleMaxObjects.
+func (a *App) yamlBundleRefs(req YAMLBundleExportRequest) ([]ObjectActionTargetRef, error) {
+	seen := make(map[string]struct{})
+	refs := make([]ObjectActionTargetRef, 0, len(req.Objects))
+	add := func(ref ObjectActionTargetRef) error {
+		ref.ClusterID = req.ClusterID
+		key := strings.Join([]string{ref.Group, ref.Version, ref.Kind, ref.Namespace, ref.Name}, "/")
+		if _, ok := seen[key]; ok {
+			return nil
+		}
+		if len(refs) >= config.YAMLBundleMaxObjects {
+			return fmt.Errorf("YAML export is limited to %d objects", config.YAMLBundleMaxObjects)
+		}
+		seen[key] = struct{}{}
+		refs = append(refs, ref)
+		return nil
+	}
+
+	for _, ref := range req.Objects {
+		if strings.TrimSpace(ref.Version) == "" || strings.TrimSpace(ref.Kind) == "" || strings.TrimSpace(ref.Name) == "" {
+			return nil, fmt.Errorf("every exported object needs a version, kind and name")
+		}
+		if err := add(ref); err != nil {
+			return nil, err
+		}
+	}
+	if req.Query == nil {
+		return refs, nil
+	}
+
+	svc := a.objectCatalogServiceForCluster(req.ClusterID)
+	if svc == nil {
+		return nil, fmt.Errorf("object catalog service unavailable for cluster %q", req.ClusterID)
+	}
+	opts := objectcatalog.QueryOptions{
+		Kinds:      req.Query.Kinds,
+		Namespaces: req.Query.Namespaces,
+		Groups:     req.Query.Groups,
+		Search:     req.Query.Search,
+		Limit:      config.ObjectCatalogMaxQueryLimit,
+	}
+	for {
+		result := svc.Query(opts)
+		if result.CursorInvalid {
+			return nil, fmt.Errorf("object catalog changed during export; try again")
+		}
+		for _, item := range result.Items {
+			if err := add(item.Ref); err != nil {
+				return nil, err
+			}
+		}
+		if result.ContinueToken == "" {
+			return refs, nil
+		}
+		opts.Continue = result.ContinueToken
+	}
+}
+
+// fetchYAMLBundleDocuments reads each object live. Objects that cannot be read
+// (deleted since selection, or forbidden) are reported as skipped.
+func fetchYAMLBundleDocuments(
+	ctx context.Context,
+	deps common.Dependencies,
+	refs []ObjectActionTargetRef,
+	stripServerFields bool,
+	includeSecretValues bool,
+) ([]yamlBundleDocument, []YAMLBundleSkippedObject) {
+	documents := make([]yamlBundleDocument, 0, len(refs))
+	var skipped []YAMLBundleSkippedObject
+	for _, ref := range refs {
+		gvk := schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind}
+		obj, err := fetchObjectByGVK(ctx, deps, gvk, ref.Namespace, ref.Name)
+		if err == nil {
+			var manifest string
+			manifest, err = yamlBundleManifest(obj, stripServerFields, includeSecretValues)
+			if err == nil {
+				documents = append(documents, yamlBundleDocument{ref: ref, manifest: manifest})
+				continue
+			}
+		}
+		skipped = append(skipped, YAMLBundleSkippedObject{Ref: ref, Error: err.Error()})
+	}
+	sort.SliceStable(documents, func(i, j int) bool {
+		return yamlBundleSortKey(documents[i].ref) < yamlBundleSortKey(documents[j].ref)
+	})
+	return documents, skipped
+}
+
+// yamlBundleManifest renders one exported object. Secret values are replaced
+// by placeholders unless includeSecretValues is set.
+func yamlBundleManifest(obj *unstructured.Unstructured, stripServerFields, includeSecretValues bool) (string, error) {
+	if stripServerFields {
+		obj = stripServerManagedFields(obj)
+	}
+	content := obj.Object
+	if !includeSecretValues {
+		content = withholdSecretValues(content, nil)
+	}
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize manifest: %w", err)
+	}
+	return string(data), nil
+}
+
+// yamlBundleSortKey orders documents by namespace, then kind, then name, so
+// repeated exports of the same objects produce the same bundle.
+func yamlBundleSortKey(ref ObjectActionTargetRef) string {
+	return strings.Join([]string{ref.Namespace, ref.Group, ref.Kind, ref.Name}, "\x00")
+}
+
+// joinYAMLBundleDocuments renders documents as one multi-document YAML stream.
+func joinYAMLBundleDocuments(documents []yamlBundleDocument) string {
+	var builder strings.Builder
+	for _, document := range documents {
+		builder.WriteString("---\n")
+		builder.WriteString(document.manifest)
+	}
+	return builder.String()
+}
+
+// writeYAMLBundleDirectory writes one file per object at
+// <namespace>/<kind>[.<group>]/<name>.yaml beneath root, with cluster-scoped
+// objects under _cluster. It returns the bytes written.
+func writeYAMLBundleDirectory(root string, documents []yamlBundleDocument) (int64, error) {
+	var total int64
+	for _, document := range documents {
+		path := filepath.Join(root, yamlBundleRelativePath(document.ref))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return total, fmt.Errorf("create YAML export directory: %w", err)
+		}
+		info, err := writeExportFileAtomically(path, document.manifest, "YAML export")
+		if err != nil {
+			return total, err
+		}
+		total += info.Size()
+	}
+	return total, nil
+}
+
+func yamlBundleRelativePath(ref ObjectActionTargetRef) string {
+	namespace := yamlBundlePathSegment(ref.Namespace)
+	if namespace == "" {
+		namespace = yamlBundleClusterScopedDir
+	}
+	kind := strings.ToLower(ref.Kind)
+	if ref.Group != "" {
+		kind += "." + ref.Group
+	}
+	return filepath.Join(namespace, yamlBundlePathSegment(kind), yamlBundlePathSegment(ref.Name)+".yaml")
+}
+
+// yamlBundlePathSegment keeps a name from escaping its directory. Kubernetes
+// names cannot contain separators, but the ref comes from the caller.
+func yamlBundlePathSegment(value string) string {
+	value = strings.TrimSpace(value)
+	value = strings.ReplaceAll(value, "/", "-")
+	value = strings.ReplaceAll(value, "\\", "-")
+	if value == "." || value == ".." {
+		return "_"
+	}
+	return value
+}
+
+func normalizeYAMLBundleLayout(layout string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(layout)) {
+	case "", YAMLBundleLayoutFile:
+		return YAMLBundleLayoutFile, nil
+	case YAMLBundleLayoutDirectory:
+		return YAMLBundleLayoutDirectory, nil
+	default:
+		return "", fmt.Errorf("unknown YAML export layout %q", layout)
+	}
+}
+
+// sanitizeYAMLBundleFilename returns a safe default filename ending in .yaml.
+func sanitizeYAMLBundleFilename(name string) string {
+	trimmed := yamlBundlePathSegment(name)
+	if trimmed == "" || trimmed == "_" {
+		trimmed = "export"
+	}
+	lower := strings.ToLower(trimmed)
+	if !strings.HasSuffix(lower, ".yaml") && !strings.HasSuffix(lower, ".yml") {
+		trimmed += ".yaml"
+	}
+	return trimmed
+}
@@ -0,0 +1,124 @@
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func yamlBundleTestRefs(clusterID string) []ObjectActionTargetRef {
+	return []ObjectActionTargetRef{
+		{
+			ClusterID: clusterID,
+			Group:     kindaRocksDBInstanceGVK.Group,
+			Version:   kindaRocksDBInstanceGVK.Version,
+			Kind:      kindaRocksDBInstanceGVK.Kind,
+			Namespace: "default",
+			Name:      "my-db",
+		},
+		{
+			ClusterID: clusterID,
+			Group:     ackDBInstanceGVK.Group,
+			Version:   ackDBInstanceGVK.Version,
+			Kind:      ackDBInstanceGVK.Kind,
+			Namespace: "default",
+			Name:      "my-db",
+		},
+		{
+			ClusterID: clusterID,
+			Group:     ackDBInstanceGVK.Group,
+			Version:   ackDBInstanceGVK.Version,
+			Kind:      ackDBInstanceGVK.Kind,
+			Namespace: "default",
+			Name:      "missing",
+		},
+	}
+}
+
+func TestExportYAMLBundleWritesSortedMultiDocumentFile(t *testing.T) {
+	const clusterID = "yaml-bundle-file"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+
+	result, err := app.exportYAMLBundle(YAMLBundleExportRequest{
+		ClusterID:         clusterID,
+		Objects:           yamlBundleTestRefs(clusterID),
+		StripServerFields: true,
+	}, path)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Objects)
+	require.Equal(t, YAMLBundleLayoutFile, result.Layout)
+	require.Len(t, result.Skipped, 1)
+	require.Equal(t, "missing", result.Skipped[0].Ref.Name)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), result.Bytes)
+	documents := strings.Split(strings.TrimPrefix(string(content), "---\n"), "---\n")
+	require.Len(t, documents, 2)
+	// Ordered by namespace, group, kind and name regardless of request order.
+	require.Contains(t, documents[0], "source: db-operator")
+	require.Contains(t, documents[1], "source: ack-rds")
+	require.NotContains(t, string(content), "resourceVersion")
+	require.NotContains(t, string(content), "creationTimestamp")
+}
+
+func TestExportYAMLBundleWritesDirectoryTree(t *testing.T) {
+	const clusterID = "yaml-bundle-directory"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	root := t.TempDir()
+
+	result, err := app.exportYAMLBundle(YAMLBundleExportRequest{
+		ClusterID: clusterID,
+		Objects:   yamlBundleTestRefs(clusterID)[:2],
+		Layout:    YAMLBundleLayoutDirectory,
+	}, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Objects)
+
+	ack, err := os.ReadFile(filepath.Join(root, "default", "dbinstance.rds.services.k8s.aws", "my-db.yaml"))
+	require.NoError(t, err)
+	require.Contains(t, string(ack), "source: ack-rds")
+	require.Contains(t, string(ack), "resourceVersion", "server fields are kept unless stripping is requested")
+
+	_, err = os.Stat(filepath.Join(root, "default", "dbinstance.kinda.rocks", "my-db.yaml"))
+	require.NoError(t, err)
+}
+
+func TestYAMLBundleManifestWithholdsSecretValuesUnlessIncluded(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "db", "namespace": "default"},
+		"data":       map[string]interface{}{"password": "aHVudGVyMg=="},
+		"stringData": map[string]interface{}{"user": "admin"},
+	}}
+
+	withheld, err := yamlBundleManifest(secret, true, false)
+	require.NoError(t, err)
+	require.Contains(t, withheld, "password: "+withheldSecretData)
+	require.Contains(t, withheld, "user: "+withheldSecretText)
+	require.NotContains(t, withheld, "aHVudGVyMg==")
+	require.NotContains(t, withheld, "admin")
+	require.Equal(t, "aHVudGVyMg==", secret.Object["data"].(map[string]interface{})["password"], "the fetched object is not modified")
+
+	included, err := yamlBundleManifest(secret, true, true)
+	require.NoError(t, err)
+	require.Contains(t, included, "password: aHVudGVyMg==")
+	require.Contains(t, included, "user: admin")
+}
+
+func TestYAMLBundleRelativePathStaysInsideRoot(t *testing.T) {
+	require.Equal(t,
+		filepath.Join("_cluster", "clusterrole.rbac.authorization.k8s.io", "admin.yaml"),
+		yamlBundleRelativePath(ObjectActionTargetRef{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "admin"}),
+	)
+	require.Equal(t,
+		filepath.Join("_", "configmap", "..-etc.yaml"),
+		yamlBundleRelativePath(ObjectActionTargetRef{Version: "v1", Kind: "ConfigMap", Namespace: "..", Name: "../etc"}),
+	)
+}
@@ -0,0 +1,251 @@
+/*
+ * backend/bulk_object_actions.go
+ *
+ * Owns the multi-select side of the object action contract: one call that
+ * deletes, restarts, labels, or annotates a set of objects with bounded
+ * concurrency and reports a result per object. Each item goes through the
+ * same read-only, protected-namespace, and permission checks as a single
+ * RunObjectAction.
+ */
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/parallel"
+	"github.com/luxury-yacht/app/backend/resources/generic"
+)
+
+const (
+	BulkObjectActionDelete   = ObjectActionDelete
+	BulkObjectActionRestart  = ObjectActionRestart
+	BulkObjectActionLabel    = "label"
+	BulkObjectActionAnnotate = "annotate"
+)
+
+// Per-object bulk action outcomes.
+const (
+	// BulkObjectActionSucceeded: the object was changed.
+	BulkObjectActionSucceeded = "succeeded"
+	// BulkObjectActionReady: a dry run found nothing stopping the change.
+	BulkObjectActionReady = "ready"
+	// BulkObjectActionBlocked: read-only mode or a protected namespace stopped
+	// the change before it reached the cluster.
+	BulkObjectActionBlocked = "blocked"
+	// BulkObjectActionFailed: validation, permission, or the API call failed.
+	BulkObjectActionFailed = "failed"
+)
+
+type BulkObjectActionRequest struct {
+	Action  string                  `json:"action"`
+	Targets []ObjectActionTargetRef `json:"targets"`
+	// Set adds or overwrites these labels or annotations.
+	Set map[string]string `json:"set,omitempty"`
+	// Remove deletes these label or annotation keys.
+	Remove []string `json:"remove,omitempty"`
+	// DryRun runs every check, and for label/annotate a server-side dry-run
+	// patch, without changing anything.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Concurrency caps the objects changed at once; zero uses the default.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Confirmation is the typed name of a protected namespace. It confirms
+	// deletes and restarts of targets in that namespace; targets in other
+	// protected namespaces stay blocked.
+	Confirmation string `json:"confirmation,omitempty"`
+}
+
+type BulkObjectActionResult struct {
+	Target ObjectActionTargetRef `json:"target"`
+	Status string                `json:"status"`
+	Error  string                `json:"error,omitempty"`
+}
+
+type BulkObjectActionResponse struct {
+	Action string `json:"action"`
+	DryRun bool   `json:"dryRun,omitempty"`
+	// Results are in request order.
+	Results []BulkObjectActionResult `json:"results"`
+	// Counts maps each status to its number of objects.
+	Counts map[string]int `json:"counts"`
+}
+
+// RunBulkObjectAction applies one action to every target. An error is only
+// returned when the request itself is invalid; per-object failures are
+// reported in the results so one bad object never hides the rest.
+func (a *App) RunBulkObjectAction(req BulkObjectActionRequest) (BulkObjectActionResponse, error) {
+	action := strings.TrimSpace(req.Action)
+	switch action {
+	case BulkObjectActionDelete, BulkObjectActionRestart, BulkObjectActionLabel, BulkObjectActionAnnotate:
+	default:
+		return BulkObjectActionResponse{}, fmt.Errorf("unsupported bulk action %q", action)
+	}
+	if len(req.Targets) == 0 {
+		return BulkObjectActionResponse{}, fmt.Errorf("bulk %s requires at least one target", action)
+	}
+	if len(req.Targets) > config.BulkObjectActionMaxTargets {
+		return BulkObjectActionResponse{}, fmt.Errorf("bulk %s is limited to %d targets", action, config.BulkObjectActionMaxTargets)
+	}
+	if req.Concurrency < 0 {
+		return BulkObjectActionResponse{}, fmt.Errorf("concurrency must not be negative")
+	}
+	concurrency := req.Concurrency
+	if concurrency == 0 {
+		concurrency = config.BulkObjectActionConcurrency
+	}
+	concurrency = min(concurrency, config.BulkObjectActionMaxConcurrency)
+
+	var patch []byte
+	if action == BulkObjectActionLabel || action == BulkObjectActionAnnotate {
+		var err error
+		if patch, err = bulkMetadataPatch(action, req.Set, req.Remove); err != nil {
+			return BulkObjectActionResponse{}, err
+		}
+	}
+
+	results := make([]BulkObjectActionResult, len(req.Targets))
+	indexes := make([]int, len(req.Targets))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	_ = parallel.ForEach(a.CtxOrBackground(), indexes, concurrency, func(ctx context.Context, i int) error {
+		target := req.Targets[i]
+		result := BulkObjectActionResult{Target: target, Status: BulkObjectActionSucceeded}
+		if req.DryRun {
+			result.Status = BulkObjectActionReady
+		}
+		if ctx.Err() != nil {
+			result.Status, result.Error = BulkObjectActionFailed, ctx.Err().Error()
+		} else if err := a.runBulkObjectActionItem(action, target, patch, req.Confirmation, req.DryRun); err != nil {
+			result.Status, result.Error = BulkObjectActionFailed, err.Error()
+			if errors.Is(err, errClusterReadOnly) || errors.Is(err, errConfirmationRequired) {
+				result.Status = BulkObjectActionBlocked
+			}
+		}
+		results[i] = result
+		return nil
+	})
+
+	response := BulkObjectActionResponse{Action: action, DryRun: req.DryRun, Results: results, Counts: map[string]int{}}
+	for _, result := range results {
+		response.Counts[result.Status]++
+	}
+	return response, nil
+}
+
+// runBulkObjectActionItem changes one target, or with dryRun stops after the
+// checks. Destructive actions in a protected namespace need confirmation to
+// name that namespace.
+func (a *App) runBulkObjectActionItem(action string, target ObjectActionTargetRef, patch []byte, confirmation string, dryRun bool) error {
+	target, err := validateObjectActionTarget(target)
+	if err != nil {
+		return err
+	}
+	if err := a.requireClusterWritable(target.ClusterID, action); err != nil {
+		return err
+	}
+	if err := a.requireBulkProtectedNamespaceConfirmation(action, target, confirmation); err != nil {
+		return err
+	}
+
+	switch action {
+	case BulkObjectActionDelete:
+		if target.Group == "helm.sh" {
+			return fmt.Errorf("bulk delete does not support Helm releases")
+		}
+		if !dryRun {
+			return a.deleteObjectAction(target, false)
+		}
+		return a.checkBulkObjectActionPermission(target, "delete")
+	case BulkObjectActionRestart:
+		if err := requireActionNamespacedTarget(target, action); err != nil {
+			return err
+		}
+		if !dryRun {
+			return a.restartWorkloadAction(target)
+		}
+		if _, err := validateAppsV1WorkloadAction(action, target.Group, target.Version, target.Kind, actionRestartableWorkloadKinds); err != nil {
+			return err
+		}
+		return a.checkBulkObjectActionPermission(target, "patch")
+	default:
+		return a.patchBulkObjectMetadata(target, patch, dryRun)
+	}
+}
+
+func (a *App) checkBulkObjectActionPermission(target ObjectActionTargetRef, verb string) error {
+	deps, _, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return err
+	}
+	return a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Group:     target.Group,
+		Version:   target.Version,
+		Kind:      target.Kind,
+		Namespace: target.Namespace,
+		Name:      target.Name,
+		Verb:      verb,
+	})
+}
+
+func (a *App) patchBulkObjectMetadata(target ObjectActionTargetRef, patch []byte, dryRun bool) error {
+	if err := requireObjectName(target.Name); err != nil {
+		return err
+	}
+	if err := a.checkBulkObjectActionPermission(target, "patch"); err != nil {
+		return err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return err
+	}
+	gvk := objectActionTargetGVK(target)
+	if err := generic.NewService(deps).PatchByGVK(gvk, target.Namespace, target.Name, patch, dryRun); err != nil {
+		return err
+	}
+	if !dryRun {
+		a.invalidateResponseCacheForGVK(selectionKey, gvk, target.Namespace, target.Name)
+	}
+	return nil
+}
+
+// bulkMetadataPatch builds the merge patch that sets and removes label or
+// annotation keys, rejecting keys and label values the API server would.
+func bulkMetadataPatch(action string, set map[string]string, remove []string) ([]byte, error) {
+	if len(set) == 0 && len(remove) == 0 {
+		return nil, fmt.Errorf("bulk %s requires keys to set or remove", action)
+	}
+	field := "labels"
+	if action == BulkObjectActionAnnotate {
+		field = "annotations"
+	}
+	changes := make(map[string]any, len(set)+len(remove))
+	var problems []string
+	for key, value := range set {
+		problems = append(problems, validation.IsQualifiedName(key)...)
+		if action == BulkObjectActionLabel {
+			problems = append(problems, validation.IsValidLabelValue(value)...)
+		}
+		changes[key] = value
+	}
+	for _, key := range remove {
+		if _, ok := set[key]; ok {
+			return nil, fmt.Errorf("key %q is both set and removed", key)
+		}
+		problems = append(problems, validation.IsQualifiedName(key)...)
+		changes[key] = nil
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("invalid %s: %s", field, strings.Join(problems, "; "))
+	}
+	return json.Marshal(map[string]any{"metadata": map[string]any{field: changes}})
+}
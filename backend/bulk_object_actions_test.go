@@ -0,0 +1,80 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestRunBulkObjectActionReportsPerObjectResults(t *testing.T) {
+	const clusterID = "bulk"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	dynamicClient := app.clusterClients[clusterID].dynamicClient.(*dynamicfake.FakeDynamicClient)
+	resource := dynamicClient.Resource(schema.GroupVersionResource{Group: "rds.services.k8s.aws", Version: "v1alpha1", Resource: "dbinstances"}).Namespace("default")
+	db := objectActionTarget(clusterID, ackDBInstanceGVK.Group, ackDBInstanceGVK.Version, ackDBInstanceGVK.Kind, "default", "my-db")
+	missing := objectActionTarget(clusterID, ackDBInstanceGVK.Group, ackDBInstanceGVK.Version, ackDBInstanceGVK.Kind, "default", "gone")
+
+	response, err := app.RunBulkObjectAction(BulkObjectActionRequest{
+		Action:  BulkObjectActionLabel,
+		Targets: []ObjectActionTargetRef{db, missing},
+		Set:     map[string]string{"team": "data"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionSucceeded, response.Results[0].Status)
+	require.Equal(t, BulkObjectActionFailed, response.Results[1].Status)
+	require.NotEmpty(t, response.Results[1].Error)
+	require.Equal(t, map[string]int{BulkObjectActionSucceeded: 1, BulkObjectActionFailed: 1}, response.Counts)
+	obj, err := resource.Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "data", obj.GetLabels()["team"])
+
+	response, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionDelete, Targets: []ObjectActionTargetRef{db}, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionReady, response.Results[0].Status)
+	_, err = resource.Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err, "a dry run deletes nothing")
+
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceProtectedNamespaces, Value: []any{"default"}},
+	}})
+	require.NoError(t, err)
+	response, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionDelete, Targets: []ObjectActionTargetRef{db}})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionBlocked, response.Results[0].Status)
+	require.Contains(t, response.Results[0].Error, `type "default"`)
+	response, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionDelete, Targets: []ObjectActionTargetRef{db}, Confirmation: "my-db", DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionBlocked, response.Results[0].Status, "bulk confirmation names the namespace, not an object")
+	response, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionDelete, Targets: []ObjectActionTargetRef{db}, Confirmation: "default", DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionReady, response.Results[0].Status)
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	response, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionAnnotate, Targets: []ObjectActionTargetRef{db}, Remove: []string{"note"}})
+	require.NoError(t, err)
+	require.Equal(t, BulkObjectActionBlocked, response.Results[0].Status)
+}
+
+func TestRunBulkObjectActionValidatesRequest(t *testing.T) {
+	app := NewApp()
+	target := objectActionTarget("cluster-a", "", "v1", "ConfigMap", "default", "app")
+
+	_, err := app.RunBulkObjectAction(BulkObjectActionRequest{Action: "scale", Targets: []ObjectActionTargetRef{target}})
+	require.ErrorContains(t, err, "unsupported bulk action")
+	_, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionDelete})
+	require.ErrorContains(t, err, "at least one target")
+	_, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionLabel, Targets: []ObjectActionTargetRef{target}})
+	require.ErrorContains(t, err, "keys to set or remove")
+	_, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionLabel, Targets: []ObjectActionTargetRef{target}, Set: map[string]string{"team": "not valid"}})
+	require.ErrorContains(t, err, "invalid labels")
+	_, err = app.RunBulkObjectAction(BulkObjectActionRequest{Action: BulkObjectActionAnnotate, Targets: []ObjectActionTargetRef{target}, Set: map[string]string{"a": "1"}, Remove: []string{"a"}})
+	require.ErrorContains(t, err, "both set and removed")
+
+	patch, err := bulkMetadataPatch(BulkObjectActionAnnotate, map[string]string{"note": "free text"}, []string{"old"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"metadata":{"annotations":{"note":"free text","old":null}}}`, string(patch))
+}
@@ -0,0 +1,367 @@
+// Package certexpiry scans a cluster for X.509 certificates that have expired or
+// will expire soon: TLS Secrets, admission-webhook and CRD-conversion caBundles,
+// and cert-manager Certificates. Expired webhook CA bundles break every request
+// the webhook intercepts, so they are reported with the same weight as serving
+// certificates.
+package certexpiry
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	corev1 "k8s.io/api/core/v1"
+	apiextclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultWarningWindow is how far ahead a certificate counts as expiring soon.
+const DefaultWarningWindow = 30 * 24 * time.Hour
+
+// Source identifies where a certificate was found.
+type Source string
+
+const (
+	SourceTLSSecret              Source = "tls-secret"
+	SourceWebhookCABundle        Source = "webhook-ca-bundle"
+	SourceCRDConversionCABundle  Source = "crd-conversion-ca-bundle"
+	SourceCertManagerCertificate Source = "cert-manager-certificate"
+)
+
+// Status classifies a certificate against the warning window.
+type Status string
+
+const (
+	StatusExpired  Status = "expired"
+	StatusExpiring Status = "expiring"
+	StatusValid    Status = "valid"
+)
+
+// TLSSecretCertificateKeys are the kubernetes.io/tls Secret keys that hold
+// certificates.
+var TLSSecretCertificateKeys = []string{corev1.TLSCertKey, corev1.ServiceAccountRootCAKey}
+
+var certManagerCertificates = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+
+// Finding is one certificate location and its earliest expiry. Bundles holding
+// several certificates report the one that expires first, since any expired
+// certificate in a chain breaks verification.
+type Finding struct {
+	Ref           resourcemodel.ResourceRef `json:"ref"`
+	Source        Source                    `json:"source"`
+	Location      string                    `json:"location"`
+	Subject       string                    `json:"subject,omitempty"`
+	Issuer        string                    `json:"issuer,omitempty"`
+	NotAfter      time.Time                 `json:"notAfter"`
+	DaysRemaining int                       `json:"daysRemaining"`
+	Status        Status                    `json:"status"`
+}
+
+// Report is the cluster-wide scan result, most urgent findings first.
+type Report struct {
+	ClusterID         string    `json:"clusterId"`
+	GeneratedAt       time.Time `json:"generatedAt"`
+	WarningWindowDays int       `json:"warningWindowDays"`
+	ExpiredCount      int       `json:"expiredCount"`
+	ExpiringCount     int       `json:"expiringCount"`
+	Findings          []Finding `json:"findings"`
+	// Warnings lists sources that could not be scanned (for example a
+	// forbidden list); the rest of the report is still complete.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Options tunes a scan.
+type Options struct {
+	// WarningWindow defaults to DefaultWarningWindow when zero.
+	WarningWindow time.Duration
+	// IncludeValid keeps certificates outside the warning window in Findings.
+	IncludeValid bool
+	// Namespaces restricts namespaced sources; empty scans cluster-wide.
+	Namespaces []string
+}
+
+// Scanner reads certificate sources from one cluster. Dynamic and
+// APIExtensions are optional; their sources are skipped when nil.
+type Scanner struct {
+	ClusterID     string
+	Client        kubernetes.Interface
+	Dynamic       dynamic.Interface
+	APIExtensions apiextclientset.Interface
+	Now           func() time.Time
+}
+
+// Scan collects every certificate source and classifies the results.
+func (s Scanner) Scan(ctx context.Context, opts Options) (*Report, error) {
+	if s.Client == nil {
+		return nil, fmt.Errorf("kubernetes client is not initialized")
+	}
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	window := opts.WarningWindow
+	if window <= 0 {
+		window = DefaultWarningWindow
+	}
+	report := &Report{
+		ClusterID:         s.ClusterID,
+		GeneratedAt:       now().UTC(),
+		WarningWindowDays: int(window / (24 * time.Hour)),
+		Findings:          []Finding{},
+	}
+
+	// Each source, and each namespace of a namespaced source, is scanned on
+	// its own: one failed list becomes a warning, never a shorter scan.
+	var candidates []Finding
+	collect := func(label string, found []Finding, err error) {
+		if err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s: %v", label, err))
+		}
+		candidates = append(candidates, found...)
+	}
+	for _, namespace := range namespacesOrAll(opts.Namespaces) {
+		found, err := s.scanTLSSecrets(ctx, namespace)
+		collect(scopedLabel("TLS secrets", namespace), found, err)
+	}
+	found, err := s.scanMutatingWebhookCABundles(ctx)
+	collect("mutating webhook configurations", found, err)
+	found, err = s.scanValidatingWebhookCABundles(ctx)
+	collect("validating webhook configurations", found, err)
+	if s.APIExtensions != nil {
+		found, err = s.scanCRDConversionCABundles(ctx)
+		collect("custom resource definitions", found, err)
+	}
+	if s.Dynamic != nil {
+		for _, namespace := range namespacesOrAll(opts.Namespaces) {
+			found, err := s.scanCertManagerCertificates(ctx, namespace)
+			collect(scopedLabel("cert-manager certificates", namespace), found, err)
+		}
+	}
+
+	for _, finding := range candidates {
+		finding.DaysRemaining = int(finding.NotAfter.Sub(report.GeneratedAt).Hours() / 24)
+		finding.Status = classify(finding.NotAfter, report.GeneratedAt, window)
+		switch finding.Status {
+		case StatusExpired:
+			report.ExpiredCount++
+		case StatusExpiring:
+			report.ExpiringCount++
+		case StatusValid:
+			if !opts.IncludeValid {
+				continue
+			}
+		}
+		report.Findings = append(report.Findings, finding)
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return report.Findings[i].NotAfter.Before(report.Findings[j].NotAfter)
+	})
+	return report, nil
+}
+
+func classify(notAfter, now time.Time, window time.Duration) Status {
+	switch {
+	case !notAfter.After(now):
+		return StatusExpired
+	case notAfter.Before(now.Add(window)):
+		return StatusExpiring
+	default:
+		return StatusValid
+	}
+}
+
+// EarliestExpiring parses every PEM CERTIFICATE block in data and returns the
+// one with the earliest NotAfter. Non-certificate blocks and unparsable
+// certificates are skipped; ok is false when no certificate was found.
+func EarliestExpiring(data []byte) (*x509.Certificate, bool) {
+	var earliest *x509.Certificate
+	for rest := data; len(rest) > 0; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if earliest == nil || cert.NotAfter.Before(earliest.NotAfter) {
+			earliest = cert
+		}
+	}
+	return earliest, earliest != nil
+}
+
+// EarliestNotAfter returns the earliest certificate expiry across the PEM
+// bundles; ok is false when none of them holds a certificate.
+func EarliestNotAfter(bundles ...[]byte) (time.Time, bool) {
+	var earliest time.Time
+	for _, bundle := range bundles {
+		cert, ok := EarliestExpiring(bundle)
+		if ok && (earliest.IsZero() || cert.NotAfter.Before(earliest)) {
+			earliest = cert.NotAfter
+		}
+	}
+	return earliest.UTC(), !earliest.IsZero()
+}
+
+func pemFinding(ref resourcemodel.ResourceRef, source Source, location string, data []byte) (Finding, bool) {
+	cert, ok := EarliestExpiring(data)
+	if !ok {
+		return Finding{}, false
+	}
+	return Finding{
+		Ref:      ref,
+		Source:   source,
+		Location: location,
+		Subject:  cert.Subject.String(),
+		Issuer:   cert.Issuer.String(),
+		NotAfter: cert.NotAfter.UTC(),
+	}, true
+}
+
+func (s Scanner) scanTLSSecrets(ctx context.Context, namespace string) ([]Finding, error) {
+	list, err := s.Client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "type=" + string(corev1.SecretTypeTLS),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for i := range list.Items {
+		sec := &list.Items[i]
+		// Field selectors are advisory for some API paths; filter again.
+		if sec.Type != corev1.SecretTypeTLS {
+			continue
+		}
+		ref := resourcemodel.NewResourceRef(s.ClusterID, "", "v1", "Secret", "secrets", sec.Namespace, sec.Name, string(sec.UID))
+		for _, key := range TLSSecretCertificateKeys {
+			if finding, ok := pemFinding(ref, SourceTLSSecret, key, sec.Data[key]); ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
+}
+
+func (s Scanner) scanMutatingWebhookCABundles(ctx context.Context) ([]Finding, error) {
+	mutating, err := s.Client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, config := range mutating.Items {
+		ref := resourcemodel.NewResourceRef(s.ClusterID, "admissionregistration.k8s.io", "v1", "MutatingWebhookConfiguration", "mutatingwebhookconfigurations", "", config.Name, string(config.UID))
+		for _, webhook := range config.Webhooks {
+			if finding, ok := pemFinding(ref, SourceWebhookCABundle, "webhooks["+webhook.Name+"].clientConfig.caBundle", webhook.ClientConfig.CABundle); ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
+}
+
+func (s Scanner) scanValidatingWebhookCABundles(ctx context.Context) ([]Finding, error) {
+	validating, err := s.Client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, config := range validating.Items {
+		ref := resourcemodel.NewResourceRef(s.ClusterID, "admissionregistration.k8s.io", "v1", "ValidatingWebhookConfiguration", "validatingwebhookconfigurations", "", config.Name, string(config.UID))
+		for _, webhook := range config.Webhooks {
+			if finding, ok := pemFinding(ref, SourceWebhookCABundle, "webhooks["+webhook.Name+"].clientConfig.caBundle", webhook.ClientConfig.CABundle); ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
+}
+
+func (s Scanner) scanCRDConversionCABundles(ctx context.Context) ([]Finding, error) {
+	list, err := s.APIExtensions.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, crd := range list.Items {
+		conversion := crd.Spec.Conversion
+		if conversion == nil || conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+			continue
+		}
+		ref := resourcemodel.NewResourceRef(s.ClusterID, "apiextensions.k8s.io", "v1", "CustomResourceDefinition", "customresourcedefinitions", "", crd.Name, string(crd.UID))
+		if finding, ok := pemFinding(ref, SourceCRDConversionCABundle, "spec.conversion.webhook.clientConfig.caBundle", conversion.Webhook.ClientConfig.CABundle); ok {
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+func (s Scanner) scanCertManagerCertificates(ctx context.Context, namespace string) ([]Finding, error) {
+	list, err := s.Dynamic.Resource(certManagerCertificates).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// cert-manager is optional: an absent API is not a scan failure.
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var findings []Finding
+	for i := range list.Items {
+		if finding, ok := s.certManagerFinding(&list.Items[i]); ok {
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+func (s Scanner) certManagerFinding(obj *unstructured.Unstructured) (Finding, bool) {
+	raw, found, _ := unstructured.NestedString(obj.Object, "status", "notAfter")
+	if !found || raw == "" {
+		return Finding{}, false
+	}
+	notAfter, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return Finding{}, false
+	}
+	commonName, _, _ := unstructured.NestedString(obj.Object, "spec", "commonName")
+	issuer, _, _ := unstructured.NestedString(obj.Object, "spec", "issuerRef", "name")
+	ref := resourcemodel.NewResourceRef(s.ClusterID, certManagerCertificates.Group, certManagerCertificates.Version, "Certificate", certManagerCertificates.Resource, obj.GetNamespace(), obj.GetName(), string(obj.GetUID()))
+	return Finding{
+		Ref:      ref,
+		Source:   SourceCertManagerCertificate,
+		Location: "status.notAfter",
+		Subject:  commonName,
+		Issuer:   issuer,
+		NotAfter: notAfter.UTC(),
+	}, true
+}
+
+// scopedLabel names a namespaced source in a warning.
+func scopedLabel(label, namespace string) string {
+	if namespace == metav1.NamespaceAll {
+		return label
+	}
+	return label + " in " + namespace
+}
+
+// namespacesOrAll returns the scoped namespaces, or the all-namespaces
+// sentinel when no scope is set.
+func namespacesOrAll(namespaces []string) []string {
+	if len(namespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return namespaces
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/errorcapture"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
 	"github.com/luxury-yacht/app/backend/refresh/system"
 )
 
@@ -60,13 +62,14 @@ func (a *App) handleClusterAuthStateChange(clusterID string, state authstate.Sta
 		a.logger.Warn(fmt.Sprintf("Cluster %s: auth recovering - %s", clusterName, diag.Reason), logsources.Auth, clusterID, clusterName)
 		// Emit per-cluster recovering event for the frontend
 		a.emitEvent("cluster:auth:recovering", authEventPayload(clusterID, clusterName, diag))
-		// Teardown only this cluster's subsystem through the coordinated mutation path.
+		// Stop only this cluster's feeds through the coordinated mutation path,
+		// keeping its last state browsable until the rebuild on recovery.
 		a.runSelectionMutationAsync(fmt.Sprintf("cluster-auth-teardown:%s", clusterID), func(_ *selectionMutation) error {
 			return a.runClusterOperation(context.Background(), clusterID, func(opCtx context.Context) error {
 				if err := opCtx.Err(); err != nil {
 					return err
 				}
-				a.teardownClusterSubsystem(clusterID)
+				a.freezeClusterSubsystemOffline(clusterID)
 				return opCtx.Err()
 			})
 		})
@@ -186,6 +189,35 @@ func (a *App) teardownClusterSubsystem(clusterID string) {
 	a.spillClusterIngestStores(clusterID, subsystem.IngestManager)
 }
 
+// freezeClusterSubsystemOffline keeps a cluster's views browsable while its
+// credentials recover. Like a teardown it stops everything feeding the
+// subsystem and spills the stores for the rebuild's warm-paint, but it leaves
+// the subsystem registered behind an always-settled informer hub, so snapshots
+// keep serving the last captured state flagged offline instead of blanking.
+// The rebuild on recovery swaps in a live subsystem. A cooled subsystem, or one
+// whose snapshot service cannot take the settled hub, is torn down as before.
+func (a *App) freezeClusterSubsystemOffline(clusterID string) {
+	if a == nil || clusterID == "" {
+		return
+	}
+	subsystem := a.getRefreshSubsystem(clusterID)
+	var service *snapshot.Service
+	if subsystem != nil && !subsystem.Cooled {
+		service, _ = subsystem.SnapshotService.(*snapshot.Service)
+	}
+	if service == nil {
+		a.teardownClusterSubsystem(clusterID)
+		return
+	}
+
+	a.stopClusterFeeds(clusterID, subsystem)
+	service.SetInformerHub(system.NewCooledInformerHub())
+	subsystem.Freshness.MarkDegraded(refresh.FreshnessReasonAuth)
+	a.spillClusterStores(clusterID, subsystem.Registry)
+	a.spillClusterIngestStores(clusterID, subsystem.IngestManager)
+	a.logger.Info(fmt.Sprintf("Serving the last captured state of cluster %s offline until its credentials recover", clusterID), logsources.Auth, clusterID, a.clusterNameForID(clusterID))
+}
+
 // rebuildClusterSubsystem rebuilds the cluster clients and refresh subsystem
 // for a specific cluster after auth recovery. This rebuilds everything with
 // fresh credentials from the kubeconfig to pick up refreshed SSO tokens.
@@ -13,6 +13,10 @@ import (
 	"testing"
 
 	"github.com/luxury-yacht/app/backend/internal/authstate"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/refresh/system"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,3 +77,41 @@ func TestRebuildClusterSubsystemPreservesAuthManagerWiring(t *testing.T) {
 	require.Equal(t, authstate.StateInvalid, state,
 		"auth failures seen by rebuilt transports must transition the tracked manager")
 }
+
+// TestFreezeClusterSubsystemOfflineKeepsServingLastState verifies that an
+// auth-recovering cluster stays registered behind a settled hub with its
+// freshness flagged offline, so views keep their last captured state.
+func TestFreezeClusterSubsystemOfflineKeepsServingLastState(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.spillRoot = t.TempDir()
+
+	tracker := refresh.NewFreshnessTracker()
+	service := snapshot.NewServiceWithPermissions(domain.New(), nil, snapshot.ClusterMeta{ClusterID: "cluster-a", ClusterName: "alpha"}, nil).
+		WithFreshness(tracker)
+	subsystem := &system.Subsystem{Registry: domain.New(), SnapshotService: service, Freshness: tracker}
+	app.setRefreshSubsystem("cluster-a", subsystem)
+
+	app.freezeClusterSubsystemOffline("cluster-a")
+
+	require.Same(t, subsystem, app.getRefreshSubsystem("cluster-a"))
+	freshness := service.DomainFreshness(context.Background(), "pods")
+	require.True(t, freshness.InformersSynced)
+	require.True(t, freshness.Offline)
+	require.Equal(t, refresh.FreshnessReasonAuth, freshness.DegradedReason)
+}
+
+// TestFreezeClusterSubsystemOfflineTearsDownCooledSubsystem verifies that a
+// cooled subsystem, which already serves from spilled stores, is torn down.
+func TestFreezeClusterSubsystemOfflineTearsDownCooledSubsystem(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.spillRoot = t.TempDir()
+
+	tracker := refresh.NewFreshnessTracker()
+	service := snapshot.NewServiceWithPermissions(domain.New(), nil, snapshot.ClusterMeta{ClusterID: "cluster-a", ClusterName: "alpha"}, nil).
+		WithFreshness(tracker)
+	app.setRefreshSubsystem("cluster-a", &system.Subsystem{Registry: domain.New(), SnapshotService: service, Freshness: tracker, Cooled: true})
+
+	app.freezeClusterSubsystemOffline("cluster-a")
+
+	require.Nil(t, app.getRefreshSubsystem("cluster-a"))
+}
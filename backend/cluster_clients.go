@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"runtime"
+	"time"
 
 	appconfig "github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
@@ -15,6 +16,7 @@ import (
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
@@ -37,6 +39,7 @@ type clusterClients struct {
 	gatewayVersionResolver common.VersionResolver
 	apiextensionsClient    apiextensionsclientset.Interface
 	dynamicClient          dynamic.Interface
+	metadataClient         metadata.Interface
 	metricsClient          *metricsclient.Clientset
 	restConfig             *rest.Config
 	rateLimiter            *mutableKubernetesRateLimiter
@@ -272,6 +275,7 @@ func (a *App) syncClusterClientPoolWithBuilder(
 
 	for _, id := range removedClusterIDs {
 		a.ensureKubernetesAPIMetricsRegistry().remove(id)
+		a.clusterRequestTimeouts.Delete(id)
 		a.removeClusterWorkspaceState(id)
 	}
 
@@ -312,13 +316,22 @@ func (a *App) applyKubernetesClientRateLimits(qps int, burst int) {
 
 	registry := a.ensureKubernetesAPIMetricsRegistry()
 	for _, item := range clients {
+		// Clusters with their own limits keep them.
+		itemQPS, itemBurst := qps, burst
+		overrides := a.clusterKubernetesAPIOverrides(item.meta.ID)
+		if overrides.ClientQPS > 0 {
+			itemQPS = clampKubernetesClientQPS(overrides.ClientQPS)
+		}
+		if overrides.ClientBurst > 0 {
+			itemBurst = clampKubernetesClientBurst(overrides.ClientBurst)
+		}
 		if item.rateLimiter != nil {
-			item.rateLimiter.Set(qps, burst)
+			item.rateLimiter.Set(itemQPS, itemBurst)
 		}
-		registry.getOrCreate(item.meta, qps, burst)
+		registry.getOrCreate(item.meta, itemQPS, itemBurst)
 		if item.restConfig != nil {
-			item.restConfig.QPS = float32(qps)
-			item.restConfig.Burst = burst
+			item.restConfig.QPS = float32(itemQPS)
+			item.restConfig.Burst = itemBurst
 		}
 	}
 }
@@ -385,6 +398,12 @@ func (a *App) buildClusterClientsWithManager(
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
 	}
 
+	metadataClient, err := metadata.NewForConfig(config)
+	if err != nil {
+		shutdownOwned()
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+
 	var metrics *metricsclient.Clientset
 	metricsClient, err := metricsclient.NewForConfig(typedConfig)
 	if err != nil {
@@ -406,7 +425,7 @@ func (a *App) buildClusterClientsWithManager(
 			return nil, fmt.Errorf("failed to create gateway api clientset: %w", err)
 		}
 		gatewayClient = gatewayClientset
-		gatewayInformerFactory = gatewayinformers.NewSharedInformerFactoryWithOptions(gatewayClientset, appconfig.RefreshResyncInterval, gatewayinformers.WithTransform(informerpkg.StripManagedFields))
+		gatewayInformerFactory = gatewayinformers.NewSharedInformerFactoryWithOptions(gatewayClientset, a.currentRefreshTuning().informerResync, gatewayinformers.WithTransform(informerpkg.StripManagedFields))
 	}
 
 	// Configure the recovery test to rebuild credentials from kubeconfig.
@@ -472,6 +491,7 @@ func (a *App) buildClusterClientsWithManager(
 		gatewayVersionResolver: gatewayPresence,
 		apiextensionsClient:    apiextensionsClient,
 		dynamicClient:          dynamicClient,
+		metadataClient:         metadataClient,
 		metricsClient:          metrics,
 		restConfig:             config,
 		rateLimiter:            config.RateLimiter.(*mutableKubernetesRateLimiter),
@@ -573,19 +593,27 @@ func (a *App) buildRestConfigForSelection(selection kubeconfigSelection, meta Cl
 		wrapExecProviderForWindows(config)
 	}
 
-	qps, burst := a.kubernetesClientRateLimits()
+	qps, burst, requestTimeout := a.kubernetesClientLimitsForCluster(meta.ID)
+	a.clusterRequestTimeouts.Store(meta.ID, requestTimeout)
 	config.QPS = float32(qps)
 	config.Burst = burst
-	config.RateLimiter = newMutableKubernetesRateLimiter(qps, burst)
-
 	// Wrap transport once so diagnostics see real outbound Kubernetes requests,
 	// then preserve the auth-aware layer for per-cluster auth state management.
+	// The rate limiter reports its waits to the same metrics, and the request
+	// timeout sits below them so timed-out requests count as errors.
 	apiMetrics := a.ensureKubernetesAPIMetricsRegistry().getOrCreate(meta, qps, burst)
+	rateLimiter := newMutableKubernetesRateLimiter(qps, burst)
+	rateLimiter.metrics = apiMetrics
+	config.RateLimiter = rateLimiter
 	existingWrap := config.WrapTransport
 	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
 		if existingWrap != nil {
 			rt = existingWrap(rt)
 		}
+		rt = &kubernetesRequestTimeoutTransport{
+			base:    rt,
+			timeout: func() time.Duration { return a.clusterRequestTimeout(meta.ID) },
+		}
 		rt = &kubernetesAPIMetricsTransport{base: rt, metrics: apiMetrics}
 		if clusterAuthMgr != nil {
 			rt = clusterAuthMgr.WrapTransport(rt)
@@ -8,6 +8,9 @@ const (
 	ClusterHealthUnknown  ClusterHealthState = "unknown"
 	ClusterHealthHealthy  ClusterHealthState = "healthy"
 	ClusterHealthDegraded ClusterHealthState = "degraded"
+	// ClusterHealthReconnecting means the API server has been unreachable for
+	// several consecutive health checks and the app is waiting for it to return.
+	ClusterHealthReconnecting ClusterHealthState = "reconnecting"
 )
 
 type ClusterWorkspaceAuthState struct {
@@ -93,6 +96,7 @@ func (a *App) removeClusterWorkspaceRuntimeState(clusterID string) {
 	_, hadHealth := a.clusterHealth[clusterID]
 	_, hadScopeRevision := a.clusterScopeRevisions[clusterID]
 	delete(a.clusterHealth, clusterID)
+	delete(a.clusterConnectivityFailures, clusterID)
 	delete(a.clusterScopeRevisions, clusterID)
 	if hadHealth || hadScopeRevision {
 		a.markClusterWorkspaceChanged()
@@ -102,6 +106,14 @@ func (a *App) removeClusterWorkspaceRuntimeState(clusterID string) {
 
 func (a *App) removeClusterWorkspaceState(clusterID string) {
 	a.removeClusterWorkspaceRuntimeState(clusterID)
+	if a != nil {
+		a.forgetClusterAlerts(clusterID)
+		a.crashHistory().ForgetCluster(clusterID)
+		a.hpaHistory().ForgetCluster(clusterID)
+		a.configHistory().ForgetCluster(clusterID)
+		a.imageIndex().ForgetCluster(clusterID)
+		a.forgetClusterGitDrift(clusterID)
+	}
 	if a != nil && a.clusterLifecycle != nil {
 		a.clusterLifecycle.Remove(clusterID)
 	}
@@ -0,0 +1,238 @@
+// Package clusterinfo summarizes a cluster for the cluster info panel: the API
+// server's version and platform, the API groups it serves, how kubelet
+// versions are spread across the nodes, and which nodes fall outside the
+// Kubernetes version skew policy against the control plane.
+package clusterinfo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SkewSeverity classifies a kubelet version against the skew policy.
+type SkewSeverity string
+
+const (
+	// SkewUnsupported kubelets are newer than the API server or older than the
+	// policy allows.
+	SkewUnsupported SkewSeverity = "unsupported"
+	// SkewAtLimit kubelets are as old as the policy allows; the next control
+	// plane minor upgrade takes them out of support.
+	SkewAtLimit SkewSeverity = "at-limit"
+)
+
+// ServerVersion is the API server's reported build.
+type ServerVersion struct {
+	GitVersion string `json:"gitVersion"`
+	Major      string `json:"major"`
+	Minor      string `json:"minor"`
+	Platform   string `json:"platform"`
+	GoVersion  string `json:"goVersion,omitempty"`
+	BuildDate  string `json:"buildDate,omitempty"`
+}
+
+// APIGroup is one served API group and its versions, preferred first.
+type APIGroup struct {
+	Name             string   `json:"name"`
+	PreferredVersion string   `json:"preferredVersion"`
+	Versions         []string `json:"versions"`
+}
+
+// KubeletVersion is one kubelet version and the nodes running it.
+type KubeletVersion struct {
+	Version string   `json:"version"`
+	Count   int      `json:"count"`
+	Nodes   []string `json:"nodes"`
+}
+
+// SkewFinding is a kubelet version outside, or at the edge of, the supported
+// skew from the API server.
+type SkewFinding struct {
+	Severity       SkewSeverity `json:"severity"`
+	KubeletVersion string       `json:"kubeletVersion"`
+	// MinorsBehind is how many minor versions the kubelet trails the API
+	// server; negative when it is ahead.
+	MinorsBehind int      `json:"minorsBehind"`
+	Nodes        []string `json:"nodes"`
+	Message      string   `json:"message"`
+}
+
+// Info is the cluster summary. Kubelet versions are newest first; skew
+// findings list unsupported versions before those at the limit.
+type Info struct {
+	ClusterID       string           `json:"clusterId"`
+	GeneratedAt     time.Time        `json:"generatedAt"`
+	Server          ServerVersion    `json:"server"`
+	APIGroups       []APIGroup       `json:"apiGroups"`
+	NodeCount       int              `json:"nodeCount"`
+	KubeletVersions []KubeletVersion `json:"kubeletVersions"`
+	Skew            []SkewFinding    `json:"skew"`
+	// Warnings lists parts that could not be read (for example a forbidden
+	// node list); the rest of the summary is still complete.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Collector reads the summary from one cluster.
+type Collector struct {
+	ClusterID string
+	Client    kubernetes.Interface
+	Now       func() time.Time
+}
+
+// Collect builds the summary. Only the server version is required; API
+// groups and nodes that cannot be read become warnings.
+func (c Collector) Collect(ctx context.Context) (*Info, error) {
+	if c.Client == nil {
+		return nil, fmt.Errorf("kubernetes client is not initialized")
+	}
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+	discovery := c.Client.Discovery()
+	serverInfo, err := discovery.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server version: %w", err)
+	}
+	info := &Info{
+		ClusterID:   c.ClusterID,
+		GeneratedAt: now().UTC(),
+		Server: ServerVersion{
+			GitVersion: serverInfo.GitVersion,
+			Major:      serverInfo.Major,
+			Minor:      serverInfo.Minor,
+			Platform:   serverInfo.Platform,
+			GoVersion:  serverInfo.GoVersion,
+			BuildDate:  serverInfo.BuildDate,
+		},
+		APIGroups:       []APIGroup{},
+		KubeletVersions: []KubeletVersion{},
+		Skew:            []SkewFinding{},
+	}
+
+	groups, err := discovery.ServerGroups()
+	if err != nil {
+		info.Warnings = append(info.Warnings, fmt.Sprintf("API groups: %v", err))
+	} else if groups != nil {
+		info.APIGroups = apiGroups(groups)
+	}
+
+	nodes, err := c.Client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		info.Warnings = append(info.Warnings, fmt.Sprintf("nodes: %v", err))
+		return info, nil
+	}
+	byVersion := make(map[string][]string)
+	for _, node := range nodes.Items {
+		kubelet := node.Status.NodeInfo.KubeletVersion
+		byVersion[kubelet] = append(byVersion[kubelet], node.Name)
+	}
+	info.NodeCount = len(nodes.Items)
+	info.KubeletVersions = kubeletVersions(byVersion)
+	info.Skew = skewFindings(serverInfo.GitVersion, info.KubeletVersions)
+	return info, nil
+}
+
+// apiGroups lists the served groups by name; the core group is "".
+func apiGroups(list *metav1.APIGroupList) []APIGroup {
+	result := make([]APIGroup, 0, len(list.Groups))
+	for _, group := range list.Groups {
+		entry := APIGroup{
+			Name:             group.Name,
+			PreferredVersion: group.PreferredVersion.Version,
+			Versions:         make([]string, 0, len(group.Versions)),
+		}
+		for _, v := range group.Versions {
+			entry.Versions = append(entry.Versions, v.Version)
+		}
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func kubeletVersions(byVersion map[string][]string) []KubeletVersion {
+	result := make([]KubeletVersion, 0, len(byVersion))
+	for v, nodes := range byVersion {
+		sort.Strings(nodes)
+		result = append(result, KubeletVersion{Version: v, Count: len(nodes), Nodes: nodes})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, aErr := version.ParseGeneric(result[i].Version)
+		b, bErr := version.ParseGeneric(result[j].Version)
+		if aErr == nil && bErr == nil {
+			if b.LessThan(a) {
+				return true
+			}
+			if a.LessThan(b) {
+				return false
+			}
+		} else if (aErr == nil) != (bErr == nil) {
+			// Parsable versions before unparsable ones.
+			return aErr == nil
+		}
+		return result[i].Version < result[j].Version
+	})
+	return result
+}
+
+// maxKubeletMinorsBehind is how far a kubelet may trail the API server: three
+// minor versions, or two for kubelets older than 1.25.
+func maxKubeletMinorsBehind(kubelet *version.Version) int {
+	if kubelet.Major() == 1 && kubelet.Minor() < 25 {
+		return 2
+	}
+	return 3
+}
+
+// skewFindings checks each kubelet version against the API server. Versions
+// that cannot be parsed are skipped rather than guessed at.
+func skewFindings(serverVersion string, kubelets []KubeletVersion) []SkewFinding {
+	findings := []SkewFinding{}
+	server, err := version.ParseGeneric(serverVersion)
+	if err != nil {
+		return findings
+	}
+	for _, kubelet := range kubelets {
+		parsed, err := version.ParseGeneric(kubelet.Version)
+		if err != nil {
+			continue
+		}
+		if parsed.Major() != server.Major() {
+			findings = append(findings, SkewFinding{
+				Severity:       SkewUnsupported,
+				KubeletVersion: kubelet.Version,
+				Nodes:          kubelet.Nodes,
+				Message:        fmt.Sprintf("kubelet %s has a different major version from the API server %s", kubelet.Version, serverVersion),
+			})
+			continue
+		}
+		behind := int(server.Minor()) - int(parsed.Minor())
+		limit := maxKubeletMinorsBehind(parsed)
+		finding := SkewFinding{KubeletVersion: kubelet.Version, MinorsBehind: behind, Nodes: kubelet.Nodes}
+		switch {
+		case behind < 0:
+			finding.Severity = SkewUnsupported
+			finding.Message = fmt.Sprintf("kubelet %s is newer than the API server %s", kubelet.Version, serverVersion)
+		case behind > limit:
+			finding.Severity = SkewUnsupported
+			finding.Message = fmt.Sprintf("kubelet %s is %d minor versions behind the API server %s; at most %d is supported", kubelet.Version, behind, serverVersion, limit)
+		case behind == limit:
+			finding.Severity = SkewAtLimit
+			finding.Message = fmt.Sprintf("kubelet %s is %d minor versions behind the API server %s; upgrade these nodes before the next control plane upgrade", kubelet.Version, behind, serverVersion)
+		default:
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity == SkewUnsupported && findings[j].Severity != SkewUnsupported
+	})
+	return findings
+}
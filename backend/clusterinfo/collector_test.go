@@ -0,0 +1,118 @@
+package clusterinfo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	versioninfo "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/clusterinfo"
+)
+
+func node(name, kubelet string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubelet}},
+	}
+}
+
+func fakeClient(serverVersion string, objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewClientset(objects...)
+	discovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.FakedServerVersion = &versioninfo.Info{
+		GitVersion: serverVersion,
+		Major:      "1",
+		Minor:      "31",
+		Platform:   "linux/amd64",
+	}
+	discovery.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1"},
+		{GroupVersion: "apps/v1"},
+		{GroupVersion: "batch/v1"},
+	}
+	return client
+}
+
+func TestCollectReportsVersionSpreadAndSkew(t *testing.T) {
+	client := fakeClient("v1.31.2-eks-7f9249a",
+		node("a", "v1.31.1-eks-1"),
+		node("b", "v1.31.1-eks-1"),
+		node("c", "v1.28.9"),
+		node("d", "v1.27.3"),
+		node("e", "v1.32.0"),
+	)
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	info, err := clusterinfo.Collector{ClusterID: "c1", Client: client, Now: func() time.Time { return now }}.Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "c1", info.ClusterID)
+	require.Equal(t, now, info.GeneratedAt)
+	require.Equal(t, "v1.31.2-eks-7f9249a", info.Server.GitVersion)
+	require.Equal(t, "linux/amd64", info.Server.Platform)
+	require.Equal(t, 5, info.NodeCount)
+	require.Empty(t, info.Warnings)
+
+	var groups []string
+	for _, group := range info.APIGroups {
+		groups = append(groups, group.Name)
+	}
+	require.Equal(t, []string{"", "apps", "batch"}, groups)
+
+	require.Len(t, info.KubeletVersions, 4)
+	require.Equal(t, "v1.32.0", info.KubeletVersions[0].Version)
+	require.Equal(t, clusterinfo.KubeletVersion{Version: "v1.31.1-eks-1", Count: 2, Nodes: []string{"a", "b"}}, info.KubeletVersions[1])
+	require.Equal(t, "v1.27.3", info.KubeletVersions[3].Version)
+
+	require.Len(t, info.Skew, 3)
+	require.Equal(t, clusterinfo.SkewUnsupported, info.Skew[0].Severity)
+	require.Equal(t, "v1.32.0", info.Skew[0].KubeletVersion)
+	require.Equal(t, -1, info.Skew[0].MinorsBehind)
+	require.Equal(t, clusterinfo.SkewUnsupported, info.Skew[1].Severity)
+	require.Equal(t, "v1.27.3", info.Skew[1].KubeletVersion)
+	require.Equal(t, []string{"d"}, info.Skew[1].Nodes)
+	require.Equal(t, clusterinfo.SkewAtLimit, info.Skew[2].Severity)
+	require.Equal(t, "v1.28.9", info.Skew[2].KubeletVersion)
+	require.Equal(t, 3, info.Skew[2].MinorsBehind)
+}
+
+func TestCollectAllowsTwoMinorsForOldKubelets(t *testing.T) {
+	client := fakeClient("v1.26.0", node("a", "v1.24.1"), node("b", "v1.23.0"))
+
+	info, err := clusterinfo.Collector{Client: client}.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, info.Skew, 2)
+	require.Equal(t, clusterinfo.SkewUnsupported, info.Skew[0].Severity)
+	require.Equal(t, "v1.23.0", info.Skew[0].KubeletVersion)
+	require.Equal(t, clusterinfo.SkewAtLimit, info.Skew[1].Severity)
+	require.Equal(t, "v1.24.1", info.Skew[1].KubeletVersion)
+}
+
+func TestCollectWarnsWhenNodesAreForbidden(t *testing.T) {
+	client := fakeClient("v1.31.0", node("a", "v1.20.0"))
+	client.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, "", nil)
+	})
+
+	info, err := clusterinfo.Collector{Client: client}.Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "v1.31.0", info.Server.GitVersion)
+	require.Zero(t, info.NodeCount)
+	require.Empty(t, info.Skew)
+	require.Len(t, info.Warnings, 1)
+	require.Contains(t, info.Warnings[0], "nodes")
+}
+
+func TestCollectRequiresClient(t *testing.T) {
+	_, err := clusterinfo.Collector{}.Collect(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,339 @@
+// Package confighistory records how ConfigMap and Secret data changes as the
+// stream observes it: per-key digests for both kinds, and the values of small
+// ConfigMaps, so "what changed in this configmap and when" can be answered
+// without GitOps.
+package confighistory
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+// Key change kinds reported by Diff.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Revision is one observed state of a ConfigMap's or Secret's data.
+type Revision struct {
+	// Revision numbers the states seen this session, starting at 1.
+	Revision   int       `json:"revision"`
+	ObservedAt time.Time `json:"observedAt"`
+	// Baseline marks the first state seen; what changed before it is unknown.
+	Baseline bool `json:"baseline,omitempty"`
+	Deleted  bool `json:"deleted,omitempty"`
+	// Added, Removed and Changed list the data keys that differ from the previous
+	// revision.
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Changed []string `json:"changed,omitempty"`
+	// HasValues reports whether the data values were kept (small ConfigMaps only).
+	HasValues bool `json:"hasValues,omitempty"`
+}
+
+// History is the recorded revisions of one ConfigMap or Secret, newest first.
+type History struct {
+	ClusterID string     `json:"clusterId"`
+	Namespace string     `json:"namespace"`
+	Kind      string     `json:"kind"`
+	Name      string     `json:"name"`
+	Revisions []Revision `json:"revisions"`
+	// ObservedSince is when the recorder started watching the cluster's config.
+	ObservedSince time.Time `json:"observedSince"`
+}
+
+// KeyChange is one data key that differs between two revisions. From and To
+// carry the values only when both revisions kept them.
+type KeyChange struct {
+	Key    string `json:"key"`
+	Change string `json:"change"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+// Diff compares the data of two revisions of one ConfigMap or Secret.
+type Diff struct {
+	ClusterID    string      `json:"clusterId"`
+	Namespace    string      `json:"namespace"`
+	Kind         string      `json:"kind"`
+	Name         string      `json:"name"`
+	FromRevision int         `json:"fromRevision"`
+	ToRevision   int         `json:"toRevision"`
+	Changes      []KeyChange `json:"changes"`
+	// ValuesAvailable is false when either revision kept digests only (Secrets and
+	// ConfigMaps over the content limit); changes then name the keys alone.
+	ValuesAvailable bool `json:"valuesAvailable"`
+}
+
+// record is a stored revision with the data it was computed from.
+type record struct {
+	Revision
+	hashes map[string]string
+	values map[string]string
+}
+
+// objectHistory is one object's revisions, oldest first.
+type objectHistory struct {
+	next    int
+	records []record
+}
+
+// Recorder keeps ConfigMap and Secret data revisions from ingest bundles for this
+// session. History follows the object's name, so a deleted and recreated object
+// continues its history. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	now   func() time.Time
+	limit int
+	// objects maps clusterID|Kind|namespace/name to its history.
+	objects map[string]*objectHistory
+	since   map[string]time.Time
+}
+
+// NewRecorder returns a recorder that keeps at most limit revisions per object.
+func NewRecorder(limit int, now func() time.Time) *Recorder {
+	if now == nil {
+		now = time.Now
+	}
+	return &Recorder{
+		now:     now,
+		limit:   limit,
+		objects: make(map[string]*objectHistory),
+		since:   make(map[string]time.Time),
+	}
+}
+
+// Sink returns the ingest bundle sink that feeds one cluster's objects of kind
+// (ConfigMap or Secret) into the recorder.
+func (r *Recorder) Sink(clusterID, kind string) ingest.BundleSink {
+	return recorderSink{recorder: r, clusterID: clusterID, kind: kind}
+}
+
+// ForgetCluster drops a removed cluster's history.
+func (r *Recorder) ForgetCluster(clusterID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prefix := clusterID + "|"
+	for key := range r.objects {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.objects, key)
+		}
+	}
+	delete(r.since, clusterID)
+}
+
+// History returns an object's revisions, newest first.
+func (r *Recorder) History(clusterID, namespace, kind, name string) History {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	history := History{
+		ClusterID:     clusterID,
+		Namespace:     namespace,
+		Kind:          kind,
+		Name:          name,
+		Revisions:     []Revision{},
+		ObservedSince: r.since[clusterID],
+	}
+	if object := r.objects[objectKey(clusterID, kind, namespace, name)]; object != nil {
+		for i := len(object.records) - 1; i >= 0; i-- {
+			history.Revisions = append(history.Revisions, object.records[i].Revision)
+		}
+	}
+	return history
+}
+
+// Diff compares two recorded revisions of an object. A revision that was never
+// recorded or has been trimmed is an error.
+func (r *Recorder) Diff(clusterID, namespace, kind, name string, fromRevision, toRevision int) (Diff, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	object := r.objects[objectKey(clusterID, kind, namespace, name)]
+	from, err := object.find(fromRevision)
+	if err != nil {
+		return Diff{}, err
+	}
+	to, err := object.find(toRevision)
+	if err != nil {
+		return Diff{}, err
+	}
+	diff := Diff{
+		ClusterID:       clusterID,
+		Namespace:       namespace,
+		Kind:            kind,
+		Name:            name,
+		FromRevision:    fromRevision,
+		ToRevision:      toRevision,
+		Changes:         []KeyChange{},
+		ValuesAvailable: (from.values != nil || from.Deleted) && (to.values != nil || to.Deleted),
+	}
+	added, removed, changed := compareHashes(from.hashes, to.hashes)
+	appendChanges := func(change string, keys []string) {
+		for _, key := range keys {
+			keyChange := KeyChange{Key: key, Change: change}
+			if diff.ValuesAvailable {
+				keyChange.From = from.values[key]
+				keyChange.To = to.values[key]
+			}
+			diff.Changes = append(diff.Changes, keyChange)
+		}
+	}
+	appendChanges(ChangeAdded, added)
+	appendChanges(ChangeRemoved, removed)
+	appendChanges(ChangeChanged, changed)
+	slices.SortStableFunc(diff.Changes, func(a, b KeyChange) int { return strings.Compare(a.Key, b.Key) })
+	return diff, nil
+}
+
+func (o *objectHistory) find(revision int) (*record, error) {
+	if o != nil {
+		for i := range o.records {
+			if o.records[i].Revision.Revision == revision {
+				return &o.records[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("revision %d not recorded", revision)
+}
+
+func objectKey(clusterID, kind, namespace, name string) string {
+	return clusterID + "|" + kind + "|" + namespace + "/" + name
+}
+
+// compareHashes returns the sorted keys added, removed and changed from one set of
+// digests to the next.
+func compareHashes(from, to map[string]string) (added, removed, changed []string) {
+	for key, hash := range to {
+		previous, ok := from[key]
+		switch {
+		case !ok:
+			added = append(added, key)
+		case previous != hash:
+			changed = append(changed, key)
+		}
+	}
+	for key := range from {
+		if _, ok := to[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(changed)
+	return added, removed, changed
+}
+
+func (r *Recorder) observeLocked(clusterID, kind string, agg streamrows.ConfigDataAggregate) {
+	key := objectKey(clusterID, kind, agg.Namespace, agg.Name)
+	object := r.objects[key]
+	if object == nil {
+		object = &objectHistory{}
+		r.objects[key] = object
+	}
+	next := record{
+		Revision: Revision{ObservedAt: r.now(), HasValues: agg.Values != nil},
+		hashes:   agg.Hashes,
+		values:   agg.Values,
+	}
+	if len(object.records) == 0 {
+		next.Baseline = true
+	} else {
+		last := object.records[len(object.records)-1]
+		if !last.Deleted && maps.Equal(last.hashes, agg.Hashes) {
+			return
+		}
+		next.Added, next.Removed, next.Changed = compareHashes(last.hashes, agg.Hashes)
+	}
+	r.appendLocked(object, next)
+}
+
+func (r *Recorder) deleteLocked(key string) {
+	object := r.objects[key]
+	if object == nil || len(object.records) == 0 {
+		return
+	}
+	last := object.records[len(object.records)-1]
+	if last.Deleted {
+		return
+	}
+	next := record{Revision: Revision{ObservedAt: r.now(), Deleted: true}}
+	next.Removed = slices.Sorted(maps.Keys(last.hashes))
+	r.appendLocked(object, next)
+}
+
+func (r *Recorder) appendLocked(object *objectHistory, next record) {
+	object.next++
+	next.Revision.Revision = object.next
+	object.records = append(object.records, next)
+	if r.limit > 0 && len(object.records) > r.limit {
+		object.records = slices.Clone(object.records[len(object.records)-r.limit:])
+	}
+}
+
+func (r *Recorder) markObservedLocked(clusterID string) {
+	if _, ok := r.since[clusterID]; !ok {
+		r.since[clusterID] = r.now()
+	}
+}
+
+// recorderSink adapts the recorder to one cluster's ConfigMap or Secret ingest store.
+type recorderSink struct {
+	recorder  *Recorder
+	clusterID string
+	kind      string
+}
+
+func (s recorderSink) UpsertBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.ConfigDataAggregate)
+	if !ok {
+		return
+	}
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.markObservedLocked(s.clusterID)
+	s.recorder.observeLocked(s.clusterID, s.kind, agg)
+}
+
+func (s recorderSink) DeleteBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.ConfigDataAggregate)
+	if !ok {
+		return
+	}
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.deleteLocked(objectKey(s.clusterID, s.kind, agg.Namespace, agg.Name))
+}
+
+// ReplaceBundles records a relist: changed objects get a revision, and objects
+// missing from the complete set were deleted while the watch was down.
+func (s recorderSink) ReplaceBundles(bundles []ingest.Bundle) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.markObservedLocked(s.clusterID)
+	present := make(map[string]struct{}, len(bundles))
+	for _, bundle := range bundles {
+		agg, ok := bundle.Aggregate.(streamrows.ConfigDataAggregate)
+		if !ok {
+			continue
+		}
+		present[objectKey(s.clusterID, s.kind, agg.Namespace, agg.Name)] = struct{}{}
+		s.recorder.observeLocked(s.clusterID, s.kind, agg)
+	}
+	prefix := s.clusterID + "|" + s.kind + "|"
+	for key := range s.recorder.objects {
+		if _, ok := present[key]; !ok && strings.HasPrefix(key, prefix) {
+			s.recorder.deleteLocked(key)
+		}
+	}
+}
+
+var _ ingest.BundleSink = recorderSink{}
+var _ ingest.BundleReplaceSink = recorderSink{}
@@ -0,0 +1,101 @@
+package confighistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/confighistory"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func configMapBundle(name string, values map[string]string) ingest.Bundle {
+	hashes := make(map[string]string, len(values))
+	for key, value := range values {
+		hashes[key] = streamrows.HashConfigValue([]byte(value))
+	}
+	return ingest.Bundle{Aggregate: streamrows.ConfigDataAggregate{
+		Namespace: "prod",
+		Name:      name,
+		Hashes:    hashes,
+		Values:    values,
+	}}
+}
+
+func TestRecorderTracksConfigMapRevisions(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	recorder := confighistory.NewRecorder(10, func() time.Time { return now })
+	sink := recorder.Sink("c1", "ConfigMap")
+
+	sink.UpsertBundle(configMapBundle("app", map[string]string{"LOG_LEVEL": "info", "MODE": "a"}))
+	// A resync that carries the same data records nothing.
+	sink.UpsertBundle(configMapBundle("app", map[string]string{"LOG_LEVEL": "info", "MODE": "a"}))
+	now = now.Add(time.Hour)
+	sink.UpsertBundle(configMapBundle("app", map[string]string{"LOG_LEVEL": "debug", "TIMEOUT": "5s"}))
+	sink.DeleteBundle(configMapBundle("app", nil))
+
+	history := recorder.History("c1", "prod", "ConfigMap", "app")
+	require.Len(t, history.Revisions, 3)
+	require.True(t, history.Revisions[0].Deleted)
+	require.Equal(t, []string{"LOG_LEVEL", "TIMEOUT"}, history.Revisions[0].Removed)
+	second := history.Revisions[1]
+	require.Equal(t, 2, second.Revision)
+	require.Equal(t, now, second.ObservedAt)
+	require.Equal(t, []string{"TIMEOUT"}, second.Added)
+	require.Equal(t, []string{"MODE"}, second.Removed)
+	require.Equal(t, []string{"LOG_LEVEL"}, second.Changed)
+	require.True(t, second.HasValues)
+	require.True(t, history.Revisions[2].Baseline)
+
+	diff, err := recorder.Diff("c1", "prod", "ConfigMap", "app", 1, 2)
+	require.NoError(t, err)
+	require.True(t, diff.ValuesAvailable)
+	require.Equal(t, []confighistory.KeyChange{
+		{Key: "LOG_LEVEL", Change: confighistory.ChangeChanged, From: "info", To: "debug"},
+		{Key: "MODE", Change: confighistory.ChangeRemoved, From: "a"},
+		{Key: "TIMEOUT", Change: confighistory.ChangeAdded, To: "5s"},
+	}, diff.Changes)
+
+	_, err = recorder.Diff("c1", "prod", "ConfigMap", "app", 1, 9)
+	require.ErrorContains(t, err, "revision 9 not recorded")
+
+	recorder.ForgetCluster("c1")
+	require.Empty(t, recorder.History("c1", "prod", "ConfigMap", "app").Revisions)
+}
+
+func TestRecorderSecretDiffNamesKeysOnlyAndRelistDeletes(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	recorder := confighistory.NewRecorder(2, func() time.Time { return now })
+	sink := recorder.Sink("c1", "Secret").(ingest.BundleReplaceSink)
+	secret := func(name, token string) ingest.Bundle {
+		return ingest.Bundle{Aggregate: streamrows.ConfigDataAggregate{
+			Namespace: "prod",
+			Name:      name,
+			Hashes:    map[string]string{"token": streamrows.HashConfigValue([]byte(token))},
+		}}
+	}
+
+	sink.ReplaceBundles([]ingest.Bundle{secret("creds", "one"), secret("gone", "x")})
+	sink.ReplaceBundles([]ingest.Bundle{secret("creds", "two")})
+	sink.ReplaceBundles([]ingest.Bundle{secret("creds", "three")})
+
+	history := recorder.History("c1", "prod", "Secret", "creds")
+	// The limit keeps the two newest revisions.
+	require.Len(t, history.Revisions, 2)
+	require.Equal(t, 3, history.Revisions[0].Revision)
+	require.False(t, history.Revisions[0].HasValues)
+
+	diff, err := recorder.Diff("c1", "prod", "Secret", "creds", 2, 3)
+	require.NoError(t, err)
+	require.False(t, diff.ValuesAvailable)
+	require.Equal(t, []confighistory.KeyChange{{Key: "token", Change: confighistory.ChangeChanged}}, diff.Changes)
+
+	// Missing from the complete relist: recorded as deleted.
+	gone := recorder.History("c1", "prod", "Secret", "gone")
+	require.Len(t, gone.Revisions, 2)
+	require.True(t, gone.Revisions[0].Deleted)
+	// The same name under another kind is a separate object.
+	require.Empty(t, recorder.History("c1", "prod", "ConfigMap", "creds").Revisions)
+}
@@ -0,0 +1,23 @@
+package backend
+
+import (
+	"github.com/luxury-yacht/app/backend/resources/configmap"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+)
+
+// GetConfigMapValue returns one byte range of a single ConfigMap key. The
+// details payload lists keys and sizes only, so the object panel loads values
+// through here on demand rather than shipping multi-megabyte data up front.
+func (a *App) GetConfigMapValue(clusterID, namespace, name, key string, rng restypes.DataValueRange) (*restypes.DataValue, error) {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "ConfigMap"); err != nil {
+		return nil, err
+	}
+	return configmap.NewService(deps).Value(namespace, name, key, rng)
+}
@@ -0,0 +1,104 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	podspkg "github.com/luxury-yacht/app/backend/resources/pods"
+)
+
+// StartConnectivityCheck starts a short-lived pod in req.Namespace and runs a
+// curl (HTTP) or nc (TCP) probe of req.Host:req.Port in it. The probe runs as
+// a shell session, so its output streams like any exec; the pod is deleted
+// when the session ends.
+func (a *App) StartConnectivityCheck(clusterID string, req ConnectivityCheckRequest) (*ShellSession, error) {
+	command, err := podspkg.ConnectivityCheckCommand(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "connectivity check"); err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      podspkg.Identity.Kind,
+		Namespace: req.Namespace,
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+
+	service := podspkg.NewService(deps)
+	pod, err := service.StartConnectivityCheckPod(req.Namespace, req.Image)
+	if err != nil {
+		return nil, err
+	}
+	deletePod := func() {
+		if err := service.DeleteConnectivityCheckPod(pod.Namespace, pod.Name); err != nil {
+			a.logger.Warn(err.Error(), logsources.App, clusterID, deps.ClusterName)
+		}
+	}
+	a.logger.Info(fmt.Sprintf("Started connectivity check pod %s/%s for %s:%d", pod.Namespace, pod.Name, req.Host, req.Port), logsources.App, clusterID, deps.ClusterName)
+
+	session, err := a.startShellSession(clusterID, ShellSessionRequest{
+		Namespace: pod.Namespace,
+		PodName:   pod.Name,
+		Command:   command,
+	}, deletePod)
+	if err != nil {
+		deletePod()
+		return nil, err
+	}
+	return session, nil
+}
+
+// LookupClusterDNS resolves req.Name from a short-lived pod in req.Namespace,
+// so the answer reflects that namespace's search domains and the cluster DNS,
+// and returns the parsed records alongside the pod's resolver settings. The
+// pod is deleted once the lookup returns.
+func (a *App) LookupClusterDNS(clusterID string, req DNSLookupRequest) (*podspkg.DNSLookup, error) {
+	command, err := podspkg.DNSLookupCommand(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "DNS lookup"); err != nil {
+		return nil, err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      podspkg.Identity.Kind,
+		Namespace: req.Namespace,
+		Verb:      "create",
+	}); err != nil {
+		return nil, err
+	}
+
+	service := podspkg.NewService(deps)
+	pod, err := service.StartConnectivityCheckPod(req.Namespace, req.Image)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := service.DeleteConnectivityCheckPod(pod.Namespace, pod.Name); err != nil {
+			a.logger.Warn(err.Error(), logsources.App, clusterID, deps.ClusterName)
+		}
+	}()
+
+	capture, err := a.capturePodExec(clusterID, "dns-lookup", pod.Namespace, pod.Name, "", command)
+	if err != nil {
+		return nil, err
+	}
+	lookup, err := podspkg.ParseDNSLookup(req.Name, capture.stdout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %q: %w", req.Name, err)
+	}
+	return &lookup, nil
+}
@@ -0,0 +1,275 @@
+// Package crashhistory records container terminations (exit code, OOMKilled,
+// timestamps) from the streamed pods so a workload's crash history can be
+// shown without external monitoring.
+package crashhistory
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+// Event is one container termination seen in the pod stream.
+type Event struct {
+	Namespace    string `json:"namespace"`
+	Pod          string `json:"pod"`
+	Container    string `json:"container"`
+	Init         bool   `json:"init,omitempty"`
+	RestartCount int32  `json:"restartCount"`
+	ExitCode     int32  `json:"exitCode"`
+	Signal       int32  `json:"signal,omitempty"`
+	// Reason is the kubelet's termination reason, e.g. OOMKilled, Error or Completed.
+	Reason     string    `json:"reason,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// Missed counts restarts between this event and the previous one seen for the
+	// container: the kubelet only reports the last termination, so restarts that
+	// happen faster than the stream delivers them are counted but not detailed.
+	Missed int32 `json:"missed,omitempty"`
+}
+
+// Timeline is the recorded terminations of one workload's (or one pod's) containers.
+type Timeline struct {
+	ClusterID string  `json:"clusterId"`
+	Namespace string  `json:"namespace"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Events    []Event `json:"events"`
+	// ObservedSince is when the recorder started watching the cluster's pods.
+	// Events before it come only from each container's last termination.
+	ObservedSince time.Time `json:"observedSince"`
+}
+
+// containerState is the last termination recorded for one container.
+type containerState struct {
+	restarts   int32
+	finishedAt time.Time
+}
+
+// Recorder keeps container terminations from ingest pod bundles, grouped by the
+// pod's owning workload, for this session. Events outlive their pods so a
+// workload's history spans rollouts. It is safe for concurrent use.
+type Recorder struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	retention time.Duration
+	limit     int
+	// events maps clusterID|ownerKey to the workload's events, oldest first.
+	events map[string][]Event
+	// containers maps clusterID|namespace/pod/container to its last recorded
+	// termination, so a pod update records only new terminations.
+	containers map[string]containerState
+	since      map[string]time.Time
+}
+
+// NewRecorder returns a recorder that keeps events for retention and at most
+// limit events per workload.
+func NewRecorder(retention time.Duration, limit int, now func() time.Time) *Recorder {
+	if now == nil {
+		now = time.Now
+	}
+	return &Recorder{
+		now:        now,
+		retention:  retention,
+		limit:      limit,
+		events:     make(map[string][]Event),
+		containers: make(map[string]containerState),
+		since:      make(map[string]time.Time),
+	}
+}
+
+// Sink returns the ingest bundle sink that feeds one cluster's pods into the
+// recorder.
+func (r *Recorder) Sink(clusterID string) ingest.BundleSink {
+	return recorderSink{recorder: r, clusterID: clusterID}
+}
+
+// ForgetCluster drops a removed cluster's history.
+func (r *Recorder) ForgetCluster(clusterID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prefix := clusterID + "|"
+	for key := range r.events {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.events, key)
+		}
+	}
+	for key := range r.containers {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.containers, key)
+		}
+	}
+	delete(r.since, clusterID)
+}
+
+// Timeline returns a workload's events, newest first. For kind Pod it returns the
+// events of that pod alone.
+func (r *Recorder) Timeline(clusterID, namespace, kind, name string) Timeline {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timeline := Timeline{
+		ClusterID:     clusterID,
+		Namespace:     namespace,
+		Kind:          kind,
+		Name:          name,
+		Events:        []Event{},
+		ObservedSince: r.since[clusterID],
+	}
+	cutoff := r.now().Add(-r.retention)
+	if kind == "Pod" {
+		prefix := clusterID + "|"
+		for key, events := range r.events {
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			for _, event := range events {
+				if event.Namespace == namespace && event.Pod == name && event.FinishedAt.After(cutoff) {
+					timeline.Events = append(timeline.Events, event)
+				}
+			}
+		}
+	} else {
+		for _, event := range r.events[clusterID+"|"+ownerKey(namespace, kind, name)] {
+			if event.FinishedAt.After(cutoff) {
+				timeline.Events = append(timeline.Events, event)
+			}
+		}
+	}
+	slices.SortFunc(timeline.Events, func(a, b Event) int { return b.FinishedAt.Compare(a.FinishedAt) })
+	return timeline
+}
+
+// ownerKey matches the pod aggregate's OwnerKey format (namespace/Kind/name).
+func ownerKey(namespace, kind, name string) string {
+	return namespace + "/" + kind + "/" + name
+}
+
+func (r *Recorder) observeLocked(clusterID string, agg streamrows.PodAggregate) {
+	if _, ok := r.since[clusterID]; !ok {
+		r.since[clusterID] = r.now()
+	}
+	if len(agg.LastTerminations) == 0 {
+		return
+	}
+	group := agg.OwnerKey
+	if group == "" {
+		group = ownerKey(agg.Namespace, "Pod", agg.Name)
+	}
+	group = clusterID + "|" + group
+	cutoff := r.now().Add(-r.retention)
+	for _, term := range agg.LastTerminations {
+		key := clusterID + "|" + agg.Namespace + "/" + agg.Name + "/" + term.Container
+		previous, seen := r.containers[key]
+		if seen && !term.FinishedAt.After(previous.finishedAt) {
+			continue
+		}
+		r.containers[key] = containerState{restarts: term.RestartCount, finishedAt: term.FinishedAt}
+		if term.FinishedAt.Before(cutoff) {
+			continue
+		}
+		event := Event{
+			Namespace:    agg.Namespace,
+			Pod:          agg.Name,
+			Container:    term.Container,
+			Init:         term.Init,
+			RestartCount: term.RestartCount,
+			ExitCode:     term.ExitCode,
+			Signal:       term.Signal,
+			Reason:       term.Reason,
+			StartedAt:    term.StartedAt,
+			FinishedAt:   term.FinishedAt,
+		}
+		if seen && term.RestartCount > previous.restarts+1 {
+			event.Missed = term.RestartCount - previous.restarts - 1
+		}
+		r.events[group] = append(r.events[group], event)
+		r.trimLocked(group, cutoff)
+	}
+}
+
+// trimLocked drops a workload's events past the retention window and the
+// per-workload limit, oldest first.
+func (r *Recorder) trimLocked(group string, cutoff time.Time) {
+	events := r.events[group]
+	slices.SortStableFunc(events, func(a, b Event) int { return a.FinishedAt.Compare(b.FinishedAt) })
+	start := 0
+	for start < len(events) && !events[start].FinishedAt.After(cutoff) {
+		start++
+	}
+	if r.limit > 0 && len(events)-start > r.limit {
+		start = len(events) - r.limit
+	}
+	r.events[group] = slices.Clone(events[start:])
+}
+
+// forgetPodLocked drops a deleted pod's container state; its events stay with
+// the workload.
+func (r *Recorder) forgetPodLocked(clusterID, namespace, name string) {
+	prefix := clusterID + "|" + namespace + "/" + name + "/"
+	for key := range r.containers {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.containers, key)
+		}
+	}
+}
+
+// recorderSink adapts the recorder to one cluster's pod ingest store.
+type recorderSink struct {
+	recorder  *Recorder
+	clusterID string
+}
+
+func (s recorderSink) UpsertBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.PodAggregate)
+	if !ok {
+		return
+	}
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.observeLocked(s.clusterID, agg)
+}
+
+func (s recorderSink) DeleteBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.PodAggregate)
+	if !ok {
+		return
+	}
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.forgetPodLocked(s.clusterID, agg.Namespace, agg.Name)
+}
+
+func (s recorderSink) ReplaceBundles(bundles []ingest.Bundle) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	present := make(map[string]struct{}, len(bundles))
+	for _, bundle := range bundles {
+		agg, ok := bundle.Aggregate.(streamrows.PodAggregate)
+		if !ok {
+			continue
+		}
+		present[agg.Namespace+"/"+agg.Name] = struct{}{}
+		s.recorder.observeLocked(s.clusterID, agg)
+	}
+	prefix := s.clusterID + "|"
+	for key := range s.recorder.containers {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+		// rest is namespace/pod/container; pod names cannot contain "/".
+		parts := strings.SplitN(rest, "/", 3)
+		if len(parts) == 3 {
+			if _, ok := present[parts[0]+"/"+parts[1]]; !ok {
+				delete(s.recorder.containers, key)
+			}
+		}
+	}
+}
+
+var _ ingest.BundleSink = recorderSink{}
+var _ ingest.BundleReplaceSink = recorderSink{}
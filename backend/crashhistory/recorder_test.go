@@ -0,0 +1,84 @@
+package crashhistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/crashhistory"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func podBundle(name, owner string, terms ...streamrows.ContainerTermination) ingest.Bundle {
+	return ingest.Bundle{Aggregate: streamrows.PodAggregate{
+		Namespace:        "prod",
+		Name:             name,
+		OwnerKey:         owner,
+		LastTerminations: terms,
+	}}
+}
+
+func oomKill(restarts int32, finished time.Time) streamrows.ContainerTermination {
+	return streamrows.ContainerTermination{
+		Container:    "app",
+		RestartCount: restarts,
+		ExitCode:     137,
+		Reason:       "OOMKilled",
+		StartedAt:    finished.Add(-time.Minute),
+		FinishedAt:   finished,
+	}
+}
+
+func TestRecorderBuildsWorkloadTimeline(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	recorder := crashhistory.NewRecorder(7*24*time.Hour, 100, func() time.Time { return now })
+	sink := recorder.Sink("c1")
+	owner := "prod/Deployment/api"
+
+	first := now.Add(-3 * 24 * time.Hour)
+	sink.UpsertBundle(podBundle("api-1", owner, oomKill(1, first)))
+	// A status update that repeats the same last termination records nothing new.
+	sink.UpsertBundle(podBundle("api-1", owner, oomKill(1, first)))
+	// Three restarts later: two of them were not seen in detail.
+	sink.UpsertBundle(podBundle("api-1", owner, oomKill(4, now.Add(-time.Hour))))
+	// The pod is replaced by a rollout; its history stays with the Deployment.
+	sink.DeleteBundle(podBundle("api-1", owner))
+	sink.UpsertBundle(podBundle("api-2", owner, streamrows.ContainerTermination{
+		Container: "app", RestartCount: 1, ExitCode: 1, Reason: "Error", FinishedAt: now.Add(-time.Minute),
+	}))
+	// Terminations older than the retention window are not kept.
+	sink.UpsertBundle(podBundle("api-3", owner, oomKill(1, now.Add(-8*24*time.Hour))))
+	sink.UpsertBundle(podBundle("other", "prod/Deployment/web", oomKill(1, now)))
+
+	timeline := recorder.Timeline("c1", "prod", "Deployment", "api")
+	require.Len(t, timeline.Events, 3)
+	require.Equal(t, "api-2", timeline.Events[0].Pod)
+	require.Equal(t, "Error", timeline.Events[0].Reason)
+	require.Equal(t, int32(4), timeline.Events[1].RestartCount)
+	require.Equal(t, int32(2), timeline.Events[1].Missed)
+	require.Equal(t, "OOMKilled", timeline.Events[2].Reason)
+	require.Equal(t, now, timeline.ObservedSince)
+
+	podTimeline := recorder.Timeline("c1", "prod", "Pod", "api-1")
+	require.Len(t, podTimeline.Events, 2)
+
+	recorder.ForgetCluster("c1")
+	require.Empty(t, recorder.Timeline("c1", "prod", "Deployment", "api").Events)
+}
+
+func TestRecorderKeepsUnownedPodsAndCapsEvents(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	recorder := crashhistory.NewRecorder(24*time.Hour, 2, func() time.Time { return now })
+	sink := recorder.Sink("c1").(ingest.BundleReplaceSink)
+
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("bare", "", oomKill(1, now.Add(-3*time.Minute)))})
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("bare", "", oomKill(2, now.Add(-2*time.Minute)))})
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("bare", "", oomKill(3, now.Add(-time.Minute)))})
+
+	timeline := recorder.Timeline("c1", "prod", "Pod", "bare")
+	require.Len(t, timeline.Events, 2)
+	require.Equal(t, int32(3), timeline.Events[0].RestartCount)
+	require.Equal(t, int32(2), timeline.Events[1].RestartCount)
+}
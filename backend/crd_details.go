@@ -25,3 +25,30 @@ func (a *App) GetCustomResourceDefinition(clusterID, name string) (*CustomResour
 		return apiextensions.NewService(deps).CustomResourceDefinition(name)
 	})
 }
+
+// GetCustomResourceDefinitionSchema renders the OpenAPI v3 schema of one CRD
+// version as a field tree; an empty version selects the storage version.
+func (a *App) GetCustomResourceDefinitionSchema(clusterID, name, version string) (*apiextensions.CRDSchema, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if deps.APIExtensionsClient == nil {
+		return nil, fmt.Errorf("apiextensions client not initialized")
+	}
+	return apiextensions.NewService(deps).CustomResourceDefinitionSchema(name, version)
+}
+
+// GetCustomResourceSkeleton returns a commented example manifest for one CRD
+// version, ready to edit and create. Namespaced kinds use namespace, or
+// "default" when it is empty.
+func (a *App) GetCustomResourceSkeleton(clusterID, name, version, namespace string) (string, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return "", err
+	}
+	if deps.APIExtensionsClient == nil {
+		return "", fmt.Errorf("apiextensions client not initialized")
+	}
+	return apiextensions.NewService(deps).CustomResourceSkeleton(name, version, namespace)
+}
@@ -0,0 +1,429 @@
+/*
+ * backend/custom_actions.go
+ *
+ * User-defined actions from actions.yaml next to settings.json. Each entry
+ * names a target kind and either a patch applied to the object or a local
+ * command, with {{placeholders}} replaced by the object's coordinates. They
+ * join the action registry as "custom:<id>" entries, so context menus and
+ * the command palette list and run them like the built-in actions.
+ */
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+)
+
+const (
+	customActionsFileName = "actions.yaml"
+	customActionIDPrefix  = "custom:"
+)
+
+// Patch types a custom action may use.
+const (
+	customActionPatchMerge     = "merge"
+	customActionPatchJSON      = "json"
+	customActionPatchStrategic = "strategic"
+)
+
+var (
+	customActionIDPattern          = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	customActionPlaceholderPattern = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+)
+
+// customActionPlaceholders are the values a command or patch may refer to.
+var customActionPlaceholders = []string{
+	"apiVersion", "cluster", "clusterId", "context", "group", "kind",
+	"kubeconfig", "name", "namespace", "version",
+}
+
+// ActionKindSelector picks the objects an action applies to. Kind matches
+// case-insensitively; an empty Group or Version matches any.
+type ActionKindSelector struct {
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version,omitempty"`
+	Kind    string `json:"kind"`
+}
+
+// CustomActionResult is the outcome of a custom action.
+type CustomActionResult struct {
+	// Output is the command's combined output, or a summary of the patch.
+	Output    string `json:"output"`
+	Truncated bool   `json:"truncated,omitempty"`
+	// KubectlCommand is the kubectl patch equivalent to a patch action.
+	KubectlCommand string `json:"kubectlCommand,omitempty"`
+}
+
+// CustomActionsFileStatus describes actions.yaml for the settings UI.
+type CustomActionsFileStatus struct {
+	Path    string   `json:"path"`
+	Exists  bool     `json:"exists"`
+	Actions int      `json:"actions"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+// customActionsFile is the layout of actions.yaml.
+type customActionsFile struct {
+	Actions []customActionDefinition `json:"actions"`
+}
+
+// customActionDefinition is one entry of actions.yaml. Exactly one of
+// Command and Patch is set.
+type customActionDefinition struct {
+	ID      string             `json:"id"`
+	Label   string             `json:"label"`
+	Target  ActionKindSelector `json:"target"`
+	Command []string           `json:"command,omitempty"`
+	// Patch is the patch body; YAML in the file, sent as JSON.
+	Patch     json.RawMessage `json:"patch,omitempty"`
+	PatchType string          `json:"patchType,omitempty"`
+	// Mutating marks a command that changes cluster state. Commands count as
+	// mutating unless it is false; patches always do.
+	Mutating *bool `json:"mutating,omitempty"`
+}
+
+// matches reports whether the action applies to target.
+func (s ActionKindSelector) matches(target ObjectActionTargetRef) bool {
+	return strings.EqualFold(s.Kind, target.Kind) &&
+		(s.Group == "" || s.Group == target.Group) &&
+		(s.Version == "" || s.Version == target.Version)
+}
+
+func (d customActionDefinition) mutating() bool {
+	return d.Patch != nil || d.Mutating == nil || *d.Mutating
+}
+
+func (d customActionDefinition) descriptor() ActionDescriptor {
+	target := d.Target
+	descriptor := ActionDescriptor{
+		ID:       customActionIDPrefix + d.ID,
+		Label:    d.Label,
+		Category: ActionCategoryCustom,
+		Mutating: d.mutating(),
+		Target:   &target,
+	}
+	if d.Patch != nil {
+		descriptor.Permissions = []ActionPermission{{Verb: "patch"}}
+		descriptor.PermissionSummary = "target object patch"
+	} else {
+		descriptor.PermissionSummary = fmt.Sprintf("runs %s locally with the cluster's kubeconfig", filepath.Base(d.Command[0]))
+	}
+	return descriptor
+}
+
+// validate checks one definition and fills in defaults.
+func (d *customActionDefinition) validate() error {
+	d.ID = strings.TrimSpace(d.ID)
+	d.Label = strings.TrimSpace(d.Label)
+	d.Target.Kind = strings.TrimSpace(d.Target.Kind)
+	if !customActionIDPattern.MatchString(d.ID) {
+		return fmt.Errorf("id %q must be lowercase letters, digits and dashes", d.ID)
+	}
+	if d.Label == "" {
+		return fmt.Errorf("%s: label is required", d.ID)
+	}
+	if d.Target.Kind == "" {
+		return fmt.Errorf("%s: target.kind is required", d.ID)
+	}
+	if (len(d.Command) == 0) == (d.Patch == nil) {
+		return fmt.Errorf("%s: set exactly one of command and patch", d.ID)
+	}
+	templates := d.Command
+	if d.Patch != nil {
+		if d.Mutating != nil && !*d.Mutating {
+			return fmt.Errorf("%s: a patch is always mutating", d.ID)
+		}
+		if d.PatchType == "" {
+			d.PatchType = customActionPatchMerge
+		}
+		if !slices.Contains([]string{customActionPatchMerge, customActionPatchJSON, customActionPatchStrategic}, d.PatchType) {
+			return fmt.Errorf("%s: unknown patchType %q", d.ID, d.PatchType)
+		}
+		templates = []string{string(d.Patch)}
+	} else if strings.TrimSpace(d.Command[0]) == "" {
+		return fmt.Errorf("%s: command is empty", d.ID)
+	}
+	for _, template := range templates {
+		for _, match := range customActionPlaceholderPattern.FindAllStringSubmatch(template, -1) {
+			if !slices.Contains(customActionPlaceholders, match[1]) {
+				return fmt.Errorf("%s: unknown placeholder %s", d.ID, match[0])
+			}
+		}
+	}
+	return nil
+}
+
+// customActionsPath returns the actions.yaml path next to settings.json.
+func (a *App) customActionsPath() (string, error) {
+	settingsPath, err := a.getSettingsFilePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(settingsPath), customActionsFileName), nil
+}
+
+// loadCustomActions reads actions.yaml. Invalid entries are left out and
+// reported in problems so one typo does not hide every action. A missing
+// file means no custom actions.
+func (a *App) loadCustomActions() (actions []customActionDefinition, problems []string, err error) {
+	path, err := a.customActionsPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", customActionsFileName, err)
+	}
+	var file customActionsFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, nil, fmt.Errorf("parse %s: %w", customActionsFileName, err)
+	}
+	seen := make(map[string]struct{}, len(file.Actions))
+	for i := range file.Actions {
+		definition := file.Actions[i]
+		if err := definition.validate(); err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if _, duplicate := seen[definition.ID]; duplicate {
+			problems = append(problems, fmt.Sprintf("%s: duplicate id", definition.ID))
+			continue
+		}
+		seen[definition.ID] = struct{}{}
+		actions = append(actions, definition)
+	}
+	return actions, problems, nil
+}
+
+// customActionDescriptors returns the registry entries for actions.yaml.
+// Problems with the file are reported by GetCustomActionsFile.
+func (a *App) customActionDescriptors() []ActionDescriptor {
+	actions, _, _ := a.loadCustomActions()
+	descriptors := make([]ActionDescriptor, 0, len(actions))
+	for _, definition := range actions {
+		descriptors = append(descriptors, definition.descriptor())
+	}
+	return descriptors
+}
+
+// ListCustomActions returns the custom actions that apply to target, for
+// the object's context menu.
+func (a *App) ListCustomActions(target ObjectActionTargetRef) []ActionDescriptor {
+	matching := []ActionDescriptor{}
+	for _, descriptor := range a.customActionDescriptors() {
+		if descriptor.Target.matches(target) {
+			matching = append(matching, descriptor)
+		}
+	}
+	return matching
+}
+
+// GetCustomActionsFile reports where actions.yaml lives and whether it loads.
+func (a *App) GetCustomActionsFile() (CustomActionsFileStatus, error) {
+	path, err := a.customActionsPath()
+	if err != nil {
+		return CustomActionsFileStatus{}, err
+	}
+	status := CustomActionsFileStatus{Path: path}
+	if _, err := os.Stat(path); err == nil {
+		status.Exists = true
+	}
+	actions, problems, err := a.loadCustomActions()
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	status.Actions = len(actions)
+	status.Errors = problems
+	return status, nil
+}
+
+// findCustomAction looks up a "custom:<id>" registry ID in actions.yaml.
+func (a *App) findCustomAction(id string) (customActionDefinition, bool) {
+	name, ok := strings.CutPrefix(id, customActionIDPrefix)
+	if !ok {
+		return customActionDefinition{}, false
+	}
+	actions, _, err := a.loadCustomActions()
+	if err != nil {
+		return customActionDefinition{}, false
+	}
+	index := slices.IndexFunc(actions, func(definition customActionDefinition) bool { return definition.ID == name })
+	if index < 0 {
+		return customActionDefinition{}, false
+	}
+	return actions[index], true
+}
+
+// runCustomAction runs definition against target with the same read-only
+// and permission checks as the built-in actions.
+func (a *App) runCustomAction(definition customActionDefinition, target ObjectActionTargetRef) (CustomActionResult, error) {
+	if !definition.Target.matches(target) {
+		return CustomActionResult{}, fmt.Errorf("action %q does not apply to %s %s", definition.ID, target.Kind, target.Name)
+	}
+	if definition.mutating() {
+		if err := a.requireClusterWritable(target.ClusterID, definition.Label); err != nil {
+			return CustomActionResult{}, err
+		}
+	}
+	clients := a.clusterClientsForID(target.ClusterID)
+	if clients == nil {
+		return CustomActionResult{}, fmt.Errorf("cluster %s is not connected", target.ClusterID)
+	}
+	values := map[string]string{
+		"apiVersion": objectActionTargetGVK(target).GroupVersion().String(),
+		"cluster":    clients.meta.Name,
+		"clusterId":  target.ClusterID,
+		"context":    clients.kubeconfigContext,
+		"group":      target.Group,
+		"kind":       target.Kind,
+		"kubeconfig": clients.kubeconfigPath,
+		"name":       target.Name,
+		"namespace":  target.Namespace,
+		"version":    target.Version,
+	}
+
+	a.logger.Info(fmt.Sprintf("Running custom action %q on %s %s", definition.Label, target.Kind, target.Name), logsources.App, target.ClusterID, clients.meta.Name)
+	if definition.Patch != nil {
+		return a.runCustomActionPatch(definition, target, values)
+	}
+	return runCustomActionCommand(definition, values)
+}
+
+func (a *App) runCustomActionPatch(definition customActionDefinition, target ObjectActionTargetRef, values map[string]string) (CustomActionResult, error) {
+	body, err := expandCustomActionPatch(definition.Patch, values)
+	if err != nil {
+		return CustomActionResult{}, fmt.Errorf("action %q patch: %w", definition.ID, err)
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(target.ClusterID)
+	if err != nil {
+		return CustomActionResult{}, err
+	}
+	if deps.DynamicClient == nil {
+		return CustomActionResult{}, fmt.Errorf("dynamic client not initialized")
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Group:     target.Group,
+		Version:   target.Version,
+		Kind:      target.Kind,
+		Namespace: target.Namespace,
+		Name:      target.Name,
+		Verb:      "patch",
+	}); err != nil {
+		return CustomActionResult{}, err
+	}
+	gvk := objectActionTargetGVK(target)
+	gvr, namespaced, err := resolveObjectYAMLGVR(deps.Context, deps, gvk, objectYAMLResolverStrict)
+	if err != nil {
+		return CustomActionResult{}, err
+	}
+	var resource dynamic.ResourceInterface = deps.DynamicClient.Resource(gvr)
+	if namespaced {
+		resource = deps.DynamicClient.Resource(gvr).Namespace(target.Namespace)
+	}
+	patchType := map[string]types.PatchType{
+		customActionPatchMerge:     types.MergePatchType,
+		customActionPatchJSON:      types.JSONPatchType,
+		customActionPatchStrategic: types.StrategicMergePatchType,
+	}[definition.PatchType]
+	patched, err := resource.Patch(deps.Context, target.Name, patchType, body, metav1.PatchOptions{})
+	if err != nil {
+		return CustomActionResult{}, err
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, gvk, target.Namespace, target.Name)
+	return CustomActionResult{
+		Output:         fmt.Sprintf("Patched %s %s (resourceVersion %s)", target.Kind, target.Name, patched.GetResourceVersion()),
+		KubectlCommand: a.kubectlPatchCommand(target.ClusterID, target.Group, target.Kind, target.Namespace, target.Name, patchType, body),
+	}, nil
+}
+
+// expandCustomActionPatch replaces placeholders inside the patch's string
+// values, so object names never change the patch's JSON structure.
+func expandCustomActionPatch(patch json.RawMessage, values map[string]string) ([]byte, error) {
+	var body interface{}
+	if err := json.Unmarshal(patch, &body); err != nil {
+		return nil, err
+	}
+	var expand func(interface{}) interface{}
+	expand = func(value interface{}) interface{} {
+		switch typed := value.(type) {
+		case string:
+			return expandCustomActionTemplate(typed, values)
+		case []interface{}:
+			for i := range typed {
+				typed[i] = expand(typed[i])
+			}
+		case map[string]interface{}:
+			expanded := make(map[string]interface{}, len(typed))
+			for key, item := range typed {
+				expanded[expandCustomActionTemplate(key, values)] = expand(item)
+			}
+			return expanded
+		}
+		return value
+	}
+	return json.Marshal(expand(body))
+}
+
+func expandCustomActionTemplate(template string, values map[string]string) string {
+	return customActionPlaceholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		return values[customActionPlaceholderPattern.FindStringSubmatch(match)[1]]
+	})
+}
+
+// runCustomActionCommand runs the command directly, without a shell, so the
+// substituted values stay single arguments. KUBECONFIG points at the
+// cluster's kubeconfig file.
+func runCustomActionCommand(definition customActionDefinition, values map[string]string) (CustomActionResult, error) {
+	args := make([]string, len(definition.Command))
+	for i, arg := range definition.Command {
+		args[i] = expandCustomActionTemplate(arg, values)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.CustomActionCommandTimeout)
+	defer cancel()
+	cmd := execCommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+values["kubeconfig"])
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	runErr := cmd.Run()
+
+	result := CustomActionResult{Output: output.String()}
+	if len(result.Output) > config.CustomActionOutputLimit {
+		result.Output = result.Output[len(result.Output)-config.CustomActionOutputLimit:]
+		result.Truncated = true
+	}
+	if runErr != nil {
+		if ctx.Err() != nil {
+			return result, fmt.Errorf("action %q timed out after %s", definition.ID, config.CustomActionCommandTimeout)
+		}
+		detail := strings.TrimSpace(result.Output)
+		if index := strings.LastIndexByte(detail, '\n'); index >= 0 {
+			detail = detail[index+1:]
+		}
+		if detail == "" {
+			detail = runErr.Error()
+		}
+		return result, fmt.Errorf("action %q failed: %s", definition.ID, detail)
+	}
+	return result, nil
+}
@@ -0,0 +1,141 @@
+package backend
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func writeCustomActions(t *testing.T, app *App, content string) {
+	t.Helper()
+	path, err := app.customActionsPath()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+const testCustomActions = `
+actions:
+  - id: flag-db
+    label: Flag database
+    target: {group: rds.services.k8s.aws, kind: DBInstance}
+    patch:
+      metadata:
+        annotations:
+          example.com/flagged-by: "{{cluster}}/{{name}}"
+  - id: describe
+    label: Describe with kubectl
+    target: {kind: Pod}
+    command: [kubectl, describe, pod, "{{name}}", -n, "{{namespace}}", --context, "{{context}}"]
+    mutating: false
+  - id: Bad_ID
+    label: Bad
+    target: {kind: Pod}
+    command: [true]
+  - id: both
+    label: Both
+    target: {kind: Pod}
+    command: [true]
+    patch: {}
+  - id: typo
+    label: Typo
+    target: {kind: Pod}
+    command: [echo, "{{nmae}}"]
+  - id: describe
+    label: Again
+    target: {kind: Pod}
+    command: [true]
+`
+
+func TestCustomActionsLoadValidEntriesAndReportProblems(t *testing.T) {
+	setTestConfigEnv(t)
+	app := newTestAppWithDefaults(t)
+
+	status, err := app.GetCustomActionsFile()
+	require.NoError(t, err)
+	require.False(t, status.Exists)
+	require.Zero(t, status.Actions)
+
+	writeCustomActions(t, app, testCustomActions)
+	status, err = app.GetCustomActionsFile()
+	require.NoError(t, err)
+	require.True(t, status.Exists)
+	require.Equal(t, 2, status.Actions)
+	require.Len(t, status.Errors, 4)
+
+	pod := objectActionTarget(workloadClusterID, "", "v1", "Pod", "default", "web")
+	actions := app.ListCustomActions(pod)
+	require.Len(t, actions, 1)
+	require.Equal(t, "custom:describe", actions[0].ID)
+	require.Equal(t, ActionCategoryCustom, actions[0].Category)
+	require.False(t, actions[0].Mutating)
+
+	db := objectActionTarget(workloadClusterID, "kinda.rocks", "v1beta1", "DbInstance", "default", "my-db")
+	require.Empty(t, app.ListCustomActions(db), "the group must match")
+
+	all := app.ListActions()
+	require.Equal(t, "custom:describe", all[len(all)-1].ID)
+
+	writeCustomActions(t, app, "actions: [")
+	status, err = app.GetCustomActionsFile()
+	require.NoError(t, err)
+	require.Len(t, status.Errors, 1)
+	require.Empty(t, app.ListCustomActions(pod))
+}
+
+func TestExpandCustomActionPatchKeepsJSONStructure(t *testing.T) {
+	body, err := expandCustomActionPatch([]byte(`{"metadata":{"annotations":{"by":"{{name}}"}}}`), map[string]string{"name": `a"},"x":{"`})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"metadata":{"annotations":{"by":"a\"},\"x\":{\""}}}`, string(body))
+}
+
+func TestInvokeCustomPatchAction(t *testing.T) {
+	const clusterID = "custom-actions"
+	app := newCollidingDBInstanceCluster(t, clusterID)
+	writeCustomActions(t, app, testCustomActions)
+	target := objectActionTarget(clusterID, "rds.services.k8s.aws", "v1alpha1", "DBInstance", "default", "my-db")
+
+	response, err := app.InvokeAction(ActionInvokeRequest{ID: "custom:flag-db", Target: target})
+	require.NoError(t, err)
+	require.Contains(t, response.Custom.Output, "Patched DBInstance my-db")
+
+	dynamicClient := app.clusterClients[clusterID].dynamicClient.(*dynamicfake.FakeDynamicClient)
+	gvr := schema.GroupVersionResource{Group: "rds.services.k8s.aws", Version: "v1alpha1", Resource: "dbinstances"}
+	obj, err := dynamicClient.Resource(gvr).Namespace("default").Get(context.Background(), "my-db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "ctx/my-db", obj.GetAnnotations()["example.com/flagged-by"])
+
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "custom:describe", Target: target})
+	require.ErrorContains(t, err, "does not apply")
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "custom:missing", Target: target})
+	require.ErrorContains(t, err, "unknown action")
+
+	require.NoError(t, app.SetClusterReadOnly(clusterID, true))
+	_, err = app.InvokeAction(ActionInvokeRequest{ID: "custom:flag-db", Target: target})
+	require.ErrorIs(t, err, errClusterReadOnly)
+}
+
+func TestRunCustomActionCommandPassesArgumentsAndKubeconfig(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses /bin/sh")
+	}
+	values := map[string]string{"name": "web; rm -rf /", "kubeconfig": "/tmp/kubeconfig"}
+
+	result, err := runCustomActionCommand(customActionDefinition{
+		ID:      "echo",
+		Command: []string{"/bin/sh", "-c", `printf '%s %s' "$0" "$KUBECONFIG"`, "{{name}}"},
+	}, values)
+	require.NoError(t, err)
+	require.Equal(t, "web; rm -rf / /tmp/kubeconfig", result.Output)
+
+	_, err = runCustomActionCommand(customActionDefinition{
+		ID:      "fail",
+		Command: []string{"/bin/sh", "-c", "echo first; echo broken >&2; exit 3"},
+	}, values)
+	require.ErrorContains(t, err, `action "fail" failed: broken`)
+}
@@ -0,0 +1,227 @@
+/*
+ * backend/deeplink.go
+ *
+ * Handles luxury-yacht:// links so runbooks and alert messages can open a
+ * cluster, namespace or object in the app.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// DeepLinkScheme is the URL scheme the app registers with the OS.
+const DeepLinkScheme = "luxury-yacht"
+
+// DeepLink is a parsed luxury-yacht:// link. The supported forms are
+//
+//	luxury-yacht://cluster/<context>
+//	luxury-yacht://cluster/<context>/namespace/<namespace>
+//	luxury-yacht://cluster/<context>/namespace/<namespace>/<kind>/<name>
+//	luxury-yacht://cluster/<context>/<kind>/<name>
+//
+// where the last form is for cluster-scoped objects. Path segments are
+// URL-escaped, so contexts containing "/" stay one segment.
+type DeepLink struct {
+	Context   string `json:"context"`
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// DeepLinkTarget is a deep link resolved against the local kubeconfigs.
+// Selection is the kubeconfig selection to open when the cluster is not
+// connected yet; Ref is set when the object is already in the catalog.
+type DeepLinkTarget struct {
+	URL       string                     `json:"url"`
+	Link      DeepLink                   `json:"link"`
+	ClusterID string                     `json:"clusterId"`
+	Selection string                     `json:"selection"`
+	Connected bool                       `json:"connected"`
+	Ref       *resourcemodel.ResourceRef `json:"ref,omitempty"`
+}
+
+// ParseDeepLink parses a luxury-yacht:// URL.
+func ParseDeepLink(raw string) (DeepLink, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return DeepLink{}, fmt.Errorf("invalid link: %w", err)
+	}
+	if !strings.EqualFold(parsed.Scheme, DeepLinkScheme) {
+		return DeepLink{}, fmt.Errorf("link scheme must be %s://", DeepLinkScheme)
+	}
+	if parsed.Host != "cluster" {
+		return DeepLink{}, fmt.Errorf("link must start with %s://cluster/", DeepLinkScheme)
+	}
+	path := strings.Trim(parsed.EscapedPath(), "/")
+	if path == "" {
+		return DeepLink{}, fmt.Errorf("link is missing the cluster context")
+	}
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			return DeepLink{}, fmt.Errorf("invalid link path: %w", err)
+		}
+		if value == "" {
+			return DeepLink{}, fmt.Errorf("link path has an empty segment")
+		}
+		segments = append(segments, value)
+	}
+
+	link := DeepLink{Context: segments[0]}
+	rest := segments[1:]
+	if len(rest) >= 2 && rest[0] == "namespace" {
+		link.Namespace = rest[1]
+		rest = rest[2:]
+	}
+	switch len(rest) {
+	case 0:
+	case 2:
+		link.Kind, link.Name = rest[0], rest[1]
+	default:
+		return DeepLink{}, fmt.Errorf("link path must end in /namespace/<namespace>, /<kind>/<name> or both")
+	}
+	return link, nil
+}
+
+// ResolveDeepLink resolves a luxury-yacht:// link without opening it, for
+// links pasted into the app.
+func (a *App) ResolveDeepLink(raw string) (*DeepLinkTarget, error) {
+	link, err := ParseDeepLink(raw)
+	if err != nil {
+		return nil, err
+	}
+	target, err := a.resolveDeepLink(link)
+	if err != nil {
+		return nil, err
+	}
+	target.URL = strings.TrimSpace(raw)
+	return target, nil
+}
+
+// TakePendingDeepLink resolves and clears the link waiting to be opened, or
+// returns nil when there is none. The frontend calls it on startup and on each
+// deeplink:open event, so a link that launched the app is resolved once the
+// kubeconfigs are loaded and none is lost before the frontend listens.
+func (a *App) TakePendingDeepLink() (*DeepLinkTarget, error) {
+	a.deepLinkMu.Lock()
+	raw := a.pendingDeepLink
+	a.pendingDeepLink = ""
+	a.deepLinkMu.Unlock()
+	if raw == "" {
+		return nil, nil
+	}
+	return a.ResolveDeepLink(raw)
+}
+
+// queueDeepLink holds a link for the frontend, brings the window forward and
+// announces it with deeplink:open. A newer link replaces an unopened one.
+func (a *App) queueDeepLink(raw string) error {
+	if _, err := ParseDeepLink(raw); err != nil {
+		return err
+	}
+	a.deepLinkMu.Lock()
+	a.pendingDeepLink = strings.TrimSpace(raw)
+	a.deepLinkMu.Unlock()
+
+	if a.Ctx != nil {
+		runtimeWindowShow(a.Ctx)
+	}
+	a.emitEvent("deeplink:open")
+	return nil
+}
+
+// resolveDeepLink maps the link's context onto a kubeconfig, preferring one
+// that is already open when several kubeconfigs share the context name.
+func (a *App) resolveDeepLink(link DeepLink) (*DeepLinkTarget, error) {
+	selected := make(map[string]bool)
+	for _, raw := range a.GetSelectedKubeconfigs() {
+		selected[raw] = true
+	}
+	var selection kubeconfigSelection
+	a.kubeconfigsMu.RLock()
+	for _, kc := range a.availableKubeconfigs {
+		if kc.Context != link.Context {
+			continue
+		}
+		candidate := kubeconfigSelection{Path: kc.Path, Context: kc.Context}
+		if selection.Path == "" || selected[candidate.String()] {
+			selection = candidate
+		}
+		if selected[candidate.String()] {
+			break
+		}
+	}
+	a.kubeconfigsMu.RUnlock()
+	if selection.Path == "" {
+		return nil, fmt.Errorf("kubeconfig context %q not found", link.Context)
+	}
+
+	meta := a.clusterMetaForSelection(selection)
+	target := &DeepLinkTarget{
+		Link:      link,
+		ClusterID: meta.ID,
+		Selection: selection.String(),
+		Connected: a.clusterClientsForID(meta.ID) != nil,
+	}
+	if target.Connected && link.Kind != "" {
+		target.Ref = a.findDeepLinkObject(meta.ID, link)
+	}
+	return target, nil
+}
+
+// findDeepLinkObject looks the linked object up in the cluster's catalog. The
+// kind may be given as the kind or the resource name, in any case.
+func (a *App) findDeepLinkObject(clusterID string, link DeepLink) *resourcemodel.ResourceRef {
+	svc := a.objectCatalogServiceForCluster(clusterID)
+	if svc == nil {
+		return nil
+	}
+	for _, match := range svc.Search(fmt.Sprintf("kind:%s %s", link.Kind, link.Name), 0) {
+		ref := match.Summary.Ref
+		if ref.Name == link.Name && ref.Namespace == link.Namespace {
+			return &ref
+		}
+	}
+	return nil
+}
+
+// OpenLaunchDeepLinks opens the deep links among the command-line arguments
+// the app was started with. Linux and Windows pass a clicked link this way.
+func OpenLaunchDeepLinks(app *App, args []string) {
+	for _, arg := range args {
+		if !strings.HasPrefix(strings.ToLower(arg), DeepLinkScheme+"://") {
+			continue
+		}
+		if err := app.queueDeepLink(arg); err != nil {
+			app.logger.Warn(fmt.Sprintf("Could not open link %s: %v", arg, err), logsources.App)
+		}
+	}
+}
+
+// NewSecondInstanceHandler forwards a second launch to the running instance:
+// links it was started with are opened here and the window comes forward.
+func NewSecondInstanceHandler(app *App) func(options.SecondInstanceData) {
+	return func(data options.SecondInstanceData) {
+		if app.Ctx != nil {
+			runtimeWindowShow(app.Ctx)
+		}
+		OpenLaunchDeepLinks(app, data.Args)
+	}
+}
+
+// NewURLOpenHandler opens links macOS delivers through the app delegate.
+func NewURLOpenHandler(app *App) func(string) {
+	return func(raw string) {
+		OpenLaunchDeepLinks(app, []string{raw})
+	}
+}
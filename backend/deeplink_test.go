@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestParseDeepLink(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want DeepLink
+		err  string
+	}{
+		{raw: "luxury-yacht://cluster/prod", want: DeepLink{Context: "prod"}},
+		{raw: "luxury-yacht://cluster/prod/namespace/payments/", want: DeepLink{Context: "prod", Namespace: "payments"}},
+		{raw: "luxury-yacht://cluster/prod/namespace/payments/Deployment/api", want: DeepLink{Context: "prod", Namespace: "payments", Kind: "Deployment", Name: "api"}},
+		{raw: "luxury-yacht://cluster/prod/Node/worker-1", want: DeepLink{Context: "prod", Kind: "Node", Name: "worker-1"}},
+		{
+			raw:  "luxury-yacht://cluster/arn:aws:eks:us-east-1:123:cluster%2Fprod/namespace/default",
+			want: DeepLink{Context: "arn:aws:eks:us-east-1:123:cluster/prod", Namespace: "default"},
+		},
+		{raw: "https://cluster/prod", err: "scheme"},
+		{raw: "luxury-yacht://namespace/prod", err: "cluster/"},
+		{raw: "luxury-yacht://cluster/", err: "missing the cluster context"},
+		{raw: "luxury-yacht://cluster/prod/namespace", err: "must end in"},
+		{raw: "luxury-yacht://cluster/prod//pods", err: "empty segment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			link, err := ParseDeepLink(tt.raw)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, link)
+		})
+	}
+}
+
+func TestDeepLinkQueuesUntilTaken(t *testing.T) {
+	app := newTestAppWithDefaults(t)
+	app.Ctx = context.Background()
+	var events []string
+	app.eventEmitter = func(_ context.Context, name string, _ ...interface{}) { events = append(events, name) }
+	originalShow := runtimeWindowShow
+	shown := 0
+	runtimeWindowShow = func(context.Context) { shown++ }
+	t.Cleanup(func() { runtimeWindowShow = originalShow })
+
+	app.availableKubeconfigs = []KubeconfigInfo{
+		{Name: "other", Path: "/p/other", Context: "prod"},
+		{Name: "config", Path: "/p/config", Context: "prod"},
+	}
+	app.selectedKubeconfigs = []string{"/p/config:prod"}
+	app.clusterClients = map[string]*clusterClients{"config:prod": {meta: ClusterMeta{ID: "config:prod", Name: "prod"}}}
+	svc := objectcatalog.NewService(objectcatalog.Dependencies{}, nil)
+	ref := resourcemodel.ResourceRef{ClusterID: "config:prod", Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "payments", Name: "api"}
+	setCatalogServiceItems(t, svc, map[string]objectcatalog.Summary{"api": {Ref: ref, Scope: objectcatalog.ScopeNamespace}})
+	app.storeObjectCatalogEntry("config:prod", &objectCatalogEntry{service: svc})
+
+	require.Error(t, app.queueDeepLink("luxury-yacht://elsewhere"))
+	OpenLaunchDeepLinks(app, []string{"--verbose", "luxury-yacht://cluster/prod/namespace/payments/deployments/api"})
+	require.Equal(t, []string{"deeplink:open"}, events)
+	require.Equal(t, 1, shown)
+
+	target, err := app.TakePendingDeepLink()
+	require.NoError(t, err)
+	require.Equal(t, "config:prod", target.ClusterID, "the open kubeconfig wins over another with the same context")
+	require.Equal(t, "/p/config:prod", target.Selection)
+	require.True(t, target.Connected)
+	require.Equal(t, ref, *target.Ref)
+
+	target, err = app.TakePendingDeepLink()
+	require.NoError(t, err)
+	require.Nil(t, target, "a link is taken once")
+
+	_, err = app.ResolveDeepLink("luxury-yacht://cluster/missing")
+	require.ErrorContains(t, err, `context "missing" not found`)
+}
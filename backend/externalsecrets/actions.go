@@ -0,0 +1,31 @@
+package externalsecrets
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ForceSyncAnnotation makes the operator refresh an ExternalSecret outside its
+// refreshInterval whenever the value changes; it is what the ESO docs use for
+// `kubectl annotate es <name> force-sync=$(date +%s) --overwrite`.
+const ForceSyncAnnotation = "force-sync"
+
+// ExternalSecretGVR returns the ExternalSecret resource at one API version.
+func ExternalSecretGVR(version string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: Group, Version: version, Resource: ResourceExternalSecrets}
+}
+
+// ForceSyncPatch returns the merge patch that requests an immediate refresh.
+func ForceSyncPatch(now time.Time) []byte {
+	data, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				ForceSyncAnnotation: strconv.FormatInt(now.Unix(), 10),
+			},
+		},
+	})
+	return data
+}
@@ -0,0 +1,310 @@
+/*
+ * backend/externalsecrets/collector.go
+ *
+ * Collects External Secrets Operator objects (external-secrets.io):
+ * ExternalSecrets with their sync status and the Secret each one writes, plus
+ * the SecretStores and ClusterSecretStores they read from. The operator is
+ * optional; when none of its CRDs are served the snapshot reports it as
+ * undetected rather than failing. Both the v1 API and the older v1beta1 API
+ * are read, preferring v1.
+ */
+
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Group is the External Secrets Operator API group.
+const Group = "external-secrets.io"
+
+// Kinds collected here.
+const (
+	KindExternalSecret     = "ExternalSecret"
+	KindSecretStore        = "SecretStore"
+	KindClusterSecretStore = "ClusterSecretStore"
+)
+
+// Versions lists the served API versions read, preferred first.
+var Versions = []string{"v1", "v1beta1"}
+
+// Resource names of the collected kinds.
+const (
+	ResourceExternalSecrets     = "externalsecrets"
+	ResourceSecretStores        = "secretstores"
+	ResourceClusterSecretStores = "clustersecretstores"
+)
+
+// ConditionReady is the condition the operator sets on every kind.
+const ConditionReady = "Ready"
+
+// Sources records which External Secrets kinds are served, and at which
+// version.
+type Sources struct {
+	Detected            bool   `json:"detected"`
+	APIVersion          string `json:"apiVersion,omitempty"`
+	ExternalSecrets     bool   `json:"externalSecrets"`
+	SecretStores        bool   `json:"secretStores"`
+	ClusterSecretStores bool   `json:"clusterSecretStores"`
+}
+
+// Status is the Ready condition shared by every kind.
+type Status struct {
+	// Ready is the Ready condition's status: "True", "False" or "Unknown".
+	Ready              string `json:"ready"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// LastError is the Ready message while the condition is False.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// ExternalSecret is one external-secrets.io ExternalSecret.
+type ExternalSecret struct {
+	Ref resourcemodel.ResourceRef `json:"ref"`
+	Status
+	// Store is the referenced store as "Kind/name".
+	Store string `json:"store,omitempty"`
+	// RefreshInterval is spec.refreshInterval as written ("1h", "0" disables
+	// periodic refresh).
+	RefreshInterval string `json:"refreshInterval,omitempty"`
+	// LastRefreshTime is when the operator last synced the Secret.
+	LastRefreshTime       string `json:"lastRefreshTime,omitempty"`
+	SyncedResourceVersion string `json:"syncedResourceVersion,omitempty"`
+	CreationPolicy        string `json:"creationPolicy,omitempty"`
+	// TargetSecret is the Secret the ExternalSecret writes.
+	TargetSecret resourcemodel.ResourceRef `json:"targetSecret"`
+	// LastForceSync is the force-sync annotation value of the last
+	// "refresh now" request.
+	LastForceSync string `json:"lastForceSync,omitempty"`
+}
+
+// SecretStore is one SecretStore or ClusterSecretStore.
+type SecretStore struct {
+	Ref resourcemodel.ResourceRef `json:"ref"`
+	Status
+	// Provider is the configured backend ("aws", "vault", ...).
+	Provider string `json:"provider,omitempty"`
+	// RefreshInterval is how often, in seconds, the store re-validates its
+	// provider; zero means it does not.
+	RefreshInterval int64  `json:"refreshInterval,omitempty"`
+	Capabilities    string `json:"capabilities,omitempty"`
+}
+
+// Snapshot is the cluster-external-secrets domain payload.
+type Snapshot struct {
+	ClusterID       string           `json:"clusterId"`
+	ClusterName     string           `json:"clusterName"`
+	Sources         Sources          `json:"sources"`
+	ExternalSecrets []ExternalSecret `json:"externalSecrets"`
+	// SecretStores holds both SecretStores and ClusterSecretStores.
+	SecretStores []SecretStore `json:"secretStores"`
+	// NotReady counts entries of every kind whose Ready condition is not True.
+	NotReady int `json:"notReady"`
+	// Warnings lists kinds that are served but could not be read.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Collector reads External Secrets objects through the dynamic client.
+type Collector struct {
+	ClusterID string
+	Dynamic   dynamic.Interface
+}
+
+// Collect gathers External Secrets state, limited to namespace when it is
+// non-empty; ClusterSecretStores are only included cluster-wide. The returned
+// version is the highest resourceVersion among the objects read.
+func (c Collector) Collect(ctx context.Context, namespace string) (*Snapshot, uint64, error) {
+	if c.Dynamic == nil {
+		return nil, 0, fmt.Errorf("dynamic client is not initialized")
+	}
+	snapshot := &Snapshot{
+		ClusterID:       c.ClusterID,
+		ExternalSecrets: []ExternalSecret{},
+		SecretStores:    []SecretStore{},
+	}
+	var version uint64
+	track := func(obj *unstructured.Unstructured) {
+		if parsed, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64); err == nil && parsed > version {
+			version = parsed
+		}
+	}
+	count := func(status Status) {
+		if status.Ready != string(metav1.ConditionTrue) {
+			snapshot.NotReady++
+		}
+	}
+	served := func(gvr schema.GroupVersionResource, found bool) bool {
+		if found && snapshot.Sources.APIVersion == "" {
+			snapshot.Sources.APIVersion = gvr.GroupVersion().String()
+		}
+		return found
+	}
+
+	externalSecrets, gvr, found := c.list(ctx, ResourceExternalSecrets, namespace, snapshot)
+	snapshot.Sources.ExternalSecrets = served(gvr, found)
+	for i := range externalSecrets {
+		track(&externalSecrets[i])
+		entry := c.externalSecret(&externalSecrets[i], gvr)
+		count(entry.Status)
+		snapshot.ExternalSecrets = append(snapshot.ExternalSecrets, entry)
+	}
+
+	stores, gvr, found := c.list(ctx, ResourceSecretStores, namespace, snapshot)
+	snapshot.Sources.SecretStores = served(gvr, found)
+	for i := range stores {
+		track(&stores[i])
+		entry := c.secretStore(&stores[i], KindSecretStore, gvr)
+		count(entry.Status)
+		snapshot.SecretStores = append(snapshot.SecretStores, entry)
+	}
+	if namespace == "" {
+		clusterStores, gvr, found := c.list(ctx, ResourceClusterSecretStores, "", snapshot)
+		snapshot.Sources.ClusterSecretStores = served(gvr, found)
+		for i := range clusterStores {
+			track(&clusterStores[i])
+			entry := c.secretStore(&clusterStores[i], KindClusterSecretStore, gvr)
+			count(entry.Status)
+			snapshot.SecretStores = append(snapshot.SecretStores, entry)
+		}
+	}
+	snapshot.Sources.Detected = snapshot.Sources.ExternalSecrets ||
+		snapshot.Sources.SecretStores ||
+		snapshot.Sources.ClusterSecretStores
+
+	sort.SliceStable(snapshot.ExternalSecrets, func(i, j int) bool {
+		return lessRef(snapshot.ExternalSecrets[i].Ref, snapshot.ExternalSecrets[j].Ref)
+	})
+	sort.SliceStable(snapshot.SecretStores, func(i, j int) bool {
+		left, right := snapshot.SecretStores[i].Ref, snapshot.SecretStores[j].Ref
+		if left.Kind != right.Kind {
+			// Namespaced stores first, then cluster stores.
+			return left.Kind == KindSecretStore
+		}
+		return lessRef(left, right)
+	})
+	return snapshot, version, nil
+}
+
+// list returns the items of resource at the first served version and the GVR
+// it was read from; found is false when no version is served. Other failures
+// are recorded as snapshot warnings.
+func (c Collector) list(ctx context.Context, resource, namespace string, snapshot *Snapshot) ([]unstructured.Unstructured, schema.GroupVersionResource, bool) {
+	for _, version := range Versions {
+		gvr := schema.GroupVersionResource{Group: Group, Version: version, Resource: resource}
+		list, err := c.Dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("%s: %v", gvr.GroupResource(), err))
+			return nil, gvr, true
+		}
+		return list.Items, gvr, true
+	}
+	return nil, schema.GroupVersionResource{}, false
+}
+
+func (c Collector) ref(obj *unstructured.Unstructured, kind string, gvr schema.GroupVersionResource) resourcemodel.ResourceRef {
+	return resourcemodel.NewResourceRef(c.ClusterID, gvr.Group, gvr.Version, kind, gvr.Resource, obj.GetNamespace(), obj.GetName(), string(obj.GetUID()))
+}
+
+func (c Collector) externalSecret(obj *unstructured.Unstructured, gvr schema.GroupVersionResource) ExternalSecret {
+	// The Secret defaults to the ExternalSecret's name; status.binding names
+	// the one actually written once the operator has synced.
+	target := nestedString(obj, "status", "binding", "name")
+	if target == "" {
+		target = nestedString(obj, "spec", "target", "name")
+	}
+	if target == "" {
+		target = obj.GetName()
+	}
+	store := ""
+	if name := nestedString(obj, "spec", "secretStoreRef", "name"); name != "" {
+		kind := nestedString(obj, "spec", "secretStoreRef", "kind")
+		if kind == "" {
+			kind = KindSecretStore
+		}
+		store = kind + "/" + name
+	}
+	return ExternalSecret{
+		Ref:                   c.ref(obj, KindExternalSecret, gvr),
+		Status:                readStatus(obj),
+		Store:                 store,
+		RefreshInterval:       nestedString(obj, "spec", "refreshInterval"),
+		LastRefreshTime:       nestedString(obj, "status", "refreshTime"),
+		SyncedResourceVersion: nestedString(obj, "status", "syncedResourceVersion"),
+		CreationPolicy:        nestedString(obj, "spec", "target", "creationPolicy"),
+		TargetSecret:          resourcemodel.NewResourceRef(c.ClusterID, "", "v1", "Secret", "secrets", obj.GetNamespace(), target, ""),
+		LastForceSync:         obj.GetAnnotations()[ForceSyncAnnotation],
+	}
+}
+
+func (c Collector) secretStore(obj *unstructured.Unstructured, kind string, gvr schema.GroupVersionResource) SecretStore {
+	store := SecretStore{
+		Ref:          c.ref(obj, kind, gvr),
+		Status:       readStatus(obj),
+		Capabilities: nestedString(obj, "status", "capabilities"),
+	}
+	store.RefreshInterval, _, _ = unstructured.NestedInt64(obj.Object, "spec", "refreshInterval")
+	// spec.provider holds exactly one key naming the backend.
+	if provider, _, _ := unstructured.NestedMap(obj.Object, "spec", "provider"); len(provider) > 0 {
+		names := make([]string, 0, len(provider))
+		for name := range provider {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		store.Provider = strings.Join(names, ",")
+	}
+	return store
+}
+
+// readStatus reads the Ready condition. An object the operator has not
+// reconciled yet has no Ready condition and reads as "Unknown".
+func readStatus(obj *unstructured.Unstructured) Status {
+	status := Status{Ready: string(metav1.ConditionUnknown)}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, entry := range conditions {
+		condition, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType, _ := condition["type"].(string); conditionType != ConditionReady {
+			continue
+		}
+		status.Ready, _ = condition["status"].(string)
+		status.Reason, _ = condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		status.Message = strings.TrimSpace(message)
+		status.LastTransitionTime, _ = condition["lastTransitionTime"].(string)
+		if status.Ready == string(metav1.ConditionFalse) {
+			status.LastError = status.Message
+		}
+	}
+	return status
+}
+
+func lessRef(left, right resourcemodel.ResourceRef) bool {
+	if left.Namespace != right.Namespace {
+		return left.Namespace < right.Namespace
+	}
+	return left.Name < right.Name
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
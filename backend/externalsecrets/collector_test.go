@@ -0,0 +1,183 @@
+package externalsecrets_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/externalsecrets"
+)
+
+func gvr(version, resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: externalsecrets.Group, Version: version, Resource: resource}
+}
+
+func esoObject(version, kind, namespace, name, resourceVersion string, annotations map[string]interface{}, spec, status map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{"name": name, "resourceVersion": resourceVersion}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	if annotations != nil {
+		metadata["annotations"] = annotations
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": externalsecrets.Group + "/" + version,
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       spec,
+		"status":     status,
+	}}
+}
+
+func ready(status, reason, message string) map[string]interface{} {
+	return map[string]interface{}{"conditions": []interface{}{
+		map[string]interface{}{"type": externalsecrets.ConditionReady, "status": status, "reason": reason, "message": message},
+	}}
+}
+
+func newDynamic(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, version := range externalsecrets.Versions {
+		listKinds[gvr(version, externalsecrets.ResourceExternalSecrets)] = "ExternalSecretList"
+		listKinds[gvr(version, externalsecrets.ResourceSecretStores)] = "SecretStoreList"
+		listKinds[gvr(version, externalsecrets.ResourceClusterSecretStores)] = "ClusterSecretStoreList"
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func fixtures() []runtime.Object {
+	return []runtime.Object{
+		esoObject("v1", externalsecrets.KindExternalSecret, "apps", "db-creds", "40",
+			map[string]interface{}{externalsecrets.ForceSyncAnnotation: "1767225600"},
+			map[string]interface{}{
+				"refreshInterval": "1h",
+				"secretStoreRef":  map[string]interface{}{"name": "vault", "kind": "ClusterSecretStore"},
+				"target":          map[string]interface{}{"name": "db", "creationPolicy": "Owner"},
+			},
+			map[string]interface{}{
+				"conditions":  ready("False", "SecretSyncedError", "could not get secret data from provider")["conditions"],
+				"refreshTime": "2026-10-16T09:00:00Z",
+			}),
+		esoObject("v1", externalsecrets.KindExternalSecret, "apps", "api-key", "12", nil,
+			map[string]interface{}{"secretStoreRef": map[string]interface{}{"name": "aws"}},
+			map[string]interface{}{
+				"conditions":            ready("True", "SecretSynced", "secret synced")["conditions"],
+				"binding":               map[string]interface{}{"name": "api-key"},
+				"syncedResourceVersion": "1-abc",
+			}),
+		esoObject("v1", externalsecrets.KindSecretStore, "apps", "aws", "7", nil,
+			map[string]interface{}{"provider": map[string]interface{}{"aws": map[string]interface{}{"service": "SecretsManager"}}, "refreshInterval": int64(300)},
+			map[string]interface{}{"conditions": ready("True", "Valid", "store validated")["conditions"], "capabilities": "ReadWrite"}),
+		esoObject("v1", externalsecrets.KindClusterSecretStore, "", "vault", "8", nil,
+			map[string]interface{}{"provider": map[string]interface{}{"vault": map[string]interface{}{}}},
+			map[string]interface{}{"conditions": ready("False", "InvalidProviderConfig", "unable to log in to vault")["conditions"]}),
+	}
+}
+
+func TestCollectReadsExternalSecretsState(t *testing.T) {
+	snapshot, version, err := externalsecrets.Collector{ClusterID: "config:ctx", Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Warnings)
+	require.Equal(t, externalsecrets.Sources{
+		Detected:            true,
+		APIVersion:          "external-secrets.io/v1",
+		ExternalSecrets:     true,
+		SecretStores:        true,
+		ClusterSecretStores: true,
+	}, snapshot.Sources)
+	require.Equal(t, uint64(40), version)
+	require.Equal(t, 2, snapshot.NotReady)
+
+	require.Len(t, snapshot.ExternalSecrets, 2)
+	synced := snapshot.ExternalSecrets[0]
+	require.Equal(t, "api-key", synced.Ref.Name)
+	require.Equal(t, "True", synced.Ready)
+	require.Empty(t, synced.LastError)
+	require.Equal(t, "SecretStore/aws", synced.Store, "the store kind defaults to SecretStore")
+	require.Equal(t, "api-key", synced.TargetSecret.Name)
+	require.Equal(t, "1-abc", synced.SyncedResourceVersion)
+
+	failing := snapshot.ExternalSecrets[1]
+	require.Equal(t, "config:ctx", failing.Ref.ClusterID)
+	require.Equal(t, externalsecrets.KindExternalSecret, failing.Ref.Kind)
+	require.Equal(t, "False", failing.Ready)
+	require.Equal(t, "could not get secret data from provider", failing.LastError)
+	require.Equal(t, "ClusterSecretStore/vault", failing.Store)
+	require.Equal(t, "1h", failing.RefreshInterval)
+	require.Equal(t, "2026-10-16T09:00:00Z", failing.LastRefreshTime)
+	require.Equal(t, "Owner", failing.CreationPolicy)
+	require.Equal(t, "1767225600", failing.LastForceSync)
+	require.Equal(t, "Secret", failing.TargetSecret.Kind)
+	require.Equal(t, "apps", failing.TargetSecret.Namespace)
+	require.Equal(t, "db", failing.TargetSecret.Name, "spec.target.name names the Secret before the first sync")
+
+	require.Len(t, snapshot.SecretStores, 2)
+	store := snapshot.SecretStores[0]
+	require.Equal(t, externalsecrets.KindSecretStore, store.Ref.Kind)
+	require.Equal(t, "aws", store.Provider)
+	require.Equal(t, int64(300), store.RefreshInterval)
+	require.Equal(t, "ReadWrite", store.Capabilities)
+	clusterStore := snapshot.SecretStores[1]
+	require.Equal(t, externalsecrets.KindClusterSecretStore, clusterStore.Ref.Kind)
+	require.Equal(t, "vault", clusterStore.Provider)
+	require.Equal(t, "unable to log in to vault", clusterStore.LastError)
+}
+
+func TestCollectScopedToNamespaceSkipsClusterStores(t *testing.T) {
+	snapshot, _, err := externalsecrets.Collector{Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "apps")
+	require.NoError(t, err)
+	require.Len(t, snapshot.ExternalSecrets, 2)
+	require.Len(t, snapshot.SecretStores, 1)
+	require.False(t, snapshot.Sources.ClusterSecretStores)
+	require.Equal(t, 1, snapshot.NotReady)
+}
+
+func TestCollectFallsBackToV1beta1(t *testing.T) {
+	client := newDynamic(esoObject("v1beta1", externalsecrets.KindExternalSecret, "apps", "legacy", "3", nil,
+		map[string]interface{}{}, map[string]interface{}{}))
+	client.PrependReactor("list", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetResource().Version == "v1" {
+			return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), "")
+		}
+		return false, nil, nil
+	})
+
+	snapshot, _, err := externalsecrets.Collector{Dynamic: client}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "external-secrets.io/v1beta1", snapshot.Sources.APIVersion)
+	require.Len(t, snapshot.ExternalSecrets, 1)
+	require.Equal(t, "v1beta1", snapshot.ExternalSecrets[0].Ref.Version)
+	require.Equal(t, "Unknown", snapshot.ExternalSecrets[0].Ready, "an unreconciled object has no Ready condition")
+	require.Equal(t, "legacy", snapshot.ExternalSecrets[0].TargetSecret.Name)
+}
+
+func TestCollectWithoutExternalSecrets(t *testing.T) {
+	client := newDynamic()
+	client.PrependReactor("list", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), "")
+	})
+
+	snapshot, _, err := externalsecrets.Collector{ClusterID: "config:ctx", Dynamic: client}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, externalsecrets.Sources{}, snapshot.Sources)
+	require.Empty(t, snapshot.Warnings)
+	require.NotNil(t, snapshot.ExternalSecrets)
+	require.NotNil(t, snapshot.SecretStores)
+}
+
+func TestForceSyncPatch(t *testing.T) {
+	var patch map[string]interface{}
+	require.NoError(t, json.Unmarshal(externalsecrets.ForceSyncPatch(time.Unix(1767225600, 0)), &patch))
+	value, _, _ := unstructured.NestedString(patch, "metadata", "annotations", externalsecrets.ForceSyncAnnotation)
+	require.Equal(t, "1767225600", value)
+	require.NotContains(t, patch, "spec")
+}
@@ -0,0 +1,282 @@
+// Package finishedcleanup finds pods and Jobs that have run to completion —
+// Succeeded or Failed pods, and Jobs with a Complete or Failed condition — and
+// deletes them, replacing the "kubectl get pods | awk | xargs kubectl delete"
+// ritual. Finding and deleting are separate so a caller can show what would go
+// before anything is removed.
+package finishedcleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Outcomes a finished object can have.
+const (
+	OutcomeSucceeded = "Succeeded"
+	OutcomeFailed    = "Failed"
+)
+
+// Filter selects the finished objects to clean up.
+type Filter struct {
+	// Namespaces limits the search; empty searches every namespace.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// MinAgeMinutes keeps objects that finished more recently than this.
+	MinAgeMinutes int  `json:"minAgeMinutes,omitempty"`
+	Pods          bool `json:"pods"`
+	Jobs          bool `json:"jobs"`
+	// Outcomes limits the search to Succeeded or Failed objects; empty means both.
+	Outcomes []string `json:"outcomes,omitempty"`
+}
+
+// Validate rejects filters that select nothing or name an unknown outcome.
+func (f Filter) Validate() error {
+	if !f.Pods && !f.Jobs {
+		return fmt.Errorf("select pods, jobs or both")
+	}
+	if f.MinAgeMinutes < 0 {
+		return fmt.Errorf("minimum age must not be negative")
+	}
+	for _, outcome := range f.Outcomes {
+		if outcome != OutcomeSucceeded && outcome != OutcomeFailed {
+			return fmt.Errorf("unknown outcome %q", outcome)
+		}
+	}
+	return nil
+}
+
+// Candidate is one finished object the filter selected.
+type Candidate struct {
+	Kind       string    `json:"kind"`
+	Namespace  string    `json:"namespace"`
+	Name       string    `json:"name"`
+	Outcome    string    `json:"outcome"`
+	Reason     string    `json:"reason,omitempty"`
+	Owner      string    `json:"owner,omitempty"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// Find lists the finished objects matching filter, oldest first. A pod owned
+// by a Job that is itself a candidate is left out: deleting the Job removes it.
+func Find(ctx context.Context, client kubernetes.Interface, filter Filter, now time.Time) ([]Candidate, error) {
+	if client == nil {
+		return nil, fmt.Errorf("kubernetes client is not initialized")
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	namespaces := filter.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	cutoff := now.Add(-time.Duration(filter.MinAgeMinutes) * time.Minute)
+	outcomes := map[string]bool{OutcomeSucceeded: true, OutcomeFailed: true}
+	if len(filter.Outcomes) > 0 {
+		outcomes = map[string]bool{}
+		for _, outcome := range filter.Outcomes {
+			outcomes[outcome] = true
+		}
+	}
+	keep := func(candidate Candidate) bool {
+		return outcomes[candidate.Outcome] && !candidate.FinishedAt.After(cutoff)
+	}
+
+	var candidates []Candidate
+	jobs := make(map[string]bool)
+	for _, namespace := range namespaces {
+		if !filter.Jobs {
+			break
+		}
+		list, err := client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list jobs: %w", err)
+		}
+		for i := range list.Items {
+			candidate, finished := jobCandidate(&list.Items[i])
+			if finished && keep(candidate) {
+				candidates = append(candidates, candidate)
+				jobs[candidate.Namespace+"/"+candidate.Name] = true
+			}
+		}
+	}
+	for _, namespace := range namespaces {
+		if !filter.Pods {
+			break
+		}
+		list, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list pods: %w", err)
+		}
+		for i := range list.Items {
+			candidate, finished := podCandidate(&list.Items[i])
+			if !finished || !keep(candidate) {
+				continue
+			}
+			if kind, name, _ := strings.Cut(candidate.Owner, "/"); kind == "Job" && jobs[candidate.Namespace+"/"+name] {
+				continue
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].FinishedAt.Equal(candidates[j].FinishedAt) {
+			return candidates[i].FinishedAt.Before(candidates[j].FinishedAt)
+		}
+		if candidates[i].Namespace != candidates[j].Namespace {
+			return candidates[i].Namespace < candidates[j].Namespace
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates, nil
+}
+
+// Delete removes one candidate. A Job's pods go with it; an object already
+// gone counts as deleted.
+func Delete(ctx context.Context, client kubernetes.Interface, candidate Candidate) error {
+	var err error
+	switch candidate.Kind {
+	case "Pod":
+		err = client.CoreV1().Pods(candidate.Namespace).Delete(ctx, candidate.Name, metav1.DeleteOptions{})
+	case "Job":
+		propagation := metav1.DeletePropagationBackground
+		err = client.BatchV1().Jobs(candidate.Namespace).Delete(ctx, candidate.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	default:
+		return fmt.Errorf("cannot clean up kind %q", candidate.Kind)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// podCandidate reports whether the pod has finished, and when: the last
+// container termination, falling back to its start or creation time.
+func podCandidate(pod *corev1.Pod) (Candidate, bool) {
+	if pod.DeletionTimestamp != nil {
+		return Candidate{}, false
+	}
+	var outcome string
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		outcome = OutcomeSucceeded
+	case corev1.PodFailed:
+		outcome = OutcomeFailed
+	default:
+		return Candidate{}, false
+	}
+	finishedAt := pod.CreationTimestamp.Time
+	if pod.Status.StartTime != nil {
+		finishedAt = pod.Status.StartTime.Time
+	}
+	for _, status := range append(append([]corev1.ContainerStatus(nil), pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+		if terminated := status.State.Terminated; terminated != nil && terminated.FinishedAt.After(finishedAt) {
+			finishedAt = terminated.FinishedAt.Time
+		}
+	}
+	candidate := Candidate{
+		Kind:       "Pod",
+		Namespace:  pod.Namespace,
+		Name:       pod.Name,
+		Outcome:    outcome,
+		Reason:     pod.Status.Reason,
+		FinishedAt: finishedAt,
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		candidate.Owner = owner.Kind + "/" + owner.Name
+	}
+	return candidate, true
+}
+
+// jobCandidate reports whether the Job has finished, and when: its completion
+// time, or when the Complete or Failed condition turned true.
+func jobCandidate(job *batchv1.Job) (Candidate, bool) {
+	if job.DeletionTimestamp != nil {
+		return Candidate{}, false
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		var outcome string
+		switch condition.Type {
+		case batchv1.JobComplete:
+			outcome = OutcomeSucceeded
+		case batchv1.JobFailed:
+			outcome = OutcomeFailed
+		default:
+			continue
+		}
+		finishedAt := condition.LastTransitionTime.Time
+		if job.Status.CompletionTime != nil {
+			finishedAt = job.Status.CompletionTime.Time
+		}
+		candidate := Candidate{
+			Kind:       "Job",
+			Namespace:  job.Namespace,
+			Name:       job.Name,
+			Outcome:    outcome,
+			Reason:     condition.Reason,
+			FinishedAt: finishedAt,
+		}
+		if owner := metav1.GetControllerOf(job); owner != nil {
+			candidate.Owner = owner.Kind + "/" + owner.Name
+		}
+		return candidate, true
+	}
+	return Candidate{}, false
+}
+
+// Progress is how far a cleanup run has got.
+type Progress struct {
+	Total   int `json:"total"`
+	Deleted int `json:"deleted"`
+	// Failed lists the candidates that could not be deleted and why.
+	Failed []Failure `json:"failed,omitempty"`
+	// Current is the candidate being deleted, as "Kind namespace/name".
+	Current  string `json:"current,omitempty"`
+	Done     bool   `json:"done"`
+	Canceled bool   `json:"canceled,omitempty"`
+}
+
+// Failure is a candidate whose delete failed.
+type Failure struct {
+	Candidate
+	Error string `json:"error"`
+}
+
+// Run deletes the candidates in order, calling report before each delete and
+// once when done. beforeDelete, when set, runs just before each delete so the
+// caller can snapshot the object. A canceled context stops the run between
+// deletes.
+func Run(ctx context.Context, client kubernetes.Interface, candidates []Candidate, beforeDelete func(Candidate), report func(Progress)) Progress {
+	progress := Progress{Total: len(candidates)}
+	for _, candidate := range candidates {
+		if ctx.Err() != nil {
+			progress.Canceled = true
+			break
+		}
+		progress.Current = candidate.Kind + " " + candidate.Namespace + "/" + candidate.Name
+		report(progress)
+		if beforeDelete != nil {
+			beforeDelete(candidate)
+		}
+		if err := Delete(ctx, client, candidate); err != nil {
+			progress.Failed = append(progress.Failed, Failure{Candidate: candidate, Error: err.Error()})
+			continue
+		}
+		progress.Deleted++
+	}
+	progress.Current = ""
+	progress.Done = true
+	report(progress)
+	return progress
+}
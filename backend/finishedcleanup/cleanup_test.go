@@ -0,0 +1,100 @@
+package finishedcleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFindAndRunCleansUpFinishedObjects(t *testing.T) {
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	controller := true
+	pod := func(namespace, name string, phase corev1.PodPhase, finished time.Time, owner string) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: metav1.NewTime(finished.Add(-time.Hour))},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "app",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finished)}},
+				}},
+			},
+		}
+		if owner != "" {
+			p.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: owner, Controller: &controller}}
+		}
+		return p
+	}
+	job := func(namespace, name string, condition batchv1.JobConditionType, finished time.Time) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+				Type: condition, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(finished),
+			}}},
+		}
+	}
+
+	client := cgofake.NewClientset(
+		pod("dev", "done", corev1.PodSucceeded, now.Add(-3*time.Hour), ""),
+		pod("dev", "crashed", corev1.PodFailed, now.Add(-2*time.Hour), ""),
+		pod("dev", "recent", corev1.PodSucceeded, now.Add(-10*time.Minute), ""),
+		pod("dev", "running", corev1.PodRunning, now.Add(-3*time.Hour), ""),
+		pod("dev", "migrate-x1", corev1.PodSucceeded, now.Add(-5*time.Hour), "migrate"),
+		pod("prod", "other", corev1.PodSucceeded, now.Add(-3*time.Hour), ""),
+		job("dev", "migrate", batchv1.JobComplete, now.Add(-5*time.Hour)),
+		job("dev", "backfill", batchv1.JobFailed, now.Add(-90*time.Minute)),
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "dev", Name: "active"}},
+	)
+	ctx := context.Background()
+
+	filter := Filter{Namespaces: []string{"dev"}, MinAgeMinutes: 60, Pods: true, Jobs: true}
+	candidates, err := Find(ctx, client, filter, now)
+	require.NoError(t, err)
+	var names []string
+	for _, candidate := range candidates {
+		names = append(names, candidate.Kind+"/"+candidate.Name)
+	}
+	// The Job's own pod goes with the Job, so it is not listed separately.
+	require.Equal(t, []string{"Job/migrate", "Pod/done", "Pod/crashed", "Job/backfill"}, names)
+
+	failedOnly, err := Find(ctx, client, Filter{Namespaces: []string{"dev"}, Pods: true, Outcomes: []string{OutcomeFailed}}, now)
+	require.NoError(t, err)
+	require.Len(t, failedOnly, 1)
+	require.Equal(t, "crashed", failedOnly[0].Name)
+
+	var reports []Progress
+	var captured []string
+	result := Run(ctx, client, candidates, func(candidate Candidate) {
+		_, err := client.CoreV1().Pods(candidate.Namespace).Get(ctx, candidate.Name, metav1.GetOptions{})
+		if candidate.Kind == "Pod" {
+			require.NoError(t, err, "beforeDelete runs while the object still exists")
+		}
+		captured = append(captured, candidate.Kind+"/"+candidate.Name)
+	}, func(progress Progress) { reports = append(reports, progress) })
+	require.Equal(t, names, captured)
+	require.Equal(t, 4, result.Deleted)
+	require.Empty(t, result.Failed)
+	require.True(t, result.Done)
+	require.Len(t, reports, 5)
+	require.Equal(t, "Job dev/migrate", reports[0].Current)
+
+	_, err = client.CoreV1().Pods("dev").Get(ctx, "done", metav1.GetOptions{})
+	require.Error(t, err)
+	_, err = client.CoreV1().Pods("prod").Get(ctx, "other", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	result = Run(canceled, client, candidates, nil, func(Progress) {})
+	require.True(t, result.Canceled)
+	require.Zero(t, result.Deleted)
+
+	_, err = Find(ctx, client, Filter{}, now)
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package flux
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ReconcileRequestedAtAnnotation asks a Flux controller to reconcile an
+// object outside its interval; the controller records the handled value in
+// status.lastHandledReconcileAt.
+const ReconcileRequestedAtAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+// ReconcilePatch returns the merge patch `flux reconcile` applies.
+func ReconcilePatch(now time.Time) []byte {
+	return marshal(map[string]interface{}{
+		"metadata": requestedAt(now),
+	})
+}
+
+// SuspendPatch returns the merge patch that suspends or resumes an object.
+// Resuming also requests a reconcile, as `flux resume` does, so changes made
+// while suspended are applied straight away.
+func SuspendPatch(suspend bool, now time.Time) []byte {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{"suspend": suspend},
+	}
+	if !suspend {
+		patch["metadata"] = requestedAt(now)
+	}
+	return marshal(patch)
+}
+
+func requestedAt(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"annotations": map[string]interface{}{
+			ReconcileRequestedAtAnnotation: now.UTC().Format(time.RFC3339Nano),
+		},
+	}
+}
+
+// marshal encodes a patch built from maps, strings and bools, which cannot
+// fail.
+func marshal(value interface{}) []byte {
+	data, _ := json.Marshal(value)
+	return data
+}
@@ -0,0 +1,323 @@
+/*
+ * backend/flux/collector.go
+ *
+ * Collects Flux HelmReleases (helm.toolkit.fluxcd.io/v2) and Kustomizations
+ * (kustomize.toolkit.fluxcd.io/v1) with their reconciliation status. Flux is
+ * optional; when neither CRD is served the snapshot reports it as undetected
+ * rather than failing.
+ */
+
+package flux
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Kinds collected here.
+const (
+	KindHelmRelease   = "HelmRelease"
+	KindKustomization = "Kustomization"
+)
+
+var (
+	HelmReleaseGVR   = schema.GroupVersionResource{Group: "helm.toolkit.fluxcd.io", Version: "v2", Resource: "helmreleases"}
+	KustomizationGVR = schema.GroupVersionResource{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Resource: "kustomizations"}
+)
+
+// Condition types Flux controllers set.
+const (
+	ConditionReady       = "Ready"
+	ConditionStalled     = "Stalled"
+	ConditionReconciling = "Reconciling"
+)
+
+// Sources records which Flux controllers' CRDs are served.
+type Sources struct {
+	Detected       bool `json:"detected"`
+	HelmReleases   bool `json:"helmReleases"`
+	Kustomizations bool `json:"kustomizations"`
+}
+
+// Status is the reconciliation state shared by every Flux kind.
+type Status struct {
+	// Ready is the Ready condition's status: "True", "False" or "Unknown".
+	Ready string `json:"ready"`
+	// Reason and Message come from the Ready condition.
+	Reason                 string `json:"reason,omitempty"`
+	Message                string `json:"message,omitempty"`
+	LastTransitionTime     string `json:"lastTransitionTime,omitempty"`
+	Suspended              bool   `json:"suspended,omitempty"`
+	Reconciling            bool   `json:"reconciling,omitempty"`
+	Stalled                bool   `json:"stalled,omitempty"`
+	LastAppliedRevision    string `json:"lastAppliedRevision,omitempty"`
+	LastAttemptedRevision  string `json:"lastAttemptedRevision,omitempty"`
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+	// Failures holds the messages of failing conditions, Ready first.
+	Failures []string `json:"failures,omitempty"`
+}
+
+// HelmRelease is one helm.toolkit.fluxcd.io HelmRelease.
+type HelmRelease struct {
+	Ref resourcemodel.ResourceRef `json:"ref"`
+	Status
+	Chart        string `json:"chart,omitempty"`
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// Source is the chart's source as "Kind/namespace/name".
+	Source          string `json:"source,omitempty"`
+	ReleaseName     string `json:"releaseName,omitempty"`
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+}
+
+// Kustomization is one kustomize.toolkit.fluxcd.io Kustomization.
+type Kustomization struct {
+	Ref resourcemodel.ResourceRef `json:"ref"`
+	Status
+	// Source is the artifact source as "Kind/namespace/name".
+	Source          string `json:"source,omitempty"`
+	Path            string `json:"path,omitempty"`
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	Prune           bool   `json:"prune,omitempty"`
+}
+
+// Snapshot is the cluster-flux domain payload.
+type Snapshot struct {
+	ClusterID      string          `json:"clusterId"`
+	ClusterName    string          `json:"clusterName"`
+	Sources        Sources         `json:"sources"`
+	HelmReleases   []HelmRelease   `json:"helmReleases"`
+	Kustomizations []Kustomization `json:"kustomizations"`
+	// NotReady and Suspended count entries across both kinds.
+	NotReady  int `json:"notReady"`
+	Suspended int `json:"suspended"`
+	// Warnings lists Flux kinds that are served but could not be read.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Collector reads Flux objects through the dynamic client.
+type Collector struct {
+	ClusterID string
+	Dynamic   dynamic.Interface
+}
+
+// Collect gathers Flux state, limited to namespace when it is non-empty. The
+// returned version is the highest resourceVersion among the objects read.
+func (c Collector) Collect(ctx context.Context, namespace string) (*Snapshot, uint64, error) {
+	if c.Dynamic == nil {
+		return nil, 0, fmt.Errorf("dynamic client is not initialized")
+	}
+	snapshot := &Snapshot{
+		ClusterID:      c.ClusterID,
+		HelmReleases:   []HelmRelease{},
+		Kustomizations: []Kustomization{},
+	}
+	var version uint64
+	track := func(obj *unstructured.Unstructured) {
+		if parsed, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64); err == nil && parsed > version {
+			version = parsed
+		}
+	}
+	count := func(status Status) {
+		if status.Suspended {
+			snapshot.Suspended++
+		}
+		if status.Ready != string(metav1.ConditionTrue) {
+			snapshot.NotReady++
+		}
+	}
+
+	releases, found := c.list(ctx, HelmReleaseGVR, namespace, snapshot)
+	snapshot.Sources.HelmReleases = found
+	for i := range releases {
+		track(&releases[i])
+		release := c.helmRelease(&releases[i])
+		count(release.Status)
+		snapshot.HelmReleases = append(snapshot.HelmReleases, release)
+	}
+	kustomizations, found := c.list(ctx, KustomizationGVR, namespace, snapshot)
+	snapshot.Sources.Kustomizations = found
+	for i := range kustomizations {
+		track(&kustomizations[i])
+		kustomization := c.kustomization(&kustomizations[i])
+		count(kustomization.Status)
+		snapshot.Kustomizations = append(snapshot.Kustomizations, kustomization)
+	}
+	snapshot.Sources.Detected = snapshot.Sources.HelmReleases || snapshot.Sources.Kustomizations
+
+	sort.SliceStable(snapshot.HelmReleases, func(i, j int) bool {
+		return lessRef(snapshot.HelmReleases[i].Ref, snapshot.HelmReleases[j].Ref)
+	})
+	sort.SliceStable(snapshot.Kustomizations, func(i, j int) bool {
+		return lessRef(snapshot.Kustomizations[i].Ref, snapshot.Kustomizations[j].Ref)
+	})
+	return snapshot, version, nil
+}
+
+// GVRForKind returns the GVR of a Flux kind handled here.
+func GVRForKind(kind string) (schema.GroupVersionResource, bool) {
+	switch kind {
+	case KindHelmRelease:
+		return HelmReleaseGVR, true
+	case KindKustomization:
+		return KustomizationGVR, true
+	}
+	return schema.GroupVersionResource{}, false
+}
+
+// list returns the items of gvr; found is false when the API is not served.
+// Other failures are recorded as snapshot warnings.
+func (c Collector) list(ctx context.Context, gvr schema.GroupVersionResource, namespace string, snapshot *Snapshot) ([]unstructured.Unstructured, bool) {
+	list, err := c.Dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false
+		}
+		snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("%s: %v", gvr.GroupResource(), err))
+		return nil, true
+	}
+	return list.Items, true
+}
+
+func (c Collector) ref(obj *unstructured.Unstructured, kind string, gvr schema.GroupVersionResource) resourcemodel.ResourceRef {
+	return resourcemodel.NewResourceRef(c.ClusterID, gvr.Group, gvr.Version, kind, gvr.Resource, obj.GetNamespace(), obj.GetName(), string(obj.GetUID()))
+}
+
+func (c Collector) helmRelease(obj *unstructured.Unstructured) HelmRelease {
+	status := readStatus(obj)
+	// v2 records releases in status.history, newest first; older API
+	// versions stored lastAppliedRevision directly.
+	if history, _, _ := unstructured.NestedSlice(obj.Object, "status", "history"); len(history) > 0 {
+		if latest, ok := history[0].(map[string]interface{}); ok {
+			if chartVersion, _ := latest["chartVersion"].(string); chartVersion != "" {
+				status.LastAppliedRevision = chartVersion
+			}
+		}
+	}
+	release := HelmRelease{
+		Ref:             c.ref(obj, KindHelmRelease, HelmReleaseGVR),
+		Status:          status,
+		Chart:           nestedString(obj, "spec", "chart", "spec", "chart"),
+		ChartVersion:    nestedString(obj, "spec", "chart", "spec", "version"),
+		Source:          sourceRef(obj, "spec", "chart", "spec", "sourceRef"),
+		ReleaseName:     nestedString(obj, "spec", "releaseName"),
+		TargetNamespace: nestedString(obj, "spec", "targetNamespace"),
+	}
+	if release.Chart == "" {
+		// spec.chartRef points at an OCIRepository or HelmChart instead.
+		release.Chart = nestedString(obj, "spec", "chartRef", "name")
+		release.Source = sourceRef(obj, "spec", "chartRef")
+	}
+	return release
+}
+
+func (c Collector) kustomization(obj *unstructured.Unstructured) Kustomization {
+	prune, _, _ := unstructured.NestedBool(obj.Object, "spec", "prune")
+	return Kustomization{
+		Ref:             c.ref(obj, KindKustomization, KustomizationGVR),
+		Status:          readStatus(obj),
+		Source:          sourceRef(obj, "spec", "sourceRef"),
+		Path:            nestedString(obj, "spec", "path"),
+		TargetNamespace: nestedString(obj, "spec", "targetNamespace"),
+		Prune:           prune,
+	}
+}
+
+// readStatus reads the fields every Flux kind shares. An object the
+// controller has not reconciled yet has no Ready condition and reads as
+// "Unknown".
+func readStatus(obj *unstructured.Unstructured) Status {
+	suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+	status := Status{
+		Ready:                  string(metav1.ConditionUnknown),
+		Suspended:              suspended,
+		LastAppliedRevision:    nestedString(obj, "status", "lastAppliedRevision"),
+		LastAttemptedRevision:  nestedString(obj, "status", "lastAttemptedRevision"),
+		LastHandledReconcileAt: nestedString(obj, "status", "lastHandledReconcileAt"),
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	var failures []string
+	for _, entry := range conditions {
+		condition, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		conditionStatus, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		message = strings.TrimSpace(message)
+		switch conditionType {
+		case ConditionReady:
+			status.Ready = conditionStatus
+			status.Reason, _ = condition["reason"].(string)
+			status.Message = message
+			status.LastTransitionTime, _ = condition["lastTransitionTime"].(string)
+			if conditionStatus == string(metav1.ConditionFalse) && message != "" {
+				failures = append([]string{message}, failures...)
+			}
+		case ConditionStalled:
+			if conditionStatus == string(metav1.ConditionTrue) {
+				status.Stalled = true
+				failures = append(failures, message)
+			}
+		case ConditionReconciling:
+			status.Reconciling = conditionStatus == string(metav1.ConditionTrue)
+		}
+	}
+	status.Failures = dedupe(failures)
+	return status
+}
+
+// sourceRef formats a Flux cross-namespace reference as "Kind/namespace/name",
+// defaulting the namespace to the object's own.
+func sourceRef(obj *unstructured.Unstructured, fields ...string) string {
+	kind := nestedString(obj, append(fields, "kind")...)
+	name := nestedString(obj, append(fields, "name")...)
+	if kind == "" || name == "" {
+		return ""
+	}
+	namespace := nestedString(obj, append(fields, "namespace")...)
+	if namespace == "" {
+		namespace = obj.GetNamespace()
+	}
+	return kind + "/" + namespace + "/" + name
+}
+
+func dedupe(values []string) []string {
+	var out []string
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		out = append(out, value)
+	}
+	return out
+}
+
+func lessRef(left, right resourcemodel.ResourceRef) bool {
+	if left.Namespace != right.Namespace {
+		return left.Namespace < right.Namespace
+	}
+	return left.Name < right.Name
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
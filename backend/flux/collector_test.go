@@ -0,0 +1,151 @@
+package flux_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/flux"
+)
+
+func fluxObject(gvr schema.GroupVersionResource, kind, namespace, name, resourceVersion string, spec, status map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": gvr.GroupVersion().String(),
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace, "resourceVersion": resourceVersion},
+		"spec":       spec,
+		"status":     status,
+	}}
+}
+
+func condition(conditionType, status, reason, message string) map[string]interface{} {
+	return map[string]interface{}{"type": conditionType, "status": status, "reason": reason, "message": message}
+}
+
+func newDynamic(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		flux.HelmReleaseGVR:   "HelmReleaseList",
+		flux.KustomizationGVR: "KustomizationList",
+	}, objects...)
+}
+
+func fixtures() []runtime.Object {
+	return []runtime.Object{
+		fluxObject(flux.HelmReleaseGVR, flux.KindHelmRelease, "apps", "podinfo", "21",
+			map[string]interface{}{"chart": map[string]interface{}{"spec": map[string]interface{}{
+				"chart": "podinfo", "version": "6.x", "sourceRef": map[string]interface{}{"kind": "HelmRepository", "name": "podinfo", "namespace": "flux-system"},
+			}}},
+			map[string]interface{}{
+				"conditions": []interface{}{
+					condition(flux.ConditionReady, "False", "UpgradeFailed", "Helm upgrade failed: timed out waiting for the condition"),
+					condition(flux.ConditionStalled, "True", "RetriesExceeded", "Failed to upgrade after 3 attempt(s)"),
+				},
+				"history":               []interface{}{map[string]interface{}{"chartVersion": "6.5.4"}, map[string]interface{}{"chartVersion": "6.5.3"}},
+				"lastAttemptedRevision": "6.5.5",
+			}),
+		fluxObject(flux.HelmReleaseGVR, flux.KindHelmRelease, "apps", "redis", "9",
+			map[string]interface{}{"suspend": true, "chartRef": map[string]interface{}{"kind": "OCIRepository", "name": "redis"}},
+			map[string]interface{}{"conditions": []interface{}{condition(flux.ConditionReady, "True", "UpgradeSucceeded", "Helm upgrade succeeded")}}),
+		fluxObject(flux.KustomizationGVR, flux.KindKustomization, "flux-system", "infra", "30",
+			map[string]interface{}{"path": "./infra", "prune": true, "sourceRef": map[string]interface{}{"kind": "GitRepository", "name": "flux-system"}},
+			map[string]interface{}{
+				"conditions":             []interface{}{condition(flux.ConditionReady, "True", "ReconciliationSucceeded", "Applied revision: main@sha1:abc")},
+				"lastAppliedRevision":    "main@sha1:abc",
+				"lastHandledReconcileAt": "2026-01-01T00:00:00Z",
+			}),
+	}
+}
+
+func TestCollectReadsFluxState(t *testing.T) {
+	snapshot, version, err := flux.Collector{ClusterID: "config:ctx", Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Warnings)
+	require.Equal(t, flux.Sources{Detected: true, HelmReleases: true, Kustomizations: true}, snapshot.Sources)
+	require.Equal(t, uint64(30), version)
+	require.Equal(t, 1, snapshot.NotReady)
+	require.Equal(t, 1, snapshot.Suspended)
+
+	require.Len(t, snapshot.HelmReleases, 2)
+	failing := snapshot.HelmReleases[0]
+	require.Equal(t, "podinfo", failing.Ref.Name)
+	require.Equal(t, "config:ctx", failing.Ref.ClusterID)
+	require.Equal(t, "False", failing.Ready)
+	require.Equal(t, "UpgradeFailed", failing.Reason)
+	require.True(t, failing.Stalled)
+	require.Equal(t, []string{"Helm upgrade failed: timed out waiting for the condition", "Failed to upgrade after 3 attempt(s)"}, failing.Failures)
+	require.Equal(t, "6.5.4", failing.LastAppliedRevision, "the newest history entry")
+	require.Equal(t, "6.5.5", failing.LastAttemptedRevision)
+	require.Equal(t, "HelmRepository/flux-system/podinfo", failing.Source)
+
+	suspended := snapshot.HelmReleases[1]
+	require.True(t, suspended.Suspended)
+	require.Equal(t, "redis", suspended.Chart)
+	require.Equal(t, "OCIRepository/apps/redis", suspended.Source, "the namespace defaults to the release's own")
+	require.Empty(t, suspended.Failures)
+
+	require.Len(t, snapshot.Kustomizations, 1)
+	kustomization := snapshot.Kustomizations[0]
+	require.Equal(t, "True", kustomization.Ready)
+	require.Equal(t, "main@sha1:abc", kustomization.LastAppliedRevision)
+	require.Equal(t, "GitRepository/flux-system/flux-system", kustomization.Source)
+	require.True(t, kustomization.Prune)
+}
+
+func TestCollectScopedToNamespace(t *testing.T) {
+	snapshot, _, err := flux.Collector{ClusterID: "config:ctx", Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "flux-system")
+	require.NoError(t, err)
+	require.Empty(t, snapshot.HelmReleases)
+	require.Len(t, snapshot.Kustomizations, 1)
+	require.Zero(t, snapshot.NotReady)
+}
+
+func TestCollectWithoutFlux(t *testing.T) {
+	client := newDynamic()
+	client.PrependReactor("list", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), "")
+	})
+
+	snapshot, _, err := flux.Collector{ClusterID: "config:ctx", Dynamic: client}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, flux.Sources{}, snapshot.Sources)
+	require.Empty(t, snapshot.Warnings)
+	require.NotNil(t, snapshot.HelmReleases)
+	require.NotNil(t, snapshot.Kustomizations)
+}
+
+func TestUnreconciledObjectIsUnknown(t *testing.T) {
+	obj := fluxObject(flux.KustomizationGVR, flux.KindKustomization, "apps", "new", "1", map[string]interface{}{}, map[string]interface{}{})
+	snapshot, _, err := flux.Collector{Dynamic: newDynamic(obj)}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "Unknown", snapshot.Kustomizations[0].Ready)
+	require.Equal(t, 1, snapshot.NotReady)
+}
+
+func TestPatches(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 6, 7, 8, time.UTC)
+	var patch map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(flux.ReconcilePatch(now), &patch))
+	requestedAt, _, _ := unstructured.NestedString(patch, "metadata", "annotations", flux.ReconcileRequestedAtAnnotation)
+	require.Equal(t, "2026-03-04T05:06:07.000000008Z", requestedAt)
+	require.NotContains(t, patch, "spec")
+
+	patch = nil
+	require.NoError(t, json.Unmarshal(flux.SuspendPatch(true, now), &patch))
+	require.Equal(t, map[string]interface{}{"spec": map[string]interface{}{"suspend": true}}, patch)
+
+	patch = nil
+	require.NoError(t, json.Unmarshal(flux.SuspendPatch(false, now), &patch))
+	suspend, _, _ := unstructured.NestedBool(patch, "spec", "suspend")
+	require.False(t, suspend)
+	require.Contains(t, patch, "metadata", "resuming requests a reconcile")
+}
@@ -29,6 +29,7 @@ func (a *App) deleteGenericResourceAction(target ObjectActionTargetRef) error {
 	}); err != nil {
 		return err
 	}
+	a.captureDeletedObject(deps, target)
 	service := generic.NewService(deps)
 	if err := service.DeleteByGVK(objectActionTargetGVK(target), target.Namespace, target.Name); err != nil {
 		return err
@@ -0,0 +1,190 @@
+// Package gitdrift compares the manifests in a git working copy with the live
+// objects they declare, GitOps-lite for clusters without Argo CD or Flux. A
+// Source ties one namespace to a directory of a local checkout; Check renders
+// that directory (a kustomization, or a folder of manifests), optionally
+// fast-forwards the checkout first, and dry-run applies the result to find the
+// objects whose live state has drifted from what git declares.
+package gitdrift
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/kustomizebuild"
+	"github.com/luxury-yacht/app/backend/manifestapply"
+)
+
+// Per-object drift states.
+const (
+	// StatusInSync: applying git would change nothing.
+	StatusInSync = "in-sync"
+	// StatusDrifted: the live object differs from git.
+	StatusDrifted = "drifted"
+	// StatusMissing: git declares an object the cluster does not have.
+	StatusMissing = "missing"
+	// StatusError: the manifest or the dry run failed.
+	StatusError = "error"
+)
+
+// Source points a namespace at a directory of a git working copy.
+type Source struct {
+	Namespace string `json:"namespace"`
+	// Repo is the local working copy.
+	Repo string `json:"repo"`
+	// Path is the manifest directory inside Repo; empty is the repo root.
+	Path string `json:"path,omitempty"`
+	// Pull fast-forwards the working copy from its upstream before each check.
+	Pull bool `json:"pull,omitempty"`
+}
+
+// Object is the drift state of one declared object.
+type Object struct {
+	Source     string `json:"source,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status"`
+	// Diff is a unified diff from the live object to the git one.
+	Diff  string `json:"diff,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the outcome of one check of a source.
+type Report struct {
+	Source
+	// Commit is the checked-out commit the manifests were read at.
+	Commit    string    `json:"commit,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+	// Error is set when the source could not be rendered at all.
+	Error   string   `json:"error,omitempty"`
+	Objects []Object `json:"objects"`
+	// Counts maps each status to its number of objects.
+	Counts map[string]int `json:"counts"`
+}
+
+// Validate checks that the source names a namespace and a directory inside a
+// git working copy, and normalizes its paths.
+func (s *Source) Validate() error {
+	s.Namespace = strings.TrimSpace(s.Namespace)
+	s.Repo = strings.TrimSpace(s.Repo)
+	s.Path = filepath.ToSlash(filepath.Clean(strings.TrimSpace(s.Path)))
+	if s.Path == "." {
+		s.Path = ""
+	}
+	if s.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if s.Repo == "" {
+		return fmt.Errorf("repository path is required")
+	}
+	if !filepath.IsAbs(s.Repo) {
+		return fmt.Errorf("repository path %q must be absolute", s.Repo)
+	}
+	if filepath.IsAbs(s.Path) || s.Path == ".." || strings.HasPrefix(s.Path, "../") {
+		return fmt.Errorf("path %q must be inside the repository", s.Path)
+	}
+	if _, err := os.Stat(filepath.Join(s.Repo, ".git")); err != nil {
+		return fmt.Errorf("%s is not a git working copy", s.Repo)
+	}
+	return nil
+}
+
+func (s Source) dir() string {
+	return filepath.Join(s.Repo, filepath.FromSlash(s.Path))
+}
+
+// Check renders the source and compares every object with the cluster. Live
+// state is read with a forced server-side dry-run apply, so field ownership
+// never hides a difference and nothing is changed.
+func Check(ctx context.Context, client dynamic.Interface, resolve manifestapply.Resolver, source Source, now time.Time) Report {
+	report := Report{Source: source, CheckedAt: now, Objects: []Object{}, Counts: map[string]int{}}
+	if source.Pull {
+		if _, err := git(ctx, source.Repo, "pull", "--ff-only", "--quiet"); err != nil {
+			report.Error = err.Error()
+			return report
+		}
+	}
+	commit, err := git(ctx, source.Repo, "rev-parse", "HEAD")
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.Commit = commit
+
+	manifests, problems, err := Render(source.dir())
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	applied := manifestapply.Run(ctx, client, resolve, manifests, manifestapply.Options{Namespace: source.Namespace, Force: true, DryRun: true})
+	for _, result := range append(applied.Results, problems...) {
+		object := Object{
+			Source:     result.Source,
+			APIVersion: result.APIVersion,
+			Kind:       result.Kind,
+			Namespace:  result.Namespace,
+			Name:       result.Name,
+			Diff:       result.Diff,
+			Error:      result.Error,
+		}
+		switch result.Action {
+		case manifestapply.ActionUnchanged:
+			object.Status = StatusInSync
+		case manifestapply.ActionUpdate:
+			object.Status = StatusDrifted
+		case manifestapply.ActionCreate:
+			object.Status = StatusMissing
+		default:
+			object.Status = StatusError
+		}
+		report.Objects = append(report.Objects, object)
+		report.Counts[object.Status]++
+	}
+	return report
+}
+
+// Render reads the manifests in dir: a kustomization is built, anything else
+// is loaded as a folder of manifests.
+func Render(dir string) ([]manifestapply.Manifest, []manifestapply.Result, error) {
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			continue
+		}
+		objects, _, err := kustomizebuild.Build(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifests := make([]manifestapply.Manifest, 0, len(objects))
+		for _, obj := range objects {
+			manifests = append(manifests, manifestapply.Manifest{Source: name, Object: obj})
+		}
+		return manifests, nil, nil
+	}
+	return manifestapply.LoadDirectory(dir, config.ManifestDirectoryMaxFiles)
+}
+
+// git runs a git command in repo and returns its trimmed output.
+func git(ctx context.Context, repo string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", repo}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	// Never block on a credential prompt.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], message)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
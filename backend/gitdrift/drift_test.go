@@ -0,0 +1,114 @@
+package gitdrift
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// newRepo commits files to a new git working copy.
+func newRepo(t *testing.T, files map[string]string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(repo, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "manifests"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return repo
+}
+
+func TestSourceValidate(t *testing.T) {
+	repo := newRepo(t, map[string]string{"README.md": "x"})
+
+	source := Source{Namespace: " app ", Repo: repo, Path: "./deploy/"}
+	require.NoError(t, source.Validate())
+	require.Equal(t, "app", source.Namespace)
+	require.Equal(t, "deploy", source.Path)
+
+	for name, bad := range map[string]Source{
+		"namespace": {Repo: repo},
+		"repo":      {Namespace: "app"},
+		"relative":  {Namespace: "app", Repo: "repo"},
+		"escape":    {Namespace: "app", Repo: repo, Path: "../other"},
+		"not git":   {Namespace: "app", Repo: t.TempDir()},
+	} {
+		require.Error(t, bad.Validate(), name)
+	}
+}
+
+func TestCheckReportsDrift(t *testing.T) {
+	configMap := func(name, value string) string {
+		return "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: " + name + "\ndata:\n  key: " + value + "\n"
+	}
+	repo := newRepo(t, map[string]string{
+		"deploy/same.yaml":    configMap("same", "one"),
+		"deploy/changed.yaml": configMap("changed", "git"),
+		"deploy/missing.yaml": configMap("missing", "one"),
+		"deploy/broken.yaml":  "kind: [\n",
+	})
+
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	live := func(name, value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name, "namespace": "app"},
+			"data":       map[string]any{"key": value},
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMaps: "ConfigMapList",
+	}, live("same", "one"), live("changed", "edited"))
+	// The fake tracker cannot apply; a dry-run apply returns the manifest.
+	client.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchActionImpl)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		require.NotEmpty(t, patch.PatchOptions.DryRun)
+		obj := &unstructured.Unstructured{}
+		return true, obj, obj.UnmarshalJSON(patch.GetPatch())
+	})
+	resolve := func(context.Context, schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+		return configMaps, true, nil
+	}
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	report := Check(context.Background(), client, resolve, Source{Namespace: "app", Repo: repo, Path: "deploy"}, now)
+	require.Empty(t, report.Error)
+	require.Len(t, report.Commit, 40)
+	require.Equal(t, now, report.CheckedAt)
+	require.Equal(t, map[string]int{StatusInSync: 1, StatusDrifted: 1, StatusMissing: 1, StatusError: 1}, report.Counts)
+	for _, object := range report.Objects {
+		if object.Status == StatusDrifted {
+			require.Equal(t, "changed.yaml", object.Source)
+			require.Contains(t, object.Diff, "+  key: git")
+		}
+	}
+
+	failed := Check(context.Background(), client, resolve, Source{Namespace: "app", Repo: repo, Path: "absent"}, now)
+	require.NotEmpty(t, failed.Error)
+}
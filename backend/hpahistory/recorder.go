@@ -0,0 +1,333 @@
+// Package hpahistory records HorizontalPodAutoscaler status changes (current
+// and desired replicas, metric values) and the autoscaler's events from the
+// cluster's informers, so an HPA's scaling decisions can be read back as a
+// timeline. The instantaneous status hides oscillation; the timeline shows it.
+package hpahistory
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/resources/hpa"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Sample is the HPA's status at one observed change.
+type Sample struct {
+	Time            time.Time `json:"time"`
+	CurrentReplicas int32     `json:"currentReplicas"`
+	DesiredReplicas int32     `json:"desiredReplicas"`
+	MinReplicas     int32     `json:"minReplicas"`
+	MaxReplicas     int32     `json:"maxReplicas"`
+	// Metrics are the current metric values the controller reported.
+	Metrics []hpa.MetricStatusFacts `json:"metrics,omitempty"`
+}
+
+// Event is an event the controller reported on the HPA, e.g.
+// SuccessfulRescale or FailedGetResourceMetric.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	Reason  string    `json:"reason"`
+	Message string    `json:"message"`
+	Count   int32     `json:"count"`
+}
+
+// Timeline is the recorded history of one HPA.
+type Timeline struct {
+	ClusterID string `json:"clusterId"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Samples and Events are newest first.
+	Samples []Sample `json:"samples"`
+	Events  []Event  `json:"events"`
+	// Rescales counts desired-replica changes among the samples;
+	// DirectionChanges counts the times scaling reversed (up then down or
+	// down then up), the signature of an oscillating autoscaler.
+	Rescales         int `json:"rescales"`
+	DirectionChanges int `json:"directionChanges"`
+	// ObservedSince is when the recorder started watching the cluster's HPAs.
+	ObservedSince time.Time `json:"observedSince"`
+}
+
+// history is what is kept for one HPA.
+type history struct {
+	samples []Sample
+	events  []Event
+}
+
+// Recorder keeps HPA samples and events for this session. Replica changes are
+// always recorded; a change in metric values alone is recorded at most once
+// per metricInterval, since the controller refreshes them every sync. It is
+// safe for concurrent use.
+type Recorder struct {
+	mu             sync.Mutex
+	now            func() time.Time
+	retention      time.Duration
+	limit          int
+	metricInterval time.Duration
+	// histories maps clusterID|namespace/name to the HPA's history.
+	histories map[string]*history
+	// eventCounts maps clusterID|event UID to the last count recorded, so a
+	// repeated event is recorded once per increment.
+	eventCounts map[string]int32
+	since       map[string]time.Time
+}
+
+// NewRecorder returns a recorder that keeps samples and events for retention
+// and at most limit of each per HPA.
+func NewRecorder(retention time.Duration, limit int, metricInterval time.Duration, now func() time.Time) *Recorder {
+	if now == nil {
+		now = time.Now
+	}
+	return &Recorder{
+		now:            now,
+		retention:      retention,
+		limit:          limit,
+		metricInterval: metricInterval,
+		histories:      make(map[string]*history),
+		eventCounts:    make(map[string]int32),
+		since:          make(map[string]time.Time),
+	}
+}
+
+// HPAHandler returns the informer handler that feeds one cluster's
+// autoscaling/v2 HPAs into the recorder.
+func (r *Recorder) HPAHandler(clusterID string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { r.observeObject(clusterID, obj) },
+		UpdateFunc: func(_, obj interface{}) { r.observeObject(clusterID, obj) },
+	}
+}
+
+// EventHandler returns the informer handler that records one cluster's events
+// whose involved object is an HPA.
+func (r *Recorder) EventHandler(clusterID string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { r.observeEventObject(clusterID, obj) },
+		UpdateFunc: func(_, obj interface{}) { r.observeEventObject(clusterID, obj) },
+	}
+}
+
+func (r *Recorder) observeObject(clusterID string, obj interface{}) {
+	if h, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+		r.ObserveHPA(clusterID, h)
+	}
+}
+
+func (r *Recorder) observeEventObject(clusterID string, obj interface{}) {
+	if event, ok := obj.(*corev1.Event); ok {
+		r.ObserveEvent(clusterID, event)
+	}
+}
+
+// ObserveHPA records the HPA's status when it differs from the last sample.
+func (r *Recorder) ObserveHPA(clusterID string, h *autoscalingv2.HorizontalPodAutoscaler) {
+	if h == nil {
+		return
+	}
+	facts := hpa.BuildFacts(clusterID, h)
+	minReplicas := int32(1)
+	if facts.MinReplicas != nil {
+		minReplicas = *facts.MinReplicas
+	}
+	now := r.now()
+	sample := Sample{
+		Time:            now,
+		CurrentReplicas: facts.CurrentReplicas,
+		DesiredReplicas: facts.DesiredReplicas,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     facts.MaxReplicas,
+		Metrics:         facts.CurrentMetrics,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.markObservedLocked(clusterID)
+	entry := r.historyLocked(clusterID, h.Namespace, h.Name)
+	if n := len(entry.samples); n > 0 {
+		last := entry.samples[n-1]
+		if sameReplicas(last, sample) {
+			if metricsEqual(last.Metrics, sample.Metrics) || now.Sub(last.Time) < r.metricInterval {
+				return
+			}
+		}
+	}
+	entry.samples = append(entry.samples, sample)
+	entry.samples = trimmed(entry.samples, now.Add(-r.retention), r.limit, func(s Sample) time.Time { return s.Time })
+}
+
+// ObserveEvent records an event about an HPA, once per occurrence.
+func (r *Recorder) ObserveEvent(clusterID string, event *corev1.Event) {
+	if event == nil || event.InvolvedObject.Kind != hpa.Identity.Kind {
+		return
+	}
+	count := event.Count
+	if count == 0 {
+		count = 1
+	}
+	occurred := eventTime(event)
+	if occurred.IsZero() {
+		occurred = r.now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.markObservedLocked(clusterID)
+	countKey := clusterID + "|" + string(event.UID)
+	if previous, ok := r.eventCounts[countKey]; ok && count <= previous {
+		return
+	}
+	r.eventCounts[countKey] = count
+	cutoff := r.now().Add(-r.retention)
+	if occurred.Before(cutoff) {
+		return
+	}
+	entry := r.historyLocked(clusterID, event.InvolvedObject.Namespace, event.InvolvedObject.Name)
+	entry.events = append(entry.events, Event{
+		Time:    occurred,
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Count:   count,
+	})
+	entry.events = trimmed(entry.events, cutoff, r.limit, func(e Event) time.Time { return e.Time })
+}
+
+// ForgetCluster drops a removed cluster's history.
+func (r *Recorder) ForgetCluster(clusterID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prefix := clusterID + "|"
+	for key := range r.histories {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.histories, key)
+		}
+	}
+	for key := range r.eventCounts {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.eventCounts, key)
+		}
+	}
+	delete(r.since, clusterID)
+}
+
+// Timeline returns an HPA's recorded samples and events, newest first.
+func (r *Recorder) Timeline(clusterID, namespace, name string) Timeline {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timeline := Timeline{
+		ClusterID:     clusterID,
+		Namespace:     namespace,
+		Name:          name,
+		Samples:       []Sample{},
+		Events:        []Event{},
+		ObservedSince: r.since[clusterID],
+	}
+	entry := r.histories[historyKey(clusterID, namespace, name)]
+	if entry == nil {
+		return timeline
+	}
+	cutoff := r.now().Add(-r.retention)
+	for _, sample := range entry.samples {
+		if sample.Time.After(cutoff) {
+			timeline.Samples = append(timeline.Samples, sample)
+		}
+	}
+	for _, event := range entry.events {
+		if event.Time.After(cutoff) {
+			timeline.Events = append(timeline.Events, event)
+		}
+	}
+	timeline.Rescales, timeline.DirectionChanges = scalingChanges(timeline.Samples)
+	slices.Reverse(timeline.Samples)
+	slices.Reverse(timeline.Events)
+	return timeline
+}
+
+// scalingChanges counts desired-replica changes and direction reversals in
+// samples ordered oldest first.
+func scalingChanges(samples []Sample) (rescales, reversals int) {
+	direction := 0
+	for i := 1; i < len(samples); i++ {
+		delta := samples[i].DesiredReplicas - samples[i-1].DesiredReplicas
+		if delta == 0 {
+			continue
+		}
+		rescales++
+		next := 1
+		if delta < 0 {
+			next = -1
+		}
+		if direction != 0 && next != direction {
+			reversals++
+		}
+		direction = next
+	}
+	return rescales, reversals
+}
+
+func (r *Recorder) markObservedLocked(clusterID string) {
+	if _, ok := r.since[clusterID]; !ok {
+		r.since[clusterID] = r.now()
+	}
+}
+
+func (r *Recorder) historyLocked(clusterID, namespace, name string) *history {
+	key := historyKey(clusterID, namespace, name)
+	entry := r.histories[key]
+	if entry == nil {
+		entry = &history{}
+		r.histories[key] = entry
+	}
+	return entry
+}
+
+func historyKey(clusterID, namespace, name string) string {
+	return clusterID + "|" + namespace + "/" + name
+}
+
+func sameReplicas(a, b Sample) bool {
+	return a.CurrentReplicas == b.CurrentReplicas && a.DesiredReplicas == b.DesiredReplicas &&
+		a.MinReplicas == b.MinReplicas && a.MaxReplicas == b.MaxReplicas
+}
+
+func metricsEqual(a, b []hpa.MetricStatusFacts) bool {
+	return slices.EqualFunc(a, b, func(x, y hpa.MetricStatusFacts) bool {
+		return x.Kind == y.Kind && maps.Equal(x.Current, y.Current)
+	})
+}
+
+// eventTime is when the event last occurred, preferring the newest timestamp
+// the reporter set.
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.FirstTimestamp.Time
+	}
+}
+
+// trimmed sorts items oldest first and drops those at or before cutoff and
+// beyond limit.
+func trimmed[T any](items []T, cutoff time.Time, limit int, at func(T) time.Time) []T {
+	slices.SortStableFunc(items, func(a, b T) int { return at(a).Compare(at(b)) })
+	start := 0
+	for start < len(items) && !at(items[start]).After(cutoff) {
+		start++
+	}
+	if limit > 0 && len(items)-start > limit {
+		start = len(items) - limit
+	}
+	return slices.Clone(items[start:])
+}
@@ -0,0 +1,119 @@
+package hpahistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/hpahistory"
+)
+
+func autoscaler(current, desired, utilization int32) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "api"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			MinReplicas: ptr.To(int32(2)),
+			MaxReplicas: 10,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: current,
+			DesiredReplicas: desired,
+			CurrentMetrics: []autoscalingv2.MetricStatus{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    corev1.ResourceCPU,
+					Current: autoscalingv2.MetricValueStatus{AverageUtilization: ptr.To(utilization)},
+				},
+			}},
+		},
+	}
+}
+
+func hpaEvent(uid, reason string, count int32, at time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{UID: types.UID(uid)},
+		InvolvedObject: corev1.ObjectReference{Kind: "HorizontalPodAutoscaler", Namespace: "prod", Name: "api"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		Message:        "New size: 4; reason: cpu resource utilization (percentage of request) above target",
+		Count:          count,
+		LastTimestamp:  metav1.NewTime(at),
+	}
+}
+
+func TestRecorderRecordsScalingChanges(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	recorder := hpahistory.NewRecorder(24*time.Hour, 100, time.Minute, clock)
+
+	recorder.ObserveHPA("c1", autoscaler(2, 2, 40))
+	// A metric-only change inside the interval is not recorded.
+	now = now.Add(15 * time.Second)
+	recorder.ObserveHPA("c1", autoscaler(2, 2, 55))
+	// A replica change always is.
+	now = now.Add(15 * time.Second)
+	recorder.ObserveHPA("c1", autoscaler(2, 4, 90))
+	now = now.Add(time.Minute)
+	recorder.ObserveHPA("c1", autoscaler(4, 4, 90))
+	// Unchanged status records nothing.
+	now = now.Add(time.Minute)
+	recorder.ObserveHPA("c1", autoscaler(4, 4, 90))
+	// Once the interval has passed, a metric-only change is recorded.
+	recorder.ObserveHPA("c1", autoscaler(4, 4, 60))
+	now = now.Add(time.Minute)
+	recorder.ObserveHPA("c1", autoscaler(4, 3, 20))
+	now = now.Add(time.Minute)
+	recorder.ObserveHPA("c1", autoscaler(3, 5, 95))
+
+	timeline := recorder.Timeline("c1", "prod", "api")
+	require.Len(t, timeline.Samples, 6)
+	require.Equal(t, int32(5), timeline.Samples[0].DesiredReplicas)
+	require.Equal(t, int32(2), timeline.Samples[5].DesiredReplicas)
+	require.Equal(t, int32(2), timeline.Samples[5].MinReplicas)
+	require.Equal(t, "40%", timeline.Samples[5].Metrics[0].Current["averageUtilization"])
+	// 2→4 up, 4→3 down, 3→5 up.
+	require.Equal(t, 3, timeline.Rescales)
+	require.Equal(t, 2, timeline.DirectionChanges)
+	require.Equal(t, time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC), timeline.ObservedSince)
+
+	require.Empty(t, recorder.Timeline("c2", "prod", "api").Samples)
+}
+
+func TestRecorderRecordsEventsOncePerOccurrence(t *testing.T) {
+	now := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	recorder := hpahistory.NewRecorder(time.Hour, 2, time.Minute, func() time.Time { return now })
+
+	recorder.ObserveEvent("c1", hpaEvent("e1", "SuccessfulRescale", 1, now.Add(-30*time.Minute)))
+	// A resync delivers the same event again.
+	recorder.ObserveEvent("c1", hpaEvent("e1", "SuccessfulRescale", 1, now.Add(-30*time.Minute)))
+	recorder.ObserveEvent("c1", hpaEvent("e2", "FailedGetResourceMetric", 1, now.Add(-20*time.Minute)))
+	recorder.ObserveEvent("c1", hpaEvent("e2", "FailedGetResourceMetric", 2, now.Add(-10*time.Minute)))
+	// Events outside the retention window and about other kinds are ignored.
+	recorder.ObserveEvent("c1", hpaEvent("e3", "SuccessfulRescale", 1, now.Add(-2*time.Hour)))
+	other := hpaEvent("e4", "ScalingReplicaSet", 1, now)
+	other.InvolvedObject.Kind = "Deployment"
+	recorder.ObserveEvent("c1", other)
+
+	timeline := recorder.Timeline("c1", "prod", "api")
+	// The limit keeps the newest two.
+	require.Len(t, timeline.Events, 2)
+	require.Equal(t, int32(2), timeline.Events[0].Count)
+	require.Equal(t, "FailedGetResourceMetric", timeline.Events[1].Reason)
+
+	recorder.ObserveHPA("c1", autoscaler(2, 2, 40))
+	now = now.Add(2 * time.Hour)
+	require.Empty(t, recorder.Timeline("c1", "prod", "api").Samples)
+	require.Empty(t, recorder.Timeline("c1", "prod", "api").Events)
+
+	recorder.ObserveHPA("c1", autoscaler(2, 2, 40))
+	recorder.ForgetCluster("c1")
+	timeline = recorder.Timeline("c1", "prod", "api")
+	require.Empty(t, timeline.Samples)
+	require.True(t, timeline.ObservedSince.IsZero())
+}
@@ -0,0 +1,210 @@
+// Package idleworkloads finds workloads whose pods have done nothing for a
+// while: every pod's CPU stayed at or under a threshold and no container
+// restarted across the whole window. On dev clusters these are the
+// deployments left running after the work moved on, and the list is a
+// starting point for scaling them to zero or deleting them.
+package idleworkloads
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/metrics"
+)
+
+// Suggested actions.
+const (
+	ActionScaleToZero = "scale-to-zero"
+	ActionDelete      = "delete"
+)
+
+// scalableKinds can be scaled to zero replicas; anything else is suggested
+// for deletion.
+var scalableKinds = map[string]bool{
+	"Deployment":            true,
+	"StatefulSet":           true,
+	"ReplicaSet":            true,
+	"ReplicationController": true,
+}
+
+// batchKinds run to completion on their own and are never candidates.
+var batchKinds = map[string]bool{"Job": true, "CronJob": true}
+
+// Options configures a detection. Zero values take the configured defaults.
+type Options struct {
+	WindowMinutes     int   `json:"windowMinutes,omitempty"`
+	CPUThresholdMilli int64 `json:"cpuThresholdMilli,omitempty"`
+}
+
+// Candidate is one idle workload, or a bare pod.
+type Candidate struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Action    string `json:"action"`
+	Pods      int    `json:"pods"`
+	// PeakCPUMilli and PeakMemoryBytes are the highest single-pod usage seen
+	// in the window.
+	PeakCPUMilli    int64 `json:"peakCpuMilli"`
+	PeakMemoryBytes int64 `json:"peakMemoryBytes"`
+	// IdleSince is the oldest history bucket from which every pod has been idle.
+	IdleSince time.Time `json:"idleSince"`
+}
+
+// Report is the outcome of one detection.
+type Report struct {
+	ClusterID         string `json:"clusterId"`
+	WindowMinutes     int    `json:"windowMinutes"`
+	CPUThresholdMilli int64  `json:"cpuThresholdMilli"`
+	// HistoryStart is the oldest recorded metrics bucket; zero when none.
+	HistoryStart time.Time `json:"historyStart"`
+	// CoveragePercent is the share of the window's buckets with a metrics
+	// collection. Metrics are only collected while a metrics view is open, so
+	// a low value means the verdicts rest on few samples.
+	CoveragePercent int `json:"coveragePercent"`
+	// Unobserved counts workloads left out because a pod's history does not
+	// reach back to the window start.
+	Unobserved int         `json:"unobserved"`
+	Candidates []Candidate `json:"candidates"`
+}
+
+// workload groups the running pods of one owner.
+type workload struct {
+	candidate Candidate
+	pods      []streamrows.PodAggregate
+	excluded  bool
+}
+
+// Detect evaluates every running pod's history over the window ending at now
+// and returns the workloads whose pods were all idle. A workload with a pod
+// that is not running, restarted in the window, or was not observed since
+// the window start is not a candidate.
+func Detect(clusterID string, pods []streamrows.PodAggregate, history *metrics.History, window time.Duration, cpuThresholdMilli int64, now time.Time) Report {
+	report := Report{
+		ClusterID:         clusterID,
+		WindowMinutes:     int(window / time.Minute),
+		CPUThresholdMilli: cpuThresholdMilli,
+		Candidates:        []Candidate{},
+	}
+	if history == nil {
+		return report
+	}
+	windowStart := now.Add(-window)
+	oldest, covered, total := history.Coverage(windowStart)
+	report.HistoryStart = oldest
+	if total > 0 {
+		report.CoveragePercent = covered * 100 / total
+	}
+
+	workloads := groupByOwner(pods)
+	keys := make([]string, 0, len(workloads))
+	for key := range workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		w := workloads[key]
+		if w.excluded || len(w.pods) == 0 {
+			continue
+		}
+		idle, observed := true, true
+		for _, pod := range w.pods {
+			if restartedSince(pod, windowStart) {
+				idle = false
+				break
+			}
+			points := history.Pod(pod.Namespace+"/"+pod.Name, windowStart.Add(-history.Resolution()))
+			if len(points) == 0 || points[0].At.After(windowStart) {
+				observed = false
+				break
+			}
+			idleSince, peakCPU, peakMemory, ok := idleStreak(points, cpuThresholdMilli)
+			if !ok || idleSince.After(windowStart) {
+				idle = false
+				break
+			}
+			w.candidate.PeakCPUMilli = max(w.candidate.PeakCPUMilli, peakCPU)
+			w.candidate.PeakMemoryBytes = max(w.candidate.PeakMemoryBytes, peakMemory)
+			if idleSince.After(w.candidate.IdleSince) {
+				w.candidate.IdleSince = idleSince
+			}
+		}
+		switch {
+		case !idle:
+		case !observed:
+			report.Unobserved++
+		default:
+			w.candidate.Pods = len(w.pods)
+			report.Candidates = append(report.Candidates, w.candidate)
+		}
+	}
+	return report
+}
+
+// groupByOwner groups running pods by controlling owner. Pods of batch kinds
+// are skipped, and an owner with a pending or unknown-phase pod is excluded,
+// since it is still trying to do something.
+func groupByOwner(pods []streamrows.PodAggregate) map[string]*workload {
+	workloads := make(map[string]*workload)
+	for _, pod := range pods {
+		kind, name := "Pod", pod.Name
+		if owner := strings.TrimPrefix(pod.OwnerKey, pod.Namespace+"/"); pod.OwnerKey != "" {
+			kind, name, _ = strings.Cut(owner, "/")
+		}
+		if batchKinds[kind] {
+			continue
+		}
+		switch corev1.PodPhase(pod.Phase) {
+		case corev1.PodSucceeded, corev1.PodFailed:
+			continue
+		}
+		key := pod.Namespace + "/" + kind + "/" + name
+		w, ok := workloads[key]
+		if !ok {
+			action := ActionDelete
+			if scalableKinds[kind] {
+				action = ActionScaleToZero
+			}
+			w = &workload{candidate: Candidate{Kind: kind, Namespace: pod.Namespace, Name: name, Action: action}}
+			workloads[key] = w
+		}
+		if corev1.PodPhase(pod.Phase) != corev1.PodRunning {
+			w.excluded = true
+			continue
+		}
+		w.pods = append(w.pods, pod)
+	}
+	return workloads
+}
+
+// restartedSince reports whether a container's last termination ended after
+// since, which is a restart within the window.
+func restartedSince(pod streamrows.PodAggregate, since time.Time) bool {
+	for _, termination := range pod.LastTerminations {
+		if termination.FinishedAt.After(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// idleStreak walks the points back from the newest while CPU stays at or
+// under the threshold and returns where the streak starts and its peaks. ok is
+// false when the newest point is already busy.
+func idleStreak(points []metrics.PodPoint, cpuThresholdMilli int64) (since time.Time, peakCPU, peakMemory int64, ok bool) {
+	for i := len(points) - 1; i >= 0; i-- {
+		point := points[i]
+		if point.PeakCPUMilli > cpuThresholdMilli {
+			break
+		}
+		since, ok = point.At, true
+		peakCPU = max(peakCPU, point.PeakCPUMilli)
+		peakMemory = max(peakMemory, point.PeakMemoryBytes)
+	}
+	return since, peakCPU, peakMemory, ok
+}
@@ -0,0 +1,67 @@
+package idleworkloads
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/metrics"
+)
+
+func TestDetectFlagsIdleWorkloads(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	history := metrics.NewHistory(4*time.Hour, 5*time.Minute)
+	for i := 0; i <= 24; i++ {
+		usage := map[string]metrics.PodUsage{
+			"dev/idle-abc":    {CPUUsageMilli: 2, MemoryUsageBytes: 64},
+			"dev/busy-abc":    {CPUUsageMilli: 250},
+			"dev/restart-abc": {CPUUsageMilli: 1},
+			"dev/bare":        {CPUUsageMilli: 0},
+			"dev/woke-abc":    {CPUUsageMilli: 1},
+		}
+		if i == 24 {
+			usage["dev/woke-abc"] = metrics.PodUsage{CPUUsageMilli: 80}
+		}
+		if i >= 20 {
+			usage["dev/late-abc"] = metrics.PodUsage{}
+		}
+		history.Record(metrics.Sample{PodUsage: usage, Metadata: metrics.Metadata{CollectedAt: start.Add(time.Duration(i) * 5 * time.Minute)}})
+	}
+	now := start.Add(2 * time.Hour)
+
+	pod := func(name, owner string) streamrows.PodAggregate {
+		return streamrows.PodAggregate{Namespace: "dev", Name: name, Phase: "Running", OwnerKey: owner}
+	}
+	restarted := pod("restart-abc", "dev/Deployment/restart")
+	restarted.LastTerminations = []streamrows.ContainerTermination{{Container: "app", FinishedAt: now.Add(-10 * time.Minute)}}
+	pending := pod("idle-def", "dev/Deployment/idle")
+	pending.Phase = "Pending"
+	pods := []streamrows.PodAggregate{
+		pod("idle-abc", "dev/Deployment/idle"),
+		pod("busy-abc", "dev/Deployment/busy"),
+		restarted,
+		pod("bare", ""),
+		pod("woke-abc", "dev/StatefulSet/woke"),
+		pod("late-abc", "dev/Deployment/late"),
+		pod("job-abc", "dev/Job/job"),
+	}
+
+	report := Detect("c1", pods, history, time.Hour, 5, now)
+	require.Equal(t, 100, report.CoveragePercent)
+	require.Equal(t, start, report.HistoryStart)
+	require.Equal(t, 1, report.Unobserved)
+	require.Equal(t, []Candidate{
+		{Kind: "Deployment", Namespace: "dev", Name: "idle", Action: ActionScaleToZero, Pods: 1, PeakCPUMilli: 2, PeakMemoryBytes: 64, IdleSince: start.Add(55 * time.Minute)},
+		{Kind: "Pod", Namespace: "dev", Name: "bare", Action: ActionDelete, Pods: 1, IdleSince: start.Add(55 * time.Minute)},
+	}, report.Candidates)
+
+	// A pending replica means the owner is still trying to do something.
+	report = Detect("c1", append(pods, pending), history, time.Hour, 5, now)
+	require.Len(t, report.Candidates, 1)
+	require.Equal(t, "bare", report.Candidates[0].Name)
+
+	report = Detect("c1", pods, nil, time.Hour, 5, now)
+	require.Empty(t, report.Candidates)
+}
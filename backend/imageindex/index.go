@@ -0,0 +1,326 @@
+// Package imageindex indexes the container images of streamed pods so a search
+// such as "where is this image still running" is answered from memory.
+package imageindex
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+// ContainerMatch is one container whose image matched the query.
+type ContainerMatch struct {
+	Container string `json:"container"`
+	Init      bool   `json:"init,omitempty"`
+	Image     string `json:"image"`
+	// ImageID is the resolved image the kubelet reports, usually a digest
+	// reference; empty until the image has been pulled.
+	ImageID string `json:"imageId,omitempty"`
+}
+
+// PodMatch is a pod running at least one matching container.
+type PodMatch struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	NodeName  string `json:"nodeName,omitempty"`
+	// WorkloadKind and WorkloadName identify the owning workload; empty for
+	// standalone pods.
+	WorkloadKind string           `json:"workloadKind,omitempty"`
+	WorkloadName string           `json:"workloadName,omitempty"`
+	Containers   []ContainerMatch `json:"containers"`
+}
+
+// WorkloadMatch is a workload with pods running a matching image.
+type WorkloadMatch struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Pods      int    `json:"pods"`
+	// Images lists the distinct matching image references, sorted.
+	Images []string `json:"images"`
+}
+
+// Result is the answer to one image search in one cluster.
+type Result struct {
+	ClusterID string          `json:"clusterId"`
+	Query     string          `json:"query"`
+	Pods      []PodMatch      `json:"pods"`
+	Workloads []WorkloadMatch `json:"workloads"`
+	// Truncated reports that more pods matched than were returned.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Query is a parsed image search: registry/repo[:tag|@digest], where each part
+// may use * as a wildcard.
+type Query struct {
+	raw    string
+	repo   *regexp.Regexp
+	tag    *regexp.Regexp
+	digest *regexp.Regexp
+}
+
+// ParseQuery parses an image search such as "nginx", "docker.io/library/nginx:1.*",
+// "*/log4j*" or "registry.example.com/app@sha256:ab*". Matching is case-insensitive.
+// A repository without a registry also matches images from any registry.
+func ParseQuery(raw string) (Query, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return Query{}, fmt.Errorf("image query is required")
+	}
+	name, tag, digest := splitReference(strings.ToLower(trimmed))
+	if name == "" {
+		return Query{}, fmt.Errorf("image query %q has no repository", raw)
+	}
+	query := Query{raw: trimmed, repo: globPattern(name)}
+	if tag != "" {
+		query.tag = globPattern(tag)
+	}
+	if digest != "" {
+		query.digest = globPattern(digest)
+	}
+	return query, nil
+}
+
+// String returns the query as written.
+func (q Query) String() string {
+	return q.raw
+}
+
+// matches reports whether a container's image (or its resolved imageID) matches.
+func (q Query) matches(image streamrows.ContainerImage) bool {
+	name, tag, digest := splitReference(strings.ToLower(image.Image))
+	if !slices.ContainsFunc(repositoryForms(name), q.repo.MatchString) {
+		return false
+	}
+	if q.tag != nil {
+		if tag == "" && digest == "" {
+			tag = "latest"
+		}
+		if !q.tag.MatchString(tag) {
+			return false
+		}
+	}
+	if q.digest != nil {
+		resolved := imageIDDigest(strings.ToLower(image.ImageID))
+		if !(digest != "" && q.digest.MatchString(digest)) && !(resolved != "" && q.digest.MatchString(resolved)) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitReference splits an image reference into its name, tag and digest. A
+// colon is a tag separator only after the last slash, so registry ports survive.
+func splitReference(ref string) (name, tag, digest string) {
+	name, digest, _ = strings.Cut(ref, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	return name, tag, digest
+}
+
+// repositoryForms returns the ways an image name may be written: fully qualified
+// (docker.io/library/nginx), without the registry (library/nginx), and for Docker
+// Hub official images the bare name (nginx).
+func repositoryForms(name string) []string {
+	registry, path, hasRegistry := strings.Cut(name, "/")
+	if !hasRegistry || !(strings.ContainsAny(registry, ".:") || registry == "localhost") {
+		registry, path = "docker.io", name
+		if !strings.Contains(path, "/") {
+			path = "library/" + path
+		}
+	}
+	forms := []string{registry + "/" + path, path}
+	if registry == "docker.io" {
+		if bare, ok := strings.CutPrefix(path, "library/"); ok {
+			forms = append(forms, bare)
+		}
+	}
+	return forms
+}
+
+// imageIDDigest extracts the digest from a container status imageID, which runtimes
+// report as "repo@sha256:…", "docker-pullable://repo@sha256:…" or a bare "sha256:…".
+func imageIDDigest(imageID string) string {
+	if _, digest, ok := strings.Cut(imageID, "@"); ok {
+		return digest
+	}
+	if strings.HasPrefix(imageID, "sha256:") {
+		return imageID
+	}
+	return ""
+}
+
+// globPattern compiles a pattern where * matches any run of characters.
+func globPattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// podImages is the indexed state of one pod.
+type podImages struct {
+	namespace string
+	name      string
+	nodeName  string
+	ownerKey  string
+	images    []streamrows.ContainerImage
+}
+
+// Index keeps the container images of every streamed pod, per cluster. It is
+// safe for concurrent use.
+type Index struct {
+	mu sync.RWMutex
+	// pods maps clusterID to namespace/name to the pod's images.
+	pods map[string]map[string]podImages
+}
+
+// NewIndex returns an empty index.
+func NewIndex() *Index {
+	return &Index{pods: make(map[string]map[string]podImages)}
+}
+
+// Sink returns the ingest bundle sink that feeds one cluster's pods into the index.
+func (x *Index) Sink(clusterID string) ingest.BundleSink {
+	return indexSink{index: x, clusterID: clusterID}
+}
+
+// ForgetCluster drops a removed cluster's pods.
+func (x *Index) ForgetCluster(clusterID string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	delete(x.pods, clusterID)
+}
+
+// Search returns the pods in a cluster running a matching image, at most limit of
+// them (0 for no limit), and the workloads those pods belong to.
+func (x *Index) Search(clusterID string, query Query, limit int) Result {
+	result := Result{ClusterID: clusterID, Query: query.String(), Pods: []PodMatch{}, Workloads: []WorkloadMatch{}}
+	workloads := make(map[string]*WorkloadMatch)
+
+	x.mu.RLock()
+	for _, pod := range x.pods[clusterID] {
+		match := PodMatch{Namespace: pod.namespace, Name: pod.name, NodeName: pod.nodeName}
+		for _, image := range pod.images {
+			if query.matches(image) {
+				match.Containers = append(match.Containers, ContainerMatch{
+					Container: image.Container,
+					Init:      image.Init,
+					Image:     image.Image,
+					ImageID:   image.ImageID,
+				})
+			}
+		}
+		if len(match.Containers) == 0 {
+			continue
+		}
+		if namespace, kind, name, ok := splitOwnerKey(pod.ownerKey); ok {
+			match.WorkloadKind, match.WorkloadName = kind, name
+			workload := workloads[pod.ownerKey]
+			if workload == nil {
+				workload = &WorkloadMatch{Namespace: namespace, Kind: kind, Name: name}
+				workloads[pod.ownerKey] = workload
+			}
+			workload.Pods++
+			for _, container := range match.Containers {
+				if !slices.Contains(workload.Images, container.Image) {
+					workload.Images = append(workload.Images, container.Image)
+				}
+			}
+		}
+		result.Pods = append(result.Pods, match)
+	}
+	x.mu.RUnlock()
+
+	slices.SortFunc(result.Pods, func(a, b PodMatch) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	if limit > 0 && len(result.Pods) > limit {
+		result.Pods = result.Pods[:limit]
+		result.Truncated = true
+	}
+	for _, workload := range workloads {
+		slices.Sort(workload.Images)
+		result.Workloads = append(result.Workloads, *workload)
+	}
+	slices.SortFunc(result.Workloads, func(a, b WorkloadMatch) int {
+		return strings.Compare(a.Namespace+"/"+a.Kind+"/"+a.Name, b.Namespace+"/"+b.Kind+"/"+b.Name)
+	})
+	return result
+}
+
+// splitOwnerKey splits the pod aggregate's namespace/Kind/name owner key.
+func splitOwnerKey(key string) (namespace, kind, name string, ok bool) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
+func podKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func (x *Index) upsertLocked(clusterID string, agg streamrows.PodAggregate) {
+	pods := x.pods[clusterID]
+	if pods == nil {
+		pods = make(map[string]podImages)
+		x.pods[clusterID] = pods
+	}
+	pods[podKey(agg.Namespace, agg.Name)] = podImages{
+		namespace: agg.Namespace,
+		name:      agg.Name,
+		nodeName:  agg.NodeName,
+		ownerKey:  agg.OwnerKey,
+		images:    agg.Images,
+	}
+}
+
+// indexSink adapts the index to one cluster's pod ingest store.
+type indexSink struct {
+	index     *Index
+	clusterID string
+}
+
+func (s indexSink) UpsertBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.PodAggregate)
+	if !ok {
+		return
+	}
+	s.index.mu.Lock()
+	defer s.index.mu.Unlock()
+	s.index.upsertLocked(s.clusterID, agg)
+}
+
+func (s indexSink) DeleteBundle(bundle ingest.Bundle) {
+	agg, ok := bundle.Aggregate.(streamrows.PodAggregate)
+	if !ok {
+		return
+	}
+	s.index.mu.Lock()
+	defer s.index.mu.Unlock()
+	delete(s.index.pods[s.clusterID], podKey(agg.Namespace, agg.Name))
+}
+
+// ReplaceBundles rebuilds the cluster's pods from a relist's complete set.
+func (s indexSink) ReplaceBundles(bundles []ingest.Bundle) {
+	s.index.mu.Lock()
+	defer s.index.mu.Unlock()
+	delete(s.index.pods, s.clusterID)
+	for _, bundle := range bundles {
+		if agg, ok := bundle.Aggregate.(streamrows.PodAggregate); ok {
+			s.index.upsertLocked(s.clusterID, agg)
+		}
+	}
+}
+
+var _ ingest.BundleSink = indexSink{}
+var _ ingest.BundleReplaceSink = indexSink{}
@@ -0,0 +1,130 @@
+package imageindex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/imageindex"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+)
+
+func podBundle(namespace, name, owner string, images ...streamrows.ContainerImage) ingest.Bundle {
+	return ingest.Bundle{Aggregate: streamrows.PodAggregate{
+		Namespace: namespace,
+		Name:      name,
+		NodeName:  "node-1",
+		OwnerKey:  owner,
+		Images:    images,
+	}}
+}
+
+func image(container, ref, imageID string) streamrows.ContainerImage {
+	return streamrows.ContainerImage{Container: container, Image: ref, ImageID: imageID}
+}
+
+func search(t *testing.T, index *imageindex.Index, raw string) imageindex.Result {
+	t.Helper()
+	query, err := imageindex.ParseQuery(raw)
+	require.NoError(t, err)
+	return index.Search("c1", query, 0)
+}
+
+func podNames(result imageindex.Result) []string {
+	names := make([]string, 0, len(result.Pods))
+	for _, pod := range result.Pods {
+		names = append(names, pod.Namespace+"/"+pod.Name)
+	}
+	return names
+}
+
+func newTestIndex() *imageindex.Index {
+	index := imageindex.NewIndex()
+	sink := index.Sink("c1")
+	sink.UpsertBundle(podBundle("prod", "api-1", "prod/Deployment/api",
+		image("app", "registry.example.com/team/log4j-app:2.14", "registry.example.com/team/log4j-app@sha256:aaa111"),
+		image("proxy", "nginx:1.25", "docker.io/library/nginx@sha256:bbb222"),
+	))
+	sink.UpsertBundle(podBundle("prod", "api-2", "prod/Deployment/api",
+		image("app", "registry.example.com/team/log4j-app:2.17", ""),
+	))
+	sink.UpsertBundle(podBundle("ops", "debug", "",
+		image("shell", "docker.io/library/busybox", "docker-pullable://busybox@sha256:ccc333"),
+	))
+	return index
+}
+
+func TestSearchMatchesRepositoryForms(t *testing.T) {
+	index := newTestIndex()
+
+	require.Equal(t, []string{"prod/api-1"}, podNames(search(t, index, "nginx")))
+	require.Equal(t, []string{"prod/api-1"}, podNames(search(t, index, "library/nginx")))
+	require.Equal(t, []string{"prod/api-1"}, podNames(search(t, index, "docker.io/library/nginx:1.25")))
+	require.Equal(t, []string{"ops/debug"}, podNames(search(t, index, "busybox:latest")))
+	require.Empty(t, search(t, index, "nginx:1.24").Pods)
+}
+
+func TestSearchWildcardsAndWorkloads(t *testing.T) {
+	index := newTestIndex()
+
+	result := search(t, index, "*/log4j*:2.1[0-6]*")
+	require.Empty(t, result.Pods, "brackets are literal, not a character class")
+
+	result = search(t, index, "*log4j*:2.14")
+	require.Equal(t, []string{"prod/api-1"}, podNames(result))
+	require.Equal(t, "Deployment", result.Pods[0].WorkloadKind)
+	require.Equal(t, "api", result.Pods[0].WorkloadName)
+	require.Equal(t, []imageindex.ContainerMatch{{
+		Container: "app",
+		Image:     "registry.example.com/team/log4j-app:2.14",
+		ImageID:   "registry.example.com/team/log4j-app@sha256:aaa111",
+	}}, result.Pods[0].Containers)
+
+	result = search(t, index, "REGISTRY.example.com/*")
+	require.Equal(t, []string{"prod/api-1", "prod/api-2"}, podNames(result))
+	require.Equal(t, []imageindex.WorkloadMatch{{
+		Namespace: "prod",
+		Kind:      "Deployment",
+		Name:      "api",
+		Pods:      2,
+		Images:    []string{"registry.example.com/team/log4j-app:2.14", "registry.example.com/team/log4j-app:2.17"},
+	}}, result.Workloads)
+}
+
+func TestSearchMatchesResolvedDigest(t *testing.T) {
+	index := newTestIndex()
+
+	require.Equal(t, []string{"prod/api-1"}, podNames(search(t, index, "*@sha256:bbb*")))
+	require.Equal(t, []string{"ops/debug"}, podNames(search(t, index, "busybox@sha256:ccc333")))
+	require.Empty(t, search(t, index, "nginx@sha256:ccc333").Pods)
+}
+
+func TestSearchLimitAndSinkLifecycle(t *testing.T) {
+	index := newTestIndex()
+	query, err := imageindex.ParseQuery("*")
+	require.NoError(t, err)
+
+	limited := index.Search("c1", query, 2)
+	require.Len(t, limited.Pods, 2)
+	require.True(t, limited.Truncated)
+	require.Equal(t, 2, limited.Workloads[0].Pods, "workloads count every matching pod")
+
+	sink := index.Sink("c1").(ingest.BundleReplaceSink)
+	sink.ReplaceBundles([]ingest.Bundle{podBundle("ops", "debug", "", image("shell", "busybox", ""))})
+	require.Equal(t, []string{"ops/debug"}, podNames(index.Search("c1", query, 0)))
+
+	index.Sink("c1").DeleteBundle(podBundle("ops", "debug", ""))
+	require.Empty(t, index.Search("c1", query, 0).Pods)
+
+	index.Sink("c1").UpsertBundle(podBundle("ops", "debug", "", image("shell", "busybox", "")))
+	index.ForgetCluster("c1")
+	require.Empty(t, index.Search("c1", query, 0).Pods)
+}
+
+func TestParseQueryRejectsEmpty(t *testing.T) {
+	_, err := imageindex.ParseQuery("  ")
+	require.Error(t, err)
+	_, err = imageindex.ParseQuery("@sha256:abc")
+	require.Error(t, err)
+}
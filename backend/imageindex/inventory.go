@@ -0,0 +1,126 @@
+package imageindex
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// InventoryImage is one unique image reference running in a cluster.
+type InventoryImage struct {
+	Image string `json:"image"`
+	// Containers counts the running containers using the image; Pods counts the
+	// distinct pods they belong to.
+	Containers int `json:"containers"`
+	Pods       int `json:"pods"`
+	// Namespaces, PullPolicies and Digests are distinct and sorted. Digests are
+	// the resolved digests the kubelets report, so a mutable tag resolving to
+	// more than one digest shows nodes running different builds.
+	Namespaces   []string `json:"namespaces"`
+	PullPolicies []string `json:"pullPolicies"`
+	Digests      []string `json:"digests"`
+	// MutableTag is set when the reference is not pinned: it names the latest
+	// tag, explicitly or by omitting both tag and digest.
+	MutableTag bool `json:"mutableTag"`
+}
+
+// Inventory lists every unique image running in one cluster.
+type Inventory struct {
+	ClusterID string           `json:"clusterId"`
+	Images    []InventoryImage `json:"images"`
+}
+
+// Inventory aggregates the cluster's indexed containers by image reference,
+// sorted by reference.
+func (x *Index) Inventory(clusterID string) Inventory {
+	images := make(map[string]*InventoryImage)
+	podsByImage := make(map[string]map[string]struct{})
+
+	x.mu.RLock()
+	for key, pod := range x.pods[clusterID] {
+		for _, container := range pod.images {
+			entry := images[container.Image]
+			if entry == nil {
+				entry = &InventoryImage{Image: container.Image, MutableTag: isMutableReference(container.Image)}
+				images[container.Image] = entry
+				podsByImage[container.Image] = make(map[string]struct{})
+			}
+			entry.Containers++
+			podsByImage[container.Image][key] = struct{}{}
+			entry.Namespaces = appendDistinct(entry.Namespaces, pod.namespace)
+			entry.PullPolicies = appendDistinct(entry.PullPolicies, container.PullPolicy)
+			entry.Digests = appendDistinct(entry.Digests, imageIDDigest(container.ImageID))
+		}
+	}
+	x.mu.RUnlock()
+
+	inventory := Inventory{ClusterID: clusterID, Images: make([]InventoryImage, 0, len(images))}
+	for ref, entry := range images {
+		entry.Pods = len(podsByImage[ref])
+		for _, values := range [][]string{entry.Namespaces, entry.PullPolicies, entry.Digests} {
+			slices.Sort(values)
+		}
+		if entry.PullPolicies == nil {
+			entry.PullPolicies = []string{}
+		}
+		if entry.Digests == nil {
+			entry.Digests = []string{}
+		}
+		inventory.Images = append(inventory.Images, *entry)
+	}
+	slices.SortFunc(inventory.Images, func(a, b InventoryImage) int {
+		return strings.Compare(a.Image, b.Image)
+	})
+	return inventory
+}
+
+// isMutableReference reports whether an image reference resolves through the
+// latest tag rather than a fixed tag or digest.
+func isMutableReference(ref string) bool {
+	_, tag, digest := splitReference(strings.ToLower(ref))
+	if digest != "" {
+		return false
+	}
+	return tag == "" || tag == "latest"
+}
+
+// appendDistinct appends value unless it is empty or already present.
+func appendDistinct(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// RenderInventoryCSV writes the inventory as CSV, one record per image. List
+// columns are joined with semicolons.
+func RenderInventoryCSV(inventory Inventory) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{"Image", "Containers", "Pods", "Namespaces", "Pull Policies", "Mutable Tag", "Digests"}
+	if err := writer.Write(header); err != nil {
+		return "", fmt.Errorf("write CSV header: %w", err)
+	}
+	for _, image := range inventory.Images {
+		record := []string{
+			image.Image,
+			strconv.Itoa(image.Containers),
+			strconv.Itoa(image.Pods),
+			strings.Join(image.Namespaces, ";"),
+			strings.Join(image.PullPolicies, ";"),
+			strconv.FormatBool(image.MutableTag),
+			strings.Join(image.Digests, ";"),
+		}
+		if err := writer.Write(record); err != nil {
+			return "", fmt.Errorf("write CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
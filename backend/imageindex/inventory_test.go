@@ -0,0 +1,72 @@
+package imageindex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/imageindex"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+)
+
+func policyImage(container, ref, imageID, policy string) streamrows.ContainerImage {
+	return streamrows.ContainerImage{Container: container, Image: ref, ImageID: imageID, PullPolicy: policy}
+}
+
+func newInventoryIndex() *imageindex.Index {
+	index := imageindex.NewIndex()
+	sink := index.Sink("c1")
+	sink.UpsertBundle(podBundle("prod", "api-1", "prod/Deployment/api",
+		policyImage("app", "registry.example.com/team/api:1.4", "registry.example.com/team/api@sha256:aaa", "IfNotPresent"),
+		policyImage("proxy", "nginx:latest", "docker.io/library/nginx@sha256:bbb", "Always"),
+	))
+	sink.UpsertBundle(podBundle("staging", "api-2", "staging/Deployment/api",
+		policyImage("app", "registry.example.com/team/api:1.4", "registry.example.com/team/api@sha256:aaa", "IfNotPresent"),
+		policyImage("proxy", "nginx:latest", "docker.io/library/nginx@sha256:ccc", "IfNotPresent"),
+	))
+	sink.UpsertBundle(podBundle("ops", "debug", "",
+		policyImage("shell", "busybox", "", "Always"),
+		policyImage("pinned", "alpine@sha256:ddd", "", "IfNotPresent"),
+	))
+	return index
+}
+
+func TestInventoryAggregatesUniqueImages(t *testing.T) {
+	inventory := newInventoryIndex().Inventory("c1")
+
+	require.Equal(t, "c1", inventory.ClusterID)
+	require.Equal(t, []imageindex.InventoryImage{
+		{
+			Image: "alpine@sha256:ddd", Containers: 1, Pods: 1,
+			Namespaces: []string{"ops"}, PullPolicies: []string{"IfNotPresent"}, Digests: []string{},
+		},
+		{
+			Image: "busybox", Containers: 1, Pods: 1,
+			Namespaces: []string{"ops"}, PullPolicies: []string{"Always"}, Digests: []string{},
+			MutableTag: true,
+		},
+		{
+			Image: "nginx:latest", Containers: 2, Pods: 2,
+			Namespaces: []string{"prod", "staging"}, PullPolicies: []string{"Always", "IfNotPresent"},
+			Digests: []string{"sha256:bbb", "sha256:ccc"}, MutableTag: true,
+		},
+		{
+			Image: "registry.example.com/team/api:1.4", Containers: 2, Pods: 2,
+			Namespaces: []string{"prod", "staging"}, PullPolicies: []string{"IfNotPresent"},
+			Digests: []string{"sha256:aaa"},
+		},
+	}, inventory.Images)
+	require.Empty(t, newInventoryIndex().Inventory("other").Images)
+}
+
+func TestRenderInventoryCSV(t *testing.T) {
+	inventory := newInventoryIndex().Inventory("c1")
+	inventory.Images = inventory.Images[2:3]
+
+	content, err := imageindex.RenderInventoryCSV(inventory)
+	require.NoError(t, err)
+	require.Equal(t,
+		"Image,Containers,Pods,Namespaces,Pull Policies,Mutable Tag,Digests\n"+
+			"nginx:latest,2,2,prod;staging,Always;IfNotPresent,true,sha256:bbb;sha256:ccc\n",
+		content)
+}
@@ -64,6 +64,13 @@ const (
 	// SnapshotCacheTTL controls how long snapshot builds are cached to avoid redundant work.
 	SnapshotCacheTTL = 5 * time.Second
 
+	// SnapshotDeltaVersionsPerScope is how many recent snapshot versions per
+	// domain and scope the API keeps for answering since-version requests.
+	SnapshotDeltaVersionsPerScope = 2
+
+	// SnapshotDeltaMaxScopes caps the domain and scope pairs kept for deltas.
+	SnapshotDeltaMaxScopes = 32
+
 	// ResponseCacheTTL controls how long non-informer resource GETs are cached.
 	// Keep this short to reduce staleness while still cutting repeated requests.
 	ResponseCacheTTL = 10 * time.Second
@@ -71,6 +78,10 @@ const (
 	// ResponseCacheMaxEntries caps the number of cached GET responses before eviction.
 	ResponseCacheMaxEntries = 512
 
+	// ObjectRenderCacheMaxEntries caps the rendered YAML and detail payloads
+	// kept per app. Entries are validated by resourceVersion, so they have no TTL.
+	ObjectRenderCacheMaxEntries = 256
+
 	// ResponseCacheInvalidationWarmupAge ignores cache invalidation events for very new objects.
 	ResponseCacheInvalidationWarmupAge = time.Minute
 
@@ -200,6 +211,14 @@ const (
 	// ClusterHealthHeartbeatTimeout bounds a single /readyz heartbeat request.
 	ClusterHealthHeartbeatTimeout = 5 * time.Second
 
+	// ClusterHealthReconnectingFailures is how many consecutive connectivity
+	// failures move a cluster from degraded to reconnecting.
+	ClusterHealthReconnectingFailures = 3
+
+	// ClusterHealthProbeMinInterval spaces the out-of-band health probes that
+	// informer watch failures trigger between heartbeats.
+	ClusterHealthProbeMinInterval = 2 * time.Second
+
 	// ClusterTransportFailureThreshold is the number of failures before auth recovery can rebuild transport.
 	ClusterTransportFailureThreshold = 3
 
@@ -247,6 +266,70 @@ const (
 
 	// MetricsStaleWindow is the window used to determine cluster overview metric freshness.
 	MetricsStaleWindow = 45 * time.Second
+
+	// MetricsHistoryRetention is how far back the per-pod usage history reaches.
+	MetricsHistoryRetention = 12 * time.Hour
+
+	// MetricsHistoryResolution is the width of one usage history bucket; a bucket
+	// keeps the peak usage of the collections that fell in it.
+	MetricsHistoryResolution = 5 * time.Minute
+)
+
+// Idle workload detection settings.
+const (
+	// IdleWorkloadDefaultWindow is the lookback used when a request sets none.
+	IdleWorkloadDefaultWindow = 2 * time.Hour
+
+	// IdleWorkloadDefaultCPUThresholdMilli is the peak CPU, in millicores, at or
+	// under which a pod counts as idle when a request sets no threshold.
+	IdleWorkloadDefaultCPUThresholdMilli = 5
+)
+
+// Manifest apply settings.
+const (
+	// ManifestApplyTimeout bounds one apply or dry-run of a set of rendered
+	// manifests, such as a kustomization.
+	ManifestApplyTimeout = 2 * time.Minute
+	// ManifestDirectoryMaxFiles caps the manifest files one directory apply
+	// reads, so picking a home directory by mistake fails fast.
+	ManifestDirectoryMaxFiles = 1000
+)
+
+// Git drift settings.
+const (
+	// GitDriftCheckInterval is how often each configured git source is
+	// compared with the cluster.
+	GitDriftCheckInterval = 5 * time.Minute
+	// GitDriftCheckTimeout bounds one cluster's drift check, including the
+	// git pull.
+	GitDriftCheckTimeout = 2 * time.Minute
+)
+
+// Bulk object action settings.
+const (
+	// BulkObjectActionConcurrency is how many objects a bulk action changes at
+	// once when the caller does not ask for a limit.
+	BulkObjectActionConcurrency = 5
+
+	// BulkObjectActionMaxConcurrency caps a caller-requested bulk action limit.
+	BulkObjectActionMaxConcurrency = 20
+
+	// BulkObjectActionMaxTargets caps the objects one bulk action may select.
+	BulkObjectActionMaxTargets = 500
+)
+
+// Finished object cleanup settings.
+const (
+	// FinishedCleanupListTimeout bounds listing the pods and Jobs a cleanup
+	// filter searches.
+	FinishedCleanupListTimeout = 30 * time.Second
+)
+
+// Problem pods settings.
+const (
+	// ProblemPodPendingThreshold is how long a pod must stay Pending before the
+	// problem pods domain lists it.
+	ProblemPodPendingThreshold = 5 * time.Minute
 )
 
 // Container log stream settings.
@@ -299,6 +382,11 @@ const (
 	// EventStreamSubscriberBufferSize buffers per-subscriber event stream deliveries.
 	EventStreamSubscriberBufferSize = 256
 
+	// EventStreamRecurringGroupLimit caps the recurring-event groups the event
+	// stream tracks for counts and rates; the least recently seen group is
+	// dropped first.
+	EventStreamRecurringGroupLimit = 4096
+
 	// AggregateEventStreamResumeBufferSize caps stored aggregate events per scope for resume tokens.
 	AggregateEventStreamResumeBufferSize = 2000
 
@@ -316,6 +404,10 @@ const (
 
 	// ResourceStreamResumeBufferSize caps buffered resource updates per scope for resume tokens.
 	ResourceStreamResumeBufferSize = 1000
+
+	// ResourceStreamCustomInformerIdleTimeout is how long the per-CRD custom resource
+	// informers keep running after their domain's last stream subscriber leaves.
+	ResourceStreamCustomInformerIdleTimeout = 5 * time.Minute
 )
 
 // Stream mux websocket settings.
@@ -483,12 +575,36 @@ const (
 
 	// DebugContainerPollTimeout controls how long to wait for a debug container to start.
 	DebugContainerPollTimeout = 30 * time.Second
+
+	// NodeLogDebugPodStartTimeout bounds scheduling, pulling and starting a node log debug pod.
+	NodeLogDebugPodStartTimeout = 2 * time.Minute
+
+	// NodeLogDebugPodLifetime is how long a node log debug pod lives before the
+	// kubelet ends it (activeDeadlineSeconds), in case it is never stopped.
+	NodeLogDebugPodLifetime = 30 * time.Minute
+
+	// ConnectivityCheckPodStartTimeout bounds scheduling, pulling and starting a connectivity check pod.
+	ConnectivityCheckPodStartTimeout = 2 * time.Minute
+
+	// ConnectivityCheckPodLifetime is how long a connectivity check pod lives
+	// before the kubelet ends it, in case its session never closes.
+	ConnectivityCheckPodLifetime = 10 * time.Minute
+
+	// ConnectivityCheckDefaultTimeout bounds one probe when the request sets no timeout.
+	ConnectivityCheckDefaultTimeout = 5 * time.Second
 )
 
 // Application update settings.
 const (
 	// AppUpdateRequestTimeout bounds update metadata checks.
 	AppUpdateRequestTimeout = 6 * time.Second
+
+	// AppUpdateBetaReleaseLimit is how many recent releases the beta channel
+	// scans for the newest (pre)release.
+	AppUpdateBetaReleaseLimit = 20
+
+	// AppUpdateDownloadTimeout bounds downloading an update package.
+	AppUpdateDownloadTimeout = 10 * time.Minute
 )
 
 // Application menu settings.
@@ -547,6 +663,25 @@ const (
 	ShellSessionCleanupInterval = time.Minute
 )
 
+// Pod exec settings (process list, socket inspector, file browser).
+const (
+	// PodExecCaptureTimeout bounds one captured exec round trip.
+	PodExecCaptureTimeout = 15 * time.Second
+
+	// PodExecCaptureMaxOutputBytes caps the output read from a captured exec.
+	PodExecCaptureMaxOutputBytes = 8 * 1024 * 1024
+
+	// PodFilePreviewMaxBytes caps how much of a container file is previewed.
+	PodFilePreviewMaxBytes = 256 * 1024
+
+	// PodFileDownloadTimeout bounds one container file download.
+	PodFileDownloadTimeout = 30 * time.Minute
+
+	// PodProcessSampleTTL is how long a container's last CPU sample is kept for
+	// the next refresh's delta.
+	PodProcessSampleTTL = 2 * time.Minute
+)
+
 // Shutdown settings.
 const (
 	// RefreshShutdownTimeout bounds refresh manager and refresh HTTP server shutdown.
@@ -561,3 +696,154 @@ const (
 	// ManualJobRetryDelay is the base delay between manual refresh retries.
 	ManualJobRetryDelay = 1 * time.Second
 )
+
+// Recycle bin settings.
+const (
+	// RecycleBinTTL is how long a deleted object's manifest stays restorable.
+	RecycleBinTTL = 24 * time.Hour
+
+	// RecycleBinMaxEntries caps the recycle bin; the oldest entries are dropped first.
+	RecycleBinMaxEntries = 200
+)
+
+// YAML bundle export settings.
+const (
+	// YAMLBundleMaxObjects caps the objects one YAML bundle export may fetch.
+	YAMLBundleMaxObjects = 5000
+)
+
+// Table export settings.
+const (
+	// TableExportPageLimit is the page size requested while walking a
+	// query-backed table for export.
+	TableExportPageLimit = 1000
+	// TableExportMaxRows caps the rows one table export may write.
+	TableExportMaxRows = 100000
+)
+
+// Namespace backup settings.
+const (
+	// NamespaceBackupMaxArchiveBytes caps the archive size a restore will read.
+	NamespaceBackupMaxArchiveBytes = 512 << 20
+)
+
+// Desktop notification settings.
+const (
+	// NotificationPollInterval is how often each cluster's Attention findings
+	// are checked for new notifications.
+	NotificationPollInterval = 5 * time.Second
+	// NotificationMaxPerCluster caps the notifications one check sends per
+	// cluster; the rest are folded into a single summary notification.
+	NotificationMaxPerCluster = 3
+)
+
+// Alert rule settings.
+const (
+	// AlertEvaluationInterval is how often alert rules are checked against the
+	// streamed Pod and workload rows.
+	AlertEvaluationInterval = 5 * time.Second
+	// AlertHistoryLimit caps the alerts kept in memory; the oldest resolved
+	// alerts are dropped first.
+	AlertHistoryLimit = 500
+)
+
+// Crash history settings.
+const (
+	// CrashHistoryRetention is how long container terminations are kept.
+	CrashHistoryRetention = 7 * 24 * time.Hour
+	// CrashHistoryWorkloadLimit caps the terminations kept per workload; the
+	// oldest are dropped first.
+	CrashHistoryWorkloadLimit = 500
+)
+
+// HPA scaling history settings.
+const (
+	// HPAHistoryRetention is how long HPA samples and events are kept.
+	HPAHistoryRetention = 24 * time.Hour
+	// HPAHistoryLimit caps the samples, and separately the events, kept per
+	// HPA; the oldest are dropped first.
+	HPAHistoryLimit = 1000
+	// HPAHistoryMetricInterval is the shortest gap between two samples that
+	// differ only in metric values; replica changes are always recorded.
+	HPAHistoryMetricInterval = time.Minute
+)
+
+// Config change history settings.
+const (
+	// ConfigHistoryContentLimit is the largest ConfigMap (total string data, in
+	// bytes) whose values are kept with its history; larger ones keep hashes only.
+	ConfigHistoryContentLimit = 16 * 1024
+	// ConfigHistoryObjectLimit caps the revisions kept per ConfigMap or Secret;
+	// the oldest are dropped first.
+	ConfigHistoryObjectLimit = 50
+)
+
+// Image search settings.
+const (
+	// ImageSearchPodLimit caps the pods one image search returns; workloads are
+	// still counted over every matching pod.
+	ImageSearchPodLimit = 500
+)
+
+// Object search settings.
+const (
+	// ObjectSearchDefaultLimit is the result count used when a search does not
+	// ask for one.
+	ObjectSearchDefaultLimit = 50
+	// ObjectSearchMaxLimit caps the results one search returns.
+	ObjectSearchMaxLimit = 500
+)
+
+// Cluster health summary settings.
+const (
+	// HealthSummaryInterval is how often the cluster health summary shown in
+	// the Clusters menu is recomputed.
+	HealthSummaryInterval = 10 * time.Second
+)
+
+// Local API settings.
+const (
+	// LocalAPIDefaultPort is the loopback port the local API listens on when
+	// settings do not name one.
+	LocalAPIDefaultPort = 47824
+	// LocalAPIRequestTimeout bounds one local API request, including a
+	// forwarded snapshot build.
+	LocalAPIRequestTimeout = 30 * time.Second
+	// LocalAPIShutdownTimeout bounds stopping the local API server.
+	LocalAPIShutdownTimeout = 5 * time.Second
+)
+
+// Custom action settings.
+const (
+	// CustomActionCommandTimeout bounds one run of a custom action command.
+	CustomActionCommandTimeout = 2 * time.Minute
+	// CustomActionOutputLimit caps the command output returned to the UI.
+	CustomActionOutputLimit = 64 * 1024
+)
+
+// External editor settings.
+const (
+	// ExternalEditSaveDebounce waits for an editor's save to settle before the
+	// file is read and applied; editors often write in several steps.
+	ExternalEditSaveDebounce = 300 * time.Millisecond
+	// ExternalEditIdleTimeout ends an external edit session that has seen no
+	// saves for this long.
+	ExternalEditIdleTimeout = time.Hour
+)
+
+// Image pull secret check settings.
+const (
+	// PullSecretCheckTimeout bounds one pull secret verification, including
+	// every registry request it makes.
+	PullSecretCheckTimeout = 60 * time.Second
+	// PullSecretRegistryRequestTimeout bounds a single registry token or
+	// manifest request.
+	PullSecretRegistryRequestTimeout = 10 * time.Second
+)
+
+// Cache introspection settings.
+const (
+	// CacheIntrospectionSampleSize caps the objects per informer or ingest
+	// store whose size is measured; the rest are extrapolated.
+	CacheIntrospectionSampleSize = 64
+)
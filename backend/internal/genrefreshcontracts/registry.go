@@ -3,10 +3,13 @@ package genrefreshcontracts
 import (
 	"reflect"
 
+	"github.com/luxury-yacht/app/backend/externalsecrets"
+	"github.com/luxury-yacht/app/backend/flux"
 	"github.com/luxury-yacht/app/backend/kind/objectmap"
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	"github.com/luxury-yacht/app/backend/nodemaintenance"
 	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/policyreport"
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/containerlogsstream"
 	"github.com/luxury-yacht/app/backend/refresh/snapshot"
@@ -14,6 +17,7 @@ import (
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
 	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	"github.com/luxury-yacht/app/backend/velero"
 )
 
 type typeSpec struct {
@@ -47,6 +51,8 @@ var contractTypes = []typeSpec{
 	{name: "RefreshPermissionDeniedDetails", typeOf: typeOf[refresh.PermissionDeniedDetails]()},
 	{name: "RefreshPermissionDeniedStatus", typeOf: typeOf[refresh.PermissionDeniedStatus]()},
 	{name: "SnapshotStats", typeOf: typeOf[refresh.SnapshotStats]()},
+	{name: "SnapshotDelta", typeOf: typeOf[refresh.SnapshotDelta]()},
+	{name: "DataFreshness", typeOf: typeOf[refresh.DataFreshness]()},
 	{name: "ResourceRef", typeOf: typeOf[resourcemodel.ResourceRef]()},
 	{name: "DisplayRef", typeOf: typeOf[resourcemodel.DisplayRef]()},
 	{name: "ResourceLink", typeOf: typeOf[resourcemodel.ResourceLink]()},
@@ -95,6 +101,34 @@ var contractTypes = []typeSpec{
 	{name: "ClusterCustomSnapshotPayload", typeOf: typeOf[snapshot.ClusterCustomSnapshot]()},
 	{name: "ClusterEventEntry", typeOf: typeOf[snapshot.ClusterEventEntry]()},
 	{name: "ClusterEventsSnapshotPayload", typeOf: typeOf[snapshot.ClusterEventsSnapshot]()},
+	{name: "NodePackingPod", typeOf: typeOf[snapshot.NodePackingPod]()},
+	{name: "NodePackingNode", typeOf: typeOf[snapshot.NodePackingNode]()},
+	{name: "NodePackingSnapshotPayload", typeOf: typeOf[snapshot.NodePackingSnapshot]()},
+	{name: "NamespaceUsage", typeOf: typeOf[snapshot.NamespaceUsage]()},
+	{name: "CategoryKindGroup", typeOf: typeOf[snapshot.CategoryKindGroup]()},
+	{name: "CategoryGroup", typeOf: typeOf[snapshot.CategoryGroup]()},
+	{name: "CategoryOverviewSnapshotPayload", typeOf: typeOf[snapshot.CategoryOverviewSnapshot]()},
+	{name: "NamespaceUsageSnapshotPayload", typeOf: typeOf[snapshot.NamespaceUsageSnapshot]()},
+	{name: "ProblemPod", typeOf: typeOf[snapshot.ProblemPod]()},
+	{name: "ProblemPodsSnapshotPayload", typeOf: typeOf[snapshot.ProblemPodsSnapshot]()},
+	{name: "PolicyReportSources", typeOf: typeOf[policyreport.Sources]()},
+	{name: "PolicyReportViolation", typeOf: typeOf[policyreport.Violation]()},
+	{name: "PolicyReportResourceCounts", typeOf: typeOf[policyreport.ResourceCounts]()},
+	{name: "PolicyReportsSnapshotPayload", typeOf: typeOf[policyreport.Snapshot]()},
+	{name: "VeleroSources", typeOf: typeOf[velero.Sources]()},
+	{name: "VeleroBackup", typeOf: typeOf[velero.Backup]()},
+	{name: "VeleroRestore", typeOf: typeOf[velero.Restore]()},
+	{name: "VeleroSchedule", typeOf: typeOf[velero.Schedule]()},
+	{name: "VeleroStorageLocation", typeOf: typeOf[velero.StorageLocation]()},
+	{name: "VeleroSnapshotPayload", typeOf: typeOf[velero.Snapshot]()},
+	{name: "FluxSources", typeOf: typeOf[flux.Sources]()},
+	{name: "FluxHelmRelease", typeOf: typeOf[flux.HelmRelease]()},
+	{name: "FluxKustomization", typeOf: typeOf[flux.Kustomization]()},
+	{name: "FluxSnapshotPayload", typeOf: typeOf[flux.Snapshot]()},
+	{name: "ExternalSecretsSources", typeOf: typeOf[externalsecrets.Sources]()},
+	{name: "ExternalSecret", typeOf: typeOf[externalsecrets.ExternalSecret]()},
+	{name: "ExternalSecretStore", typeOf: typeOf[externalsecrets.SecretStore]()},
+	{name: "ExternalSecretsSnapshotPayload", typeOf: typeOf[externalsecrets.Snapshot]()},
 	{name: "KindInfo", typeOf: typeOf[objectcatalog.KindInfo]()},
 	{name: "CatalogItem", typeOf: typeOf[objectcatalog.Summary]()},
 	{name: "CatalogActionFacts", typeOf: typeOf[objectcatalog.ActionFacts]()},
@@ -124,6 +158,11 @@ var contractTypes = []typeSpec{
 	{name: "ObjectMapStatus", typeOf: typeOf[snapshot.ObjectMapStatus]()},
 	{name: "ObjectMapEdge", typeOf: typeOf[snapshot.ObjectMapEdge]()},
 	{name: "ObjectMapSnapshotPayload", typeOf: typeOf[snapshot.ObjectMapSnapshotPayload]()},
+	{name: "ObjectReferrer", typeOf: typeOf[snapshot.ObjectReferrer]()},
+	{name: "ObjectReferencesSnapshotPayload", typeOf: typeOf[snapshot.ObjectReferencesSnapshotPayload]()},
+	{name: "ObjectStorageChainLink", typeOf: typeOf[snapshot.ObjectStorageChainLink]()},
+	{name: "ObjectStorageChainPod", typeOf: typeOf[snapshot.ObjectStorageChainPod]()},
+	{name: "ObjectStorageChainSnapshotPayload", typeOf: typeOf[snapshot.ObjectStorageChainSnapshotPayload]()},
 	{name: "ObjectYAMLSnapshotPayload", typeOf: typeOf[snapshot.ObjectYAMLSnapshotPayload]()},
 	{name: "ObjectHelmManifestSnapshotPayload", typeOf: typeOf[snapshot.ObjectHelmManifestSnapshotPayload]()},
 	{name: "ObjectHelmValuesSnapshotPayload", typeOf: typeOf[snapshot.ObjectHelmValuesSnapshotPayload]()},
@@ -145,12 +184,15 @@ var contractTypes = []typeSpec{
 	{name: "NamespaceEventSummary", typeOf: typeOf[snapshot.EventSummary]()},
 	{name: "NamespaceEventsSnapshotPayload", typeOf: typeOf[snapshot.NamespaceEventsSnapshot]()},
 	{name: "NamespaceCustomSummary", typeOf: typeOf[streamrows.NamespaceCustomSummary]()},
+	{name: "IstioSummary", typeOf: typeOf[streamrows.IstioSummary]()},
+	{name: "VolumeSnapshotSummary", typeOf: typeOf[streamrows.VolumeSnapshotSummary]()},
 	{name: "NamespaceCustomSnapshotPayload", typeOf: typeOf[snapshot.NamespaceCustomSnapshot]()},
 	{name: "NamespaceHelmSummary", typeOf: typeOf[snapshot.NamespaceHelmSummary]()},
 	{name: "NamespaceHelmSnapshotPayload", typeOf: typeOf[snapshot.NamespaceHelmSnapshot]()},
 	{name: "ContainerLogsWireEntry", typeOf: typeOf[containerlogsstream.Entry]()},
 	{name: "ContainerLogsStreamEventPayload", typeOf: typeOf[containerlogsstream.EventPayload]()},
 	{name: "ResourceStreamClientMessage", typeOf: typeOf[streammux.ClientMessage]()},
+	{name: "ResourceStreamDomainFreshness", typeOf: typeOf[streammux.DomainFreshness]()},
 	{name: "ResourceStreamServerMessage", typeOf: typeOf[streammux.ServerMessage]()},
 	{name: "TelemetrySnapshotStatus", typeOf: typeOf[telemetry.SnapshotStatus]()},
 	{name: "TelemetryMetricsStatus", typeOf: typeOf[telemetry.MetricsStatus]()},
@@ -0,0 +1,139 @@
+// Package memsize estimates how much memory Go values hold, for diagnostics
+// that need to say which caches are large. Estimates walk the value with
+// reflection and count the heap data it references: shared strings and
+// allocator overhead make them approximate, but they rank caches correctly.
+package memsize
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// mapEntryOverhead approximates the per-entry bookkeeping of a Go map.
+const mapEntryOverhead = 8
+
+// Of estimates the bytes v holds, including everything it references. A
+// pointer reached twice is counted once.
+func Of(v any) int64 {
+	if v == nil {
+		return 0
+	}
+	value := reflect.ValueOf(v)
+	s := sizer{seen: make(map[uintptr]struct{})}
+	return int64(value.Type().Size()) + s.indirect(value)
+}
+
+// Sample estimates the bytes held by items from at most limit of them, spread
+// evenly across the slice, scaled up to the whole slice.
+func Sample(items []any, limit int) int64 {
+	if len(items) == 0 || limit <= 0 {
+		return 0
+	}
+	if len(items) <= limit {
+		var total int64
+		for _, item := range items {
+			total += Of(item)
+		}
+		return total
+	}
+	var total int64
+	step := float64(len(items)) / float64(limit)
+	for i := 0; i < limit; i++ {
+		total += Of(items[int(float64(i)*step)])
+	}
+	return total * int64(len(items)) / int64(limit)
+}
+
+type sizer struct {
+	seen map[uintptr]struct{}
+}
+
+// indirect returns the bytes v references beyond its own inline size.
+func (s *sizer) indirect(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || !s.visit(v.Pointer()) {
+			return 0
+		}
+		elem := v.Elem()
+		return int64(elem.Type().Size()) + s.indirect(elem)
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		elem := v.Elem()
+		if elem.Kind() == reflect.Pointer {
+			return s.indirect(elem)
+		}
+		return int64(elem.Type().Size()) + s.indirect(elem)
+	case reflect.String:
+		if v.Len() == 0 || !s.visit(uintptr(unsafe.Pointer(unsafe.StringData(v.String())))) {
+			return 0
+		}
+		return int64(v.Len())
+	case reflect.Slice:
+		if v.IsNil() || !s.visit(v.Pointer()) {
+			return 0
+		}
+		total := int64(v.Cap()) * int64(v.Type().Elem().Size())
+		if hasPointers(v.Type().Elem()) {
+			for i := 0; i < v.Len(); i++ {
+				total += s.indirect(v.Index(i))
+			}
+		}
+		return total
+	case reflect.Array:
+		var total int64
+		if hasPointers(v.Type().Elem()) {
+			for i := 0; i < v.Len(); i++ {
+				total += s.indirect(v.Index(i))
+			}
+		}
+		return total
+	case reflect.Map:
+		if v.IsNil() || !s.visit(v.Pointer()) {
+			return 0
+		}
+		entry := int64(v.Type().Key().Size()+v.Type().Elem().Size()) + mapEntryOverhead
+		total := int64(v.Len()) * entry
+		iter := v.MapRange()
+		for iter.Next() {
+			total += s.indirect(iter.Key()) + s.indirect(iter.Value())
+		}
+		return total
+	case reflect.Struct:
+		var total int64
+		for i := 0; i < v.NumField(); i++ {
+			total += s.indirect(v.Field(i))
+		}
+		return total
+	default:
+		return 0
+	}
+}
+
+// visit records p and reports whether it was new.
+func (s *sizer) visit(p uintptr) bool {
+	if _, ok := s.seen[p]; ok {
+		return false
+	}
+	s.seen[p] = struct{}{}
+	return true
+}
+
+// hasPointers reports whether values of t can reference other memory.
+func hasPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.String, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	case reflect.Array:
+		return hasPointers(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package memsize
+
+import (
+	"strings"
+	"testing"
+)
+
+type node struct {
+	name   string
+	labels map[string]string
+	next   *node
+}
+
+func TestOfCountsReferencedData(t *testing.T) {
+	small := Of(&node{name: "a"})
+	large := Of(&node{name: strings.Repeat("a", 1000)})
+	if diff := large - small; diff != 999 {
+		t.Fatalf("expected the longer name to add 999 bytes, got %d", diff)
+	}
+
+	withLabels := Of(&node{name: "a", labels: map[string]string{"app": "web"}})
+	if withLabels <= small {
+		t.Fatalf("expected labels to add to the estimate: %d <= %d", withLabels, small)
+	}
+
+	loop := &node{name: "loop"}
+	loop.next = loop
+	if got := Of(loop); got <= 0 {
+		t.Fatalf("expected a positive estimate for a cycle, got %d", got)
+	}
+	if Of(nil) != 0 {
+		t.Fatal("expected nil to have no size")
+	}
+}
+
+func TestSampleScalesToTheWholeSlice(t *testing.T) {
+	items := make([]any, 100)
+	for i := range items {
+		items[i] = strings.Repeat("x", 100)
+	}
+	full := Sample(items, len(items))
+	sampled := Sample(items, 10)
+	if full != sampled {
+		t.Fatalf("expected uniform items to sample exactly: full %d, sampled %d", full, sampled)
+	}
+	if Sample(nil, 10) != 0 {
+		t.Fatal("expected an empty slice to have no size")
+	}
+}
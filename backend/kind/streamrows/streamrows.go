@@ -17,6 +17,8 @@
 package streamrows
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -106,6 +108,38 @@ type PodAggregate struct {
 	// kind, or a ReplicaSet owner could not be resolved to a Deployment. This is
 	// the field cluster-overview's buildWorkloadResourceUsage buckets metrics by.
 	WorkloadKind string
+
+	// LastTerminations and Images are the non-scalar fields, both bounded by the
+	// container count. LastTerminations holds each container's last termination
+	// (lastState.terminated), kept only for containers that have one; the
+	// crash-history recorder reads it.
+	LastTerminations []ContainerTermination
+	// Images is each init and regular container's image; the image index reads it.
+	Images []ContainerImage
+}
+
+// ContainerImage is the image a container runs: the reference from the pod spec
+// and, once the kubelet has pulled it, the resolved imageID (usually a digest
+// reference) from the container status, and the spec's image pull policy.
+type ContainerImage struct {
+	Container  string
+	Init       bool
+	Image      string
+	ImageID    string
+	PullPolicy string
+}
+
+// ContainerTermination is a container's last termination as the kubelet reports
+// it in lastState.terminated, plus the restart count it was reported with.
+type ContainerTermination struct {
+	Container    string
+	Init         bool
+	RestartCount int32
+	ExitCode     int32
+	Signal       int32
+	Reason       string
+	StartedAt    time.Time
+	FinishedAt   time.Time
 }
 
 // EndpointSliceServiceFact is a projected per-EndpointSlice join fact: the small reduced
@@ -129,6 +163,9 @@ type ConfigSummary struct {
 	Data         int                       `json:"data"`
 	Age          string                    `json:"age"`
 	AgeTimestamp int64                     `json:"ageTimestamp,omitempty"`
+	// CertificateNotAfter is the earliest certificate expiry (Unix ms) of a TLS
+	// Secret; the table badges it against the current time.
+	CertificateNotAfter int64 `json:"certificateNotAfter,omitempty"`
 }
 
 // RBACSummary describes a Role/RoleBinding/ServiceAccount row (namespace-rbac).
@@ -161,8 +198,12 @@ type AutoscalingSummary struct {
 	Min              int32                     `json:"min"`
 	Max              int32                     `json:"max"`
 	Current          int32                     `json:"current"`
-	Age              string                    `json:"age"`
-	AgeTimestamp     int64                     `json:"ageTimestamp,omitempty"`
+	// Triggers holds a KEDA ScaledObject/ScaledJob's scaler types.
+	Triggers []string `json:"triggers,omitempty"`
+	// Paused is set when KEDA scaling is paused.
+	Paused       bool   `json:"paused,omitempty"`
+	Age          string `json:"age"`
+	AgeTimestamp int64  `json:"ageTimestamp,omitempty"`
 }
 
 // StorageSummary captures PVC info for display (namespace-storage).
@@ -174,8 +215,12 @@ type StorageSummary struct {
 	StatusPresentation string                    `json:"statusPresentation,omitempty"`
 	StatusReason       string                    `json:"statusReason,omitempty"`
 	StorageClass       string                    `json:"storageClass"`
-	Age                string                    `json:"age"`
-	AgeTimestamp       int64                     `json:"ageTimestamp,omitempty"`
+	// ResizeState is the in-flight volume expansion ("Requested", "Resizing",
+	// "FileSystemResizePending" or "Failed"); empty when none is pending.
+	ResizeState   string `json:"resizeState,omitempty"`
+	ResizeMessage string `json:"resizeMessage,omitempty"`
+	Age           string `json:"age"`
+	AgeTimestamp  int64  `json:"ageTimestamp,omitempty"`
 }
 
 // QuotaSummary captures ResourceQuota/LimitRange/PDB info (namespace-quotas).
@@ -188,6 +233,11 @@ type QuotaSummary struct {
 	MinAvailable   *string                   `json:"minAvailable,omitempty"`
 	MaxUnavailable *string                   `json:"maxUnavailable,omitempty"`
 	Status         *QuotaStatus              `json:"status,omitempty"`
+	// UsedPercentage maps each ResourceQuota resource with a hard limit to the
+	// share of it in use; HighestUsedPercentage is the largest. Both are unset
+	// for the other kinds and for quotas without hard limits.
+	UsedPercentage        map[string]int `json:"usedPercentage,omitempty"`
+	HighestUsedPercentage *int           `json:"highestUsedPercentage,omitempty"`
 }
 
 // QuotaStatus carries PDB status fields needed by the quotas table.
@@ -204,6 +254,25 @@ type ResourceQuotaAggregate struct {
 	HighestUsedPercentage int
 }
 
+// ConfigDataAggregate is the compact ingest half used to record ConfigMap and
+// Secret data changes: a digest per data key, and the values of ConfigMaps small
+// enough to keep. Secret values are never kept.
+type ConfigDataAggregate struct {
+	Namespace string
+	Name      string
+	// Hashes maps each data (and binaryData) key to HashConfigValue of its value.
+	Hashes map[string]string
+	// Values holds a ConfigMap's string data when it fits the content limit; nil
+	// otherwise and for Secrets. Consumers must not mutate it.
+	Values map[string]string
+}
+
+// HashConfigValue returns the digest ConfigDataAggregate stores for one value.
+func HashConfigValue(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:8])
+}
+
 // NewQuotaSummary fills the row skeleton shared by the namespace-quotas kinds.
 func NewQuotaSummary(meta ClusterMeta, identity resourcekind.Identity, obj metav1.Object, details string) QuotaSummary {
 	return QuotaSummary{
@@ -242,6 +311,9 @@ type ClusterConfigEntry struct {
 	IsDefault    bool                      `json:"isDefault,omitempty"`
 	Age          string                    `json:"age"`
 	AgeTimestamp int64                     `json:"ageTimestamp,omitempty"`
+	// CertificateNotAfter is the earliest caBundle certificate expiry (Unix ms)
+	// of a webhook configuration; the table badges it against the current time.
+	CertificateNotAfter int64 `json:"certificateNotAfter,omitempty"`
 }
 
 // NewClusterConfigEntry fills the row skeleton shared by the cluster-config kinds.
@@ -293,9 +365,40 @@ type NamespaceCustomSummary struct {
 	Ready              *bool                          `json:"ready,omitempty"`
 	ObservedGeneration *int64                         `json:"observedGeneration,omitempty"`
 	Conditions         []resourcemodel.ConditionFacts `json:"conditions,omitempty"`
-	Age                string                         `json:"age"`
-	Labels             map[string]string              `json:"labels,omitempty"`
-	Annotations        map[string]string              `json:"annotations,omitempty"`
+	// Health is "healthy", "progressing", "degraded" or "unknown", inferred
+	// from conditions, phase and printer columns; HealthReason names the
+	// deciding signal.
+	Health       string            `json:"health,omitempty"`
+	HealthReason string            `json:"healthReason,omitempty"`
+	Age          string            `json:"age"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	// Istio is set for Istio traffic and security kinds, whose generic
+	// status columns say little about what they do.
+	Istio *IstioSummary `json:"istio,omitempty"`
+	// VolumeSnapshot is set for snapshot.storage.k8s.io VolumeSnapshots.
+	VolumeSnapshot *VolumeSnapshotSummary `json:"volumeSnapshot,omitempty"`
+}
+
+// IstioSummary is the tailored part of a namespace-custom row for an Istio
+// VirtualService, Gateway, DestinationRule or PeerAuthentication. Only the
+// fields the kind has are set.
+type IstioSummary struct {
+	Hosts []string `json:"hosts,omitempty"`
+	// Gateways are the gateways a VirtualService is bound to.
+	Gateways []string `json:"gateways,omitempty"`
+	// Routes are a VirtualService's routes as "match -> destinations".
+	Routes []string `json:"routes,omitempty"`
+	// Subsets are a DestinationRule's subset names.
+	Subsets []string `json:"subsets,omitempty"`
+	// Servers are a Gateway's listeners as "PROTOCOL/port".
+	Servers []string `json:"servers,omitempty"`
+	// TLSMode is a DestinationRule's client TLS mode.
+	TLSMode string `json:"tlsMode,omitempty"`
+	// MTLSMode is a PeerAuthentication's workload mTLS mode.
+	MTLSMode string `json:"mtlsMode,omitempty"`
+	// Details is a one-line description for the table.
+	Details string `json:"details"`
 }
 
 // ClusterCustomSummary is a CRD-backed cluster-scoped custom resource row.
@@ -308,9 +411,40 @@ type ClusterCustomSummary struct {
 	Ready              *bool                          `json:"ready,omitempty"`
 	ObservedGeneration *int64                         `json:"observedGeneration,omitempty"`
 	Conditions         []resourcemodel.ConditionFacts `json:"conditions,omitempty"`
-	Age                string                         `json:"age"`
-	Labels             map[string]string              `json:"labels,omitempty"`
-	Annotations        map[string]string              `json:"annotations,omitempty"`
+	// Health is "healthy", "progressing", "degraded" or "unknown", inferred
+	// from conditions, phase and printer columns; HealthReason names the
+	// deciding signal.
+	Health       string            `json:"health,omitempty"`
+	HealthReason string            `json:"healthReason,omitempty"`
+	Age          string            `json:"age"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	// VolumeSnapshot is set for snapshot.storage.k8s.io VolumeSnapshotClasses.
+	VolumeSnapshot *VolumeSnapshotSummary `json:"volumeSnapshot,omitempty"`
+}
+
+// VolumeSnapshotSummary is the tailored part of a custom row for a
+// VolumeSnapshot (namespace-custom) or VolumeSnapshotClass (cluster-custom).
+// Only the fields the kind has are set.
+type VolumeSnapshotSummary struct {
+	// SourcePVC is the claim a dynamically provisioned snapshot was taken
+	// from; SourceContent is the VolumeSnapshotContent a pre-provisioned
+	// snapshot binds to.
+	SourcePVC     string `json:"sourcePvc,omitempty"`
+	SourceContent string `json:"sourceContent,omitempty"`
+	SnapshotClass string `json:"snapshotClass,omitempty"`
+	BoundContent  string `json:"boundContent,omitempty"`
+	// ReadyToUse is unset until the snapshot controller reports it.
+	ReadyToUse   *bool  `json:"readyToUse,omitempty"`
+	RestoreSize  string `json:"restoreSize,omitempty"`
+	CreationTime string `json:"creationTime,omitempty"`
+	Error        string `json:"error,omitempty"`
+	// Driver, DeletionPolicy and IsDefault describe a VolumeSnapshotClass.
+	Driver         string `json:"driver,omitempty"`
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+	IsDefault      bool   `json:"isDefault,omitempty"`
+	// Details is a one-line description for the table.
+	Details string `json:"details"`
 }
 
 // NetworkSummary is a Service/Ingress/EndpointSlice/NetworkPolicy/Gateway-API row
@@ -0,0 +1,192 @@
+/*
+ * backend/kubectl_command.go
+ *
+ * Builds the kubectl command equivalent to an app action, so users can copy
+ * it into a change ticket or run it elsewhere. Commands name the cluster's
+ * kubeconfig context but not the kubeconfig file, which is local to this
+ * machine. They describe what the app does, not a byte-for-byte replay: YAML
+ * edits become the patch the app sends, and a drain that skips waiting for
+ * pods has no kubectl flag.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PreviewObjectActionCommand returns the kubectl command RunObjectAction
+// would be equivalent to for req, without running anything.
+func (a *App) PreviewObjectActionCommand(req ObjectActionRequest) (string, error) {
+	action := strings.TrimSpace(req.Action)
+	if err := validateObjectActionName(action); err != nil {
+		return "", err
+	}
+	target, err := validateObjectActionTarget(req.Target)
+	if err != nil {
+		return "", err
+	}
+	return a.objectActionKubectlCommand(action, target, req, ObjectActionResponse{})
+}
+
+// objectActionKubectlCommand builds the command for an object action. The
+// response supplies names only known once the action ran, such as the Job a
+// CronJob trigger created.
+func (a *App) objectActionKubectlCommand(action string, target ObjectActionTargetRef, req ObjectActionRequest, response ObjectActionResponse) (string, error) {
+	resource := kubectlResource(target.Group, target.Kind) + "/" + target.Name
+	var args []string
+	switch action {
+	case ObjectActionDelete, ObjectActionForceDelete:
+		if target.Group == "helm.sh" && strings.EqualFold(target.Kind, "HelmRelease") {
+			return a.helmCommand(target.ClusterID, target.Namespace, "uninstall", target.Name), nil
+		}
+		args = []string{"delete", resource}
+		if action == ObjectActionForceDelete {
+			args = append(args, "--grace-period=0", "--force")
+		}
+	case ObjectActionRestart:
+		args = []string{"rollout", "restart", resource}
+	case ObjectActionScale:
+		replicas, err := requireObjectActionOption(req.Replicas, "replicas", action)
+		if err != nil {
+			return "", err
+		}
+		args = []string{"scale", resource, "--replicas=" + strconv.Itoa(replicas)}
+	case ObjectActionTrigger:
+		jobName := response.Name
+		if jobName == "" {
+			jobName = target.Name + "-manual"
+		}
+		args = []string{"create", "job", jobName, "--from=cronjob/" + target.Name}
+	case ObjectActionSuspend:
+		suspend, err := requireObjectActionOption(req.Suspend, "suspend", action)
+		if err != nil {
+			return "", err
+		}
+		args = []string{"patch", resource, "--type=merge", "-p", fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)}
+	case ObjectActionCordon:
+		args = []string{"cordon", target.Name}
+	case ObjectActionUncordon:
+		args = []string{"uncordon", target.Name}
+	case ObjectActionDrain, ObjectActionStartDrain:
+		args = append([]string{"drain", target.Name}, kubectlDrainFlags(req.DrainOptions)...)
+	case ObjectActionStartPortForward:
+		options, err := requireObjectActionOption(req.PortForward, "portForward", action)
+		if err != nil {
+			return "", err
+		}
+		args = []string{"port-forward", resource, fmt.Sprintf("%d:%d", options.LocalPort, options.ContainerPort)}
+	case ObjectActionCreateDebugContainer:
+		options, err := requireObjectActionOption(req.DebugContainer, "debugContainer", action)
+		if err != nil {
+			return "", err
+		}
+		args = []string{"debug", target.Name, "-it", "--image=" + options.Image}
+		if options.TargetContainer != "" {
+			args = append(args, "--target="+options.TargetContainer)
+		}
+	case ObjectActionRollback:
+		revision, err := requireObjectActionOption(req.Revision, "revision", action)
+		if err != nil {
+			return "", err
+		}
+		args = []string{"rollout", "undo", resource, "--to-revision=" + strconv.FormatInt(revision, 10)}
+	default:
+		return "", fmt.Errorf("object action %q has no kubectl equivalent", action)
+	}
+	return a.kubectlCommand(target.ClusterID, target.Namespace, args...), nil
+}
+
+// kubectlPatchCommand builds a kubectl patch sending body with patchType.
+func (a *App) kubectlPatchCommand(clusterID, group, kind, namespace, name string, patchType types.PatchType, body []byte) string {
+	kubectlType := map[types.PatchType]string{
+		types.MergePatchType:          "merge",
+		types.JSONPatchType:           "json",
+		types.StrategicMergePatchType: "strategic",
+	}[patchType]
+	return a.kubectlCommand(clusterID, namespace,
+		"patch", kubectlResource(group, kind)+"/"+name, "--type="+kubectlType, "-p", string(body))
+}
+
+// kubectlDrainFlags maps drain options to kubectl drain flags.
+// SkipWaitForPodsToTerminate has no kubectl equivalent and is left out.
+func kubectlDrainFlags(options *DrainNodeOptions) []string {
+	if options == nil {
+		return nil
+	}
+	var flags []string
+	if options.IgnoreDaemonSets {
+		flags = append(flags, "--ignore-daemonsets")
+	}
+	if options.DeleteEmptyDirData {
+		flags = append(flags, "--delete-emptydir-data")
+	}
+	if options.Force {
+		flags = append(flags, "--force")
+	}
+	if options.DisableEviction {
+		flags = append(flags, "--disable-eviction")
+	}
+	if options.GracePeriodSeconds != nil {
+		flags = append(flags, "--grace-period="+strconv.Itoa(*options.GracePeriodSeconds))
+	}
+	if options.TimeoutSeconds != nil {
+		flags = append(flags, "--timeout="+strconv.Itoa(*options.TimeoutSeconds)+"s")
+	}
+	return flags
+}
+
+// kubectlResource names a kind the way kubectl resolves it: the lower-cased
+// kind, qualified by its group unless it is a core kind.
+func kubectlResource(group, kind string) string {
+	resource := strings.ToLower(kind)
+	if group != "" {
+		resource += "." + group
+	}
+	return resource
+}
+
+// kubectlCommand renders a kubectl invocation for the cluster's context.
+func (a *App) kubectlCommand(clusterID, namespace string, args ...string) string {
+	command := append([]string{"kubectl"}, args...)
+	if namespace != "" {
+		command = append(command, "-n", namespace)
+	}
+	if clients := a.clusterClientsForID(clusterID); clients != nil && clients.kubeconfigContext != "" {
+		command = append(command, "--context", clients.kubeconfigContext)
+	}
+	return shellJoin(command)
+}
+
+// helmCommand renders a helm invocation for the cluster's context.
+func (a *App) helmCommand(clusterID, namespace string, args ...string) string {
+	command := append([]string{"helm"}, args...)
+	if namespace != "" {
+		command = append(command, "-n", namespace)
+	}
+	if clients := a.clusterClientsForID(clusterID); clients != nil && clients.kubeconfigContext != "" {
+		command = append(command, "--kube-context", clients.kubeconfigContext)
+	}
+	return shellJoin(command)
+}
+
+// shellJoin joins args into a POSIX shell command line, single-quoting any
+// argument that is not plainly safe.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(arg string) string {
+	if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./:=@,+%") == "" {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
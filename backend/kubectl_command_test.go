@@ -0,0 +1,93 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPreviewObjectActionCommand(t *testing.T) {
+	app := NewApp()
+	registerTestClusterWithClients(app, "c1", &clusterClients{
+		meta:              ClusterMeta{ID: "c1", Name: "prod"},
+		kubeconfigContext: "prod-admin@eu",
+	})
+	deployment := objectActionTarget("c1", "apps", "v1", "Deployment", "shop", "web")
+	replicas := 3
+	suspend := true
+	revision := int64(4)
+	grace := 30
+
+	tests := []struct {
+		name string
+		req  ObjectActionRequest
+		want string
+	}{
+		{
+			name: "scale",
+			req:  ObjectActionRequest{Action: ObjectActionScale, Target: deployment, Replicas: &replicas},
+			want: "kubectl scale deployment.apps/web --replicas=3 -n shop --context prod-admin@eu",
+		},
+		{
+			name: "restart",
+			req:  ObjectActionRequest{Action: ObjectActionRestart, Target: deployment},
+			want: "kubectl rollout restart deployment.apps/web -n shop --context prod-admin@eu",
+		},
+		{
+			name: "rollback",
+			req:  ObjectActionRequest{Action: ObjectActionRollback, Target: deployment, Revision: &revision},
+			want: "kubectl rollout undo deployment.apps/web --to-revision=4 -n shop --context prod-admin@eu",
+		},
+		{
+			name: "delete core kind",
+			req:  ObjectActionRequest{Action: ObjectActionDelete, Target: objectActionTarget("c1", "", "v1", "Pod", "shop", "web-0")},
+			want: "kubectl delete pod/web-0 -n shop --context prod-admin@eu",
+		},
+		{
+			name: "force delete node",
+			req:  ObjectActionRequest{Action: ObjectActionForceDelete, Target: objectActionTarget("c1", "", "v1", "Node", "", "node-1")},
+			want: "kubectl delete node/node-1 --grace-period=0 --force --context prod-admin@eu",
+		},
+		{
+			name: "helm release",
+			req:  ObjectActionRequest{Action: ObjectActionDelete, Target: objectActionTarget("c1", "helm.sh", "v3", "HelmRelease", "shop", "web")},
+			want: "helm uninstall web -n shop --kube-context prod-admin@eu",
+		},
+		{
+			name: "suspend",
+			req:  ObjectActionRequest{Action: ObjectActionSuspend, Target: objectActionTarget("c1", "batch", "v1", "CronJob", "shop", "report"), Suspend: &suspend},
+			want: `kubectl patch cronjob.batch/report --type=merge -p '{"spec":{"suspend":true}}' -n shop --context prod-admin@eu`,
+		},
+		{
+			name: "drain",
+			req: ObjectActionRequest{
+				Action:       ObjectActionStartDrain,
+				Target:       objectActionTarget("c1", "", "v1", "Node", "", "node-1"),
+				DrainOptions: &DrainNodeOptions{IgnoreDaemonSets: true, DeleteEmptyDirData: true, GracePeriodSeconds: &grace, SkipWaitForPodsToTerminate: true},
+			},
+			want: "kubectl drain node-1 --ignore-daemonsets --delete-emptydir-data --grace-period=30 --context prod-admin@eu",
+		},
+		{
+			name: "cordon",
+			req:  ObjectActionRequest{Action: ObjectActionCordon, Target: objectActionTarget("c1", "", "v1", "Node", "", "node-1")},
+			want: "kubectl cordon node-1 --context prod-admin@eu",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := app.PreviewObjectActionCommand(tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := app.PreviewObjectActionCommand(ObjectActionRequest{Action: ObjectActionScale, Target: deployment})
+	require.ErrorContains(t, err, "requires replicas")
+}
+
+func TestKubectlPatchCommandQuotesThePatch(t *testing.T) {
+	app := NewApp()
+	got := app.kubectlPatchCommand("unknown", "", "ConfigMap", "team a", "settings", types.StrategicMergePatchType, []byte(`{"data":{"motd":"it's up"}}`))
+	require.Equal(t, `kubectl patch configmap/settings --type=strategic -p '{"data":{"motd":"it'\''s up"}}' -n 'team a'`, got)
+}
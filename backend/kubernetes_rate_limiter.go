@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 	"sync"
+	"time"
 
 	"k8s.io/client-go/util/flowcontrol"
 )
@@ -12,6 +13,8 @@ type mutableKubernetesRateLimiter struct {
 	limiter flowcontrol.RateLimiter
 	qps     int
 	burst   int
+	// metrics, when set, records how long Wait held each request.
+	metrics *kubernetesAPIMetrics
 }
 
 func newMutableKubernetesRateLimiter(qps int, burst int) *mutableKubernetesRateLimiter {
@@ -73,7 +76,11 @@ func (l *mutableKubernetesRateLimiter) Wait(ctx context.Context) error {
 	if limiter == nil {
 		return nil
 	}
-	return limiter.Wait(ctx)
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	now := time.Now()
+	l.metrics.recordThrottle(now.Sub(start), now)
+	return err
 }
 
 func (l *mutableKubernetesRateLimiter) current() flowcontrol.RateLimiter {
@@ -0,0 +1,52 @@
+// Package kustomizebuild renders a local kustomization the way
+// "kustomize build" does, through the kustomize Go API rather than a kustomize
+// binary. Plugins, including Helm chart inflation, stay disabled: building a
+// directory never runs a program from it.
+package kustomizebuild
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// Build renders the kustomization in dir and returns the rendered objects and
+// the multi-document YAML they print as.
+func Build(dir string) ([]*unstructured.Unstructured, string, error) {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil, "", fmt.Errorf("kustomization directory is required")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, "", err
+	}
+	if info, err := os.Stat(dir); err != nil {
+		return nil, "", err
+	} else if !info.IsDir() {
+		return nil, "", fmt.Errorf("%s is not a directory", dir)
+	}
+
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("kustomize build %s: %w", dir, err)
+	}
+	rendered, err := resources.AsYaml()
+	if err != nil {
+		return nil, "", fmt.Errorf("render kustomize output: %w", err)
+	}
+	objects := make([]*unstructured.Unstructured, 0, resources.Size())
+	for _, resource := range resources.Resources() {
+		object, err := resource.Map()
+		if err != nil {
+			return nil, "", fmt.Errorf("read %s: %w", resource.CurId(), err)
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: object})
+	}
+	return objects, string(rendered), nil
+}
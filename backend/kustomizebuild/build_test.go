@@ -0,0 +1,35 @@
+package kustomizebuild
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRendersKustomization(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	write("kustomization.yaml", "namespace: shop\nnamePrefix: prod-\nresources:\n- service.yaml\nconfigMapGenerator:\n- name: settings\n  literals:\n  - mode=fast\n")
+	write("service.yaml", "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\nspec:\n  ports:\n  - port: 80\n")
+
+	objects, rendered, err := Build(dir)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	names := map[string]string{}
+	for _, object := range objects {
+		require.Equal(t, "shop", object.GetNamespace())
+		names[object.GetKind()] = object.GetName()
+	}
+	require.Equal(t, "prod-web", names["Service"])
+	require.Regexp(t, `^prod-settings-\w+$`, names["ConfigMap"])
+	require.Contains(t, rendered, "mode: fast")
+
+	_, _, err = Build(filepath.Join(dir, "service.yaml"))
+	require.ErrorContains(t, err, "is not a directory")
+	_, _, err = Build(t.TempDir())
+	require.ErrorContains(t, err, "kustomize build")
+}
@@ -0,0 +1,39 @@
+package localterminal
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// openPTY opens a pseudo-terminal pair: the controlling side and the tty the
+// process runs on.
+func openPTY() (*os.File, *os.File, error) {
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ioctl(ptmx, syscall.TIOCPTYGRANT, 0); err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	if err := ioctl(ptmx, syscall.TIOCPTYUNLK, 0); err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	name := make([]byte, 128)
+	if err := ioctl(ptmx, syscall.TIOCPTYGNAME, uintptr(unsafe.Pointer(&name[0]))); err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	if end := bytes.IndexByte(name, 0); end >= 0 {
+		name = name[:end]
+	}
+	tty, err := os.OpenFile(string(name), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	return ptmx, tty, nil
+}
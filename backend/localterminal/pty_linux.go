@@ -0,0 +1,33 @@
+package localterminal
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+	"unsafe"
+)
+
+// openPTY opens a pseudo-terminal pair: the controlling side and the tty the
+// process runs on.
+func openPTY() (*os.File, *os.File, error) {
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	var unlock int32
+	if err := ioctl(ptmx, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	var number uint32
+	if err := ioctl(ptmx, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number))); err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	tty, err := os.OpenFile("/dev/pts/"+strconv.FormatUint(uint64(number), 10), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		ptmx.Close()
+		return nil, nil, err
+	}
+	return ptmx, tty, nil
+}
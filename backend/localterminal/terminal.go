@@ -0,0 +1,65 @@
+// Package localterminal runs a local shell on a pseudo-terminal, for the
+// integrated terminal that opens with a cluster's kubeconfig context and
+// namespace already selected. It is the local counterpart of pod exec: the
+// caller reads and writes the terminal like a stream and resizes it when the
+// view changes.
+package localterminal
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Options describe the process to start.
+type Options struct {
+	// Command is the program and its arguments; empty runs DefaultShell.
+	Command []string
+	Dir     string
+	// Env is the complete environment of the process.
+	Env     []string
+	Columns uint16
+	Rows    uint16
+}
+
+// Terminal is a running process attached to a pseudo-terminal.
+type Terminal struct {
+	pty  *os.File
+	cmd  *exec.Cmd
+	done chan struct{}
+	err  error
+}
+
+// DefaultShell returns the user's login shell, or /bin/sh.
+func DefaultShell() string {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	return "/bin/sh"
+}
+
+// Read reads the process's terminal output. It fails once the process has
+// exited and its output is drained.
+func (t *Terminal) Read(p []byte) (int, error) {
+	return t.pty.Read(p)
+}
+
+// Write sends input to the process as if typed.
+func (t *Terminal) Write(p []byte) (int, error) {
+	return t.pty.Write(p)
+}
+
+// Done is closed when the process exits.
+func (t *Terminal) Done() <-chan struct{} {
+	return t.done
+}
+
+// Err returns how the process exited; valid once Done is closed.
+func (t *Terminal) Err() error {
+	<-t.done
+	return t.err
+}
+
+func (t *Terminal) wait() {
+	t.err = t.cmd.Wait()
+	close(t.done)
+}
@@ -0,0 +1,24 @@
+//go:build !darwin && !linux
+
+package localterminal
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Start is unsupported here: the integrated terminal needs a unix
+// pseudo-terminal.
+func Start(Options) (*Terminal, error) {
+	return nil, fmt.Errorf("the integrated terminal is not supported on %s", runtime.GOOS)
+}
+
+// Resize is unreachable without Start.
+func (t *Terminal) Resize(uint16, uint16) error {
+	return fmt.Errorf("the integrated terminal is not supported on %s", runtime.GOOS)
+}
+
+// Close is unreachable without Start.
+func (t *Terminal) Close() error {
+	return nil
+}
@@ -0,0 +1,90 @@
+//go:build darwin || linux
+
+package localterminal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+// Start runs the command on a new pseudo-terminal. The process leads its own
+// session with the terminal as its controlling tty, so job control and
+// Ctrl-C work as in any terminal.
+func Start(opts Options) (*Terminal, error) {
+	command := opts.Command
+	if len(command) == 0 {
+		command = []string{DefaultShell()}
+	}
+	ptmx, tty, err := openPTY()
+	if err != nil {
+		return nil, fmt.Errorf("open pseudo-terminal: %w", err)
+	}
+	defer tty.Close()
+	if opts.Columns > 0 && opts.Rows > 0 {
+		if err := setSize(ptmx, opts.Columns, opts.Rows); err != nil {
+			ptmx.Close()
+			return nil, err
+		}
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = opts.Dir
+	cmd.Env = opts.Env
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = tty, tty, tty
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	if err := cmd.Start(); err != nil {
+		ptmx.Close()
+		return nil, fmt.Errorf("start %s: %w", command[0], err)
+	}
+	t := &Terminal{pty: ptmx, cmd: cmd, done: make(chan struct{})}
+	go t.wait()
+	return t, nil
+}
+
+// Resize sets the terminal size; the process receives SIGWINCH.
+func (t *Terminal) Resize(columns, rows uint16) error {
+	if columns == 0 || rows == 0 {
+		return fmt.Errorf("columns and rows must be positive")
+	}
+	return setSize(t.pty, columns, rows)
+}
+
+// Close hangs up the terminal, ending the process and everything it started
+// in its session, and releases the pseudo-terminal.
+func (t *Terminal) Close() error {
+	select {
+	case <-t.done:
+	default:
+		_ = syscall.Kill(-t.cmd.Process.Pid, syscall.SIGHUP)
+	}
+	return t.pty.Close()
+}
+
+func setSize(pty *os.File, columns, rows uint16) error {
+	size := struct{ rows, columns, x, y uint16 }{rows: rows, columns: columns}
+	if err := ioctl(pty, syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&size))); err != nil {
+		return fmt.Errorf("resize terminal: %w", err)
+	}
+	return nil
+}
+
+// ioctl runs an ioctl on f without switching it to blocking mode.
+func ioctl(f *os.File, request, arg uintptr) error {
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var errno syscall.Errno
+	if err := conn.Control(func(fd uintptr) {
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, request, arg)
+	}); err != nil {
+		return err
+	}
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
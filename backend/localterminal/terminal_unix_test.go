@@ -0,0 +1,60 @@
+//go:build darwin || linux
+
+package localterminal
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartRunsTheCommandOnATerminal(t *testing.T) {
+	term, err := Start(Options{
+		Command: []string{"/bin/sh", "-c", `test -t 0 && echo "tty $GREETING"; stty size`},
+		Env:     append(os.Environ(), "GREETING=hello"),
+		Columns: 100,
+		Rows:    30,
+	})
+	require.NoError(t, err)
+	defer term.Close()
+
+	output := readUntilExit(t, term)
+	require.Contains(t, output, "tty hello")
+	require.Contains(t, output, "30 100")
+	require.NoError(t, term.Err())
+}
+
+func TestCloseEndsTheProcess(t *testing.T) {
+	term, err := Start(Options{Command: []string{"/bin/sh", "-c", "sleep 30"}, Env: os.Environ()})
+	require.NoError(t, err)
+	require.NoError(t, term.Resize(80, 24))
+	require.Error(t, term.Resize(0, 24))
+
+	require.NoError(t, term.Close())
+	select {
+	case <-term.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("process did not exit after Close")
+	}
+}
+
+// readUntilExit collects output until the terminal reports the process gone.
+func readUntilExit(t *testing.T, term *Terminal) string {
+	t.Helper()
+	var output strings.Builder
+	read := make(chan struct{})
+	go func() {
+		defer close(read)
+		_, _ = io.Copy(&output, term)
+	}()
+	select {
+	case <-read:
+	case <-time.After(5 * time.Second):
+		t.Fatal("terminal output did not end")
+	}
+	return output.String()
+}
@@ -0,0 +1,287 @@
+// Package manifestapply applies a set of rendered manifests to a cluster with
+// server-side apply, the way "kubectl apply --server-side" does, and previews
+// the same apply as a dry run with a diff of each object's live state against
+// the state the apply would leave. Renderers (kustomize, a directory of
+// manifests) produce the objects; this package only applies them.
+package manifestapply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// FieldManager is the server-side apply manager applied manifests are owned by.
+const FieldManager = "luxury-yacht-apply"
+
+// Placeholders for Secret values in a diff: one for a value the apply leaves
+// as it is, one for a value it adds or changes.
+const (
+	withheldSecretValue = "<redacted>"
+	changedSecretValue  = "<redacted, changed>"
+)
+
+// lastAppliedAnnotation is kubectl's client-side apply record, which repeats
+// a Secret's values.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// What applying an object does to it.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionUnchanged = "unchanged"
+	ActionFailed    = "failed"
+)
+
+// kindOrder applies the objects other objects depend on first: namespaces and
+// CRDs, then identities and RBAC, then configuration, then everything else.
+var kindOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"ServiceAccount":           2,
+	"ClusterRole":              3,
+	"ClusterRoleBinding":       4,
+	"Role":                     5,
+	"RoleBinding":              6,
+	"ConfigMap":                7,
+	"Secret":                   8,
+	"PersistentVolumeClaim":    9,
+	"Service":                  10,
+}
+
+// Resolver maps a kind to its resource and reports whether it is namespaced.
+type Resolver func(ctx context.Context, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error)
+
+// Options control an apply.
+type Options struct {
+	// Namespace is used for namespaced objects that do not name one; empty
+	// means "default".
+	Namespace string `json:"namespace,omitempty"`
+	// Force takes ownership of fields other managers own instead of failing
+	// the object with a conflict.
+	Force  bool `json:"force,omitempty"`
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// Manifest is one object to apply and where it came from.
+type Manifest struct {
+	// Source is the file, and document within it, the object was read from.
+	Source string
+	Object *unstructured.Unstructured
+}
+
+// Result is the outcome for one object.
+type Result struct {
+	Source     string `json:"source,omitempty"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Action     string `json:"action"`
+	// Diff is a unified diff from the live object to the applied one.
+	Diff  string `json:"diff,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the outcome of an apply, in apply order.
+type Report struct {
+	DryRun  bool     `json:"dryRun,omitempty"`
+	Results []Result `json:"results"`
+	// Counts maps each action to its number of objects.
+	Counts map[string]int `json:"counts"`
+}
+
+// Run applies objects in dependency order. One failed object does not stop
+// the others.
+func Run(ctx context.Context, client dynamic.Interface, resolve Resolver, manifests []Manifest, opts Options) Report {
+	ordered := append([]Manifest(nil), manifests...)
+	sort.SliceStable(ordered, func(i, j int) bool { return rank(ordered[i].Object.GetKind()) < rank(ordered[j].Object.GetKind()) })
+
+	report := Report{DryRun: opts.DryRun, Results: make([]Result, 0, len(ordered)), Counts: map[string]int{}}
+	for _, manifest := range ordered {
+		result := applyObject(ctx, client, resolve, manifest.Object.DeepCopy(), opts)
+		result.Source = manifest.Source
+		report.Add(result)
+	}
+	return report
+}
+
+// Add records a result, such as a manifest that failed to load.
+func (r *Report) Add(result Result) {
+	r.Results = append(r.Results, result)
+	r.Counts[result.Action]++
+}
+
+func rank(kind string) int {
+	if r, ok := kindOrder[kind]; ok {
+		return r
+	}
+	return len(kindOrder)
+}
+
+func applyObject(ctx context.Context, client dynamic.Interface, resolve Resolver, obj *unstructured.Unstructured, opts Options) Result {
+	result := Result{APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	fail := func(err error) Result {
+		result.Action, result.Error = ActionFailed, err.Error()
+		return result
+	}
+	if result.Kind == "" || result.APIVersion == "" || result.Name == "" {
+		return fail(fmt.Errorf("manifest needs apiVersion, kind and metadata.name"))
+	}
+
+	gvr, namespaced, err := resolve(ctx, obj.GroupVersionKind())
+	if err != nil {
+		return fail(err)
+	}
+	var resource dynamic.ResourceInterface = client.Resource(gvr)
+	if namespaced {
+		if obj.GetNamespace() == "" {
+			namespace := strings.TrimSpace(opts.Namespace)
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			obj.SetNamespace(namespace)
+		}
+		result.Namespace = obj.GetNamespace()
+		resource = client.Resource(gvr).Namespace(obj.GetNamespace())
+	} else {
+		obj.SetNamespace("")
+		result.Namespace = ""
+	}
+
+	live, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		result.Action = ActionCreate
+		live = nil
+	case err != nil:
+		return fail(err)
+	default:
+		result.Action = ActionUpdate
+	}
+
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return fail(fmt.Errorf("failed to encode manifest: %w", err))
+	}
+	options := metav1.PatchOptions{FieldManager: FieldManager, Force: &opts.Force}
+	if opts.DryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	applied, err := resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, options)
+	if err != nil {
+		return fail(err)
+	}
+	live, applied = withholdSecretValues(live, applied)
+	var liveYAML string
+	if live != nil {
+		if liveYAML, err = comparable(live); err != nil {
+			return fail(err)
+		}
+	}
+	appliedYAML, err := comparable(applied)
+	if err != nil {
+		return fail(err)
+	}
+	if result.Action == ActionUpdate && appliedYAML == liveYAML {
+		result.Action = ActionUnchanged
+		return result
+	}
+	name := result.Kind + "/" + result.Name
+	result.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(appliedYAML),
+		FromFile: "live/" + name,
+		ToFile:   "applied/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return fail(err)
+	}
+	return result
+}
+
+// withholdSecretValues returns copies of a Secret's live and applied states
+// with every data and stringData value, and the last-applied annotation that
+// repeats them, replaced by a placeholder, so the diff never carries a Secret
+// value. A value the apply adds or changes gets its own placeholder, which
+// keeps it in the diff and keeps a value-only change from reading as
+// unchanged. Objects that are not Secrets are returned as is.
+func withholdSecretValues(live, applied *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	if applied.GetAPIVersion() != "v1" || applied.GetKind() != "Secret" {
+		return live, applied
+	}
+	applied = applied.DeepCopy()
+	var liveObject map[string]any
+	if live != nil {
+		live = live.DeepCopy()
+		liveObject = live.Object
+	}
+	withhold := func(liveValue any, liveFound bool, value any) string {
+		if live != nil && (!liveFound || !reflect.DeepEqual(liveValue, value)) {
+			return changedSecretValue
+		}
+		return withheldSecretValue
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		liveValues, _, _ := unstructured.NestedMap(liveObject, field)
+		if values, found, _ := unstructured.NestedMap(applied.Object, field); found {
+			for key, value := range values {
+				liveValue, liveFound := liveValues[key]
+				values[key] = withhold(liveValue, liveFound, value)
+			}
+			_ = unstructured.SetNestedMap(applied.Object, values, field)
+		}
+		if liveValues != nil {
+			for key := range liveValues {
+				liveValues[key] = withheldSecretValue
+			}
+			_ = unstructured.SetNestedMap(liveObject, liveValues, field)
+		}
+	}
+
+	var liveAnnotations map[string]string
+	if live != nil {
+		liveAnnotations = live.GetAnnotations()
+	}
+	if annotations := applied.GetAnnotations(); annotations != nil {
+		if value, found := annotations[lastAppliedAnnotation]; found {
+			liveValue, liveFound := liveAnnotations[lastAppliedAnnotation]
+			annotations[lastAppliedAnnotation] = withhold(liveValue, liveFound, value)
+			applied.SetAnnotations(annotations)
+		}
+	}
+	if _, found := liveAnnotations[lastAppliedAnnotation]; found {
+		liveAnnotations[lastAppliedAnnotation] = withheldSecretValue
+		live.SetAnnotations(liveAnnotations)
+	}
+	return live, applied
+}
+
+// comparable renders obj as YAML without the fields every write changes, so
+// an apply that changes nothing produces identical text.
+func comparable(obj *unstructured.Unstructured) (string, error) {
+	copied := obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(copied.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(copied.Object, "status")
+	data, err := yaml.Marshal(copied.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to render object: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,148 @@
+package manifestapply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestRunAppliesInDependencyOrderAndPreviewsDiffs(t *testing.T) {
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMap := func(name, value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name, "namespace": "app"},
+			"data":       map[string]any{"key": value},
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMaps: "ConfigMapList",
+		namespaces: "NamespaceList",
+	}, configMap("same", "1"), configMap("changed", "old"))
+	// The fake tracker cannot create through an apply patch; treat an apply as
+	// a full replacement, which matches server-side apply for these manifests.
+	client.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchActionImpl)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		if len(patch.PatchOptions.DryRun) > 0 {
+			return true, obj, nil
+		}
+		tracker := client.Tracker()
+		if _, err := tracker.Get(patch.GetResource(), patch.GetNamespace(), patch.GetName()); err != nil {
+			return true, obj, tracker.Create(patch.GetResource(), obj, patch.GetNamespace())
+		}
+		return true, obj, tracker.Update(patch.GetResource(), obj, patch.GetNamespace())
+	})
+	resolve := func(_ context.Context, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+		if gvk.Kind == "Namespace" {
+			return namespaces, false, nil
+		}
+		return configMaps, true, nil
+	}
+	objects := []Manifest{
+		{Source: "same.yaml", Object: configMap("same", "1")},
+		{Source: "changed.yaml", Object: configMap("changed", "new")},
+		{Object: &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "fresh"}, "data": map[string]any{"key": "x"}}}},
+		{Object: &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]any{"name": "app"}}}},
+		{Object: &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}}},
+	}
+	ctx := context.Background()
+
+	preview := Run(ctx, client, resolve, objects, Options{Namespace: "app", DryRun: true})
+	require.True(t, preview.DryRun)
+	require.Equal(t, "Namespace", preview.Results[0].Kind, "namespaces first")
+	require.Equal(t, ActionCreate, preview.Results[0].Action)
+	require.Empty(t, preview.Results[0].Namespace)
+	byName := map[string]Result{}
+	for _, result := range preview.Results[1:] {
+		byName[result.Name] = result
+	}
+	require.Equal(t, ActionUnchanged, byName["same"].Action)
+	require.Empty(t, byName["same"].Diff)
+	require.Equal(t, ActionUpdate, byName["changed"].Action)
+	require.Equal(t, "changed.yaml", byName["changed"].Source)
+	require.Contains(t, byName["changed"].Diff, "-  key: old")
+	require.Contains(t, byName["changed"].Diff, "+  key: new")
+	require.Equal(t, ActionCreate, byName["fresh"].Action)
+	require.Equal(t, "app", byName["fresh"].Namespace, "namespace defaulted from options")
+	require.Equal(t, ActionFailed, byName[""].Action)
+	require.Equal(t, map[string]int{ActionCreate: 2, ActionUpdate: 1, ActionUnchanged: 1, ActionFailed: 1}, preview.Counts)
+	_, err := client.Resource(configMaps).Namespace("app").Get(ctx, "fresh", metav1.GetOptions{})
+	require.Error(t, err, "a dry run creates nothing")
+
+	applied := Run(ctx, client, resolve, objects, Options{Namespace: "app"})
+	require.False(t, applied.DryRun)
+	require.Equal(t, 2, applied.Counts[ActionCreate])
+	live, err := client.Resource(configMaps).Namespace("app").Get(ctx, "changed", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "new", live.Object["data"].(map[string]any)["key"])
+	_, err = client.Resource(configMaps).Namespace("app").Get(ctx, "fresh", metav1.GetOptions{})
+	require.NoError(t, err)
+}
+
+func TestRunWithholdsSecretValuesFromDiffs(t *testing.T) {
+	secrets := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	secret := func(name string, data map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": name, "namespace": "app"},
+			"data":       data,
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		secrets: "SecretList",
+	},
+		secret("same", map[string]any{"password": "c2FtZQ=="}),
+		secret("changed", map[string]any{"password": "b2xkLXBhc3N3b3Jk", "user": "YWRtaW4="}),
+	)
+	client.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		obj := &unstructured.Unstructured{}
+		return true, obj, obj.UnmarshalJSON(action.(clienttesting.PatchActionImpl).GetPatch())
+	})
+	resolve := func(context.Context, schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+		return secrets, true, nil
+	}
+	fresh := secret("fresh", nil)
+	fresh.Object["stringData"] = map[string]any{"token": "plain-token"}
+	objects := []Manifest{
+		{Object: secret("same", map[string]any{"password": "c2FtZQ=="})},
+		{Object: secret("changed", map[string]any{"password": "bmV3LXBhc3N3b3Jk", "user": "YWRtaW4="})},
+		{Object: fresh},
+	}
+
+	preview := Run(context.Background(), client, resolve, objects, Options{Namespace: "app", DryRun: true})
+	byName := map[string]Result{}
+	for _, result := range preview.Results {
+		require.Empty(t, result.Error)
+		byName[result.Name] = result
+	}
+	require.Equal(t, ActionUnchanged, byName["same"].Action, "masking must not hide that nothing changed")
+	require.Equal(t, ActionUpdate, byName["changed"].Action)
+	require.Contains(t, byName["changed"].Diff, "-  password: <redacted>")
+	require.Contains(t, byName["changed"].Diff, "+  password: <redacted, changed>")
+	require.Contains(t, byName["changed"].Diff, "  user: <redacted>")
+	require.Equal(t, ActionCreate, byName["fresh"].Action)
+	require.Contains(t, byName["fresh"].Diff, "token: <redacted>")
+	for _, result := range byName {
+		for _, value := range []string{"c2FtZQ==", "b2xkLXBhc3N3b3Jk", "bmV3LXBhc3N3b3Jk", "YWRtaW4=", "plain-token"} {
+			require.NotContains(t, result.Diff, value)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package manifestapply
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// manifestExtensions are the file types LoadDirectory reads.
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// LoadDirectory reads every YAML and JSON manifest under dir, recursively and
+// in path order. Hidden files and directories (.git) are skipped, multi-document
+// files are split, and List kinds are expanded into their items. Sources are
+// paths relative to dir, with "#n" naming the nth document of a file that has
+// several.
+//
+// Documents that cannot be applied — unparsable, missing apiVersion, kind or
+// name, or declaring the same object as an earlier document — are returned as
+// failed results instead of manifests, so one bad file never hides the rest.
+// An error is only returned when dir itself cannot be read or holds more than
+// maxFiles manifest files.
+func LoadDirectory(dir string, maxFiles int) ([]Manifest, []Result, error) {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil, nil, fmt.Errorf("manifest directory is required")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info, err := os.Stat(dir); err != nil {
+		return nil, nil, err
+	} else if !info.IsDir() {
+		return nil, nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && manifestExtensions[strings.ToLower(filepath.Ext(path))] {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", dir, err)
+	}
+	if len(files) > maxFiles {
+		return nil, nil, fmt.Errorf("%s holds %d manifest files; at most %d can be applied at once", dir, len(files), maxFiles)
+	}
+	sort.Strings(files)
+
+	var manifests []Manifest
+	var problems []Result
+	seen := map[string]string{}
+	for _, path := range files {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			rel = path
+		}
+		rel = filepath.ToSlash(rel)
+		documents, err := readManifestFile(path)
+		if err != nil {
+			problems = append(problems, Result{Source: rel, Action: ActionFailed, Error: err.Error()})
+			continue
+		}
+		for i, document := range documents {
+			source := rel
+			if len(documents) > 1 {
+				source = fmt.Sprintf("%s#%d", rel, i+1)
+			}
+			if document.err != nil {
+				problems = append(problems, Result{Source: source, Action: ActionFailed, Error: document.err.Error()})
+				continue
+			}
+			for _, obj := range document.objects {
+				result := Result{Source: source, APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()}
+				if result.APIVersion == "" || result.Kind == "" || result.Name == "" {
+					result.Action, result.Error = ActionFailed, "manifest needs apiVersion, kind and metadata.name"
+					problems = append(problems, result)
+					continue
+				}
+				key := strings.Join([]string{obj.GroupVersionKind().GroupKind().String(), result.Namespace, result.Name}, "/")
+				if first, ok := seen[key]; ok {
+					result.Action, result.Error = ActionFailed, fmt.Sprintf("%s %s is already declared in %s", result.Kind, result.Name, first)
+					problems = append(problems, result)
+					continue
+				}
+				seen[key] = source
+				manifests = append(manifests, Manifest{Source: source, Object: obj})
+			}
+		}
+	}
+	return manifests, problems, nil
+}
+
+// manifestDocument is one non-empty document of a manifest file: its objects,
+// or why it could not be read.
+type manifestDocument struct {
+	objects []*unstructured.Unstructured
+	err     error
+}
+
+func readManifestFile(path string) ([]manifestDocument, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var documents []manifestDocument
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		raw, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to split YAML documents: %w", err)
+		}
+		var object map[string]any
+		if err := utilyaml.Unmarshal(raw, &object); err != nil {
+			documents = append(documents, manifestDocument{err: fmt.Errorf("invalid YAML: %w", err)})
+			continue
+		}
+		if len(object) == 0 {
+			continue
+		}
+		documents = append(documents, decodeManifestDocument(object))
+	}
+}
+
+// decodeManifestDocument expands a List into its items.
+func decodeManifestDocument(object map[string]any) manifestDocument {
+	obj := &unstructured.Unstructured{Object: object}
+	if !obj.IsList() {
+		return manifestDocument{objects: []*unstructured.Unstructured{obj}}
+	}
+	list, err := obj.ToList()
+	if err != nil {
+		return manifestDocument{err: fmt.Errorf("invalid %s: %w", obj.GetKind(), err)}
+	}
+	document := manifestDocument{}
+	for i := range list.Items {
+		document.objects = append(document.objects, &list.Items[i])
+	}
+	return document
+}
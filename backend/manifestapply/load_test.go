@@ -0,0 +1,69 @@
+package manifestapply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeManifestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+}
+
+func TestLoadDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeManifestFiles(t, dir, map[string]string{
+		"namespace.yaml":     "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: app\n",
+		"app/config.yml":     "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: one\n---\n# only a comment\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: two\n",
+		"app/list.json":      `{"apiVersion":"v1","kind":"List","items":[{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"}}]}`,
+		"app/broken.yaml":    "apiVersion: v1\nkind: [\n",
+		"app/nameless.yaml":  "apiVersion: v1\nkind: ConfigMap\n",
+		"app/duplicate.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: one\n",
+		"README.md":          "not a manifest",
+		".git/config.yaml":   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: hidden\n",
+		"app/.draft.yaml":    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: draft\n",
+	})
+
+	manifests, problems, err := LoadDirectory(dir, 10)
+	require.NoError(t, err)
+
+	sources := map[string]string{}
+	for _, manifest := range manifests {
+		sources[manifest.Object.GetName()] = manifest.Source
+	}
+	require.Equal(t, map[string]string{
+		"one": "app/config.yml#1",
+		"two": "app/config.yml#2",
+		"web": "app/list.json",
+		"app": "namespace.yaml",
+	}, sources)
+
+	failed := map[string]string{}
+	for _, problem := range problems {
+		require.Equal(t, ActionFailed, problem.Action)
+		failed[problem.Source] = problem.Error
+	}
+	require.Len(t, failed, 3)
+	require.Contains(t, failed["app/broken.yaml"], "invalid YAML")
+	require.Contains(t, failed["app/nameless.yaml"], "metadata.name")
+	require.Contains(t, failed["app/duplicate.yaml"], "already declared in app/config.yml#1")
+}
+
+func TestLoadDirectoryLimits(t *testing.T) {
+	_, _, err := LoadDirectory(" ", 10)
+	require.ErrorContains(t, err, "directory is required")
+
+	dir := t.TempDir()
+	writeManifestFiles(t, dir, map[string]string{"a.yaml": "", "b.yaml": ""})
+	_, _, err = LoadDirectory(dir, 1)
+	require.ErrorContains(t, err, "at most 1")
+	_, _, err = LoadDirectory(filepath.Join(dir, "a.yaml"), 10)
+	require.ErrorContains(t, err, "not a directory")
+}
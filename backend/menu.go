@@ -23,6 +23,9 @@ func CreateMenu(app *App) *menu.Menu {
 	// View menu
 	createViewMenu(appMenu, app)
 
+	// Clusters menu
+	createClustersMenu(appMenu, app)
+
 	// Window menu
 	createWindowMenu(appMenu, app)
 
@@ -37,6 +40,21 @@ func CreateMenu(app *App) *menu.Menu {
 	return appMenu
 }
 
+// NewWindowFlag marks a process started by File > New Window. Such a process
+// skips the single-instance lock, which would otherwise hand it straight back
+// to the window that spawned it.
+const NewWindowFlag = "--new-window"
+
+// IsNewWindowLaunch reports whether args came from File > New Window.
+func IsNewWindowLaunch(args []string) bool {
+	for _, arg := range args {
+		if arg == NewWindowFlag {
+			return true
+		}
+	}
+	return false
+}
+
 // spawnNewWindow starts a new instance of the application as a separate process
 func spawnNewWindow() {
 	execPath, err := os.Executable()
@@ -45,7 +63,7 @@ func spawnNewWindow() {
 		return
 	}
 
-	cmd := exec.Command(execPath)
+	cmd := exec.Command(execPath, NewWindowFlag)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	if err := cmd.Start(); err != nil {
@@ -65,6 +83,10 @@ func createApplicationMenu(appMenu *menu.Menu, app *App) {
 			}()
 		})
 
+		appSubmenu.AddText("Generate Diagnostic Bundle...", nil, func(_ *menu.CallbackData) {
+			go app.generateDiagnosticBundleFromMenu()
+		})
+
 		appSubmenu.AddSeparator()
 
 		appSubmenu.AddText("Settings...", keys.CmdOrCtrl(","), func(_ *menu.CallbackData) {
@@ -291,6 +313,26 @@ func createViewMenu(appMenu *menu.Menu, app *App) {
 	}
 }
 
+// createClustersMenu summarises the health of the open clusters and opens the
+// app on the one picked.
+func createClustersMenu(appMenu *menu.Menu, app *App) {
+	clustersMenu := appMenu.AddSubmenu("Clusters")
+
+	summaries := app.GetClusterHealthSummary()
+	if len(summaries) == 0 {
+		clustersMenu.AddText("No clusters open", nil, nil).Disabled = true
+		return
+	}
+
+	clustersMenu.AddText(healthSummaryTotals(summaries), nil, nil).Disabled = true
+	clustersMenu.AddSeparator()
+	for _, summary := range summaries {
+		clustersMenu.AddText(healthSummaryLabel(summary), nil, func(_ *menu.CallbackData) {
+			go app.openClusterFromMenu(summary)
+		})
+	}
+}
+
 // createDebugMenu exposes development-only debug overlays.
 func createDebugMenu(appMenu *menu.Menu, app *App) {
 	debugMenu := appMenu.AddSubmenu("Debug")
@@ -400,4 +442,8 @@ func createHelpMenu(appMenu *menu.Menu, app *App) {
 			app.ShowAbout()
 		}()
 	})
+
+	helpMenu.AddText("Generate Diagnostic Bundle...", nil, func(_ *menu.CallbackData) {
+		go app.generateDiagnosticBundleFromMenu()
+	})
 }
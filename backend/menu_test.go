@@ -27,15 +27,15 @@ func TestCreateMenuTopLevelLabels(t *testing.T) {
 	var expected []string
 	switch runtime.GOOS {
 	case "darwin":
-		expected = []string{"Luxury Yacht", "File", "Edit", "View", "Window"}
+		expected = []string{"Luxury Yacht", "File", "Edit", "View", "Clusters", "Window"}
 	default:
-		expected = []string{"File", "Edit", "View", "Window", "Help"}
+		expected = []string{"File", "Edit", "View", "Clusters", "Window", "Help"}
 	}
 	if appDebugMenuEnabled {
 		if runtime.GOOS == "darwin" {
-			expected = []string{"Luxury Yacht", "File", "Edit", "View", "Window", "Debug"}
+			expected = []string{"Luxury Yacht", "File", "Edit", "View", "Clusters", "Window", "Debug"}
 		} else {
-			expected = []string{"File", "Edit", "View", "Window", "Debug", "Help"}
+			expected = []string{"File", "Edit", "View", "Clusters", "Window", "Debug", "Help"}
 		}
 	}
 
@@ -265,3 +265,21 @@ func TestDebugMenuItemsEmitFrontendEvents(t *testing.T) {
 		}
 	}
 }
+
+func TestIsNewWindowLaunch(t *testing.T) {
+	if !IsNewWindowLaunch([]string{NewWindowFlag}) {
+		t.Fatal("expected the New Window flag to be recognised")
+	}
+	if IsNewWindowLaunch([]string{"luxury-yacht://cluster/prod"}) {
+		t.Fatal("a deep link launch is not a New Window launch")
+	}
+}
+
+func TestHelpMenuOffersDiagnosticBundle(t *testing.T) {
+	m := CreateMenu(&App{})
+	label := "Help"
+	if runtime.GOOS == "darwin" {
+		label = "Luxury Yacht"
+	}
+	assertMenuContainsLabel(t, menuLabels(findSubmenu(t, m, label)), "Generate Diagnostic Bundle...")
+}
@@ -0,0 +1,54 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/networkpolicy"
+)
+
+// AnalyzeNetworkPolicyConnectivity reports whether traffic from the query's
+// source pod (or namespace) may reach its destination on one port, after
+// evaluating every NetworkPolicy selecting either side, and names the rules
+// that decide it.
+func (a *App) AnalyzeNetworkPolicyConnectivity(clusterID string, query networkpolicy.ConnectivityQuery) (*networkpolicy.ConnectivityResult, error) {
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "NetworkPolicy"); err != nil {
+		return nil, err
+	}
+	listers := a.networkPolicyConnectivityListers(deps.ClusterID)
+	result, err := networkpolicy.NewService(deps).AnalyzeConnectivity(listers, query)
+	if err != nil {
+		return nil, err
+	}
+	a.logger.Debug(
+		fmt.Sprintf("NetworkPolicy connectivity %s/%s -> %s/%s %d/%s: allowed=%t", query.SourceNamespace, query.SourcePod, query.DestinationNamespace, query.DestinationPod, result.Query.Port, result.Query.Protocol, result.Allowed),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
+
+// networkPolicyConnectivityListers returns the cluster's shared namespace
+// lister for the analysis, or nil when the refresh subsystem is not running,
+// the user cannot list and watch namespaces, or the informer has not synced
+// yet. Pods and NetworkPolicies are ingest-owned kinds with no typed informer
+// in the shared factory; asking the factory for one would register an
+// informer nothing starts, so the analysis reads those from the API.
+func (a *App) networkPolicyConnectivityListers(clusterID string) *networkpolicy.ConnectivityListers {
+	subsystem := a.getRefreshSubsystem(clusterID)
+	if subsystem == nil || subsystem.InformerFactory == nil {
+		return nil
+	}
+	shared := subsystem.InformerFactory.SharedInformerFactory()
+	if shared == nil || !subsystem.InformerFactory.CanListWatch("", "namespaces") {
+		return nil
+	}
+	namespaces := shared.Core().V1().Namespaces()
+	if !namespaces.Informer().HasSynced() {
+		return nil
+	}
+	return &networkpolicy.ConnectivityListers{Namespaces: namespaces.Lister()}
+}
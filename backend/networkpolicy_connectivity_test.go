@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+	cgotesting "k8s.io/client-go/testing"
+
+	refreshinformer "github.com/luxury-yacht/app/backend/refresh/informer"
+	refreshpermissions "github.com/luxury-yacht/app/backend/refresh/permissions"
+	"github.com/luxury-yacht/app/backend/refresh/system"
+	"github.com/luxury-yacht/app/backend/resources/networkpolicy"
+)
+
+func TestAnalyzeNetworkPolicyConnectivityDefaultDeny(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "default"},
+			Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		},
+	)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	result, err := app.AnalyzeNetworkPolicyConnectivity("config:ctx", networkpolicy.ConnectivityQuery{
+		SourceNamespace: "default", DestinationNamespace: "default", DestinationPod: "db", Port: 5432,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "config:ctx", result.ClusterID)
+	require.False(t, result.Allowed)
+	require.Equal(t, "deny-all", result.Ingress.SelectingPolicies[0].Name)
+	require.Equal(t, "config:ctx", result.Ingress.SelectingPolicies[0].ClusterID)
+}
+
+func TestAnalyzeNetworkPolicyConnectivityUsesStartedInformerFactory(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "default"},
+			Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		},
+	)
+	// Namespace reads must come from the informer cache, not a live Get.
+	client.PrependReactor("get", "namespaces", func(cgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("namespace get must be served by the informer")
+	})
+	checker := refreshpermissions.NewCheckerWithReview("config:ctx", time.Minute, func(context.Context, string, string, string, string) (bool, error) {
+		return true, nil
+	})
+	factory := refreshinformer.New(client, nil, time.Minute, checker)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, factory.Start(ctx))
+
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+	app.setRefreshSubsystem("config:ctx", &system.Subsystem{InformerFactory: factory})
+
+	result, err := app.AnalyzeNetworkPolicyConnectivity("config:ctx", networkpolicy.ConnectivityQuery{
+		SourceNamespace: "default", DestinationNamespace: "default", DestinationPod: "db", Port: 5432,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Equal(t, "deny-all", result.Ingress.SelectingPolicies[0].Name)
+
+	// The analysis must not register typed informers for the ingest-owned
+	// kinds: starting the factory again would open duplicate watches.
+	shared := factory.SharedInformerFactory()
+	shared.Start(ctx.Done())
+	for informerType := range shared.WaitForCacheSync(ctx.Done()) {
+		require.NotEqual(t, reflect.TypeOf(&corev1.Pod{}), informerType)
+		require.NotEqual(t, reflect.TypeOf(&networkingv1.NetworkPolicy{}), informerType)
+	}
+}
@@ -139,6 +139,7 @@ func (a *App) deleteNodeAction(target ObjectActionTargetRef, force bool) error {
 	}); err != nil {
 		return err
 	}
+	a.captureDeletedObject(deps, target)
 	if err := nodes.NewService(deps).Delete(target.Name, force); err != nil {
 		return err
 	}
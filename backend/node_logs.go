@@ -8,6 +8,10 @@
 package backend
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/resources/nodes"
 )
 
@@ -34,6 +38,9 @@ func (a *App) FetchNodeLogs(clusterID, nodeName string, req NodeLogFetchRequest)
 	if err := requireObjectName(nodeName); err != nil {
 		return NodeLogFetchResponse{Error: err.Error(), SourcePath: req.SourcePath}
 	}
+	if nodes.IsDebugPodLogSource(req.SourcePath) {
+		return a.fetchNodeLogsFromDebugPod(clusterID, nodeName, req)
+	}
 	deps, _, err := a.resolveClusterDependencies(clusterID)
 	if err != nil {
 		return NodeLogFetchResponse{Error: err.Error(), SourcePath: req.SourcePath}
@@ -49,3 +56,82 @@ func (a *App) FetchNodeLogs(clusterID, nodeName string, req NodeLogFetchRequest)
 	}
 	return nodes.NewService(deps).FetchLogs(nodeName, req)
 }
+
+// StartNodeLogDebugPod starts (or reuses) a debug pod on the node that reads
+// its logs through the host filesystem, for nodes whose kubelet does not serve
+// /logs, and returns the sources it can read.
+func (a *App) StartNodeLogDebugPod(clusterID, nodeName string, req NodeLogDebugPodRequest) NodeLogDiscoveryResponse {
+	if err := requireObjectName(nodeName); err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	if err := a.requireClusterWritable(clusterID, "node log debug pod"); err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      "Pod",
+		Namespace: req.Namespace,
+		Verb:      "create",
+	}); err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	pod, err := nodes.NewService(deps).EnsureLogDebugPod(nodeName, req.Namespace, req.Image)
+	if err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	capture, err := a.capturePodExec(clusterID, "node log discovery", pod.Namespace, pod.Name, "", nodes.NodeLogDebugSourcesCommand())
+	if err != nil {
+		return NodeLogDiscoveryResponse{Reason: err.Error()}
+	}
+	return nodes.ParseDebugPodLogSources(pod, capture.stdout)
+}
+
+// StopNodeLogDebugPod deletes the node's log debug pods in req.Namespace.
+func (a *App) StopNodeLogDebugPod(clusterID, nodeName string, req NodeLogDebugPodRequest) error {
+	if err := requireObjectName(nodeName); err != nil {
+		return err
+	}
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return err
+	}
+	if err := a.requireResourcePermission(deps.Context, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      "Pod",
+		Namespace: req.Namespace,
+		Verb:      "delete",
+	}); err != nil {
+		return err
+	}
+	return nodes.NewService(deps).StopLogDebugPods(nodeName, req.Namespace)
+}
+
+// fetchNodeLogsFromDebugPod reads a debug pod source by exec. The pod named in
+// the source path must be a log debug pod on this node.
+func (a *App) fetchNodeLogsFromDebugPod(clusterID, nodeName string, req NodeLogFetchRequest) NodeLogFetchResponse {
+	namespace, podName, source, err := nodes.ParseDebugPodLogSource(req.SourcePath)
+	if err != nil {
+		return NodeLogFetchResponse{Error: err.Error(), SourcePath: req.SourcePath}
+	}
+	target, err := a.resolvePodExecTarget(clusterID, "node log fetch", namespace, podName, "")
+	if err != nil {
+		return NodeLogFetchResponse{Error: err.Error(), SourcePath: req.SourcePath}
+	}
+	if !nodes.IsLogDebugPod(target.pod, nodeName) {
+		return NodeLogFetchResponse{
+			Error:      fmt.Sprintf("pod %s/%s is not a log debug pod on node %s", namespace, podName, nodeName),
+			SourcePath: req.SourcePath,
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.PodExecCaptureTimeout)
+	defer cancel()
+	stdout := &cappedBuffer{limit: config.PodExecCaptureMaxOutputBytes}
+	if err := target.stream(ctx, nodes.DebugPodLogCommand(source, req.SinceTime, req.TailBytes), stdout); err != nil {
+		return NodeLogFetchResponse{Error: err.Error(), SourcePath: req.SourcePath}
+	}
+	return nodes.DebugPodLogResponse(req.SourcePath, source, stdout.Bytes(), req.TailBytes)
+}
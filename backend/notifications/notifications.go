@@ -0,0 +1,243 @@
+/*
+ * backend/notifications/notifications.go
+ *
+ * Turns Attention findings into desktop notifications. Rules choose which
+ * findings notify (Warning events, CrashLoopBackOff pods, failed Jobs,
+ * NotReady nodes), quiet hours mute them, and a Tracker reports each finding
+ * once, when it first appears.
+ */
+
+package notifications
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Rule identifiers, reported on every Notification.
+const (
+	RuleWarningEvents    = "warning-events"
+	RuleCrashLoopBackOff = "crash-loop-backoff"
+	RuleFailedJobs       = "failed-jobs"
+	RuleNodeNotReady     = "node-not-ready"
+	// RuleAlert marks notifications raised by user-defined alert rules.
+	RuleAlert = "alert"
+)
+
+// clockLayout is the quiet hours format, a local 24-hour time.
+const clockLayout = "15:04"
+
+// Rules selects the findings that raise a notification.
+type Rules struct {
+	WarningEvents    bool `json:"warningEvents"`
+	CrashLoopBackOff bool `json:"crashLoopBackOff"`
+	FailedJobs       bool `json:"failedJobs"`
+	NodeNotReady     bool `json:"nodeNotReady"`
+	// EventReasons limits Warning event notifications to these reasons,
+	// compared case-insensitively. Empty allows every reason.
+	EventReasons []string `json:"eventReasons,omitempty"`
+	// Namespaces limits notifications to these namespaces. Empty allows every
+	// namespace; cluster-scoped objects such as nodes always pass.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// QuietHours mutes notifications between Start and End, local "HH:MM" times.
+// A window whose End is before its Start runs over midnight.
+type QuietHours struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+}
+
+// Settings is the app-wide notification configuration. Clusters opt in
+// separately.
+type Settings struct {
+	Rules      Rules      `json:"rules"`
+	QuietHours QuietHours `json:"quietHours"`
+}
+
+// DefaultSettings notifies for every rule, with quiet hours off.
+func DefaultSettings() Settings {
+	return Settings{
+		Rules:      Rules{WarningEvents: true, CrashLoopBackOff: true, FailedJobs: true, NodeNotReady: true},
+		QuietHours: QuietHours{Start: "22:00", End: "07:00"},
+	}
+}
+
+// Normalize trims and dedupes the rule filters and validates quiet hours.
+func (s Settings) Normalize() (Settings, error) {
+	s.Rules.EventReasons = normalizeList(s.Rules.EventReasons)
+	s.Rules.Namespaces = normalizeList(s.Rules.Namespaces)
+	s.QuietHours.Start = strings.TrimSpace(s.QuietHours.Start)
+	s.QuietHours.End = strings.TrimSpace(s.QuietHours.End)
+	if _, err := time.Parse(clockLayout, s.QuietHours.Start); err != nil {
+		return s, fmt.Errorf("invalid quiet hours start %q: use HH:MM", s.QuietHours.Start)
+	}
+	if _, err := time.Parse(clockLayout, s.QuietHours.End); err != nil {
+		return s, fmt.Errorf("invalid quiet hours end %q: use HH:MM", s.QuietHours.End)
+	}
+	return s, nil
+}
+
+// Contains reports whether now falls inside the quiet hours. Start is
+// inclusive and End exclusive; equal times mean the whole day. Unparseable
+// times never mute.
+func (q QuietHours) Contains(now time.Time) bool {
+	if !q.Enabled {
+		return false
+	}
+	start, err := time.Parse(clockLayout, q.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(clockLayout, q.End)
+	if err != nil {
+		return false
+	}
+	minute := now.Hour()*60 + now.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	switch {
+	case from == to:
+		return true
+	case from < to:
+		return minute >= from && minute < to
+	default:
+		return minute >= from || minute < to
+	}
+}
+
+// Notification is one desktop notification raised for a finding.
+type Notification struct {
+	ClusterID   string                    `json:"clusterId"`
+	ClusterName string                    `json:"clusterName"`
+	Rule        string                    `json:"rule"`
+	Ref         resourcemodel.ResourceRef `json:"ref"`
+	Title       string                    `json:"title"`
+	Body        string                    `json:"body"`
+}
+
+// Match returns the notification a finding raises under rules, if any.
+func Match(rules Rules, finding snapshot.AttentionFinding) (Notification, bool) {
+	if len(rules.Namespaces) > 0 && finding.Namespace != "" && !slices.Contains(rules.Namespaces, finding.Namespace) {
+		return Notification{}, false
+	}
+	ref := finding.Ref
+	notification := Notification{ClusterID: ref.ClusterID, Ref: ref}
+	switch {
+	case ref.Kind == "Event" && strings.EqualFold(finding.Status, "Warning"):
+		if !rules.WarningEvents {
+			return Notification{}, false
+		}
+		reason, message := eventReasonAndMessage(finding)
+		if len(rules.EventReasons) > 0 && !slices.ContainsFunc(rules.EventReasons, func(allowed string) bool {
+			return strings.EqualFold(allowed, reason)
+		}) {
+			return Notification{}, false
+		}
+		notification.Rule = RuleWarningEvents
+		notification.Title = "Warning: " + reason
+		notification.Body = withNamespace(finding.Namespace, message)
+	case ref.Kind == "Pod" && finding.Status == "CrashLoopBackOff":
+		if !rules.CrashLoopBackOff {
+			return Notification{}, false
+		}
+		notification.Rule = RuleCrashLoopBackOff
+		notification.Title = "Pod crash looping"
+		notification.Body = withNamespace(ref.Namespace, ref.Name+" is in CrashLoopBackOff")
+	case ref.Kind == "Job" && finding.Status == "Failed":
+		if !rules.FailedJobs {
+			return Notification{}, false
+		}
+		notification.Rule = RuleFailedJobs
+		notification.Title = "Job failed"
+		notification.Body = withNamespace(ref.Namespace, ref.Name+" failed")
+	case ref.Kind == "Node" && (finding.Status == "NotReady" || finding.Status == "Unknown"):
+		if !rules.NodeNotReady {
+			return Notification{}, false
+		}
+		notification.Rule = RuleNodeNotReady
+		notification.Title = "Node not ready"
+		notification.Body = fmt.Sprintf("%s is %s", ref.Name, finding.Status)
+	default:
+		return Notification{}, false
+	}
+	return notification, true
+}
+
+// eventReasonAndMessage splits a Warning event finding's cause, which the
+// Attention index renders as "Reason · Message".
+func eventReasonAndMessage(finding snapshot.AttentionFinding) (string, string) {
+	for _, cause := range finding.Causes {
+		if cause.Type != "warning-event" {
+			continue
+		}
+		reason, message, found := strings.Cut(cause.Message, " · ")
+		if !found {
+			return cause.Message, cause.Message
+		}
+		return reason, message
+	}
+	return finding.Status, finding.Ref.Name
+}
+
+func withNamespace(namespace, text string) string {
+	if namespace == "" {
+		return text
+	}
+	return namespace + ": " + text
+}
+
+func normalizeList(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
+
+// Tracker remembers the notifications raised for one cluster so each finding
+// notifies once while it lasts. The first Observe only records what is
+// already there: connecting to a cluster does not replay its standing
+// problems.
+type Tracker struct {
+	primed bool
+	active map[string]struct{}
+}
+
+// Observe takes the cluster's current findings and returns the notifications
+// for findings that were not present at the previous call.
+func (t *Tracker) Observe(rules Rules, findings []snapshot.AttentionFinding) []Notification {
+	active := make(map[string]struct{}, len(t.active))
+	var raised []Notification
+	for _, finding := range findings {
+		notification, ok := Match(rules, finding)
+		if !ok {
+			continue
+		}
+		key := notification.Rule + "|" + refKey(finding.Ref)
+		active[key] = struct{}{}
+		if _, seen := t.active[key]; seen || !t.primed {
+			continue
+		}
+		raised = append(raised, notification)
+	}
+	t.active = active
+	t.primed = true
+	return raised
+}
+
+func refKey(ref resourcemodel.ResourceRef) string {
+	return strings.Join([]string{ref.Group, ref.Kind, ref.Namespace, ref.Name, ref.UID}, "/")
+}
@@ -0,0 +1,95 @@
+package notifications_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/notifications"
+	"github.com/luxury-yacht/app/backend/refresh/snapshot"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func crashLoopingPod(name string) snapshot.AttentionFinding {
+	return snapshot.AttentionFinding{
+		Ref:       resourcemodel.ResourceRef{ClusterID: "config:ctx", Version: "v1", Kind: "Pod", Namespace: "prod", Name: name, UID: name + "-uid"},
+		Namespace: "prod",
+		Status:    "CrashLoopBackOff",
+	}
+}
+
+func warningEvent(namespace, reason string) snapshot.AttentionFinding {
+	return snapshot.AttentionFinding{
+		Ref:       resourcemodel.ResourceRef{ClusterID: "config:ctx", Version: "v1", Kind: "Event", Namespace: namespace, Name: "web.1", UID: "evt-uid"},
+		Namespace: namespace,
+		Status:    "Warning",
+		Causes:    []snapshot.AttentionCause{{Type: "warning-event", Message: reason + " · Back-off pulling image"}},
+	}
+}
+
+func TestMatchAppliesRules(t *testing.T) {
+	rules := notifications.DefaultSettings().Rules
+
+	notification, ok := notifications.Match(rules, warningEvent("prod", "BackOff"))
+	require.True(t, ok)
+	require.Equal(t, notifications.RuleWarningEvents, notification.Rule)
+	require.Equal(t, "Warning: BackOff", notification.Title)
+	require.Equal(t, "prod: Back-off pulling image", notification.Body)
+
+	node := snapshot.AttentionFinding{Ref: resourcemodel.ResourceRef{Kind: "Node", Name: "worker-1"}, Status: "Unknown"}
+	notification, ok = notifications.Match(rules, node)
+	require.True(t, ok)
+	require.Equal(t, notifications.RuleNodeNotReady, notification.Rule)
+
+	job := snapshot.AttentionFinding{Ref: resourcemodel.ResourceRef{Group: "batch", Kind: "Job", Namespace: "prod", Name: "migrate"}, Namespace: "prod", Status: "Failed"}
+	_, ok = notifications.Match(notifications.Rules{}, job)
+	require.False(t, ok, "disabled rules do not notify")
+
+	rules.EventReasons = []string{"FailedMount"}
+	_, ok = notifications.Match(rules, warningEvent("prod", "BackOff"))
+	require.False(t, ok)
+
+	rules.Namespaces = []string{"staging"}
+	_, ok = notifications.Match(rules, crashLoopingPod("web"))
+	require.False(t, ok)
+	_, ok = notifications.Match(rules, node)
+	require.True(t, ok, "cluster-scoped findings ignore the namespace filter")
+}
+
+func TestTrackerNotifiesNewFindingsOnce(t *testing.T) {
+	rules := notifications.DefaultSettings().Rules
+	var tracker notifications.Tracker
+
+	require.Empty(t, tracker.Observe(rules, []snapshot.AttentionFinding{crashLoopingPod("api")}), "standing findings are not replayed")
+
+	raised := tracker.Observe(rules, []snapshot.AttentionFinding{crashLoopingPod("api"), crashLoopingPod("web")})
+	require.Len(t, raised, 1)
+	require.Equal(t, "prod: web is in CrashLoopBackOff", raised[0].Body)
+
+	require.Empty(t, tracker.Observe(rules, []snapshot.AttentionFinding{crashLoopingPod("api"), crashLoopingPod("web")}))
+
+	// A finding that clears and comes back notifies again.
+	require.Empty(t, tracker.Observe(rules, []snapshot.AttentionFinding{crashLoopingPod("api")}))
+	require.Len(t, tracker.Observe(rules, []snapshot.AttentionFinding{crashLoopingPod("api"), crashLoopingPod("web")}), 1)
+}
+
+func TestQuietHours(t *testing.T) {
+	at := func(hour, minute int) time.Time { return time.Date(2026, 1, 1, hour, minute, 0, 0, time.Local) }
+
+	overnight := notifications.QuietHours{Enabled: true, Start: "22:00", End: "07:00"}
+	require.True(t, overnight.Contains(at(23, 30)))
+	require.True(t, overnight.Contains(at(6, 59)))
+	require.False(t, overnight.Contains(at(7, 0)))
+	require.False(t, overnight.Contains(at(12, 0)))
+
+	daytime := notifications.QuietHours{Enabled: true, Start: "09:00", End: "17:00"}
+	require.True(t, daytime.Contains(at(9, 0)))
+	require.False(t, daytime.Contains(at(20, 0)))
+
+	overnight.Enabled = false
+	require.False(t, overnight.Contains(at(23, 30)))
+
+	_, err := notifications.Settings{QuietHours: notifications.QuietHours{Start: "25:00", End: "07:00"}}.Normalize()
+	require.Error(t, err)
+}
@@ -69,6 +69,9 @@ type ObjectActionRequest struct {
 	PortForward    *ObjectActionPortForwardOptions    `json:"portForward,omitempty"`
 	DebugContainer *ObjectActionDebugContainerOptions `json:"debugContainer,omitempty"`
 	Revision       *int64                             `json:"revision,omitempty"`
+	// Confirmation is the user-typed target name required for destructive
+	// actions in protected namespaces.
+	Confirmation string `json:"confirmation,omitempty"`
 }
 
 type ObjectActionResponse struct {
@@ -76,6 +79,9 @@ type ObjectActionResponse struct {
 	JobID          string                  `json:"jobId,omitempty"`
 	SessionID      string                  `json:"sessionId,omitempty"`
 	DebugContainer *DebugContainerResponse `json:"debugContainer,omitempty"`
+	// KubectlCommand is the equivalent kubectl command, for copying into
+	// change tickets or running elsewhere.
+	KubectlCommand string `json:"kubectlCommand,omitempty"`
 }
 
 func objectActionTarget(clusterID, group, version, kind, namespace, name string) ObjectActionTargetRef {
@@ -159,7 +165,29 @@ func (a *App) RunObjectAction(req ObjectActionRequest) (ObjectActionResponse, er
 	if err != nil {
 		return ObjectActionResponse{}, err
 	}
+	// Port-forwarding reads from a pod without changing cluster state, so it
+	// stays available in read-only mode.
+	if action != ObjectActionStartPortForward {
+		if err := a.requireClusterWritable(target.ClusterID, action); err != nil {
+			return ObjectActionResponse{}, err
+		}
+	}
+	if err := a.requireProtectedNamespaceConfirmation(action, target, req.Confirmation); err != nil {
+		return ObjectActionResponse{}, err
+	}
+
+	response, err := a.runObjectAction(action, target, req)
+	if err != nil {
+		return response, err
+	}
+	if command, err := a.objectActionKubectlCommand(action, target, req, response); err == nil {
+		response.KubectlCommand = command
+	}
+	return response, nil
+}
 
+// runObjectAction dispatches a validated action to its handler.
+func (a *App) runObjectAction(action string, target ObjectActionTargetRef, req ObjectActionRequest) (ObjectActionResponse, error) {
 	switch action {
 	case ObjectActionDelete:
 		return ObjectActionResponse{}, a.deleteObjectAction(target, false)
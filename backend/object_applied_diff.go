@@ -0,0 +1,376 @@
+/*
+ * backend/object_applied_diff.go
+ *
+ * Drift between what was last applied and what is live. For objects
+ * applied with kubectl's client-side apply the declared manifest is the
+ * last-applied-configuration annotation, and every declared field whose
+ * live value differs is drift. Server-side apply keeps no copy of the
+ * applied values, so for those objects the drift reported is the set of
+ * fields an imperative writer (Update operation) now owns. Either way each
+ * change names the field managers that own it, so the writer can be found.
+ */
+
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Where the declared state of an applied diff comes from.
+const (
+	AppliedDiffSourceLastApplied     = "last-applied"
+	AppliedDiffSourceServerSideApply = "server-side-apply"
+)
+
+// Applied diff change types.
+const (
+	// AppliedDiffChanged: the live value differs from the declared one.
+	AppliedDiffChanged = "changed"
+	// AppliedDiffMissing: a declared field is absent from the live object.
+	AppliedDiffMissing = "missing"
+	// AppliedDiffUpdatedOutsideApply: an imperative writer owns a field of a
+	// server-side applied object.
+	AppliedDiffUpdatedOutsideApply = "updated-outside-apply"
+)
+
+// ObjectAppliedDiffChange is one drifted field.
+type ObjectAppliedDiffChange struct {
+	// Path uses the field ownership notation, e.g. .spec.replicas.
+	Path string `json:"path"`
+	Type string `json:"type"`
+	// Declared and Live are JSON-encoded values; empty when absent or unknown.
+	Declared string `json:"declared,omitempty"`
+	Live     string `json:"live,omitempty"`
+	// Managers own the field, or part of it, on the live object.
+	Managers []string `json:"managers,omitempty"`
+}
+
+// ObjectAppliedDiff compares an object's applied state with its live state.
+type ObjectAppliedDiff struct {
+	Source string `json:"source"`
+	// Appliers are the server-side apply field managers.
+	Appliers []string `json:"appliers,omitempty"`
+	// Declared is the last-applied manifest as YAML; server-side apply keeps none.
+	Declared string `json:"declared,omitempty"`
+	// Live is the live object as YAML without server-populated fields.
+	Live    string                    `json:"live"`
+	Changes []ObjectAppliedDiffChange `json:"changes"`
+}
+
+// DiffObjectAgainstLastApplied fetches the object by apiVersion + kind and
+// reports the drift between its last applied state and its live state.
+func (a *App) DiffObjectAgainstLastApplied(clusterID, apiVersion, kind, namespace, name string) (*ObjectAppliedDiff, error) {
+	gvk := schema.FromAPIVersionAndKind(strings.TrimSpace(apiVersion), strings.TrimSpace(kind))
+	if gvk.Kind == "" {
+		return nil, fmt.Errorf("kind is required")
+	}
+	if gvk.Version == "" {
+		return nil, fmt.Errorf("apiVersion is required")
+	}
+	if err := requireObjectName(name); err != nil {
+		return nil, err
+	}
+
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := fetchObjectByGVK(deps.Context, deps, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return objectAppliedDiff(obj)
+}
+
+func objectAppliedDiff(obj *unstructured.Unstructured) (*ObjectAppliedDiff, error) {
+	ownership, err := objectFieldOwnership(obj)
+	if err != nil {
+		return nil, err
+	}
+	live := stripServerManagedFields(obj)
+	unstructured.RemoveNestedField(live.Object, "metadata", "annotations", lastAppliedConfigAnnotation)
+	if len(live.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(live.Object, "metadata", "annotations")
+	}
+	liveYAML, err := yaml.Marshal(withholdSecretValues(live.Object, nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render live object: %w", err)
+	}
+	diff := &ObjectAppliedDiff{Live: string(liveYAML), Changes: []ObjectAppliedDiffChange{}}
+
+	if lastApplied := obj.GetAnnotations()[lastAppliedConfigAnnotation]; lastApplied != "" {
+		var declared map[string]any
+		if err := json.Unmarshal([]byte(lastApplied), &declared); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", lastAppliedConfigAnnotation, err)
+		}
+		unstructured.RemoveNestedField(declared, "status")
+		renderedDeclared := declared
+		if isCoreSecret(obj.Object) {
+			renderedDeclared = withholdSecretFields(declared, nil)
+		}
+		declaredYAML, err := yaml.Marshal(renderedDeclared)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render last-applied configuration: %w", err)
+		}
+		diff.Source = AppliedDiffSourceLastApplied
+		diff.Declared = string(declaredYAML)
+		compareAppliedValues("", declared, obj.Object, &diff.Changes)
+		for i := range diff.Changes {
+			diff.Changes[i].Managers = fieldManagersAt(ownership, diff.Changes[i].Path)
+		}
+		withholdAppliedDiffSecretValues(obj, diff)
+		return diff, nil
+	}
+
+	appliers := map[string]bool{}
+	for _, manager := range ownership.Managers {
+		if manager.Operation == string(metav1.ManagedFieldsOperationApply) {
+			appliers[manager.Manager] = true
+		}
+	}
+	if len(appliers) == 0 {
+		return nil, fmt.Errorf("%s %s has no last-applied configuration or server-side apply manager", obj.GetKind(), obj.GetName())
+	}
+	diff.Source = AppliedDiffSourceServerSideApply
+	for manager := range appliers {
+		diff.Appliers = append(diff.Appliers, manager)
+	}
+	sort.Strings(diff.Appliers)
+	for _, manager := range ownership.Managers {
+		if manager.Operation == string(metav1.ManagedFieldsOperationApply) || manager.Subresource != "" {
+			continue
+		}
+		for _, field := range manager.Fields {
+			if strings.HasPrefix(field, ".metadata.") || strings.HasPrefix(field, ".status.") {
+				continue
+			}
+			change := ObjectAppliedDiffChange{Path: field, Type: AppliedDiffUpdatedOutsideApply, Managers: fieldManagersAt(ownership, field)}
+			if value, ok := liveValueAt(obj, field); ok {
+				change.Live = encodeAppliedValue(value)
+			}
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool { return diff.Changes[i].Path < diff.Changes[j].Path })
+	withholdAppliedDiffSecretValues(obj, diff)
+	return diff, nil
+}
+
+// withholdAppliedDiffSecretValues keeps a Secret's drifted values out of the
+// diff: the change is still reported, its values are not.
+func withholdAppliedDiffSecretValues(obj *unstructured.Unstructured, diff *ObjectAppliedDiff) {
+	if !isCoreSecret(obj.Object) {
+		return
+	}
+	for i := range diff.Changes {
+		change := &diff.Changes[i]
+		if !isSecretValuePath(change.Path) {
+			continue
+		}
+		if change.Declared != "" {
+			change.Declared = encodeAppliedValue(withheldSecretText)
+		}
+		if change.Live != "" {
+			change.Live = encodeAppliedValue(withheldSecretText)
+		}
+	}
+}
+
+// compareAppliedValues walks the declared value and records each declared
+// field whose live value differs. Fields only present live are defaults or
+// controller additions and are not drift. Lists of named items are matched
+// by name; other lists compare as a whole.
+func compareAppliedValues(path string, declared, live any, changes *[]ObjectAppliedDiffChange) {
+	switch declaredValue := declared.(type) {
+	case map[string]any:
+		liveMap, ok := live.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(declaredValue))
+		for key := range declaredValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := path + "." + key
+			liveChild, present := liveMap[key]
+			if !present {
+				*changes = append(*changes, ObjectAppliedDiffChange{Path: child, Type: AppliedDiffMissing, Declared: encodeAppliedValue(declaredValue[key])})
+				continue
+			}
+			compareAppliedValues(child, declaredValue[key], liveChild, changes)
+		}
+		return
+	case []any:
+		liveList, ok := live.([]any)
+		if ok && namedAppliedList(declaredValue) && namedAppliedList(liveList) {
+			byName := map[string]any{}
+			for _, item := range liveList {
+				byName[item.(map[string]any)["name"].(string)] = item
+			}
+			for _, item := range declaredValue {
+				name := item.(map[string]any)["name"].(string)
+				child := fmt.Sprintf("%s[name=%q]", path, name)
+				liveItem, present := byName[name]
+				if !present {
+					*changes = append(*changes, ObjectAppliedDiffChange{Path: child, Type: AppliedDiffMissing, Declared: encodeAppliedValue(item)})
+					continue
+				}
+				compareAppliedValues(child, item, liveItem, changes)
+			}
+			return
+		}
+	}
+	if !appliedValuesEqual(declared, live) {
+		*changes = append(*changes, ObjectAppliedDiffChange{Path: path, Type: AppliedDiffChanged, Declared: encodeAppliedValue(declared), Live: encodeAppliedValue(live)})
+	}
+}
+
+// namedAppliedList reports whether every item is an object with a string name.
+func namedAppliedList(items []any) bool {
+	if len(items) == 0 {
+		return false
+	}
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		if _, ok := object["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// appliedValuesEqual compares a declared and a live value. The API server
+// canonicalizes quantities ("0.5" is stored as "500m"), so strings that are
+// equal quantities match.
+func appliedValuesEqual(declared, live any) bool {
+	if declaredString, ok := declared.(string); ok {
+		liveString, ok := live.(string)
+		if !ok {
+			return false
+		}
+		if declaredString == liveString {
+			return true
+		}
+		declaredQuantity, err := resource.ParseQuantity(declaredString)
+		if err != nil {
+			return false
+		}
+		liveQuantity, err := resource.ParseQuantity(liveString)
+		return err == nil && declaredQuantity.Cmp(liveQuantity) == 0
+	}
+	// JSON encoding folds float64 (parsed annotation) and int64 (live) numbers
+	// into one form and sorts map keys.
+	return encodeAppliedValue(declared) == encodeAppliedValue(live)
+}
+
+func encodeAppliedValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// fieldManagersAt returns the managers owning path or a field below it.
+func fieldManagersAt(ownership *ObjectFieldOwnership, path string) []string {
+	var managers []string
+	for _, owner := range ownership.Fields {
+		if owner.Field != path && !strings.HasPrefix(owner.Field, path+".") && !strings.HasPrefix(owner.Field, path+"[") {
+			continue
+		}
+		for _, manager := range owner.Managers {
+			if !slices.Contains(managers, manager) {
+				managers = append(managers, manager)
+			}
+		}
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+var appliedPathSegmentPattern = regexp.MustCompile(`\.([^.\[]+)|\[([^\]]*)\]`)
+
+// liveValueAt resolves a field ownership path such as
+// .spec.containers[name="app"].image against the object. Paths whose field
+// names contain dots (label keys) are not resolved.
+func liveValueAt(obj *unstructured.Unstructured, path string) (any, bool) {
+	var current any = obj.Object
+	for _, match := range appliedPathSegmentPattern.FindAllStringSubmatch(path, -1) {
+		if field := match[1]; field != "" {
+			object, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = object[field]; !ok {
+				return nil, false
+			}
+			continue
+		}
+		list, ok := current.([]any)
+		if !ok {
+			return nil, false
+		}
+		item, ok := findAppliedListItem(list, match[2])
+		if !ok {
+			return nil, false
+		}
+		current = item
+	}
+	return current, true
+}
+
+// findAppliedListItem finds the list item a path key selects: an index
+// ("2"), a set value ("=\"x\""), or associative keys ("name=\"app\",port=80").
+func findAppliedListItem(list []any, key string) (any, bool) {
+	if index, err := strconv.Atoi(key); err == nil {
+		if index < 0 || index >= len(list) {
+			return nil, false
+		}
+		return list[index], true
+	}
+	if value, ok := strings.CutPrefix(key, "="); ok {
+		for _, item := range list {
+			if encodeAppliedValue(item) == value {
+				return item, true
+			}
+		}
+		return nil, false
+	}
+	for _, item := range list {
+		object, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		matched := true
+		for _, pair := range strings.Split(key, ",") {
+			field, value, _ := strings.Cut(pair, "=")
+			if encodeAppliedValue(object[field]) != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return item, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,87 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectAppliedDiffComparesLastAppliedConfiguration(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "api",
+			"namespace": "default",
+			"annotations": map[string]any{
+				lastAppliedConfigAnnotation: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"default","labels":{"team":"web"}},` +
+					`"spec":{"replicas":2,"template":{"spec":{"containers":[{"name":"app","image":"api:1.0","resources":{"requests":{"cpu":"0.5"}}}]}}}}`,
+			},
+		},
+		"spec": map[string]any{
+			"replicas":             int64(5),
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]any{"spec": map[string]any{"containers": []any{
+				map[string]any{"name": "sidecar", "image": "proxy:2"},
+				map[string]any{"name": "app", "image": "api:1.1", "resources": map[string]any{"requests": map[string]any{"cpu": "500m"}}},
+			}}},
+		},
+		"status": map[string]any{"replicas": int64(5)},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{"f:image":{}}}}}}}`)}},
+		{Manager: "hpa-controller", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)}},
+	})
+
+	diff, err := objectAppliedDiff(obj)
+	require.NoError(t, err)
+	require.Equal(t, AppliedDiffSourceLastApplied, diff.Source)
+	require.Contains(t, diff.Declared, "replicas: 2")
+	require.NotContains(t, diff.Live, lastAppliedConfigAnnotation)
+	require.NotContains(t, diff.Live, "status:")
+	require.Equal(t, []ObjectAppliedDiffChange{
+		{Path: ".metadata.labels", Type: AppliedDiffMissing, Declared: `{"team":"web"}`},
+		{Path: ".spec.replicas", Type: AppliedDiffChanged, Declared: "2", Live: "5", Managers: []string{"hpa-controller"}},
+		{Path: `.spec.template.spec.containers[name="app"].image`, Type: AppliedDiffChanged, Declared: `"api:1.0"`, Live: `"api:1.1"`, Managers: []string{"kubectl-client-side-apply"}},
+	}, diff.Changes)
+}
+
+func TestObjectAppliedDiffReportsUpdatesToServerSideAppliedObjects(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "api", "namespace": "default"},
+		"spec": map[string]any{
+			"replicas": int64(5),
+			"template": map[string]any{"spec": map[string]any{"containers": []any{
+				map[string]any{"name": "app", "image": "api:1.1"},
+			}}},
+		},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, FieldsType: "FieldsV1",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{".":{},"f:name":{}}}}}}}`)}},
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{"f:note":{}}},"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{"f:image":{}}}}}}}`)}},
+		{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", FieldsType: "FieldsV1",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:replicas":{}}}`)}},
+	})
+
+	diff, err := objectAppliedDiff(obj)
+	require.NoError(t, err)
+	require.Equal(t, AppliedDiffSourceServerSideApply, diff.Source)
+	require.Equal(t, []string{"argocd-controller"}, diff.Appliers)
+	require.Empty(t, diff.Declared)
+	require.Equal(t, []ObjectAppliedDiffChange{
+		{Path: ".spec.replicas", Type: AppliedDiffUpdatedOutsideApply, Live: "5", Managers: []string{"kubectl-edit"}},
+		{Path: `.spec.template.spec.containers[name="app"].image`, Type: AppliedDiffUpdatedOutsideApply, Live: `"api:1.1"`, Managers: []string{"kubectl-edit"}},
+	}, diff.Changes)
+
+	obj.SetManagedFields(nil)
+	_, err = objectAppliedDiff(obj)
+	require.ErrorContains(t, err, "no last-applied configuration")
+}
@@ -150,7 +150,20 @@ func (p *objectDetailProvider) FetchObjectDetails(ctx context.Context, gvk schem
 	}
 
 	cacheKey := objectDetailCacheKeyForGVK(gvk, namespace, name)
+	renderKey := objectRenderDetailKey(resolved.selectionKey, gvk, namespace, name)
+	var current objectVersion
+	versioned := false
 	if p != nil && p.app != nil {
+		// An unchanged object is served from the render cache without a TTL.
+		current, versioned = p.app.currentObjectVersion(resolved.selectionKey, gvk, namespace, name)
+		if versioned {
+			if cached, ok := p.app.objectRenderCache.get(renderKey, current); ok {
+				if p.app.canServeCachedResponse(ctx, resolved.deps, resolved.selectionKey, gvk, namespace, name) {
+					return cached, nil
+				}
+				p.app.objectRenderCache.delete(renderKey)
+			}
+		}
 		if cached, ok := p.app.responseCacheLookup(resolved.selectionKey, cacheKey); ok {
 			// Avoid serving cached details when permission checks deny access.
 			if p.app.canServeCachedResponse(ctx, resolved.deps, resolved.selectionKey, gvk, namespace, name) {
@@ -162,6 +175,12 @@ func (p *objectDetailProvider) FetchObjectDetails(ctx context.Context, gvk schem
 	detail, err := fetcher.withDeps(resolved.deps, namespace, name)
 	if err == nil && p != nil && p.app != nil {
 		p.app.responseCacheStore(resolved.selectionKey, cacheKey, detail)
+		if versioned {
+			// The version was read before the fetch, so the detail is at least
+			// that new; a change since then moves the catalog version on and
+			// the entry stops matching.
+			p.app.objectRenderCache.set(renderKey, current, detail)
+		}
 	}
 	return detail, err
 }
@@ -289,7 +308,31 @@ func (p *objectDetailProvider) FetchObjectYAML(ctx context.Context, gvk schema.G
 			gvk.Kind,
 		)
 	}
-	return fetchObjectYAMLByGVK(ctx, resolved.deps, gvk, namespace, name)
+	renderKey := objectRenderYAMLKey(resolved.selectionKey, gvk, namespace, name)
+	if p != nil && p.app != nil {
+		if current, ok := p.app.currentObjectVersion(resolved.selectionKey, gvk, namespace, name); ok {
+			if cached, ok := p.app.objectRenderCache.get(renderKey, current); ok {
+				if yaml, ok := cached.(string); ok &&
+					p.app.canServeCachedResponse(ctx, resolved.deps, resolved.selectionKey, gvk, namespace, name) {
+					return yaml, nil
+				}
+				p.app.objectRenderCache.delete(renderKey)
+			}
+		}
+	}
+
+	obj, err := fetchObjectByGVK(ctx, resolved.deps, gvk, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	yaml, err := renderObjectYAML(obj)
+	if err == nil && p != nil && p.app != nil {
+		p.app.objectRenderCache.set(renderKey, objectVersion{
+			uid:             string(obj.GetUID()),
+			resourceVersion: obj.GetResourceVersion(),
+		}, yaml)
+	}
+	return yaml, err
 }
 
 // FetchHelmManifest retrieves the manifest for a Helm release.
@@ -0,0 +1,60 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectFieldOwnershipIndexesFieldsByManager(t *testing.T) {
+	older := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(older.Add(time.Hour))
+	obj := &unstructured.Unstructured{}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:    "helm",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "apps/v1",
+			Time:       &older,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+		},
+		{
+			Manager:    "hpa-controller",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			APIVersion: "apps/v1",
+			Time:       &newer,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+		},
+		{
+			Manager:     "kube-controller-manager",
+			Operation:   metav1.ManagedFieldsOperationUpdate,
+			Subresource: "status",
+			FieldsType:  "FieldsV1",
+			FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:readyReplicas":{}}}`)},
+		},
+	})
+
+	ownership, err := objectFieldOwnership(obj)
+	require.NoError(t, err)
+	require.Len(t, ownership.Managers, 3)
+	require.Equal(t, "hpa-controller", ownership.Managers[0].Manager, "most recent write first")
+	require.Equal(t, "kube-controller-manager", ownership.Managers[2].Manager, "entries without a time last")
+	require.Equal(t, "status", ownership.Managers[2].Subresource)
+	require.Equal(t, []string{
+		`.spec.replicas`,
+		`.spec.template.spec.containers[name="app"].image`,
+		`.spec.template.spec.containers[name="app"].name`,
+	}, ownership.Managers[1].Fields)
+
+	require.Equal(t, []ObjectFieldOwner{
+		{Field: `.spec.replicas`, Managers: []string{"helm", "hpa-controller"}},
+		{Field: `.spec.template.spec.containers[name="app"].image`, Managers: []string{"helm"}},
+		{Field: `.spec.template.spec.containers[name="app"].name`, Managers: []string{"helm"}},
+		{Field: `.status.readyReplicas`, Managers: []string{"kube-controller-manager"}},
+	}, ownership.Fields)
+}
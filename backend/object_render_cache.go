@@ -0,0 +1,150 @@
+/*
+ * backend/object_render_cache.go
+ *
+ * Rendered object YAML and detail payloads, cached by object identity and
+ * validated against the object catalog's current UID and resourceVersion.
+ *
+ * Unlike the TTL response cache, an entry here never expires on its own: it
+ * is served for as long as the catalog reports the same UID and
+ * resourceVersion the entry was rendered from, so reopening an unchanged
+ * object in the details panel costs no API call. Informer and resource stream
+ * handlers evict entries on change (response_cache_invalidation.go); the
+ * resourceVersion check covers any change those handlers miss.
+ */
+
+package backend
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/internal/cachekeys"
+	"github.com/luxury-yacht/app/backend/internal/config"
+)
+
+// objectRenderCache stores rendered payloads with the object version they
+// were rendered from.
+type objectRenderCache struct {
+	mu         sync.RWMutex
+	maxEntries int
+	entries    map[string]objectRenderCacheEntry
+}
+
+type objectRenderCacheEntry struct {
+	uid             string
+	resourceVersion string
+	value           any
+}
+
+// objectVersion identifies one version of one object.
+type objectVersion struct {
+	uid             string
+	resourceVersion string
+}
+
+func newObjectRenderCache(maxEntries int) *objectRenderCache {
+	if maxEntries < 0 {
+		maxEntries = 0
+	}
+	return &objectRenderCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]objectRenderCacheEntry),
+	}
+}
+
+func newDefaultObjectRenderCache() *objectRenderCache {
+	return newObjectRenderCache(config.ObjectRenderCacheMaxEntries)
+}
+
+// get returns the entry for key when it was rendered from current.
+func (c *objectRenderCache) get(key string, current objectVersion) (any, bool) {
+	if c == nil || key == "" || current.resourceVersion == "" {
+		return nil, false
+	}
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if entry.resourceVersion != current.resourceVersion || (current.uid != "" && entry.uid != current.uid) {
+		// The object changed, or was deleted and recreated under the same name.
+		c.delete(key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *objectRenderCache) set(key string, version objectVersion, value any) {
+	if c == nil || key == "" || version.resourceVersion == "" || c.maxEntries == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		// Drop all cached entries to keep memory bounded without heavy bookkeeping.
+		c.entries = make(map[string]objectRenderCacheEntry)
+	}
+	c.entries[key] = objectRenderCacheEntry{
+		uid:             version.uid,
+		resourceVersion: version.resourceVersion,
+		value:           value,
+	}
+}
+
+func (c *objectRenderCache) delete(key string) {
+	if c == nil || key == "" {
+		return
+	}
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
+
+// objectRenderYAMLKey and objectRenderDetailKey key the two rendered forms of
+// an object separately within a cluster.
+func objectRenderYAMLKey(selectionKey string, gvk schema.GroupVersionKind, namespace, name string) string {
+	return objectRenderKey(selectionKey, gvk, "yaml", namespace, name)
+}
+
+func objectRenderDetailKey(selectionKey string, gvk schema.GroupVersionKind, namespace, name string) string {
+	return objectRenderKey(selectionKey, gvk, "detail", namespace, name)
+}
+
+func objectRenderKey(selectionKey string, gvk schema.GroupVersionKind, form, namespace, name string) string {
+	if strings.TrimSpace(gvk.Version) == "" || strings.TrimSpace(gvk.Kind) == "" || strings.TrimSpace(name) == "" {
+		return ""
+	}
+	gvkKey := strings.ToLower(strings.TrimSpace(gvk.Group) + "/" + strings.TrimSpace(gvk.Version) + "/" + strings.TrimSpace(gvk.Kind))
+	return selectionKey + "|" + cachekeys.Build(gvkKey+"-"+form, namespace, name)
+}
+
+// currentObjectVersion reports the UID and resourceVersion the cluster's
+// object catalog holds for the object. It returns false when the catalog is
+// not running or does not track the object, in which case nothing cached can
+// be validated.
+func (a *App) currentObjectVersion(selectionKey string, gvk schema.GroupVersionKind, namespace, name string) (objectVersion, bool) {
+	if a == nil {
+		return objectVersion{}, false
+	}
+	svc := a.objectCatalogServiceForCluster(selectionKey)
+	if svc == nil {
+		return objectVersion{}, false
+	}
+	match, ok := svc.FindExactMatch(namespace, gvk.Group, gvk.Version, gvk.Kind, name)
+	if !ok || match.ResourceVersion == "" {
+		return objectVersion{}, false
+	}
+	return objectVersion{uid: match.Ref.UID, resourceVersion: match.ResourceVersion}, true
+}
+
+// invalidateObjectRenderCache drops both rendered forms of an object.
+func (a *App) invalidateObjectRenderCache(selectionKey string, gvk schema.GroupVersionKind, namespace, name string) {
+	if a == nil || a.objectRenderCache == nil {
+		return
+	}
+	a.objectRenderCache.delete(objectRenderYAMLKey(selectionKey, gvk, namespace, name))
+	a.objectRenderCache.delete(objectRenderDetailKey(selectionKey, gvk, namespace, name))
+}
@@ -0,0 +1,55 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestObjectRenderCacheServesOnlyTheRenderedVersion(t *testing.T) {
+	cache := newObjectRenderCache(4)
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	key := objectRenderYAMLKey("cluster-a", gvk, "default", "web")
+	rendered := objectVersion{uid: "uid-1", resourceVersion: "10"}
+
+	cache.set(key, rendered, "yaml-v10")
+
+	value, ok := cache.get(key, rendered)
+	require.True(t, ok)
+	require.Equal(t, "yaml-v10", value)
+
+	_, ok = cache.get(key, objectVersion{uid: "uid-1", resourceVersion: "11"})
+	require.False(t, ok, "a newer resourceVersion must miss")
+	_, ok = cache.get(key, rendered)
+	require.False(t, ok, "a stale entry is dropped once it misses")
+
+	cache.set(key, rendered, "yaml-v10")
+	_, ok = cache.get(key, objectVersion{uid: "uid-2", resourceVersion: "10"})
+	require.False(t, ok, "a recreated object with the same name must miss")
+}
+
+func TestObjectRenderCacheKeysFormsAndClustersApart(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	yamlKey := objectRenderYAMLKey("cluster-a", gvk, "default", "cm")
+	require.NotEqual(t, yamlKey, objectRenderDetailKey("cluster-a", gvk, "default", "cm"))
+	require.NotEqual(t, yamlKey, objectRenderYAMLKey("cluster-b", gvk, "default", "cm"))
+	require.Empty(t, objectRenderYAMLKey("cluster-a", schema.GroupVersionKind{Kind: "ConfigMap"}, "default", "cm"))
+}
+
+func TestInvalidateResponseCacheForGVKDropsRenderedObject(t *testing.T) {
+	app := NewApp()
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	version := objectVersion{uid: "uid-1", resourceVersion: "10"}
+	yamlKey := objectRenderYAMLKey("cluster-a", gvk, "default", "web")
+	detailKey := objectRenderDetailKey("cluster-a", gvk, "default", "web")
+	app.objectRenderCache.set(yamlKey, version, "yaml")
+	app.objectRenderCache.set(detailKey, version, "detail")
+
+	app.invalidateResponseCacheForGVK("cluster-a", gvk, "default", "web")
+
+	_, ok := app.objectRenderCache.get(yamlKey, version)
+	require.False(t, ok)
+	_, ok = app.objectRenderCache.get(detailKey, version)
+	require.False(t, ok)
+}
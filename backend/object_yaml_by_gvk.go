@@ -67,8 +67,13 @@ func fetchObjectYAMLByGVK(ctx context.Context, deps common.Dependencies, gvk sch
 	if err != nil {
 		return "", err
 	}
+	return renderObjectYAML(obj)
+}
 
-	yamlBytes, err := yaml.Marshal(obj.Object)
+// renderObjectYAML returns the YAML form of a fetched object, with any Secret
+// values withheld.
+func renderObjectYAML(obj *unstructured.Unstructured) (string, error) {
+	yamlBytes, err := yaml.Marshal(withholdSecretValues(obj.Object, nil))
 	if err != nil {
 		return "", fmt.Errorf("failed to convert to YAML: %w", err)
 	}
@@ -129,6 +129,8 @@ func collidingDBInstanceDiscoveryLists() []*metav1.APIResourceList {
 func newCollidingDBInstanceCluster(t *testing.T, clusterID string) *App {
 	t.Helper()
 
+	// Deletes snapshot into the recycle bin under the user config dir.
+	setTestConfigEnv(t)
 	app := newTestAppWithDefaults(t)
 	app.Ctx = context.Background()
 
@@ -37,12 +37,18 @@ import (
 const objectYAMLErrorPrefix = "ObjectYAMLError:"
 const objectYAMLFieldManager = "luxury-yacht-yaml-editor"
 
+// objectYAMLApplyAttempts bounds how often an apply re-fetches and re-bases
+// the edit after losing a resourceVersion race to another writer.
+const objectYAMLApplyAttempts = 3
+
 type objectYAMLError struct {
 	Code                   string   `json:"code"`
 	Message                string   `json:"message"`
 	CurrentYAML            string   `json:"currentYaml,omitempty"`
 	CurrentResourceVersion string   `json:"currentResourceVersion,omitempty"`
 	Causes                 []string `json:"causes,omitempty"`
+	// Issues carries line-anchored findings from local schema validation.
+	Issues []objectyaml.SchemaIssue `json:"issues,omitempty"`
 }
 
 func (e *objectYAMLError) Error() string {
@@ -68,6 +74,8 @@ type ObjectYAMLMutationRequest struct {
 // ObjectYAMLMutationResponse returns basic metadata after a validation/apply attempt.
 type ObjectYAMLMutationResponse struct {
 	ResourceVersion string `json:"resourceVersion"`
+	// KubectlCommand is the kubectl patch equivalent to the edit.
+	KubectlCommand string `json:"kubectlCommand,omitempty"`
 }
 
 type mutationContext struct {
@@ -116,6 +124,10 @@ func (a *App) ValidateObjectYaml(clusterID string, req ObjectYAMLMutationRequest
 		return nil, err
 	}
 
+	if err := validateObjectYAMLSchema(ctx, deps, mc); err != nil {
+		return nil, err
+	}
+
 	result, err := mc.resource.Patch(
 		ctx,
 		req.Name,
@@ -132,12 +144,16 @@ func (a *App) ValidateObjectYaml(clusterID string, req ObjectYAMLMutationRequest
 
 	return &ObjectYAMLMutationResponse{
 		ResourceVersion: result.GetResourceVersion(),
+		KubectlCommand:  a.mutationKubectlCommand(clusterID, mc),
 	}, nil
 }
 
 // ApplyObjectYaml performs a kubectl-edit-style patch using the original editor
 // baseline plus the user's edited YAML.
 func (a *App) ApplyObjectYaml(clusterID string, req ObjectYAMLMutationRequest) (*ObjectYAMLMutationResponse, error) {
+	if err := a.requireClusterWritable(clusterID, "apply"); err != nil {
+		return nil, err
+	}
 	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
 	if err != nil {
 		return nil, err
@@ -159,26 +175,62 @@ func (a *App) ApplyObjectYaml(clusterID string, req ObjectYAMLMutationRequest) (
 		return nil, err
 	}
 
-	result, err := mc.resource.Patch(
-		ctx,
-		req.Name,
-		mc.patchType,
-		mc.patch,
-		metav1.PatchOptions{
-			FieldManager: objectYAMLFieldManager,
-		},
-	)
-	if err != nil {
-		return nil, wrapKubernetesError(err, "apply failed")
+	if err := validateObjectYAMLSchema(ctx, deps, mc); err != nil {
+		return nil, err
+	}
+
+	gvk := schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
+	var result *unstructured.Unstructured
+	for attempt := 1; ; attempt++ {
+		patch, err := withResourceVersionPrecondition(mc.patch, mc.current.GetResourceVersion())
+		if err != nil {
+			return nil, err
+		}
+		result, err = mc.resource.Patch(
+			ctx,
+			req.Name,
+			mc.patchType,
+			patch,
+			metav1.PatchOptions{
+				FieldManager: objectYAMLFieldManager,
+			},
+		)
+		if err == nil {
+			break
+		}
+		if !isResourceVersionConflict(err) || attempt == objectYAMLApplyAttempts {
+			return nil, wrapKubernetesError(err, "apply failed")
+		}
+
+		// Another writer got in between the read and the patch. Re-fetch, and
+		// re-base the edit onto the latest object unless the two overlap.
+		previous := mc
+		mc, err = prepareMutationContextWithDependencies(ctx, deps, selectionKey, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkRebaseConflict(gvk, previous, mc.current); err != nil {
+			return nil, err
+		}
 	}
 
 	a.invalidateResponseCacheForGVK(selectionKey, schema.FromAPIVersionAndKind(req.APIVersion, req.Kind), req.Namespace, req.Name)
 
 	return &ObjectYAMLMutationResponse{
 		ResourceVersion: result.GetResourceVersion(),
+		KubectlCommand:  a.mutationKubectlCommand(clusterID, mc),
 	}, nil
 }
 
+// mutationKubectlCommand renders the patch a YAML edit sends as kubectl patch.
+func (a *App) mutationKubectlCommand(clusterID string, mc *mutationContext) string {
+	namespace := ""
+	if mc.isNamespaced {
+		namespace = mc.desired.GetNamespace()
+	}
+	return a.kubectlPatchCommand(clusterID, mc.gvr.Group, mc.request.Kind, namespace, mc.request.Name, mc.patchType, mc.patch)
+}
+
 func prepareMutationContextWithDependencies(
 	ctx context.Context,
 	deps common.Dependencies,
@@ -264,6 +316,11 @@ func prepareMutationContextWithDependencies(
 		}
 	}
 
+	// Secret values reach the editor as placeholders; an untouched one keeps
+	// the live value.
+	restoreWithheldSecretValues(base.Object, current.Object)
+	restoreWithheldSecretValues(desired.Object, current.Object)
+
 	if isNamespaced && current.GetNamespace() != desired.GetNamespace() {
 		return nil, fmt.Errorf("live object namespace %s does not match YAML namespace %s", namespaceLabel(current.GetNamespace()), namespaceLabel(desired.GetNamespace()))
 	}
@@ -372,6 +429,105 @@ func buildKubectlEditPatch(
 	}
 }
 
+// withResourceVersionPrecondition pins a patch to the resourceVersion of the
+// object it was computed against, so a write that lands in between fails it
+// with a Conflict instead of being merged over unseen.
+func withResourceVersionPrecondition(patch []byte, resourceVersion string) ([]byte, error) {
+	var patchMap map[string]interface{}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("failed to decode patch: %w", err)
+	}
+	if patchMap == nil {
+		patchMap = map[string]interface{}{}
+	}
+	metadata, _ := patchMap["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadata["resourceVersion"] = resourceVersion
+	patchMap["metadata"] = metadata
+	pinned, err := json.Marshal(patchMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode patch: %w", err)
+	}
+	return pinned, nil
+}
+
+// isResourceVersionConflict reports an optimistic-concurrency failure, as
+// opposed to a server-side apply field ownership conflict.
+func isResourceVersionConflict(err error) bool {
+	var statusErr *apierrors.StatusError
+	if !errors.As(err, &statusErr) || !apierrors.IsConflict(err) {
+		return false
+	}
+	return !statusHasCauseType(statusErr.ErrStatus.Details, metav1.CauseTypeFieldManagerConflict)
+}
+
+// checkRebaseConflict decides whether an edit that lost a resourceVersion race
+// can be re-based onto the latest object. The edit as attempted is the patch
+// applied to the object it was computed against; the other writer's changes
+// are that object against the latest. Only a three-way conflict between the
+// two is surfaced, carrying the latest YAML for the editor.
+func checkRebaseConflict(gvk schema.GroupVersionKind, previous *mutationContext, latest *unstructured.Unstructured) error {
+	attempted, err := applyMutationPatch(gvk, previous.current, previous.patch, previous.patchType)
+	if err != nil {
+		return fmt.Errorf("failed to rebase edit onto the latest object: %w", err)
+	}
+	_, _, err = buildReloadMergePatch(gvk, previous.current, attempted, latest)
+	if err == nil {
+		return nil
+	}
+	if !mergepatch.IsConflict(err) {
+		return fmt.Errorf("failed to rebase edit onto the latest object: %w", err)
+	}
+	currentYAML, yamlErr := normalizeObjectYAML(latest)
+	if yamlErr != nil {
+		return yamlErr
+	}
+	return &objectYAMLError{
+		Code:                   objectYAMLMergeConflictCode,
+		Message:                "The object was changed by another writer while applying, and those changes overlap your edits. Review the latest object and re-apply the conflicting edits.",
+		CurrentYAML:            currentYAML,
+		CurrentResourceVersion: latest.GetResourceVersion(),
+		Causes:                 []string{err.Error()},
+	}
+}
+
+// applyMutationPatch applies a kubectl-edit-style patch locally.
+func applyMutationPatch(
+	gvk schema.GroupVersionKind,
+	obj *unstructured.Unstructured,
+	patch []byte,
+	patchType types.PatchType,
+) (*unstructured.Unstructured, error) {
+	objJSON, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode object: %w", err)
+	}
+	var patchedJSON []byte
+	switch patchType {
+	case types.StrategicMergePatchType:
+		versionedObject, err := kubescheme.Scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		patchedJSON, err = strategicpatch.StrategicMergePatch(objJSON, patch, versionedObject)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		patchedJSON, err = jsonpatch.MergePatch(objJSON, patch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	patched := &unstructured.Unstructured{}
+	if err := patched.UnmarshalJSON(patchedJSON); err != nil {
+		return nil, fmt.Errorf("failed to decode patched object: %w", err)
+	}
+	return patched, nil
+}
+
 func parseYAMLToUnstructured(content string) (*unstructured.Unstructured, error) {
 	reader := bytes.NewReader([]byte(content))
 	decoder := yamlutil.NewYAMLOrJSONDecoder(reader, 4096)
@@ -450,7 +606,7 @@ func normalizeObjectYAML(obj *unstructured.Unstructured) (string, error) {
 	unstructured.RemoveNestedField(copyObj.Object, "metadata", "generation")
 	unstructured.RemoveNestedField(copyObj.Object, "status")
 
-	bytes, err := yaml.Marshal(copyObj.Object)
+	bytes, err := yaml.Marshal(withholdSecretValues(copyObj.Object, nil))
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal object for diff: %w", err)
 	}
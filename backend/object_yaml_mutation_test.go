@@ -246,6 +246,47 @@ spec:
 	}
 }
 
+func TestValidateObjectYamlReportsSchemaIssuesBeforePatching(t *testing.T) {
+	app, dynamicClient, clusterID := setupYAMLTestApp(t)
+
+	request := ObjectYAMLMutationRequest{
+		BaseYAML: baseYAML(),
+		YAML: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+  namespace: default
+  uid: demo-uid
+  resourceVersion: "42"
+spec:
+  replicaz: 2
+`,
+		Kind:            "Deployment",
+		APIVersion:      "apps/v1",
+		Namespace:       "default",
+		Name:            "demo",
+		UID:             "demo-uid",
+		ResourceVersion: "42",
+	}
+
+	_, err := app.ValidateObjectYaml(clusterID, request)
+	var yamlErr *objectYAMLError
+	if !errors.As(err, &yamlErr) {
+		t.Fatalf("expected objectYAMLError, got %v", err)
+	}
+	if yamlErr.Code != "SchemaValidationFailed" {
+		t.Fatalf("expected SchemaValidationFailed, got %s", yamlErr.Code)
+	}
+	if len(yamlErr.Issues) != 1 || yamlErr.Issues[0].Path != "spec.replicaz" || yamlErr.Issues[0].Line != 9 {
+		t.Fatalf("expected a line-anchored unknown field issue, got %#v", yamlErr.Issues)
+	}
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == "patch" {
+			t.Fatalf("schema errors must stop the request before it reaches the API server")
+		}
+	}
+}
+
 func TestApplyObjectYamlSuccess(t *testing.T) {
 	app, _, clusterID := setupYAMLTestApp(t)
 	app.responseCache = newResponseCache(time.Minute, 10)
@@ -489,6 +530,129 @@ func TestApplyObjectYamlPreservesAuthoritativeResourceVersion(t *testing.T) {
 	}
 }
 
+// raceApplyWith makes the next patch lose a resourceVersion race: before it
+// lands, another writer applies mutate to the live deployment.
+func raceApplyWith(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient, mutate func(*unstructured.Unstructured)) *[]string {
+	t.Helper()
+	gvr := appsv1.SchemeGroupVersion.WithResource("deployments")
+	var pinned []string
+	raced := false
+	dynamicClient.Fake.PrependReactor("patch", "deployments", func(action cgotesting.Action) (bool, runtime.Object, error) {
+		patchAction := action.(cgotesting.PatchActionImpl)
+		var patch struct {
+			Metadata struct {
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(patchAction.GetPatch(), &patch); err != nil {
+			return true, nil, err
+		}
+		pinned = append(pinned, patch.Metadata.ResourceVersion)
+		if raced {
+			return false, nil, nil
+		}
+		raced = true
+		current, err := dynamicClient.Tracker().Get(gvr, "default", "demo")
+		if err != nil {
+			return true, nil, err
+		}
+		live := current.(*unstructured.Unstructured).DeepCopy()
+		mutate(live)
+		live.SetResourceVersion(nextResourceVersion(live.GetResourceVersion()))
+		if err := dynamicClient.Tracker().Update(gvr, live, "default"); err != nil {
+			return true, nil, err
+		}
+		return true, nil, apierrors.NewConflict(gvr.GroupResource(), "demo", errors.New("the object has been modified"))
+	})
+	return &pinned
+}
+
+func TestApplyObjectYamlRebasesOntoConcurrentWrite(t *testing.T) {
+	app, dynamicClient, clusterID := setupYAMLTestApp(t)
+	pinned := raceApplyWith(t, dynamicClient, func(live *unstructured.Unstructured) {
+		live.SetAnnotations(map[string]string{"syncedAt": "now"})
+	})
+
+	response, err := app.ApplyObjectYaml(clusterID, ObjectYAMLMutationRequest{
+		BaseYAML:        baseYAML(),
+		YAML:            strings.Replace(baseYAML(), "nginx:1.26", "nginx:1.27", 1),
+		Kind:            "Deployment",
+		APIVersion:      "apps/v1",
+		Namespace:       "default",
+		Name:            "demo",
+		UID:             "demo-uid",
+		ResourceVersion: "42",
+	})
+	if err != nil {
+		t.Fatalf("expected apply to re-base onto the concurrent write: %v", err)
+	}
+	if got := strings.Join(*pinned, ","); got != "42,43" {
+		t.Fatalf("expected patches pinned to resourceVersions 42 then 43, got %s", got)
+	}
+	if response.ResourceVersion != "44" {
+		t.Fatalf("expected resourceVersion 44 after the retried apply, got %q", response.ResourceVersion)
+	}
+
+	updated, err := dynamicClient.Resource(appsv1.SchemeGroupVersion.WithResource("deployments")).Namespace("default").Get(context.Background(), "demo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch updated deployment: %v", err)
+	}
+	if updated.GetAnnotations()["syncedAt"] != "now" {
+		t.Fatalf("expected the concurrent annotation to be kept, got %#v", updated.GetAnnotations())
+	}
+	containers, _, _ := unstructured.NestedSlice(updated.Object, "spec", "template", "spec", "containers")
+	if len(containers) != 1 || containers[0].(map[string]interface{})["image"] != "nginx:1.27" {
+		t.Fatalf("expected the edited image to be applied, got %#v", containers)
+	}
+}
+
+func TestApplyObjectYamlReportsOverlappingConcurrentWrite(t *testing.T) {
+	app, dynamicClient, clusterID := setupYAMLTestApp(t)
+	pinned := raceApplyWith(t, dynamicClient, func(live *unstructured.Unstructured) {
+		_ = unstructured.SetNestedSlice(live.Object, []interface{}{
+			map[string]interface{}{"name": "app", "image": "nginx:1.28"},
+		}, "spec", "template", "spec", "containers")
+	})
+
+	_, err := app.ApplyObjectYaml(clusterID, ObjectYAMLMutationRequest{
+		BaseYAML:        baseYAML(),
+		YAML:            strings.Replace(baseYAML(), "nginx:1.26", "nginx:1.27", 1),
+		Kind:            "Deployment",
+		APIVersion:      "apps/v1",
+		Namespace:       "default",
+		Name:            "demo",
+		UID:             "demo-uid",
+		ResourceVersion: "42",
+	})
+	var objErr *objectYAMLError
+	if !errors.As(err, &objErr) {
+		t.Fatalf("expected objectYAMLError, got %v", err)
+	}
+	if objErr.Code != objectYAMLMergeConflictCode {
+		t.Fatalf("expected merge conflict code %q, got %q", objectYAMLMergeConflictCode, objErr.Code)
+	}
+	if objErr.CurrentResourceVersion != "43" || !strings.Contains(objErr.CurrentYAML, "image: nginx:1.28") {
+		t.Fatalf("expected the latest object in the conflict, got rv %q yaml %q", objErr.CurrentResourceVersion, objErr.CurrentYAML)
+	}
+	if len(*pinned) != 1 {
+		t.Fatalf("expected no retry after an overlapping write, got %d patches", len(*pinned))
+	}
+}
+
+func TestIsResourceVersionConflictIgnoresFieldManagerConflicts(t *testing.T) {
+	gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	if !isResourceVersionConflict(apierrors.NewConflict(gr, "demo", errors.New("modified"))) {
+		t.Fatalf("expected a resourceVersion conflict")
+	}
+	applyConflict := apierrors.NewApplyConflict([]metav1.StatusCause{{Type: metav1.CauseTypeFieldManagerConflict, Field: ".spec.replicas"}}, "conflict")
+	if isResourceVersionConflict(applyConflict) {
+		t.Fatalf("expected field manager conflicts to be excluded")
+	}
+	if isResourceVersionConflict(errors.New("boom")) {
+		t.Fatalf("expected plain errors to be excluded")
+	}
+}
+
 func TestMergeObjectYamlWithLatestStrategicMergesBuiltInLists(t *testing.T) {
 	app, dynamicClient, clusterID := setupYAMLTestApp(t)
 	resource := dynamicClient.Resource(appsv1.SchemeGroupVersion.WithResource("deployments")).Namespace("default")
@@ -592,6 +756,56 @@ func TestMergeObjectYamlWithLatestRejectsConflictingBuiltInListEdits(t *testing.
 	}
 }
 
+func TestThreeWayMergeObjectYamlMarksConflictingLiveChanges(t *testing.T) {
+	app, dynamicClient, clusterID := setupYAMLTestApp(t)
+	resource := dynamicClient.Resource(appsv1.SchemeGroupVersion.WithResource("deployments")).Namespace("default")
+	liveDeployment, err := resource.Get(context.Background(), "demo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch deployment: %v", err)
+	}
+	liveDeployment.SetResourceVersion("99")
+	liveDeployment.SetAnnotations(map[string]string{"syncedAt": "now"})
+	if err := unstructured.SetNestedSlice(liveDeployment.Object, []interface{}{
+		map[string]interface{}{
+			"name":  "app",
+			"image": "nginx:1.27",
+		},
+	}, "spec", "template", "spec", "containers"); err != nil {
+		t.Fatalf("failed to set containers: %v", err)
+	}
+	if err := dynamicClient.Tracker().Update(appsv1.SchemeGroupVersion.WithResource("deployments"), liveDeployment, "default"); err != nil {
+		t.Fatalf("failed to seed live deployment: %v", err)
+	}
+
+	response, err := app.ThreeWayMergeObjectYaml(clusterID, ObjectYAMLReloadMergeRequest{
+		BaseYAML:   deploymentYAML("42", "nginx:1.25"),
+		DraftYAML:  strings.Replace(deploymentYAML("42", "nginx:1.26"), "replicas: 1", "replicas: 5", 1),
+		Kind:       "Deployment",
+		APIVersion: "apps/v1",
+		Namespace:  "default",
+		Name:       "demo",
+		UID:        "demo-uid",
+	})
+	if err != nil {
+		t.Fatalf("ThreeWayMergeObjectYaml returned error: %v", err)
+	}
+	if response.ResourceVersion != "99" || !strings.Contains(response.MergedYAML, "resourceVersion: \"99\"") {
+		t.Fatalf("expected the live resourceVersion 99, got %q in:\n%s", response.ResourceVersion, response.MergedYAML)
+	}
+	if len(response.Conflicts) != 1 || response.Conflicts[0].Path != "spec.template.spec.containers[name=app].image" {
+		t.Fatalf("expected one image conflict, got %#v", response.Conflicts)
+	}
+	for _, want := range []string{
+		"<<<<<<< draft\n        image: nginx:1.26\n=======\n        image: nginx:1.27\n>>>>>>> live\n",
+		"replicas: 5",
+		"syncedAt: now",
+	} {
+		if !strings.Contains(response.MergedYAML, want) {
+			t.Fatalf("expected merged YAML to contain %q, got:\n%s", want, response.MergedYAML)
+		}
+	}
+}
+
 func TestMergeObjectYamlWithLatestDetectsUIDMismatch(t *testing.T) {
 	app, dynamicClient, clusterID := setupYAMLTestApp(t)
 	resource := dynamicClient.Resource(appsv1.SchemeGroupVersion.WithResource("deployments")).Namespace("default")
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/luxury-yacht/app/backend/objectyaml"
 	"github.com/luxury-yacht/app/backend/resources/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -115,7 +116,7 @@ func (a *App) MergeObjectYamlWithLatest(
 		mergedObj.SetResourceVersion(currentObj.GetResourceVersion())
 	}
 
-	mergedYAML, err := marshalObjectYAML(mergedObj)
+	mergedYAML, err := marshalMergedObjectYAML(mergedObj, currentObj)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +128,85 @@ func (a *App) MergeObjectYamlWithLatest(
 	}, nil
 }
 
+// ObjectYAMLThreeWayMergeResponse returns the draft merged onto the latest live
+// object. Fields both sides changed are left as draft/live conflict markers in
+// MergedYAML and listed in Conflicts.
+type ObjectYAMLThreeWayMergeResponse struct {
+	MergedYAML      string                     `json:"mergedYAML"`
+	CurrentYAML     string                     `json:"currentYAML"`
+	ResourceVersion string                     `json:"resourceVersion"`
+	Conflicts       []objectyaml.MergeConflict `json:"conflicts"`
+}
+
+// ThreeWayMergeObjectYaml merges the user's edits (baseline to draft) with the
+// changes made to the live object since the baseline, field by field. Unlike
+// MergeObjectYamlWithLatest it never rejects the merge: overlapping changes
+// come back as conflict markers for the user to resolve in the editor, so a
+// controller mutating the object while it is edited clobbers neither side.
+func (a *App) ThreeWayMergeObjectYaml(
+	clusterID string,
+	req ObjectYAMLReloadMergeRequest,
+) (*ObjectYAMLThreeWayMergeResponse, error) {
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+
+	baseObj, draftObj, currentObj, err := prepareReloadMergeContext(
+		ctx,
+		deps,
+		selectionKey,
+		req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	currentYAML, err := marshalObjectYAML(currentObj)
+	if err != nil {
+		return nil, err
+	}
+
+	var patchMeta strategicpatch.LookupPatchMeta
+	if typedObj, err := kubescheme.Scheme.New(schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)); err == nil {
+		if meta, metaErr := strategicpatch.NewPatchMetaFromStruct(typedObj); metaErr == nil {
+			patchMeta = meta
+		}
+	}
+
+	result := objectyaml.ThreeWayMerge(baseObj.Object, currentObj.Object, draftObj.Object, patchMeta)
+	// The editor hides managedFields unless asked; a draft without them gets
+	// none back rather than the live copy.
+	if _, found, _ := unstructured.NestedFieldNoCopy(draftObj.Object, "metadata", "managedFields"); !found {
+		unstructured.RemoveNestedField(result.Object, "metadata", "managedFields")
+	}
+	// Keep the live resourceVersion authoritative, as the reload merge does.
+	if currentObj.GetResourceVersion() != "" {
+		if err := unstructured.SetNestedField(result.Object, currentObj.GetResourceVersion(), "metadata", "resourceVersion"); err != nil {
+			return nil, fmt.Errorf("failed to set merged resourceVersion: %w", err)
+		}
+	}
+	result.Object = withholdSecretValues(result.Object, currentObj.Object)
+	mergedYAML, err := result.Render()
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := result.Conflicts
+	if conflicts == nil {
+		conflicts = []objectyaml.MergeConflict{}
+	}
+	return &ObjectYAMLThreeWayMergeResponse{
+		MergedYAML:      mergedYAML,
+		CurrentYAML:     currentYAML,
+		ResourceVersion: currentObj.GetResourceVersion(),
+		Conflicts:       conflicts,
+	}, nil
+}
+
 func prepareReloadMergeContext(
 	ctx context.Context,
 	deps common.Dependencies,
@@ -210,6 +290,8 @@ func prepareReloadMergeContext(
 		}
 	}
 
+	restoreWithheldSecretValues(baseObj.Object, currentObj.Object)
+	restoreWithheldSecretValues(draftObj.Object, currentObj.Object)
 	return baseObj, draftObj, currentObj, nil
 }
 
@@ -346,11 +428,21 @@ func applyReloadMergePatch(
 }
 
 func marshalObjectYAML(obj *unstructured.Unstructured) (string, error) {
+	return marshalMergedObjectYAML(obj, nil)
+}
+
+// marshalMergedObjectYAML renders a merge result, withholding the Secret
+// values it shares with live but keeping the ones the draft changed.
+func marshalMergedObjectYAML(obj, live *unstructured.Unstructured) (string, error) {
 	if obj == nil {
 		return "", fmt.Errorf("object payload is required")
 	}
+	var liveObject map[string]interface{}
+	if live != nil {
+		liveObject = live.Object
+	}
 
-	yamlBytes, err := yaml.Marshal(obj.Object)
+	yamlBytes, err := yaml.Marshal(withholdSecretValues(obj.Object, liveObject))
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal object YAML: %w", err)
 	}
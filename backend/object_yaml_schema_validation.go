@@ -0,0 +1,63 @@
+/*
+ * backend/object_yaml_schema_validation.go
+ *
+ * Local schema validation for edited object YAML. Runs before the dry-run or
+ * apply patch so typos are reported against the editor's lines instead of
+ * being rejected, or silently pruned, by the API server.
+ */
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/objectyaml"
+	"github.com/luxury-yacht/app/backend/resources/apiextensions"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+// validateObjectYAMLSchema checks built-in kinds by strict typed decoding and
+// custom resources against their CRD's structural schema. Kinds with neither
+// (aggregated APIs, CRDs without a schema, unreadable CRDs) are left to the
+// API server.
+func validateObjectYAMLSchema(ctx context.Context, deps common.Dependencies, mc *mutationContext) error {
+	gvk := mc.desired.GroupVersionKind()
+	issues, known := objectyaml.ValidateBuiltin(mc.request.YAML, gvk, kubescheme.Scheme)
+	if !known && deps.APIExtensionsClient != nil && mc.gvr.Group != "" {
+		crdName := mc.gvr.Resource + "." + mc.gvr.Group
+		crd, err := deps.APIExtensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crdName, metav1.GetOptions{})
+		if err == nil {
+			if version, err := apiextensions.SelectVersion(crd, gvk.Version); err == nil && version.Schema != nil {
+				issues = objectyaml.ValidateAgainstCRDSchema(mc.request.YAML, mc.desired.Object, version.Schema.OpenAPIV3Schema)
+			}
+		}
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	causes := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		cause := issue.Message
+		if issue.Path != "" {
+			cause = issue.Path + ": " + cause
+		}
+		if issue.Line > 0 {
+			cause = fmt.Sprintf("line %d: %s", issue.Line, cause)
+		}
+		causes = append(causes, cause)
+	}
+	message := fmt.Sprintf("edited YAML does not match the %s schema: %s", gvk.Kind, causes[0])
+	if len(causes) > 1 {
+		message += fmt.Sprintf(" (and %d more)", len(causes)-1)
+	}
+	return &objectYAMLError{
+		Code:    "SchemaValidationFailed",
+		Message: message,
+		Causes:  causes,
+		Issues:  issues,
+	}
+}
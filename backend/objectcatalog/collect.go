@@ -19,10 +19,8 @@ import (
 	"github.com/luxury-yacht/app/backend/resourcemodel"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	unstructuredv1 "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic"
 )
 
 func (s *Service) collectResource(ctx context.Context, index int, desc resourceDescriptor, namespaces []string, agg *streamingAggregator) ([]Summary, error) {
@@ -120,13 +118,59 @@ func (s *Service) summariesFromObjects(desc resourceDescriptor, objs []metav1.Ob
 	return result
 }
 
-func (s *Service) listResource(ctx context.Context, index int, desc resourceDescriptor, namespaces []string, agg *streamingAggregator) ([]Summary, error) {
+// catalogPageLister lists one page of a kind in one target namespace, returning
+// the page's objects and its continue token.
+type catalogPageLister func(ctx context.Context, options metav1.ListOptions) ([]metav1.Object, string, error)
+
+// pageListerFor returns the per-target page lister for desc: the metadata client
+// for metadata-only kinds (see metadataOnlyGroupResources), the dynamic client for
+// everything else.
+func (s *Service) pageListerFor(desc resourceDescriptor) (func(target string) catalogPageLister, error) {
+	if planCollectionSource(desc).source == collectionSourceMetadataList && s.deps.Common.MetadataClient != nil {
+		getter := s.deps.Common.MetadataClient.Resource(desc.GVR)
+		return func(target string) catalogPageLister {
+			resourceInterface := metadataInterfaceForTarget(getter, desc.Namespaced, target)
+			return func(ctx context.Context, options metav1.ListOptions) ([]metav1.Object, string, error) {
+				list, err := resourceInterface.List(ctx, options)
+				if err != nil || list == nil {
+					return nil, "", err
+				}
+				items := make([]metav1.Object, 0, len(list.Items))
+				for i := range list.Items {
+					items = append(items, &list.Items[i])
+				}
+				return items, list.GetContinue(), nil
+			}
+		}, nil
+	}
+
 	dynamicClient := s.deps.Common.DynamicClient
 	if dynamicClient == nil {
 		return nil, errors.New("dynamic client not available")
 	}
-
 	namespaceable := dynamicClient.Resource(desc.GVR)
+	return func(target string) catalogPageLister {
+		resourceInterface := resourceInterfaceForTarget(namespaceable, desc.Namespaced, target)
+		return func(ctx context.Context, options metav1.ListOptions) ([]metav1.Object, string, error) {
+			list, err := resourceInterface.List(ctx, options)
+			if err != nil || list == nil {
+				return nil, "", err
+			}
+			items := make([]metav1.Object, 0, len(list.Items))
+			for i := range list.Items {
+				items = append(items, &list.Items[i])
+			}
+			return items, list.GetContinue(), nil
+		}
+	}, nil
+}
+
+func (s *Service) listResource(ctx context.Context, index int, desc resourceDescriptor, namespaces []string, agg *streamingAggregator) ([]Summary, error) {
+	listerFor, err := s.pageListerFor(desc)
+	if err != nil {
+		return nil, err
+	}
+
 	var targets []string
 	if desc.Namespaced && len(namespaces) > 0 {
 		targets = uniqueNamespaces(namespaces)
@@ -144,10 +188,10 @@ func (s *Service) listResource(ctx context.Context, index int, desc resourceDesc
 	}
 
 	if desc.Namespaced && len(targets) > 1 && s.namespaceWorkerLimit(len(targets)) > 1 {
-		return s.listResourceNamespacedParallel(ctx, index, namespaceable, desc, targets, agg)
+		return s.listResourceNamespacedParallel(ctx, index, listerFor, desc, targets, agg)
 	}
 
-	return s.listResourceSequential(ctx, index, namespaceable, desc, targets, agg)
+	return s.listResourceSequential(ctx, index, listerFor, desc, targets, agg)
 }
 
 // scopeNamespaces returns the cluster's configured namespace scope for
@@ -169,7 +213,7 @@ func skipForbiddenNamespaceTarget(target string, err error) bool {
 	return target != "" && target != metav1.NamespaceAll && apierrors.IsForbidden(err)
 }
 
-func (s *Service) listResourceSequential(ctx context.Context, index int, namespaceable dynamic.NamespaceableResourceInterface, desc resourceDescriptor, targets []string, agg *streamingAggregator) ([]Summary, error) {
+func (s *Service) listResourceSequential(ctx context.Context, index int, listerFor func(target string) catalogPageLister, desc resourceDescriptor, targets []string, agg *streamingAggregator) ([]Summary, error) {
 	results := make([]Summary, 0)
 	for _, target := range targets {
 		select {
@@ -177,8 +221,7 @@ func (s *Service) listResourceSequential(ctx context.Context, index int, namespa
 			return nil, ctx.Err()
 		default:
 		}
-		resourceInterface := resourceInterfaceForTarget(namespaceable, desc.Namespaced, target)
-		items, err := s.listNamespaceItems(ctx, index, desc, resourceInterface, agg)
+		items, err := s.listNamespaceItems(ctx, index, desc, listerFor(target), agg)
 		if err != nil {
 			if skipForbiddenNamespaceTarget(target, err) {
 				continue
@@ -192,13 +235,12 @@ func (s *Service) listResourceSequential(ctx context.Context, index int, namespa
 	return results, nil
 }
 
-func (s *Service) listResourceNamespacedParallel(ctx context.Context, index int, namespaceable dynamic.NamespaceableResourceInterface, desc resourceDescriptor, targets []string, agg *streamingAggregator) ([]Summary, error) {
+func (s *Service) listResourceNamespacedParallel(ctx context.Context, index int, listerFor func(target string) catalogPageLister, desc resourceDescriptor, targets []string, agg *streamingAggregator) ([]Summary, error) {
 	results := make([]Summary, 0)
 	var mu sync.Mutex
 	limit := s.namespaceWorkerLimit(len(targets))
 	err := parallel.ForEach(ctx, targets, limit, func(taskCtx context.Context, target string) error {
-		resourceInterface := resourceInterfaceForTarget(namespaceable, true, target)
-		items, err := s.listNamespaceItems(taskCtx, index, desc, resourceInterface, agg)
+		items, err := s.listNamespaceItems(taskCtx, index, desc, listerFor(target), agg)
 		if err != nil {
 			if skipForbiddenNamespaceTarget(target, err) {
 				return nil
@@ -219,7 +261,7 @@ func (s *Service) listResourceNamespacedParallel(ctx context.Context, index int,
 	return results, nil
 }
 
-func (s *Service) listNamespaceItems(ctx context.Context, index int, desc resourceDescriptor, resourceInterface dynamic.ResourceInterface, agg *streamingAggregator) ([]Summary, error) {
+func (s *Service) listNamespaceItems(ctx context.Context, index int, desc resourceDescriptor, list catalogPageLister, agg *streamingAggregator) ([]Summary, error) {
 	batchSize := s.opts.PageSize
 	if s.opts.StreamingBatchSize > 0 && s.opts.StreamingBatchSize < batchSize {
 		batchSize = s.opts.StreamingBatchSize
@@ -233,10 +275,11 @@ func (s *Service) listNamespaceItems(ctx context.Context, index int, desc resour
 		default:
 		}
 
-		var list *unstructuredv1.UnstructuredList
+		var items []metav1.Object
+		var cont string
 		var err error
 		for attempt := range config.ObjectCatalogListRetryMaxAttempts {
-			list, err = resourceInterface.List(ctx, options)
+			items, cont, err = list(ctx, options)
 			if err == nil {
 				break
 			}
@@ -257,13 +300,8 @@ func (s *Service) listNamespaceItems(ctx context.Context, index int, desc resour
 				return nil, err
 			}
 		}
-		if list == nil {
-			return results, nil
-		}
-
-		page := make([]Summary, 0, len(list.Items))
-		for i := range list.Items {
-			item := &list.Items[i]
+		page := make([]Summary, 0, len(items))
+		for _, item := range items {
 			page = append(page, s.buildSummary(desc, item))
 		}
 		if len(page) > 0 {
@@ -273,7 +311,6 @@ func (s *Service) listNamespaceItems(ctx context.Context, index int, desc resour
 			}
 		}
 
-		cont := list.GetContinue()
 		if cont == "" {
 			break
 		}
@@ -327,7 +364,11 @@ func (s *Service) maybePromote(desc resourceDescriptor, itemCount int) {
 	}
 	gvk := schema.GroupVersionKind{Group: desc.Group, Version: desc.Version, Kind: desc.Kind}
 	project := func(obj metav1.Object) interface{} { return s.buildSummary(desc, obj) }
-	if !source.RegisterDynamicCatalogReflector(gvr, gvk, project, desc.Namespaced) {
+	register := source.RegisterDynamicCatalogReflector
+	if planCollectionSource(desc).source == collectionSourceMetadataList && s.deps.Common.MetadataClient != nil {
+		register = source.RegisterMetadataCatalogReflector
+	}
+	if !register(gvr, gvk, project, desc.Namespaced) {
 		return
 	}
 	source.AddCatalogSink(gvr, ingestCatalogSink{service: s, gvr: gvr})
@@ -393,8 +434,9 @@ func summaryFromObject(clusterID string, desc resourceDescriptor, item metav1.Ob
 		Scope:             desc.Scope,
 	}
 
-	if digest := labelsDigest(item.GetLabels()); digest != "" {
-		summary.LabelsDigest = digest
+	if labels := item.GetLabels(); len(labels) > 0 {
+		summary.LabelsDigest = labelsDigest(labels)
+		summary.searchLabels = searchLabelText(labels)
 	}
 	summary.ActionFacts = buildSummaryActionFacts(desc, item)
 
@@ -14,6 +14,7 @@ import (
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	clientfeatures "k8s.io/client-go/features"
 	clientfeaturestesting "k8s.io/client-go/features/testing"
+	metadatafake "k8s.io/client-go/metadata/fake"
 
 	"github.com/luxury-yacht/app/backend/refresh/ingest"
 	"github.com/luxury-yacht/app/backend/resources/common"
@@ -31,6 +32,8 @@ type fakeDynamicIngestSource struct {
 	projects map[schema.GroupVersionResource]ingest.CatalogProjector
 	stopped  map[schema.GroupVersionResource]bool
 	sinks    map[schema.GroupVersionResource][]ingest.Sink
+	// metadataOnly records the kinds registered through RegisterMetadataCatalogReflector.
+	metadataOnly map[schema.GroupVersionResource]bool
 }
 
 func newFakeDynamicIngestSource() *fakeDynamicIngestSource {
@@ -39,6 +42,8 @@ func newFakeDynamicIngestSource() *fakeDynamicIngestSource {
 		projects: map[schema.GroupVersionResource]ingest.CatalogProjector{},
 		stopped:  map[schema.GroupVersionResource]bool{},
 		sinks:    map[schema.GroupVersionResource][]ingest.Sink{},
+
+		metadataOnly: map[schema.GroupVersionResource]bool{},
 	}
 }
 
@@ -65,6 +70,18 @@ func (f *fakeDynamicIngestSource) RegisterDynamicCatalogReflector(gvr schema.Gro
 	return true
 }
 
+// RegisterMetadataCatalogReflector registers like the dynamic path and records that the
+// kind was promoted through the metadata client.
+func (f *fakeDynamicIngestSource) RegisterMetadataCatalogReflector(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project ingest.CatalogProjector, namespaced bool) bool {
+	if !f.RegisterDynamicCatalogReflector(gvr, gvk, project, namespaced) {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.metadataOnly[gvr] = true
+	return true
+}
+
 func (f *fakeDynamicIngestSource) StopReflectorFor(gvr schema.GroupVersionResource) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -202,3 +219,52 @@ func TestCatalogDynamicCRDPromotesOnlyAboveThreshold(t *testing.T) {
 	require.False(t, fake.registered(desc.GVR),
 		"a kind below the promotion threshold must NOT be promoted to the ingest path")
 }
+
+// TestCatalogMetadataOnlyKindUsesMetadataClient pins the metadata-only path: a Lease is
+// listed and promoted through the metadata client (no dynamic client is configured), and
+// its Summaries match what the full-object list path produces.
+func TestCatalogMetadataOnlyKindUsesMetadataClient(t *testing.T) {
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}
+	desc := resourceDescriptor{
+		GVR:        schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"},
+		Namespaced: true,
+		Kind:       "Lease",
+		Group:      "coordination.k8s.io",
+		Version:    "v1",
+		Resource:   "leases",
+		Scope:      ScopeNamespace,
+	}
+	newLease := func(name string) *metav1.PartialObjectMetadata {
+		obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "kube-system",
+			Name:            name,
+			ResourceVersion: "7",
+			Labels:          map[string]string{"app": name},
+		}}
+		obj.SetGroupVersionKind(gvk)
+		return obj
+	}
+	l1, l2 := newLease("l1"), newLease("l2")
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+
+	fake := newFakeDynamicIngestSource()
+	fake.seed(desc.GVR, l1, l2)
+	svc := NewService(Dependencies{
+		Common:       common.Dependencies{Context: ctx, MetadataClient: metadatafake.NewSimpleMetadataClient(scheme, l1, l2)},
+		IngestSource: fake,
+		ClusterID:    "c1",
+	}, &Options{ResyncInterval: time.Minute, PageSize: 200, ListWorkers: 2, InformerPromotionThreshold: 2})
+
+	listed, err := svc.collectResource(ctx, 0, desc, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	require.ElementsMatch(t, []Summary{summaryFromObject("c1", desc, l1), summaryFromObject("c1", desc, l2)}, listed)
+	require.True(t, fake.registered(desc.GVR))
+	require.True(t, fake.metadataOnly[desc.GVR], "a metadata-only kind must promote through the metadata client")
+
+	served, err := svc.collectResource(ctx, 0, desc, nil, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, listed, served)
+}
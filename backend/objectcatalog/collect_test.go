@@ -126,6 +126,10 @@ func (f *fakeCatalogIngestSource) RegisterDynamicCatalogReflector(schema.GroupVe
 	return false
 }
 
+func (f *fakeCatalogIngestSource) RegisterMetadataCatalogReflector(schema.GroupVersionResource, schema.GroupVersionKind, ingest.CatalogProjector, bool) bool {
+	return false
+}
+
 func (f *fakeCatalogIngestSource) StopReflectorFor(schema.GroupVersionResource) {}
 
 func (f *fakeCatalogIngestSource) HasSyncedFor(gvr schema.GroupVersionResource) bool {
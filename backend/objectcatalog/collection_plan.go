@@ -10,8 +10,19 @@ const (
 	collectionSourceGatewayInformer       collectionSourceKind = "gateway-informer"
 	collectionSourceAPIExtensionsInformer collectionSourceKind = "apiextensions-informer"
 	collectionSourceDynamicList           collectionSourceKind = "dynamic-list"
+	collectionSourceMetadataList          collectionSourceKind = "metadata-list"
 )
 
+// metadataOnlyGroupResources are high-cardinality kinds the app never reads past
+// name, namespace, labels and age. They are listed and promoted through the
+// metadata client, so the API server sends PartialObjectMetadata instead of full
+// objects. EndpointSlices and Events are not here: the ingest path reads their
+// endpoints and event fields, and the catalog does not collect Events.
+var metadataOnlyGroupResources = map[schema.GroupResource]struct{}{
+	{Group: "coordination.k8s.io", Resource: "leases"}: {},
+	{Group: "apps", Resource: "controllerrevisions"}:   {},
+}
+
 type collectionSourcePlan struct {
 	groupResource schema.GroupResource
 	source        collectionSourceKind
@@ -50,6 +61,9 @@ func planCollectionSourceForGroupResource(gr schema.GroupResource) collectionSou
 			plan.promotable = false
 			return plan
 		}
+		if _, ok := metadataOnlyGroupResources[gr]; ok {
+			plan.source = collectionSourceMetadataList
+		}
 	}
 
 	return plan
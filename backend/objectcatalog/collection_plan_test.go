@@ -41,6 +41,14 @@ func TestCollectionSourcePlanDocumentsSpecialCases(t *testing.T) {
 	require.True(t, crds.watchable)
 	require.False(t, crds.promotable)
 
+	leases := planCollectionSourceForGroupResource(schema.GroupResource{
+		Group:    "coordination.k8s.io",
+		Resource: "leases",
+	})
+	require.Equal(t, collectionSourceMetadataList, leases.source)
+	require.False(t, leases.watchable)
+	require.True(t, leases.promotable)
+
 	unknown := planCollectionSourceForGroupResource(schema.GroupResource{
 		Group:    "example.com",
 		Resource: "widgets",
@@ -161,6 +161,7 @@ func extractResourceDescriptors(resourceLists []*metav1.APIResourceList) []resou
 			Version:    desc.Version,
 			Resource:   desc.Resource,
 			Scope:      desc.Scope,
+			Categories: desc.Categories,
 		}
 		result = append(result, r)
 	}
@@ -206,6 +207,10 @@ func ExtractDescriptors(resourceLists []*metav1.APIResourceList) []Descriptor {
 			if apiResource.Namespaced {
 				scope = ScopeNamespace
 			}
+			categories := apiResource.Categories
+			if len(categories) == 0 {
+				categories = builtinCategories[groupVersion.Group+"/"+apiResource.Name]
+			}
 
 			result = append(result, Descriptor{
 				Group:      groupVersion.Group,
@@ -214,6 +219,7 @@ func ExtractDescriptors(resourceLists []*metav1.APIResourceList) []Descriptor {
 				Kind:       apiResource.Kind,
 				Scope:      scope,
 				Namespaced: apiResource.Namespaced,
+				Categories: append([]string(nil), categories...),
 			})
 		}
 	}
@@ -45,3 +45,25 @@ func TestExtractDescriptorsFiltersUnsupportedDiscoveryResources(t *testing.T) {
 		{Group: "", Version: "v1", Resource: "configmaps", Kind: "ConfigMap", Scope: ScopeNamespace, Namespaced: true},
 	}, descriptors)
 }
+
+func TestExtractDescriptorsKeepsCategories(t *testing.T) {
+	descriptors := ExtractDescriptors([]*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				// Fake discovery omits categories; built-in membership fills them.
+				{Name: "deployments", Namespaced: true, Kind: "Deployment", Verbs: []string{"list"}},
+			},
+		},
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Namespaced: true, Kind: "Widget", Verbs: []string{"list"}, Categories: []string{"all", "example"}},
+			},
+		},
+	})
+
+	require.Len(t, descriptors, 2)
+	require.Equal(t, []string{"all"}, descriptors[0].Categories)
+	require.Equal(t, []string{"all", "example"}, descriptors[1].Categories)
+}
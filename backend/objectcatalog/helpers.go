@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
 )
 
 // cloneSet creates a shallow copy of a string set.
@@ -71,6 +72,18 @@ func resourceInterfaceForTarget(namespaceable dynamic.NamespaceableResourceInter
 	return namespaceable.Namespace(ns)
 }
 
+// metadataInterfaceForTarget is resourceInterfaceForTarget for the metadata client.
+func metadataInterfaceForTarget(getter metadata.Getter, namespaced bool, target string) metadata.ResourceInterface {
+	if !namespaced {
+		return getter
+	}
+	ns := target
+	if ns == "" {
+		ns = metav1.NamespaceAll
+	}
+	return getter.Namespace(ns)
+}
+
 // shouldRetryList returns true if the error is retryable for list operations.
 func shouldRetryList(err error) bool {
 	return isRetryableListError(err)
@@ -121,6 +134,7 @@ func exportDescriptor(in resourceDescriptor) Descriptor {
 		Kind:       in.Kind,
 		Scope:      in.Scope,
 		Namespaced: in.Namespaced,
+		Categories: append([]string(nil), in.Categories...),
 	}
 }
 
@@ -153,6 +167,17 @@ func labelsDigest(labels map[string]string) string {
 	return fmt.Sprintf("%x", hash.Sum(nil))
 }
 
+// searchLabelText renders labels as lowercased, sorted "key=value" lines for
+// catalog search.
+func searchLabelText(labels map[string]string) string {
+	lines := make([]string, 0, len(labels))
+	for key, value := range labels {
+		lines = append(lines, strings.ToLower(key)+"="+strings.ToLower(value))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
 // containsVerb checks if a verb exists in a list of verbs.
 func containsVerb(verbs []string, target string) bool {
 	for _, verb := range verbs {
@@ -277,6 +302,7 @@ func toDescriptorSlice(resources []resourceDescriptor) []Descriptor {
 			Kind:       res.Kind,
 			Scope:      res.Scope,
 			Namespaced: res.Namespaced,
+			Categories: append([]string(nil), res.Categories...),
 		})
 	}
 	return result
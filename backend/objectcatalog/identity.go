@@ -39,17 +39,35 @@ var builtinResourceCatalog = builtinResourceDescriptors()
 func builtinResourceDescriptors() []resourceDescriptor {
 	descriptors := make([]resourceDescriptor, 0, len(resourcecontract.BuiltinResources))
 	for _, resource := range resourcecontract.BuiltinResources {
-		descriptors = append(descriptors, builtinDescriptor(
+		desc := builtinDescriptor(
 			resource.Group,
 			resource.Version,
 			resource.Kind,
 			resource.Resource,
 			resource.Namespaced,
-		))
+		)
+		desc.Categories = builtinCategories[desc.Group+"/"+desc.Resource]
+		descriptors = append(descriptors, desc)
 	}
 	return descriptors
 }
 
+// builtinCategories is the API server's category membership for the built-in
+// kinds, keyed by group/resource, for descriptors that did not come from
+// discovery. "all" is the set `kubectl get all` lists.
+var builtinCategories = map[string][]string{
+	"/pods":                                {"all"},
+	"/replicationcontrollers":              {"all"},
+	"/services":                            {"all"},
+	"apps/daemonsets":                      {"all"},
+	"apps/deployments":                     {"all"},
+	"apps/replicasets":                     {"all"},
+	"apps/statefulsets":                    {"all"},
+	"autoscaling/horizontalpodautoscalers": {"all"},
+	"batch/cronjobs":                       {"all"},
+	"batch/jobs":                           {"all"},
+}
+
 func builtinDescriptor(group, version, kind, resource string, namespaced bool) resourceDescriptor {
 	scope := ScopeCluster
 	if namespaced {
@@ -203,7 +221,9 @@ func (r *resourceIdentityResolver) resolveCRD(ctx context.Context, gvk schema.Gr
 				continue
 			}
 			namespaced := crd.Spec.Scope == apiextensionsv1.NamespaceScoped
-			return builtinDescriptor(crd.Spec.Group, version.Name, crd.Spec.Names.Kind, crd.Spec.Names.Plural, namespaced), true, nil
+			desc := builtinDescriptor(crd.Spec.Group, version.Name, crd.Spec.Names.Kind, crd.Spec.Names.Plural, namespaced)
+			desc.Categories = append([]string(nil), crd.Spec.Names.Categories...)
+			return desc, true, nil
 		}
 	}
 	return resourceDescriptor{}, false, nil
@@ -32,6 +32,9 @@ func (r replayIngestSource) AddCatalogSink(gvr schema.GroupVersionResource, sink
 func (r replayIngestSource) RegisterDynamicCatalogReflector(schema.GroupVersionResource, schema.GroupVersionKind, ingest.CatalogProjector, bool) bool {
 	return false
 }
+func (r replayIngestSource) RegisterMetadataCatalogReflector(schema.GroupVersionResource, schema.GroupVersionKind, ingest.CatalogProjector, bool) bool {
+	return false
+}
 func (r replayIngestSource) StopReflectorFor(schema.GroupVersionResource)  {}
 func (r replayIngestSource) HasSyncedFor(schema.GroupVersionResource) bool { return true }
 
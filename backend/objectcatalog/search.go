@@ -0,0 +1,175 @@
+/*
+ * backend/objectcatalog/search.go
+ *
+ * Ranked free-text search over the catalog, used by the cross-cluster "jump to
+ * anything" lookup. Everything is answered from the in-memory index.
+ */
+
+package objectcatalog
+
+import (
+	"sort"
+	"strings"
+)
+
+// SearchMatch is a catalog item that matched a search, with its rank.
+type SearchMatch struct {
+	Summary Summary
+	Score   int
+}
+
+// searchTerm is one whitespace-separated part of a search query. Qualified
+// terms ("kind:pod", "ns:prod", "app=web") must match their field; bare terms
+// may match the name, namespace, kind or any label.
+type searchTerm struct {
+	field string
+	key   string
+	value string
+}
+
+// Search scores every catalog item against query and returns up to limit
+// matches, best first. Every term in the query must match. A limit of zero or
+// less returns all matches.
+func (s *Service) Search(query string, limit int) []SearchMatch {
+	if s == nil {
+		return nil
+	}
+	terms := parseSearchQuery(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	s.mu.RLock()
+	var matches []SearchMatch
+	for _, item := range s.catalogIndex.items {
+		if score, ok := scoreSearch(item, terms); ok {
+			matches = append(matches, SearchMatch{Summary: item, Score: score})
+		}
+	}
+	s.mu.RUnlock()
+
+	SortSearchMatches(matches)
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// SortSearchMatches orders matches by score, then by shorter name, then by
+// identity so results are stable across calls and clusters.
+func SortSearchMatches(matches []SearchMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Summary.Ref.Name) != len(b.Summary.Ref.Name) {
+			return len(a.Summary.Ref.Name) < len(b.Summary.Ref.Name)
+		}
+		if a.Summary.Ref.Name != b.Summary.Ref.Name {
+			return a.Summary.Ref.Name < b.Summary.Ref.Name
+		}
+		if a.Summary.Ref.Kind != b.Summary.Ref.Kind {
+			return a.Summary.Ref.Kind < b.Summary.Ref.Kind
+		}
+		if a.Summary.Ref.Namespace != b.Summary.Ref.Namespace {
+			return a.Summary.Ref.Namespace < b.Summary.Ref.Namespace
+		}
+		return a.Summary.Ref.ClusterID < b.Summary.Ref.ClusterID
+	})
+}
+
+func parseSearchQuery(query string) []searchTerm {
+	fields := strings.Fields(strings.ToLower(query))
+	terms := make([]searchTerm, 0, len(fields))
+	for _, field := range fields {
+		if prefix, value, ok := strings.Cut(field, ":"); ok && value != "" {
+			switch prefix {
+			case "kind", "k":
+				terms = append(terms, searchTerm{field: "kind", value: value})
+				continue
+			case "ns", "namespace":
+				terms = append(terms, searchTerm{field: "namespace", value: value})
+				continue
+			case "label", "l":
+				key, labelValue, _ := strings.Cut(value, "=")
+				terms = append(terms, searchTerm{field: "label", key: key, value: labelValue})
+				continue
+			}
+		}
+		if key, value, ok := strings.Cut(field, "="); ok && key != "" {
+			terms = append(terms, searchTerm{field: "label", key: key, value: value})
+			continue
+		}
+		terms = append(terms, searchTerm{value: field})
+	}
+	return terms
+}
+
+// scoreSearch reports whether item matches every term and how well. Exact and
+// prefix name matches outrank everything else.
+func scoreSearch(item Summary, terms []searchTerm) (int, bool) {
+	name := strings.ToLower(item.Ref.Name)
+	namespace := strings.ToLower(item.Ref.Namespace)
+	kind := strings.ToLower(item.Ref.Kind)
+	total := 0
+	for _, term := range terms {
+		score := 0
+		switch term.field {
+		case "kind":
+			if kind == term.value || strings.ToLower(item.Ref.Resource) == term.value {
+				score = 10
+			}
+		case "namespace":
+			score = matchScore(namespace, term.value, 10, 6, 3)
+		case "label":
+			score = labelScore(item.searchLabels, term.key, term.value)
+		default:
+			score = matchScore(name, term.value, 100, 60, 30)
+			score = max(score, matchScore(kind, term.value, 20, 10, 0))
+			score = max(score, matchScore(namespace, term.value, 15, 8, 4))
+			score = max(score, labelScore(item.searchLabels, "", term.value))
+		}
+		if score == 0 {
+			return 0, false
+		}
+		total += score
+	}
+	return total, true
+}
+
+func matchScore(candidate, value string, exact, prefix, contains int) int {
+	switch {
+	case candidate == "":
+		return 0
+	case candidate == value:
+		return exact
+	case strings.HasPrefix(candidate, value):
+		return prefix
+	case strings.Contains(candidate, value):
+		return contains
+	}
+	return 0
+}
+
+// labelScore matches a label by key and value. An empty key matches the value
+// against any label value; an empty value matches any label with the key.
+func labelScore(labels, key, value string) int {
+	best := 0
+	for line := range strings.SplitSeq(labels, "\n") {
+		labelKey, labelValue, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		switch {
+		case key == "":
+			best = max(best, matchScore(labelValue, value, 12, 6, 0))
+		case labelKey != key:
+		case value == "" || labelValue == value:
+			best = max(best, 12)
+		case strings.HasPrefix(labelValue, value):
+			best = max(best, 6)
+		}
+	}
+	return best
+}
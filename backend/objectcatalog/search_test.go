@@ -0,0 +1,65 @@
+package objectcatalog
+
+import (
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func TestSearchRanksAndFilters(t *testing.T) {
+	svc := NewService(Dependencies{}, nil)
+	svc.mu.Lock()
+	svc.items = map[string]Summary{
+		"pod-web": {
+			Ref:          resourcemodel.ResourceRef{ClusterID: "cluster-a", Version: "v1", Kind: "Pod", Resource: "pods", Namespace: "prod", Name: "web-7d9f"},
+			searchLabels: searchLabelText(map[string]string{"app": "web"}),
+		},
+		"deploy-web": {
+			Ref:          resourcemodel.ResourceRef{ClusterID: "cluster-a", Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "prod", Name: "web"},
+			searchLabels: searchLabelText(map[string]string{"app": "web"}),
+		},
+		"svc-api": {
+			Ref:          resourcemodel.ResourceRef{ClusterID: "cluster-a", Version: "v1", Kind: "Service", Resource: "services", Namespace: "staging", Name: "api"},
+			searchLabels: searchLabelText(map[string]string{"tier": "web-frontend"}),
+		},
+		"ns-prod": {
+			Ref: resourcemodel.ResourceRef{ClusterID: "cluster-a", Version: "v1", Kind: "Namespace", Resource: "namespaces", Name: "prod"},
+		},
+	}
+	svc.mu.Unlock()
+
+	names := func(matches []SearchMatch) []string {
+		result := make([]string, 0, len(matches))
+		for _, match := range matches {
+			result = append(result, match.Summary.Ref.Name)
+		}
+		return result
+	}
+	assertNames := func(query string, want ...string) {
+		t.Helper()
+		got := names(svc.Search(query, 0))
+		if len(got) != len(want) {
+			t.Fatalf("Search(%q) = %v, want %v", query, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Search(%q) = %v, want %v", query, got, want)
+			}
+		}
+	}
+
+	// An exact name outranks a prefix, which outranks a label value.
+	assertNames("web", "web", "web-7d9f", "api")
+	assertNames("kind:pod web", "web-7d9f")
+	assertNames("k:deployments", "web")
+	assertNames("ns:staging", "api")
+	assertNames("app=web", "web", "web-7d9f")
+	assertNames("label:tier", "api")
+	assertNames("web ns:prod", "web", "web-7d9f")
+	assertNames("missing")
+	assertNames("   ")
+
+	if got := svc.Search("web", 1); len(got) != 1 || got[0].Summary.Ref.Name != "web" {
+		t.Fatalf("expected limit to keep the best match, got %v", names(got))
+	}
+}
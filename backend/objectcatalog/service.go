@@ -119,6 +119,7 @@ type resourceDescriptor struct {
 	Version    string
 	Resource   string
 	Scope      Scope
+	Categories []string
 }
 
 // summaryChunk holds one published batch of summaries. Chunks are IMMUTABLE
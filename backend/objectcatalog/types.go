@@ -66,6 +66,10 @@ type Summary struct {
 	Scope             Scope                     `json:"scope"`                  // resource scope
 	LabelsDigest      string                    `json:"labelsDigest,omitempty"` // optional digest of resource labels
 	ActionFacts       *ActionFacts              `json:"actionFacts,omitempty"`  // optional facts needed to present object actions correctly
+
+	// searchLabels holds the lowercased labels as sorted "key=value" lines for
+	// in-process search. A string keeps Summary comparable.
+	searchLabels string
 }
 
 // ActionFacts carries lightweight, action-relevant state for catalog rows.
@@ -98,6 +102,9 @@ type Descriptor struct {
 	Kind       string // resource kind
 	Scope      Scope  // resource scope
 	Namespaced bool   // indicates if the resource is namespaced
+	// Categories are the discovery categories the resource belongs to, such
+	// as "all" for the core workload kinds or a CRD's spec.names.categories.
+	Categories []string
 }
 
 // GVR returns the full GroupVersionResource for the descriptor.
@@ -150,6 +157,9 @@ type IngestSource interface {
 	// catalog calls it when a CR kind crosses its promotion threshold (maybePromote),
 	// consolidating the former catalog-owned dynamic informer onto the ingest path.
 	RegisterDynamicCatalogReflector(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project ingest.CatalogProjector, namespaced bool) bool
+	// RegisterMetadataCatalogReflector is RegisterDynamicCatalogReflector over the
+	// metadata client, used to promote metadata-only kinds (metadataOnlyGroupResources).
+	RegisterMetadataCatalogReflector(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project ingest.CatalogProjector, namespaced bool) bool
 	// StopReflectorFor stops and evicts the on-demand reflector for gvr, the teardown half
 	// of the dynamic path (stopDynamicReflectors).
 	StopReflectorFor(gvr schema.GroupVersionResource)
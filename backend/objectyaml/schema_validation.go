@@ -0,0 +1,445 @@
+package objectyaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	serializerjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	goyaml "sigs.k8s.io/yaml/goyaml.v3"
+)
+
+// SchemaIssue is one problem found by local schema validation, anchored to
+// the YAML line and column of the offending field when it can be located.
+type SchemaIssue struct {
+	Path    string `json:"path"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// pathSegment is a mapping key, or a sequence index when isIndex is set.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+type fieldPath []pathSegment
+
+func (p fieldPath) child(key string) fieldPath {
+	return append(append(fieldPath{}, p...), pathSegment{key: key})
+}
+
+func (p fieldPath) item(index int) fieldPath {
+	return append(append(fieldPath{}, p...), pathSegment{index: index, isIndex: true})
+}
+
+func (p fieldPath) String() string {
+	var b strings.Builder
+	for _, segment := range p {
+		if segment.isIndex {
+			fmt.Fprintf(&b, "[%d]", segment.index)
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(segment.key)
+	}
+	return b.String()
+}
+
+type pathIssue struct {
+	path    fieldPath
+	message string
+}
+
+// ValidateAgainstCRDSchema checks obj against a CRD version's structural
+// schema: unknown fields (which the API server would reject or prune),
+// missing required fields, type mismatches, enums, and numeric/string
+// bounds. The object envelope (apiVersion, kind, metadata) is not checked.
+func ValidateAgainstCRDSchema(yamlText string, obj map[string]interface{}, root *apiextensionsv1.JSONSchemaProps) []SchemaIssue {
+	if root == nil {
+		return nil
+	}
+	var issues []pathIssue
+	required := make(map[string]bool, len(root.Required))
+	for _, name := range root.Required {
+		required[name] = true
+	}
+	for _, name := range sortedKeys(obj) {
+		switch name {
+		case "apiVersion", "kind", "metadata":
+			continue
+		}
+		prop, ok := root.Properties[name]
+		if !ok {
+			if !preservesUnknown(root) {
+				issues = append(issues, pathIssue{fieldPath{{key: name}}, "unknown field"})
+			}
+			continue
+		}
+		issues = append(issues, validateValue(fieldPath{{key: name}}, obj[name], &prop)...)
+	}
+	for _, name := range root.Required {
+		switch name {
+		case "apiVersion", "kind", "metadata":
+			continue
+		}
+		if _, ok := obj[name]; !ok {
+			issues = append(issues, pathIssue{fieldPath{{key: name}}, "required field is missing"})
+		}
+	}
+	return anchorIssues(yamlText, issues)
+}
+
+func validateValue(path fieldPath, value interface{}, prop *apiextensionsv1.JSONSchemaProps) []pathIssue {
+	if value == nil {
+		if prop.Nullable {
+			return nil
+		}
+		return []pathIssue{{path, "must not be null"}}
+	}
+	if prop.XEmbeddedResource || (prop.XPreserveUnknownFields != nil && *prop.XPreserveUnknownFields && prop.Type == "" && len(prop.Properties) == 0) {
+		return nil
+	}
+	if prop.XIntOrString {
+		switch value.(type) {
+		case string, int64, float64:
+			return nil
+		default:
+			return []pathIssue{{path, fmt.Sprintf("must be an integer or string, got %s", jsonType(value))}}
+		}
+	}
+
+	var issues []pathIssue
+	if prop.Type != "" && !typeMatches(prop.Type, value) {
+		return []pathIssue{{path, fmt.Sprintf("must be of type %s, got %s", prop.Type, jsonType(value))}}
+	}
+	if len(prop.Enum) > 0 && !enumContains(prop.Enum, value) {
+		issues = append(issues, pathIssue{path, fmt.Sprintf("unsupported value %s; must be one of %s", compactJSON(value), enumList(prop.Enum))})
+	}
+
+	switch typed := value.(type) {
+	case string:
+		if prop.MaxLength != nil && int64(len(typed)) > *prop.MaxLength {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must be at most %d characters", *prop.MaxLength)})
+		}
+		if prop.MinLength != nil && int64(len(typed)) < *prop.MinLength {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must be at least %d characters", *prop.MinLength)})
+		}
+		if prop.Pattern != "" {
+			if re, err := regexp.Compile(prop.Pattern); err == nil && !re.MatchString(typed) {
+				issues = append(issues, pathIssue{path, fmt.Sprintf("must match pattern %s", prop.Pattern)})
+			}
+		}
+	case int64, float64:
+		number := toFloat(typed)
+		if prop.Minimum != nil && (number < *prop.Minimum || (prop.ExclusiveMinimum && number == *prop.Minimum)) {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must be greater than %s%v", orEqual(!prop.ExclusiveMinimum), *prop.Minimum)})
+		}
+		if prop.Maximum != nil && (number > *prop.Maximum || (prop.ExclusiveMaximum && number == *prop.Maximum)) {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must be less than %s%v", orEqual(!prop.ExclusiveMaximum), *prop.Maximum)})
+		}
+	case []interface{}:
+		if prop.MaxItems != nil && int64(len(typed)) > *prop.MaxItems {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must have at most %d items", *prop.MaxItems)})
+		}
+		if prop.MinItems != nil && int64(len(typed)) < *prop.MinItems {
+			issues = append(issues, pathIssue{path, fmt.Sprintf("must have at least %d items", *prop.MinItems)})
+		}
+		if prop.Items != nil && prop.Items.Schema != nil {
+			for i, item := range typed {
+				issues = append(issues, validateValue(path.item(i), item, prop.Items.Schema)...)
+			}
+		}
+	case map[string]interface{}:
+		issues = append(issues, validateObject(path, typed, prop)...)
+	}
+	return issues
+}
+
+func validateObject(path fieldPath, obj map[string]interface{}, prop *apiextensionsv1.JSONSchemaProps) []pathIssue {
+	var issues []pathIssue
+	for _, name := range sortedKeys(obj) {
+		child, ok := prop.Properties[name]
+		switch {
+		case ok:
+			issues = append(issues, validateValue(path.child(name), obj[name], &child)...)
+		case prop.AdditionalProperties != nil && prop.AdditionalProperties.Schema != nil:
+			issues = append(issues, validateValue(path.child(name), obj[name], prop.AdditionalProperties.Schema)...)
+		case prop.AdditionalProperties != nil && prop.AdditionalProperties.Allows, preservesUnknown(prop):
+		default:
+			issues = append(issues, pathIssue{path.child(name), "unknown field"})
+		}
+	}
+	for _, name := range prop.Required {
+		if _, ok := obj[name]; !ok {
+			issues = append(issues, pathIssue{path.child(name), "required field is missing"})
+		}
+	}
+	return issues
+}
+
+func preservesUnknown(prop *apiextensionsv1.JSONSchemaProps) bool {
+	return prop.XPreserveUnknownFields != nil && *prop.XPreserveUnknownFields
+}
+
+func typeMatches(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		switch typed := value.(type) {
+		case int64:
+			return true
+		case float64:
+			return typed == float64(int64(typed))
+		}
+		return false
+	case "number":
+		switch value.(type) {
+		case int64, float64:
+			return true
+		}
+		return false
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64:
+		return "integer"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func enumContains(enum []apiextensionsv1.JSON, value interface{}) bool {
+	encoded := compactJSON(value)
+	for _, candidate := range enum {
+		var decoded interface{}
+		if err := json.Unmarshal(candidate.Raw, &decoded); err != nil {
+			continue
+		}
+		if compactJSON(decoded) == encoded {
+			return true
+		}
+	}
+	return false
+}
+
+func enumList(enum []apiextensionsv1.JSON) string {
+	values := make([]string, 0, len(enum))
+	for _, candidate := range enum {
+		values = append(values, string(candidate.Raw))
+	}
+	return strings.Join(values, ", ")
+}
+
+func compactJSON(value interface{}) string {
+	if number, ok := value.(int64); ok {
+		value = float64(number)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+func toFloat(value interface{}) float64 {
+	switch typed := value.(type) {
+	case int64:
+		return float64(typed)
+	case float64:
+		return typed
+	}
+	return 0
+}
+
+func orEqual(inclusive bool) string {
+	if inclusive {
+		return "or equal to "
+	}
+	return ""
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Decoder error shapes for built-in kinds: strict-mode field errors carry the
+// full path; type errors name the Go struct field's JSON path without indices.
+var (
+	strictFieldPattern = regexp.MustCompile(`^(unknown|duplicate) field "([^"]+)"$`)
+	typeErrorPattern   = regexp.MustCompile(`cannot unmarshal (\S+) into Go struct field \w+\.(\S+) of type (\S+)`)
+)
+
+// ValidateBuiltin decodes yamlText strictly into the typed object registered
+// for gvk in scheme, reporting unknown and duplicate fields and type
+// mismatches. ok is false when scheme does not know gvk.
+func ValidateBuiltin(yamlText string, gvk schema.GroupVersionKind, scheme *runtime.Scheme) (issues []SchemaIssue, ok bool) {
+	if !scheme.Recognizes(gvk) {
+		return nil, false
+	}
+	serializer := serializerjson.NewSerializerWithOptions(serializerjson.DefaultMetaFactory, scheme, scheme, serializerjson.SerializerOptions{Yaml: true, Strict: true})
+	_, _, err := serializer.Decode([]byte(yamlText), &gvk, nil)
+	if err == nil {
+		return nil, true
+	}
+
+	var found []pathIssue
+	if strict, isStrict := runtime.AsStrictDecodingError(err); isStrict {
+		for _, fieldErr := range strict.Errors() {
+			message := fieldErr.Error()
+			if match := strictFieldPattern.FindStringSubmatch(message); match != nil {
+				found = append(found, pathIssue{parseFieldPath(match[2]), match[1] + " field"})
+				continue
+			}
+			found = append(found, pathIssue{nil, message})
+		}
+	} else if match := typeErrorPattern.FindStringSubmatch(err.Error()); match != nil {
+		found = append(found, pathIssue{parseFieldPath(match[2]), fmt.Sprintf("must be of type %s, got %s", match[3], match[1])})
+	} else {
+		found = append(found, pathIssue{nil, err.Error()})
+	}
+	return anchorIssues(yamlText, found), true
+}
+
+// parseFieldPath parses "spec.containers[0].image" into segments.
+func parseFieldPath(text string) fieldPath {
+	var path fieldPath
+	for _, part := range strings.Split(text, ".") {
+		for part != "" {
+			open := strings.IndexByte(part, '[')
+			if open < 0 {
+				path = append(path, pathSegment{key: part})
+				break
+			}
+			if open > 0 {
+				path = append(path, pathSegment{key: part[:open]})
+			}
+			end := strings.IndexByte(part[open:], ']')
+			if end < 0 {
+				path = append(path, pathSegment{key: part[open:]})
+				break
+			}
+			index, err := strconv.Atoi(part[open+1 : open+end])
+			if err != nil {
+				path = append(path, pathSegment{key: part[open : open+end+1]})
+			} else {
+				path = append(path, pathSegment{index: index, isIndex: true})
+			}
+			part = part[open+end+1:]
+		}
+	}
+	return path
+}
+
+// anchorIssues resolves each issue's path to a YAML position. A missing field
+// is anchored to its nearest existing parent.
+func anchorIssues(yamlText string, issues []pathIssue) []SchemaIssue {
+	if len(issues) == 0 {
+		return nil
+	}
+	var doc goyaml.Node
+	root := (*goyaml.Node)(nil)
+	if err := goyaml.Unmarshal([]byte(yamlText), &doc); err == nil && len(doc.Content) > 0 {
+		root = doc.Content[0]
+	}
+	out := make([]SchemaIssue, 0, len(issues))
+	for _, issue := range issues {
+		anchored := SchemaIssue{Path: issue.path.String(), Message: issue.message}
+		if node := locate(root, issue.path); node != nil {
+			anchored.Line, anchored.Column = node.Line, node.Column
+		}
+		out = append(out, anchored)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Line < out[j].Line })
+	return out
+}
+
+// locate walks path from node, returning the deepest node reached. Mapping
+// keys resolve to the key node so the anchor points at the field name. Paths
+// without indices (from decoder type errors) search every sequence item.
+func locate(node *goyaml.Node, path fieldPath) *goyaml.Node {
+	if node == nil {
+		return nil
+	}
+	if len(path) == 0 {
+		return node
+	}
+	segment := path[0]
+	switch node.Kind {
+	case goyaml.DocumentNode, goyaml.AliasNode:
+		if len(node.Content) > 0 {
+			return locate(node.Content[0], path)
+		}
+	case goyaml.SequenceNode:
+		if segment.isIndex {
+			if segment.index < len(node.Content) {
+				if found := locate(node.Content[segment.index], path[1:]); found != nil {
+					return found
+				}
+			}
+			return node
+		}
+		for _, item := range node.Content {
+			if found := locate(item, path); found != nil && found != item {
+				return found
+			}
+		}
+	case goyaml.MappingNode:
+		if segment.isIndex {
+			return node
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != segment.key {
+				continue
+			}
+			if len(path) == 1 {
+				return node.Content[i]
+			}
+			if found := locate(node.Content[i+1], path[1:]); found != nil && found != node.Content[i+1] {
+				return found
+			}
+			return node.Content[i]
+		}
+	}
+	return node
+}
@@ -0,0 +1,125 @@
+package objectyaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func widgetSchema() *apiextensionsv1.JSONSchemaProps {
+	return &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec": {
+				Type:     "object",
+				Required: []string{"size"},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"size":     {Type: "string", Enum: []apiextensionsv1.JSON{{Raw: []byte(`"small"`)}, {Raw: []byte(`"large"`)}}},
+					"replicas": {Type: "integer", Minimum: ptr.To(1.0)},
+					"port":     {XIntOrString: true},
+					"labels":   {Type: "object", AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}}},
+					"extra":    {Type: "object", XPreserveUnknownFields: ptr.To(true)},
+					"parts": {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:       "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string"}},
+					}}},
+				},
+			},
+		},
+	}
+}
+
+func validateWidget(t *testing.T, text string) []SchemaIssue {
+	t.Helper()
+	var obj map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(text), &obj))
+	return ValidateAgainstCRDSchema(text, obj, widgetSchema())
+}
+
+func TestValidateAgainstCRDSchemaAcceptsValidObject(t *testing.T) {
+	issues := validateWidget(t, `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: demo
+spec:
+  size: small
+  replicas: 2
+  port: http
+  labels:
+    team: web
+  extra:
+    anything: [1, 2]
+  parts:
+    - name: wheel
+`)
+	require.Empty(t, issues)
+}
+
+func TestValidateAgainstCRDSchemaReportsLineAnchoredIssues(t *testing.T) {
+	issues := validateWidget(t, `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: demo
+spec:
+  replicas: 0
+  labels:
+    team: 5
+  parts:
+    - name: wheel
+    - nmae: axle
+  colour: red
+`)
+	require.Equal(t, []SchemaIssue{
+		{Path: "spec.size", Line: 5, Column: 1, Message: "required field is missing"},
+		{Path: "spec.replicas", Line: 6, Column: 3, Message: "must be greater than or equal to 1"},
+		{Path: "spec.labels.team", Line: 8, Column: 5, Message: "must be of type string, got number"},
+		{Path: "spec.parts[1].nmae", Line: 11, Column: 7, Message: "unknown field"},
+		{Path: "spec.colour", Line: 12, Column: 3, Message: "unknown field"},
+	}, issues)
+}
+
+func TestValidateAgainstCRDSchemaReportsEnumAndType(t *testing.T) {
+	issues := validateWidget(t, "spec:\n  size: medium\n  replicas: many\n")
+	require.Len(t, issues, 2)
+	require.Equal(t, "spec.size", issues[0].Path)
+	require.Contains(t, issues[0].Message, `"small", "large"`)
+	require.Equal(t, "spec.replicas", issues[1].Path)
+	require.Equal(t, 3, issues[1].Line)
+}
+
+func TestValidateBuiltinReportsUnknownAndMistypedFields(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	text := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          imagePullPolicyy: Always
+`
+	issues, ok := ValidateBuiltin(text, gvk, kubescheme.Scheme)
+	require.True(t, ok)
+	require.Equal(t, []SchemaIssue{{
+		Path: "spec.template.spec.containers[0].imagePullPolicyy", Line: 10, Column: 11, Message: "unknown field",
+	}}, issues)
+
+	issues, ok = ValidateBuiltin("apiVersion: apps/v1\nkind: Deployment\nspec:\n  replicas: two\n", gvk, kubescheme.Scheme)
+	require.True(t, ok)
+	require.Len(t, issues, 1)
+	require.Equal(t, "spec.replicas", issues[0].Path)
+	require.Equal(t, 4, issues[0].Line)
+
+	_, ok = ValidateBuiltin("kind: Widget\n", schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, kubescheme.Scheme)
+	require.False(t, ok)
+}
@@ -0,0 +1,391 @@
+package objectyaml
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+// Conflict marker lines, git style: the draft side comes first, the live side
+// second.
+const (
+	ConflictMarkerDraft     = "<<<<<<< draft"
+	ConflictMarkerSeparator = "======="
+	ConflictMarkerLive      = ">>>>>>> live"
+)
+
+const conflictPlaceholderPrefix = "__objectyaml_merge_conflict_"
+
+// MergeConflict is one field the draft and the live object both changed, to
+// different values, since the base. Values are rendered as YAML; a side is
+// empty when it removed the field.
+type MergeConflict struct {
+	Path  string `json:"path"`
+	Base  string `json:"base,omitempty"`
+	Live  string `json:"live,omitempty"`
+	Draft string `json:"draft,omitempty"`
+}
+
+// MergeResult is a three-way merge of an edited draft onto the live object.
+// Object holds the merged document with a placeholder at each conflict; Render
+// turns it into YAML with conflict markers in their place.
+type MergeResult struct {
+	Object    map[string]interface{}
+	Conflicts []MergeConflict
+	markers   []conflictMarker
+}
+
+type conflictMarker struct {
+	token  string
+	key    string
+	inList bool
+	live   mergeSide
+	draft  mergeSide
+}
+
+type mergeSide struct {
+	value   interface{}
+	present bool
+}
+
+// ThreeWayMerge merges the changes made from base to draft with those made
+// from base to live. A field changed on only one side takes that side's value;
+// nested objects merge field by field, and lists merge element by element when
+// patchMeta gives them a strategic merge key (containers by name, for
+// example). Other lists are atomic. patchMeta may be nil for kinds without a
+// registered schema, in which case every list is atomic.
+func ThreeWayMerge(base, live, draft map[string]interface{}, patchMeta strategicpatch.LookupPatchMeta) *MergeResult {
+	result := &MergeResult{}
+	merged := result.mergeMap("", base, live, draft, patchMeta)
+	result.Object = merged
+	return result
+}
+
+// HasConflicts reports whether any field needs resolving by hand.
+func (r *MergeResult) HasConflicts() bool {
+	return len(r.Conflicts) > 0
+}
+
+func (r *MergeResult) mergeMap(
+	path string,
+	base, live, draft map[string]interface{},
+	patchMeta strategicpatch.LookupPatchMeta,
+) map[string]interface{} {
+	keys := make(map[string]struct{}, len(live)+len(draft))
+	for _, doc := range []map[string]interface{}{base, live, draft} {
+		for key := range doc {
+			keys[key] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	merged := make(map[string]interface{}, len(sorted))
+	for _, key := range sorted {
+		b, bok := base[key]
+		l, lok := live[key]
+		d, dok := draft[key]
+		value := r.mergeValue(
+			joinMergePath(path, key),
+			key,
+			false,
+			mergeSide{b, bok},
+			mergeSide{l, lok},
+			mergeSide{d, dok},
+			patchMeta,
+		)
+		if value.present {
+			merged[key] = value.value
+		}
+	}
+	return merged
+}
+
+// mergeValue merges one field. parentMeta describes the object holding it, and
+// key is its name there; list elements pass the element meta and an empty key.
+func (r *MergeResult) mergeValue(
+	path, key string,
+	inList bool,
+	base, live, draft mergeSide,
+	parentMeta strategicpatch.LookupPatchMeta,
+) mergeSide {
+	switch {
+	case sameSide(draft, base):
+		return live
+	case sameSide(live, base):
+		return draft
+	case sameSide(live, draft):
+		return draft
+	}
+
+	if live.present && draft.present {
+		liveMap, liveIsMap := live.value.(map[string]interface{})
+		draftMap, draftIsMap := draft.value.(map[string]interface{})
+		baseMap, baseIsMap := base.value.(map[string]interface{})
+		if liveIsMap && draftIsMap && (baseIsMap || !base.present) {
+			meta := parentMeta
+			if !inList {
+				meta = lookupStructMeta(parentMeta, key)
+			}
+			return mergeSide{r.mergeMap(path, baseMap, liveMap, draftMap, meta), true}
+		}
+
+		liveList, liveIsList := live.value.([]interface{})
+		draftList, draftIsList := draft.value.([]interface{})
+		baseList, baseIsList := base.value.([]interface{})
+		if liveIsList && draftIsList && (baseIsList || !base.present) && !inList {
+			if elemMeta, mergeKey := lookupListMergeKey(parentMeta, key); mergeKey != "" {
+				if merged, ok := r.mergeKeyedList(path, baseList, liveList, draftList, mergeKey, elemMeta); ok {
+					return mergeSide{merged, true}
+				}
+			}
+		}
+	}
+
+	return mergeSide{r.conflict(path, key, inList, base, live, draft), true}
+}
+
+// mergeKeyedList merges lists whose elements are identified by mergeKey. The
+// result follows the live order, with elements only the draft added appended
+// in draft order. It reports false when an element lacks the key, so the
+// caller treats the list as atomic instead.
+func (r *MergeResult) mergeKeyedList(
+	path string,
+	base, live, draft []interface{},
+	mergeKey string,
+	elemMeta strategicpatch.LookupPatchMeta,
+) ([]interface{}, bool) {
+	baseByKey, _, ok := indexByMergeKey(base, mergeKey)
+	if !ok {
+		return nil, false
+	}
+	liveByKey, liveOrder, ok := indexByMergeKey(live, mergeKey)
+	if !ok {
+		return nil, false
+	}
+	draftByKey, draftOrder, ok := indexByMergeKey(draft, mergeKey)
+	if !ok {
+		return nil, false
+	}
+
+	order := append([]string{}, liveOrder...)
+	for _, id := range draftOrder {
+		if _, inLive := liveByKey[id]; !inLive {
+			order = append(order, id)
+		}
+	}
+
+	merged := make([]interface{}, 0, len(order))
+	for _, id := range order {
+		b, bok := baseByKey[id]
+		l, lok := liveByKey[id]
+		d, dok := draftByKey[id]
+		value := r.mergeValue(
+			fmt.Sprintf("%s[%s=%s]", path, mergeKey, id),
+			"",
+			true,
+			mergeSide{b, bok},
+			mergeSide{l, lok},
+			mergeSide{d, dok},
+			elemMeta,
+		)
+		if value.present {
+			merged = append(merged, value.value)
+		}
+	}
+	return merged, true
+}
+
+func (r *MergeResult) conflict(path, key string, inList bool, base, live, draft mergeSide) string {
+	token := fmt.Sprintf("%s%d__", conflictPlaceholderPrefix, len(r.markers))
+	r.markers = append(r.markers, conflictMarker{token: token, key: key, inList: inList, live: live, draft: draft})
+	r.Conflicts = append(r.Conflicts, MergeConflict{
+		Path:  path,
+		Base:  renderSideValue(base),
+		Live:  renderSideValue(live),
+		Draft: renderSideValue(draft),
+	})
+	return token
+}
+
+// Render marshals the merged object as YAML, replacing each conflict with a
+// draft/live marker block. Marker lines start at column zero; the values
+// between them keep the indentation of the field they replace.
+func (r *MergeResult) Render() (string, error) {
+	out, err := yaml.Marshal(r.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal merged object: %w", err)
+	}
+	if len(r.markers) == 0 {
+		return string(out), nil
+	}
+
+	byToken := make(map[string]conflictMarker, len(r.markers))
+	for _, marker := range r.markers {
+		byToken[marker.token] = marker
+	}
+
+	var builder strings.Builder
+	for _, line := range strings.SplitAfter(string(out), "\n") {
+		marker, start, ok := findConflictMarker(line, byToken)
+		if !ok {
+			builder.WriteString(line)
+			continue
+		}
+		lead := line[:start]
+		trimmed := strings.TrimLeft(lead, " ")
+		indent := lead[:len(lead)-len(trimmed)]
+		// A field that opens a list element keeps its dash outside the markers,
+		// so either side still resolves to a valid element.
+		for strings.HasPrefix(trimmed, "- ") && (!marker.inList || strings.HasPrefix(trimmed[2:], "- ")) {
+			builder.WriteString(indent + "-\n")
+			indent += "  "
+			trimmed = trimmed[2:]
+		}
+		draft, err := marker.renderSide(marker.draft, indent)
+		if err != nil {
+			return "", err
+		}
+		live, err := marker.renderSide(marker.live, indent)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteString(ConflictMarkerDraft + "\n")
+		builder.WriteString(draft)
+		builder.WriteString(ConflictMarkerSeparator + "\n")
+		builder.WriteString(live)
+		builder.WriteString(ConflictMarkerLive + "\n")
+	}
+	return builder.String(), nil
+}
+
+// findConflictMarker finds the placeholder on a rendered line and where it
+// starts.
+func findConflictMarker(line string, byToken map[string]conflictMarker) (conflictMarker, int, bool) {
+	start := strings.Index(line, conflictPlaceholderPrefix)
+	if start < 0 {
+		return conflictMarker{}, 0, false
+	}
+	end := strings.Index(line[start+len(conflictPlaceholderPrefix):], "__")
+	if end < 0 {
+		return conflictMarker{}, 0, false
+	}
+	token := line[start : start+len(conflictPlaceholderPrefix)+end+2]
+	marker, ok := byToken[token]
+	return marker, start, ok
+}
+
+// renderSide renders one side of a conflict as the field, or list element, it
+// replaces. A side that removed the field renders as nothing.
+func (m conflictMarker) renderSide(side mergeSide, indent string) (string, error) {
+	if !side.present {
+		return "", nil
+	}
+	var doc interface{} = map[string]interface{}{m.key: side.value}
+	if m.inList {
+		doc = []interface{}{side.value}
+	}
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal conflicting value: %w", err)
+	}
+	var builder strings.Builder
+	for _, line := range strings.SplitAfter(string(out), "\n") {
+		if line == "" {
+			continue
+		}
+		builder.WriteString(indent)
+		builder.WriteString(line)
+	}
+	return builder.String(), nil
+}
+
+func renderSideValue(side mergeSide) string {
+	if !side.present {
+		return ""
+	}
+	out, err := yaml.Marshal(side.value)
+	if err != nil {
+		return fmt.Sprintf("%v", side.value)
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
+
+func sameSide(a, b mergeSide) bool {
+	if a.present != b.present {
+		return false
+	}
+	return !a.present || reflect.DeepEqual(a.value, b.value)
+}
+
+func indexByMergeKey(list []interface{}, mergeKey string) (map[string]interface{}, []string, bool) {
+	byKey := make(map[string]interface{}, len(list))
+	order := make([]string, 0, len(list))
+	for _, elem := range list {
+		id, ok := mergeKeyValue(elem, mergeKey)
+		if !ok {
+			return nil, nil, false
+		}
+		if _, dup := byKey[id]; dup {
+			return nil, nil, false
+		}
+		byKey[id] = elem
+		order = append(order, id)
+	}
+	return byKey, order, true
+}
+
+func mergeKeyValue(elem interface{}, mergeKey string) (string, bool) {
+	fields, ok := elem.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	value, ok := fields[mergeKey]
+	if !ok || value == nil {
+		return "", false
+	}
+	return fmt.Sprintf("%v", value), true
+}
+
+func lookupStructMeta(meta strategicpatch.LookupPatchMeta, key string) strategicpatch.LookupPatchMeta {
+	if meta == nil {
+		return nil
+	}
+	nested, _, err := meta.LookupPatchMetadataForStruct(key)
+	if err != nil {
+		return nil
+	}
+	return nested
+}
+
+// lookupListMergeKey returns the element meta and merge key of a list field
+// that strategic merge patches element by element, or an empty key.
+func lookupListMergeKey(meta strategicpatch.LookupPatchMeta, key string) (strategicpatch.LookupPatchMeta, string) {
+	if meta == nil {
+		return nil, ""
+	}
+	elemMeta, patchMeta, err := meta.LookupPatchMetadataForSlice(key)
+	if err != nil {
+		return nil, ""
+	}
+	for _, strategy := range patchMeta.GetPatchStrategies() {
+		if strategy == "merge" {
+			return elemMeta, patchMeta.GetPatchMergeKey()
+		}
+	}
+	return nil, ""
+}
+
+func joinMergePath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
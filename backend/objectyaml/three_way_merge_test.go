@@ -0,0 +1,211 @@
+package objectyaml
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+func deploymentPatchMeta(t *testing.T) strategicpatch.LookupPatchMeta {
+	t.Helper()
+	meta, err := strategicpatch.NewPatchMetaFromStruct(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("failed to build patch meta: %v", err)
+	}
+	return meta
+}
+
+func mustParseMergeDoc(t *testing.T, doc string) map[string]interface{} {
+	t.Helper()
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		t.Fatalf("failed to parse YAML: %v", err)
+	}
+	return parsed
+}
+
+// resolveConflictMarkers keeps one side of every marker block.
+func resolveConflictMarkers(rendered string, keepDraft bool) string {
+	var builder strings.Builder
+	section := ""
+	for _, line := range strings.SplitAfter(rendered, "\n") {
+		switch strings.TrimSuffix(line, "\n") {
+		case ConflictMarkerDraft:
+			section = "draft"
+			continue
+		case ConflictMarkerSeparator:
+			section = "live"
+			continue
+		case ConflictMarkerLive:
+			section = ""
+			continue
+		}
+		if section == "" || (section == "draft") == keepDraft {
+			builder.WriteString(line)
+		}
+	}
+	return builder.String()
+}
+
+const mergeBaseDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: app
+          image: nginx:1.25
+          args: ["--port=80"]
+`
+
+func TestThreeWayMergeCombinesNonOverlappingChanges(t *testing.T) {
+	base := mustParseMergeDoc(t, mergeBaseDeployment)
+	live := mustParseMergeDoc(t, strings.NewReplacer(
+		"  namespace: default\n", "  namespace: default\n  annotations:\n    syncedAt: now\n",
+		"replicas: 1", "replicas: 3",
+		"          args: [\"--port=80\"]\n", "          args: [\"--port=80\"]\n        - name: proxy\n          image: envoy:1.30\n",
+	).Replace(mergeBaseDeployment))
+	draft := mustParseMergeDoc(t, strings.NewReplacer(
+		"nginx:1.25", "nginx:1.26",
+		"          args: [\"--port=80\"]\n", "          args: [\"--port=80\"]\n        - name: sidecar\n          image: busybox\n",
+	).Replace(mergeBaseDeployment))
+
+	result := ThreeWayMerge(base, live, draft, deploymentPatchMeta(t))
+	if result.HasConflicts() {
+		t.Fatalf("expected no conflicts, got %#v", result.Conflicts)
+	}
+	rendered, err := result.Render()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	for _, want := range []string{"syncedAt: now", "replicas: 3", "image: nginx:1.26", "image: envoy:1.30", "image: busybox"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected merged YAML to contain %q, got:\n%s", want, rendered)
+		}
+	}
+	if strings.Index(rendered, "name: proxy") > strings.Index(rendered, "name: sidecar") {
+		t.Fatalf("expected live containers before draft additions, got:\n%s", rendered)
+	}
+}
+
+func TestThreeWayMergeMarksOverlappingChanges(t *testing.T) {
+	base := mustParseMergeDoc(t, mergeBaseDeployment)
+	live := mustParseMergeDoc(t, strings.Replace(mergeBaseDeployment, "nginx:1.25", "nginx:1.27", 1))
+	draft := mustParseMergeDoc(t, strings.NewReplacer("nginx:1.25", "nginx:1.26", "replicas: 1", "replicas: 2").Replace(mergeBaseDeployment))
+
+	result := ThreeWayMerge(base, live, draft, deploymentPatchMeta(t))
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("expected one conflict, got %#v", result.Conflicts)
+	}
+	conflict := result.Conflicts[0]
+	if conflict.Path != "spec.template.spec.containers[name=app].image" {
+		t.Fatalf("unexpected conflict path %q", conflict.Path)
+	}
+	if conflict.Base != "nginx:1.25" || conflict.Live != "nginx:1.27" || conflict.Draft != "nginx:1.26" {
+		t.Fatalf("unexpected conflict values %#v", conflict)
+	}
+
+	rendered, err := result.Render()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	want := ConflictMarkerDraft + "\n" +
+		"        image: nginx:1.26\n" +
+		ConflictMarkerSeparator + "\n" +
+		"        image: nginx:1.27\n" +
+		ConflictMarkerLive + "\n"
+	if !strings.Contains(rendered, want) {
+		t.Fatalf("expected marker block %q in:\n%s", want, rendered)
+	}
+
+	for keepDraft, image := range map[bool]string{true: "nginx:1.26", false: "nginx:1.27"} {
+		resolved := mustParseMergeDoc(t, resolveConflictMarkers(rendered, keepDraft))
+		containers := resolved["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+		app := containers[0].(map[string]interface{})
+		if app["image"] != image || app["name"] != "app" {
+			t.Fatalf("expected resolved container with image %s, got %#v", image, app)
+		}
+		if resolved["spec"].(map[string]interface{})["replicas"] != float64(2) {
+			t.Fatalf("expected the non-conflicting replicas edit to be kept, got %#v", resolved["spec"])
+		}
+	}
+}
+
+func TestThreeWayMergeMarksEditOfRemovedElement(t *testing.T) {
+	base := mustParseMergeDoc(t, mergeBaseDeployment)
+	live := mustParseMergeDoc(t, strings.Replace(mergeBaseDeployment, "      containers:\n        - name: app\n          image: nginx:1.25\n          args: [\"--port=80\"]\n", "      containers: []\n", 1))
+	draft := mustParseMergeDoc(t, strings.Replace(mergeBaseDeployment, "nginx:1.25", "nginx:1.26", 1))
+
+	result := ThreeWayMerge(base, live, draft, deploymentPatchMeta(t))
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Path != "spec.template.spec.containers[name=app]" {
+		t.Fatalf("expected a conflict on the removed container, got %#v", result.Conflicts)
+	}
+	if result.Conflicts[0].Live != "" {
+		t.Fatalf("expected the live side to be empty, got %q", result.Conflicts[0].Live)
+	}
+
+	rendered, err := result.Render()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	keepDraft := mustParseMergeDoc(t, resolveConflictMarkers(rendered, true))
+	containers := keepDraft["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+	if len(containers) != 1 {
+		t.Fatalf("expected the draft side to restore the container, got %#v", containers)
+	}
+	keepLive := mustParseMergeDoc(t, resolveConflictMarkers(rendered, false))
+	containers, _ = keepLive["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+	if len(containers) != 0 {
+		t.Fatalf("expected the live side to keep the container removed, got %#v", containers)
+	}
+}
+
+func TestThreeWayMergeTreatsListsAsAtomicWithoutPatchMeta(t *testing.T) {
+	base := mustParseMergeDoc(t, "spec:\n  hosts: [a, b]\n  port: 80\n")
+	live := mustParseMergeDoc(t, "spec:\n  hosts: [a, c]\n  port: 80\n")
+	draft := mustParseMergeDoc(t, "spec:\n  hosts: [a, b, d]\n  port: 8080\n")
+
+	result := ThreeWayMerge(base, live, draft, nil)
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Path != "spec.hosts" {
+		t.Fatalf("expected a conflict on the whole list, got %#v", result.Conflicts)
+	}
+	rendered, err := result.Render()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	resolved := mustParseMergeDoc(t, resolveConflictMarkers(rendered, false))
+	spec := resolved["spec"].(map[string]interface{})
+	if spec["port"] != float64(8080) || len(spec["hosts"].([]interface{})) != 2 {
+		t.Fatalf("expected the live list with the draft port, got %#v", spec)
+	}
+}
+
+func TestThreeWayMergeMarksConflictOpeningListElement(t *testing.T) {
+	base := mustParseMergeDoc(t, mergeBaseDeployment)
+	live := mustParseMergeDoc(t, strings.Replace(mergeBaseDeployment, "--port=80", "--port=81", 1))
+	draft := mustParseMergeDoc(t, strings.Replace(mergeBaseDeployment, "--port=80", "--port=82", 1))
+
+	result := ThreeWayMerge(base, live, draft, deploymentPatchMeta(t))
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Path != "spec.template.spec.containers[name=app].args" {
+		t.Fatalf("expected a conflict on args, got %#v", result.Conflicts)
+	}
+	rendered, err := result.Render()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	for keepDraft, arg := range map[bool]string{true: "--port=82", false: "--port=81"} {
+		resolved := mustParseMergeDoc(t, resolveConflictMarkers(rendered, keepDraft))
+		containers := resolved["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+		app := containers[0].(map[string]interface{})
+		if len(containers) != 1 || app["name"] != "app" || app["args"].([]interface{})[0] != arg {
+			t.Fatalf("expected one container with arg %s, got %#v from:\n%s", arg, containers, rendered)
+		}
+	}
+}
@@ -31,6 +31,7 @@ func (a *App) deletePodAction(target ObjectActionTargetRef) error {
 	}); err != nil {
 		return err
 	}
+	a.captureDeletedObject(deps, target)
 	if err := pods.DeletePod(deps, target.Namespace, target.Name); err != nil {
 		return err
 	}
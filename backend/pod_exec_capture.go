@@ -0,0 +1,124 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// podExecCapture is the result of a non-interactive exec whose output is read
+// whole, for the inspectors that parse a container's /proc.
+type podExecCapture struct {
+	pod       *corev1.Pod
+	container string
+	stdout    []byte
+}
+
+// podExecTarget is a resolved, permission-checked exec target.
+type podExecTarget struct {
+	deps      common.Dependencies
+	pod       *corev1.Pod
+	container string
+}
+
+// resolvePodExecTarget applies the same guards as a shell session: the pod
+// must exist, the cluster must allow exec and the user needs pods/exec. An
+// empty container selects the pod's first container.
+func (a *App) resolvePodExecTarget(clusterID, operation, namespace, podName, container string) (*podExecTarget, error) {
+	if err := requirePodObject(namespace, podName); err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "exec"); err != nil {
+		return nil, err
+	}
+
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	if deps.RestConfig == nil {
+		return nil, fmt.Errorf("kubernetes rest config not initialized")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.PodExecCaptureTimeout)
+	defer cancel()
+
+	podIdentifier := fmt.Sprintf("%s/%s", namespace, podName)
+	pod, err := executeWithRetry(ctx, a, clusterID, operation, podIdentifier, func() (*corev1.Pod, error) {
+		return deps.KubernetesClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pod: %w", err)
+	}
+	container, err = resolveExecContainer(pod, container)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.requirePodExecPermission(deps, namespace, podName); err != nil {
+		return nil, err
+	}
+	return &podExecTarget{deps: deps, pod: pod, container: container}, nil
+}
+
+// stream runs command in the target container without a TTY, writing its
+// stdout to stdout. A failure carries the start of stderr.
+func (t *podExecTarget) stream(ctx context.Context, command []string, stdout io.Writer) error {
+	executor, err := newPodExecExecutor(t.deps, t.pod.Namespace, t.pod.Name, &corev1.PodExecOptions{
+		Container: t.container,
+		Command:   command,
+		Stdout:    true,
+		Stderr:    true,
+	})
+	if err != nil {
+		return err
+	}
+	stderr := &cappedBuffer{limit: 4096}
+	if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr}); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return fmt.Errorf("exec in container %q failed: %w: %s", t.container, err, detail)
+		}
+		return fmt.Errorf("exec in container %q failed: %w", t.container, err)
+	}
+	return nil
+}
+
+// capturePodExec runs command in a pod container and returns its stdout,
+// bounded in size and time.
+func (a *App) capturePodExec(clusterID, operation, namespace, podName, container string, command []string) (*podExecCapture, error) {
+	target, err := a.resolvePodExecTarget(clusterID, operation, namespace, podName, container)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.PodExecCaptureTimeout)
+	defer cancel()
+	stdout := &cappedBuffer{limit: config.PodExecCaptureMaxOutputBytes}
+	if err := target.stream(ctx, command, stdout); err != nil {
+		return nil, err
+	}
+	return &podExecCapture{pod: target.pod, container: target.container, stdout: stdout.Bytes()}, nil
+}
+
+// cappedBuffer is a bytes.Buffer that fails writes past limit, ending an exec
+// stream that produces more output than expected.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, fmt.Errorf("output exceeds %d bytes", b.limit)
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,17 @@
+package backend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCappedBufferRejectsOversizedOutput(t *testing.T) {
+	buf := &cappedBuffer{limit: 8}
+	_, err := buf.Write([]byte("12345"))
+	require.NoError(t, err)
+	_, err = buf.Write([]byte("6789"))
+	require.Error(t, err)
+	require.Equal(t, "12345", strings.TrimSpace(buf.String()))
+}
@@ -0,0 +1,336 @@
+// Package podsecurity evaluates pod specs against the Kubernetes Pod Security
+// Standards (baseline and restricted) and reports violations per workload and
+// per namespace, alongside the namespace's pod-security.kubernetes.io labels.
+// Check IDs match the upstream pod-security-admission policy names so results
+// line up with admission warnings.
+package podsecurity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Level is a Pod Security Standards profile.
+type Level string
+
+const (
+	LevelPrivileged Level = "privileged"
+	LevelBaseline   Level = "baseline"
+	LevelRestricted Level = "restricted"
+)
+
+// ParseLevel returns the level for a namespace label value; ok is false for
+// unknown values.
+func ParseLevel(value string) (Level, bool) {
+	switch Level(strings.TrimSpace(value)) {
+	case LevelPrivileged:
+		return LevelPrivileged, true
+	case LevelBaseline:
+		return LevelBaseline, true
+	case LevelRestricted:
+		return LevelRestricted, true
+	}
+	return "", false
+}
+
+// Violation is one failed check. Container is empty for pod-level checks.
+type Violation struct {
+	Check     string `json:"check"`
+	Level     Level  `json:"level"`
+	Container string `json:"container,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+var baselineCapabilities = []corev1.Capability{
+	"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD",
+	"NET_BIND_SERVICE", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+}
+
+var allowedSELinuxTypes = []string{"", "container_t", "container_init_t", "container_kvm_t", "container_engine_t"}
+
+var safeSysctls = []string{
+	"kernel.shm_rmid_forced",
+	"net.ipv4.ip_local_port_range",
+	"net.ipv4.ip_unprivileged_port_start",
+	"net.ipv4.tcp_syncookies",
+	"net.ipv4.ping_group_range",
+	"net.ipv4.ip_local_reserved_ports",
+	"net.ipv4.tcp_keepalive_time",
+	"net.ipv4.tcp_fin_timeout",
+	"net.ipv4.tcp_keepalive_intvl",
+	"net.ipv4.tcp_keepalive_probes",
+}
+
+const appArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+
+// containerRef pairs a container's name with its security context, covering
+// init, regular, and ephemeral containers alike.
+type containerRef struct {
+	name  string
+	ports []corev1.ContainerPort
+	sc    *corev1.SecurityContext
+}
+
+func allContainers(spec *corev1.PodSpec) []containerRef {
+	var refs []containerRef
+	for _, c := range spec.InitContainers {
+		refs = append(refs, containerRef{name: c.Name, ports: c.Ports, sc: c.SecurityContext})
+	}
+	for _, c := range spec.Containers {
+		refs = append(refs, containerRef{name: c.Name, ports: c.Ports, sc: c.SecurityContext})
+	}
+	for _, c := range spec.EphemeralContainers {
+		refs = append(refs, containerRef{name: c.Name, ports: c.Ports, sc: c.SecurityContext})
+	}
+	return refs
+}
+
+// Evaluate checks spec (and the pod annotations, for legacy AppArmor) against
+// level. Restricted includes every baseline check; privileged has none.
+func Evaluate(spec *corev1.PodSpec, annotations map[string]string, level Level) []Violation {
+	if spec == nil || level == LevelPrivileged {
+		return nil
+	}
+	violations := evaluateBaseline(spec, annotations)
+	if level == LevelRestricted {
+		violations = append(violations, evaluateRestricted(spec)...)
+	}
+	return violations
+}
+
+func evaluateBaseline(spec *corev1.PodSpec, annotations map[string]string) []Violation {
+	var out []Violation
+	add := func(check, container, detail string) {
+		out = append(out, Violation{Check: check, Level: LevelBaseline, Container: container, Detail: detail})
+	}
+	podSC := spec.SecurityContext
+	if podSC == nil {
+		podSC = &corev1.PodSecurityContext{}
+	}
+
+	var hostNamespaces []string
+	if spec.HostNetwork {
+		hostNamespaces = append(hostNamespaces, "hostNetwork")
+	}
+	if spec.HostPID {
+		hostNamespaces = append(hostNamespaces, "hostPID")
+	}
+	if spec.HostIPC {
+		hostNamespaces = append(hostNamespaces, "hostIPC")
+	}
+	if len(hostNamespaces) > 0 {
+		add("hostNamespaces", "", strings.Join(hostNamespaces, ", ")+"=true")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			add("hostPathVolumes", "", fmt.Sprintf("volume %q uses hostPath %s", volume.Name, volume.HostPath.Path))
+		}
+	}
+	if podSC.WindowsOptions != nil && podSC.WindowsOptions.HostProcess != nil && *podSC.WindowsOptions.HostProcess {
+		add("windowsHostProcess", "", "securityContext.windowsOptions.hostProcess=true")
+	}
+	if podSC.SeccompProfile != nil && podSC.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		add("seccompProfile_baseline", "", "securityContext.seccompProfile.type=Unconfined")
+	}
+	if podSC.AppArmorProfile != nil && podSC.AppArmorProfile.Type == corev1.AppArmorProfileTypeUnconfined {
+		add("appArmorProfile", "", "securityContext.appArmorProfile.type=Unconfined")
+	}
+	if detail := seLinuxViolation(podSC.SELinuxOptions); detail != "" {
+		add("seLinuxOptions", "", detail)
+	}
+	for _, sysctl := range podSC.Sysctls {
+		if !slices.Contains(safeSysctls, sysctl.Name) {
+			add("sysctls", "", fmt.Sprintf("unsafe sysctl %s", sysctl.Name))
+		}
+	}
+
+	for _, c := range allContainers(spec) {
+		for _, port := range c.ports {
+			if port.HostPort != 0 {
+				add("hostPorts", c.name, fmt.Sprintf("hostPort %d", port.HostPort))
+			}
+		}
+		if annotations[appArmorAnnotationPrefix+c.name] == "unconfined" {
+			add("appArmorProfile", c.name, "AppArmor annotation is unconfined")
+		}
+		sc := c.sc
+		if sc == nil {
+			continue
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			add("privileged", c.name, "securityContext.privileged=true")
+		}
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if !slices.Contains(baselineCapabilities, capability) {
+					add("capabilities_baseline", c.name, fmt.Sprintf("adds capability %s", capability))
+				}
+			}
+		}
+		if sc.ProcMount != nil && *sc.ProcMount != corev1.DefaultProcMount {
+			add("procMount", c.name, fmt.Sprintf("securityContext.procMount=%s", *sc.ProcMount))
+		}
+		if sc.SeccompProfile != nil && sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+			add("seccompProfile_baseline", c.name, "securityContext.seccompProfile.type=Unconfined")
+		}
+		if sc.AppArmorProfile != nil && sc.AppArmorProfile.Type == corev1.AppArmorProfileTypeUnconfined {
+			add("appArmorProfile", c.name, "securityContext.appArmorProfile.type=Unconfined")
+		}
+		if detail := seLinuxViolation(sc.SELinuxOptions); detail != "" {
+			add("seLinuxOptions", c.name, detail)
+		}
+		if sc.WindowsOptions != nil && sc.WindowsOptions.HostProcess != nil && *sc.WindowsOptions.HostProcess {
+			add("windowsHostProcess", c.name, "securityContext.windowsOptions.hostProcess=true")
+		}
+	}
+	return out
+}
+
+func seLinuxViolation(opts *corev1.SELinuxOptions) string {
+	if opts == nil {
+		return ""
+	}
+	var problems []string
+	if !slices.Contains(allowedSELinuxTypes, opts.Type) {
+		problems = append(problems, "type="+opts.Type)
+	}
+	if opts.User != "" {
+		problems = append(problems, "user="+opts.User)
+	}
+	if opts.Role != "" {
+		problems = append(problems, "role="+opts.Role)
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return "seLinuxOptions " + strings.Join(problems, ", ")
+}
+
+func evaluateRestricted(spec *corev1.PodSpec) []Violation {
+	var out []Violation
+	add := func(check, container, detail string) {
+		out = append(out, Violation{Check: check, Level: LevelRestricted, Container: container, Detail: detail})
+	}
+	podSC := spec.SecurityContext
+	if podSC == nil {
+		podSC = &corev1.PodSecurityContext{}
+	}
+
+	for _, volume := range spec.Volumes {
+		if kind := restrictedVolumeType(volume.VolumeSource); kind != "" {
+			add("restrictedVolumes", "", fmt.Sprintf("volume %q uses %s", volume.Name, kind))
+		}
+	}
+	if podSC.RunAsUser != nil && *podSC.RunAsUser == 0 {
+		add("runAsUser", "", "securityContext.runAsUser=0")
+	}
+
+	podRunAsNonRoot := podSC.RunAsNonRoot != nil && *podSC.RunAsNonRoot
+	podSeccompOK := seccompRestricted(podSC.SeccompProfile)
+	for _, c := range allContainers(spec) {
+		sc := c.sc
+		if sc == nil {
+			sc = &corev1.SecurityContext{}
+		}
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			add("allowPrivilegeEscalation", c.name, "securityContext.allowPrivilegeEscalation is not false")
+		}
+		nonRoot := podRunAsNonRoot
+		if sc.RunAsNonRoot != nil {
+			nonRoot = *sc.RunAsNonRoot
+		}
+		if !nonRoot {
+			add("runAsNonRoot", c.name, "runAsNonRoot is not true (may run as root)")
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			add("runAsUser", c.name, "securityContext.runAsUser=0")
+		}
+		seccompOK := podSeccompOK
+		if sc.SeccompProfile != nil {
+			seccompOK = seccompRestricted(sc.SeccompProfile)
+		}
+		if !seccompOK {
+			add("seccompProfile_restricted", c.name, "seccompProfile is not RuntimeDefault or Localhost")
+		}
+		dropsAll := false
+		var added []corev1.Capability
+		if sc.Capabilities != nil {
+			dropsAll = slices.Contains(sc.Capabilities.Drop, "ALL")
+			added = sc.Capabilities.Add
+		}
+		if !dropsAll {
+			add("capabilities_restricted", c.name, "capabilities do not drop ALL")
+		}
+		for _, capability := range added {
+			if capability != "NET_BIND_SERVICE" {
+				add("capabilities_restricted", c.name, fmt.Sprintf("adds capability %s", capability))
+			}
+		}
+	}
+	return out
+}
+
+func seccompRestricted(profile *corev1.SeccompProfile) bool {
+	return profile != nil && (profile.Type == corev1.SeccompProfileTypeRuntimeDefault || profile.Type == corev1.SeccompProfileTypeLocalhost)
+}
+
+// restrictedVolumeType names the volume source when it is outside the
+// restricted profile's allowed set, or returns "".
+func restrictedVolumeType(source corev1.VolumeSource) string {
+	switch {
+	case source.ConfigMap != nil, source.CSI != nil, source.DownwardAPI != nil, source.EmptyDir != nil,
+		source.Ephemeral != nil, source.PersistentVolumeClaim != nil, source.Projected != nil,
+		source.Secret != nil, source.Image != nil:
+		return ""
+	case source.HostPath != nil:
+		return "hostPath"
+	case source.NFS != nil:
+		return "nfs"
+	case source.ISCSI != nil:
+		return "iscsi"
+	case source.GitRepo != nil:
+		return "gitRepo"
+	case source.RBD != nil:
+		return "rbd"
+	case source.CephFS != nil:
+		return "cephfs"
+	case source.FC != nil:
+		return "fc"
+	case source.Glusterfs != nil:
+		return "glusterfs"
+	case source.FlexVolume != nil:
+		return "flexVolume"
+	}
+	return "a non-restricted volume type"
+}
+
+// HighestPassingLevel returns the strictest level spec satisfies.
+func HighestPassingLevel(spec *corev1.PodSpec, annotations map[string]string) (Level, []Violation) {
+	violations := Evaluate(spec, annotations, LevelRestricted)
+	level := LevelRestricted
+	for _, violation := range violations {
+		if violation.Level == LevelBaseline {
+			return LevelPrivileged, violations
+		}
+		level = LevelBaseline
+	}
+	return level, violations
+}
+
+// Satisfies reports whether a workload at passing meets required.
+func Satisfies(passing, required Level) bool {
+	return levelRank(passing) >= levelRank(required)
+}
+
+func levelRank(level Level) int {
+	switch level {
+	case LevelRestricted:
+		return 2
+	case LevelBaseline:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+package podsecurity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/podsecurity"
+)
+
+func restrictedSpec() *corev1.PodSpec {
+	return &corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.To(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{{
+			Name: "app",
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}, Add: []corev1.Capability{"NET_BIND_SERVICE"}},
+			},
+		}},
+		Volumes: []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}}},
+	}
+}
+
+func checks(violations []podsecurity.Violation) []string {
+	out := make([]string, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, v.Check)
+	}
+	return out
+}
+
+func TestRestrictedSpecPasses(t *testing.T) {
+	level, violations := podsecurity.HighestPassingLevel(restrictedSpec(), nil)
+	require.Equal(t, podsecurity.LevelRestricted, level)
+	require.Empty(t, violations)
+}
+
+func TestDefaultSpecIsBaselineOnly(t *testing.T) {
+	spec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}}
+
+	level, violations := podsecurity.HighestPassingLevel(spec, nil)
+	require.Equal(t, podsecurity.LevelBaseline, level)
+	require.ElementsMatch(t,
+		[]string{"allowPrivilegeEscalation", "runAsNonRoot", "seccompProfile_restricted", "capabilities_restricted"},
+		checks(violations))
+	require.Empty(t, podsecurity.Evaluate(spec, nil, podsecurity.LevelBaseline))
+	require.Empty(t, podsecurity.Evaluate(spec, nil, podsecurity.LevelPrivileged))
+}
+
+func TestBaselineViolations(t *testing.T) {
+	spec := restrictedSpec()
+	spec.HostNetwork = true
+	spec.Volumes = append(spec.Volumes, corev1.Volume{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/run"}}})
+	spec.SecurityContext.Sysctls = []corev1.Sysctl{{Name: "kernel.msgmax"}, {Name: "net.ipv4.tcp_syncookies"}}
+	spec.InitContainers = []corev1.Container{{
+		Name:            "setup",
+		Ports:           []corev1.ContainerPort{{ContainerPort: 80, HostPort: 8080}},
+		SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true), Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN", "CHOWN"}}},
+	}}
+
+	violations := podsecurity.Evaluate(spec, map[string]string{"container.apparmor.security.beta.kubernetes.io/app": "unconfined"}, podsecurity.LevelBaseline)
+	require.ElementsMatch(t,
+		[]string{"hostNamespaces", "hostPathVolumes", "sysctls", "hostPorts", "privileged", "capabilities_baseline", "appArmorProfile"},
+		checks(violations))
+	for _, v := range violations {
+		require.Equal(t, podsecurity.LevelBaseline, v.Level)
+	}
+
+	level, _ := podsecurity.HighestPassingLevel(spec, nil)
+	require.Equal(t, podsecurity.LevelPrivileged, level)
+}
+
+func TestRestrictedChecksHonourContainerOverrides(t *testing.T) {
+	spec := restrictedSpec()
+	spec.Containers[0].SecurityContext.RunAsNonRoot = ptr.To(false)
+	spec.Containers[0].SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}
+	spec.Containers[0].SecurityContext.RunAsUser = ptr.To[int64](0)
+
+	violations := podsecurity.Evaluate(spec, nil, podsecurity.LevelRestricted)
+	require.ElementsMatch(t,
+		[]string{"seccompProfile_baseline", "runAsNonRoot", "runAsUser", "seccompProfile_restricted"},
+		checks(violations))
+	for _, v := range violations {
+		require.Equal(t, "app", v.Container)
+	}
+}
+
+func TestRestrictedVolumes(t *testing.T) {
+	spec := restrictedSpec()
+	spec.Volumes = append(spec.Volumes, corev1.Volume{Name: "shared", VolumeSource: corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/"}}})
+
+	violations := podsecurity.Evaluate(spec, nil, podsecurity.LevelRestricted)
+	require.Len(t, violations, 1)
+	require.Equal(t, "restrictedVolumes", violations[0].Check)
+	require.Contains(t, violations[0].Detail, "nfs")
+}
+
+func TestParseLevelAndSatisfies(t *testing.T) {
+	level, ok := podsecurity.ParseLevel("restricted")
+	require.True(t, ok)
+	require.Equal(t, podsecurity.LevelRestricted, level)
+	_, ok = podsecurity.ParseLevel("strict")
+	require.False(t, ok)
+
+	require.True(t, podsecurity.Satisfies(podsecurity.LevelRestricted, podsecurity.LevelBaseline))
+	require.False(t, podsecurity.Satisfies(podsecurity.LevelBaseline, podsecurity.LevelRestricted))
+	require.True(t, podsecurity.Satisfies(podsecurity.LevelPrivileged, podsecurity.LevelPrivileged))
+}
@@ -0,0 +1,267 @@
+package podsecurity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Namespace label keys for the Pod Security Admission modes.
+const (
+	labelPrefix = "pod-security.kubernetes.io/"
+	ModeEnforce = "enforce"
+	ModeAudit   = "audit"
+	ModeWarn    = "warn"
+)
+
+// ModeLabel is one admission mode's configured level and version. Level is
+// empty when the namespace does not set the mode; Invalid carries a label
+// value that is not a known level.
+type ModeLabel struct {
+	Level   Level  `json:"level,omitempty"`
+	Version string `json:"version,omitempty"`
+	Invalid string `json:"invalid,omitempty"`
+}
+
+// WorkloadResult is the evaluation of one top-level workload's pod template
+// (or a bare Pod). Level is the strictest profile it satisfies.
+type WorkloadResult struct {
+	Ref        resourcemodel.ResourceRef `json:"ref"`
+	Level      Level                     `json:"level"`
+	Violations []Violation               `json:"violations"`
+	// ViolatesEnforced is set when the namespace enforces a stricter level
+	// than the workload satisfies: its next pod creation will be rejected.
+	ViolatesEnforced bool `json:"violatesEnforced,omitempty"`
+}
+
+// NamespaceReport summarises one namespace.
+type NamespaceReport struct {
+	Namespace string               `json:"namespace"`
+	Labels    map[string]ModeLabel `json:"labels"`
+	Workloads []WorkloadResult     `json:"workloads"`
+	// Counts of workloads failing each profile and the enforced level.
+	BaselineViolations   int `json:"baselineViolations"`
+	RestrictedViolations int `json:"restrictedViolations"`
+	EnforcedViolations   int `json:"enforcedViolations"`
+}
+
+// Report is the Pod Security Standards evaluation for one cluster.
+type Report struct {
+	ClusterID  string            `json:"clusterId"`
+	Namespaces []NamespaceReport `json:"namespaces"`
+	// Warnings lists workload kinds that could not be listed; the report
+	// covers every other kind.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Evaluator lists workloads from one cluster and evaluates them.
+type Evaluator struct {
+	ClusterID string
+	Client    kubernetes.Interface
+}
+
+type podTemplate struct {
+	ref         resourcemodel.ResourceRef
+	annotations map[string]string
+	spec        *corev1.PodSpec
+}
+
+// Evaluate reports on the given namespaces; an empty list covers every
+// namespace in the cluster.
+func (e Evaluator) Evaluate(ctx context.Context, namespaces []string) (*Report, error) {
+	if e.Client == nil {
+		return nil, fmt.Errorf("kubernetes client is not initialized")
+	}
+	report := &Report{ClusterID: e.ClusterID, Namespaces: []NamespaceReport{}}
+
+	nsObjects, err := e.namespaces(ctx, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	byNamespace := make(map[string]*NamespaceReport, len(nsObjects))
+	for _, ns := range nsObjects {
+		byNamespace[ns.Name] = &NamespaceReport{
+			Namespace: ns.Name,
+			Labels:    modeLabels(ns.Labels),
+			Workloads: []WorkloadResult{},
+		}
+	}
+
+	scope := namespaces
+	if len(scope) == 0 {
+		scope = []string{metav1.NamespaceAll}
+	}
+	for _, namespace := range scope {
+		templates, warnings := e.templates(ctx, namespace)
+		report.Warnings = append(report.Warnings, warnings...)
+		for _, template := range templates {
+			nsReport := byNamespace[template.ref.Namespace]
+			if nsReport == nil {
+				continue
+			}
+			level, violations := HighestPassingLevel(template.spec, template.annotations)
+			result := WorkloadResult{Ref: template.ref, Level: level, Violations: violations}
+			if violations == nil {
+				result.Violations = []Violation{}
+			}
+			if enforce := nsReport.Labels[ModeEnforce].Level; enforce != "" && !Satisfies(level, enforce) {
+				result.ViolatesEnforced = true
+				nsReport.EnforcedViolations++
+			}
+			if !Satisfies(level, LevelBaseline) {
+				nsReport.BaselineViolations++
+			}
+			if !Satisfies(level, LevelRestricted) {
+				nsReport.RestrictedViolations++
+			}
+			nsReport.Workloads = append(nsReport.Workloads, result)
+		}
+	}
+
+	for _, ns := range nsObjects {
+		nsReport := byNamespace[ns.Name]
+		sort.SliceStable(nsReport.Workloads, func(i, j int) bool {
+			left, right := nsReport.Workloads[i], nsReport.Workloads[j]
+			if levelRank(left.Level) != levelRank(right.Level) {
+				return levelRank(left.Level) < levelRank(right.Level)
+			}
+			if left.Ref.Kind != right.Ref.Kind {
+				return left.Ref.Kind < right.Ref.Kind
+			}
+			return left.Ref.Name < right.Ref.Name
+		})
+		report.Namespaces = append(report.Namespaces, *nsReport)
+	}
+	return report, nil
+}
+
+func (e Evaluator) namespaces(ctx context.Context, names []string) ([]corev1.Namespace, error) {
+	if len(names) == 0 {
+		list, err := e.Client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+		return list.Items, nil
+	}
+	out := make([]corev1.Namespace, 0, len(names))
+	for _, name := range names {
+		ns, err := e.Client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *ns)
+	}
+	return out, nil
+}
+
+func modeLabels(labels map[string]string) map[string]ModeLabel {
+	out := make(map[string]ModeLabel)
+	for _, mode := range []string{ModeEnforce, ModeAudit, ModeWarn} {
+		value, ok := labels[labelPrefix+mode]
+		if !ok {
+			continue
+		}
+		label := ModeLabel{Version: labels[labelPrefix+mode+"-version"]}
+		if level, valid := ParseLevel(value); valid {
+			label.Level = level
+		} else {
+			label.Invalid = value
+		}
+		out[mode] = label
+	}
+	return out
+}
+
+// templates collects the pod templates of top-level workloads in namespace.
+// Workloads owned by another workload (a Deployment's ReplicaSets, a
+// CronJob's Jobs, controller-managed Pods) are skipped so each template is
+// reported once, against its owner.
+func (e Evaluator) templates(ctx context.Context, namespace string) ([]podTemplate, []string) {
+	var (
+		out      []podTemplate
+		warnings []string
+	)
+	list := metav1.ListOptions{}
+	fail := func(kind string, err error) {
+		warnings = append(warnings, fmt.Sprintf("%s: %v", kind, err))
+	}
+	ref := func(group, kind, resource string, meta metav1.ObjectMeta) resourcemodel.ResourceRef {
+		return resourcemodel.NewResourceRef(e.ClusterID, group, "v1", kind, resource, meta.Namespace, meta.Name, string(meta.UID))
+	}
+
+	if items, err := e.Client.AppsV1().Deployments(namespace).List(ctx, list); err != nil {
+		fail("Deployments", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			out = append(out, podTemplate{ref("apps", "Deployment", "deployments", obj.ObjectMeta), obj.Spec.Template.Annotations, &obj.Spec.Template.Spec})
+		}
+	}
+	if items, err := e.Client.AppsV1().StatefulSets(namespace).List(ctx, list); err != nil {
+		fail("StatefulSets", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			out = append(out, podTemplate{ref("apps", "StatefulSet", "statefulsets", obj.ObjectMeta), obj.Spec.Template.Annotations, &obj.Spec.Template.Spec})
+		}
+	}
+	if items, err := e.Client.AppsV1().DaemonSets(namespace).List(ctx, list); err != nil {
+		fail("DaemonSets", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			out = append(out, podTemplate{ref("apps", "DaemonSet", "daemonsets", obj.ObjectMeta), obj.Spec.Template.Annotations, &obj.Spec.Template.Spec})
+		}
+	}
+	if items, err := e.Client.AppsV1().ReplicaSets(namespace).List(ctx, list); err != nil {
+		fail("ReplicaSets", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			if metav1.GetControllerOf(obj) != nil {
+				continue
+			}
+			out = append(out, podTemplate{ref("apps", "ReplicaSet", "replicasets", obj.ObjectMeta), obj.Spec.Template.Annotations, &obj.Spec.Template.Spec})
+		}
+	}
+	if items, err := e.Client.BatchV1().CronJobs(namespace).List(ctx, list); err != nil {
+		fail("CronJobs", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			template := &obj.Spec.JobTemplate.Spec.Template
+			out = append(out, podTemplate{ref("batch", "CronJob", "cronjobs", obj.ObjectMeta), template.Annotations, &template.Spec})
+		}
+	}
+	if items, err := e.Client.BatchV1().Jobs(namespace).List(ctx, list); err != nil {
+		fail("Jobs", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			if metav1.GetControllerOf(obj) != nil {
+				continue
+			}
+			out = append(out, podTemplate{ref("batch", "Job", "jobs", obj.ObjectMeta), obj.Spec.Template.Annotations, &obj.Spec.Template.Spec})
+		}
+	}
+	if items, err := e.Client.CoreV1().Pods(namespace).List(ctx, list); err != nil {
+		fail("Pods", err)
+	} else {
+		for i := range items.Items {
+			obj := &items.Items[i]
+			if metav1.GetControllerOf(obj) != nil {
+				continue
+			}
+			podRef := resourcemodel.NewResourceRef(e.ClusterID, "", "v1", "Pod", "pods", obj.Namespace, obj.Name, string(obj.UID))
+			out = append(out, podTemplate{podRef, obj.Annotations, &obj.Spec})
+		}
+	}
+	return out, warnings
+}
@@ -0,0 +1,84 @@
+package podsecurity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/podsecurity"
+)
+
+func TestEvaluatorReportsPerNamespaceAndWorkload(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod", UID: "dep-uid"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}},
+		}}},
+	}
+	owned := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "prod", OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "dep-uid", Controller: ptr.To(true),
+		}}},
+		Spec: appsv1.ReplicaSetSpec{Template: deployment.Spec.Template},
+	}
+	bare := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "prod"},
+		Spec:       corev1.PodSpec{HostPID: true, Containers: []corev1.Container{{Name: "shell"}}},
+	}
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{
+			"pod-security.kubernetes.io/enforce":         "baseline",
+			"pod-security.kubernetes.io/enforce-version": "v1.33",
+			"pod-security.kubernetes.io/warn":            "strict",
+		}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		deployment, owned, bare,
+	)
+
+	report, err := podsecurity.Evaluator{ClusterID: "config:ctx", Client: client}.Evaluate(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, report.Warnings)
+	require.Len(t, report.Namespaces, 2)
+	require.Equal(t, "dev", report.Namespaces[0].Namespace)
+	require.Empty(t, report.Namespaces[0].Workloads)
+
+	prod := report.Namespaces[1]
+	require.Equal(t, podsecurity.ModeLabel{Level: podsecurity.LevelBaseline, Version: "v1.33"}, prod.Labels[podsecurity.ModeEnforce])
+	require.Equal(t, "strict", prod.Labels[podsecurity.ModeWarn].Invalid)
+	require.Len(t, prod.Workloads, 2, "controller-owned ReplicaSets are reported via their Deployment")
+	require.Equal(t, 1, prod.BaselineViolations)
+	require.Equal(t, 2, prod.RestrictedViolations)
+	require.Equal(t, 1, prod.EnforcedViolations)
+
+	pod := prod.Workloads[0]
+	require.Equal(t, "Pod", pod.Ref.Kind)
+	require.Equal(t, "config:ctx", pod.Ref.ClusterID)
+	require.Equal(t, podsecurity.LevelPrivileged, pod.Level)
+	require.True(t, pod.ViolatesEnforced)
+
+	dep := prod.Workloads[1]
+	require.Equal(t, "Deployment", dep.Ref.Kind)
+	require.Equal(t, "apps", dep.Ref.Group)
+	require.Equal(t, podsecurity.LevelBaseline, dep.Level)
+	require.False(t, dep.ViolatesEnforced)
+}
+
+func TestEvaluatorScopedToNamespaces(t *testing.T) {
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "b"}, Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "c"}}}},
+	)
+
+	report, err := podsecurity.Evaluator{ClusterID: "config:ctx", Client: client}.Evaluate(context.Background(), []string{"a"})
+	require.NoError(t, err)
+	require.Len(t, report.Namespaces, 1)
+	require.Equal(t, "a", report.Namespaces[0].Namespace)
+	require.Empty(t, report.Namespaces[0].Workloads)
+}
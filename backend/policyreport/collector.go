@@ -0,0 +1,432 @@
+/*
+ * backend/policyreport/collector.go
+ *
+ * Collects policy-engine results from the cluster: wgpolicyk8s.io
+ * PolicyReport/ClusterPolicyReport objects (written by Kyverno and other
+ * report producers) and Gatekeeper constraint status violations. Both sources
+ * are CRDs that may be absent; a missing API is reported as an undetected
+ * source rather than an error. Objects come from a Source: the refresh domain
+ * reads shared informers, and APISource lists through the dynamic client.
+ */
+
+package policyreport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+var (
+	// PolicyReportGVR and ClusterPolicyReportGVR are the wgpolicyk8s.io report APIs.
+	PolicyReportGVR        = schema.GroupVersionResource{Group: "wgpolicyk8s.io", Version: "v1alpha2", Resource: "policyreports"}
+	ClusterPolicyReportGVR = schema.GroupVersionResource{Group: "wgpolicyk8s.io", Version: "v1alpha2", Resource: "clusterpolicyreports"}
+
+	crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+)
+
+const (
+	// GatekeeperConstraintGroup is the API group of every Gatekeeper constraint kind.
+	GatekeeperConstraintGroup = "constraints.gatekeeper.sh"
+	// GatekeeperConstraintLabel marks the CRDs Gatekeeper generates from ConstraintTemplates.
+	GatekeeperConstraintLabel = "gatekeeper.sh/constraint=yes"
+
+	// EngineGatekeeper is the Engine reported for constraint violations.
+	EngineGatekeeper = "gatekeeper"
+
+	ResultFail  = "fail"
+	ResultWarn  = "warn"
+	ResultError = "error"
+)
+
+// Sources records which policy APIs the cluster serves.
+type Sources struct {
+	PolicyReports        bool `json:"policyReports"`
+	ClusterPolicyReports bool `json:"clusterPolicyReports"`
+	Gatekeeper           bool `json:"gatekeeper"`
+}
+
+// Violation is one failing policy result against one resource.
+type Violation struct {
+	// Engine is the report's result source (e.g. "kyverno") or "gatekeeper".
+	Engine   string `json:"engine"`
+	Policy   string `json:"policy"`
+	Rule     string `json:"rule,omitempty"`
+	Result   string `json:"result"`
+	Severity string `json:"severity,omitempty"`
+	Message  string `json:"message,omitempty"`
+	// EnforcementAction is Gatekeeper's deny/dryrun/warn action.
+	EnforcementAction string                    `json:"enforcementAction,omitempty"`
+	Resource          resourcemodel.ResourceRef `json:"resource"`
+	// Report is the PolicyReport or constraint that recorded the result.
+	Report resourcemodel.ResourceRef `json:"report"`
+}
+
+// ResourceCounts is the per-resource violation tally. It is keyed by the
+// resource's full ref so a consumer can match it to a resource summary; no
+// view joins it onto summary rows yet.
+type ResourceCounts struct {
+	Ref   resourcemodel.ResourceRef `json:"ref"`
+	Fail  int                       `json:"fail"`
+	Warn  int                       `json:"warn"`
+	Error int                       `json:"error"`
+}
+
+// Snapshot is the policy-reports domain payload.
+type Snapshot struct {
+	ClusterID   string           `json:"clusterId"`
+	ClusterName string           `json:"clusterName"`
+	Sources     Sources          `json:"sources"`
+	Violations  []Violation      `json:"violations"`
+	Resources   []ResourceCounts `json:"resources"`
+	// Warnings lists sources that exist but could not be read.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Source reads the policy objects a Collector converts.
+type Source interface {
+	// List returns the objects of gvr in namespace ("" = every namespace, or
+	// a cluster-scoped resource). found is false when the API is not served.
+	List(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (items []*unstructured.Unstructured, found bool, err error)
+	// ConstraintResources returns the constraint kinds Gatekeeper generated,
+	// sorted by resource. found is false when no CRDs could be read.
+	ConstraintResources(ctx context.Context) (resources []schema.GroupVersionResource, found bool, err error)
+}
+
+// Collector converts the policy objects read from Source into violations.
+type Collector struct {
+	ClusterID string
+	Source    Source
+	// Namespaces is the cluster's namespace scope. When set, the cluster-wide
+	// view reads only these namespaces, ClusterPolicyReports are skipped, and
+	// Gatekeeper violations outside the scope are dropped. Empty means the
+	// whole cluster.
+	Namespaces []string
+}
+
+// Collect gathers violations in namespace, or cluster-wide when namespace is
+// empty (which also includes ClusterPolicyReports). The returned version is
+// the highest resourceVersion among the source objects.
+func (c Collector) Collect(ctx context.Context, namespace string) (*Snapshot, uint64, error) {
+	if c.Source == nil {
+		return nil, 0, fmt.Errorf("policy report source is not initialized")
+	}
+	snapshot := &Snapshot{ClusterID: c.ClusterID, Violations: []Violation{}, Resources: []ResourceCounts{}}
+	var version uint64
+	track := func(obj *unstructured.Unstructured) {
+		if parsed, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64); err == nil && parsed > version {
+			version = parsed
+		}
+	}
+
+	targets := []string{namespace}
+	inScope := func(ns string) bool { return namespace == "" || ns == namespace }
+	if len(c.Namespaces) > 0 {
+		allowed := make(map[string]struct{}, len(c.Namespaces))
+		for _, ns := range c.Namespaces {
+			allowed[ns] = struct{}{}
+		}
+		inScope = func(ns string) bool {
+			_, ok := allowed[ns]
+			return ok && (namespace == "" || ns == namespace)
+		}
+		targets = nil
+		for _, ns := range c.Namespaces {
+			if inScope(ns) {
+				targets = append(targets, ns)
+			}
+		}
+	}
+
+	for _, target := range targets {
+		if items, found := c.list(ctx, PolicyReportGVR, target, snapshot); found {
+			snapshot.Sources.PolicyReports = true
+			for _, item := range items {
+				track(item)
+				snapshot.Violations = append(snapshot.Violations, c.reportViolations(item, "PolicyReport", PolicyReportGVR.Resource)...)
+			}
+		}
+	}
+	if namespace == "" && len(c.Namespaces) == 0 {
+		if items, found := c.list(ctx, ClusterPolicyReportGVR, "", snapshot); found {
+			snapshot.Sources.ClusterPolicyReports = true
+			for _, item := range items {
+				track(item)
+				snapshot.Violations = append(snapshot.Violations, c.reportViolations(item, "ClusterPolicyReport", ClusterPolicyReportGVR.Resource)...)
+			}
+		}
+	}
+	for _, gvr := range c.constraintResources(ctx, snapshot) {
+		snapshot.Sources.Gatekeeper = true
+		items, found := c.list(ctx, gvr, "", snapshot)
+		if !found {
+			continue
+		}
+		for _, item := range items {
+			track(item)
+			for _, violation := range c.constraintViolations(item, gvr) {
+				if inScope(violation.Resource.Namespace) {
+					snapshot.Violations = append(snapshot.Violations, violation)
+				}
+			}
+		}
+	}
+
+	sortViolations(snapshot.Violations)
+	snapshot.Resources = CountByResource(snapshot.Violations)
+	return snapshot, version, nil
+}
+
+// list returns the items of gvr; found is false when the API is not served.
+// Other failures are recorded as snapshot warnings.
+func (c Collector) list(ctx context.Context, gvr schema.GroupVersionResource, namespace string, snapshot *Snapshot) ([]*unstructured.Unstructured, bool) {
+	items, found, err := c.Source.List(ctx, gvr, namespace)
+	if err != nil {
+		snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("%s: %v", gvr.GroupResource(), err))
+		return nil, false
+	}
+	return items, found
+}
+
+// constraintResources discovers the constraint kinds Gatekeeper generated.
+func (c Collector) constraintResources(ctx context.Context, snapshot *Snapshot) []schema.GroupVersionResource {
+	resources, found, err := c.Source.ConstraintResources(ctx)
+	if err != nil {
+		snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("%s: %v", crdGVR.GroupResource(), err))
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	return resources
+}
+
+// APISource lists policy objects through the dynamic client on every call.
+type APISource struct {
+	Dynamic dynamic.Interface
+}
+
+// List implements Source.
+func (s APISource) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, bool, error) {
+	return s.list(ctx, gvr, namespace, "")
+}
+
+func (s APISource) list(ctx context.Context, gvr schema.GroupVersionResource, namespace, selector string) ([]*unstructured.Unstructured, bool, error) {
+	if s.Dynamic == nil {
+		return nil, false, fmt.Errorf("dynamic client is not initialized")
+	}
+	opts := metav1.ListOptions{LabelSelector: selector}
+	var (
+		list *unstructured.UnstructuredList
+		err  error
+	)
+	if namespace != "" {
+		list, err = s.Dynamic.Resource(gvr).Namespace(namespace).List(ctx, opts)
+	} else {
+		list, err = s.Dynamic.Resource(gvr).List(ctx, opts)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	items := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, &list.Items[i])
+	}
+	return items, true, nil
+}
+
+// ConstraintResources implements Source from the labelled constraint CRDs.
+func (s APISource) ConstraintResources(ctx context.Context) ([]schema.GroupVersionResource, bool, error) {
+	crds, found, err := s.list(ctx, crdGVR, "", GatekeeperConstraintLabel)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	var out []schema.GroupVersionResource
+	for _, crd := range crds {
+		if group, _, _ := unstructured.NestedString(crd.Object, "spec", "group"); group != GatekeeperConstraintGroup {
+			continue
+		}
+		plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+		version := storageVersion(crd.Object)
+		if plural == "" || version == "" {
+			continue
+		}
+		out = append(out, schema.GroupVersionResource{Group: GatekeeperConstraintGroup, Version: version, Resource: plural})
+	}
+	SortResources(out)
+	return out, true, nil
+}
+
+// SortResources orders constraint resources the way ConstraintResources
+// returns them.
+func SortResources(resources []schema.GroupVersionResource) {
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Resource < resources[j].Resource })
+}
+
+func storageVersion(crd map[string]interface{}) string {
+	versions, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+	fallback := ""
+	for _, raw := range versions {
+		entry, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := entry["name"].(string)
+		if storage, _ := entry["storage"].(bool); storage {
+			return name
+		}
+		if served, _ := entry["served"].(bool); served && fallback == "" {
+			fallback = name
+		}
+	}
+	return fallback
+}
+
+// reportViolations converts the failing results of one PolicyReport. Results
+// without their own resources apply to the report's scope, which is how
+// per-resource reports (Kyverno 1.10+) are written.
+func (c Collector) reportViolations(report *unstructured.Unstructured, kind, resource string) []Violation {
+	reportRef := resourcemodel.NewResourceRef(c.ClusterID, PolicyReportGVR.Group, PolicyReportGVR.Version, kind, resource, report.GetNamespace(), report.GetName(), string(report.GetUID()))
+	scope, hasScope, _ := unstructured.NestedMap(report.Object, "scope")
+	results, _, _ := unstructured.NestedSlice(report.Object, "results")
+
+	var out []Violation
+	for _, raw := range results {
+		result, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		outcome := strings.ToLower(stringField(result, "result"))
+		if outcome != ResultFail && outcome != ResultWarn && outcome != ResultError {
+			continue
+		}
+		base := Violation{
+			Engine:   stringField(result, "source"),
+			Policy:   stringField(result, "policy"),
+			Rule:     stringField(result, "rule"),
+			Result:   outcome,
+			Severity: stringField(result, "severity"),
+			Message:  stringField(result, "message"),
+			Report:   reportRef,
+		}
+		resources, _, _ := unstructured.NestedSlice(result, "resources")
+		if len(resources) == 0 && hasScope {
+			resources = []interface{}{scope}
+		}
+		for _, rawRef := range resources {
+			objectRef, ok := rawRef.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			group, version := resourcemodel.SplitAPIVersion(stringField(objectRef, "apiVersion"))
+			namespace := stringField(objectRef, "namespace")
+			if namespace == "" && kind == "PolicyReport" {
+				namespace = report.GetNamespace()
+			}
+			violation := base
+			violation.Resource = resourcemodel.NewResourceRef(c.ClusterID, group, version, stringField(objectRef, "kind"), "", namespace, stringField(objectRef, "name"), stringField(objectRef, "uid"))
+			out = append(out, violation)
+		}
+	}
+	return out
+}
+
+// constraintViolations converts a Gatekeeper constraint's audit violations.
+func (c Collector) constraintViolations(constraint *unstructured.Unstructured, gvr schema.GroupVersionResource) []Violation {
+	reportRef := resourcemodel.NewResourceRef(c.ClusterID, gvr.Group, gvr.Version, constraint.GetKind(), gvr.Resource, "", constraint.GetName(), string(constraint.GetUID()))
+	violations, _, _ := unstructured.NestedSlice(constraint.Object, "status", "violations")
+	out := make([]Violation, 0, len(violations))
+	for _, raw := range violations {
+		entry, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		action := stringField(entry, "enforcementAction")
+		result := ResultFail
+		if action == "warn" || action == "dryrun" {
+			result = ResultWarn
+		}
+		out = append(out, Violation{
+			Engine:            EngineGatekeeper,
+			Policy:            constraint.GetName(),
+			Result:            result,
+			Message:           stringField(entry, "message"),
+			EnforcementAction: action,
+			Resource: resourcemodel.NewResourceRef(c.ClusterID, stringField(entry, "group"), stringField(entry, "version"), stringField(entry, "kind"), "",
+				stringField(entry, "namespace"), stringField(entry, "name"), ""),
+			Report: reportRef,
+		})
+	}
+	return out
+}
+
+// CountByResource tallies violations per resource. Resources are matched on
+// group/kind/namespace/name since Gatekeeper does not record UIDs.
+func CountByResource(violations []Violation) []ResourceCounts {
+	type key struct{ group, kind, namespace, name string }
+	index := make(map[key]int)
+	out := []ResourceCounts{}
+	for _, violation := range violations {
+		ref := violation.Resource
+		k := key{ref.Group, ref.Kind, ref.Namespace, ref.Name}
+		position, ok := index[k]
+		if !ok {
+			position = len(out)
+			index[k] = position
+			out = append(out, ResourceCounts{Ref: ref})
+		}
+		counts := &out[position]
+		if counts.Ref.UID == "" {
+			counts.Ref.UID = ref.UID
+		}
+		if counts.Ref.Version == "" {
+			counts.Ref.Version = ref.Version
+		}
+		switch violation.Result {
+		case ResultFail:
+			counts.Fail++
+		case ResultWarn:
+			counts.Warn++
+		case ResultError:
+			counts.Error++
+		}
+	}
+	return out
+}
+
+func sortViolations(violations []Violation) {
+	sort.SliceStable(violations, func(i, j int) bool {
+		left, right := violations[i], violations[j]
+		if left.Resource.Namespace != right.Resource.Namespace {
+			return left.Resource.Namespace < right.Resource.Namespace
+		}
+		if left.Resource.Kind != right.Resource.Kind {
+			return left.Resource.Kind < right.Resource.Kind
+		}
+		if left.Resource.Name != right.Resource.Name {
+			return left.Resource.Name < right.Resource.Name
+		}
+		if left.Policy != right.Policy {
+			return left.Policy < right.Policy
+		}
+		return left.Rule < right.Rule
+	})
+}
+
+func stringField(obj map[string]interface{}, field string) string {
+	value, _ := obj[field].(string)
+	return strings.TrimSpace(value)
+}
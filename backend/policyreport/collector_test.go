@@ -0,0 +1,170 @@
+package policyreport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/policyreport"
+)
+
+var (
+	crdGVR        = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	constraintGVR = schema.GroupVersionResource{Group: policyreport.GatekeeperConstraintGroup, Version: "v1beta1", Resource: "k8srequiredlabels"}
+)
+
+func policyReport() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "wgpolicyk8s.io/v1alpha2",
+		"kind":       "PolicyReport",
+		"metadata":   map[string]interface{}{"name": "pol-web", "namespace": "prod", "resourceVersion": "42"},
+		"scope":      map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web", "namespace": "prod", "uid": "dep-uid"},
+		"results": []interface{}{
+			map[string]interface{}{"policy": "require-limits", "rule": "cpu", "result": "fail", "severity": "medium", "source": "kyverno", "message": "limits required"},
+			map[string]interface{}{"policy": "require-probes", "rule": "liveness", "result": "warn", "source": "kyverno"},
+			map[string]interface{}{"policy": "disallow-latest", "rule": "tag", "result": "pass", "source": "kyverno"},
+			map[string]interface{}{"policy": "no-host-path", "result": "error", "source": "kyverno", "resources": []interface{}{
+				map[string]interface{}{"apiVersion": "v1", "kind": "Pod", "name": "debug"},
+			}},
+		},
+	}}
+}
+
+func constraintCRD() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "k8srequiredlabels.constraints.gatekeeper.sh", "labels": map[string]interface{}{"gatekeeper.sh/constraint": "yes"}},
+		"spec": map[string]interface{}{
+			"group": policyreport.GatekeeperConstraintGroup,
+			"names": map[string]interface{}{"plural": "k8srequiredlabels", "kind": "K8sRequiredLabels"},
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "served": true},
+				map[string]interface{}{"name": "v1beta1", "served": true, "storage": true},
+			},
+		},
+	}}
+}
+
+func constraint() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+		"kind":       "K8sRequiredLabels",
+		"metadata":   map[string]interface{}{"name": "must-have-owner", "resourceVersion": "7"},
+		"status": map[string]interface{}{"violations": []interface{}{
+			map[string]interface{}{"enforcementAction": "deny", "group": "apps", "version": "v1", "kind": "Deployment", "namespace": "prod", "name": "web", "message": "missing owner"},
+			map[string]interface{}{"enforcementAction": "dryrun", "group": "", "version": "v1", "kind": "Namespace", "name": "dev", "message": "missing owner"},
+		}},
+	}}
+}
+
+func newDynamic(t *testing.T, objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		policyreport.PolicyReportGVR:        "PolicyReportList",
+		policyreport.ClusterPolicyReportGVR: "ClusterPolicyReportList",
+		crdGVR:                              "CustomResourceDefinitionList",
+		constraintGVR:                       "K8sRequiredLabelsList",
+	}, objects...)
+	// The tracker cannot guess the plural of K8sRequiredLabels.
+	require.NoError(t, client.Tracker().Create(constraintGVR, constraint(), ""))
+	return client
+}
+
+func TestCollectMergesPolicyReportsAndGatekeeper(t *testing.T) {
+	client := newDynamic(t, policyReport(), constraintCRD())
+
+	snapshot, version, err := policyreport.Collector{ClusterID: "config:ctx", Source: policyreport.APISource{Dynamic: client}}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Warnings)
+	require.Equal(t, policyreport.Sources{PolicyReports: true, ClusterPolicyReports: true, Gatekeeper: true}, snapshot.Sources)
+	require.Equal(t, uint64(42), version)
+	require.Len(t, snapshot.Violations, 5, "pass results are dropped")
+
+	first := snapshot.Violations[0]
+	require.Equal(t, "Namespace", first.Resource.Kind)
+	require.Equal(t, "warn", first.Result)
+	require.Equal(t, "dryrun", first.EnforcementAction)
+
+	gatekeeper := snapshot.Violations[1]
+	require.Equal(t, policyreport.EngineGatekeeper, gatekeeper.Engine)
+	require.Equal(t, "fail", gatekeeper.Result)
+	require.Equal(t, "K8sRequiredLabels", gatekeeper.Report.Kind)
+
+	fail := snapshot.Violations[2]
+	require.Equal(t, "kyverno", fail.Engine)
+	require.Equal(t, "require-limits", fail.Policy)
+	require.Equal(t, "apps", fail.Resource.Group)
+	require.Equal(t, "dep-uid", fail.Resource.UID)
+	require.Equal(t, "config:ctx", fail.Resource.ClusterID)
+	require.Equal(t, "PolicyReport", fail.Report.Kind)
+
+	pod := snapshot.Violations[4]
+	require.Equal(t, "Pod", pod.Resource.Kind)
+	require.Equal(t, "prod", pod.Resource.Namespace, "namespaced report resources default to the report namespace")
+
+	require.Len(t, snapshot.Resources, 3)
+	var deployment policyreport.ResourceCounts
+	for _, counts := range snapshot.Resources {
+		if counts.Ref.Kind == "Deployment" {
+			deployment = counts
+		}
+	}
+	require.Equal(t, 2, deployment.Fail, "Kyverno and Gatekeeper failures are tallied on one resource")
+	require.Equal(t, 1, deployment.Warn)
+	require.Equal(t, "dep-uid", deployment.Ref.UID)
+}
+
+func TestCollectScopedToNamespace(t *testing.T) {
+	client := newDynamic(t, policyReport(), constraintCRD())
+
+	snapshot, _, err := policyreport.Collector{ClusterID: "config:ctx", Source: policyreport.APISource{Dynamic: client}}.Collect(context.Background(), "dev")
+	require.NoError(t, err)
+	require.False(t, snapshot.Sources.ClusterPolicyReports)
+	require.Empty(t, snapshot.Violations, "cluster-scoped violations have no namespace")
+	require.Empty(t, snapshot.Resources)
+}
+
+func TestCollectWithoutPolicyEngines(t *testing.T) {
+	client := newDynamic(t)
+	for _, resource := range []string{"policyreports", "clusterpolicyreports"} {
+		gvr := schema.GroupVersionResource{Group: "wgpolicyk8s.io", Version: "v1alpha2", Resource: resource}
+		client.PrependReactor("list", resource, func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewNotFound(gvr.GroupResource(), "")
+		})
+	}
+	client.PrependReactor("list", "customresourcedefinitions", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(crdGVR.GroupResource(), "", nil)
+	})
+
+	snapshot, _, err := policyreport.Collector{ClusterID: "config:ctx", Source: policyreport.APISource{Dynamic: client}}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, policyreport.Sources{}, snapshot.Sources)
+	require.Len(t, snapshot.Warnings, 1, "forbidden is surfaced, not-found is not")
+	require.NotNil(t, snapshot.Violations)
+	require.NotNil(t, snapshot.Resources)
+}
+
+func TestCollectLimitedToNamespaceScope(t *testing.T) {
+	client := newDynamic(t, policyReport(), constraintCRD())
+	collector := policyreport.Collector{ClusterID: "config:ctx", Source: policyreport.APISource{Dynamic: client}, Namespaces: []string{"prod"}}
+
+	snapshot, _, err := collector.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.False(t, snapshot.Sources.ClusterPolicyReports, "cluster-scoped reports are outside a namespace scope")
+	require.Len(t, snapshot.Violations, 4)
+	for _, violation := range snapshot.Violations {
+		require.Equal(t, "prod", violation.Resource.Namespace)
+	}
+
+	snapshot, _, err = collector.Collect(context.Background(), "dev")
+	require.NoError(t, err)
+	require.False(t, snapshot.Sources.PolicyReports, "namespaces outside the scope are not read")
+	require.Empty(t, snapshot.Violations)
+}
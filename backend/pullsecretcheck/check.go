@@ -0,0 +1,368 @@
+// Package pullsecretcheck verifies that a workload's image pull secrets still
+// work: each image's manifest is requested from its registry with the
+// credentials the kubelet would use, so a rotated or mistyped secret shows up
+// before the next rollout ends in ImagePullBackOff.
+package pullsecretcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Image check statuses.
+const (
+	// StatusOK: a pull secret's credentials fetched the manifest.
+	StatusOK = "ok"
+	// StatusPublic: the manifest is readable anonymously, so no pull secret
+	// is needed.
+	StatusPublic = "public"
+	// StatusNoCredentials: the registry requires authentication and no pull
+	// secret has credentials for it.
+	StatusNoCredentials = "no-credentials"
+	// StatusUnauthorized: every matching pull secret was rejected.
+	StatusUnauthorized = "unauthorized"
+	// StatusNotFound: the registry accepted the request but has no such
+	// image or tag.
+	StatusNotFound = "not-found"
+	// StatusError: the registry could not be reached or answered unexpectedly.
+	StatusError = "error"
+)
+
+// Pull secret sources.
+const (
+	SourcePodSpec        = "podSpec"
+	SourceServiceAccount = "serviceAccount"
+)
+
+// Request names what to check. With Kind and Name, the workload's pod template
+// supplies the images, pull secrets and service account. Images, when set,
+// replace the template's images, so a new tag can be checked before it is
+// rolled out; without a workload they are checked against ServiceAccount's
+// pull secrets (the namespace's default service account when empty).
+type Request struct {
+	Namespace      string   `json:"namespace"`
+	Kind           string   `json:"kind,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Images         []string `json:"images,omitempty"`
+	ServiceAccount string   `json:"serviceAccount,omitempty"`
+}
+
+// SecretCheck is one pull secret the pods would use.
+type SecretCheck struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	// Registries lists the registry keys the secret holds credentials for.
+	Registries []string `json:"registries"`
+	// Problem is set when the secret is missing, unreadable or malformed.
+	Problem string `json:"problem,omitempty"`
+}
+
+// ImageCheck is the verdict for one image.
+type ImageCheck struct {
+	Image      string   `json:"image"`
+	Containers []string `json:"containers,omitempty"`
+	Registry   string   `json:"registry"`
+	Status     string   `json:"status"`
+	// Secret names the pull secret the verdict was reached with, if any.
+	Secret  string `json:"secret,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the outcome of one pull secret check.
+type Report struct {
+	ClusterID      string        `json:"clusterId"`
+	Namespace      string        `json:"namespace"`
+	Kind           string        `json:"kind,omitempty"`
+	Name           string        `json:"name,omitempty"`
+	ServiceAccount string        `json:"serviceAccount"`
+	Secrets        []SecretCheck `json:"secrets"`
+	Images         []ImageCheck  `json:"images"`
+	// Failed counts images whose status would stop a pull.
+	Failed   int      `json:"failed"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Checker runs pull secret checks against one cluster.
+type Checker struct {
+	ClusterID string
+	Client    kubernetes.Interface
+	// HTTPClient makes the registry requests; nil uses a default client.
+	HTTPClient *http.Client
+	// RequestTimeout bounds each registry request.
+	RequestTimeout time.Duration
+}
+
+// Check resolves the images and pull secrets for req and verifies each image
+// against its registry.
+func (c Checker) Check(ctx context.Context, req Request) (*Report, error) {
+	if c.Client == nil {
+		return nil, fmt.Errorf("kubernetes client is not initialized")
+	}
+	namespace := strings.TrimSpace(req.Namespace)
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	report := &Report{
+		ClusterID:      c.ClusterID,
+		Namespace:      namespace,
+		Kind:           req.Kind,
+		Name:           req.Name,
+		ServiceAccount: strings.TrimSpace(req.ServiceAccount),
+		Secrets:        []SecretCheck{},
+		Images:         []ImageCheck{},
+	}
+
+	var spec *corev1.PodSpec
+	if req.Kind != "" || req.Name != "" {
+		var err error
+		if spec, err = workloadPodSpec(ctx, c.Client, namespace, req.Kind, req.Name); err != nil {
+			return nil, err
+		}
+		report.ServiceAccount = spec.ServiceAccountName
+	}
+	if report.ServiceAccount == "" {
+		report.ServiceAccount = "default"
+	}
+
+	images := containerImages(spec, req.Images)
+	if len(images) == 0 {
+		return nil, fmt.Errorf("no images to check")
+	}
+
+	creds := c.collectCredentials(ctx, report, spec)
+	registry := registryClient{http: c.HTTPClient, timeout: c.RequestTimeout}
+	if registry.http == nil {
+		registry.http = &http.Client{}
+	}
+	if registry.timeout <= 0 {
+		registry.timeout = 10 * time.Second
+	}
+	for _, image := range images {
+		check := checkImage(ctx, registry, creds, image.image)
+		check.Containers = image.containers
+		if check.Status != StatusOK && check.Status != StatusPublic {
+			report.Failed++
+		}
+		report.Images = append(report.Images, check)
+	}
+	return report, nil
+}
+
+// collectCredentials reads the pod's pull secrets and then the service
+// account's, as the kubelet merges them, recording each in the report.
+func (c Checker) collectCredentials(ctx context.Context, report *Report, spec *corev1.PodSpec) []credential {
+	type secretRef struct{ name, source string }
+	var refs []secretRef
+	if spec != nil {
+		for _, ref := range spec.ImagePullSecrets {
+			refs = append(refs, secretRef{ref.Name, SourcePodSpec})
+		}
+	}
+	sa, err := c.Client.CoreV1().ServiceAccounts(report.Namespace).Get(ctx, report.ServiceAccount, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		for _, ref := range sa.ImagePullSecrets {
+			refs = append(refs, secretRef{ref.Name, SourceServiceAccount})
+		}
+	case apierrors.IsNotFound(err):
+		report.Warnings = append(report.Warnings, fmt.Sprintf("service account %q not found", report.ServiceAccount))
+	default:
+		report.Warnings = append(report.Warnings, fmt.Sprintf("service account %q: %v", report.ServiceAccount, err))
+	}
+
+	var creds []credential
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		if ref.name == "" || seen[ref.name] {
+			continue
+		}
+		seen[ref.name] = true
+		check := SecretCheck{Name: ref.name, Source: ref.source, Registries: []string{}}
+		secret, err := c.Client.CoreV1().Secrets(report.Namespace).Get(ctx, ref.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			check.Problem = "secret not found"
+		case err != nil:
+			check.Problem = err.Error()
+		default:
+			parsed, err := parsePullSecret(secret)
+			if err != nil {
+				check.Problem = err.Error()
+				break
+			}
+			for _, cred := range parsed {
+				check.Registries = append(check.Registries, cred.key)
+			}
+			creds = append(creds, parsed...)
+		}
+		report.Secrets = append(report.Secrets, check)
+	}
+	return creds
+}
+
+// checkImage verifies one image. Matching credentials are tried most specific
+// first, as the kubelet does, and the first that works wins.
+func checkImage(ctx context.Context, registry registryClient, creds []credential, image string) ImageCheck {
+	check := ImageCheck{Image: image}
+	ref, err := parseImage(image)
+	if err != nil {
+		check.Status, check.Message = StatusError, err.Error()
+		return check
+	}
+	check.Registry = ref.registry
+
+	candidates := matchingCredentials(creds, ref)
+	if len(candidates) == 0 {
+		status, err := registry.headManifest(ctx, ref, nil)
+		check.Status, check.Message = verdict(status, err)
+		switch check.Status {
+		case StatusOK:
+			check.Status, check.Message = StatusPublic, "readable without credentials"
+		case StatusUnauthorized:
+			check.Status = StatusNoCredentials
+			check.Message = "registry requires authentication and no pull secret has credentials for it; node credentials may still apply"
+		}
+		return check
+	}
+
+	for _, cred := range candidates {
+		status, err := registry.headManifest(ctx, ref, &cred)
+		check.Secret = cred.secret
+		check.Status, check.Message = verdict(status, err)
+		if check.Status != StatusUnauthorized && check.Status != StatusError {
+			break
+		}
+	}
+	return check
+}
+
+// verdict maps a manifest HEAD outcome to a status and message.
+func verdict(status int, err error) (string, string) {
+	switch {
+	case err != nil:
+		var netErr interface{ Timeout() bool }
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return StatusError, "registry request timed out"
+		}
+		return StatusError, err.Error()
+	case status >= 200 && status < 300:
+		return StatusOK, ""
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return StatusUnauthorized, fmt.Sprintf("registry rejected the credentials (HTTP %d)", status)
+	case status == http.StatusNotFound:
+		return StatusNotFound, "manifest not found"
+	default:
+		return StatusError, fmt.Sprintf("unexpected registry response (HTTP %d)", status)
+	}
+}
+
+// podImage is one distinct image and the containers that run it.
+type podImage struct {
+	image      string
+	containers []string
+}
+
+// containerImages lists the distinct images to check: explicit images when
+// given, otherwise the spec's init and regular containers' images.
+func containerImages(spec *corev1.PodSpec, explicit []string) []podImage {
+	var out []podImage
+	add := func(image, container string) {
+		image = strings.TrimSpace(image)
+		if image == "" {
+			return
+		}
+		for i := range out {
+			if out[i].image == image {
+				if container != "" {
+					out[i].containers = append(out[i].containers, container)
+				}
+				return
+			}
+		}
+		entry := podImage{image: image}
+		if container != "" {
+			entry.containers = []string{container}
+		}
+		out = append(out, entry)
+	}
+	if len(explicit) > 0 {
+		for _, image := range explicit {
+			add(image, "")
+		}
+		return out
+	}
+	if spec == nil {
+		return nil
+	}
+	for _, container := range spec.InitContainers {
+		add(container.Image, container.Name)
+	}
+	for _, container := range spec.Containers {
+		add(container.Image, container.Name)
+	}
+	return out
+}
+
+// workloadPodSpec fetches a workload and returns its pod template's spec (or a
+// Pod's own spec).
+func workloadPodSpec(ctx context.Context, client kubernetes.Interface, namespace, kind, name string) (*corev1.PodSpec, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("workload name is required")
+	}
+	get := metav1.GetOptions{}
+	switch kind {
+	case "Deployment":
+		obj, err := client.AppsV1().Deployments(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+	case "StatefulSet":
+		obj, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+	case "DaemonSet":
+		obj, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+	case "ReplicaSet":
+		obj, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+	case "Job":
+		obj, err := client.BatchV1().Jobs(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+	case "CronJob":
+		obj, err := client.BatchV1().CronJobs(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec.JobTemplate.Spec.Template.Spec, nil
+	case "Pod":
+		obj, err := client.CoreV1().Pods(namespace).Get(ctx, name, get)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.Spec, nil
+	default:
+		return nil, fmt.Errorf("pull secret check does not support kind %q", kind)
+	}
+}
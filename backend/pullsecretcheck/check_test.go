@@ -0,0 +1,219 @@
+package pullsecretcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestRegistry serves a token realm that issues "good-token" for
+// robot:secret and "anon-token" without credentials. Repositories under team/
+// need the good token; public/ accepts any token; only tag 1.0 exists.
+func newTestRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			token := "anon-token"
+			if user, pass, ok := r.BasicAuth(); ok {
+				if user != "robot" || pass != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				token = "good-token"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+			return
+		}
+		repo, tag, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/"), "/manifests/")
+		if !ok || r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		auth := r.Header.Get("Authorization")
+		authorized := auth == "Bearer good-token" || (strings.HasPrefix(repo, "public/") && auth != "")
+		if !authorized {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test-registry",scope="repository:`+repo+`:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if tag != "1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func pullSecret(name, registry, username, password string) *corev1.Secret {
+	config, _ := json.Marshal(map[string]any{"auths": map[string]any{
+		registry: map[string]string{"username": username, "password": password},
+	}})
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "prod"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: config},
+	}
+}
+
+func imageStatuses(report *Report) map[string]string {
+	out := make(map[string]string, len(report.Images))
+	for _, image := range report.Images {
+		out[image.Image] = image.Status + " " + image.Secret
+	}
+	return out
+}
+
+func TestCheckWorkloadFallsBackToWorkingSecret(t *testing.T) {
+	server := newTestRegistry(t)
+	host := strings.TrimPrefix(server.URL, "https://")
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "prod"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				ServiceAccountName: "api",
+				ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "rotated"}},
+				InitContainers:     []corev1.Container{{Name: "migrate", Image: host + "/team/app:1.0"}},
+				Containers: []corev1.Container{
+					{Name: "app", Image: host + "/team/app:1.0"},
+					{Name: "next", Image: host + "/team/app:2.0"},
+					{Name: "tool", Image: host + "/public/tool:1.0"},
+				},
+			}}},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta:       metav1.ObjectMeta{Name: "api", Namespace: "prod"},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "current"}, {Name: "rotated"}},
+		},
+		pullSecret("rotated", host+"/team", "robot", "old"),
+		pullSecret("current", "https://"+host+"/team", "robot", "secret"),
+	)
+
+	report, err := Checker{ClusterID: "c1", Client: client, HTTPClient: server.Client()}.Check(context.Background(), Request{
+		Namespace: "prod", Kind: "Deployment", Name: "api",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "api", report.ServiceAccount)
+	require.Equal(t, []SecretCheck{
+		{Name: "rotated", Source: SourcePodSpec, Registries: []string{host + "/team"}},
+		{Name: "current", Source: SourceServiceAccount, Registries: []string{"https://" + host + "/team"}},
+	}, report.Secrets)
+	require.Equal(t, map[string]string{
+		host + "/team/app:1.0":    "ok current",
+		host + "/team/app:2.0":    "not-found current",
+		host + "/public/tool:1.0": "public ",
+	}, imageStatuses(report))
+	require.Equal(t, []string{"migrate", "app"}, report.Images[0].Containers)
+	require.Equal(t, 1, report.Failed)
+}
+
+func TestCheckImagesAgainstServiceAccountSecrets(t *testing.T) {
+	server := newTestRegistry(t)
+	host := strings.TrimPrefix(server.URL, "https://")
+	client := fake.NewSimpleClientset(
+		&corev1.ServiceAccount{
+			ObjectMeta:       metav1.ObjectMeta{Name: "default", Namespace: "prod"},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "rotated"}, {Name: "gone"}},
+		},
+		pullSecret("rotated", host, "robot", "old"),
+	)
+	checker := Checker{ClusterID: "c1", Client: client, HTTPClient: server.Client()}
+
+	report, err := checker.Check(context.Background(), Request{Namespace: "prod", Images: []string{host + "/team/app:1.0"}})
+	require.NoError(t, err)
+	require.Equal(t, "default", report.ServiceAccount)
+	require.Equal(t, "secret not found", report.Secrets[1].Problem)
+	require.Equal(t, map[string]string{host + "/team/app:1.0": "unauthorized rotated"}, imageStatuses(report))
+	require.Equal(t, 1, report.Failed)
+
+	report, err = checker.Check(context.Background(), Request{
+		Namespace: "prod", ServiceAccount: "builder", Images: []string{host + "/team/app:1.0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`service account "builder" not found`}, report.Warnings)
+	require.Equal(t, map[string]string{host + "/team/app:1.0": "no-credentials "}, imageStatuses(report))
+
+	_, err = checker.Check(context.Background(), Request{Namespace: "prod", Kind: "Gadget", Name: "x"})
+	require.ErrorContains(t, err, `does not support kind "Gadget"`)
+	_, err = checker.Check(context.Background(), Request{Namespace: "prod"})
+	require.ErrorContains(t, err, "no images to check")
+}
+
+func TestParseImageAppliesDockerDefaults(t *testing.T) {
+	for image, want := range map[string]imageRef{
+		"nginx":           {dockerHub, "library/nginx", "latest"},
+		"bitnami/redis:7": {dockerHub, "bitnami/redis", "7"},
+		"index.docker.io/library/nginx@sha256:abc": {dockerHub, "library/nginx", "sha256:abc"},
+		"registry.example.com:5000/team/app:1.0":   {"registry.example.com:5000", "team/app", "1.0"},
+		"localhost/app":                            {"localhost", "app", "latest"},
+	} {
+		got, err := parseImage(image)
+		require.NoError(t, err, image)
+		require.Equal(t, want, got, image)
+	}
+}
+
+func TestCredentialMatching(t *testing.T) {
+	creds := []credential{
+		{key: "https://index.docker.io/v1/", host: dockerHub},
+		{key: "*.example.com", host: "*.example.com"},
+		{key: "registry.example.com/team", host: "registry.example.com", path: "team"},
+	}
+	host, repoPath := normalizeRegistryKey("https://index.docker.io/v1/")
+	require.Equal(t, []string{dockerHub, ""}, []string{host, repoPath})
+
+	keys := func(image string) []string {
+		ref, err := parseImage(image)
+		require.NoError(t, err)
+		var out []string
+		for _, cred := range matchingCredentials(creds, ref) {
+			out = append(out, cred.key)
+		}
+		return out
+	}
+	require.Equal(t, []string{"https://index.docker.io/v1/"}, keys("nginx"))
+	require.Equal(t, []string{"registry.example.com/team", "*.example.com"}, keys("registry.example.com/team/app"))
+	require.Equal(t, []string{"*.example.com"}, keys("registry.example.com/teammate/app"))
+	require.Empty(t, keys("quay.io/team/app"))
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry",scope="repository:a/b:pull,push"`)
+	require.Equal(t, "bearer", scheme)
+	require.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry",
+		"scope":   "repository:a/b:pull,push",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	require.Equal(t, "basic", scheme)
+	require.Equal(t, "registry", params["realm"])
+}
+
+func TestFetchTokenRefusesPlainHTTPRealm(t *testing.T) {
+	requests := 0
+	realm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "leaked"})
+	}))
+	t.Cleanup(realm.Close)
+
+	client := registryClient{http: realm.Client(), timeout: time.Second}
+	_, _, err := client.fetchToken(context.Background(), map[string]string{"realm": realm.URL + "/token"},
+		imageRef{registry: "registry.example.com", repository: "team/app"}, &credential{username: "robot", password: "secret"})
+	require.ErrorContains(t, err, "not https")
+	require.Zero(t, requests)
+}
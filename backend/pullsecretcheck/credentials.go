@@ -0,0 +1,170 @@
+package pullsecretcheck
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// dockerHub is the canonical registry name for Docker Hub images and keys.
+const dockerHub = "docker.io"
+
+// credential is one registry login from a pull secret.
+type credential struct {
+	secret   string
+	key      string
+	host     string
+	path     string
+	username string
+	password string
+}
+
+// dockerConfigEntry is one registry entry of a .dockerconfigjson or .dockercfg.
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// parsePullSecret reads the registry logins from a kubernetes.io/dockerconfigjson
+// or legacy kubernetes.io/dockercfg secret, sorted by registry key.
+func parsePullSecret(secret *corev1.Secret) ([]credential, error) {
+	var entries map[string]dockerConfigEntry
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		var config struct {
+			Auths map[string]dockerConfigEntry `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", corev1.DockerConfigJsonKey, err)
+		}
+		entries = config.Auths
+	case corev1.SecretTypeDockercfg:
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &entries); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", corev1.DockerConfigKey, err)
+		}
+	default:
+		return nil, fmt.Errorf("secret type %q is not an image pull secret", secret.Type)
+	}
+
+	creds := make([]credential, 0, len(entries))
+	for key, entry := range entries {
+		username, password := entry.Username, entry.Password
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("decode auth for %s: %w", key, err)
+			}
+			var ok bool
+			if username, password, ok = strings.Cut(string(decoded), ":"); !ok {
+				return nil, fmt.Errorf("auth for %s is not username:password", key)
+			}
+		}
+		host, repoPath := normalizeRegistryKey(key)
+		creds = append(creds, credential{
+			secret:   secret.Name,
+			key:      key,
+			host:     host,
+			path:     repoPath,
+			username: username,
+			password: password,
+		})
+	}
+	sort.Slice(creds, func(i, j int) bool { return creds[i].key < creds[j].key })
+	return creds, nil
+}
+
+// normalizeRegistryKey splits a docker config key such as
+// "https://index.docker.io/v1/" or "registry.example.com/team" into a host and
+// an optional repository path prefix. Docker Hub aliases become docker.io.
+func normalizeRegistryKey(key string) (host, repoPath string) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(key)), "https://"), "http://")
+	host, repoPath, _ = strings.Cut(strings.TrimSuffix(trimmed, "/"), "/")
+	switch host {
+	case "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com":
+		host = dockerHub
+	}
+	if host == dockerHub || repoPath == "v1" || repoPath == "v2" {
+		repoPath = ""
+	}
+	return host, repoPath
+}
+
+// matches reports whether the credential applies to an image, following the
+// kubelet keyring: the host may use * globs per label, and a key with a path
+// applies only to repositories under it.
+func (c credential) matches(ref imageRef) bool {
+	if matched, err := path.Match(c.host, ref.registry); err != nil || !matched {
+		return false
+	}
+	return c.path == "" || ref.repository == c.path || strings.HasPrefix(ref.repository, c.path+"/")
+}
+
+// specificity orders matching credentials the way the kubelet tries them:
+// longer path prefixes and non-wildcard hosts first.
+func (c credential) specificity() int {
+	score := len(c.path) * 2
+	if !strings.Contains(c.host, "*") {
+		score++
+	}
+	return score
+}
+
+// matchingCredentials returns the credentials that apply to ref, most specific first.
+func matchingCredentials(creds []credential, ref imageRef) []credential {
+	var out []credential
+	for _, cred := range creds {
+		if cred.matches(ref) {
+			out = append(out, cred)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].specificity() > out[j].specificity() })
+	return out
+}
+
+// imageRef is a parsed image reference.
+type imageRef struct {
+	registry   string
+	repository string
+	// reference is the digest when the image is pinned, else the tag.
+	reference string
+}
+
+// parseImage parses an image reference, applying Docker's defaults: docker.io
+// for a missing registry, library/ for official images and latest for a
+// missing tag.
+func parseImage(image string) (imageRef, error) {
+	name, digest, _ := strings.Cut(strings.TrimSpace(image), "@")
+	tag := ""
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if name == "" {
+		return imageRef{}, fmt.Errorf("image %q has no repository", image)
+	}
+	ref := imageRef{registry: dockerHub, repository: name}
+	if first, rest, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.registry, ref.repository = strings.ToLower(first), rest
+	}
+	switch ref.registry {
+	case "index.docker.io", "registry-1.docker.io":
+		ref.registry = dockerHub
+	}
+	if ref.registry == dockerHub && !strings.Contains(ref.repository, "/") {
+		ref.repository = "library/" + ref.repository
+	}
+	switch {
+	case digest != "":
+		ref.reference = digest
+	case tag != "":
+		ref.reference = tag
+	default:
+		ref.reference = "latest"
+	}
+	return ref, nil
+}
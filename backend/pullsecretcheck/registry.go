@@ -0,0 +1,194 @@
+package pullsecretcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// manifestAccept lists the manifest media types a HEAD asks for, so registries
+// answer for multi-arch indexes as well as single manifests.
+var manifestAccept = strings.Join([]string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}, ", ")
+
+// registryClient speaks just enough of the distribution API to HEAD a manifest,
+// answering a Bearer or Basic challenge with an optional credential.
+type registryClient struct {
+	http    *http.Client
+	timeout time.Duration
+}
+
+// apiHost returns the host serving the registry's /v2 API.
+func apiHost(registry string) string {
+	if registry == dockerHub {
+		return "registry-1.docker.io"
+	}
+	return registry
+}
+
+// headManifest HEADs the image's manifest and returns the final HTTP status.
+// Without a credential the challenge is answered anonymously, which is how a
+// public image is told apart from one that needs a pull secret.
+func (r registryClient) headManifest(ctx context.Context, ref imageRef, cred *credential) (int, error) {
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", apiHost(ref.registry), ref.repository, ref.reference)
+	resp, err := r.do(ctx, http.MethodHead, manifestURL, "")
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp.StatusCode, nil
+	}
+
+	scheme, params := parseChallenge(resp.Header.Get("WWW-Authenticate"))
+	var authorization string
+	switch scheme {
+	case "bearer":
+		token, status, err := r.fetchToken(ctx, params, ref, cred)
+		if err != nil || status != http.StatusOK {
+			return status, err
+		}
+		authorization = "Bearer " + token
+	case "basic":
+		if cred == nil {
+			return http.StatusUnauthorized, nil
+		}
+		authorization = basicAuthorization(cred)
+	default:
+		return 0, fmt.Errorf("registry %s sent an unsupported auth challenge %q", ref.registry, resp.Header.Get("WWW-Authenticate"))
+	}
+
+	resp, err = r.do(ctx, http.MethodHead, manifestURL, authorization)
+	if err != nil {
+		return 0, err
+	}
+	return resp.StatusCode, nil
+}
+
+// fetchToken requests a pull token from a Bearer challenge's realm. A rejected
+// credential surfaces as the token endpoint's 401. The realm comes from the
+// registry's response, so one that is not https is refused rather than sent
+// the pull secret's password in the clear.
+func (r registryClient) fetchToken(ctx context.Context, params map[string]string, ref imageRef, cred *credential) (string, int, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", 0, fmt.Errorf("registry %s sent a bearer challenge without a realm", ref.registry)
+	}
+	if realm.Scheme != "https" {
+		return "", 0, fmt.Errorf("registry %s sent a token realm that is not https: %s", ref.registry, realm.Redacted())
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", "repository:"+ref.repository+":pull")
+	realm.RawQuery = query.Encode()
+
+	authorization := ""
+	if cred != nil {
+		authorization = basicAuthorization(cred)
+	}
+	resp, body, err := r.get(ctx, realm.String(), authorization)
+	if err != nil {
+		return "", 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", resp.StatusCode, nil
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", 0, fmt.Errorf("parse registry token response: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return token.Token, http.StatusOK, nil
+}
+
+func (r registryClient) do(ctx context.Context, method, target, authorization string) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", manifestAccept)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	return resp, nil
+}
+
+func (r registryClient) get(ctx context.Context, target, authorization string) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+func basicAuthorization(cred *credential) string {
+	req := http.Request{Header: http.Header{}}
+	req.SetBasicAuth(cred.username, cred.password)
+	return req.Header.Get("Authorization")
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"` into its
+// lower-cased scheme and parameters. Quoted values may contain commas.
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, after, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		var value string
+		if strings.HasPrefix(after, `"`) {
+			end := strings.Index(after[1:], `"`)
+			if end < 0 {
+				value, after = after[1:], ""
+			} else {
+				value, after = after[1:end+1], after[end+2:]
+			}
+		} else {
+			value, after, _ = strings.Cut(after, ",")
+			after = "," + after
+		}
+		params[key] = strings.TrimSpace(value)
+		rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(after), ","))
+	}
+	return strings.ToLower(scheme), params
+}
@@ -0,0 +1,54 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/persistentvolumeclaim"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResizePersistentVolumeClaim expands a PVC to size (a quantity such as
+// "20Gi"). The claim must be bound and its StorageClass must allow volume
+// expansion; progress then shows up in the PVC's resize conditions.
+func (a *App) ResizePersistentVolumeClaim(clusterID, namespace, name, size string) (*persistentvolumeclaim.ResizeResult, error) {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "resize PVC"); err != nil {
+		return nil, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "PersistentVolumeClaim"); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResourcePermission(ctx, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      "PersistentVolumeClaim",
+		Namespace: namespace,
+		Name:      name,
+		Verb:      "patch",
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := persistentvolumeclaim.NewService(deps).Resize(namespace, name, size)
+	if err != nil {
+		return nil, err
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}, namespace, name)
+
+	a.logger.Info(
+		fmt.Sprintf("Requested resize of PVC %s/%s from %s to %s", namespace, name, result.Previous, result.Requested),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
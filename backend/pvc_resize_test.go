@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/testsupport"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResizePersistentVolumeClaimPatchesStorageRequest(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		testsupport.PersistentVolumeClaimFixture("default", "data"),
+		testsupport.StorageClassFixture("standard"),
+	)
+	allowSelfSubjectAccessReviews(client)
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+
+	result, err := app.ResizePersistentVolumeClaim("config:ctx", "default", "data", "8Gi")
+	require.NoError(t, err)
+	require.Equal(t, "5Gi", result.Previous)
+	require.Equal(t, "8Gi", result.Requested)
+
+	pvc, err := client.CoreV1().PersistentVolumeClaims("default").Get(context.Background(), "data", metav1.GetOptions{})
+	require.NoError(t, err)
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	require.Equal(t, "8Gi", requested.String())
+
+	require.NoError(t, app.SetClusterReadOnly("config:ctx", true))
+	_, err = app.ResizePersistentVolumeClaim("config:ctx", "default", "data", "10Gi")
+	require.ErrorContains(t, err, "read-only")
+}
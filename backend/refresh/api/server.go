@@ -13,11 +13,14 @@ import (
 
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+	"github.com/luxury-yacht/app/backend/refresh/wire"
 )
 
 const (
 	// CorrelationIDHeader is the HTTP header used for request correlation.
 	CorrelationIDHeader = "X-Correlation-ID"
+	// FreshnessHeader carries the JSON DataFreshness on 304 snapshot responses.
+	FreshnessHeader = "X-Refresh-Freshness"
 )
 
 var (
@@ -34,6 +37,7 @@ type Server struct {
 	queue     refresh.ManualQueue
 	telemetry telemetry.Summarizer
 	metrics   refresh.ClusterMetricsDemandController
+	deltas    *snapshotDeltaCache
 }
 
 // NewServer constructs an API server instance.
@@ -48,6 +52,7 @@ func NewServer(
 		queue:     queue,
 		telemetry: recorder,
 		metrics:   metrics,
+		deltas:    newSnapshotDeltaCache(),
 	}
 }
 
@@ -106,16 +111,29 @@ func (s *Server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
 	ifNoneMatch := r.Header.Get("If-None-Match")
 	if ifNoneMatch != "" && validator != "" && ifNoneMatch == validator {
 		setCorrelationID(w, correlationID)
+		// A 304 has no body, so freshness travels in a header; the rows the
+		// client still holds may have gone stale since it fetched them.
+		setFreshnessHeader(w, snapshot.Freshness)
 		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
+	// A since version the server still holds lets the response carry only
+	// the rows that changed, instead of the whole payload.
+	format := wire.FormatFromAccept(r.Header.Get("Accept"))
+	response, err := s.deltas.prepare(snapshot, validator, r.URL.Query().Get("since"), format)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err, correlationID)
+		return
+	}
+
 	setCorrelationID(w, correlationID)
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Add("Vary", "Accept")
 	if validator != "" {
 		w.Header().Set("ETag", validator)
 	}
-	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+	if err := wire.Encode(w, format, response); err != nil {
 		writeError(w, http.StatusInternalServerError, err, correlationID)
 	}
 }
@@ -269,6 +287,15 @@ func getCorrelationID(r *http.Request) string {
 }
 
 // setCorrelationID sets the correlation ID on the response header.
+func setFreshnessHeader(w http.ResponseWriter, freshness *refresh.DataFreshness) {
+	if freshness == nil {
+		return
+	}
+	if encoded, err := json.Marshal(freshness); err == nil {
+		w.Header().Set(FreshnessHeader, string(encoded))
+	}
+}
+
 func setCorrelationID(w http.ResponseWriter, correlationID string) {
 	if correlationID != "" {
 		w.Header().Set(CorrelationIDHeader, correlationID)
@@ -11,6 +11,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
+
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/api"
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
@@ -154,6 +156,174 @@ func TestSnapshotEndpointUsesSourceVersionForETagAndNotModified(t *testing.T) {
 	}
 }
 
+func TestSnapshotEndpointReportsFreshnessOnNotModified(t *testing.T) {
+	svc := &fakeSnapshotService{snapshot: &refresh.Snapshot{
+		Version:       1,
+		SourceVersion: "src-v1",
+		Payload:       map[string]int{"items": 1},
+		Freshness: &refresh.DataFreshness{
+			LastSyncedAt:    1_000,
+			InformersSynced: true,
+			Degraded:        true,
+			DegradedReason:  refresh.FreshnessReasonConnectivity,
+		},
+	}}
+	server := api.NewServer(svc, &fakeQueue{}, nil, nil)
+
+	mux := http.NewServeMux()
+	server.Register(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/snapshots/nodes?scope=cluster-a|", nil)
+	req.Header.Set("If-None-Match", "src-v1")
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotModified {
+		t.Fatalf("expected status 304 got %d", rr.Code)
+	}
+	var freshness refresh.DataFreshness
+	if err := json.Unmarshal([]byte(rr.Header().Get(api.FreshnessHeader)), &freshness); err != nil {
+		t.Fatalf("decode freshness header: %v", err)
+	}
+	if freshness != *svc.snapshot.Freshness {
+		t.Fatalf("unexpected freshness %+v", freshness)
+	}
+}
+
+func TestSnapshotEndpointReturnsDeltaSinceHeldVersion(t *testing.T) {
+	type row struct {
+		Ref    map[string]string `json:"ref"`
+		Status string            `json:"status"`
+	}
+	rowFor := func(name, status string) row {
+		return row{Ref: map[string]string{"kind": "Pod", "name": name}, Status: status}
+	}
+	svc := &fakeSnapshotService{snapshot: &refresh.Snapshot{
+		SourceVersion: "v1",
+		Payload: map[string]any{
+			"rows":  []row{rowFor("a", "Running"), rowFor("b", "Running"), rowFor("c", "Running")},
+			"total": 3,
+		},
+	}}
+	server := api.NewServer(svc, &fakeQueue{}, nil, nil)
+	mux := http.NewServeMux()
+	server.Register(mux)
+
+	get := func(since string) refresh.Snapshot {
+		t.Helper()
+		url := "/api/v2/snapshots/pods?scope=cluster-a|namespace:all"
+		if since != "" {
+			url += "&since=" + since
+		}
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("expected status 200 got %d", rr.Code)
+		}
+		var snap refresh.Snapshot
+		if err := json.Unmarshal(rr.Body.Bytes(), &snap); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		return snap
+	}
+
+	if snap := get(""); snap.Delta != nil {
+		t.Fatalf("expected full snapshot without since, got delta %+v", snap.Delta)
+	}
+
+	svc.snapshot = &refresh.Snapshot{
+		SourceVersion: "v2",
+		Payload: map[string]any{
+			"rows":  []row{rowFor("a", "Running"), rowFor("c", "Failed"), rowFor("d", "Pending")},
+			"total": 3,
+		},
+	}
+	snap := get("v1")
+	if snap.Delta == nil {
+		t.Fatal("expected delta response for held since version")
+	}
+	if snap.Delta.Since != "v1" || snap.Delta.RowsField != "rows" {
+		t.Fatalf("unexpected delta header %+v", snap.Delta)
+	}
+	payload := snap.Payload.(map[string]any)
+	if _, ok := payload["rows"]; ok {
+		t.Fatal("delta payload must omit the rows field")
+	}
+	if payload["total"] != float64(3) {
+		t.Fatalf("expected other payload fields to be kept, got %v", payload)
+	}
+	if len(snap.Delta.Upserted) != 2 || len(snap.Delta.Removed) != 1 || snap.Delta.Order != nil {
+		t.Fatalf("unexpected delta contents %+v", snap.Delta)
+	}
+	if removed := snap.Delta.Removed[0].(map[string]any); removed["name"] != "b" {
+		t.Fatalf("expected row b removed, got %v", removed)
+	}
+
+	svc.snapshot = &refresh.Snapshot{
+		SourceVersion: "v3",
+		Payload: map[string]any{
+			"rows": []row{rowFor("d", "Pending"), rowFor("a", "Running"), rowFor("c", "Failed")},
+		},
+	}
+	if snap := get("v2"); snap.Delta == nil || len(snap.Delta.Upserted) != 0 || len(snap.Delta.Order) != 3 {
+		t.Fatalf("expected order-only delta, got %+v", snap.Delta)
+	}
+
+	if snap := get("unknown"); snap.Delta != nil {
+		t.Fatal("expected full snapshot for an unknown since version")
+	}
+}
+
+func TestSnapshotEndpointEncodesCBORWhenAccepted(t *testing.T) {
+	svc := &fakeSnapshotService{snapshot: &refresh.Snapshot{
+		SourceVersion: "v1",
+		Payload: map[string]any{
+			"rows": []map[string]any{{"ref": map[string]string{"name": "a"}, "status": "Running"}},
+		},
+	}}
+	server := api.NewServer(svc, &fakeQueue{}, nil, nil)
+	mux := http.NewServeMux()
+	server.Register(mux)
+
+	get := func(since string) (*httptest.ResponseRecorder, map[string]any) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/snapshots/pods?scope=cluster-a|&since="+since, nil)
+		req.Header.Set("Accept", "application/cbor, application/json;q=0.9")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("expected status 200 got %d", rr.Code)
+		}
+		if got := rr.Header().Get("Content-Type"); got != "application/cbor" {
+			t.Fatalf("expected cbor content type, got %q", got)
+		}
+		var body map[string]any
+		if err := cbor.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+			t.Fatalf("failed to decode cbor body: %v", err)
+		}
+		return rr, body
+	}
+
+	_, body := get("")
+	if body["domain"] != "pods" || body["sourceVersion"] != "v1" {
+		t.Fatalf("unexpected cbor snapshot %v", body)
+	}
+
+	svc.snapshot = &refresh.Snapshot{
+		SourceVersion: "v2",
+		Payload: map[string]any{
+			"rows": []map[string]any{{"ref": map[string]string{"name": "a"}, "status": "Failed"}},
+		},
+	}
+	_, body = get("v1")
+	delta, ok := body["delta"].(map[any]any)
+	if !ok {
+		t.Fatalf("expected a cbor delta, got %v", body)
+	}
+	if delta["since"] != "v1" || len(delta["upserted"].([]any)) != 1 {
+		t.Fatalf("unexpected cbor delta %v", delta)
+	}
+}
+
 func TestSnapshotPermissionDenied(t *testing.T) {
 	svc := &errorSnapshotService{
 		err: refresh.NewPermissionDeniedError("nodes", "core/nodes"),
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/wire"
+)
+
+// deltaRowFields lists the payload fields that can hold delta-capable rows.
+var deltaRowFields = []string{"rows", "resources"}
+
+// snapshotDeltaCache keeps the rows of recent snapshot versions so repeat
+// requests that name a since version can be answered with only the changes.
+type snapshotDeltaCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type snapshotDeltaEntry struct {
+	key      string
+	versions []*snapshotRowsVersion
+}
+
+// snapshotRowsVersion is one snapshot version split into its rows. Rows and
+// refs stay encoded in the format of the request that produced them.
+type snapshotRowsVersion struct {
+	validator string
+	rowsField string
+	keys      []string
+	rows      map[string][]byte
+	refs      map[string][]byte
+}
+
+func newSnapshotDeltaCache() *snapshotDeltaCache {
+	return &snapshotDeltaCache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// prepare returns the snapshot to send. The payload is encoded once here; when
+// since names a version still held for the domain, scope and format, the
+// returned snapshot carries a delta instead of the full row list. The current
+// version is always recorded for later requests.
+func (c *snapshotDeltaCache) prepare(snapshot *refresh.Snapshot, validator, since string, format wire.Format) (*refresh.Snapshot, error) {
+	raw, err := wire.Marshal(format, snapshot.Payload)
+	if err != nil {
+		return nil, err
+	}
+	out := *snapshot
+	out.Payload = wire.RawValue(format, raw)
+	if validator == "" {
+		return &out, nil
+	}
+
+	fields, current := splitSnapshotRows(format, raw, validator)
+	if current == nil {
+		return &out, nil
+	}
+
+	key := snapshot.Domain + "|" + snapshot.Scope + "|" + format.ContentType()
+	previous := c.record(key, current, since)
+	if previous == nil || previous.rowsField != current.rowsField || since == validator {
+		return &out, nil
+	}
+
+	delete(fields, current.rowsField)
+	payload := make(map[string]interface{}, len(fields))
+	for field, value := range fields {
+		payload[field] = wire.RawValue(format, value)
+	}
+	out.Payload = payload
+	out.Delta = buildSnapshotDelta(format, previous, current)
+	return &out, nil
+}
+
+// record stores current for key and returns the held version matching since.
+func (c *snapshotDeltaCache) record(key string, current *snapshotRowsVersion, since string) *snapshotRowsVersion {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var entry *snapshotDeltaEntry
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		entry = elem.Value.(*snapshotDeltaEntry)
+	} else {
+		entry = &snapshotDeltaEntry{key: key}
+		c.entries[key] = c.lru.PushFront(entry)
+		for c.lru.Len() > config.SnapshotDeltaMaxScopes {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.entries, oldest.Value.(*snapshotDeltaEntry).key)
+		}
+	}
+
+	var previous *snapshotRowsVersion
+	if since != "" {
+		for _, version := range entry.versions {
+			if version.validator == since {
+				previous = version
+				break
+			}
+		}
+	}
+
+	kept := entry.versions[:0]
+	for _, version := range entry.versions {
+		if version.validator != current.validator {
+			kept = append(kept, version)
+		}
+	}
+	kept = append(kept, current)
+	if len(kept) > config.SnapshotDeltaVersionsPerScope {
+		kept = kept[len(kept)-config.SnapshotDeltaVersionsPerScope:]
+	}
+	entry.versions = kept
+	return previous
+}
+
+// splitSnapshotRows decodes an encoded payload into its top-level fields and
+// its rows keyed by ref. It returns a nil version when the payload has no row
+// list, or when any row lacks a ref or shares one with another row.
+func splitSnapshotRows(format wire.Format, raw []byte, validator string) (map[string][]byte, *snapshotRowsVersion) {
+	fields, err := wire.SplitObject(format, raw)
+	if err != nil {
+		return nil, nil
+	}
+	for _, field := range deltaRowFields {
+		rawRows, ok := fields[field]
+		if !ok {
+			continue
+		}
+		rows, err := wire.SplitArray(format, rawRows)
+		if err != nil {
+			return nil, nil
+		}
+		version := &snapshotRowsVersion{
+			validator: validator,
+			rowsField: field,
+			keys:      make([]string, 0, len(rows)),
+			rows:      make(map[string][]byte, len(rows)),
+			refs:      make(map[string][]byte, len(rows)),
+		}
+		for _, row := range rows {
+			rowFields, err := wire.SplitObject(format, row)
+			if err != nil {
+				return nil, nil
+			}
+			ref := rowFields["ref"]
+			if len(ref) == 0 || wire.IsNull(format, ref) {
+				return nil, nil
+			}
+			key := string(ref)
+			if _, dup := version.rows[key]; dup {
+				return nil, nil
+			}
+			version.keys = append(version.keys, key)
+			version.rows[key] = row
+			version.refs[key] = ref
+		}
+		return fields, version
+	}
+	return nil, nil
+}
+
+// buildSnapshotDelta lists the rows that changed between previous and current.
+// Order is included only when applying the delta in the default way (kept rows
+// in their old order, then new rows) would not reproduce the current order.
+func buildSnapshotDelta(format wire.Format, previous, current *snapshotRowsVersion) *refresh.SnapshotDelta {
+	delta := &refresh.SnapshotDelta{
+		Since:     previous.validator,
+		RowsField: current.rowsField,
+		Upserted:  []interface{}{},
+		Removed:   []interface{}{},
+	}
+
+	for _, key := range previous.keys {
+		if _, ok := current.rows[key]; !ok {
+			delta.Removed = append(delta.Removed, wire.RawValue(format, previous.refs[key]))
+		}
+	}
+
+	expected := make([]string, 0, len(current.keys))
+	for _, key := range previous.keys {
+		if _, ok := current.rows[key]; ok {
+			expected = append(expected, key)
+		}
+	}
+	for _, key := range current.keys {
+		prior, existed := previous.rows[key]
+		if !existed {
+			expected = append(expected, key)
+		}
+		if !existed || !bytes.Equal(prior, current.rows[key]) {
+			delta.Upserted = append(delta.Upserted, wire.RawValue(format, current.rows[key]))
+		}
+	}
+
+	for i, key := range current.keys {
+		if expected[i] != key {
+			delta.Order = make([]interface{}, 0, len(current.keys))
+			for _, key := range current.keys {
+				delta.Order = append(delta.Order, wire.RawValue(format, current.refs[key]))
+			}
+			break
+		}
+	}
+	return delta
+}
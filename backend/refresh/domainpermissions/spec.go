@@ -396,6 +396,30 @@ var policySpecs = []policySpec{
 		Runtime: []Resource{fromIdentity(nodes.Identity)},
 		Stream:  []Resource{fromIdentity(nodes.Identity)},
 	},
+	{
+		// Bins are nodes and items are pods; neither half means anything alone.
+		Domain: "cluster-node-packing",
+		Mode:   ModeAll,
+		Reason: "Node packing requires list and watch on nodes and pods",
+		Runtime: []Resource{
+			fromIdentity(nodes.Identity),
+			fromIdentity(pods.Identity),
+		},
+	},
+	{
+		// Requests, limits and pod counts come from pods; the other object counts
+		// are best-effort and count nothing for kinds the identity cannot list.
+		Domain:  "cluster-namespace-usage",
+		Mode:    ModeAll,
+		Reason:  "Namespace usage requires list and watch on pods",
+		Runtime: []Resource{fromIdentity(pods.Identity)},
+	},
+	{
+		Domain:  "cluster-problem-pods",
+		Mode:    ModeAll,
+		Reason:  "Problem pods requires list and watch on pods",
+		Runtime: []Resource{fromIdentity(pods.Identity)},
+	},
 	{
 		// ModeAny: the overview stays useful for identities without node access
 		// (issue #244 — the standard view role has pods+namespaces but no nodes).
@@ -457,6 +481,14 @@ var policySpecs = []policySpec{
 			fromIdentity(admissionpkg.MutatingIdentity),
 		},
 	},
+	{
+		Domain: "cluster-policy-reports",
+		Mode:   ModeAll,
+		Runtime: []Resource{
+			{Group: "wgpolicyk8s.io", Version: "v1alpha2", Kind: "PolicyReport", Resource: "policyreports"},
+			fromIdentity(apiextensionspkg.Identity),
+		},
+	},
 	{
 		Domain:  "cluster-crds",
 		Mode:    ModeAll,
@@ -480,43 +512,65 @@ var policySpecs = []policySpec{
 		Runtime: []Resource{fromIdentity(events.Identity)},
 	},
 	{
-		Domain: "object-map",
+		Domain:  "object-map",
+		Mode:    ModeAny,
+		Reason:  "object map resources",
+		Runtime: objectMapResources,
+	},
+	{
+		Domain:  "object-references",
+		Mode:    ModeAny,
+		Reason:  "object map resources",
+		Runtime: objectMapResources,
+	},
+	{
+		Domain: "object-storage-chain",
 		Mode:   ModeAny,
-		Reason: "object map resources",
-		Runtime: resources(
-			[]Resource{
-				fromIdentity(pods.Identity),
-				fromIdentity(service.Identity),
-				fromIdentity(endpointslice.Identity),
-				fromIdentity(persistentvolumeclaim.Identity),
-				fromIdentity(persistentvolume.Identity),
-				fromIdentity(storageclass.Identity),
-				fromIdentity(configmap.Identity),
-				fromIdentity(secretpkg.Identity),
-				fromIdentity(serviceaccount.Identity),
-				fromIdentity(nodes.Identity),
-			},
-			[]Resource{
-				fromIdentity(deployment.Identity),
-				fromIdentity(replicaset.Identity),
-				fromIdentity(statefulset.Identity),
-				fromIdentity(daemonset.Identity),
-				fromIdentity(job.Identity),
-				fromIdentity(cronjob.Identity),
-				fromIdentity(hpa.IdentityV1),
-				fromIdentity(ingress.Identity),
-				fromIdentity(ingressclass.Identity),
-			},
-			[]Resource{
-				fromIdentity(gatewayclass.Identity),
-				fromIdentity(gatewaypkg.Identity),
-				fromIdentity(httproute.Identity),
-				fromIdentity(grpcroute.Identity),
-				fromIdentity(tlsroute.Identity),
-				fromIdentity(listenerset.Identity),
-				fromIdentity(referencegrant.Identity),
-				fromIdentity(backendtlspolicy.Identity),
-			},
-		),
+		Reason: "storage chain resources",
+		Runtime: resources([]Resource{
+			fromIdentity(persistentvolumeclaim.Identity),
+			fromIdentity(persistentvolume.Identity),
+			fromIdentity(storageclass.Identity),
+			fromIdentity(pods.Identity),
+			fromIdentity(nodes.Identity),
+		}),
 	},
 }
+
+// objectMapResources are the kinds the object map and object-references
+// domains read; either domain is useful with any subset of them.
+var objectMapResources = resources(
+	[]Resource{
+		fromIdentity(pods.Identity),
+		fromIdentity(service.Identity),
+		fromIdentity(endpointslice.Identity),
+		fromIdentity(persistentvolumeclaim.Identity),
+		fromIdentity(persistentvolume.Identity),
+		fromIdentity(storageclass.Identity),
+		fromIdentity(configmap.Identity),
+		fromIdentity(secretpkg.Identity),
+		fromIdentity(serviceaccount.Identity),
+		fromIdentity(nodes.Identity),
+	},
+	[]Resource{
+		fromIdentity(deployment.Identity),
+		fromIdentity(replicaset.Identity),
+		fromIdentity(statefulset.Identity),
+		fromIdentity(daemonset.Identity),
+		fromIdentity(job.Identity),
+		fromIdentity(cronjob.Identity),
+		fromIdentity(hpa.IdentityV1),
+		fromIdentity(ingress.Identity),
+		fromIdentity(ingressclass.Identity),
+	},
+	[]Resource{
+		fromIdentity(gatewayclass.Identity),
+		fromIdentity(gatewaypkg.Identity),
+		fromIdentity(httproute.Identity),
+		fromIdentity(grpcroute.Identity),
+		fromIdentity(tlsroute.Identity),
+		fromIdentity(listenerset.Identity),
+		fromIdentity(referencegrant.Identity),
+		fromIdentity(backendtlspolicy.Identity),
+	},
+)
@@ -33,6 +33,10 @@ type Manager struct {
 	nextID         uint64
 	telemetry      *telemetry.Recorder
 	signalObserver func(scope string, sequence uint64)
+	lagObserver    func(scope string, changedAt time.Time)
+
+	recurringMu sync.Mutex
+	recurring   *recurringEvents
 }
 
 type bufferedEvent struct {
@@ -68,9 +72,19 @@ func NewManager(
 		telemetry:   recorder,
 	}
 
-	informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    m.handleEvent,
-		UpdateFunc: func(_, newObj interface{}) { m.handleEvent(newObj) },
+	informer.Informer().AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			m.handleEvent(obj)
+			if !isInInitialList {
+				m.observeLag(obj)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			m.handleEvent(newObj)
+			if !sameResourceVersion(oldObj, newObj) {
+				m.observeLag(newObj)
+			}
+		},
 	})
 
 	return m
@@ -85,6 +99,19 @@ func (m *Manager) SetSignalObserver(observer func(scope string, sequence uint64)
 	m.signalObserver = observer
 }
 
+// SetLagObserver installs the callback told, for each event the watch
+// delivers, when the event last happened. Initial-list adds and relist
+// updates that change nothing are skipped: their timestamps are old by
+// nature, not because the watch fell behind.
+func (m *Manager) SetLagObserver(observer func(scope string, changedAt time.Time)) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lagObserver = observer
+}
+
 // Subscribe returns a channel that receives events for the provided scope.
 // Supported scopes: "cluster" for cluster-wide events, or "namespace:<name>" for namespace events.
 // Returns nil channel and no-op cancel if subscriber limit is reached for the scope.
@@ -250,6 +277,14 @@ func (m *Manager) handleEvent(obj interface{}) {
 	entry.CreatedAt = lastSeen.UnixMilli()
 	entry.Age = timeutil.FormatAge(lastSeen)
 
+	entry.AggregateKey = eventres.AggregateKey(entry.ObjectAPIVersion, entry.ObjectNamespace, entry.Object, entry.ObjectUID, entry.Type, entry.Reason)
+	m.recurringMu.Lock()
+	if m.recurring == nil {
+		m.recurring = newRecurringEvents()
+	}
+	entry.Aggregate = m.recurring.record(entry.AggregateKey, evt.Namespace+"/"+evt.Name, eventres.BuildAggregate(evt))
+	m.recurringMu.Unlock()
+
 	if entry.ObjectNamespace == "" {
 		m.broadcast("cluster", entry)
 	}
@@ -258,6 +293,31 @@ func (m *Manager) handleEvent(obj interface{}) {
 	}
 }
 
+func (m *Manager) observeLag(obj interface{}) {
+	evt, ok := obj.(*corev1.Event)
+	if !ok || evt == nil {
+		return
+	}
+	m.mu.RLock()
+	observer := m.lagObserver
+	m.mu.RUnlock()
+	changedAt := timeutil.LatestEventTimestamp(evt)
+	if observer == nil || changedAt.IsZero() {
+		return
+	}
+	scope := "cluster"
+	if evt.InvolvedObject.Namespace != "" {
+		scope = "namespace:" + evt.InvolvedObject.Namespace
+	}
+	observer(scope, changedAt)
+}
+
+func sameResourceVersion(oldObj, newObj interface{}) bool {
+	oldEvt, oldOK := oldObj.(*corev1.Event)
+	newEvt, newOK := newObj.(*corev1.Event)
+	return oldOK && newOK && oldEvt != nil && newEvt != nil && oldEvt.ResourceVersion == newEvt.ResourceVersion
+}
+
 func (m *Manager) broadcast(scope string, entry Entry) {
 	m.mu.Lock()
 	subscribers := m.subscribers[scope]
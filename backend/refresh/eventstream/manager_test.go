@@ -211,6 +211,40 @@ func TestManagerNotifiesSignalObserverWithoutSSESubscribers(t *testing.T) {
 	}
 }
 
+func TestManagerObservesEventLagByScope(t *testing.T) {
+	client := fake.NewClientset()
+	factory := informers.NewSharedInformerFactory(client, 0)
+	manager := NewManager(factory.Core().V1().Events(), applog.Noop, telemetry.NewRecorder(), "cluster-a")
+
+	seenAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	observed := map[string]time.Time{}
+	manager.SetLagObserver(func(scope string, changedAt time.Time) {
+		observed[scope] = changedAt
+	})
+
+	manager.observeLag(&corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web", Namespace: "default"},
+		LastTimestamp:  metav1.NewTime(seenAt),
+	})
+	manager.observeLag(&corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "Node", Name: "node-a"},
+		EventTime:      metav1.NewMicroTime(seenAt),
+	})
+	manager.observeLag(&corev1.Event{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "undated", Namespace: "other"}})
+
+	if len(observed) != 2 || !observed["namespace:default"].Equal(seenAt) || !observed["cluster"].Equal(seenAt) {
+		t.Fatalf("expected dated events observed per scope, got %+v", observed)
+	}
+
+	unchanged := &corev1.Event{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "5"}}
+	if !sameResourceVersion(unchanged, unchanged.DeepCopy()) {
+		t.Fatal("relisted events with the same resource version should be skipped")
+	}
+	if sameResourceVersion(unchanged, &corev1.Event{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "6"}}) {
+		t.Fatal("a new resource version is a real change")
+	}
+}
+
 func TestManagerEvictsResumeBufferWhenLastSubscriberCancels(t *testing.T) {
 	manager := &Manager{
 		logger:      applog.Noop,
@@ -368,3 +402,54 @@ func TestBroadcastRecordsDeliveryPerScope(t *testing.T) {
 		t.Fatalf("expected >=1 delivered for scope namespace:demo, got %d", got.TotalMessages)
 	}
 }
+
+func TestManagerAggregatesRecurringEventsAcrossEventObjects(t *testing.T) {
+	manager := &Manager{
+		logger:      applog.Noop,
+		subscribers: make(map[string]map[uint64]*subscription),
+		buffers:     make(map[string]*eventBuffer),
+		sequences:   make(map[string]uint64),
+	}
+	ch, cancel := manager.Subscribe("namespace:default")
+	defer cancel()
+
+	base := time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC)
+	backoff := func(name string, count int32, last time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web-123", Namespace: "default", UID: "pod-uid"},
+			Type:           "Warning",
+			Reason:         "BackOff",
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(base),
+			LastTimestamp:  metav1.NewTime(base.Add(last)),
+		}
+	}
+
+	manager.handleEvent(backoff("backoff-a", 3, 2*time.Minute))
+	// An informer update re-delivers backoff-a with its cumulative count.
+	manager.handleEvent(backoff("backoff-a", 5, 4*time.Minute))
+	manager.handleEvent(backoff("backoff-b", 3, 6*time.Minute))
+
+	var last Entry
+	for i := 0; i < 3; i++ {
+		select {
+		case streamEvent := <-ch:
+			last = streamEvent.Entry
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		}
+	}
+	if last.Name != "backoff-b" || last.AggregateKey == "" {
+		t.Fatalf("unexpected entry: %+v", last)
+	}
+	if last.Count != 8 {
+		t.Fatalf("expected the group count to replace backoff-a's earlier delivery, got %d", last.Count)
+	}
+	if last.FirstSeen != base.UnixMilli() || last.LastSeen != base.Add(6*time.Minute).UnixMilli() {
+		t.Fatalf("unexpected seen window: %+v", last.Aggregate)
+	}
+	if last.RatePerMinute != 1.33 {
+		t.Fatalf("expected 8 events over 6 minutes, got rate %v", last.RatePerMinute)
+	}
+}
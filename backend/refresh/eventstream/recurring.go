@@ -0,0 +1,78 @@
+package eventstream
+
+import (
+	"github.com/luxury-yacht/app/backend/internal/config"
+	eventres "github.com/luxury-yacht/app/backend/resources/events"
+)
+
+// recurringMemberLimit caps the Event objects tracked per group; older members
+// are folded into the group's retired total.
+const recurringMemberLimit = 32
+
+// recurringGroup is the running aggregate for one involved object, type and
+// reason. Members are keyed by Event so an informer update of an Event already
+// seen replaces its contribution instead of counting it twice.
+type recurringGroup struct {
+	members  map[string]eventres.Aggregate
+	retired  eventres.Aggregate
+	lastSeen int64
+}
+
+func (g *recurringGroup) total() eventres.Aggregate {
+	total := g.retired
+	for _, member := range g.members {
+		total = total.Merge(member)
+	}
+	return total
+}
+
+// recurringEvents tracks recurring-event groups for the stream so each
+// delivered Entry carries the counts of every Event in its group.
+type recurringEvents struct {
+	groups map[string]*recurringGroup
+	limit  int
+}
+
+func newRecurringEvents() *recurringEvents {
+	return &recurringEvents{
+		groups: make(map[string]*recurringGroup),
+		limit:  config.EventStreamRecurringGroupLimit,
+	}
+}
+
+// record stores one Event's own aggregate under its group and returns the
+// group's total.
+func (r *recurringEvents) record(groupKey, eventKey string, aggregate eventres.Aggregate) eventres.Aggregate {
+	group, ok := r.groups[groupKey]
+	if !ok {
+		if len(r.groups) >= r.limit {
+			r.evictLeastRecent()
+		}
+		group = &recurringGroup{members: make(map[string]eventres.Aggregate)}
+		r.groups[groupKey] = group
+	}
+	group.members[eventKey] = aggregate
+	group.lastSeen = max(group.lastSeen, aggregate.LastSeen)
+	if len(group.members) > recurringMemberLimit {
+		oldestKey := ""
+		for key, member := range group.members {
+			if oldestKey == "" || member.LastSeen < group.members[oldestKey].LastSeen {
+				oldestKey = key
+			}
+		}
+		group.retired = group.retired.Merge(group.members[oldestKey])
+		delete(group.members, oldestKey)
+	}
+	return group.total()
+}
+
+func (r *recurringEvents) evictLeastRecent() {
+	oldestKey := ""
+	var oldestSeen int64
+	for key, group := range r.groups {
+		if oldestKey == "" || group.lastSeen < oldestSeen {
+			oldestKey, oldestSeen = key, group.lastSeen
+		}
+	}
+	delete(r.groups, oldestKey)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
+	eventres "github.com/luxury-yacht/app/backend/resources/events"
 )
 
 // Logger represents the minimal interface required for streaming telemetry,
@@ -33,6 +34,11 @@ type Entry struct {
 	Message          string                      `json:"message"`
 	Age              string                      `json:"age"`
 	CreatedAt        int64                       `json:"createdAt"`
+	// AggregateKey identifies the recurring-event group (same involved
+	// object, type and reason) so subscribers can replace the group's row;
+	// Aggregate carries the counts of every Event seen in that group.
+	AggregateKey string `json:"aggregateKey"`
+	eventres.Aggregate
 }
 
 // StreamEvent wraps an Entry with its stream sequence identifier.
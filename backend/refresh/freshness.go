@@ -0,0 +1,196 @@
+package refresh
+
+import (
+	"sync"
+	"time"
+)
+
+// Reasons reported in DataFreshness.DegradedReason.
+const (
+	// FreshnessReasonConnectivity means the cluster API server is unreachable.
+	FreshnessReasonConnectivity = "connectivity"
+	// FreshnessReasonAuth means the cluster rejects the current credentials.
+	FreshnessReasonAuth = "auth"
+	// FreshnessReasonFrozen means the cluster's informers were stopped to save
+	// memory and its last data is served from the frozen stores.
+	FreshnessReasonFrozen = "frozen"
+)
+
+// DataFreshness tells clients how current the data behind a snapshot or
+// stream subscription is, so stale rows can be flagged instead of shown as live.
+type DataFreshness struct {
+	// LastSyncedAt is when the data was last known to match the cluster, in
+	// Unix milliseconds. While the connection is healthy this is the time the
+	// freshness was read; while degraded it is the last time the cluster
+	// answered.
+	LastSyncedAt int64 `json:"lastSyncedAt,omitempty"`
+	// InformersSynced reports whether the informers backing the domain have
+	// completed their initial list.
+	InformersSynced bool `json:"informersSynced"`
+	// Degraded is set while the data is not being kept up to date, such as
+	// when the cluster connection is failing. Informer caches keep serving
+	// their last contents, which may be out of date.
+	Degraded bool `json:"degraded"`
+	// DegradedReason is one of the FreshnessReason values while Degraded is set.
+	DegradedReason string `json:"degradedReason,omitempty"`
+	// Offline is set while the cluster cannot be reached or its credentials
+	// are recovering. The last captured state keeps being served, and
+	// LastSyncedAt is when it was captured.
+	Offline bool `json:"offline,omitempty"`
+	// LastEventAt is when a watch event for the domain was last received, in
+	// Unix milliseconds; zero until the first one arrives.
+	LastEventAt int64 `json:"lastEventAt,omitempty"`
+	// LastListAt is when a snapshot of the domain was last built from the
+	// informer caches, in Unix milliseconds; zero until the first build.
+	LastListAt int64 `json:"lastListAt,omitempty"`
+	// BehindMs estimates how far the domain's data trails the cluster. While
+	// degraded it is the time since the cluster last answered; otherwise it
+	// is the delivery delay of the most recent timestamped watch event. Zero
+	// means live.
+	BehindMs int64 `json:"behindMs,omitempty"`
+}
+
+// FreshnessTracker records whether a cluster's connection is healthy and
+// when it last was. Heartbeats and informer watch failures feed it. It also
+// records, per domain, when a watch event last arrived, how late it was, and
+// when a snapshot was last built.
+type FreshnessTracker struct {
+	mu            sync.Mutex
+	lastHealthyAt time.Time
+	degraded      bool
+	reason        string
+	domains       map[string]*domainActivity
+	now           func() time.Time
+}
+
+type domainActivity struct {
+	lastEventAt time.Time
+	lastListAt  time.Time
+	watchLag    time.Duration
+}
+
+// NewFreshnessTracker returns a tracker for a cluster that was just reached.
+func NewFreshnessTracker() *FreshnessTracker {
+	return &FreshnessTracker{lastHealthyAt: time.Now(), domains: map[string]*domainActivity{}, now: time.Now}
+}
+
+// MarkHealthy records that the cluster answered.
+func (t *FreshnessTracker) MarkHealthy() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastHealthyAt = t.now()
+	t.degraded = false
+	t.reason = ""
+}
+
+// MarkDegraded records that the cluster stopped answering for reason.
+func (t *FreshnessTracker) MarkDegraded(reason string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.degraded {
+		// Data was current up to the moment the failure was noticed.
+		t.lastHealthyAt = t.now()
+	}
+	t.degraded = true
+	t.reason = reason
+}
+
+// RecordEvent records that a watch event for domain was received.
+func (t *FreshnessTracker) RecordEvent(domain string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.activityLocked(domain).lastEventAt = t.now()
+}
+
+// RecordWatchLag records a watch event for domain whose change happened at
+// changedAt, so the delay between the change and its delivery becomes the
+// domain's watch lag. Clock skew that puts changedAt in the future counts as
+// no lag.
+func (t *FreshnessTracker) RecordWatchLag(domain string, changedAt time.Time) {
+	if t == nil || changedAt.IsZero() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	activity := t.activityLocked(domain)
+	activity.lastEventAt = t.now()
+	activity.watchLag = max(activity.lastEventAt.Sub(changedAt), 0)
+}
+
+// RecordList records that a snapshot of domain was built successfully.
+func (t *FreshnessTracker) RecordList(domain string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.activityLocked(domain).lastListAt = t.now()
+}
+
+func (t *FreshnessTracker) activityLocked(domain string) *domainActivity {
+	if t.domains == nil {
+		t.domains = map[string]*domainActivity{}
+	}
+	activity := t.domains[domain]
+	if activity == nil {
+		activity = &domainActivity{}
+		t.domains[domain] = activity
+	}
+	return activity
+}
+
+// Freshness returns the current freshness for data whose informers are in the
+// given sync state. A nil tracker reports a healthy connection.
+func (t *FreshnessTracker) Freshness(informersSynced bool) DataFreshness {
+	if t == nil {
+		return DataFreshness{LastSyncedAt: time.Now().UnixMilli(), InformersSynced: informersSynced}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	freshness := DataFreshness{
+		InformersSynced: informersSynced,
+		Degraded:        t.degraded,
+		DegradedReason:  t.reason,
+		LastSyncedAt:    t.now().UnixMilli(),
+	}
+	if t.degraded {
+		freshness.LastSyncedAt = t.lastHealthyAt.UnixMilli()
+		freshness.Offline = t.reason == FreshnessReasonConnectivity || t.reason == FreshnessReasonAuth
+		freshness.BehindMs = max(t.now().Sub(t.lastHealthyAt).Milliseconds(), 0)
+	}
+	return freshness
+}
+
+// DomainFreshness returns Freshness for one domain, adding when its last watch
+// event and snapshot build happened and how far behind its data is.
+func (t *FreshnessTracker) DomainFreshness(domain string, informersSynced bool) DataFreshness {
+	freshness := t.Freshness(informersSynced)
+	if t == nil {
+		return freshness
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	activity := t.domains[domain]
+	if activity == nil {
+		return freshness
+	}
+	if !activity.lastEventAt.IsZero() {
+		freshness.LastEventAt = activity.lastEventAt.UnixMilli()
+	}
+	if !activity.lastListAt.IsZero() {
+		freshness.LastListAt = activity.lastListAt.UnixMilli()
+	}
+	if !freshness.Degraded {
+		freshness.BehindMs = activity.watchLag.Milliseconds()
+	}
+	return freshness
+}
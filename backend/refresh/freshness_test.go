@@ -0,0 +1,79 @@
+package refresh
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFreshnessTrackerReportsLastHealthyTimeWhileDegraded(t *testing.T) {
+	clock := time.UnixMilli(1_000)
+	tracker := NewFreshnessTracker()
+	tracker.now = func() time.Time { return clock }
+
+	tracker.MarkHealthy()
+	clock = time.UnixMilli(5_000)
+	if got := tracker.Freshness(true); got.Degraded || got.LastSyncedAt != 5_000 || !got.InformersSynced {
+		t.Fatalf("healthy freshness should be current, got %+v", got)
+	}
+
+	tracker.MarkDegraded("connectivity")
+	clock = time.UnixMilli(9_000)
+	tracker.MarkDegraded("connectivity")
+	got := tracker.Freshness(true)
+	if !got.Degraded || got.DegradedReason != "connectivity" || got.LastSyncedAt != 5_000 || !got.Offline {
+		t.Fatalf("degraded freshness should keep the first failure time, got %+v", got)
+	}
+
+	tracker.MarkHealthy()
+	if got := tracker.Freshness(false); got.Degraded || got.DegradedReason != "" || got.LastSyncedAt != 9_000 || got.InformersSynced || got.Offline {
+		t.Fatalf("recovered freshness should clear the degraded state, got %+v", got)
+	}
+
+	// Frozen data is stale by choice; the cluster is still reachable.
+	tracker.MarkDegraded(FreshnessReasonFrozen)
+	if got := tracker.Freshness(true); !got.Degraded || got.Offline {
+		t.Fatalf("frozen freshness should not be offline, got %+v", got)
+	}
+}
+
+func TestNilFreshnessTrackerReportsHealthy(t *testing.T) {
+	var tracker *FreshnessTracker
+	tracker.MarkDegraded("auth")
+	if got := tracker.Freshness(true); got.Degraded || got.LastSyncedAt == 0 {
+		t.Fatalf("nil tracker should report healthy, got %+v", got)
+	}
+}
+
+func TestFreshnessTrackerReportsDomainActivityAndWatchLag(t *testing.T) {
+	clock := time.UnixMilli(100_000)
+	tracker := NewFreshnessTracker()
+	tracker.now = func() time.Time { return clock }
+
+	if got := tracker.DomainFreshness("pods", true); got.LastEventAt != 0 || got.LastListAt != 0 || got.BehindMs != 0 {
+		t.Fatalf("untouched domain should report no activity, got %+v", got)
+	}
+
+	tracker.RecordList("pods")
+	clock = time.UnixMilli(102_000)
+	tracker.RecordEvent("pods")
+	tracker.RecordWatchLag("cluster-events", time.UnixMilli(57_000))
+	got := tracker.DomainFreshness("pods", true)
+	if got.LastListAt != 100_000 || got.LastEventAt != 102_000 || got.BehindMs != 0 {
+		t.Fatalf("pods should be live with its list and event times, got %+v", got)
+	}
+	if got := tracker.DomainFreshness("cluster-events", true); got.BehindMs != 45_000 || got.LastEventAt != 102_000 {
+		t.Fatalf("events should be 45s behind, got %+v", got)
+	}
+
+	// A change stamped ahead of the local clock is skew, not negative lag.
+	tracker.RecordWatchLag("cluster-events", time.UnixMilli(110_000))
+	if got := tracker.DomainFreshness("cluster-events", true); got.BehindMs != 0 {
+		t.Fatalf("future timestamps should clamp to live, got %+v", got)
+	}
+
+	tracker.MarkDegraded(FreshnessReasonConnectivity)
+	clock = time.UnixMilli(132_000)
+	if got := tracker.DomainFreshness("pods", true); !got.Degraded || got.BehindMs != 30_000 {
+		t.Fatalf("degraded domain should trail by the time since the cluster answered, got %+v", got)
+	}
+}
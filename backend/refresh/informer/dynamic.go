@@ -0,0 +1,187 @@
+package informer
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DynamicInformers shares one dynamic informer per custom resource kind and
+// namespace between every consumer that watches it: the resource stream's
+// custom domains and the catalog's promoted reflectors. Without it each
+// consumer opened its own watch for the same kind. Informers are reference
+// counted: the first Acquire starts one, the last release stops it, and
+// Shutdown stops them all. Followers ride along on whatever is running
+// without keeping anything alive.
+type DynamicInformers struct {
+	client dynamic.Interface
+
+	mu         sync.Mutex
+	entries    map[dynamicInformerKey]*sharedDynamicInformer
+	followers  map[dynamicInformerKey]map[int]func(cache.SharedIndexInformer)
+	nextFollow int
+	stopped    bool
+}
+
+type dynamicInformerKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+type sharedDynamicInformer struct {
+	informer cache.SharedIndexInformer
+	refs     int
+	stopCh   chan struct{}
+}
+
+// NewDynamicInformers returns a registry over client; a nil client yields a
+// nil registry, on which Acquire always fails.
+func NewDynamicInformers(client dynamic.Interface) *DynamicInformers {
+	if client == nil {
+		return nil
+	}
+	return &DynamicInformers{
+		client:    client,
+		entries:   make(map[dynamicInformerKey]*sharedDynamicInformer),
+		followers: make(map[dynamicInformerKey]map[int]func(cache.SharedIndexInformer)),
+	}
+}
+
+// Acquire returns the running informer for gvr in namespace ("" = all
+// namespaces), starting it when no one else holds it, and the release func
+// that drops this holder's reference. Handlers the caller added must be
+// removed before releasing. ok is false on a nil or shut down registry.
+func (d *DynamicInformers) Acquire(gvr schema.GroupVersionResource, namespace string) (inf cache.SharedIndexInformer, release func(), ok bool) {
+	if d == nil {
+		return nil, nil, false
+	}
+	key := dynamicInformerKey{gvr: gvr, namespace: namespace}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return nil, nil, false
+	}
+	shared := d.entries[key]
+	if shared == nil {
+		dynamicInformer := dynamicinformer.NewFilteredDynamicInformer(
+			d.client,
+			gvr,
+			namespace,
+			0,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			nil,
+		)
+		// Same projection-at-intake transform as the shared factories. It can
+		// only fail once the informer has started, which this one has not.
+		_ = dynamicInformer.Informer().SetTransform(StripManagedFields)
+		shared = &sharedDynamicInformer{
+			informer: dynamicInformer.Informer(),
+			stopCh:   make(chan struct{}),
+		}
+		d.entries[key] = shared
+		go shared.informer.Run(shared.stopCh)
+		d.notifyLocked(key, shared.informer)
+	}
+	shared.refs++
+	var once sync.Once
+	release = func() {
+		once.Do(func() { d.release(key, shared) })
+	}
+	return shared.informer, release, true
+}
+
+// release drops one reference and stops the informer with the last one.
+func (d *DynamicInformers) release(key dynamicInformerKey, shared *sharedDynamicInformer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.entries[key] != shared {
+		// Already stopped by Shutdown.
+		return
+	}
+	shared.refs--
+	if shared.refs > 0 {
+		return
+	}
+	delete(d.entries, key)
+	close(shared.stopCh)
+	d.notifyLocked(key, nil)
+}
+
+// Follow registers follow for gvr in namespace without holding the informer:
+// it is called with the running informer whenever a holder starts one (and at
+// once if one is already running), and with nil when the informer stops. It
+// runs under the registry lock, so it must not block or call back into the
+// registry. unfollow drops the registration; ok is false on a nil or shut
+// down registry.
+func (d *DynamicInformers) Follow(gvr schema.GroupVersionResource, namespace string, follow func(cache.SharedIndexInformer)) (unfollow func(), ok bool) {
+	if d == nil {
+		return nil, false
+	}
+	key := dynamicInformerKey{gvr: gvr, namespace: namespace}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return nil, false
+	}
+	id := d.nextFollow
+	d.nextFollow++
+	if d.followers[key] == nil {
+		d.followers[key] = make(map[int]func(cache.SharedIndexInformer))
+	}
+	d.followers[key][id] = follow
+	if shared := d.entries[key]; shared != nil {
+		follow(shared.informer)
+	}
+	var once sync.Once
+	unfollow = func() {
+		once.Do(func() {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			delete(d.followers[key], id)
+			if len(d.followers[key]) == 0 {
+				delete(d.followers, key)
+			}
+		})
+	}
+	return unfollow, true
+}
+
+// notifyLocked tells key's followers that its informer started (inf) or
+// stopped (nil). Callers hold d.mu.
+func (d *DynamicInformers) notifyLocked(key dynamicInformerKey, inf cache.SharedIndexInformer) {
+	for _, follow := range d.followers[key] {
+		follow(inf)
+	}
+}
+
+// Holders reports how many consumers hold the informer for gvr in namespace.
+func (d *DynamicInformers) Holders(gvr schema.GroupVersionResource, namespace string) int {
+	if d == nil {
+		return 0
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if shared := d.entries[dynamicInformerKey{gvr: gvr, namespace: namespace}]; shared != nil {
+		return shared.refs
+	}
+	return 0
+}
+
+// Shutdown stops every shared informer regardless of holders and makes
+// further Acquire calls fail. Outstanding releases become no-ops.
+func (d *DynamicInformers) Shutdown() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped = true
+	for key, shared := range d.entries {
+		close(shared.stopCh)
+		delete(d.entries, key)
+		d.notifyLocked(key, nil)
+	}
+}
@@ -0,0 +1,92 @@
+package informer
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestDynamicInformersShareAndReferenceCount(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "WidgetList"})
+	shared := NewDynamicInformers(client)
+
+	first, releaseFirst, ok := shared.Acquire(gvr, "")
+	if !ok {
+		t.Fatal("expected the first acquire to succeed")
+	}
+	second, releaseSecond, _ := shared.Acquire(gvr, "")
+	if first != second {
+		t.Fatal("expected both holders to share one informer")
+	}
+	other, releaseOther, _ := shared.Acquire(gvr, "team-a")
+	if other == first {
+		t.Fatal("expected a separate informer per namespace")
+	}
+	if got := shared.Holders(gvr, ""); got != 2 {
+		t.Fatalf("expected 2 holders, got %d", got)
+	}
+
+	releaseFirst()
+	releaseFirst()
+	if got := shared.Holders(gvr, ""); got != 1 {
+		t.Fatalf("expected a repeated release to count once, got %d holders", got)
+	}
+	releaseSecond()
+	if got := shared.Holders(gvr, ""); got != 0 {
+		t.Fatalf("expected the last release to stop the informer, got %d holders", got)
+	}
+	if again, release, _ := shared.Acquire(gvr, ""); again == first {
+		t.Fatal("expected a fresh informer after the last release")
+	} else {
+		release()
+	}
+
+	shared.Shutdown()
+	releaseOther()
+	if _, _, ok := shared.Acquire(gvr, ""); ok {
+		t.Fatal("expected Acquire to fail after Shutdown")
+	}
+	if NewDynamicInformers(nil) != nil {
+		t.Fatal("expected no registry without a dynamic client")
+	}
+}
+
+func TestDynamicInformersFollowWithoutHolding(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "WidgetList"})
+	shared := NewDynamicInformers(client)
+	defer shared.Shutdown()
+
+	var seen []cache.SharedIndexInformer
+	unfollow, ok := shared.Follow(gvr, "", func(inf cache.SharedIndexInformer) {
+		seen = append(seen, inf)
+	})
+	if !ok {
+		t.Fatal("expected Follow to succeed")
+	}
+	if len(seen) != 0 || shared.Holders(gvr, "") != 0 {
+		t.Fatal("expected a follower alone neither to be notified nor to start an informer")
+	}
+
+	inf, release, _ := shared.Acquire(gvr, "")
+	if len(seen) != 1 || seen[0] != inf {
+		t.Fatal("expected the follower to get the informer a holder started")
+	}
+	release()
+	if len(seen) != 2 || seen[1] != nil {
+		t.Fatal("expected the follower to hear the informer stop with the last release")
+	}
+
+	unfollow()
+	_, release, _ = shared.Acquire(gvr, "")
+	defer release()
+	if len(seen) != 2 {
+		t.Fatal("expected no notifications after unfollow")
+	}
+}
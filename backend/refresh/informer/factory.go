@@ -2,7 +2,9 @@ package informer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +17,7 @@ import (
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextinformers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -65,6 +68,15 @@ type Factory struct {
 	// objects; this dedicated source serves the three helm consumers that still need
 	// the typed object. nil before New finishes wiring it.
 	helmStorage *HelmStorageSource
+
+	// connectivityObserver is told about watch failures that look like a lost
+	// connection to the API server (see SetConnectivityObserver).
+	connectivityObserver atomic.Pointer[func(error)]
+
+	// dynamicInformers shares custom resource informers between the resource
+	// stream and the catalog's promoted reflectors. nil without a dynamic
+	// client (see WithDynamicClient).
+	dynamicInformers *DynamicInformers
 }
 
 // informerSyncState tracks one informer's progress toward its initial sync.
@@ -81,6 +93,11 @@ type informerSyncState struct {
 	hasSynced cache.InformerSynced
 	terminal  atomic.Bool
 	degraded  atomic.Bool
+	// watchErrors counts reflector list/watch failures since registration.
+	watchErrors atomic.Uint64
+	// informer and lastEvent back cache introspection (introspection.go).
+	informer  cache.SharedIndexInformer
+	lastEvent atomic.Int64
 	// factoryGateExempt excludes this informer from the FACTORY-WIDE settle gate
 	// (cachesSettled → HasSynced/Start): events on a busy cluster is often the
 	// slowest initial LIST of any kind, and only the event domains — which declare
@@ -301,6 +318,25 @@ func (f *Factory) WithGatewayFactory(factory gatewayinformers.SharedInformerFact
 	return f
 }
 
+// WithDynamicClient enables the shared custom resource informers served by
+// DynamicInformers. A nil client leaves them disabled.
+func (f *Factory) WithDynamicClient(client dynamic.Interface) *Factory {
+	if f == nil {
+		return f
+	}
+	f.dynamicInformers = NewDynamicInformers(client)
+	return f
+}
+
+// DynamicInformers returns the shared custom resource informers, or nil when
+// no dynamic client was configured.
+func (f *Factory) DynamicInformers() *DynamicInformers {
+	if f == nil {
+		return nil
+	}
+	return f.dynamicInformers
+}
+
 // Start initialises informers for core resources and waits for their caches to sync.
 func (f *Factory) Start(ctx context.Context) error {
 	var startErr error
@@ -412,6 +448,33 @@ func isTerminalWatchError(err error) bool {
 	return apierrors.IsNotFound(err) || apierrors.IsForbidden(err)
 }
 
+// isConnectivityWatchError reports whether a reflector failure came from the
+// transport rather than the API server: any error without an API status, such
+// as a refused connection, a timeout or a dropped stream. Status errors (an
+// expired resource version, throttling, auth) mean the server answered.
+func isConnectivityWatchError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var status apierrors.APIStatus
+	return !errors.As(err, &status)
+}
+
+// SetConnectivityObserver installs a callback for informer watch failures that
+// look like a lost connection, so the app can probe cluster health at once
+// instead of waiting for the next heartbeat. It may be called after Start; the
+// callback runs on the reflector goroutine and must not block.
+func (f *Factory) SetConnectivityObserver(observer func(error)) {
+	if f == nil {
+		return
+	}
+	if observer == nil {
+		f.connectivityObserver.Store(nil)
+		return
+	}
+	f.connectivityObserver.Store(&observer)
+}
+
 // SharedInformerFactory exposes the underlying factory once started.
 func (f *Factory) SharedInformerFactory() informers.SharedInformerFactory {
 	return f.factory
@@ -473,6 +536,41 @@ func (f *Factory) ResourcesSettled(keys []string) bool {
 	return true
 }
 
+// InformerSyncStatus is one informer's progress toward its initial sync.
+type InformerSyncStatus struct {
+	Resource string `json:"resource"`
+	Synced   bool   `json:"synced"`
+	Terminal bool   `json:"terminal,omitempty"`
+	Degraded bool   `json:"degraded,omitempty"`
+	// WatchErrors counts the informer's list/watch failures so far.
+	WatchErrors uint64 `json:"watchErrors,omitempty"`
+}
+
+// SyncStatus reports every registered informer's sync state, sorted by
+// resource key, for diagnostics.
+func (f *Factory) SyncStatus() []InformerSyncStatus {
+	if f == nil {
+		return nil
+	}
+	f.syncStatesMu.Lock()
+	states := make([]*informerSyncState, len(f.syncStates))
+	copy(states, f.syncStates)
+	f.syncStatesMu.Unlock()
+
+	result := make([]InformerSyncStatus, 0, len(states))
+	for _, state := range states {
+		result = append(result, InformerSyncStatus{
+			Resource:    state.key,
+			Synced:      state.hasSynced(),
+			Terminal:    state.terminal.Load(),
+			Degraded:    state.degraded.Load(),
+			WatchErrors: state.watchErrors.Load(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Resource < result[j].Resource })
+	return result
+}
+
 // Shutdown clears factory references to allow garbage collection.
 // The informers themselves stop via context cancellation, but clearing
 // references ensures memory is reclaimed during transport rebuilds.
@@ -495,6 +593,10 @@ func (f *Factory) Shutdown() error {
 	f.permissionAllowed = nil
 	f.permissionMu.Unlock()
 
+	// Shared custom resource informers stop with the factory, whoever still
+	// holds them.
+	f.dynamicInformers.Shutdown()
+
 	// Clear factory references to allow GC
 	f.factory = nil
 	f.apiextFactory = nil
@@ -524,12 +626,20 @@ func (f *Factory) registerInformerState(group, resource string, inf cache.Shared
 		key:               permissions.ResourceKey(group, resource),
 		hasSynced:         inf.HasSynced,
 		factoryGateExempt: factoryGateExempt,
+		informer:          inf,
 	}
+	state.trackEvents(inf)
 	err := inf.SetWatchErrorHandlerWithContext(func(ctx context.Context, r *cache.Reflector, watchErr error) {
 		cache.DefaultWatchErrorHandler(ctx, r, watchErr)
+		state.watchErrors.Add(1)
 		if isTerminalWatchError(watchErr) && state.terminal.CompareAndSwap(false, true) {
 			klog.V(2).Infof("informer excluded from initial cache sync; its watch can never complete: %v", watchErr)
 		}
+		if isConnectivityWatchError(watchErr) {
+			if observer := f.connectivityObserver.Load(); observer != nil {
+				(*observer)(watchErr)
+			}
+		}
 	})
 	if err != nil {
 		// Handlers can only be set before the informer starts; a started
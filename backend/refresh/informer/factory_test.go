@@ -462,6 +462,26 @@ func TestIsTerminalWatchError(t *testing.T) {
 	}
 }
 
+func TestIsConnectivityWatchError(t *testing.T) {
+	cases := []struct {
+		name         string
+		err          error
+		connectivity bool
+	}{
+		{"plain network error", errors.New("connection refused"), true},
+		{"wrapped network error", fmt.Errorf("failed to list *v1.Pod: %w", errors.New("i/o timeout")), true},
+		{"expired resource version", apierrors.NewResourceExpired("too old"), false},
+		{"server timeout", apierrors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "list", 1), false},
+		{"canceled", context.Canceled, false},
+		{"nil", nil, false},
+	}
+	for _, tc := range cases {
+		if got := isConnectivityWatchError(tc.err); got != tc.connectivity {
+			t.Errorf("%s: isConnectivityWatchError = %v, want %v", tc.name, got, tc.connectivity)
+		}
+	}
+}
+
 func TestNewFactoryRegistersHelmStorageNotFullConfigInformers(t *testing.T) {
 	client := fake.NewClientset()
 	checker := permissions.NewCheckerWithReview("test", time.Minute, func(_ context.Context, _, _, _, _ string) (bool, error) {
@@ -574,3 +594,33 @@ func TestFactoryGateExemptInformerDoesNotBlockFactorySync(t *testing.T) {
 		t.Fatalf("core/events must NOT be settled while its informer has not synced")
 	}
 }
+
+func TestSyncStatusReportsEachInformer(t *testing.T) {
+	factory := newStartedFactory(t)
+
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "gateway.networking.k8s.io", Resource: "tlsroutes"}, "")
+	terminal := brokenInformer(notFound)
+	factory.registerInformer("gateway.networking.k8s.io", "tlsroutes", terminal)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go terminal.Run(ctx.Done())
+	if err := factory.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	statuses := map[string]InformerSyncStatus{}
+	synced := 0
+	for _, status := range factory.SyncStatus() {
+		statuses[status.Resource] = status
+		if status.Synced {
+			synced++
+		}
+	}
+	if synced == 0 {
+		t.Fatalf("expected the fake-client informers to report synced, got %+v", statuses)
+	}
+	if status := statuses["gateway.networking.k8s.io/tlsroutes"]; status.Synced || !status.Terminal {
+		t.Fatalf("expected tlsroutes to report terminal, got %+v", status)
+	}
+}
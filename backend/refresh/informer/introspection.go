@@ -0,0 +1,87 @@
+package informer
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/luxury-yacht/app/backend/internal/memsize"
+)
+
+// CacheStatus describes what one shared informer holds, for cache
+// introspection.
+type CacheStatus struct {
+	Resource string `json:"resource"`
+	Objects  int    `json:"objects"`
+	// EstimatedBytes is the cached objects' size estimated from a sample.
+	EstimatedBytes int64 `json:"estimatedBytes"`
+	// LastEvent is when the informer last saw an add, change or delete, in
+	// unix milliseconds; 0 before the first.
+	LastEvent   int64  `json:"lastEvent,omitempty"`
+	Synced      bool   `json:"synced"`
+	Terminal    bool   `json:"terminal,omitempty"`
+	Degraded    bool   `json:"degraded,omitempty"`
+	WatchErrors uint64 `json:"watchErrors,omitempty"`
+}
+
+// trackEvents stamps lastEvent on every add, change and delete. Periodic
+// resyncs redeliver unchanged objects, so updates that keep the resource
+// version are not events.
+func (s *informerSyncState) trackEvents(inf cache.SharedIndexInformer) {
+	touch := func() { s.lastEvent.Store(time.Now().UnixMilli()) }
+	_, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { touch() },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldMeta, oldErr := meta.Accessor(oldObj)
+			newMeta, newErr := meta.Accessor(newObj)
+			if oldErr == nil && newErr == nil && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			touch()
+		},
+		DeleteFunc: func(interface{}) { touch() },
+	})
+	if err != nil {
+		klog.V(2).Infof("informer event tracking not installed: %v", err)
+	}
+}
+
+// CacheStatus reports every registered informer's contents, largest first.
+// Sizes are estimated from at most sampleLimit objects per informer.
+func (f *Factory) CacheStatus(sampleLimit int) []CacheStatus {
+	if f == nil {
+		return nil
+	}
+	f.syncStatesMu.Lock()
+	states := make([]*informerSyncState, len(f.syncStates))
+	copy(states, f.syncStates)
+	f.syncStatesMu.Unlock()
+
+	result := make([]CacheStatus, 0, len(states))
+	for _, state := range states {
+		status := CacheStatus{
+			Resource:    state.key,
+			LastEvent:   state.lastEvent.Load(),
+			Synced:      state.hasSynced(),
+			Terminal:    state.terminal.Load(),
+			Degraded:    state.degraded.Load(),
+			WatchErrors: state.watchErrors.Load(),
+		}
+		if state.informer != nil {
+			objects := state.informer.GetStore().List()
+			status.Objects = len(objects)
+			status.EstimatedBytes = memsize.Sample(objects, sampleLimit)
+		}
+		result = append(result, status)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].EstimatedBytes != result[j].EstimatedBytes {
+			return result[i].EstimatedBytes > result[j].EstimatedBytes
+		}
+		return result[i].Resource < result[j].Resource
+	})
+	return result
+}
@@ -0,0 +1,49 @@
+package informer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/refresh/permissions"
+)
+
+func TestCacheStatusReportsInformerContents(t *testing.T) {
+	checker := permissions.NewCheckerWithReview("test", time.Minute, func(_ context.Context, _, _, _, _ string) (bool, error) {
+		return true, nil
+	})
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", ResourceVersion: "2"}},
+	)
+	factory := New(client, nil, time.Minute, checker)
+	if got := factory.CacheStatus(8); len(got) == 0 || got[0].Synced {
+		t.Fatalf("expected unsynced informers before Start, got %+v", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := factory.Start(ctx); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	var namespaces *CacheStatus
+	for _, status := range factory.CacheStatus(8) {
+		if status.Resource == permissions.ResourceKey("", "namespaces") {
+			namespaces = &status
+		}
+	}
+	if namespaces == nil {
+		t.Fatal("namespaces informer not reported")
+	}
+	if !namespaces.Synced || namespaces.Objects != 2 {
+		t.Fatalf("expected 2 synced namespaces, got %+v", namespaces)
+	}
+	if namespaces.EstimatedBytes <= 0 || namespaces.LastEvent == 0 {
+		t.Fatalf("expected a size estimate and a last event, got %+v", namespaces)
+	}
+}
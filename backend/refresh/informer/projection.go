@@ -8,7 +8,8 @@ import (
 // docs/architecture/data-layer.md, "Ingestion"): it discards
 // metadata.managedFields before any object lands in an informer cache. Exported so
 // every ingestion path — the core/apiext factories here, plus the Gateway-API
-// factory and the catalog's dynamic-CRD informers — can install the same transform.
+// factory and the resource stream's per-CRD dynamic informers — can install the
+// same transform.
 //
 // managedFields is server-side-apply bookkeeping — 30-50% of a Pod's bytes — that
 // the table / catalog / maintained-store paths never read (verified: no
@@ -0,0 +1,80 @@
+package ingest
+
+import (
+	"sort"
+
+	"github.com/luxury-yacht/app/backend/internal/memsize"
+)
+
+// ReflectorStatus describes what one ingested kind holds, for cache
+// introspection.
+type ReflectorStatus struct {
+	Group    string `json:"group,omitempty"`
+	Version  string `json:"version"`
+	Kind     string `json:"kind"`
+	Resource string `json:"resource"`
+	// Namespaces lists the namespaces watched under a namespace scope; empty
+	// means cluster-wide.
+	Namespaces []string `json:"namespaces,omitempty"`
+	Objects    int      `json:"objects"`
+	// EstimatedBytes is the projected rows' size estimated from a sample.
+	// The source objects are not kept.
+	EstimatedBytes int64 `json:"estimatedBytes"`
+	// LastEvent is when the store last changed, in unix milliseconds; 0
+	// before the first change.
+	LastEvent int64 `json:"lastEvent,omitempty"`
+	Synced    bool  `json:"synced"`
+	Degraded  bool  `json:"degraded,omitempty"`
+	// OnDemand marks custom resource reflectors the catalog promoted at
+	// runtime.
+	OnDemand          bool `json:"onDemand,omitempty"`
+	PermissionSkipped bool `json:"permissionSkipped,omitempty"`
+}
+
+// CacheStatus reports every ingested kind's store, largest first. Sizes are
+// estimated from at most sampleLimit rows per store.
+func (m *IngestManager) CacheStatus(sampleLimit int) []ReflectorStatus {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	entries := make([]*entry, 0, len(m.entries))
+	for _, e := range m.entries {
+		entries = append(entries, e)
+	}
+	m.mu.Unlock()
+
+	// Leaf-lock rule: store reads happen outside m.mu.
+	result := make([]ReflectorStatus, 0, len(entries))
+	for _, e := range entries {
+		status := ReflectorStatus{
+			Group:             e.desc.Group,
+			Version:           e.desc.Version,
+			Kind:              e.desc.Kind,
+			Resource:          e.desc.Resource,
+			Synced:            e.store.HasSynced(),
+			Degraded:          e.degraded.Load(),
+			OnDemand:          e.onDemand.Load(),
+			PermissionSkipped: e.allPartsSkipped(),
+		}
+		for _, part := range e.parts {
+			if part.namespace != "" {
+				status.Namespaces = append(status.Namespaces, part.namespace)
+			}
+		}
+		rows := e.store.List()
+		status.Objects = len(rows)
+		status.EstimatedBytes = memsize.Sample(rows, sampleLimit)
+		if last := e.store.LastEventAt(); !last.IsZero() {
+			status.LastEvent = last.UnixMilli()
+		}
+		result = append(result, status)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].EstimatedBytes != result[j].EstimatedBytes {
+			return result[i].EstimatedBytes > result[j].EstimatedBytes
+		}
+		return result[i].Group+"/"+result[i].Resource < result[j].Group+"/"+result[j].Resource
+	})
+	return result
+}
@@ -0,0 +1,38 @@
+package ingest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCacheStatusReportsIngestStores(t *testing.T) {
+	server := newTrackerAPIServer(t)
+	server.add(t, newCM("default", "seed-cm"), configMapGVK)
+	server.add(t, newCM("other", "second-cm"), configMapGVK)
+
+	httpSrv := httptest.NewServer(server)
+	defer httpSrv.Close()
+	mgr := NewIngestManager(testMeta, newKubeClientFor(t, httpSrv), nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr.Start(ctx)
+	waitForManagerSynced(t, mgr)
+	waitForNames(t, mgr.StoreFor(configMapGVR), []string{"default/seed-cm", "other/second-cm"})
+
+	var configMaps *ReflectorStatus
+	for _, status := range mgr.CacheStatus(8) {
+		if status.Resource == configMapGVR.Resource && status.Group == "" {
+			configMaps = &status
+		}
+	}
+	if configMaps == nil {
+		t.Fatal("configmaps reflector not reported")
+	}
+	if configMaps.Kind != "ConfigMap" || configMaps.Objects != 2 || !configMaps.Synced {
+		t.Fatalf("expected 2 synced ConfigMaps, got %+v", configMaps)
+	}
+	if configMaps.EstimatedBytes <= 0 || configMaps.LastEvent == 0 || configMaps.OnDemand || len(configMaps.Namespaces) != 0 {
+		t.Fatalf("unexpected cluster-wide reflector status %+v", configMaps)
+	}
+}
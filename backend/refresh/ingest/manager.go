@@ -44,6 +44,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -170,6 +171,12 @@ type IngestManager struct {
 	// only that path needs it — the descriptor reflectors use the typed group clients
 	// (restClientFor). nil leaves the on-demand path disabled.
 	dynamic dynamic.Interface
+	// sharedDynamic, when set, lets the dynamic catalog reflectors ride on
+	// informers the resource stream holds (SetSharedDynamicInformers).
+	sharedDynamic SharedDynamicInformers
+	// metadata serves the on-demand metadata-only reflectors
+	// (RegisterMetadataCatalogReflector). Optional like dynamic (SetMetadataClient).
+	metadata metadata.Interface
 
 	entries map[schema.GroupVersionResource]*entry
 
@@ -353,7 +360,51 @@ func (m *IngestManager) RegisterReflector(gvr schema.GroupVersionResource, gvk s
 func (m *IngestManager) RegisterDynamicCatalogReflector(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project CatalogProjector, namespaced bool) bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	if m.dynamic == nil || m.runCtx == nil {
+	if m.dynamic == nil {
+		return false
+	}
+	if m.sharedDynamic != nil {
+		return m.registerSharedCatalogReflectorLocked(gvr, gvk, project, namespaced)
+	}
+	example := &unstructuredv1.Unstructured{}
+	example.SetGroupVersionKind(gvk)
+	client := m.dynamic
+	return m.registerCatalogReflectorLocked(gvr, gvk, project, namespaced, example, func(namespace string) cache.ListerWatcher {
+		return dynamicListWatch(client, gvr, namespace)
+	})
+}
+
+// RegisterMetadataCatalogReflector is RegisterDynamicCatalogReflector for a kind whose
+// catalog row needs only object metadata: it LIST+WATCHes through the metadata client, so
+// the API server sends *metav1.PartialObjectMetadata instead of full objects. The catalog
+// uses it for high-cardinality kinds it never reads the spec or status of. It returns
+// false when no metadata client is set, the manager is not started, or an entry for gvr
+// already exists.
+func (m *IngestManager) RegisterMetadataCatalogReflector(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project CatalogProjector, namespaced bool) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.metadata == nil {
+		return false
+	}
+	example := &metav1.PartialObjectMetadata{}
+	example.SetGroupVersionKind(gvk)
+	client := m.metadata
+	return m.registerCatalogReflectorLocked(gvr, gvk, project, namespaced, example, func(namespace string) cache.ListerWatcher {
+		return metadataListWatch(client, gvr, namespace)
+	})
+}
+
+// registerCatalogReflectorLocked builds and launches an on-demand catalog-only entry,
+// one reflector per scope partition, each listing through listWatch. Callers hold m.mu.
+func (m *IngestManager) registerCatalogReflectorLocked(
+	gvr schema.GroupVersionResource,
+	gvk schema.GroupVersionKind,
+	project CatalogProjector,
+	namespaced bool,
+	example apiruntime.Object,
+	listWatch func(namespace string) cache.ListerWatcher,
+) bool {
+	if m.runCtx == nil {
 		return false
 	}
 	if _, exists := m.entries[gvr]; exists {
@@ -361,21 +412,14 @@ func (m *IngestManager) RegisterDynamicCatalogReflector(gvr schema.GroupVersionR
 	}
 	e := &entry{store: NewProjectingStore(catalogProjectionFor(project))}
 	e.onDemand.Store(true)
-	example := &unstructuredv1.Unstructured{}
-	example.SetGroupVersionKind(gvk)
-	// A CRD-backed kind is not in the built-in registry, so its scope
-	// fan-out is decided by the caller-supplied namespaced flag.
-	namespaces := []string{""}
-	if namespaced && len(m.scope) > 0 {
-		namespaces = append([]string(nil), m.scope...)
-	}
+	namespaces := m.catalogPartitions(namespaced)
 	for _, namespace := range namespaces {
 		name := gvk.String()
 		if namespace != "" {
 			name += " ns=" + namespace
 		}
 		view := e.store.PartitionView(namespace)
-		lw := dynamicListWatch(m.dynamic, gvr, namespace)
+		lw := listWatch(namespace)
 		e.parts = append(e.parts, &ingestPart{
 			namespace: namespace,
 			lw:        lw,
@@ -393,6 +437,16 @@ func (m *IngestManager) RegisterDynamicCatalogReflector(gvr schema.GroupVersionR
 	return true
 }
 
+// catalogPartitions returns the partitions of an on-demand catalog entry. A kind
+// outside the built-in registry has its scope fan-out decided by the
+// caller-supplied namespaced flag.
+func (m *IngestManager) catalogPartitions(namespaced bool) []string {
+	if namespaced && len(m.scope) > 0 {
+		return append([]string(nil), m.scope...)
+	}
+	return []string{""}
+}
+
 // StopReflectorFor stops and evicts the reflector for gvr — the teardown half of the
 // on-demand dynamic path (the catalog drops a promoted CR kind on shutdown). It cancels
 // only that entry's reflector (on-demand entries carry their own cancel) and removes it,
@@ -432,6 +486,25 @@ func dynamicListWatch(client dynamic.Interface, gvr schema.GroupVersionResource,
 	}
 }
 
+// metadataListWatch is dynamicListWatch over the metadata client: the list and
+// watch return *metav1.PartialObjectMetadata, which carries everything the catalog
+// projection reads and none of the object's spec or status.
+func metadataListWatch(client metadata.Interface, gvr schema.GroupVersionResource, namespace string) cache.ListerWatcher {
+	if namespace == "" {
+		namespace = metav1.NamespaceAll
+	}
+	resource := client.Resource(gvr).Namespace(namespace)
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (apiruntime.Object, error) {
+			return resource.List(context.Background(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.Watch = true
+			return resource.Watch(context.Background(), options)
+		},
+	}
+}
+
 // catalogProjectionFor adapts a CatalogProjector to a ProjectFunc yielding a Bundle that
 // carries only the Catalog half, so an on-demand reflector's store serves its rows through
 // CatalogRows exactly as the descriptor reflectors' catalog half does.
@@ -570,6 +643,27 @@ func (m *IngestManager) SetDynamicClient(dyn dynamic.Interface) {
 	m.mu.Unlock()
 }
 
+// SetSharedDynamicInformers makes RegisterDynamicCatalogReflector feed its store
+// from the resource stream's shared informer while the stream holds one, so a
+// custom resource kind both consumers follow is watched once; otherwise the
+// catalog keeps its own projection-only watch. It must be set before the first
+// such registration; the dynamic client is still required to enable the
+// on-demand path.
+func (m *IngestManager) SetSharedDynamicInformers(shared SharedDynamicInformers) {
+	m.mu.Lock()
+	m.sharedDynamic = shared
+	m.mu.Unlock()
+}
+
+// SetMetadataClient installs the metadata client used for on-demand metadata-only
+// reflectors (RegisterMetadataCatalogReflector). A nil client (the default) leaves that
+// path disabled, so the catalog keeps listing the kind.
+func (m *IngestManager) SetMetadataClient(client metadata.Interface) {
+	m.mu.Lock()
+	m.metadata = client
+	m.mu.Unlock()
+}
+
 // Start runs every permitted reflector on a goroutine bound to a context derived from
 // ctx. Both Stop and cancelling ctx wind the reflectors down. Start is idempotent per
 // manager: a second call is a no-op once reflectors are running. A kind the permission
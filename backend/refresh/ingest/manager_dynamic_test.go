@@ -13,6 +13,7 @@ import (
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	clientfeatures "k8s.io/client-go/features"
 	clientfeaturestesting "k8s.io/client-go/features/testing"
+	metadatafake "k8s.io/client-go/metadata/fake"
 
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 )
@@ -107,6 +108,51 @@ func TestRegisterDynamicCatalogReflectorServesCatalogRows(t *testing.T) {
 	require.Equal(t, map[string]bool{"w1": true, "w2": true}, got)
 }
 
+// TestRegisterMetadataCatalogReflectorServesCatalogRows pins the metadata-only variant:
+// the reflector lists PartialObjectMetadata through the metadata client and serves the
+// projected rows exactly as the dynamic path does.
+func TestRegisterMetadataCatalogReflectorServesCatalogRows(t *testing.T) {
+	disableWatchList(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gvr := schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"}
+	gvk := schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}
+	newLease := func(name string) *metav1.PartialObjectMetadata {
+		obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: name}}
+		obj.SetGroupVersionKind(gvk)
+		return obj
+	}
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	client := metadatafake.NewSimpleMetadataClient(scheme, newLease("l1"), newLease("l2"))
+
+	m := &IngestManager{
+		entries:      make(map[schema.GroupVersionResource]*entry),
+		syncDeadline: time.Minute,
+		now:          time.Now,
+	}
+	m.Start(ctx)
+	project := func(o metav1.Object) interface{} {
+		_, partial := o.(*metav1.PartialObjectMetadata)
+		require.True(t, partial, "the reflector should decode metadata-only objects")
+		return dynCatRow{Namespace: o.GetNamespace(), Name: o.GetName()}
+	}
+	require.False(t, m.RegisterMetadataCatalogReflector(gvr, gvk, project, true),
+		"registration needs a metadata client")
+	m.SetMetadataClient(client)
+	require.True(t, m.RegisterMetadataCatalogReflector(gvr, gvk, project, true))
+	require.False(t, m.RegisterMetadataCatalogReflector(gvr, gvk, project, true),
+		"re-registering the same gvr should be a no-op")
+
+	require.Eventually(t, func() bool { return m.HasSyncedFor(gvr) }, 2*time.Second, 10*time.Millisecond)
+	got := map[string]bool{}
+	for _, r := range m.CatalogRows(gvr) {
+		got[r.(dynCatRow).Name] = true
+	}
+	require.Equal(t, map[string]bool{"l1": true, "l2": true}, got)
+}
+
 // TestGlobalHasSyncedIgnoresOnDemandEntries proves the readiness isolation: an on-demand
 // dynamic reflector that has NOT synced must not gate the whole-manager HasSynced (which
 // blocks the metrics poller — the issue-#225 class), yet its per-gvr HasSyncedFor reports
@@ -2,6 +2,7 @@ package ingest
 
 import (
 	"strings"
+	"time"
 
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -105,6 +106,7 @@ func (s *ProjectingStore) ReplacePartition(namespace string, list []interface{},
 	}
 	s.partitionRVs[namespace] = resourceVersion
 	s.rv = resourceVersion
+	s.lastEventAt = time.Now()
 	s.markPartitionSyncedLocked(namespace)
 	s.rebuildIndexesLocked()
 	return nil
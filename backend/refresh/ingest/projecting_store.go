@@ -150,6 +150,9 @@ type ProjectingStore struct {
 	// per-kind initial-sync durations (the cold-start telemetry that names which
 	// kinds dominate the first-connect window). Zero until the first sync.
 	syncedAt time.Time
+	// lastEventAt stamps the latest add, change, delete or relist, for cache
+	// introspection. Zero until the first.
+	lastEventAt time.Time
 
 	// projectErrLogged ensures a recurring projection failure is logged once,
 	// matching the repo rule that recurring identical errors log exactly once.
@@ -480,6 +483,7 @@ func (s *ProjectingStore) projectAndStore(obj interface{}) error {
 	stored := s.storedValue(projected)
 	s.rows[key] = stored
 	s.addIndexesForKey(key, stored)
+	s.lastEventAt = time.Now()
 	return nil
 }
 
@@ -512,6 +516,7 @@ func (s *ProjectingStore) Delete(obj interface{}) error {
 	delete(s.rows, key)
 	if existed {
 		s.removeIndexesForKey(key, stored)
+		s.lastEventAt = time.Now()
 	}
 	if existed && s.hasSinks() {
 		s.emitDelete(stored)
@@ -749,6 +754,7 @@ func (s *ProjectingStore) Replace(list []interface{}, resourceVersion string) er
 	}
 	prev := s.rows
 	s.rv = resourceVersion
+	s.lastEventAt = time.Now()
 	s.markSyncedLocked()
 	// Fan the FULL projected values (Table half present) to the sinks first, then store the
 	// (possibly Table-dropped) copies — the same emit-then-drop ordering Add/Update use. A
@@ -986,6 +992,14 @@ func (s *ProjectingStore) markSyncedLocked() {
 	}
 }
 
+// LastEventAt reports when the store last changed; zero before the first
+// change.
+func (s *ProjectingStore) LastEventAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastEventAt
+}
+
 // SyncedAt reports when the store first synced; zero while unsynced.
 func (s *ProjectingStore) SyncedAt() time.Time {
 	s.mu.RLock()
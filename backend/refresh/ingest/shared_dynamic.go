@@ -0,0 +1,169 @@
+package ingest
+
+import (
+	"context"
+	"sync"
+
+	unstructuredv1 "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// SharedDynamicInformers hands out dynamic informers shared by every consumer
+// of a custom resource kind (informer.DynamicInformers). Follow reports the
+// informer for gvr in namespace each time a holder starts it, and nil when the
+// last holder stops it, without keeping it running itself.
+type SharedDynamicInformers interface {
+	Follow(gvr schema.GroupVersionResource, namespace string, follow func(cache.SharedIndexInformer)) (func(), bool)
+}
+
+// sharedFollower hands the latest informer reported by Follow to the
+// partition's feed goroutine. The callback runs under the registry lock, so it
+// only records the informer and signals.
+type sharedFollower struct {
+	mu      sync.Mutex
+	current cache.SharedIndexInformer
+	changed chan struct{}
+}
+
+func newSharedFollower() *sharedFollower {
+	return &sharedFollower{changed: make(chan struct{}, 1)}
+}
+
+func (f *sharedFollower) follow(inf cache.SharedIndexInformer) {
+	f.mu.Lock()
+	f.current = inf
+	f.mu.Unlock()
+	select {
+	case f.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (f *sharedFollower) latest() cache.SharedIndexInformer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current
+}
+
+// registerSharedCatalogReflectorLocked is registerCatalogReflectorLocked over
+// shared informers. Each partition runs its own projection-only reflector, so
+// the catalog never keeps full objects alive by itself; while a resource stream
+// holds the kind's shared informer, the partition switches to a handler on that
+// informer instead and drops its own watch. When the stream lets go the
+// informer stops and the partition restarts its reflector, whose relist
+// reconciles whatever changed in between. Cancelling the entry
+// (StopReflectorFor, Stop) stops both. Callers hold m.mu.
+func (m *IngestManager) registerSharedCatalogReflectorLocked(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, project CatalogProjector, namespaced bool) bool {
+	if m.runCtx == nil {
+		return false
+	}
+	if _, exists := m.entries[gvr]; exists {
+		return false
+	}
+	e := &entry{store: NewProjectingStore(catalogProjectionFor(project))}
+	e.onDemand.Store(true)
+	namespaces := m.catalogPartitions(namespaced)
+	followers := make([]*sharedFollower, 0, len(namespaces))
+	unfollows := make([]func(), 0, len(namespaces))
+	unfollowAll := func() {
+		for _, unfollow := range unfollows {
+			unfollow()
+		}
+	}
+	for _, namespace := range namespaces {
+		follower := newSharedFollower()
+		unfollow, ok := m.sharedDynamic.Follow(gvr, namespace, follower.follow)
+		if !ok {
+			unfollowAll()
+			return false
+		}
+		followers = append(followers, follower)
+		unfollows = append(unfollows, unfollow)
+		view := e.store.PartitionView(namespace)
+		e.parts = append(e.parts, &ingestPart{
+			namespace: namespace,
+			lw:        dynamicListWatch(m.dynamic, gvr, namespace),
+			view:      view,
+		})
+	}
+	e.store.SetExpectedPartitions(namespaces)
+	m.entries[gvr] = e
+	ctx, cancel := context.WithCancel(m.runCtx)
+	e.cancel = cancel
+	for i, part := range e.parts {
+		go runSharedCatalogPart(ctx, gvr, gvk, part, followers[i])
+	}
+	go func() {
+		<-ctx.Done()
+		unfollowAll()
+	}()
+	return true
+}
+
+// runSharedCatalogPart feeds one catalog partition until ctx ends, from its
+// own reflector while no shared informer runs and from the shared informer
+// while one does.
+func runSharedCatalogPart(ctx context.Context, gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, part *ingestPart, follower *sharedFollower) {
+	name := gvk.String()
+	if part.namespace != "" {
+		name += " ns=" + part.namespace
+	}
+	example := &unstructuredv1.Unstructured{}
+	example.SetGroupVersionKind(gvk)
+
+	var (
+		feeding cache.SharedIndexInformer
+		stop    context.CancelFunc
+	)
+	stopFeed := func() {
+		if stop != nil {
+			stop()
+			stop = nil
+		}
+	}
+	defer stopFeed()
+	for {
+		inf := follower.latest()
+		if stop == nil || inf != feeding {
+			stopFeed()
+			feedCtx, cancel := context.WithCancel(ctx)
+			stop = cancel
+			feeding = inf
+			if inf == nil {
+				go NewProjectingReflector(name, part.lw, example, part.view, resyncDisabled).Run(feedCtx)
+			} else {
+				go feedFromSharedInformer(feedCtx, gvr, inf, part.view)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-follower.changed:
+		}
+	}
+}
+
+// feedFromSharedInformer mirrors inf into view until ctx ends. Once the
+// handler has seen the informer's initial list, the partition is replaced with
+// the informer's contents, which drops rows deleted while the previous feed
+// was handing over, and marked synced.
+func feedFromSharedInformer(ctx context.Context, gvr schema.GroupVersionResource, inf cache.SharedIndexInformer, view *StorePartitionView) {
+	registration, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { _ = view.Add(obj) },
+		UpdateFunc: func(_, newObj interface{}) { _ = view.Update(newObj) },
+		DeleteFunc: func(obj interface{}) { _ = view.Delete(obj) },
+	})
+	if err != nil {
+		// The informer stopped before the handler went in; the stop
+		// notification brings the partition's own reflector back.
+		klog.V(2).Infof("ingest: shared informer handler for %s not installed: %v", gvr.String(), err)
+		return
+	}
+	defer func() { _ = inf.RemoveEventHandler(registration) }()
+	if cache.WaitForCacheSync(ctx.Done(), registration.HasSynced) {
+		_ = view.Replace(inf.GetStore().List(), "")
+	}
+	<-ctx.Done()
+}
@@ -0,0 +1,61 @@
+package ingest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/refresh/informer"
+)
+
+// TestSharedDynamicCatalogReflectorFeedsFromSharedInformer pins the shared path: with
+// shared informers set, a promoted kind does not hold the shared informer by itself (it
+// serves its rows from its own projection-only watch), follows the shared informer once a
+// stream holds it, and falls back to its own watch, still tracking changes, once the stream
+// lets go.
+func TestSharedDynamicCatalogReflectorFeedsFromSharedInformer(t *testing.T) {
+	disableWatchList(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	dyn := newWidgetDynamicClient(gvr, gvk, newDynUnstructured(gvk, "default", "w1", "100"))
+	shared := informer.NewDynamicInformers(dyn)
+	defer shared.Shutdown()
+
+	m := newStartedDynamicManager(ctx, dyn)
+	m.SetSharedDynamicInformers(shared)
+	project := func(o metav1.Object) interface{} {
+		return dynCatRow{Namespace: o.GetNamespace(), Name: o.GetName()}
+	}
+	require.True(t, m.RegisterDynamicCatalogReflector(gvr, gvk, project, true))
+	require.Equal(t, 0, shared.Holders(gvr, ""))
+	require.Eventually(t, func() bool { return m.HasSyncedFor(gvr) }, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, []interface{}{dynCatRow{Namespace: "default", Name: "w1"}}, m.CatalogRows(gvr))
+
+	// A stream subscriber takes the shared informer; the catalog follows it.
+	inf, release, ok := shared.Acquire(gvr, "")
+	require.True(t, ok)
+	require.Eventually(t, func() bool { return inf.HasSynced() }, 2*time.Second, 10*time.Millisecond)
+	_, err := dyn.Resource(gvr).Namespace("default").Create(ctx, newDynUnstructured(gvk, "default", "w2", ""), metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(m.CatalogRows(gvr)) == 2 }, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, shared.Holders(gvr, ""))
+
+	// The stream lets go: the informer stops and the catalog watches on its own again.
+	release()
+	require.Equal(t, 0, shared.Holders(gvr, ""))
+	require.NoError(t, dyn.Resource(gvr).Namespace("default").Delete(ctx, "w1", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]interface{}{dynCatRow{Namespace: "default", Name: "w2"}}, m.CatalogRows(gvr))
+	}, 2*time.Second, 10*time.Millisecond)
+
+	m.StopReflectorFor(gvr)
+	require.False(t, m.HasSyncedFor(gvr))
+}
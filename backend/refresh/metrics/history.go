@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// PodPoint is one bucket of a pod's usage history: the peak usage of the
+// collections that fell in the bucket starting at At.
+type PodPoint struct {
+	At               time.Time
+	PeakCPUMilli     int64
+	PeakMemoryBytes  int64
+	CollectionsCount int
+}
+
+// History keeps a bounded, bucketed per-pod usage history from successive
+// collections, so consumers can ask how a pod behaved over a window rather
+// than only what it uses now. The poller only collects while metrics are in
+// demand, so the history can have gaps; Coverage reports them. It is safe for
+// concurrent use.
+type History struct {
+	retention  time.Duration
+	resolution time.Duration
+
+	mu            sync.Mutex
+	pods          map[string][]PodPoint
+	buckets       []time.Time
+	lastCollected time.Time
+}
+
+// NewHistory returns a history that keeps retention worth of buckets of the
+// given resolution.
+func NewHistory(retention, resolution time.Duration) *History {
+	if resolution <= 0 {
+		resolution = time.Minute
+	}
+	if retention < resolution {
+		retention = resolution
+	}
+	return &History{
+		retention:  retention,
+		resolution: resolution,
+		pods:       make(map[string][]PodPoint),
+	}
+}
+
+// Resolution returns the bucket width.
+func (h *History) Resolution() time.Duration {
+	return h.resolution
+}
+
+// Record adds a collection to the history. Samples without a collection time,
+// or already recorded, are ignored, so it can be called after every
+// collection attempt.
+func (h *History) Record(sample Sample) {
+	collectedAt := sample.Metadata.CollectedAt
+	if collectedAt.IsZero() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !collectedAt.After(h.lastCollected) {
+		return
+	}
+	h.lastCollected = collectedAt
+	bucket := collectedAt.Truncate(h.resolution)
+	if n := len(h.buckets); n == 0 || h.buckets[n-1].Before(bucket) {
+		h.buckets = append(h.buckets, bucket)
+	}
+	for key, usage := range sample.PodUsage {
+		points := h.pods[key]
+		n := len(points)
+		if n == 0 || points[n-1].At.Before(bucket) {
+			points = append(points, PodPoint{At: bucket})
+			n++
+		}
+		point := &points[n-1]
+		point.PeakCPUMilli = max(point.PeakCPUMilli, usage.CPUUsageMilli)
+		point.PeakMemoryBytes = max(point.PeakMemoryBytes, usage.MemoryUsageBytes)
+		point.CollectionsCount++
+		h.pods[key] = points
+	}
+	h.trimLocked(collectedAt.Add(-h.retention))
+}
+
+// Pod returns the points for the pod keyed "namespace/name" from the bucket
+// holding since onwards, oldest first.
+func (h *History) Pod(key string, since time.Time) []PodPoint {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	points := h.pods[key]
+	start := len(points)
+	for i, point := range points {
+		if !point.At.Before(since.Truncate(h.resolution)) {
+			start = i
+			break
+		}
+	}
+	return append([]PodPoint(nil), points[start:]...)
+}
+
+// Coverage reports the oldest recorded bucket and how many of the buckets from
+// since to the latest collection hold at least one collection.
+func (h *History) Coverage(since time.Time) (oldest time.Time, covered, total int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.buckets) == 0 {
+		return time.Time{}, 0, 0
+	}
+	start := since.Truncate(h.resolution)
+	last := h.buckets[len(h.buckets)-1]
+	if last.Before(start) {
+		return h.buckets[0], 0, 0
+	}
+	total = int(last.Sub(start)/h.resolution) + 1
+	for _, bucket := range h.buckets {
+		if !bucket.Before(start) {
+			covered++
+		}
+	}
+	return h.buckets[0], covered, total
+}
+
+// trimLocked drops buckets that start before cutoff, and pods left without
+// points, which also forgets deleted pods.
+func (h *History) trimLocked(cutoff time.Time) {
+	cutoff = cutoff.Truncate(h.resolution)
+	keep := 0
+	for keep < len(h.buckets) && h.buckets[keep].Before(cutoff) {
+		keep++
+	}
+	if keep > 0 {
+		h.buckets = append(h.buckets[:0], h.buckets[keep:]...)
+	}
+	for key, points := range h.pods {
+		drop := 0
+		for drop < len(points) && points[drop].At.Before(cutoff) {
+			drop++
+		}
+		switch {
+		case drop == len(points):
+			delete(h.pods, key)
+		case drop > 0:
+			h.pods[key] = append(points[:0], points[drop:]...)
+		}
+	}
+}
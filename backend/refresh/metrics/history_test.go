@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryBucketsPeaksAndTrims(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	history := NewHistory(time.Hour, 10*time.Minute)
+	record := func(at time.Time, usage map[string]PodUsage) {
+		history.Record(Sample{PodUsage: usage, Metadata: Metadata{CollectedAt: at}})
+	}
+
+	record(start, map[string]PodUsage{"prod/api": {CPUUsageMilli: 40, MemoryUsageBytes: 100}, "prod/old": {CPUUsageMilli: 1}})
+	record(start.Add(2*time.Minute), map[string]PodUsage{"prod/api": {CPUUsageMilli: 10, MemoryUsageBytes: 300}})
+	// A repeated collection time is an observer call after a failed attempt.
+	record(start.Add(2*time.Minute), map[string]PodUsage{"prod/api": {CPUUsageMilli: 999}})
+	record(start.Add(30*time.Minute), map[string]PodUsage{"prod/api": {CPUUsageMilli: 5}})
+
+	points := history.Pod("prod/api", start)
+	require.Equal(t, []PodPoint{
+		{At: start, PeakCPUMilli: 40, PeakMemoryBytes: 300, CollectionsCount: 2},
+		{At: start.Add(30 * time.Minute), PeakCPUMilli: 5, CollectionsCount: 1},
+	}, points)
+	require.Len(t, history.Pod("prod/api", start.Add(15*time.Minute)), 1)
+
+	oldest, covered, total := history.Coverage(start)
+	require.Equal(t, start, oldest)
+	require.Equal(t, 2, covered)
+	require.Equal(t, 4, total)
+
+	// Past the retention the first bucket, and the pod only seen in it, go.
+	record(start.Add(70*time.Minute), map[string]PodUsage{"prod/api": {CPUUsageMilli: 5}})
+	require.Empty(t, history.Pod("prod/old", start))
+	oldest, _, _ = history.Coverage(start)
+	require.Equal(t, start.Add(30*time.Minute), oldest)
+
+	history.Record(Sample{})
+	_, covered, _ = history.Coverage(start)
+	require.Equal(t, 2, covered)
+}
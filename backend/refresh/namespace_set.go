@@ -0,0 +1,48 @@
+package refresh
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Namespace set scopes select an explicit set of namespaces, e.g.
+// "namespace:{team-a,team-b}", for users who own several namespaces but not
+// the cluster. The canonical form lists each namespace once, sorted, so equal
+// sets share one cache key and one stream subscription.
+
+// ParseNamespaceSet parses the value of a namespace scope (the part after
+// "namespace:") as a namespace set. ok is false when value is not a set; err
+// is set when it is one but is empty or names an invalid namespace.
+func ParseNamespaceSet(value string) (namespaces []string, ok bool, err error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+		return nil, false, nil
+	}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value[1:len(value)-1], ",") {
+		namespace := strings.TrimSpace(part)
+		if namespace == "" {
+			continue
+		}
+		if problems := validation.IsDNS1123Label(namespace); len(problems) > 0 {
+			return nil, true, fmt.Errorf("invalid namespace %q in namespace set: %s", namespace, strings.Join(problems, "; "))
+		}
+		if !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil, true, fmt.Errorf("namespace set is empty")
+	}
+	sort.Strings(namespaces)
+	return namespaces, true, nil
+}
+
+// NamespaceSetScope returns the canonical scope for a parsed namespace set.
+func NamespaceSetScope(namespaces []string) string {
+	return "namespace:{" + strings.Join(namespaces, ",") + "}"
+}
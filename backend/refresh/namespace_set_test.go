@@ -0,0 +1,28 @@
+package refresh
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNamespaceSet(t *testing.T) {
+	namespaces, ok, err := ParseNamespaceSet(" {team-b, team-a ,team-b,} ")
+	if err != nil || !ok {
+		t.Fatalf("ParseNamespaceSet() = %v, %v", ok, err)
+	}
+	if want := []string{"team-a", "team-b"}; !reflect.DeepEqual(namespaces, want) {
+		t.Fatalf("namespaces = %v, want %v", namespaces, want)
+	}
+	if scope := NamespaceSetScope(namespaces); scope != "namespace:{team-a,team-b}" {
+		t.Fatalf("NamespaceSetScope() = %q", scope)
+	}
+
+	if _, ok, err := ParseNamespaceSet("default"); ok || err != nil {
+		t.Fatalf("single namespace parsed as a set: %v, %v", ok, err)
+	}
+	for _, value := range []string{"{}", "{ , }", "{team-a,Team_B}"} {
+		if _, ok, err := ParseNamespaceSet(value); !ok || err == nil {
+			t.Fatalf("expected %q to be an invalid set, got %v, %v", value, ok, err)
+		}
+	}
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
 
 	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/kubernetes"
 
 	"golang.org/x/sync/singleflight"
 
+	"github.com/luxury-yacht/app/backend/internal/authstate"
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/k8sretry"
 )
@@ -293,7 +296,7 @@ func (c *Checker) canInNamespace(ctx context.Context, group, resource, verb, nam
 		}, nil
 	}
 
-	if ok && isTransientPermissionError(err) {
+	if ok && (isTransientPermissionError(err) || isUnreachablePermissionError(err)) {
 		return Decision{
 			Allowed:   entry.allowed,
 			Source:    DecisionSourceFallback,
@@ -409,3 +412,17 @@ func isTransientPermissionError(err error) bool {
 	}
 	return k8sretry.IsRetryable(err)
 }
+
+// isUnreachablePermissionError reports errors meaning the cluster could not be
+// asked at all: the connection failed, or its requests are held while the
+// credentials recover. The last known decision keeps offline views readable.
+func isUnreachablePermissionError(err error) bool {
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	return errors.As(err, &opErr) ||
+		errors.As(err, &dnsErr) ||
+		utilnet.IsConnectionRefused(err) ||
+		utilnet.IsConnectionReset(err) ||
+		utilnet.IsProbableEOF(err) ||
+		errors.Is(err, &authstate.AuthInvalidError{})
+}
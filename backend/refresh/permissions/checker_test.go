@@ -3,8 +3,10 @@ package permissions
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -14,6 +16,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	cgotesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/internal/authstate"
 )
 
 func TestCheckerUsesCacheUntilExpiry(t *testing.T) {
@@ -67,6 +71,36 @@ func TestCheckerFallsBackOnTransientError(t *testing.T) {
 	require.True(t, decision.Allowed)
 }
 
+func TestCheckerFallsBackWhileClusterIsUnreachable(t *testing.T) {
+	for name, unreachable := range map[string]error{
+		"connection refused": &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		"dns":                &net.DNSError{Err: "no such host", Name: "api.cluster.example", IsNotFound: true},
+		"auth recovering":    &authstate.AuthInvalidError{Reason: "token expired", State: authstate.StateRecovering},
+	} {
+		t.Run(name, func(t *testing.T) {
+			callCount := 0
+			checker := NewCheckerWithReview("cluster-a", time.Minute, func(context.Context, string, string, string, string) (bool, error) {
+				callCount++
+				if callCount == 1 {
+					return true, nil
+				}
+				return false, fmt.Errorf("post selfsubjectaccessreviews: %w", unreachable)
+			})
+			now := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+			checker.now = func() time.Time { return now }
+
+			_, err := checker.Can(context.Background(), "", "pods", "list")
+			require.NoError(t, err)
+
+			now = now.Add(time.Hour)
+			decision, err := checker.Can(context.Background(), "", "pods", "list")
+			require.NoError(t, err)
+			require.Equal(t, DecisionSourceFallback, decision.Source)
+			require.True(t, decision.Allowed)
+		})
+	}
+}
+
 func TestCheckerReturnsErrorWithoutCacheOnTransient(t *testing.T) {
 	checker := NewCheckerWithReview("cluster-a", time.Minute, func(context.Context, string, string, string, string) (bool, error) {
 		return false, context.DeadlineExceeded
@@ -3,6 +3,7 @@ package resourcestream
 import (
 	"errors"
 
+	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/streammux"
 )
 
@@ -53,3 +54,12 @@ func resourceStreamSelector(selector streammux.Selector) (StreamSelector, error)
 	}
 	return resourceSelector, nil
 }
+
+// Freshness reports how current the data behind the selector is.
+func (a *Adapter) Freshness(selector streammux.Selector) *refresh.DataFreshness {
+	resourceSelector, err := resourceStreamSelector(selector)
+	if err != nil {
+		return nil
+	}
+	return a.manager.Freshness(resourceSelector)
+}
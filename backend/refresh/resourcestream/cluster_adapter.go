@@ -3,6 +3,7 @@ package resourcestream
 import (
 	"errors"
 
+	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/streammux"
 )
 
@@ -55,6 +56,19 @@ func (a *ClusterAdapter) Resume(selector streammux.Selector, since uint64) ([]st
 	return manager.ResumeSelector(resourceSelector, since)
 }
 
+// Freshness reports how current the data behind the selector is.
+func (a *ClusterAdapter) Freshness(selector streammux.Selector) *refresh.DataFreshness {
+	resourceSelector, err := resourceStreamSelector(selector)
+	if err != nil {
+		return nil
+	}
+	manager, err := a.managerFor(resourceSelector.ClusterID)
+	if err != nil {
+		return nil
+	}
+	return manager.Freshness(resourceSelector)
+}
+
 func (a *ClusterAdapter) managerFor(clusterID string) (*Manager, error) {
 	if a == nil || a.resolve == nil {
 		return nil, errors.New("resource stream adapter is required")
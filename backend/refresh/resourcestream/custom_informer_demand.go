@@ -0,0 +1,198 @@
+/*
+ * backend/refresh/resourcestream/custom_informer_demand.go
+ *
+ * Starts the per-CRD custom resource informers on demand. A cluster can carry
+ * hundreds of CRDs, and each informer is a cluster-wide LIST+WATCH whose only
+ * consumer is a namespace-custom or cluster-custom stream subscriber, so the
+ * informers of a custom domain run only while that domain has subscribers and
+ * for an idle period after the last one leaves. Custom snapshots are served by
+ * the object catalog and do not need these informers.
+ *
+ * Built-in kinds are not demand-driven: the catalog and the other
+ * cluster-wide builders read all of them from connect (see
+ * refresh/system/ingest_hub.go), so only custom domains defer their watches.
+ */
+
+package resourcestream
+
+import (
+	"fmt"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+)
+
+// customDomainDemand is one custom domain's informer lifecycle. Guarded by
+// customInformerMu.
+type customDomainDemand struct {
+	active bool
+	// idle fires the idle shutdown scheduled when the last subscriber left.
+	idle *time.Timer
+	// done is closed when the domain deactivates, ending the post-start sync wait.
+	done chan struct{}
+}
+
+func isCustomDomain(domain string) bool {
+	return domain == domainNamespaceCustom || domain == domainClusterCustom
+}
+
+// SetCustomInformerIdleTimeout overrides how long a custom domain's informers
+// outlive its last subscriber. Non-positive values keep the current timeout.
+func (m *Manager) SetCustomInformerIdleTimeout(timeout time.Duration) {
+	if m == nil || timeout <= 0 {
+		return
+	}
+	m.customInformerMu.Lock()
+	defer m.customInformerMu.Unlock()
+	m.customInformerIdleTimeout = timeout
+}
+
+func (m *Manager) customInformerIdleTimeoutLocked() time.Duration {
+	if m.customInformerIdleTimeout <= 0 {
+		return config.ResourceStreamCustomInformerIdleTimeout
+	}
+	return m.customInformerIdleTimeout
+}
+
+func (m *Manager) customDomainActiveLocked(domain string) bool {
+	demand := m.customDemand[domain]
+	return demand != nil && demand.active
+}
+
+// rememberCustomCRDLocked records a streamable CRD so a later activation can
+// start its informer.
+func (m *Manager) rememberCustomCRDLocked(crd *apiextensionsv1.CustomResourceDefinition) {
+	if m.customCRDs == nil {
+		m.customCRDs = make(map[string]*apiextensionsv1.CustomResourceDefinition)
+	}
+	m.customCRDs[crd.Name] = crd
+}
+
+// activateCustomDomain runs on every subscription to a custom domain. The
+// first one starts an informer per known CRD of the domain; later ones only
+// cancel a pending idle shutdown. Initial-list adds are not broadcast (the
+// subscriber's snapshot already holds those objects), so once the new
+// informers sync a COMPLETE tells subscribers to resync across the gap
+// between their snapshot and the watch.
+func (m *Manager) activateCustomDomain(domain string) {
+	if m == nil || !isCustomDomain(domain) {
+		return
+	}
+	m.customInformerMu.Lock()
+	if m.stopped {
+		m.customInformerMu.Unlock()
+		return
+	}
+	if m.customDemand == nil {
+		m.customDemand = make(map[string]*customDomainDemand)
+	}
+	demand := m.customDemand[domain]
+	if demand == nil {
+		demand = &customDomainDemand{}
+		m.customDemand[domain] = demand
+	}
+	if demand.idle != nil {
+		demand.idle.Stop()
+		demand.idle = nil
+	}
+	if demand.active {
+		m.customInformerMu.Unlock()
+		return
+	}
+	demand.active = true
+	demand.done = make(chan struct{})
+	done := demand.done
+	crds := make([]*apiextensionsv1.CustomResourceDefinition, 0, len(m.customCRDs))
+	for _, crd := range m.customCRDs {
+		if customCRDDomain(crd) == domain {
+			crds = append(crds, crd)
+		}
+	}
+	m.customInformerMu.Unlock()
+
+	for _, crd := range crds {
+		m.ensureCustomInformer(crd)
+	}
+
+	m.customInformerMu.Lock()
+	var synced []cache.InformerSynced
+	started := 0
+	for _, info := range m.customInformers {
+		if info.domain != domain {
+			continue
+		}
+		started++
+		for _, registration := range info.registrations {
+			synced = append(synced, registration.HasSynced)
+		}
+	}
+	m.customInformerMu.Unlock()
+	if len(synced) == 0 {
+		return
+	}
+	m.logInfo(fmt.Sprintf("Started %d custom resource informers for %s", started, domain))
+	go func() {
+		if cache.WaitForCacheSync(done, synced...) {
+			m.broadcastCustomDomainComplete(domain, "", nil)
+		}
+	}()
+}
+
+// scheduleCustomDomainIdle runs when a custom domain loses its last
+// subscriber and stops the domain's informers after the idle timeout.
+func (m *Manager) scheduleCustomDomainIdle(domain string) {
+	if m == nil || !isCustomDomain(domain) {
+		return
+	}
+	m.customInformerMu.Lock()
+	defer m.customInformerMu.Unlock()
+	demand := m.customDemand[domain]
+	if m.stopped || demand == nil || !demand.active {
+		return
+	}
+	if demand.idle != nil {
+		demand.idle.Stop()
+	}
+	demand.idle = time.AfterFunc(m.customInformerIdleTimeoutLocked(), func() {
+		m.deactivateCustomDomainIfIdle(domain)
+	})
+}
+
+// deactivateCustomDomainIfIdle stops the domain's informers unless a
+// subscriber arrived since the idle timer was armed. The subscriber check
+// runs under customInformerMu so a concurrent activation waits and then
+// starts the informers again.
+func (m *Manager) deactivateCustomDomainIfIdle(domain string) {
+	m.customInformerMu.Lock()
+	defer m.customInformerMu.Unlock()
+	demand := m.customDemand[domain]
+	if m.stopped || demand == nil || !demand.active {
+		return
+	}
+	if len(m.activeScopesForDomain(domain)) > 0 {
+		return
+	}
+	m.deactivateCustomDomainLocked(domain, demand)
+	m.logInfo(fmt.Sprintf("Stopped idle custom resource informers for %s", domain))
+}
+
+func (m *Manager) deactivateCustomDomainLocked(domain string, demand *customDomainDemand) {
+	demand.active = false
+	if demand.idle != nil {
+		demand.idle.Stop()
+		demand.idle = nil
+	}
+	if demand.done != nil {
+		close(demand.done)
+		demand.done = nil
+	}
+	for name, info := range m.customInformers {
+		if info.domain == domain {
+			info.stop()
+			delete(m.customInformers, name)
+		}
+	}
+}
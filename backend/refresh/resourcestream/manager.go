@@ -11,6 +11,7 @@ package resourcestream
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,7 +26,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
-	dynamicinformer "k8s.io/client-go/dynamic/dynamicinformer"
 	appslisters "k8s.io/client-go/listers/apps/v1"
 	batchlisters "k8s.io/client-go/listers/batch/v1"
 	"k8s.io/client-go/tools/cache"
@@ -33,6 +33,7 @@ import (
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/containerlogsstream"
 	"github.com/luxury-yacht/app/backend/refresh/informer"
 	"github.com/luxury-yacht/app/backend/refresh/ingest"
@@ -157,12 +158,18 @@ type customResourceInformer struct {
 	gvr    schema.GroupVersionResource
 	kind   string
 	domain string
-	// informers are the CRD's dynamic informers: one cluster-wide (or one
-	// per configured scope namespace for a namespaced CRD under a namespace
-	// scope, docs/plans/namespace-scope.md). All share stopCh.
-	informers []cache.SharedIndexInformer
-	stopCh    chan struct{}
-	stopOnce  sync.Once
+	// printerColumns are the CRD's status-like printer columns, read for row health.
+	printerColumns []customresource.PrinterColumn
+	// informers are the shared dynamic informers the CRD's handlers hang off:
+	// one cluster-wide (or one per configured scope namespace for a namespaced
+	// CRD under a namespace scope, docs/plans/namespace-scope.md).
+	// registrations[i] is the handler on informers[i]; releases drop the holds
+	// on the shared informers, which stop once the catalog no longer holds them
+	// either.
+	informers     []cache.SharedIndexInformer
+	registrations []cache.ResourceEventHandlerRegistration
+	releases      []func()
+	stopOnce      sync.Once
 }
 
 func (c *customResourceInformer) stop() {
@@ -170,7 +177,12 @@ func (c *customResourceInformer) stop() {
 		return
 	}
 	c.stopOnce.Do(func() {
-		close(c.stopCh)
+		for i, registration := range c.registrations {
+			_ = c.informers[i].RemoveEventHandler(registration)
+		}
+		for _, release := range c.releases {
+			release()
+		}
 	})
 }
 
@@ -182,6 +194,11 @@ type Manager struct {
 	permissions permissions.ListWatchChecker
 
 	dynamicClient dynamic.Interface
+	// dynamicInformers shares the custom resource informers with the catalog's
+	// promoted reflectors. NewManager takes the factory's; a manager without
+	// one builds its own over dynamicClient on first use. Guarded by
+	// customInformerMu.
+	dynamicInformers *informer.DynamicInformers
 
 	// The workload listers (deployment/stateful/daemon/job/cronJob) are wired only
 	// by unit tests: lookupWorkloadRef prefers a wired lister (lookupWorkloadObject),
@@ -209,9 +226,16 @@ type Manager struct {
 	// discarded and replaced by a fresh one. It gates ensureCustomInformer so a
 	// CRD event arriving after teardown (the shared CRD informer can still fire,
 	// including its resync, until the factory is shut down) cannot resurrect a
-	// custom informer whose stopCh nothing would ever close. Guarded by
+	// custom informer hold nothing would ever release. Guarded by
 	// customInformerMu.
 	stopped bool
+	// customCRDs are the streamable CRDs seen so far, by CRD name. Their
+	// informers run only while the CRD's custom domain has demand
+	// (custom_informer_demand.go). customCRDs, customDemand, and
+	// customInformerIdleTimeout are guarded by customInformerMu.
+	customCRDs                map[string]*apiextensionsv1.CustomResourceDefinition
+	customDemand              map[string]*customDomainDemand
+	customInformerIdleTimeout time.Duration
 	// customInvalidator evicts cached YAML/details when custom resources change.
 	customInvalidatorMu sync.RWMutex
 	customInvalidator   func(ref resourcemodel.ResourceRef)
@@ -220,12 +244,23 @@ type Manager struct {
 	// snapshot from cache after this one-shot signal would leave the query stale.
 	snapshotDomainInvalidatorMu sync.RWMutex
 	snapshotDomainInvalidator   func(domain string)
+	// freshnessSource reports how current a domain's data is for subscribe
+	// ACKs. It is also guarded by snapshotDomainInvalidatorMu.
+	freshnessSource func(domain string) *refresh.DataFreshness
+	// activityObserver is told about every update broadcast for a domain so
+	// freshness can report when the domain last received a watch event. It is
+	// also guarded by snapshotDomainInvalidatorMu.
+	activityObserver func(domain string)
 
 	mu          sync.RWMutex
 	subscribers map[string]map[string]map[uint64]*subscription
-	nextID      uint64
-	buffers     map[string]*updateBuffer
-	sequences   map[string]uint64
+	// subscriberBufferSize and resumeBufferSize size new subscriber channels
+	// and per-scope resume buffers; see SetBufferSizes.
+	subscriberBufferSize int
+	resumeBufferSize     int
+	nextID               uint64
+	buffers              map[string]*updateBuffer
+	sequences            map[string]uint64
 
 	jobPodOwnerHealSink *ingest.AsyncBundleSink
 }
@@ -257,6 +292,12 @@ func NewManager(
 		subscribers:       make(map[string]map[string]map[uint64]*subscription),
 		buffers:           make(map[string]*updateBuffer),
 		sequences:         make(map[string]uint64),
+
+		subscriberBufferSize: config.ResourceStreamSubscriberBufferSize,
+		resumeBufferSize:     config.ResourceStreamResumeBufferSize,
+	}
+	if factory != nil {
+		mgr.dynamicInformers = factory.DynamicInformers()
 	}
 	if ingestManager != nil {
 		mgr.podIngest = ingestManager
@@ -290,6 +331,39 @@ func NewManager(
 	return mgr
 }
 
+// SetBufferSizes overrides the subscriber and resume buffer sizes for
+// subscriptions and scopes created afterwards. Non-positive values keep the
+// current size.
+func (m *Manager) SetBufferSizes(subscriber, resume int) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if subscriber > 0 {
+		m.subscriberBufferSize = subscriber
+	}
+	if resume > 0 {
+		m.resumeBufferSize = resume
+	}
+}
+
+// subscriberBufferSizeLocked and resumeBufferSizeLocked fall back to the config
+// defaults for managers built without NewManager.
+func (m *Manager) subscriberBufferSizeLocked() int {
+	if m.subscriberBufferSize <= 0 {
+		return config.ResourceStreamSubscriberBufferSize
+	}
+	return m.subscriberBufferSize
+}
+
+func (m *Manager) resumeBufferSizeLocked() int {
+	if m.resumeBufferSize <= 0 {
+		return config.ResourceStreamResumeBufferSize
+	}
+	return m.resumeBufferSize
+}
+
 // Stop halts any dynamically managed informers that are not owned by the shared factory.
 func (m *Manager) Stop() {
 	if m == nil {
@@ -301,6 +375,9 @@ func (m *Manager) Stop() {
 	m.customInformerMu.Lock()
 	defer m.customInformerMu.Unlock()
 	m.stopped = true
+	for domain, demand := range m.customDemand {
+		m.deactivateCustomDomainLocked(domain, demand)
+	}
 	for key, informer := range m.customInformers {
 		informer.stop()
 		delete(m.customInformers, key)
@@ -349,6 +426,43 @@ func (m *Manager) SetSnapshotDomainInvalidator(invalidator func(domain string))
 	m.snapshotDomainInvalidatorMu.Unlock()
 }
 
+// SetFreshnessSource installs the lookup used to report a domain's data
+// freshness when a subscription is acknowledged.
+func (m *Manager) SetFreshnessSource(source func(domain string) *refresh.DataFreshness) {
+	if m == nil {
+		return
+	}
+	m.snapshotDomainInvalidatorMu.Lock()
+	m.freshnessSource = source
+	m.snapshotDomainInvalidatorMu.Unlock()
+}
+
+// SetActivityObserver installs the callback told about each update broadcast
+// for a domain.
+func (m *Manager) SetActivityObserver(observer func(domain string)) {
+	if m == nil {
+		return
+	}
+	m.snapshotDomainInvalidatorMu.Lock()
+	m.activityObserver = observer
+	m.snapshotDomainInvalidatorMu.Unlock()
+}
+
+// Freshness reports how current the data behind the selector's domain is, or
+// nil when no freshness source is installed.
+func (m *Manager) Freshness(selector StreamSelector) *refresh.DataFreshness {
+	if m == nil {
+		return nil
+	}
+	m.snapshotDomainInvalidatorMu.RLock()
+	source := m.freshnessSource
+	m.snapshotDomainInvalidatorMu.RUnlock()
+	if source == nil {
+		return nil
+	}
+	return source(selector.DomainName())
+}
+
 func (m *Manager) invalidateSnapshotDomain(domain string) {
 	if m == nil {
 		return
@@ -475,6 +589,33 @@ func (m *Manager) broadcastCustomDomainComplete(domain, resourceVersion string,
 	m.broadcast(domain, scopes, update)
 }
 
+// ResyncSubscribers sends a COMPLETE to every subscribed scope of every domain,
+// telling each client to refetch its snapshot. The app calls it when a cluster's
+// connection recovers, since updates may have been missed while it was down.
+func (m *Manager) ResyncSubscribers() {
+	if m == nil {
+		return
+	}
+	m.mu.RLock()
+	domains := make([]string, 0, len(m.subscribers))
+	for domain := range m.subscribers {
+		domains = append(domains, domain)
+	}
+	m.mu.RUnlock()
+	for _, domain := range domains {
+		scopes := m.activeScopesForDomain(domain)
+		if len(scopes) == 0 {
+			continue
+		}
+		m.broadcast(domain, scopes, Update{
+			Type:        MessageTypeComplete,
+			Domain:      domain,
+			ClusterID:   m.clusterMeta.ClusterID,
+			ClusterName: m.clusterMeta.ClusterName,
+		})
+	}
+}
+
 func (m *Manager) activeScopesForDomain(domain string) []string {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -513,6 +654,7 @@ func (m *Manager) ensureCustomInformer(crd *apiextensionsv1.CustomResourceDefini
 		Resource: crd.Spec.Names.Plural,
 	}
 	kind := crd.Spec.Names.Kind
+	printerColumns := customresource.HealthPrinterColumns(crd, version)
 
 	m.customInformerMu.Lock()
 	// Once stopped, never resurrect an informer; the check-and-insert below must
@@ -521,8 +663,22 @@ func (m *Manager) ensureCustomInformer(crd *apiextensionsv1.CustomResourceDefini
 		m.customInformerMu.Unlock()
 		return
 	}
+	m.rememberCustomCRDLocked(crd)
+	if m.dynamicInformers == nil {
+		m.dynamicInformers = informer.NewDynamicInformers(m.dynamicClient)
+	}
 	existing := m.customInformers[crd.Name]
-	if existing != nil && existing.gvr == gvr && existing.kind == kind && existing.domain == customDomain {
+	if !m.customDomainActiveLocked(customDomain) {
+		// No subscriber wants this domain; activateCustomDomain starts the
+		// informer later. Drop one left over from the CRD's previous scope.
+		if existing != nil {
+			existing.stop()
+			delete(m.customInformers, crd.Name)
+		}
+		m.customInformerMu.Unlock()
+		return
+	}
+	if existing != nil && existing.gvr == gvr && existing.kind == kind && existing.domain == customDomain && slices.Equal(existing.printerColumns, printerColumns) {
 		m.customInformerMu.Unlock()
 		return
 	}
@@ -531,43 +687,50 @@ func (m *Manager) ensureCustomInformer(crd *apiextensionsv1.CustomResourceDefini
 		delete(m.customInformers, crd.Name)
 	}
 
-	// One dynamic informer per CRD streams custom resource updates. Under a
-	// namespace scope a namespaced CRD fans out one informer per configured
-	// namespace (the scoped identity typically cannot watch cluster-wide);
-	// the unscoped path is the same loop with a single all-namespaces entry.
+	// One dynamic informer per CRD streams custom resource updates, shared
+	// with the catalog when it has promoted the same kind. Under a namespace
+	// scope a namespaced CRD fans out one informer per configured namespace
+	// (the scoped identity typically cannot watch cluster-wide); the unscoped
+	// path is the same loop with a single all-namespaces entry.
 	namespaces := []string{namespace}
 	if customDomain == domainNamespaceCustom && len(m.allowedNamespaces) > 0 {
 		namespaces = append([]string(nil), m.allowedNamespaces...)
 	}
 	info := &customResourceInformer{
-		gvr:    gvr,
-		kind:   kind,
-		domain: customDomain,
-		stopCh: make(chan struct{}),
+		gvr:            gvr,
+		kind:           kind,
+		domain:         customDomain,
+		printerColumns: printerColumns,
 	}
 	for _, ns := range namespaces {
-		dynamicInformer := dynamicinformer.NewFilteredDynamicInformer(
-			m.dynamicClient,
-			gvr,
-			ns,
-			0,
-			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
-			nil,
-		)
-		informer := dynamicInformer.Informer()
-		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-			AddFunc:    func(obj interface{}) { m.handleCustomResource(obj, MessageTypeAdded, info) },
+		shared, release, ok := m.dynamicInformers.Acquire(gvr, ns)
+		if !ok {
+			continue
+		}
+		// Initial-list adds are existing objects the subscriber's snapshot
+		// already has; the COMPLETE sent on CRD change or activation covers
+		// them. A handler joining an informer that already runs gets the
+		// cached objects the same way.
+		registration, err := shared.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+			AddFunc: func(obj interface{}, isInInitialList bool) {
+				if !isInInitialList {
+					m.handleCustomResource(obj, MessageTypeAdded, info)
+				}
+			},
 			UpdateFunc: func(_, newObj interface{}) { m.handleCustomResource(newObj, MessageTypeModified, info) },
 			DeleteFunc: func(obj interface{}) { m.handleCustomResource(obj, MessageTypeDeleted, info) },
 		})
-		info.informers = append(info.informers, informer)
+		if err != nil {
+			release()
+			m.logWarn(fmt.Sprintf("custom resource handler for %s not installed: %v", gvr.String(), err))
+			continue
+		}
+		info.informers = append(info.informers, shared)
+		info.registrations = append(info.registrations, registration)
+		info.releases = append(info.releases, release)
 	}
 	m.customInformers[crd.Name] = info
 	m.customInformerMu.Unlock()
-
-	for _, informer := range info.informers {
-		go informer.Run(info.stopCh)
-	}
 }
 
 func (m *Manager) removeCustomInformer(crdName string) {
@@ -576,6 +739,7 @@ func (m *Manager) removeCustomInformer(crdName string) {
 	}
 	m.customInformerMu.Lock()
 	defer m.customInformerMu.Unlock()
+	delete(m.customCRDs, crdName)
 	if informer, ok := m.customInformers[crdName]; ok {
 		informer.stop()
 		delete(m.customInformers, crdName)
@@ -609,12 +773,12 @@ func (m *Manager) handleCustomResource(obj interface{}, updateType MessageType,
 		// for both the cluster-scoped and namespace-scoped paths.
 		crdName := info.gvr.Resource + "." + info.gvr.Group
 		if domain == domainClusterCustom {
-			row = customresource.BuildClusterStreamSummary(m.clusterMeta, resource, info.gvr.Group, info.gvr.Version, info.gvr.Resource, info.kind, crdName)
+			row = customresource.BuildClusterStreamSummary(m.clusterMeta, resource, info.gvr.Group, info.gvr.Version, info.gvr.Resource, info.kind, crdName, info.printerColumns...)
 		} else {
 			// The streaming path has no parent scope concept — fall back
 			// to the resource's own namespace (which is almost always
 			// set for anything that reaches an informer).
-			row = customresource.BuildNamespaceStreamSummary(m.clusterMeta, resource, info.gvr.Group, info.gvr.Version, info.gvr.Resource, info.kind, crdName, resource.GetNamespace())
+			row = customresource.BuildNamespaceStreamSummary(m.clusterMeta, resource, info.gvr.Group, info.gvr.Version, info.gvr.Resource, info.kind, crdName, resource.GetNamespace(), info.printerColumns...)
 		}
 	}
 	update := m.newObjectRowUpdate(updateType, domain, resource, ref, row)
@@ -1035,7 +1199,59 @@ func (m *Manager) subscribedScopes(domain string) []string {
 
 func (m *Manager) broadcast(domain string, scopes []string, update Update) {
 	m.invalidateSnapshotDomain(domain)
-	m.streamHub().broadcast(domain, scopes, update)
+	m.snapshotDomainInvalidatorMu.RLock()
+	observer := m.activityObserver
+	m.snapshotDomainInvalidatorMu.RUnlock()
+	if observer != nil {
+		observer(domain)
+	}
+	m.streamHub().broadcast(domain, m.withNamespaceSetScopes(domain, scopes), update)
+}
+
+// withNamespaceSetScopes adds the subscribed namespace-set scopes an update
+// reaches. Updates name their own namespace ("namespace:X"), so a set receives
+// those of its members; an update that names no namespace (only
+// "namespace:all") reaches every set.
+func (m *Manager) withNamespaceSetScopes(domain string, scopes []string) []string {
+	var sets []string
+	for _, scope := range m.subscribedScopes(domain) {
+		if strings.HasPrefix(scope, "namespace:{") {
+			sets = append(sets, scope)
+		}
+	}
+	if len(sets) == 0 {
+		return scopes
+	}
+	namespaces := map[string]bool{}
+	namesAll := false
+	for _, scope := range scopes {
+		value, ok := strings.CutPrefix(strings.TrimSpace(scope), "namespace:")
+		switch {
+		case !ok:
+		case isAllNamespace(value):
+			namesAll = true
+		default:
+			namespaces[value] = true
+		}
+	}
+	if !namesAll && len(namespaces) == 0 {
+		return scopes
+	}
+	expanded := scopes
+	for _, set := range sets {
+		members, _, err := refresh.ParseNamespaceSet(strings.TrimPrefix(set, "namespace:"))
+		if err != nil {
+			continue
+		}
+		reached := len(namespaces) == 0
+		for _, namespace := range members {
+			reached = reached || namespaces[namespace]
+		}
+		if reached {
+			expanded = append(expanded[:len(expanded):len(expanded)], set)
+		}
+	}
+	return expanded
 }
 
 func (m *Manager) prepareBroadcast(domain, scope string, update Update) (Update, []struct {
@@ -1104,7 +1320,7 @@ func (m *Manager) bufferLocked(domain, scope string) *updateBuffer {
 	}
 	buffer := m.buffers[key]
 	if buffer == nil {
-		buffer = newUpdateBuffer(config.ResourceStreamResumeBufferSize)
+		buffer = newUpdateBuffer(m.resumeBufferSizeLocked())
 		m.buffers[key] = buffer
 	}
 	return buffer
@@ -1122,30 +1338,41 @@ func (m *Manager) clearScopeStateLocked(domain, scope string) {
 }
 
 func (m *Manager) dropSubscriber(domain, scope string, id uint64, sub *subscription, reason DropReason) {
+	// Idle scheduling takes customInformerMu, so it runs after m.mu is released.
+	if m.removeSubscriber(domain, scope, id, sub, reason) {
+		m.scheduleCustomDomainIdle(domain)
+	}
+}
+
+// removeSubscriber closes and unregisters sub, reporting whether it was the
+// domain's last subscriber.
+func (m *Manager) removeSubscriber(domain, scope string, id uint64, sub *subscription, reason DropReason) bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	domainSubs, ok := m.subscribers[domain]
 	if !ok {
-		return
+		return false
 	}
 	scopeSubs, ok := domainSubs[scope]
 	if !ok {
-		return
+		return false
 	}
 	current, exists := scopeSubs[id]
 	if !exists || current != sub {
-		return
+		return false
 	}
 	delete(scopeSubs, id)
 	if len(scopeSubs) == 0 {
 		delete(domainSubs, scope)
 		m.clearScopeStateLocked(domain, scope)
 	}
+	sub.close(reason)
 	if len(domainSubs) == 0 {
 		delete(m.subscribers, domain)
+		return true
 	}
-	sub.close(reason)
+	return false
 }
 
 func (m *Manager) trySend(sub *subscription, update Update) (sent bool, closed bool, reset bool) {
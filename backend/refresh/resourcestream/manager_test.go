@@ -373,6 +373,17 @@ func TestManagerResumeReturnsBufferedUpdates(t *testing.T) {
 	require.Equal(t, "pod-2", updates[0].Ref.Name)
 }
 
+func TestManagerSetBufferSizesAppliesToNewSubscribers(t *testing.T) {
+	manager := NewManager(nil, nil, nil, snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"}, nil, nil)
+	manager.SetBufferSizes(8, 0)
+	require.Equal(t, config.ResourceStreamResumeBufferSize, manager.resumeBufferSize, "non-positive keeps the current size")
+
+	sub, err := subscribeForTest(t, manager, domainPods, "namespace:default")
+	require.NoError(t, err)
+	defer sub.Cancel()
+	require.Equal(t, 8, cap(sub.Updates))
+}
+
 func TestManagerChangeSignalInvalidatesSnapshotCacheBeforeDelivery(t *testing.T) {
 	reg := domain.New()
 	builds := 0
@@ -513,6 +524,52 @@ func TestManagerQuotasUpdateBroadcasts(t *testing.T) {
 	}
 }
 
+func TestManagerBroadcastsToNamespaceSetScopes(t *testing.T) {
+	manager := &Manager{
+		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
+		logger:      applog.Noop,
+		subscribers: make(map[string]map[string]map[uint64]*subscription),
+	}
+
+	member, err := subscribeForTest(t, manager, domainNamespaceQuotas, "namespace:{default,team-a}")
+	require.NoError(t, err)
+	other, err := subscribeForTest(t, manager, domainNamespaceQuotas, "namespace:{team-b}")
+	require.NoError(t, err)
+
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "quota-1",
+			Namespace:       "default",
+			UID:             "quota-uid",
+			ResourceVersion: "7",
+		},
+	}
+	manager.streamObjectRowFromDescriptor(quota, MessageTypeAdded, resourcequota.StreamDescriptor)
+
+	select {
+	case update := <-member.Updates:
+		require.Equal(t, "namespace:{default,team-a}", update.Scope)
+		requireUpdateObjectMetadata(t, update, "7", "quota-uid", "quota-1", "default", "ResourceQuota")
+	default:
+		t.Fatal("expected the set containing default to receive the update")
+	}
+	select {
+	case update := <-other.Updates:
+		t.Fatalf("unexpected update for a set without default: %+v", update)
+	default:
+	}
+
+	// An update naming no namespace reaches every set.
+	manager.broadcast(domainNamespaceQuotas, []string{"namespace:all"}, Update{Type: MessageTypeModified, Domain: domainNamespaceQuotas})
+	for _, sub := range []*Subscription{member, other} {
+		select {
+		case <-sub.Updates:
+		default:
+			t.Fatalf("expected %s to receive the all-namespaces update", sub.Scope)
+		}
+	}
+}
+
 func TestManagerClusterConfigUpdateBroadcasts(t *testing.T) {
 	manager := &Manager{
 		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
@@ -710,7 +767,7 @@ func TestManagerSkipsCustomInformerForFirstClassGatewayCRD(t *testing.T) {
 		subscribers:     make(map[string]map[string]map[uint64]*subscription),
 	}
 	manager.customInformers["gateways.gateway.networking.k8s.io"] = &customResourceInformer{
-		stopCh: existingStopCh,
+		releases: []func(){func() { close(existingStopCh) }},
 	}
 
 	crd := &apiextensionsv1.CustomResourceDefinition{
@@ -754,15 +811,101 @@ func TestManagerDoesNotRecreateCustomInformerAfterStop(t *testing.T) {
 
 	// A CRD event arriving after Stop (e.g. an informer resync firing during the
 	// teardown window, before the shared CRD informer is shut down) must not
-	// resurrect a custom informer. Re-creating one here would spawn a goroutine
-	// and a dynamic watch on a stopCh that nothing will ever close — a permanent
-	// goroutine + watch leak.
+	// resurrect a custom informer. Re-creating one here would hold a shared
+	// dynamic watch that nothing will ever release — a permanent goroutine +
+	// watch leak.
 	crd := customResourceDefinition("widgets.example.com", "example.com", "widgets", "Widget", apiextensionsv1.NamespaceScoped, "1")
 	manager.handleCustomResourceDefinition(crd, MessageTypeAdded)
 
 	require.Empty(t, manager.customInformers, "stopped manager must not re-create custom informers")
 }
 
+func TestManagerCustomInformerStripsManagedFields(t *testing.T) {
+	widget := &unstructured.Unstructured{}
+	widget.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"})
+	widget.SetName("widget-1")
+	widget.SetNamespace("default")
+	widget.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}})
+	widget.SetAnnotations(map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		"team": "platform",
+	})
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	manager := &Manager{
+		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
+		logger:      applog.Noop,
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "WidgetList"}, widget),
+		customInformers: make(map[string]*customResourceInformer),
+		subscribers:     make(map[string]map[string]map[uint64]*subscription),
+	}
+	t.Cleanup(manager.Stop)
+
+	crd := customResourceDefinition("widgets.example.com", "example.com", "widgets", "Widget", apiextensionsv1.NamespaceScoped, "1")
+	manager.handleCustomResourceDefinition(crd, MessageTypeAdded)
+	_, err := subscribeForTest(t, manager, domainNamespaceCustom, "namespace:default")
+	require.NoError(t, err)
+	info := manager.customInformers["widgets.example.com"]
+	require.NotNil(t, info)
+	require.Len(t, info.informers, 1)
+	store := info.informers[0].GetStore()
+	require.Eventually(t, func() bool { return len(store.List()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	cached := store.List()[0].(*unstructured.Unstructured)
+	require.Empty(t, cached.GetManagedFields())
+	require.Equal(t, map[string]string{"team": "platform"}, cached.GetAnnotations())
+}
+
+func TestManagerStartsCustomInformersOnDemand(t *testing.T) {
+	widget := &unstructured.Unstructured{}
+	widget.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"})
+	widget.SetName("widget-1")
+	widget.SetNamespace("default")
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	manager := &Manager{
+		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
+		logger:      applog.Noop,
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "WidgetList"}, widget),
+		customInformers: make(map[string]*customResourceInformer),
+		subscribers:     make(map[string]map[string]map[uint64]*subscription),
+	}
+	manager.SetCustomInformerIdleTimeout(50 * time.Millisecond)
+	t.Cleanup(manager.Stop)
+	informerCount := func() int {
+		manager.customInformerMu.Lock()
+		defer manager.customInformerMu.Unlock()
+		return len(manager.customInformers)
+	}
+
+	crd := customResourceDefinition("widgets.example.com", "example.com", "widgets", "Widget", apiextensionsv1.NamespaceScoped, "1")
+	manager.handleCustomResourceDefinition(crd, MessageTypeAdded)
+	require.Zero(t, informerCount(), "no informer before a custom subscriber")
+
+	// A subscriber to another domain does not start custom informers.
+	_, err := subscribeForTest(t, manager, domainClusterCustom, "")
+	require.NoError(t, err)
+	require.Zero(t, informerCount())
+
+	sub, err := subscribeForTest(t, manager, domainNamespaceCustom, "namespace:default")
+	require.NoError(t, err)
+	require.Equal(t, 1, informerCount())
+	// The existing widget is not replayed as an ADDED; a COMPLETE follows the sync.
+	update := requireNextUpdate(t, sub)
+	require.Equal(t, MessageTypeComplete, update.Type)
+	require.Equal(t, domainNamespaceCustom, update.Domain)
+
+	// A returning subscriber inside the idle window keeps the informer.
+	sub.Cancel()
+	again, err := subscribeForTest(t, manager, domainNamespaceCustom, "namespace:default")
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, informerCount())
+
+	again.Cancel()
+	require.Eventually(t, func() bool { return informerCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestManagerCRDSignatureChangeCompletesCustomDomain(t *testing.T) {
 	manager := &Manager{
 		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
@@ -1600,3 +1743,26 @@ func customResourceDefinition(
 func ptrBool(value bool) *bool {
 	return &value
 }
+
+func TestManagerResyncSubscribersCompletesEverySubscribedScope(t *testing.T) {
+	manager := &Manager{
+		clusterMeta: snapshot.ClusterMeta{ClusterID: "c1", ClusterName: "cluster"},
+		logger:      applog.Noop,
+		subscribers: make(map[string]map[string]map[uint64]*subscription),
+	}
+	pods, err := subscribeForTest(t, manager, domainPods, "namespace:default")
+	require.NoError(t, err)
+	custom, err := subscribeForTest(t, manager, domainClusterCustom, "")
+	require.NoError(t, err)
+
+	manager.ResyncSubscribers()
+
+	update := requireNextUpdate(t, pods)
+	require.Equal(t, MessageTypeComplete, update.Type)
+	require.Equal(t, domainPods, update.Domain)
+	require.Equal(t, "namespace:default", update.Scope)
+	require.Equal(t, "c1", update.ClusterID)
+	update = requireNextUpdate(t, custom)
+	require.Equal(t, MessageTypeComplete, update.Type)
+	require.Equal(t, domainClusterCustom, update.Domain)
+}
@@ -19,6 +19,9 @@ const (
 	StreamScopeCluster      StreamScopeKind = "cluster"
 	StreamScopeNamespace    StreamScopeKind = "namespace"
 	StreamScopeAllNamespace StreamScopeKind = "namespace-all"
+	// StreamScopeNamespaceSet selects an explicit set of namespaces
+	// (namespace:{a,b}); it receives the updates of each member namespace.
+	StreamScopeNamespaceSet StreamScopeKind = "namespace-set"
 	StreamScopeNode         StreamScopeKind = "node"
 	StreamScopeWorkload     StreamScopeKind = "workload"
 	// StreamScopeObject pins one Kubernetes object by its object-scope tail
@@ -46,8 +49,11 @@ type StreamSelector struct {
 	Domain    string
 	ScopeKind StreamScopeKind
 	Namespace string
-	Node      string
-	Workload  *WorkloadSelector
+	// Namespaces is the sorted namespace set; non-empty only when
+	// ScopeKind == StreamScopeNamespaceSet.
+	Namespaces []string
+	Node       string
+	Workload   *WorkloadSelector
 	// Object is the validated object-scope tail; non-empty only when
 	// ScopeKind == StreamScopeObject.
 	Object string
@@ -156,6 +162,8 @@ func (s StreamSelector) String() string {
 		return "namespace:all"
 	case StreamScopeNamespace:
 		return "namespace:" + s.Namespace
+	case StreamScopeNamespaceSet:
+		return refresh.NamespaceSetScope(s.Namespaces)
 	case StreamScopeNode:
 		return "node:" + s.Node
 	case StreamScopeWorkload:
@@ -190,6 +198,14 @@ func parsePodSelector(selector StreamSelector, scope string) (StreamSelector, er
 			selector.ScopeKind = StreamScopeAllNamespace
 			return selector, nil
 		}
+		if namespaces, ok, err := refresh.ParseNamespaceSet(value); ok {
+			if err != nil {
+				return StreamSelector{}, fmt.Errorf("pods scope: %w", err)
+			}
+			selector.ScopeKind = StreamScopeNamespaceSet
+			selector.Namespaces = namespaces
+			return selector, nil
+		}
 		selector.ScopeKind = StreamScopeNamespace
 		selector.Namespace = value
 		return selector, nil
@@ -245,6 +261,14 @@ func parseNamespaceSelector(selector StreamSelector, scope string) (StreamSelect
 		selector.ScopeKind = StreamScopeAllNamespace
 		return selector, nil
 	}
+	if namespaces, ok, err := refresh.ParseNamespaceSet(value); ok {
+		if err != nil {
+			return StreamSelector{}, fmt.Errorf("%s scope: %w", selector.Domain, err)
+		}
+		selector.ScopeKind = StreamScopeNamespaceSet
+		selector.Namespaces = namespaces
+		return selector, nil
+	}
 	selector.ScopeKind = StreamScopeNamespace
 	selector.Namespace = value
 	return selector, nil
@@ -75,6 +75,18 @@ func TestParseStreamSelectorRoundTrips(t *testing.T) {
 			scope:  "cluster",
 			want:   StreamSelector{ClusterID: "c1", Domain: "cluster-events", ScopeKind: StreamScopeCluster},
 		},
+		{
+			name:   "pods namespace set",
+			domain: domainPods,
+			scope:  "namespace:{team-a,team-b}",
+			want:   StreamSelector{ClusterID: "c1", Domain: domainPods, ScopeKind: StreamScopeNamespaceSet, Namespaces: []string{"team-a", "team-b"}},
+		},
+		{
+			name:   "namespace set namespace-workloads",
+			domain: domainWorkloads,
+			scope:  "namespace:{team-a,team-b}",
+			want:   StreamSelector{ClusterID: "c1", Domain: domainWorkloads, ScopeKind: StreamScopeNamespaceSet, Namespaces: []string{"team-a", "team-b"}},
+		},
 		{
 			name:   "namespace events doorbell scope",
 			domain: "namespace-events",
@@ -114,6 +126,8 @@ func TestParseStreamSelectorRejectsInvalid(t *testing.T) {
 		{domainPods, "workload:prod:apps", "namespace:group:version:kind:name"},
 		{domainPods, "workload:prod::v1:Deployment:web", "namespace:group:version:kind:name"},
 		{domainNodes, "namespace:prod", "does not accept scope"},
+		{domainPods, "namespace:{}", "namespace set is empty"},
+		{domainWorkloads, "namespace:{prod,Not_Valid}", "invalid namespace"},
 		{"catalog", "limit=50", "does not accept scope"},
 		{"unknown-domain", "anything", "unsupported resource stream domain"},
 	}
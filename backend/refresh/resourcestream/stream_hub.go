@@ -63,31 +63,16 @@ func (h managerStreamHub) subscribe(selector StreamSelector) (*Subscription, err
 
 	id := atomic.AddUint64(&m.nextID, 1)
 	sub := &subscription{
-		ch:      make(chan Update, config.ResourceStreamSubscriberBufferSize),
+		ch:      make(chan Update, m.subscriberBufferSizeLocked()),
 		drops:   make(chan DropReason, 1),
 		created: time.Now(),
 	}
 	subs[id] = sub
 	m.mu.Unlock()
+	m.activateCustomDomain(domain)
 
 	cancel := func() {
-		m.mu.Lock()
-		defer m.mu.Unlock()
-		if domainSubs, ok := m.subscribers[domain]; ok {
-			if scopeSubs, ok := domainSubs[normalized]; ok {
-				if current, exists := scopeSubs[id]; exists && current == sub {
-					delete(scopeSubs, id)
-					if len(scopeSubs) == 0 {
-						delete(domainSubs, normalized)
-						m.clearScopeStateLocked(domain, normalized)
-					}
-					sub.close(DropReasonClosed)
-				}
-			}
-			if len(domainSubs) == 0 {
-				delete(m.subscribers, domain)
-			}
-		}
+		m.dropSubscriber(domain, normalized, id, sub, DropReasonClosed)
 	}
 
 	return &Subscription{
@@ -0,0 +1,175 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/resourcecontract"
+)
+
+const (
+	categoryOverviewDomainName = "cluster-categories"
+	// categoryAll is the category `kubectl get all` lists.
+	categoryAll = "all"
+)
+
+// CategoryOverviewBuilder groups a namespace's objects by API category: the
+// core workload kinds in "all" plus every category custom resources declare,
+// so operator objects show up next to the workloads the way `kubectl get all`
+// would show them if it knew about them. It reads the object catalog, which
+// already lists every kind the identity can see.
+type CategoryOverviewBuilder struct {
+	catalogService func() *objectcatalog.Service
+}
+
+// CategoryOverviewSnapshot is the grouped overview payload.
+type CategoryOverviewSnapshot struct {
+	ClusterMeta
+	// Namespace is the namespace the overview covers; empty covers all.
+	Namespace  string          `json:"namespace,omitempty"`
+	Categories []CategoryGroup `json:"categories"`
+}
+
+// CategoryGroup is one category's kinds. "all" comes first, then the others
+// by name; an object in several categories appears in each.
+type CategoryGroup struct {
+	Category string              `json:"category"`
+	Count    int                 `json:"count"`
+	Kinds    []CategoryKindGroup `json:"kinds"`
+}
+
+// CategoryKindGroup is one kind's objects within a category.
+type CategoryKindGroup struct {
+	Group    string `json:"group"`
+	Version  string `json:"version"`
+	Kind     string `json:"kind"`
+	Resource string `json:"resource"`
+	// Custom marks kinds served by a CRD rather than built into the API server.
+	Custom bool                    `json:"custom,omitempty"`
+	Items  []objectcatalog.Summary `json:"items"`
+}
+
+// RegisterCategoryOverviewDomain wires the cluster-categories domain into the
+// registry.
+func RegisterCategoryOverviewDomain(reg *domain.Registry, catalogService func() *objectcatalog.Service) error {
+	builder := &CategoryOverviewBuilder{catalogService: catalogService}
+	return reg.Register(refresh.DomainConfig{
+		Name:          categoryOverviewDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build groups the scope's namespaced objects by category: empty for every
+// namespace, or a namespace to keep only the objects in it.
+func (b *CategoryOverviewBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, trimmed := refresh.SplitClusterScope(scope)
+	namespace := strings.TrimSpace(trimmed)
+
+	var svc *objectcatalog.Service
+	if b.catalogService != nil {
+		svc = b.catalogService()
+	}
+	if svc == nil {
+		return nil, fmt.Errorf("object catalog not available")
+	}
+
+	payload, version := buildCategoryOverview(svc.Descriptors(), svc.Snapshot(), namespace)
+	payload.ClusterMeta = meta
+	count := 0
+	for _, group := range payload.Categories {
+		count += group.Count
+	}
+	return &refresh.Snapshot{
+		Domain:  categoryOverviewDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, namespace),
+		Version: version,
+		Payload: payload,
+		Stats:   refresh.SnapshotStats{ItemCount: count},
+	}, nil
+}
+
+// buildCategoryOverview groups items of categorized, namespaced descriptors.
+// The version is the newest resource version among the grouped objects.
+func buildCategoryOverview(descriptors []objectcatalog.Descriptor, items []objectcatalog.Summary, namespace string) (CategoryOverviewSnapshot, uint64) {
+	type kindKey struct{ group, kind string }
+	categorized := make(map[kindKey]objectcatalog.Descriptor)
+	for _, desc := range descriptors {
+		if desc.Namespaced && len(desc.Categories) > 0 {
+			categorized[kindKey{desc.Group, desc.Kind}] = desc
+		}
+	}
+
+	byKind := make(map[kindKey][]objectcatalog.Summary)
+	var version uint64
+	for _, item := range items {
+		if item.Ref.Namespace == "" || (namespace != "" && item.Ref.Namespace != namespace) {
+			continue
+		}
+		key := kindKey{item.Ref.Group, item.Ref.Kind}
+		if _, ok := categorized[key]; !ok {
+			continue
+		}
+		byKind[key] = append(byKind[key], item)
+		if v := parseVersion(item.ResourceVersion); v > version {
+			version = v
+		}
+	}
+
+	groups := make(map[string]*CategoryGroup)
+	for key, kindItems := range byKind {
+		desc := categorized[key]
+		sort.Slice(kindItems, func(i, j int) bool {
+			if kindItems[i].Ref.Namespace != kindItems[j].Ref.Namespace {
+				return kindItems[i].Ref.Namespace < kindItems[j].Ref.Namespace
+			}
+			return kindItems[i].Ref.Name < kindItems[j].Ref.Name
+		})
+		_, builtin := resourcecontract.FindBuiltin(desc.Group, desc.Version, desc.Kind)
+		for _, category := range snapshotSortedUniqueStrings(desc.Categories) {
+			group := groups[category]
+			if group == nil {
+				group = &CategoryGroup{Category: category}
+				groups[category] = group
+			}
+			group.Kinds = append(group.Kinds, CategoryKindGroup{
+				Group:    desc.Group,
+				Version:  desc.Version,
+				Kind:     desc.Kind,
+				Resource: desc.Resource,
+				Custom:   !builtin,
+				Items:    kindItems,
+			})
+			group.Count += len(kindItems)
+		}
+	}
+
+	result := CategoryOverviewSnapshot{Namespace: namespace, Categories: make([]CategoryGroup, 0, len(groups))}
+	for _, group := range groups {
+		// Built-in kinds lead, as in kubectl's output; custom kinds follow.
+		sort.Slice(group.Kinds, func(i, j int) bool {
+			a, b := group.Kinds[i], group.Kinds[j]
+			if a.Custom != b.Custom {
+				return !a.Custom
+			}
+			if a.Kind != b.Kind {
+				return a.Kind < b.Kind
+			}
+			return a.Group < b.Group
+		})
+		result.Categories = append(result.Categories, *group)
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		a, b := result.Categories[i].Category, result.Categories[j].Category
+		if (a == categoryAll) != (b == categoryAll) {
+			return a == categoryAll
+		}
+		return a < b
+	})
+	return result, version
+}
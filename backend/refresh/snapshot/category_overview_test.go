@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+func categorySummary(group, version, kind, namespace, name, rv string) objectcatalog.Summary {
+	return objectcatalog.Summary{
+		Ref:             resourcemodel.ResourceRef{ClusterID: "c1", Group: group, Version: version, Kind: kind, Namespace: namespace, Name: name},
+		ResourceVersion: rv,
+		Scope:           objectcatalog.ScopeNamespace,
+	}
+}
+
+func TestBuildCategoryOverviewGroupsBuiltinAndCustomKinds(t *testing.T) {
+	descriptors := []objectcatalog.Descriptor{
+		{Group: "apps", Version: "v1", Resource: "deployments", Kind: "Deployment", Namespaced: true, Categories: []string{"all"}},
+		{Group: "", Version: "v1", Resource: "pods", Kind: "Pod", Namespaced: true, Categories: []string{"all"}},
+		{Group: "", Version: "v1", Resource: "configmaps", Kind: "ConfigMap", Namespaced: true},
+		{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true, Categories: []string{"all", "example"}},
+		{Group: "example.com", Version: "v1", Resource: "clusterwidgets", Kind: "ClusterWidget", Categories: []string{"all"}},
+	}
+	items := []objectcatalog.Summary{
+		categorySummary("", "v1", "Pod", "default", "web-2", "12"),
+		categorySummary("", "v1", "Pod", "default", "web-1", "11"),
+		categorySummary("apps", "v1", "Deployment", "default", "web", "10"),
+		categorySummary("", "v1", "ConfigMap", "default", "settings", "40"),
+		categorySummary("example.com", "v1", "Widget", "default", "gear", "20"),
+		categorySummary("example.com", "v1", "Widget", "other", "cog", "30"),
+	}
+
+	payload, version := buildCategoryOverview(descriptors, items, "default")
+
+	require.Equal(t, "default", payload.Namespace)
+	require.Equal(t, uint64(20), version)
+	require.Len(t, payload.Categories, 2)
+
+	all := payload.Categories[0]
+	require.Equal(t, "all", all.Category)
+	require.Equal(t, 4, all.Count)
+	require.Equal(t, []string{"Deployment", "Pod", "Widget"}, []string{all.Kinds[0].Kind, all.Kinds[1].Kind, all.Kinds[2].Kind})
+	require.False(t, all.Kinds[1].Custom)
+	require.True(t, all.Kinds[2].Custom)
+	require.Equal(t, "web-1", all.Kinds[1].Items[0].Ref.Name)
+
+	example := payload.Categories[1]
+	require.Equal(t, "example", example.Category)
+	require.Equal(t, 1, example.Count)
+
+	everywhere, _ := buildCategoryOverview(descriptors, items, "")
+	require.Equal(t, 5, everywhere.Categories[0].Count)
+}
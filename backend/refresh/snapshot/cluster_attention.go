@@ -143,7 +143,7 @@ func (b *ClusterAttentionBuilder) Build(ctx context.Context, scope string) (*ref
 		b.index.maintained.store,
 		query,
 		availableKinds,
-		"",
+		nil,
 		attentionTableQueryAdapter(),
 		attentionQuerypageSchema(),
 		clusterAttentionQueryCapabilities(),
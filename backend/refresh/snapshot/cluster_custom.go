@@ -176,6 +176,7 @@ func (b *ClusterCustomBuilder) Build(ctx context.Context, scope string) (*refres
 
 			localSummaries := make([]ClusterCustomSummary, 0, len(resourceList.Items))
 			var localVersion uint64
+			printerColumns := customresource.HealthPrinterColumns(crdCopy, crdVersion)
 			for i := range resourceList.Items {
 				item := resourceList.Items[i].DeepCopy()
 				if item == nil {
@@ -198,6 +199,7 @@ func (b *ClusterCustomBuilder) Build(ctx context.Context, scope string) (*refres
 					gvr.Resource,
 					crdCopy.Spec.Names.Kind,
 					crdCopy.Name,
+					printerColumns...,
 				))
 				if v := resourceVersionOrTimestamp(item); v > localVersion {
 					localVersion = v
@@ -83,6 +83,7 @@ func projectClusterEventEntry(meta ClusterMeta, evt *corev1.Event) (ClusterEvent
 		Message:          eventres.EventMessage(evt),
 		Age:              formatAge(timestamp),
 		AgeTimestamp:     timestamp.UnixMilli(),
+		Aggregate:        eventres.BuildAggregate(evt),
 	}, true
 }
 
@@ -97,7 +98,7 @@ type ClusterEventsSnapshot struct {
 
 func clusterEventsQueryCapabilities() ResourceQueryCapabilities {
 	return newTypedResourceCapabilities(
-		[]string{"name", "kind", "type", "source", "reason", "object", "objectType", "objectName", "message", "age"},
+		[]string{"name", "kind", "type", "source", "reason", "object", "objectType", "objectName", "message", "count", "age"},
 		[]string{"kinds"},
 		[]string{"kind", "name", "type", "source", "reason", "object", "message"},
 		nil, // open kind set (involved-object kinds); no kind dropdown
@@ -116,7 +117,7 @@ func clusterEventsQuerypageSchema() querypage.Schema[ClusterEventEntry] {
 	// so "objecttype"/"objectname" still resolve to the right encoders.
 	return querypageSchemaFromAdapter(
 		clusterEventTableQueryAdapter(),
-		[]string{"name", "kind", "type", "source", "reason", "object", "objecttype", "objectname", "message", "age"},
+		[]string{"name", "kind", "type", "source", "reason", "object", "objecttype", "objectname", "message", "count", "age"},
 	)
 }
 
@@ -135,6 +136,9 @@ type ClusterEventEntry struct {
 	Message          string                      `json:"message"`
 	Age              string                      `json:"age"`
 	AgeTimestamp     int64                       `json:"ageTimestamp"`
+	// Aggregate folds in the other Events for the same object, type and
+	// reason; see aggregateEventRows.
+	eventres.Aggregate
 }
 
 // RegisterClusterEventsDomain registers the cluster events domain. It serves from a
@@ -217,6 +221,8 @@ func (b *ClusterEventsBuilder) Build(ctx context.Context, scope string) (*refres
 		}
 	}
 
+	entries = aggregateEventRows(entries, clusterEventAggregateKey, clusterEventAggregate)
+
 	// Window-mode order is most-recent-first with a deterministic name tiebreak.
 	// Apply it before resolving so the engine's query branch (which sorts by the
 	// request's SortField) and the window branch both serve a stable order.
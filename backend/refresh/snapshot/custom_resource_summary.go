@@ -1,23 +1,30 @@
 package snapshot
 
-import "github.com/luxury-yacht/app/backend/resourcemodel"
+import (
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
 
 // CustomResourceSummary is the page-hydration row shape used by catalog-backed
 // custom-resource tables. It preserves the rich status and metadata fields from
 // the legacy namespace/cluster custom snapshot rows without requiring the
 // production Custom tabs to subscribe to full CRD fanout domains.
 type CustomResourceSummary struct {
-	Ref                resourcemodel.ResourceRef      `json:"ref"`
-	CRDName            string                         `json:"crdName,omitempty"`
-	Status             string                         `json:"status,omitempty"`
-	StatusState        string                         `json:"statusState,omitempty"`
-	StatusPresentation string                         `json:"statusPresentation,omitempty"`
-	Ready              *bool                          `json:"ready,omitempty"`
-	ObservedGeneration *int64                         `json:"observedGeneration,omitempty"`
-	Conditions         []resourcemodel.ConditionFacts `json:"conditions,omitempty"`
-	Age                string                         `json:"age"`
-	Labels             map[string]string              `json:"labels,omitempty"`
-	Annotations        map[string]string              `json:"annotations,omitempty"`
+	Ref                resourcemodel.ResourceRef         `json:"ref"`
+	CRDName            string                            `json:"crdName,omitempty"`
+	Status             string                            `json:"status,omitempty"`
+	StatusState        string                            `json:"statusState,omitempty"`
+	StatusPresentation string                            `json:"statusPresentation,omitempty"`
+	Ready              *bool                             `json:"ready,omitempty"`
+	ObservedGeneration *int64                            `json:"observedGeneration,omitempty"`
+	Conditions         []resourcemodel.ConditionFacts    `json:"conditions,omitempty"`
+	Health             string                            `json:"health,omitempty"`
+	HealthReason       string                            `json:"healthReason,omitempty"`
+	Age                string                            `json:"age"`
+	Labels             map[string]string                 `json:"labels,omitempty"`
+	Annotations        map[string]string                 `json:"annotations,omitempty"`
+	Istio              *streamrows.IstioSummary          `json:"istio,omitempty"`
+	VolumeSnapshot     *streamrows.VolumeSnapshotSummary `json:"volumeSnapshot,omitempty"`
 }
 
 func CustomResourceSummaryFromNamespace(row NamespaceCustomSummary) CustomResourceSummary {
@@ -30,9 +37,13 @@ func CustomResourceSummaryFromNamespace(row NamespaceCustomSummary) CustomResour
 		Ready:              row.Ready,
 		ObservedGeneration: row.ObservedGeneration,
 		Conditions:         row.Conditions,
+		Health:             row.Health,
+		HealthReason:       row.HealthReason,
 		Age:                row.Age,
 		Labels:             row.Labels,
 		Annotations:        row.Annotations,
+		Istio:              row.Istio,
+		VolumeSnapshot:     row.VolumeSnapshot,
 	}
 }
 
@@ -46,8 +57,11 @@ func CustomResourceSummaryFromCluster(row ClusterCustomSummary) CustomResourceSu
 		Ready:              row.Ready,
 		ObservedGeneration: row.ObservedGeneration,
 		Conditions:         row.Conditions,
+		Health:             row.Health,
+		HealthReason:       row.HealthReason,
 		Age:                row.Age,
 		Labels:             row.Labels,
 		Annotations:        row.Annotations,
+		VolumeSnapshot:     row.VolumeSnapshot,
 	}
 }
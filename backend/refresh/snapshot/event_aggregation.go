@@ -0,0 +1,46 @@
+package snapshot
+
+import (
+	eventres "github.com/luxury-yacht/app/backend/resources/events"
+)
+
+// aggregateEventRows folds event rows that report the same involved object,
+// type and reason into one row: the most recently seen of them, carrying the
+// summed count and the widened first/last seen window. Busy clusters record a
+// fresh Event object for each recurrence a reporter fails to dedupe (new
+// reporting instance, series rollover), so listing them raw buries the signal.
+// Row order follows each group's first appearance; callers sort afterwards.
+func aggregateEventRows[T any](rows []T, key func(T) string, aggregate func(*T) *eventres.Aggregate) []T {
+	if len(rows) < 2 {
+		return rows
+	}
+	index := make(map[string]int, len(rows))
+	folded := make([]T, 0, len(rows))
+	for _, row := range rows {
+		k := key(row)
+		at, seen := index[k]
+		if !seen {
+			index[k] = len(folded)
+			folded = append(folded, row)
+			continue
+		}
+		merged := aggregate(&folded[at]).Merge(*aggregate(&row))
+		if aggregate(&row).LastSeen > aggregate(&folded[at]).LastSeen {
+			folded[at] = row
+		}
+		*aggregate(&folded[at]) = merged
+	}
+	return folded
+}
+
+func namespaceEventAggregateKey(row EventSummary) string {
+	return eventres.AggregateKey(row.ObjectAPIVersion, row.ObjectNamespace, row.Object, row.ObjectUID, row.Type, row.Reason)
+}
+
+func namespaceEventAggregate(row *EventSummary) *eventres.Aggregate { return &row.Aggregate }
+
+func clusterEventAggregateKey(row ClusterEventEntry) string {
+	return eventres.AggregateKey(row.ObjectAPIVersion, row.ObjectNamespace, row.Object, row.ObjectUID, row.Type, row.Reason)
+}
+
+func clusterEventAggregate(row *ClusterEventEntry) *eventres.Aggregate { return &row.Aggregate }
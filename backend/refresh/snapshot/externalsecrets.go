@@ -0,0 +1,59 @@
+package snapshot
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/externalsecrets"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+)
+
+const externalSecretsDomainName = "cluster-external-secrets"
+
+// ExternalSecretsBuilder serves External Secrets Operator ExternalSecrets and
+// secret stores with their sync status. The operator is an optional install,
+// so its objects are listed per build rather than watched.
+type ExternalSecretsBuilder struct {
+	collector externalsecrets.Collector
+}
+
+// RegisterExternalSecretsDomain wires the cluster-external-secrets domain into
+// the registry.
+func RegisterExternalSecretsDomain(reg *domain.Registry, client dynamic.Interface, meta ClusterMeta) error {
+	builder := &ExternalSecretsBuilder{collector: externalsecrets.Collector{ClusterID: meta.ClusterID, Dynamic: client}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          externalSecretsDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build collects External Secrets state for the scope: empty for the whole
+// cluster, or a namespace to keep only the objects in it. Cluster-scoped
+// stores are only listed for the whole-cluster scope.
+func (b *ExternalSecretsBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, trimmed := refresh.SplitClusterScope(scope)
+	namespace := strings.TrimSpace(trimmed)
+
+	payload, version, err := b.collector.Collect(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	payload.ClusterID = meta.ClusterID
+	payload.ClusterName = meta.ClusterName
+
+	stats := refresh.SnapshotStats{ItemCount: len(payload.ExternalSecrets) + len(payload.SecretStores)}
+	if len(payload.Warnings) > 0 {
+		stats.Warnings = append(stats.Warnings, payload.Warnings...)
+	}
+	return &refresh.Snapshot{
+		Domain:  externalSecretsDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, namespace),
+		Version: version,
+		Payload: *payload,
+		Stats:   stats,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/flux"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+)
+
+const fluxDomainName = "cluster-flux"
+
+// FluxBuilder serves Flux HelmReleases and Kustomizations with their
+// reconciliation status. Flux is an optional install, so its objects are
+// listed per build rather than watched.
+type FluxBuilder struct {
+	collector flux.Collector
+}
+
+// RegisterFluxDomain wires the cluster-flux domain into the registry.
+func RegisterFluxDomain(reg *domain.Registry, client dynamic.Interface, meta ClusterMeta) error {
+	builder := &FluxBuilder{collector: flux.Collector{ClusterID: meta.ClusterID, Dynamic: client}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          fluxDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build collects Flux state for the scope: empty for the whole cluster, or a
+// namespace to keep only the objects in it.
+func (b *FluxBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, trimmed := refresh.SplitClusterScope(scope)
+	namespace := strings.TrimSpace(trimmed)
+
+	payload, version, err := b.collector.Collect(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	payload.ClusterID = meta.ClusterID
+	payload.ClusterName = meta.ClusterName
+
+	stats := refresh.SnapshotStats{ItemCount: len(payload.HelmReleases) + len(payload.Kustomizations)}
+	if len(payload.Warnings) > 0 {
+		stats.Warnings = append(stats.Warnings, payload.Warnings...)
+	}
+	return &refresh.Snapshot{
+		Domain:  fluxDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, namespace),
+		Version: version,
+		Payload: *payload,
+		Stats:   stats,
+	}, nil
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"sort"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	informers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/luxury-yacht/app/backend/refresh/domain"
 	"github.com/luxury-yacht/app/backend/refresh/querypage"
 	"github.com/luxury-yacht/app/backend/resources/hpa"
+	"github.com/luxury-yacht/app/backend/resources/keda"
 )
 
 const (
@@ -23,9 +27,12 @@ const (
 )
 
 // NamespaceAutoscalingBuilder constructs HPA summaries via the shared typed-table
-// domain skeleton (typed_table_domain.go).
+// domain skeleton (typed_table_domain.go). KEDA ScaledObjects and ScaledJobs are
+// an optional install, so they are listed per build through the dynamic client
+// rather than watched.
 type NamespaceAutoscalingBuilder struct {
 	collectIndexer func(streamspec.Descriptor) cache.Indexer
+	dynamicClient  dynamic.Interface
 	// maintained, when set, is an informer-fed store the builder serves rows from
 	// instead of listing + re-projecting per request. nil falls back to the list path.
 	maintained *typedMaintainedStore[AutoscalingSummary]
@@ -50,7 +57,7 @@ func namespaceAutoscalingQueryCapabilities() ResourceQueryCapabilities {
 	return newTypedResourceCapabilities(
 		[]string{"name", "kind", "namespace", "target", "min", "max", "current", "age"},
 		[]string{"kinds", "namespaces"},
-		[]string{"kind", "name", "namespace", "target", "targetApiVersion"},
+		[]string{"kind", "name", "namespace", "target", "targetApiVersion", "triggers"},
 		[]string{hpa.Identity.Kind},
 	)
 }
@@ -88,6 +95,7 @@ func sortAutoscalingSummaries(resources []AutoscalingSummary) {
 func RegisterNamespaceAutoscalingDomain(
 	reg *domain.Registry,
 	factory informers.SharedInformerFactory,
+	dynamicClient dynamic.Interface,
 	clusterMeta ClusterMeta,
 ) error {
 	if factory == nil {
@@ -104,6 +112,7 @@ func RegisterNamespaceAutoscalingDomain(
 
 	builder := &NamespaceAutoscalingBuilder{
 		collectIndexer: collectIndexer,
+		dynamicClient:  dynamicClient,
 		maintained:     maintained,
 	}
 	return reg.Register(refresh.DomainConfig{
@@ -112,10 +121,48 @@ func RegisterNamespaceAutoscalingDomain(
 	})
 }
 
-// Build assembles HPA summaries for a namespace.
+// Build assembles HPA and KEDA summaries for a namespace.
 func (b *NamespaceAutoscalingBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
-	return buildTypedTableSnapshot(ctx, scope, namespaceAutoscalingDomainSpec(), b.collectIndexer, b.maintained,
+	spec := namespaceAutoscalingDomainSpec()
+	if b.dynamicClient != nil {
+		spec.supplemental = b.listKEDA
+	}
+	return buildTypedTableSnapshot(ctx, scope, spec, b.collectIndexer, b.maintained,
 		func(meta ClusterMeta, envelope ResourceQueryEnvelope, rows []AutoscalingSummary) any {
 			return NamespaceAutoscalingSnapshot{ClusterMeta: meta, ResourceQueryEnvelope: envelope, Rows: rows}
 		})
 }
+
+// listKEDA lists the KEDA ScaledObjects and ScaledJobs in namespace ("" for all).
+// A kind whose CRD is not served contributes no source; any other list error
+// (typically forbidden) reports the kind unavailable so the table flags partial
+// data.
+func (b *NamespaceAutoscalingBuilder) listKEDA(ctx context.Context, meta ClusterMeta, namespace string) ([]AutoscalingSummary, []typedTableResourceSource, uint64) {
+	var rows []AutoscalingSummary
+	var sources []typedTableResourceSource
+	var version uint64
+	for _, identity := range keda.Identities {
+		list, err := b.dynamicClient.Resource(identity.GVR()).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		sources = append(sources, typedTableResourceSource{
+			Kind:      identity.Kind,
+			Group:     identity.Group,
+			Resource:  identity.Resource,
+			Available: err == nil,
+			Listed:    true,
+		})
+		if err != nil {
+			continue
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			rows = append(rows, keda.BuildStreamSummary(meta, identity, item))
+			if v := resourceVersionOrTimestamp(item); v > version {
+				version = v
+			}
+		}
+	}
+	return rows, sources, version
+}
@@ -4,9 +4,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/luxury-yacht/app/backend/resources/keda"
 	"github.com/luxury-yacht/app/backend/testsupport"
 )
 
@@ -102,3 +110,106 @@ func TestBuildSnapshotPopulatesTargetAPIVersion(t *testing.T) {
 		t.Errorf("ack-hpa: Target=%q, want DBCluster/primary", got)
 	}
 }
+
+func scaledObject(ns, name, rv string, triggers ...string) *unstructured.Unstructured {
+	items := make([]any, 0, len(triggers))
+	for _, trigger := range triggers {
+		items = append(items, map[string]any{"type": trigger})
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "keda.sh/v1alpha1",
+		"kind":       "ScaledObject",
+		"metadata":   map[string]any{"name": name, "namespace": ns, "resourceVersion": rv},
+		"spec": map[string]any{
+			"scaleTargetRef":  map[string]any{"name": name},
+			"maxReplicaCount": int64(20),
+			"triggers":        items,
+		},
+	}}
+}
+
+// kedaDynamicClient serves ScaledObjects; ScaledJobs are served unless
+// scaledJobsInstalled is false, in which case listing them returns NotFound
+// as it does for a CRD that is not installed.
+func kedaDynamicClient(scaledJobsInstalled bool, objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		keda.ScaledObjectIdentity.GVR(): "ScaledObjectList",
+		keda.ScaledJobIdentity.GVR():    "ScaledJobList",
+	}, objects...)
+	if !scaledJobsInstalled {
+		client.PrependReactor("list", keda.ScaledJobIdentity.Resource, func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewNotFound(keda.ScaledJobIdentity.GVR().GroupResource(), "")
+		})
+	}
+	return client
+}
+
+func TestBuildSnapshotIncludesKEDAScaledObjects(t *testing.T) {
+	hpaIdx := testsupport.NewNamespacedIndexer(t, hpaObj("default", "web-hpa", "5", "web", 4))
+	builder := &NamespaceAutoscalingBuilder{
+		collectIndexer: autoscalingCollectIndexer(hpaIdx),
+		dynamicClient: kedaDynamicClient(false,
+			scaledObject("default", "worker", "9", "prometheus", "cron"),
+			scaledObject("other", "ignored", "11", "kafka"),
+		),
+	}
+
+	snap, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+	payload := snap.Payload.(NamespaceAutoscalingSnapshot)
+	require.Len(t, payload.Rows, 2)
+	row := findAutoscalingRow(payload.Rows, "ScaledObject", "default", "worker")
+	require.NotNil(t, row)
+	require.Equal(t, []string{"prometheus", "cron"}, row.Triggers)
+	require.Equal(t, int32(20), row.Max)
+	require.Equal(t, uint64(9), snap.Version)
+	require.ElementsMatch(t, []string{"HorizontalPodAutoscaler", "ScaledObject"}, payload.Capabilities.KindVocabulary,
+		"a KEDA kind whose CRD is not served stays out of the kind vocabulary")
+	require.Empty(t, payload.Issues)
+}
+
+func TestBuildSnapshotReportsForbiddenKEDAListAsPartial(t *testing.T) {
+	client := kedaDynamicClient(false)
+	client.PrependReactor("list", keda.ScaledObjectIdentity.Resource, func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(keda.ScaledObjectIdentity.GVR().GroupResource(), "", nil)
+	})
+	builder := &NamespaceAutoscalingBuilder{
+		collectIndexer: autoscalingCollectIndexer(newNamespaceIndexer()),
+		dynamicClient:  client,
+	}
+
+	snap, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+	issues := snap.Payload.(NamespaceAutoscalingSnapshot).Issues
+	require.Len(t, issues, 1)
+	require.Equal(t, "ScaledObject", issues[0].Kind)
+}
+
+func TestMaintainedAutoscalingSyncsKEDARows(t *testing.T) {
+	meta := ClusterMeta{ClusterID: "c1", ClusterName: "cluster-one"}
+	hpaIdx := newNamespaceIndexer()
+	worker := scaledObject("default", "worker", "9", "prometheus")
+	client := kedaDynamicClient(true, worker, scaledObject("default", "batch", "10", "cron"))
+	builder := &NamespaceAutoscalingBuilder{
+		collectIndexer: autoscalingCollectIndexer(hpaIdx),
+		dynamicClient:  client,
+		maintained:     newTypedMaintainedStore(meta, autoscalingQuerypageSchema(), autoscalingTableQueryAdapter()),
+	}
+
+	snap, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+	require.Len(t, snap.Payload.(NamespaceAutoscalingSnapshot).Rows, 2)
+	first := snap.Version
+
+	again, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+	require.Equal(t, first, again.Version, "an unchanged list keeps the version")
+
+	require.NoError(t, client.Tracker().Delete(keda.ScaledObjectIdentity.GVR(), "default", "batch"))
+	pruned, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+	rows := pruned.Payload.(NamespaceAutoscalingSnapshot).Rows
+	require.Len(t, rows, 1)
+	require.Equal(t, "worker", rows[0].Ref.Name)
+	require.Greater(t, pruned.Version, first, "a removed KEDA object advances the version")
+}
@@ -172,7 +172,11 @@ func (b *NamespaceCustomBuilder) Build(ctx context.Context, scope string) (*refr
 			// The all-namespaces view under a scope fans out over the
 			// configured namespaces; the unscoped path is the same loop with
 			// a single all-namespaces target.
+			// A namespace set lists each of its namespaces.
 			listTargets := []string{parsedScope.Namespace}
+			if len(parsedScope.Namespaces) > 0 {
+				listTargets = parsedScope.Namespaces
+			}
 			if parsedScope.AllNamespaces {
 				listTargets = []string{metav1.NamespaceAll}
 				if len(b.scope) > 0 {
@@ -215,6 +219,7 @@ func (b *NamespaceCustomBuilder) Build(ctx context.Context, scope string) (*refr
 
 			items := make([]NamespaceCustomSummary, 0, len(resourceList.Items))
 			var snapshotVersion uint64
+			printerColumns := customresource.HealthPrinterColumns(crdCopy, crdVersion)
 			for i := range resourceList.Items {
 				item := &resourceList.Items[i]
 				// Delegate to the shared row builder so the full-snapshot
@@ -233,6 +238,7 @@ func (b *NamespaceCustomBuilder) Build(ctx context.Context, scope string) (*refr
 					crdCopy.Spec.Names.Kind,
 					crdCopy.Name,
 					parsedScope.Namespace,
+					printerColumns...,
 				))
 				if v := resourceVersionOrTimestamp(item); v > snapshotVersion {
 					snapshotVersion = v
@@ -412,6 +412,7 @@ func TestNamespaceEventsBuilderUsesEventTimestampOrdering(t *testing.T) {
 		InvolvedObject: corev1.ObjectReference{
 			Namespace: "default",
 		},
+		Reason:        "Pulled",
 		LastTimestamp: metav1.NewTime(newer),
 	}
 	eventB := &corev1.Event{
@@ -423,6 +424,7 @@ func TestNamespaceEventsBuilderUsesEventTimestampOrdering(t *testing.T) {
 		InvolvedObject: corev1.ObjectReference{
 			Namespace: "default",
 		},
+		Reason:        "Scheduled",
 		LastTimestamp: metav1.NewTime(older),
 	}
 
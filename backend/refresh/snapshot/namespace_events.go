@@ -62,6 +62,7 @@ func projectNamespaceEventSummary(meta ClusterMeta, event *corev1.Event) (EventS
 		Message:          facts.Message,
 		Age:              formatAge(timestamp),
 		AgeTimestamp:     timestamp.UnixMilli(),
+		Aggregate:        eventres.BuildAggregate(event),
 	}, true
 }
 
@@ -74,7 +75,7 @@ type NamespaceEventsSnapshot struct {
 
 func namespaceEventsQueryCapabilities() ResourceQueryCapabilities {
 	return newTypedResourceCapabilities(
-		[]string{"name", "kind", "namespace", "type", "source", "reason", "object", "objectType", "objectName", "message", "age"},
+		[]string{"name", "kind", "namespace", "type", "source", "reason", "object", "objectType", "objectName", "message", "count", "age"},
 		[]string{"kinds", "namespaces"},
 		[]string{"kind", "name", "namespace", "type", "source", "reason", "object", "message"},
 		nil, // open kind set (involved-object kinds); no kind dropdown
@@ -94,7 +95,7 @@ func namespaceEventsQuerypageSchema() querypage.Schema[EventSummary] {
 	// so "objecttype"/"objectname" still resolve to the right encoders.
 	return querypageSchemaFromAdapter(
 		namespacedEventTableQueryAdapter(),
-		[]string{"name", "kind", "namespace", "type", "source", "reason", "object", "objecttype", "objectname", "message", "age"},
+		[]string{"name", "kind", "namespace", "type", "source", "reason", "object", "objecttype", "objectname", "message", "count", "age"},
 	)
 }
 
@@ -114,6 +115,9 @@ type EventSummary struct {
 	Message          string                      `json:"message"`
 	Age              string                      `json:"age"`
 	AgeTimestamp     int64                       `json:"ageTimestamp"`
+	// Aggregate folds in the other Events for the same object, type and
+	// reason; see aggregateEventRows.
+	eventres.Aggregate
 }
 
 // RegisterNamespaceEventsDomain registers the events domain. It serves from a maintained
@@ -182,7 +186,7 @@ func (b *NamespaceEventsBuilder) Build(ctx context.Context, scope string) (*refr
 		if !parsedScope.AllNamespaces {
 			ns = parsedScope.Namespace
 		}
-		summaries = b.maintained.rowsInNamespace(ns)
+		summaries = filterNamespaceRows(parsedScope, b.maintained.rowsInNamespace(ns), func(e EventSummary) string { return e.ObjectNamespace })
 		version = b.maintained.snapshotVersion()
 	} else {
 		var events []*corev1.Event
@@ -196,7 +200,7 @@ func (b *NamespaceEventsBuilder) Build(ctx context.Context, scope string) (*refr
 			if !keep {
 				continue
 			}
-			if !parsedScope.AllNamespaces && summary.ObjectNamespace != parsedScope.Namespace {
+			if !parsedScope.Includes(summary.ObjectNamespace) {
 				continue
 			}
 			summaries = append(summaries, summary)
@@ -206,6 +210,8 @@ func (b *NamespaceEventsBuilder) Build(ctx context.Context, scope string) (*refr
 		}
 	}
 
+	summaries = aggregateEventRows(summaries, namespaceEventAggregateKey, namespaceEventAggregate)
+
 	// Window-mode order is most-recent-first with a deterministic name tiebreak.
 	// Apply it before resolving so the engine's query branch (which sorts by the
 	// request's SortField) and the window branch both serve a stable order.
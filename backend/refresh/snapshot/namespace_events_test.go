@@ -153,3 +153,47 @@ func TestNamespaceEventsBuilderUsesDeterministicTieBreakers(t *testing.T) {
 	require.Equal(t, "event-high-rv", payload.Rows[0].Ref.Name)
 	require.Equal(t, "event-low-rv", payload.Rows[1].Ref.Name)
 }
+
+func TestNamespaceEventsBuilderAggregatesRecurringEvents(t *testing.T) {
+	base := time.Now().Add(-30 * time.Minute)
+	pod := corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "api-0", UID: types.UID("pod-uid")}
+	backoff := func(name string, count int32, first, last time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container " + name,
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(base.Add(first)),
+			LastTimestamp:  metav1.NewTime(base.Add(last)),
+			InvolvedObject: pod,
+		}
+	}
+	pulled := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "pulled", Namespace: "default"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Pulled",
+		LastTimestamp:  metav1.NewTime(base),
+		InvolvedObject: pod,
+	}
+
+	builder := &NamespaceEventsBuilder{
+		eventLister: testsupport.NewEventLister(t, backoff("backoff-a", 6, 0, 5*time.Minute), backoff("backoff-b", 4, 6*time.Minute, 10*time.Minute), pulled),
+	}
+	snapshot, err := builder.Build(context.Background(), "namespace:default")
+	require.NoError(t, err)
+
+	payload, ok := snapshot.Payload.(NamespaceEventsSnapshot)
+	require.True(t, ok)
+	require.Len(t, payload.Rows, 2)
+	folded := payload.Rows[0]
+	require.Equal(t, "backoff-b", folded.Ref.Name, "the most recent event represents the group")
+	require.Equal(t, "Back-off restarting failed container backoff-b", folded.Message)
+	require.Equal(t, int32(10), folded.Count)
+	require.Equal(t, base.UnixMilli(), folded.FirstSeen)
+	require.Equal(t, base.Add(10*time.Minute).UnixMilli(), folded.LastSeen)
+	require.Equal(t, 1.0, folded.RatePerMinute)
+	require.Equal(t, "pulled", payload.Rows[1].Ref.Name)
+	require.Equal(t, int32(1), payload.Rows[1].Count)
+	require.Zero(t, payload.Rows[1].RatePerMinute)
+}
@@ -237,6 +237,7 @@ func (b *NamespaceHelmBuilder) Build(ctx context.Context, scope string) (*refres
 		}
 		summaries, version = mapHelmReleases(releases, namespaceFilter, meta)
 	}
+	summaries = filterNamespaceRows(parsedScope, summaries, func(s NamespaceHelmSummary) string { return s.Ref.Namespace })
 	// The lister + latest-revision map yield no particular order; builds must
 	// be deterministic for stable snapshot checksums.
 	sort.Slice(summaries, func(i, j int) bool {
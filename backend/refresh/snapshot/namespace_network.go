@@ -214,6 +214,7 @@ func (b *NamespaceNetworkBuilder) Build(ctx context.Context, scope string) (*ref
 		))
 		version = b.maintained.snapshotVersion()
 	}
+	ownRows = filterNamespaceRows(parsedScope, ownRows, func(row NetworkSummary) string { return row.Ref.Namespace })
 
 	resources := make([]NetworkSummary, 0, len(ownRows))
 	// Service rows re-join the endpoint count from the EndpointSlice store's join facts,
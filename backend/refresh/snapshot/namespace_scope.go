@@ -2,20 +2,52 @@ package snapshot
 
 import (
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/luxury-yacht/app/backend/refresh"
 )
 
 // NamespaceSnapshotScope is the parsed identity for namespace-scoped snapshot
-// builders. Namespace is empty only when AllNamespaces is true.
+// builders. Exactly one of Namespace, AllNamespaces, and Namespaces (an
+// explicit namespace set, sorted) is set.
 type NamespaceSnapshotScope struct {
 	ClusterID      string
 	Namespace      string
 	AllNamespaces  bool
+	Namespaces     []string
 	CanonicalScope string
 }
 
+// Includes reports whether the scope selects namespace.
+func (s NamespaceSnapshotScope) Includes(namespace string) bool {
+	switch {
+	case s.AllNamespaces:
+		return true
+	case len(s.Namespaces) > 0:
+		_, found := slices.BinarySearch(s.Namespaces, namespace)
+		return found
+	default:
+		return namespace == s.Namespace
+	}
+}
+
+// filterNamespaceRows keeps the rows in the scope's namespaces. Builders list
+// a namespace set like all namespaces (Namespace is empty) and filter here;
+// the other scopes list exactly their rows, so only a set filters.
+func filterNamespaceRows[T any](scope NamespaceSnapshotScope, rows []T, namespaceOf func(T) string) []T {
+	if len(scope.Namespaces) == 0 {
+		return rows
+	}
+	kept := make([]T, 0, len(rows))
+	for _, row := range rows {
+		if scope.Includes(namespaceOf(row)) {
+			kept = append(kept, row)
+		}
+	}
+	return kept
+}
+
 func parseNamespaceSnapshotScope(scope, requiredMessage string) (NamespaceSnapshotScope, error) {
 	clusterID, trimmed := refresh.SplitClusterScope(scope)
 	trimmed = strings.TrimSpace(trimmed)
@@ -31,6 +63,17 @@ func parseNamespaceSnapshotScope(scope, requiredMessage string) (NamespaceSnapsh
 		}, nil
 	}
 
+	if namespaces, ok, err := refresh.ParseNamespaceSet(namespaceScopeValue(trimmed)); ok {
+		if err != nil {
+			return NamespaceSnapshotScope{}, err
+		}
+		return NamespaceSnapshotScope{
+			ClusterID:      clusterID,
+			Namespaces:     namespaces,
+			CanonicalScope: refresh.JoinClusterScope(clusterID, refresh.NamespaceSetScope(namespaces)),
+		}, nil
+	}
+
 	namespace, err := parseNamespaceScopeValue(trimmed, requiredMessage)
 	if err != nil {
 		return NamespaceSnapshotScope{}, err
@@ -43,17 +86,22 @@ func parseNamespaceSnapshotScope(scope, requiredMessage string) (NamespaceSnapsh
 }
 
 func parseNamespaceScopeValue(scope, requiredMessage string) (string, error) {
+	namespace := namespaceScopeValue(scope)
+	if namespace == "" {
+		return "", errors.New(requiredMessage)
+	}
+	return namespace, nil
+}
+
+// namespaceScopeValue strips the cluster prefix and "namespace:" from scope.
+func namespaceScopeValue(scope string) string {
 	_, scopeValue := refresh.SplitClusterScope(scope)
 	namespace := strings.TrimSpace(scopeValue)
 	if strings.HasPrefix(namespace, "namespace:") {
 		namespace = strings.TrimPrefix(namespace, "namespace:")
 		namespace = strings.TrimLeft(namespace, ":")
 	}
-	namespace = strings.TrimSpace(namespace)
-	if namespace == "" {
-		return "", errors.New(requiredMessage)
-	}
-	return namespace, nil
+	return strings.TrimSpace(namespace)
 }
 
 func isAllNamespaceScope(scope string) bool {
@@ -91,3 +91,33 @@ func TestParseNamespaceSnapshotScopeRequiresScope(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNamespaceSnapshotScopeNamespaceSet(t *testing.T) {
+	got, err := parseNamespaceSnapshotScope("cluster-a|namespace:{team-b, team-a,team-b}", "namespace scope is required")
+	if err != nil {
+		t.Fatalf("parseNamespaceSnapshotScope() error = %v", err)
+	}
+	if got.Namespace != "" || got.AllNamespaces {
+		t.Fatalf("set scope parsed as single or all namespaces: %+v", got)
+	}
+	if len(got.Namespaces) != 2 || got.Namespaces[0] != "team-a" || got.Namespaces[1] != "team-b" {
+		t.Fatalf("Namespaces = %v, want [team-a team-b]", got.Namespaces)
+	}
+	if got.CanonicalScope != "cluster-a|namespace:{team-a,team-b}" {
+		t.Fatalf("CanonicalScope = %q", got.CanonicalScope)
+	}
+	if !got.Includes("team-a") || got.Includes("team-c") {
+		t.Fatalf("Includes does not follow the set")
+	}
+
+	rows := filterNamespaceRows(got, []string{"team-c", "team-a", "team-b"}, func(row string) string { return row })
+	if len(rows) != 2 || rows[0] != "team-a" || rows[1] != "team-b" {
+		t.Fatalf("filterNamespaceRows = %v, want [team-a team-b]", rows)
+	}
+
+	for _, scope := range []string{"namespace:{}", "namespace:{team-a,Bad_Name}"} {
+		if _, err := parseNamespaceSnapshotScope(scope, "namespace scope is required"); err == nil {
+			t.Fatalf("expected %q to be rejected", scope)
+		}
+	}
+}
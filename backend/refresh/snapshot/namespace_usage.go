@@ -0,0 +1,198 @@
+/*
+ * backend/refresh/snapshot/namespace_usage.go
+ *
+ * The cluster-namespace-usage domain: per-namespace totals of pod CPU/memory requests and
+ * limits, live usage from the metrics provider, and object counts, ordered by consumption so
+ * the namespaces overview leads with the namespaces using the most. Requests and limits sum
+ * the active pods' regular containers, matching the namespaces domain's reservation columns;
+ * object counts come from the ingest stores' catalog rows, so the domain adds no informer or
+ * list of its own.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/refresh/metrics"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	"github.com/luxury-yacht/app/backend/resources/configmap"
+	"github.com/luxury-yacht/app/backend/resources/persistentvolumeclaim"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+)
+
+const namespaceUsageDomainName = "cluster-namespace-usage"
+
+// namespaceUsageCountedKinds are the kinds counted per namespace besides pods, which are
+// counted from their aggregates. A kind the identity cannot list has no store and simply
+// counts nothing.
+var namespaceUsageCountedKinds = []schema.GroupVersionResource{
+	DeploymentGVR, StatefulSetGVR, DaemonSetGVR, JobGVR, CronJobGVR,
+	ServiceGVR, IngressGVR,
+	configmap.Identity.GVR(), secret.Identity.GVR(), persistentvolumeclaim.Identity.GVR(),
+}
+
+// namespaceUsageIngestSource is the slice of the ingest manager the domain reads.
+type namespaceUsageIngestSource interface {
+	podAggregateIngestSource
+	CatalogRows(gvr schema.GroupVersionResource) []interface{}
+}
+
+// NamespaceUsageBuilder rolls pod aggregates, metrics and catalog rows up by namespace.
+type NamespaceUsageBuilder struct {
+	ingest  namespaceUsageIngestSource
+	metrics metrics.Provider
+}
+
+// NamespaceUsageSnapshot is the payload for the cluster-namespace-usage domain.
+type NamespaceUsageSnapshot struct {
+	ClusterMeta
+	// Namespaces are ordered by CPU usage, then CPU requests, then memory, largest first.
+	Namespaces   []NamespaceUsage     `json:"namespaces"`
+	Metrics      PodMetricsInfo       `json:"metrics"`
+	MetricsState NamespaceSignalState `json:"metricsState"`
+}
+
+// NamespaceUsage is one namespace's resource totals.
+type NamespaceUsage struct {
+	Ref                 resourcemodel.ResourceRef `json:"ref"`
+	Pods                int                       `json:"pods"`
+	CPURequestsMilli    int64                     `json:"cpuRequestsMilli"`
+	CPULimitsMilli      int64                     `json:"cpuLimitsMilli"`
+	MemoryRequestsBytes int64                     `json:"memoryRequestsBytes"`
+	MemoryLimitsBytes   int64                     `json:"memoryLimitsBytes"`
+	// CPUUsageMilli and MemoryUsageBytes are zero unless MetricsState is available.
+	CPUUsageMilli    int64 `json:"cpuUsageMilli"`
+	MemoryUsageBytes int64 `json:"memoryUsageBytes"`
+	// ObjectCounts maps each counted kind other than Pod to the number of its objects in
+	// the namespace; kinds with none are left out.
+	ObjectCounts map[string]int `json:"objectCounts"`
+}
+
+// RegisterNamespaceUsageDomain registers the cluster-namespace-usage domain. ingestManager
+// may be nil in a unit test, in which case only metrics are read.
+func RegisterNamespaceUsageDomain(reg *domain.Registry, ingestManager *ingest.IngestManager, provider metrics.Provider) error {
+	builder := &NamespaceUsageBuilder{metrics: provider}
+	if ingestManager != nil {
+		builder.ingest = ingestManager
+	}
+	return reg.Register(refresh.DomainConfig{
+		Name:          namespaceUsageDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build returns every namespace with pods, usage or counted objects.
+func (b *NamespaceUsageBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, _ := refresh.SplitClusterScope(scope)
+
+	var (
+		aggregates []streamrows.PodAggregate
+		catalog    []objectcatalog.Summary
+		version    uint64
+	)
+	if b.ingest != nil {
+		aggregates = podAggregatesFromIngest(b.ingest)
+		for _, gvr := range namespaceUsageCountedKinds {
+			for _, row := range b.ingest.CatalogRows(gvr) {
+				if summary, ok := row.(objectcatalog.Summary); ok {
+					catalog = append(catalog, summary)
+				}
+			}
+		}
+		// Pods move the totals most; the counted kinds ride the build cadence.
+		version = podIngestVersion(b.ingest)
+	}
+	usage, metricsInfo, metricsState, metricsRevision := namespaceUtilizationRollups(b.metrics)
+
+	payload := buildNamespaceUsage(meta.ClusterID, aggregates, catalog, usage)
+	payload.ClusterMeta = meta
+	payload.Metrics = metricsInfo
+	payload.MetricsState = metricsState
+	return &refresh.Snapshot{
+		Domain:         namespaceUsageDomainName,
+		Scope:          refresh.JoinClusterScope(clusterID, ""),
+		Version:        version,
+		SourceVersions: metricSourceVersions(metricsRevision),
+		Payload:        payload,
+		Stats:          refresh.SnapshotStats{ItemCount: len(payload.Namespaces)},
+	}, nil
+}
+
+// buildNamespaceUsage sums the pod aggregates, catalog rows and metrics rollups by
+// namespace. Finished pods count as objects but no longer hold their requests.
+func buildNamespaceUsage(clusterID string, aggregates []streamrows.PodAggregate, catalog []objectcatalog.Summary, usage map[string]namespaceUtilization) NamespaceUsageSnapshot {
+	byNamespace := make(map[string]*NamespaceUsage)
+	entry := func(namespace string) *NamespaceUsage {
+		item, ok := byNamespace[namespace]
+		if !ok {
+			item = &NamespaceUsage{
+				Ref:          resourcemodel.NewResourceRef(clusterID, "", "v1", "Namespace", "namespaces", "", namespace, ""),
+				ObjectCounts: map[string]int{},
+			}
+			byNamespace[namespace] = item
+		}
+		return item
+	}
+
+	for _, agg := range aggregates {
+		if agg.Namespace == "" {
+			continue
+		}
+		item := entry(agg.Namespace)
+		item.Pods++
+		if agg.Phase == string(corev1.PodSucceeded) || agg.Phase == string(corev1.PodFailed) {
+			continue
+		}
+		item.CPURequestsMilli += agg.CPURequestMilli
+		item.CPULimitsMilli += agg.CPULimitMilli
+		item.MemoryRequestsBytes += agg.MemRequestBytes
+		item.MemoryLimitsBytes += agg.MemLimitBytes
+	}
+	for _, summary := range catalog {
+		if summary.Ref.Namespace == "" || summary.Ref.Kind == "" {
+			continue
+		}
+		entry(summary.Ref.Namespace).ObjectCounts[summary.Ref.Kind]++
+	}
+	for namespace, utilization := range usage {
+		item := entry(namespace)
+		item.CPUUsageMilli = utilization.cpuMilli
+		item.MemoryUsageBytes = utilization.memoryBytes
+	}
+
+	payload := NamespaceUsageSnapshot{Namespaces: make([]NamespaceUsage, 0, len(byNamespace))}
+	for _, item := range byNamespace {
+		payload.Namespaces = append(payload.Namespaces, *item)
+	}
+	sortNamespaceUsage(payload.Namespaces)
+	return payload
+}
+
+func sortNamespaceUsage(items []NamespaceUsage) {
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch {
+		case a.CPUUsageMilli != b.CPUUsageMilli:
+			return a.CPUUsageMilli > b.CPUUsageMilli
+		case a.CPURequestsMilli != b.CPURequestsMilli:
+			return a.CPURequestsMilli > b.CPURequestsMilli
+		case a.MemoryUsageBytes != b.MemoryUsageBytes:
+			return a.MemoryUsageBytes > b.MemoryUsageBytes
+		case a.MemoryRequestsBytes != b.MemoryRequestsBytes:
+			return a.MemoryRequestsBytes > b.MemoryRequestsBytes
+		}
+		return strings.Compare(a.Ref.Name, b.Ref.Name) < 0
+	})
+}
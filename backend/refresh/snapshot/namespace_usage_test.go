@@ -0,0 +1,91 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/refresh/metrics"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	"github.com/luxury-yacht/app/backend/resources/configmap"
+)
+
+func TestNamespaceUsageBuilderRollsUpByNamespace(t *testing.T) {
+	usagePod := func(namespace, name string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+		pod := packingTestPod(name, "node-a", phase, cpu, memory)
+		pod.Namespace = namespace
+		return pod
+	}
+	catalogRow := func(kind, namespace, name string) interface{} {
+		return objectcatalog.Summary{Ref: resourcemodel.ResourceRef{Kind: kind, Namespace: namespace, Name: name}}
+	}
+
+	meta := ClusterMeta{ClusterID: "c1", ClusterName: "one"}
+	source := newFakePodAggregateSource(nil,
+		usagePod("team-a", "api-1", corev1.PodRunning, "500m", "256Mi"),
+		usagePod("team-a", "api-2", corev1.PodRunning, "500m", "256Mi"),
+		usagePod("team-a", "migrate", corev1.PodSucceeded, "2", "1Gi"),
+		usagePod("team-b", "batch", corev1.PodRunning, "2", "2Gi"),
+	)
+	source.resourceVersion = "17"
+	source.workloadCatalog = map[schema.GroupVersionResource][]interface{}{
+		DeploymentGVR:            {catalogRow("Deployment", "team-a", "api")},
+		configmap.Identity.GVR(): {catalogRow("ConfigMap", "team-a", "settings"), catalogRow("ConfigMap", "idle", "leftover")},
+	}
+	provider := fakeMetricsProvider{
+		podUsage: map[string]metrics.PodUsage{
+			"team-a/api-1": {CPUUsageMilli: 900, MemoryUsageBytes: 300 << 20},
+			"team-a/api-2": {CPUUsageMilli: 800, MemoryUsageBytes: 200 << 20},
+			"team-b/batch": {CPUUsageMilli: 100, MemoryUsageBytes: 1 << 30},
+		},
+		metadata: metrics.Metadata{CollectedAt: time.Unix(1700000000, 0)},
+	}
+
+	builder := &NamespaceUsageBuilder{ingest: source, metrics: provider}
+	snap, err := builder.Build(WithClusterMeta(context.Background(), meta), "c1|")
+	require.NoError(t, err)
+	require.Equal(t, namespaceUsageDomainName, snap.Domain)
+	require.Equal(t, uint64(17), snap.Version)
+	require.NotEmpty(t, snap.SourceVersions["metric"])
+
+	payload, ok := snap.Payload.(NamespaceUsageSnapshot)
+	require.True(t, ok)
+	require.Equal(t, "c1", payload.ClusterID)
+	require.Equal(t, NamespaceSignalAvailable, payload.MetricsState)
+	require.Len(t, payload.Namespaces, 3)
+
+	// Ordered by CPU usage: team-a uses more than team-b despite requesting less.
+	teamA := payload.Namespaces[0]
+	require.Equal(t, "team-a", teamA.Ref.Name)
+	require.Equal(t, "c1", teamA.Ref.ClusterID)
+	require.Equal(t, 3, teamA.Pods)
+	require.Equal(t, int64(1000), teamA.CPURequestsMilli, "finished pods hold no requests")
+	require.Equal(t, int64(512<<20), teamA.MemoryRequestsBytes)
+	require.Equal(t, int64(1700), teamA.CPUUsageMilli)
+	require.Equal(t, int64(500<<20), teamA.MemoryUsageBytes)
+	require.Equal(t, map[string]int{"Deployment": 1, "ConfigMap": 1}, teamA.ObjectCounts)
+
+	require.Equal(t, "team-b", payload.Namespaces[1].Ref.Name)
+	require.Equal(t, int64(2000), payload.Namespaces[1].CPURequestsMilli)
+
+	idle := payload.Namespaces[2]
+	require.Equal(t, "idle", idle.Ref.Name)
+	require.Zero(t, idle.Pods)
+	require.Equal(t, map[string]int{"ConfigMap": 1}, idle.ObjectCounts)
+}
+
+func TestNamespaceUsageBuilderWithoutSourcesServesEmptyPayload(t *testing.T) {
+	builder := &NamespaceUsageBuilder{}
+	snap, err := builder.Build(context.Background(), "")
+	require.NoError(t, err)
+	payload, ok := snap.Payload.(NamespaceUsageSnapshot)
+	require.True(t, ok)
+	require.NotNil(t, payload.Namespaces)
+	require.Empty(t, payload.Namespaces)
+	require.Equal(t, NamespaceSignalUnavailable, payload.MetricsState)
+}
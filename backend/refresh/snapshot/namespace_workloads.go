@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"sort"
 	"strconv"
@@ -255,16 +256,24 @@ func (b *NamespaceWorkloadsBuilder) Build(ctx context.Context, scope string) (*r
 	// The workload OWN-rows come from the Sink-fed maintained store, scope-filtered to the
 	// namespace ("" = all namespaces) and the kinds this request is permitted to read — the
 	// SAME per-kind runtime gate the typed path applied.
-	ownRows := b.workloadOwnRows(ctx, namespace)
+	ownRows := filterNamespaceRows(parsedScope, b.workloadOwnRows(ctx, namespace), func(row WorkloadSummary) string { return row.Ref.Namespace })
 
 	var (
 		podAggregates []streamrows.PodAggregate
 		podSummaries  map[string]streamrows.PodSummary
 	)
 	if b.includePods && b.podIngest != nil && runtimeResourceAllowed(ctx, namespaceWorkloadsDomainName, "", "pods") {
-		if parsedScope.AllNamespaces {
+		switch {
+		case parsedScope.AllNamespaces:
 			podAggregates, podSummaries = workloadOwnerPodRowsFromIngest(b.podIngest, ownRows)
-		} else {
+		case len(parsedScope.Namespaces) > 0:
+			podSummaries = make(map[string]streamrows.PodSummary)
+			for _, setNamespace := range parsedScope.Namespaces {
+				aggregates, summaries := namespacePodRowsFromIngest(b.podIngest, setNamespace)
+				podAggregates = append(podAggregates, aggregates...)
+				maps.Copy(podSummaries, summaries)
+			}
+		default:
 			podAggregates, podSummaries = namespacePodRowsFromIngest(b.podIngest, namespace)
 		}
 	}
@@ -0,0 +1,193 @@
+/*
+ * backend/refresh/snapshot/node_packing.go
+ *
+ * The cluster-node-packing domain: every node's allocatable CPU/memory/pods alongside the pods
+ * bound to it and their requests, so the UI can draw a bin-packing view (fragmented nodes,
+ * pods that dominate a node). It reads the same ingest rows the nodes domain joins at serve —
+ * the node bundles (Table=NodeSummary own-row for identity and pod allocatable,
+ * Aggregate=nodeOverviewFact for CPU/memory allocatable) and the pod aggregates — so it adds no
+ * informer or list of its own.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+const nodePackingDomainName = "cluster-node-packing"
+
+// NodePackingBuilder groups the projected pod aggregates by node for the packing view.
+type NodePackingBuilder struct {
+	ingest nodeDomainIngestSource
+}
+
+// NodePackingSnapshot is the payload for the cluster-node-packing domain.
+type NodePackingSnapshot struct {
+	ClusterMeta
+	Nodes []NodePackingNode `json:"nodes"`
+	// UnscheduledPods are pending pods not yet bound to a node: the demand the current
+	// packing could not place.
+	UnscheduledPods []NodePackingPod `json:"unscheduledPods,omitempty"`
+}
+
+// NodePackingNode is one bin: the node's allocatable resources and the pods packed into it.
+type NodePackingNode struct {
+	Ref                    resourcemodel.ResourceRef `json:"ref"`
+	Ready                  bool                      `json:"ready"`
+	Unschedulable          bool                      `json:"unschedulable"`
+	AllocatableCPUMilli    int64                     `json:"allocatableCpuMilli"`
+	AllocatableMemoryBytes int64                     `json:"allocatableMemoryBytes"`
+	AllocatablePods        int64                     `json:"allocatablePods"`
+	RequestedCPUMilli      int64                     `json:"requestedCpuMilli"`
+	RequestedMemoryBytes   int64                     `json:"requestedMemoryBytes"`
+	// Pods are ordered by CPU request, largest first, so the pods that dominate a node lead.
+	Pods []NodePackingPod `json:"pods"`
+}
+
+// NodePackingPod is one item in a bin. Requests sum regular and init containers, matching
+// the node's request totals in the nodes domain.
+type NodePackingPod struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Owner is the controlling workload as "Kind/name" (ReplicaSets collapse to their
+	// Deployment); empty for a bare pod.
+	Owner           string `json:"owner,omitempty"`
+	Phase           string `json:"phase"`
+	CPURequestMilli int64  `json:"cpuRequestMilli"`
+	MemRequestBytes int64  `json:"memRequestBytes"`
+}
+
+// RegisterNodePackingDomain registers the cluster-node-packing domain. It reads the node and
+// pod ingest stores; ingestManager may be nil in a unit test, in which case no rows are read.
+func RegisterNodePackingDomain(reg *domain.Registry, ingestManager *ingest.IngestManager) error {
+	builder := &NodePackingBuilder{}
+	if ingestManager != nil {
+		builder.ingest = ingestManager
+	}
+	return reg.Register(refresh.DomainConfig{
+		Name:          nodePackingDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build returns every node with the pods bound to it.
+func (b *NodePackingBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, _ := refresh.SplitClusterScope(scope)
+
+	var (
+		bundles    []interface{}
+		aggregates []streamrows.PodAggregate
+		version    uint64
+	)
+	if b.ingest != nil {
+		bundles = b.ingest.Rows(NodeGVR)
+		aggregates = podAggregatesFromIngest(b.ingest)
+		// Two-store watermark, as in the nodes domain: a pod bind or delete moves the bins.
+		version = nodeDomainIngestVersion(b.ingest)
+	}
+
+	payload := buildNodePacking(bundles, aggregates)
+	payload.ClusterMeta = meta
+	return &refresh.Snapshot{
+		Domain:  nodePackingDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, ""),
+		Version: version,
+		Payload: payload,
+		Stats:   refresh.SnapshotStats{ItemCount: len(payload.Nodes)},
+	}, nil
+}
+
+// buildNodePacking joins the node bundles with the pod aggregates. Pods that finished
+// (Succeeded/Failed) no longer hold their requests on the node and are left out; pods bound
+// to a node the store does not hold yet are dropped rather than invented as a bin.
+func buildNodePacking(bundles []interface{}, aggregates []streamrows.PodAggregate) NodePackingSnapshot {
+	payload := NodePackingSnapshot{Nodes: make([]NodePackingNode, 0, len(bundles))}
+
+	active := make([]streamrows.PodAggregate, 0, len(aggregates))
+	for _, agg := range aggregates {
+		switch corev1.PodPhase(agg.Phase) {
+		case corev1.PodSucceeded, corev1.PodFailed:
+			continue
+		}
+		if agg.NodeName == "" {
+			payload.UnscheduledPods = append(payload.UnscheduledPods, nodePackingPod(agg))
+			continue
+		}
+		active = append(active, agg)
+	}
+	podsByNode := podAggregatesByNode(active)
+
+	for _, raw := range bundles {
+		bundle, ok := raw.(ingest.Bundle)
+		if !ok {
+			continue
+		}
+		own, ok := bundle.Table.(streamrows.NodeSummary)
+		if !ok {
+			continue
+		}
+		fact, _ := bundle.Aggregate.(nodeOverviewFact)
+		node := NodePackingNode{
+			Ref:                    own.Ref,
+			Ready:                  fact.Ready,
+			Unschedulable:          own.Unschedulable,
+			AllocatableCPUMilli:    fact.AllocatableCPUMilli,
+			AllocatableMemoryBytes: fact.AllocatableMemoryBytes,
+			AllocatablePods:        nodePodsCapacityValue(own.PodsAllocatable),
+		}
+		pods := podsByNode[own.Ref.Name]
+		node.Pods = make([]NodePackingPod, 0, len(pods))
+		for _, agg := range pods {
+			pod := nodePackingPod(agg)
+			node.RequestedCPUMilli += pod.CPURequestMilli
+			node.RequestedMemoryBytes += pod.MemRequestBytes
+			node.Pods = append(node.Pods, pod)
+		}
+		sortNodePackingPods(node.Pods)
+		payload.Nodes = append(payload.Nodes, node)
+	}
+
+	sort.Slice(payload.Nodes, func(i, j int) bool {
+		return payload.Nodes[i].Ref.Name < payload.Nodes[j].Ref.Name
+	})
+	sortNodePackingPods(payload.UnscheduledPods)
+	return payload
+}
+
+func nodePackingPod(agg streamrows.PodAggregate) NodePackingPod {
+	return NodePackingPod{
+		Namespace:       agg.Namespace,
+		Name:            agg.Name,
+		Owner:           strings.TrimPrefix(agg.OwnerKey, agg.Namespace+"/"),
+		Phase:           agg.Phase,
+		CPURequestMilli: agg.CPURequestMilli + agg.InitCPURequestMilli,
+		MemRequestBytes: agg.MemRequestBytes + agg.InitMemRequestBytes,
+	}
+}
+
+func sortNodePackingPods(pods []NodePackingPod) {
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].CPURequestMilli != pods[j].CPURequestMilli {
+			return pods[i].CPURequestMilli > pods[j].CPURequestMilli
+		}
+		if pods[i].MemRequestBytes != pods[j].MemRequestBytes {
+			return pods[i].MemRequestBytes > pods[j].MemRequestBytes
+		}
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
@@ -0,0 +1,112 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func packingTestPod(name, nodeName string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	controller := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "ReplicaSet",
+				Name:       "web-7d4b9c8f6d",
+				Controller: &controller,
+			}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestNodePackingBuilderGroupsPodsByNode(t *testing.T) {
+	node := func(name string, ready bool) *corev1.Node {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+					corev1.ResourcePods:   resource.MustParse("110"),
+				},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			},
+		}
+	}
+
+	meta := ClusterMeta{ClusterID: "c1", ClusterName: "one"}
+	source := newFakePodAggregateSource(nil,
+		packingTestPod("small", "node-b", corev1.PodRunning, "100m", "128Mi"),
+		packingTestPod("large", "node-b", corev1.PodRunning, "2", "1Gi"),
+		packingTestPod("done", "node-b", corev1.PodSucceeded, "1", "1Gi"),
+		packingTestPod("waiting", "", corev1.PodPending, "500m", "256Mi"),
+		packingTestPod("orphan", "node-gone", corev1.PodRunning, "1", "1Gi"),
+	).withNodes(meta, "", node("node-b", true), node("node-a", false))
+	source.resourceVersion = "42"
+
+	builder := &NodePackingBuilder{ingest: source}
+	snap, err := builder.Build(WithClusterMeta(context.Background(), meta), "c1|")
+	require.NoError(t, err)
+	require.Equal(t, nodePackingDomainName, snap.Domain)
+	require.Equal(t, uint64(42), snap.Version)
+
+	payload, ok := snap.Payload.(NodePackingSnapshot)
+	require.True(t, ok)
+	require.Equal(t, "c1", payload.ClusterID)
+	require.Len(t, payload.Nodes, 2)
+
+	empty := payload.Nodes[0]
+	require.Equal(t, "node-a", empty.Ref.Name)
+	require.False(t, empty.Ready)
+	require.Empty(t, empty.Pods)
+
+	busy := payload.Nodes[1]
+	require.Equal(t, "node-b", busy.Ref.Name)
+	require.True(t, busy.Ready)
+	require.Equal(t, int64(4000), busy.AllocatableCPUMilli)
+	require.Equal(t, int64(8<<30), busy.AllocatableMemoryBytes)
+	require.Equal(t, int64(110), busy.AllocatablePods)
+	require.Equal(t, int64(2100), busy.RequestedCPUMilli)
+	require.Equal(t, int64(1<<30+128<<20), busy.RequestedMemoryBytes)
+	require.Len(t, busy.Pods, 2)
+	require.Equal(t, "large", busy.Pods[0].Name)
+	require.Equal(t, "Deployment/web", busy.Pods[0].Owner)
+	require.Equal(t, "small", busy.Pods[1].Name)
+
+	require.Len(t, payload.UnscheduledPods, 1)
+	require.Equal(t, "waiting", payload.UnscheduledPods[0].Name)
+	require.Equal(t, int64(500), payload.UnscheduledPods[0].CPURequestMilli)
+}
+
+func TestNodePackingBuilderWithoutIngestServesEmptyPayload(t *testing.T) {
+	builder := &NodePackingBuilder{}
+	snap, err := builder.Build(context.Background(), "")
+	require.NoError(t, err)
+	payload, ok := snap.Payload.(NodePackingSnapshot)
+	require.True(t, ok)
+	require.NotNil(t, payload.Nodes)
+	require.Empty(t, payload.Nodes)
+}
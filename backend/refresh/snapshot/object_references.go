@@ -0,0 +1,160 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+
+	"k8s.io/client-go/informers"
+	gatewayinformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+)
+
+const objectReferencesDomain = "object-references"
+
+// ObjectReferrer is one object that references the target, with the relationship
+// it declares (e.g. a Pod that "uses" a ConfigMap via volume.configMap).
+type ObjectReferrer struct {
+	Ref      ObjectMapReference `json:"ref"`
+	Type     string             `json:"type"`
+	Label    string             `json:"label"`
+	TracedBy string             `json:"tracedBy,omitempty"`
+}
+
+// ObjectReferencesSnapshotPayload is returned by the object-references refresh
+// domain: every object in the cluster that references the target.
+type ObjectReferencesSnapshotPayload struct {
+	ClusterMeta
+	Target    ObjectMapReference `json:"target"`
+	Referrers []ObjectReferrer   `json:"referrers"`
+	Warnings  []string           `json:"warnings,omitempty"`
+}
+
+// objectReferencesBuilder answers "what references this object" from the same
+// informer- and ingest-backed index as the object map, so the answer tracks the
+// watch streams without extra LIST calls.
+type objectReferencesBuilder struct {
+	objectMapBuilder
+}
+
+// RegisterObjectReferencesDomain wires the reverse reference domain into the
+// registry. It takes the same sources as RegisterObjectMapDomain.
+func RegisterObjectReferencesDomain(
+	reg *domain.Registry,
+	shared informers.SharedInformerFactory,
+	permissions objectMapPermissionChecker,
+	gatewayShared gatewayinformers.SharedInformerFactory,
+	gatewayPresence objectMapGatewayPresence,
+	catalogService func() *objectcatalog.Service,
+	ingestSource objectMapIngestSource,
+	allowedNamespaces []string,
+) error {
+	if shared == nil {
+		return fmt.Errorf("shared informer factory is required for object references domain")
+	}
+	builder := &objectReferencesBuilder{objectMapBuilder{
+		gatewayShared:     gatewayShared,
+		gatewayPresence:   gatewayPresence,
+		catalogService:    catalogService,
+		shared:            shared,
+		permissions:       permissions,
+		ingest:            ingestSource,
+		allowedNamespaces: append([]string(nil), allowedNamespaces...),
+	}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          objectReferencesDomain,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+func (b *objectReferencesBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	identity, err := parseObjectScope(scope)
+	if err != nil {
+		return nil, err
+	}
+	if identity.GVK.Group == "" && identity.GVK.Version == "" {
+		return nil, fmt.Errorf("object-references scope for %s/%s is missing group/version", identity.GVK.Kind, identity.Name)
+	}
+
+	assembler, err := b.newObjectMapAssembler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := assembler.index.findIdentity(identity.Namespace, identity.GVK, identity.Name)
+	if !ok {
+		return nil, fmt.Errorf("object-references target not found: %s/%s %s/%s", identity.GVK.Group, identity.GVK.Version, identity.GVK.Kind, identity.Name)
+	}
+
+	referrers := assembler.index.referrersOf(target)
+	payload := ObjectReferencesSnapshotPayload{
+		ClusterMeta: assembler.meta,
+		Target:      target.ref,
+		Referrers:   referrers,
+		Warnings:    assembler.index.warnings,
+	}
+	return &refresh.Snapshot{
+		Domain:  objectReferencesDomain,
+		Scope:   scope,
+		Version: 0,
+		Payload: payload,
+		Stats: refresh.SnapshotStats{
+			ItemCount:    len(referrers),
+			TotalItems:   len(referrers),
+			Warnings:     assembler.index.warnings,
+			IsFinalBatch: true,
+			BatchSize:    len(referrers),
+			TotalBatches: 1,
+		},
+	}, nil
+}
+
+// referrersOf returns the records whose declared relationship edges resolve to
+// target, sorted by referrer. Ownership is left out: owners are already shown on
+// the object itself, and the object map covers the ownership tree.
+func (idx *objectMapIndex) referrersOf(target *objectMapRecord) []ObjectReferrer {
+	targetID := objectMapNodeID(target.ref)
+	seen := make(map[string]struct{})
+	referrers := []ObjectReferrer{}
+	for _, record := range idx.records {
+		sourceID := objectMapNodeID(record.ref)
+		if sourceID == targetID {
+			continue
+		}
+		for _, e := range idx.recordEdges(record) {
+			if !slices.ContainsFunc(idx.resolveEdgeTargets(record, e), func(candidate *objectMapRecord) bool {
+				return candidate != nil && objectMapNodeID(candidate.ref) == targetID
+			}) {
+				continue
+			}
+			relationship := objectMapRelationships[e.Type]
+			label := e.Label
+			if label == "" {
+				label = relationship.label
+			}
+			tracedBy := e.TracedBy
+			if tracedBy == "" {
+				tracedBy = relationship.defaultTracedBy
+			}
+			key := strings.Join([]string{sourceID, e.Type, tracedBy}, "|")
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			referrers = append(referrers, ObjectReferrer{Ref: record.ref, Type: e.Type, Label: label, TracedBy: tracedBy})
+		}
+	}
+	slices.SortFunc(referrers, func(a, b ObjectReferrer) int {
+		if c := compareObjectMapRefs(a.Ref, b.Ref); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TracedBy, b.TracedBy)
+	})
+	return referrers
+}
@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newObjectReferencesTestBuilder(t *testing.T, objects ...runtime.Object) *objectReferencesBuilder {
+	t.Helper()
+	return &objectReferencesBuilder{*newObjectMapTestBuilder(t, fake.NewSimpleClientset(objects...))}
+}
+
+func referrerSummaries(payload ObjectReferencesSnapshotPayload) []string {
+	out := make([]string, 0, len(payload.Referrers))
+	for _, referrer := range payload.Referrers {
+		out = append(out, referrer.Ref.Kind+"/"+referrer.Ref.Name+" "+referrer.Label+" "+referrer.TracedBy)
+	}
+	return out
+}
+
+func TestObjectReferencesListsConfigMapAndServiceReferrers(t *testing.T) {
+	builder := newObjectReferencesTestBuilder(t, objectMapFixtureObjects()...)
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a", ClusterName: "Cluster A"})
+
+	snap, err := builder.Build(ctx, "cluster-a|default:/v1:ConfigMap:app-config")
+	require.NoError(t, err)
+	payload := snap.Payload.(ObjectReferencesSnapshotPayload)
+	require.Equal(t, objectReferencesDomain, snap.Domain)
+	require.Equal(t, "ConfigMap", payload.Target.Kind)
+	require.Equal(t, "cluster-a", payload.Target.ClusterID)
+	require.Equal(t, []string{
+		"Deployment/web uses volume.configMap",
+		"Pod/web-pod uses envFrom.configMapRef",
+		"Pod/web-pod uses volume.configMap",
+	}, referrerSummaries(payload))
+	require.Equal(t, 3, snap.Stats.ItemCount)
+
+	snap, err = builder.Build(ctx, "cluster-a|default:/v1:Service:web")
+	require.NoError(t, err)
+	payload = snap.Payload.(ObjectReferencesSnapshotPayload)
+	require.Contains(t, referrerSummaries(payload), "Ingress/web routes to spec.backend.service")
+}
+
+func TestObjectReferencesListsRoleBindingsAndServiceAccountUsers(t *testing.T) {
+	objects := append(objectMapFixtureObjects(),
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "reader", Namespace: "default", UID: types.UID("role-uid")}},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "reader-binding", Namespace: "default", UID: types.UID("rb-uid")},
+			RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: "reader"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "builder"}},
+		},
+	)
+	builder := newObjectReferencesTestBuilder(t, objects...)
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a"})
+
+	snap, err := builder.Build(ctx, "cluster-a|default:rbac.authorization.k8s.io/v1:Role:reader")
+	require.NoError(t, err)
+	require.Equal(t, []string{"RoleBinding/reader-binding grants roleRef"}, referrerSummaries(snap.Payload.(ObjectReferencesSnapshotPayload)))
+
+	snap, err = builder.Build(ctx, "cluster-a|default:/v1:ServiceAccount:builder")
+	require.NoError(t, err)
+	summaries := referrerSummaries(snap.Payload.(ObjectReferencesSnapshotPayload))
+	require.Contains(t, summaries, "Deployment/web uses template.spec.serviceAccountName")
+	require.Contains(t, summaries, "Pod/web-pod uses spec.serviceAccountName")
+	require.Contains(t, summaries, "RoleBinding/reader-binding binds subjects")
+}
+
+func TestObjectReferencesRejectsMissingTarget(t *testing.T) {
+	builder := newObjectReferencesTestBuilder(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}})
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a"})
+
+	_, err := builder.Build(ctx, "cluster-a|default:/v1:ConfigMap:missing")
+	require.ErrorContains(t, err, "object-references target not found")
+}
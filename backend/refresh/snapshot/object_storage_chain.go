@@ -0,0 +1,226 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/luxury-yacht/app/backend/objectcatalog"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+
+	"k8s.io/client-go/informers"
+	gatewayinformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+)
+
+const objectStorageChainDomain = "object-storage-chain"
+
+// ObjectStorageChainLink is one object in a storage chain with its object-map
+// status.
+type ObjectStorageChainLink struct {
+	Ref    ObjectMapReference `json:"ref"`
+	Status *ObjectMapStatus   `json:"status,omitempty"`
+}
+
+// ObjectStorageChainPod is a Pod mounting the claim and the node it runs on.
+type ObjectStorageChainPod struct {
+	Ref    ObjectMapReference `json:"ref"`
+	Status *ObjectMapStatus   `json:"status,omitempty"`
+	Node   string             `json:"node,omitempty"`
+}
+
+// ObjectStorageChainSnapshotPayload is returned by the object-storage-chain
+// refresh domain: the claim, volume, storage class, nodes, and mounting pods
+// around a PersistentVolume or PersistentVolumeClaim.
+type ObjectStorageChainSnapshotPayload struct {
+	ClusterMeta
+	Target       ObjectMapReference       `json:"target"`
+	Claim        *ObjectStorageChainLink  `json:"claim,omitempty"`
+	Volume       *ObjectStorageChainLink  `json:"volume,omitempty"`
+	StorageClass *ObjectStorageChainLink  `json:"storageClass,omitempty"`
+	Nodes        []ObjectStorageChainLink `json:"nodes"`
+	Pods         []ObjectStorageChainPod  `json:"pods"`
+	Warnings     []string                 `json:"warnings,omitempty"`
+}
+
+// objectStorageChainBuilder resolves the PV/PVC/Pod chain from the object-map
+// index, following the volume-binding, storage-class, mounts, and schedules
+// edges the map already derives.
+type objectStorageChainBuilder struct {
+	objectMapBuilder
+}
+
+// RegisterObjectStorageChainDomain wires the storage chain domain into the
+// registry. It takes the same sources as RegisterObjectMapDomain.
+func RegisterObjectStorageChainDomain(
+	reg *domain.Registry,
+	shared informers.SharedInformerFactory,
+	permissions objectMapPermissionChecker,
+	gatewayShared gatewayinformers.SharedInformerFactory,
+	gatewayPresence objectMapGatewayPresence,
+	catalogService func() *objectcatalog.Service,
+	ingestSource objectMapIngestSource,
+	allowedNamespaces []string,
+) error {
+	if shared == nil {
+		return fmt.Errorf("shared informer factory is required for object storage chain domain")
+	}
+	builder := &objectStorageChainBuilder{objectMapBuilder{
+		gatewayShared:     gatewayShared,
+		gatewayPresence:   gatewayPresence,
+		catalogService:    catalogService,
+		shared:            shared,
+		permissions:       permissions,
+		ingest:            ingestSource,
+		allowedNamespaces: append([]string(nil), allowedNamespaces...),
+	}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          objectStorageChainDomain,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+func (b *objectStorageChainBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	identity, err := parseObjectScope(scope)
+	if err != nil {
+		return nil, err
+	}
+	if identity.GVK.Group != "" || identity.GVK.Version != "v1" ||
+		(identity.GVK.Kind != "PersistentVolumeClaim" && identity.GVK.Kind != "PersistentVolume") {
+		return nil, fmt.Errorf("object-storage-chain scope must name a v1 PersistentVolume or PersistentVolumeClaim, got %s/%s %s", identity.GVK.Group, identity.GVK.Version, identity.GVK.Kind)
+	}
+
+	assembler, err := b.newObjectMapAssembler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := assembler.index
+	target, ok := idx.findIdentity(identity.Namespace, identity.GVK, identity.Name)
+	if !ok {
+		return nil, fmt.Errorf("object-storage-chain target not found: %s %s", identity.GVK.Kind, identity.Name)
+	}
+
+	payload := ObjectStorageChainSnapshotPayload{
+		ClusterMeta: assembler.meta,
+		Target:      target.ref,
+		Nodes:       []ObjectStorageChainLink{},
+		Pods:        []ObjectStorageChainPod{},
+		Warnings:    append([]string(nil), idx.warnings...),
+	}
+
+	var claim, volume *objectMapRecord
+	if target.ref.Kind == "PersistentVolumeClaim" {
+		claim = target
+		volume = idx.edgeTarget(claim, objectMapEdgeVolumeBinding)
+		if volume == nil {
+			payload.Warnings = append(payload.Warnings, fmt.Sprintf("PersistentVolumeClaim %s/%s is not bound to a volume", claim.ref.Namespace, claim.ref.Name))
+		}
+	} else {
+		volume = target
+		claim = idx.volumeClaim(volume)
+		if claim == nil {
+			payload.Warnings = append(payload.Warnings, fmt.Sprintf("no claim is bound to PersistentVolume %s", volume.ref.Name))
+		}
+	}
+
+	// A bound claim's storage-class edge is on its volume; an unbound claim
+	// carries its own.
+	var class *objectMapRecord
+	for _, record := range []*objectMapRecord{volume, claim} {
+		if record == nil {
+			continue
+		}
+		if class = idx.edgeTarget(record, objectMapEdgeStorageClass); class != nil {
+			break
+		}
+	}
+
+	payload.Claim = storageChainLink(claim)
+	payload.Volume = storageChainLink(volume)
+	payload.StorageClass = storageChainLink(class)
+	if claim != nil {
+		payload.Pods, payload.Nodes = idx.claimMounts(claim)
+	}
+
+	itemCount := len(payload.Pods) + len(payload.Nodes)
+	return &refresh.Snapshot{
+		Domain:  objectStorageChainDomain,
+		Scope:   scope,
+		Version: 0,
+		Payload: payload,
+		Stats: refresh.SnapshotStats{
+			ItemCount:    itemCount,
+			TotalItems:   itemCount,
+			Warnings:     payload.Warnings,
+			IsFinalBatch: true,
+			BatchSize:    itemCount,
+			TotalBatches: 1,
+		},
+	}, nil
+}
+
+// edgeTarget returns the first resolved target of record's edges of edgeType.
+func (idx *objectMapIndex) edgeTarget(record *objectMapRecord, edgeType string) *objectMapRecord {
+	for _, e := range idx.recordEdges(record) {
+		if e.Type != edgeType {
+			continue
+		}
+		for _, candidate := range idx.resolveEdgeTargets(record, e) {
+			if candidate != nil {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
+
+// volumeClaim returns the claim whose volume-binding edge resolves to volume.
+func (idx *objectMapIndex) volumeClaim(volume *objectMapRecord) *objectMapRecord {
+	for _, referrer := range idx.referrersOf(volume) {
+		if referrer.Type == objectMapEdgeVolumeBinding && referrer.Ref.Kind == "PersistentVolumeClaim" {
+			return idx.byIdent[objectMapIdentityKey(referrer.Ref.Namespace, referrer.Ref.Group, referrer.Ref.Version, referrer.Ref.Kind, referrer.Ref.Name)]
+		}
+	}
+	return nil
+}
+
+// claimMounts returns the pods mounting claim and the nodes those pods are
+// scheduled on, each sorted by name.
+func (idx *objectMapIndex) claimMounts(claim *objectMapRecord) ([]ObjectStorageChainPod, []ObjectStorageChainLink) {
+	pods := []ObjectStorageChainPod{}
+	nodes := []ObjectStorageChainLink{}
+	seenPods := make(map[string]struct{})
+	seenNodes := make(map[string]struct{})
+	for _, referrer := range idx.referrersOf(claim) {
+		if referrer.Type != objectMapEdgeMounts || referrer.Ref.Kind != "Pod" {
+			continue
+		}
+		if _, ok := seenPods[referrer.Ref.Name]; ok {
+			continue
+		}
+		seenPods[referrer.Ref.Name] = struct{}{}
+		pod := idx.byIdent[objectMapIdentityKey(referrer.Ref.Namespace, referrer.Ref.Group, referrer.Ref.Version, referrer.Ref.Kind, referrer.Ref.Name)]
+		if pod == nil {
+			continue
+		}
+		entry := ObjectStorageChainPod{Ref: pod.ref, Status: pod.status}
+		if node := idx.edgeTarget(pod, objectMapEdgeSchedules); node != nil {
+			entry.Node = node.ref.Name
+			if _, ok := seenNodes[node.ref.Name]; !ok {
+				seenNodes[node.ref.Name] = struct{}{}
+				nodes = append(nodes, *storageChainLink(node))
+			}
+		}
+		pods = append(pods, entry)
+	}
+	slices.SortFunc(pods, func(a, b ObjectStorageChainPod) int { return compareObjectMapRefs(a.Ref, b.Ref) })
+	slices.SortFunc(nodes, func(a, b ObjectStorageChainLink) int { return compareObjectMapRefs(a.Ref, b.Ref) })
+	return pods, nodes
+}
+
+func storageChainLink(record *objectMapRecord) *ObjectStorageChainLink {
+	if record == nil {
+		return nil
+	}
+	return &ObjectStorageChainLink{Ref: record.ref, Status: record.status}
+}
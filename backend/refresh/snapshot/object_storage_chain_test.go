@@ -0,0 +1,131 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newObjectStorageChainTestBuilder(t *testing.T, objects ...runtime.Object) *objectStorageChainBuilder {
+	t.Helper()
+	return &objectStorageChainBuilder{*newObjectMapTestBuilder(t, fake.NewSimpleClientset(objects...))}
+}
+
+func storageChainFixtureObjects() []runtime.Object {
+	className := "fast"
+	mountingPod := func(name, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-uid")},
+			Spec: corev1.PodSpec{
+				NodeName: node,
+				Volumes: []corev1.Volume{{Name: "data", VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+				}}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	return []runtime.Object{
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fast", UID: types.UID("sc-uid")}, Provisioner: "ebs.csi.aws.com"},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a", UID: types.UID("node-a-uid")}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b", UID: types.UID("node-b-uid")}},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-data", UID: types.UID("pv-uid")},
+			Spec:       corev1.PersistentVolumeSpec{StorageClassName: "fast"},
+			Status:     corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default", UID: types.UID("pvc-uid")},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-data", StorageClassName: &className},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "default", UID: types.UID("pending-uid")},
+			Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &className},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-released", UID: types.UID("pv-released-uid")},
+			Status:     corev1.PersistentVolumeStatus{Phase: corev1.VolumeReleased},
+		},
+		mountingPod("db-1", "node-b"),
+		mountingPod("db-0", "node-a"),
+		mountingPod("db-2", "node-a"),
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default", UID: types.UID("unrelated-uid")}, Spec: corev1.PodSpec{NodeName: "node-b"}},
+	}
+}
+
+func storageChainNames[T any](items []T, ref func(T) ObjectMapReference) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		out = append(out, ref(item).Name)
+	}
+	return out
+}
+
+func TestObjectStorageChainResolvesFromEitherEnd(t *testing.T) {
+	builder := newObjectStorageChainTestBuilder(t, storageChainFixtureObjects()...)
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a", ClusterName: "Cluster A"})
+
+	for _, scope := range []string{
+		"cluster-a|default:/v1:PersistentVolumeClaim:data",
+		"cluster-a|__cluster__:/v1:PersistentVolume:pv-data",
+	} {
+		snap, err := builder.Build(ctx, scope)
+		require.NoError(t, err, scope)
+		require.Equal(t, objectStorageChainDomain, snap.Domain)
+		payload := snap.Payload.(ObjectStorageChainSnapshotPayload)
+		require.Equal(t, "cluster-a", payload.Target.ClusterID)
+		require.NotNil(t, payload.Claim, scope)
+		require.Equal(t, "data", payload.Claim.Ref.Name)
+		require.NotNil(t, payload.Volume, scope)
+		require.Equal(t, "pv-data", payload.Volume.Ref.Name)
+		require.NotNil(t, payload.StorageClass, scope)
+		require.Equal(t, "fast", payload.StorageClass.Ref.Name)
+		require.Equal(t, []string{"db-0", "db-1", "db-2"}, storageChainNames(payload.Pods, func(p ObjectStorageChainPod) ObjectMapReference { return p.Ref }))
+		require.Equal(t, "node-a", payload.Pods[0].Node)
+		require.Equal(t, "node-b", payload.Pods[1].Node)
+		require.Equal(t, []string{"node-a", "node-b"}, storageChainNames(payload.Nodes, func(n ObjectStorageChainLink) ObjectMapReference { return n.Ref }))
+		require.Empty(t, payload.Warnings)
+		require.Equal(t, 5, snap.Stats.ItemCount)
+	}
+}
+
+func TestObjectStorageChainReportsUnboundEnds(t *testing.T) {
+	builder := newObjectStorageChainTestBuilder(t, storageChainFixtureObjects()...)
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a"})
+
+	snap, err := builder.Build(ctx, "cluster-a|default:/v1:PersistentVolumeClaim:pending")
+	require.NoError(t, err)
+	payload := snap.Payload.(ObjectStorageChainSnapshotPayload)
+	require.Nil(t, payload.Volume)
+	require.NotNil(t, payload.StorageClass, "an unbound claim still names its class")
+	require.Equal(t, "fast", payload.StorageClass.Ref.Name)
+	require.Empty(t, payload.Pods)
+	require.Equal(t, []string{"PersistentVolumeClaim default/pending is not bound to a volume"}, payload.Warnings)
+
+	snap, err = builder.Build(ctx, "cluster-a|__cluster__:/v1:PersistentVolume:pv-released")
+	require.NoError(t, err)
+	payload = snap.Payload.(ObjectStorageChainSnapshotPayload)
+	require.Nil(t, payload.Claim)
+	require.Nil(t, payload.StorageClass)
+	require.Equal(t, []string{"no claim is bound to PersistentVolume pv-released"}, payload.Warnings)
+}
+
+func TestObjectStorageChainRejectsOtherKinds(t *testing.T) {
+	builder := newObjectStorageChainTestBuilder(t, storageChainFixtureObjects()...)
+	ctx := WithClusterMeta(context.Background(), ClusterMeta{ClusterID: "cluster-a"})
+
+	_, err := builder.Build(ctx, "cluster-a|default:/v1:Pod:db-0")
+	require.ErrorContains(t, err, "must name a v1 PersistentVolume or PersistentVolumeClaim")
+
+	_, err = builder.Build(ctx, "cluster-a|default:/v1:PersistentVolumeClaim:missing")
+	require.ErrorContains(t, err, "object-storage-chain target not found")
+}
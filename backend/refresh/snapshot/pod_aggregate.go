@@ -129,9 +129,56 @@ func projectPodAggregateFromSummary(pod *corev1.Pod, sources PodOwnerSources, ow
 		agg.RestartCountContainersInit += status.RestartCount
 	}
 
+	// Last terminations feed the crash-history recorder.
+	agg.LastTerminations = appendContainerTerminations(agg.LastTerminations, pod.Status.InitContainerStatuses, true)
+	agg.LastTerminations = appendContainerTerminations(agg.LastTerminations, pod.Status.ContainerStatuses, false)
+
+	// Container images feed the image index.
+	agg.Images = appendContainerImages(agg.Images, pod.Spec.InitContainers, pod.Status.InitContainerStatuses, true)
+	agg.Images = appendContainerImages(agg.Images, pod.Spec.Containers, pod.Status.ContainerStatuses, false)
+
 	return agg
 }
 
+func appendContainerImages(out []streamrows.ContainerImage, containers []corev1.Container, statuses []corev1.ContainerStatus, init bool) []streamrows.ContainerImage {
+	for _, container := range containers {
+		image := streamrows.ContainerImage{
+			Container:  container.Name,
+			Init:       init,
+			Image:      container.Image,
+			PullPolicy: string(container.ImagePullPolicy),
+		}
+		for _, status := range statuses {
+			if status.Name == container.Name {
+				image.ImageID = status.ImageID
+				break
+			}
+		}
+		out = append(out, image)
+	}
+	return out
+}
+
+func appendContainerTerminations(out []streamrows.ContainerTermination, statuses []corev1.ContainerStatus, init bool) []streamrows.ContainerTermination {
+	for _, status := range statuses {
+		terminated := status.LastTerminationState.Terminated
+		if terminated == nil {
+			continue
+		}
+		out = append(out, streamrows.ContainerTermination{
+			Container:    status.Name,
+			Init:         init,
+			RestartCount: status.RestartCount,
+			ExitCode:     terminated.ExitCode,
+			Signal:       terminated.Signal,
+			Reason:       terminated.Reason,
+			StartedAt:    terminated.StartedAt.Time,
+			FinishedAt:   terminated.FinishedAt.Time,
+		})
+	}
+	return out
+}
+
 func jobOwnerLookupAdapter(lookup func(namespace, jobName string) (JobControllerOwner, bool)) podres.JobControllerOwnerLookup {
 	if lookup == nil {
 		return nil
@@ -178,3 +178,12 @@ func podAggregatesFromIngest(source podAggregateIngestSource) []streamrows.PodAg
 	}
 	return out
 }
+
+// PodAggregates returns every pod's projected PodAggregate row from the ingest
+// manager, for consumers outside the snapshot domains.
+func PodAggregates(ingestManager *ingest.IngestManager) []streamrows.PodAggregate {
+	if ingestManager == nil {
+		return nil
+	}
+	return podAggregatesFromIngest(ingestManager)
+}
@@ -278,6 +278,19 @@ func (s fakePodWorkloadsIngestSource) RowsByIndex(gvr schema.GroupVersionResourc
 	return out
 }
 
+// AggregateRows returns the bundles' Aggregate halves for the pod GVR, so the source also
+// stands in wherever a podAggregateIngestSource is required.
+func (s fakePodWorkloadsIngestSource) AggregateRows(gvr schema.GroupVersionResource) []interface{} {
+	if gvr != PodGVR {
+		return nil
+	}
+	out := make([]interface{}, 0, len(s.bundles))
+	for _, b := range s.bundles {
+		out = append(out, b.Aggregate)
+	}
+	return out
+}
+
 func (s fakePodWorkloadsIngestSource) StoreResourceVersion(gvr schema.GroupVersionResource) string {
 	if gvr != PodGVR {
 		return ""
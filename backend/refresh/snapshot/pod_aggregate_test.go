@@ -1,7 +1,9 @@
 package snapshot
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	podres "github.com/luxury-yacht/app/backend/resources/pods"
@@ -135,8 +137,12 @@ func TestProjectPodAggregateMatchesCurrentComputations(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var rsLister appslisters.ReplicaSetLister
 			got := projectPodAggregate(tc.pod, PodOwnerSources{ReplicaSets: rsLister})
+			// The oracle predates LastTerminations and Images; TestProjectPodAggregateLastTerminations
+			// and TestProjectPodAggregateImages cover them.
+			got.LastTerminations = nil
+			got.Images = nil
 			want := oraclePodAggregate(tc.pod, rsLister)
-			if got != want {
+			if !reflect.DeepEqual(got, want) {
 				t.Fatalf("projectPodAggregate mismatch:\n got=%+v\nwant=%+v", got, want)
 			}
 		})
@@ -257,6 +263,70 @@ func oraclePodAggregate(pod *corev1.Pod, rsLister appslisters.ReplicaSetLister)
 	return agg
 }
 
+func TestProjectPodAggregateLastTerminations(t *testing.T) {
+	finished := metav1.NewTime(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "migrate"}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "sidecar", RestartCount: 0},
+				{
+					Name:         "app",
+					RestartCount: 4,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode:   137,
+						Reason:     "OOMKilled",
+						StartedAt:  metav1.NewTime(finished.Add(-time.Minute)),
+						FinishedAt: finished,
+					}},
+				},
+			},
+		},
+	}
+
+	got := projectPodAggregate(pod, PodOwnerSources{}).LastTerminations
+	want := []streamrows.ContainerTermination{{
+		Container:    "app",
+		RestartCount: 4,
+		ExitCode:     137,
+		Reason:       "OOMKilled",
+		StartedAt:    finished.Add(-time.Minute),
+		FinishedAt:   finished.Time,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("LastTerminations mismatch:\n got=%+v\nwant=%+v", got, want)
+	}
+}
+
+func TestProjectPodAggregateImages(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Image: "registry.example.com/tools/migrate:1.2"}},
+			Containers: []corev1.Container{
+				{Name: "app", Image: "nginx:1.25", ImagePullPolicy: corev1.PullIfNotPresent},
+				{Name: "sidecar", Image: "envoyproxy/envoy:v1.30"},
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", ImageID: "docker.io/library/nginx@sha256:abc"},
+			},
+		},
+	}
+
+	got := projectPodAggregate(pod, PodOwnerSources{}).Images
+	want := []streamrows.ContainerImage{
+		{Container: "migrate", Init: true, Image: "registry.example.com/tools/migrate:1.2"},
+		{Container: "app", Image: "nginx:1.25", ImageID: "docker.io/library/nginx@sha256:abc", PullPolicy: "IfNotPresent"},
+		{Container: "sidecar", Image: "envoyproxy/envoy:v1.30"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Images mismatch:\n got=%+v\nwant=%+v", got, want)
+	}
+}
+
 // oracleClusterOverviewReplicaSetDeploymentMap is the prior cluster-overview RS->
 // Deployment map (the resolution clusterOverviewWorkloadKind keyed off, before
 // PodAggregate.WorkloadKind subsumed it). Kept here as the byte-equivalence oracle for
@@ -87,7 +87,7 @@ func TestNewPodIngestProjectorBundleMatchesLivePaths(t *testing.T) {
 		}
 
 		wantAgg := projectPodAggregate(pod, PodOwnerSources{ReplicaSets: rsLister})
-		if gotAgg, ok := bundle.Aggregate.(streamrows.PodAggregate); !ok || gotAgg != wantAgg {
+		if gotAgg, ok := bundle.Aggregate.(streamrows.PodAggregate); !ok || !reflect.DeepEqual(gotAgg, wantAgg) {
 			t.Fatalf("Aggregate half mismatch for %s/%s:\n got=%#v\nwant=%#v", pod.Namespace, pod.Name, bundle.Aggregate, wantAgg)
 		}
 		if wantIndexes := podAggregateBundleIndexes(wantAgg); !reflect.DeepEqual(bundle.Indexes, wantIndexes) {
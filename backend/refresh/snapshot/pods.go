@@ -424,8 +424,9 @@ func (b *PodBuilder) collectSummaries(meta ClusterMeta, baseScope string) ([]Pod
 	if b.podLister == nil && b.maintained != nil {
 		return b.collectSummariesFromStore(baseScope)
 	}
-	if namespace, ok := podStoreServableNamespace(baseScope); ok && b.maintained != nil {
-		rows := b.maintained.rows(namespace, map[string]bool{podres.Identity.Kind: true})
+	if scope, ok := podStoreServableNamespace(baseScope); ok && b.maintained != nil {
+		rows := b.maintained.rows(scope.Namespace, map[string]bool{podres.Identity.Kind: true})
+		rows = filterNamespaceRows(scope, rows, func(row PodSummary) string { return row.Ref.Namespace })
 		return rows, b.maintained.snapshotVersion(), nil
 	}
 
@@ -473,7 +474,7 @@ func (b *PodBuilder) collectSummariesFromStore(baseScope string) ([]PodSummary,
 // mirrors collectPods' scope parsing, but matches against the rows' already-resolved
 // fields instead of a typed pod: the node scope filters by Node, the workload scope by
 // the resolved owner GVK+name, and the namespace scope by Namespace (all/* = every
-// namespace).
+// namespace, {a,b} = a namespace set).
 func filterPodRowsByScope(rows []PodSummary, scope string) ([]PodSummary, error) {
 	parts := strings.SplitN(scope, ":", 2)
 	if len(parts) != 2 {
@@ -508,6 +509,13 @@ func filterPodRowsByScope(rows []PodSummary, scope string) ([]PodSummary, error)
 		if namespace == "all" || namespace == "*" {
 			return append([]PodSummary(nil), rows...), nil
 		}
+		if namespaces, ok, err := refresh.ParseNamespaceSet(namespace); ok {
+			if err != nil {
+				return nil, err
+			}
+			set := NamespaceSnapshotScope{Namespaces: namespaces}
+			return filterPodRows(rows, func(row PodSummary) bool { return set.Includes(row.Ref.Namespace) }), nil
+		}
 		return filterPodRows(rows, func(row PodSummary) bool { return row.Ref.Namespace == namespace }), nil
 	default:
 		return nil, fmt.Errorf("unsupported pods scope: %s", scope)
@@ -602,23 +610,30 @@ func overlayPodMetrics(rows []PodSummary, podUsage map[string]metrics.PodUsage)
 }
 
 // podStoreServableNamespace reports whether baseScope is a namespace scope the
-// maintained store can serve, returning the namespace to filter by ("" for all
-// namespaces). Node and workload scopes are not store-servable. It remains for the
+// maintained store can serve, returning the parsed scope (a namespace set reads
+// every namespace and filters). Node and workload scopes are not store-servable,
+// nor is a malformed set, which the list path rejects. It remains for the
 // builder that has BOTH a typed lister and a store (no longer the production wiring,
 // but kept so a mixed builder still serves namespace scopes from RAM).
-func podStoreServableNamespace(baseScope string) (string, bool) {
+func podStoreServableNamespace(baseScope string) (NamespaceSnapshotScope, bool) {
 	value, ok := strings.CutPrefix(baseScope, namespaceScopeKey+":")
 	if !ok {
-		return "", false
+		return NamespaceSnapshotScope{}, false
 	}
 	value = strings.TrimSpace(value)
 	if value == "" {
-		return "", false
+		return NamespaceSnapshotScope{}, false
 	}
 	if value == "all" || value == "*" {
-		return "", true
+		return NamespaceSnapshotScope{AllNamespaces: true}, true
+	}
+	if namespaces, ok, err := refresh.ParseNamespaceSet(value); ok {
+		if err != nil {
+			return NamespaceSnapshotScope{}, false
+		}
+		return NamespaceSnapshotScope{Namespaces: namespaces}, true
 	}
-	return value, true
+	return NamespaceSnapshotScope{Namespace: value}, true
 }
 
 func podTableQueryAdapter() typedTableQueryAdapter[PodSummary] {
@@ -786,6 +801,17 @@ func (b *PodBuilder) collectPods(scope string) ([]*corev1.Pod, error) {
 		if namespace == "all" || namespace == "*" {
 			return b.listAllPods()
 		}
+		if namespaces, ok, err := refresh.ParseNamespaceSet(namespace); ok {
+			if err != nil {
+				return nil, err
+			}
+			pods, err := b.listAllPods()
+			if err != nil {
+				return nil, err
+			}
+			set := NamespaceSnapshotScope{Namespaces: namespaces}
+			return filterNamespaceRows(set, pods, func(pod *corev1.Pod) string { return pod.Namespace }), nil
+		}
 		return b.listPodsByNamespace(namespace)
 	default:
 		return nil, fmt.Errorf("unsupported pods scope: %s", scope)
@@ -611,6 +611,50 @@ func TestPodBuilderAllNamespacesScope(t *testing.T) {
 	require.Equal(t, []string{"team-a", "team-b"}, []string{payload.Rows[0].Ref.Namespace, payload.Rows[1].Ref.Namespace})
 }
 
+func TestPodBuilderNamespaceSetScope(t *testing.T) {
+	meta := ClusterMeta{ClusterID: "c-1", ClusterName: "prod"}
+	pods := []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "alpha", Namespace: "team-a", ResourceVersion: "20"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "bravo", Namespace: "team-b", ResourceVersion: "25"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "charlie", Namespace: "team-c", ResourceVersion: "30"}},
+	}
+	rsLister := testsupport.NewReplicaSetLister(t)
+	listBuilder := &PodBuilder{
+		podLister: testsupport.NewPodLister(t, pods...),
+		rsLister:  rsLister,
+	}
+	maintained := newTypedMaintainedStore(meta, podQuerypageSchema(), podTableQueryAdapter())
+	sink := maintained.Sink()
+	for _, pod := range pods {
+		sink.Upsert(podSummaryWithoutMetrics(podres.BuildStreamSummary(meta, pod, 0, 0, rsLister, nil)))
+	}
+	storeBuilder := &PodBuilder{maintained: maintained}
+	// A builder with both a lister and a store serves namespace scopes from the store.
+	mixedBuilder := &PodBuilder{
+		podLister:  testsupport.NewPodLister(t, pods...),
+		rsLister:   rsLister,
+		maintained: maintained,
+	}
+
+	for name, builder := range map[string]*PodBuilder{"list": listBuilder, "store": storeBuilder, "mixed": mixedBuilder} {
+		t.Run(name, func(t *testing.T) {
+			ctx := WithClusterMeta(context.Background(), meta)
+			snapshot, err := builder.Build(ctx, "namespace:{team-c,team-a}")
+			require.NoError(t, err)
+
+			payload := snapshot.Payload.(PodSnapshot)
+			names := make([]string, 0, len(payload.Rows))
+			for _, row := range payload.Rows {
+				names = append(names, row.Ref.Name)
+			}
+			require.Equal(t, []string{"alpha", "charlie"}, names)
+
+			_, err = builder.Build(ctx, "namespace:{Team_A}")
+			require.ErrorContains(t, err, "invalid namespace")
+		})
+	}
+}
+
 // TestPodBuilderWindowScopeOrdersRowsByNamespaceThenName pins the WINDOW branch's
 // (namespace, name) ordering with deliberately scrambled input: the window truncates
 // input order, so an unsorted window would truncate a nondeterministic subset. The
@@ -0,0 +1,247 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsinformers "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
+	apiextensionslisters "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/luxury-yacht/app/backend/policyreport"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+)
+
+const policyReportsDomainName = "cluster-policy-reports"
+
+// PolicyReportDynamicInformers is the cluster's shared dynamic informer
+// registry (informer.DynamicInformers) the domain reads policy objects from.
+type PolicyReportDynamicInformers interface {
+	Acquire(gvr schema.GroupVersionResource, namespace string) (cache.SharedIndexInformer, func(), bool)
+}
+
+// PolicyReportsBuilder serves PolicyReport and Gatekeeper constraint
+// violations from shared informers, started on the first build for each
+// source the cluster serves and the user may list and watch.
+type PolicyReportsBuilder struct {
+	collector policyreport.Collector
+}
+
+// RegisterPolicyReportsDomain wires the cluster-policy-reports domain into the
+// registry. permitted reports whether the user may list and watch a resource;
+// allowedNamespaces is the cluster's namespace scope (empty = cluster-wide).
+func RegisterPolicyReportsDomain(
+	reg *domain.Registry,
+	apiextFactory apiextensionsinformers.SharedInformerFactory,
+	dynamicInformers PolicyReportDynamicInformers,
+	permitted func(group, resource string) bool,
+	allowedNamespaces []string,
+	meta ClusterMeta,
+) error {
+	if apiextFactory == nil {
+		return fmt.Errorf("apiextensions informer factory is nil")
+	}
+	if dynamicInformers == nil {
+		return fmt.Errorf("dynamic informers are not initialised")
+	}
+	crds := apiextFactory.Apiextensions().V1().CustomResourceDefinitions()
+	source := &policyReportInformerSource{
+		informers: dynamicInformers,
+		crdLister: crds.Lister(),
+		crdSynced: crds.Informer().HasSynced,
+		permitted: permitted,
+		scoped:    len(allowedNamespaces) > 0,
+		held:      make(map[policyReportInformerKey]heldPolicyReportInformer),
+	}
+	builder := &PolicyReportsBuilder{collector: policyreport.Collector{
+		ClusterID:  meta.ClusterID,
+		Source:     source,
+		Namespaces: append([]string(nil), allowedNamespaces...),
+	}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          policyReportsDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build collects violations for the scope: empty for the whole cluster, or a
+// single namespace.
+func (b *PolicyReportsBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, trimmed := refresh.SplitClusterScope(scope)
+	namespace := strings.TrimSpace(trimmed)
+
+	payload, version, err := b.collector.Collect(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	payload.ClusterID = meta.ClusterID
+	payload.ClusterName = meta.ClusterName
+
+	stats := refresh.SnapshotStats{ItemCount: len(payload.Violations)}
+	if len(payload.Warnings) > 0 {
+		stats.Warnings = append(stats.Warnings, payload.Warnings...)
+	}
+	return &refresh.Snapshot{
+		Domain:  policyReportsDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, namespace),
+		Version: version,
+		Payload: *payload,
+		Stats:   stats,
+	}, nil
+}
+
+type policyReportInformerKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+type heldPolicyReportInformer struct {
+	informer cache.SharedIndexInformer
+	release  func()
+}
+
+// policyReportInformerSource implements policyreport.Source over shared
+// informers. Whether an API is served comes from the CRD lister, so no
+// informer is started for a policy engine the cluster does not run, and one
+// whose CRD disappears is released.
+type policyReportInformerSource struct {
+	informers PolicyReportDynamicInformers
+	crdLister apiextensionslisters.CustomResourceDefinitionLister
+	crdSynced cache.InformerSynced
+	permitted func(group, resource string) bool
+	// scoped clusters hold one informer per namespace, since the user may not
+	// be able to watch cluster-wide; others hold one for all namespaces.
+	scoped bool
+
+	mu   sync.Mutex
+	held map[policyReportInformerKey]heldPolicyReportInformer
+}
+
+// List implements policyreport.Source.
+func (s *policyReportInformerSource) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]*unstructured.Unstructured, bool, error) {
+	key := policyReportInformerKey{gvr: gvr}
+	if s.scoped {
+		key.namespace = namespace
+	}
+	served, err := s.served(ctx, gvr)
+	if err != nil {
+		return nil, false, err
+	}
+	if !served {
+		s.release(gvr)
+		return nil, false, nil
+	}
+	if s.permitted != nil && !s.permitted(gvr.Group, gvr.Resource) {
+		return nil, false, fmt.Errorf("list and watch are not permitted")
+	}
+
+	inf, err := s.acquire(key)
+	if err != nil {
+		return nil, false, err
+	}
+	// Wait out the informer's initial sync (bounded by the request context)
+	// rather than reporting an empty cache after connect.
+	if !cache.WaitForCacheSync(ctx.Done(), inf.HasSynced) {
+		return nil, false, fmt.Errorf("cache has not finished syncing")
+	}
+	var objects []interface{}
+	if namespace == "" {
+		objects = inf.GetStore().List()
+	} else if objects, err = inf.GetIndexer().ByIndex(cache.NamespaceIndex, namespace); err != nil {
+		return nil, false, err
+	}
+	items := make([]*unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		if item, ok := obj.(*unstructured.Unstructured); ok {
+			items = append(items, item)
+		}
+	}
+	return items, true, nil
+}
+
+// ConstraintResources implements policyreport.Source from the CRD lister.
+func (s *policyReportInformerSource) ConstraintResources(ctx context.Context) ([]schema.GroupVersionResource, bool, error) {
+	if !cache.WaitForCacheSync(ctx.Done(), s.crdSynced) {
+		return nil, false, fmt.Errorf("cache has not finished syncing")
+	}
+	selector, err := labels.Parse(policyreport.GatekeeperConstraintLabel)
+	if err != nil {
+		return nil, false, err
+	}
+	crds, err := s.crdLister.List(selector)
+	if err != nil {
+		return nil, false, err
+	}
+	var out []schema.GroupVersionResource
+	for _, crd := range crds {
+		if crd.Spec.Group != policyreport.GatekeeperConstraintGroup {
+			continue
+		}
+		version := preferredCRDVersion(crd)
+		if version == "" || crd.Spec.Names.Plural == "" {
+			continue
+		}
+		out = append(out, schema.GroupVersionResource{Group: crd.Spec.Group, Version: version, Resource: crd.Spec.Names.Plural})
+	}
+	policyreport.SortResources(out)
+	return out, true, nil
+}
+
+// served reports whether the cluster serves gvr, from its CRD.
+func (s *policyReportInformerSource) served(ctx context.Context, gvr schema.GroupVersionResource) (bool, error) {
+	if !cache.WaitForCacheSync(ctx.Done(), s.crdSynced) {
+		return false, fmt.Errorf("cache has not finished syncing")
+	}
+	crd, err := s.crdLister.Get(gvr.Resource + "." + gvr.Group)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return servesVersion(crd, gvr.Version), nil
+}
+
+func servesVersion(crd *apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for _, candidate := range crd.Spec.Versions {
+		if candidate.Name == version && candidate.Served {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *policyReportInformerSource) acquire(key policyReportInformerKey) (cache.SharedIndexInformer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if held, ok := s.held[key]; ok {
+		return held.informer, nil
+	}
+	inf, release, ok := s.informers.Acquire(key.gvr, key.namespace)
+	if !ok {
+		return nil, fmt.Errorf("dynamic informers are shut down")
+	}
+	s.held[key] = heldPolicyReportInformer{informer: inf, release: release}
+	return inf, nil
+}
+
+// release drops every informer held for gvr, whose API is no longer served.
+func (s *policyReportInformerSource) release(gvr schema.GroupVersionResource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, held := range s.held {
+		if key.gvr == gvr {
+			held.release()
+			delete(s.held, key)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionslisters "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/luxury-yacht/app/backend/policyreport"
+	"github.com/luxury-yacht/app/backend/refresh/informer"
+)
+
+func policyReportCRD(gvr schema.GroupVersionResource) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: gvr.Resource + "." + gvr.Group},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group:    gvr.Group,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: gvr.Version, Served: true, Storage: true}},
+		},
+	}
+}
+
+func failingPolicyReport(namespace, resourceVersion string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "wgpolicyk8s.io/v1alpha2",
+		"kind":       "PolicyReport",
+		"metadata":   map[string]interface{}{"name": "pol-web", "namespace": namespace, "resourceVersion": resourceVersion},
+		"scope":      map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web", "namespace": namespace},
+		"results": []interface{}{
+			map[string]interface{}{"policy": "require-limits", "result": "fail", "source": "kyverno"},
+		},
+	}}
+}
+
+func newPolicyReportSource(t *testing.T, scoped bool, permitted func(group, resource string) bool, crds ...*apiextensionsv1.CustomResourceDefinition) (*policyReportInformerSource, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		policyreport.PolicyReportGVR:        "PolicyReportList",
+		policyreport.ClusterPolicyReportGVR: "ClusterPolicyReportList",
+	}, failingPolicyReport("prod", "42"), failingPolicyReport("dev", "7"))
+	shared := informer.NewDynamicInformers(client)
+	t.Cleanup(shared.Shutdown)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, crd := range crds {
+		require.NoError(t, indexer.Add(crd))
+	}
+	return &policyReportInformerSource{
+		informers: shared,
+		crdLister: apiextensionslisters.NewCustomResourceDefinitionLister(indexer),
+		crdSynced: func() bool { return true },
+		permitted: permitted,
+		scoped:    scoped,
+		held:      make(map[policyReportInformerKey]heldPolicyReportInformer),
+	}, client
+}
+
+func countListActions(client *dynamicfake.FakeDynamicClient, resource string) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == resource {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPolicyReportsBuilderReadsSharedInformers(t *testing.T) {
+	allowAll := func(string, string) bool { return true }
+	source, client := newPolicyReportSource(t, false, allowAll,
+		policyReportCRD(policyreport.PolicyReportGVR))
+	builder := &PolicyReportsBuilder{collector: policyreport.Collector{ClusterID: "config:ctx", Source: source}}
+
+	for range 3 {
+		snap, err := builder.Build(context.Background(), "")
+		require.NoError(t, err)
+		payload := snap.Payload.(policyreport.Snapshot)
+		require.Len(t, payload.Violations, 2)
+		require.True(t, payload.Sources.PolicyReports)
+		require.False(t, payload.Sources.ClusterPolicyReports, "no informer for an API the cluster does not serve")
+		require.Empty(t, payload.Warnings)
+	}
+	require.Equal(t, 1, countListActions(client, "policyreports"), "builds read the informer cache")
+	require.Zero(t, countListActions(client, "clusterpolicyreports"))
+
+	snap, err := builder.Build(context.Background(), "prod")
+	require.NoError(t, err)
+	require.Len(t, snap.Payload.(policyreport.Snapshot).Violations, 1)
+	require.Equal(t, 1, countListActions(client, "policyreports"), "a namespace view filters the cluster-wide cache")
+}
+
+func TestPolicyReportsBuilderHonoursNamespaceScopeAndPermissions(t *testing.T) {
+	deniedClusterReports := func(_ string, resource string) bool { return resource != "clusterpolicyreports" }
+	source, client := newPolicyReportSource(t, true, deniedClusterReports,
+		policyReportCRD(policyreport.PolicyReportGVR), policyReportCRD(policyreport.ClusterPolicyReportGVR))
+	builder := &PolicyReportsBuilder{collector: policyreport.Collector{
+		ClusterID: "config:ctx", Source: source, Namespaces: []string{"prod"},
+	}}
+
+	snap, err := builder.Build(context.Background(), "")
+	require.NoError(t, err)
+	payload := snap.Payload.(policyreport.Snapshot)
+	require.Len(t, payload.Violations, 1)
+	require.Equal(t, "prod", payload.Violations[0].Resource.Namespace)
+	require.Zero(t, countListActions(client, "clusterpolicyreports"), "scoped clusters skip cluster-scoped reports")
+
+	snap, err = builder.Build(context.Background(), "dev")
+	require.NoError(t, err)
+	require.Empty(t, snap.Payload.(policyreport.Snapshot).Violations, "namespaces outside the scope are never read")
+
+	unscoped := &PolicyReportsBuilder{collector: policyreport.Collector{ClusterID: "config:ctx", Source: source}}
+	snap, err = unscoped.Build(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, snap.Payload.(policyreport.Snapshot).Warnings, 1, "a denied source is reported, not watched")
+	require.Zero(t, countListActions(client, "clusterpolicyreports"))
+}
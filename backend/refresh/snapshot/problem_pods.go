@@ -0,0 +1,231 @@
+/*
+ * backend/refresh/snapshot/problem_pods.go
+ *
+ * The cluster-problem-pods domain: only the pods that are broken right now, cluster-wide —
+ * CrashLoopBackOff, image pull failures, evictions, and pods stuck Pending past a grace
+ * period — each with the reason it is listed, so the first screen after connecting can answer
+ * "what's broken". It reads the pod bundles the ingest store already holds (Table=PodSummary
+ * for status and reason, Aggregate=PodAggregate for phase and node), so it adds no informer or
+ * list of its own.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/refresh/ingest"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+const problemPodsDomainName = "cluster-problem-pods"
+
+// Problem pod categories, in the order the payload lists them.
+const (
+	ProblemCrashLoop = "CrashLoopBackOff"
+	ProblemImagePull = "ImagePullBackOff"
+	ProblemEvicted   = "Evicted"
+	ProblemPending   = "Pending"
+)
+
+var problemPodOrder = map[string]int{
+	ProblemCrashLoop: 0,
+	ProblemImagePull: 1,
+	ProblemEvicted:   2,
+	ProblemPending:   3,
+}
+
+// problemPodImagePullReasons are the container waiting reasons that mean the
+// kubelet cannot get the image.
+var problemPodImagePullReasons = map[string]bool{
+	"ImagePullBackOff":  true,
+	"ErrImagePull":      true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// problemPodsIngestSource is the slice of the ingest manager the domain reads.
+type problemPodsIngestSource interface {
+	podAggregateIngestSource
+	Rows(gvr schema.GroupVersionResource) []interface{}
+}
+
+// ProblemPodsBuilder filters the pod bundles down to the problematic pods.
+type ProblemPodsBuilder struct {
+	ingest problemPodsIngestSource
+	// now is the clock the Pending threshold is measured against; nil means time.Now.
+	now func() time.Time
+}
+
+// ProblemPodsSnapshot is the payload for the cluster-problem-pods domain.
+type ProblemPodsSnapshot struct {
+	ClusterMeta
+	// Pods are ordered by problem (crash loops first), then oldest first.
+	Pods []ProblemPod `json:"pods"`
+	// Counts maps each problem to its number of pods; problems with none are left out.
+	Counts map[string]int `json:"counts"`
+	// PendingThresholdSeconds is how long a pod must have been Pending to be listed.
+	PendingThresholdSeconds int64 `json:"pendingThresholdSeconds"`
+}
+
+// ProblemPod is one broken pod and why it is listed.
+type ProblemPod struct {
+	Ref resourcemodel.ResourceRef `json:"ref"`
+	// Problem is one of CrashLoopBackOff, ImagePullBackOff, Evicted or Pending.
+	Problem string `json:"problem"`
+	// Reason is the status reason behind the problem: the container waiting reason
+	// (ErrImagePull, Init:CrashLoopBackOff), or for a Pending pod the waiting reason or
+	// Unscheduled when no node was assigned.
+	Reason    string `json:"reason"`
+	Status    string `json:"status"`
+	Node      string `json:"node,omitempty"`
+	OwnerKind string `json:"ownerKind,omitempty"`
+	OwnerName string `json:"ownerName,omitempty"`
+	Ready     string `json:"ready"`
+	Restarts  int32  `json:"restarts"`
+	Age       string `json:"age"`
+	// AgeTimestamp is the pod's creation time in Unix milliseconds.
+	AgeTimestamp int64 `json:"ageTimestamp,omitempty"`
+}
+
+// RegisterProblemPodsDomain registers the cluster-problem-pods domain. ingestManager may be
+// nil in a unit test, in which case no pods are read.
+func RegisterProblemPodsDomain(reg *domain.Registry, ingestManager *ingest.IngestManager) error {
+	builder := &ProblemPodsBuilder{}
+	if ingestManager != nil {
+		builder.ingest = ingestManager
+	}
+	return reg.Register(refresh.DomainConfig{
+		Name:          problemPodsDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build returns the cluster's problematic pods.
+func (b *ProblemPodsBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, _ := refresh.SplitClusterScope(scope)
+
+	var (
+		bundles []interface{}
+		version uint64
+	)
+	if b.ingest != nil {
+		bundles = b.ingest.Rows(PodGVR)
+		version = podIngestVersion(b.ingest)
+	}
+	now := time.Now
+	if b.now != nil {
+		now = b.now
+	}
+
+	payload := buildProblemPods(bundles, now(), config.ProblemPodPendingThreshold)
+	payload.ClusterMeta = meta
+	return &refresh.Snapshot{
+		Domain:  problemPodsDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, ""),
+		Version: version,
+		Payload: payload,
+		Stats:   refresh.SnapshotStats{ItemCount: len(payload.Pods)},
+	}, nil
+}
+
+// buildProblemPods classifies each pod bundle and keeps the problematic ones.
+func buildProblemPods(bundles []interface{}, now time.Time, pendingThreshold time.Duration) ProblemPodsSnapshot {
+	payload := ProblemPodsSnapshot{
+		Pods:                    []ProblemPod{},
+		Counts:                  map[string]int{},
+		PendingThresholdSeconds: int64(pendingThreshold / time.Second),
+	}
+	for _, raw := range bundles {
+		bundle, ok := raw.(ingest.Bundle)
+		if !ok {
+			continue
+		}
+		row, ok := bundle.Table.(PodSummary)
+		if !ok {
+			continue
+		}
+		agg, _ := bundle.Aggregate.(streamrows.PodAggregate)
+		problem, reason, ok := classifyProblemPod(row, agg, now, pendingThreshold)
+		if !ok {
+			continue
+		}
+		item := ProblemPod{
+			Ref:          row.Ref,
+			Problem:      problem,
+			Reason:       reason,
+			Status:       row.Status,
+			Node:         row.Node,
+			Ready:        row.Ready,
+			Restarts:     row.Restarts,
+			Age:          row.Age,
+			AgeTimestamp: row.AgeTimestamp,
+		}
+		if row.OwnerKind != "None" {
+			item.OwnerKind, item.OwnerName = row.OwnerKind, row.OwnerName
+		}
+		payload.Pods = append(payload.Pods, item)
+		payload.Counts[problem]++
+	}
+	sort.SliceStable(payload.Pods, func(i, j int) bool {
+		left, right := payload.Pods[i], payload.Pods[j]
+		if left.Problem != right.Problem {
+			return problemPodOrder[left.Problem] < problemPodOrder[right.Problem]
+		}
+		if left.AgeTimestamp != right.AgeTimestamp {
+			return left.AgeTimestamp < right.AgeTimestamp
+		}
+		if left.Ref.Namespace != right.Ref.Namespace {
+			return left.Ref.Namespace < right.Ref.Namespace
+		}
+		return left.Ref.Name < right.Ref.Name
+	})
+	return payload
+}
+
+// classifyProblemPod reports the pod's problem, if any. Terminating pods are on their way
+// out and are never listed. The status reason carries the first failing container's waiting
+// or termination reason, so crash loops and pull failures are read from it; a Pending pod
+// only counts once it has been Pending longer than the threshold.
+func classifyProblemPod(row PodSummary, agg streamrows.PodAggregate, now time.Time, pendingThreshold time.Duration) (problem, reason string, ok bool) {
+	if row.StatusPresentation == "terminating" {
+		return "", "", false
+	}
+	switch {
+	case row.StatusReason == "Evicted":
+		return ProblemEvicted, "Evicted", true
+	case row.StatusReason == "CrashLoopBackOff":
+		return ProblemCrashLoop, row.Status, true
+	case problemPodImagePullReasons[row.StatusReason]:
+		return ProblemImagePull, row.Status, true
+	}
+
+	phase := agg.Phase
+	if phase == "" {
+		phase = row.StatusState
+	}
+	if corev1.PodPhase(phase) != corev1.PodPending || row.AgeTimestamp == 0 {
+		return "", "", false
+	}
+	if now.Sub(time.UnixMilli(row.AgeTimestamp)) < pendingThreshold {
+		return "", "", false
+	}
+	switch {
+	case row.StatusReason != "":
+		return ProblemPending, row.Status, true
+	case row.Node == "":
+		return ProblemPending, "Unscheduled", true
+	default:
+		return ProblemPending, "Pending", true
+	}
+}
@@ -0,0 +1,87 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProblemPodsBuilderListsOnlyBrokenPods(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	problemPod := func(name string, created time.Time, mutate func(*corev1.Pod)) *corev1.Pod {
+		pod := packingTestPod(name, "node-a", corev1.PodRunning, "100m", "64Mi")
+		pod.CreationTimestamp = metav1.NewTime(created)
+		pod.ResourceVersion = "40"
+		if mutate != nil {
+			mutate(pod)
+		}
+		return pod
+	}
+	waiting := func(reason string) func(*corev1.Pod) {
+		return func(pod *corev1.Pod) {
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "app",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+			}}
+		}
+	}
+	pending := func(node string) func(*corev1.Pod) {
+		return func(pod *corev1.Pod) {
+			pod.Spec.NodeName = node
+			pod.Status.Phase = corev1.PodPending
+		}
+	}
+
+	meta := ClusterMeta{ClusterID: "c1", ClusterName: "one"}
+	source := newFakePodWorkloadsIngestSource(meta, nil,
+		problemPod("healthy", now.Add(-time.Hour), nil),
+		problemPod("crash-new", now.Add(-time.Minute), waiting("CrashLoopBackOff")),
+		problemPod("crash-old", now.Add(-time.Hour), waiting("CrashLoopBackOff")),
+		problemPod("pull", now.Add(-time.Hour), waiting("ErrImagePull")),
+		problemPod("evicted", now.Add(-time.Hour), func(pod *corev1.Pod) {
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.Reason = "Evicted"
+		}),
+		problemPod("stuck", now.Add(-10*time.Minute), pending("")),
+		problemPod("just-created", now.Add(-time.Minute), pending("")),
+		problemPod("terminating", now.Add(-time.Hour), func(pod *corev1.Pod) {
+			waiting("CrashLoopBackOff")(pod)
+			deleted := metav1.NewTime(now)
+			pod.DeletionTimestamp = &deleted
+		}),
+	)
+
+	builder := &ProblemPodsBuilder{ingest: source, now: func() time.Time { return now }}
+	snap, err := builder.Build(WithClusterMeta(context.Background(), meta), "c1|")
+	require.NoError(t, err)
+	require.Equal(t, problemPodsDomainName, snap.Domain)
+	require.Equal(t, uint64(40), snap.Version)
+
+	payload, ok := snap.Payload.(ProblemPodsSnapshot)
+	require.True(t, ok)
+	require.Equal(t, "c1", payload.ClusterID)
+
+	type listed struct{ name, problem, reason string }
+	var got []listed
+	for _, pod := range payload.Pods {
+		got = append(got, listed{pod.Ref.Name, pod.Problem, pod.Reason})
+	}
+	require.Equal(t, []listed{
+		{"crash-old", ProblemCrashLoop, "CrashLoopBackOff"},
+		{"crash-new", ProblemCrashLoop, "CrashLoopBackOff"},
+		{"pull", ProblemImagePull, "ErrImagePull"},
+		{"evicted", ProblemEvicted, "Evicted"},
+		{"stuck", ProblemPending, "Unscheduled"},
+	}, got)
+	require.Equal(t, map[string]int{ProblemCrashLoop: 2, ProblemImagePull: 1, ProblemEvicted: 1, ProblemPending: 1}, payload.Counts)
+	require.Equal(t, "ReplicaSet", payload.Pods[0].OwnerKind)
+
+	empty := &ProblemPodsBuilder{}
+	snap, err = empty.Build(WithClusterMeta(context.Background(), meta), "c1|")
+	require.NoError(t, err)
+	require.Empty(t, snap.Payload.(ProblemPodsSnapshot).Pods)
+}
@@ -160,7 +160,7 @@ func TestMaintainedDirectAnchorServesAlignedPage(t *testing.T) {
 		},
 	}
 	resolved := resolveMaintainedDirect(
-		maintained.store, query, available, "", autoscalingTableQueryAdapter(),
+		maintained.store, query, available, nil, autoscalingTableQueryAdapter(),
 		autoscalingQuerypageSchema(), ResourceQueryCapabilities{}, 100, "items",
 		func(r AutoscalingSummary) string { return r.Ref.Kind },
 		func() []AutoscalingSummary { return nil },
@@ -185,7 +185,7 @@ func TestMaintainedDirectAnchorServesAlignedPage(t *testing.T) {
 	// a namespace filter that excludes the anchor row.
 	query.Request.Namespaces = []string{"other-ns"}
 	resolved = resolveMaintainedDirect(
-		maintained.store, query, available, "", autoscalingTableQueryAdapter(),
+		maintained.store, query, available, nil, autoscalingTableQueryAdapter(),
 		autoscalingQuerypageSchema(), ResourceQueryCapabilities{}, 100, "items",
 		func(r AutoscalingSummary) string { return r.Ref.Kind },
 		func() []AutoscalingSummary { return nil },
@@ -209,7 +209,7 @@ func TestMaintainedDirectAppliesProviderFacetsAndPublishesStructuralOptions(t *t
 		},
 	}
 	resolved := resolveMaintainedDirect(
-		store, query, map[string]bool{"Node": true}, "", nodeTableQueryAdapter(),
+		store, query, map[string]bool{"Node": true}, nil, nodeTableQueryAdapter(),
 		nodesQuerypageSchema(), nodeQueryCapabilities(), 100, "nodes",
 		func(NodeSummary) string { return "Node" }, func() []NodeSummary { return nil }, nil,
 	)
@@ -224,7 +224,7 @@ func TestMaintainedDirectAppliesProviderFacetsAndPublishesStructuralOptions(t *t
 
 	query.Request.Facets = map[string][]string{"statuses": {"notready"}}
 	caseFolded := resolveMaintainedDirect(
-		store, query, map[string]bool{"Node": true}, "", nodeTableQueryAdapter(),
+		store, query, map[string]bool{"Node": true}, nil, nodeTableQueryAdapter(),
 		nodesQuerypageSchema(), nodeQueryCapabilities(), 100, "nodes",
 		func(NodeSummary) string { return "Node" }, func() []NodeSummary { return nil }, nil,
 	)
@@ -377,7 +377,7 @@ func TestMaintainedDirectStartRankServesOffsetPage(t *testing.T) {
 		},
 	}
 	resolved := resolveMaintainedDirect(
-		maintained.store, query, map[string]bool{"HorizontalPodAutoscaler": true}, "",
+		maintained.store, query, map[string]bool{"HorizontalPodAutoscaler": true}, nil,
 		autoscalingTableQueryAdapter(), autoscalingQuerypageSchema(),
 		ResourceQueryCapabilities{}, 100, "items",
 		func(r AutoscalingSummary) string { return r.Ref.Kind },
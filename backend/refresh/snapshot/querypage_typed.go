@@ -2,6 +2,7 @@ package snapshot
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -452,7 +453,7 @@ func pageStartRankPtr(rank int) *int {
 // maintainedScopeBase builds the querypage facet filters that pin a maintained
 // store query to THIS request's visible scope: the available kinds for the request
 // (optionally intersected with the user's kind filter) and, for a namespaced domain,
-// the namespace (optionally intersected with the user's namespace filter). All values
+// the scope's namespaces (optionally intersected with the user's namespace filter). All values
 // are lowered+trimmed to match the schema's facet extractor, which lowers+trims too.
 //
 // userKinds/userNamespaces are the request's kind/namespace filters. When a user list
@@ -460,7 +461,7 @@ func pageStartRankPtr(rank int) *int {
 // non-empty the effective filter is the intersection (AND), exactly as the live
 // matcher applies namespace AND kind. An intersection that is empty yields a filter
 // that matches nothing, mirroring the matcher rejecting every row.
-func maintainedScopeBase(availableKinds map[string]bool, namespace string, userKinds, userNamespaces []string, includeUser bool) map[string][]string {
+func maintainedScopeBase(availableKinds map[string]bool, namespaces []string, userKinds, userNamespaces []string, includeUser bool) map[string][]string {
 	base := map[string][]string{}
 
 	available := make([]string, 0, len(availableKinds))
@@ -476,8 +477,8 @@ func maintainedScopeBase(availableKinds map[string]bool, namespace string, userK
 		}
 	}
 
-	if namespace != "" {
-		base["namespace"] = []string{strings.ToLower(strings.TrimSpace(namespace))}
+	if len(namespaces) > 0 {
+		base["namespace"] = lowerTrimAll(namespaces)
 		if includeUser {
 			if un := lowerTrimAll(userNamespaces); len(un) > 0 {
 				base["namespace"] = intersectLowered(base["namespace"], un)
@@ -552,7 +553,7 @@ func maintainedQueryFacetValues[T any](counts map[string]map[string]int, facets
 // persistent maintained store, querying it in place (O(log N + page)) instead of
 // snapshotting every row and rebuilding a fresh per-Build store. Its output is
 // byte-identical to resolveTypedSnapshotPageViaStore(domain, store.rows(namespace,
-// availableKinds), …): same page rows/order, Total (matched count), UnfilteredTotal
+// availableKinds), …) filtered to namespaces (nil = all namespaces): same page rows/order, Total (matched count), UnfilteredTotal
 // (in-scope rows before the user's filters/search), facet value lists, and cursor.
 //
 // The kind facet list is mapped back to original casing from availableKinds (whose
@@ -562,7 +563,7 @@ func resolveMaintainedDirect[T any](
 	store *querypage.Store[T],
 	query typedTableQuery,
 	availableKinds map[string]bool,
-	namespace string,
+	namespaces []string,
 	adapter typedTableQueryAdapter[T],
 	schema querypage.Schema[T],
 	capabilities ResourceQueryCapabilities,
@@ -601,7 +602,7 @@ func resolveMaintainedDirect[T any](
 	// the full index, the page rows, order, and boundary cursor are identical to a
 	// matched-only store queried with no filters. Cursor decode/validate is owned by
 	// the engine: an invalid token restarts at page 1 on page.CursorInvalid.
-	pageBase := maintainedScopeBase(availableKinds, namespace, query.Request.Kinds, query.Request.Namespaces, true)
+	pageBase := maintainedScopeBase(availableKinds, namespaces, query.Request.Kinds, query.Request.Namespaces, true)
 	for key, selected := range query.Request.Facets {
 		pageBase[key] = stableFacetSelection(selected)
 	}
@@ -637,7 +638,7 @@ func resolveMaintainedDirect[T any](
 	// is over the scope-only set (available kinds + namespace, NO user filters/search) —
 	// the count of in-scope rows the list path passed in as `items`.
 	matchedFacets, matchedTotal := store.Scope(pageBase, searchLower)
-	scopeOnlyBase := maintainedScopeBase(availableKinds, namespace, nil, nil, false)
+	scopeOnlyBase := maintainedScopeBase(availableKinds, namespaces, nil, nil, false)
 	scopeOnlyFacets, unfilteredTotal := store.Scope(scopeOnlyBase, "")
 	if query.Request.MatchNone {
 		matchedFacets = map[string]map[string]int{}
@@ -1175,6 +1176,48 @@ func (m *typedMaintainedStore[T]) deleteRow(row T) {
 	m.store.Delete(m.adapter.Key(row))
 }
 
+// syncListedRows folds the rows of a domain's per-build Listed sources into the
+// store: it upserts the listed rows and drops rows of those kinds in namespace
+// ("" = all) that the list no longer returned. Only available sources are synced,
+// so a failed list never empties its kind. Listed rows carry no resourceVersion,
+// so the version advances by one, and only when the served set changed.
+func (m *typedMaintainedStore[T]) syncListedRows(rows []T, sources []typedTableResourceSource, namespace string) {
+	kinds := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		if source.Available {
+			kinds[source.Kind] = true
+		}
+	}
+	if len(kinds) == 0 {
+		return
+	}
+	stale := make(map[string]T)
+	for _, row := range m.store.Snapshot() {
+		if kinds[m.adapter.Kind(row)] && (namespace == "" || m.adapter.Namespace(row) == namespace) {
+			stale[m.adapter.Key(row)] = row
+		}
+	}
+	changed := false
+	for _, row := range rows {
+		key := m.adapter.Key(row)
+		if existing, ok := stale[key]; ok {
+			delete(stale, key)
+			if reflect.DeepEqual(existing, row) {
+				continue
+			}
+		}
+		m.store.Upsert(row)
+		changed = true
+	}
+	for key := range stale {
+		m.store.Delete(key)
+		changed = true
+	}
+	if changed {
+		m.bumpSinkVersion()
+	}
+}
+
 // ingest projects an added/updated object via the descriptor's StreamRow closure
 // and upserts it — generic over the domain's kinds, no per-kind branch.
 func (m *typedMaintainedStore[T]) ingest(d streamspec.Descriptor, obj interface{}) {
@@ -42,6 +42,7 @@ type Service struct {
 	informerHub         refresh.InformerHub
 	domainReadiness     map[string][]string
 	informerSyncTimeout time.Duration
+	freshness           *refresh.FreshnessTracker
 	cacheMu             sync.RWMutex
 	cache               map[string]cacheEntry
 	cacheTTL            time.Duration
@@ -150,6 +151,17 @@ func (s *Service) WithDomainReadiness(readiness map[string][]string) *Service {
 	return s
 }
 
+// WithFreshness attaches the cluster's connection state to every snapshot the
+// service returns, so clients can flag data served while the cluster is
+// unreachable.
+func (s *Service) WithFreshness(tracker *refresh.FreshnessTracker) *Service {
+	if s == nil {
+		return s
+	}
+	s.freshness = tracker
+	return s
+}
+
 // Build returns a snapshot for the requested domain/scope.
 func (s *Service) Build(ctx context.Context, domainName, scope string) (*refresh.Snapshot, error) {
 	return s.BuildRequest(BuildRequest{
@@ -195,7 +207,7 @@ func (s *Service) BuildRequest(req BuildRequest) (*refresh.Snapshot, error) {
 	bypassSnapshotCache := s.shouldBypassSnapshotCache(domainName)
 	if !refresh.HasCacheBypass(ctx) && !bypassSnapshotCache {
 		if cached := s.loadCache(cacheKey); cached != nil {
-			return cached, nil
+			return s.withServedFreshness(ctx, domainName, cached), nil
 		}
 	}
 	value, err, _ := s.group.Do(groupKey, func() (interface{}, error) {
@@ -238,6 +250,7 @@ func (s *Service) BuildRequest(req BuildRequest) (*refresh.Snapshot, error) {
 			}
 		}
 		s.finalizeSourceVersion(snap)
+		s.freshness.RecordList(domainName)
 		s.recordTelemetry(
 			domainName,
 			scope,
@@ -261,7 +274,35 @@ func (s *Service) BuildRequest(req BuildRequest) (*refresh.Snapshot, error) {
 	if err != nil {
 		return nil, err
 	}
-	return value.(*refresh.Snapshot), nil
+	return s.withServedFreshness(ctx, domainName, value.(*refresh.Snapshot)), nil
+}
+
+// withServedFreshness returns a copy of snap carrying the current freshness.
+// Freshness describes the moment of serving, not of building, so it is never
+// stored in the snapshot cache.
+func (s *Service) withServedFreshness(ctx context.Context, domainName string, snap *refresh.Snapshot) *refresh.Snapshot {
+	served := *snap
+	served.Freshness = s.DomainFreshness(ctx, domainName)
+	return &served
+}
+
+// DomainFreshness reports how current the data served for domainName is.
+func (s *Service) DomainFreshness(ctx context.Context, domainName string) *refresh.DataFreshness {
+	freshness := s.freshness.DomainFreshness(domainName, s.informersSettled(ctx, domainName))
+	return &freshness
+}
+
+// informersSettled reports whether the informers the domain waits on have
+// synced. Without an informer hub there is nothing to wait for.
+func (s *Service) informersSettled(ctx context.Context, domainName string) bool {
+	hub := s.currentInformerHub()
+	if hub == nil {
+		return true
+	}
+	if keys, scoped := s.domainReadiness[domainName]; scoped {
+		return hub.ResourcesSettled(keys)
+	}
+	return hub.HasSynced(ctx)
 }
 
 // waitForInformerSync blocks until the domain's informers have settled, returning the
@@ -281,16 +322,8 @@ func (s *Service) waitForInformerSync(ctx context.Context, domainName string) (t
 	// undeclared domains keep the conservative factory-wide gate. The hub is re-read
 	// on every poll, not captured once, so a runtime swap (the Cold-tier cooled-hub
 	// install) is observed by an already-blocked Build.
-	keys, scoped := s.domainReadiness[domainName]
 	settled := func() bool {
-		hub := s.currentInformerHub()
-		if hub == nil {
-			return true
-		}
-		if scoped {
-			return hub.ResourcesSettled(keys)
-		}
-		return hub.HasSynced(ctx)
+		return s.informersSettled(ctx, domainName)
 	}
 	if settled() {
 		return time.Since(start), nil
@@ -1134,3 +1134,31 @@ func TestServiceBuildServesRuntimePolicyExemptDomainForDeniedIdentity(t *testing
 		t.Fatalf("expected the builder to run (called=%v, snap=%v)", called, snap)
 	}
 }
+
+func TestServiceBuildAttachesServedFreshness(t *testing.T) {
+	reg := domain.New()
+	require.NoError(t, reg.Register(refresh.DomainConfig{
+		Name: "demo",
+		BuildSnapshot: func(_ context.Context, scope string) (*refresh.Snapshot, error) {
+			return &refresh.Snapshot{Domain: "demo", Scope: scope}, nil
+		},
+	}))
+
+	tracker := refresh.NewFreshnessTracker()
+	service := NewServiceWithPermissions(reg, telemetry.NewRecorder(), testClusterMeta(), nil).
+		WithInformerHub(alwaysSyncedHub{}).
+		WithFreshness(tracker)
+
+	first, err := service.Build(context.Background(), "demo", "scope-a")
+	require.NoError(t, err)
+	require.NotNil(t, first.Freshness)
+	require.True(t, first.Freshness.InformersSynced)
+	require.False(t, first.Freshness.Degraded)
+
+	// A cached snapshot still reports the freshness at the moment it is served.
+	tracker.MarkDegraded(refresh.FreshnessReasonConnectivity)
+	second, err := service.Build(context.Background(), "demo", "scope-a")
+	require.NoError(t, err)
+	require.True(t, second.Freshness.Degraded)
+	require.Equal(t, refresh.FreshnessReasonConnectivity, second.Freshness.DegradedReason)
+}
@@ -182,7 +182,7 @@ func autoscalingTableQueryAdapter() typedTableQueryAdapter[AutoscalingSummary] {
 		Namespace: func(row AutoscalingSummary) string { return row.Ref.Namespace },
 		Kind:      func(row AutoscalingSummary) string { return row.Ref.Kind },
 		SearchText: func(row AutoscalingSummary) []string {
-			return []string{row.Ref.Kind, row.Ref.Name, row.Ref.Namespace, row.Target, row.TargetAPIVersion}
+			return append([]string{row.Ref.Kind, row.Ref.Name, row.Ref.Namespace, row.Target, row.TargetAPIVersion}, row.Triggers...)
 		},
 		Predicate: func(AutoscalingSummary, string, string) bool { return true },
 		SortValue: func(row AutoscalingSummary, field string) string {
@@ -388,6 +388,8 @@ func namespacedEventTableQueryAdapter() typedTableQueryAdapter[EventSummary] {
 				return eventObjectNameForSort(row.Object)
 			case "message":
 				return row.Message
+			case "count":
+				return strconv.Itoa(int(row.Count))
 			case "age", "agetimestamp":
 				return strconv.FormatInt(row.AgeTimestamp, 10)
 			default:
@@ -395,6 +397,9 @@ func namespacedEventTableQueryAdapter() typedTableQueryAdapter[EventSummary] {
 			}
 		},
 		NumericSort: func(row EventSummary, field string) (float64, bool) {
+			if strings.EqualFold(field, "count") {
+				return float64(row.Count), true
+			}
 			if strings.EqualFold(field, "age") {
 				return numericAgeSortValue(row.AgeTimestamp)
 			}
@@ -441,6 +446,8 @@ func clusterEventTableQueryAdapter() typedTableQueryAdapter[ClusterEventEntry] {
 				return eventObjectNameForSort(row.Object)
 			case "message":
 				return row.Message
+			case "count":
+				return strconv.Itoa(int(row.Count))
 			case "age", "agetimestamp":
 				return strconv.FormatInt(row.AgeTimestamp, 10)
 			default:
@@ -448,6 +455,9 @@ func clusterEventTableQueryAdapter() typedTableQueryAdapter[ClusterEventEntry] {
 			}
 		},
 		NumericSort: func(row ClusterEventEntry, field string) (float64, bool) {
+			if strings.EqualFold(field, "count") {
+				return float64(row.Count), true
+			}
 			if strings.EqualFold(field, "age") {
 				return numericAgeSortValue(row.AgeTimestamp)
 			}
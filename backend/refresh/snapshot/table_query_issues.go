@@ -12,6 +12,10 @@ type typedTableResourceSource struct {
 	Resource   string
 	Available  bool
 	QueryKinds []string
+	// Listed marks a source listed per build (an optional CRD kind) rather
+	// than watched; its Available already reflects the list outcome, and the
+	// domain's runtime permission policy does not cover it.
+	Listed bool
 }
 
 // typedTableQueryResourceIssues reports the sources that are unavailable or
@@ -25,7 +29,7 @@ func typedTableQueryResourceIssues(ctx context.Context, domainName string, query
 		if !typedTableQueryNeedsSource(query, source) {
 			continue
 		}
-		if source.Available && runtimeResourceAllowed(ctx, domainName, source.Group, source.Resource) {
+		if source.Available && (source.Listed || runtimeResourceAllowed(ctx, domainName, source.Group, source.Resource)) {
 			continue
 		}
 		issues = append(issues, ResourceQueryIssue{
@@ -93,3 +97,17 @@ func capabilitiesWithAvailableKinds(capabilities ResourceQueryCapabilities, sour
 	capabilities.KindVocabulary = kinds
 	return capabilities
 }
+
+// capabilitiesWithListedKinds adds the kinds of a domain's available Listed
+// sources to its kind vocabulary. Those kinds exist only on clusters that
+// serve their CRD, so the static vocabulary cannot name them.
+func capabilitiesWithListedKinds(capabilities ResourceQueryCapabilities, sources []typedTableResourceSource) ResourceQueryCapabilities {
+	kinds := append([]string(nil), capabilities.KindVocabulary...)
+	for _, source := range sources {
+		if source.Listed && source.Available {
+			kinds = append(kinds, source.Kind)
+		}
+	}
+	capabilities.KindVocabulary = kinds
+	return capabilities
+}
@@ -40,6 +40,12 @@ type typedTableDomainSpec[T any] struct {
 	capabilities    ResourceQueryCapabilities
 	kindOf          func(T) string
 	sortRows        func([]T)
+	// supplemental, when set, lists the rows of optional CRD kinds that have
+	// no stream descriptor (KEDA in the autoscaling table) on every build. It
+	// returns the rows, a Listed source per kind whose CRD is served, and the
+	// newest resourceVersion seen; a kind that is not installed gets no source
+	// and stays out of the kind vocabulary.
+	supplemental func(ctx context.Context, meta ClusterMeta, namespace string) ([]T, []typedTableResourceSource, uint64)
 }
 
 // typedTableSources computes per-descriptor availability for THIS request (indexer
@@ -89,33 +95,60 @@ func buildTypedTableSnapshot[T any](
 	}
 
 	// rowsScope selects the store/list rows: the parsed namespace for a
-	// namespace-scoped domain, all rows ("") for a cluster-scoped one.
+	// namespace-scoped domain, all rows ("") for a cluster-scoped one and for a
+	// namespace set, which rowsNamespaces then narrows to its namespaces.
 	rowsScope := ""
+	var rowsNamespaces []string
+	var parsedScope NamespaceSnapshotScope
 	if spec.scopeRequiredErr != "" {
-		parsedScope, scopeErr := parseNamespaceSnapshotScope(refresh.JoinClusterScope(clusterID, baseScope), spec.scopeRequiredErr)
+		var scopeErr error
+		parsedScope, scopeErr = parseNamespaceSnapshotScope(refresh.JoinClusterScope(clusterID, baseScope), spec.scopeRequiredErr)
 		if scopeErr != nil {
 			return nil, scopeErr
 		}
 		rowsScope = parsedScope.Namespace
+		rowsNamespaces = parsedScope.Namespaces
+		if rowsScope != "" {
+			rowsNamespaces = []string{rowsScope}
+		}
+	}
+	inScope := func(rows []T) []T {
+		return filterNamespaceRows(parsedScope, rows, spec.adapter.Namespace)
+	}
+
+	capabilities := spec.capabilities
+	var listedRows []T
+	var listedSources []typedTableResourceSource
+	var listedVersion uint64
+	if spec.supplemental != nil {
+		listedRows, listedSources, listedVersion = spec.supplemental(ctx, meta, rowsScope)
+		capabilities = capabilitiesWithListedKinds(capabilities, listedSources)
 	}
 
 	var resolved typedSnapshotPage[T]
 	var version uint64
 	if maintained != nil {
 		sources, available := typedTableSources(ctx, spec.domain, collectIndexer)
+		if len(listedSources) > 0 {
+			maintained.syncListedRows(listedRows, listedSources, rowsScope)
+			sources = append(sources, listedSources...)
+			for _, source := range listedSources {
+				available[source.Kind] = source.Available
+			}
+		}
 		resolved = resolveMaintainedDirect(
 			maintained.store,
 			query,
 			available,
-			rowsScope,
+			rowsNamespaces,
 			spec.adapter,
 			spec.schema,
-			capabilitiesWithAvailableKinds(spec.capabilities, sources),
+			capabilitiesWithAvailableKinds(capabilities, sources),
 			spec.entryLimit,
 			spec.description,
 			spec.kindOf,
 			func() []T {
-				rows := maintained.rows(rowsScope, available)
+				rows := inScope(maintained.rows(rowsScope, available))
 				spec.sortRows(rows)
 				return rows
 			},
@@ -131,6 +164,11 @@ func buildTypedTableSnapshot[T any](
 			return nil, listErr
 		}
 		version = v
+		if listedVersion > version {
+			version = listedVersion
+		}
+		rows = inScope(append(rows, listedRows...))
+		sources = append(sources, listedSources...)
 		spec.sortRows(rows)
 		resolved = resolveTypedSnapshotPageViaStore(
 			spec.domain,
@@ -138,7 +176,7 @@ func buildTypedTableSnapshot[T any](
 			query,
 			spec.adapter,
 			spec.schema,
-			capabilitiesWithAvailableKinds(spec.capabilities, sources),
+			capabilitiesWithAvailableKinds(capabilities, sources),
 			spec.entryLimit,
 			spec.description,
 			spec.kindOf,
@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/domain"
+	"github.com/luxury-yacht/app/backend/velero"
+)
+
+const veleroDomainName = "cluster-velero"
+
+// VeleroBuilder serves Velero Backups, Restores, Schedules and storage
+// locations. Velero is an optional install, so its objects are listed per
+// build rather than watched.
+type VeleroBuilder struct {
+	collector velero.Collector
+}
+
+// RegisterVeleroDomain wires the cluster-velero domain into the registry.
+func RegisterVeleroDomain(reg *domain.Registry, client dynamic.Interface, meta ClusterMeta) error {
+	builder := &VeleroBuilder{collector: velero.Collector{ClusterID: meta.ClusterID, Dynamic: client}}
+	return reg.Register(refresh.DomainConfig{
+		Name:          veleroDomainName,
+		BuildSnapshot: builder.Build,
+	})
+}
+
+// Build collects Velero state for the scope: empty for the whole cluster, or
+// a namespace to keep only the backups, restores and schedules covering it.
+func (b *VeleroBuilder) Build(ctx context.Context, scope string) (*refresh.Snapshot, error) {
+	meta := ClusterMetaFromContext(ctx)
+	clusterID, trimmed := refresh.SplitClusterScope(scope)
+	namespace := strings.TrimSpace(trimmed)
+
+	payload, version, err := b.collector.Collect(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	payload.ClusterID = meta.ClusterID
+	payload.ClusterName = meta.ClusterName
+
+	stats := refresh.SnapshotStats{ItemCount: len(payload.Backups) + len(payload.Restores) + len(payload.Schedules)}
+	if len(payload.Warnings) > 0 {
+		stats.Warnings = append(stats.Warnings, payload.Warnings...)
+	}
+	return &refresh.Snapshot{
+		Domain:  veleroDomainName,
+		Scope:   refresh.JoinClusterScope(clusterID, namespace),
+		Version: version,
+		Payload: *payload,
+		Stats:   stats,
+	}, nil
+}
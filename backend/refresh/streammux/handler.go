@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,11 +20,13 @@ import (
 	"github.com/luxury-yacht/app/backend/refresh"
 	"github.com/luxury-yacht/app/backend/refresh/containerlogsstream"
 	"github.com/luxury-yacht/app/backend/refresh/telemetry"
+	"github.com/luxury-yacht/app/backend/refresh/wire"
 )
 
 type wsConn interface {
 	ReadJSON(v interface{}) error
 	WriteJSON(v interface{}) error
+	WriteMessage(messageType int, data []byte) error
 	SetWriteDeadline(time.Time) error
 	Close() error
 }
@@ -44,6 +47,12 @@ type Adapter interface {
 	Resume(selector Selector, since uint64) ([]ServerMessage, bool)
 }
 
+// FreshnessReporter is implemented by adapters that can describe how current
+// a subscription's data is. The mux attaches the report to the subscribe ACK.
+type FreshnessReporter interface {
+	Freshness(selector Selector) *refresh.DataFreshness
+}
+
 // Config captures the dependencies for a websocket stream multiplexer.
 type Config struct {
 	Adapter                    Adapter
@@ -136,6 +145,9 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.allowClusterScopedRequests,
 		h.resolveClusterName,
 	)
+	// Clients opt into binary frames on the upgrade URL; requests from the
+	// client stay JSON either way.
+	session.format = wire.FormatFromEncoding(r.URL.Query().Get(wire.EncodingQueryParam))
 	h.sessionsMu.Lock()
 	h.sessions[session] = struct{}{}
 	h.sessionsMu.Unlock()
@@ -177,6 +189,7 @@ type session struct {
 	sendReset                 bool
 	allowClusterScopedRequest bool
 	resolveClusterName        func(clusterID string) string
+	format                    wire.Format
 
 	mu        sync.Mutex
 	subs      map[string]*sessionSubscription
@@ -189,6 +202,7 @@ type session struct {
 
 type sessionSubscription struct {
 	sub         *Subscription
+	selector    Selector
 	clusterID   string
 	clusterName string
 }
@@ -324,20 +338,24 @@ func (s *session) handleSubscribe(msg ClientMessage) {
 	}
 
 	key := subscriptionKey(selector)
-	s.storeSubscription(key, sub, clusterID, clusterName)
+	s.storeSubscription(key, sub, selector, clusterID, clusterName)
 
 	// Positively confirm EVERY accepted subscribe. The client anchors its
 	// "synchronized" stream health on this frame; without it, a resumed
 	// subscribe with no buffered updates is indistinguishable from an ignored
 	// one, and the client would either poll a healthy stream forever or trust
 	// a dead one. Clients that predate ACK drop the frame at parse.
-	s.enqueue(ServerMessage{
+	ack := ServerMessage{
 		Type:        MessageTypeAck,
 		Domain:      msg.Domain,
 		Scope:       normalized,
 		ClusterID:   clusterID,
 		ClusterName: clusterName,
-	})
+	}
+	if reporter, ok := s.adapter.(FreshnessReporter); ok {
+		ack.Freshness = reporter.Freshness(selector)
+	}
+	s.enqueue(ack)
 
 	resumeToken := parseResumeToken(msg.ResumeToken)
 	resumeUpdates := []ServerMessage(nil)
@@ -407,7 +425,7 @@ func (s *session) handleCancel(msg ClientMessage) {
 	sub.sub.Cancel()
 }
 
-func (s *session) storeSubscription(key string, sub *Subscription, clusterID, clusterName string) {
+func (s *session) storeSubscription(key string, sub *Subscription, selector Selector, clusterID, clusterName string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if existing := s.subs[key]; existing != nil {
@@ -415,6 +433,7 @@ func (s *session) storeSubscription(key string, sub *Subscription, clusterID, cl
 	}
 	s.subs[key] = &sessionSubscription{
 		sub:         sub,
+		selector:    selector,
 		clusterID:   clusterID,
 		clusterName: clusterName,
 	}
@@ -543,9 +562,10 @@ func (s *session) writeLoop(ctx context.Context) {
 			}
 		case <-heartbeat.C:
 			if err := s.writeMessage(ServerMessage{
-				Type:        MessageTypeHeartbeat,
-				ClusterID:   s.clusterID,
-				ClusterName: s.clusterName,
+				Type:            MessageTypeHeartbeat,
+				ClusterID:       s.clusterID,
+				ClusterName:     s.clusterName,
+				DomainFreshness: s.domainFreshness(),
 			}); err != nil {
 				return
 			}
@@ -553,6 +573,47 @@ func (s *session) writeLoop(ctx context.Context) {
 	}
 }
 
+// domainFreshness reports the freshness of each cluster domain the session
+// subscribes to, once per domain however many scopes are subscribed.
+func (s *session) domainFreshness() []DomainFreshness {
+	reporter, ok := s.adapter.(FreshnessReporter)
+	if !ok {
+		return nil
+	}
+	s.mu.Lock()
+	selectors := make(map[string]Selector, len(s.subs))
+	for _, entry := range s.subs {
+		if entry.selector == nil {
+			continue
+		}
+		key := entry.selector.Cluster() + "|" + entry.selector.DomainName()
+		if _, seen := selectors[key]; !seen {
+			selectors[key] = entry.selector
+		}
+	}
+	s.mu.Unlock()
+
+	result := make([]DomainFreshness, 0, len(selectors))
+	for _, selector := range selectors {
+		freshness := reporter.Freshness(selector)
+		if freshness == nil {
+			continue
+		}
+		result = append(result, DomainFreshness{
+			ClusterID: selector.Cluster(),
+			Domain:    selector.DomainName(),
+			Freshness: freshness,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ClusterID != result[j].ClusterID {
+			return result[i].ClusterID < result[j].ClusterID
+		}
+		return result[i].Domain < result[j].Domain
+	})
+	return result
+}
+
 func (s *session) writeMessage(msg ServerMessage) error {
 	// Populate the public {source, signal} doorbell pair from the internal
 	// MessageType at the one send chokepoint, so live and resume-replayed frames
@@ -561,7 +622,7 @@ func (s *session) writeMessage(msg ServerMessage) error {
 	if err := s.conn.SetWriteDeadline(time.Now().Add(config.StreamMuxWriteTimeout)); err != nil {
 		s.logger.Warn(fmt.Sprintf("stream mux: write deadline failed: %v", err), logsources.StreamMux)
 	}
-	if err := s.conn.WriteJSON(msg); err != nil {
+	if err := s.writeEncoded(msg); err != nil {
 		if !isExpectedStreamCloseError(err) {
 			s.logger.Warn(fmt.Sprintf("stream mux write error: %v", err), logsources.StreamMux)
 		}
@@ -571,6 +632,19 @@ func (s *session) writeMessage(msg ServerMessage) error {
 	return nil
 }
 
+// writeEncoded writes msg as a JSON text frame, or as a CBOR binary frame when
+// the client negotiated CBOR.
+func (s *session) writeEncoded(msg ServerMessage) error {
+	if s.format != wire.FormatCBOR {
+		return s.conn.WriteJSON(msg)
+	}
+	data, err := wire.Marshal(s.format, msg)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
 // resolveClusterID determines the cluster to use for the incoming message.
 func (s *session) resolveClusterID(msg ClientMessage) (string, error) {
 	scopeClusterIDs, _ := refresh.SplitClusterScopeList(msg.Scope)
@@ -4,15 +4,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gorilla/websocket"
+
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/wire"
 )
 
 type stubConn struct{}
 
 func (stubConn) ReadJSON(interface{}) error       { return nil }
 func (stubConn) WriteJSON(interface{}) error      { return nil }
+func (stubConn) WriteMessage(int, []byte) error   { return nil }
 func (stubConn) SetWriteDeadline(time.Time) error { return nil }
 func (stubConn) Close() error                     { return nil }
 
@@ -208,3 +213,104 @@ func TestHandleSubscribeAcksEveryAcceptedSubscribe(t *testing.T) {
 		t.Fatalf("resumed-empty subscribe must send exactly ACK, got %v", resumedTypes)
 	}
 }
+
+// freshnessStubAdapter reports a fixed freshness for every selector.
+type freshnessStubAdapter struct {
+	ackStubAdapter
+	freshness refresh.DataFreshness
+}
+
+func (a freshnessStubAdapter) Freshness(Selector) *refresh.DataFreshness {
+	freshness := a.freshness
+	return &freshness
+}
+
+func TestHandleSubscribeAckCarriesAdapterFreshness(t *testing.T) {
+	adapter := freshnessStubAdapter{freshness: refresh.DataFreshness{
+		LastSyncedAt:    1_000,
+		InformersSynced: true,
+		Degraded:        true,
+		DegradedReason:  refresh.FreshnessReasonConnectivity,
+	}}
+	session := newSession(stubConn{}, adapter, applog.Noop, nil, "cluster-1", "cluster-a", "resources", true, false, nil)
+	session.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "pods", Scope: "namespace:default"})
+
+	ack := <-session.outgoing
+	if ack.Type != MessageTypeAck || ack.Freshness == nil || *ack.Freshness != adapter.freshness {
+		t.Fatalf("expected ACK with adapter freshness, got %+v", ack)
+	}
+
+	plain := newSession(stubConn{}, ackStubAdapter{}, applog.Noop, nil, "cluster-1", "cluster-a", "resources", true, false, nil)
+	plain.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "pods", Scope: "namespace:default"})
+	if ack := <-plain.outgoing; ack.Freshness != nil {
+		t.Fatalf("adapters without freshness should leave it unset, got %+v", ack.Freshness)
+	}
+}
+
+func TestSessionDomainFreshnessReportsEachSubscribedDomainOnce(t *testing.T) {
+	adapter := freshnessStubAdapter{freshness: refresh.DataFreshness{InformersSynced: true, BehindMs: 45_000}}
+	session := newSession(stubConn{}, adapter, applog.Noop, nil, "cluster-1", "cluster-a", "resources", true, false, nil)
+	session.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "pods", Scope: "namespace:default"})
+	session.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "pods", Scope: "namespace:kube-system"})
+	session.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "cluster-events", Scope: ""})
+
+	got := session.domainFreshness()
+	if len(got) != 2 || got[0].Domain != "cluster-events" || got[1].Domain != "pods" {
+		t.Fatalf("expected one entry per subscribed domain, got %+v", got)
+	}
+	if got[1].ClusterID != "cluster-1" || got[1].Freshness == nil || got[1].Freshness.BehindMs != 45_000 {
+		t.Fatalf("expected adapter freshness for pods, got %+v", got[1])
+	}
+
+	plain := newSession(stubConn{}, ackStubAdapter{}, applog.Noop, nil, "cluster-1", "cluster-a", "resources", true, false, nil)
+	plain.handleSubscribe(ClientMessage{Type: MessageTypeRequest, ClusterID: "cluster-1", Domain: "pods", Scope: "namespace:default"})
+	if got := plain.domainFreshness(); got != nil {
+		t.Fatalf("adapters without freshness should report none, got %+v", got)
+	}
+}
+
+type recordingConn struct {
+	stubConn
+	jsonWrites   int
+	binaryFrames [][]byte
+}
+
+func (c *recordingConn) WriteJSON(interface{}) error {
+	c.jsonWrites++
+	return nil
+}
+
+func (c *recordingConn) WriteMessage(messageType int, data []byte) error {
+	if messageType == websocket.BinaryMessage {
+		c.binaryFrames = append(c.binaryFrames, data)
+	}
+	return nil
+}
+
+func TestSessionWritesCBORFramesWhenNegotiated(t *testing.T) {
+	conn := &recordingConn{}
+	session := newSession(conn, nil, applog.Noop, nil, "cluster-1", "cluster-a", "resources", true, false, nil)
+	msg := ServerMessage{Type: MessageTypeAck, Domain: "pods", Scope: "namespace:default", ClusterID: "cluster-1"}
+
+	if err := session.writeMessage(msg); err != nil {
+		t.Fatalf("write json: %v", err)
+	}
+	if conn.jsonWrites != 1 || len(conn.binaryFrames) != 0 {
+		t.Fatalf("expected a JSON frame by default, got %d json and %d binary", conn.jsonWrites, len(conn.binaryFrames))
+	}
+
+	session.format = wire.FormatCBOR
+	if err := session.writeMessage(msg); err != nil {
+		t.Fatalf("write cbor: %v", err)
+	}
+	if len(conn.binaryFrames) != 1 {
+		t.Fatalf("expected one binary frame, got %d", len(conn.binaryFrames))
+	}
+	var decoded map[string]interface{}
+	if err := cbor.Unmarshal(conn.binaryFrames[0], &decoded); err != nil {
+		t.Fatalf("decode cbor frame: %v", err)
+	}
+	if decoded["domain"] != "pods" || decoded["clusterId"] != "cluster-1" {
+		t.Fatalf("unexpected decoded frame %v", decoded)
+	}
+}
@@ -90,6 +90,20 @@ type ServerMessage struct {
 	Ref             *resourcemodel.ResourceRef      `json:"ref,omitempty"`
 	Error           string                          `json:"error,omitempty"`
 	ErrorDetails    *refresh.PermissionDeniedStatus `json:"errorDetails,omitempty"`
+	// Freshness is set on ACK so a subscriber knows whether the data it is
+	// about to receive may be stale.
+	Freshness *refresh.DataFreshness `json:"freshness,omitempty"`
+	// DomainFreshness is set on HEARTBEAT with the current freshness of each
+	// domain the session subscribes to, so staleness indicators keep moving
+	// between ACKs.
+	DomainFreshness []DomainFreshness `json:"domainFreshness,omitempty"`
+}
+
+// DomainFreshness is one subscribed domain's freshness on a heartbeat.
+type DomainFreshness struct {
+	ClusterID string                 `json:"clusterId"`
+	Domain    string                 `json:"domain"`
+	Freshness *refresh.DataFreshness `json:"freshness"`
 }
 
 // Subscription captures an active stream subscription.
@@ -65,6 +65,12 @@ var _ refresh.InformerHub = (*ingestInformerHub)(nil)
 // does not wait for every ingest-owned kind: domains that read cut resources
 // declare those keys and wait through ResourcesSettled, while global manager
 // startup and metrics polling match the factory-scoped startup gate.
+//
+// Both start at connect rather than on a view's first subscription: the object
+// catalog, object map, attention and overview builders read every built-in
+// kind whichever view is open, so connect is when their demand begins. Only
+// the per-CRD informers, whose sole consumer is a custom stream subscriber,
+// start on demand (resourcestream/custom_informer_demand.go).
 func (h *ingestInformerHub) Start(ctx context.Context) error {
 	if h.ingest != nil {
 		h.ingest.Start(ctx)
@@ -21,6 +21,7 @@ import (
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
@@ -66,6 +67,7 @@ type Config struct {
 	GatewayInformerFactory       gatewayinformers.SharedInformerFactory   // Informers for Gateway API resources.
 	GatewayAPIPresence           common.GatewayAPIPresence                // Installed Gateway API kind set.
 	DynamicClient                dynamic.Interface                        // Dynamic client for interacting with Kubernetes resources.
+	MetadataClient               metadata.Interface                       // Metadata-only client for kinds the catalog reads only metadata of.
 	ObjectDetailsProvider        snapshot.ObjectDetailProvider            // Provider for detailed object information.
 	Logger                       containerlogsstream.Logger               // Logger for recording refresh operations.
 	ObjectCatalogEnabled         func() bool                              // Function to check if the object catalog is enabled.
@@ -81,6 +83,13 @@ type Config struct {
 	// the permission checker's scope fan-out, the scoped namespaces domain,
 	// and the ingest manager's per-namespace reflectors.
 	AllowedNamespaces []string
+	// ResourceStreamSubscriberBufferSize and ResourceStreamResumeBufferSize
+	// override the resource stream buffers; zero keeps the config defaults.
+	ResourceStreamSubscriberBufferSize int
+	ResourceStreamResumeBufferSize     int
+	// CustomInformerIdleTimeout is how long idle custom resource informers
+	// keep running; zero keeps the config default.
+	CustomInformerIdleTimeout time.Duration
 }
 
 // Subsystem bundles the refresh manager and supporting services.
@@ -98,6 +107,10 @@ type Subsystem struct {
 	EventStream      *eventstream.Manager    // Manager for event streams.
 	ResourceStream   *resourcestream.Manager // Manager for resource streams.
 	ClusterMeta      snapshot.ClusterMeta    // Metadata about the cluster.
+	// Freshness tracks whether the cluster's data is being kept current. The
+	// app feeds it from heartbeats and watch failures; snapshots and stream
+	// ACKs report it.
+	Freshness *refresh.FreshnessTracker
 	// NamespaceNotifier and ObjectEventsNotifier drive the namespaces and
 	// object-events doorbells. Teardown/cooling MUST Stop() them (via
 	// StopDoorbellNotifiers) or their debounce/rearm timers keep broadcasting
@@ -109,6 +122,9 @@ type Subsystem struct {
 	// doorbell; the app attaches the cluster-Ready self-build hook here (see
 	// app_refresh_setup) once the aggregate service exists.
 	NamespacesDoorbell *NamespacesDoorbellObserver
+	// MetricsHistory is the bucketed per-pod usage history recorded from each
+	// metrics collection; nil when metrics are disabled.
+	MetricsHistory *metrics.History
 
 	// Cooled marks a subsystem in the governor's Cold-tier SERVING state: its informers,
 	// metrics poller, and permission revalidation are stopped (heap reclaimed) and its
@@ -249,7 +265,8 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 	// instead of wedging. Idempotent — only the first cluster build runs the probe.
 	informer.EnsureWatchListDecision(context.Background(), cfg.KubernetesClient)
 	informerFactory := informer.New(cfg.KubernetesClient, cfg.APIExtensionsClient, cfg.ResyncInterval, runtimePerms).
-		WithGatewayFactory(cfg.GatewayInformerFactory, cfg.GatewayAPIPresence)
+		WithGatewayFactory(cfg.GatewayInformerFactory, cfg.GatewayAPIPresence).
+		WithDynamicClient(cfg.DynamicClient)
 
 	// Owned-reflector ingestion for cut kinds: build the manager, register each cut
 	// kind's table/catalog/object-map projectors, and let the composite hub start +
@@ -266,6 +283,14 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 	// at runtime (objectcatalog maybePromote → RegisterDynamicCatalogReflector). Set before
 	// Start; nil leaves the on-demand path disabled and the catalog keeps listing CRs.
 	ingestManager.SetDynamicClient(cfg.DynamicClient)
+	// Promoted CR kinds share their watch with the resource stream's custom
+	// domains through the factory's dynamic informers.
+	if shared := informerFactory.DynamicInformers(); shared != nil {
+		ingestManager.SetSharedDynamicInformers(shared)
+	}
+	// Metadata client for the on-demand metadata-only reflectors the catalog promotes
+	// for kinds it reads only metadata of (RegisterMetadataCatalogReflector).
+	ingestManager.SetMetadataClient(cfg.MetadataClient)
 	registerIngestProjectors(ingestManager, cfg.ClusterID)
 	// Pods has no Stream descriptor (its table is the bespoke PodSummary), so the
 	// generic ingest loop above does not build it. Wire the pod reflector explicitly
@@ -339,6 +364,10 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 	var (
 		metricsPoller   refresh.MetricsPoller
 		metricsProvider metrics.Provider
+		metricsHistory  *metrics.History
+		// recordMetricsHistory reads the inner poller, not the demand wrapper,
+		// so recording a collection never counts as metrics demand.
+		recordMetricsHistory func()
 	)
 
 	serverHost := ""
@@ -370,6 +399,8 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 		demandPoller := metrics.NewDemandPoller(poller, poller, idleTimeout)
 		metricsPoller = demandPoller
 		metricsProvider = demandPoller
+		metricsHistory = metrics.NewHistory(config.MetricsHistoryRetention, config.MetricsHistoryResolution)
+		recordMetricsHistory = func() { metricsHistory.Record(poller.Sample()) }
 	} else {
 		logSkip("metrics-poller", "metrics.k8s.io", "nodes/pods")
 
@@ -432,13 +463,15 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 		return nil, err
 	}
 
+	freshness := refresh.NewFreshnessTracker()
 	snapshotService := snapshot.NewServiceWithPermissions(
 		registry,
 		telemetryRecorder,
 		clusterMeta,
 		runtimePerms,
 	).WithInformerHub(informerHub).
-		WithDomainReadiness(domainReadinessResources(registrations))
+		WithDomainReadiness(domainReadinessResources(registrations)).
+		WithFreshness(freshness)
 	queue := refresh.NewInMemoryQueue()
 
 	manager := refresh.NewManager(registry, informerHub, snapshotService, metricsPoller, queue)
@@ -469,19 +502,33 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 	}
 	if resourceManager != nil {
 		resourceManager.SetSnapshotDomainInvalidator(snapshotService.InvalidateDomainCache)
+		resourceManager.SetFreshnessSource(func(domain string) *refresh.DataFreshness {
+			return snapshotService.DomainFreshness(context.Background(), domain)
+		})
+		resourceManager.SetActivityObserver(freshness.RecordEvent)
 	}
 	if eventManager != nil && resourceManager != nil {
 		eventManager.SetSignalObserver(eventSignalObserver(resourceManager))
 	}
+	if eventManager != nil {
+		eventManager.SetLagObserver(eventLagObserver(freshness))
+	}
 	// Metric doorbell: each successful poller collection notifies the stream so
 	// the frontend refetches metric-bearing tables on the poller's schedule —
 	// no client-side metric polling. Wired via type assertion because the
-	// poller may be the disabled stub, which has no observer.
-	if resourceManager != nil {
+	// poller may be the disabled stub, which has no observer. The same observer
+	// records each collection into the metrics history.
+	if resourceManager != nil || recordMetricsHistory != nil {
 		if observable, ok := metricsPoller.(interface {
 			SetCollectionObserver(func(metrics.Metadata))
 		}); ok {
-			observable.SetCollectionObserver(metricsSignalObserver(resourceManager))
+			signal := metricsSignalObserver(resourceManager)
+			observable.SetCollectionObserver(func(metadata metrics.Metadata) {
+				if recordMetricsHistory != nil {
+					recordMetricsHistory()
+				}
+				signal(metadata)
+			})
 		}
 	}
 	// Namespaces doorbell: namespace object changes and workload-presence flips
@@ -516,10 +563,12 @@ func NewSubsystemWithServices(cfg Config) (*Subsystem, error) {
 		EventStream:          eventManager,
 		ResourceStream:       resourceManager,
 		ClusterMeta:          clusterMeta,
+		Freshness:            freshness,
 		NamespaceNotifier:    namespaceNotifier,
 		ObjectEventsNotifier: objectEventsNotifier,
 		AttentionIndex:       attentionIndex,
 		NamespacesDoorbell:   namespacesDoorbellObserver,
+		MetricsHistory:       metricsHistory,
 	}, nil
 }
 
@@ -648,6 +697,19 @@ func eventSignalObserver(resourceManager *resourcestream.Manager) func(scope str
 	}
 }
 
+// eventLagObserver records the delivery delay of each watched Kubernetes event
+// against the events domain its scope feeds, which is the watch lag the
+// freshness indicator reports for those domains.
+func eventLagObserver(freshness *refresh.FreshnessTracker) func(scope string, changedAt time.Time) {
+	return func(scope string, changedAt time.Time) {
+		domain := "cluster-events"
+		if strings.HasPrefix(strings.TrimSpace(scope), "namespace:") {
+			domain = "namespace-events"
+		}
+		freshness.RecordWatchLag(domain, changedAt)
+	}
+}
+
 // ingestPermissionFilter builds the predicate the ingest manager uses to decide whether
 // to launch each cut kind's reflector. It mirrors the shared factory's permission-skip
 // but conservatively: it skips a kind ONLY on a confirmed denial (allowed==false with no
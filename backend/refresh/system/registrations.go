@@ -425,6 +425,54 @@ func domainRegistrations(deps registrationDeps) []domainRegistration {
 		directRegistration("cluster-events", func() error {
 			return snapshot.RegisterClusterEventsDomain(deps.registry, deps.informerFactory.SharedInformerFactory(), snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName})
 		}),
+		directRegistration("cluster-node-packing", func() error {
+			return snapshot.RegisterNodePackingDomain(deps.registry, deps.ingestManager)
+		}),
+		directRegistration("cluster-namespace-usage", func() error {
+			return snapshot.RegisterNamespaceUsageDomain(deps.registry, deps.ingestManager, deps.metricsProvider)
+		}),
+		directRegistration("cluster-problem-pods", func() error {
+			return snapshot.RegisterProblemPodsDomain(deps.registry, deps.ingestManager)
+		}),
+		// Policy reports are read from shared dynamic informers; the gate
+		// covers the PolicyReports themselves and the CRDs that say which
+		// policy engines the cluster runs. ClusterPolicyReports and Gatekeeper
+		// constraints are checked per source at build time.
+		withRequire(listWatchRegistration(listWatchDomainConfig{
+			name:          "cluster-policy-reports",
+			issueResource: "wgpolicyk8s.io/policyreports",
+			logGroup:      "wgpolicyk8s.io",
+			logResource:   "policyreports",
+			checks: []listWatchCheck{
+				{group: "wgpolicyk8s.io", resource: "policyreports"},
+				{group: "apiextensions.k8s.io", resource: "customresourcedefinitions"},
+			},
+			registerInformer: func() error {
+				return snapshot.RegisterPolicyReportsDomain(
+					deps.registry,
+					deps.informerFactory.APIExtensionsInformerFactory(),
+					deps.informerFactory.DynamicInformers(),
+					deps.informerFactory.CanListWatch,
+					deps.cfg.AllowedNamespaces,
+					snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName},
+				)
+			},
+			deniedReason: "wgpolicyk8s.io/policyreports",
+		}), requireAvailable("dynamic client must be provided for policy reports", func() bool {
+			return deps.informerFactory != nil && deps.informerFactory.DynamicInformers() != nil
+		})),
+		directRegistration("cluster-velero", func() error {
+			return snapshot.RegisterVeleroDomain(deps.registry, deps.cfg.DynamicClient, snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName})
+		}),
+		directRegistration("cluster-flux", func() error {
+			return snapshot.RegisterFluxDomain(deps.registry, deps.cfg.DynamicClient, snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName})
+		}),
+		directRegistration("cluster-external-secrets", func() error {
+			return snapshot.RegisterExternalSecretsDomain(deps.registry, deps.cfg.DynamicClient, snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName})
+		}),
+		withSkipUnless(directRegistration("cluster-categories", func() error {
+			return snapshot.RegisterCategoryOverviewDomain(deps.registry, deps.cfg.ObjectCatalogService)
+		}), func() bool { return deps.cfg.ObjectCatalogService != nil }),
 
 		accessListRegistration(runtimeAccess, listDomainConfig{
 			name: "cluster-rbac",
@@ -483,6 +531,7 @@ func domainRegistrations(deps registrationDeps) []domainRegistration {
 			return snapshot.RegisterNamespaceAutoscalingDomain(
 				deps.registry,
 				deps.informerFactory.SharedInformerFactory(),
+				deps.cfg.DynamicClient,
 				snapshot.ClusterMeta{ClusterID: deps.cfg.ClusterID, ClusterName: deps.cfg.ClusterName},
 			)
 		}),
@@ -618,6 +667,30 @@ func domainRegistrations(deps registrationDeps) []domainRegistration {
 				deps.cfg.AllowedNamespaces,
 			)
 		}),
+		directRegistration("object-references", func() error {
+			return snapshot.RegisterObjectReferencesDomain(
+				deps.registry,
+				deps.informerFactory.SharedInformerFactory(),
+				deps.informerFactory,
+				deps.informerFactory.GatewayInformerFactory(),
+				deps.cfg.GatewayAPIPresence,
+				deps.cfg.ObjectCatalogService,
+				deps.ingestManager,
+				deps.cfg.AllowedNamespaces,
+			)
+		}),
+		directRegistration("object-storage-chain", func() error {
+			return snapshot.RegisterObjectStorageChainDomain(
+				deps.registry,
+				deps.informerFactory.SharedInformerFactory(),
+				deps.informerFactory,
+				deps.informerFactory.GatewayInformerFactory(),
+				deps.cfg.GatewayAPIPresence,
+				deps.cfg.ObjectCatalogService,
+				deps.ingestManager,
+				deps.cfg.AllowedNamespaces,
+			)
+		}),
 		directRegistration("object-maintenance", func() error {
 			return snapshot.RegisterNodeMaintenanceDomain(deps.registry)
 		}),
@@ -56,6 +56,8 @@ func registerStreamHandlers(mux *http.ServeMux, deps streamDeps) (*eventstream.M
 		deps.ingestManager,
 		deps.cfg.AllowedNamespaces...,
 	)
+	resourceManager.SetBufferSizes(deps.cfg.ResourceStreamSubscriberBufferSize, deps.cfg.ResourceStreamResumeBufferSize)
+	resourceManager.SetCustomInformerIdleTimeout(deps.cfg.CustomInformerIdleTimeout)
 	resourceHandler, err := resourcestream.NewHandler(resourceManager, logger, deps.telemetry, deps.clusterMeta)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,185 @@
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Prometheus metric types used by Exposition.
+const (
+	PrometheusCounter = "counter"
+	PrometheusGauge   = "gauge"
+)
+
+// Exposition collects samples and renders them in the Prometheus text format.
+// Samples may be added in any order; each family is written once, with its
+// HELP and TYPE lines, in the order families were first added.
+type Exposition struct {
+	families []*promFamily
+	byName   map[string]*promFamily
+}
+
+type promFamily struct {
+	name    string
+	kind    string
+	help    string
+	samples []string
+}
+
+// NewExposition returns an empty exposition.
+func NewExposition() *Exposition {
+	return &Exposition{byName: make(map[string]*promFamily)}
+}
+
+// Add records one sample. labels alternate names and values.
+func (e *Exposition) Add(name, kind, help string, value float64, labels ...string) {
+	family, ok := e.byName[name]
+	if !ok {
+		family = &promFamily{name: name, kind: kind, help: help}
+		e.byName[name] = family
+		e.families = append(e.families, family)
+	}
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) > 1 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(labels[i])
+			b.WriteString(`="`)
+			b.WriteString(promLabelEscaper.Replace(labels[i+1]))
+			b.WriteByte('"')
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(formatPromValue(value))
+	family.samples = append(family.samples, b.String())
+}
+
+// WriteTo writes the exposition to w.
+func (e *Exposition) WriteTo(w io.Writer) (int64, error) {
+	buf := bufio.NewWriter(w)
+	var written int64
+	for _, family := range e.families {
+		n, _ := fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", family.name, family.help, family.name, family.kind)
+		written += int64(n)
+		for _, sample := range family.samples {
+			n, _ = fmt.Fprintln(buf, sample)
+			written += int64(n)
+		}
+	}
+	return written, buf.Flush()
+}
+
+// promLabelEscaper escapes label values as the text format requires.
+var promLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatPromValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// AddSummaries adds the refresh telemetry of each cluster, keyed by cluster
+// ID, to e. Counters are cumulative since the cluster's subsystem started.
+func AddSummaries(e *Exposition, summaries map[string]Summary) {
+	clusterIDs := make([]string, 0, len(summaries))
+	for clusterID := range summaries {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+
+	for _, clusterID := range clusterIDs {
+		summary := summaries[clusterID]
+		// Snapshots are recorded per scope; export them per domain so scope
+		// names (often namespaces) do not multiply the series.
+		domains := make(map[string]*SnapshotStatus)
+		for _, s := range summary.Snapshots {
+			total, ok := domains[s.Domain]
+			if !ok {
+				copied := s
+				domains[s.Domain] = &copied
+				continue
+			}
+			total.SuccessCount += s.SuccessCount
+			total.FailureCount += s.FailureCount
+			total.TotalDurationMs += s.TotalDurationMs
+			total.MaxInformerSyncWaitMs = max(total.MaxInformerSyncWaitMs, s.MaxInformerSyncWaitMs)
+			if s.LastUpdated > total.LastUpdated {
+				total.LastUpdated = s.LastUpdated
+				total.LastDurationMs = s.LastDurationMs
+			}
+		}
+		domainNames := make([]string, 0, len(domains))
+		for domain := range domains {
+			domainNames = append(domainNames, domain)
+		}
+		sort.Strings(domainNames)
+		for _, domain := range domainNames {
+			s := domains[domain]
+			labels := []string{"cluster", clusterID, "domain", domain}
+			e.Add("luxury_yacht_snapshot_builds_total", PrometheusCounter, "Snapshot builds by outcome.",
+				float64(s.SuccessCount), append(labels, "status", string(SnapshotLastStatusSuccess))...)
+			e.Add("luxury_yacht_snapshot_builds_total", PrometheusCounter, "Snapshot builds by outcome.",
+				float64(s.FailureCount), append(labels, "status", string(SnapshotLastStatusError))...)
+			e.Add("luxury_yacht_snapshot_build_seconds_total", PrometheusCounter, "Time spent building snapshots.",
+				float64(s.TotalDurationMs)/1000, labels...)
+			e.Add("luxury_yacht_snapshot_last_build_seconds", PrometheusGauge, "Duration of the latest snapshot build.",
+				float64(s.LastDurationMs)/1000, labels...)
+			e.Add("luxury_yacht_snapshot_informer_sync_wait_max_seconds", PrometheusGauge, "Longest wait for informer sync before a snapshot build.",
+				float64(s.MaxInformerSyncWaitMs)/1000, labels...)
+		}
+
+		streams := append([]StreamStatus(nil), summary.Streams...)
+		sort.Slice(streams, func(i, j int) bool {
+			return streams[i].Name+"\x00"+streams[i].Domain < streams[j].Name+"\x00"+streams[j].Domain
+		})
+		for _, s := range streams {
+			labels := []string{"cluster", clusterID, "stream", s.Name, "domain", s.Domain}
+			e.Add("luxury_yacht_stream_active_sessions", PrometheusGauge, "Open stream sessions.",
+				float64(s.ActiveSessions), labels...)
+			e.Add("luxury_yacht_stream_messages_total", PrometheusCounter, "Stream messages delivered to subscribers.",
+				float64(s.TotalMessages), labels...)
+			e.Add("luxury_yacht_stream_dropped_total", PrometheusCounter, "Stream deliveries dropped or reset because a subscriber fell behind.",
+				float64(s.DroppedMessages), labels...)
+			e.Add("luxury_yacht_stream_errors_total", PrometheusCounter, "Stream errors.",
+				float64(s.ErrorCount), labels...)
+		}
+
+		if catalog := summary.Catalog; catalog != nil {
+			labels := []string{"cluster", clusterID}
+			e.Add("luxury_yacht_catalog_last_sync_seconds", PrometheusGauge, "Duration of the latest object catalog sync.",
+				float64(catalog.LastSyncMs)/1000, labels...)
+			e.Add("luxury_yacht_catalog_items", PrometheusGauge, "Objects in the object catalog.",
+				float64(catalog.ItemCount), labels...)
+			e.Add("luxury_yacht_catalog_consecutive_failures", PrometheusGauge, "Object catalog syncs failed in a row.",
+				float64(catalog.ConsecutiveFailures), labels...)
+		}
+
+		labels := []string{"cluster", clusterID}
+		e.Add("luxury_yacht_metrics_polls_total", PrometheusCounter, "Metrics server polls by outcome.",
+			float64(summary.Metrics.SuccessCount), append(labels, "status", string(SnapshotLastStatusSuccess))...)
+		e.Add("luxury_yacht_metrics_polls_total", PrometheusCounter, "Metrics server polls by outcome.",
+			float64(summary.Metrics.FailureCount), append(labels, "status", string(SnapshotLastStatusError))...)
+		e.Add("luxury_yacht_api_retries_total", PrometheusCounter, "Kubernetes API request retries.",
+			float64(summary.Connection.RetryAttempts), labels...)
+		e.Add("luxury_yacht_api_retries_exhausted_total", PrometheusCounter, "Kubernetes API requests that failed after every retry.",
+			float64(summary.Connection.RetryExhausted), labels...)
+		e.Add("luxury_yacht_transport_rebuilds_total", PrometheusCounter, "Kubernetes client transport rebuilds.",
+			float64(summary.Connection.TransportRebuilds), labels...)
+	}
+}
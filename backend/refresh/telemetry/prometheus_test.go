@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpositionGroupsFamiliesAndEscapesLabels(t *testing.T) {
+	e := NewExposition()
+	e.Add("a_total", PrometheusCounter, "A things.", 1, "cluster", `say "hi"\now`)
+	e.Add("b", PrometheusGauge, "B level.", 0.5)
+	e.Add("a_total", PrometheusCounter, "A things.", 2, "cluster", "two\nlines")
+
+	var out strings.Builder
+	_, err := e.WriteTo(&out)
+	require.NoError(t, err)
+	require.Equal(t, `# HELP a_total A things.
+# TYPE a_total counter
+a_total{cluster="say \"hi\"\\now"} 1
+a_total{cluster="two\nlines"} 2
+# HELP b B level.
+# TYPE b gauge
+b 0.5
+`, out.String())
+}
+
+func TestAddSummariesFoldsSnapshotScopesIntoDomains(t *testing.T) {
+	e := NewExposition()
+	AddSummaries(e, map[string]Summary{
+		"c1": {
+			Snapshots: []SnapshotStatus{
+				{Domain: "pods", Scope: "namespace:a", SuccessCount: 2, FailureCount: 1, TotalDurationMs: 300, LastDurationMs: 100, LastUpdated: 10},
+				{Domain: "pods", Scope: "namespace:b", SuccessCount: 3, TotalDurationMs: 1200, LastDurationMs: 400, LastUpdated: 20, MaxInformerSyncWaitMs: 2000},
+			},
+			Streams: []StreamStatus{{Name: StreamResources, Domain: "pods", TotalMessages: 7, DroppedMessages: 2}},
+		},
+	})
+
+	var out strings.Builder
+	_, err := e.WriteTo(&out)
+	require.NoError(t, err)
+	text := out.String()
+	require.Contains(t, text, `luxury_yacht_snapshot_builds_total{cluster="c1",domain="pods",status="success"} 5`)
+	require.Contains(t, text, `luxury_yacht_snapshot_builds_total{cluster="c1",domain="pods",status="error"} 1`)
+	require.Contains(t, text, `luxury_yacht_snapshot_build_seconds_total{cluster="c1",domain="pods"} 1.5`)
+	require.Contains(t, text, `luxury_yacht_snapshot_last_build_seconds{cluster="c1",domain="pods"} 0.4`)
+	require.Contains(t, text, `luxury_yacht_snapshot_informer_sync_wait_max_seconds{cluster="c1",domain="pods"} 2`)
+	require.Contains(t, text, `luxury_yacht_stream_dropped_total{cluster="c1",stream="resources",domain="pods"} 2`)
+	require.NotContains(t, text, "luxury_yacht_catalog_items", "no catalog telemetry was recorded")
+	require.Equal(t, 1, strings.Count(text, "# TYPE luxury_yacht_snapshot_builds_total counter"))
+}
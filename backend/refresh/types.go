@@ -124,6 +124,28 @@ type Snapshot struct {
 	Sequence       uint64            `json:"sequence"`
 	Payload        interface{}       `json:"payload"`
 	Stats          SnapshotStats     `json:"stats"`
+	// Delta is set when the snapshot was requested with a since version the
+	// server still holds. Payload then omits its row list, and the client
+	// rebuilds the rows from its copy of that version plus the delta.
+	Delta *SnapshotDelta `json:"delta,omitempty"`
+	// Freshness reports how current the data is when the snapshot is served.
+	Freshness *DataFreshness `json:"freshness,omitempty"`
+}
+
+// SnapshotDelta lists the row changes between the client's version of a
+// snapshot and the current one. Rows are matched by their ref.
+type SnapshotDelta struct {
+	// Since is the validator (ETag) of the version the delta applies to.
+	Since string `json:"since"`
+	// RowsField names the payload field holding the rows, such as "rows".
+	RowsField string `json:"rowsField"`
+	// Upserted holds rows that were added or changed.
+	Upserted []interface{} `json:"upserted,omitempty"`
+	// Removed holds the refs of rows that are gone.
+	Removed []interface{} `json:"removed,omitempty"`
+	// Order holds every row ref in payload order. It is sent only when the
+	// order differs from the kept rows followed by the new ones.
+	Order []interface{} `json:"order,omitempty"`
 }
 
 // SnapshotStats captures simple metrics for a snapshot build.
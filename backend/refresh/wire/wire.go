@@ -0,0 +1,256 @@
+// Package wire encodes refresh snapshot and stream payloads for the frontend.
+// JSON is the default. Clients may negotiate CBOR instead, which is cheaper
+// to produce for payloads carrying thousands of summary rows. The CBOR form
+// decodes to the same values the JSON form would: struct fields use their
+// json tags, times are RFC 3339 strings, and nil slices and maps are null.
+//
+// apimachinery types such as metav1.Time and resource.Quantity bring their own
+// MarshalCBOR, which writes text as CBOR byte strings. Decoders therefore read
+// untagged byte strings as UTF-8 text. Go []byte values are tagged 22 and read
+// as base64 text, which is what encoding/json produces for them.
+package wire
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	// ContentTypeJSON is the media type of JSON-encoded payloads.
+	ContentTypeJSON = "application/json"
+	// ContentTypeCBOR is the media type of CBOR-encoded payloads.
+	ContentTypeCBOR = "application/cbor"
+
+	// EncodingQueryParam names the query parameter stream clients use to pick
+	// an encoding, since websocket upgrades cannot negotiate via Accept.
+	EncodingQueryParam = "encoding"
+	// EncodingCBOR is the EncodingQueryParam value that selects CBOR.
+	EncodingCBOR = "cbor"
+)
+
+// Format is a payload encoding.
+type Format int
+
+const (
+	// FormatJSON encodes payloads as JSON.
+	FormatJSON Format = iota
+	// FormatCBOR encodes payloads as CBOR (RFC 8949).
+	FormatCBOR
+)
+
+// FormatFromAccept returns FormatCBOR when the Accept header lists
+// application/cbor, and FormatJSON otherwise.
+func FormatFromAccept(accept string) Format {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ContentTypeCBOR {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+			continue
+		}
+		return FormatCBOR
+	}
+	return FormatJSON
+}
+
+// FormatFromEncoding maps an EncodingQueryParam value to a Format.
+func FormatFromEncoding(encoding string) Format {
+	if strings.EqualFold(strings.TrimSpace(encoding), EncodingCBOR) {
+		return FormatCBOR
+	}
+	return FormatJSON
+}
+
+// ContentType returns the media type for the format.
+func (f Format) ContentType() string {
+	if f == FormatCBOR {
+		return ContentTypeCBOR
+	}
+	return ContentTypeJSON
+}
+
+// Marshal encodes v in the given format.
+func Marshal(f Format, v interface{}) ([]byte, error) {
+	if f == FormatCBOR {
+		return cborMode.Marshal(v)
+	}
+	return json.Marshal(v)
+}
+
+// Encode writes v to w in the given format. JSON output ends with a newline,
+// matching json.Encoder.
+func Encode(w io.Writer, f Format, v interface{}) error {
+	if f == FormatCBOR {
+		return cborMode.NewEncoder(w).Encode(v)
+	}
+	return json.NewEncoder(w).Encode(v)
+}
+
+// Unmarshal decodes data in the given format into v.
+func Unmarshal(f Format, data []byte, v interface{}) error {
+	if f == FormatCBOR {
+		return cbor.Unmarshal(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// RawValue wraps already-encoded bytes so Encode and Marshal write them
+// verbatim in the given format.
+func RawValue(f Format, data []byte) interface{} {
+	if f == FormatCBOR {
+		return cbor.RawMessage(data)
+	}
+	return json.RawMessage(data)
+}
+
+// SplitObject decodes an encoded object into its still-encoded fields.
+func SplitObject(f Format, data []byte) (map[string][]byte, error) {
+	out := make(map[string][]byte)
+	if f == FormatCBOR {
+		var fields map[string]cbor.RawMessage
+		if err := cbor.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		for key, value := range fields {
+			out[key] = value
+		}
+		return out, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		out[key] = value
+	}
+	return out, nil
+}
+
+// SplitArray decodes an encoded array into its still-encoded items.
+func SplitArray(f Format, data []byte) ([][]byte, error) {
+	if f == FormatCBOR {
+		var items []cbor.RawMessage
+		if err := cbor.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		out := make([][]byte, len(items))
+		for i, item := range items {
+			out[i] = item
+		}
+		return out, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	out := make([][]byte, len(items))
+	for i, item := range items {
+		out[i] = item
+	}
+	return out, nil
+}
+
+// IsNull reports whether data is an encoded null.
+func IsNull(f Format, data []byte) bool {
+	if f == FormatCBOR {
+		return bytes.Equal(data, []byte{0xf6})
+	}
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+var cborMode = func() cbor.EncMode {
+	opts := cborOptions()
+	// Types with their own MarshalJSON (json.RawMessage, apimachinery
+	// quantities without a CBOR form) are encoded from their JSON output.
+	opts.JSONMarshalerTranscoder = jsonTranscoder{}
+	mode, err := opts.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// plainCBORMode encodes the generic values produced while transcoding JSON.
+var plainCBORMode = func() cbor.EncMode {
+	mode, err := cborOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+func cborOptions() cbor.EncOptions {
+	return cbor.EncOptions{
+		// Sorted map keys keep encodings deterministic, as encoding/json
+		// does, so unchanged rows encode to identical bytes.
+		Sort:          cbor.SortBytewiseLexical,
+		ShortestFloat: cbor.ShortestFloat16,
+		NaNConvert:    cbor.NaNConvertReject,
+		InfConvert:    cbor.InfConvertReject,
+		Time:          cbor.TimeRFC3339Nano,
+		TimeTag:       cbor.EncTagNone,
+		IndefLength:   cbor.IndefLengthForbidden,
+		NilContainers: cbor.NilContainerAsNull,
+		OmitEmpty:     cbor.OmitEmptyGoValue,
+		String:        cbor.StringToTextString,
+		FieldName:     cbor.FieldNameToTextString,
+		ByteArray:     cbor.ByteArrayToArray,
+		// encoding/json writes []byte as base64 text. Tag 22 tells the
+		// frontend decoder to do the same, so both encodings agree.
+		ByteSliceLaterFormat: cbor.ByteSliceLaterFormatBase64,
+		BinaryMarshaler:      cbor.BinaryMarshalerNone,
+		TextMarshaler:        cbor.TextMarshalerTextString,
+	}
+}
+
+// jsonTranscoder converts the output of a MarshalJSON method into CBOR.
+type jsonTranscoder struct{}
+
+func (jsonTranscoder) Transcode(dst io.Writer, src io.Reader) error {
+	decoder := json.NewDecoder(src)
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	encoded, err := plainCBORMode.Marshal(normalizeJSONNumbers(value))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, bytes.NewReader(encoded))
+	return err
+}
+
+// normalizeJSONNumbers replaces json.Number values with int64 or float64 so
+// they encode as CBOR numbers rather than strings.
+func normalizeJSONNumbers(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case json.Number:
+		if i, err := typed.Int64(); err == nil {
+			return i
+		}
+		if f, err := typed.Float64(); err == nil {
+			return f
+		}
+		return typed.String()
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = normalizeJSONNumbers(item)
+		}
+		return typed
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = normalizeJSONNumbers(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
@@ -0,0 +1,177 @@
+package wire_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/refresh"
+	"github.com/luxury-yacht/app/backend/refresh/wire"
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+type wireSample struct {
+	Name      string            `json:"name"`
+	Count     int64             `json:"count"`
+	Ratio     float64           `json:"ratio"`
+	Hidden    string            `json:"-"`
+	Optional  string            `json:"optional,omitempty"`
+	Labels    map[string]string `json:"labels"`
+	Items     []string          `json:"items"`
+	Created   time.Time         `json:"created"`
+	Started   metav1.Time       `json:"started"`
+	Request   resource.Quantity `json:"request"`
+	Raw       json.RawMessage   `json:"raw"`
+	Embedded  interface{}       `json:"embedded"`
+	Untouched *string           `json:"untouched"`
+}
+
+// decodeCBORAsJSON decodes CBOR the way the frontend decoder does (untagged
+// byte strings as text) and round-trips the result through JSON so numbers
+// compare the same as the JSON-decoded side.
+func decodeCBORAsJSON(t *testing.T, data []byte) interface{} {
+	t.Helper()
+	mode, err := cbor.DecOptions{
+		DefaultMapType:        reflect.TypeOf(map[string]interface{}{}),
+		DefaultByteStringType: reflect.TypeOf(""),
+	}.DecMode()
+	if err != nil {
+		t.Fatalf("decode mode: %v", err)
+	}
+	var decoded interface{}
+	if err := mode.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("decode cbor: %v", err)
+	}
+	encoded, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("re-encode json: %v", err)
+	}
+	var out interface{}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		t.Fatalf("re-decode json: %v", err)
+	}
+	return out
+}
+
+func TestCBORDecodesToTheJSONValues(t *testing.T) {
+	sample := wireSample{
+		Name:     "pod-a",
+		Count:    42,
+		Ratio:    0.25,
+		Hidden:   "never sent",
+		Labels:   map[string]string{"app": "web"},
+		Created:  time.Date(2026, 10, 15, 12, 0, 0, 5, time.UTC),
+		Started:  metav1.NewTime(time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC)),
+		Request:  resource.MustParse("250m"),
+		Raw:      json.RawMessage(`{"nested":[1,2.5,"x",null,true]}`),
+		Embedded: refresh.SnapshotStats{ItemCount: 3, BuildDurationMs: 7},
+	}
+
+	jsonBytes, err := wire.Marshal(wire.FormatJSON, sample)
+	if err != nil {
+		t.Fatalf("marshal json: %v", err)
+	}
+	var fromJSON interface{}
+	if err := json.Unmarshal(jsonBytes, &fromJSON); err != nil {
+		t.Fatalf("decode json: %v", err)
+	}
+
+	cborBytes, err := wire.Marshal(wire.FormatCBOR, sample)
+	if err != nil {
+		t.Fatalf("marshal cbor: %v", err)
+	}
+	if fromCBOR := decodeCBORAsJSON(t, cborBytes); !reflect.DeepEqual(fromCBOR, fromJSON) {
+		t.Fatalf("cbor and json disagree:\ncbor: %#v\njson: %#v", fromCBOR, fromJSON)
+	}
+}
+
+func TestFormatNegotiation(t *testing.T) {
+	cases := map[string]wire.Format{
+		"":                                   wire.FormatJSON,
+		"application/json":                   wire.FormatJSON,
+		"application/cbor":                   wire.FormatCBOR,
+		"application/json, application/cbor": wire.FormatCBOR,
+		"application/cbor;q=0":               wire.FormatJSON,
+		"application/cbor;q=0.5, */*":        wire.FormatCBOR,
+	}
+	for accept, want := range cases {
+		if got := wire.FormatFromAccept(accept); got != want {
+			t.Errorf("FormatFromAccept(%q) = %v, want %v", accept, got, want)
+		}
+	}
+	if wire.FormatFromEncoding("CBOR") != wire.FormatCBOR || wire.FormatFromEncoding("") != wire.FormatJSON {
+		t.Fatal("unexpected encoding query mapping")
+	}
+	if wire.FormatCBOR.ContentType() != wire.ContentTypeCBOR || wire.FormatJSON.ContentType() != wire.ContentTypeJSON {
+		t.Fatal("unexpected content types")
+	}
+}
+
+func benchmarkPodRows(count int) []streamrows.PodSummary {
+	rows := make([]streamrows.PodSummary, count)
+	for i := range rows {
+		rows[i] = streamrows.PodSummary{
+			Ref: resourcemodel.ResourceRef{
+				ClusterID: "cluster-a",
+				Group:     "",
+				Version:   "v1",
+				Kind:      "Pod",
+				Namespace: fmt.Sprintf("team-%d", i%20),
+				Name:      fmt.Sprintf("web-%d-7f9c8d", i),
+				UID:       fmt.Sprintf("uid-%d", i),
+			},
+			Node:                 fmt.Sprintf("node-%d", i%50),
+			Status:               "Running",
+			StatusState:          "healthy",
+			Ready:                "1/1",
+			Restarts:             int32(i % 3),
+			Age:                  "3d",
+			AgeTimestamp:         1760000000000 + int64(i),
+			OwnerKind:            "Deployment",
+			OwnerName:            fmt.Sprintf("web-%d", i%40),
+			PortForwardAvailable: true,
+			CPURequest:           "100m",
+			CPULimit:             "500m",
+			CPUUsage:             "37m",
+			MemRequest:           "128Mi",
+			MemLimit:             "512Mi",
+			MemUsage:             "201Mi",
+		}
+	}
+	return rows
+}
+
+// BenchmarkEncodePodRows compares JSON and CBOR for a namespace:all sized
+// pods payload. Run with -benchmem to see the allocation difference too.
+func BenchmarkEncodePodRows(b *testing.B) {
+	payload := map[string]interface{}{"rows": benchmarkPodRows(5000)}
+	for _, format := range []struct {
+		name   string
+		format wire.Format
+	}{
+		{"json", wire.FormatJSON},
+		{"cbor", wire.FormatCBOR},
+	} {
+		b.Run(format.name, func(b *testing.B) {
+			data, err := wire.Marshal(format.format, payload)
+			if err != nil {
+				b.Fatalf("marshal: %v", err)
+			}
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for b.Loop() {
+				if _, err := wire.Marshal(format.format, payload); err != nil {
+					b.Fatalf("marshal: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -665,6 +665,12 @@ func TestActionWrappersRequireTargetIdentity(t *testing.T) {
 	if resp := app.FetchNodeLogs("cluster-a", "", NodeLogFetchRequest{SourcePath: "/var/log"}); resp.Error != "name is required" {
 		t.Fatalf("expected FetchNodeLogs name error, got %+v", resp)
 	}
+	if resp := app.StartNodeLogDebugPod("cluster-a", "", NodeLogDebugPodRequest{Namespace: "default", Image: "busybox"}); resp.Reason != "name is required" {
+		t.Fatalf("expected StartNodeLogDebugPod name error, got %+v", resp)
+	}
+	if resp := app.FetchNodeLogs("cluster-a", "node-a", NodeLogFetchRequest{SourcePath: "debug:default/pod/../etc"}); resp.Error != "invalid node log source path" {
+		t.Fatalf("expected debug source path error, got %+v", resp)
+	}
 }
 
 func TestNodeLogsRequireNodeProxyPermission(t *testing.T) {
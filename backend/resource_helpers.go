@@ -54,6 +54,7 @@ func (a *App) resourceDependenciesForSelection(selection kubeconfigSelection, cl
 	deps.GatewayAPIPresence = clients.gatewayAPIPresence
 	deps.GatewayVersionResolver = clients.gatewayVersionResolver
 	deps.DynamicClient = clients.dynamicClient
+	deps.MetadataClient = clients.metadataClient
 	deps.APIExtensionsClient = clients.apiextensionsClient
 	deps.RestConfig = clients.restConfig
 	deps.EnsureClient = func(resourceKind string) error {
@@ -9,6 +9,7 @@
 package admission
 
 import (
+	"github.com/luxury-yacht/app/backend/certexpiry"
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 )
@@ -20,7 +21,13 @@ func BuildValidatingStreamSummary(meta streamrows.ClusterMeta, webhook *admissio
 		return streamrows.ClusterConfigEntry{}
 	}
 	count := len(BuildValidatingFacts(meta.ClusterID, webhook).Webhooks)
-	return streamrows.NewClusterConfigEntry(meta, ValidatingIdentity, webhook, WebhookCountDetails(count), false)
+	entry := streamrows.NewClusterConfigEntry(meta, ValidatingIdentity, webhook, WebhookCountDetails(count), false)
+	bundles := make([][]byte, 0, len(webhook.Webhooks))
+	for _, hook := range webhook.Webhooks {
+		bundles = append(bundles, hook.ClientConfig.CABundle)
+	}
+	entry.CertificateNotAfter = earliestCABundleExpiry(bundles)
+	return entry
 }
 
 // BuildMutatingStreamSummary builds the cluster-config row for one
@@ -30,5 +37,21 @@ func BuildMutatingStreamSummary(meta streamrows.ClusterMeta, webhook *admissionr
 		return streamrows.ClusterConfigEntry{}
 	}
 	count := len(BuildMutatingFacts(meta.ClusterID, webhook).Webhooks)
-	return streamrows.NewClusterConfigEntry(meta, MutatingIdentity, webhook, WebhookCountDetails(count), false)
+	entry := streamrows.NewClusterConfigEntry(meta, MutatingIdentity, webhook, WebhookCountDetails(count), false)
+	bundles := make([][]byte, 0, len(webhook.Webhooks))
+	for _, hook := range webhook.Webhooks {
+		bundles = append(bundles, hook.ClientConfig.CABundle)
+	}
+	entry.CertificateNotAfter = earliestCABundleExpiry(bundles)
+	return entry
+}
+
+// earliestCABundleExpiry returns the earliest caBundle certificate expiry in
+// Unix ms, or 0 when no bundle holds a certificate.
+func earliestCABundleExpiry(bundles [][]byte) int64 {
+	notAfter, ok := certexpiry.EarliestNotAfter(bundles...)
+	if !ok {
+		return 0
+	}
+	return notAfter.UnixMilli()
 }
@@ -0,0 +1,54 @@
+package admission
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testCABundle(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "webhook-ca"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestBuildStreamSummariesReportEarliestCABundleExpiry(t *testing.T) {
+	early := time.Date(2026, 6, 2, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	meta := streamrows.ClusterMeta{ClusterID: "cluster-a"}
+
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "injector"},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{Name: "a.example", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: testCABundle(t, late)}},
+			{Name: "b.example", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: testCABundle(t, early)}},
+		},
+	}
+	require.Equal(t, early.UnixMilli(), BuildMutatingStreamSummary(meta, mutating).CertificateNotAfter)
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "c.example"}},
+	}
+	require.Zero(t, BuildValidatingStreamSummary(meta, validating).CertificateNotAfter)
+}
@@ -0,0 +1,207 @@
+/*
+ * backend/resources/apiextensions/schema.go
+ *
+ * CustomResourceDefinition schema viewer.
+ * - Flattens a version's structural OpenAPI v3 schema into a field tree
+ *   (types, descriptions, required, defaults, enums) for the frontend.
+ */
+
+package apiextensions
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SchemaField is one property in a CRD schema. Array items and map values
+// are described inline: ItemType/ValueType name their type and Children lists
+// their properties when they are objects.
+type SchemaField struct {
+	Name        string   `json:"name"`
+	Path        string   `json:"path"`
+	Type        string   `json:"type"`
+	Format      string   `json:"format,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Nullable    bool     `json:"nullable,omitempty"`
+	Default     string   `json:"default,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	ItemType    string   `json:"itemType,omitempty"`
+	ValueType   string   `json:"valueType,omitempty"`
+	// PreserveUnknownFields marks free-form objects the API server does not prune.
+	PreserveUnknownFields bool          `json:"preserveUnknownFields,omitempty"`
+	Children              []SchemaField `json:"children,omitempty"`
+}
+
+// CRDSchema is the rendered schema of one CRD version.
+type CRDSchema struct {
+	Name        string        `json:"name"`
+	Group       string        `json:"group"`
+	Kind        string        `json:"kind"`
+	Scope       string        `json:"scope"`
+	Version     string        `json:"version"`
+	Versions    []string      `json:"versions"`
+	Description string        `json:"description,omitempty"`
+	HasSchema   bool          `json:"hasSchema"`
+	Fields      []SchemaField `json:"fields"`
+}
+
+// CustomResourceDefinitionSchema renders the schema for version of the named
+// CRD; an empty version selects the storage version.
+func (s *Service) CustomResourceDefinitionSchema(name, version string) (*CRDSchema, error) {
+	if err := s.ensureAPIExtensions("CustomResourceDefinition"); err != nil {
+		return nil, err
+	}
+	crd, err := s.deps.APIExtensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		s.logError(fmt.Sprintf("Failed to get CRD %s: %v", name, err))
+		return nil, fmt.Errorf("failed to get CRD: %v", err)
+	}
+	return BuildCRDSchema(crd, version)
+}
+
+// BuildCRDSchema renders version of crd.
+func BuildCRDSchema(crd *apiextensionsv1.CustomResourceDefinition, version string) (*CRDSchema, error) {
+	selected, err := SelectVersion(crd, version)
+	if err != nil {
+		return nil, err
+	}
+	out := &CRDSchema{
+		Name:     crd.Name,
+		Group:    crd.Spec.Group,
+		Kind:     crd.Spec.Names.Kind,
+		Scope:    string(crd.Spec.Scope),
+		Version:  selected.Name,
+		Versions: make([]string, 0, len(crd.Spec.Versions)),
+		Fields:   []SchemaField{},
+	}
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			out.Versions = append(out.Versions, v.Name)
+		}
+	}
+	if selected.Schema == nil || selected.Schema.OpenAPIV3Schema == nil {
+		return out, nil
+	}
+	root := selected.Schema.OpenAPIV3Schema
+	out.HasSchema = true
+	out.Description = root.Description
+	out.Fields = SchemaFields(root, "")
+	return out, nil
+}
+
+// SelectVersion returns the named version, or the storage version when name
+// is empty.
+func SelectVersion(crd *apiextensionsv1.CustomResourceDefinition, name string) (*apiextensionsv1.CustomResourceDefinitionVersion, error) {
+	name = strings.TrimSpace(name)
+	for i := range crd.Spec.Versions {
+		v := &crd.Spec.Versions[i]
+		if (name == "" && v.Storage) || (name != "" && v.Name == name) {
+			return v, nil
+		}
+	}
+	if name == "" && len(crd.Spec.Versions) > 0 {
+		return &crd.Spec.Versions[0], nil
+	}
+	return nil, fmt.Errorf("CRD %s has no version %q", crd.Name, name)
+}
+
+// SchemaFields lists the properties of an object schema, sorted by name.
+// parent is the dotted path of the object ("" for the root).
+func SchemaFields(schema *apiextensionsv1.JSONSchemaProps, parent string) []SchemaField {
+	if schema == nil || len(schema.Properties) == 0 {
+		return nil
+	}
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]SchemaField, 0, len(names))
+	for _, name := range names {
+		prop := schema.Properties[name]
+		path := name
+		if parent != "" {
+			path = parent + "." + name
+		}
+		field := describe(&prop, name, path)
+		field.Required = required[name]
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func describe(prop *apiextensionsv1.JSONSchemaProps, name, path string) SchemaField {
+	field := SchemaField{
+		Name:                  name,
+		Path:                  path,
+		Type:                  SchemaType(prop),
+		Format:                prop.Format,
+		Description:           strings.TrimSpace(prop.Description),
+		Nullable:              prop.Nullable,
+		Pattern:               prop.Pattern,
+		Enum:                  EnumValues(prop),
+		PreserveUnknownFields: prop.XPreserveUnknownFields != nil && *prop.XPreserveUnknownFields,
+	}
+	if prop.Default != nil {
+		field.Default = string(prop.Default.Raw)
+	}
+	switch {
+	case prop.Items != nil && prop.Items.Schema != nil:
+		item := prop.Items.Schema
+		field.ItemType = SchemaType(item)
+		field.Children = SchemaFields(item, path+"[]")
+	case prop.AdditionalProperties != nil && prop.AdditionalProperties.Schema != nil:
+		value := prop.AdditionalProperties.Schema
+		field.ValueType = SchemaType(value)
+		field.Children = SchemaFields(value, path+".*")
+	default:
+		field.Children = SchemaFields(prop, path)
+	}
+	return field
+}
+
+// SchemaType names a property's type, including the Kubernetes extensions
+// that replace an explicit type.
+func SchemaType(prop *apiextensionsv1.JSONSchemaProps) string {
+	switch {
+	case prop.XIntOrString:
+		return "int-or-string"
+	case prop.XEmbeddedResource:
+		return "resource"
+	case prop.Type != "":
+		return prop.Type
+	case prop.XPreserveUnknownFields != nil && *prop.XPreserveUnknownFields:
+		return "any"
+	default:
+		return "object"
+	}
+}
+
+// EnumValues renders a property's enum as strings.
+func EnumValues(prop *apiextensionsv1.JSONSchemaProps) []string {
+	if len(prop.Enum) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(prop.Enum))
+	for _, raw := range prop.Enum {
+		var value string
+		if err := json.Unmarshal(raw.Raw, &value); err == nil {
+			values = append(values, value)
+			continue
+		}
+		values = append(values, string(raw.Raw))
+	}
+	return values
+}
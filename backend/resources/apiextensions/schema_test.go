@@ -0,0 +1,131 @@
+/*
+ * backend/resources/apiextensions/schema_test.go
+ *
+ * Tests for the CustomResourceDefinition schema viewer.
+ */
+
+package apiextensions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/internal/applog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+)
+
+func rawJSON(value string) *apiextensionsv1.JSON {
+	return &apiextensionsv1.JSON{Raw: []byte(value)}
+}
+
+// widgetCRD has a v1beta1 storage version with a representative schema and
+// an older served v1alpha1 without one.
+func widgetCRD() *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "widgets.example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Scope: apiextensionsv1.NamespaceScoped,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets", Kind: "Widget"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true, Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:        "object",
+						Description: "Widget is a demo resource.",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"apiVersion": {Type: "string"},
+							"kind":       {Type: "string"},
+							"metadata":   {Type: "object"},
+							"spec": {
+								Type:     "object",
+								Required: []string{"size"},
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"size":     {Type: "string", Description: "Widget size.", Enum: []apiextensionsv1.JSON{*rawJSON(`"small"`), *rawJSON(`"large"`)}},
+									"replicas": {Type: "integer", Format: "int32", Default: rawJSON(`1`)},
+									"port":     {XIntOrString: true},
+									"labels":   {Type: "object", AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}}},
+									"extra":    {Type: "object", XPreserveUnknownFields: ptr.To(true)},
+									"parts": {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+										Type:       "object",
+										Required:   []string{"name"},
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string"}, "count": {Type: "integer"}},
+									}}},
+								},
+							},
+						},
+					},
+				}},
+			},
+		},
+	}
+}
+
+func fieldByName(t *testing.T, fields []SchemaField, name string) SchemaField {
+	t.Helper()
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	t.Fatalf("field %q not found", name)
+	return SchemaField{}
+}
+
+func TestCustomResourceDefinitionSchemaUsesStorageVersion(t *testing.T) {
+	svc := NewService(common.Dependencies{
+		Context:             context.Background(),
+		Logger:              applog.Noop,
+		APIExtensionsClient: fake.NewClientset(widgetCRD()),
+	})
+
+	schema, err := svc.CustomResourceDefinitionSchema("widgets.example.com", "")
+	require.NoError(t, err)
+	require.True(t, schema.HasSchema)
+	require.Equal(t, "v1beta1", schema.Version)
+	require.Equal(t, []string{"v1alpha1", "v1beta1"}, schema.Versions)
+	require.Equal(t, "Widget", schema.Kind)
+	require.Equal(t, "Widget is a demo resource.", schema.Description)
+	require.Equal(t, []string{"apiVersion", "kind", "metadata", "spec"}, []string{
+		schema.Fields[0].Name, schema.Fields[1].Name, schema.Fields[2].Name, schema.Fields[3].Name,
+	})
+
+	spec := fieldByName(t, schema.Fields, "spec")
+	size := fieldByName(t, spec.Children, "size")
+	require.True(t, size.Required)
+	require.Equal(t, "spec.size", size.Path)
+	require.Equal(t, []string{"small", "large"}, size.Enum)
+	require.Equal(t, "Widget size.", size.Description)
+
+	replicas := fieldByName(t, spec.Children, "replicas")
+	require.False(t, replicas.Required)
+	require.Equal(t, "1", replicas.Default)
+	require.Equal(t, "int32", replicas.Format)
+
+	require.Equal(t, "int-or-string", fieldByName(t, spec.Children, "port").Type)
+	require.Equal(t, "string", fieldByName(t, spec.Children, "labels").ValueType)
+	require.True(t, fieldByName(t, spec.Children, "extra").PreserveUnknownFields)
+
+	parts := fieldByName(t, spec.Children, "parts")
+	require.Equal(t, "array", parts.Type)
+	require.Equal(t, "object", parts.ItemType)
+	name := fieldByName(t, parts.Children, "name")
+	require.True(t, name.Required)
+	require.Equal(t, "spec.parts[].name", name.Path)
+}
+
+func TestCustomResourceDefinitionSchemaWithoutSchema(t *testing.T) {
+	schema, err := BuildCRDSchema(widgetCRD(), "v1alpha1")
+	require.NoError(t, err)
+	require.False(t, schema.HasSchema)
+	require.Empty(t, schema.Fields)
+
+	_, err = BuildCRDSchema(widgetCRD(), "v2")
+	require.ErrorContains(t, err, "no version")
+}
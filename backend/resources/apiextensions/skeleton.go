@@ -0,0 +1,234 @@
+/*
+ * backend/resources/apiextensions/skeleton.go
+ *
+ * Skeleton custom resource generator.
+ * - Renders a commented example manifest from a CRD version's schema:
+ *   required fields are filled with defaults, enum values, or typed
+ *   placeholders; optional fields are listed as comments.
+ */
+
+package apiextensions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// skeletonDescriptionLimit caps the description text copied into comments, in
+// characters, so a cut never splits a multi-byte rune.
+const skeletonDescriptionLimit = 120
+
+// CustomResourceSkeleton renders an example manifest for the named CRD; an
+// empty version selects the storage version.
+func (s *Service) CustomResourceSkeleton(name, version, namespace string) (string, error) {
+	if err := s.ensureAPIExtensions("CustomResourceDefinition"); err != nil {
+		return "", err
+	}
+	crd, err := s.deps.APIExtensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		s.logError(fmt.Sprintf("Failed to get CRD %s: %v", name, err))
+		return "", fmt.Errorf("failed to get CRD: %v", err)
+	}
+	return BuildCustomResourceSkeleton(crd, version, namespace)
+}
+
+// BuildCustomResourceSkeleton renders version of crd. Namespaced kinds get
+// namespace ("default" when empty).
+func BuildCustomResourceSkeleton(crd *apiextensionsv1.CustomResourceDefinition, version, namespace string) (string, error) {
+	selected, err := SelectVersion(crd, version)
+	if err != nil {
+		return "", err
+	}
+	kind := crd.Spec.Names.Kind
+	w := &skeletonWriter{}
+	w.comment(0, fmt.Sprintf("%s (%s/%s)", kind, crd.Spec.Group, selected.Name))
+
+	var root *apiextensionsv1.JSONSchemaProps
+	if selected.Schema != nil {
+		root = selected.Schema.OpenAPIV3Schema
+	}
+	if root != nil && root.Description != "" {
+		w.comment(0, firstSentence(root.Description))
+	}
+	if root == nil {
+		w.comment(0, "This version has no schema; fields are not validated.")
+	}
+	w.line(0, false, "apiVersion: "+crd.Spec.Group+"/"+selected.Name)
+	w.line(0, false, "kind: "+kind)
+	w.line(0, false, "metadata:")
+	w.line(2, false, "name: example-"+strings.ToLower(kind))
+	if crd.Spec.Scope == apiextensionsv1.NamespaceScoped {
+		if strings.TrimSpace(namespace) == "" {
+			namespace = metav1.NamespaceDefault
+		}
+		w.line(2, false, "namespace: "+namespace)
+	}
+	if root == nil {
+		w.line(0, false, "spec: {}")
+		return w.String(), nil
+	}
+
+	// Top-level sections other than the envelope and status. spec is always
+	// written, even when the schema does not require it.
+	required := stringSet(root.Required)
+	for _, name := range sortedProperties(root) {
+		switch name {
+		case "apiVersion", "kind", "metadata", "status":
+			continue
+		}
+		prop := root.Properties[name]
+		w.field(0, name, &prop, required[name] || name == "spec", false)
+	}
+	return w.String(), nil
+}
+
+type skeletonWriter struct {
+	b strings.Builder
+}
+
+func (w *skeletonWriter) String() string {
+	return w.b.String()
+}
+
+func (w *skeletonWriter) line(indent int, commented bool, text string) {
+	w.b.WriteString(strings.Repeat(" ", indent))
+	if commented {
+		w.b.WriteString("# ")
+	}
+	w.b.WriteString(text)
+	w.b.WriteString("\n")
+}
+
+func (w *skeletonWriter) comment(indent int, text string) {
+	w.line(indent, true, text)
+}
+
+// field writes one property. Required fields are written with a value;
+// optional ones as a single commented line. Inside a commented block
+// everything stays commented.
+func (w *skeletonWriter) field(indent int, name string, prop *apiextensionsv1.JSONSchemaProps, required, commented bool) {
+	w.comment(indent, fieldDoc(prop, required))
+	if !required || commented {
+		w.line(indent, true, name+": "+placeholder(prop))
+		return
+	}
+	switch {
+	case hasProperties(prop):
+		w.line(indent, false, name+":")
+		w.object(indent+2, prop)
+	case prop.Type == "array" && prop.Items != nil && prop.Items.Schema != nil && hasProperties(prop.Items.Schema):
+		w.line(indent, false, name+":")
+		item := &skeletonWriter{}
+		item.object(0, prop.Items.Schema)
+		w.listItem(indent+2, item.String())
+	default:
+		w.line(indent, false, name+": "+placeholder(prop))
+	}
+}
+
+func (w *skeletonWriter) object(indent int, prop *apiextensionsv1.JSONSchemaProps) {
+	required := stringSet(prop.Required)
+	names := sortedProperties(prop)
+	// Required fields first so the filled-in part of the manifest reads top-down.
+	sort.SliceStable(names, func(i, j int) bool { return required[names[i]] && !required[names[j]] })
+	if len(required) == 0 {
+		w.line(indent, false, "{}")
+	}
+	for _, name := range names {
+		child := prop.Properties[name]
+		w.field(indent, name, &child, required[name], false)
+	}
+}
+
+// listItem writes an object rendered at indent 0 as the first element of a
+// YAML sequence.
+func (w *skeletonWriter) listItem(indent int, rendered string) {
+	first := true
+	for _, text := range strings.Split(strings.TrimRight(rendered, "\n"), "\n") {
+		prefix := "  "
+		if first && !strings.HasPrefix(strings.TrimSpace(text), "#") {
+			prefix = "- "
+			first = false
+		}
+		w.b.WriteString(strings.Repeat(" ", indent) + prefix + text + "\n")
+	}
+}
+
+func fieldDoc(prop *apiextensionsv1.JSONSchemaProps, required bool) string {
+	facts := []string{SchemaType(prop)}
+	if required {
+		facts = append(facts, "required")
+	}
+	if enum := EnumValues(prop); len(enum) > 0 {
+		facts = append(facts, "one of: "+strings.Join(enum, ", "))
+	}
+	doc := "[" + strings.Join(facts, ", ") + "]"
+	if description := firstSentence(prop.Description); description != "" {
+		doc = description + " " + doc
+	}
+	return doc
+}
+
+// placeholder is the value written for a field: its default, its first enum
+// value, or an empty value of its type. Defaults and enums are JSON, which
+// YAML reads as flow style.
+func placeholder(prop *apiextensionsv1.JSONSchemaProps) string {
+	if prop.Default != nil && len(prop.Default.Raw) > 0 {
+		return string(prop.Default.Raw)
+	}
+	if len(prop.Enum) > 0 {
+		return string(prop.Enum[0].Raw)
+	}
+	switch SchemaType(prop) {
+	case "string":
+		return `""`
+	case "integer", "number", "int-or-string":
+		return "0"
+	case "boolean":
+		return "false"
+	case "array":
+		return "[]"
+	default:
+		return "{}"
+	}
+}
+
+func hasProperties(prop *apiextensionsv1.JSONSchemaProps) bool {
+	return prop != nil && len(prop.Properties) > 0
+}
+
+func sortedProperties(prop *apiextensionsv1.JSONSchemaProps) []string {
+	names := make([]string, 0, len(prop.Properties))
+	for name := range prop.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func stringSet(values []string) map[string]bool {
+	out := make(map[string]bool, len(values))
+	for _, value := range values {
+		out[value] = true
+	}
+	return out
+}
+
+// firstSentence trims a description to its first line or sentence.
+func firstSentence(text string) string {
+	text = strings.TrimSpace(text)
+	if idx := strings.IndexByte(text, '\n'); idx >= 0 {
+		text = strings.TrimSpace(text[:idx])
+	}
+	if idx := strings.Index(text, ". "); idx >= 0 {
+		text = text[:idx+1]
+	}
+	if runes := []rune(text); len(runes) > skeletonDescriptionLimit {
+		text = strings.TrimSpace(string(runes[:skeletonDescriptionLimit])) + "..."
+	}
+	return text
+}
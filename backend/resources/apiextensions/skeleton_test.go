@@ -0,0 +1,81 @@
+/*
+ * backend/resources/apiextensions/skeleton_test.go
+ *
+ * Tests for the skeleton custom resource generator.
+ */
+
+package apiextensions
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/luxury-yacht/app/backend/internal/applog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+)
+
+func TestCustomResourceSkeletonFillsRequiredFields(t *testing.T) {
+	crd := widgetCRD()
+	spec := crd.Spec.Versions[1].Schema.OpenAPIV3Schema.Properties["spec"]
+	spec.Required = append(spec.Required, "parts")
+	crd.Spec.Versions[1].Schema.OpenAPIV3Schema.Properties["spec"] = spec
+	svc := NewService(common.Dependencies{
+		Context:             context.Background(),
+		Logger:              applog.Noop,
+		APIExtensionsClient: fake.NewClientset(crd),
+	})
+
+	manifest, err := svc.CustomResourceSkeleton("widgets.example.com", "", "team-a")
+	require.NoError(t, err)
+	require.Contains(t, manifest, "# Widget (example.com/v1beta1)")
+	require.Contains(t, manifest, "# Widget size. [string, required, one of: small, large]")
+	require.Contains(t, manifest, `# replicas: 1`)
+	require.Contains(t, manifest, `# labels: {}`)
+
+	var parsed map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &parsed), manifest)
+	require.Equal(t, "example.com/v1beta1", parsed["apiVersion"])
+	require.Equal(t, "Widget", parsed["kind"])
+	metadata := parsed["metadata"].(map[string]interface{})
+	require.Equal(t, "example-widget", metadata["name"])
+	require.Equal(t, "team-a", metadata["namespace"])
+
+	specValues := parsed["spec"].(map[string]interface{})
+	require.Equal(t, "small", specValues["size"])
+	require.NotContains(t, specValues, "replicas", "optional fields stay commented out")
+	require.Equal(t, []interface{}{map[string]interface{}{"name": ""}}, specValues["parts"])
+}
+
+func TestCustomResourceSkeletonClusterScopedWithoutSchema(t *testing.T) {
+	crd := widgetCRD()
+	crd.Spec.Scope = apiextensionsv1.ClusterScoped
+
+	manifest, err := BuildCustomResourceSkeleton(crd, "v1alpha1", "ignored")
+	require.NoError(t, err)
+	require.Contains(t, manifest, "no schema")
+
+	var parsed map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &parsed))
+	require.NotContains(t, parsed["metadata"], "namespace")
+	require.Equal(t, map[string]interface{}{}, parsed["spec"])
+}
+
+func TestFirstSentenceTruncatesOnRuneBoundary(t *testing.T) {
+	// 119 ASCII bytes put the 120-byte cut in the middle of "é".
+	text := strings.Repeat("a", skeletonDescriptionLimit-1) + strings.Repeat("é", 10)
+
+	got := firstSentence(text)
+	require.True(t, utf8.ValidString(got), got)
+	require.Equal(t, strings.Repeat("a", skeletonDescriptionLimit-1)+"é...", got)
+
+	short := "Größe des Widgets. Weitere Details folgen."
+	require.Equal(t, "Größe des Widgets.", firstSentence(short))
+	require.Equal(t, "日本語の説明", firstSentence("日本語の説明"))
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/metrics/pkg/client/clientset/versioned"
 	gatewayversioned "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
@@ -46,6 +47,7 @@ type Dependencies struct {
 	MetricsClient          versioned.Interface
 	SetMetricsClient       func(versioned.Interface)
 	DynamicClient          dynamic.Interface
+	MetadataClient         metadata.Interface
 	APIExtensionsClient    clientset.Interface
 	RestConfig             *rest.Config
 	ResourceResolver       ResourceResolver
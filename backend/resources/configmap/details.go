@@ -8,7 +8,6 @@
 package configmap
 
 import (
-	"encoding/base64"
 	"fmt"
 
 	"github.com/luxury-yacht/app/backend/internal/logsources"
@@ -50,19 +49,13 @@ func (s *Service) processConfigMapDetails(cm *corev1.ConfigMap, relationships *r
 		Kind:        "ConfigMap",
 		Name:        cm.Name,
 		Namespace:   cm.Namespace,
-		Data:        cm.Data,
+		Keys:        restypes.DataKeyInfos(cm.Data, cm.BinaryData),
 		DataCount:   facts.DataCount,
+		DataSize:    facts.DataSizeBytes,
 		Labels:      cm.Labels,
 		Annotations: cm.Annotations,
 	}
 
-	if len(cm.BinaryData) > 0 {
-		details.BinaryData = make(map[string]string, len(cm.BinaryData))
-		for key, value := range cm.BinaryData {
-			details.BinaryData[key] = base64.StdEncoding.EncodeToString(value)
-		}
-	}
-
 	details.UsedBy = restypes.ObjectRefsFromResourceLinks(facts.UsedBy)
 
 	details.Details = fmt.Sprintf("Data items: %d", details.DataCount)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/resources/configmap"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
 	"github.com/luxury-yacht/app/backend/testsupport"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -85,5 +86,33 @@ func TestServiceConfigMapDetailsIncludesUsage(t *testing.T) {
 	require.Equal(t, "pods", detail.UsedBy[0].Resource)
 	require.Equal(t, "default", detail.UsedBy[0].Namespace)
 	require.Equal(t, "web-0", detail.UsedBy[0].Name)
-	require.Contains(t, detail.BinaryData, "secret")
+	require.Equal(t, []restypes.DataKeyInfo{
+		{Key: "CONFIG", Size: 5},
+		{Key: "secret", Size: 10, Binary: true},
+	}, detail.Keys)
+	require.Equal(t, int64(15), detail.DataSize)
+}
+
+func TestServiceValueFetchesOneKeyByRange(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "big", Namespace: "default"},
+		Data:       map[string]string{"payload": "0123456789"},
+		BinaryData: map[string][]byte{"blob": {0xff, 0xfe}},
+	}
+	service := newService(t, fake.NewClientset(cm))
+
+	chunk, err := service.Value("default", "big", "payload", restypes.DataValueRange{Offset: 2, Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, "234", chunk.Value)
+	require.Equal(t, 10, chunk.Size)
+	require.Equal(t, 5, chunk.NextOffset)
+	require.True(t, chunk.Truncated)
+
+	blob, err := service.Value("default", "big", "blob", restypes.DataValueRange{})
+	require.NoError(t, err)
+	require.True(t, blob.Binary)
+	require.Equal(t, "//4=", blob.Value)
+
+	_, err = service.Value("default", "big", "absent", restypes.DataValueRange{})
+	require.ErrorContains(t, err, `no key "absent"`)
 }
@@ -1,6 +1,7 @@
 package configmap_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,6 +9,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
 	"github.com/luxury-yacht/app/backend/resources/configmap"
 )
@@ -88,3 +91,21 @@ func TestBuildResourceModelTerminatingStatus(t *testing.T) {
 	require.Equal(t, "terminating", model.Status.Presentation)
 	require.True(t, model.Status.Lifecycle.FinalizerBlocked)
 }
+
+func TestBuildAggregateHashesKeysAndKeepsSmallValues(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"A": "one"},
+		BinaryData: map[string][]byte{"cert": []byte("tls")},
+	}
+	aggregate := configmap.BuildAggregate(cm)
+	require.Equal(t, "app-config", aggregate.Name)
+	require.Equal(t, map[string]string{
+		"A":    streamrows.HashConfigValue([]byte("one")),
+		"cert": streamrows.HashConfigValue([]byte("tls")),
+	}, aggregate.Hashes)
+	require.Equal(t, map[string]string{"A": "one"}, aggregate.Values)
+
+	cm.Data["big"] = strings.Repeat("x", config.ConfigHistoryContentLimit)
+	require.Nil(t, configmap.BuildAggregate(cm).Values)
+}
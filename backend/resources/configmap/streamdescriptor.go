@@ -30,6 +30,9 @@ var StreamDescriptor = streamspec.Descriptor{
 	StreamRow: func(meta streamrows.ClusterMeta, obj metav1.Object) any {
 		return BuildStreamSummary(meta, obj.(*corev1.ConfigMap))
 	},
+	AggregateRow: func(obj metav1.Object) any {
+		return BuildAggregate(obj.(*corev1.ConfigMap))
+	},
 	Informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
 		return factory.Core().V1().ConfigMaps().Informer()
 	},
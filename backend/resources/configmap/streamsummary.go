@@ -10,6 +10,7 @@
 package configmap
 
 import (
+	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -28,3 +29,31 @@ func BuildStreamSummary(meta streamrows.ClusterMeta, cm *corev1.ConfigMap) strea
 		AgeTimestamp: streamrows.CreationMillis(cm),
 	}
 }
+
+// BuildAggregate projects a digest of each data key for the config change history,
+// and the string values when they fit config.ConfigHistoryContentLimit.
+func BuildAggregate(cm *corev1.ConfigMap) streamrows.ConfigDataAggregate {
+	if cm == nil {
+		return streamrows.ConfigDataAggregate{}
+	}
+	aggregate := streamrows.ConfigDataAggregate{
+		Namespace: cm.Namespace,
+		Name:      cm.Name,
+		Hashes:    make(map[string]string, len(cm.Data)+len(cm.BinaryData)),
+	}
+	size := 0
+	for key, value := range cm.Data {
+		aggregate.Hashes[key] = streamrows.HashConfigValue([]byte(value))
+		size += len(value)
+	}
+	for key, value := range cm.BinaryData {
+		aggregate.Hashes[key] = streamrows.HashConfigValue(value)
+	}
+	if size <= config.ConfigHistoryContentLimit {
+		aggregate.Values = cm.Data
+		if aggregate.Values == nil {
+			aggregate.Values = map[string]string{}
+		}
+	}
+	return aggregate
+}
@@ -0,0 +1,33 @@
+/*
+ * backend/resources/configmap/value.go
+ *
+ * Per-key ConfigMap value fetch. Details carry only keys and sizes, so the
+ * object panel loads values on demand, a byte range at a time for large keys.
+ */
+
+package configmap
+
+import (
+	"fmt"
+
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Value returns the requested range of one data or binaryData key. Binary
+// values come back base64-encoded.
+func (s *Service) Value(namespace, name, key string, rng restypes.DataValueRange) (*restypes.DataValue, error) {
+	cm, err := s.deps.KubernetesClient.CoreV1().ConfigMaps(namespace).Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap: %v", err)
+	}
+	if value, ok := cm.Data[key]; ok {
+		chunk := restypes.SliceDataValue(key, []byte(value), false, rng)
+		return &chunk, nil
+	}
+	if value, ok := cm.BinaryData[key]; ok {
+		chunk := restypes.SliceDataValue(key, value, true, rng)
+		return &chunk, nil
+	}
+	return nil, fmt.Errorf("configmap %s/%s has no key %q", namespace, name, key)
+}
@@ -10,7 +10,6 @@ package cronjob
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/luxury-yacht/app/backend/internal/logsources"
@@ -19,7 +18,6 @@ import (
 	"github.com/luxury-yacht/app/backend/resources/pods"
 	restypes "github.com/luxury-yacht/app/backend/resources/types"
 	"github.com/luxury-yacht/app/backend/resources/workloads"
-	"github.com/robfig/cron/v3"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -65,7 +63,7 @@ func buildCronJobDetails(clusterID string, cronJob *batchv1.CronJob, jobs *batch
 	facts := BuildFacts(cronJob)
 
 	// Intrinsic scheduling fields come from the model facts (single extraction).
-	// The job-template sub-structure, next-schedule computation, and live job
+	// The job-template sub-structure, schedule preview, and live job
 	// correlation are assembled below (template feeds shared formatters; the rest
 	// is display / live data, not the resource's intrinsic definition).
 	details := &CronJobDetails{
@@ -95,9 +93,7 @@ func buildCronJobDetails(clusterID string, cronJob *batchv1.CronJob, jobs *batch
 		Containers:              workloads.DescribeContainers(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers),
 	}
 
-	if !details.Suspend {
-		details.NextScheduleTime, details.TimeUntilNextSchedule = calculateNextSchedule(cronJob.Spec.Schedule, cronJob.Spec.TimeZone)
-	}
+	details.SchedulePreview = buildSchedulePreview(cronJob.Spec.Schedule, cronJob.Spec.TimeZone, details.Suspend, time.Now())
 
 	details.LastManualTime, details.LastFailureTime = computeRunMarkers(cronJob, jobs)
 
@@ -356,39 +352,3 @@ func summarizeCronJob(details *CronJobDetails) string {
 	}
 	return summary
 }
-
-// calculateNextSchedule parses the CronJob's schedule expression and returns
-// the next firing time as RFC3339 plus a human "in 15m" string. Falls back to
-// empty values when the expression is unparseable so the frontend can hide the
-// row instead of showing wrong data.
-func calculateNextSchedule(schedule string, timeZone *string) (string, string) {
-	return calculateNextScheduleAt(schedule, timeZone, time.Now())
-}
-
-func calculateNextScheduleAt(schedule string, timeZone *string, now time.Time) (string, string) {
-	// k8s CronJob uses the standard 5-field format (no seconds) plus
-	// the @yearly/@hourly/@daily descriptors. cron.ParseStandard covers
-	// both, matching the kube-controller-manager parser.
-	expr, err := cron.ParseStandard(formatCronJobSchedule(schedule, timeZone))
-	if err != nil {
-		return "", ""
-	}
-	nextTime := expr.Next(now)
-	if nextTime.IsZero() {
-		return "", ""
-	}
-	return nextTime.Format(time.RFC3339), common.FormatAge(time.Now().Add(-nextTime.Sub(now)))
-}
-
-func formatCronJobSchedule(schedule string, timeZone *string) string {
-	if strings.Contains(schedule, "TZ") {
-		return schedule
-	}
-	if timeZone == nil {
-		return schedule
-	}
-	if _, err := time.LoadLocation(*timeZone); err != nil {
-		return schedule
-	}
-	return fmt.Sprintf("TZ=%s %s", *timeZone, schedule)
-}
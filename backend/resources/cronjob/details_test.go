@@ -72,13 +72,18 @@ func TestCronJobServiceCollectsPods(t *testing.T) {
 	require.Len(t, detail.Pods, 1)
 	require.Equal(t, job.Name, detail.ActiveJobs[0].Name)
 	require.NotNil(t, detail.ActiveJobs[0].StartTime)
-	// `*/5 * * * *` always has a future fire-time within 5 minutes of any
-	// "now", so we expect a parseable RFC3339 value and a non-empty
-	// "until" duration. Exact values are clock-dependent.
-	require.NotEmpty(t, detail.NextScheduleTime)
-	_, err = time.Parse(time.RFC3339, detail.NextScheduleTime)
+	// `*/5 * * * *` always has future fire-times five minutes apart, so we
+	// expect parseable RFC3339 values. Exact values are clock-dependent.
+	require.NotNil(t, detail.SchedulePreview)
+	require.Equal(t, "Every 5 minutes", detail.SchedulePreview.Description)
+	require.Equal(t, "UTC", detail.SchedulePreview.TimeZone)
+	require.True(t, detail.SchedulePreview.DefaultTimeZone)
+	require.Len(t, detail.SchedulePreview.NextRuns, 5)
+	first, err := time.Parse(time.RFC3339, detail.SchedulePreview.NextRuns[0])
 	require.NoError(t, err)
-	require.NotEmpty(t, detail.TimeUntilNextSchedule)
+	second, err := time.Parse(time.RFC3339, detail.SchedulePreview.NextRuns[1])
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Minute, second.Sub(first))
 	require.Contains(t, detail.Details, "Schedule: "+cron.Spec.Schedule)
 }
 
@@ -92,10 +97,10 @@ func TestCronJobServiceComputesNextScheduleBeforeFirstRun(t *testing.T) {
 	service := cronjob.NewService(deps)
 	detail, err := service.CronJob("default", "nightly")
 	require.NoError(t, err)
-	require.NotEmpty(t, detail.NextScheduleTime)
-	_, err = time.Parse(time.RFC3339, detail.NextScheduleTime)
+	require.NotNil(t, detail.SchedulePreview)
+	require.NotEmpty(t, detail.SchedulePreview.NextRuns)
+	_, err = time.Parse(time.RFC3339, detail.SchedulePreview.NextRuns[0])
 	require.NoError(t, err)
-	require.NotEmpty(t, detail.TimeUntilNextSchedule)
 }
 
 func TestCronJobServiceUsesSpecTimeZoneForNextSchedule(t *testing.T) {
@@ -112,9 +117,12 @@ func TestCronJobServiceUsesSpecTimeZoneForNextSchedule(t *testing.T) {
 	detail, err := service.CronJob("default", "nightly")
 	after := time.Now()
 	require.NoError(t, err)
-	require.NotEmpty(t, detail.NextScheduleTime)
+	require.NotNil(t, detail.SchedulePreview)
+	require.Equal(t, "UTC", detail.SchedulePreview.TimeZone)
+	require.False(t, detail.SchedulePreview.DefaultTimeZone)
+	require.NotEmpty(t, detail.SchedulePreview.NextRuns)
 
-	got, err := time.Parse(time.RFC3339, detail.NextScheduleTime)
+	got, err := time.Parse(time.RFC3339, detail.SchedulePreview.NextRuns[0])
 	require.NoError(t, err)
 	schedule, err := cron.ParseStandard("TZ=UTC " + cronJob.Spec.Schedule)
 	require.NoError(t, err)
@@ -22,12 +22,11 @@ type CronJobDetails struct {
 	Details string `json:"details"`
 
 	// Schedule information
-	Schedule              string       `json:"schedule"`
-	Suspend               bool         `json:"suspend"`
-	LastScheduleTime      *metav1.Time `json:"lastScheduleTime,omitempty"`
-	LastSuccessfulTime    *metav1.Time `json:"lastSuccessfulTime,omitempty"`
-	NextScheduleTime      string       `json:"nextScheduleTime,omitempty"`
-	TimeUntilNextSchedule string       `json:"timeUntilNextSchedule,omitempty"`
+	Schedule           string           `json:"schedule"`
+	Suspend            bool             `json:"suspend"`
+	LastScheduleTime   *metav1.Time     `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime *metav1.Time     `json:"lastSuccessfulTime,omitempty"`
+	SchedulePreview    *SchedulePreview `json:"schedulePreview,omitempty"`
 
 	// Derived from owned Jobs — bounded by job-history retention. A
 	// nil value can mean "never happened" OR "happened but the job
@@ -58,3 +57,23 @@ type CronJobDetails struct {
 	Pods              []restypes.PodSimpleInfo    `json:"pods,omitempty"`
 	PodMetricsSummary *restypes.PodMetricsSummary `json:"podMetricsSummary,omitempty"`
 }
+
+// SchedulePreview describes the schedule expression and the runs it produces
+// next, evaluated in the CronJob's time zone.
+type SchedulePreview struct {
+	// Description is the schedule in words, e.g. "At 02:30, on Monday
+	// through Friday". Empty when the expression cannot be described.
+	Description string `json:"description,omitempty"`
+	// TimeZone is the zone the schedule is evaluated in: spec.timeZone, a
+	// TZ= prefix in the schedule, or UTC when neither is set.
+	TimeZone string `json:"timeZone"`
+	// DefaultTimeZone is set when the CronJob names no zone. The controller
+	// then uses kube-controller-manager's local zone, assumed to be UTC.
+	DefaultTimeZone bool `json:"defaultTimeZone,omitempty"`
+	// NextRuns are RFC3339 times in TimeZone, soonest first. Empty while
+	// the CronJob is suspended.
+	NextRuns []string `json:"nextRuns,omitempty"`
+	// Error explains why no runs could be computed (invalid expression or
+	// unknown time zone).
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,290 @@
+/*
+ * backend/resources/cronjob/schedule.go
+ *
+ * Schedule preview for the CronJob detail view: the next run times, evaluated
+ * in the CronJob's time zone, and the schedule expression in words.
+ */
+
+package cronjob
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// schedulePreviewRuns is how many upcoming runs the preview lists.
+const schedulePreviewRuns = 5
+
+// buildSchedulePreview parses the schedule the way the CronJob controller does
+// (cron.ParseStandard: five fields plus the @ descriptors) and lists the next
+// runs after now. A suspended CronJob still gets its description and zone, but
+// no runs.
+func buildSchedulePreview(schedule string, timeZone *string, suspended bool, now time.Time) *SchedulePreview {
+	spec, inlineZone := splitScheduleTimeZone(schedule)
+	preview := &SchedulePreview{}
+	switch {
+	case timeZone != nil && *timeZone != "":
+		preview.TimeZone = *timeZone
+	case inlineZone != "":
+		preview.TimeZone = inlineZone
+	default:
+		preview.TimeZone = "UTC"
+		preview.DefaultTimeZone = true
+	}
+
+	expr, err := cron.ParseStandard(spec)
+	if err != nil {
+		preview.Error = fmt.Sprintf("invalid schedule: %v", err)
+		return preview
+	}
+	preview.Description = describeSchedule(spec)
+
+	location, err := time.LoadLocation(preview.TimeZone)
+	if err != nil {
+		preview.Error = fmt.Sprintf("unknown time zone %q", preview.TimeZone)
+		return preview
+	}
+	if suspended {
+		return preview
+	}
+	// Without a TZ= prefix the parsed schedule follows the location of the
+	// time it is given, so stepping from now in the zone evaluates it there.
+	next := now.In(location)
+	for len(preview.NextRuns) < schedulePreviewRuns {
+		next = expr.Next(next)
+		if next.IsZero() {
+			break
+		}
+		preview.NextRuns = append(preview.NextRuns, next.Format(time.RFC3339))
+	}
+	return preview
+}
+
+// splitScheduleTimeZone strips a leading TZ= or CRON_TZ= zone from the
+// schedule. The API server rejects it alongside spec.timeZone, but older
+// CronJobs may still carry one.
+func splitScheduleTimeZone(schedule string) (spec, zone string) {
+	spec = strings.TrimSpace(schedule)
+	for _, prefix := range []string{"TZ=", "CRON_TZ="} {
+		if rest, ok := strings.CutPrefix(spec, prefix); ok {
+			zone, spec, _ = strings.Cut(rest, " ")
+			return strings.TrimSpace(spec), zone
+		}
+	}
+	return spec, ""
+}
+
+// scheduleDescriptors expands the fixed @ descriptors to the expressions they
+// stand for, so they are described the same way.
+var scheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = []string{"", "January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+
+var dayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// describeSchedule renders a parsed schedule expression in words, e.g.
+// "Every 15 minutes during hours 9 through 17, on Monday through Friday".
+func describeSchedule(spec string) string {
+	if every, ok := strings.CutPrefix(spec, "@every "); ok {
+		return "Every " + strings.TrimSpace(every)
+	}
+	if expanded, ok := scheduleDescriptors[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return ""
+	}
+	minute, hour, dom, month, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	parts := []string{describeTimeOfDay(minute, hour)}
+	if days := describeDays(dom, dow); days != "" {
+		parts = append(parts, days)
+	}
+	if !isWildcard(month) {
+		phrase := fieldPhrase(month, "months", monthName)
+		if !strings.HasPrefix(phrase, "every ") {
+			phrase = "in " + phrase
+		}
+		parts = append(parts, phrase)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeTimeOfDay(minute, hour string) string {
+	minutes, minutesPlain := plainNumbers(minute)
+	hours, hoursPlain := plainNumbers(hour)
+	if minutesPlain && hoursPlain && len(minutes)*len(hours) <= 6 {
+		times := make([]string, 0, len(minutes)*len(hours))
+		for _, h := range hours {
+			for _, m := range minutes {
+				times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+			}
+		}
+		return "At " + joinList(times)
+	}
+
+	var lead string
+	switch {
+	case isWildcard(minute):
+		lead = "Every minute"
+	case strings.HasPrefix(minute, "*/") && !strings.Contains(minute, ","):
+		lead = "Every " + strings.TrimPrefix(fieldPhrase(minute, "minutes", strconv.Itoa), "every ")
+	default:
+		lead = "At " + labelledPhrase(minute, "minute", "minutes", "minutes", strconv.Itoa)
+	}
+	if isWildcard(hour) {
+		if minutesPlain && len(minutes) == 1 && minutes[0] == 0 {
+			return "Every hour"
+		}
+		if strings.HasPrefix(lead, "At ") {
+			return lead + " past every hour"
+		}
+		return lead
+	}
+	hourPhrase := labelledPhrase(hour, "hour", "hours", "hours", strconv.Itoa)
+	if strings.HasPrefix(lead, "At ") {
+		return lead + " past " + hourPhrase
+	}
+	return lead + " during " + hourPhrase
+}
+
+// describeDays phrases the day-of-month and day-of-week fields. When both are
+// restricted, cron runs on days matching either, hence "or".
+func describeDays(dom, dow string) string {
+	var parts []string
+	if !isWildcard(dom) {
+		phrase := labelledPhrase(dom, "day", "days", "days", strconv.Itoa)
+		if !strings.HasPrefix(phrase, "every ") {
+			phrase = "on " + phrase
+		}
+		parts = append(parts, phrase+" of the month")
+	}
+	if !isWildcard(dow) {
+		phrase := fieldPhrase(dow, "days", dayName)
+		if !strings.HasPrefix(phrase, "every ") {
+			phrase = "on " + phrase
+		}
+		parts = append(parts, phrase)
+	}
+	return strings.Join(parts, " or ")
+}
+
+// labelledPhrase prefixes a field phrase with the unit, e.g. "minute 5" or
+// "hours 9 through 17"; step phrases already name it.
+func labelledPhrase(field, singular, plural, unit string, name func(int) string) string {
+	phrase := fieldPhrase(field, unit, name)
+	switch {
+	case strings.HasPrefix(phrase, "every "):
+		return phrase
+	case strings.ContainsAny(field, ",-/"):
+		return plural + " " + phrase
+	default:
+		return singular + " " + phrase
+	}
+}
+
+// fieldPhrase renders one cron field: lists, ranges and steps, naming each
+// value with name ("every 2 hours", "1 and 15", "Monday through Friday").
+func fieldPhrase(field, unit string, name func(int) string) string {
+	items := strings.Split(field, ",")
+	phrases := make([]string, 0, len(items))
+	for _, item := range items {
+		base, step, hasStep := strings.Cut(item, "/")
+		switch {
+		case hasStep:
+			every := "every " + step + " " + unit
+			if step == "1" {
+				every = "every " + strings.TrimSuffix(unit, "s")
+			}
+			switch {
+			case isWildcard(base):
+				phrases = append(phrases, every)
+			case strings.Contains(base, "-"):
+				from, to, _ := strings.Cut(base, "-")
+				phrases = append(phrases, every+" from "+fieldName(from, name)+" through "+fieldName(to, name))
+			default:
+				phrases = append(phrases, every+" starting at "+fieldName(base, name))
+			}
+		case strings.Contains(item, "-"):
+			from, to, _ := strings.Cut(item, "-")
+			phrases = append(phrases, fieldName(from, name)+" through "+fieldName(to, name))
+		default:
+			phrases = append(phrases, fieldName(item, name))
+		}
+	}
+	return joinList(phrases)
+}
+
+// fieldName names a single field value, resolving month and weekday
+// abbreviations (JAN, mon) to their numbers first.
+func fieldName(value string, name func(int) string) string {
+	if n, err := strconv.Atoi(value); err == nil {
+		return name(n)
+	}
+	lower := strings.ToLower(value)
+	for i, month := range monthNames {
+		if i > 0 && strings.HasPrefix(strings.ToLower(month), lower) {
+			return name(i)
+		}
+	}
+	for i, day := range dayNames {
+		if strings.HasPrefix(strings.ToLower(day), lower) {
+			return name(i)
+		}
+	}
+	return value
+}
+
+func monthName(n int) string {
+	if n >= 1 && n < len(monthNames) {
+		return monthNames[n]
+	}
+	return strconv.Itoa(n)
+}
+
+func dayName(n int) string {
+	if n >= 0 && n <= 7 {
+		return dayNames[n%7]
+	}
+	return strconv.Itoa(n)
+}
+
+// plainNumbers returns the values of a field that is a single number or a
+// list of numbers, with no ranges, steps or wildcards.
+func plainNumbers(field string) ([]int, bool) {
+	items := strings.Split(field, ",")
+	values := make([]int, 0, len(items))
+	for _, item := range items {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, n)
+	}
+	return values, true
+}
+
+func isWildcard(field string) bool {
+	return field == "*" || field == "?"
+}
+
+// joinList joins phrases as "a", "a and b" or "a, b and c".
+func joinList(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
@@ -0,0 +1,79 @@
+package cronjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestDescribeSchedule(t *testing.T) {
+	cases := map[string]string{
+		"*/5 * * * *":           "Every 5 minutes",
+		"* * * * *":             "Every minute",
+		"0 * * * *":             "Every hour",
+		"@hourly":               "Every hour",
+		"15,45 * * * *":         "At minutes 15 and 45 past every hour",
+		"30 2 * * 1-5":          "At 02:30, on Monday through Friday",
+		"0 9,17 1,15 * *":       "At 09:00 and 17:00, on days 1 and 15 of the month",
+		"0 */2 * * *":           "At minute 0 past every 2 hours",
+		"*/15 9-17 * * MON-FRI": "Every 15 minutes during hours 9 through 17, on Monday through Friday",
+		"@yearly":               "At 00:00, on day 1 of the month, in January",
+		"@weekly":               "At 00:00, on Sunday",
+		"0 0 13 * 5":            "At 00:00, on day 13 of the month or on Friday",
+		"0 6 * jan,jul *":       "At 06:00, in January and July",
+		"0 0 1 */3 *":           "At 00:00, on day 1 of the month, every 3 months",
+		"5 4 */2 * *":           "At 04:05, every 2 days of the month",
+		"@every 1h30m":          "Every 1h30m",
+		"0-30/10 22 * * sun":    "At every 10 minutes from 0 through 30 past hour 22, on Sunday",
+		"0 0,6,12,18 * * *":     "At 00:00, 06:00, 12:00 and 18:00",
+		"0,30 8,9,10,11 * * *":  "At minutes 0 and 30 past hours 8, 9, 10 and 11",
+		"0 12 * * 7":            "At 12:00, on Sunday",
+	}
+	for spec, want := range cases {
+		require.Equal(t, want, describeSchedule(spec), spec)
+	}
+}
+
+func TestBuildSchedulePreviewEvaluatesInTimeZone(t *testing.T) {
+	now := time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC)
+
+	// Berlin's clocks skip 02:00-03:00 on 2024-03-31, so that day has no
+	// 02:30 run.
+	preview := buildSchedulePreview("30 2 * * *", ptr.To("Europe/Berlin"), false, now)
+	require.Empty(t, preview.Error)
+	require.Equal(t, "Europe/Berlin", preview.TimeZone)
+	require.False(t, preview.DefaultTimeZone)
+	require.Len(t, preview.NextRuns, 5)
+	require.Equal(t, "2024-04-01T02:30:00+02:00", preview.NextRuns[0])
+
+	// A TZ= prefix in the schedule is honoured when spec.timeZone is unset.
+	preview = buildSchedulePreview("TZ=America/New_York 0 9 * * *", nil, false, now)
+	require.Equal(t, "America/New_York", preview.TimeZone)
+	require.Equal(t, "At 09:00", preview.Description)
+	require.Equal(t, "2024-03-31T09:00:00-04:00", preview.NextRuns[0])
+
+	// Without a zone the schedule is evaluated in UTC.
+	preview = buildSchedulePreview("0 0 * * *", nil, false, now)
+	require.True(t, preview.DefaultTimeZone)
+	require.Equal(t, "2024-03-31T00:00:00Z", preview.NextRuns[0])
+
+	// Suspended CronJobs keep the description but list no runs.
+	preview = buildSchedulePreview("0 0 * * *", nil, true, now)
+	require.Equal(t, "At 00:00", preview.Description)
+	require.Empty(t, preview.NextRuns)
+}
+
+func TestBuildSchedulePreviewReportsErrors(t *testing.T) {
+	now := time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC)
+
+	preview := buildSchedulePreview("61 * * * *", nil, false, now)
+	require.Contains(t, preview.Error, "invalid schedule")
+	require.Empty(t, preview.NextRuns)
+
+	preview = buildSchedulePreview("0 0 * * *", ptr.To("Mars/Olympus_Mons"), false, now)
+	require.Equal(t, `unknown time zone "Mars/Olympus_Mons"`, preview.Error)
+	require.Equal(t, "At 00:00", preview.Description)
+	require.Empty(t, preview.NextRuns)
+}
@@ -0,0 +1,213 @@
+/*
+ * backend/resources/customresource/health.go
+ *
+ * Generic health summary for custom resources of any kind. The app knows
+ * nothing about most operators' CRDs, so health is inferred from the
+ * conventions they share: status.conditions (with the polarity of common
+ * condition types), status.phase / status.state, observedGeneration lag, and
+ * the CRD's own status-like printer columns.
+ */
+
+package customresource
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Health states of a custom resource row.
+const (
+	HealthHealthy     = "healthy"
+	HealthProgressing = "progressing"
+	HealthDegraded    = "degraded"
+	HealthUnknown     = "unknown"
+)
+
+// healthNotReady classifies a value that means "not ready" without saying
+// whether the resource is failing or still coming up.
+const healthNotReady = "not-ready"
+
+// Health is the computed health of one custom resource. Reason names the
+// signal that decided State, e.g. "Ready=False (ReconcileFailed)".
+type Health struct {
+	State  string
+	Reason string
+}
+
+// PrinterColumn is a CRD additionalPrinterColumn read for health.
+type PrinterColumn struct {
+	Name     string
+	JSONPath string
+}
+
+// Condition types where True means something is wrong.
+var negativeConditionTypes = map[string]bool{
+	"degraded": true, "stalled": true, "failed": true, "failure": true,
+	"error": true, "unhealthy": true, "reconcileerror": true,
+}
+
+// Condition types where True means work is still under way.
+var progressingConditionTypes = map[string]bool{
+	"progressing": true, "reconciling": true, "pending": true, "updating": true,
+}
+
+// Condition types where False means the resource is not working.
+var positiveConditionTypes = map[string]bool{
+	"ready": true, "available": true, "healthy": true, "synced": true,
+	"succeeded": true, "established": true, "reconciled": true,
+}
+
+// Printer column names that carry a status value.
+var healthPrinterColumnNames = map[string]bool{
+	"status": true, "ready": true, "state": true, "phase": true,
+	"health": true, "synced": true, "available": true,
+}
+
+// HealthPrinterColumns returns the status-like printer columns a CRD declares
+// for version: columns with a status name whose path reads from .status.
+func HealthPrinterColumns(crd *apiextensionsv1.CustomResourceDefinition, version string) []PrinterColumn {
+	if crd == nil {
+		return nil
+	}
+	var columns []PrinterColumn
+	for _, served := range crd.Spec.Versions {
+		if served.Name != version {
+			continue
+		}
+		for _, column := range served.AdditionalPrinterColumns {
+			if !healthPrinterColumnNames[strings.ToLower(column.Name)] || !strings.HasPrefix(column.JSONPath, ".status") {
+				continue
+			}
+			columns = append(columns, PrinterColumn{Name: column.Name, JSONPath: column.JSONPath})
+		}
+	}
+	return columns
+}
+
+// BuildHealth computes a custom resource's health. A failing signal wins over
+// one still progressing, which wins over a healthy one; a not-ready signal
+// (Ready=False) counts as failing only when nothing reports progress, since
+// not ready mid-rollout is expected. With no signal at all the state is
+// unknown.
+func BuildHealth(resource *unstructured.Unstructured, facts Facts, printerColumns []PrinterColumn) Health {
+	if resource == nil {
+		return Health{State: HealthUnknown}
+	}
+	var failed, notReady, progressing, healthy []string
+
+	for _, condition := range facts.Conditions {
+		conditionType := strings.ToLower(condition.Type)
+		status := strings.ToLower(condition.Status)
+		reason := conditionReason(condition)
+		switch {
+		case negativeConditionTypes[conditionType] && status == "true":
+			failed = append(failed, reason)
+		case progressingConditionTypes[conditionType] && status == "true":
+			progressing = append(progressing, reason)
+		case positiveConditionTypes[conditionType] && status == "false":
+			notReady = append(notReady, reason)
+		case positiveConditionTypes[conditionType] && status == "true":
+			healthy = append(healthy, reason)
+		case positiveConditionTypes[conditionType]:
+			progressing = append(progressing, reason)
+		}
+	}
+
+	classify := func(label, value string) {
+		if value == "" {
+			return
+		}
+		reason := label + " " + value
+		switch classifyStatusValue(value) {
+		case HealthDegraded:
+			failed = append(failed, reason)
+		case healthNotReady:
+			notReady = append(notReady, reason)
+		case HealthProgressing:
+			progressing = append(progressing, reason)
+		case HealthHealthy:
+			healthy = append(healthy, reason)
+		}
+	}
+	classify("phase", facts.Phase)
+	classify("state", facts.State)
+	for _, column := range printerColumns {
+		classify(column.Name, printerColumnValue(resource, column.JSONPath))
+	}
+
+	if facts.ObservedGeneration != nil && resource.GetGeneration() > *facts.ObservedGeneration {
+		progressing = append(progressing, fmt.Sprintf("generation %d not yet observed", resource.GetGeneration()))
+	}
+	if resource.GetDeletionTimestamp() != nil {
+		progressing = append(progressing, "deleting")
+	}
+
+	switch {
+	case len(failed) > 0:
+		return Health{State: HealthDegraded, Reason: failed[0]}
+	case len(notReady) > 0 && len(progressing) > 0:
+		return Health{State: HealthProgressing, Reason: notReady[0] + "; " + progressing[0]}
+	case len(notReady) > 0:
+		return Health{State: HealthDegraded, Reason: notReady[0]}
+	case len(progressing) > 0:
+		return Health{State: HealthProgressing, Reason: progressing[0]}
+	case len(healthy) > 0:
+		return Health{State: HealthHealthy, Reason: healthy[0]}
+	}
+	return Health{State: HealthUnknown}
+}
+
+func conditionReason(condition resourcemodel.ConditionFacts) string {
+	reason := condition.Type + "=" + condition.Status
+	if condition.Reason != "" {
+		reason += " (" + condition.Reason + ")"
+	}
+	return reason
+}
+
+// classifyStatusValue maps a phase, state or printer column value to a health
+// state, or "" when the value says nothing about health.
+func classifyStatusValue(value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "ready", "running", "active", "available", "bound", "succeeded", "healthy", "true", "synced", "complete", "completed", "deployed", "established":
+		return HealthHealthy
+	case "pending", "progressing", "reconciling", "creating", "updating", "provisioning", "initializing":
+		return HealthProgressing
+	case "false", "notready", "not ready", "unavailable":
+		return healthNotReady
+	case "failed", "error", "degraded", "unhealthy", "crashloopbackoff", "stalled":
+		return HealthDegraded
+	}
+	return ""
+}
+
+// printerColumnValue evaluates a printer column's JSONPath the way kubectl
+// does, returning the first value found as text.
+func printerColumnValue(resource *unstructured.Unstructured, path string) string {
+	parser := jsonpath.New("column").AllowMissingKeys(true)
+	if err := parser.Parse("{" + path + "}"); err != nil {
+		return ""
+	}
+	results, err := parser.FindResults(resource.Object)
+	if err != nil {
+		return ""
+	}
+	for _, result := range results {
+		for _, value := range result {
+			if value.Kind() == reflect.Interface && !value.IsNil() {
+				value = value.Elem()
+			}
+			if value.IsValid() && value.CanInterface() {
+				return fmt.Sprint(value.Interface())
+			}
+		}
+	}
+	return ""
+}
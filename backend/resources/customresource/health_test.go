@@ -0,0 +1,83 @@
+package customresource
+
+import (
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func healthOf(t *testing.T, status map[string]any, columns ...PrinterColumn) Health {
+	t.Helper()
+	resource := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]any{"name": "w", "namespace": "apps", "generation": int64(2)},
+		"status":     status,
+	}}
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	return BuildHealth(resource, BuildFacts("c1", resource, gvr, "", resourcemodel.ResourceModelBuildOptions{}), columns)
+}
+
+func condition(conditionType, status, reason string) map[string]any {
+	return map[string]any{"type": conditionType, "status": status, "reason": reason}
+}
+
+func TestBuildHealthFromConditions(t *testing.T) {
+	require.Equal(t, Health{State: HealthHealthy, Reason: "Ready=True"},
+		healthOf(t, map[string]any{"conditions": []any{condition("Ready", "True", "")}}))
+
+	require.Equal(t, Health{State: HealthDegraded, Reason: "Ready=False (ReconcileFailed)"},
+		healthOf(t, map[string]any{"conditions": []any{condition("Ready", "False", "ReconcileFailed")}}))
+
+	require.Equal(t, Health{State: HealthDegraded, Reason: "Stalled=True (InstallFailed)"},
+		healthOf(t, map[string]any{"conditions": []any{
+			condition("Ready", "True", ""),
+			condition("Stalled", "True", "InstallFailed"),
+		}}), "a negative condition outranks Ready=True")
+
+	require.Equal(t, HealthProgressing,
+		healthOf(t, map[string]any{"conditions": []any{
+			condition("Ready", "False", "Progressing"),
+			condition("Reconciling", "True", ""),
+		}}).State, "not ready during a reconcile is progress, not failure")
+}
+
+func TestBuildHealthFromPhaseAndGeneration(t *testing.T) {
+	require.Equal(t, Health{State: HealthDegraded, Reason: "phase Failed"}, healthOf(t, map[string]any{"phase": "Failed"}))
+	require.Equal(t, Health{State: HealthHealthy, Reason: "phase Running"}, healthOf(t, map[string]any{"phase": "Running"}))
+	require.Equal(t, Health{State: HealthProgressing, Reason: "generation 2 not yet observed"},
+		healthOf(t, map[string]any{"phase": "Running", "observedGeneration": int64(1)}))
+	require.Equal(t, Health{State: HealthUnknown}, healthOf(t, map[string]any{"replicas": int64(3)}))
+}
+
+func TestBuildHealthReadsPrinterColumns(t *testing.T) {
+	crd := &apiextensionsv1.CustomResourceDefinition{Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+		Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+			Name: "v1",
+			AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+				{Name: "Replicas", JSONPath: ".status.replicas"},
+				{Name: "Health", JSONPath: ".status.summary.health"},
+				{Name: "Status", JSONPath: ".spec.mode"},
+			},
+		}},
+	}}
+	columns := HealthPrinterColumns(crd, "v1")
+	require.Equal(t, []PrinterColumn{{Name: "Health", JSONPath: ".status.summary.health"}}, columns,
+		"only status-named columns reading .status count")
+	require.Nil(t, HealthPrinterColumns(crd, "v2"))
+
+	require.Equal(t, Health{State: HealthDegraded, Reason: "Health Degraded"},
+		healthOf(t, map[string]any{"summary": map[string]any{"health": "Degraded"}}, columns...))
+
+	// Deployed is not a condition type BuildHealth knows, but the CRD's own
+	// column says it is the one that matters.
+	deployedColumn := PrinterColumn{Name: "Status", JSONPath: `.status.conditions[?(@.type=="Deployed")].status`}
+	require.Equal(t, Health{State: HealthDegraded, Reason: "Status False"},
+		healthOf(t, map[string]any{"conditions": []any{condition("Deployed", "False", "")}}, deployedColumn))
+	require.Equal(t, Health{State: HealthUnknown},
+		healthOf(t, map[string]any{"conditions": []any{condition("Deployed", "False", "")}}))
+}
@@ -12,13 +12,17 @@ package customresource
 import (
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
+	"github.com/luxury-yacht/app/backend/resources/istio"
+	"github.com/luxury-yacht/app/backend/resources/volumesnapshot"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // BuildNamespaceStreamSummary builds the namespace-custom row for one namespaced
-// custom resource. defaultNamespace is used when the object carries no namespace.
-func BuildNamespaceStreamSummary(meta streamrows.ClusterMeta, resource *unstructured.Unstructured, group, version, resourceName, kindFallback, crdName, defaultNamespace string) streamrows.NamespaceCustomSummary {
+// custom resource. defaultNamespace is used when the object carries no namespace;
+// printerColumns are the CRD's status-like printer columns, when the caller has
+// the CRD at hand.
+func BuildNamespaceStreamSummary(meta streamrows.ClusterMeta, resource *unstructured.Unstructured, group, version, resourceName, kindFallback, crdName, defaultNamespace string, printerColumns ...PrinterColumn) streamrows.NamespaceCustomSummary {
 	if resource == nil {
 		return streamrows.NamespaceCustomSummary{
 			Ref:     resourcemodel.NewResourceRef(meta.ClusterID, group, version, kindFallback, resourceName, "", "", ""),
@@ -28,6 +32,7 @@ func BuildNamespaceStreamSummary(meta streamrows.ClusterMeta, resource *unstruct
 	gvr := schema.GroupVersionResource{Group: group, Version: version, Resource: resourceName}
 	model := BuildResourceModel(meta.ClusterID, resource, gvr, kindFallback, crdName, resourcemodel.ResourceScopeNamespaced, defaultNamespace)
 	facts := BuildFacts(meta.ClusterID, resource, gvr, crdName, resourcemodel.ResourceModelBuildOptions{})
+	health := BuildHealth(resource, facts, printerColumns)
 	return streamrows.NamespaceCustomSummary{
 		Ref:                model.Ref,
 		CRDName:            crdName,
@@ -37,15 +42,19 @@ func BuildNamespaceStreamSummary(meta streamrows.ClusterMeta, resource *unstruct
 		Ready:              facts.Ready,
 		ObservedGeneration: facts.ObservedGeneration,
 		Conditions:         facts.Conditions,
+		Health:             health.State,
+		HealthReason:       health.Reason,
 		Age:                streamrows.FormatAge(model.Metadata.CreationTimestamp.Time),
 		Labels:             model.Metadata.Labels,
 		Annotations:        model.Metadata.Annotations,
+		Istio:              istio.BuildSummary(resource, group),
+		VolumeSnapshot:     volumesnapshot.BuildSummary(resource, group),
 	}
 }
 
 // BuildClusterStreamSummary builds the cluster-custom row for one cluster-scoped
 // custom resource.
-func BuildClusterStreamSummary(meta streamrows.ClusterMeta, resource *unstructured.Unstructured, group, version, resourceName, kindFallback, crdName string) streamrows.ClusterCustomSummary {
+func BuildClusterStreamSummary(meta streamrows.ClusterMeta, resource *unstructured.Unstructured, group, version, resourceName, kindFallback, crdName string, printerColumns ...PrinterColumn) streamrows.ClusterCustomSummary {
 	if resource == nil {
 		return streamrows.ClusterCustomSummary{
 			Ref:     resourcemodel.NewResourceRef(meta.ClusterID, group, version, kindFallback, resourceName, "", "", ""),
@@ -55,6 +64,7 @@ func BuildClusterStreamSummary(meta streamrows.ClusterMeta, resource *unstructur
 	gvr := schema.GroupVersionResource{Group: group, Version: version, Resource: resourceName}
 	model := BuildResourceModel(meta.ClusterID, resource, gvr, kindFallback, crdName, resourcemodel.ResourceScopeCluster, "")
 	facts := BuildFacts(meta.ClusterID, resource, gvr, crdName, resourcemodel.ResourceModelBuildOptions{})
+	health := BuildHealth(resource, facts, printerColumns)
 	return streamrows.ClusterCustomSummary{
 		Ref:                model.Ref,
 		CRDName:            crdName,
@@ -64,8 +74,11 @@ func BuildClusterStreamSummary(meta streamrows.ClusterMeta, resource *unstructur
 		Ready:              facts.Ready,
 		ObservedGeneration: facts.ObservedGeneration,
 		Conditions:         facts.Conditions,
+		Health:             health.State,
+		HealthReason:       health.Reason,
 		Age:                streamrows.FormatAge(model.Metadata.CreationTimestamp.Time),
 		Labels:             model.Metadata.Labels,
 		Annotations:        model.Metadata.Annotations,
+		VolumeSnapshot:     volumesnapshot.BuildSummary(resource, group),
 	}
 }
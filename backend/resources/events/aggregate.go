@@ -0,0 +1,84 @@
+/*
+ * backend/resources/events/aggregate.go
+ *
+ * Recurring-event aggregation.
+ * - Folds Events with the same involved object, type and reason into a single
+ *   count with first/last seen times and a rate, shared by the event tables
+ *   and the event stream.
+ */
+
+package events
+
+import (
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Aggregate summarises how often a recurring event happened. FirstSeen and
+// LastSeen are Unix milliseconds; RatePerMinute is zero until the event has
+// recurred.
+type Aggregate struct {
+	Count         int32   `json:"count"`
+	FirstSeen     int64   `json:"firstSeen"`
+	LastSeen      int64   `json:"lastSeen"`
+	RatePerMinute float64 `json:"ratePerMinute,omitempty"`
+}
+
+// BuildAggregate returns one Event's own occurrences: its count (or series
+// count for events.k8s.io-style series, whichever is larger, and at least one)
+// and the window it was seen in.
+func BuildAggregate(event *corev1.Event) Aggregate {
+	if event == nil {
+		return Aggregate{}
+	}
+	count := event.Count
+	if event.Series != nil && event.Series.Count > count {
+		count = event.Series.Count
+	}
+	if count < 1 {
+		count = 1
+	}
+	first, last := eventTimes(event)
+	return withRate(Aggregate{Count: count, FirstSeen: first.UnixMilli(), LastSeen: last.UnixMilli()})
+}
+
+// Merge folds other into a: counts add up and the seen window widens.
+func (a Aggregate) Merge(other Aggregate) Aggregate {
+	if a.Count == 0 {
+		return other
+	}
+	if other.Count == 0 {
+		return a
+	}
+	merged := Aggregate{Count: a.Count + other.Count, FirstSeen: a.FirstSeen, LastSeen: a.LastSeen}
+	if other.FirstSeen != 0 && (merged.FirstSeen == 0 || other.FirstSeen < merged.FirstSeen) {
+		merged.FirstSeen = other.FirstSeen
+	}
+	if other.LastSeen > merged.LastSeen {
+		merged.LastSeen = other.LastSeen
+	}
+	return withRate(merged)
+}
+
+// withRate sets the occurrences per minute across the seen window. Windows
+// shorter than a minute count as one minute so a burst does not read as an
+// absurd rate.
+func withRate(a Aggregate) Aggregate {
+	a.RatePerMinute = 0
+	if a.Count < 2 || a.FirstSeen == 0 || a.LastSeen == 0 {
+		return a
+	}
+	minutes := time.Duration(a.LastSeen-a.FirstSeen) * time.Millisecond
+	perMinute := float64(a.Count) / max(minutes.Minutes(), 1)
+	a.RatePerMinute = float64(int64(perMinute*100+0.5)) / 100
+	return a
+}
+
+// AggregateKey identifies the events that fold together: the same involved
+// object (apiVersion, namespace, the "Kind/Name" display from
+// EventObjectDisplay, and UID) reporting the same type and reason.
+func AggregateKey(apiVersion, namespace, object, uid, eventType, reason string) string {
+	return strings.Join([]string{apiVersion, namespace, object, uid, eventType, reason}, "\x00")
+}
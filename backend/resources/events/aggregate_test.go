@@ -0,0 +1,41 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildAggregateUsesCountOrSeries(t *testing.T) {
+	first := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	event := &corev1.Event{
+		Count:          12,
+		FirstTimestamp: metav1.NewTime(first),
+		LastTimestamp:  metav1.NewTime(first.Add(4 * time.Minute)),
+	}
+	require.Equal(t, Aggregate{Count: 12, FirstSeen: first.UnixMilli(), LastSeen: first.Add(4 * time.Minute).UnixMilli(), RatePerMinute: 3}, BuildAggregate(event))
+
+	series := &corev1.Event{
+		EventTime: metav1.NewMicroTime(first),
+		Series:    &corev1.EventSeries{Count: 30, LastObservedTime: metav1.NewMicroTime(first.Add(time.Hour))},
+	}
+	aggregate := BuildAggregate(series)
+	require.Equal(t, int32(30), aggregate.Count)
+	require.Equal(t, first.Add(time.Hour).UnixMilli(), aggregate.LastSeen)
+	require.Equal(t, 0.5, aggregate.RatePerMinute)
+
+	single := BuildAggregate(&corev1.Event{LastTimestamp: metav1.NewTime(first)})
+	require.Equal(t, int32(1), single.Count)
+	require.Zero(t, single.RatePerMinute)
+}
+
+func TestAggregateMergeWidensWindow(t *testing.T) {
+	burst := Aggregate{Count: 3, FirstSeen: 60_000, LastSeen: 90_000}.Merge(Aggregate{Count: 2, FirstSeen: 30_000, LastSeen: 40_000})
+	require.Equal(t, Aggregate{Count: 5, FirstSeen: 30_000, LastSeen: 90_000, RatePerMinute: 5}, burst, "a sub-minute window counts as one minute")
+
+	require.Equal(t, burst, Aggregate{}.Merge(burst))
+	require.NotEqual(t, AggregateKey("v1", "apps", "Pod/api", "uid", "Warning", "BackOff"), AggregateKey("v1", "apps", "Pod/api", "uid", "Warning", "Pulled"))
+}
@@ -18,6 +18,7 @@ import (
 // Event is the flattened event row returned to the frontend.
 type Event struct {
 	Kind               string    `json:"kind"`
+	Name               string    `json:"name"`
 	EventType          string    `json:"eventType"`
 	Reason             string    `json:"reason"`
 	Message            string    `json:"message"`
@@ -23,10 +23,12 @@ type Service struct {
 
 // Filter represents filtering options for events queries.
 type Filter struct {
-	Namespace    string
-	ObjectKind   string
-	ObjectName   string
-	ResourceKind string
+	Namespace        string
+	ObjectKind       string
+	ObjectName       string
+	ObjectAPIVersion string
+	ObjectUID        string
+	ResourceKind     string
 }
 
 // NewService constructs an event service with shared dependencies.
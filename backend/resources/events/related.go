@@ -0,0 +1,126 @@
+/*
+ * backend/resources/events/related.go
+ *
+ * Related events for a single object.
+ * - Lists the Events whose involved object is the given resource, newest first,
+ *   for the inline "recent events" section of any details panel.
+ */
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// DefaultRelatedEventsLimit caps RelatedEvents when the caller passes no limit.
+const DefaultRelatedEventsLimit = 20
+
+// RelatedEvents returns up to limit Events about the object described by
+// filter, most recently seen first. The API call narrows by the involved
+// object's name, kind, apiVersion and namespace; when filter.ObjectUID is set,
+// events recorded against an earlier object of the same name are dropped,
+// while events recorded without a UID are kept.
+func (s *Service) RelatedEvents(filter Filter, limit int) ([]Event, error) {
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(filter.ObjectName)
+	if name == "" {
+		return nil, fmt.Errorf("related events require an object name")
+	}
+	if limit <= 0 {
+		limit = DefaultRelatedEventsLimit
+	}
+	ctx := s.deps.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	selectors := []fields.Selector{fields.OneTermEqualSelector("involvedObject.name", name)}
+	if kind := strings.TrimSpace(filter.ObjectKind); kind != "" {
+		selectors = append(selectors, fields.OneTermEqualSelector("involvedObject.kind", kind))
+	}
+	if apiVersion := strings.TrimSpace(filter.ObjectAPIVersion); apiVersion != "" {
+		selectors = append(selectors, fields.OneTermEqualSelector("involvedObject.apiVersion", apiVersion))
+	}
+	// Events about cluster-scoped objects (e.g. Nodes) are recorded in
+	// whichever namespace the reporter chose, so only namespaced objects
+	// narrow the list to one namespace.
+	namespace := strings.TrimSpace(filter.Namespace)
+	if namespace != "" {
+		selectors = append(selectors, fields.OneTermEqualSelector("involvedObject.namespace", namespace))
+	}
+	list, err := s.deps.KubernetesClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(selectors...).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events for %s: %w", name, err)
+	}
+
+	matched := make([]*corev1.Event, 0, len(list.Items))
+	for i := range list.Items {
+		if relatedEventMatches(&list.Items[i], filter) {
+			matched = append(matched, &list.Items[i])
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		left, right := EventTimestamp(matched[i]), EventTimestamp(matched[j])
+		if !left.Equal(&right) {
+			return left.After(right.Time)
+		}
+		return matched[i].Name < matched[j].Name
+	})
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+
+	result := make([]Event, 0, len(matched))
+	for _, event := range matched {
+		facts := BuildFacts(s.deps.ClusterID, event)
+		result = append(result, Event{
+			Kind:               "Event",
+			Name:               event.Name,
+			EventType:          facts.EventType,
+			Reason:             facts.Reason,
+			Message:            EventMessage(event),
+			Count:              facts.Count,
+			FirstTimestamp:     facts.FirstTimestamp.Time,
+			LastTimestamp:      facts.LastTimestamp.Time,
+			Source:             facts.Source,
+			InvolvedObjectName: event.InvolvedObject.Name,
+			InvolvedObjectKind: event.InvolvedObject.Kind,
+			Namespace:          event.Namespace,
+		})
+	}
+	return result, nil
+}
+
+// relatedEventMatches re-checks the involved object client-side: the field
+// selector already filters on a real API server, and the UID check has no
+// selector equivalent that would keep UID-less events.
+func relatedEventMatches(event *corev1.Event, filter Filter) bool {
+	involved := event.InvolvedObject
+	if involved.Name != strings.TrimSpace(filter.ObjectName) {
+		return false
+	}
+	if kind := strings.TrimSpace(filter.ObjectKind); kind != "" && involved.Kind != kind {
+		return false
+	}
+	if apiVersion := strings.TrimSpace(filter.ObjectAPIVersion); apiVersion != "" && involved.APIVersion != apiVersion {
+		return false
+	}
+	if namespace := strings.TrimSpace(filter.Namespace); namespace != "" && involved.Namespace != namespace {
+		return false
+	}
+	if uid := strings.TrimSpace(filter.ObjectUID); uid != "" && involved.UID != "" && string(involved.UID) != uid {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func relatedEvent(name, namespace, reason string, involved corev1.ObjectReference, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		InvolvedObject: involved,
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+func TestRelatedEventsFiltersByInvolvedObjectNewestFirst(t *testing.T) {
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	pod := corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "apps", Name: "api-0", UID: types.UID("pod-uid")}
+	previousPod := pod
+	previousPod.UID = types.UID("old-pod-uid")
+	noUID := pod
+	noUID.UID = ""
+	otherPod := pod
+	otherPod.Name = "api-1"
+	client := fake.NewClientset(
+		relatedEvent("scheduled", "apps", "Scheduled", pod, base),
+		relatedEvent("pulled", "apps", "Pulled", pod, base.Add(2*time.Minute)),
+		relatedEvent("manual", "apps", "Annotated", noUID, base.Add(time.Minute)),
+		relatedEvent("stale", "apps", "Killing", previousPod, base.Add(3*time.Minute)),
+		relatedEvent("sibling", "apps", "Scheduled", otherPod, base.Add(4*time.Minute)),
+	)
+	service := newEventsService(t, client)
+
+	related, err := service.RelatedEvents(Filter{Namespace: "apps", ObjectKind: "Pod", ObjectName: "api-0", ObjectAPIVersion: "v1", ObjectUID: "pod-uid"}, 0)
+	require.NoError(t, err)
+	names := make([]string, 0, len(related))
+	for _, event := range related {
+		names = append(names, event.Name)
+	}
+	require.Equal(t, []string{"pulled", "manual", "scheduled"}, names)
+	require.Equal(t, "Pulled", related[0].Reason)
+	require.Equal(t, "Pod", related[0].InvolvedObjectKind)
+	require.Equal(t, "apps", related[0].Namespace)
+
+	limited, err := service.RelatedEvents(Filter{Namespace: "apps", ObjectKind: "Pod", ObjectName: "api-0", ObjectUID: "pod-uid"}, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	require.Equal(t, "pulled", limited[0].Name)
+}
+
+func TestRelatedEventsForClusterScopedObjectSpansNamespaces(t *testing.T) {
+	node := corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "node-a", UID: types.UID("node-uid")}
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	client := fake.NewClientset(
+		relatedEvent("pressure", "default", "NodeHasDiskPressure", node, at),
+		relatedEvent("reboot", "kube-system", "Rebooted", node, at.Add(time.Minute)),
+	)
+
+	related, err := newEventsService(t, client).RelatedEvents(Filter{ObjectKind: "Node", ObjectName: "node-a", ObjectUID: "node-uid"}, 10)
+	require.NoError(t, err)
+	require.Len(t, related, 2)
+	require.Equal(t, "reboot", related[0].Name)
+
+	_, err = newEventsService(t, client).RelatedEvents(Filter{ObjectKind: "Node"}, 10)
+	require.ErrorContains(t, err, "object name")
+}
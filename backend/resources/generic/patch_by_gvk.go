@@ -0,0 +1,76 @@
+/*
+ * backend/resources/generic/patch_by_gvk.go
+ *
+ * GVK-aware generic merge patch, resolved the same strict way as
+ * DeleteByGVK so a patch never lands on a same-Kind object from another
+ * group.
+ */
+
+package generic
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PatchByGVK applies a JSON merge patch to the resource identified by its
+// GroupVersionKind, namespace, and name. With dryRun the API server runs
+// admission and validation but persists nothing.
+func (s *Service) PatchByGVK(gvk schema.GroupVersionKind, namespace, name string, patch []byte, dryRun bool) error {
+	if gvk.Kind == "" {
+		return fmt.Errorf("kind is required")
+	}
+	if gvk.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if s.deps.ResourceResolver == nil {
+		return fmt.Errorf("resource resolver not initialized")
+	}
+	resolved, ok, err := s.deps.ResourceResolver.ResolveResourceForGVK(s.context(), gvk)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", gvk.String(), err)
+	}
+	if !ok {
+		return fmt.Errorf("failed to resolve %s: unable to resolve resource", gvk.String())
+	}
+	gvr := resolved.GVR()
+
+	dynamicClient, err := s.dynamicClient()
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	options := metav1.PatchOptions{}
+	if dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	resource := dynamicClient.Resource(gvr)
+	if resolved.Namespaced {
+		if namespace == "" {
+			return fmt.Errorf("namespaced resource %s requires a namespace", gvr.String())
+		}
+		_, err = resource.Namespace(namespace).Patch(s.context(), name, types.MergePatchType, patch, options)
+	} else {
+		_, err = resource.Patch(s.context(), name, types.MergePatchType, patch, options)
+	}
+	if err != nil {
+		s.logError(fmt.Sprintf("Failed to patch %s %s/%s: %v", gvk.String(), namespace, name, err))
+		return fmt.Errorf("failed to patch %s: %w", gvk.String(), err)
+	}
+	if !dryRun {
+		if namespace == "" {
+			s.logInfo(fmt.Sprintf("Patched %s %s", gvk.String(), name))
+		} else {
+			s.logInfo(fmt.Sprintf("Patched %s %s/%s", gvk.String(), namespace, name))
+		}
+	}
+	return nil
+}
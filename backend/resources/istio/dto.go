@@ -0,0 +1,72 @@
+/*
+ * backend/resources/istio/dto.go
+ *
+ * Istio detail DTO. Istio kinds are served through the namespace-custom
+ * domain, so there is one payload for every supported kind; the sections a
+ * kind does not have are left empty.
+ */
+
+package istio
+
+import "github.com/luxury-yacht/app/backend/kind/streamrows"
+
+// Details is the detail payload for an Istio VirtualService, Gateway,
+// DestinationRule or PeerAuthentication.
+type Details struct {
+	Kind      string                  `json:"kind"`
+	Name      string                  `json:"name"`
+	Namespace string                  `json:"namespace"`
+	Summary   streamrows.IstioSummary `json:"summary"`
+	// Selector picks the workloads a Gateway or PeerAuthentication applies to.
+	Selector map[string]string `json:"selector,omitempty"`
+	// ExportTo lists the namespaces a VirtualService or DestinationRule is
+	// visible to; empty means every namespace.
+	ExportTo []string `json:"exportTo,omitempty"`
+	Routes   []Route  `json:"routes,omitempty"`
+	Subsets  []Subset `json:"subsets,omitempty"`
+	Servers  []Server `json:"servers,omitempty"`
+	// PortMTLS maps a workload port to its PeerAuthentication mTLS override.
+	PortMTLS    map[string]string `json:"portMtls,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Route is one VirtualService http, tcp or tls route.
+type Route struct {
+	Name string `json:"name,omitempty"`
+	// Protocol is "http", "tcp" or "tls".
+	Protocol     string        `json:"protocol"`
+	Matches      []string      `json:"matches,omitempty"`
+	Destinations []Destination `json:"destinations,omitempty"`
+	// Redirect is set for an http route that redirects instead of routing.
+	Redirect string `json:"redirect,omitempty"`
+	Timeout  string `json:"timeout,omitempty"`
+	Retries  int64  `json:"retries,omitempty"`
+}
+
+// Destination is one weighted route destination.
+type Destination struct {
+	Host   string `json:"host"`
+	Subset string `json:"subset,omitempty"`
+	Port   int64  `json:"port,omitempty"`
+	Weight int64  `json:"weight,omitempty"`
+}
+
+// Subset is one DestinationRule subset.
+type Subset struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// TLSMode overrides the rule's TLS mode for this subset.
+	TLSMode string `json:"tlsMode,omitempty"`
+}
+
+// Server is one Gateway server (listener).
+type Server struct {
+	Name     string   `json:"name,omitempty"`
+	Port     int64    `json:"port"`
+	Protocol string   `json:"protocol,omitempty"`
+	Hosts    []string `json:"hosts,omitempty"`
+	TLSMode  string   `json:"tlsMode,omitempty"`
+	// CredentialName is the Secret holding the server certificate.
+	CredentialName string `json:"credentialName,omitempty"`
+}
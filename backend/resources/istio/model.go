@@ -0,0 +1,369 @@
+/*
+ * backend/resources/istio/model.go
+ *
+ * Tailored summaries for Istio VirtualServices, Gateways, DestinationRules
+ * and PeerAuthentications. These are plain CRDs to the app, so they arrive
+ * as unstructured objects in the namespace-custom domain; the builders here
+ * read the hosts, routes, subsets and TLS/mTLS modes the generic custom row
+ * has no columns for. Unknown or malformed fields are skipped rather than
+ * reported.
+ */
+
+package istio
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// API groups of the supported kinds.
+const (
+	NetworkingGroup = "networking.istio.io"
+	SecurityGroup   = "security.istio.io"
+)
+
+// Supported kinds.
+const (
+	KindVirtualService     = "VirtualService"
+	KindGateway            = "Gateway"
+	KindDestinationRule    = "DestinationRule"
+	KindPeerAuthentication = "PeerAuthentication"
+)
+
+// mtlsModeUnset is PeerAuthentication's mode when none is given: the
+// workload inherits the mode of its parent policy.
+const mtlsModeUnset = "UNSET"
+
+// Supports reports whether group and kind name an Istio kind summarized here.
+// Kind alone is not enough: the Gateway API also has a Gateway.
+func Supports(group, kind string) bool {
+	switch group {
+	case NetworkingGroup:
+		return kind == KindVirtualService || kind == KindGateway || kind == KindDestinationRule
+	case SecurityGroup:
+		return kind == KindPeerAuthentication
+	}
+	return false
+}
+
+// BuildSummary returns the row summary for an Istio object, or nil when the
+// object is not one of the supported kinds.
+func BuildSummary(resource *unstructured.Unstructured, group string) *streamrows.IstioSummary {
+	details := BuildDetails(resource, group)
+	if details == nil {
+		return nil
+	}
+	return &details.Summary
+}
+
+// BuildDetails returns the detail payload for an Istio object, or nil when
+// the object is not one of the supported kinds.
+func BuildDetails(resource *unstructured.Unstructured, group string) *Details {
+	if resource == nil || !Supports(group, resource.GetKind()) {
+		return nil
+	}
+	spec, _, _ := unstructured.NestedMap(resource.Object, "spec")
+	details := &Details{
+		Kind:        resource.GetKind(),
+		Name:        resource.GetName(),
+		Namespace:   resource.GetNamespace(),
+		Labels:      resource.GetLabels(),
+		Annotations: resource.GetAnnotations(),
+	}
+	switch resource.GetKind() {
+	case KindVirtualService:
+		buildVirtualService(details, spec)
+	case KindGateway:
+		buildGateway(details, spec)
+	case KindDestinationRule:
+		buildDestinationRule(details, spec)
+	case KindPeerAuthentication:
+		buildPeerAuthentication(details, spec)
+	}
+	return details
+}
+
+func buildVirtualService(details *Details, spec map[string]interface{}) {
+	summary := &details.Summary
+	summary.Hosts = stringSlice(spec["hosts"])
+	summary.Gateways = stringSlice(spec["gateways"])
+	details.ExportTo = stringSlice(spec["exportTo"])
+	for _, protocol := range []string{"http", "tcp", "tls"} {
+		for _, entry := range mapSlice(spec[protocol]) {
+			route := Route{
+				Name:     str(entry["name"]),
+				Protocol: protocol,
+				Timeout:  str(entry["timeout"]),
+			}
+			for _, match := range mapSlice(entry["match"]) {
+				if text := formatMatch(protocol, match); text != "" {
+					route.Matches = append(route.Matches, text)
+				}
+			}
+			for _, destination := range mapSlice(entry["route"]) {
+				target, _ := destination["destination"].(map[string]interface{})
+				port, _ := target["port"].(map[string]interface{})
+				route.Destinations = append(route.Destinations, Destination{
+					Host:   str(target["host"]),
+					Subset: str(target["subset"]),
+					Port:   integer(port["number"]),
+					Weight: integer(destination["weight"]),
+				})
+			}
+			if redirect, ok := entry["redirect"].(map[string]interface{}); ok {
+				route.Redirect = str(redirect["authority"]) + str(redirect["uri"])
+				if route.Redirect == "" {
+					route.Redirect = "/"
+				}
+			}
+			if retries, ok := entry["retries"].(map[string]interface{}); ok {
+				route.Retries = integer(retries["attempts"])
+			}
+			details.Routes = append(details.Routes, route)
+			summary.Routes = append(summary.Routes, formatRoute(route))
+		}
+	}
+
+	parts := []string{}
+	if len(summary.Hosts) > 0 {
+		parts = append(parts, strings.Join(summary.Hosts, ", "))
+	}
+	parts = append(parts, fmt.Sprintf("%d route(s)", len(details.Routes)))
+	if len(summary.Gateways) > 0 {
+		parts = append(parts, "via "+strings.Join(summary.Gateways, ", "))
+	}
+	summary.Details = strings.Join(parts, "; ")
+}
+
+func buildGateway(details *Details, spec map[string]interface{}) {
+	summary := &details.Summary
+	details.Selector = stringMap(spec["selector"])
+	for _, entry := range mapSlice(spec["servers"]) {
+		port, _ := entry["port"].(map[string]interface{})
+		tls, _ := entry["tls"].(map[string]interface{})
+		server := Server{
+			Name:           str(port["name"]),
+			Port:           integer(port["number"]),
+			Protocol:       strings.ToUpper(str(port["protocol"])),
+			Hosts:          stringSlice(entry["hosts"]),
+			TLSMode:        str(tls["mode"]),
+			CredentialName: str(tls["credentialName"]),
+		}
+		details.Servers = append(details.Servers, server)
+		summary.Servers = append(summary.Servers, fmt.Sprintf("%s/%d", server.Protocol, server.Port))
+		summary.Hosts = appendUnique(summary.Hosts, server.Hosts...)
+	}
+
+	parts := []string{"no servers"}
+	if len(summary.Servers) > 0 {
+		parts = []string{strings.Join(summary.Servers, ", ")}
+	}
+	if len(summary.Hosts) > 0 {
+		parts = append(parts, strings.Join(summary.Hosts, ", "))
+	}
+	summary.Details = strings.Join(parts, "; ")
+}
+
+func buildDestinationRule(details *Details, spec map[string]interface{}) {
+	summary := &details.Summary
+	if host := str(spec["host"]); host != "" {
+		summary.Hosts = []string{host}
+	}
+	details.ExportTo = stringSlice(spec["exportTo"])
+	summary.TLSMode = tlsMode(spec["trafficPolicy"])
+	for _, entry := range mapSlice(spec["subsets"]) {
+		subset := Subset{
+			Name:    str(entry["name"]),
+			Labels:  stringMap(entry["labels"]),
+			TLSMode: tlsMode(entry["trafficPolicy"]),
+		}
+		details.Subsets = append(details.Subsets, subset)
+		summary.Subsets = append(summary.Subsets, subset.Name)
+	}
+
+	parts := []string{}
+	if len(summary.Hosts) > 0 {
+		parts = append(parts, summary.Hosts[0])
+	}
+	if len(summary.Subsets) > 0 {
+		parts = append(parts, "subsets "+strings.Join(summary.Subsets, ", "))
+	}
+	if summary.TLSMode != "" {
+		parts = append(parts, "TLS "+summary.TLSMode)
+	}
+	summary.Details = strings.Join(parts, "; ")
+}
+
+func buildPeerAuthentication(details *Details, spec map[string]interface{}) {
+	summary := &details.Summary
+	selector, _ := spec["selector"].(map[string]interface{})
+	details.Selector = stringMap(selector["matchLabels"])
+	mtls, _ := spec["mtls"].(map[string]interface{})
+	summary.MTLSMode = str(mtls["mode"])
+	if summary.MTLSMode == "" {
+		summary.MTLSMode = mtlsModeUnset
+	}
+	if ports, ok := spec["portLevelMtls"].(map[string]interface{}); ok {
+		details.PortMTLS = make(map[string]string, len(ports))
+		for port, value := range ports {
+			override, _ := value.(map[string]interface{})
+			details.PortMTLS[port] = str(override["mode"])
+		}
+	}
+
+	summary.Details = "mTLS " + summary.MTLSMode
+	if len(details.PortMTLS) > 0 {
+		summary.Details += fmt.Sprintf("; %d port override(s)", len(details.PortMTLS))
+	}
+	if len(details.Selector) == 0 {
+		summary.Details += "; all workloads"
+	}
+}
+
+// formatMatch renders one route match condition compactly: an exact URI as
+// is, a prefix with a trailing "*", a regex with a leading "~".
+func formatMatch(protocol string, match map[string]interface{}) string {
+	var parts []string
+	if uri, ok := match["uri"].(map[string]interface{}); ok {
+		switch {
+		case str(uri["exact"]) != "":
+			parts = append(parts, str(uri["exact"]))
+		case str(uri["prefix"]) != "":
+			parts = append(parts, str(uri["prefix"])+"*")
+		case str(uri["regex"]) != "":
+			parts = append(parts, "~"+str(uri["regex"]))
+		}
+	}
+	if method, ok := match["method"].(map[string]interface{}); ok {
+		if exact := str(method["exact"]); exact != "" {
+			parts = append(parts, exact)
+		}
+	}
+	if headers, ok := match["headers"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			parts = append(parts, "header:"+name)
+		}
+	}
+	if protocol == "tls" {
+		if hosts := stringSlice(match["sniHosts"]); len(hosts) > 0 {
+			parts = append(parts, "sni "+strings.Join(hosts, ","))
+		}
+	}
+	if port := integer(match["port"]); port > 0 {
+		parts = append(parts, "port "+strconv.FormatInt(port, 10))
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatRoute renders a route as "matches -> destinations", e.g.
+// "/api* -> reviews(v2):9080 80%, reviews(v1) 20%".
+func formatRoute(route Route) string {
+	matches := "*"
+	if len(route.Matches) > 0 {
+		matches = strings.Join(route.Matches, " | ")
+	}
+	if route.Redirect != "" {
+		return matches + " -> redirect " + route.Redirect
+	}
+	targets := make([]string, 0, len(route.Destinations))
+	for _, destination := range route.Destinations {
+		target := destination.Host
+		if destination.Subset != "" {
+			target += "(" + destination.Subset + ")"
+		}
+		if destination.Port > 0 {
+			target += ":" + strconv.FormatInt(destination.Port, 10)
+		}
+		if destination.Weight > 0 && len(route.Destinations) > 1 {
+			target += fmt.Sprintf(" %d%%", destination.Weight)
+		}
+		targets = append(targets, target)
+	}
+	if len(targets) == 0 {
+		return matches
+	}
+	return matches + " -> " + strings.Join(targets, ", ")
+}
+
+func tlsMode(trafficPolicy interface{}) string {
+	policy, _ := trafficPolicy.(map[string]interface{})
+	tls, _ := policy["tls"].(map[string]interface{})
+	return str(tls["mode"])
+}
+
+func str(value interface{}) string {
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
+
+func integer(value interface{}) int64 {
+	switch typed := value.(type) {
+	case int64:
+		return typed
+	case int:
+		return int64(typed)
+	case float64:
+		return int64(typed)
+	}
+	return 0
+}
+
+func stringSlice(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var out []string
+	for _, item := range items {
+		if text := str(item); text != "" {
+			out = append(out, text)
+		}
+	}
+	return out
+}
+
+func mapSlice(value interface{}) []map[string]interface{} {
+	items, _ := value.([]interface{})
+	out := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if typed, ok := item.(map[string]interface{}); ok {
+			out = append(out, typed)
+		}
+	}
+	return out
+}
+
+func stringMap(value interface{}) map[string]string {
+	items, _ := value.(map[string]interface{})
+	if len(items) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(items))
+	for key, item := range items {
+		out[key] = str(item)
+	}
+	return out
+}
+
+func appendUnique(values []string, more ...string) []string {
+	for _, value := range more {
+		found := false
+		for _, existing := range values {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, value)
+		}
+	}
+	return values
+}
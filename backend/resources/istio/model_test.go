@@ -0,0 +1,111 @@
+package istio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func istioObject(apiVersion, kind string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]any{"name": "reviews", "namespace": "bookinfo"},
+		"spec":       spec,
+	}}
+}
+
+func TestBuildVirtualServiceSummary(t *testing.T) {
+	resource := istioObject("networking.istio.io/v1", KindVirtualService, map[string]any{
+		"hosts":    []any{"reviews", "reviews.example.com"},
+		"gateways": []any{"bookinfo-gateway"},
+		"http": []any{
+			map[string]any{
+				"name":    "canary",
+				"match":   []any{map[string]any{"uri": map[string]any{"prefix": "/api"}, "headers": map[string]any{"x-canary": map[string]any{"exact": "1"}}}},
+				"route":   []any{map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v2", "port": map[string]any{"number": int64(9080)}}, "weight": int64(80)}, map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v1"}, "weight": int64(20)}},
+				"retries": map[string]any{"attempts": int64(3)},
+			},
+			map[string]any{"match": []any{map[string]any{"uri": map[string]any{"exact": "/old"}}}, "redirect": map[string]any{"uri": "/new"}},
+			map[string]any{"route": []any{map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v1"}}}},
+		},
+		"tcp": []any{map[string]any{"match": []any{map[string]any{"port": int64(27017)}}, "route": []any{map[string]any{"destination": map[string]any{"host": "mongo"}}}}},
+	})
+
+	details := BuildDetails(resource, NetworkingGroup)
+	require.NotNil(t, details)
+	require.Equal(t, []string{"reviews", "reviews.example.com"}, details.Summary.Hosts)
+	require.Equal(t, []string{"bookinfo-gateway"}, details.Summary.Gateways)
+	require.Equal(t, []string{
+		"/api* header:x-canary -> reviews(v2):9080 80%, reviews(v1) 20%",
+		"/old -> redirect /new",
+		"* -> reviews(v1)",
+		"port 27017 -> mongo",
+	}, details.Summary.Routes)
+	require.Equal(t, "reviews, reviews.example.com; 4 route(s); via bookinfo-gateway", details.Summary.Details)
+	require.Len(t, details.Routes, 4)
+	require.Equal(t, "canary", details.Routes[0].Name)
+	require.Equal(t, int64(3), details.Routes[0].Retries)
+	require.Equal(t, "tcp", details.Routes[3].Protocol)
+}
+
+func TestBuildGatewaySummary(t *testing.T) {
+	resource := istioObject("networking.istio.io/v1", KindGateway, map[string]any{
+		"selector": map[string]any{"istio": "ingressgateway"},
+		"servers": []any{
+			map[string]any{"port": map[string]any{"number": int64(443), "name": "https", "protocol": "HTTPS"}, "hosts": []any{"*.example.com"}, "tls": map[string]any{"mode": "SIMPLE", "credentialName": "example-cert"}},
+			map[string]any{"port": map[string]any{"number": int64(80), "name": "http", "protocol": "http"}, "hosts": []any{"*.example.com"}},
+		},
+	})
+
+	details := BuildDetails(resource, NetworkingGroup)
+	require.Equal(t, []string{"HTTPS/443", "HTTP/80"}, details.Summary.Servers)
+	require.Equal(t, []string{"*.example.com"}, details.Summary.Hosts)
+	require.Equal(t, "HTTPS/443, HTTP/80; *.example.com", details.Summary.Details)
+	require.Equal(t, map[string]string{"istio": "ingressgateway"}, details.Selector)
+	require.Equal(t, "example-cert", details.Servers[0].CredentialName)
+
+	require.Nil(t, BuildDetails(istioObject("gateway.networking.k8s.io/v1", KindGateway, nil), "gateway.networking.k8s.io"), "Gateway API gateways are not Istio's")
+}
+
+func TestBuildDestinationRuleSummary(t *testing.T) {
+	resource := istioObject("networking.istio.io/v1", KindDestinationRule, map[string]any{
+		"host":          "reviews.bookinfo.svc.cluster.local",
+		"trafficPolicy": map[string]any{"tls": map[string]any{"mode": "ISTIO_MUTUAL"}},
+		"subsets": []any{
+			map[string]any{"name": "v1", "labels": map[string]any{"version": "v1"}},
+			map[string]any{"name": "v2", "labels": map[string]any{"version": "v2"}, "trafficPolicy": map[string]any{"tls": map[string]any{"mode": "DISABLE"}}},
+		},
+	})
+
+	summary := BuildSummary(resource, NetworkingGroup)
+	require.Equal(t, []string{"v1", "v2"}, summary.Subsets)
+	require.Equal(t, "ISTIO_MUTUAL", summary.TLSMode)
+	require.Equal(t, "reviews.bookinfo.svc.cluster.local; subsets v1, v2; TLS ISTIO_MUTUAL", summary.Details)
+
+	details := BuildDetails(resource, NetworkingGroup)
+	require.Equal(t, "DISABLE", details.Subsets[1].TLSMode)
+	require.Equal(t, map[string]string{"version": "v1"}, details.Subsets[0].Labels)
+}
+
+func TestBuildPeerAuthenticationSummary(t *testing.T) {
+	strict := istioObject("security.istio.io/v1", KindPeerAuthentication, map[string]any{
+		"mtls": map[string]any{"mode": "STRICT"},
+	})
+	require.Equal(t, "mTLS STRICT; all workloads", BuildSummary(strict, SecurityGroup).Details)
+
+	scoped := istioObject("security.istio.io/v1", KindPeerAuthentication, map[string]any{
+		"selector":      map[string]any{"matchLabels": map[string]any{"app": "reviews"}},
+		"portLevelMtls": map[string]any{"8080": map[string]any{"mode": "PERMISSIVE"}},
+	})
+	details := BuildDetails(scoped, SecurityGroup)
+	require.Equal(t, mtlsModeUnset, details.Summary.MTLSMode)
+	require.Equal(t, map[string]string{"8080": "PERMISSIVE"}, details.PortMTLS)
+	require.Equal(t, "mTLS UNSET; 1 port override(s)", details.Summary.Details)
+}
+
+func TestBuildSummaryIgnoresOtherKinds(t *testing.T) {
+	require.Nil(t, BuildSummary(istioObject("networking.istio.io/v1", "ServiceEntry", nil), NetworkingGroup))
+	require.Nil(t, BuildSummary(nil, NetworkingGroup))
+}
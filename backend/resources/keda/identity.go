@@ -0,0 +1,32 @@
+/*
+ * backend/resources/keda/identity.go
+ *
+ * KEDA's ScaledObject and ScaledJob identities. KEDA is an optional install,
+ * so neither kind has a stream descriptor; the namespace-autoscaling domain
+ * lists them per build when the CRDs are served.
+ */
+
+package keda
+
+import "github.com/luxury-yacht/app/backend/resourcekind"
+
+// ScaledObjectIdentity is the KEDA ScaledObject identity (namespaced).
+var ScaledObjectIdentity = resourcekind.Identity{
+	Group:      "keda.sh",
+	Version:    "v1alpha1",
+	Kind:       "ScaledObject",
+	Resource:   "scaledobjects",
+	Namespaced: true,
+}
+
+// ScaledJobIdentity is the KEDA ScaledJob identity (namespaced).
+var ScaledJobIdentity = resourcekind.Identity{
+	Group:      "keda.sh",
+	Version:    "v1alpha1",
+	Kind:       "ScaledJob",
+	Resource:   "scaledjobs",
+	Namespaced: true,
+}
+
+// Identities lists the KEDA kinds shown in the autoscaling table.
+var Identities = []resourcekind.Identity{ScaledObjectIdentity, ScaledJobIdentity}
@@ -0,0 +1,121 @@
+/*
+ * backend/resources/keda/streamsummary.go
+ *
+ * KEDA's stream-summary builder. Projects a ScaledObject or ScaledJob into the
+ * same streamrows.AutoscalingSummary row the HPAs use, adding the trigger
+ * types and paused state, so every autoscaling config shows in one table.
+ */
+
+package keda
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/resourcekind"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Annotations that pause scaling. paused-replicas also pins the replica count.
+const (
+	PausedAnnotation         = "autoscaling.keda.sh/paused"
+	PausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
+)
+
+// Replica bounds KEDA applies when a ScaledObject leaves them unset.
+const (
+	defaultMinReplicaCount = 0
+	defaultMaxReplicaCount = 100
+)
+
+// BuildStreamSummary builds the namespace-autoscaling row for a ScaledObject
+// or ScaledJob. A ScaledJob's Current is its running job count; a ScaledObject
+// leaves Current at zero, since the keda-hpa-* HPA KEDA creates for it has
+// its own row with the live replica count.
+func BuildStreamSummary(meta streamrows.ClusterMeta, identity resourcekind.Identity, obj *unstructured.Unstructured) streamrows.AutoscalingSummary {
+	if obj == nil {
+		return streamrows.AutoscalingSummary{Ref: streamrows.NewResourceRef(meta, identity, nil)}
+	}
+	row := streamrows.AutoscalingSummary{
+		Ref:          streamrows.NewResourceRef(meta, identity, obj),
+		Max:          replicaCount(obj, "maxReplicaCount", defaultMaxReplicaCount),
+		Triggers:     triggerTypes(obj),
+		Paused:       paused(obj),
+		Age:          streamrows.FormatAge(obj.GetCreationTimestamp().Time),
+		AgeTimestamp: streamrows.CreationMillis(obj),
+	}
+	if identity.Kind == ScaledJobIdentity.Kind {
+		// A ScaledJob runs one Job per unit of work and always scales from zero.
+		row.Target = "Job/" + obj.GetName()
+		row.TargetAPIVersion = "batch/v1"
+		if active, found, _ := unstructured.NestedInt64(obj.Object, "status", "activeJobs"); found {
+			row.Current = int32(active)
+		}
+		return row
+	}
+	row.Min = replicaCount(obj, "minReplicaCount", defaultMinReplicaCount)
+	kind := nestedString(obj, "spec", "scaleTargetRef", "kind")
+	if kind == "" {
+		kind = "Deployment"
+	}
+	row.Target = kind + "/" + nestedString(obj, "spec", "scaleTargetRef", "name")
+	row.TargetAPIVersion = nestedString(obj, "spec", "scaleTargetRef", "apiVersion")
+	if row.TargetAPIVersion == "" {
+		row.TargetAPIVersion = "apps/v1"
+	}
+	return row
+}
+
+func replicaCount(obj *unstructured.Unstructured, field string, fallback int32) int32 {
+	value, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", field)
+	if !found {
+		return fallback
+	}
+	switch typed := value.(type) {
+	case int64:
+		return int32(typed)
+	case float64:
+		return int32(typed)
+	}
+	return fallback
+}
+
+// triggerTypes returns the scaler type of each trigger, e.g. "prometheus".
+func triggerTypes(obj *unstructured.Unstructured) []string {
+	triggers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "triggers")
+	var types []string
+	for _, entry := range triggers {
+		trigger, _ := entry.(map[string]interface{})
+		if triggerType, _ := trigger["type"].(string); triggerType != "" {
+			types = append(types, triggerType)
+		}
+	}
+	return types
+}
+
+// paused reports whether scaling is paused by annotation or, on KEDA versions
+// that set it, the Paused condition.
+func paused(obj *unstructured.Unstructured) bool {
+	annotations := obj.GetAnnotations()
+	if value, err := strconv.ParseBool(annotations[PausedAnnotation]); err == nil && value {
+		return true
+	}
+	if _, ok := annotations[PausedReplicasAnnotation]; ok {
+		return true
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, entry := range conditions {
+		condition, _ := entry.(map[string]interface{})
+		if condition["type"] == "Paused" && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
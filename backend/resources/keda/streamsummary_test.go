@@ -0,0 +1,78 @@
+package keda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+)
+
+func kedaObject(kind string, annotations map[string]any, spec, status map[string]any) *unstructured.Unstructured {
+	obj := map[string]any{
+		"apiVersion": "keda.sh/v1alpha1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": "worker", "namespace": "jobs", "uid": "uid-1", "annotations": annotations},
+		"spec":       spec,
+	}
+	if status != nil {
+		obj["status"] = status
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestBuildScaledObjectSummary(t *testing.T) {
+	meta := streamrows.ClusterMeta{ClusterID: "c1", ClusterName: "ctx"}
+	resource := kedaObject("ScaledObject", nil, map[string]any{
+		"scaleTargetRef":  map[string]any{"name": "worker"},
+		"minReplicaCount": int64(1),
+		"triggers": []any{
+			map[string]any{"type": "prometheus", "metadata": map[string]any{"threshold": "5"}},
+			map[string]any{"type": "cron"},
+		},
+	}, nil)
+
+	row := BuildStreamSummary(meta, ScaledObjectIdentity, resource)
+	require.Equal(t, "ScaledObject", row.Ref.Kind)
+	require.Equal(t, "keda.sh", row.Ref.Group)
+	require.Equal(t, "Deployment/worker", row.Target)
+	require.Equal(t, "apps/v1", row.TargetAPIVersion)
+	require.Equal(t, int32(1), row.Min)
+	require.Equal(t, int32(defaultMaxReplicaCount), row.Max)
+	require.Equal(t, []string{"prometheus", "cron"}, row.Triggers)
+	require.False(t, row.Paused)
+}
+
+func TestBuildScaledJobSummary(t *testing.T) {
+	resource := kedaObject("ScaledJob", nil, map[string]any{
+		"maxReplicaCount": int64(10),
+		"triggers":        []any{map[string]any{"type": "rabbitmq"}},
+	}, map[string]any{"activeJobs": int64(3)})
+
+	row := BuildStreamSummary(streamrows.ClusterMeta{ClusterID: "c1"}, ScaledJobIdentity, resource)
+	require.Equal(t, "Job/worker", row.Target)
+	require.Equal(t, "batch/v1", row.TargetAPIVersion)
+	require.Equal(t, int32(0), row.Min)
+	require.Equal(t, int32(10), row.Max)
+	require.Equal(t, int32(3), row.Current)
+}
+
+func TestBuildStreamSummaryPausedState(t *testing.T) {
+	meta := streamrows.ClusterMeta{ClusterID: "c1"}
+	spec := map[string]any{"scaleTargetRef": map[string]any{"name": "worker"}}
+
+	byAnnotation := kedaObject("ScaledObject", map[string]any{PausedAnnotation: "true"}, spec, nil)
+	require.True(t, BuildStreamSummary(meta, ScaledObjectIdentity, byAnnotation).Paused)
+
+	byReplicas := kedaObject("ScaledObject", map[string]any{PausedReplicasAnnotation: "0"}, spec, nil)
+	require.True(t, BuildStreamSummary(meta, ScaledObjectIdentity, byReplicas).Paused)
+
+	byCondition := kedaObject("ScaledObject", nil, spec, map[string]any{
+		"conditions": []any{map[string]any{"type": "Paused", "status": "True"}},
+	})
+	require.True(t, BuildStreamSummary(meta, ScaledObjectIdentity, byCondition).Paused)
+
+	notPaused := kedaObject("ScaledObject", map[string]any{PausedAnnotation: "false"}, spec, nil)
+	require.False(t, BuildStreamSummary(meta, ScaledObjectIdentity, notPaused).Paused)
+}
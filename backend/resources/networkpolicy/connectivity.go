@@ -0,0 +1,333 @@
+/*
+ * backend/resources/networkpolicy/connectivity.go
+ *
+ * Effective-connectivity analysis: evaluates every NetworkPolicy that selects
+ * the source (egress) and destination (ingress) pods and reports whether
+ * traffic on one port is allowed and which rules decide it. Selectors are
+ * evaluated in full (matchExpressions included), so this reads the policy
+ * objects rather than the label-only Facts projection. Namespaces are read
+ * through the cluster's shared informer lister when the caller supplies one.
+ * Pods and NetworkPolicies are ingest-owned kinds whose caches keep projections
+ * only, so they are always read from the API, scoped to the two namespaces.
+ */
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// ConnectivityListers are the informer listers the analysis reads from. The
+// lister must be set and synced; otherwise the analysis falls back to the API.
+type ConnectivityListers struct {
+	Namespaces corelisters.NamespaceLister
+}
+
+func (l *ConnectivityListers) complete() bool {
+	return l != nil && l.Namespaces != nil
+}
+
+// ConnectivityEndpoint is one side of a connectivity check. Pod is nil for a
+// namespace-only endpoint, which is evaluated as an unlabeled pod without an IP.
+type ConnectivityEndpoint struct {
+	Namespace       string
+	NamespaceLabels map[string]string
+	Pod             *corev1.Pod
+}
+
+func (e ConnectivityEndpoint) podLabels() labels.Set {
+	if e.Pod == nil {
+		return labels.Set{}
+	}
+	return e.Pod.Labels
+}
+
+func (e ConnectivityEndpoint) podIP() net.IP {
+	if e.Pod == nil {
+		return nil
+	}
+	return net.ParseIP(e.Pod.Status.PodIP)
+}
+
+// AnalyzeConnectivity fetches the endpoints and every NetworkPolicy in their
+// namespaces, then evaluates the query. listers may be nil, in which case the
+// namespaces are read from the API as well.
+func (s *Service) AnalyzeConnectivity(listers *ConnectivityListers, query ConnectivityQuery) (*ConnectivityResult, error) {
+	query.Protocol = strings.ToUpper(strings.TrimSpace(query.Protocol))
+	if query.Protocol == "" {
+		query.Protocol = string(corev1.ProtocolTCP)
+	}
+	switch corev1.Protocol(query.Protocol) {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", query.Protocol)
+	}
+	if query.Port < 1 || query.Port > 65535 {
+		return nil, fmt.Errorf("port must be between 1 and 65535")
+	}
+	if strings.TrimSpace(query.SourceNamespace) == "" || strings.TrimSpace(query.DestinationNamespace) == "" {
+		return nil, fmt.Errorf("source and destination namespaces are required")
+	}
+
+	if !listers.complete() {
+		listers = nil
+	}
+	source, err := s.connectivityEndpoint(listers, query.SourceNamespace, query.SourcePod)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := s.connectivityEndpoint(listers, query.DestinationNamespace, query.DestinationPod)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := s.policiesIn(query.SourceNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if query.DestinationNamespace != query.SourceNamespace {
+		more, err := s.policiesIn(query.DestinationNamespace)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, more...)
+	}
+
+	result := EvaluateConnectivity(s.deps.ClusterID, policies, source, destination, query.Port, corev1.Protocol(query.Protocol))
+	result.Query = query
+	return result, nil
+}
+
+func (s *Service) connectivityEndpoint(listers *ConnectivityListers, namespace, podName string) (ConnectivityEndpoint, error) {
+	var ns *corev1.Namespace
+	var err error
+	if listers != nil {
+		ns, err = listers.Namespaces.Get(namespace)
+	} else {
+		ns, err = s.deps.KubernetesClient.CoreV1().Namespaces().Get(s.deps.Context, namespace, metav1.GetOptions{})
+	}
+	if err != nil {
+		return ConnectivityEndpoint{}, fmt.Errorf("failed to get namespace %s: %v", namespace, err)
+	}
+	endpoint := ConnectivityEndpoint{Namespace: ns.Name, NamespaceLabels: namespaceLabelsWithName(ns)}
+	if strings.TrimSpace(podName) == "" {
+		return endpoint, nil
+	}
+	pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Get(s.deps.Context, podName, metav1.GetOptions{})
+	if err != nil {
+		return ConnectivityEndpoint{}, fmt.Errorf("failed to get pod %s/%s: %v", namespace, podName, err)
+	}
+	endpoint.Pod = pod
+	return endpoint, nil
+}
+
+// namespaceLabelsWithName adds the immutable kubernetes.io/metadata.name label
+// the API server sets, so selectors on it match even on fakes and old clusters.
+func namespaceLabelsWithName(ns *corev1.Namespace) map[string]string {
+	out := make(map[string]string, len(ns.Labels)+1)
+	for key, value := range ns.Labels {
+		out[key] = value
+	}
+	out[corev1.LabelMetadataName] = ns.Name
+	return out
+}
+
+func (s *Service) policiesIn(namespace string) ([]networkingv1.NetworkPolicy, error) {
+	list, err := s.deps.KubernetesClient.NetworkingV1().NetworkPolicies(namespace).List(s.deps.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network policies in %s: %v", namespace, err)
+	}
+	return list.Items, nil
+}
+
+// EvaluateConnectivity decides whether source may reach destination on
+// port/protocol under policies. Only policies in the source namespace affect
+// egress and only those in the destination namespace affect ingress.
+func EvaluateConnectivity(clusterID string, policies []networkingv1.NetworkPolicy, source, destination ConnectivityEndpoint, port int32, protocol corev1.Protocol) *ConnectivityResult {
+	egress := decide(clusterID, policies, networkingv1.PolicyTypeEgress, source, destination, destination, port, protocol)
+	ingress := decide(clusterID, policies, networkingv1.PolicyTypeIngress, destination, source, destination, port, protocol)
+	return &ConnectivityResult{
+		ClusterID: clusterID,
+		Allowed:   egress.Allowed && ingress.Allowed,
+		Egress:    egress,
+		Ingress:   ingress,
+	}
+}
+
+// decide evaluates one direction. subject is the pod the policies must
+// select; peer is the other side, which rules must match. Named ports always
+// resolve against the destination pod, which is where traffic lands.
+func decide(clusterID string, policies []networkingv1.NetworkPolicy, direction networkingv1.PolicyType, subject, peer, destination ConnectivityEndpoint, port int32, protocol corev1.Protocol) ConnectivityDecision {
+	decision := ConnectivityDecision{SelectingPolicies: []resourcemodel.ResourceRef{}, MatchingRules: []ConnectivityRuleMatch{}}
+	for i := range policies {
+		policy := &policies[i]
+		if policy.Namespace != subject.Namespace || !affects(policy, direction) {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil || !selector.Matches(subject.podLabels()) {
+			continue
+		}
+		ref := policyRef(clusterID, policy)
+		decision.Isolated = true
+		decision.SelectingPolicies = append(decision.SelectingPolicies, ref)
+
+		if direction == networkingv1.PolicyTypeIngress {
+			for index, rule := range policy.Spec.Ingress {
+				if peersMatch(rule.From, policy.Namespace, peer) && portsMatch(rule.Ports, destination, port, protocol) {
+					decision.MatchingRules = append(decision.MatchingRules, ConnectivityRuleMatch{Policy: ref, RuleIndex: index})
+				}
+			}
+		} else {
+			for index, rule := range policy.Spec.Egress {
+				if peersMatch(rule.To, policy.Namespace, peer) && portsMatch(rule.Ports, destination, port, protocol) {
+					decision.MatchingRules = append(decision.MatchingRules, ConnectivityRuleMatch{Policy: ref, RuleIndex: index})
+				}
+			}
+		}
+	}
+
+	label := strings.ToLower(string(direction))
+	switch {
+	case !decision.Isolated:
+		decision.Allowed = true
+		decision.Reason = fmt.Sprintf("no NetworkPolicy selects the pod for %s; all %s traffic is allowed", label, label)
+	case len(decision.MatchingRules) > 0:
+		decision.Allowed = true
+		decision.Reason = fmt.Sprintf("allowed by %d %s rule(s)", len(decision.MatchingRules), label)
+	default:
+		decision.Reason = fmt.Sprintf("denied: %d NetworkPolicy object(s) isolate the pod for %s and no rule matches", len(decision.SelectingPolicies), label)
+	}
+	return decision
+}
+
+// affects reports whether policy restricts direction. Without explicit
+// policyTypes, every policy restricts ingress and those with egress rules
+// also restrict egress.
+func affects(policy *networkingv1.NetworkPolicy, direction networkingv1.PolicyType) bool {
+	if len(policy.Spec.PolicyTypes) == 0 {
+		return direction == networkingv1.PolicyTypeIngress || len(policy.Spec.Egress) > 0
+	}
+	for _, policyType := range policy.Spec.PolicyTypes {
+		if policyType == direction {
+			return true
+		}
+	}
+	return false
+}
+
+// peersMatch reports whether peer matches any of peers; an empty list matches
+// everything.
+func peersMatch(peers []networkingv1.NetworkPolicyPeer, policyNamespace string, peer ConnectivityEndpoint) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, candidate := range peers {
+		if peerMatches(candidate, policyNamespace, peer) {
+			return true
+		}
+	}
+	return false
+}
+
+func peerMatches(candidate networkingv1.NetworkPolicyPeer, policyNamespace string, peer ConnectivityEndpoint) bool {
+	if candidate.IPBlock != nil {
+		return ipBlockMatches(candidate.IPBlock, peer.podIP())
+	}
+	if candidate.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(candidate.NamespaceSelector)
+		if err != nil || !selector.Matches(labels.Set(peer.NamespaceLabels)) {
+			return false
+		}
+	} else if peer.Namespace != policyNamespace {
+		// A bare podSelector only selects pods in the policy's namespace.
+		return false
+	}
+	if candidate.PodSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(candidate.PodSelector)
+	return err == nil && selector.Matches(peer.podLabels())
+}
+
+func ipBlockMatches(block *networkingv1.IPBlock, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(block.CIDR)
+	if err != nil || !cidr.Contains(ip) {
+		return false
+	}
+	for _, except := range block.Except {
+		if _, excluded, err := net.ParseCIDR(except); err == nil && excluded.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// portsMatch reports whether port/protocol matches any entry; an empty list
+// matches every port.
+func portsMatch(ports []networkingv1.NetworkPolicyPort, destination ConnectivityEndpoint, port int32, protocol corev1.Protocol) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, candidate := range ports {
+		candidateProtocol := corev1.ProtocolTCP
+		if candidate.Protocol != nil {
+			candidateProtocol = *candidate.Protocol
+		}
+		if candidateProtocol != protocol {
+			continue
+		}
+		if candidate.Port == nil {
+			return true
+		}
+		if candidate.Port.Type == intstr.String {
+			if namedPortMatches(destination.Pod, candidate.Port.StrVal, port, protocol) {
+				return true
+			}
+			continue
+		}
+		start := candidate.Port.IntVal
+		end := start
+		if candidate.EndPort != nil {
+			end = *candidate.EndPort
+		}
+		if port >= start && port <= end {
+			return true
+		}
+	}
+	return false
+}
+
+func namedPortMatches(pod *corev1.Pod, name string, port int32, protocol corev1.Protocol) bool {
+	if pod == nil {
+		return false
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			containerProtocol := containerPort.Protocol
+			if containerProtocol == "" {
+				containerProtocol = corev1.ProtocolTCP
+			}
+			if containerPort.Name == name && containerPort.ContainerPort == port && containerProtocol == protocol {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func policyRef(clusterID string, policy *networkingv1.NetworkPolicy) resourcemodel.ResourceRef {
+	return resourcemodel.NewResourceRef(clusterID, Identity.Group, Identity.Version, Identity.Kind, Identity.Resource, policy.Namespace, policy.Name, string(policy.UID))
+}
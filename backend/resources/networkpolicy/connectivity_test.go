@@ -0,0 +1,186 @@
+/*
+ * backend/resources/networkpolicy/connectivity_test.go
+ *
+ * Tests for NetworkPolicy effective-connectivity analysis.
+ */
+
+package networkpolicy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/resources/networkpolicy"
+)
+
+func connectivityPod(namespace, name, ip string, podLabels map[string]string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: podLabels},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Ports: ports}}},
+		Status:     corev1.PodStatus{PodIP: ip},
+	}
+}
+
+func endpoint(namespace string, nsLabels map[string]string, pod *corev1.Pod) networkpolicy.ConnectivityEndpoint {
+	all := map[string]string{corev1.LabelMetadataName: namespace}
+	for key, value := range nsLabels {
+		all[key] = value
+	}
+	return networkpolicy.ConnectivityEndpoint{Namespace: namespace, NamespaceLabels: all, Pod: pod}
+}
+
+func denyAllIngress(namespace string) networkingv1.NetworkPolicy {
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "deny-all"},
+		Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+	}
+}
+
+func allowFromFrontend(namespace string, port intstr.IntOrString) networkingv1.NetworkPolicy {
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "allow-frontend"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "192.168.0.0/16"}}}},
+				{
+					From: []networkingv1.NetworkPolicyPeer{{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}},
+						PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"frontend", "edge"},
+						}}},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{{Port: &port}},
+				},
+			},
+		},
+	}
+}
+
+func TestConnectivityAllowedWithoutPolicies(t *testing.T) {
+	src := endpoint("web", nil, connectivityPod("web", "ui", "10.0.0.1", nil))
+	dst := endpoint("api", nil, connectivityPod("api", "api", "10.0.0.2", nil))
+
+	result := networkpolicy.EvaluateConnectivity("config:ctx", nil, src, dst, 8080, corev1.ProtocolTCP)
+	require.True(t, result.Allowed)
+	require.False(t, result.Egress.Isolated)
+	require.False(t, result.Ingress.Isolated)
+}
+
+func TestConnectivityDecidingRuleAndDefaultDeny(t *testing.T) {
+	api := connectivityPod("api", "api", "10.0.0.2", map[string]string{"app": "api"}, corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	frontend := endpoint("web", map[string]string{"team": "web"}, connectivityPod("web", "ui", "10.0.0.1", map[string]string{"tier": "frontend"}))
+	policies := []networkingv1.NetworkPolicy{denyAllIngress("api"), allowFromFrontend("api", intstr.FromString("http"))}
+
+	result := networkpolicy.EvaluateConnectivity("config:ctx", policies, frontend, endpoint("api", nil, api), 8080, corev1.ProtocolTCP)
+	require.True(t, result.Allowed)
+	require.True(t, result.Ingress.Isolated)
+	require.Len(t, result.Ingress.SelectingPolicies, 2)
+	require.Len(t, result.Ingress.MatchingRules, 1)
+	match := result.Ingress.MatchingRules[0]
+	require.Equal(t, "allow-frontend", match.Policy.Name)
+	require.Equal(t, "NetworkPolicy", match.Policy.Kind)
+	require.Equal(t, "networking.k8s.io", match.Policy.Group)
+	require.Equal(t, "config:ctx", match.Policy.ClusterID)
+	require.Equal(t, 1, match.RuleIndex)
+
+	// The named port only resolves to 8080, and UDP is a different port.
+	require.False(t, networkpolicy.EvaluateConnectivity("config:ctx", policies, frontend, endpoint("api", nil, api), 9090, corev1.ProtocolTCP).Allowed)
+	require.False(t, networkpolicy.EvaluateConnectivity("config:ctx", policies, frontend, endpoint("api", nil, api), 8080, corev1.ProtocolUDP).Allowed)
+
+	// A pod outside the selected namespace is denied by default.
+	other := endpoint("batch", nil, connectivityPod("batch", "job", "10.0.0.9", map[string]string{"tier": "frontend"}))
+	denied := networkpolicy.EvaluateConnectivity("config:ctx", policies, other, endpoint("api", nil, api), 8080, corev1.ProtocolTCP)
+	require.False(t, denied.Allowed)
+	require.True(t, denied.Egress.Allowed)
+	require.Empty(t, denied.Ingress.MatchingRules)
+	require.Contains(t, denied.Ingress.Reason, "denied")
+
+	// The ipBlock rule admits any port from its CIDR.
+	inCIDR := endpoint("batch", nil, connectivityPod("batch", "job", "192.168.3.4", nil))
+	viaIP := networkpolicy.EvaluateConnectivity("config:ctx", policies, inCIDR, endpoint("api", nil, api), 9090, corev1.ProtocolTCP)
+	require.True(t, viaIP.Allowed)
+	require.Equal(t, 0, viaIP.Ingress.MatchingRules[0].RuleIndex)
+}
+
+func TestConnectivityEgressIsolationAndPortRanges(t *testing.T) {
+	egress := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "egress-db"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To:    []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "db"}}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(5432)), EndPort: ptr.To[int32](5440)}},
+			}},
+		},
+	}
+	src := endpoint("web", nil, connectivityPod("web", "ui", "10.0.0.1", nil))
+
+	require.True(t, networkpolicy.EvaluateConnectivity("config:ctx", []networkingv1.NetworkPolicy{egress}, src, endpoint("db", nil, nil), 5435, corev1.ProtocolTCP).Allowed)
+	blocked := networkpolicy.EvaluateConnectivity("config:ctx", []networkingv1.NetworkPolicy{egress}, src, endpoint("cache", nil, nil), 5435, corev1.ProtocolTCP)
+	require.False(t, blocked.Allowed)
+	require.False(t, blocked.Egress.Allowed)
+	require.True(t, blocked.Ingress.Allowed)
+}
+
+func TestAnalyzeConnectivityFetchesObjects(t *testing.T) {
+	policy := allowFromFrontend("api", intstr.FromInt32(8080))
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"team": "web"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "api"}},
+		connectivityPod("web", "ui", "10.0.0.1", map[string]string{"tier": "edge"}),
+		connectivityPod("api", "api", "10.0.0.2", map[string]string{"app": "api"}),
+		&policy,
+	)
+	service := newService(t, client)
+
+	result, err := service.AnalyzeConnectivity(nil, networkpolicy.ConnectivityQuery{
+		SourceNamespace: "web", SourcePod: "ui", DestinationNamespace: "api", DestinationPod: "api", Port: 8080, Protocol: "tcp",
+	})
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Equal(t, "TCP", result.Query.Protocol)
+
+	_, err = service.AnalyzeConnectivity(nil, networkpolicy.ConnectivityQuery{SourceNamespace: "web", DestinationNamespace: "api", Port: 0})
+	require.ErrorContains(t, err, "port")
+	_, err = service.AnalyzeConnectivity(nil, networkpolicy.ConnectivityQuery{SourceNamespace: "web", DestinationNamespace: "api", Port: 80, Protocol: "icmp"})
+	require.ErrorContains(t, err, "protocol")
+	_, err = service.AnalyzeConnectivity(nil, networkpolicy.ConnectivityQuery{SourceNamespace: "web", SourcePod: "missing", DestinationNamespace: "api", Port: 80})
+	require.ErrorContains(t, err, "missing")
+}
+
+func TestAnalyzeConnectivityReadsNamespaceLister(t *testing.T) {
+	policy := allowFromFrontend("api", intstr.FromInt32(8080))
+	store := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, store.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"team": "web"}}}))
+	require.NoError(t, store.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "api"}}))
+	listers := &networkpolicy.ConnectivityListers{Namespaces: corelisters.NewNamespaceLister(store)}
+	// The API has no namespaces: they must come from the lister, while pods
+	// and policies are read from the API.
+	service := newService(t, fake.NewClientset(
+		connectivityPod("web", "ui", "10.0.0.1", map[string]string{"tier": "edge"}),
+		connectivityPod("api", "api", "10.0.0.2", map[string]string{"app": "api"}),
+		&policy,
+	))
+
+	result, err := service.AnalyzeConnectivity(listers, networkpolicy.ConnectivityQuery{
+		SourceNamespace: "web", SourcePod: "ui", DestinationNamespace: "api", DestinationPod: "api", Port: 8080,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Equal(t, "allow-frontend", result.Ingress.SelectingPolicies[0].Name)
+
+	_, err = service.AnalyzeConnectivity(listers, networkpolicy.ConnectivityQuery{
+		SourceNamespace: "web", SourcePod: "missing", DestinationNamespace: "api", Port: 80,
+	})
+	require.ErrorContains(t, err, "missing")
+}
@@ -7,6 +7,8 @@
 
 package networkpolicy
 
+import "github.com/luxury-yacht/app/backend/resourcemodel"
+
 type NetworkPolicyDetails struct {
 	Kind         string              `json:"kind"`
 	Name         string              `json:"name"`
@@ -42,3 +44,44 @@ type NetworkPolicyPort struct {
 	Port     *string `json:"port,omitempty"`
 	EndPort  *int32  `json:"endPort,omitempty"`
 }
+
+// ConnectivityQuery asks whether SourceNamespace/SourcePod may reach
+// DestinationNamespace/DestinationPod on Port/Protocol. An empty pod name
+// stands for an unlabeled pod in that namespace.
+type ConnectivityQuery struct {
+	SourceNamespace      string `json:"sourceNamespace"`
+	SourcePod            string `json:"sourcePod,omitempty"`
+	DestinationNamespace string `json:"destinationNamespace"`
+	DestinationPod       string `json:"destinationPod,omitempty"`
+	Port                 int32  `json:"port"`
+	Protocol             string `json:"protocol,omitempty"`
+}
+
+// ConnectivityResult is the verdict for a query: traffic is allowed only when
+// both the source's egress and the destination's ingress allow it.
+type ConnectivityResult struct {
+	ClusterID string               `json:"clusterId"`
+	Query     ConnectivityQuery    `json:"query"`
+	Allowed   bool                 `json:"allowed"`
+	Egress    ConnectivityDecision `json:"egress"`
+	Ingress   ConnectivityDecision `json:"ingress"`
+}
+
+// ConnectivityDecision explains one direction. When no policy selects the
+// pod for this direction it is not isolated and traffic is allowed. When
+// isolated, MatchingRules lists every rule that admits the traffic; an empty
+// list means the selecting policies deny it by default.
+type ConnectivityDecision struct {
+	Isolated          bool                        `json:"isolated"`
+	Allowed           bool                        `json:"allowed"`
+	SelectingPolicies []resourcemodel.ResourceRef `json:"selectingPolicies"`
+	MatchingRules     []ConnectivityRuleMatch     `json:"matchingRules"`
+	Reason            string                      `json:"reason"`
+}
+
+// ConnectivityRuleMatch identifies the rule that admits the traffic by its
+// index in the policy's ingress or egress list.
+type ConnectivityRuleMatch struct {
+	Policy    resourcemodel.ResourceRef `json:"policy"`
+	RuleIndex int                       `json:"ruleIndex"`
+}
@@ -0,0 +1,293 @@
+/*
+ * backend/resources/nodes/drain_simulation.go
+ *
+ * Dry run of a node drain.
+ * - Classifies each pod on the node the way kubectl's drain filters would for the
+ *   chosen options (DaemonSet, mirror, emptyDir, unmanaged pods).
+ * - Checks evictions against PodDisruptionBudget allowances and the owning
+ *   workload's ready replicas, so blocked pods are known before the drain starts.
+ */
+
+package nodes
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+)
+
+// Drain simulation outcomes for a single pod.
+const (
+	DrainOutcomeEvict = "evict"
+	DrainOutcomeSkip  = "skip"
+	DrainOutcomeBlock = "block"
+)
+
+// DrainSimulation is the predicted result of draining a node with a set of options.
+type DrainSimulation struct {
+	NodeName string               `json:"nodeName"`
+	Pods     []DrainSimulationPod `json:"pods"`
+	// Evicted, Skipped and Blocked count the pods per outcome. Any blocked pod fails
+	// the drain (or, for PDB blocks, keeps it retrying until the timeout).
+	Evicted int `json:"evicted"`
+	Skipped int `json:"skipped"`
+	Blocked int `json:"blocked"`
+	// Warnings are cluster-level caveats, e.g. PDBs that could not be read.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DrainSimulationPod is the predicted outcome for one pod on the node.
+type DrainSimulationPod struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Owner is the controlling object as "Kind/name"; empty for an unmanaged pod.
+	Owner   string `json:"owner,omitempty"`
+	Outcome string `json:"outcome"`
+	// Reasons explain a skip or block; for evicted pods they are warnings such as
+	// lost emptyDir data or a workload left without ready replicas.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// SimulateDrain predicts what Drain would do to each pod on the node without
+// cordoning it or evicting anything.
+func (s *Service) SimulateDrain(nodeName string, options restypes.DrainNodeOptions) (*DrainSimulation, error) {
+	if err := s.ensureClient("Node drain simulation"); err != nil {
+		return nil, err
+	}
+	if err := ValidateDrainOptions(options); err != nil {
+		return nil, err
+	}
+	ctx := s.requestContext()
+	client := s.deps.KubernetesClient
+
+	if _, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to get node: %v", err)
+	}
+	podList, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: nodePodFieldSelector(nodeName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node: %v", err)
+	}
+
+	simulation := &DrainSimulation{NodeName: nodeName, Pods: make([]DrainSimulationPod, 0, len(podList.Items))}
+
+	var pdbs []policyv1.PodDisruptionBudget
+	if !options.DisableEviction {
+		pdbList, err := client.PolicyV1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			s.logWarn(fmt.Sprintf("Drain simulation could not list PodDisruptionBudgets: %v", err))
+			simulation.Warnings = append(simulation.Warnings, fmt.Sprintf("PodDisruptionBudgets not checked: %v", err))
+		} else {
+			pdbs = pdbList.Items
+		}
+	}
+
+	planned := make([]int, 0, len(podList.Items))
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		result := classifyDrainPod(pod, options)
+		if result.Outcome == DrainOutcomeEvict {
+			planned = append(planned, i)
+		}
+		simulation.Pods = append(simulation.Pods, result)
+	}
+
+	// PDB check: every budget loses one allowed disruption per matching evicted pod.
+	// Evictions run in parallel, so once the node's matching pods outnumber the
+	// allowance the surplus pods are refused until replacements become healthy.
+	if len(pdbs) > 0 {
+		spent := make(map[int]int32, len(pdbs))
+		for _, idx := range planned {
+			pod := &podList.Items[idx]
+			for p := range pdbs {
+				if !pdbMatchesPod(&pdbs[p], pod) {
+					continue
+				}
+				pdb := &pdbs[p]
+				spent[p]++
+				if spent[p] > pdb.Status.DisruptionsAllowed {
+					simulation.Pods[idx].Outcome = DrainOutcomeBlock
+					simulation.Pods[idx].Reasons = append(simulation.Pods[idx].Reasons, fmt.Sprintf(
+						"PodDisruptionBudget %s allows %d disruption(s); %d matching pod(s) on this node",
+						pdb.Name, pdb.Status.DisruptionsAllowed, countPDBMatches(pdb, podList.Items, planned)))
+				}
+			}
+		}
+	}
+
+	s.addReplicaWarnings(simulation, podList.Items, planned)
+
+	for _, pod := range simulation.Pods {
+		switch pod.Outcome {
+		case DrainOutcomeEvict:
+			simulation.Evicted++
+		case DrainOutcomeSkip:
+			simulation.Skipped++
+		case DrainOutcomeBlock:
+			simulation.Blocked++
+		}
+	}
+	sort.SliceStable(simulation.Pods, func(i, j int) bool {
+		a, b := simulation.Pods[i], simulation.Pods[j]
+		if drainOutcomeRank(a.Outcome) != drainOutcomeRank(b.Outcome) {
+			return drainOutcomeRank(a.Outcome) < drainOutcomeRank(b.Outcome)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return simulation, nil
+}
+
+// classifyDrainPod mirrors kubectl's drain filters (skip-deleted aside): DaemonSet
+// pods, mirror pods, emptyDir data and pods without a controller.
+func classifyDrainPod(pod *corev1.Pod, options restypes.DrainNodeOptions) DrainSimulationPod {
+	result := DrainSimulationPod{Namespace: pod.Namespace, Name: pod.Name, Outcome: DrainOutcomeEvict}
+	controller := metav1.GetControllerOf(pod)
+	if controller != nil {
+		result.Owner = controller.Kind + "/" + controller.Name
+	}
+	finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+
+	if controller != nil && controller.Kind == "DaemonSet" && !finished {
+		if options.IgnoreDaemonSets {
+			result.Outcome = DrainOutcomeSkip
+			result.Reasons = []string{"DaemonSet pod is left running"}
+		} else {
+			result.Outcome = DrainOutcomeBlock
+			result.Reasons = []string{"DaemonSet-managed pod (enable Ignore DaemonSet pods)"}
+		}
+		return result
+	}
+	if _, mirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; mirror {
+		result.Outcome = DrainOutcomeSkip
+		result.Reasons = []string{"static (mirror) pod is managed by the kubelet"}
+		return result
+	}
+	if finished {
+		return result
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir == nil {
+			continue
+		}
+		if options.DeleteEmptyDirData {
+			result.Reasons = append(result.Reasons, fmt.Sprintf("emptyDir volume %s data will be lost", volume.Name))
+		} else {
+			result.Outcome = DrainOutcomeBlock
+			result.Reasons = []string{"pod uses emptyDir volumes (enable Remove pods with emptyDir volumes)"}
+			return result
+		}
+	}
+	if controller == nil {
+		if options.Force {
+			result.Reasons = append(result.Reasons, "no controller: the pod is not recreated")
+		} else {
+			result.Outcome = DrainOutcomeBlock
+			result.Reasons = []string{"pod has no controller (enable Allow deleting unmanaged pods)"}
+		}
+	}
+	return result
+}
+
+// addReplicaWarnings flags evicted pods whose workload keeps all of its ready
+// replicas on this node: the workload is unavailable until the replacements start.
+func (s *Service) addReplicaWarnings(simulation *DrainSimulation, pods []corev1.Pod, planned []int) {
+	onNode := make(map[string][]int)
+	for _, idx := range planned {
+		if simulation.Pods[idx].Outcome != DrainOutcomeEvict {
+			continue
+		}
+		controller := metav1.GetControllerOf(&pods[idx])
+		if controller == nil || (controller.Kind != "ReplicaSet" && controller.Kind != "StatefulSet") {
+			continue
+		}
+		key := pods[idx].Namespace + "/" + controller.Kind + "/" + controller.Name
+		onNode[key] = append(onNode[key], idx)
+	}
+	if len(onNode) == 0 {
+		return
+	}
+
+	ctx := s.requestContext()
+	apps := s.deps.KubernetesClient.AppsV1()
+	for _, indexes := range onNode {
+		pod := &pods[indexes[0]]
+		controller := metav1.GetControllerOf(pod)
+		var ready int32
+		switch controller.Kind {
+		case "ReplicaSet":
+			rs, err := apps.ReplicaSets(pod.Namespace).Get(ctx, controller.Name, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+			ready = rs.Status.ReadyReplicas
+			if owner := replicaSetDeployment(rs); owner != "" {
+				for _, idx := range indexes {
+					simulation.Pods[idx].Owner = "Deployment/" + owner
+				}
+			}
+		case "StatefulSet":
+			sts, err := apps.StatefulSets(pod.Namespace).Get(ctx, controller.Name, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+			ready = sts.Status.ReadyReplicas
+		}
+		if int(ready) > len(indexes) {
+			continue
+		}
+		for _, idx := range indexes {
+			simulation.Pods[idx].Reasons = append(simulation.Pods[idx].Reasons, fmt.Sprintf(
+				"all %d ready replica(s) of %s run on this node", ready, simulation.Pods[idx].Owner))
+		}
+	}
+}
+
+func replicaSetDeployment(rs *appsv1.ReplicaSet) string {
+	if owner := metav1.GetControllerOf(rs); owner != nil && owner.Kind == "Deployment" {
+		return owner.Name
+	}
+	return ""
+}
+
+func pdbMatchesPod(pdb *policyv1.PodDisruptionBudget, pod *corev1.Pod) bool {
+	if pdb.Namespace != pod.Namespace || pdb.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || selector.Empty() {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+func countPDBMatches(pdb *policyv1.PodDisruptionBudget, pods []corev1.Pod, planned []int) int {
+	count := 0
+	for _, idx := range planned {
+		if pdbMatchesPod(pdb, &pods[idx]) {
+			count++
+		}
+	}
+	return count
+}
+
+func drainOutcomeRank(outcome string) int {
+	switch outcome {
+	case DrainOutcomeBlock:
+		return 0
+	case DrainOutcomeEvict:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,152 @@
+package nodes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/luxury-yacht/app/backend/internal/applog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	"github.com/luxury-yacht/app/backend/resources/nodes"
+	"github.com/luxury-yacht/app/backend/resources/types"
+)
+
+func drainSimulationPod(name string, owner *metav1.OwnerReference, mutate func(*corev1.Pod)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{"app": name}},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if owner != nil {
+		controller := true
+		owner.Controller = &controller
+		pod.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+	if mutate != nil {
+		mutate(pod)
+	}
+	return pod
+}
+
+func TestServiceSimulateDrainReportsBlockingPods(t *testing.T) {
+	controller := true
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "api-7d4b",
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind: "Deployment", Name: "api", Controller: &controller,
+			}},
+		},
+		Status: appsv1.ReplicaSetStatus{ReadyReplicas: 1},
+	}
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-pdb"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+	webPod := func(name string) *corev1.Pod {
+		return drainSimulationPod(name, &metav1.OwnerReference{Kind: "StatefulSet", Name: "web"},
+			func(p *corev1.Pod) { p.Labels["tier"] = "web" })
+	}
+
+	client := fake.NewClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		replicaSet,
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 5},
+		},
+		pdb,
+		webPod("web-0"),
+		webPod("web-1"),
+		drainSimulationPod("api-7d4b-x", &metav1.OwnerReference{Kind: "ReplicaSet", Name: "api-7d4b"}, nil),
+		drainSimulationPod("bare", nil, nil),
+		drainSimulationPod("cache", &metav1.OwnerReference{Kind: "ReplicaSet", Name: "missing"}, func(p *corev1.Pod) {
+			p.Spec.Volumes = []corev1.Volume{{
+				Name:         "scratch",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}}
+		}),
+		drainSimulationPod("agent", &metav1.OwnerReference{Kind: "DaemonSet", Name: "agent"}, nil),
+	)
+	service := nodes.NewService(common.Dependencies{
+		Context:          context.Background(),
+		Logger:           applog.Noop,
+		KubernetesClient: client,
+	})
+
+	simulation, err := service.SimulateDrain("node-1", types.DrainNodeOptions{IgnoreDaemonSets: true})
+	require.NoError(t, err)
+
+	byName := map[string]nodes.DrainSimulationPod{}
+	for _, pod := range simulation.Pods {
+		byName[pod.Name] = pod
+	}
+	require.Equal(t, nodes.DrainOutcomeEvict, byName["web-0"].Outcome)
+	require.Equal(t, nodes.DrainOutcomeBlock, byName["web-1"].Outcome)
+	require.Equal(t,
+		[]string{"PodDisruptionBudget web-pdb allows 1 disruption(s); 2 matching pod(s) on this node"},
+		byName["web-1"].Reasons)
+	require.Equal(t, nodes.DrainOutcomeBlock, byName["bare"].Outcome)
+	require.Equal(t, nodes.DrainOutcomeBlock, byName["cache"].Outcome)
+	require.Equal(t, nodes.DrainOutcomeSkip, byName["agent"].Outcome)
+
+	api := byName["api-7d4b-x"]
+	require.Equal(t, nodes.DrainOutcomeEvict, api.Outcome)
+	require.Equal(t, "Deployment/api", api.Owner)
+	require.Equal(t, []string{"all 1 ready replica(s) of Deployment/api run on this node"}, api.Reasons)
+
+	require.Equal(t, 2, simulation.Evicted)
+	require.Equal(t, 3, simulation.Blocked)
+	require.Equal(t, 1, simulation.Skipped)
+	require.Equal(t, nodes.DrainOutcomeBlock, simulation.Pods[0].Outcome)
+}
+
+func TestServiceSimulateDrainHonorsOptions(t *testing.T) {
+	client := fake.NewClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "strict"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bare"}},
+			},
+		},
+		drainSimulationPod("bare", nil, func(p *corev1.Pod) {
+			p.Spec.Volumes = []corev1.Volume{{
+				Name:         "scratch",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}}
+		}),
+	)
+	service := nodes.NewService(common.Dependencies{
+		Context:          context.Background(),
+		Logger:           applog.Noop,
+		KubernetesClient: client,
+	})
+
+	// Deleting instead of evicting bypasses the PDB; force and emptyDir turn blocks into warnings.
+	simulation, err := service.SimulateDrain("node-1", types.DrainNodeOptions{
+		DeleteEmptyDirData: true,
+		Force:              true,
+		DisableEviction:    true,
+	})
+	require.NoError(t, err)
+	require.Len(t, simulation.Pods, 1)
+	require.Equal(t, nodes.DrainOutcomeEvict, simulation.Pods[0].Outcome)
+	require.Equal(t, []string{
+		"emptyDir volume scratch data will be lost",
+		"no controller: the pod is not recreated",
+	}, simulation.Pods[0].Reasons)
+
+	_, err = service.SimulateDrain("missing", types.DrainNodeOptions{})
+	require.Error(t, err)
+}
@@ -46,6 +46,9 @@ type NodeDetails struct {
 	Memory            string                   `json:"memory"`
 	Pods              string                   `json:"pods"`
 	Conditions        []NodeCondition          `json:"conditions"`
+	Resources         []NodeResource           `json:"resources,omitempty"`
+	Images            []NodeImage              `json:"images,omitempty"`
+	ImagesSizeBytes   int64                    `json:"imagesSizeBytes,omitempty"`
 	Taints            []NodeTaint              `json:"taints,omitempty"`
 	Labels            map[string]string        `json:"labels,omitempty"`
 	Annotations       map[string]string        `json:"annotations,omitempty"`
@@ -58,6 +61,25 @@ type NodeCondition struct {
 	Status  string `json:"status"`
 	Reason  string `json:"reason,omitempty"`
 	Message string `json:"message,omitempty"`
+	// RFC3339 times of the last status change and the last kubelet report.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	LastHeartbeatTime  string `json:"lastHeartbeatTime,omitempty"`
+}
+
+// NodeResource is one resource's capacity and what the kubelet offers pods;
+// Reserved is the difference, held back for the system and eviction.
+type NodeResource struct {
+	Name        string `json:"name"`
+	Capacity    string `json:"capacity"`
+	Allocatable string `json:"allocatable,omitempty"`
+	Reserved    string `json:"reserved,omitempty"`
+}
+
+// NodeImage is a container image present on the node. The kubelet reports at
+// most its configured maximum (50 by default), largest first.
+type NodeImage struct {
+	Names     []string `json:"names"`
+	SizeBytes int64    `json:"sizeBytes"`
 }
 
 // NodeTaint represents a node taint.
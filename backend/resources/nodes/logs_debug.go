@@ -0,0 +1,302 @@
+/*
+ * backend/resources/nodes/logs_debug.go
+ *
+ * Node log fallback through a debug pod, for nodes whose kubelet does not
+ * serve /logs (NodeLogQuery off, or the endpoint blocked).
+ * - EnsureLogDebugPod runs a pod on the node with the host root mounted
+ *   read-only at /host, the way `kubectl debug node/...` does, and reuses it
+ *   while it runs.
+ * - Logs are then read by exec: journalctl through chroot for services, tail
+ *   for files under /var/log.
+ * - Debug sources carry their pod in the path ("debug:<ns>/<pod>/<source>"),
+ *   so fetches need no state on the backend.
+ */
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/config"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	nodeLogDebugPrefix        = "debug:"
+	nodeLogDebugContainer     = "logs"
+	nodeLogDebugHostRoot      = "/host"
+	nodeLogDebugPurposeLabel  = "luxury-yacht.io/purpose"
+	nodeLogDebugPurposeValue  = "node-logs"
+	nodeLogDebugManagedByKey  = "app.kubernetes.io/managed-by"
+	nodeLogDebugManagedByName = "luxury-yacht"
+)
+
+var (
+	// nodeLogDebugPollInterval controls how often the debug pod's phase is
+	// checked. Tests can override this.
+	nodeLogDebugPollInterval = config.DebugContainerPollInterval
+	// nodeLogDebugStartTimeout bounds the wait for the debug pod to run. Tests
+	// can override this.
+	nodeLogDebugStartTimeout = config.NodeLogDebugPodStartTimeout
+)
+
+// nodeLogDebugSourcesScript prints "service:<unit>" for each well-known unit
+// with journal entries, then every file under /var/log two levels deep.
+const nodeLogDebugSourcesScript = `for u in "$@"; do
+  if chroot /host journalctl -u "$u" -n 1 -q --no-pager 2>/dev/null | grep -q .; then echo "service:$u"; fi
+done
+find /host/var/log -maxdepth 2 -type f 2>/dev/null
+exit 0`
+
+// nodeLogDebugServiceScript tails a unit's journal; $3 is an optional
+// --since=@<epoch>, left unquoted so that an empty value is dropped.
+const nodeLogDebugServiceScript = `chroot /host journalctl -u "$1" --no-pager -o short-iso $3 | tail -c "$2"`
+
+// nodeLogDebugFileScript tails a file under the host's /var/log.
+const nodeLogDebugFileScript = `tail -c "$2" -- "/host/var/log/$1"`
+
+// NodeLogDebugSourcesCommand is the exec command that lists the sources a
+// debug pod can read.
+func NodeLogDebugSourcesCommand() []string {
+	return append([]string{"/bin/sh", "-c", nodeLogDebugSourcesScript, "sh"}, wellKnownNodeLogServices...)
+}
+
+// IsDebugPodLogSource reports whether sourcePath is read through a debug pod.
+func IsDebugPodLogSource(sourcePath string) bool {
+	return strings.HasPrefix(strings.TrimSpace(sourcePath), nodeLogDebugPrefix)
+}
+
+// ParseDebugPodLogSource splits a debug source path into the debug pod and
+// the source within it (a "service:<unit>" or a path under /var/log).
+func ParseDebugPodLogSource(sourcePath string) (namespace, podName, source string, err error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(sourcePath), nodeLogDebugPrefix)
+	if !ok {
+		return "", "", "", fmt.Errorf("not a debug pod log source")
+	}
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid node log source path")
+	}
+	if err := validateNodeLogSourcePath(parts[2]); err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// DebugPodLogCommand builds the exec command that reads source, returning at
+// most tailBytes+1 bytes so the caller can tell the tail was cut.
+func DebugPodLogCommand(source, sinceTime string, tailBytes int) []string {
+	limit := strconv.Itoa(normalizeNodeLogTailBytes(tailBytes) + 1)
+	if serviceName, ok := parseNodeLogServiceSource(source); ok {
+		since := ""
+		if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(sinceTime)); err == nil {
+			since = "--since=@" + strconv.FormatInt(parsed.Unix(), 10)
+		}
+		return []string{"/bin/sh", "-c", nodeLogDebugServiceScript, "sh", serviceName, limit, since}
+	}
+	return []string{"/bin/sh", "-c", nodeLogDebugFileScript, "sh", strings.Trim(source, "/"), limit}
+}
+
+// ParseDebugPodLogSources turns NodeLogDebugSourcesCommand output from pod
+// into discovery sources, skipping the same files the kubelet path does.
+func ParseDebugPodLogSources(pod *corev1.Pod, output []byte) restypes.NodeLogDiscoveryResponse {
+	prefix := nodeLogDebugPrefix + pod.Namespace + "/" + pod.Name + "/"
+	sources := make([]restypes.NodeLogSource, 0)
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(string(output), "\n") {
+		source := strings.TrimSpace(line)
+		if file, ok := strings.CutPrefix(source, nodeLogDebugHostRoot+"/var/log/"); ok {
+			source = file
+		} else if _, isService := parseNodeLogServiceSource(source); !isService {
+			continue
+		}
+		if source == "" || seen[source] || shouldSkipNodeLogDiscoveryPath(source) ||
+			validateNodeLogSourcePath(source) != nil {
+			continue
+		}
+		seen[source] = true
+		sources = append(sources, restypes.NodeLogSource{
+			ID:    prefix + source,
+			Label: "debug pod / " + nodeLogSourceLabel(source),
+			Kind:  nodeLogSourceKind(source),
+			Path:  prefix + source,
+		})
+	}
+	if len(sources) == 0 {
+		return restypes.NodeLogDiscoveryResponse{Reason: "the debug pod found no readable node logs"}
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Label < sources[j].Label })
+	return restypes.NodeLogDiscoveryResponse{Supported: true, Sources: sources}
+}
+
+// DebugPodLogResponse turns DebugPodLogCommand output into a fetch response.
+func DebugPodLogResponse(sourcePath, source string, output []byte, tailBytes int) restypes.NodeLogFetchResponse {
+	response := restypes.NodeLogFetchResponse{
+		Source: restypes.NodeLogSource{
+			ID:    sourcePath,
+			Label: "debug pod / " + nodeLogSourceLabel(source),
+			Kind:  nodeLogSourceKind(source),
+			Path:  sourcePath,
+		},
+		SourcePath: sourcePath,
+	}
+	if looksLikeBinaryNodeLogBody(output) {
+		response.Error = "selected source appears to be compressed or binary and cannot be displayed"
+		return response
+	}
+	response.Content, response.Truncated = truncateNodeLogContent(output, normalizeNodeLogTailBytes(tailBytes))
+	return response
+}
+
+// IsLogDebugPod reports whether pod is a node log debug pod on nodeName, so a
+// crafted source path cannot point the log reader at an arbitrary pod.
+func IsLogDebugPod(pod *corev1.Pod, nodeName string) bool {
+	return pod != nil &&
+		pod.Labels[nodeLogDebugPurposeLabel] == nodeLogDebugPurposeValue &&
+		pod.Spec.NodeName == nodeName
+}
+
+// EnsureLogDebugPod returns a running log debug pod on nodeName in namespace,
+// starting one from image when none is running.
+func (s *Service) EnsureLogDebugPod(nodeName, namespace, image string) (*corev1.Pod, error) {
+	if err := s.ensureClient("Pods"); err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if image == "" {
+		return nil, fmt.Errorf("image is required")
+	}
+
+	ctx, cancel := context.WithTimeout(s.requestContext(), nodeLogDebugStartTimeout)
+	defer cancel()
+
+	existing, err := s.listLogDebugPods(ctx, nodeName, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for i := range existing {
+		if existing[i].Status.Phase == corev1.PodRunning && existing[i].DeletionTimestamp == nil {
+			return &existing[i], nil
+		}
+	}
+
+	pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Create(ctx, newLogDebugPod(nodeName, namespace, image), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create debug pod: %w", err)
+	}
+	s.logInfo(fmt.Sprintf("Started node log debug pod %s/%s on node %s", namespace, pod.Name, nodeName))
+	return s.waitForLogDebugPod(ctx, namespace, pod.Name)
+}
+
+// StopLogDebugPods deletes the log debug pods on nodeName in namespace.
+func (s *Service) StopLogDebugPods(nodeName, namespace string) error {
+	if err := s.ensureClient("Pods"); err != nil {
+		return err
+	}
+	ctx := s.requestContext()
+	existing, err := s.listLogDebugPods(ctx, nodeName, namespace)
+	if err != nil {
+		return err
+	}
+	zero := int64(0)
+	for _, pod := range existing {
+		err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{GracePeriodSeconds: &zero})
+		if err != nil {
+			return fmt.Errorf("failed to delete debug pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) listLogDebugPods(ctx context.Context, nodeName, namespace string) ([]corev1.Pod, error) {
+	list, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{nodeLogDebugPurposeLabel: nodeLogDebugPurposeValue}).String(),
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list debug pods: %w", err)
+	}
+	pods := make([]corev1.Pod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		// The fake clientset ignores field selectors, so check the node here too.
+		if IsLogDebugPod(&pod, nodeName) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// waitForLogDebugPod polls until the pod runs, failing early when it ends.
+func (s *Service) waitForLogDebugPod(ctx context.Context, namespace, podName string) (*corev1.Pod, error) {
+	ticker := time.NewTicker(nodeLogDebugPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for debug pod %q to start", podName)
+		case <-ticker.C:
+			pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to poll debug pod status: %w", err)
+			}
+			switch pod.Status.Phase {
+			case corev1.PodRunning:
+				return pod, nil
+			case corev1.PodFailed, corev1.PodSucceeded:
+				return nil, fmt.Errorf("debug pod %q ended before it could be used: %s", podName, pod.Status.Message)
+			}
+		}
+	}
+}
+
+// newLogDebugPod builds the debug pod: pinned to the node, tolerating every
+// taint, and bounded by activeDeadlineSeconds so it cannot outlive its use.
+func newLogDebugPod(nodeName, namespace, image string) *corev1.Pod {
+	lifetime := int64(config.NodeLogDebugPodLifetime / time.Second)
+	zero := int64(0)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "node-logs-",
+			Namespace:    namespace,
+			Labels: map[string]string{
+				nodeLogDebugPurposeLabel: nodeLogDebugPurposeValue,
+				nodeLogDebugManagedByKey: nodeLogDebugManagedByName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:                      nodeName,
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         &lifetime,
+			TerminationGracePeriodSeconds: &zero,
+			Tolerations:                   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:    nodeLogDebugContainer,
+				Image:   image,
+				Command: []string{"sleep", strconv.FormatInt(lifetime, 10)},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "host-root",
+					MountPath: nodeLogDebugHostRoot,
+					ReadOnly:  true,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "host-root",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+				},
+			}},
+		},
+	}
+}
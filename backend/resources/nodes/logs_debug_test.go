@@ -0,0 +1,120 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/testsupport"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	cgotesting "k8s.io/client-go/testing"
+)
+
+func TestParseDebugPodLogSourcesKeepsServicesAndReadableFiles(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "node-logs-abc"}}
+	output := []byte("service:kubelet\nservice:containerd\n/host/var/log/syslog\n/host/var/log/messages.1.gz\n/host/var/log/pods/x.log\n/host/var/log/apt/history.log\n/etc/passwd\n")
+
+	response := ParseDebugPodLogSources(pod, output)
+
+	require.True(t, response.Supported)
+	paths := make([]string, 0, len(response.Sources))
+	for _, source := range response.Sources {
+		paths = append(paths, source.Path)
+	}
+	require.ElementsMatch(t, []string{
+		"debug:default/node-logs-abc/service:kubelet",
+		"debug:default/node-logs-abc/service:containerd",
+		"debug:default/node-logs-abc/syslog",
+		"debug:default/node-logs-abc/apt/history.log",
+	}, paths)
+	require.Equal(t, "debug pod / apt / history.log", response.Sources[0].Label)
+
+	empty := ParseDebugPodLogSources(pod, nil)
+	require.False(t, empty.Supported)
+	require.NotEmpty(t, empty.Reason)
+}
+
+func TestParseDebugPodLogSourceRejectsEscapes(t *testing.T) {
+	namespace, podName, source, err := ParseDebugPodLogSource("debug:default/node-logs-abc/service:kubelet")
+	require.NoError(t, err)
+	require.Equal(t, []string{"default", "node-logs-abc", "service:kubelet"}, []string{namespace, podName, source})
+
+	for _, path := range []string{"debug:default/pod", "debug:default/pod/../../etc/shadow", "debug://pod/syslog", "syslog"} {
+		_, _, _, err := ParseDebugPodLogSource(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestDebugPodLogCommandPassesSourcesAsArguments(t *testing.T) {
+	service := DebugPodLogCommand("service:kubelet", "2026-01-02T03:04:05Z", 1024)
+	require.Equal(t, []string{"kubelet", "1025", "--since=@1767323045"}, service[4:])
+
+	noSince := DebugPodLogCommand("service:kubelet", "", 0)
+	require.Equal(t, "", noSince[6])
+
+	file := DebugPodLogCommand("apt/history.log", "", 1024)
+	require.Equal(t, []string{"apt/history.log", "1025"}, file[4:])
+}
+
+func TestDebugPodLogResponseTruncatesAndRejectsBinary(t *testing.T) {
+	response := DebugPodLogResponse("debug:ns/pod/syslog", "syslog", []byte("one\ntwo\nthree\n"), 8)
+	require.True(t, response.Truncated)
+	require.Equal(t, "three\n", response.Content)
+
+	binary := DebugPodLogResponse("debug:ns/pod/syslog", "syslog", []byte{0, 1, 2}, 8)
+	require.NotEmpty(t, binary.Error)
+}
+
+func TestEnsureLogDebugPodReusesRunningPod(t *testing.T) {
+	running := newLogDebugPod("node-a", "default", "busybox")
+	running.Name = "node-logs-existing"
+	running.Status.Phase = corev1.PodRunning
+	client := fake.NewClientset(running)
+	service := NewService(testsupport.NewResourceDependencies(
+		testsupport.WithDepsContext(context.Background()),
+		testsupport.WithDepsKubeClient(client),
+	))
+
+	pod, err := service.EnsureLogDebugPod("node-a", "default", "busybox")
+	require.NoError(t, err)
+	require.Equal(t, "node-logs-existing", pod.Name)
+	for _, action := range client.Actions() {
+		require.NotEqual(t, "create", action.GetVerb())
+	}
+}
+
+func TestEnsureLogDebugPodCreatesPinnedPodAndWaits(t *testing.T) {
+	originalInterval := nodeLogDebugPollInterval
+	t.Cleanup(func() { nodeLogDebugPollInterval = originalInterval })
+	nodeLogDebugPollInterval = time.Millisecond
+
+	client := fake.NewClientset()
+	var created *corev1.Pod
+	client.PrependReactor("create", "pods", func(action cgotesting.Action) (bool, runtime.Object, error) {
+		created = action.(cgotesting.CreateAction).GetObject().(*corev1.Pod).DeepCopy()
+		created.Name = "node-logs-new"
+		return true, created, nil
+	})
+	client.PrependReactor("get", "pods", func(cgotesting.Action) (bool, runtime.Object, error) {
+		pod := created.DeepCopy()
+		pod.Status.Phase = corev1.PodRunning
+		return true, pod, nil
+	})
+	service := NewService(testsupport.NewResourceDependencies(
+		testsupport.WithDepsContext(context.Background()),
+		testsupport.WithDepsKubeClient(client),
+	))
+
+	pod, err := service.EnsureLogDebugPod("node-a", "default", "busybox")
+	require.NoError(t, err)
+	require.Equal(t, "node-logs-new", pod.Name)
+	require.Equal(t, "node-a", created.Spec.NodeName)
+	require.NotNil(t, created.Spec.ActiveDeadlineSeconds)
+	require.True(t, created.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+	require.True(t, IsLogDebugPod(pod, "node-a"))
+	require.False(t, IsLogDebugPod(pod, "node-b"))
+}
@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	restypes "github.com/luxury-yacht/app/backend/resources/types"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -471,10 +473,12 @@ func (s *Service) buildNodeDetails(node *corev1.Node, pods []corev1.Pod, nodeMet
 
 	for _, condition := range node.Status.Conditions {
 		details.Conditions = append(details.Conditions, NodeCondition{
-			Kind:    string(condition.Type),
-			Status:  string(condition.Status),
-			Reason:  condition.Reason,
-			Message: condition.Message,
+			Kind:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: formatConditionTime(condition.LastTransitionTime),
+			LastHeartbeatTime:  formatConditionTime(condition.LastHeartbeatTime),
 		})
 	}
 
@@ -489,6 +493,8 @@ func (s *Service) buildNodeDetails(node *corev1.Node, pods []corev1.Pod, nodeMet
 	details.Roles = deriveNodeRoles(node.Labels)
 	setNodeAddresses(details, node.Status.Addresses)
 	setNodeCapacity(details, node.Status.Capacity, node.Status.Allocatable)
+	details.Resources = buildNodeResources(node.Status.Capacity, node.Status.Allocatable)
+	details.Images, details.ImagesSizeBytes = buildNodeImages(node.Status.Images)
 	setNodeRequests(details, cpuRequests, cpuLimits, memRequests, memLimits)
 	setNodeUsage(details, nodeMetrics)
 	details.Kind = "node"
@@ -624,6 +630,76 @@ func setNodeCapacity(details *NodeDetails, capacity, allocatable corev1.Resource
 	}
 }
 
+// nodeResourceOrder puts the core resources first; the rest follow by name.
+var nodeResourceOrder = map[corev1.ResourceName]int{
+	corev1.ResourceCPU:              0,
+	corev1.ResourceMemory:           1,
+	corev1.ResourceEphemeralStorage: 2,
+	corev1.ResourcePods:             3,
+}
+
+func buildNodeResources(capacity, allocatable corev1.ResourceList) []NodeResource {
+	names := make([]corev1.ResourceName, 0, len(capacity))
+	for name := range capacity {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		left, leftCore := nodeResourceOrder[names[i]]
+		right, rightCore := nodeResourceOrder[names[j]]
+		if leftCore != rightCore {
+			return leftCore
+		}
+		if leftCore {
+			return left < right
+		}
+		return names[i] < names[j]
+	})
+
+	resources := make([]NodeResource, 0, len(names))
+	for _, name := range names {
+		total := capacity[name]
+		entry := NodeResource{Name: string(name), Capacity: formatNodeQuantity(name, total)}
+		if offered, ok := allocatable[name]; ok {
+			entry.Allocatable = formatNodeQuantity(name, offered)
+			reserved := total.DeepCopy()
+			reserved.Sub(offered)
+			if reserved.Sign() > 0 {
+				entry.Reserved = formatNodeQuantity(name, reserved)
+			}
+		}
+		resources = append(resources, entry)
+	}
+	return resources
+}
+
+// formatNodeQuantity shows byte-valued resources in the same units as the
+// memory and storage summaries, and everything else as Kubernetes writes it.
+func formatNodeQuantity(name corev1.ResourceName, quantity resource.Quantity) string {
+	if name == corev1.ResourceMemory || name == corev1.ResourceEphemeralStorage ||
+		strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix) {
+		return formatMemoryBytes(quantity.Value())
+	}
+	return quantity.String()
+}
+
+func buildNodeImages(images []corev1.ContainerImage) ([]NodeImage, int64) {
+	result := make([]NodeImage, 0, len(images))
+	var total int64
+	for _, image := range images {
+		total += image.SizeBytes
+		result = append(result, NodeImage{Names: append([]string(nil), image.Names...), SizeBytes: image.SizeBytes})
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].SizeBytes > result[j].SizeBytes })
+	return result, total
+}
+
+func formatConditionTime(value metav1.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
 func setNodeRequests(details *NodeDetails, cpuRequests, cpuLimits, memRequests, memLimits int64) {
 	if cpuRequests > 0 {
 		details.CPURequests = fmt.Sprintf("%dm", cpuRequests)
@@ -686,6 +762,10 @@ func (s *Service) logInfo(msg string) {
 	applog.Info(s.deps.Logger, msg, "NodeOperations")
 }
 
+func (s *Service) logWarn(msg string) {
+	applog.Warn(s.deps.Logger, msg, "NodeOperations")
+}
+
 func (s *Service) logError(msg string) {
 	applog.Error(s.deps.Logger, msg, "NodeOperations")
 }
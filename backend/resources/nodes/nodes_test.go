@@ -48,6 +48,45 @@ func TestServiceNodeReturnsDetails(t *testing.T) {
 	require.Equal(t, int32(1), detail.Restarts)
 }
 
+func TestServiceNodeDetailsIncludeResourcesImagesAndConditionTimes(t *testing.T) {
+	service, client, node := newNodeService(t)
+	current, err := client.CoreV1().Nodes().Get(context.Background(), node.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	transition := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	current.Status.Conditions = append(current.Status.Conditions, corev1.NodeCondition{
+		Type:               corev1.NodeDiskPressure,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: transition,
+	})
+	current.Status.Capacity["nvidia.com/gpu"] = resource.MustParse("2")
+	current.Status.Allocatable["nvidia.com/gpu"] = resource.MustParse("2")
+	current.Status.Images = []corev1.ContainerImage{
+		{Names: []string{"busybox:1.36"}, SizeBytes: 4 << 20},
+		{Names: []string{"nginx@sha256:abc", "nginx:1.27"}, SizeBytes: 180 << 20},
+	}
+	_, err = client.CoreV1().Nodes().UpdateStatus(context.Background(), current, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	detail, err := service.Node(node.Name)
+	require.NoError(t, err)
+
+	require.Equal(t, []nodes.NodeResource{
+		{Name: "cpu", Capacity: "8", Allocatable: "7", Reserved: "1"},
+		{Name: "memory", Capacity: "16.0 GB", Allocatable: "15.0 GB", Reserved: "1.0 GB"},
+		{Name: "pods", Capacity: "110", Allocatable: "100", Reserved: "10"},
+		{Name: "nvidia.com/gpu", Capacity: "2", Allocatable: "2"},
+	}, detail.Resources)
+
+	require.Len(t, detail.Images, 2)
+	require.Equal(t, []string{"nginx@sha256:abc", "nginx:1.27"}, detail.Images[0].Names)
+	require.Equal(t, int64(184<<20), detail.ImagesSizeBytes)
+
+	require.Len(t, detail.Conditions, 2)
+	require.Equal(t, "DiskPressure", detail.Conditions[1].Kind)
+	require.Equal(t, "2026-03-01T12:00:00Z", detail.Conditions[1].LastTransitionTime)
+	require.Empty(t, detail.Conditions[0].LastTransitionTime)
+}
+
 func TestServiceNodeStatusUsesSharedResourceModel(t *testing.T) {
 	service, client, node := newNodeService(t)
 	current, err := client.CoreV1().Nodes().Get(context.Background(), node.Name, metav1.GetOptions{})
@@ -49,7 +49,17 @@ func (s *Service) PersistentVolumeClaim(namespace, name string) (*PersistentVolu
 	}
 
 	pods := s.listNamespacePods(namespace)
-	return s.processPersistentVolumeClaimDetails(pvc, pods), nil
+	details := s.processPersistentVolumeClaimDetails(pvc, pods)
+	class, err := s.storageClass(pvc)
+	if err != nil {
+		// Without the class the expansion pre-check cannot run; leave it unset
+		// rather than report the claim as not resizable.
+		s.deps.Logger.Warn(err.Error(), logsources.ResourceLoader)
+	} else {
+		expansion := CheckExpansion(pvc, class)
+		details.Expansion = &expansion
+	}
+	return details, nil
 }
 
 func (s *Service) processPersistentVolumeClaimDetails(pvc *corev1.PersistentVolumeClaim, pods *corev1.PodList) *PersistentVolumeClaimDetails {
@@ -25,6 +25,8 @@ type PersistentVolumeClaimDetails struct {
 	Labels       map[string]string    `json:"labels,omitempty"`
 	Annotations  map[string]string    `json:"annotations,omitempty"`
 	MountedBy    []restypes.ObjectRef `json:"mountedBy,omitempty"`
+	// Expansion is unset when the StorageClass could not be read.
+	Expansion *ExpansionInfo `json:"expansion,omitempty"`
 }
 
 // DataSourceInfo represents the data source of a PVC.
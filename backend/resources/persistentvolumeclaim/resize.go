@@ -0,0 +1,207 @@
+/*
+ * backend/resources/persistentvolumeclaim/resize.go
+ *
+ * PVC volume expansion: the pre-checks against the StorageClass, the
+ * spec.resources.requests.storage patch, and the in-flight resize progress the
+ * expansion controller and kubelet report through PVC conditions.
+ */
+
+package persistentvolumeclaim
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Resize states, from the PVC conditions and status.allocatedResourceStatuses.
+const (
+	// ResizeRequested means spec asks for more than status.capacity but no
+	// controller has picked the request up yet.
+	ResizeRequested = "Requested"
+	// ResizeInProgress is the Resizing condition: the volume itself is being
+	// expanded by the CSI controller.
+	ResizeInProgress = "Resizing"
+	// ResizeFileSystemPending is the FileSystemResizePending condition: the
+	// volume has grown and the kubelet expands the file system the next time a
+	// pod mounts it.
+	ResizeFileSystemPending = "FileSystemResizePending"
+	// ResizeFailed covers the controller and node resize error conditions and
+	// infeasible allocated-resource statuses.
+	ResizeFailed = "Failed"
+)
+
+// ExpansionInfo reports whether a PVC can be resized and what resize, if any,
+// is in flight.
+type ExpansionInfo struct {
+	Allowed bool `json:"allowed"`
+	// Reason explains why Allowed is false.
+	Reason   string          `json:"reason,omitempty"`
+	Progress *ResizeProgress `json:"progress,omitempty"`
+}
+
+// ResizeProgress is an in-flight or failed resize.
+type ResizeProgress struct {
+	State     string `json:"state"`
+	Message   string `json:"message,omitempty"`
+	Requested string `json:"requested"`
+	Capacity  string `json:"capacity,omitempty"`
+}
+
+// ResizeResult is the outcome of a resize request.
+type ResizeResult struct {
+	ClusterID string `json:"clusterId"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Previous  string `json:"previous"`
+	Requested string `json:"requested"`
+}
+
+// CheckExpansion reports whether pvc may be expanded under class. A nil class
+// means the PVC names no StorageClass, or the named class does not exist.
+func CheckExpansion(pvc *corev1.PersistentVolumeClaim, class *storagev1.StorageClass) ExpansionInfo {
+	info := ExpansionInfo{Progress: BuildResizeProgress(pvc)}
+	switch {
+	case pvc.Status.Phase != corev1.ClaimBound:
+		info.Reason = fmt.Sprintf("only Bound claims can be resized (phase is %s)", pvcState(pvc))
+	case storageClassName(pvc) == "":
+		info.Reason = "the claim has no StorageClass"
+	case class == nil:
+		info.Reason = fmt.Sprintf("StorageClass %q not found", storageClassName(pvc))
+	case class.AllowVolumeExpansion == nil || !*class.AllowVolumeExpansion:
+		info.Reason = fmt.Sprintf("StorageClass %q does not allow volume expansion", class.Name)
+	default:
+		info.Allowed = true
+	}
+	return info
+}
+
+// BuildResizeProgress derives the resize in flight from the PVC's conditions,
+// falling back to status.allocatedResourceStatuses and to comparing the
+// requested size with the current capacity. It returns nil when no resize is
+// pending.
+func BuildResizeProgress(pvc *corev1.PersistentVolumeClaim) *ResizeProgress {
+	if pvc == nil {
+		return nil
+	}
+	requested, hasRequest := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity, hasCapacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	progress := &ResizeProgress{}
+	if hasRequest {
+		progress.Requested = requested.String()
+	}
+	if hasCapacity {
+		progress.Capacity = capacity.String()
+	}
+
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case corev1.PersistentVolumeClaimControllerResizeError, corev1.PersistentVolumeClaimNodeResizeError:
+			progress.State, progress.Message = ResizeFailed, condition.Message
+			return progress
+		case corev1.PersistentVolumeClaimFileSystemResizePending:
+			progress.State, progress.Message = ResizeFileSystemPending, condition.Message
+		case corev1.PersistentVolumeClaimResizing:
+			if progress.State == "" {
+				progress.State, progress.Message = ResizeInProgress, condition.Message
+			}
+		}
+	}
+	if progress.State != "" {
+		return progress
+	}
+
+	switch pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage] {
+	case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+		progress.State = ResizeFailed
+	case corev1.PersistentVolumeClaimControllerResizeInProgress:
+		progress.State = ResizeInProgress
+	case corev1.PersistentVolumeClaimNodeResizePending, corev1.PersistentVolumeClaimNodeResizeInProgress:
+		progress.State = ResizeFileSystemPending
+	default:
+		if !hasRequest || !hasCapacity || requested.Cmp(capacity) <= 0 {
+			return nil
+		}
+		progress.State = ResizeRequested
+	}
+	return progress
+}
+
+// Resize raises the PVC's storage request to size after checking that the
+// claim is bound, its StorageClass allows expansion, and size is larger than
+// the current request. The patch is pinned to the resourceVersion the checks
+// ran against.
+func (s *Service) Resize(namespace, name, size string) (*ResizeResult, error) {
+	if s.deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	target, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q: %v", size, err)
+	}
+
+	pvcs := s.deps.KubernetesClient.CoreV1().PersistentVolumeClaims(namespace)
+	pvc, err := pvcs.Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PVC: %v", err)
+	}
+	class, err := s.storageClass(pvc)
+	if err != nil {
+		return nil, err
+	}
+	if info := CheckExpansion(pvc, class); !info.Allowed {
+		return nil, fmt.Errorf("cannot resize PVC %s/%s: %s", namespace, name, info.Reason)
+	}
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if target.Cmp(current) <= 0 {
+		return nil, fmt.Errorf("cannot resize PVC %s/%s: new size %s must be larger than the current request %s", namespace, name, target.String(), current.String())
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": pvc.ResourceVersion},
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{string(corev1.ResourceStorage): target.String()},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pvcs.Patch(s.deps.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to resize PVC: %v", err)
+	}
+	return &ResizeResult{
+		ClusterID: s.deps.ClusterID,
+		Namespace: namespace,
+		Name:      name,
+		Previous:  current.String(),
+		Requested: target.String(),
+	}, nil
+}
+
+// storageClass fetches the PVC's StorageClass; it returns nil without an error
+// when the PVC names none or the class does not exist.
+func (s *Service) storageClass(pvc *corev1.PersistentVolumeClaim) (*storagev1.StorageClass, error) {
+	className := storageClassName(pvc)
+	if className == "" {
+		return nil, nil
+	}
+	class, err := s.deps.KubernetesClient.StorageV1().StorageClasses().Get(s.deps.Context, className, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get StorageClass %s: %v", className, err)
+	}
+	return class, nil
+}
@@ -0,0 +1,118 @@
+/*
+ * backend/resources/persistentvolumeclaim/resize_test.go
+ *
+ * Tests for PVC expansion pre-checks, the resize patch and resize progress.
+ */
+
+package persistentvolumeclaim_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/resources/persistentvolumeclaim"
+	"github.com/luxury-yacht/app/backend/testsupport"
+)
+
+func TestServiceResizeChecksStorageClassAndSize(t *testing.T) {
+	client := fake.NewClientset(
+		testsupport.PersistentVolumeClaimFixture("default", "data"),
+		testsupport.PersistentVolumeClaimFixture("default", "fixed", func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.Spec.StorageClassName = ptr.To("fixed")
+		}),
+		testsupport.PersistentVolumeClaimFixture("default", "pending", func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.Status.Phase = corev1.ClaimPending
+		}),
+		testsupport.StorageClassFixture("standard"),
+		testsupport.StorageClassFixture("fixed", func(sc *storagev1.StorageClass) {
+			sc.AllowVolumeExpansion = nil
+		}),
+	)
+	service := newService(t, client)
+
+	_, err := service.Resize("default", "fixed", "10Gi")
+	require.ErrorContains(t, err, `StorageClass "fixed" does not allow volume expansion`)
+	_, err = service.Resize("default", "pending", "10Gi")
+	require.ErrorContains(t, err, "only Bound claims can be resized")
+	_, err = service.Resize("default", "data", "5Gi")
+	require.ErrorContains(t, err, "must be larger than the current request 5Gi")
+	_, err = service.Resize("default", "data", "lots")
+	require.ErrorContains(t, err, "invalid size")
+
+	result, err := service.Resize("default", "data", "10Gi")
+	require.NoError(t, err)
+	require.Equal(t, "10Gi", result.Requested)
+	pvc, err := client.CoreV1().PersistentVolumeClaims("default").Get(context.Background(), "data", metav1.GetOptions{})
+	require.NoError(t, err)
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	require.Equal(t, "10Gi", requested.String())
+}
+
+func TestServicePersistentVolumeClaimReportsExpansion(t *testing.T) {
+	client := fake.NewClientset(
+		testsupport.PersistentVolumeClaimFixture("default", "data"),
+		testsupport.StorageClassFixture("standard"),
+		testsupport.PersistentVolumeClaimFixture("default", "orphan", func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.Spec.StorageClassName = ptr.To("missing")
+		}),
+	)
+	service := newService(t, client)
+
+	detail, err := service.PersistentVolumeClaim("default", "data")
+	require.NoError(t, err)
+	require.Equal(t, &persistentvolumeclaim.ExpansionInfo{Allowed: true}, detail.Expansion)
+
+	detail, err = service.PersistentVolumeClaim("default", "orphan")
+	require.NoError(t, err)
+	require.False(t, detail.Expansion.Allowed)
+	require.Equal(t, `StorageClass "missing" not found`, detail.Expansion.Reason)
+}
+
+func TestBuildResizeProgress(t *testing.T) {
+	// grown requests 10Gi against the fixture's 5Gi capacity.
+	grown := func(opts ...testsupport.PersistentVolumeClaimOption) *corev1.PersistentVolumeClaim {
+		pvc := testsupport.PersistentVolumeClaimFixture("default", "data", opts...)
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("10Gi")
+		return pvc
+	}
+	condition := func(conditionType corev1.PersistentVolumeClaimConditionType, message string) testsupport.PersistentVolumeClaimOption {
+		return func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.Status.Conditions = append(pvc.Status.Conditions, corev1.PersistentVolumeClaimCondition{
+				Type: conditionType, Status: corev1.ConditionTrue, Message: message,
+			})
+		}
+	}
+
+	require.Nil(t, persistentvolumeclaim.BuildResizeProgress(testsupport.PersistentVolumeClaimFixture("default", "data")))
+	require.Equal(t, &persistentvolumeclaim.ResizeProgress{
+		State: persistentvolumeclaim.ResizeRequested, Requested: "10Gi", Capacity: "5Gi",
+	}, persistentvolumeclaim.BuildResizeProgress(grown()))
+	require.Equal(t, persistentvolumeclaim.ResizeInProgress,
+		persistentvolumeclaim.BuildResizeProgress(grown(condition(corev1.PersistentVolumeClaimResizing, ""))).State)
+
+	pending := persistentvolumeclaim.BuildResizeProgress(grown(
+		condition(corev1.PersistentVolumeClaimResizing, ""),
+		condition(corev1.PersistentVolumeClaimFileSystemResizePending, "Waiting for user to (re-)start a pod to finish file system resize of volume on node."),
+	))
+	require.Equal(t, persistentvolumeclaim.ResizeFileSystemPending, pending.State)
+	require.Contains(t, pending.Message, "re-)start a pod")
+
+	failed := persistentvolumeclaim.BuildResizeProgress(grown(condition(corev1.PersistentVolumeClaimControllerResizeError, "quota exceeded")))
+	require.Equal(t, persistentvolumeclaim.ResizeFailed, failed.State)
+	require.Equal(t, "quota exceeded", failed.Message)
+
+	row := persistentvolumeclaim.BuildStreamSummary(streamrows.ClusterMeta{ClusterID: "cluster-a"}, grown(
+		condition(corev1.PersistentVolumeClaimFileSystemResizePending, "restart a pod"),
+	))
+	require.Equal(t, persistentvolumeclaim.ResizeFileSystemPending, row.ResizeState)
+	require.Equal(t, "restart a pod", row.ResizeMessage)
+}
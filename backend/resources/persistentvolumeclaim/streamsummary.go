@@ -19,7 +19,7 @@ func BuildStreamSummary(meta streamrows.ClusterMeta, pvc *corev1.PersistentVolum
 		return streamrows.StorageSummary{}
 	}
 	model := BuildResourceModel(meta.ClusterID, pvc)
-	return streamrows.StorageSummary{
+	summary := streamrows.StorageSummary{
 		Ref:                model.Ref,
 		Capacity:           streamCapacity(pvc),
 		Status:             model.Status.Label,
@@ -30,6 +30,11 @@ func BuildStreamSummary(meta streamrows.ClusterMeta, pvc *corev1.PersistentVolum
 		Age:                streamrows.FormatAge(pvc.CreationTimestamp.Time),
 		AgeTimestamp:       streamrows.CreationMillis(pvc),
 	}
+	if progress := BuildResizeProgress(pvc); progress != nil {
+		summary.ResizeState = progress.State
+		summary.ResizeMessage = progress.Message
+	}
+	return summary
 }
 
 // streamCapacity is the PVC's storage capacity (status, else requested, else "-").
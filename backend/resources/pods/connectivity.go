@@ -0,0 +1,212 @@
+/*
+ * backend/resources/pods/connectivity.go
+ *
+ * In-cluster connectivity checks.
+ * - StartConnectivityCheckPod runs a short-lived pod that only sleeps; the
+ *   check itself is an exec into it, so its output streams back through the
+ *   shell session plumbing.
+ * - ConnectivityCheckCommand builds that exec: curl (or wget) for HTTP, nc
+ *   for TCP, after a DNS lookup of the target.
+ * - DNS lookups (dns.go) run in the same kind of pod.
+ */
+
+package pods
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luxury-yacht/app/backend/internal/config"
+	"github.com/luxury-yacht/app/backend/resources/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	connectivityCheckContainer     = "check"
+	connectivityCheckPurposeLabel  = "luxury-yacht.io/purpose"
+	connectivityCheckPurposeValue  = "connectivity-check"
+	connectivityCheckManagedByKey  = "app.kubernetes.io/managed-by"
+	connectivityCheckManagedByName = "luxury-yacht"
+
+	// Connectivity check protocols.
+	ConnectivityProtocolTCP  = "tcp"
+	ConnectivityProtocolHTTP = "http"
+)
+
+var (
+	// connectivityCheckPollInterval controls how often the check pod's phase
+	// is polled. Tests can override this.
+	connectivityCheckPollInterval = config.DebugContainerPollInterval
+	// connectivityCheckStartTimeout bounds the wait for the check pod to run.
+	// Tests can override this.
+	connectivityCheckStartTimeout = config.ConnectivityCheckPodStartTimeout
+)
+
+// connectivityCheckScript looks the host up, then probes it. Arguments are
+// protocol, host, port, timeout seconds and HTTP path; they are passed as
+// positional parameters, never spliced into the script. Images without
+// nslookup, curl or nc fall back to what busybox ships.
+const connectivityCheckScript = `proto=$1 host=$2 port=$3 timeout=$4 path=$5
+echo "Connectivity check from $(hostname) to $host:$port ($proto)"
+echo
+if command -v nslookup >/dev/null 2>&1; then
+  echo "== DNS"
+  nslookup "$host" 2>&1
+  echo
+fi
+echo "== Probe"
+if [ "$proto" = http ]; then
+  url="http://$host:$port$path"
+  if command -v curl >/dev/null 2>&1; then
+    curl -sS -o /dev/null --max-time "$timeout" -w 'HTTP %{http_code} from %{remote_ip} in %{time_total}s (connect %{time_connect}s)\n' "$url"
+  else
+    wget -S -O /dev/null -T "$timeout" "$url" 2>&1
+  fi
+else
+  nc -v -w "$timeout" "$host" "$port" </dev/null 2>&1
+fi
+status=$?
+echo
+if [ $status -eq 0 ]; then echo "RESULT: reachable"; else echo "RESULT: unreachable (exit $status)"; fi
+exit $status`
+
+// ConnectivityCheckCommand validates req and returns the exec command that
+// probes its target.
+func ConnectivityCheckCommand(req types.ConnectivityCheckRequest) ([]string, error) {
+	host := strings.TrimSpace(req.Host)
+	if host == "" || strings.ContainsAny(host, " \t\n/") {
+		return nil, fmt.Errorf("a target host (Service name, DNS name or IP) is required")
+	}
+	if req.Port < 1 || req.Port > 65535 {
+		return nil, fmt.Errorf("port must be between 1 and 65535")
+	}
+	protocol := strings.ToLower(strings.TrimSpace(req.Protocol))
+	if protocol == "" {
+		protocol = ConnectivityProtocolTCP
+	}
+	if protocol != ConnectivityProtocolTCP && protocol != ConnectivityProtocolHTTP {
+		return nil, fmt.Errorf("protocol must be %q or %q", ConnectivityProtocolTCP, ConnectivityProtocolHTTP)
+	}
+	path := strings.TrimSpace(req.Path)
+	if path == "" {
+		path = "/"
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	timeout := req.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = int(config.ConnectivityCheckDefaultTimeout / time.Second)
+	}
+	return []string{
+		"/bin/sh", "-c", connectivityCheckScript, "sh",
+		protocol, host, strconv.Itoa(req.Port), strconv.Itoa(timeout), path,
+	}, nil
+}
+
+// IsConnectivityCheckPod reports whether pod was started for a connectivity check.
+func IsConnectivityCheckPod(pod *corev1.Pod) bool {
+	return pod != nil && pod.Labels[connectivityCheckPurposeLabel] == connectivityCheckPurposeValue
+}
+
+// StartConnectivityCheckPod creates a check pod in namespace from image and
+// waits for it to run. The pod removes itself after
+// config.ConnectivityCheckPodLifetime if it is never deleted.
+func (s *Service) StartConnectivityCheckPod(namespace, image string) (*corev1.Pod, error) {
+	if s.deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	if strings.TrimSpace(namespace) == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if strings.TrimSpace(image) == "" {
+		return nil, fmt.Errorf("image is required")
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx(), connectivityCheckStartTimeout)
+	defer cancel()
+
+	pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Create(ctx, newConnectivityCheckPod(namespace, strings.TrimSpace(image)), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connectivity check pod: %w", err)
+	}
+	running, err := s.waitForConnectivityCheckPod(ctx, namespace, pod.Name)
+	if err != nil {
+		// Don't leave a pod behind that never became usable.
+		_ = s.DeleteConnectivityCheckPod(namespace, pod.Name)
+		return nil, err
+	}
+	return running, nil
+}
+
+// DeleteConnectivityCheckPod removes a check pod without a grace period.
+func (s *Service) DeleteConnectivityCheckPod(namespace, name string) error {
+	if s.deps.KubernetesClient == nil {
+		return fmt.Errorf("kubernetes client not initialized")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.ResourceFetchCallTimeout)
+	defer cancel()
+	zero := int64(0)
+	if err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{GracePeriodSeconds: &zero}); err != nil {
+		return fmt.Errorf("failed to delete connectivity check pod %s: %w", name, err)
+	}
+	return nil
+}
+
+// waitForConnectivityCheckPod polls until the pod runs, failing early when it ends.
+func (s *Service) waitForConnectivityCheckPod(ctx context.Context, namespace, podName string) (*corev1.Pod, error) {
+	ticker := time.NewTicker(connectivityCheckPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for connectivity check pod %q to start", podName)
+		case <-ticker.C:
+			pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to poll connectivity check pod status: %w", err)
+			}
+			switch pod.Status.Phase {
+			case corev1.PodRunning:
+				return pod, nil
+			case corev1.PodFailed, corev1.PodSucceeded:
+				return nil, fmt.Errorf("connectivity check pod %q ended before it could be used: %s", podName, pod.Status.Message)
+			}
+		}
+	}
+}
+
+// newConnectivityCheckPod builds the check pod: a single container that sleeps
+// for its lifetime, bounded by activeDeadlineSeconds so it cannot outlive it.
+func newConnectivityCheckPod(namespace, image string) *corev1.Pod {
+	lifetime := int64(config.ConnectivityCheckPodLifetime / time.Second)
+	zero := int64(0)
+	automountToken := false
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "connectivity-check-" + uuid.NewString()[:8],
+			Namespace: namespace,
+			Labels: map[string]string{
+				connectivityCheckPurposeLabel: connectivityCheckPurposeValue,
+				connectivityCheckManagedByKey: connectivityCheckManagedByName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         &lifetime,
+			TerminationGracePeriodSeconds: &zero,
+			AutomountServiceAccountToken:  &automountToken,
+			Containers: []corev1.Container{{
+				Name:    connectivityCheckContainer,
+				Image:   image,
+				Command: []string{"sleep", strconv.FormatInt(lifetime, 10)},
+			}},
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package pods
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/applog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	"github.com/luxury-yacht/app/backend/resources/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestConnectivityCheckCommand(t *testing.T) {
+	command, err := ConnectivityCheckCommand(types.ConnectivityCheckRequest{Host: "web.prod", Port: 8080, Protocol: "HTTP", Path: "healthz"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh", "-c", connectivityCheckScript, "sh", "http", "web.prod", "8080", "5", "/healthz"}, command)
+
+	command, err = ConnectivityCheckCommand(types.ConnectivityCheckRequest{Host: "10.0.0.7", Port: 5432, TimeoutSeconds: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tcp", "10.0.0.7", "5432", "2", "/"}, command[4:])
+
+	for _, req := range []types.ConnectivityCheckRequest{
+		{Port: 80},
+		{Host: "web; rm -rf /", Port: 80},
+		{Host: "web", Port: 0},
+		{Host: "web", Port: 80, Protocol: "udp"},
+	} {
+		_, err := ConnectivityCheckCommand(req)
+		require.Error(t, err, "%+v", req)
+	}
+}
+
+// connectivityPhaseReactor reports every polled pod in the given phase.
+func connectivityPhaseReactor(client *fake.Clientset, phase corev1.PodPhase) {
+	client.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		getAction := action.(k8stesting.GetAction)
+		obj, err := client.Tracker().Get(corev1.SchemeGroupVersion.WithResource("pods"), getAction.GetNamespace(), getAction.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		pod := obj.(*corev1.Pod).DeepCopy()
+		pod.Status.Phase = phase
+		return true, pod, nil
+	})
+}
+
+func newConnectivityTestService(t *testing.T, phase corev1.PodPhase) (*Service, *fake.Clientset) {
+	t.Helper()
+	oldInterval, oldTimeout := connectivityCheckPollInterval, connectivityCheckStartTimeout
+	connectivityCheckPollInterval, connectivityCheckStartTimeout = 5*time.Millisecond, time.Second
+	t.Cleanup(func() {
+		connectivityCheckPollInterval, connectivityCheckStartTimeout = oldInterval, oldTimeout
+	})
+	client := fake.NewClientset()
+	connectivityPhaseReactor(client, phase)
+	return NewService(common.Dependencies{Context: context.Background(), Logger: applog.Noop, KubernetesClient: client}), client
+}
+
+func TestStartConnectivityCheckPod(t *testing.T) {
+	svc, client := newConnectivityTestService(t, corev1.PodRunning)
+
+	pod, err := svc.StartConnectivityCheckPod("team-a", "busybox:latest")
+	require.NoError(t, err)
+	require.True(t, IsConnectivityCheckPod(pod))
+	require.Equal(t, "busybox:latest", pod.Spec.Containers[0].Image)
+	require.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	require.NotNil(t, pod.Spec.ActiveDeadlineSeconds)
+	require.False(t, *pod.Spec.AutomountServiceAccountToken)
+
+	require.NoError(t, svc.DeleteConnectivityCheckPod("team-a", pod.Name))
+	list, err := client.CoreV1().Pods("team-a").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, list.Items)
+
+	_, err = svc.StartConnectivityCheckPod("team-a", " ")
+	require.ErrorContains(t, err, "image is required")
+}
+
+func TestStartConnectivityCheckPodRemovesFailedPod(t *testing.T) {
+	svc, client := newConnectivityTestService(t, corev1.PodFailed)
+
+	_, err := svc.StartConnectivityCheckPod("team-a", "missing:image")
+	require.ErrorContains(t, err, "ended before it could be used")
+	list, err := client.CoreV1().Pods("team-a").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, list.Items)
+}
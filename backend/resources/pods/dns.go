@@ -0,0 +1,215 @@
+/*
+ * backend/resources/pods/dns.go
+ *
+ * In-cluster DNS lookups.
+ * - DNSLookupCommand prints the pod's /etc/resolv.conf and runs nslookup,
+ *   separating the two with "@@" markers.
+ * - ParseDNSLookup turns that output into a structured answer, including
+ *   the names the resolver tries for the pod's search list and ndots, which
+ *   is what usually explains a "works with the FQDN only" failure.
+ */
+
+package pods
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// dnsLookupScript always exits 0 so a failed lookup is reported in the output
+// rather than as a failed exec; nslookup's status follows "@@ exit".
+const dnsLookupScript = `echo "@@ resolv.conf"
+cat /etc/resolv.conf 2>/dev/null
+echo "@@ nslookup"
+nslookup "$1" 2>&1
+echo "@@ exit $?"
+exit 0`
+
+// defaultNdots is the resolver's ndots when resolv.conf does not set it.
+const defaultNdots = 1
+
+// DNSAnswer is one resolved record.
+type DNSAnswer struct {
+	Name string `json:"name"`
+	// Type is A, AAAA or CNAME.
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// DNSLookup is the outcome of resolving one name from inside the cluster.
+type DNSLookup struct {
+	Name string `json:"name"`
+	// Nameservers, Search and Ndots are read from the probe pod's resolv.conf.
+	Nameservers []string `json:"nameservers"`
+	Search      []string `json:"search"`
+	Ndots       int      `json:"ndots"`
+	// Candidates are the names the resolver tries, in order.
+	Candidates []string `json:"candidates"`
+	// Server is the DNS server nslookup reports answering.
+	Server   string      `json:"server,omitempty"`
+	Answers  []DNSAnswer `json:"answers"`
+	Resolved bool        `json:"resolved"`
+	// Error carries nslookup's failure lines when the name did not resolve.
+	Error string `json:"error,omitempty"`
+	// Output is nslookup's raw output.
+	Output string `json:"output"`
+}
+
+// DNSLookupCommand validates name and returns the exec command that resolves it.
+func DNSLookupCommand(name string) ([]string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, " \t\n/") {
+		return nil, fmt.Errorf("a DNS name is required")
+	}
+	return []string{"/bin/sh", "-c", dnsLookupScript, "sh", name}, nil
+}
+
+// ParseDNSLookup parses the output of DNSLookupCommand for name.
+func ParseDNSLookup(name string, output []byte) (DNSLookup, error) {
+	sections := make(map[string][]string)
+	current := ""
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if marker, ok := strings.CutPrefix(line, "@@ "); ok {
+			current = marker
+			sections[current] = nil
+			continue
+		}
+		if current != "" {
+			sections[current] = append(sections[current], line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return DNSLookup{}, err
+	}
+	if _, ok := sections["nslookup"]; !ok {
+		return DNSLookup{}, fmt.Errorf("unexpected lookup output: %q", truncateDNSOutput(output))
+	}
+
+	lookup := DNSLookup{
+		Name:        strings.TrimSpace(name),
+		Nameservers: []string{},
+		Search:      []string{},
+		Ndots:       defaultNdots,
+		Answers:     []DNSAnswer{},
+		Output:      strings.TrimSpace(strings.Join(sections["nslookup"], "\n")),
+	}
+	parseResolvConf(&lookup, sections["resolv.conf"])
+	lookup.Candidates = resolverCandidates(lookup.Name, lookup.Search, lookup.Ndots)
+	failures := parseNslookup(&lookup, sections["nslookup"])
+	lookup.Resolved = len(lookup.Answers) > 0
+	if !lookup.Resolved {
+		lookup.Error = strings.Join(failures, "; ")
+		switch {
+		case lookup.Error != "":
+		case lookup.Server == "" && lookup.Output != "":
+			// nslookup never reached a server, e.g. the image lacks it.
+			lookup.Error = lookup.Output
+		default:
+			lookup.Error = "no records returned"
+		}
+	}
+	return lookup, nil
+}
+
+func parseResolvConf(lookup *DNSLookup, lines []string) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			lookup.Nameservers = append(lookup.Nameservers, fields[1])
+		case "search":
+			lookup.Search = append(lookup.Search[:0], fields[1:]...)
+		case "options":
+			for _, option := range fields[1:] {
+				if value, ok := strings.CutPrefix(option, "ndots:"); ok {
+					if ndots, err := strconv.Atoi(value); err == nil {
+						lookup.Ndots = ndots
+					}
+				}
+			}
+		}
+	}
+}
+
+// resolverCandidates returns the names a glibc-style resolver queries: a
+// trailing dot means the name alone; fewer dots than ndots tries the search
+// domains first; otherwise the name is tried as-is first.
+func resolverCandidates(name string, search []string, ndots int) []string {
+	if strings.HasSuffix(name, ".") {
+		return []string{name}
+	}
+	expanded := make([]string, 0, len(search)+1)
+	for _, domain := range search {
+		expanded = append(expanded, name+"."+domain)
+	}
+	if strings.Count(name, ".") >= ndots {
+		return append([]string{name}, expanded...)
+	}
+	return append(expanded, name)
+}
+
+// parseNslookup reads answers from busybox or BIND nslookup output and returns
+// its failure lines. Address lines before the first Name: belong to the server.
+func parseNslookup(lookup *DNSLookup, lines []string) []string {
+	var failures []string
+	answerName := ""
+	add := func(answer DNSAnswer) {
+		if !slices.Contains(lookup.Answers, answer) {
+			lookup.Answers = append(lookup.Answers, answer)
+		}
+	}
+	for _, raw := range lines {
+		line := strings.TrimSpace(raw)
+		lower := strings.ToLower(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "Server:"):
+			lookup.Server = strings.TrimSpace(strings.TrimPrefix(line, "Server:"))
+		case strings.Contains(line, "canonical name ="):
+			alias, target, _ := strings.Cut(line, "canonical name =")
+			answerName = strings.TrimSpace(target)
+			add(DNSAnswer{Name: strings.TrimSpace(alias), Type: "CNAME", Value: strings.TrimSuffix(answerName, ".")})
+		case strings.HasPrefix(line, "Name:"):
+			answerName = strings.TrimSpace(strings.TrimPrefix(line, "Name:"))
+		case strings.HasPrefix(line, "Address"):
+			_, value, ok := strings.Cut(line, ":")
+			fields := strings.Fields(value)
+			if !ok || answerName == "" || len(fields) == 0 {
+				continue
+			}
+			ip := net.ParseIP(fields[0])
+			if ip == nil {
+				continue
+			}
+			recordType := "A"
+			if ip.To4() == nil {
+				recordType = "AAAA"
+			}
+			add(DNSAnswer{Name: answerName, Type: recordType, Value: ip.String()})
+		case strings.Contains(lower, "can't find"), strings.Contains(lower, "timed out"), strings.Contains(lower, "no servers could be reached"):
+			message := strings.TrimSpace(strings.TrimLeft(line, "*; "))
+			if !slices.Contains(failures, message) {
+				failures = append(failures, message)
+			}
+		}
+	}
+	return failures
+}
+
+func truncateDNSOutput(output []byte) string {
+	const limit = 200
+	if len(output) > limit {
+		return string(output[:limit]) + "…"
+	}
+	return string(output)
+}
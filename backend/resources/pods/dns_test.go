@@ -0,0 +1,94 @@
+package pods
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const dnsResolvConf = `@@ resolv.conf
+search team-a.svc.cluster.local svc.cluster.local cluster.local
+nameserver 10.96.0.10
+options ndots:5
+`
+
+func TestParseDNSLookupBusyboxServiceName(t *testing.T) {
+	output := dnsResolvConf + `@@ nslookup
+Server:		10.96.0.10
+Address:	10.96.0.10:53
+
+Name:	web.team-a.svc.cluster.local
+Address: 10.100.4.12
+
+*** Can't find web.team-a.svc.cluster.local: No answer
+
+@@ exit 0
+`
+	lookup, err := ParseDNSLookup("web", []byte(output))
+	require.NoError(t, err)
+	require.True(t, lookup.Resolved)
+	require.Empty(t, lookup.Error, "a missing AAAA record is not a failure")
+	require.Equal(t, []string{"10.96.0.10"}, lookup.Nameservers)
+	require.Equal(t, 5, lookup.Ndots)
+	require.Equal(t, "10.96.0.10", lookup.Server)
+	require.Equal(t, []DNSAnswer{{Name: "web.team-a.svc.cluster.local", Type: "A", Value: "10.100.4.12"}}, lookup.Answers)
+	require.Equal(t, []string{
+		"web.team-a.svc.cluster.local",
+		"web.svc.cluster.local",
+		"web.cluster.local",
+		"web",
+	}, lookup.Candidates)
+}
+
+func TestParseDNSLookupExternalNameCNAME(t *testing.T) {
+	output := dnsResolvConf + `@@ nslookup
+Server:		10.96.0.10
+Address:	10.96.0.10#53
+
+db.team-a.svc.cluster.local	canonical name = db.example.com.
+Name:	db.example.com
+Address: 192.0.2.8
+Name:	db.example.com
+Address: 2001:db8::8
+@@ exit 0
+`
+	lookup, err := ParseDNSLookup("db.team-a.svc.cluster.local.", []byte(output))
+	require.NoError(t, err)
+	require.Equal(t, []DNSAnswer{
+		{Name: "db.team-a.svc.cluster.local", Type: "CNAME", Value: "db.example.com"},
+		{Name: "db.example.com", Type: "A", Value: "192.0.2.8"},
+		{Name: "db.example.com", Type: "AAAA", Value: "2001:db8::8"},
+	}, lookup.Answers)
+	require.Equal(t, []string{"db.team-a.svc.cluster.local."}, lookup.Candidates)
+}
+
+func TestParseDNSLookupFailure(t *testing.T) {
+	output := dnsResolvConf + `@@ nslookup
+Server:		10.96.0.10
+Address:	10.96.0.10#53
+
+** server can't find missing.team-a.svc.cluster.local: NXDOMAIN
+@@ exit 1
+`
+	lookup, err := ParseDNSLookup("missing.team-a", []byte(output))
+	require.NoError(t, err)
+	require.False(t, lookup.Resolved)
+	require.Equal(t, "server can't find missing.team-a.svc.cluster.local: NXDOMAIN", lookup.Error)
+	require.Equal(t, "missing.team-a.team-a.svc.cluster.local", lookup.Candidates[0], "one dot is below ndots:5")
+
+	lookup, err = ParseDNSLookup("web", []byte("@@ resolv.conf\n@@ nslookup\nsh: nslookup: not found\n@@ exit 127\n"))
+	require.NoError(t, err)
+	require.Equal(t, "sh: nslookup: not found", lookup.Error)
+
+	_, err = ParseDNSLookup("x", []byte("sh: nslookup: not found"))
+	require.Error(t, err)
+}
+
+func TestDNSLookupCommand(t *testing.T) {
+	command, err := DNSLookupCommand(" web.team-a ")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh", "-c", dnsLookupScript, "sh", "web.team-a"}, command)
+
+	_, err = DNSLookupCommand("web team")
+	require.Error(t, err)
+}
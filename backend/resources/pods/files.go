@@ -0,0 +1,192 @@
+/*
+ * backend/resources/pods/files.go
+ *
+ * Container filesystem browsing over exec.
+ * - Commands pass paths as positional shell arguments, never spliced into the
+ *   script, so any file name is safe.
+ * - Listing needs sh and stat, preview needs head, download needs tar for
+ *   directories and multiple selections (cat for a single file): what
+ *   `kubectl cp` already requires of an image.
+ */
+
+package pods
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// dirLinkMarker prefixes the names of symlinks that resolve to a directory.
+const dirLinkMarker = "@@dirlink|"
+
+// listDirectoryScript stats every entry of $1 as "type|size|mode|mtime|name",
+// then marks symlinks to directories so they can be navigated.
+const listDirectoryScript = `cd -- "$1" || exit 1
+stat -c '%F|%s|%a|%Y|%n' -- .* * 2>/dev/null
+for f in .* *; do if [ -L "$f" ] && [ -d "$f" ]; then printf '` + dirLinkMarker + `%s\n' "$f"; fi; done
+exit 0`
+
+// FileEntry is one entry of a container directory.
+type FileEntry struct {
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Size       int64     `json:"size"`
+	Mode       string    `json:"mode"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+	// LinkToDir marks a symlink whose target is a directory.
+	LinkToDir bool `json:"linkToDir,omitempty"`
+}
+
+// File entry types.
+const (
+	FileTypeFile      = "file"
+	FileTypeDirectory = "dir"
+	FileTypeSymlink   = "symlink"
+	FileTypeOther     = "other"
+)
+
+// DirectoryListing is a container directory's entries, directories first.
+type DirectoryListing struct {
+	Container string      `json:"container"`
+	Path      string      `json:"path"`
+	Entries   []FileEntry `json:"entries"`
+}
+
+// FilePreview is the start of a container file. Content is empty for files
+// that are not UTF-8 text.
+type FilePreview struct {
+	Container string `json:"container"`
+	Path      string `json:"path"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated"`
+	Binary    bool   `json:"binary"`
+}
+
+// CleanContainerPath normalises an absolute container path.
+func CleanContainerPath(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "/", nil
+	}
+	if !strings.HasPrefix(trimmed, "/") {
+		return "", fmt.Errorf("path %q must be absolute", value)
+	}
+	return path.Clean(trimmed), nil
+}
+
+// ListDirectoryCommand lists dir (an absolute, cleaned path).
+func ListDirectoryCommand(dir string) []string {
+	return []string{"/bin/sh", "-c", listDirectoryScript, "sh", dir}
+}
+
+// PreviewFileCommand reads up to limit+1 bytes of file, one more than is
+// shown so ParseFilePreview can tell the file was cut short.
+func PreviewFileCommand(file string, limit int) []string {
+	return []string{"head", "-c", strconv.Itoa(limit + 1), "--", file}
+}
+
+// FileTypeCommand prints "file" when target is a regular file.
+func FileTypeCommand(target string) []string {
+	return []string{"/bin/sh", "-c", `if [ -f "$1" ]; then echo file; else echo other; fi`, "sh", target}
+}
+
+// DownloadCommand streams names from dir: a lone regular file as-is, anything
+// else as a tar archive.
+func DownloadCommand(dir string, names []string, regularFile bool) []string {
+	if regularFile && len(names) == 1 {
+		return []string{"cat", "--", path.Join(dir, names[0])}
+	}
+	return append([]string{"tar", "cf", "-", "-C", dir, "--"}, names...)
+}
+
+// ValidateEntryNames rejects names that are not single entries of a directory.
+func ValidateEntryNames(names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("no files selected")
+	}
+	for _, name := range names {
+		if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+			return fmt.Errorf("invalid file name %q", name)
+		}
+	}
+	return nil
+}
+
+// ParseDirectoryListing parses ListDirectoryCommand output.
+func ParseDirectoryListing(container, dir string, output []byte) DirectoryListing {
+	listing := DirectoryListing{Container: container, Path: dir, Entries: []FileEntry{}}
+	dirLinks := make(map[string]bool)
+	for _, line := range strings.Split(string(output), "\n") {
+		if name, ok := strings.CutPrefix(line, dirLinkMarker); ok {
+			dirLinks[name] = true
+			continue
+		}
+		parts := strings.SplitN(line, "|", 5)
+		if len(parts) != 5 || parts[4] == "." || parts[4] == ".." {
+			continue
+		}
+		size, _ := strconv.ParseInt(parts[1], 10, 64)
+		modified, _ := strconv.ParseInt(parts[3], 10, 64)
+		listing.Entries = append(listing.Entries, FileEntry{
+			Name:       parts[4],
+			Type:       fileTypeFromStat(parts[0]),
+			Size:       size,
+			Mode:       parts[2],
+			ModifiedAt: time.Unix(modified, 0).UTC(),
+		})
+	}
+	for i := range listing.Entries {
+		entry := &listing.Entries[i]
+		entry.LinkToDir = entry.Type == FileTypeSymlink && dirLinks[entry.Name]
+	}
+	sort.SliceStable(listing.Entries, func(i, j int) bool {
+		left, right := listing.Entries[i], listing.Entries[j]
+		leftDir := left.Type == FileTypeDirectory || left.LinkToDir
+		rightDir := right.Type == FileTypeDirectory || right.LinkToDir
+		if leftDir != rightDir {
+			return leftDir
+		}
+		return left.Name < right.Name
+	})
+	return listing
+}
+
+// ParseFilePreview turns PreviewFileCommand output into a preview of at most
+// limit bytes.
+func ParseFilePreview(container, file string, output []byte, limit int) FilePreview {
+	preview := FilePreview{Container: container, Path: file}
+	if len(output) > limit {
+		output = output[:limit]
+		preview.Truncated = true
+		// Do not end mid-rune when the cut lands inside a multi-byte character.
+		for cut := 0; cut < utf8.UTFMax && len(output) > 0 && !utf8.Valid(output); cut++ {
+			output = output[:len(output)-1]
+		}
+	}
+	if bytes.IndexByte(output, 0) >= 0 || !utf8.Valid(output) {
+		preview.Binary = true
+		return preview
+	}
+	preview.Content = string(output)
+	return preview
+}
+
+// fileTypeFromStat maps stat's %F to an entry type.
+func fileTypeFromStat(value string) string {
+	switch value {
+	case "regular file", "regular empty file":
+		return FileTypeFile
+	case "directory":
+		return FileTypeDirectory
+	case "symbolic link":
+		return FileTypeSymlink
+	default:
+		return FileTypeOther
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * backend/resources/pods/files_test.go
+ *
+ * Tests for container filesystem browsing helpers.
+ * - Covers listing parsing, preview truncation and binary detection, and the
+ *   download command and name validation.
+ */
+
+package pods
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDirectoryListingSortsDirectoriesFirst(t *testing.T) {
+	output := strings.Join([]string{
+		"directory|4096|755|1700000000|.",
+		"directory|4096|755|1700000000|..",
+		"regular file|120|644|1700000100|nginx.conf",
+		"directory|4096|755|1700000200|conf.d",
+		"symbolic link|11|777|1700000300|current",
+		"symbolic link|9|777|1700000300|log.txt",
+		"regular empty file|0|600|1700000400|a|b",
+		"fifo|0|644|1700000500|pipe",
+		"@@dirlink|current",
+		"",
+	}, "\n")
+
+	listing := ParseDirectoryListing("web", "/etc/nginx", []byte(output))
+	require.Equal(t, "/etc/nginx", listing.Path)
+	names := make([]string, 0, len(listing.Entries))
+	for _, entry := range listing.Entries {
+		names = append(names, entry.Name)
+	}
+	require.Equal(t, []string{"conf.d", "current", "a|b", "log.txt", "nginx.conf", "pipe"}, names)
+	require.Equal(t, FileEntry{Name: "current", Type: FileTypeSymlink, Size: 11, Mode: "777", ModifiedAt: time.Unix(1700000300, 0).UTC(), LinkToDir: true}, listing.Entries[1])
+	require.Equal(t, FileTypeFile, listing.Entries[2].Type)
+	require.Equal(t, FileTypeOther, listing.Entries[5].Type)
+}
+
+func TestParseFilePreviewTruncatesAndDetectsBinary(t *testing.T) {
+	preview := ParseFilePreview("web", "/etc/motd", []byte("hello"), 16)
+	require.Equal(t, FilePreview{Container: "web", Path: "/etc/motd", Content: "hello"}, preview)
+
+	preview = ParseFilePreview("web", "/etc/motd", []byte("héllo!"), 2)
+	require.True(t, preview.Truncated)
+	require.Equal(t, "h", preview.Content)
+
+	preview = ParseFilePreview("web", "/bin/sh", []byte("\x7fELF\x00\x01"), 16)
+	require.True(t, preview.Binary)
+	require.Empty(t, preview.Content)
+}
+
+func TestDownloadCommandAndNameValidation(t *testing.T) {
+	require.Equal(t, []string{"cat", "--", "/etc/hosts"}, DownloadCommand("/etc", []string{"hosts"}, true))
+	require.Equal(t, []string{"tar", "cf", "-", "-C", "/etc", "--", "hosts", "nginx"}, DownloadCommand("/etc", []string{"hosts", "nginx"}, false))
+
+	require.NoError(t, ValidateEntryNames([]string{"hosts", ".bashrc"}))
+	require.Error(t, ValidateEntryNames(nil))
+	require.Error(t, ValidateEntryNames([]string{"../etc"}))
+	require.Error(t, ValidateEntryNames([]string{".."}))
+
+	cleaned, err := CleanContainerPath("/var//log/../tmp/")
+	require.NoError(t, err)
+	require.Equal(t, "/var/tmp", cleaned)
+	_, err = CleanContainerPath("var/log")
+	require.Error(t, err)
+}
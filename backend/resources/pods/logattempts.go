@@ -0,0 +1,133 @@
+/*
+ * backend/resources/pods/logattempts.go
+ *
+ * Job attempt numbering for logs fetched across a Job's pods.
+ * - Numbers each Job's pods in creation order (per completion index).
+ * - Labels log lines with the attempt and, for a restarted container, its
+ *   previous run.
+ */
+
+package pods
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/luxury-yacht/app/backend/internal/containerlogs"
+	"github.com/luxury-yacht/app/backend/resources/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// jobAttempt is one pod of a Job: the Number-th pod the Job controller created
+// for the completion index, if the Job is indexed.
+type jobAttempt struct {
+	Number int
+	Index  string
+	Pod    *corev1.Pod
+}
+
+// numberJobAttempts numbers each Job's pods in creation order, so the first
+// pod is attempt 1 and each pod created to replace a failed one the next.
+// Indexed Jobs are numbered per completion index; CronJob pods per Job.
+func numberJobAttempts(pods []*corev1.Pod) map[string]jobAttempt {
+	groups := make(map[string][]*corev1.Pod)
+	for _, pod := range pods {
+		if pod == nil {
+			continue
+		}
+		key := podJobName(pod) + "/" + pod.Labels[batchv1.JobCompletionIndexAnnotation]
+		groups[key] = append(groups[key], pod)
+	}
+
+	attempts := make(map[string]jobAttempt, len(pods))
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			ci, cj := group[i].CreationTimestamp, group[j].CreationTimestamp
+			if !ci.Equal(&cj) {
+				return ci.Before(&cj)
+			}
+			return group[i].Name < group[j].Name
+		})
+		for i, pod := range group {
+			attempts[pod.Name] = jobAttempt{
+				Number: i + 1,
+				Index:  pod.Labels[batchv1.JobCompletionIndexAnnotation],
+				Pod:    pod,
+			}
+		}
+	}
+	return attempts
+}
+
+// podJobName returns the Job that created the pod, from its job-name label or
+// controller reference.
+func podJobName(pod *corev1.Pod) string {
+	if name := pod.Labels[batchv1.JobNameLabel]; name != "" {
+		return name
+	}
+	if name := pod.Labels["job-name"]; name != "" {
+		return name
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "Job" {
+			return owner.Name
+		}
+	}
+	return ""
+}
+
+// previousRun reports whether the container has restarted inside the pod
+// (restartPolicy OnFailure retries in place), returning how its last run
+// ended. Only that last run's logs are still retrievable.
+func (a jobAttempt) previousRun(container containerlogs.ContainerRef) (*corev1.ContainerStateTerminated, bool) {
+	if a.Pod == nil {
+		return nil, false
+	}
+	statuses := a.Pod.Status.ContainerStatuses
+	switch {
+	case container.IsInit:
+		statuses = a.Pod.Status.InitContainerStatuses
+	case container.IsEphemeral:
+		statuses = a.Pod.Status.EphemeralContainerStatuses
+	}
+	for _, status := range statuses {
+		if status.Name != container.Name || status.RestartCount == 0 {
+			continue
+		}
+		if status.LastTerminationState.Terminated != nil {
+			return status.LastTerminationState.Terminated, true
+		}
+		return &corev1.ContainerStateTerminated{}, true
+	}
+	return nil, false
+}
+
+// label describes the attempt, e.g. "attempt 2 (Failed)" or, for a
+// container's previous run, "attempt 1, previous run (OOMKilled)".
+func (a jobAttempt) label(previousRun *corev1.ContainerStateTerminated) string {
+	label := fmt.Sprintf("attempt %d", a.Number)
+	if a.Index != "" {
+		label = fmt.Sprintf("index %s, attempt %d", a.Index, a.Number)
+	}
+	if previousRun != nil {
+		switch {
+		case previousRun.Reason != "":
+			return fmt.Sprintf("%s, previous run (%s)", label, previousRun.Reason)
+		case previousRun.ExitCode != 0:
+			return fmt.Sprintf("%s, previous run (exit %d)", label, previousRun.ExitCode)
+		default:
+			return label + ", previous run"
+		}
+	}
+	if a.Pod != nil && a.Pod.Status.Phase != "" {
+		return fmt.Sprintf("%s (%s)", label, a.Pod.Status.Phase)
+	}
+	return label
+}
+
+func labelAttempt(entries []types.ContainerLogsEntry, label string) {
+	for i := range entries {
+		entries[i].Attempt = label
+	}
+}
@@ -53,6 +53,16 @@ func (s *Service) FetchContainerLogs(req types.ContainerLogsFetchRequest) types.
 	if err != nil {
 		return types.ContainerLogsFetchResponse{Error: fmt.Sprintf("invalid container state filter: %v", err)}
 	}
+	if req.Attempts {
+		target, err := s.resolveLogTarget(req)
+		if err != nil {
+			return types.ContainerLogsFetchResponse{Error: err.Error()}
+		}
+		if target.Kind != "job" && target.Kind != "cronjob" {
+			return types.ContainerLogsFetchResponse{Error: "logs across attempts require a Job or CronJob scope"}
+		}
+		req.Previous = false
+	}
 	if req.MatchNone {
 		if _, err := s.resolveLogTarget(req); err != nil {
 			return types.ContainerLogsFetchResponse{Error: err.Error()}
@@ -76,6 +86,10 @@ func (s *Service) FetchContainerLogs(req types.ContainerLogsFetchRequest) types.
 		containerlogs.GetPerScopeTargetLimit(),
 	)
 	warnings := containerlogs.BuildTargetLimitWarnings(len(targets), totalTargets)
+	var attempts map[string]jobAttempt
+	if req.Attempts {
+		attempts = numberJobAttempts(pods)
+	}
 
 	var allEntries []types.ContainerLogsEntry
 	var podErrors []error
@@ -96,6 +110,29 @@ func (s *Service) FetchContainerLogs(req types.ContainerLogsFetchRequest) types.
 			podErrors = append(podErrors, fmt.Errorf("pod %s container %s: %w", target.PodName, target.Container.Name, err))
 			continue
 		}
+		if req.Attempts {
+			attempt := attempts[target.PodName]
+			labelAttempt(entries, attempt.label(nil))
+			if lastRun, restarted := attempt.previousRun(target.Container); restarted {
+				previous, err := s.fetchContainerLogs(
+					target.Namespace,
+					target.PodName,
+					target.Container.Name,
+					target.Container.IsInit,
+					target.Container.IsEphemeral,
+					req.TailLines,
+					true,
+					req.SinceSeconds,
+					lineFilter,
+				)
+				if err != nil {
+					s.logWarn(fmt.Sprintf("Failed to fetch previous logs for container %s/%s: %v", target.PodName, target.Container.Name, err))
+				} else {
+					labelAttempt(previous, attempt.label(lastRun))
+					entries = append(previous, entries...)
+				}
+			}
+		}
 		allEntries = append(allEntries, entries...)
 	}
 
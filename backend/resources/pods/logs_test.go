@@ -14,6 +14,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/internal/containerlogs"
@@ -489,6 +490,105 @@ func TestFetchContainerLogsAggregatesAndSortsEntries(t *testing.T) {
 	require.Equal(t, "other pod", resp.Entries[0].Line)
 }
 
+func TestFetchContainerLogsAttemptsLabelsJobPodsAndPreviousRuns(t *testing.T) {
+	defer func(orig func(corev1client.PodInterface, context.Context, string, *corev1.PodLogOptions) (io.ReadCloser, error)) {
+		containerLogsStreamFunc = orig
+	}(containerLogsStreamFunc)
+
+	created := metav1.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobPod := func(name string, offset time.Duration, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            map[string]string{"job-name": "migrate"},
+				CreationTimestamp: metav1.NewTime(created.Add(offset)),
+			},
+			Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	first := jobPod("migrate-b", 0, corev1.PodFailed)
+	second := jobPod("migrate-a", time.Minute, corev1.PodRunning)
+	second.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:         "app",
+		RestartCount: 1,
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+		},
+	}}
+	client := fake.NewClientset(
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"}},
+		first,
+		second,
+	)
+
+	containerLogsStreamFunc = func(_ corev1client.PodInterface, _ context.Context, podName string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		switch {
+		case podName == "migrate-b":
+			return io.NopCloser(strings.NewReader("2024-01-01T00:00:10Z first attempt failed")), nil
+		case podName == "migrate-a" && opts.Previous:
+			return io.NopCloser(strings.NewReader("2024-01-01T00:01:10Z out of memory")), nil
+		case podName == "migrate-a":
+			return io.NopCloser(strings.NewReader("2024-01-01T00:02:10Z retrying")), nil
+		default:
+			return nil, fmt.Errorf("unknown pod")
+		}
+	}
+
+	service := NewService(common.Dependencies{
+		Context:          context.Background(),
+		Logger:           applog.Noop,
+		KubernetesClient: client,
+	})
+
+	resp := service.FetchContainerLogs(types.ContainerLogsFetchRequest{
+		Scope:    workloadLogScope("default", "batch", "v1", "job", "migrate"),
+		Attempts: true,
+	})
+	require.Empty(t, resp.Error)
+	require.Len(t, resp.Entries, 3)
+	require.Equal(t, "attempt 1 (Failed)", resp.Entries[0].Attempt)
+	require.Equal(t, "attempt 2, previous run (OOMKilled)", resp.Entries[1].Attempt)
+	require.Equal(t, "out of memory", resp.Entries[1].Line)
+	require.Equal(t, "attempt 2 (Running)", resp.Entries[2].Attempt)
+}
+
+func TestFetchContainerLogsAttemptsRequiresJobScope(t *testing.T) {
+	service := NewService(common.Dependencies{
+		Context:          context.Background(),
+		Logger:           applog.Noop,
+		KubernetesClient: fake.NewClientset(),
+	})
+
+	resp := service.FetchContainerLogs(types.ContainerLogsFetchRequest{
+		Scope:    workloadLogScope("default", "apps", "v1", "deployment", "web"),
+		Attempts: true,
+	})
+	require.Equal(t, "logs across attempts require a Job or CronJob scope", resp.Error)
+}
+
+func TestNumberJobAttemptsPerCompletionIndex(t *testing.T) {
+	created := metav1.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	indexed := func(name, index string, offset time.Duration) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{batchv1.JobNameLabel: "shard", batchv1.JobCompletionIndexAnnotation: index},
+			CreationTimestamp: metav1.NewTime(created.Add(offset)),
+		}}
+	}
+
+	attempts := numberJobAttempts([]*corev1.Pod{
+		indexed("shard-0-retry", "0", time.Minute),
+		indexed("shard-1", "1", 0),
+		indexed("shard-0", "0", 0),
+	})
+	require.Equal(t, 1, attempts["shard-0"].Number)
+	require.Equal(t, 2, attempts["shard-0-retry"].Number)
+	require.Equal(t, 1, attempts["shard-1"].Number)
+	require.Equal(t, "index 0, attempt 2", attempts["shard-0-retry"].label(nil))
+}
+
 func TestFetchContainerLogsRequiresClient(t *testing.T) {
 	service := NewService(common.Dependencies{
 		Context: context.Background(),
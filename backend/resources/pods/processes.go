@@ -0,0 +1,175 @@
+/*
+ * backend/resources/pods/processes.go
+ *
+ * Process list ("pod top") for one container.
+ * - ProcessListCommand reads /proc inside the container through exec; it needs
+ *   only sh and cat, so it works in images that ship no ps.
+ * - ParseProcessList turns that output into rows, computing CPU from the tick
+ *   delta since the previous sample of the same container.
+ */
+
+package pods
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// procClockTicks is USER_HZ, the unit of /proc/<pid>/stat times. It is 100 on
+// every mainstream Linux build and is not readable without getconf.
+const procClockTicks = 100
+
+// procPageSize converts /proc/<pid>/statm pages to bytes.
+const procPageSize = 4096
+
+// processListScript prints the shell's own pid, /proc/uptime, and for each
+// process a "@@ <pid>" marker followed by its stat, statm and NUL-separated
+// cmdline. Processes that exit mid-read print partial records, which the
+// parser skips.
+const processListScript = `echo "self $$"; cat /proc/uptime; for d in /proc/[0-9]*; do echo "@@ ${d#/proc/}"; cat "$d/stat" "$d/statm" "$d/cmdline" 2>/dev/null; echo; done`
+
+// ProcessListCommand is the exec command that collects a container's processes.
+var ProcessListCommand = []string{"/bin/sh", "-c", processListScript}
+
+// Process is one row of a container's process list.
+type Process struct {
+	PID        int     `json:"pid"`
+	PPID       int     `json:"ppid"`
+	State      string  `json:"state"`
+	CPUPercent float64 `json:"cpuPercent"`
+	RSSBytes   int64   `json:"rssBytes"`
+	Command    string  `json:"command"`
+}
+
+// ProcessList is a container's processes, busiest first. CPUWindowSeconds is
+// the interval CPUPercent was measured over; zero means it is each process's
+// average since it started (the first sample, as `ps` reports it).
+type ProcessList struct {
+	Container        string    `json:"container"`
+	Processes        []Process `json:"processes"`
+	CPUWindowSeconds float64   `json:"cpuWindowSeconds"`
+	SampledAt        time.Time `json:"sampledAt"`
+}
+
+// processKey identifies a process across samples; the start time guards
+// against a reused pid.
+type processKey struct {
+	pid   int
+	start uint64
+}
+
+// ProcessSample is the CPU state kept between calls for the next delta.
+type ProcessSample struct {
+	uptime float64
+	ticks  map[processKey]uint64
+}
+
+// ParseProcessList parses ProcessListCommand output. previous is the last
+// sample of the same container (the zero value for none); the returned sample
+// replaces it. The exec's own shell and its cat children are left out.
+func ParseProcessList(container string, output []byte, previous ProcessSample, sampledAt time.Time) (ProcessList, ProcessSample, error) {
+	text := string(output)
+	header, body, _ := strings.Cut(text, "\n@@ ")
+	headerLines := strings.Split(strings.TrimSpace(header), "\n")
+	if len(headerLines) < 2 || !strings.HasPrefix(headerLines[0], "self ") {
+		return ProcessList{}, ProcessSample{}, fmt.Errorf("unexpected process list output")
+	}
+	self, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(headerLines[0], "self ")))
+	if err != nil {
+		return ProcessList{}, ProcessSample{}, fmt.Errorf("unexpected process list output: %w", err)
+	}
+	uptimeFields := strings.Fields(headerLines[1])
+	if len(uptimeFields) == 0 {
+		return ProcessList{}, ProcessSample{}, fmt.Errorf("unexpected /proc/uptime output")
+	}
+	uptime, err := strconv.ParseFloat(uptimeFields[0], 64)
+	if err != nil {
+		return ProcessList{}, ProcessSample{}, fmt.Errorf("unexpected /proc/uptime output: %w", err)
+	}
+
+	window := 0.0
+	if previous.ticks != nil && uptime > previous.uptime {
+		window = uptime - previous.uptime
+	}
+	sample := ProcessSample{uptime: uptime, ticks: make(map[processKey]uint64)}
+	list := ProcessList{Container: container, Processes: []Process{}, CPUWindowSeconds: window, SampledAt: sampledAt}
+	if body == "" {
+		return list, sample, nil
+	}
+	for _, record := range strings.Split(body, "\n@@ ") {
+		process, key, ticks, ok := parseProcessRecord(record)
+		if !ok || process.PID == self || process.PPID == self {
+			continue
+		}
+		sample.ticks[key] = ticks
+		if prior, seen := previous.ticks[key]; seen && window > 0 && ticks >= prior {
+			process.CPUPercent = roundPercent(float64(ticks-prior) / procClockTicks / window * 100)
+		} else if elapsed := uptime - float64(key.start)/procClockTicks; elapsed > 0 {
+			process.CPUPercent = roundPercent(float64(ticks) / procClockTicks / elapsed * 100)
+		}
+		list.Processes = append(list.Processes, process)
+	}
+	sort.SliceStable(list.Processes, func(i, j int) bool {
+		left, right := list.Processes[i], list.Processes[j]
+		if left.CPUPercent != right.CPUPercent {
+			return left.CPUPercent > right.CPUPercent
+		}
+		if left.RSSBytes != right.RSSBytes {
+			return left.RSSBytes > right.RSSBytes
+		}
+		return left.PID < right.PID
+	})
+	return list, sample, nil
+}
+
+// parseProcessRecord parses one "<pid>\n<stat>\n<statm>\n<cmdline>" record.
+func parseProcessRecord(record string) (Process, processKey, uint64, bool) {
+	lines := strings.SplitN(record, "\n", 4)
+	if len(lines) < 3 {
+		return Process{}, processKey{}, 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return Process{}, processKey{}, 0, false
+	}
+	// The comm field is parenthesised and may itself contain spaces or
+	// parentheses, so the remaining fields start after the last ')'.
+	stat := lines[1]
+	open, closing := strings.IndexByte(stat, '('), strings.LastIndexByte(stat, ')')
+	if open < 0 || closing < open {
+		return Process{}, processKey{}, 0, false
+	}
+	comm := stat[open+1 : closing]
+	fields := strings.Fields(stat[closing+1:])
+	// fields[0] is stat field 3 (state); utime, stime and starttime are
+	// fields 14, 15 and 22.
+	if len(fields) < 20 {
+		return Process{}, processKey{}, 0, false
+	}
+	ppid, _ := strconv.Atoi(fields[1])
+	utime, _ := strconv.ParseUint(fields[11], 10, 64)
+	stime, _ := strconv.ParseUint(fields[12], 10, 64)
+	start, _ := strconv.ParseUint(fields[19], 10, 64)
+
+	var rss int64
+	if statm := strings.Fields(lines[2]); len(statm) > 1 {
+		pages, _ := strconv.ParseInt(statm[1], 10, 64)
+		rss = pages * procPageSize
+	}
+	command := ""
+	if len(lines) > 3 {
+		command = strings.TrimSpace(strings.ReplaceAll(strings.TrimRight(lines[3], "\n"), "\x00", " "))
+	}
+	if command == "" {
+		command = "[" + comm + "]"
+	}
+	process := Process{PID: pid, PPID: ppid, State: fields[0], RSSBytes: rss, Command: command}
+	return process, processKey{pid: pid, start: start}, utime + stime, true
+}
+
+func roundPercent(value float64) float64 {
+	return float64(int64(value*10+0.5)) / 10
+}
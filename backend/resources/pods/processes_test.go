@@ -0,0 +1,86 @@
+/*
+ * backend/resources/pods/processes_test.go
+ *
+ * Tests for the container process list parser.
+ * - Covers /proc record parsing, self-exclusion and CPU deltas between samples.
+ */
+
+package pods
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func procStatLine(pid int, comm, state string, ppid int, utime, stime, start uint64) string {
+	return fmt.Sprintf("%d (%s) %s %d 1 1 0 -1 4194560 100 0 0 0 %d %d 0 0 20 0 1 0 %d 1000000 200 18446744073709551615",
+		pid, comm, state, ppid, utime, stime, start)
+}
+
+func procRecord(pid int, stat, statm, cmdline string) string {
+	return fmt.Sprintf("@@ %d\n%s\n%s\n%s\n", pid, stat, statm, cmdline)
+}
+
+func procOutput(self int, uptime string, records ...string) []byte {
+	return []byte(fmt.Sprintf("self %d\n%s 900.00\n%s", self, uptime, strings.Join(records, "")))
+}
+
+func TestParseProcessListParsesRecordsAndSkipsExecShell(t *testing.T) {
+	output := procOutput(40, "100.00",
+		procRecord(1, procStatLine(1, "nginx", "S", 0, 500, 500, 0), "5000 256 100 1 0 300 0", "nginx: master process\x00-g\x00daemon off;\x00"),
+		procRecord(7, procStatLine(7, "my (odd) worker", "R", 1, 2000, 0, 5000), "5000 1024 100 1 0 300 0", ""),
+		procRecord(40, procStatLine(40, "sh", "S", 0, 0, 0, 9990), "400 50 40 1 0 30 0", "/bin/sh\x00-c\x00..."),
+		procRecord(41, procStatLine(41, "cat", "R", 40, 0, 0, 9999), "400 50 40 1 0 30 0", "cat\x00/proc/41/stat"),
+		"@@ 42\n\n",
+	)
+	sampledAt := time.Unix(1700000000, 0)
+
+	list, sample, err := ParseProcessList("web", output, ProcessSample{}, sampledAt)
+	require.NoError(t, err)
+	require.Equal(t, "web", list.Container)
+	require.Equal(t, sampledAt, list.SampledAt)
+	require.Zero(t, list.CPUWindowSeconds)
+	require.Len(t, list.Processes, 2)
+
+	// pid 7 used 20s of CPU over the 50s since it started at tick 5000.
+	require.Equal(t, Process{PID: 7, PPID: 1, State: "R", CPUPercent: 40, RSSBytes: 1024 * 4096, Command: "[my (odd) worker]"}, list.Processes[0])
+	require.Equal(t, Process{PID: 1, PPID: 0, State: "S", CPUPercent: 10, RSSBytes: 256 * 4096, Command: "nginx: master process -g daemon off;"}, list.Processes[1])
+	require.Len(t, sample.ticks, 2)
+}
+
+func TestParseProcessListUsesDeltaSincePreviousSample(t *testing.T) {
+	first := procOutput(40, "100.00",
+		procRecord(1, procStatLine(1, "app", "S", 0, 1000, 0, 0), "1 1", "app"),
+		procRecord(2, procStatLine(2, "old", "S", 1, 100, 0, 10), "1 1", "old"),
+	)
+	_, sample, err := ParseProcessList("app", first, ProcessSample{}, time.Now())
+	require.NoError(t, err)
+
+	// pid 2 was replaced by a new process reusing the pid; it falls back to
+	// its lifetime average instead of a bogus delta.
+	second := procOutput(50, "104.00",
+		procRecord(1, procStatLine(1, "app", "S", 0, 1200, 0, 0), "1 1", "app"),
+		procRecord(2, procStatLine(2, "new", "S", 1, 100, 0, 10200), "1 1", "new"),
+	)
+	list, _, err := ParseProcessList("app", second, sample, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 4.0, list.CPUWindowSeconds)
+	require.Len(t, list.Processes, 2)
+	require.Equal(t, 1, list.Processes[0].PID)
+	require.Equal(t, 50.0, list.Processes[0].CPUPercent)
+	require.Equal(t, 2, list.Processes[1].PID)
+	require.Equal(t, 50.0, list.Processes[1].CPUPercent)
+}
+
+func TestParseProcessListRejectsUnexpectedOutput(t *testing.T) {
+	_, _, err := ParseProcessList("app", []byte("sh: /proc/uptime: not found\n"), ProcessSample{}, time.Now())
+	require.Error(t, err)
+
+	list, _, err := ParseProcessList("app", procOutput(1, "10.00"), ProcessSample{}, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, list.Processes)
+}
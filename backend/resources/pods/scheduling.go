@@ -0,0 +1,412 @@
+/*
+ * backend/resources/pods/scheduling.go
+ *
+ * "Why is my pod Pending" explainer.
+ * - Collects the pod's FailedScheduling events.
+ * - Re-evaluates every node against the checks the scheduler's default filters
+ *   apply most often: cordons, nodeSelector, required node affinity, taints and
+ *   resource requests. Volume topology, host ports and inter-pod (anti-)affinity
+ *   are not modelled; the scheduler's own events cover those.
+ */
+
+package pods
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/klog/v2"
+
+	"github.com/luxury-yacht/app/backend/resources/common"
+)
+
+// SchedulingExplanation reports why a pod is (or was) not placed on each node.
+type SchedulingExplanation struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	// NodeName is set once the pod is bound; the node checks are then informational.
+	NodeName string `json:"nodeName,omitempty"`
+	// Requests is the pod's effective request per resource as the scheduler counts it.
+	Requests map[string]string `json:"requests,omitempty"`
+	Events   []SchedulingEvent `json:"events"`
+	Nodes    []NodeFit         `json:"nodes"`
+	// FittingNodes counts nodes with no blocking reason. A Pending pod with fitting
+	// nodes is held back by something this check does not model, or is waiting for
+	// the scheduler to retry.
+	FittingNodes int `json:"fittingNodes"`
+}
+
+// SchedulingEvent is one FailedScheduling event recorded for the pod.
+type SchedulingEvent struct {
+	Message  string `json:"message"`
+	Count    int32  `json:"count"`
+	LastSeen string `json:"lastSeen,omitempty"`
+}
+
+// NodeFit is the outcome of re-evaluating one node for the pod.
+type NodeFit struct {
+	Name    string   `json:"name"`
+	Fits    bool     `json:"fits"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+const failedSchedulingReason = "FailedScheduling"
+
+// ExplainScheduling explains why the pod is Pending from its FailedScheduling
+// events and a per-node fit check against the current nodes and their pods.
+func ExplainScheduling(deps common.Dependencies, namespace, name string) (*SchedulingExplanation, error) {
+	return NewService(deps).ExplainScheduling(namespace, name)
+}
+
+func (s *Service) ExplainScheduling(namespace, name string) (*SchedulingExplanation, error) {
+	if s.deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	if strings.TrimSpace(namespace) == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+	ctx := s.deps.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	core := s.deps.KubernetesClient.CoreV1()
+
+	pod, err := core.Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod: %w", err)
+	}
+	nodes, err := core.Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	// Only pods that still hold their requests count against a node.
+	running, err := core.Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	events, err := core.Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,involvedObject.name=" + name,
+	})
+	if err != nil {
+		// The node checks stand on their own; the scheduler's messages are a bonus.
+		s.deps.Logger.Warn(fmt.Sprintf("Failed to list events for pod %s/%s: %v", namespace, name, err), "Pod")
+		events = &corev1.EventList{}
+	}
+
+	explanation := buildSchedulingExplanation(pod, nodes.Items, running.Items, events.Items)
+	return &explanation, nil
+}
+
+func buildSchedulingExplanation(pod *corev1.Pod, nodes []corev1.Node, pods []corev1.Pod, events []corev1.Event) SchedulingExplanation {
+	requests := podSchedulingRequests(pod)
+	explanation := SchedulingExplanation{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Phase:     string(pod.Status.Phase),
+		NodeName:  pod.Spec.NodeName,
+		Events:    failedSchedulingEvents(pod, events),
+		Nodes:     make([]NodeFit, 0, len(nodes)),
+	}
+	if len(requests) > 0 {
+		explanation.Requests = make(map[string]string, len(requests))
+		for name, quantity := range requests {
+			explanation.Requests[string(name)] = formatSchedulingQuantity(name, quantity)
+		}
+	}
+
+	usedByNode := make(map[string]corev1.ResourceList)
+	podCountByNode := make(map[string]int64)
+	for i := range pods {
+		other := &pods[i]
+		if other.Spec.NodeName == "" || other.Namespace == pod.Namespace && other.Name == pod.Name {
+			continue
+		}
+		if other.Status.Phase == corev1.PodSucceeded || other.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		used, ok := usedByNode[other.Spec.NodeName]
+		if !ok {
+			used = corev1.ResourceList{}
+			usedByNode[other.Spec.NodeName] = used
+		}
+		addResourceList(used, podSchedulingRequests(other))
+		podCountByNode[other.Spec.NodeName]++
+	}
+
+	for i := range nodes {
+		node := &nodes[i]
+		reasons := nodeFitReasons(pod, node, requests, usedByNode[node.Name], podCountByNode[node.Name])
+		fit := NodeFit{Name: node.Name, Fits: len(reasons) == 0, Reasons: reasons}
+		if fit.Fits {
+			explanation.FittingNodes++
+		}
+		explanation.Nodes = append(explanation.Nodes, fit)
+	}
+	sort.Slice(explanation.Nodes, func(i, j int) bool {
+		return explanation.Nodes[i].Name < explanation.Nodes[j].Name
+	})
+	return explanation
+}
+
+// nodeFitReasons lists every reason the node rejects the pod, in the order the
+// scheduler's filters run, so a node blocked twice shows both.
+func nodeFitReasons(pod *corev1.Pod, node *corev1.Node, requests, used corev1.ResourceList, podCount int64) []string {
+	var reasons []string
+	if node.Spec.Unschedulable && !toleratesTaint(pod, &corev1.Taint{
+		Key:    corev1.TaintNodeUnschedulable,
+		Effect: corev1.TaintEffectNoSchedule,
+	}) {
+		reasons = append(reasons, "node is cordoned")
+	}
+	reasons = append(reasons, nodeSelectorReasons(pod, node)...)
+	if !matchesRequiredNodeAffinity(pod, node) {
+		reasons = append(reasons, "required node affinity does not match")
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !toleratesTaint(pod, taint) {
+			reasons = append(reasons, "untolerated taint "+taint.ToString())
+		}
+	}
+	reasons = append(reasons, resourceFitReasons(node, requests, used, podCount)...)
+	return reasons
+}
+
+func nodeSelectorReasons(pod *corev1.Pod, node *corev1.Node) []string {
+	keys := make([]string, 0, len(pod.Spec.NodeSelector))
+	for key := range pod.Spec.NodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var reasons []string
+	for _, key := range keys {
+		want := pod.Spec.NodeSelector[key]
+		have, ok := node.Labels[key]
+		switch {
+		case !ok:
+			reasons = append(reasons, fmt.Sprintf("nodeSelector %s=%s: label missing", key, want))
+		case have != want:
+			reasons = append(reasons, fmt.Sprintf("nodeSelector %s=%s: node has %s", key, want, have))
+		}
+	}
+	return reasons
+}
+
+// matchesRequiredNodeAffinity evaluates requiredDuringSchedulingIgnoredDuringExecution:
+// terms are ORed, the expressions and fields within a term are ANDed.
+func matchesRequiredNodeAffinity(pod *corev1.Pod, node *corev1.Node) bool {
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		if matchesNodeSelectorRequirements(term.MatchExpressions, labels.Set(node.Labels)) &&
+			matchesNodeSelectorRequirements(term.MatchFields, labels.Set{"metadata.name": node.Name}) {
+			return true
+		}
+	}
+	return false
+}
+
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+func matchesNodeSelectorRequirements(requirements []corev1.NodeSelectorRequirement, set labels.Set) bool {
+	for _, req := range requirements {
+		op, ok := nodeSelectorOperators[req.Operator]
+		if !ok {
+			return false
+		}
+		requirement, err := labels.NewRequirement(req.Key, op, req.Values)
+		if err != nil || !requirement.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
+
+func toleratesTaint(pod *corev1.Pod, taint *corev1.Taint) bool {
+	for i := range pod.Spec.Tolerations {
+		if pod.Spec.Tolerations[i].ToleratesTaint(klog.Background(), taint, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceFitReasons(node *corev1.Node, requests, used corev1.ResourceList, podCount int64) []string {
+	var reasons []string
+	if allocatable, ok := node.Status.Allocatable[corev1.ResourcePods]; ok && podCount+1 > allocatable.Value() {
+		reasons = append(reasons, fmt.Sprintf("too many pods: %d of %d already running", podCount, allocatable.Value()))
+	}
+
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, raw := range names {
+		name := corev1.ResourceName(raw)
+		want := requests[name]
+		if want.IsZero() {
+			continue
+		}
+		allocatable, ok := node.Status.Allocatable[name]
+		if !ok || allocatable.IsZero() {
+			reasons = append(reasons, fmt.Sprintf("insufficient %s: node has none", name))
+			continue
+		}
+		free := allocatable.DeepCopy()
+		if inUse, ok := used[name]; ok {
+			free.Sub(inUse)
+		}
+		if want.Cmp(free) > 0 {
+			if free.Sign() < 0 {
+				free = resource.Quantity{}
+			}
+			reasons = append(reasons, fmt.Sprintf("insufficient %s: requests %s, %s free of %s allocatable",
+				name,
+				formatSchedulingQuantity(name, want),
+				formatSchedulingQuantity(name, free),
+				formatSchedulingQuantity(name, allocatable)))
+		}
+	}
+	return reasons
+}
+
+// podSchedulingRequests is the pod's effective request per resource: the app
+// containers plus restartable (sidecar) init containers, or the largest regular
+// init container together with the sidecars started before it when that is
+// bigger, plus the pod overhead.
+func podSchedulingRequests(pod *corev1.Pod) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(total, container.Resources.Requests)
+	}
+
+	sidecars := corev1.ResourceList{}
+	initPeak := corev1.ResourceList{}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			addResourceList(total, container.Resources.Requests)
+			addResourceList(sidecars, container.Resources.Requests)
+			continue
+		}
+		step := sidecars.DeepCopy()
+		addResourceList(step, container.Resources.Requests)
+		maxResourceList(initPeak, step)
+	}
+	maxResourceList(total, initPeak)
+	addResourceList(total, pod.Spec.Overhead)
+	return total
+}
+
+func addResourceList(into, add corev1.ResourceList) {
+	for name, quantity := range add {
+		current := into[name]
+		current.Add(quantity)
+		into[name] = current
+	}
+}
+
+func maxResourceList(into, candidate corev1.ResourceList) {
+	for name, quantity := range candidate {
+		if current, ok := into[name]; !ok || quantity.Cmp(current) > 0 {
+			into[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func formatSchedulingQuantity(name corev1.ResourceName, quantity resource.Quantity) string {
+	switch {
+	case name == corev1.ResourceCPU:
+		return formatCPUQuantity(&quantity)
+	case name == corev1.ResourceMemory, name == corev1.ResourceEphemeralStorage,
+		strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix):
+		return formatMemoryQuantity(&quantity)
+	default:
+		return quantity.String()
+	}
+}
+
+// failedSchedulingEvents returns the pod's FailedScheduling events, newest first.
+// The field selector already narrows the list; the checks here keep an event for
+// an earlier pod with the same name out.
+func failedSchedulingEvents(pod *corev1.Pod, events []corev1.Event) []SchedulingEvent {
+	type timedEvent struct {
+		event SchedulingEvent
+		at    time.Time
+	}
+	matched := make([]timedEvent, 0)
+	for i := range events {
+		evt := &events[i]
+		if evt.Reason != failedSchedulingReason || evt.InvolvedObject.Kind != "Pod" ||
+			evt.InvolvedObject.Name != pod.Name {
+			continue
+		}
+		if pod.UID != "" && evt.InvolvedObject.UID != "" && evt.InvolvedObject.UID != pod.UID {
+			continue
+		}
+		at := schedulingEventTime(evt)
+		entry := SchedulingEvent{Message: evt.Message, Count: evt.Count}
+		if entry.Count == 0 {
+			entry.Count = 1
+		}
+		if evt.Series != nil && evt.Series.Count > entry.Count {
+			entry.Count = evt.Series.Count
+		}
+		if !at.IsZero() {
+			entry.LastSeen = at.UTC().Format(time.RFC3339)
+		}
+		matched = append(matched, timedEvent{event: entry, at: at})
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].at.After(matched[j].at) })
+
+	out := make([]SchedulingEvent, 0, len(matched))
+	for _, entry := range matched {
+		out = append(out, entry.event)
+	}
+	return out
+}
+
+func schedulingEventTime(evt *corev1.Event) time.Time {
+	switch {
+	case evt.Series != nil && !evt.Series.LastObservedTime.IsZero():
+		return evt.Series.LastObservedTime.Time
+	case !evt.LastTimestamp.IsZero():
+		return evt.LastTimestamp.Time
+	case !evt.EventTime.IsZero():
+		return evt.EventTime.Time
+	default:
+		return evt.CreationTimestamp.Time
+	}
+}
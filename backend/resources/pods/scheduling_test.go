@@ -0,0 +1,186 @@
+package pods
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/applog"
+	"github.com/luxury-yacht/app/backend/resources/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func schedulingTestNode(name string, mutate func(*corev1.Node)) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"zone": "a"}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(node)
+	}
+	return node
+}
+
+func schedulingTestPod(namespace, name, nodeName, cpu string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID("uid-" + name)},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestExplainSchedulingReportsPerNodeReasons(t *testing.T) {
+	pending := schedulingTestPod("team-a", "web", "", "1500m")
+	pending.Status.Phase = corev1.PodPending
+	pending.Spec.NodeSelector = map[string]string{"zone": "a"}
+	pending.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+
+	busy := schedulingTestNode("busy", nil)
+	cordoned := schedulingTestNode("cordoned", func(n *corev1.Node) { n.Spec.Unschedulable = true })
+	tainted := schedulingTestNode("tainted", func(n *corev1.Node) {
+		n.Spec.Taints = []corev1.Taint{
+			{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "dedicated", Value: "web", Effect: corev1.TaintEffectNoSchedule},
+		}
+	})
+	otherZone := schedulingTestNode("other-zone", func(n *corev1.Node) { n.Labels["zone"] = "b" })
+	free := schedulingTestNode("free", nil)
+
+	now := time.Now()
+	events := []corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "team-a", Name: "web.1"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-web"},
+			Reason:         "FailedScheduling",
+			Message:        "0/5 nodes are available: older",
+			Count:          2,
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Hour)),
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "team-a", Name: "web.2"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-web"},
+			Reason:         "FailedScheduling",
+			Message:        "0/5 nodes are available: newer",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "team-a", Name: "web.3"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-previous"},
+			Reason:         "FailedScheduling",
+			Message:        "from a deleted pod with the same name",
+		},
+	}
+
+	client := fake.NewClientset(
+		pending, busy, cordoned, tainted, otherZone, free,
+		schedulingTestPod("team-b", "hog", "busy", "1"),
+		&events[0], &events[1], &events[2],
+	)
+	service := NewService(common.Dependencies{
+		Context:          context.Background(),
+		Logger:           applog.Noop,
+		KubernetesClient: client,
+	})
+
+	explanation, err := service.ExplainScheduling("team-a", "web")
+	if err != nil {
+		t.Fatalf("ExplainScheduling returned error: %v", err)
+	}
+	if explanation.Phase != "Pending" || explanation.Requests["cpu"] != "1.50" {
+		t.Fatalf("unexpected pod summary: %+v", explanation)
+	}
+	if explanation.FittingNodes != 1 {
+		t.Fatalf("expected one fitting node, got %d", explanation.FittingNodes)
+	}
+
+	reasons := map[string]string{}
+	for _, node := range explanation.Nodes {
+		reasons[node.Name] = strings.Join(node.Reasons, "; ")
+	}
+	expect := map[string]string{
+		"busy":       "insufficient cpu: requests 1.50, 1.00 free of 2.00 allocatable",
+		"cordoned":   "node is cordoned",
+		"tainted":    "untolerated taint gpu=true:NoSchedule",
+		"other-zone": "nodeSelector zone=a: node has b",
+		"free":       "",
+	}
+	for name, want := range expect {
+		if reasons[name] != want {
+			t.Errorf("node %s: expected reasons %q, got %q", name, want, reasons[name])
+		}
+	}
+
+	if len(explanation.Events) != 2 {
+		t.Fatalf("expected the two events for this pod, got %+v", explanation.Events)
+	}
+	if !strings.HasSuffix(explanation.Events[0].Message, "newer") || explanation.Events[1].Count != 2 {
+		t.Fatalf("expected events newest first with counts, got %+v", explanation.Events)
+	}
+}
+
+func TestExplainSchedulingMatchesRequiredNodeAffinity(t *testing.T) {
+	pod := schedulingTestPod("ns", "p", "", "100m")
+	pod.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}},
+				}},
+				{MatchFields: []corev1.NodeSelectorRequirement{
+					{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"named"}},
+				}},
+			},
+		},
+	}}
+
+	if matchesRequiredNodeAffinity(pod, schedulingTestNode("plain", nil)) {
+		t.Fatalf("expected a zone=a node outside both terms to be rejected")
+	}
+	if !matchesRequiredNodeAffinity(pod, schedulingTestNode("named", nil)) {
+		t.Fatalf("expected the matchFields term to admit the named node")
+	}
+	if !matchesRequiredNodeAffinity(pod, schedulingTestNode("zone-b", func(n *corev1.Node) { n.Labels["zone"] = "b" })) {
+		t.Fatalf("expected the matchExpressions term to admit a zone=b node")
+	}
+}
+
+func TestPodSchedulingRequestsCountsInitContainersAndSidecars(t *testing.T) {
+	always := corev1.ContainerRestartPolicyAlways
+	cpu := func(value string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(value)}}
+	}
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{
+			{Name: "sidecar", RestartPolicy: &always, Resources: cpu("200m")},
+			{Name: "migrate", Resources: cpu("2")},
+		},
+		Containers: []corev1.Container{{Name: "app", Resources: cpu("500m")}},
+		Overhead:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+	}}
+
+	requests := podSchedulingRequests(pod)
+	got := requests[corev1.ResourceCPU]
+	// The migrate step runs next to the sidecar: 2 + 0.2 beats app + sidecar (0.7).
+	if got.MilliValue() != 2250 {
+		t.Fatalf("expected 2250m effective cpu request, got %dm", got.MilliValue())
+	}
+}
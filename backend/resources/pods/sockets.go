@@ -0,0 +1,221 @@
+/*
+ * backend/resources/pods/sockets.go
+ *
+ * Socket inspector for one container.
+ * - SocketListCommand dumps /proc/net/{tcp,udp}[6] and each process's fd
+ *   links through exec. It is the data ss and netstat read, so it gives the
+ *   same answer in images that ship neither.
+ * - ParseSocketList splits the sockets into listeners and connections and
+ *   attributes each to its owning process where that process is visible.
+ */
+
+package pods
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// socketListScript prints a "@@ <table>" marker before each /proc/net table,
+// then the shell's own pid and, per process, a "## <pid> <comm>" marker
+// followed by `ls -l` of its fd directory (socket fds read "socket:[inode]").
+const socketListScript = `for t in tcp tcp6 udp udp6; do echo "@@ $t"; cat /proc/net/$t 2>/dev/null; done; echo "self $$"; for d in /proc/[0-9]*; do comm=; read -r comm 2>/dev/null < "$d/comm"; echo "## ${d#/proc/} $comm"; ls -l "$d/fd" 2>/dev/null; done`
+
+// SocketListCommand is the exec command that collects a container's sockets.
+var SocketListCommand = []string{"/bin/sh", "-c", socketListScript}
+
+// Socket is one TCP or UDP socket in the pod's network namespace. PID and
+// Process are empty when the owner is not visible from the container (another
+// container's process, or one running as a different user).
+type Socket struct {
+	Protocol      string `json:"protocol"`
+	State         string `json:"state"`
+	LocalAddress  string `json:"localAddress"`
+	LocalPort     int    `json:"localPort"`
+	RemoteAddress string `json:"remoteAddress,omitempty"`
+	RemotePort    int    `json:"remotePort,omitempty"`
+	PID           int    `json:"pid,omitempty"`
+	Process       string `json:"process,omitempty"`
+}
+
+// SocketList is a container's view of the pod's sockets. Listening holds TCP
+// listeners and bound, unconnected UDP sockets; Connections holds the rest.
+type SocketList struct {
+	Container   string    `json:"container"`
+	Listening   []Socket  `json:"listening"`
+	Connections []Socket  `json:"connections"`
+	SampledAt   time.Time `json:"sampledAt"`
+}
+
+// tcpStates names the st column of /proc/net/tcp (include/net/tcp_states.h).
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+}
+
+type socketOwner struct {
+	pid     int
+	process string
+}
+
+// ParseSocketList parses SocketListCommand output.
+func ParseSocketList(container string, output []byte, sampledAt time.Time) (SocketList, error) {
+	text := string(output)
+	tables, processes, found := strings.Cut(text, "\nself ")
+	if !found {
+		return SocketList{}, fmt.Errorf("unexpected socket list output")
+	}
+	owners := parseSocketOwners(processes)
+
+	list := SocketList{Container: container, Listening: []Socket{}, Connections: []Socket{}, SampledAt: sampledAt}
+	protocol := ""
+	for _, line := range strings.Split(tables, "\n") {
+		if name, ok := strings.CutPrefix(line, "@@ "); ok {
+			protocol = strings.TrimSpace(name)
+			continue
+		}
+		socket, inode, ok := parseProcNetLine(protocol, line)
+		if !ok {
+			continue
+		}
+		if owner, known := owners[inode]; known {
+			socket.PID, socket.Process = owner.pid, owner.process
+		}
+		if socket.State == "LISTEN" || socket.State == "UNCONN" {
+			list.Listening = append(list.Listening, socket)
+		} else {
+			list.Connections = append(list.Connections, socket)
+		}
+	}
+	sortSockets(list.Listening)
+	sortSockets(list.Connections)
+	return list, nil
+}
+
+// parseSocketOwners maps socket inodes to the process holding them. The first
+// line is the exec shell's own pid, whose fds are skipped.
+func parseSocketOwners(text string) map[string]socketOwner {
+	owners := make(map[string]socketOwner)
+	selfLine, body, _ := strings.Cut(text, "\n")
+	self := strings.TrimSpace(selfLine)
+	var current socketOwner
+	skip := true
+	for _, line := range strings.Split(body, "\n") {
+		if header, ok := strings.CutPrefix(line, "## "); ok {
+			pidText, comm, _ := strings.Cut(header, " ")
+			pid, err := strconv.Atoi(pidText)
+			skip = err != nil || pidText == self
+			current = socketOwner{pid: pid, process: strings.TrimSpace(comm)}
+			continue
+		}
+		if skip {
+			continue
+		}
+		_, target, ok := strings.Cut(line, "-> socket:[")
+		if !ok {
+			continue
+		}
+		inode, _, ok := strings.Cut(target, "]")
+		if !ok {
+			continue
+		}
+		if _, taken := owners[inode]; !taken {
+			owners[inode] = current
+		}
+	}
+	return owners
+}
+
+// parseProcNetLine parses one /proc/net/{tcp,udp}[6] row:
+// "sl local_address rem_address st ... uid timeout inode ...".
+func parseProcNetLine(protocol, line string) (Socket, string, bool) {
+	fields := strings.Fields(line)
+	if protocol == "" || len(fields) < 10 || !strings.HasSuffix(fields[0], ":") {
+		return Socket{}, "", false
+	}
+	localAddress, localPort, ok := parseProcNetAddress(fields[1])
+	if !ok {
+		return Socket{}, "", false
+	}
+	remoteAddress, remotePort, ok := parseProcNetAddress(fields[2])
+	if !ok {
+		return Socket{}, "", false
+	}
+	state := tcpStates[strings.ToUpper(fields[3])]
+	if strings.HasPrefix(protocol, "udp") {
+		// UDP reuses the TCP state numbers: 07 (CLOSE) is a bound socket with
+		// no peer, which ss reports as UNCONN.
+		if state == "CLOSE" {
+			state = "UNCONN"
+		}
+	}
+	if state == "" {
+		state = strings.ToUpper(fields[3])
+	}
+	socket := Socket{
+		Protocol:     protocol,
+		State:        state,
+		LocalAddress: localAddress,
+		LocalPort:    localPort,
+	}
+	if remotePort != 0 || !net.ParseIP(remoteAddress).IsUnspecified() {
+		socket.RemoteAddress, socket.RemotePort = remoteAddress, remotePort
+	}
+	return socket, fields[9], true
+}
+
+// parseProcNetAddress decodes "ADDR:PORT" where ADDR is the address as
+// host-order (little-endian) 32-bit words in hex and PORT is big-endian hex.
+func parseProcNetAddress(value string) (string, int, bool) {
+	addrHex, portHex, ok := strings.Cut(value, ":")
+	if !ok {
+		return "", 0, false
+	}
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return "", 0, false
+	}
+	raw, err := hex.DecodeString(addrHex)
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return "", 0, false
+	}
+	for word := 0; word < len(raw); word += 4 {
+		raw[word], raw[word+1], raw[word+2], raw[word+3] = raw[word+3], raw[word+2], raw[word+1], raw[word]
+	}
+	ip := net.IP(raw)
+	if v4 := ip.To4(); v4 != nil && len(raw) == net.IPv6len && !ip.IsUnspecified() {
+		// Dual-stack sockets report IPv4 peers as ::ffff:a.b.c.d.
+		ip = v4
+	}
+	return ip.String(), int(port), true
+}
+
+func sortSockets(sockets []Socket) {
+	sort.SliceStable(sockets, func(i, j int) bool {
+		left, right := sockets[i], sockets[j]
+		if left.LocalPort != right.LocalPort {
+			return left.LocalPort < right.LocalPort
+		}
+		if left.Protocol != right.Protocol {
+			return left.Protocol < right.Protocol
+		}
+		if left.RemoteAddress != right.RemoteAddress {
+			return left.RemoteAddress < right.RemoteAddress
+		}
+		return left.RemotePort < right.RemotePort
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * backend/resources/pods/sockets_test.go
+ *
+ * Tests for the container socket inspector parser.
+ * - Covers /proc/net address decoding, listener/connection split and owners.
+ */
+
+package pods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const procNetHeader = "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"
+
+func TestParseSocketListSplitsListenersAndConnections(t *testing.T) {
+	output := "@@ tcp\n" + procNetHeader +
+		"   0: 00000000:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000   101        0 1001 1 0000000000000000 100 0 0 10 0\n" +
+		"   1: 0F02000A:1F90 0A01000A:C350 01 00000000:00000000 02:000A7C2E 00000000   101        0 1002 1 0000000000000000 20 4 30 10 -1\n" +
+		"@@ tcp6\n" + procNetHeader +
+		"   0: 00000000000000000000000001000000:2382 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2001 1 0000000000000000 100 0 0 10 0\n" +
+		"   1: 0000000000000000FFFF00000F02000A:1F90 0000000000000000FFFF00000B01000A:D431 06 00000000:00000000 03:00000F2C 00000000     0        0 0 3 0000000000000000\n" +
+		"@@ udp\n" + procNetHeader +
+		"  10: 0A00000A:0035 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 3001 2 0000000000000000 0\n" +
+		"@@ udp6\n" +
+		"self 77\n" +
+		"## 1 nginx\n" +
+		"total 0\n" +
+		"lrwx------ 1 root root 64 Oct 16 10:00 0 -> /dev/null\n" +
+		"lrwx------ 1 root root 64 Oct 16 10:00 6 -> socket:[1001]\n" +
+		"## 12 nginx worker\n" +
+		"lrwx------ 1 root root 64 Oct 16 10:00 9 -> socket:[1002]\n" +
+		"lrwx------ 1 root root 64 Oct 16 10:00 6 -> socket:[1001]\n" +
+		"## 77 sh\n" +
+		"lrwx------ 1 root root 64 Oct 16 10:00 3 -> socket:[3001]\n"
+
+	list, err := ParseSocketList("web", []byte(output), time.Unix(0, 0))
+	require.NoError(t, err)
+	require.Equal(t, "web", list.Container)
+
+	require.Equal(t, []Socket{
+		{Protocol: "udp", State: "UNCONN", LocalAddress: "10.0.0.10", LocalPort: 53},
+		{Protocol: "tcp", State: "LISTEN", LocalAddress: "0.0.0.0", LocalPort: 8080, PID: 1, Process: "nginx"},
+		{Protocol: "tcp6", State: "LISTEN", LocalAddress: "::1", LocalPort: 9090},
+	}, list.Listening)
+	require.Equal(t, []Socket{
+		{Protocol: "tcp", State: "ESTABLISHED", LocalAddress: "10.0.2.15", LocalPort: 8080, RemoteAddress: "10.0.1.10", RemotePort: 50000, PID: 12, Process: "nginx worker"},
+		{Protocol: "tcp6", State: "TIME_WAIT", LocalAddress: "10.0.2.15", LocalPort: 8080, RemoteAddress: "10.0.1.11", RemotePort: 54321},
+	}, list.Connections)
+}
+
+func TestParseSocketListRejectsUnexpectedOutput(t *testing.T) {
+	_, err := ParseSocketList("web", []byte("sh: not found\n"), time.Now())
+	require.Error(t, err)
+
+	list, err := ParseSocketList("web", []byte("@@ tcp\n@@ tcp6\n@@ udp\n@@ udp6\nself 1\n"), time.Now())
+	require.NoError(t, err)
+	require.Empty(t, list.Listening)
+	require.Empty(t, list.Connections)
+}
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	"github.com/luxury-yacht/app/backend/resources/resourcequota"
 )
 
@@ -53,4 +54,15 @@ func TestBuildAggregateCapturesNamespaceQuotaPressure(t *testing.T) {
 	aggregate := resourcequota.BuildAggregate(quota)
 	require.Equal(t, "team-a", aggregate.Namespace)
 	require.Equal(t, 120, aggregate.HighestUsedPercentage)
+
+	row := resourcequota.BuildStreamSummary(streamrows.ClusterMeta{ClusterID: "cluster-a"}, quota)
+	require.Equal(t, map[string]int{"cpu": 120, "pods": 80}, row.UsedPercentage)
+	require.NotNil(t, row.HighestUsedPercentage)
+	require.Equal(t, 120, *row.HighestUsedPercentage)
+	require.Equal(t, "Hard: 2, Used: 2, Highest: cpu 120%", row.Details)
+
+	quota.Status = corev1.ResourceQuotaStatus{}
+	row = resourcequota.BuildStreamSummary(streamrows.ClusterMeta{ClusterID: "cluster-a"}, quota)
+	require.Nil(t, row.HighestUsedPercentage)
+	require.Equal(t, "Hard: 0, Used: 0", row.Details)
 }
@@ -9,6 +9,8 @@
 package resourcequota
 
 import (
+	"sort"
+
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -18,7 +20,13 @@ func BuildStreamSummary(meta streamrows.ClusterMeta, quota *corev1.ResourceQuota
 	if quota == nil {
 		return streamrows.QuotaSummary{}
 	}
-	return streamrows.NewQuotaSummary(meta, Identity, quota, DescribeSummary(BuildFacts(quota)))
+	facts := BuildFacts(quota)
+	row := streamrows.NewQuotaSummary(meta, Identity, quota, DescribeSummary(facts))
+	if _, highest, ok := HighestUsage(facts.UsedPercentage); ok {
+		row.UsedPercentage = facts.UsedPercentage
+		row.HighestUsedPercentage = &highest
+	}
+	return row
 }
 
 // BuildAggregate projects the namespace and strongest quota utilization from
@@ -27,14 +35,27 @@ func BuildAggregate(quota *corev1.ResourceQuota) streamrows.ResourceQuotaAggrega
 	if quota == nil {
 		return streamrows.ResourceQuotaAggregate{}
 	}
-	highest := 0
-	for _, percentage := range BuildFacts(quota).UsedPercentage {
-		if percentage > highest {
-			highest = percentage
-		}
-	}
+	_, highest, _ := HighestUsage(BuildFacts(quota).UsedPercentage)
 	return streamrows.ResourceQuotaAggregate{
 		Namespace:             quota.Namespace,
 		HighestUsedPercentage: highest,
 	}
 }
+
+// HighestUsage returns the most used resource in a UsedPercentage map and its
+// percentage. Ties go to the resource name that sorts first, so the result is
+// stable across rebuilds.
+func HighestUsage(usedPercentage map[string]int) (string, int, bool) {
+	names := make([]string, 0, len(usedPercentage))
+	for name := range usedPercentage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resource, highest := "", 0
+	for _, name := range names {
+		if resource == "" || usedPercentage[name] > highest {
+			resource, highest = name, usedPercentage[name]
+		}
+	}
+	return resource, highest, resource != ""
+}
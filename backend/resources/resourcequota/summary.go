@@ -8,7 +8,12 @@ package resourcequota
 
 import "fmt"
 
-// DescribeSummary formats the ResourceQuota streaming-row detail string from its facts.
+// DescribeSummary formats the ResourceQuota streaming-row detail string from its
+// facts, naming the most used resource when any has a hard limit.
 func DescribeSummary(facts Facts) string {
-	return fmt.Sprintf("Hard: %d, Used: %d", len(facts.Hard), len(facts.Used))
+	details := fmt.Sprintf("Hard: %d, Used: %d", len(facts.Hard), len(facts.Used))
+	if resource, highest, ok := HighestUsage(facts.UsedPercentage); ok {
+		details += fmt.Sprintf(", Highest: %s %d%%", resource, highest)
+	}
+	return details
 }
@@ -0,0 +1,17 @@
+/*
+ * backend/resources/role/objectmap.go
+ *
+ * Role's object-map status projection, co-located with its model.
+ */
+
+package role
+
+import (
+	"github.com/luxury-yacht/app/backend/kind/objectmap"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// ObjectMapStatus projects a Role into its object-map node status.
+func ObjectMapStatus(clusterID string, role rbacv1.Role) *objectmap.Status {
+	return objectmap.FromResourceModel(BuildResourceModel(clusterID, &role))
+}
@@ -12,5 +12,7 @@ var Descriptor = kindspec.Descriptor{
 	DetailCacheable: true,
 	IngestOwned:     true,
 	Stream:          &StreamDescriptor,
+	Collector:       &ObjectMapNode,
+	Edges:           ObjectMapEdges,
 	Binding:         &DetailBinding,
 }
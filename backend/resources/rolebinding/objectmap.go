@@ -0,0 +1,17 @@
+/*
+ * backend/resources/rolebinding/objectmap.go
+ *
+ * RoleBinding's object-map status projection, co-located with its model.
+ */
+
+package rolebinding
+
+import (
+	"github.com/luxury-yacht/app/backend/kind/objectmap"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// ObjectMapStatus projects a RoleBinding into its object-map node status.
+func ObjectMapStatus(clusterID string, binding rbacv1.RoleBinding) *objectmap.Status {
+	return objectmap.FromResourceModel(BuildResourceModel(clusterID, &binding))
+}
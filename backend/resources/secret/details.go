@@ -9,6 +9,8 @@ package secret
 
 import (
 	"fmt"
+	"sort"
+	"unicode/utf8"
 
 	"github.com/luxury-yacht/app/backend/internal/logsources"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
@@ -51,14 +53,10 @@ func (s *Service) processSecretDetails(sec *corev1.Secret, relationships *resour
 		Namespace:   sec.Namespace,
 		SecretType:  facts.Type,
 		DataKeys:    facts.DataKeys,
+		Keys:        dataKeyInfos(sec.Data),
 		DataCount:   facts.DataCount,
 		Labels:      sec.Labels,
 		Annotations: sec.Annotations,
-		Data:        make(map[string]string, len(sec.Data)),
-	}
-
-	for key, value := range sec.Data {
-		details.Data[key] = string(value)
 	}
 
 	details.UsedBy = restypes.ObjectRefsFromResourceLinks(facts.UsedBy)
@@ -79,3 +77,15 @@ func (s *Service) listNamespacePods(namespace string) *corev1.PodList {
 	}
 	return pods
 }
+
+// dataKeyInfos lists Secret keys with their decoded sizes, sorted by key.
+// Values that are not UTF-8 text are marked binary so the UI fetches them
+// base64-encoded.
+func dataKeyInfos(data map[string][]byte) []restypes.DataKeyInfo {
+	infos := make([]restypes.DataKeyInfo, 0, len(data))
+	for key, value := range data {
+		infos = append(infos, restypes.DataKeyInfo{Key: key, Size: len(value), Binary: !utf8.Valid(value)})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+	return infos
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/luxury-yacht/app/backend/internal/applog"
 	"github.com/luxury-yacht/app/backend/resources/secret"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
 	"github.com/luxury-yacht/app/backend/testsupport"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -83,3 +84,17 @@ func TestServiceSecretDetailsIncludesUsage(t *testing.T) {
 	require.Equal(t, "api-0", detail.UsedBy[0].Name)
 	require.Contains(t, detail.Details, "Opaque")
 }
+
+func TestServiceSecretDetailsOmitDecodedValues(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	}
+	service := newService(t, fake.NewClientset(sec))
+
+	detail, err := service.Secret("default", "creds")
+	require.NoError(t, err)
+	require.Equal(t, []string{"password"}, detail.DataKeys)
+	require.Equal(t, []restypes.DataKeyInfo{{Key: "password", Size: 7}}, detail.Keys)
+	require.NotContains(t, detail.Details, "hunter2")
+}
@@ -2,7 +2,8 @@
  * backend/resources/secret/dto.go
  *
  * Secret detail DTO (the frontend wire shape), co-located with its model and
- * detail builder. UsedBy uses the shared restypes.ObjectRef.
+ * detail builder. UsedBy uses the shared restypes.ObjectRef. Details never
+ * carry decoded values; SecretRevealResult is the only value-bearing shape.
  */
 
 package secret
@@ -10,15 +11,29 @@ package secret
 import restypes "github.com/luxury-yacht/app/backend/resources/types"
 
 type SecretDetails struct {
-	Kind        string               `json:"kind"`
-	Name        string               `json:"name"`
-	Namespace   string               `json:"namespace"`
-	Details     string               `json:"details"`
-	SecretType  string               `json:"secretType"`
-	Data        map[string]string    `json:"data,omitempty"`
-	DataKeys    []string             `json:"dataKeys"`
-	DataCount   int                  `json:"dataCount"`
-	Labels      map[string]string    `json:"labels,omitempty"`
-	Annotations map[string]string    `json:"annotations,omitempty"`
-	UsedBy      []restypes.ObjectRef `json:"usedBy,omitempty"`
+	Kind        string                 `json:"kind"`
+	Name        string                 `json:"name"`
+	Namespace   string                 `json:"namespace"`
+	Details     string                 `json:"details"`
+	SecretType  string                 `json:"secretType"`
+	DataKeys    []string               `json:"dataKeys"`
+	Keys        []restypes.DataKeyInfo `json:"keys"`
+	DataCount   int                    `json:"dataCount"`
+	Labels      map[string]string      `json:"labels,omitempty"`
+	Annotations map[string]string      `json:"annotations,omitempty"`
+	UsedBy      []restypes.ObjectRef   `json:"usedBy,omitempty"`
+}
+
+// SecretRevealResult carries decoded values for an audited reveal request.
+// RedactedKeys matched a configured redaction pattern and are withheld even
+// though they exist; MissingKeys were requested but are not in the Secret.
+// BinaryKeys are values that are not UTF-8 text, returned base64-encoded.
+type SecretRevealResult struct {
+	ClusterID    string            `json:"clusterId"`
+	Namespace    string            `json:"namespace"`
+	Name         string            `json:"name"`
+	Values       map[string]string `json:"values"`
+	RedactedKeys []string          `json:"redactedKeys,omitempty"`
+	MissingKeys  []string          `json:"missingKeys,omitempty"`
+	BinaryKeys   []string          `json:"binaryKeys,omitempty"`
 }
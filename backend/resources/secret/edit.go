@@ -0,0 +1,277 @@
+/*
+ * backend/resources/secret/edit.go
+ *
+ * Typed Secret editing. Callers send plaintext values; the API machinery
+ * base64-encodes Data on the wire, the edited data is validated against the
+ * Secret's type, and the change is written with server-side apply so only the
+ * keys this editor owns are claimed.
+ */
+
+package secret
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// EditFieldManager is the server-side apply field manager for Secret edits.
+const EditFieldManager = "luxury-yacht-secret-editor"
+
+// SecretEditRequest changes some keys of one Secret. Set values are plaintext
+// and replace (or add) the key; Remove deletes keys. Keys named in neither keep
+// their current value, so callers never need to read values they do not edit.
+// ResourceVersion, when set, makes the edit fail if the Secret changed since it
+// was read.
+type SecretEditRequest struct {
+	Namespace       string            `json:"namespace"`
+	Name            string            `json:"name"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Set             map[string]string `json:"set,omitempty"`
+	Remove          []string          `json:"remove,omitempty"`
+}
+
+// SecretEditResult reports the written Secret. It lists key names only.
+type SecretEditResult struct {
+	ClusterID       string   `json:"clusterId"`
+	Namespace       string   `json:"namespace"`
+	Name            string   `json:"name"`
+	SecretType      string   `json:"secretType"`
+	ResourceVersion string   `json:"resourceVersion"`
+	DataKeys        []string `json:"dataKeys"`
+}
+
+// EditValues validates req against the live Secret's type and writes it. Set
+// keys are applied server-side under EditFieldManager, taking ownership from
+// other managers; removed keys another manager still owns are cleared with a
+// follow-up merge patch pinned to the applied resourceVersion.
+func (s *Service) EditValues(req SecretEditRequest) (*SecretEditResult, error) {
+	if len(req.Set) == 0 && len(req.Remove) == 0 {
+		return nil, fmt.Errorf("secret edit has no changes")
+	}
+	for _, key := range req.Remove {
+		if _, ok := req.Set[key]; ok {
+			return nil, fmt.Errorf("key %q is both set and removed", key)
+		}
+	}
+	for key := range req.Set {
+		if problems := validation.IsConfigMapKey(key); len(problems) > 0 {
+			return nil, fmt.Errorf("invalid key %q: %s", key, strings.Join(problems, "; "))
+		}
+	}
+
+	secrets := s.deps.KubernetesClient.CoreV1().Secrets(req.Namespace)
+	live, err := secrets.Get(s.deps.Context, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+	if req.ResourceVersion != "" && req.ResourceVersion != live.ResourceVersion {
+		return nil, fmt.Errorf("secret %s/%s changed since it was loaded (resourceVersion %s, now %s)",
+			req.Namespace, req.Name, req.ResourceVersion, live.ResourceVersion)
+	}
+	if live.Immutable != nil && *live.Immutable {
+		return nil, fmt.Errorf("secret %s/%s is immutable", req.Namespace, req.Name)
+	}
+
+	removed := make(map[string]struct{}, len(req.Remove))
+	for _, key := range req.Remove {
+		removed[key] = struct{}{}
+	}
+	edited := make(map[string][]byte, len(live.Data)+len(req.Set))
+	for key, value := range live.Data {
+		if _, drop := removed[key]; !drop {
+			edited[key] = value
+		}
+	}
+	for key, value := range req.Set {
+		edited[key] = []byte(value)
+	}
+	if err := ValidateTypedData(live.Type, edited); err != nil {
+		return nil, err
+	}
+
+	// An apply must restate every key this manager already owns, or the
+	// server deletes the ones it leaves out.
+	owned := ownedDataKeys(live, EditFieldManager)
+	applied := make(map[string][]byte, len(owned)+len(req.Set))
+	for _, key := range owned {
+		if _, drop := removed[key]; drop {
+			continue
+		}
+		if value, ok := live.Data[key]; ok {
+			applied[key] = value
+		}
+	}
+	for key, value := range req.Set {
+		applied[key] = []byte(value)
+	}
+
+	result := live
+	if len(applied) > 0 || len(owned) > 0 {
+		cfg := corev1ac.Secret(req.Name, req.Namespace).
+			WithResourceVersion(live.ResourceVersion).
+			WithData(applied)
+		result, err = secrets.Apply(s.deps.Context, cfg, metav1.ApplyOptions{FieldManager: EditFieldManager, Force: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply secret: %w", err)
+		}
+	}
+
+	lingering := make(map[string]interface{})
+	for key := range removed {
+		if _, ok := result.Data[key]; ok {
+			lingering[key] = nil
+		}
+	}
+	if len(lingering) > 0 {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"resourceVersion": result.ResourceVersion},
+			"data":     lingering,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to build secret key removal patch: %w", err)
+		}
+		result, err = secrets.Patch(s.deps.Context, req.Name, types.MergePatchType, patch,
+			metav1.PatchOptions{FieldManager: EditFieldManager})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove secret keys: %w", err)
+		}
+	}
+
+	keys := make([]string, 0, len(result.Data))
+	for key := range result.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return &SecretEditResult{
+		ClusterID:       s.deps.ClusterID,
+		Namespace:       result.Namespace,
+		Name:            result.Name,
+		SecretType:      string(result.Type),
+		ResourceVersion: result.ResourceVersion,
+		DataKeys:        keys,
+	}, nil
+}
+
+// ValidateTypedData checks decoded data against the keys the Secret type
+// requires. It goes beyond the API server, which only checks key presence:
+// docker configs must parse and TLS certificates must match their key.
+func ValidateTypedData(secretType corev1.SecretType, data map[string][]byte) error {
+	var problems []string
+	require := func(key string) bool {
+		if _, ok := data[key]; !ok {
+			problems = append(problems, fmt.Sprintf("missing required key %q", key))
+			return false
+		}
+		return true
+	}
+
+	switch secretType {
+	case corev1.SecretTypeDockerConfigJson:
+		if require(corev1.DockerConfigJsonKey) {
+			var config struct {
+				Auths map[string]json.RawMessage `json:"auths"`
+			}
+			if err := json.Unmarshal(data[corev1.DockerConfigJsonKey], &config); err != nil {
+				problems = append(problems, fmt.Sprintf("%s is not valid JSON: %v", corev1.DockerConfigJsonKey, err))
+			} else if config.Auths == nil {
+				problems = append(problems, fmt.Sprintf("%s has no \"auths\" object", corev1.DockerConfigJsonKey))
+			} else {
+				problems = append(problems, validateDockerAuths(config.Auths)...)
+			}
+		}
+	case corev1.SecretTypeDockercfg:
+		if require(corev1.DockerConfigKey) {
+			var auths map[string]json.RawMessage
+			if err := json.Unmarshal(data[corev1.DockerConfigKey], &auths); err != nil {
+				problems = append(problems, fmt.Sprintf("%s is not a valid JSON object: %v", corev1.DockerConfigKey, err))
+			} else {
+				problems = append(problems, validateDockerAuths(auths)...)
+			}
+		}
+	case corev1.SecretTypeTLS:
+		certOK := require(corev1.TLSCertKey)
+		keyOK := require(corev1.TLSPrivateKeyKey)
+		if certOK && keyOK {
+			if _, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey]); err != nil {
+				problems = append(problems, fmt.Sprintf("%s and %s do not form a key pair: %v",
+					corev1.TLSCertKey, corev1.TLSPrivateKeyKey, err))
+			}
+		}
+	case corev1.SecretTypeBasicAuth:
+		_, hasUser := data[corev1.BasicAuthUsernameKey]
+		_, hasPassword := data[corev1.BasicAuthPasswordKey]
+		if !hasUser && !hasPassword {
+			problems = append(problems, fmt.Sprintf("requires %q or %q",
+				corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey))
+		}
+	case corev1.SecretTypeSSHAuth:
+		require(corev1.SSHAuthPrivateKey)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid %s secret: %s", secretType, strings.Join(problems, "; "))
+}
+
+// validateDockerAuths checks each registry entry's optional "auth" field, which
+// must be base64 of "username:password".
+func validateDockerAuths(auths map[string]json.RawMessage) []string {
+	registries := make([]string, 0, len(auths))
+	for registry := range auths {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	var problems []string
+	for _, registry := range registries {
+		var entry struct {
+			Auth string `json:"auth"`
+		}
+		if err := json.Unmarshal(auths[registry], &entry); err != nil {
+			problems = append(problems, fmt.Sprintf("registry %q entry is not an object", registry))
+			continue
+		}
+		if entry.Auth == "" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil || !strings.Contains(string(decoded), ":") {
+			problems = append(problems, fmt.Sprintf("registry %q auth must be base64 of username:password", registry))
+		}
+	}
+	return problems
+}
+
+// ownedDataKeys lists the data keys manager owns through server-side apply.
+func ownedDataKeys(sec *corev1.Secret, manager string) []string {
+	var keys []string
+	for _, entry := range sec.ManagedFields {
+		if entry.Manager != manager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields struct {
+			Data map[string]json.RawMessage `json:"f:data"`
+		}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		for field := range fields.Data {
+			if key, ok := strings.CutPrefix(field, "f:"); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,161 @@
+/*
+ * backend/resources/secret/edit_test.go
+ *
+ * Tests for typed Secret editing and type-specific validation.
+ */
+
+package secret_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func selfSignedPair(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "demo.local"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestServiceEditValuesSetsPlaintextAndKeepsOtherKeys(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("old"),
+			"stale":    []byte("x"),
+		},
+	}
+	client := fake.NewClientset(sec)
+	service := newService(t, client)
+
+	result, err := service.EditValues(secret.SecretEditRequest{
+		Namespace: "default",
+		Name:      "creds",
+		Set:       map[string]string{"password": "s3cret", "API_TOKEN": "abc"},
+		Remove:    []string{"stale"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cluster-a", result.ClusterID)
+	require.Equal(t, []string{"API_TOKEN", "password", "username"}, result.DataKeys)
+
+	stored, err := client.CoreV1().Secrets("default").Get(context.Background(), "creds", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		"username":  []byte("admin"),
+		"password":  []byte("s3cret"),
+		"API_TOKEN": []byte("abc"),
+	}, stored.Data)
+}
+
+func TestServiceEditValuesKeepsKeysSetByEarlierEdits(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("admin")},
+	}
+	client := fake.NewClientset(sec)
+	service := newService(t, client)
+
+	_, err := service.EditValues(secret.SecretEditRequest{Namespace: "default", Name: "creds", Set: map[string]string{"a": "1"}})
+	require.NoError(t, err)
+	_, err = service.EditValues(secret.SecretEditRequest{Namespace: "default", Name: "creds", Set: map[string]string{"b": "2"}})
+	require.NoError(t, err)
+	result, err := service.EditValues(secret.SecretEditRequest{Namespace: "default", Name: "creds", Remove: []string{"a"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "username"}, result.DataKeys)
+}
+
+func TestServiceEditValuesRejectsStaleAndImmutableSecrets(t *testing.T) {
+	immutable := true
+	client := fake.NewClientset(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default", ResourceVersion: "7"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "frozen", Namespace: "default"}, Immutable: &immutable},
+	)
+	service := newService(t, client)
+
+	_, err := service.EditValues(secret.SecretEditRequest{
+		Namespace: "default", Name: "creds", ResourceVersion: "6", Set: map[string]string{"a": "1"},
+	})
+	require.ErrorContains(t, err, "changed since it was loaded")
+
+	_, err = service.EditValues(secret.SecretEditRequest{Namespace: "default", Name: "frozen", Set: map[string]string{"a": "1"}})
+	require.ErrorContains(t, err, "immutable")
+
+	_, err = service.EditValues(secret.SecretEditRequest{Namespace: "default", Name: "creds", Set: map[string]string{"bad/key": "1"}})
+	require.ErrorContains(t, err, "invalid key")
+}
+
+func TestServiceEditValuesValidatesTLSPairing(t *testing.T) {
+	certPEM, keyPEM := selfSignedPair(t)
+	_, otherKey := selfSignedPair(t)
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+	}
+	client := fake.NewClientset(sec)
+	service := newService(t, client)
+
+	_, err := service.EditValues(secret.SecretEditRequest{
+		Namespace: "default", Name: "tls", Set: map[string]string{corev1.TLSPrivateKeyKey: string(otherKey)},
+	})
+	require.ErrorContains(t, err, "do not form a key pair")
+
+	_, err = service.EditValues(secret.SecretEditRequest{
+		Namespace: "default", Name: "tls", Remove: []string{corev1.TLSCertKey},
+	})
+	require.ErrorContains(t, err, `missing required key "tls.crt"`)
+
+	newCert, newKey := selfSignedPair(t)
+	_, err = service.EditValues(secret.SecretEditRequest{
+		Namespace: "default", Name: "tls",
+		Set: map[string]string{corev1.TLSCertKey: string(newCert), corev1.TLSPrivateKeyKey: string(newKey)},
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateTypedDataChecksDockerConfig(t *testing.T) {
+	valid := `{"auths":{"registry.example.com":{"auth":"dXNlcjpwYXNz"}}}`
+	require.NoError(t, secret.ValidateTypedData(corev1.SecretTypeDockerConfigJson, map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(valid),
+	}))
+
+	require.ErrorContains(t, secret.ValidateTypedData(corev1.SecretTypeDockerConfigJson, map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(`{"registry.example.com":{}}`),
+	}), `no "auths" object`)
+	require.ErrorContains(t, secret.ValidateTypedData(corev1.SecretTypeDockerConfigJson, map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"not-base64!"}}}`),
+	}), "base64 of username:password")
+	require.ErrorContains(t, secret.ValidateTypedData(corev1.SecretTypeDockerConfigJson, map[string][]byte{}),
+		`missing required key ".dockerconfigjson"`)
+	require.ErrorContains(t, secret.ValidateTypedData(corev1.SecretTypeBasicAuth, map[string][]byte{"token": nil}),
+		`requires "username" or "password"`)
+	require.NoError(t, secret.ValidateTypedData(corev1.SecretTypeOpaque, map[string][]byte{}))
+}
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	"github.com/luxury-yacht/app/backend/resourcemodel"
 	"github.com/luxury-yacht/app/backend/resources/secret"
 )
@@ -64,3 +65,13 @@ func TestBuildResourceModelTerminatingStatus(t *testing.T) {
 	require.Equal(t, "Opaque", model.Status.State)
 	require.Equal(t, "terminating", model.Status.Presentation)
 }
+
+func TestBuildAggregateHashesKeysWithoutValues(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-secret", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	aggregate := secret.BuildAggregate(sec)
+	require.Equal(t, map[string]string{"password": streamrows.HashConfigValue([]byte("secret"))}, aggregate.Hashes)
+	require.Nil(t, aggregate.Values)
+}
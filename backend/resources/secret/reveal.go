@@ -0,0 +1,115 @@
+/*
+ * backend/resources/secret/reveal.go
+ *
+ * Decoded Secret value reveal. Detail payloads carry only key names; decoded
+ * values leave the backend solely through RevealValues and RevealValueRange,
+ * after key-pattern redaction has been applied.
+ */
+
+package secret
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedactionPatterns matches Secret data keys that must never be revealed.
+// Patterns use path.Match glob syntax (e.g. "*_TOKEN") and compare
+// case-insensitively, so "*_token" also redacts "API_TOKEN".
+type RedactionPatterns []string
+
+// Validate reports the first malformed pattern.
+func (p RedactionPatterns) Validate() error {
+	for _, pattern := range p {
+		if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+			return fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether key matches any redaction pattern. Malformed
+// patterns never match; callers validate patterns before persisting them.
+func (p RedactionPatterns) Matches(key string) bool {
+	lowered := strings.ToLower(key)
+	for _, pattern := range p {
+		if matched, err := path.Match(strings.ToLower(pattern), lowered); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// RevealValues fetches the Secret and returns the decoded values for keys.
+// An empty keys list reveals every key. Keys matching redactions are reported
+// in RedactedKeys and never included in Values; requested keys the Secret does
+// not contain are reported in MissingKeys. Values that are not UTF-8 text come
+// back base64-encoded and are listed in BinaryKeys.
+func (s *Service) RevealValues(namespace, name string, keys []string, redactions RedactionPatterns) (*SecretRevealResult, error) {
+	sec, err := s.deps.KubernetesClient.CoreV1().Secrets(namespace).Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+
+	requested := keys
+	if len(requested) == 0 {
+		requested = make([]string, 0, len(sec.Data))
+		for key := range sec.Data {
+			requested = append(requested, key)
+		}
+	}
+	sort.Strings(requested)
+
+	result := &SecretRevealResult{
+		ClusterID: s.deps.ClusterID,
+		Namespace: namespace,
+		Name:      name,
+		Values:    make(map[string]string, len(requested)),
+	}
+	seen := make(map[string]struct{}, len(requested))
+	for _, key := range requested {
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		value, ok := sec.Data[key]
+		switch {
+		case !ok:
+			result.MissingKeys = append(result.MissingKeys, key)
+		case redactions.Matches(key):
+			result.RedactedKeys = append(result.RedactedKeys, key)
+		case !utf8.Valid(value):
+			result.Values[key] = base64.StdEncoding.EncodeToString(value)
+			result.BinaryKeys = append(result.BinaryKeys, key)
+		default:
+			result.Values[key] = string(value)
+		}
+	}
+	return result, nil
+}
+
+// RevealValueRange returns one byte range of a single key, for values too large
+// to reveal whole. Values that are not UTF-8 text come back base64-encoded.
+// Redacted and missing keys are errors rather than partial results.
+func (s *Service) RevealValueRange(namespace, name, key string, rng restypes.DataValueRange, redactions RedactionPatterns) (*restypes.DataValue, error) {
+	if redactions.Matches(key) {
+		return nil, fmt.Errorf("key %q matches a redaction pattern", key)
+	}
+	sec, err := s.deps.KubernetesClient.CoreV1().Secrets(namespace).Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+	value, ok := sec.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no key %q", namespace, name, key)
+	}
+	chunk := restypes.SliceDataValue(key, value, !utf8.Valid(value), rng)
+	return &chunk, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * backend/resources/secret/reveal_test.go
+ *
+ * Tests for Secret value reveal and key-pattern redaction.
+ */
+
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRedactionPatternsMatchCaseInsensitively(t *testing.T) {
+	patterns := secret.RedactionPatterns{"*_TOKEN", "password"}
+
+	require.True(t, patterns.Matches("API_TOKEN"))
+	require.True(t, patterns.Matches("api_token"))
+	require.True(t, patterns.Matches("Password"))
+	require.False(t, patterns.Matches("username"))
+	require.False(t, secret.RedactionPatterns(nil).Matches("API_TOKEN"))
+}
+
+func TestRedactionPatternsValidateRejectsMalformedGlob(t *testing.T) {
+	require.NoError(t, secret.RedactionPatterns{"*_KEY", "tls.*"}.Validate())
+	require.ErrorContains(t, secret.RedactionPatterns{"ok", "[bad"}.Validate(), "[bad")
+}
+
+func TestServiceRevealValuesAppliesRedactions(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"username":  []byte("admin"),
+			"API_TOKEN": []byte("abc123"),
+		},
+	}
+	service := newService(t, fake.NewClientset(sec))
+
+	result, err := service.RevealValues("default", "creds", nil, secret.RedactionPatterns{"*_token"})
+	require.NoError(t, err)
+	require.Equal(t, "cluster-a", result.ClusterID)
+	require.Equal(t, map[string]string{"username": "admin"}, result.Values)
+	require.Equal(t, []string{"API_TOKEN"}, result.RedactedKeys)
+	require.Empty(t, result.MissingKeys)
+}
+
+func TestServiceRevealValuesEncodesBinaryValues(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"ca.crt":   []byte("-----BEGIN CERTIFICATE-----"),
+			"keystore": {0x00, 0xff, 0xfe},
+		},
+	}
+	service := newService(t, fake.NewClientset(sec))
+
+	result, err := service.RevealValues("default", "tls", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "-----BEGIN CERTIFICATE-----", result.Values["ca.crt"])
+	require.Equal(t, "AP/+", result.Values["keystore"])
+	require.Equal(t, []string{"keystore"}, result.BinaryKeys)
+}
+
+func TestServiceRevealValuesReportsMissingKeys(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("admin")},
+	}
+	service := newService(t, fake.NewClientset(sec))
+
+	result, err := service.RevealValues("default", "creds", []string{"username", "absent", "username"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"username": "admin"}, result.Values)
+	require.Equal(t, []string{"absent"}, result.MissingKeys)
+}
+
+func TestServiceRevealValuesPropagatesGetError(t *testing.T) {
+	service := newService(t, fake.NewClientset())
+
+	_, err := service.RevealValues("default", "missing", nil, nil)
+	require.ErrorContains(t, err, "failed to get secret")
+}
+
+func TestServiceRevealValueRangePagesOneKey(t *testing.T) {
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bundle", Namespace: "default"},
+		Data: map[string][]byte{
+			"ca.crt":    []byte("-----BEGIN CERTIFICATE-----"),
+			"keystore":  {0xfe, 0xed, 0xfe, 0xed},
+			"API_TOKEN": []byte("abc123"),
+		},
+	}
+	service := newService(t, fake.NewClientset(sec))
+
+	chunk, err := service.RevealValueRange("default", "bundle", "ca.crt", restypes.DataValueRange{Limit: 11}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "-----BEGIN ", chunk.Value)
+	require.True(t, chunk.Truncated)
+	require.Equal(t, 27, chunk.Size)
+
+	keystore, err := service.RevealValueRange("default", "bundle", "keystore", restypes.DataValueRange{}, nil)
+	require.NoError(t, err)
+	require.True(t, keystore.Binary)
+	require.Equal(t, "/u3+7Q==", keystore.Value)
+
+	_, err = service.RevealValueRange("default", "bundle", "API_TOKEN", restypes.DataValueRange{}, secret.RedactionPatterns{"*_token"})
+	require.ErrorContains(t, err, "redaction pattern")
+}
@@ -29,6 +29,9 @@ var StreamDescriptor = streamspec.Descriptor{
 	StreamRow: func(meta streamrows.ClusterMeta, obj metav1.Object) any {
 		return BuildStreamSummary(meta, obj.(*corev1.Secret))
 	},
+	AggregateRow: func(obj metav1.Object) any {
+		return BuildAggregate(obj.(*corev1.Secret))
+	},
 	Informer: func(factory informers.SharedInformerFactory) cache.SharedIndexInformer {
 		return factory.Core().V1().Secrets().Informer()
 	},
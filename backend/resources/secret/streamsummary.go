@@ -9,6 +9,7 @@
 package secret
 
 import (
+	"github.com/luxury-yacht/app/backend/certexpiry"
 	"github.com/luxury-yacht/app/backend/kind/streamrows"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -19,13 +20,40 @@ func BuildStreamSummary(meta streamrows.ClusterMeta, sec *corev1.Secret) streamr
 		return streamrows.ConfigSummary{}
 	}
 	facts := BuildFacts(sec, nil)
-	return streamrows.ConfigSummary{
+	summary := streamrows.ConfigSummary{
 		Ref:          streamrows.NewResourceRef(meta, Identity, sec),
 		TypeAlias:    streamSummaryTypeAlias(sec),
 		Data:         facts.DataCount,
 		Age:          streamrows.FormatAge(sec.GetCreationTimestamp().Time),
 		AgeTimestamp: streamrows.CreationMillis(sec),
 	}
+	if sec.Type == corev1.SecretTypeTLS {
+		bundles := make([][]byte, 0, len(certexpiry.TLSSecretCertificateKeys))
+		for _, key := range certexpiry.TLSSecretCertificateKeys {
+			bundles = append(bundles, sec.Data[key])
+		}
+		if notAfter, ok := certexpiry.EarliestNotAfter(bundles...); ok {
+			summary.CertificateNotAfter = notAfter.UnixMilli()
+		}
+	}
+	return summary
+}
+
+// BuildAggregate projects a digest of each data key for the config change history.
+// Secret values are never kept.
+func BuildAggregate(sec *corev1.Secret) streamrows.ConfigDataAggregate {
+	if sec == nil {
+		return streamrows.ConfigDataAggregate{}
+	}
+	aggregate := streamrows.ConfigDataAggregate{
+		Namespace: sec.Namespace,
+		Name:      sec.Name,
+		Hashes:    make(map[string]string, len(sec.Data)),
+	}
+	for key, value := range sec.Data {
+		aggregate.Hashes[key] = streamrows.HashConfigValue(value)
+	}
+	return aggregate
 }
 
 // streamSummaryTypeAlias renders the short Secret type label shown in the config
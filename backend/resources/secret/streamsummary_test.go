@@ -0,0 +1,58 @@
+package secret_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/luxury-yacht/app/backend/kind/streamrows"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+)
+
+func testCertificatePEM(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestBuildStreamSummaryReportsTLSCertificateExpiry(t *testing.T) {
+	leafExpiry := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	caExpiry := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	tlsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress-tls", Namespace: "default"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:              testCertificatePEM(t, leafExpiry),
+			corev1.ServiceAccountRootCAKey: testCertificatePEM(t, caExpiry),
+			corev1.TLSPrivateKeyKey:        []byte("key"),
+		},
+	}
+	opaque := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{corev1.TLSCertKey: testCertificatePEM(t, caExpiry)},
+	}
+
+	meta := streamrows.ClusterMeta{ClusterID: "cluster-a"}
+	require.Equal(t, caExpiry.UnixMilli(), secret.BuildStreamSummary(meta, tlsSecret).CertificateNotAfter)
+	require.Zero(t, secret.BuildStreamSummary(meta, opaque).CertificateNotAfter)
+}
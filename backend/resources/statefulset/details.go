@@ -13,6 +13,7 @@ package statefulset
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/luxury-yacht/app/backend/internal/logsources"
 	"github.com/luxury-yacht/app/backend/resources/common"
@@ -54,7 +55,12 @@ func (s *Service) StatefulSet(namespace, name string) (*StatefulSetDetails, erro
 		s.deps.Logger.Warn(fmt.Sprintf("Failed to collect pods for StatefulSet %s/%s: %v", namespace, name, err), logsources.ResourceLoader)
 	}
 
-	return s.buildStatefulSetDetails(ss, podsForSet, podMetrics), nil
+	details := s.buildStatefulSetDetails(ss, podsForSet, podMetrics)
+	if err == nil {
+		// Without the pod list every ordinal would read as missing.
+		details.Ordinals = BuildOrdinals(ss, podsForSet, time.Now())
+	}
+	return details, nil
 }
 
 func (s *Service) buildStatefulSetDetails(
@@ -119,5 +119,8 @@ func TestStatefulSetServiceReturnsDetail(t *testing.T) {
 	require.Equal(t, "10Gi", detail.VolumeClaimTemplates[0].StorageRequest)
 	require.Contains(t, detail.Conditions, "Ready: True (AllReplicasReady)")
 	require.Equal(t, "Ready: 2/2, Service: db-svc, 1 PVC template(s)", detail.Details)
+	require.Len(t, detail.Ordinals, 2)
+	require.Equal(t, "node-b", detail.Ordinals[1].Node)
+	require.True(t, detail.Ordinals[0].Partitioned, "ordinal 0 sits below partition 1")
 	require.Equal(t, "db", detail.Name)
 }
@@ -62,6 +62,9 @@ type StatefulSetDetails struct {
 	Pods              []restypes.PodSimpleInfo    `json:"pods,omitempty"`
 	PodMetricsSummary *restypes.PodMetricsSummary `json:"podMetricsSummary,omitempty"`
 
+	// Ordinals lists each ordinal's pod and the revision it runs, lowest first.
+	Ordinals []OrdinalStatus `json:"ordinals,omitempty"`
+
 	// Revision information
 	CurrentRevision string `json:"currentRevision,omitempty"`
 	UpdateRevision  string `json:"updateRevision,omitempty"`
@@ -82,3 +85,25 @@ type VolumeClaimTemplateSummary struct {
 	AccessModes    []string `json:"accessModes,omitempty"`    // e.g. ["ReadWriteOnce"]
 	VolumeMode     string   `json:"volumeMode,omitempty"`     // "Filesystem" (default) or "Block"
 }
+
+// OrdinalStatus is one StatefulSet ordinal: its pod, if any, and whether that
+// pod runs the update revision.
+type OrdinalStatus struct {
+	Ordinal int32  `json:"ordinal"`
+	PodName string `json:"podName"`
+	// Missing is set when no pod exists for a desired ordinal; Extra when a
+	// pod exists for an ordinal beyond the desired replicas (scale-down).
+	Missing  bool   `json:"missing,omitempty"`
+	Extra    bool   `json:"extra,omitempty"`
+	Phase    string `json:"phase,omitempty"`
+	Ready    bool   `json:"ready"`
+	Node     string `json:"node,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	UpToDate bool   `json:"upToDate"`
+	// Partitioned ordinals sit below the rolling update partition and keep
+	// the current revision until the partition is lowered.
+	Partitioned bool `json:"partitioned,omitempty"`
+	Terminating bool `json:"terminating,omitempty"`
+	// Stuck is set when a terminating pod has outlived its grace period.
+	Stuck bool `json:"stuck,omitempty"`
+}
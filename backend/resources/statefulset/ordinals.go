@@ -0,0 +1,232 @@
+/*
+ * backend/resources/statefulset/ordinals.go
+ *
+ * Ordinal-aware StatefulSet operations: per-ordinal revision status for the
+ * detail view, deleting or force-deleting the pod at one ordinal, and moving
+ * the rolling update partition.
+ */
+
+package statefulset
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+// BuildOrdinals reports every desired ordinal plus any pod left over from a
+// scale-down, lowest ordinal first. now decides whether a terminating pod has
+// outlived its grace period.
+func BuildOrdinals(statefulSet *appsv1.StatefulSet, pods []corev1.Pod, now time.Time) []OrdinalStatus {
+	start := ordinalStart(statefulSet)
+	desired := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		desired = *statefulSet.Spec.Replicas
+	}
+	partition := partitionOf(statefulSet)
+	updateRevision := statefulSet.Status.UpdateRevision
+
+	byOrdinal := make(map[int32]*corev1.Pod, len(pods))
+	for i := range pods {
+		if ordinal, ok := PodOrdinal(statefulSet.Name, pods[i].Name); ok {
+			byOrdinal[ordinal] = &pods[i]
+		}
+	}
+
+	result := make([]OrdinalStatus, 0, max(int(desired), len(byOrdinal)))
+	appendOrdinal := func(ordinal int32, extra bool) {
+		status := OrdinalStatus{
+			Ordinal:     ordinal,
+			PodName:     OrdinalPodName(statefulSet.Name, ordinal),
+			Extra:       extra,
+			Partitioned: partitioned(statefulSet, ordinal, partition),
+		}
+		pod := byOrdinal[ordinal]
+		if pod == nil {
+			status.Missing = true
+			result = append(result, status)
+			return
+		}
+		status.Phase = string(pod.Status.Phase)
+		status.Ready = podReady(pod)
+		status.Node = pod.Spec.NodeName
+		status.Revision = pod.Labels[appsv1.ControllerRevisionHashLabelKey]
+		status.UpToDate = updateRevision != "" && status.Revision == updateRevision
+		if pod.DeletionTimestamp != nil {
+			status.Terminating = true
+			status.Stuck = now.After(pod.DeletionTimestamp.Time)
+		}
+		result = append(result, status)
+	}
+
+	for ordinal := start; ordinal < start+desired; ordinal++ {
+		appendOrdinal(ordinal, false)
+	}
+	for ordinal := range byOrdinal {
+		if ordinal < start || ordinal >= start+desired {
+			appendOrdinal(ordinal, true)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Ordinal < result[j].Ordinal })
+	return result
+}
+
+// OrdinalPodName is the name of the pod a StatefulSet runs at ordinal.
+func OrdinalPodName(statefulSetName string, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", statefulSetName, ordinal)
+}
+
+// PodOrdinal parses the ordinal from a StatefulSet pod name.
+func PodOrdinal(statefulSetName, podName string) (int32, bool) {
+	suffix, ok := strings.CutPrefix(podName, statefulSetName+"-")
+	if !ok || suffix == "" {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(suffix, 10, 32)
+	if err != nil || ordinal < 0 || strconv.FormatInt(ordinal, 10) != suffix {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+// DeleteOrdinal deletes the pod at ordinal so the controller recreates it.
+// It returns the deleted pod's name.
+func (s *Service) DeleteOrdinal(namespace, name string, ordinal int32) (string, error) {
+	pod, err := s.ordinalPod(namespace, name, ordinal)
+	if err != nil {
+		return "", err
+	}
+	if err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Delete(s.deps.Context, pod.Name, metav1.DeleteOptions{}); err != nil {
+		return "", fmt.Errorf("failed to delete pod %s: %w", pod.Name, err)
+	}
+	return pod.Name, nil
+}
+
+// ForceDeleteOrdinal removes a pod at ordinal that is stuck terminating,
+// skipping the grace period. A StatefulSet will not replace an ordinal while
+// its old pod still exists, so this unblocks a pod whose node is gone. The pod
+// must already be terminating: force-deleting a running pod risks two pods
+// with the same identity.
+func (s *Service) ForceDeleteOrdinal(namespace, name string, ordinal int32) (string, error) {
+	pod, err := s.ordinalPod(namespace, name, ordinal)
+	if err != nil {
+		return "", err
+	}
+	if pod.DeletionTimestamp == nil {
+		return "", fmt.Errorf("pod %s is not terminating; delete it first", pod.Name)
+	}
+	opts := metav1.DeleteOptions{
+		GracePeriodSeconds: ptr.To(int64(0)),
+		Preconditions:      &metav1.Preconditions{UID: &pod.UID},
+	}
+	if err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Delete(s.deps.Context, pod.Name, opts); err != nil {
+		return "", fmt.Errorf("failed to force delete pod %s: %w", pod.Name, err)
+	}
+	return pod.Name, nil
+}
+
+// SetPartition moves the rolling update partition: ordinals at or above it
+// update to the new revision, those below keep the current one. It returns
+// the previous partition.
+func (s *Service) SetPartition(namespace, name string, partition int32) (int32, error) {
+	if partition < 0 {
+		return 0, fmt.Errorf("partition must be non-negative")
+	}
+	statefulSet, err := s.getStatefulSet(namespace, name)
+	if err != nil {
+		return 0, err
+	}
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return 0, fmt.Errorf("statefulset %s/%s uses the OnDelete strategy; partitions apply to RollingUpdate only", namespace, name)
+	}
+	previous := partitionOf(statefulSet)
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"updateStrategy": map[string]any{
+				"type":          appsv1.RollingUpdateStatefulSetStrategyType,
+				"rollingUpdate": map[string]any{"partition": partition},
+			},
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal partition patch: %w", err)
+	}
+	if _, err := s.deps.KubernetesClient.AppsV1().StatefulSets(namespace).Patch(s.deps.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return 0, fmt.Errorf("failed to set partition on statefulset %s/%s: %w", namespace, name, err)
+	}
+	return previous, nil
+}
+
+// ordinalPod fetches the pod at ordinal and checks the StatefulSet controls it,
+// so an unrelated pod that happens to share the name is never touched.
+func (s *Service) ordinalPod(namespace, name string, ordinal int32) (*corev1.Pod, error) {
+	if ordinal < 0 {
+		return nil, fmt.Errorf("ordinal must be non-negative")
+	}
+	statefulSet, err := s.getStatefulSet(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	podName := OrdinalPodName(name, ordinal)
+	pod, err := s.deps.KubernetesClient.CoreV1().Pods(namespace).Get(s.deps.Context, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != Identity.Kind || owner.UID != statefulSet.UID {
+		return nil, fmt.Errorf("pod %s is not controlled by statefulset %s", podName, name)
+	}
+	return pod, nil
+}
+
+func (s *Service) getStatefulSet(namespace, name string) (*appsv1.StatefulSet, error) {
+	if s.deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	statefulSet, err := s.deps.KubernetesClient.AppsV1().StatefulSets(namespace).Get(s.deps.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset %s/%s: %w", namespace, name, err)
+	}
+	return statefulSet, nil
+}
+
+func ordinalStart(statefulSet *appsv1.StatefulSet) int32 {
+	if statefulSet.Spec.Ordinals != nil {
+		return statefulSet.Spec.Ordinals.Start
+	}
+	return 0
+}
+
+func partitionOf(statefulSet *appsv1.StatefulSet) int32 {
+	if ru := statefulSet.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return *ru.Partition
+	}
+	return 0
+}
+
+// partitioned reports whether a rolling update holds ordinal at the current
+// revision. The partition counts ordinals from spec.ordinals.start.
+func partitioned(statefulSet *appsv1.StatefulSet, ordinal, partition int32) bool {
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return false
+	}
+	return ordinal-ordinalStart(statefulSet) < partition
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package statefulset_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/resources/statefulset"
+	"github.com/luxury-yacht/app/backend/testsupport"
+)
+
+func ordinalPod(ss *appsv1.StatefulSet, name, revision string) *corev1.Pod {
+	return testsupport.PodFixture(
+		ss.Namespace,
+		name,
+		testsupport.PodWithOwner("StatefulSet", ss.Name, true),
+		testsupport.PodWithLabels(map[string]string{appsv1.ControllerRevisionHashLabelKey: revision}),
+	)
+}
+
+func TestBuildOrdinalsReportsRevisionPerOrdinal(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	ss := testsupport.StatefulSetFixture("default", "db")
+	ss.Spec.Replicas = ptr.To[int32](3)
+	ss.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To[int32](2)},
+	}
+	ss.Status.CurrentRevision = "db-old"
+	ss.Status.UpdateRevision = "db-new"
+
+	stuck := ordinalPod(ss, "db-1", "db-old")
+	stuck.DeletionTimestamp = &metav1.Time{Time: now.Add(-time.Minute)}
+	pods := []corev1.Pod{
+		*ordinalPod(ss, "db-2", "db-new"),
+		*ordinalPod(ss, "db-0", "db-old"),
+		*stuck,
+		*ordinalPod(ss, "db-3", "db-old"),
+		*ordinalPod(ss, "db-canary", "db-old"),
+	}
+
+	ordinals := statefulset.BuildOrdinals(ss, pods, now)
+	require.Len(t, ordinals, 4, "pods without an ordinal suffix are ignored")
+
+	require.Equal(t, int32(0), ordinals[0].Ordinal)
+	require.Equal(t, "db-old", ordinals[0].Revision)
+	require.False(t, ordinals[0].UpToDate)
+	require.True(t, ordinals[0].Partitioned)
+	require.True(t, ordinals[0].Ready)
+
+	require.True(t, ordinals[1].Terminating)
+	require.True(t, ordinals[1].Stuck, "the grace period ended before now")
+
+	require.Equal(t, "db-2", ordinals[2].PodName)
+	require.True(t, ordinals[2].UpToDate)
+	require.False(t, ordinals[2].Partitioned)
+
+	require.Equal(t, int32(3), ordinals[3].Ordinal)
+	require.True(t, ordinals[3].Extra, "ordinal 3 is beyond the desired replicas")
+
+	ordinals = statefulset.BuildOrdinals(ss, nil, now)
+	require.Len(t, ordinals, 3)
+	require.True(t, ordinals[0].Missing)
+
+	ss.Spec.Ordinals = &appsv1.StatefulSetOrdinals{Start: 5}
+	ordinals = statefulset.BuildOrdinals(ss, nil, now)
+	require.Equal(t, "db-5", ordinals[0].PodName)
+	require.True(t, ordinals[1].Partitioned, "the partition counts from the start ordinal")
+	require.False(t, ordinals[2].Partitioned)
+}
+
+func TestPodOrdinal(t *testing.T) {
+	ordinal, ok := statefulset.PodOrdinal("db", "db-12")
+	require.True(t, ok)
+	require.Equal(t, int32(12), ordinal)
+
+	for _, name := range []string{"db", "db-", "db-x", "db-01", "web-1", "db-replica-1"} {
+		_, ok := statefulset.PodOrdinal("db", name)
+		require.False(t, ok, name)
+	}
+}
+
+func TestOrdinalActions(t *testing.T) {
+	ss := testsupport.StatefulSetFixture("default", "db")
+	running := ordinalPod(ss, "db-0", "db-old")
+	terminating := ordinalPod(ss, "db-1", "db-old")
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	stranger := testsupport.PodFixture("default", "db-2")
+	client := cgofake.NewClientset(ss, running, terminating, stranger)
+	service := statefulset.NewService(newDeps(t, client))
+
+	_, err := service.ForceDeleteOrdinal("default", "db", 0)
+	require.ErrorContains(t, err, "is not terminating")
+
+	_, err = service.DeleteOrdinal("default", "db", 2)
+	require.ErrorContains(t, err, "is not controlled by statefulset db")
+
+	name, err := service.ForceDeleteOrdinal("default", "db", 1)
+	require.NoError(t, err)
+	require.Equal(t, "db-1", name)
+
+	name, err = service.DeleteOrdinal("default", "db", 0)
+	require.NoError(t, err)
+	require.Equal(t, "db-0", name)
+	_, err = client.CoreV1().Pods("default").Get(context.Background(), "db-0", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	previous, err := service.SetPartition("default", "db", 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), previous)
+	updated, err := client.AppsV1().StatefulSets("default").Get(context.Background(), "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, appsv1.RollingUpdateStatefulSetStrategyType, updated.Spec.UpdateStrategy.Type)
+	require.Equal(t, int32(1), *updated.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	_, err = service.SetPartition("default", "db", -1)
+	require.ErrorContains(t, err, "non-negative")
+
+	updated.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	_, err = client.AppsV1().StatefulSets("default").Update(context.Background(), updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = service.SetPartition("default", "db", 0)
+	require.ErrorContains(t, err, "OnDelete")
+}
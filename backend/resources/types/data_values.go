@@ -0,0 +1,120 @@
+/*
+ * backend/resources/types/data_values.go
+ *
+ * Shared shapes for ConfigMap and Secret data. Detail payloads list keys with
+ * their sizes; values are fetched one key at a time, optionally by byte range,
+ * so multi-megabyte entries never ride along with the details.
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"sort"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultDataValueLimit is the chunk returned when a fetch names no limit.
+	DefaultDataValueLimit = 256 * 1024
+	// MaxDataValueLimit caps a single fetch; it matches the 1 MiB object size
+	// limit etcd places on ConfigMaps and Secrets.
+	MaxDataValueLimit = 1024 * 1024
+)
+
+// DataKeyInfo names one data key and its decoded size in bytes. Binary marks
+// values served base64-encoded: ConfigMap binaryData entries and Secret values
+// that are not valid UTF-8.
+type DataKeyInfo struct {
+	Key    string `json:"key"`
+	Size   int    `json:"size"`
+	Binary bool   `json:"binary,omitempty"`
+}
+
+// DataValueRange selects the bytes of a value to fetch. Limit <= 0 uses
+// DefaultDataValueLimit; larger limits are capped at MaxDataValueLimit.
+type DataValueRange struct {
+	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty"`
+}
+
+// DataValue is one fetched chunk of a data value. Value is plain text, or
+// base64 of the chunk's bytes when Binary is set. Truncated reports that bytes
+// remain after Offset+len(chunk); the next chunk starts at NextOffset.
+type DataValue struct {
+	Key        string `json:"key"`
+	Binary     bool   `json:"binary,omitempty"`
+	Size       int    `json:"size"`
+	Offset     int    `json:"offset"`
+	NextOffset int    `json:"nextOffset"`
+	Value      string `json:"value"`
+	Truncated  bool   `json:"truncated"`
+}
+
+// DataKeyInfos lists text and binary keys with their sizes, sorted by key.
+func DataKeyInfos(data map[string]string, binaryData map[string][]byte) []DataKeyInfo {
+	infos := make([]DataKeyInfo, 0, len(data)+len(binaryData))
+	for key, value := range data {
+		infos = append(infos, DataKeyInfo{Key: key, Size: len(value)})
+	}
+	for key, value := range binaryData {
+		infos = append(infos, DataKeyInfo{Key: key, Size: len(value), Binary: true})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Key != infos[j].Key {
+			return infos[i].Key < infos[j].Key
+		}
+		return !infos[i].Binary
+	})
+	return infos
+}
+
+// SliceDataValue returns the chunk of raw selected by rng. Text chunks end on a
+// UTF-8 boundary so a multi-byte character is never split across fetches, and
+// binary chunks are a multiple of three bytes long so their base64 forms can be
+// joined.
+func SliceDataValue(key string, raw []byte, binary bool, rng DataValueRange) DataValue {
+	limit := rng.Limit
+	if limit <= 0 {
+		limit = DefaultDataValueLimit
+	}
+	limit = min(limit, MaxDataValueLimit)
+	start := min(max(rng.Offset, 0), len(raw))
+	end := min(start+limit, len(raw))
+	if !binary && end < len(raw) {
+		trimmed := end
+		for trimmed > start && !utf8.RuneStart(raw[trimmed]) {
+			trimmed--
+		}
+		// A limit smaller than one character still has to make progress.
+		if trimmed == start {
+			trimmed = end
+			for trimmed < len(raw) && !utf8.RuneStart(raw[trimmed]) {
+				trimmed++
+			}
+		}
+		end = trimmed
+	}
+	// Binary chunks hold whole base64 groups so consecutive chunks concatenate
+	// into the encoding of the full value.
+	if binary && end < len(raw) {
+		if whole := (end - start) / 3 * 3; whole > 0 {
+			end = start + whole
+		}
+	}
+
+	value := DataValue{
+		Key:        key,
+		Binary:     binary,
+		Size:       len(raw),
+		Offset:     start,
+		NextOffset: end,
+		Truncated:  end < len(raw),
+	}
+	if binary {
+		value.Value = base64.StdEncoding.EncodeToString(raw[start:end])
+	} else {
+		value.Value = string(raw[start:end])
+	}
+	return value
+}
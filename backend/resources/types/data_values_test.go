@@ -0,0 +1,81 @@
+/*
+ * backend/resources/types/data_values_test.go
+ *
+ * Tests for data key listing and ranged value slicing.
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestDataKeyInfosListsSizesSortedByKey(t *testing.T) {
+	infos := DataKeyInfos(
+		map[string]string{"b": "12345", "a": ""},
+		map[string][]byte{"c": {0x00, 0x01}},
+	)
+	want := []DataKeyInfo{{Key: "a", Size: 0}, {Key: "b", Size: 5}, {Key: "c", Size: 2, Binary: true}}
+	if len(infos) != len(want) {
+		t.Fatalf("expected %d infos, got %#v", len(want), infos)
+	}
+	for i := range want {
+		if infos[i] != want[i] {
+			t.Fatalf("info %d: expected %#v, got %#v", i, want[i], infos[i])
+		}
+	}
+}
+
+func TestSliceDataValuePagesThroughText(t *testing.T) {
+	raw := []byte(strings.Repeat("x", 10))
+
+	first := SliceDataValue("k", raw, false, DataValueRange{Limit: 4})
+	if first.Value != "xxxx" || !first.Truncated || first.NextOffset != 4 || first.Size != 10 {
+		t.Fatalf("unexpected first chunk %#v", first)
+	}
+	last := SliceDataValue("k", raw, false, DataValueRange{Offset: 8, Limit: 4})
+	if last.Value != "xx" || last.Truncated || last.NextOffset != 10 {
+		t.Fatalf("unexpected last chunk %#v", last)
+	}
+	whole := SliceDataValue("k", raw, false, DataValueRange{})
+	if whole.Value != string(raw) || whole.Truncated {
+		t.Fatalf("expected the default limit to cover a small value, got %#v", whole)
+	}
+}
+
+func TestSliceDataValueKeepsMultiByteCharactersWhole(t *testing.T) {
+	raw := []byte("aé€")
+
+	chunk := SliceDataValue("k", raw, false, DataValueRange{Limit: 2})
+	if chunk.Value != "a" || chunk.NextOffset != 1 {
+		t.Fatalf("expected the chunk to stop before é, got %#v", chunk)
+	}
+	chunk = SliceDataValue("k", raw, false, DataValueRange{Offset: 3, Limit: 1})
+	if chunk.Value != "€" || chunk.Truncated {
+		t.Fatalf("expected a limit below one character to still return it, got %#v", chunk)
+	}
+}
+
+func TestSliceDataValueEncodesBinaryChunks(t *testing.T) {
+	raw := []byte{0xff, 0x00, 0xfe, 0x01}
+
+	chunk := SliceDataValue("bin", raw, true, DataValueRange{Offset: 1, Limit: 2})
+	if chunk.Value != base64.StdEncoding.EncodeToString([]byte{0x00, 0xfe}) || !chunk.Binary || !chunk.Truncated {
+		t.Fatalf("unexpected binary chunk %#v", chunk)
+	}
+}
+
+func TestSliceDataValueAlignsBinaryChunksForBase64(t *testing.T) {
+	raw := []byte{1, 2, 3, 4, 5, 6, 7}
+
+	first := SliceDataValue("bin", raw, true, DataValueRange{Limit: 5})
+	rest := SliceDataValue("bin", raw, true, DataValueRange{Offset: first.NextOffset, Limit: 5})
+	if first.NextOffset != 3 || rest.Truncated {
+		t.Fatalf("expected a three-byte first chunk and a final second chunk, got %#v then %#v", first, rest)
+	}
+	if first.Value+rest.Value != base64.StdEncoding.EncodeToString(raw) {
+		t.Fatalf("expected chunks to concatenate into the full encoding, got %q + %q", first.Value, rest.Value)
+	}
+}
@@ -41,6 +41,7 @@ type AppSettings struct {
 	ExclusiveNamespaces                      bool     `json:"exclusiveNamespaces"`                      // Allow only one expanded namespace in the sidebar
 	AutoRefreshEnabled                       bool     `json:"autoRefreshEnabled"`                       // Enable automatic refresh cycles
 	RefreshBackgroundClustersEnabled         bool     `json:"refreshBackgroundClustersEnabled"`         // Refresh inactive clusters in the background
+	RefreshBinaryEncodingEnabled             bool     `json:"refreshBinaryEncodingEnabled"`             // Request CBOR snapshot and stream payloads instead of JSON
 	MetricsRefreshIntervalMs                 int      `json:"metricsRefreshIntervalMs"`                 // Metrics refresh interval (ms)
 	KubernetesClientQPS                      int      `json:"kubernetesClientQPS"`                      // Per-cluster Kubernetes REST client QPS
 	KubernetesClientBurst                    int      `json:"kubernetesClientBurst"`                    // Per-cluster Kubernetes REST client burst allowance
@@ -69,6 +70,22 @@ type AppSettings struct {
 	AccentColorDark                          string   `json:"accentColorDark"`                          // Custom accent hex for dark mode (empty = default)
 	LinkColorLight                           string   `json:"linkColorLight"`                           // Custom link hex for light mode (empty = default)
 	LinkColorDark                            string   `json:"linkColorDark"`                            // Custom link hex for dark mode (empty = default)
+	SecretRevealEnabled                      bool     `json:"secretRevealEnabled"`                      // Allow decoded Secret values to be revealed (each reveal is audited)
+	SecretRedactedKeyPatterns                []string `json:"secretRedactedKeyPatterns"`                // Secret data key globs (e.g. "*_TOKEN") that are never revealed
+	TrivyPath                                string   `json:"trivyPath"`                                // Trivy binary used for image scans; empty resolves "trivy" from PATH
+	TrivyServerURL                           string   `json:"trivyServerURL"`                           // Optional Trivy server; when set, scans run in client mode against it
+	InformerResyncIntervalMs                 int      `json:"informerResyncIntervalMs"`                 // Shared informer resync period (ms); applies when a cluster connects
+	ObjectCatalogResyncIntervalMs            int      `json:"objectCatalogResyncIntervalMs"`            // Full object catalog sync period (ms)
+	ObjectCatalogPageSize                    int      `json:"objectCatalogPageSize"`                    // Items per catalog list call
+	ObjectCatalogPromotionThreshold          int      `json:"objectCatalogPromotionThreshold"`          // Resource count above which the catalog switches to informer-backed updates
+	ObjectCatalogStreamingBatchSize          int      `json:"objectCatalogStreamingBatchSize"`          // Items per catalog streaming batch
+	ObjectCatalogStreamingFlushIntervalMs    int      `json:"objectCatalogStreamingFlushIntervalMs"`    // Catalog streaming flush cadence (ms)
+	ResourceStreamSubscriberBufferSize       int      `json:"resourceStreamSubscriberBufferSize"`       // Buffered resource stream updates per subscriber
+	ResourceStreamResumeBufferSize           int      `json:"resourceStreamResumeBufferSize"`           // Buffered resource stream updates per scope for resume
+	CustomInformerIdleTimeoutMs              int      `json:"customInformerIdleTimeoutMs"`              // How long custom resource informers outlive their last stream subscriber (ms)
+	ProtectedNamespaces                      []string `json:"protectedNamespaces"`                      // Namespace globs (e.g. "prod-*") whose destructive actions need a typed confirmation
+	UpdateChannel                            string   `json:"updateChannel"`                            // Release channel the update check follows: "stable" or "beta"
+	ExternalEditor                           string   `json:"externalEditor"`                           // Command for "Edit in External Editor"; empty uses $VISUAL, $EDITOR or VS Code
 	Themes                                   []Theme  `json:"themes"`                                   // Saved theme library
 }
 
@@ -142,6 +159,50 @@ type ThemeClusterPatternValidationResult struct {
 	Message string `json:"message,omitempty"`
 }
 
+// SettingsTransferResult reports the file a settings export wrote or an
+// import read.
+type SettingsTransferResult struct {
+	Path  string `json:"path"`
+	Bytes int64  `json:"bytes"`
+}
+
+// SettingsSyncStatus describes the settings sync directory. Directory is empty
+// when sync is off; LastSyncedAt is RFC3339 and empty until a sync succeeds.
+type SettingsSyncStatus struct {
+	Directory    string `json:"directory"`
+	FilePath     string `json:"filePath,omitempty"`
+	LastSyncedAt string `json:"lastSyncedAt,omitempty"`
+	LastError    string `json:"lastError,omitempty"`
+}
+
+// KeyBinding binds one keyboard chord to an app action. Key is the
+// KeyboardEvent.key value ("b", "?", "ArrowLeft"); an empty Key leaves the
+// action unbound. Mod is the platform primary modifier: Cmd on macOS, Ctrl
+// elsewhere.
+type KeyBinding struct {
+	Action string `json:"action"`
+	Key    string `json:"key"`
+	Mod    bool   `json:"mod,omitempty"`
+	Ctrl   bool   `json:"ctrl,omitempty"`
+	Shift  bool   `json:"shift,omitempty"`
+	Alt    bool   `json:"alt,omitempty"`
+	Meta   bool   `json:"meta,omitempty"`
+}
+
+// KeyBindingAction describes a remappable action with its default and
+// effective bindings. Global actions fire from any view; destructive ones
+// change cluster state. Both must use a modifier.
+type KeyBindingAction struct {
+	Action      string     `json:"action"`
+	Description string     `json:"description"`
+	Category    string     `json:"category"`
+	Global      bool       `json:"global"`
+	Destructive bool       `json:"destructive,omitempty"`
+	Default     KeyBinding `json:"default"`
+	Binding     KeyBinding `json:"binding"`
+	Customized  bool       `json:"customized"`
+}
+
 // ContainerLogsEntry represents a single log line with metadata
 type ContainerLogsEntry struct {
 	Timestamp   string `json:"timestamp"` // RFC3339Nano format
@@ -150,6 +211,7 @@ type ContainerLogsEntry struct {
 	Line        string `json:"line"`
 	IsInit      bool   `json:"isInit"`                // Whether this is from an init container
 	IsEphemeral bool   `json:"isEphemeral,omitempty"` // Whether this is from an ephemeral/debug container
+	Attempt     string `json:"attempt,omitempty"`     // Job attempt the line came from, set when fetching across attempts
 }
 
 // ContainerLogsFetchRequest represents parameters for fetching logs
@@ -169,6 +231,10 @@ type ContainerLogsFetchRequest struct {
 	Previous         bool     `json:"previous"`
 	TailLines        int      `json:"tailLines"`
 	SinceSeconds     int64    `json:"sinceSeconds,omitempty"`
+	// Attempts fetches every pod of a Job or CronJob scope, failed and
+	// retried ones included, plus each restarted container's previous run,
+	// labelling every line with its attempt. Previous is ignored.
+	Attempts bool `json:"attempts,omitempty"`
 }
 
 // ContainerLogsFetchResponse represents the response from FetchContainerLogs
@@ -209,6 +275,36 @@ type NodeLogFetchResponse struct {
 	Truncated  bool          `json:"truncated,omitempty"`
 }
 
+// NodeLogDebugPodRequest selects where and with which image to run the debug
+// pod that reads node logs when the kubelet does not serve them.
+type NodeLogDebugPodRequest struct {
+	Namespace string `json:"namespace"`
+	Image     string `json:"image"`
+}
+
+// ConnectivityCheckRequest describes an in-cluster connectivity check: a
+// short-lived pod in Namespace runs curl (HTTP) or nc (TCP) against Host:Port.
+type ConnectivityCheckRequest struct {
+	Namespace string `json:"namespace"`
+	Image     string `json:"image"`
+	// Host is a Service name, DNS name or IP; a bare Service name resolves in Namespace.
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	// Protocol is "tcp" (the default) or "http".
+	Protocol string `json:"protocol,omitempty"`
+	// Path is the HTTP request path; ignored for TCP.
+	Path           string `json:"path,omitempty"`
+	TimeoutSeconds int    `json:"timeoutSeconds,omitempty"`
+}
+
+// DNSLookupRequest describes an in-cluster DNS lookup of Name from a
+// short-lived pod in Namespace, so the pod's search domains apply.
+type DNSLookupRequest struct {
+	Namespace string `json:"namespace"`
+	Image     string `json:"image"`
+	Name      string `json:"name"`
+}
+
 // ShellSessionRequest describes the namespace/pod/container to exec into.
 type ShellSessionRequest struct {
 	Namespace string   `json:"namespace"`
@@ -227,6 +323,37 @@ type ShellSession struct {
 	Containers []string `json:"containers"`
 }
 
+// PodContainerRequest selects the pod container an exec-backed inspector
+// (processes, sockets) reads from.
+type PodContainerRequest struct {
+	Namespace string `json:"namespace"`
+	PodName   string `json:"podName"`
+	Container string `json:"container,omitempty"`
+}
+
+// PodFileRequest names a path in a pod container's filesystem.
+type PodFileRequest struct {
+	Namespace string `json:"namespace"`
+	PodName   string `json:"podName"`
+	Container string `json:"container,omitempty"`
+	Path      string `json:"path"`
+}
+
+// PodFilesDownloadRequest selects entries of one container directory to save.
+type PodFilesDownloadRequest struct {
+	Namespace string   `json:"namespace"`
+	PodName   string   `json:"podName"`
+	Container string   `json:"container,omitempty"`
+	Directory string   `json:"directory"`
+	Names     []string `json:"names"`
+}
+
+// PodFilesDownloadResult reports where downloaded container files were written.
+type PodFilesDownloadResult struct {
+	Path  string `json:"path"`
+	Bytes int64  `json:"bytes"`
+}
+
 // ShellSessionInfo describes a tracked shell exec session.
 type ShellSessionInfo struct {
 	SessionID   string      `json:"sessionId"`
@@ -0,0 +1,153 @@
+/*
+ * backend/resources/volumesnapshot/actions.go
+ *
+ * Detection of the snapshot API and the objects behind the two snapshot
+ * actions: a VolumeSnapshot taken from a PersistentVolumeClaim, and a new
+ * claim restored from a ready snapshot through spec.dataSource.
+ */
+
+package volumesnapshot
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/utils/ptr"
+)
+
+// Served reports whether the cluster serves snapshot.storage.k8s.io/v1
+// volumesnapshots, i.e. whether the external snapshotter CRDs are installed.
+func Served(client discovery.DiscoveryInterface) (bool, error) {
+	if client == nil {
+		return false, nil
+	}
+	list, err := client.ServerResourcesForGroupVersion(VolumeSnapshotGVR.GroupVersion().String())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, apiResource := range list.APIResources {
+		if apiResource.Name == VolumeSnapshotGVR.Resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ClassOptions lists VolumeSnapshotClasses with the default classes first,
+// then by name.
+func ClassOptions(classes []unstructured.Unstructured) []ClassOption {
+	options := make([]ClassOption, 0, len(classes))
+	for i := range classes {
+		summary := BuildSummary(&classes[i], Group)
+		if summary == nil {
+			continue
+		}
+		options = append(options, ClassOption{Name: classes[i].GetName(), Driver: summary.Driver, IsDefault: summary.IsDefault})
+	}
+	sort.Slice(options, func(i, j int) bool {
+		if options[i].IsDefault != options[j].IsDefault {
+			return options[i].IsDefault
+		}
+		return options[i].Name < options[j].Name
+	})
+	return options
+}
+
+// BuildSnapshot returns the VolumeSnapshot for req. Without a name the
+// snapshot is named after the claim and the current time.
+func BuildSnapshot(req CreateRequest, now time.Time) *unstructured.Unstructured {
+	name := req.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", req.ClaimName, now.UTC().Format("20060102-150405"))
+	}
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{"persistentVolumeClaimName": req.ClaimName},
+	}
+	if req.SnapshotClass != "" {
+		spec["volumeSnapshotClassName"] = req.SnapshotClass
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": VolumeSnapshotGVR.GroupVersion().String(),
+		"kind":       KindVolumeSnapshot,
+		"metadata":   map[string]interface{}{"name": name, "namespace": req.Namespace},
+		"spec":       spec,
+	}}
+}
+
+// BuildRestoreClaim returns the PersistentVolumeClaim that restores snapshot.
+// source is the claim the snapshot was taken from, when it still exists; it
+// supplies the storage class, access modes and volume mode req leaves unset.
+// The snapshot must be ready to use, and the claim can be no smaller than the
+// snapshot's restore size.
+func BuildRestoreClaim(snapshot *unstructured.Unstructured, source *corev1.PersistentVolumeClaim, req RestoreRequest) (*corev1.PersistentVolumeClaim, error) {
+	details := BuildDetails(snapshot, Group)
+	if details == nil || details.Kind != KindVolumeSnapshot {
+		return nil, fmt.Errorf("%s/%s is not a VolumeSnapshot", req.Namespace, req.SnapshotName)
+	}
+	summary := details.Summary
+	if summary.Error != "" {
+		return nil, fmt.Errorf("snapshot %s/%s failed: %s", details.Namespace, details.Name, summary.Error)
+	}
+	if summary.ReadyToUse == nil || !*summary.ReadyToUse {
+		return nil, fmt.Errorf("snapshot %s/%s is not ready to use", details.Namespace, details.Name)
+	}
+
+	sizeText := req.Size
+	if sizeText == "" {
+		sizeText = summary.RestoreSize
+	}
+	if sizeText == "" && source != nil {
+		if request, ok := source.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+			sizeText = request.String()
+		}
+	}
+	if sizeText == "" {
+		return nil, fmt.Errorf("snapshot %s/%s reports no restore size; a size is required", details.Namespace, details.Name)
+	}
+	size, err := resource.ParseQuantity(sizeText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q: %v", sizeText, err)
+	}
+	if summary.RestoreSize != "" {
+		if restoreSize, err := resource.ParseQuantity(summary.RestoreSize); err == nil && size.Cmp(restoreSize) < 0 {
+			return nil, fmt.Errorf("size %s is smaller than the snapshot's restore size %s", size.String(), restoreSize.String())
+		}
+	}
+
+	claim := &corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: req.ClaimName, Namespace: details.Namespace},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+			DataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: ptr.To(Group),
+				Kind:     KindVolumeSnapshot,
+				Name:     details.Name,
+			},
+		},
+	}
+	if source != nil {
+		if len(source.Spec.AccessModes) > 0 {
+			claim.Spec.AccessModes = append([]corev1.PersistentVolumeAccessMode(nil), source.Spec.AccessModes...)
+		}
+		claim.Spec.VolumeMode = source.Spec.VolumeMode
+		claim.Spec.StorageClassName = source.Spec.StorageClassName
+	}
+	if req.StorageClass != "" {
+		claim.Spec.StorageClassName = ptr.To(req.StorageClass)
+	}
+	return claim, nil
+}
@@ -0,0 +1,66 @@
+/*
+ * backend/resources/volumesnapshot/dto.go
+ *
+ * VolumeSnapshot detail, detection and action DTOs.
+ */
+
+package volumesnapshot
+
+import "github.com/luxury-yacht/app/backend/kind/streamrows"
+
+// Details is the detail payload for a VolumeSnapshot or VolumeSnapshotClass.
+type Details struct {
+	Kind      string                           `json:"kind"`
+	Name      string                           `json:"name"`
+	Namespace string                           `json:"namespace,omitempty"`
+	Summary   streamrows.VolumeSnapshotSummary `json:"summary"`
+	// Parameters are a VolumeSnapshotClass's driver parameters.
+	Parameters  map[string]string `json:"parameters,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Support reports whether the cluster serves the snapshot API and which
+// VolumeSnapshotClasses a new snapshot can use.
+type Support struct {
+	Installed bool `json:"installed"`
+	// APIVersion is the served group/version, e.g. "snapshot.storage.k8s.io/v1".
+	APIVersion string        `json:"apiVersion,omitempty"`
+	Classes    []ClassOption `json:"classes"`
+}
+
+// ClassOption is a VolumeSnapshotClass offered when creating a snapshot.
+type ClassOption struct {
+	Name      string `json:"name"`
+	Driver    string `json:"driver"`
+	IsDefault bool   `json:"isDefault,omitempty"`
+}
+
+// CreateRequest asks for a snapshot of a PersistentVolumeClaim. An empty Name
+// is generated from the claim name; an empty SnapshotClass leaves the choice
+// to the cluster's default class.
+type CreateRequest struct {
+	Namespace     string `json:"namespace"`
+	ClaimName     string `json:"claimName"`
+	Name          string `json:"name,omitempty"`
+	SnapshotClass string `json:"snapshotClass,omitempty"`
+}
+
+// RestoreRequest asks for a new PersistentVolumeClaim populated from a
+// snapshot. StorageClass and Size default to the source claim's class and the
+// snapshot's restore size.
+type RestoreRequest struct {
+	Namespace    string `json:"namespace"`
+	SnapshotName string `json:"snapshotName"`
+	ClaimName    string `json:"claimName"`
+	StorageClass string `json:"storageClass,omitempty"`
+	Size         string `json:"size,omitempty"`
+}
+
+// ActionResult names the object a create or restore produced.
+type ActionResult struct {
+	ClusterID string `json:"clusterId"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
@@ -0,0 +1,169 @@
+package volumesnapshot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/luxury-yacht/app/backend/resources/volumesnapshot"
+)
+
+func snapshotObject(name string, spec, status map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1",
+		"kind":       volumesnapshot.KindVolumeSnapshot,
+		"metadata":   map[string]interface{}{"name": name, "namespace": "apps"},
+		"spec":       spec,
+		"status":     status,
+	}}
+}
+
+func classObject(name, driver string, isDefault bool) unstructured.Unstructured {
+	metadata := map[string]interface{}{"name": name}
+	if isDefault {
+		metadata["annotations"] = map[string]interface{}{volumesnapshot.DefaultClassAnnotation: "true"}
+	}
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":     "snapshot.storage.k8s.io/v1",
+		"kind":           volumesnapshot.KindVolumeSnapshotClass,
+		"metadata":       metadata,
+		"driver":         driver,
+		"deletionPolicy": "Delete",
+		"parameters":     map[string]interface{}{"tagSpecification_1": "team=storage"},
+	}}
+}
+
+func readySnapshot() *unstructured.Unstructured {
+	return snapshotObject("db-snap",
+		map[string]interface{}{
+			"source":                  map[string]interface{}{"persistentVolumeClaimName": "db"},
+			"volumeSnapshotClassName": "csi-aws",
+		},
+		map[string]interface{}{
+			"readyToUse":                     true,
+			"restoreSize":                    "10Gi",
+			"boundVolumeSnapshotContentName": "snapcontent-123",
+			"creationTime":                   "2026-10-16T09:00:00Z",
+		})
+}
+
+func TestBuildSummaryReadsSnapshotsAndClasses(t *testing.T) {
+	summary := volumesnapshot.BuildSummary(readySnapshot(), volumesnapshot.Group)
+	require.NotNil(t, summary)
+	require.Equal(t, "db", summary.SourcePVC)
+	require.Equal(t, "csi-aws", summary.SnapshotClass)
+	require.Equal(t, "snapcontent-123", summary.BoundContent)
+	require.Equal(t, ptr.To(true), summary.ReadyToUse)
+	require.Equal(t, "10Gi", summary.RestoreSize)
+	require.Equal(t, "from PVC db; 10Gi; ready", summary.Details)
+
+	failed := snapshotObject("broken",
+		map[string]interface{}{"source": map[string]interface{}{"volumeSnapshotContentName": "imported"}},
+		map[string]interface{}{"readyToUse": false, "error": map[string]interface{}{"message": "driver does not support snapshots"}})
+	summary = volumesnapshot.BuildSummary(failed, volumesnapshot.Group)
+	require.Equal(t, "imported", summary.SourceContent)
+	require.Equal(t, "pre-provisioned from imported; error: driver does not support snapshots", summary.Details)
+
+	pending := snapshotObject("pending", map[string]interface{}{"source": map[string]interface{}{"persistentVolumeClaimName": "db"}}, nil)
+	summary = volumesnapshot.BuildSummary(pending, volumesnapshot.Group)
+	require.Nil(t, summary.ReadyToUse, "readiness is unknown until the controller reports it")
+	require.Equal(t, "from PVC db; not ready", summary.Details)
+
+	class := classObject("csi-aws", "ebs.csi.aws.com", true)
+	details := volumesnapshot.BuildDetails(&class, volumesnapshot.Group)
+	require.Equal(t, "ebs.csi.aws.com", details.Summary.Driver)
+	require.True(t, details.Summary.IsDefault)
+	require.Equal(t, "ebs.csi.aws.com; deletion Delete; default", details.Summary.Details)
+	require.Equal(t, map[string]string{"tagSpecification_1": "team=storage"}, details.Parameters)
+
+	require.Nil(t, volumesnapshot.BuildSummary(readySnapshot(), "example.com"), "the group must match")
+}
+
+func TestClassOptionsListDefaultFirst(t *testing.T) {
+	options := volumesnapshot.ClassOptions([]unstructured.Unstructured{
+		classObject("zfs", "zfs.csi.openebs.io", false),
+		classObject("csi-aws", "ebs.csi.aws.com", false),
+		classObject("standard", "pd.csi.storage.gke.io", true),
+	})
+	require.Equal(t, []volumesnapshot.ClassOption{
+		{Name: "standard", Driver: "pd.csi.storage.gke.io", IsDefault: true},
+		{Name: "csi-aws", Driver: "ebs.csi.aws.com"},
+		{Name: "zfs", Driver: "zfs.csi.openebs.io"},
+	}, options)
+}
+
+func TestServedDetectsTheSnapshotAPI(t *testing.T) {
+	client := fake.NewClientset()
+	served, err := volumesnapshot.Served(client.Discovery())
+	require.NoError(t, err)
+	require.False(t, served)
+
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: "snapshot.storage.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Name: "volumesnapshots", Kind: volumesnapshot.KindVolumeSnapshot, Namespaced: true}},
+	}}
+	served, err = volumesnapshot.Served(client.Discovery())
+	require.NoError(t, err)
+	require.True(t, served)
+}
+
+func TestBuildSnapshotNamesAfterTheClaim(t *testing.T) {
+	obj := volumesnapshot.BuildSnapshot(volumesnapshot.CreateRequest{Namespace: "apps", ClaimName: "db", SnapshotClass: "csi-aws"}, time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC))
+	require.Equal(t, "db-20261016-093000", obj.GetName())
+	require.Equal(t, "apps", obj.GetNamespace())
+	source, _, _ := unstructured.NestedString(obj.Object, "spec", "source", "persistentVolumeClaimName")
+	require.Equal(t, "db", source)
+	class, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeSnapshotClassName")
+	require.Equal(t, "csi-aws", class)
+
+	obj = volumesnapshot.BuildSnapshot(volumesnapshot.CreateRequest{Namespace: "apps", ClaimName: "db", Name: "before-upgrade"}, time.Now())
+	require.Equal(t, "before-upgrade", obj.GetName())
+	_, found, _ := unstructured.NestedString(obj.Object, "spec", "volumeSnapshotClassName")
+	require.False(t, found, "without a class the cluster default applies")
+}
+
+func TestBuildRestoreClaim(t *testing.T) {
+	block := corev1.PersistentVolumeBlock
+	source := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "apps"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod},
+			StorageClassName: ptr.To("gp3"),
+			VolumeMode:       &block,
+		},
+	}
+	req := volumesnapshot.RestoreRequest{Namespace: "apps", SnapshotName: "db-snap", ClaimName: "db-restored"}
+
+	claim, err := volumesnapshot.BuildRestoreClaim(readySnapshot(), source, req)
+	require.NoError(t, err)
+	require.Equal(t, "db-restored", claim.Name)
+	require.Equal(t, "apps", claim.Namespace)
+	require.Equal(t, "gp3", *claim.Spec.StorageClassName)
+	require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod}, claim.Spec.AccessModes)
+	require.Equal(t, &block, claim.Spec.VolumeMode)
+	require.Equal(t, resource.MustParse("10Gi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	require.Equal(t, &corev1.TypedLocalObjectReference{APIGroup: ptr.To(volumesnapshot.Group), Kind: volumesnapshot.KindVolumeSnapshot, Name: "db-snap"}, claim.Spec.DataSource)
+
+	req.StorageClass, req.Size = "io2", "20Gi"
+	claim, err = volumesnapshot.BuildRestoreClaim(readySnapshot(), nil, req)
+	require.NoError(t, err)
+	require.Equal(t, "io2", *claim.Spec.StorageClassName)
+	require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, claim.Spec.AccessModes, "without a source claim the access mode defaults to ReadWriteOnce")
+	require.Equal(t, resource.MustParse("20Gi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+
+	req.Size = "5Gi"
+	_, err = volumesnapshot.BuildRestoreClaim(readySnapshot(), source, req)
+	require.ErrorContains(t, err, "smaller than the snapshot's restore size 10Gi")
+
+	pending := snapshotObject("db-snap", map[string]interface{}{"source": map[string]interface{}{"persistentVolumeClaimName": "db"}}, map[string]interface{}{"readyToUse": false})
+	_, err = volumesnapshot.BuildRestoreClaim(pending, source, volumesnapshot.RestoreRequest{ClaimName: "x"})
+	require.ErrorContains(t, err, "is not ready to use")
+}
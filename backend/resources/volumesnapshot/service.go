@@ -0,0 +1,134 @@
+/*
+ * backend/resources/volumesnapshot/service.go
+ *
+ * Snapshot API detection and the create/restore actions, run against the
+ * cluster's dynamic client (VolumeSnapshots) and typed client (claims).
+ */
+
+package volumesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/resources/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Service runs snapshot detection and actions against shared dependencies.
+type Service struct {
+	deps common.Dependencies
+}
+
+// NewService constructs a VolumeSnapshot service using the supplied dependencies bundle.
+func NewService(deps common.Dependencies) *Service {
+	return &Service{deps: deps}
+}
+
+// Support detects the snapshot API and lists the VolumeSnapshotClasses a new
+// snapshot can use. A cluster without the CRDs reports Installed false rather
+// than an error.
+func (s *Service) Support() (*Support, error) {
+	if s.deps.KubernetesClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	support := &Support{Classes: []ClassOption{}}
+	served, err := Served(s.deps.KubernetesClient.Discovery())
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover %s: %v", Group, err)
+	}
+	if !served {
+		return support, nil
+	}
+	support.Installed = true
+	support.APIVersion = VolumeSnapshotGVR.GroupVersion().String()
+	if s.deps.DynamicClient == nil {
+		return support, nil
+	}
+	classes, err := s.deps.DynamicClient.Resource(VolumeSnapshotClassGVR).List(s.deps.Context, metav1.ListOptions{})
+	switch {
+	case apierrors.IsForbidden(err) || apierrors.IsNotFound(err):
+		// Snapshots still work without listing classes: the default applies.
+	case err != nil:
+		return nil, fmt.Errorf("failed to list VolumeSnapshotClasses: %v", err)
+	default:
+		support.Classes = ClassOptions(classes.Items)
+	}
+	return support, nil
+}
+
+// Create snapshots the claim named in req. The claim must exist; binding and
+// driver support are left to the snapshot controller, which reports problems
+// in the snapshot's status.error.
+func (s *Service) Create(req CreateRequest, now time.Time) (*ActionResult, error) {
+	if s.deps.KubernetesClient == nil || s.deps.DynamicClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	if _, err := s.deps.KubernetesClient.CoreV1().PersistentVolumeClaims(req.Namespace).Get(s.deps.Context, req.ClaimName, metav1.GetOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to get PVC: %w", err)
+	}
+	created, err := s.deps.DynamicClient.Resource(VolumeSnapshotGVR).Namespace(req.Namespace).
+		Create(s.deps.Context, BuildSnapshot(req, now), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create VolumeSnapshot: %w", err)
+	}
+	return &ActionResult{
+		ClusterID: s.deps.ClusterID,
+		Kind:      KindVolumeSnapshot,
+		Namespace: created.GetNamespace(),
+		Name:      created.GetName(),
+	}, nil
+}
+
+// Restore creates a claim populated from the snapshot named in req. The
+// snapshot's source claim, when it still exists, supplies the defaults for
+// the new claim.
+func (s *Service) Restore(req RestoreRequest) (*ActionResult, error) {
+	if s.deps.KubernetesClient == nil || s.deps.DynamicClient == nil {
+		return nil, fmt.Errorf("kubernetes client not initialized")
+	}
+	snapshot, err := s.deps.DynamicClient.Resource(VolumeSnapshotGVR).Namespace(req.Namespace).
+		Get(s.deps.Context, req.SnapshotName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VolumeSnapshot: %w", err)
+	}
+	source, err := s.sourceClaim(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	claim, err := BuildRestoreClaim(snapshot, source, req)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.deps.KubernetesClient.CoreV1().PersistentVolumeClaims(req.Namespace).
+		Create(s.deps.Context, claim, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PVC: %w", err)
+	}
+	return &ActionResult{
+		ClusterID: s.deps.ClusterID,
+		Kind:      "PersistentVolumeClaim",
+		Namespace: created.Namespace,
+		Name:      created.Name,
+	}, nil
+}
+
+// sourceClaim fetches the claim a snapshot was taken from; it returns nil
+// without an error for pre-provisioned snapshots and deleted claims.
+func (s *Service) sourceClaim(snapshot *unstructured.Unstructured) (*corev1.PersistentVolumeClaim, error) {
+	name, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	if name == "" {
+		return nil, nil
+	}
+	claim, err := s.deps.KubernetesClient.CoreV1().PersistentVolumeClaims(snapshot.GetNamespace()).Get(s.deps.Context, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source PVC %s: %w", name, err)
+	}
+	return claim, nil
+}
@@ -253,6 +253,5 @@ func DescribeContainers(containers []corev1.Container) []restypes.PodDetailInfoC
 }
 
 // Job-specific helpers (filterPodsForJob, summarizeJob) and CronJob-specific
-// helpers (defaultInt32, summarizeCronJob, calculateNextSchedule[At],
-// formatCronJobSchedule) moved to resources/job and resources/cronjob with their
-// detail builders.
+// helpers (defaultInt32, summarizeCronJob, the schedule preview) moved to
+// resources/job and resources/cronjob with their detail builders.
@@ -290,6 +290,7 @@ func (a *App) invalidateResponseCacheForGVK(selectionKey string, gvk schema.Grou
 	a.responseCacheDelete(selectionKey, objectDetailCacheKeyForGVK(gvk, namespace, name))
 	a.responseCacheDelete(selectionKey, objectHeaderMetadataCacheKey(gvk, namespace, name))
 	a.responseCacheDelete(selectionKey, objectDetailCacheKey(gvk.Kind, namespace, name))
+	a.invalidateObjectRenderCache(selectionKey, gvk, namespace, name)
 }
 
 // invalidateResponseCache drops the cached detail entry for the resource, plus
@@ -305,6 +306,7 @@ func (a *App) invalidateResponseCache(selectionKey, kind, namespace, name string
 	if gvk, ok := objectDetailFetcherGVKs[strings.ToLower(strings.TrimSpace(kind))]; ok {
 		a.responseCacheDelete(selectionKey, objectDetailCacheKeyForGVK(gvk, namespace, name))
 		a.responseCacheDelete(selectionKey, objectHeaderMetadataCacheKey(gvk, namespace, name))
+		a.invalidateObjectRenderCache(selectionKey, gvk, namespace, name)
 	}
 }
 
@@ -0,0 +1,54 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EditSecretValues writes plaintext key/value changes to one Secret. Values
+// are base64-encoded server-side, validated against the Secret's type, and
+// applied with server-side apply. The result and the log line name keys only.
+func (a *App) EditSecretValues(clusterID string, req secret.SecretEditRequest) (*secret.SecretEditResult, error) {
+	if err := requireNamespacedObject(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	if err := a.requireClusterWritable(clusterID, "edit secret"); err != nil {
+		return nil, err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Secret"); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResourcePermission(ctx, deps, resourcePermissionCheck{
+		Version:   "v1",
+		Kind:      "Secret",
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Verb:      "patch",
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := secret.NewService(deps).EditValues(req)
+	if err != nil {
+		return nil, err
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, req.Namespace, req.Name)
+
+	a.logger.Info(
+		fmt.Sprintf("Edited Secret %s/%s (%d key(s) set, %d removed)", req.Namespace, req.Name, len(req.Set), len(req.Remove)),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	"github.com/stretchr/testify/require"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEditSecretValuesAppliesPlaintextValues(t *testing.T) {
+	app := newSecretRevealApp(t)
+	allowSelfSubjectAccessReviews(app.clusterClients["config:ctx"].client.(*cgofake.Clientset))
+
+	result, err := app.EditSecretValues("config:ctx", secret.SecretEditRequest{
+		Namespace: "default",
+		Name:      "creds",
+		Set:       map[string]string{"password": "s3cret"},
+		Remove:    []string{"API_TOKEN"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"password", "username"}, result.DataKeys)
+
+	require.NoError(t, app.SetClusterReadOnly("config:ctx", true))
+	_, err = app.EditSecretValues("config:ctx", secret.SecretEditRequest{
+		Namespace: "default",
+		Name:      "creds",
+		Set:       map[string]string{"password": "again"},
+	})
+	require.ErrorContains(t, err, "read-only")
+}
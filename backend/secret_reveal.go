@@ -0,0 +1,200 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/secret"
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+)
+
+// secretRevealAuditFileName is the append-only JSON-lines audit trail of every
+// Secret value reveal, stored next to settings.json.
+const secretRevealAuditFileName = "secret-reveal-audit.log"
+
+// SecretRevealAuditRecord is one line of the reveal audit log. It records which
+// keys were revealed or withheld, never the values themselves.
+type SecretRevealAuditRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	ClusterID    string    `json:"clusterId"`
+	ClusterName  string    `json:"clusterName,omitempty"`
+	Namespace    string    `json:"namespace"`
+	Name         string    `json:"name"`
+	RevealedKeys []string  `json:"revealedKeys"`
+	RedactedKeys []string  `json:"redactedKeys,omitempty"`
+}
+
+// RevealSecretValues returns decoded values for the requested keys of one
+// Secret (every key when keys is empty). Reveal is gated by the
+// secretRevealEnabled preference, keys matching secretRedactedKeyPatterns are
+// withheld, and each reveal is appended to the local audit log before any value
+// is returned — a reveal that cannot be audited fails.
+func (a *App) RevealSecretValues(clusterID, namespace, name string, keys []string) (*secret.SecretRevealResult, error) {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	settings, err := a.GetAppSettings()
+	if err != nil {
+		return nil, err
+	}
+	if !settings.SecretRevealEnabled {
+		return nil, fmt.Errorf("secret reveal is disabled; enable it in Settings")
+	}
+
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Secret"); err != nil {
+		return nil, err
+	}
+
+	result, err := secret.NewService(deps).RevealValues(namespace, name, keys, secret.RedactionPatterns(settings.SecretRedactedKeyPatterns))
+	if err != nil {
+		return nil, err
+	}
+
+	record := SecretRevealAuditRecord{
+		Timestamp:    time.Now().UTC(),
+		ClusterID:    deps.ClusterID,
+		ClusterName:  deps.ClusterName,
+		Namespace:    namespace,
+		Name:         name,
+		RevealedKeys: slices.Sorted(maps.Keys(result.Values)),
+		RedactedKeys: result.RedactedKeys,
+	}
+	if err := a.appendSecretRevealAudit(record); err != nil {
+		return nil, fmt.Errorf("record secret reveal audit: %w", err)
+	}
+
+	a.logger.Info(
+		fmt.Sprintf("Revealed %d key(s) of Secret %s/%s (%d redacted)", len(result.Values), namespace, name, len(result.RedactedKeys)),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return result, nil
+}
+
+// RevealSecretValueRange returns one byte range of a single Secret key, for
+// values too large to reveal whole. It is gated, redacted and audited exactly
+// like RevealSecretValues; each chunk is its own audit record.
+func (a *App) RevealSecretValueRange(clusterID, namespace, name, key string, rng restypes.DataValueRange) (*restypes.DataValue, error) {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return nil, err
+	}
+	settings, err := a.GetAppSettings()
+	if err != nil {
+		return nil, err
+	}
+	if !settings.SecretRevealEnabled {
+		return nil, fmt.Errorf("secret reveal is disabled; enable it in Settings")
+	}
+
+	deps, _, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "Secret"); err != nil {
+		return nil, err
+	}
+
+	chunk, err := secret.NewService(deps).RevealValueRange(namespace, name, key, rng, secret.RedactionPatterns(settings.SecretRedactedKeyPatterns))
+	if err != nil {
+		return nil, err
+	}
+
+	record := SecretRevealAuditRecord{
+		Timestamp:    time.Now().UTC(),
+		ClusterID:    deps.ClusterID,
+		ClusterName:  deps.ClusterName,
+		Namespace:    namespace,
+		Name:         name,
+		RevealedKeys: []string{key},
+	}
+	if err := a.appendSecretRevealAudit(record); err != nil {
+		return nil, fmt.Errorf("record secret reveal audit: %w", err)
+	}
+	return chunk, nil
+}
+
+// secretRevealAuditFilePath returns the audit log path in the app config dir.
+func (a *App) secretRevealAuditFilePath() (string, error) {
+	settingsPath, err := a.getSettingsFilePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(settingsPath), secretRevealAuditFileName), nil
+}
+
+// appendSecretRevealAudit appends one record to the audit log. The file is
+// owner-only because it names the Secrets a user has looked at.
+func (a *App) appendSecretRevealAudit(record SecretRevealAuditRecord) error {
+	path, err := a.secretRevealAuditFilePath()
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	a.secretRevealAuditMu.Lock()
+	defer a.secretRevealAuditMu.Unlock()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// GetSecretRevealAuditLog returns the most recent audit records, newest first.
+// limit <= 0 returns every record.
+func (a *App) GetSecretRevealAuditLog(limit int) ([]SecretRevealAuditRecord, error) {
+	path, err := a.secretRevealAuditFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	a.secretRevealAuditMu.Lock()
+	data, err := os.ReadFile(path)
+	a.secretRevealAuditMu.Unlock()
+	if os.IsNotExist(err) {
+		return []SecretRevealAuditRecord{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read secret reveal audit log: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	records := make([]SecretRevealAuditRecord, 0, len(lines))
+	for i := len(lines) - 1; i >= 0; i-- {
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		var record SecretRevealAuditRecord
+		if err := json.Unmarshal([]byte(lines[i]), &record); err != nil {
+			// A torn or hand-edited line must not hide the rest of the trail.
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
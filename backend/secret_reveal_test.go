@@ -0,0 +1,128 @@
+package backend
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	restypes "github.com/luxury-yacht/app/backend/resources/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newSecretRevealApp(t *testing.T) *App {
+	t.Helper()
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"username":  []byte("admin"),
+			"API_TOKEN": []byte("abc123"),
+		},
+	})
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+		},
+	}
+	return app
+}
+
+func TestRevealSecretValuesDisabledByDefault(t *testing.T) {
+	app := newSecretRevealApp(t)
+
+	_, err := app.RevealSecretValues("config:ctx", "default", "creds", nil)
+	require.ErrorContains(t, err, "disabled")
+
+	records, err := app.GetSecretRevealAuditLog(0)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func TestRevealSecretValuesRedactsAndAudits(t *testing.T) {
+	app := newSecretRevealApp(t)
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceSecretRevealEnabled, Value: true},
+		{Key: appPreferenceSecretRedactedKeyPatterns, Value: []any{"*_TOKEN", " *_TOKEN "}},
+	}})
+	require.NoError(t, err)
+
+	result, err := app.RevealSecretValues("config:ctx", "default", "creds", nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"username": "admin"}, result.Values)
+	require.Equal(t, []string{"API_TOKEN"}, result.RedactedKeys)
+
+	records, err := app.GetSecretRevealAuditLog(0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "config:ctx", records[0].ClusterID)
+	require.Equal(t, "default", records[0].Namespace)
+	require.Equal(t, "creds", records[0].Name)
+	require.Equal(t, []string{"username"}, records[0].RevealedKeys)
+	require.Equal(t, []string{"API_TOKEN"}, records[0].RedactedKeys)
+
+	path, err := app.secretRevealAuditFilePath()
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "admin", "the audit log must never contain revealed values")
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+}
+
+func TestRevealSecretValueRangeIsGatedAndAudited(t *testing.T) {
+	app := newSecretRevealApp(t)
+
+	_, err := app.RevealSecretValueRange("config:ctx", "default", "creds", "username", restypes.DataValueRange{})
+	require.ErrorContains(t, err, "disabled")
+
+	_, err = app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceSecretRevealEnabled, Value: true},
+	}})
+	require.NoError(t, err)
+
+	chunk, err := app.RevealSecretValueRange("config:ctx", "default", "creds", "username", restypes.DataValueRange{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, "dm", chunk.Value)
+	require.True(t, chunk.Truncated)
+
+	records, err := app.GetSecretRevealAuditLog(0)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, []string{"username"}, records[0].RevealedKeys)
+}
+
+func TestGetSecretRevealAuditLogNewestFirstWithLimit(t *testing.T) {
+	app := newSecretRevealApp(t)
+	for _, name := range []string{"first", "second", "third"} {
+		require.NoError(t, app.appendSecretRevealAudit(SecretRevealAuditRecord{ClusterID: "config:ctx", Namespace: "default", Name: name}))
+	}
+
+	records, err := app.GetSecretRevealAuditLog(2)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "third", records[0].Name)
+	require.Equal(t, "second", records[1].Name)
+}
+
+func TestSecretRedactedKeyPatternsPreferenceRejectsMalformedGlob(t *testing.T) {
+	app := newSecretRevealApp(t)
+
+	_, err := app.UpdateAppPreferences(UpdateAppPreferencesRequest{Changes: []AppPreferenceChange{
+		{Key: appPreferenceSecretRedactedKeyPatterns, Value: []any{"[bad"}},
+	}})
+	require.ErrorContains(t, err, "[bad")
+
+	settings, err := app.GetAppSettings()
+	require.NoError(t, err)
+	require.Empty(t, settings.SecretRedactedKeyPatterns)
+}
@@ -0,0 +1,148 @@
+/*
+ * backend/secret_yaml.go
+ *
+ * Keeps Secret values out of rendered YAML.
+ * - Rendering a core/v1 Secret replaces every data and stringData value, and
+ *   the last-applied annotation that repeats them, with a placeholder.
+ * - Placeholders an edit leaves untouched are restored from the live object
+ *   before the patch or merge is built, so they never overwrite a value.
+ * Decoded values leave the backend only through the audited reveal calls.
+ */
+
+package backend
+
+import (
+	"reflect"
+	"strings"
+)
+
+const (
+	// withheldSecretText replaces clear-text Secret values: stringData and
+	// the last-applied annotation.
+	withheldSecretText = "<redacted>"
+	// withheldSecretData replaces base64 data values. It is withheldSecretText
+	// encoded, so a rendered Secret still decodes and validates.
+	withheldSecretData = "PHJlZGFjdGVkPg=="
+)
+
+// secretValueFields are the Secret fields holding values, with the
+// placeholder each is rendered with.
+var secretValueFields = map[string]string{
+	"data":       withheldSecretData,
+	"stringData": withheldSecretText,
+}
+
+func isCoreSecret(obj map[string]interface{}) bool {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	return apiVersion == "v1" && kind == "Secret"
+}
+
+// withholdSecretValues returns obj with its Secret values replaced by
+// placeholders. When live is set (rendering a merge result) only values equal
+// to the live ones are withheld; the rest are the user's own edits. Objects
+// that are not Secrets are returned as is. obj itself is never modified.
+func withholdSecretValues(obj, live map[string]interface{}) map[string]interface{} {
+	if obj == nil || !isCoreSecret(obj) {
+		return obj
+	}
+	return withholdSecretFields(obj, live)
+}
+
+// withholdSecretFields is withholdSecretValues for a document already known to
+// be a Secret, such as a last-applied manifest that may omit apiVersion/kind.
+func withholdSecretFields(obj, live map[string]interface{}) map[string]interface{} {
+	withheld := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		withheld[key] = value
+	}
+	for field, placeholder := range secretValueFields {
+		values, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		liveValues, _ := live[field].(map[string]interface{})
+		copied := make(map[string]interface{}, len(values))
+		for key, value := range values {
+			copied[key] = value
+			if live != nil && !reflect.DeepEqual(liveValues[key], value) {
+				continue
+			}
+			copied[key] = placeholder
+		}
+		withheld[field] = copied
+	}
+
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		return withheld
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return withheld
+	}
+	applied, ok := annotations[lastAppliedConfigAnnotation]
+	if !ok || (live != nil && !reflect.DeepEqual(liveLastApplied(live), applied)) {
+		return withheld
+	}
+	copiedMetadata := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		copiedMetadata[key] = value
+	}
+	copiedAnnotations := make(map[string]interface{}, len(annotations))
+	for key, value := range annotations {
+		copiedAnnotations[key] = value
+	}
+	copiedAnnotations[lastAppliedConfigAnnotation] = withheldSecretText
+	copiedMetadata["annotations"] = copiedAnnotations
+	withheld["metadata"] = copiedMetadata
+	return withheld
+}
+
+// restoreWithheldSecretValues puts the live values back wherever obj, an
+// edited or baseline Secret, still carries a placeholder, in place.
+func restoreWithheldSecretValues(obj, live map[string]interface{}) {
+	if obj == nil || live == nil || !isCoreSecret(obj) {
+		return
+	}
+	for field, placeholder := range secretValueFields {
+		values, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		liveValues, _ := live[field].(map[string]interface{})
+		for key, value := range values {
+			if text, ok := value.(string); !ok || strings.TrimSpace(text) != placeholder {
+				continue
+			}
+			if liveValue, found := liveValues[key]; found {
+				values[key] = liveValue
+			}
+		}
+	}
+
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if value, ok := annotations[lastAppliedConfigAnnotation].(string); ok && value == withheldSecretText {
+		if applied := liveLastApplied(live); applied != nil {
+			annotations[lastAppliedConfigAnnotation] = applied
+		}
+	}
+}
+
+func liveLastApplied(live map[string]interface{}) interface{} {
+	metadata, _ := live["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	return annotations[lastAppliedConfigAnnotation]
+}
+
+// isSecretValuePath reports whether an applied-diff field path points into a
+// Secret's values.
+func isSecretValuePath(path string) bool {
+	for field := range secretValueFields {
+		if path == "."+field || strings.HasPrefix(path, "."+field+".") {
+			return true
+		}
+	}
+	return false
+}
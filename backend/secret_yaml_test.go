@@ -0,0 +1,116 @@
+/*
+ * backend/secret_yaml_test.go
+ *
+ * Tests for withholding Secret values from rendered YAML.
+ */
+
+package backend
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func liveSecretObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "creds",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				lastAppliedConfigAnnotation: `{"data":{"password":"c3VwZXItc2VjcmV0"}}`,
+				"team":                      "payments",
+			},
+		},
+		"type": "Opaque",
+		"data": map[string]interface{}{
+			"password": "c3VwZXItc2VjcmV0",
+			"username": "YWRtaW4=",
+		},
+	}}
+}
+
+func TestRenderObjectYAMLWithholdsSecretValues(t *testing.T) {
+	live := liveSecretObject()
+
+	rendered, err := renderObjectYAML(live)
+	require.NoError(t, err)
+	require.NotContains(t, rendered, "c3VwZXItc2VjcmV0")
+	require.NotContains(t, rendered, "YWRtaW4=")
+	require.Contains(t, rendered, "password: "+withheldSecretData)
+	require.Contains(t, rendered, "team: payments")
+
+	normalized, err := normalizeObjectYAML(live)
+	require.NoError(t, err)
+	require.NotContains(t, normalized, "c3VwZXItc2VjcmV0")
+
+	// The live object itself is left alone.
+	data, _, _ := unstructured.NestedStringMap(live.Object, "data")
+	require.Equal(t, "c3VwZXItc2VjcmV0", data["password"])
+	require.Equal(t, `{"data":{"password":"c3VwZXItc2VjcmV0"}}`, live.GetAnnotations()[lastAppliedConfigAnnotation])
+}
+
+func TestRenderObjectYAMLKeepsOtherKindsUnchanged(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "cfg", "namespace": "default"},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+
+	rendered, err := renderObjectYAML(configMap)
+	require.NoError(t, err)
+	require.Contains(t, rendered, "key: value")
+}
+
+func TestMarshalMergedObjectYAMLKeepsDraftSecretEdits(t *testing.T) {
+	live := liveSecretObject()
+	merged := live.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(merged.Object, "bmV3LXZhbHVl", "data", "password"))
+
+	rendered, err := marshalMergedObjectYAML(merged, live)
+	require.NoError(t, err)
+	require.Contains(t, rendered, "password: bmV3LXZhbHVl")
+	require.Contains(t, rendered, "username: "+withheldSecretData)
+	require.NotContains(t, rendered, "YWRtaW4=")
+}
+
+func TestSecretEditPatchesOnlyChangedValues(t *testing.T) {
+	live := liveSecretObject()
+	rendered, err := renderObjectYAML(live)
+	require.NoError(t, err)
+
+	base, err := parseYAMLToUnstructured(rendered)
+	require.NoError(t, err)
+	desired, err := parseYAMLToUnstructured(strings.Replace(rendered, "username: "+withheldSecretData, "username: cm9vdA==", 1))
+	require.NoError(t, err)
+
+	restoreWithheldSecretValues(base.Object, live.Object)
+	restoreWithheldSecretValues(desired.Object, live.Object)
+
+	patch, _, err := buildKubectlEditPatch(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, base, desired)
+	require.NoError(t, err)
+	var patchMap map[string]interface{}
+	require.NoError(t, json.Unmarshal(patch, &patchMap))
+	require.Equal(t, map[string]interface{}{"data": map[string]interface{}{"username": "cm9vdA=="}}, patchMap)
+}
+
+func TestObjectAppliedDiffWithholdsSecretValues(t *testing.T) {
+	live := liveSecretObject()
+	require.NoError(t, unstructured.SetNestedField(live.Object, "ZHJpZnRlZA==", "data", "password"))
+
+	diff, err := objectAppliedDiff(live)
+	require.NoError(t, err)
+	require.NotContains(t, diff.Live, "ZHJpZnRlZA==")
+	require.NotContains(t, diff.Declared, "c3VwZXItc2VjcmV0")
+	require.Len(t, diff.Changes, 1)
+	require.Equal(t, ".data.password", diff.Changes[0].Path)
+	require.Equal(t, encodeAppliedValue(withheldSecretText), diff.Changes[0].Declared)
+	require.Equal(t, encodeAppliedValue(withheldSecretText), diff.Changes[0].Live)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/luxury-yacht/app/backend/internal/config"
 	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/common"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -48,7 +49,9 @@ type shellSession struct {
 	stdinR      *io.PipeReader
 	sizeQueue   *terminalSizeQueue
 	cancel      context.CancelFunc
-	once        sync.Once
+	// onClose, when set, runs once after the session closes, however it ends.
+	onClose func()
+	once    sync.Once
 
 	activityMu   sync.Mutex
 	lastActivity time.Time
@@ -125,6 +128,9 @@ func (s *shellSession) Close() {
 		if s.cancel != nil {
 			s.cancel()
 		}
+		if s.onClose != nil {
+			go s.onClose()
+		}
 	})
 }
 
@@ -186,9 +192,18 @@ func (w *shellEventWriter) Write(p []byte) (int, error) {
 
 // StartShellSession launches a kubectl exec session and begins streaming data back to the frontend.
 func (a *App) StartShellSession(clusterID string, req ShellSessionRequest) (*ShellSession, error) {
+	return a.startShellSession(clusterID, req, nil)
+}
+
+// startShellSession starts an exec session; onClose, when set, runs once the
+// session has closed.
+func (a *App) startShellSession(clusterID string, req ShellSessionRequest, onClose func()) (*ShellSession, error) {
 	if err := requirePodObject(req.Namespace, req.PodName); err != nil {
 		return nil, err
 	}
+	if err := a.requireClusterWritable(clusterID, "exec"); err != nil {
+		return nil, err
+	}
 
 	deps, _, err := a.resolveClusterDependencies(clusterID)
 	if err != nil {
@@ -211,47 +226,19 @@ func (a *App) StartShellSession(clusterID string, req ShellSessionRequest) (*She
 	if err != nil {
 		return nil, fmt.Errorf("failed to load pod: %w", err)
 	}
-	if len(pod.Spec.Containers) == 0 && len(pod.Spec.EphemeralContainers) == 0 {
-		return nil, fmt.Errorf("pod has no containers available for exec")
-	}
-
-	container := req.Container
-	if container == "" {
-		if len(pod.Spec.Containers) > 0 {
-			container = pod.Spec.Containers[0].Name
-		} else {
-			// Pods normally have regular containers, but allow ephemeral-only fallback.
-			container = pod.Spec.EphemeralContainers[0].Name
-		}
-	}
-	if !hasContainer(pod.Spec.Containers, container) && !hasEphemeralContainer(pod.Spec.EphemeralContainers, container) {
-		return nil, fmt.Errorf("container %q not found in pod %s", container, req.PodName)
+	container, err := resolveExecContainer(pod, req.Container)
+	if err != nil {
+		return nil, err
 	}
-
-	if err := a.requireAnyResourcePermission(deps.Context, deps,
-		resourcePermissionCheck{
-			Version:     "v1",
-			Kind:        podspkg.Identity.Kind,
-			Namespace:   req.Namespace,
-			Name:        req.PodName,
-			Verb:        "get",
-			Subresource: "exec",
-		},
-		resourcePermissionCheck{
-			Version:     "v1",
-			Kind:        podspkg.Identity.Kind,
-			Namespace:   req.Namespace,
-			Name:        req.PodName,
-			Verb:        "create",
-			Subresource: "exec",
-		},
-	); err != nil {
+	if err := a.requirePodExecPermission(deps, req.Namespace, req.PodName); err != nil {
 		return nil, err
 	}
 
 	command := req.Command
 	if len(command) == 0 {
-		command = []string{"/bin/sh"}
+		if command, err = a.defaultShellCommandFor(pod, container); err != nil {
+			return nil, err
+		}
 	}
 
 	sessionID := uuid.NewString()
@@ -259,37 +246,16 @@ func (a *App) StartShellSession(clusterID string, req ShellSessionRequest) (*She
 	sizeQueue := newTerminalSizeQueue()
 	sizeQueue.Set(120, 40)
 
-	execReq := deps.KubernetesClient.CoreV1().
-		RESTClient().
-		Post().
-		Resource("pods").
-		Namespace(req.Namespace).
-		Name(req.PodName).
-		SubResource("exec").
-		VersionedParams(&corev1.PodExecOptions{
-			Container: container,
-			Command:   command,
-			Stdin:     true,
-			Stdout:    true,
-			Stderr:    true,
-			TTY:       true,
-		}, scheme.ParameterCodec)
-
-	websocketExec, err := websocketExecutorFactory(deps.RestConfig, http.MethodGet, execReq.URL().String())
-	if err != nil {
-		return nil, fmt.Errorf("failed to create websocket executor: %w", err)
-	}
-	spdyExecutor, err := spdyExecutorFactory(deps.RestConfig, http.MethodPost, execReq.URL())
-	if err != nil {
-		return nil, fmt.Errorf("failed to create SPDY executor: %w", err)
-	}
-
-	// Use websocket exec when possible, but fall back to SPDY on upgrade or proxy errors.
-	executor, err := remotecommand.NewFallbackExecutor(websocketExec, spdyExecutor, func(err error) bool {
-		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	executor, err := newPodExecExecutor(deps, req.Namespace, req.PodName, &corev1.PodExecOptions{
+		Container: container,
+		Command:   command,
+		Stdin:     true,
+		Stdout:    true,
+		Stderr:    true,
+		TTY:       true,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create fallback executor: %w", err)
+		return nil, err
 	}
 
 	sessionCtx, sessionCancel := context.WithCancel(context.Background())
@@ -306,6 +272,7 @@ func (a *App) StartShellSession(clusterID string, req ShellSessionRequest) (*She
 		stdinR:       stdinReader,
 		sizeQueue:    sizeQueue,
 		cancel:       sessionCancel,
+		onClose:      onClose,
 		startedAt:    now,
 		lastActivity: now,
 	}
@@ -437,6 +404,79 @@ func (a *App) GetShellSessionBacklog(sessionID string) (string, error) {
 	return sess.snapshotBacklog(), nil
 }
 
+// resolveExecContainer returns the requested container, or the pod's first
+// container when none is requested, and rejects names the pod does not have.
+func resolveExecContainer(pod *corev1.Pod, requested string) (string, error) {
+	if len(pod.Spec.Containers) == 0 && len(pod.Spec.EphemeralContainers) == 0 {
+		return "", fmt.Errorf("pod has no containers available for exec")
+	}
+	container := requested
+	if container == "" {
+		if len(pod.Spec.Containers) > 0 {
+			container = pod.Spec.Containers[0].Name
+		} else {
+			// Pods normally have regular containers, but allow ephemeral-only fallback.
+			container = pod.Spec.EphemeralContainers[0].Name
+		}
+	}
+	if !hasContainer(pod.Spec.Containers, container) && !hasEphemeralContainer(pod.Spec.EphemeralContainers, container) {
+		return "", fmt.Errorf("container %q not found in pod %s", container, pod.Name)
+	}
+	return container, nil
+}
+
+// requirePodExecPermission checks that the user may exec into the pod.
+func (a *App) requirePodExecPermission(deps common.Dependencies, namespace, podName string) error {
+	return a.requireAnyResourcePermission(deps.Context, deps,
+		resourcePermissionCheck{
+			Version:     "v1",
+			Kind:        podspkg.Identity.Kind,
+			Namespace:   namespace,
+			Name:        podName,
+			Verb:        "get",
+			Subresource: "exec",
+		},
+		resourcePermissionCheck{
+			Version:     "v1",
+			Kind:        podspkg.Identity.Kind,
+			Namespace:   namespace,
+			Name:        podName,
+			Verb:        "create",
+			Subresource: "exec",
+		},
+	)
+}
+
+// newPodExecExecutor builds an exec executor for the pod.
+func newPodExecExecutor(deps common.Dependencies, namespace, podName string, options *corev1.PodExecOptions) (remotecommand.Executor, error) {
+	execReq := deps.KubernetesClient.CoreV1().
+		RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(options, scheme.ParameterCodec)
+
+	websocketExec, err := websocketExecutorFactory(deps.RestConfig, http.MethodGet, execReq.URL().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create websocket executor: %w", err)
+	}
+	spdyExecutor, err := spdyExecutorFactory(deps.RestConfig, http.MethodPost, execReq.URL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPDY executor: %w", err)
+	}
+
+	// Use websocket exec when possible, but fall back to SPDY on upgrade or proxy errors.
+	executor, err := remotecommand.NewFallbackExecutor(websocketExec, spdyExecutor, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fallback executor: %w", err)
+	}
+	return executor, nil
+}
+
 func hasContainer(containers []corev1.Container, name string) bool {
 	for _, c := range containers {
 		if c.Name == name {
@@ -621,3 +621,22 @@ func TestStartShellSessionRequiresExecPermission(t *testing.T) {
 		t.Fatalf("expected denied shell session not to be registered")
 	}
 }
+
+func TestShellSessionCloseRunsOnCloseOnce(t *testing.T) {
+	calls := make(chan struct{}, 2)
+	sess := &shellSession{id: "sess-hook", onClose: func() { calls <- struct{}{} }}
+
+	sess.Close()
+	sess.Close()
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("expected onClose to run after Close")
+	}
+	select {
+	case <-calls:
+		t.Fatal("expected onClose to run only once")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
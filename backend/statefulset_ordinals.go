@@ -0,0 +1,138 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/luxury-yacht/app/backend/internal/logsources"
+	"github.com/luxury-yacht/app/backend/resources/pods"
+	"github.com/luxury-yacht/app/backend/resources/statefulset"
+)
+
+// DeleteStatefulSetOrdinal deletes the pod at one ordinal of a StatefulSet so
+// the controller recreates it with the same identity. It returns the deleted
+// pod's name. confirmation is the typed pod name required in protected
+// namespaces.
+func (a *App) DeleteStatefulSetOrdinal(clusterID, namespace, name string, ordinal int, confirmation string) (string, error) {
+	return a.deleteStatefulSetOrdinal(clusterID, namespace, name, ordinal, confirmation, false)
+}
+
+// ForceDeleteStatefulSetOrdinal removes the pod at one ordinal without waiting
+// for its grace period. The pod must already be terminating; this is for pods
+// stuck on an unreachable node, which block the ordinal from being replaced.
+func (a *App) ForceDeleteStatefulSetOrdinal(clusterID, namespace, name string, ordinal int, confirmation string) (string, error) {
+	return a.deleteStatefulSetOrdinal(clusterID, namespace, name, ordinal, confirmation, true)
+}
+
+func (a *App) deleteStatefulSetOrdinal(clusterID, namespace, name string, ordinal int, confirmation string, force bool) (string, error) {
+	action := ObjectActionDelete
+	if force {
+		action = ObjectActionForceDelete
+	}
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return "", err
+	}
+	if ordinal < 0 || ordinal > maxScaleReplicas {
+		return "", fmt.Errorf("ordinal must be between 0 and %d", maxScaleReplicas)
+	}
+	if err := a.requireClusterWritable(clusterID, action); err != nil {
+		return "", err
+	}
+	podName := statefulset.OrdinalPodName(name, int32(ordinal))
+	podTarget := objectActionTarget(clusterID, pods.Identity.Group, pods.Identity.Version, pods.Identity.Kind, namespace, podName)
+	if err := a.requireProtectedNamespaceConfirmation(action, podTarget, confirmation); err != nil {
+		return "", err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "StatefulSet"); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResourcePermission(ctx, deps, resourcePermissionCheck{
+		Version:   pods.Identity.Version,
+		Kind:      pods.Identity.Kind,
+		Namespace: namespace,
+		Name:      podName,
+		Verb:      "delete",
+	}); err != nil {
+		return "", err
+	}
+
+	service := statefulset.NewService(deps)
+	verb := "Deleted"
+	if force {
+		// A force delete only finishes a pod that is already terminating, so
+		// the delete that started it is the one the recycle bin keeps.
+		verb = "Force deleted"
+		podName, err = service.ForceDeleteOrdinal(namespace, name, int32(ordinal))
+	} else {
+		a.captureDeletedObject(deps, podTarget)
+		podName, err = service.DeleteOrdinal(namespace, name, int32(ordinal))
+	}
+	if err != nil {
+		return "", wrapKubernetesError(err, "failed to delete StatefulSet ordinal")
+	}
+	a.invalidateResponseCacheForGVK(selectionKey, objectActionTargetGVK(podTarget), namespace, podName)
+	a.invalidateResponseCache(selectionKey, statefulset.Identity.Kind, namespace, name)
+
+	a.logger.Info(
+		fmt.Sprintf("%s pod %s/%s (StatefulSet %s ordinal %d)", verb, namespace, podName, name, ordinal),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return podName, nil
+}
+
+// SetStatefulSetPartition sets the rolling update partition of a StatefulSet:
+// ordinals at or above partition move to the update revision, those below
+// keep the current one. Lowering it step by step stages a rollout.
+func (a *App) SetStatefulSetPartition(clusterID, namespace, name string, partition int) error {
+	if err := requireNamespacedObject(namespace, name); err != nil {
+		return err
+	}
+	if partition < 0 || partition > maxScaleReplicas {
+		return fmt.Errorf("partition must be between 0 and %d", maxScaleReplicas)
+	}
+	if err := a.requireClusterWritable(clusterID, "set partition"); err != nil {
+		return err
+	}
+	deps, selectionKey, err := a.resolveClusterDependencies(clusterID)
+	if err != nil {
+		return err
+	}
+	if err := ensureDependenciesInitialized(a, deps, "StatefulSet"); err != nil {
+		return err
+	}
+
+	ctx, cancel := a.mutationContext()
+	defer cancel()
+	deps.Context = ctx
+
+	if err := a.requireResourcePermission(ctx, deps, resourcePermissionCheck{
+		Group:     statefulset.Identity.Group,
+		Version:   statefulset.Identity.Version,
+		Kind:      statefulset.Identity.Kind,
+		Namespace: namespace,
+		Name:      name,
+		Verb:      "patch",
+	}); err != nil {
+		return err
+	}
+
+	previous, err := statefulset.NewService(deps).SetPartition(namespace, name, int32(partition))
+	if err != nil {
+		return wrapKubernetesError(err, "failed to set StatefulSet partition")
+	}
+	a.invalidateResponseCache(selectionKey, statefulset.Identity.Kind, namespace, name)
+
+	a.logger.Info(
+		fmt.Sprintf("Set partition of StatefulSet %s/%s from %d to %d", namespace, name, previous, partition),
+		logsources.ResourceLoader, deps.ClusterID, deps.ClusterName,
+	)
+	return nil
+}
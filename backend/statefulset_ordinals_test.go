@@ -0,0 +1,65 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/luxury-yacht/app/backend/testsupport"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStatefulSetOrdinalActions(t *testing.T) {
+	setTestConfigEnv(t)
+	client := cgofake.NewClientset(
+		testsupport.StatefulSetFixture("default", "db"),
+		testsupport.PodFixture("default", "db-1", testsupport.PodWithOwner("StatefulSet", "db", true)),
+	)
+	allowSelfSubjectAccessReviews(client)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]any{"name": "db-1", "namespace": "default"},
+	}})
+	app := newTestAppWithDefaults(t)
+	app.clusterClients = map[string]*clusterClients{
+		"config:ctx": {
+			meta:              ClusterMeta{ID: "config:ctx", Name: "ctx"},
+			kubeconfigPath:    "/path",
+			kubeconfigContext: "ctx",
+			client:            client,
+			dynamicClient:     dynamicClient,
+		},
+	}
+
+	_, err := app.ForceDeleteStatefulSetOrdinal("config:ctx", "default", "db", 1, "")
+	require.ErrorContains(t, err, "is not terminating")
+
+	podName, err := app.DeleteStatefulSetOrdinal("config:ctx", "default", "db", 1, "")
+	require.NoError(t, err)
+	require.Equal(t, "db-1", podName)
+	_, err = client.CoreV1().Pods("default").Get(context.Background(), "db-1", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+	entries, err := app.GetRecycleBin()
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "the deleted ordinal pod is kept in the recycle bin")
+	require.Equal(t, "db-1", entries[0].Name)
+
+	_, err = app.DeleteStatefulSetOrdinal("config:ctx", "default", "db", -1, "")
+	require.ErrorContains(t, err, "ordinal must be between")
+
+	require.NoError(t, app.SetStatefulSetPartition("config:ctx", "default", "db", 2))
+	sts, err := client.AppsV1().StatefulSets("default").Get(context.Background(), "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), *sts.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	require.NoError(t, app.SetClusterReadOnly("config:ctx", true))
+	require.ErrorContains(t, app.SetStatefulSetPartition("config:ctx", "default", "db", 0), "read-only")
+	_, err = app.DeleteStatefulSetOrdinal("config:ctx", "default", "db", 0, "")
+	require.ErrorContains(t, err, "read-only")
+}
@@ -28,6 +28,10 @@ type (
 	AppearanceModeInfo                  = types.AppearanceModeInfo
 	Theme                               = types.Theme
 	ThemeClusterPatternValidationResult = types.ThemeClusterPatternValidationResult
+	KeyBinding                          = types.KeyBinding
+	SettingsTransferResult              = types.SettingsTransferResult
+	SettingsSyncStatus                  = types.SettingsSyncStatus
+	KeyBindingAction                    = types.KeyBindingAction
 	ContainerLogsEntry                  = types.ContainerLogsEntry
 	ContainerLogsFetchRequest           = types.ContainerLogsFetchRequest
 	ContainerLogsFetchResponse          = types.ContainerLogsFetchResponse
@@ -35,9 +39,16 @@ type (
 	NodeLogDiscoveryResponse            = types.NodeLogDiscoveryResponse
 	NodeLogFetchRequest                 = types.NodeLogFetchRequest
 	NodeLogFetchResponse                = types.NodeLogFetchResponse
+	NodeLogDebugPodRequest              = types.NodeLogDebugPodRequest
 	ShellSessionRequest                 = types.ShellSessionRequest
+	ConnectivityCheckRequest            = types.ConnectivityCheckRequest
+	DNSLookupRequest                    = types.DNSLookupRequest
 	ShellSession                        = types.ShellSession
 	ShellSessionInfo                    = types.ShellSessionInfo
+	PodContainerRequest                 = types.PodContainerRequest
+	PodFileRequest                      = types.PodFileRequest
+	PodFilesDownloadRequest             = types.PodFilesDownloadRequest
+	PodFilesDownloadResult              = types.PodFilesDownloadResult
 	DebugContainerRequest               = types.DebugContainerRequest
 	DebugContainerResponse              = types.DebugContainerResponse
 	ShellOutputEvent                    = types.ShellOutputEvent
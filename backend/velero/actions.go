@@ -0,0 +1,56 @@
+package velero
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// nameTimestampFormat suffixes the names of objects created here, matching
+// the names `velero backup create --from-schedule` gives its backups.
+const nameTimestampFormat = "20060102150405"
+
+// NamespaceBackup returns an ad-hoc Backup of namespace, created in Velero's
+// install namespace and stored in Velero's default storage location.
+func NamespaceBackup(installNamespace, namespace string, now time.Time) *unstructured.Unstructured {
+	backup := newObject("Backup", installNamespace, fmt.Sprintf("%s-%s", namespace, now.UTC().Format(nameTimestampFormat)))
+	backup.Object["spec"] = map[string]interface{}{
+		"includedNamespaces": []interface{}{namespace},
+	}
+	return backup
+}
+
+// BackupFromSchedule returns a Backup built from a Schedule's template, as
+// `velero backup create --from-schedule` does.
+func BackupFromSchedule(schedule *unstructured.Unstructured, now time.Time) (*unstructured.Unstructured, error) {
+	template, found, err := unstructured.NestedMap(schedule.Object, "spec", "template")
+	if err != nil {
+		return nil, fmt.Errorf("read schedule %s template: %w", schedule.GetName(), err)
+	}
+	if !found {
+		template = map[string]interface{}{}
+	}
+	backup := newObject("Backup", schedule.GetNamespace(), fmt.Sprintf("%s-%s", schedule.GetName(), now.UTC().Format(nameTimestampFormat)))
+	backup.SetLabels(map[string]string{ScheduleNameLabel: schedule.GetName()})
+	backup.Object["spec"] = template
+	return backup, nil
+}
+
+// RestoreOf returns a Restore of every object in the named Backup.
+func RestoreOf(backupNamespace, backupName string, now time.Time) *unstructured.Unstructured {
+	restore := newObject("Restore", backupNamespace, fmt.Sprintf("%s-%s", backupName, now.UTC().Format(nameTimestampFormat)))
+	restore.Object["spec"] = map[string]interface{}{
+		"backupName": backupName,
+	}
+	return restore
+}
+
+func newObject(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	obj.SetAPIVersion(Group + "/" + Version)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
@@ -0,0 +1,374 @@
+/*
+ * backend/velero/collector.go
+ *
+ * Collects Velero state from the cluster: Backups, Restores, Schedules and
+ * BackupStorageLocations (velero.io/v1). Velero is optional; when its CRDs
+ * are not served the snapshot reports it as undetected rather than failing.
+ */
+
+package velero
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/luxury-yacht/app/backend/resourcemodel"
+)
+
+// Group and Version are the Velero API served for every kind collected here.
+const (
+	Group   = "velero.io"
+	Version = "v1"
+)
+
+// DefaultNamespace is where Velero is installed unless a BackupStorageLocation
+// says otherwise.
+const DefaultNamespace = "velero"
+
+// ScheduleNameLabel links a Backup to the Schedule that created it.
+const ScheduleNameLabel = "velero.io/schedule-name"
+
+var (
+	BackupGVR          = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "backups"}
+	RestoreGVR         = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "restores"}
+	ScheduleGVR        = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "schedules"}
+	StorageLocationGVR = schema.GroupVersionResource{Group: Group, Version: Version, Resource: "backupstoragelocations"}
+)
+
+// Phases that mean a Backup or Restore did not complete cleanly.
+const (
+	PhaseFailed           = "Failed"
+	PhasePartiallyFailed  = "PartiallyFailed"
+	PhaseFailedValidation = "FailedValidation"
+)
+
+// Sources records whether Velero is installed and where.
+type Sources struct {
+	Detected bool `json:"detected"`
+	// Namespace is Velero's install namespace, where new Backups and
+	// Restores are created.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Backup is one velero.io Backup.
+type Backup struct {
+	Ref                resourcemodel.ResourceRef `json:"ref"`
+	Phase              string                    `json:"phase,omitempty"`
+	Schedule           string                    `json:"schedule,omitempty"`
+	IncludedNamespaces []string                  `json:"includedNamespaces,omitempty"`
+	StorageLocation    string                    `json:"storageLocation,omitempty"`
+	StartTime          string                    `json:"startTime,omitempty"`
+	CompletionTime     string                    `json:"completionTime,omitempty"`
+	Expiration         string                    `json:"expiration,omitempty"`
+	ItemsBackedUp      int64                     `json:"itemsBackedUp,omitempty"`
+	TotalItems         int64                     `json:"totalItems,omitempty"`
+	Errors             int64                     `json:"errors,omitempty"`
+	Warnings           int64                     `json:"warnings,omitempty"`
+	FailureReason      string                    `json:"failureReason,omitempty"`
+	ValidationErrors   []string                  `json:"validationErrors,omitempty"`
+}
+
+// Restore is one velero.io Restore.
+type Restore struct {
+	Ref                resourcemodel.ResourceRef `json:"ref"`
+	BackupName         string                    `json:"backupName,omitempty"`
+	ScheduleName       string                    `json:"scheduleName,omitempty"`
+	Phase              string                    `json:"phase,omitempty"`
+	IncludedNamespaces []string                  `json:"includedNamespaces,omitempty"`
+	StartTime          string                    `json:"startTime,omitempty"`
+	CompletionTime     string                    `json:"completionTime,omitempty"`
+	Errors             int64                     `json:"errors,omitempty"`
+	Warnings           int64                     `json:"warnings,omitempty"`
+	FailureReason      string                    `json:"failureReason,omitempty"`
+	ValidationErrors   []string                  `json:"validationErrors,omitempty"`
+}
+
+// Schedule is one velero.io Schedule.
+type Schedule struct {
+	Ref                resourcemodel.ResourceRef `json:"ref"`
+	Cron               string                    `json:"cron"`
+	Paused             bool                      `json:"paused,omitempty"`
+	Phase              string                    `json:"phase,omitempty"`
+	IncludedNamespaces []string                  `json:"includedNamespaces,omitempty"`
+	LastBackup         string                    `json:"lastBackup,omitempty"`
+	ValidationErrors   []string                  `json:"validationErrors,omitempty"`
+}
+
+// StorageLocation is one velero.io BackupStorageLocation.
+type StorageLocation struct {
+	Ref                resourcemodel.ResourceRef `json:"ref"`
+	Provider           string                    `json:"provider,omitempty"`
+	Bucket             string                    `json:"bucket,omitempty"`
+	Phase              string                    `json:"phase,omitempty"`
+	Default            bool                      `json:"default,omitempty"`
+	LastValidationTime string                    `json:"lastValidationTime,omitempty"`
+	Message            string                    `json:"message,omitempty"`
+}
+
+// Snapshot is the cluster-velero domain payload.
+type Snapshot struct {
+	ClusterID        string            `json:"clusterId"`
+	ClusterName      string            `json:"clusterName"`
+	Sources          Sources           `json:"sources"`
+	Backups          []Backup          `json:"backups"`
+	Restores         []Restore         `json:"restores"`
+	Schedules        []Schedule        `json:"schedules"`
+	StorageLocations []StorageLocation `json:"storageLocations"`
+	// FailedBackups and FailedRestores count the entries in a failed phase.
+	FailedBackups  int `json:"failedBackups"`
+	FailedRestores int `json:"failedRestores"`
+	// Warnings lists Velero kinds that are served but could not be read.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Collector reads Velero objects through the dynamic client.
+type Collector struct {
+	ClusterID string
+	Dynamic   dynamic.Interface
+}
+
+// Collect gathers Velero state. A non-empty namespace keeps the Backups,
+// Restores and Schedules that cover it (an empty includedNamespaces list or
+// "*" covers every namespace); storage locations are always returned. The
+// returned version is the highest resourceVersion among the objects read.
+func (c Collector) Collect(ctx context.Context, namespace string) (*Snapshot, uint64, error) {
+	if c.Dynamic == nil {
+		return nil, 0, fmt.Errorf("dynamic client is not initialized")
+	}
+	snapshot := &Snapshot{
+		ClusterID:        c.ClusterID,
+		Backups:          []Backup{},
+		Restores:         []Restore{},
+		Schedules:        []Schedule{},
+		StorageLocations: []StorageLocation{},
+	}
+	var version uint64
+	track := func(obj *unstructured.Unstructured) {
+		if parsed, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64); err == nil && parsed > version {
+			version = parsed
+		}
+	}
+
+	backups, found := c.list(ctx, BackupGVR, snapshot)
+	if !found {
+		return snapshot, 0, nil
+	}
+	snapshot.Sources.Detected = true
+	for i := range backups {
+		track(&backups[i])
+		backup := c.backup(&backups[i])
+		if !Covers(backup.IncludedNamespaces, namespace) {
+			continue
+		}
+		if IsFailedPhase(backup.Phase) {
+			snapshot.FailedBackups++
+		}
+		snapshot.Backups = append(snapshot.Backups, backup)
+	}
+	restores, _ := c.list(ctx, RestoreGVR, snapshot)
+	for i := range restores {
+		track(&restores[i])
+		restore := c.restore(&restores[i])
+		if !Covers(restore.IncludedNamespaces, namespace) {
+			continue
+		}
+		if IsFailedPhase(restore.Phase) {
+			snapshot.FailedRestores++
+		}
+		snapshot.Restores = append(snapshot.Restores, restore)
+	}
+	schedules, _ := c.list(ctx, ScheduleGVR, snapshot)
+	for i := range schedules {
+		track(&schedules[i])
+		schedule := c.schedule(&schedules[i])
+		if Covers(schedule.IncludedNamespaces, namespace) {
+			snapshot.Schedules = append(snapshot.Schedules, schedule)
+		}
+	}
+	locations, _ := c.list(ctx, StorageLocationGVR, snapshot)
+	for i := range locations {
+		track(&locations[i])
+		snapshot.StorageLocations = append(snapshot.StorageLocations, c.storageLocation(&locations[i]))
+	}
+	snapshot.Sources.Namespace = installNamespace(snapshot.StorageLocations, backups)
+
+	sort.SliceStable(snapshot.Backups, func(i, j int) bool {
+		return newerFirst(snapshot.Backups[i].StartTime, snapshot.Backups[j].StartTime, snapshot.Backups[i].Ref.Name, snapshot.Backups[j].Ref.Name)
+	})
+	sort.SliceStable(snapshot.Restores, func(i, j int) bool {
+		return newerFirst(snapshot.Restores[i].StartTime, snapshot.Restores[j].StartTime, snapshot.Restores[i].Ref.Name, snapshot.Restores[j].Ref.Name)
+	})
+	sort.SliceStable(snapshot.Schedules, func(i, j int) bool { return snapshot.Schedules[i].Ref.Name < snapshot.Schedules[j].Ref.Name })
+	sort.SliceStable(snapshot.StorageLocations, func(i, j int) bool {
+		return snapshot.StorageLocations[i].Ref.Name < snapshot.StorageLocations[j].Ref.Name
+	})
+	return snapshot, version, nil
+}
+
+// list returns the items of gvr in every namespace; found is false when the
+// API is not served. Other failures are recorded as snapshot warnings.
+func (c Collector) list(ctx context.Context, gvr schema.GroupVersionResource, snapshot *Snapshot) ([]unstructured.Unstructured, bool) {
+	list, err := c.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("%s: %v", gvr.GroupResource(), err))
+		}
+		return nil, false
+	}
+	return list.Items, true
+}
+
+func (c Collector) ref(obj *unstructured.Unstructured, kind string, gvr schema.GroupVersionResource) resourcemodel.ResourceRef {
+	return resourcemodel.NewResourceRef(c.ClusterID, gvr.Group, gvr.Version, kind, gvr.Resource, obj.GetNamespace(), obj.GetName(), string(obj.GetUID()))
+}
+
+func (c Collector) backup(obj *unstructured.Unstructured) Backup {
+	return Backup{
+		Ref:                c.ref(obj, "Backup", BackupGVR),
+		Phase:              nestedString(obj, "status", "phase"),
+		Schedule:           obj.GetLabels()[ScheduleNameLabel],
+		IncludedNamespaces: nestedStrings(obj, "spec", "includedNamespaces"),
+		StorageLocation:    nestedString(obj, "spec", "storageLocation"),
+		StartTime:          nestedString(obj, "status", "startTimestamp"),
+		CompletionTime:     nestedString(obj, "status", "completionTimestamp"),
+		Expiration:         nestedString(obj, "status", "expiration"),
+		ItemsBackedUp:      nestedInt(obj, "status", "progress", "itemsBackedUp"),
+		TotalItems:         nestedInt(obj, "status", "progress", "totalItems"),
+		Errors:             nestedInt(obj, "status", "errors"),
+		Warnings:           nestedInt(obj, "status", "warnings"),
+		FailureReason:      nestedString(obj, "status", "failureReason"),
+		ValidationErrors:   nestedStrings(obj, "status", "validationErrors"),
+	}
+}
+
+func (c Collector) restore(obj *unstructured.Unstructured) Restore {
+	return Restore{
+		Ref:                c.ref(obj, "Restore", RestoreGVR),
+		BackupName:         nestedString(obj, "spec", "backupName"),
+		ScheduleName:       nestedString(obj, "spec", "scheduleName"),
+		Phase:              nestedString(obj, "status", "phase"),
+		IncludedNamespaces: nestedStrings(obj, "spec", "includedNamespaces"),
+		StartTime:          nestedString(obj, "status", "startTimestamp"),
+		CompletionTime:     nestedString(obj, "status", "completionTimestamp"),
+		Errors:             nestedInt(obj, "status", "errors"),
+		Warnings:           nestedInt(obj, "status", "warnings"),
+		FailureReason:      nestedString(obj, "status", "failureReason"),
+		ValidationErrors:   nestedStrings(obj, "status", "validationErrors"),
+	}
+}
+
+func (c Collector) schedule(obj *unstructured.Unstructured) Schedule {
+	paused, _, _ := unstructured.NestedBool(obj.Object, "spec", "paused")
+	return Schedule{
+		Ref:                c.ref(obj, "Schedule", ScheduleGVR),
+		Cron:               nestedString(obj, "spec", "schedule"),
+		Paused:             paused,
+		Phase:              nestedString(obj, "status", "phase"),
+		IncludedNamespaces: nestedStrings(obj, "spec", "template", "includedNamespaces"),
+		LastBackup:         nestedString(obj, "status", "lastBackup"),
+		ValidationErrors:   nestedStrings(obj, "status", "validationErrors"),
+	}
+}
+
+func (c Collector) storageLocation(obj *unstructured.Unstructured) StorageLocation {
+	isDefault, _, _ := unstructured.NestedBool(obj.Object, "spec", "default")
+	return StorageLocation{
+		Ref:                c.ref(obj, "BackupStorageLocation", StorageLocationGVR),
+		Provider:           nestedString(obj, "spec", "provider"),
+		Bucket:             nestedString(obj, "spec", "objectStorage", "bucket"),
+		Phase:              nestedString(obj, "status", "phase"),
+		Default:            isDefault,
+		LastValidationTime: nestedString(obj, "status", "lastValidationTime"),
+		Message:            nestedString(obj, "status", "message"),
+	}
+}
+
+// Covers reports whether a Velero includedNamespaces list includes namespace.
+// An empty namespace matches every list.
+func Covers(included []string, namespace string) bool {
+	if namespace == "" || len(included) == 0 {
+		return true
+	}
+	for _, entry := range included {
+		if entry == "*" || entry == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFailedPhase reports whether a Backup or Restore phase is a failure.
+func IsFailedPhase(phase string) bool {
+	switch phase {
+	case PhaseFailed, PhasePartiallyFailed, PhaseFailedValidation:
+		return true
+	}
+	return false
+}
+
+// installNamespace picks the namespace Velero runs in: the default storage
+// location's, then any storage location's, then any backup's, and finally
+// DefaultNamespace.
+func installNamespace(locations []StorageLocation, backups []unstructured.Unstructured) string {
+	for _, location := range locations {
+		if location.Default && location.Ref.Namespace != "" {
+			return location.Ref.Namespace
+		}
+	}
+	for _, location := range locations {
+		if location.Ref.Namespace != "" {
+			return location.Ref.Namespace
+		}
+	}
+	for i := range backups {
+		if namespace := backups[i].GetNamespace(); namespace != "" {
+			return namespace
+		}
+	}
+	return DefaultNamespace
+}
+
+// newerFirst orders by RFC 3339 start time, newest first, with entries that
+// have not started yet ahead of all others and name as the tie-breaker.
+func newerFirst(leftTime, rightTime, leftName, rightName string) bool {
+	if leftTime != rightTime {
+		if leftTime == "" || rightTime == "" {
+			return leftTime == ""
+		}
+		return leftTime > rightTime
+	}
+	return leftName < rightName
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
+
+func nestedStrings(obj *unstructured.Unstructured, fields ...string) []string {
+	values, _, _ := unstructured.NestedStringSlice(obj.Object, fields...)
+	return values
+}
+
+func nestedInt(obj *unstructured.Unstructured, fields ...string) int64 {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	switch typed := value.(type) {
+	case int64:
+		return typed
+	case int:
+		return int64(typed)
+	case float64:
+		return int64(typed)
+	}
+	return 0
+}
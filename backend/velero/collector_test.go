@@ -0,0 +1,128 @@
+package velero_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/luxury-yacht/app/backend/velero"
+)
+
+func veleroObject(kind, name, resourceVersion string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "velero.io/v1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": "backups", "resourceVersion": resourceVersion},
+	}}
+	for key, value := range fields {
+		obj.Object[key] = value
+	}
+	return obj
+}
+
+func newDynamic(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		velero.BackupGVR:          "BackupList",
+		velero.RestoreGVR:         "RestoreList",
+		velero.ScheduleGVR:        "ScheduleList",
+		velero.StorageLocationGVR: "BackupStorageLocationList",
+	}, objects...)
+}
+
+func fixtures() []runtime.Object {
+	return []runtime.Object{
+		veleroObject("Backup", "nightly-20260101000000", "10", map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "nightly-20260101000000", "namespace": "backups", "resourceVersion": "10", "labels": map[string]interface{}{velero.ScheduleNameLabel: "nightly"}},
+			"spec":     map[string]interface{}{"storageLocation": "default"},
+			"status":   map[string]interface{}{"phase": "Completed", "startTimestamp": "2026-01-01T00:00:00Z", "progress": map[string]interface{}{"itemsBackedUp": int64(40), "totalItems": int64(40)}},
+		}),
+		veleroObject("Backup", "prod-20260102000000", "12", map[string]interface{}{
+			"spec":   map[string]interface{}{"includedNamespaces": []interface{}{"prod"}},
+			"status": map[string]interface{}{"phase": velero.PhasePartiallyFailed, "startTimestamp": "2026-01-02T00:00:00Z", "errors": int64(3), "failureReason": "volume snapshot failed"},
+		}),
+		veleroObject("Restore", "prod-restore", "14", map[string]interface{}{
+			"spec":   map[string]interface{}{"backupName": "prod-20260102000000", "includedNamespaces": []interface{}{"prod"}},
+			"status": map[string]interface{}{"phase": velero.PhaseFailed},
+		}),
+		veleroObject("Schedule", "nightly", "5", map[string]interface{}{
+			"spec":   map[string]interface{}{"schedule": "0 0 * * *", "template": map[string]interface{}{"includedNamespaces": []interface{}{"*"}}},
+			"status": map[string]interface{}{"phase": "Enabled", "lastBackup": "2026-01-01T00:00:00Z"},
+		}),
+		veleroObject("BackupStorageLocation", "default", "3", map[string]interface{}{
+			"spec":   map[string]interface{}{"provider": "aws", "default": true, "objectStorage": map[string]interface{}{"bucket": "cluster-backups"}},
+			"status": map[string]interface{}{"phase": "Available"},
+		}),
+	}
+}
+
+func TestCollectReadsVeleroState(t *testing.T) {
+	snapshot, version, err := velero.Collector{ClusterID: "config:ctx", Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Warnings)
+	require.Equal(t, velero.Sources{Detected: true, Namespace: "backups"}, snapshot.Sources)
+	require.Equal(t, uint64(14), version)
+
+	require.Len(t, snapshot.Backups, 2)
+	failed := snapshot.Backups[0]
+	require.Equal(t, "prod-20260102000000", failed.Ref.Name, "newest backup first")
+	require.Equal(t, "config:ctx", failed.Ref.ClusterID)
+	require.Equal(t, int64(3), failed.Errors)
+	require.Equal(t, "volume snapshot failed", failed.FailureReason)
+	require.Equal(t, "nightly", snapshot.Backups[1].Schedule)
+	require.Equal(t, int64(40), snapshot.Backups[1].ItemsBackedUp)
+	require.Equal(t, 1, snapshot.FailedBackups)
+	require.Equal(t, 1, snapshot.FailedRestores)
+
+	require.Len(t, snapshot.Schedules, 1)
+	require.Equal(t, "0 0 * * *", snapshot.Schedules[0].Cron)
+	require.Len(t, snapshot.StorageLocations, 1)
+	require.Equal(t, "cluster-backups", snapshot.StorageLocations[0].Bucket)
+	require.True(t, snapshot.StorageLocations[0].Default)
+}
+
+func TestCollectScopedToNamespace(t *testing.T) {
+	snapshot, _, err := velero.Collector{ClusterID: "config:ctx", Dynamic: newDynamic(fixtures()...)}.Collect(context.Background(), "dev")
+	require.NoError(t, err)
+	require.Len(t, snapshot.Backups, 1, "only the all-namespace backup covers dev")
+	require.Equal(t, "nightly-20260101000000", snapshot.Backups[0].Ref.Name)
+	require.Zero(t, snapshot.FailedBackups)
+	require.Empty(t, snapshot.Restores)
+	require.Len(t, snapshot.Schedules, 1, "\"*\" covers every namespace")
+	require.Len(t, snapshot.StorageLocations, 1)
+}
+
+func TestCollectWithoutVelero(t *testing.T) {
+	client := newDynamic()
+	client.PrependReactor("list", "backups", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(velero.BackupGVR.GroupResource(), "")
+	})
+
+	snapshot, _, err := velero.Collector{ClusterID: "config:ctx", Dynamic: client}.Collect(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, velero.Sources{}, snapshot.Sources)
+	require.Empty(t, snapshot.Warnings)
+	require.NotNil(t, snapshot.Backups)
+	require.NotNil(t, snapshot.StorageLocations)
+}
+
+func TestBackupFromScheduleCopiesTemplate(t *testing.T) {
+	schedule := veleroObject("Schedule", "nightly", "5", map[string]interface{}{
+		"spec": map[string]interface{}{"schedule": "0 0 * * *", "template": map[string]interface{}{"includedNamespaces": []interface{}{"prod"}, "ttl": "720h0m0s"}},
+	})
+
+	backup, err := velero.BackupFromSchedule(schedule, time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "nightly-20260304050607", backup.GetName())
+	require.Equal(t, "backups", backup.GetNamespace())
+	require.Equal(t, "nightly", backup.GetLabels()[velero.ScheduleNameLabel])
+	ttl, _, _ := unstructured.NestedString(backup.Object, "spec", "ttl")
+	require.Equal(t, "720h0m0s", ttl)
+}
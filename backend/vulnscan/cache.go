@@ -0,0 +1,178 @@
+package vulnscan
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL bounds how long a scan result is reused. Digests are
+// immutable, but the vulnerability DB is not: a day-old count may miss newly
+// published CVEs.
+const DefaultCacheTTL = 24 * time.Hour
+
+// Counts tallies vulnerabilities by Trivy severity.
+type Counts struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+	Unknown  int `json:"unknown"`
+}
+
+// Total returns the number of vulnerabilities across all severities.
+func (c Counts) Total() int {
+	return c.Critical + c.High + c.Medium + c.Low + c.Unknown
+}
+
+// Add returns the element-wise sum of c and other.
+func (c Counts) Add(other Counts) Counts {
+	return Counts{
+		Critical: c.Critical + other.Critical,
+		High:     c.High + other.High,
+		Medium:   c.Medium + other.Medium,
+		Low:      c.Low + other.Low,
+		Unknown:  c.Unknown + other.Unknown,
+	}
+}
+
+func (c *Counts) add(severity string) {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		c.Critical++
+	case "HIGH":
+		c.High++
+	case "MEDIUM":
+		c.Medium++
+	case "LOW":
+		c.Low++
+	default:
+		c.Unknown++
+	}
+}
+
+// ImageResult is the scan outcome for one image.
+type ImageResult struct {
+	Image     string    `json:"image"`
+	Digest    string    `json:"digest,omitempty"`
+	Counts    Counts    `json:"counts"`
+	ScannedAt time.Time `json:"scannedAt"`
+}
+
+type cacheEntry struct {
+	result   ImageResult
+	storedAt time.Time
+}
+
+// Cache holds scan results keyed by image digest. Image references without a
+// known digest (a tag in a workload template) are aliased to the digest Trivy
+// resolved, so a rescan happens when the tag entry expires or is rescanned.
+type Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	digests map[string]cacheEntry
+	aliases map[string]cacheEntry
+}
+
+// NewCache returns an empty cache; ttl <= 0 selects DefaultCacheTTL.
+func NewCache(ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		digests: make(map[string]cacheEntry),
+		aliases: make(map[string]cacheEntry),
+	}
+}
+
+// Lookup returns a fresh cached result for digest, or for image when digest is
+// empty.
+func (c *Cache) Lookup(image, digest string) (ImageResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, key := c.digests, digest
+	if digest == "" {
+		entries, key = c.aliases, image
+	}
+	entry, ok := entries[key]
+	if !ok || c.now().Sub(entry.storedAt) > c.ttl {
+		return ImageResult{}, false
+	}
+	return entry.result, true
+}
+
+// Store records result under its digest and under image as an alias.
+func (c *Cache) Store(image string, result ImageResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := cacheEntry{result: result, storedAt: c.now()}
+	if result.Digest != "" {
+		c.digests[result.Digest] = entry
+	}
+	if image != "" {
+		c.aliases[image] = entry
+	}
+}
+
+// Scan returns the cached result for the image when fresh, otherwise runs the
+// scanner and caches the outcome. A known digest pins the scan to exactly the
+// image the cluster is running rather than whatever the tag now points to.
+func Scan(ctx context.Context, runner Runner, cache *Cache, image, digest string, force bool) (ImageResult, error) {
+	if !force {
+		if cached, ok := cache.Lookup(image, digest); ok {
+			return cached, nil
+		}
+	}
+	target := image
+	if digest != "" {
+		target = PinnedReference(image, digest)
+	}
+	data, err := runner.Run(ctx, target)
+	if err != nil {
+		return ImageResult{}, err
+	}
+	reported, counts, err := ParseTrivyReport(data)
+	if err != nil {
+		return ImageResult{}, err
+	}
+	if digest == "" {
+		digest = reported
+	}
+	result := ImageResult{Image: image, Digest: digest, Counts: counts, ScannedAt: cache.now().UTC()}
+	cache.Store(image, result)
+	return result, nil
+}
+
+// DigestFromImageID extracts the "sha256:..." repo digest from a container
+// status imageID or a RepoDigests entry ("docker-pullable://repo@sha256:...",
+// "repo@sha256:..."). A bare "sha256:..." image ID is a config digest, not a
+// registry digest, and yields "".
+func DigestFromImageID(imageID string) string {
+	idx := strings.LastIndexByte(imageID, '@')
+	if idx < 0 {
+		return ""
+	}
+	digest := imageID[idx+1:]
+	if !strings.HasPrefix(digest, "sha256:") {
+		return ""
+	}
+	return digest
+}
+
+// PinnedReference rewrites image to reference digest, dropping any tag or
+// existing digest ("nginx:1.25" → "nginx@sha256:...").
+func PinnedReference(image, digest string) string {
+	repo := image
+	if idx := strings.IndexByte(repo, '@'); idx >= 0 {
+		repo = repo[:idx]
+	}
+	// A colon after the last slash is a tag; one before it is a registry port.
+	if idx := strings.LastIndexByte(repo, ':'); idx > strings.LastIndexByte(repo, '/') {
+		repo = repo[:idx]
+	}
+	return repo + "@" + digest
+}
@@ -0,0 +1,142 @@
+package vulnscan
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const sampleReport = `{
+  "ArtifactName": "nginx:1.25",
+  "Metadata": {"RepoDigests": ["nginx@sha256:aaa"]},
+  "Results": [
+    {"Vulnerabilities": [{"Severity": "CRITICAL"}, {"Severity": "HIGH"}, {"Severity": "HIGH"}]},
+    {"Vulnerabilities": [{"Severity": "LOW"}, {"Severity": "NEGLIGIBLE"}]},
+    {"Vulnerabilities": null}
+  ]
+}`
+
+type fakeRunner struct {
+	targets []string
+	output  string
+	err     error
+}
+
+func (f *fakeRunner) Run(_ context.Context, image string) ([]byte, error) {
+	f.targets = append(f.targets, image)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []byte(f.output), nil
+}
+
+func TestParseTrivyReport(t *testing.T) {
+	digest, counts, err := ParseTrivyReport([]byte(sampleReport))
+	require.NoError(t, err)
+	require.Equal(t, "sha256:aaa", digest)
+	require.Equal(t, Counts{Critical: 1, High: 2, Low: 1, Unknown: 1}, counts)
+	require.Equal(t, 5, counts.Total())
+
+	_, _, err = ParseTrivyReport([]byte("not json"))
+	require.Error(t, err)
+}
+
+func TestScanCachesByDigestAndAlias(t *testing.T) {
+	runner := &fakeRunner{output: sampleReport}
+	cache := NewCache(time.Hour)
+
+	result, err := Scan(context.Background(), runner, cache, "nginx:1.25", "", false)
+	require.NoError(t, err)
+	require.Equal(t, "sha256:aaa", result.Digest)
+	require.Equal(t, []string{"nginx:1.25"}, runner.targets)
+
+	// The tag alias and the resolved digest both hit the cache.
+	_, err = Scan(context.Background(), runner, cache, "nginx:1.25", "", false)
+	require.NoError(t, err)
+	_, err = Scan(context.Background(), runner, cache, "registry.local:5000/nginx:1.25", "sha256:aaa", false)
+	require.NoError(t, err)
+	require.Len(t, runner.targets, 1)
+
+	// force bypasses the cache; a known digest pins the scan target.
+	_, err = Scan(context.Background(), runner, cache, "registry.local:5000/nginx:1.25", "sha256:bbb", true)
+	require.NoError(t, err)
+	require.Equal(t, "registry.local:5000/nginx@sha256:bbb", runner.targets[1])
+}
+
+func TestScanEntriesExpire(t *testing.T) {
+	runner := &fakeRunner{output: sampleReport}
+	cache := NewCache(time.Hour)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	_, err := Scan(context.Background(), runner, cache, "nginx:1.25", "", false)
+	require.NoError(t, err)
+	now = now.Add(2 * time.Hour)
+	_, ok := cache.Lookup("nginx:1.25", "")
+	require.False(t, ok)
+}
+
+func TestScanDoesNotCacheFailures(t *testing.T) {
+	runner := &fakeRunner{err: errors.New("boom")}
+	cache := NewCache(0)
+
+	_, err := Scan(context.Background(), runner, cache, "nginx:1.25", "", false)
+	require.Error(t, err)
+	_, ok := cache.Lookup("nginx:1.25", "")
+	require.False(t, ok)
+}
+
+func TestDigestFromImageID(t *testing.T) {
+	require.Equal(t, "sha256:abc", DigestFromImageID("docker-pullable://nginx@sha256:abc"))
+	require.Equal(t, "sha256:abc", DigestFromImageID("docker.io/library/nginx@sha256:abc"))
+	require.Empty(t, DigestFromImageID("sha256:abc"))
+	require.Empty(t, DigestFromImageID(""))
+}
+
+func TestTrivyRunnerArgs(t *testing.T) {
+	require.Equal(t,
+		[]string{"image", "--quiet", "--format", "json", "--scanners", "vuln", "--server", "http://trivy:4954", "--", "nginx"},
+		TrivyRunner{ServerURL: " http://trivy:4954 "}.args("nginx"))
+
+	_, err := TrivyRunner{Path: "/nonexistent/trivy"}.Run(context.Background(), "nginx")
+	require.ErrorIs(t, err, ErrTrivyNotFound)
+	_, err = TrivyRunner{Path: "bin/trivy"}.Run(context.Background(), "nginx")
+	require.ErrorIs(t, err, ErrTrivyNotFound)
+	require.ErrorContains(t, err, "must be absolute")
+
+	_, err = TrivyRunner{}.Run(context.Background(), "--output=/tmp/owned")
+	require.ErrorContains(t, err, "refusing to scan")
+}
+
+func TestValidateTrivyPath(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "plain")
+	require.NoError(t, os.WriteFile(plain, []byte("data"), 0o644))
+	executable := filepath.Join(dir, "trivy")
+	require.NoError(t, os.WriteFile(executable, []byte("#!/bin/sh\necho 'Version: 0.50.0'\n"), 0o755))
+
+	require.NoError(t, ValidateTrivyPath(""))
+	require.NoError(t, ValidateTrivyPath(executable))
+	require.ErrorContains(t, ValidateTrivyPath("trivy"), "must be absolute")
+	require.ErrorContains(t, ValidateTrivyPath(filepath.Join(dir, "missing")), "does not exist")
+	require.ErrorContains(t, ValidateTrivyPath(dir), "not a regular file")
+	if runtime.GOOS == "windows" {
+		return
+	}
+	require.ErrorContains(t, ValidateTrivyPath(plain), "not executable")
+
+	version, err := VerifyTrivyBinary(context.Background(), executable)
+	require.NoError(t, err)
+	require.Equal(t, "Version: 0.50.0", version)
+
+	impostor := filepath.Join(dir, "impostor")
+	require.NoError(t, os.WriteFile(impostor, []byte("#!/bin/sh\necho hello\n"), 0o755))
+	_, err = VerifyTrivyBinary(context.Background(), impostor)
+	require.ErrorContains(t, err, "does not look like trivy")
+}
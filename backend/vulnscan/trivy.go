@@ -0,0 +1,184 @@
+// Package vulnscan runs container image vulnerability scans through Trivy and
+// caches the severity counts by image digest. Trivy runs either standalone
+// (downloading its own vulnerability DB) or in client mode against a Trivy
+// server; the app never bundles a scanner.
+package vulnscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Runner produces a Trivy JSON report for one image reference.
+type Runner interface {
+	Run(ctx context.Context, image string) ([]byte, error)
+}
+
+// TrivyRunner invokes the trivy CLI. Path defaults to "trivy" on PATH;
+// ServerURL, when set, runs the scan in client mode against that server.
+type TrivyRunner struct {
+	Path      string
+	ServerURL string
+}
+
+// ErrTrivyNotFound reports that no trivy binary could be resolved.
+var ErrTrivyNotFound = errors.New("trivy not found; install it or set its path in Settings")
+
+// trivyVersionTimeout bounds the `trivy --version` check of a configured path.
+const trivyVersionTimeout = 10 * time.Second
+
+// ValidateTrivyPath checks a configured Trivy path before anything executes
+// it: the path must be absolute and name an existing, executable regular file.
+// An empty path is valid and resolves "trivy" from PATH at scan time.
+func ValidateTrivyPath(path string) error {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("trivy path %q must be absolute", path)
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("trivy path %q does not exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("trivy path %q: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("trivy path %q is not a regular file", path)
+	}
+	// Windows has no executable bit; the loader decides by extension.
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o111 == 0 {
+		return fmt.Errorf("trivy path %q is not executable", path)
+	}
+	return nil
+}
+
+// VerifyTrivyBinary validates path and confirms it is Trivy by running
+// `trivy --version`, returning the reported version line.
+func VerifyTrivyBinary(ctx context.Context, path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if err := ValidateTrivyPath(path); err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, trivyVersionTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path, "--version")
+	cmd.Stdin = nil
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("trivy path %q did not answer --version: %w", path, err)
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, "Version:") {
+			return line, nil
+		}
+	}
+	return "", fmt.Errorf("trivy path %q does not look like trivy: --version printed no version", path)
+}
+
+// Run scans image and returns Trivy's JSON report. A configured Path is
+// validated before it is executed; an invalid one reports ErrTrivyNotFound.
+// The image comes from a pod spec the cluster controls, so a reference that
+// could be parsed as a flag is refused.
+func (r TrivyRunner) Run(ctx context.Context, image string) ([]byte, error) {
+	if strings.HasPrefix(image, "-") {
+		return nil, fmt.Errorf("refusing to scan image reference %q: it starts with \"-\"", image)
+	}
+	binary := strings.TrimSpace(r.Path)
+	if binary == "" {
+		binary = "trivy"
+	} else if err := ValidateTrivyPath(binary); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTrivyNotFound, err)
+	}
+	resolved, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, ErrTrivyNotFound
+	}
+
+	cmd := exec.CommandContext(ctx, resolved, r.args(image)...)
+	// Prevent inherited stdin so the scanner cannot block waiting for input.
+	cmd.Stdin = nil
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("trivy scan of %s: %w", image, ctx.Err())
+		}
+		detail := strings.TrimSpace(stderr.String())
+		if detail == "" {
+			detail = err.Error()
+		}
+		return nil, fmt.Errorf("trivy scan of %s failed: %s", image, lastLine(detail))
+	}
+	return stdout.Bytes(), nil
+}
+
+func (r TrivyRunner) args(image string) []string {
+	args := []string{"image", "--quiet", "--format", "json", "--scanners", "vuln"}
+	if server := strings.TrimSpace(r.ServerURL); server != "" {
+		args = append(args, "--server", server)
+	}
+	// "--" ends flag parsing, so the image is always read as a positional argument.
+	return append(args, "--", image)
+}
+
+// lastLine keeps the final line of Trivy's stderr, which carries the fatal
+// error after any progress or warning output.
+func lastLine(text string) string {
+	if idx := strings.LastIndexByte(text, '\n'); idx >= 0 {
+		return strings.TrimSpace(text[idx+1:])
+	}
+	return text
+}
+
+// trivyReport is the subset of Trivy's JSON report this package reads.
+type trivyReport struct {
+	ArtifactName string `json:"ArtifactName"`
+	Metadata     struct {
+		RepoDigests []string `json:"RepoDigests"`
+	} `json:"Metadata"`
+	Results []struct {
+		Vulnerabilities []struct {
+			Severity string `json:"Severity"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// ParseTrivyReport extracts the image's repo digest (empty when Trivy did not
+// report one) and the per-severity vulnerability counts.
+func ParseTrivyReport(data []byte) (string, Counts, error) {
+	var report trivyReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return "", Counts{}, fmt.Errorf("parse trivy report: %w", err)
+	}
+	var counts Counts
+	for _, result := range report.Results {
+		for _, vuln := range result.Vulnerabilities {
+			counts.add(vuln.Severity)
+		}
+	}
+	digest := ""
+	for _, repoDigest := range report.Metadata.RepoDigests {
+		if d := DigestFromImageID(repoDigest); d != "" {
+			digest = d
+			break
+		}
+	}
+	return digest, counts, nil
+}
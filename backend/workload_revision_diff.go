@@ -0,0 +1,212 @@
+package backend
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// Revision diff change categories.
+const (
+	RevisionChangeContainer  = "container"
+	RevisionChangeImage      = "image"
+	RevisionChangeEnv        = "env"
+	RevisionChangeResources  = "resources"
+	RevisionChangeLabel      = "label"
+	RevisionChangeAnnotation = "annotation"
+)
+
+// RevisionChange is one field that differs between two rollout revisions.
+// From is empty for an added field and To is empty for a removed one.
+type RevisionChange struct {
+	// Category is one of container, image, env, resources, label or annotation.
+	Category string `json:"category"`
+	// Container names the container the change belongs to; empty for pod labels
+	// and annotations.
+	Container string `json:"container,omitempty"`
+	// Field is the env var name, the resource (e.g. "limits.memory"), or the
+	// label or annotation key.
+	Field string `json:"field,omitempty"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// RevisionDiff lists what changed in the pod template from one revision to another.
+type RevisionDiff struct {
+	FromRevision int64            `json:"fromRevision"`
+	ToRevision   int64            `json:"toRevision"`
+	Changes      []RevisionChange `json:"changes"`
+}
+
+// GetRevisionDiff compares the pod templates of two rollout revisions of a workload
+// (images, env, resources, labels and annotations), e.g. to show what rollout N
+// changed over N-1. Supports the same kinds as GetRevisionHistory.
+func (a *App) GetRevisionDiff(clusterID, namespace, group, version, workloadKind, name string, fromRevision, toRevision int64) (*RevisionDiff, error) {
+	entries, err := a.GetRevisionHistory(clusterID, namespace, group, version, workloadKind, name)
+	if err != nil {
+		return nil, err
+	}
+	from, err := revisionPodTemplate(entries, fromRevision)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s/%s: %w", workloadKind, namespace, name, err)
+	}
+	to, err := revisionPodTemplate(entries, toRevision)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s/%s: %w", workloadKind, namespace, name, err)
+	}
+	return &RevisionDiff{
+		FromRevision: fromRevision,
+		ToRevision:   toRevision,
+		Changes:      diffPodTemplates(from, to),
+	}, nil
+}
+
+func revisionPodTemplate(entries []RevisionEntry, revision int64) (*corev1.PodTemplateSpec, error) {
+	for i := range entries {
+		if entries[i].Revision != revision {
+			continue
+		}
+		var template corev1.PodTemplateSpec
+		if err := sigsyaml.Unmarshal([]byte(entries[i].PodTemplate), &template); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pod template for revision %d: %w", revision, err)
+		}
+		return &template, nil
+	}
+	return nil, fmt.Errorf("revision %d not found", revision)
+}
+
+// diffPodTemplates reports pod metadata changes first, then each container in the
+// newer template's order followed by removed containers.
+func diffPodTemplates(from, to *corev1.PodTemplateSpec) []RevisionChange {
+	changes := []RevisionChange{}
+	// ReplicaSet templates carry a per-revision hash label that always differs.
+	fromLabels := withoutKey(from.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	toLabels := withoutKey(to.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	changes = appendMapChanges(changes, RevisionChangeLabel, "", fromLabels, toLabels)
+	changes = appendMapChanges(changes, RevisionChangeAnnotation, "", from.Annotations, to.Annotations)
+
+	fromContainers := revisionContainers(&from.Spec)
+	toContainers := revisionContainers(&to.Spec)
+	for _, container := range toContainers {
+		previous := findRevisionContainer(fromContainers, container.Name)
+		if previous == nil {
+			changes = append(changes, RevisionChange{
+				Category: RevisionChangeContainer, Container: container.Name, To: container.Image,
+			})
+			continue
+		}
+		if previous.Image != container.Image {
+			changes = append(changes, RevisionChange{
+				Category: RevisionChangeImage, Container: container.Name, From: previous.Image, To: container.Image,
+			})
+		}
+		changes = appendMapChanges(changes, RevisionChangeEnv, container.Name, envValues(previous.Env), envValues(container.Env))
+		changes = appendMapChanges(changes, RevisionChangeResources, container.Name,
+			resourceValues(previous.Resources), resourceValues(container.Resources))
+	}
+	for _, container := range fromContainers {
+		if findRevisionContainer(toContainers, container.Name) == nil {
+			changes = append(changes, RevisionChange{
+				Category: RevisionChangeContainer, Container: container.Name, From: container.Image,
+			})
+		}
+	}
+	return changes
+}
+
+// revisionContainers returns init containers followed by regular containers.
+func revisionContainers(spec *corev1.PodSpec) []corev1.Container {
+	return slices.Concat(spec.InitContainers, spec.Containers)
+}
+
+func findRevisionContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// appendMapChanges appends one change per key that was added, removed or changed,
+// in key order.
+func appendMapChanges(changes []RevisionChange, category, container string, from, to map[string]string) []RevisionChange {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		before, hadBefore := from[key]
+		after, hasAfter := to[key]
+		if hadBefore == hasAfter && before == after {
+			continue
+		}
+		changes = append(changes, RevisionChange{
+			Category: category, Container: container, Field: key, From: before, To: after,
+		})
+	}
+	return changes
+}
+
+func withoutKey(values map[string]string, key string) map[string]string {
+	if _, ok := values[key]; !ok {
+		return values
+	}
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// envValues maps each env var to its value, or to a description of its source for
+// valueFrom references (secret values are never resolved).
+func envValues(env []corev1.EnvVar) map[string]string {
+	values := make(map[string]string, len(env))
+	for _, item := range env {
+		values[item.Name] = describeEnvValue(item)
+	}
+	return values
+}
+
+func describeEnvValue(item corev1.EnvVar) string {
+	source := item.ValueFrom
+	switch {
+	case source == nil:
+		return item.Value
+	case source.SecretKeyRef != nil:
+		return fmt.Sprintf("secret %s/%s", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+	case source.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("configmap %s/%s", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+	case source.FieldRef != nil:
+		return "field " + source.FieldRef.FieldPath
+	case source.ResourceFieldRef != nil:
+		return "resource " + source.ResourceFieldRef.Resource
+	default:
+		return "valueFrom"
+	}
+}
+
+// resourceValues flattens requests and limits into "requests.cpu"-style keys.
+func resourceValues(resources corev1.ResourceRequirements) map[string]string {
+	values := make(map[string]string, len(resources.Requests)+len(resources.Limits))
+	for name, quantity := range resources.Requests {
+		values["requests."+string(name)] = quantity.String()
+	}
+	for name, quantity := range resources.Limits {
+		values["limits."+string(name)] = quantity.String()
+	}
+	return values
+}
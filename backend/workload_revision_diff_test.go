@@ -0,0 +1,98 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	cgofake "k8s.io/client-go/kubernetes/fake"
+)
+
+func revisionDiffReplicaSet(revision string, template corev1.PodTemplateSpec) *appsv1.ReplicaSet {
+	isController := true
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "myapp-rs" + revision,
+			Namespace:   "default",
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": revision},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "myapp", UID: "deploy-uid", Controller: &isController,
+			}},
+		},
+		Spec: appsv1.ReplicaSetSpec{Template: template},
+	}
+}
+
+func TestGetRevisionDiffDeployment(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "myapp",
+			Namespace:   "default",
+			UID:         types.UID("deploy-uid"),
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "2"},
+		},
+	}
+	rs1 := revisionDiffReplicaSet("1", corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"app": "myapp", "version": "v1", "pod-template-hash": "aaa",
+		}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{
+				Name:  "app",
+				Image: "myapp:v1",
+				Env:   []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}, {Name: "OLD", Value: "x"}},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				},
+			},
+			{Name: "sidecar", Image: "proxy:1"},
+		}},
+	})
+	rs2 := revisionDiffReplicaSet("2", corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"app": "myapp", "version": "v2", "pod-template-hash": "bbb",
+		}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{
+				Name:  "app",
+				Image: "myapp:v2",
+				Env: []corev1.EnvVar{
+					{Name: "LOG_LEVEL", Value: "info"},
+					{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "token",
+					}}},
+				},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+				},
+			},
+			{Name: "metrics", Image: "exporter:3"},
+		}},
+	})
+	app := buildRevisionHistoryApp(cgofake.NewClientset(deploy, rs1, rs2))
+
+	diff, err := app.GetRevisionDiff("config:ctx", "default", "apps", "v1", "Deployment", "myapp", 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), diff.FromRevision)
+	require.Equal(t, int64(2), diff.ToRevision)
+	require.Equal(t, []RevisionChange{
+		{Category: RevisionChangeLabel, Field: "version", From: "v1", To: "v2"},
+		{Category: RevisionChangeImage, Container: "app", From: "myapp:v1", To: "myapp:v2"},
+		{Category: RevisionChangeEnv, Container: "app", Field: "OLD", From: "x"},
+		{Category: RevisionChangeEnv, Container: "app", Field: "TOKEN", To: "secret creds/token"},
+		{Category: RevisionChangeResources, Container: "app", Field: "limits.memory", From: "256Mi", To: "512Mi"},
+		{Category: RevisionChangeContainer, Container: "metrics", To: "exporter:3"},
+		{Category: RevisionChangeContainer, Container: "sidecar", From: "proxy:1"},
+	}, diff.Changes)
+
+	same, err := app.GetRevisionDiff("config:ctx", "default", "apps", "v1", "Deployment", "myapp", 2, 2)
+	require.NoError(t, err)
+	require.Empty(t, same.Changes)
+
+	_, err = app.GetRevisionDiff("config:ctx", "default", "apps", "v1", "Deployment", "myapp", 1, 7)
+	require.ErrorContains(t, err, "revision 7 not found")
+}
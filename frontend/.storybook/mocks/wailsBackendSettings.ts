@@ -31,6 +31,7 @@ export function setMockSettingsBackend(options: SettingsMockOptions = {}): void
       useShortResourceNames: false,
       autoRefreshEnabled: false,
       refreshBackgroundClustersEnabled: false,
+      refreshBinaryEncodingEnabled: false,
       metricsRefreshIntervalMs: 30000,
       gridTablePersistenceMode: 'shared',
       defaultObjectPanelPosition: 'right',
@@ -48,6 +48,7 @@ window.__storybookGoOverrides = {
       useShortResourceNames: false,
       autoRefreshEnabled: false,
       refreshBackgroundClustersEnabled: false,
+      refreshBinaryEncodingEnabled: false,
       metricsRefreshIntervalMs: 30000,
       gridTablePersistenceMode: 'shared',
       defaultObjectPanelPosition: 'right',
@@ -45,6 +45,7 @@ import {
 import { autoApplyClusterTheme } from '@/core/settings/clusterThemeAutoApply';
 // Custom hooks
 import { useBackendErrorHandler } from '@/hooks/useBackendErrorHandler';
+import { useDeepLinks } from '@/hooks/useDeepLinks';
 import { useSidebarResize } from '@/hooks/useSidebarResize';
 import { useConnectionStatusListener, useWailsRuntimeEvents } from '@/hooks/useWailsRuntimeEvents';
 
@@ -141,6 +142,9 @@ function AppContent() {
   // Handle connection status events from Wails runtime
   useConnectionStatusListener();
 
+  // Open luxury-yacht:// links the app was launched or focused with
+  useDeepLinks();
+
   // Callbacks for UI actions
   const handleToggleAppLogsPanel = useCallback(() => {
     // App logs is an app-global tool panel (like Settings, About). Its
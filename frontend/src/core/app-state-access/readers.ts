@@ -4,6 +4,7 @@ import {
   GetAppLogsSince,
   GetAppSettings,
   GetAppSettingsSchema,
+  GetKeybindings,
   GetKubeconfigSearchPaths,
   GetKubeconfigs,
   GetShellSessionBacklog,
@@ -18,6 +19,7 @@ export const readKubeconfigs = () => GetKubeconfigs();
 export const readAppSettings = () => GetAppSettings();
 export const readAppSettingsSchema = () => GetAppSettingsSchema();
 export const readThemes = () => GetThemes();
+export const readKeybindings = () => GetKeybindings();
 export const readZoomLevel = () => GetZoomLevel();
 export const readKubeconfigSearchPaths = () => GetKubeconfigSearchPaths();
 export const readAppInfo = () => GetAppInfo();
@@ -4,67 +4,189 @@
  * frontend boundary decision before application code can call them.
  */
 export {
+  AcknowledgeAlert,
   ApplyClusterWorkspace,
+  ApplyKustomization,
+  ApplyManifestDirectory,
   ApplyObjectYaml,
   ApplyTheme,
+  BackupNamespace,
+  BuildKustomization,
   CancelDrainNodeJob,
+  CancelFinishedCleanup,
+  CheckForUpdates,
+  CheckGitDrift,
   CheckObjectYamlOwnership,
   ClearAppLogs,
+  ClearResolvedAlerts,
+  CloseLocalTerminal,
   CloseShellSession,
+  CreateVolumeSnapshot,
+  DeleteStatefulSetOrdinal,
   DeleteTheme,
+  DetectIdleWorkloads,
+  DiffConfigRevisions,
+  DiffObjectAgainstLastApplied,
+  DiscardRecycleBinEntry,
   DiscoverNodeLogs,
+  DownloadPodFiles,
+  DownloadUpdate,
+  ExplainPodScheduling,
+  ExportAppSettings,
+  ExportImageInventoryCSV,
+  ExportKeybindings,
+  ExportTableView,
+  ExportYAMLBundle,
   FetchContainerLogs,
   FetchNodeLogs,
   FindCatalogObjectByUID,
   FindCatalogObjectMatch,
+  ForceDeleteStatefulSetOrdinal,
+  GenerateDiagnosticBundle,
+  GetAlertHistory,
+  GetAlertRules,
   GetAppInfo,
   GetAppLogs,
   GetAppLogsSince,
   GetAppSettings,
   GetAppSettingsSchema,
+  GetCacheIntrospection,
   GetClusterAllowedNamespaces,
+  GetClusterClientLimits,
+  GetClusterHealthSummary,
+  GetClusterInfo,
+  GetClusterNotificationsEnabled,
+  GetClusterReadOnly,
   GetClusterWorkspaceState,
+  GetConfigHistory,
+  GetConfigMapValue,
   GetContainerLogsScopeContainers,
+  GetCustomActionsFile,
+  GetGitDriftReports,
+  GetGitDriftSources,
+  GetHPAScalingHistory,
+  GetImageInventory,
+  GetIstioDetails,
+  GetKeybindings,
   GetKubeconfigSearchPaths,
   GetKubeconfigs,
   GetKubernetesAPIClientDiagnostics,
+  GetLocalAPIStatus,
+  GetNotificationSettings,
+  GetObjectFieldOwnership,
   GetObjectYAMLByGVK,
+  GetPinnedSummaries,
+  GetPins,
   GetPodContainers,
+  GetPodProcesses,
+  GetPodSockets,
+  GetRecycleBin,
   GetRefreshBaseURL,
+  GetRelatedEvents,
+  GetRevisionDiff,
   GetRevisionHistory,
   GetSelectionDiagnostics,
+  GetSettingsSync,
+  GetShellCommandRules,
   GetShellSessionBacklog,
   GetTargetPorts,
   GetThemes,
+  GetVolumeSnapshotDetails,
+  GetVolumeSnapshotSupport,
+  GetWorkloadCrashHistory,
+  GetWorkloadImageScan,
   GetZoomLevel,
   HydrateCatalogCustomRows,
   IgnoreClusterAttentionFindingType,
   IgnoreClusterAttentionObjectFinding,
   IgnoreGlobalAttentionFindingType,
+  ImportAppSettings,
+  ImportKeybindings,
+  InvokeAction,
+  IsNamespaceProtected,
   IsWorkloadHPAManaged,
+  ListActions,
+  ListCustomActions,
+  ListPodDirectory,
   ListPortForwards,
   ListRuntimeOperations,
   ListShellSessions,
+  LookupClusterDNS,
   MatchThemeForCluster,
   MergeObjectYamlWithLatest,
+  OpenInExternalEditor,
   OpenKubeconfigSearchPathDialog,
+  PreviewFinishedCleanup,
+  PreviewKustomization,
+  PreviewManifestDirectory,
+  PreviewObjectActionCommand,
+  PreviewPodFile,
+  ReconcileFluxObject,
+  RefreshExternalSecret,
+  RegenerateLocalAPIToken,
   ReorderThemes,
+  ResetKeybindings,
+  ResizeLocalTerminal,
+  ResizePersistentVolumeClaim,
   ResizeShellSession,
+  ResolveDeepLink,
+  RestartToUpdate,
   RestoreClusterAttentionFindingType,
   RestoreClusterAttentionObjectFinding,
+  RestoreDeletedObject,
   RestoreGlobalAttentionFindingType,
+  RestoreNamespaceBackup,
+  RestoreVeleroBackup,
+  RestoreVolumeSnapshot,
+  ResumeFluxObject,
   RetryClusterAuth,
+  RevealSecretValueRange,
+  RevealSecretValues,
+  RunBulkObjectAction,
   RunObjectAction,
   SaveCsvFile,
   SaveTheme,
+  ScanWorkloadImages,
+  SearchContainerImages,
+  SearchObjects,
+  SelectKustomizationDirectory,
+  SelectManifestDirectory,
+  SendLocalTerminalInput,
   SendShellInput,
+  SetAlertRules,
   SetAppLogsPanelVisible,
   SetClusterAllowedNamespaces,
+  SetClusterClientLimits,
+  SetClusterNotificationsEnabled,
+  SetClusterReadOnly,
+  SetGitDriftSources,
+  SetKeybindings,
   SetKubeconfigSearchPaths,
+  SetLocalAPIEnabled,
+  SetLocalAPIMetricsEnabled,
+  SetNamespacePinned,
+  SetNotificationSettings,
+  SetResourcePinned,
+  SetSettingsSyncDirectory,
+  SetShellCommandRules,
   SetSidebarVisible,
+  SetStatefulSetPartition,
   SetZoomLevel,
+  SimulateNodeDrain,
+  StartConnectivityCheck,
+  StartFinishedCleanup,
+  StartLocalTerminal,
+  StartNodeLogDebugPod,
   StartShellSession,
+  StopExternalEdit,
+  StopNodeLogDebugPod,
   StopPortForward,
+  SuspendFluxObject,
+  TakePendingDeepLink,
+  ThreeWayMergeObjectYaml,
+  TriggerVeleroNamespaceBackup,
+  TriggerVeleroScheduleBackup,
   UpdateAppPreferences,
   ValidateThemeClusterPattern,
+  VerifyImagePullSecrets,
 } from '@wailsjs/go/backend/App';
@@ -4,7 +4,7 @@ import { parseClusterLifecycleState } from '@/core/contexts/clusterLifecycleStat
 import { eventBus } from '@/core/events';
 import { logAppLogsInfo } from '@/core/logging/appLogsClient';
 
-export type ClusterHealthStatus = 'healthy' | 'degraded' | 'unknown';
+export type ClusterHealthStatus = 'healthy' | 'degraded' | 'reconnecting' | 'unknown';
 export type AuthErrorClass = 'auth' | 'connectivity' | '';
 
 export interface ClusterAuthState {
@@ -330,7 +330,7 @@ export class ClusterWorkspaceStore {
           : authStateFromWire(raw.auth ?? { state: 'unknown' }, raw.clusterName || clusterId),
         health: isLiveField('health')
           ? (previous?.health ?? 'unknown')
-          : raw.health === 'healthy' || raw.health === 'degraded'
+          : raw.health === 'healthy' || raw.health === 'degraded' || raw.health === 'reconnecting'
             ? raw.health
             : 'unknown',
         scopeRevision: isLiveField('scope')
@@ -376,6 +376,7 @@ export class ClusterWorkspaceStore {
     on('cluster:auth:progress', (...args) => this.handleAuthProgress(args[0]));
     on('cluster:health:healthy', (...args) => this.handleHealth(args[0], 'healthy'));
     on('cluster:health:degraded', (...args) => this.handleHealth(args[0], 'degraded'));
+    on('cluster:health:reconnecting', (...args) => this.handleHealth(args[0], 'reconnecting'));
     on('cluster:scope:changed', (...args) => this.handleScopeChanged(args[0]));
 
     void this.hydrate().catch((error) =>
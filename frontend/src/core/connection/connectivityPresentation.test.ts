@@ -141,4 +141,31 @@ describe('buildConnectivityPresentation', () => {
     expect(presentation.status).toBe('degraded');
     expect(presentation.summary).toBe('Retrying authentication');
   });
+
+  it('presents repeated heartbeat connectivity failures as reconnecting', () => {
+    const presentation = buildConnectivityPresentation({
+      clusterId: 'cluster-a',
+      clusterName: 'alpha',
+      lifecycleState: 'ready',
+      namespaceReady: true,
+      health: 'reconnecting',
+      isPaused: false,
+      isRefreshing: false,
+      authState: {
+        hasError: false,
+        isRecovering: false,
+        reason: '',
+        clusterName: '',
+        secondsUntilRetry: 0,
+        errorClass: '',
+        execCommand: '',
+        diagnosticKind: '',
+        diagnosticSummary: '',
+      },
+    });
+
+    expect(presentation.status).toBe('degraded');
+    expect(presentation.summary).toBe('Reconnecting');
+    expect(presentation.detail).toContain('resync automatically');
+  });
 });
@@ -166,6 +166,15 @@ export const buildConnectivityPresentation = ({
     };
   }
 
+  if (health === 'reconnecting') {
+    return {
+      status: 'degraded',
+      summary: 'Reconnecting',
+      detail: `${clusterLabel} is not responding. Views may be stale until it responds, then they resync automatically.`,
+      actionLabel: 'Refresh Now',
+    };
+  }
+
   if (health === 'degraded') {
     return {
       status: 'degraded',
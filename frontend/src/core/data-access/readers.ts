@@ -9,18 +9,27 @@
 import type { capabilities, types } from '@wailsjs/go/models';
 import {
   DiscoverNodeLogs,
+  ExplainPodScheduling,
   FetchContainerLogs,
   FetchNodeLogs,
   FindCatalogObjectByUID,
   FindCatalogObjectMatch,
   GetContainerLogsScopeContainers,
+  GetHPAScalingHistory,
   GetObjectYAMLByGVK,
   GetPodContainers,
+  GetPodProcesses,
+  GetPodSockets,
   GetRevisionHistory,
   GetTargetPorts,
+  GetWorkloadCrashHistory,
+  GetWorkloadImageScan,
   HydrateCatalogCustomRows,
   IsWorkloadHPAManaged,
+  ListPodDirectory,
+  PreviewPodFile,
   SaveCsvFile,
+  SimulateNodeDrain,
 } from '@/core/backend-api';
 
 export interface ObjectReadTarget {
@@ -70,6 +79,42 @@ export const readTargetPortsForRef = (target: ObjectReadTarget) =>
 export const readPodContainers = (clusterId: string, namespace: string, resourceName: string) =>
   GetPodContainers(clusterId, namespace, resourceName);
 
+export const readPodProcesses = (clusterId: string, request: types.PodContainerRequest) =>
+  GetPodProcesses(clusterId, request);
+
+export const readPodSockets = (clusterId: string, request: types.PodContainerRequest) =>
+  GetPodSockets(clusterId, request);
+
+export const readPodDirectory = (clusterId: string, request: types.PodFileRequest) =>
+  ListPodDirectory(clusterId, request);
+
+export const readPodFilePreview = (clusterId: string, request: types.PodFileRequest) =>
+  PreviewPodFile(clusterId, request);
+
+export const readWorkloadCrashHistory = (
+  clusterId: string,
+  namespace: string,
+  kind: string,
+  name: string
+) => GetWorkloadCrashHistory(clusterId, namespace, kind, name);
+
+/** Cached image vulnerability counts for a Pod or workload; never runs the scanner. */
+export const readWorkloadImageScanForRef = (target: ObjectReadTarget) =>
+  GetWorkloadImageScan(
+    target.clusterId,
+    target.group,
+    target.version,
+    target.kind,
+    namespaceOrEmpty(target.namespace),
+    target.name
+  );
+
+export const readHPAScalingHistory = (clusterId: string, namespace: string, name: string) =>
+  GetHPAScalingHistory(clusterId, namespace, name);
+
+export const readPodSchedulingExplanation = (clusterId: string, namespace: string, name: string) =>
+  ExplainPodScheduling(clusterId, namespace, name);
+
 export const readContainerLogsScopeContainers = (clusterId: string, scope: string) =>
   GetContainerLogsScopeContainers(clusterId, scope);
 
@@ -85,6 +130,12 @@ export const readNodeLogs = (
   request: types.NodeLogFetchRequest
 ) => FetchNodeLogs(clusterId, nodeName, request);
 
+export const readNodeDrainSimulation = (
+  clusterId: string,
+  nodeName: string,
+  options: types.DrainNodeOptions
+) => SimulateNodeDrain(clusterId, nodeName, options);
+
 export const readObjectYAMLForRef = (target: ObjectYAMLReadTarget) =>
   GetObjectYAMLByGVK(
     target.clusterId,
@@ -83,6 +83,7 @@ export interface AppEvents {
   'view:toggle-app-logs-panel': undefined;
   'view:open-object-diff': ObjectDiffOpenRequest;
   'cluster-tabs:order': string[];
+  'keybindings:changed': undefined;
 
   // Favorites events
   'favorites:changed': unknown[];
@@ -136,6 +137,7 @@ export interface AppEvents {
   'settings:obj-panel-logs-api-timestamp-use-local-time-zone': boolean;
   'settings:obj-panel-logs-target-per-scope-limit': number;
   'settings:obj-panel-logs-target-global-limit': number;
+  'settings:secret-reveal-enabled': boolean;
   'settings:palette-tint': {
     mode: 'light' | 'dark';
     hue: number;
@@ -42,6 +42,9 @@ describe('view registry', () => {
       { id: 'crds', label: 'CRDs' },
       { id: 'custom', label: 'Custom' },
       { id: 'rbac', label: 'RBAC' },
+      { id: 'git-drift', label: 'Git Drift' },
+      { id: 'flux', label: 'Flux' },
+      { id: 'velero', label: 'Velero' },
     ]);
     expect(CLUSTER_VIEW_DESCRIPTORS.some((descriptor) => 'intent' in descriptor)).toBe(false);
   });
@@ -120,6 +120,30 @@ export const CLUSTER_VIEW_DESCRIPTORS = [
     keywords: ['rbac', 'cluster', 'security', 'roles', 'bindings', 'admission'],
     refresher: 'cluster-rbac',
   },
+  {
+    scope: 'cluster',
+    id: 'git-drift',
+    label: 'Git Drift',
+    description: 'Compare live objects with the manifests in local git working copies',
+    keywords: ['git-drift', 'git', 'drift', 'gitops', 'manifests', 'diff', 'cluster'],
+    refresher: null,
+  },
+  {
+    scope: 'cluster',
+    id: 'flux',
+    label: 'Flux',
+    description: 'Reconcile, suspend, or resume Flux HelmReleases and Kustomizations',
+    keywords: ['flux', 'gitops', 'helmreleases', 'kustomizations', 'reconcile', 'cluster'],
+    refresher: 'cluster-flux',
+  },
+  {
+    scope: 'cluster',
+    id: 'velero',
+    label: 'Velero',
+    description: 'Velero Backups, Restores and Schedules; back up a namespace or restore a backup',
+    keywords: ['velero', 'backups', 'restores', 'schedules', 'backup', 'restore', 'cluster'],
+    refresher: 'cluster-velero',
+  },
 ] as const satisfies readonly ViewDescriptor<'cluster', string>[];
 
 export const NAMESPACE_VIEW_DESCRIPTORS = [
@@ -0,0 +1,45 @@
+/**
+ * frontend/src/core/refresh/cborDecode.test.ts
+ *
+ * Tests for the CBOR refresh payload decoder.
+ */
+
+import { describe, expect, test } from 'vitest';
+
+import { decodeCbor, isCborContentType } from './cborDecode';
+
+const fromHex = (hex: string): Uint8Array =>
+  Uint8Array.from(hex.match(/../g)?.map((byte) => parseInt(byte, 16)) ?? []);
+
+describe('decodeCbor', () => {
+  test('decodes backend CBOR to the values of its JSON form', () => {
+    // Produced by backend/refresh/wire with FormatCBOR. The fields cover
+    // integers, floats of each width, null, a metav1.Time (untagged byte
+    // string), a []byte (tag 22) and a json.RawMessage.
+    const encoded = fromHex(
+      'ac626f6bf5627069fb400921f9f01b866e636269671b00000199c82cc000636e65672663726177a1616e8201' +
+        'f94100646e616d6565706f642d61646e6f6e65f6656279746573d642686965636f756e74182a656974656d' +
+        '73826178617965726174696ff93400677374617274656454323032362d31302d31355431313a30303a30305a'
+    );
+
+    expect(decodeCbor(encoded)).toEqual(
+      JSON.parse(
+        '{"big":1760000000000,"bytes":"aGk=","count":42,"items":["x","y"],"name":"pod-a",' +
+          '"neg":-7,"none":null,"ok":true,"pi":3.14159,"ratio":0.25,"raw":{"n":[1,2.5]},' +
+          '"started":"2026-10-15T11:00:00Z"}'
+      )
+    );
+  });
+
+  test('rejects truncated and trailing data', () => {
+    expect(() => decodeCbor(fromHex('8201'))).toThrow('Unexpected end of CBOR data');
+    expect(() => decodeCbor(fromHex('0101'))).toThrow('Trailing bytes');
+  });
+
+  test('recognizes the CBOR content type', () => {
+    expect(isCborContentType('application/cbor')).toBe(true);
+    expect(isCborContentType('Application/CBOR; charset=binary')).toBe(true);
+    expect(isCborContentType('application/json')).toBe(false);
+    expect(isCborContentType(null)).toBe(false);
+  });
+});
@@ -0,0 +1,188 @@
+/**
+ * frontend/src/core/refresh/cborDecode.ts
+ *
+ * Decodes CBOR (RFC 8949) refresh payloads into the same values JSON.parse
+ * would produce for the JSON form of the payload.
+ *
+ * The backend writes some text as byte strings (apimachinery types such as
+ * metav1.Time bring their own CBOR encoding), so untagged byte strings decode
+ * as UTF-8 text. Byte strings under tag 22 are Go []byte values and decode as
+ * base64 text, matching encoding/json. Other tags decode to their content.
+ */
+
+export const CBOR_CONTENT_TYPE = 'application/cbor';
+
+const TAG_EXPECT_BASE64 = 22;
+
+const textDecoder = new TextDecoder('utf-8', { fatal: true });
+
+const toBase64 = (bytes: Uint8Array): string => {
+  let binary = '';
+  for (let i = 0; i < bytes.length; i += 1) {
+    binary += String.fromCharCode(bytes[i]);
+  }
+  return btoa(binary);
+};
+
+const decodeFloat16 = (half: number): number => {
+  const exponent = (half >> 10) & 0x1f;
+  const fraction = half & 0x3ff;
+  const sign = half & 0x8000 ? -1 : 1;
+  if (exponent === 0) {
+    return sign * 2 ** -14 * (fraction / 1024);
+  }
+  if (exponent === 0x1f) {
+    return fraction ? NaN : sign * Infinity;
+  }
+  return sign * 2 ** (exponent - 15) * (1 + fraction / 1024);
+};
+
+class CborReader {
+  private offset = 0;
+  private readonly view: DataView;
+
+  constructor(private readonly bytes: Uint8Array) {
+    this.view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  }
+
+  get done(): boolean {
+    return this.offset >= this.bytes.length;
+  }
+
+  private ensure(length: number): void {
+    if (this.offset + length > this.bytes.length) {
+      throw new Error('Unexpected end of CBOR data');
+    }
+  }
+
+  private readArgument(info: number): number {
+    if (info < 24) {
+      return info;
+    }
+    switch (info) {
+      case 24:
+        this.ensure(1);
+        return this.view.getUint8(this.offset++);
+      case 25: {
+        this.ensure(2);
+        const value = this.view.getUint16(this.offset);
+        this.offset += 2;
+        return value;
+      }
+      case 26: {
+        this.ensure(4);
+        const value = this.view.getUint32(this.offset);
+        this.offset += 4;
+        return value;
+      }
+      case 27: {
+        this.ensure(8);
+        const value = this.view.getBigUint64(this.offset);
+        this.offset += 8;
+        if (value > BigInt(Number.MAX_SAFE_INTEGER)) {
+          throw new Error('CBOR integer exceeds the safe integer range');
+        }
+        return Number(value);
+      }
+      default:
+        throw new Error(`Unsupported CBOR additional info ${info}`);
+    }
+  }
+
+  private readBytes(length: number): Uint8Array {
+    this.ensure(length);
+    const slice = this.bytes.subarray(this.offset, this.offset + length);
+    this.offset += length;
+    return slice;
+  }
+
+  read(tag?: number): unknown {
+    this.ensure(1);
+    const initial = this.view.getUint8(this.offset++);
+    const major = initial >> 5;
+    const info = initial & 0x1f;
+
+    switch (major) {
+      case 0:
+        return this.readArgument(info);
+      case 1:
+        return -1 - this.readArgument(info);
+      case 2: {
+        const bytes = this.readBytes(this.readArgument(info));
+        return tag === TAG_EXPECT_BASE64 ? toBase64(bytes) : textDecoder.decode(bytes);
+      }
+      case 3:
+        return textDecoder.decode(this.readBytes(this.readArgument(info)));
+      case 4: {
+        const length = this.readArgument(info);
+        const items = new Array<unknown>(length);
+        for (let i = 0; i < length; i += 1) {
+          items[i] = this.read();
+        }
+        return items;
+      }
+      case 5: {
+        const length = this.readArgument(info);
+        const result: Record<string, unknown> = {};
+        for (let i = 0; i < length; i += 1) {
+          const key = this.read();
+          if (typeof key !== 'string' && typeof key !== 'number') {
+            throw new Error('Unsupported CBOR map key');
+          }
+          result[String(key)] = this.read();
+        }
+        return result;
+      }
+      case 6:
+        return this.read(this.readArgument(info));
+      default:
+        return this.readSimple(info);
+    }
+  }
+
+  private readSimple(info: number): unknown {
+    switch (info) {
+      case 20:
+        return false;
+      case 21:
+        return true;
+      case 22:
+      case 23:
+        return null;
+      case 25: {
+        this.ensure(2);
+        const value = decodeFloat16(this.view.getUint16(this.offset));
+        this.offset += 2;
+        return value;
+      }
+      case 26: {
+        this.ensure(4);
+        const value = this.view.getFloat32(this.offset);
+        this.offset += 4;
+        return value;
+      }
+      case 27: {
+        this.ensure(8);
+        const value = this.view.getFloat64(this.offset);
+        this.offset += 8;
+        return value;
+      }
+      default:
+        throw new Error(`Unsupported CBOR simple value ${info}`);
+    }
+  }
+}
+
+/** Decodes a single CBOR data item. */
+export function decodeCbor(data: ArrayBuffer | Uint8Array): unknown {
+  const reader = new CborReader(data instanceof Uint8Array ? data : new Uint8Array(data));
+  const value = reader.read();
+  if (!reader.done) {
+    throw new Error('Trailing bytes after CBOR data item');
+  }
+  return value;
+}
+
+/** Reports whether a Content-Type header names CBOR. */
+export const isCborContentType = (contentType: string | null | undefined): boolean =>
+  (contentType ?? '').split(';')[0].trim().toLowerCase() === CBOR_CONTENT_TYPE;
@@ -126,7 +126,10 @@ describe('fetchSnapshot', () => {
     expect(url).toBe('http://127.0.0.1:0/api/v2/snapshots/namespace-workloads?scope=team-a');
     expect(init).toEqual({
       signal: controller.signal,
-      headers: { 'If-None-Match': 'etag-old' },
+      headers: {
+        Accept: 'application/json',
+        'If-None-Match': 'etag-old',
+      },
     });
 
     expect(result).toEqual({
@@ -291,6 +294,117 @@ describe('fetchSnapshot', () => {
     );
   });
 
+  test('requests deltas against the cached version and rebuilds the rows', async () => {
+    mockGetBaseURL.mockResolvedValue('http://127.0.0.1:0/');
+
+    const rowA = { ref: { kind: 'Pod', name: 'a' }, status: 'Running' };
+    const rowB = { ref: { kind: 'Pod', name: 'b' }, status: 'Running' };
+    const rowC = { ref: { kind: 'Pod', name: 'c' }, status: 'Running' };
+    const envelope = {
+      domain: 'pods',
+      version: 1,
+      checksum: 'sum',
+      generatedAt: 1700000000000,
+      sequence: 1,
+      stats: { itemCount: 3, buildDurationMs: 1 },
+    };
+    const fetchMock = vi
+      .fn()
+      .mockResolvedValueOnce({
+        ok: true,
+        status: 200,
+        json: vi.fn().mockResolvedValue({
+          ...envelope,
+          payload: { rows: [rowA, rowB, rowC], total: 3 },
+        }),
+        headers: new Headers({ ETag: 'v1' }),
+      })
+      .mockResolvedValueOnce({
+        ok: true,
+        status: 200,
+        json: vi.fn().mockResolvedValue({
+          ...envelope,
+          payload: { total: 3 },
+          delta: {
+            since: 'v1',
+            rowsField: 'rows',
+            upserted: [{ ...rowC, status: 'Failed' }, { ref: { kind: 'Pod', name: 'd' } }],
+            removed: [rowB.ref],
+          },
+        }),
+        headers: new Headers({ ETag: 'v2' }),
+      });
+
+    globalThis.fetch = fetchMock;
+    const { fetchSnapshot } = await import('./client');
+
+    await fetchSnapshot('pods', { scope: 'cluster-a|namespace:all' });
+    const result = await fetchSnapshot<{ rows: unknown[]; total: number }>('pods', {
+      scope: 'cluster-a|namespace:all',
+      ifNoneMatch: 'v1',
+    });
+
+    const [url] = fetchMock.mock.calls[1];
+    expect(new URL(url).searchParams.get('since')).toBe('v1');
+    expect(result.etag).toBe('v2');
+    expect(result.snapshot?.delta).toBeUndefined();
+    expect(result.snapshot?.payload).toEqual({
+      total: 3,
+      rows: [rowA, { ...rowC, status: 'Failed' }, { ref: { kind: 'Pod', name: 'd' } }],
+    });
+    // Unchanged rows keep their identity so memoized row views skip work.
+    expect(result.snapshot?.payload.rows[0]).toBe(rowA);
+  });
+
+  test('applySnapshotDelta follows the explicit order and rejects unknown refs', async () => {
+    const { applySnapshotDelta } = await import('./client');
+    const base = { rows: [{ ref: 'a' }, { ref: 'b' }] };
+
+    expect(
+      applySnapshotDelta(base, {}, { since: 'v1', rowsField: 'rows', order: ['b', 'a'] })
+    ).toEqual({ rows: [{ ref: 'b' }, { ref: 'a' }] });
+    expect(
+      applySnapshotDelta(base, {}, { since: 'v1', rowsField: 'rows', order: ['a', 'z'] })
+    ).toBeUndefined();
+  });
+
+  test('decodes CBOR snapshot responses', async () => {
+    mockGetBaseURL.mockResolvedValue('http://127.0.0.1:0/');
+
+    // {"domain":"catalog","version":1,"checksum":"c","generatedAt":1,"sequence":1,
+    //  "payload":{},"stats":{"itemCount":0,"buildDurationMs":0}} as CBOR.
+    const encoded = Uint8Array.from(
+      (
+        'a7657374617473a2696974656d436f756e74006f6275696c644475726174696f6e4d730066646f6d61696e' +
+        '67636174616c6f67677061796c6f6164a06776657273696f6e0168636865636b73756d6163687365717565' +
+        '6e6365016b67656e657261746564417401'
+      )
+        .match(/../g)
+        ?.map((byte) => parseInt(byte, 16)) ?? []
+    );
+    const fetchMock = vi.fn().mockResolvedValue({
+      ok: true,
+      status: 200,
+      json: vi.fn(),
+      arrayBuffer: vi.fn().mockResolvedValue(encoded.buffer),
+      headers: new Headers({ 'Content-Type': 'application/cbor' }),
+    });
+
+    globalThis.fetch = fetchMock;
+    const { fetchSnapshot } = await import('./client');
+
+    const result = await fetchSnapshot('catalog');
+    expect(result.snapshot).toEqual({
+      domain: 'catalog',
+      version: 1,
+      checksum: 'c',
+      generatedAt: 1,
+      sequence: 1,
+      payload: {},
+      stats: { itemCount: 0, buildDurationMs: 0 },
+    });
+  });
+
   test('returns notModified when server responds with 304', async () => {
     mockGetBaseURL.mockResolvedValue('http://127.0.0.1:0');
 
@@ -309,7 +423,51 @@ describe('fetchSnapshot', () => {
     expect(result).toEqual({ notModified: true });
 
     const [, init] = fetchMock.mock.calls[0];
-    expect(init?.headers).toBeUndefined();
+    expect(init?.headers).toEqual({ Accept: 'application/json' });
+  });
+
+  test('asks for CBOR only when binary encoding is on', async () => {
+    mockGetBaseURL.mockResolvedValue('http://127.0.0.1:0');
+
+    const fetchMock = vi.fn().mockResolvedValue({
+      ok: false,
+      status: 304,
+      statusText: 'Not Modified',
+      json: vi.fn(),
+      headers: new Headers(),
+    });
+
+    globalThis.fetch = fetchMock;
+    const { setAppPreferencesForTesting } = await import('@/core/settings/appPreferences');
+    const { fetchSnapshot } = await import('./client');
+    setAppPreferencesForTesting({ refreshBinaryEncodingEnabled: true });
+
+    await fetchSnapshot('catalog');
+
+    const [, init] = fetchMock.mock.calls[0];
+    expect(init?.headers).toEqual({ Accept: 'application/cbor, application/json;q=0.9' });
+  });
+
+  test('reads freshness from the header of a 304 response', async () => {
+    mockGetBaseURL.mockResolvedValue('http://127.0.0.1:0');
+
+    const freshness = {
+      lastSyncedAt: 1_000,
+      informersSynced: true,
+      degraded: true,
+      degradedReason: 'connectivity',
+    };
+    globalThis.fetch = vi.fn().mockResolvedValue({
+      ok: false,
+      status: 304,
+      statusText: 'Not Modified',
+      json: vi.fn(),
+      headers: new Headers({ 'X-Refresh-Freshness': JSON.stringify(freshness) }),
+    });
+    const { fetchSnapshot } = await import('./client');
+
+    const result = await fetchSnapshot('catalog', { ifNoneMatch: 'v1' });
+    expect(result).toEqual({ notModified: true, freshness });
   });
 
   test('throws parsed message when snapshot request fails', async () => {
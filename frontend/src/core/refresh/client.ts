@@ -11,12 +11,16 @@ import {
   GetRefreshBaseURL,
   GetSelectionDiagnostics,
 } from '@/core/backend-api';
+import { getRefreshBinaryEncodingEnabled } from '@/core/settings/appPreferences';
+import { CBOR_CONTENT_TYPE, decodeCbor, isCborContentType } from './cborDecode';
 import { formatPermissionDeniedStatus, isPermissionDeniedStatus } from './permissionErrors';
 import {
   assertRefreshSnapshotEnvelope,
   assertTelemetrySummary,
+  type DataFreshness,
   type RefreshDomain,
   type RefreshSnapshot,
+  type SnapshotDelta,
   type SnapshotStats,
   type TelemetrySummary,
 } from './types';
@@ -44,6 +48,13 @@ type ManualRefreshJob = {
   error?: string;
 };
 
+// Row lists of the last full snapshot per domain and scope, kept so repeat
+// requests can ask the backend for only the rows that changed since then.
+type SnapshotRowsCacheEntry = { etag: string; payload: Record<string, unknown> };
+const SNAPSHOT_ROWS_CACHE_MAX_ENTRIES = 32;
+const SNAPSHOT_ROW_FIELDS = ['rows', 'resources'] as const;
+const snapshotRowsCache = new Map<string, SnapshotRowsCacheEntry>();
+
 let cachedRefreshBaseURL: string | null = null;
 let refreshBaseURLPromise: Promise<string> | null = null;
 let refreshReadyPromise: Promise<string> | null = null;
@@ -215,10 +226,120 @@ export function parseRefreshSnapshotValue<TPayload>(
   return value;
 }
 
+const snapshotRowsCacheKey = (domain: RefreshDomain, scope?: string) => `${domain}|${scope ?? ''}`;
+
+const isPayloadRecord = (value: unknown): value is Record<string, unknown> =>
+  typeof value === 'object' && value !== null && !Array.isArray(value);
+
+const rememberSnapshotRows = (key: string, etag: string | undefined, payload: unknown) => {
+  snapshotRowsCache.delete(key);
+  if (
+    !etag ||
+    !isPayloadRecord(payload) ||
+    !SNAPSHOT_ROW_FIELDS.some((field) => Array.isArray(payload[field]))
+  ) {
+    return;
+  }
+  snapshotRowsCache.set(key, { etag, payload });
+  if (snapshotRowsCache.size > SNAPSHOT_ROWS_CACHE_MAX_ENTRIES) {
+    const oldest = snapshotRowsCache.keys().next().value;
+    if (oldest !== undefined) {
+      snapshotRowsCache.delete(oldest);
+    }
+  }
+};
+
+const rowRefKey = (row: unknown): string | undefined =>
+  isPayloadRecord(row) && row.ref !== undefined ? JSON.stringify(row.ref) : undefined;
+
+/**
+ * Rebuilds a delta response's payload from the cached rows it was computed
+ * against. Returns undefined when the cached rows cannot be matched, so the
+ * caller falls back to a full request.
+ */
+export function applySnapshotDelta(
+  base: Record<string, unknown>,
+  payload: unknown,
+  delta: SnapshotDelta
+): Record<string, unknown> | undefined {
+  const baseRows = base[delta.rowsField];
+  if (!Array.isArray(baseRows) || !isPayloadRecord(payload)) {
+    return undefined;
+  }
+
+  const rows = new Map<string, unknown>();
+  for (const row of baseRows) {
+    const key = rowRefKey(row);
+    if (key === undefined) {
+      return undefined;
+    }
+    rows.set(key, row);
+  }
+  for (const ref of delta.removed ?? []) {
+    rows.delete(JSON.stringify(ref));
+  }
+  for (const row of delta.upserted ?? []) {
+    const key = rowRefKey(row);
+    if (key === undefined) {
+      return undefined;
+    }
+    // Map.set keeps the position of existing keys and appends new ones,
+    // which is the order the backend assumes when it omits `order`.
+    rows.set(key, row);
+  }
+
+  let nextRows = Array.from(rows.values());
+  if (delta.order) {
+    nextRows = [];
+    for (const ref of delta.order) {
+      const row = rows.get(JSON.stringify(ref));
+      if (row === undefined) {
+        return undefined;
+      }
+      nextRows.push(row);
+    }
+  }
+  return { ...payload, [delta.rowsField]: nextRows };
+}
+
+// CBOR is opt-in (Settings → Advanced): the backend encodes it faster than
+// JSON for large row lists, but the JS decoder has not been measured against
+// the browser's native JSON.parse. Backends that predate CBOR answer with JSON.
+const SNAPSHOT_ACCEPT_CBOR = `${CBOR_CONTENT_TYPE}, application/json;q=0.9`;
+const SNAPSHOT_ACCEPT_JSON = 'application/json';
+
+const readSnapshotBody = async (response: Response): Promise<unknown> =>
+  isCborContentType(response.headers.get('Content-Type'))
+    ? decodeCbor(await response.arrayBuffer())
+    : response.json();
+
+// 304 responses carry freshness in a header, since they have no body.
+const FRESHNESS_HEADER = 'X-Refresh-Freshness';
+
+const readFreshnessHeader = (response: Response): DataFreshness | undefined => {
+  const header = response.headers.get(FRESHNESS_HEADER);
+  if (!header) {
+    return undefined;
+  }
+  try {
+    const value: unknown = JSON.parse(header);
+    return isPayloadRecord(value) && typeof value.degraded === 'boolean'
+      ? (value as unknown as DataFreshness)
+      : undefined;
+  } catch {
+    return undefined;
+  }
+};
+
 export async function fetchSnapshot<TPayload>(
   domain: RefreshDomain,
   options: FetchSnapshotOptions = {}
-): Promise<{ snapshot?: Snapshot<TPayload>; etag?: string; notModified: boolean }> {
+): Promise<{
+  snapshot?: Snapshot<TPayload>;
+  etag?: string;
+  notModified: boolean;
+  freshness?: DataFreshness;
+}> {
   if (options.manual) {
     if (!options.scope) {
       throw new Error(`Manual refresh for ${domain} requires a cluster scope`);
@@ -230,22 +351,33 @@ export async function fetchSnapshot<TPayload>(
       options.signal
     );
   }
-  const buildRequest = async () => {
+  const cacheKey = snapshotRowsCacheKey(domain, options.scope);
+  const cachedRows = snapshotRowsCache.get(cacheKey);
+  // Only ask for a delta against the version the caller still holds.
+  const since =
+    cachedRows && options.ifNoneMatch === cachedRows.etag ? cachedRows.etag : undefined;
+
+  const buildRequest = async (requestSince?: string) => {
     const baseURL = await resolveRefreshBaseURL();
     const url = new URL(`/api/v2/snapshots/${domain}`, baseURL);
 
     if (options.scope) {
       url.searchParams.set('scope', options.scope);
     }
+    if (requestSince) {
+      url.searchParams.set('since', requestSince);
+    }
 
-    const headers: Record<string, string> = {};
+    const headers: Record<string, string> = {
+      Accept: getRefreshBinaryEncodingEnabled() ? SNAPSHOT_ACCEPT_CBOR : SNAPSHOT_ACCEPT_JSON,
+    };
     if (options.ifNoneMatch && !options.manual) {
       headers['If-None-Match'] = options.ifNoneMatch;
     }
 
     return fetch(url.toString(), {
       signal: options.signal,
-      headers: Object.keys(headers).length > 0 ? headers : undefined,
+      headers,
     });
   };
 
@@ -263,28 +395,33 @@ export async function fetchSnapshot<TPayload>(
     return false;
   };
 
-  const maxAttempts = 3;
-  let response: Response | undefined;
-  for (let attempt = 0; attempt < maxAttempts; attempt += 1) {
-    try {
-      response = await buildRequest();
-      break;
-    } catch (error) {
-      if (!isRetryableNetworkError(error) || attempt + 1 >= maxAttempts) {
-        throw error;
+  const request = async (requestSince?: string) => {
+    const maxAttempts = 3;
+    let response: Response | undefined;
+    for (let attempt = 0; attempt < maxAttempts; attempt += 1) {
+      try {
+        response = await buildRequest(requestSince);
+        break;
+      } catch (error) {
+        if (!isRetryableNetworkError(error) || attempt + 1 >= maxAttempts) {
+          throw error;
+        }
+        // Refresh base URLs can change when the backend rebuilds the refresh subsystem.
+        invalidateRefreshBaseURL();
+        const delayMs = Math.min(1000, 200 * 2 ** attempt);
+        await delay(delayMs);
       }
-      // Refresh base URLs can change when the backend rebuilds the refresh subsystem.
-      invalidateRefreshBaseURL();
-      const delayMs = Math.min(1000, 200 * 2 ** attempt);
-      await delay(delayMs);
     }
-  }
-  if (!response) {
-    throw new Error('Snapshot request failed');
-  }
+    if (!response) {
+      throw new Error('Snapshot request failed');
+    }
+    return response;
+  };
+
+  let response = await request(since);
 
   if (response.status === 304) {
-    return { notModified: true };
+    return { notModified: true, freshness: readFreshnessHeader(response) };
   }
 
   if (!response.ok) {
@@ -292,10 +429,35 @@ export async function fetchSnapshot<TPayload>(
     throw permissionDenied ? new SnapshotPermissionDeniedError(message) : new Error(message);
   }
 
-  const snapshot = parseRefreshSnapshotValue<TPayload>(await response.json(), domain);
+  let snapshot = parseRefreshSnapshotValue<TPayload>(await readSnapshotBody(response), domain);
+  if (snapshot.delta) {
+    const { delta, ...rest } = snapshot;
+    const payload =
+      cachedRows && delta.since === cachedRows.etag
+        ? applySnapshotDelta(cachedRows.payload, rest.payload, delta)
+        : undefined;
+    if (payload) {
+      snapshot = { ...rest, payload: payload as TPayload };
+    } else {
+      // The cached rows no longer match the delta; fetch the full snapshot.
+      snapshotRowsCache.delete(cacheKey);
+      response = await request();
+      if (response.status === 304) {
+        return { notModified: true, freshness: readFreshnessHeader(response) };
+      }
+      if (!response.ok) {
+        const { message, permissionDenied } = await safeParseError(response);
+        throw permissionDenied ? new SnapshotPermissionDeniedError(message) : new Error(message);
+      }
+      snapshot = parseRefreshSnapshotValue<TPayload>(await readSnapshotBody(response), domain);
+    }
+  }
+
+  const etag = response.headers.get('ETag') ?? undefined;
+  rememberSnapshotRows(cacheKey, etag, snapshot.payload);
   return {
     snapshot,
-    etag: response.headers.get('ETag') ?? undefined,
+    etag,
     notModified: false,
   };
 }
@@ -334,6 +496,7 @@ async function safeParseError(
 }
 
 export function invalidateRefreshBaseURL(): void {
+  snapshotRowsCache.clear();
   cachedRefreshBaseURL = null;
   refreshBaseURLPromise = null;
   refreshReadyPromise = null;
@@ -6,6 +6,7 @@
  */
 
 import { KeyboardProvider } from '@ui/shortcuts';
+import { backend } from '@wailsjs/go/models';
 import React, { act } from 'react';
 import * as ReactDOM from 'react-dom/client';
 import { afterEach, beforeAll, beforeEach, describe, expect, test, vi } from 'vitest';
@@ -1355,7 +1356,7 @@ describe('DiagnosticsPanel component', () => {
       generatedAt: Date.now(),
     } as unknown as TelemetrySummary);
     fetchKubernetesAPIClientDiagnosticsMock.mockResolvedValue([
-      {
+      backend.KubernetesAPIClientDiagnostics.createFrom({
         clusterId: 'cluster-a',
         clusterName: 'prod',
         configuredQPS: 500,
@@ -1372,7 +1373,21 @@ describe('DiagnosticsPanel component', () => {
         status429: 1,
         errors: 3,
         lastRequestMs: Date.now(),
-      },
+        throttledRequests: 6,
+        throttleWaitMs: 2400,
+        maxThrottleWaitMs: 900,
+        operations: [
+          {
+            verb: 'list',
+            group: 'apps',
+            resource: 'deployments',
+            requests: 310,
+            errors: 0,
+            avgLatencyMs: 42,
+            maxLatencyMs: 180,
+          },
+        ],
+      }),
     ]);
 
     const { DiagnosticsPanel } = await import('./DiagnosticsPanel');
@@ -1386,6 +1401,9 @@ describe('DiagnosticsPanel component', () => {
     expect(rendered.container.textContent).toContain('500 / 1000');
     expect(rendered.container.textContent).toContain('1200');
     expect(rendered.container.textContent).toContain('44');
+    expect(rendered.container.textContent).toContain('6 (2.4s)');
+    expect(rendered.container.textContent).toContain('deployments.apps');
+    expect(rendered.container.textContent).toContain('42ms');
 
     await rendered.unmount();
   });
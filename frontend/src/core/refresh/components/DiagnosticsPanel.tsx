@@ -60,6 +60,7 @@ import {
   buildDiagnosticsStreamSummary,
   buildEventStreamSummary,
   buildKubernetesAPIClientRows,
+  buildKubernetesAPIOperationRows,
   buildKubernetesAPISummary,
   buildMetricsSummary,
   buildOrchestratorSummary,
@@ -1574,6 +1575,11 @@ export const DiagnosticsPanel: React.FC<DiagnosticsPanelProps> = ({ onClose, isO
     [kubernetesAPIDiagnostics]
   );
 
+  const kubernetesAPIOperationRows = useMemo(
+    () => buildKubernetesAPIOperationRows(kubernetesAPIDiagnostics),
+    [kubernetesAPIDiagnostics]
+  );
+
   const kubernetesAPISummary = useMemo(() => {
     return buildKubernetesAPISummary(kubernetesAPIClientRows, kubernetesAPIDiagnosticsError);
   }, [kubernetesAPIClientRows, kubernetesAPIDiagnosticsError]);
@@ -1694,7 +1700,11 @@ export const DiagnosticsPanel: React.FC<DiagnosticsPanelProps> = ({ onClose, isO
   );
 
   const kubernetesAPIContent = (
-    <KubernetesAPIClientsTable rows={kubernetesAPIClientRows} summary={kubernetesAPISummary} />
+    <KubernetesAPIClientsTable
+      rows={kubernetesAPIClientRows}
+      operationRows={kubernetesAPIOperationRows}
+      summary={kubernetesAPISummary}
+    />
   );
 
   const tablePerformanceContent = (
@@ -1,20 +1,23 @@
 /**
  * frontend/src/core/refresh/components/diagnostics/TableKubernetesAPIClients.tsx
  *
- * Renders Kubernetes API client usage in the diagnostics panel.
+ * Renders Kubernetes API client usage in the diagnostics panel: per-cluster
+ * request rates and throttling, then the busiest verb/resource pairs.
  */
 
 import { TableCellValue } from '@shared/components/tables/tableNoValue';
 import type React from 'react';
-import type { KubernetesAPIClientRow } from './diagnosticsPanelTypes';
+import type { KubernetesAPIClientRow, KubernetesAPIOperationRow } from './diagnosticsPanelTypes';
 
 interface KubernetesAPIClientsTableProps {
   rows: KubernetesAPIClientRow[];
+  operationRows: KubernetesAPIOperationRow[];
   summary: string;
 }
 
 export const KubernetesAPIClientsTable: React.FC<KubernetesAPIClientsTableProps> = ({
   rows,
+  operationRows,
   summary,
 }) => {
   return (
@@ -38,13 +41,14 @@ export const KubernetesAPIClientsTable: React.FC<KubernetesAPIClientsTableProps>
               <th>429s</th>
               <th>5xx</th>
               <th>Errors</th>
+              <th>Throttled</th>
               <th>Last Request</th>
             </tr>
           </thead>
           <tbody>
             {rows.length === 0 ? (
               <tr className="diagnostics-empty">
-                <td colSpan={11}>Kubernetes API client telemetry is not available yet.</td>
+                <td colSpan={12}>Kubernetes API client telemetry is not available yet.</td>
               </tr>
             ) : (
               rows.map((row) => (
@@ -81,6 +85,9 @@ export const KubernetesAPIClientsTable: React.FC<KubernetesAPIClientsTableProps>
                   <td>
                     <TableCellValue>{row.errors}</TableCellValue>
                   </td>
+                  <td title={row.throttledTooltip}>
+                    <TableCellValue>{row.throttled}</TableCellValue>
+                  </td>
                   <td title={row.lastRequestTooltip}>
                     <TableCellValue>{row.lastRequest}</TableCellValue>
                   </td>
@@ -90,6 +97,54 @@ export const KubernetesAPIClientsTable: React.FC<KubernetesAPIClientsTableProps>
           </tbody>
         </table>
       </div>
+      <div className="diagnostics-table-wrapper">
+        <table className="diagnostics-table">
+          <thead>
+            <tr>
+              <th>Cluster</th>
+              <th>Verb</th>
+              <th>Resource</th>
+              <th>Requests</th>
+              <th>Errors</th>
+              <th>Avg Latency</th>
+              <th>Max Latency</th>
+            </tr>
+          </thead>
+          <tbody>
+            {operationRows.length === 0 ? (
+              <tr className="diagnostics-empty">
+                <td colSpan={7}>No Kubernetes API requests recorded yet.</td>
+              </tr>
+            ) : (
+              operationRows.map((row) => (
+                <tr key={row.key}>
+                  <td>
+                    <span className="diagnostics-domain">{row.cluster}</span>
+                  </td>
+                  <td>
+                    <TableCellValue>{row.verb}</TableCellValue>
+                  </td>
+                  <td title={row.resourceTooltip}>
+                    <TableCellValue>{row.resource}</TableCellValue>
+                  </td>
+                  <td>
+                    <TableCellValue>{row.requests}</TableCellValue>
+                  </td>
+                  <td>
+                    <TableCellValue>{row.errors}</TableCellValue>
+                  </td>
+                  <td>
+                    <TableCellValue>{row.avgLatency}</TableCellValue>
+                  </td>
+                  <td>
+                    <TableCellValue>{row.maxLatency}</TableCellValue>
+                  </td>
+                </tr>
+              ))
+            )}
+          </tbody>
+        </table>
+      </div>
     </div>
   );
 };
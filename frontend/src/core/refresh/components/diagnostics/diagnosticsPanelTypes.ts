@@ -146,10 +146,24 @@ export interface KubernetesAPIClientRow {
   status429: number;
   status5xx: number;
   errors: number;
+  throttled: string;
+  throttledTooltip: string;
   lastRequest: string;
   lastRequestTooltip: string;
 }
 
+export interface KubernetesAPIOperationRow {
+  key: string;
+  cluster: string;
+  verb: string;
+  resource: string;
+  resourceTooltip: string;
+  requests: number;
+  errors: number;
+  avgLatency: string;
+  maxLatency: string;
+}
+
 export interface CapabilityDescriptorActivityDetails {
   scope: string;
   descriptorLabel: string;
@@ -5,6 +5,7 @@
  * These tests keep stream telemetry row semantics local to the row model module.
  */
 
+import { backend } from '@wailsjs/go/models';
 import { afterEach, describe, expect, test, vi } from 'vitest';
 import type { PermissionQueryDiagnostics, PermissionStatus } from '@/core/capabilities';
 import { getPermissionKey, PERMISSION_FEATURES } from '@/core/capabilities';
@@ -20,6 +21,7 @@ import {
   buildDiagnosticsStreamSummary,
   buildEventStreamSummary,
   buildKubernetesAPIClientRows,
+  buildKubernetesAPIOperationRows,
   buildKubernetesAPISummary,
   buildMetricsSummary,
   buildOrchestratorSummary,
@@ -331,7 +333,7 @@ describe('diagnosticsRowModel', () => {
     const now = Date.now();
 
     const rows = buildKubernetesAPIClientRows([
-      {
+      backend.KubernetesAPIClientDiagnostics.createFrom({
         clusterId: 'cluster-a',
         clusterName: 'Cluster A',
         configuredQPS: 50,
@@ -348,8 +350,11 @@ describe('diagnosticsRowModel', () => {
         status5xx: 1,
         errors: 2,
         lastRequestMs: now - 1000,
-      },
-      {
+        throttledRequests: 4,
+        throttleWaitMs: 1500,
+        maxThrottleWaitMs: 800,
+      }),
+      backend.KubernetesAPIClientDiagnostics.createFrom({
         clusterId: 'cluster-b',
         clusterName: '',
         configuredQPS: 20,
@@ -366,7 +371,10 @@ describe('diagnosticsRowModel', () => {
         status5xx: 2,
         errors: 1,
         lastRequestMs: 0,
-      },
+        throttledRequests: 0,
+        throttleWaitMs: 0,
+        maxThrottleWaitMs: 0,
+      }),
     ]);
 
     expect(rows[0]).toMatchObject({
@@ -378,13 +386,16 @@ describe('diagnosticsRowModel', () => {
       qps10s: '13',
       qps60s: '0',
       totalRequests: 200,
+      throttled: '4 (1.5s)',
     });
+    expect(rows[0].throttledTooltip).toContain('longest 800ms');
     expect(rows[1]).toMatchObject({
       key: 'cluster-b',
       cluster: 'cluster-b',
       qps1s: '0',
       qps10s: '1.3',
       qps60s: '10',
+      throttled: '0',
       lastRequest: '—',
     });
     expect(buildKubernetesAPISummary(rows, null)).toBe(
@@ -395,6 +406,56 @@ describe('diagnosticsRowModel', () => {
     );
   });
 
+  test('builds Kubernetes API operation rows busiest first', () => {
+    const cluster = (
+      clusterId: string,
+      operations: backend.KubernetesAPIOperationStats[]
+    ): backend.KubernetesAPIClientDiagnostics =>
+      backend.KubernetesAPIClientDiagnostics.createFrom({
+        clusterId,
+        clusterName: clusterId.toUpperCase(),
+        operations,
+      });
+    const operation = (
+      overrides: Partial<backend.KubernetesAPIOperationStats>
+    ): backend.KubernetesAPIOperationStats =>
+      backend.KubernetesAPIOperationStats.createFrom({
+        verb: 'list',
+        group: '',
+        resource: 'pods',
+        requests: 1,
+        errors: 0,
+        avgLatencyMs: 0,
+        maxLatencyMs: 0,
+        ...overrides,
+      });
+
+    const rows = buildKubernetesAPIOperationRows([
+      cluster('a', [
+        operation({ verb: 'watch', requests: 3, avgLatencyMs: 0.4, maxLatencyMs: 12.6 }),
+        operation({ verb: 'get', resource: '', group: 'apps', requests: 1 }),
+      ]),
+      cluster('b', [
+        operation({
+          verb: 'patch',
+          group: 'apps',
+          resource: 'deployments/scale',
+          requests: 9,
+          errors: 2,
+          avgLatencyMs: 1250,
+        }),
+      ]),
+    ]);
+
+    expect(rows.map((row) => [row.cluster, row.verb, row.resource])).toEqual([
+      ['B', 'patch', 'deployments/scale.apps'],
+      ['A', 'watch', 'pods'],
+      ['A', 'get', 'discovery.apps'],
+    ]);
+    expect(rows[0]).toMatchObject({ errors: 2, avgLatency: '1.3s', maxLatency: '—' });
+    expect(rows[1]).toMatchObject({ avgLatency: '<1ms', maxLatency: '13ms' });
+  });
+
   test('builds broker read rows and summary', () => {
     vi.useFakeTimers();
     vi.setSystemTime(new Date('2024-01-01T12:00:00Z'));
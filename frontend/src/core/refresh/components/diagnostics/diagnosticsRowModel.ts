@@ -32,6 +32,7 @@ import type {
   DiagnosticsStreamHeaderRow,
   DiagnosticsStreamRow,
   KubernetesAPIClientRow,
+  KubernetesAPIOperationRow,
   PermissionRow,
   SummaryCardData,
 } from './diagnosticsPanelTypes';
@@ -356,12 +357,59 @@ export const buildKubernetesAPIClientRows = (
       status429: entry.status429,
       status5xx: entry.status5xx,
       errors: entry.errors,
+      throttled:
+        entry.throttledRequests > 0
+          ? `${entry.throttledRequests} (${formatDurationMs(entry.throttleWaitMs)})`
+          : '0',
+      throttledTooltip:
+        entry.throttledRequests > 0
+          ? `Held by the client-side rate limiter for ${formatDurationMs(entry.throttleWaitMs)} in total, longest ${formatDurationMs(entry.maxThrottleWaitMs)}`
+          : 'No requests held by the client-side rate limiter',
       lastRequest: lastRequestInfo.display,
       lastRequestTooltip: lastRequestInfo.tooltip,
     };
   });
 };
 
+// Busiest verb/resource pairs shown across all clusters.
+const KUBERNETES_API_OPERATION_LIMIT = 25;
+
+const formatLatencyMs = (value: number): string => {
+  if (!Number.isFinite(value) || value <= 0) {
+    return '—';
+  }
+  if (value < 1) {
+    return '<1ms';
+  }
+  return formatDurationMs(Math.round(value));
+};
+
+export const buildKubernetesAPIOperationRows = (
+  diagnostics: KubernetesAPIClientDiagnostics[]
+): KubernetesAPIOperationRow[] => {
+  const rows = diagnostics.flatMap((entry) => {
+    const clusterName = entry.clusterName || entry.clusterId || 'Unknown cluster';
+    return (entry.operations ?? []).map((operation) => {
+      // Discovery requests carry no resource; non-resource paths start with "/".
+      const resource = operation.resource || 'discovery';
+      const qualified = operation.group ? `${resource}.${operation.group}` : resource;
+      return {
+        key: [entry.clusterId, operation.verb, operation.group, operation.resource].join('\u0000'),
+        cluster: clusterName,
+        verb: operation.verb,
+        resource: qualified,
+        resourceTooltip: operation.group ? `${operation.group}: ${resource}` : resource,
+        requests: operation.requests,
+        errors: operation.errors,
+        avgLatency: formatLatencyMs(operation.avgLatencyMs),
+        maxLatency: formatLatencyMs(operation.maxLatencyMs),
+      };
+    });
+  });
+  rows.sort((a, b) => b.requests - a.requests);
+  return rows.slice(0, KUBERNETES_API_OPERATION_LIMIT);
+};
+
 export const buildKubernetesAPISummary = (
   rows: KubernetesAPIClientRow[],
   diagnosticsError: string | null
@@ -85,6 +85,8 @@ export function registerDefaultRefreshDomains(registrar: RefreshDomainRegistrar)
   registerRefreshDomain('object-details', undefined, false);
   registerSnapshotDomains(
     'object-map',
+    'object-references',
+    'object-storage-chain',
     'object-yaml',
     'object-helm-manifest',
     'object-helm-values'
@@ -98,6 +100,14 @@ export function registerDefaultRefreshDomains(registrar: RefreshDomainRegistrar)
   doorbellStreamDomain('catalog');
   registerSnapshotDomains('catalog-diff');
   doorbellStreamDomain('cluster-events');
+  registerSnapshotDomains('cluster-node-packing');
+  registerSnapshotDomains('cluster-namespace-usage');
+  registerSnapshotDomains('cluster-problem-pods');
+  registerSnapshotDomains('cluster-policy-reports');
+  registerSnapshotDomains('cluster-velero');
+  registerSnapshotDomains('cluster-flux');
+  registerSnapshotDomains('cluster-external-secrets');
+  registerSnapshotDomains('cluster-categories');
   resourceStreamDomain('nodes');
   resourceStreamDomain('cluster-rbac');
   resourceStreamDomain('cluster-storage');
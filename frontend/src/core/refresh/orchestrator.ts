@@ -1347,7 +1347,9 @@ class RefreshOrchestrator {
     markPendingRequest(1);
 
     try {
-      const { snapshot, etag, notModified } = await fetchSnapshot<DomainPayloadMap[K]>(domain, {
+      const { snapshot, etag, notModified, freshness } = await fetchSnapshot<
+        DomainPayloadMap[K]
+      >(domain, {
         scope: normalizedScope,
         signal: controller.signal,
         ifNoneMatch: previousState.sourceVersion ?? previousState.etag,
@@ -1374,6 +1376,7 @@ class RefreshOrchestrator {
           status: prev.data ? 'ready' : 'idle',
           isManual: options.isManual,
           lastAutoRefresh: options.isManual ? prev.lastAutoRefresh : Date.now(),
+          freshness: freshness ?? prev.freshness,
         }));
         this.clearRefreshError(domain, normalizedScope);
         return;
@@ -1480,6 +1483,7 @@ class RefreshOrchestrator {
         checksum: snapshot.checksum,
         etag: etag ?? snapshot.sourceVersion ?? snapshot.checksum ?? prev.etag,
         lastUpdated: Date.now(),
+        freshness: snapshot.freshness ?? prev.freshness,
         lastManualRefresh: isManual ? Date.now() : prev.lastManualRefresh,
         lastAutoRefresh: !isManual ? Date.now() : prev.lastAutoRefresh,
         error: null,
@@ -86,6 +86,8 @@ const MULTI_ACTIVE_SCOPE_DOMAINS = new Set<RefreshDomain>([
   'object-helm-values',
   'object-maintenance',
   'object-map',
+  'object-references',
+  'object-storage-chain',
   'object-yaml',
   'pods',
 ]);
@@ -33,6 +33,14 @@ const CLUSTER_REFRESHERS = {
   crds: 'cluster-crds',
   custom: 'cluster-custom',
   events: 'cluster-events',
+  nodePacking: 'cluster-node-packing',
+  namespaceUsage: 'cluster-namespace-usage',
+  problemPods: 'cluster-problem-pods',
+  policyReports: 'cluster-policy-reports',
+  velero: 'cluster-velero',
+  flux: 'cluster-flux',
+  externalSecrets: 'cluster-external-secrets',
+  categories: 'cluster-categories',
   browse: 'catalog',
   catalogDiff: 'catalog-diff',
 } as const;
@@ -48,6 +56,8 @@ const SYSTEM_REFRESHERS = {
   objectHelmManifest: 'object-helm-manifest',
   objectHelmValues: 'object-helm-values',
   objectMap: 'object-map',
+  objectReferences: 'object-references',
+  objectStorageChain: 'object-storage-chain',
   containerLogs: 'container-logs',
   objectMaintenance: 'object-maintenance',
 } as const;
@@ -8,7 +8,7 @@
 import { useSyncExternalStore } from 'react';
 import type { SnapshotStats } from './client';
 import type { RefreshSourceClock } from './domainRegistry';
-import type { DomainPayloadMap, RefreshDomain } from './types';
+import type { DataFreshness, DomainPayloadMap, RefreshDomain } from './types';
 
 export type DomainStatus = 'idle' | 'loading' | 'initialising' | 'updating' | 'ready' | 'error';
 
@@ -47,6 +47,9 @@ export interface DomainSnapshotState<TPayload> {
   // mounted consumer retains.
   queryReconcileVersion?: number;
   lastUpdated?: number;
+  // How current the backend's data was when last reported, by a snapshot
+  // response or a stream ACK. Degraded means the rows may be stale.
+  freshness?: DataFreshness;
   lastManualRefresh?: number;
   lastAutoRefresh?: number;
   error?: string | null;
@@ -113,6 +116,8 @@ const state: RefreshStoreState = {
     'object-details': createInitialDomainState(),
     'object-events': createInitialDomainState(),
     'object-map': createInitialDomainState(),
+    'object-references': createInitialDomainState(),
+    'object-storage-chain': createInitialDomainState(),
     'object-yaml': createInitialDomainState(),
     'object-helm-manifest': createInitialDomainState(),
     'object-helm-values': createInitialDomainState(),
@@ -123,6 +128,14 @@ const state: RefreshStoreState = {
     'cluster-crds': createInitialDomainState(),
     'cluster-custom': createInitialDomainState(),
     'cluster-events': createInitialDomainState(),
+    'cluster-node-packing': createInitialDomainState(),
+    'cluster-namespace-usage': createInitialDomainState(),
+    'cluster-problem-pods': createInitialDomainState(),
+    'cluster-policy-reports': createInitialDomainState(),
+    'cluster-velero': createInitialDomainState(),
+    'cluster-flux': createInitialDomainState(),
+    'cluster-external-secrets': createInitialDomainState(),
+    'cluster-categories': createInitialDomainState(),
     catalog: createInitialDomainState(),
     'catalog-diff': createInitialDomainState(),
     'namespace-workloads': createInitialDomainState(),
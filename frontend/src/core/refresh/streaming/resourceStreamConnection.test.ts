@@ -1,4 +1,8 @@
 import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import {
+  resetAppPreferencesCacheForTesting,
+  setAppPreferencesForTesting,
+} from '@/core/settings/appPreferences';
 import { installWindowProperty } from '@/test-utils/windowProperty';
 
 const ensureRefreshBaseURLMock = vi.hoisted(() => vi.fn(async () => 'http://127.0.0.1:0'));
@@ -52,6 +56,7 @@ describe('ResourceStreamConnection', () => {
   afterEach(() => {
     restoreWebSocket?.();
     restoreWebSocket = undefined;
+    resetAppPreferencesCacheForTesting();
     vi.useRealTimers();
   });
 
@@ -74,6 +79,28 @@ describe('ResourceStreamConnection', () => {
     expect(delegate.handleMessage).toHaveBeenCalledWith('', '{"type":"HEARTBEAT"}');
   });
 
+  it('asks for CBOR frames when binary encoding is on and decodes them', async () => {
+    setAppPreferencesForTesting({ refreshBinaryEncodingEnabled: true });
+    const delegate = {
+      handleConnectionOpen: vi.fn(),
+      handleMessage: vi.fn(),
+      handleConnectionError: vi.fn(),
+    };
+    const connection = new ResourceStreamConnection(delegate);
+
+    await connection.connect();
+
+    expect(createdSockets[0].url).toBe('ws://127.0.0.1:0/api/v2/stream/resources?encoding=cbor');
+
+    // {"type":"HEARTBEAT"} as CBOR.
+    const frame = Uint8Array.from([
+      0xa1, 0x64, 0x74, 0x79, 0x70, 0x65, 0x69, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54,
+    ]);
+    createdSockets[0].onmessage?.({ data: frame.buffer } as MessageEvent);
+
+    expect(delegate.handleMessage).toHaveBeenCalledWith('', { type: 'HEARTBEAT' });
+  });
+
   it('queues outbound messages until the socket is available', async () => {
     const delegate = {
       handleConnectionOpen: vi.fn(),
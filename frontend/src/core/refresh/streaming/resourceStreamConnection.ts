@@ -1,3 +1,5 @@
+import { getRefreshBinaryEncodingEnabled } from '@/core/settings/appPreferences';
+import { decodeCbor } from '../cborDecode';
 import { ensureRefreshBaseURL, invalidateRefreshBaseURL } from '../client';
 import type { ResourceStreamClientMessage as ResourceStreamWireClientMessage } from '../types';
 import type { DoorbellDomain } from './resourceStreamDomains';
@@ -5,6 +7,9 @@ import { streamReconnectDelay } from './streamTiming';
 
 const RESOURCE_STREAM_PATH = '/api/v2/stream/resources';
 const RECONNECT_JITTER_FACTOR = 0.2;
+// With binary encoding on, server frames arrive as CBOR binary messages;
+// client requests stay JSON either way. The choice is read on each connect.
+const RESOURCE_STREAM_ENCODING = 'cbor';
 
 export type ResourceStreamClientMessage = Omit<
   ResourceStreamWireClientMessage,
@@ -18,7 +23,8 @@ export type ResourceStreamClientMessage = Omit<
 
 export type ResourceStreamConnectionDelegate = {
   handleConnectionOpen(clusterId: string): void;
-  handleMessage(clusterId: string, raw: string): void;
+  // raw is JSON text, or the already-decoded message for binary frames.
+  handleMessage(clusterId: string, raw: unknown): void;
   handleConnectionError(clusterId: string, message: string): void;
 };
 
@@ -46,8 +52,12 @@ export class ResourceStreamConnection {
       }
       const url = new URL(RESOURCE_STREAM_PATH, baseURL);
       url.protocol = url.protocol === 'https:' ? 'wss:' : 'ws:';
+      if (getRefreshBinaryEncodingEnabled()) {
+        url.searchParams.set('encoding', RESOURCE_STREAM_ENCODING);
+      }
 
       const socket = new WebSocket(url.toString());
+      socket.binaryType = 'arraybuffer';
       this.socket = socket;
       socket.onopen = () => this.handleOpen();
       socket.onmessage = (event) => this.handleMessage(event);
@@ -106,6 +116,17 @@ export class ResourceStreamConnection {
   }
 
   private handleMessage(event: MessageEvent): void {
+    if (event.data instanceof ArrayBuffer) {
+      let decoded: unknown;
+      try {
+        decoded = decodeCbor(event.data);
+      } catch (_err) {
+        console.error('Invalid resource stream payload');
+        return;
+      }
+      this.delegate.handleMessage('', decoded);
+      return;
+    }
     this.delegate.handleMessage('', event.data);
   }
 
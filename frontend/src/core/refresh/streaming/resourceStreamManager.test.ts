@@ -339,6 +339,96 @@ describe('ResourceStreamManager', () => {
     expect(manager.getHealthStatus('cluster-config', storeScope)).toBe('healthy');
   });
 
+  test('records the freshness reported by the subscribe ACK', async () => {
+    vi.useFakeTimers();
+    installWindowTimers();
+    const manager = new ResourceStreamManager();
+    const storeScope = buildClusterScope('cluster-a', '');
+
+    fetchSnapshotMock.mockResolvedValue({
+      snapshot: {
+        domain: 'cluster-config',
+        scope: '',
+        version: 1,
+        checksum: 'etag',
+        generatedAt: Date.now(),
+        sequence: 1,
+        payload: { rows: [] },
+        stats: { itemCount: 0, buildDurationMs: 0 },
+      },
+      notModified: false,
+    });
+
+    await manager.start('cluster-config', storeScope);
+    await flushPromises();
+
+    createdSockets[0].onopen?.(new Event('open'));
+    await vi.advanceTimersByTimeAsync(1100);
+    await flushPromises();
+
+    const freshness = {
+      lastSyncedAt: 1_000,
+      informersSynced: true,
+      degraded: true,
+      degradedReason: 'frozen',
+    };
+    manager.handleMessage(
+      'cluster-a',
+      JSON.stringify({
+        type: 'ACK',
+        domain: 'cluster-config',
+        scope: '',
+        clusterId: 'cluster-a',
+        freshness,
+      })
+    );
+    expect(getScopedDomainState('cluster-config', storeScope).freshness).toEqual(freshness);
+  });
+
+  test('records per-domain freshness carried on heartbeats', async () => {
+    vi.useFakeTimers();
+    installWindowTimers();
+    const manager = new ResourceStreamManager();
+    const storeScope = buildClusterScope('cluster-a', '');
+
+    fetchSnapshotMock.mockResolvedValue({
+      snapshot: {
+        domain: 'cluster-config',
+        scope: '',
+        version: 1,
+        checksum: 'etag',
+        generatedAt: Date.now(),
+        sequence: 1,
+        payload: { rows: [] },
+        stats: { itemCount: 0, buildDurationMs: 0 },
+      },
+      notModified: false,
+    });
+
+    await manager.start('cluster-config', storeScope);
+    await flushPromises();
+
+    const freshness = {
+      lastSyncedAt: 2_000,
+      informersSynced: true,
+      degraded: false,
+      lastEventAt: 1_500,
+      behindMs: 45_000,
+    };
+    manager.handleMessage(
+      'cluster-a',
+      JSON.stringify({
+        type: 'HEARTBEAT',
+        clusterId: 'cluster-a',
+        domainFreshness: [
+          { clusterId: 'cluster-a', domain: 'cluster-config', freshness },
+          { clusterId: 'cluster-b', domain: 'cluster-config', freshness: { ...freshness, behindMs: 1 } },
+        ],
+      })
+    );
+    expect(getScopedDomainState('cluster-config', storeScope).freshness).toEqual(freshness);
+  });
+
   // The inverse guard (found live: a backend without the namespaces selector
   // ignored/rejected the subscribe while the client claimed healthy and froze):
   // a subscription the server never confirms must NOT report healthy, so the
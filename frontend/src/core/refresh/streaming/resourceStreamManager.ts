@@ -18,6 +18,8 @@ import { stripClusterScope } from '../clusterScope';
 import { isPermissionDeniedStatus, resolvePermissionDeniedMessage } from '../permissionErrors';
 import { getScopedDomainState, setScopedDomainState } from '../store';
 import {
+  type DataFreshness,
+  type ResourceStreamDomainFreshness,
   RESOURCE_STREAM_MESSAGE_TYPES,
   RESOURCE_STREAM_SIGNALS,
   type ResourceStreamMessageType,
@@ -318,17 +320,24 @@ export class ResourceStreamManager {
     );
   }
 
-  handleMessage(clusterId: string, raw: string): void {
+  handleMessage(clusterId: string, raw: unknown): void {
     let parsed: ServerMessage | null = null;
-    try {
-      parsed = JSON.parse(raw) as ServerMessage;
-    } catch (_err) {
-      console.error('Invalid resource stream payload');
-      return;
+    if (typeof raw === 'string') {
+      try {
+        parsed = JSON.parse(raw) as ServerMessage;
+      } catch (_err) {
+        console.error('Invalid resource stream payload');
+        return;
+      }
+    } else {
+      parsed = raw as ServerMessage | null;
     }
     if (!parsed) {
       return;
     }
+    if (parsed.type === 'HEARTBEAT' && parsed.domainFreshness) {
+      this.recordHeartbeatFreshness(parsed.domainFreshness);
+    }
     const update = resolveUpdateMessage(parsed);
     if (!update) {
       return;
@@ -433,6 +442,9 @@ export class ResourceStreamManager {
         // back — a send alone proves nothing (found live: a backend without
         // the domain's selector left the client claiming healthy, frozen).
         this.markSubscriptionSynchronized(subscription);
+        if (resolvedUpdate.freshness) {
+          this.recordFreshness(subscription, resolvedUpdate.freshness);
+        }
         this.updateHealthForSubscription(subscription);
         return;
       case 'RESET':
@@ -1012,6 +1024,34 @@ export class ResourceStreamManager {
     this.clearStreamError(subscription.clusterId);
   }
 
+  // The ACK reports how current the backend's data for the scope is, so a
+  // stream over a degraded connection is not mistaken for live data.
+  private recordFreshness(subscription: StreamSubscription, freshness: DataFreshness): void {
+    this.forEachReportScope(subscription, (reportScope) => {
+      setScopedDomainState(subscription.domain, reportScope, (previous) => ({
+        ...previous,
+        freshness,
+        scope: reportScope,
+      }));
+    });
+  }
+
+  // Heartbeats carry each subscribed domain's freshness, so "events: 45s
+  // behind" keeps moving between ACKs.
+  private recordHeartbeatFreshness(entries: ResourceStreamDomainFreshness[]): void {
+    entries.forEach((entry) => {
+      if (!entry.freshness) {
+        return;
+      }
+      const freshness = entry.freshness;
+      this.subscriptions.forEach((subscription) => {
+        if (subscription.clusterId === entry.clusterId && subscription.domain === entry.domain) {
+          this.recordFreshness(subscription, freshness);
+        }
+      });
+    });
+  }
+
   private markResyncing(subscription: StreamSubscription): void {
     const message = RESYNC_MESSAGE;
     this.forEachReportScope(subscription, (reportScope) => {
@@ -168,6 +168,28 @@ export interface CatalogSnapshotPayload {
   firstBatchLatencyMs?: number;
 }
 
+export interface CategoryGroup {
+  category: string;
+  count: number;
+  kinds: Array<CategoryKindGroup> | null;
+}
+
+export interface CategoryKindGroup {
+  group: string;
+  version: string;
+  kind: string;
+  resource: string;
+  custom?: boolean;
+  items: Array<CatalogItem> | null;
+}
+
+export interface CategoryOverviewSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  namespace?: string;
+  categories: Array<CategoryGroup> | null;
+}
+
 export interface ClusterAttentionFinding {
   ref: CanonicalResourceRef;
   namespace?: string;
@@ -251,6 +273,7 @@ export interface ClusterConfigEntry {
   isDefault?: boolean;
   age: string;
   ageTimestamp?: number;
+  certificateNotAfter?: number;
 }
 
 export interface ClusterConfigSnapshotPayload {
@@ -288,9 +311,12 @@ export interface ClusterCustomEntry {
   ready?: boolean;
   observedGeneration?: number;
   conditions?: Array<ConditionFacts>;
+  health?: string;
+  healthReason?: string;
   age: string;
   labels?: Record<string, string>;
   annotations?: Record<string, string>;
+  volumeSnapshot?: VolumeSnapshotSummary;
 }
 
 export interface ClusterCustomSnapshotPayload {
@@ -314,6 +340,10 @@ export interface ClusterEventEntry {
   message: string;
   age: string;
   ageTimestamp: number;
+  count: number;
+  firstSeen: number;
+  lastSeen: number;
+  ratePerMinute?: number;
 }
 
 export interface ClusterEventsSnapshotPayload {
@@ -578,9 +608,24 @@ export interface CustomResourceSummary {
   ready?: boolean;
   observedGeneration?: number;
   conditions?: Array<ConditionFacts>;
+  health?: string;
+  healthReason?: string;
   age: string;
   labels?: Record<string, string>;
   annotations?: Record<string, string>;
+  istio?: IstioSummary;
+  volumeSnapshot?: VolumeSnapshotSummary;
+}
+
+export interface DataFreshness {
+  lastSyncedAt?: number;
+  informersSynced: boolean;
+  degraded: boolean;
+  degradedReason?: string;
+  offline?: boolean;
+  lastEventAt?: number;
+  lastListAt?: number;
+  behindMs?: number;
 }
 
 export interface DisplayRef {
@@ -604,6 +649,119 @@ export interface DrainNodeOptionsPayload {
   skipWaitForPodsToTerminate: boolean;
 }
 
+export interface ExternalSecret {
+  ref: ResourceRef;
+  ready: string;
+  reason?: string;
+  message?: string;
+  lastTransitionTime?: string;
+  lastError?: string;
+  store?: string;
+  refreshInterval?: string;
+  lastRefreshTime?: string;
+  syncedResourceVersion?: string;
+  creationPolicy?: string;
+  targetSecret: ResourceRef;
+  lastForceSync?: string;
+}
+
+export interface ExternalSecretStore {
+  ref: ResourceRef;
+  ready: string;
+  reason?: string;
+  message?: string;
+  lastTransitionTime?: string;
+  lastError?: string;
+  provider?: string;
+  refreshInterval?: number;
+  capabilities?: string;
+}
+
+export interface ExternalSecretsSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  sources: ExternalSecretsSources;
+  externalSecrets: Array<ExternalSecret> | null;
+  secretStores: Array<ExternalSecretStore> | null;
+  notReady: number;
+  warnings?: Array<string>;
+}
+
+export interface ExternalSecretsSources {
+  detected: boolean;
+  apiVersion?: string;
+  externalSecrets: boolean;
+  secretStores: boolean;
+  clusterSecretStores: boolean;
+}
+
+export interface FluxHelmRelease {
+  ref: ResourceRef;
+  ready: string;
+  reason?: string;
+  message?: string;
+  lastTransitionTime?: string;
+  suspended?: boolean;
+  reconciling?: boolean;
+  stalled?: boolean;
+  lastAppliedRevision?: string;
+  lastAttemptedRevision?: string;
+  lastHandledReconcileAt?: string;
+  failures?: Array<string>;
+  chart?: string;
+  chartVersion?: string;
+  source?: string;
+  releaseName?: string;
+  targetNamespace?: string;
+}
+
+export interface FluxKustomization {
+  ref: ResourceRef;
+  ready: string;
+  reason?: string;
+  message?: string;
+  lastTransitionTime?: string;
+  suspended?: boolean;
+  reconciling?: boolean;
+  stalled?: boolean;
+  lastAppliedRevision?: string;
+  lastAttemptedRevision?: string;
+  lastHandledReconcileAt?: string;
+  failures?: Array<string>;
+  source?: string;
+  path?: string;
+  targetNamespace?: string;
+  prune?: boolean;
+}
+
+export interface FluxSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  sources: FluxSources;
+  helmReleases: Array<FluxHelmRelease> | null;
+  kustomizations: Array<FluxKustomization> | null;
+  notReady: number;
+  suspended: number;
+  warnings?: Array<string>;
+}
+
+export interface FluxSources {
+  detected: boolean;
+  helmReleases: boolean;
+  kustomizations: boolean;
+}
+
+export interface IstioSummary {
+  hosts?: Array<string>;
+  gateways?: Array<string>;
+  routes?: Array<string>;
+  subsets?: Array<string>;
+  servers?: Array<string>;
+  tlsMode?: string;
+  mtlsMode?: string;
+  details: string;
+}
+
 export interface KindInfo {
   kind: string;
   namespaced: boolean;
@@ -642,6 +800,8 @@ export interface NamespaceAutoscalingSummary {
   min: number;
   max: number;
   current: number;
+  triggers?: Array<string>;
+  paused?: boolean;
   age: string;
   ageTimestamp?: number;
 }
@@ -678,6 +838,7 @@ export interface NamespaceConfigSummary {
   data: number;
   age: string;
   ageTimestamp?: number;
+  certificateNotAfter?: number;
 }
 
 export interface NamespaceCustomSnapshotPayload {
@@ -696,9 +857,13 @@ export interface NamespaceCustomSummary {
   ready?: boolean;
   observedGeneration?: number;
   conditions?: Array<ConditionFacts>;
+  health?: string;
+  healthReason?: string;
   age: string;
   labels?: Record<string, string>;
   annotations?: Record<string, string>;
+  istio?: IstioSummary;
+  volumeSnapshot?: VolumeSnapshotSummary;
 }
 
 export interface NamespaceEventSummary {
@@ -716,6 +881,10 @@ export interface NamespaceEventSummary {
   message: string;
   age: string;
   ageTimestamp: number;
+  count: number;
+  firstSeen: number;
+  lastSeen: number;
+  ratePerMinute?: number;
 }
 
 export interface NamespaceEventsSnapshotPayload {
@@ -840,6 +1009,8 @@ export interface NamespaceQuotaSummary {
   minAvailable?: string;
   maxUnavailable?: string;
   status?: QuotaStatus;
+  usedPercentage?: Record<string, number>;
+  highestUsedPercentage?: number;
 }
 
 export interface NamespaceQuotasSnapshotPayload {
@@ -941,6 +1112,8 @@ export interface NamespaceStorageSummary {
   statusPresentation?: string;
   statusReason?: string;
   storageClass: string;
+  resizeState?: string;
+  resizeMessage?: string;
   age: string;
   ageTimestamp?: number;
 }
@@ -970,6 +1143,26 @@ export interface NamespaceSummary {
   scopeStatus?: NamespaceScopeStatus;
 }
 
+export interface NamespaceUsage {
+  ref: ResourceRef;
+  pods: number;
+  cpuRequestsMilli: number;
+  cpuLimitsMilli: number;
+  memoryRequestsBytes: number;
+  memoryLimitsBytes: number;
+  cpuUsageMilli: number;
+  memoryUsageBytes: number;
+  objectCounts: Record<string, number> | null;
+}
+
+export interface NamespaceUsageSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  namespaces: Array<NamespaceUsage> | null;
+  metrics: PodMetricsInfo;
+  metricsState: NamespaceSignalState;
+}
+
 export interface NamespaceWorkloadSnapshotPayload {
   clusterId: string;
   clusterName: string;
@@ -1057,6 +1250,34 @@ export interface NodeMetricsInfo {
   failureCount: number;
 }
 
+export interface NodePackingNode {
+  ref: ResourceRef;
+  ready: boolean;
+  unschedulable: boolean;
+  allocatableCpuMilli: number;
+  allocatableMemoryBytes: number;
+  allocatablePods: number;
+  requestedCpuMilli: number;
+  requestedMemoryBytes: number;
+  pods: Array<NodePackingPod> | null;
+}
+
+export interface NodePackingPod {
+  namespace: string;
+  name: string;
+  owner?: string;
+  phase: string;
+  cpuRequestMilli: number;
+  memRequestBytes: number;
+}
+
+export interface NodePackingSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  nodes: Array<NodePackingNode> | null;
+  unscheduledPods?: Array<NodePackingPod>;
+}
+
 export interface NodePodMetric {
   namespace: string;
   name: string;
@@ -1175,6 +1396,44 @@ export interface ObjectMapStatus {
   reason?: string;
 }
 
+export interface ObjectReferencesSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  target: ObjectMapReference;
+  referrers: Array<ObjectReferrer> | null;
+  warnings?: Array<string>;
+}
+
+export interface ObjectReferrer {
+  ref: ObjectMapReference;
+  type: string;
+  label: string;
+  tracedBy?: string;
+}
+
+export interface ObjectStorageChainLink {
+  ref: ObjectMapReference;
+  status?: ObjectMapStatus;
+}
+
+export interface ObjectStorageChainPod {
+  ref: ObjectMapReference;
+  status?: ObjectMapStatus;
+  node?: string;
+}
+
+export interface ObjectStorageChainSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  target: ObjectMapReference;
+  claim?: ObjectStorageChainLink;
+  volume?: ObjectStorageChainLink;
+  storageClass?: ObjectStorageChainLink;
+  nodes: Array<ObjectStorageChainLink> | null;
+  pods: Array<ObjectStorageChainPod> | null;
+  warnings?: Array<string>;
+}
+
 export interface ObjectYAMLSnapshotPayload {
   clusterId: string;
   clusterName: string;
@@ -1246,6 +1505,62 @@ export interface PodSnapshotPayload {
   healthCounts: Record<string, number> | null;
 }
 
+export interface PolicyReportResourceCounts {
+  ref: ResourceRef;
+  fail: number;
+  warn: number;
+  error: number;
+}
+
+export interface PolicyReportSources {
+  policyReports: boolean;
+  clusterPolicyReports: boolean;
+  gatekeeper: boolean;
+}
+
+export interface PolicyReportViolation {
+  engine: string;
+  policy: string;
+  rule?: string;
+  result: string;
+  severity?: string;
+  message?: string;
+  enforcementAction?: string;
+  resource: ResourceRef;
+  report: ResourceRef;
+}
+
+export interface PolicyReportsSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  sources: PolicyReportSources;
+  violations: Array<PolicyReportViolation> | null;
+  resources: Array<PolicyReportResourceCounts> | null;
+  warnings?: Array<string>;
+}
+
+export interface ProblemPod {
+  ref: ResourceRef;
+  problem: string;
+  reason: string;
+  status: string;
+  node?: string;
+  ownerKind?: string;
+  ownerName?: string;
+  ready: string;
+  restarts: number;
+  age: string;
+  ageTimestamp?: number;
+}
+
+export interface ProblemPodsSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  pods: Array<ProblemPod> | null;
+  counts: Record<string, number> | null;
+  pendingThresholdSeconds: number;
+}
+
 export interface QuotaStatus {
   disruptionsAllowed: number;
   currentHealthy: number;
@@ -1455,6 +1770,12 @@ export interface ResourceStreamClientMessage {
   resumeToken?: string;
 }
 
+export interface ResourceStreamDomainFreshness {
+  clusterId: string;
+  domain: string;
+  freshness: DataFreshness | null;
+}
+
 export interface ResourceStreamServerMessage {
   type: ResourceStreamMessageType;
   clusterId?: string;
@@ -1469,6 +1790,16 @@ export interface ResourceStreamServerMessage {
   ref?: ResourceRef;
   error?: string;
   errorDetails?: RefreshPermissionDeniedStatus;
+  freshness?: DataFreshness;
+  domainFreshness?: Array<ResourceStreamDomainFreshness>;
+}
+
+export interface SnapshotDelta {
+  since: string;
+  rowsField: string;
+  upserted?: Array<unknown>;
+  removed?: Array<unknown>;
+  order?: Array<unknown>;
 }
 
 export interface SnapshotStats {
@@ -1577,6 +1908,90 @@ export interface TelemetrySummary {
   connection: TelemetryConnectionStats;
 }
 
+export interface VeleroBackup {
+  ref: ResourceRef;
+  phase?: string;
+  schedule?: string;
+  includedNamespaces?: Array<string>;
+  storageLocation?: string;
+  startTime?: string;
+  completionTime?: string;
+  expiration?: string;
+  itemsBackedUp?: number;
+  totalItems?: number;
+  errors?: number;
+  warnings?: number;
+  failureReason?: string;
+  validationErrors?: Array<string>;
+}
+
+export interface VeleroRestore {
+  ref: ResourceRef;
+  backupName?: string;
+  scheduleName?: string;
+  phase?: string;
+  includedNamespaces?: Array<string>;
+  startTime?: string;
+  completionTime?: string;
+  errors?: number;
+  warnings?: number;
+  failureReason?: string;
+  validationErrors?: Array<string>;
+}
+
+export interface VeleroSchedule {
+  ref: ResourceRef;
+  cron: string;
+  paused?: boolean;
+  phase?: string;
+  includedNamespaces?: Array<string>;
+  lastBackup?: string;
+  validationErrors?: Array<string>;
+}
+
+export interface VeleroSnapshotPayload {
+  clusterId: string;
+  clusterName: string;
+  sources: VeleroSources;
+  backups: Array<VeleroBackup> | null;
+  restores: Array<VeleroRestore> | null;
+  schedules: Array<VeleroSchedule> | null;
+  storageLocations: Array<VeleroStorageLocation> | null;
+  failedBackups: number;
+  failedRestores: number;
+  warnings?: Array<string>;
+}
+
+export interface VeleroSources {
+  detected: boolean;
+  namespace?: string;
+}
+
+export interface VeleroStorageLocation {
+  ref: ResourceRef;
+  provider?: string;
+  bucket?: string;
+  phase?: string;
+  default?: boolean;
+  lastValidationTime?: string;
+  message?: string;
+}
+
+export interface VolumeSnapshotSummary {
+  sourcePvc?: string;
+  sourceContent?: string;
+  snapshotClass?: string;
+  boundContent?: string;
+  readyToUse?: boolean;
+  restoreSize?: string;
+  creationTime?: string;
+  error?: string;
+  driver?: string;
+  deletionPolicy?: string;
+  isDefault?: boolean;
+  details: string;
+}
+
 export interface WorkloadResourceUsage {
   deployments: WorkloadTypeResourceUsage;
   daemonSets: WorkloadTypeResourceUsage;
@@ -1605,6 +2020,8 @@ export interface RefreshSnapshot<TPayload> {
   sequence: number;
   payload: TPayload;
   stats: SnapshotStats;
+  delta?: SnapshotDelta;
+  freshness?: DataFreshness;
 }
 
 export type ClusterNodeRow = ClusterNodeSnapshotEntry;
@@ -1621,6 +2038,14 @@ export const REFRESH_DOMAINS = [
   'cluster-crds',
   'cluster-custom',
   'cluster-events',
+  'cluster-node-packing',
+  'cluster-namespace-usage',
+  'cluster-problem-pods',
+  'cluster-policy-reports',
+  'cluster-velero',
+  'cluster-flux',
+  'cluster-external-secrets',
+  'cluster-categories',
   'cluster-rbac',
   'cluster-storage',
   'namespace-workloads',
@@ -1640,6 +2065,8 @@ export const REFRESH_DOMAINS = [
   'object-helm-values',
   'object-events',
   'object-map',
+  'object-references',
+  'object-storage-chain',
   'object-maintenance',
   'container-logs',
 ] as const;
@@ -1755,6 +2182,23 @@ const refreshSnapshotSchema: RefreshContractSchema = { kind: 'object', fields: {
     timeToFirstRowMs: { optional: true, schema: { kind: 'number' } },
     buildStartedAtUnix: { optional: true, schema: { kind: 'number' } },
   } } },
+  delta: { optional: true, schema: { kind: 'object', fields: {
+    since: { optional: false, schema: { kind: 'string' } },
+    rowsField: { optional: false, schema: { kind: 'string' } },
+    upserted: { optional: true, schema: { kind: 'array', items: { kind: 'unknown' } } },
+    removed: { optional: true, schema: { kind: 'array', items: { kind: 'unknown' } } },
+    order: { optional: true, schema: { kind: 'array', items: { kind: 'unknown' } } },
+  } } },
+  freshness: { optional: true, schema: { kind: 'object', fields: {
+    lastSyncedAt: { optional: true, schema: { kind: 'number' } },
+    informersSynced: { optional: false, schema: { kind: 'boolean' } },
+    degraded: { optional: false, schema: { kind: 'boolean' } },
+    degradedReason: { optional: true, schema: { kind: 'string' } },
+    offline: { optional: true, schema: { kind: 'boolean' } },
+    lastEventAt: { optional: true, schema: { kind: 'number' } },
+    lastListAt: { optional: true, schema: { kind: 'number' } },
+    behindMs: { optional: true, schema: { kind: 'number' } },
+  } } },
 } };
 
 export function assertRefreshSnapshotEnvelope<TPayload>(
@@ -1880,6 +2324,14 @@ export interface BackendDomainPayloadMap {
   'cluster-crds': ClusterCRDSnapshotPayload;
   'cluster-custom': ClusterCustomSnapshotPayload;
   'cluster-events': ClusterEventsSnapshotPayload;
+  'cluster-node-packing': NodePackingSnapshotPayload;
+  'cluster-namespace-usage': NamespaceUsageSnapshotPayload;
+  'cluster-problem-pods': ProblemPodsSnapshotPayload;
+  'cluster-policy-reports': PolicyReportsSnapshotPayload;
+  'cluster-velero': VeleroSnapshotPayload;
+  'cluster-flux': FluxSnapshotPayload;
+  'cluster-external-secrets': ExternalSecretsSnapshotPayload;
+  'cluster-categories': CategoryOverviewSnapshotPayload;
   'cluster-rbac': ClusterRBACSnapshotPayload;
   'cluster-storage': ClusterStorageSnapshotPayload;
   'namespace-workloads': NamespaceWorkloadSnapshotPayload;
@@ -1899,5 +2351,7 @@ export interface BackendDomainPayloadMap {
   'object-helm-values': ObjectHelmValuesSnapshotPayload;
   'object-events': ObjectEventsSnapshotPayload;
   'object-map': ObjectMapSnapshotPayload;
+  'object-references': ObjectReferencesSnapshotPayload;
+  'object-storage-chain': ObjectStorageChainSnapshotPayload;
   'object-maintenance': NodeMaintenanceSnapshotPayload;
 }
@@ -37,8 +37,10 @@ export interface PermissionDeniedStatus
 }
 
 // The backend owns the log-line wire fields. `_seq` is assigned by the
-// frontend reducer to provide stable rendering keys.
+// frontend reducer to provide stable rendering keys. `attempt` is only set on
+// entries fetched across a Job's attempts; the live stream never carries it.
 export interface ContainerLogsEntry extends ContainerLogsWireEntry {
+  attempt?: string;
   _seq?: number;
 }
 
@@ -140,6 +140,13 @@ const preferenceSchema = (overrides: Record<string, Partial<Record<string, unkno
       currentValue: true,
       runtimeSideEffect: true,
     },
+    {
+      key: 'refreshBinaryEncodingEnabled',
+      type: 'boolean',
+      defaultValue: false,
+      currentValue: false,
+      runtimeSideEffect: false,
+    },
     {
       key: 'metricsRefreshIntervalMs',
       type: 'integer',
@@ -373,6 +380,13 @@ const preferenceSchema = (overrides: Record<string, Partial<Record<string, unkno
       validation: '#rrggbb-or-empty',
       runtimeSideEffect: false,
     },
+    {
+      key: 'secretRevealEnabled',
+      type: 'boolean',
+      defaultValue: false,
+      currentValue: false,
+      runtimeSideEffect: false,
+    },
   ];
 
   return {
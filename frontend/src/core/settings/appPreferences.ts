@@ -48,6 +48,7 @@ export interface AppPreferences {
   exclusiveNamespaces: boolean;
   autoRefreshEnabled: boolean;
   refreshBackgroundClustersEnabled: boolean;
+  refreshBinaryEncodingEnabled: boolean;
   metricsRefreshIntervalMs: number;
   kubernetesClientQPS: number;
   kubernetesClientBurst: number;
@@ -76,6 +77,7 @@ export interface AppPreferences {
   accentColorDark: string;
   linkColorLight: string;
   linkColorDark: string;
+  secretRevealEnabled: boolean;
 }
 
 export type AppPreferenceKey = keyof AppPreferences;
@@ -139,6 +141,7 @@ interface AppSettingsPayload {
   exclusiveNamespaces?: boolean;
   autoRefreshEnabled?: boolean;
   refreshBackgroundClustersEnabled?: boolean;
+  refreshBinaryEncodingEnabled?: boolean;
   metricsRefreshIntervalMs?: number;
   kubernetesClientQPS?: number;
   kubernetesClientBurst?: number;
@@ -172,6 +175,7 @@ interface AppSettingsPayload {
   accentColorDark?: string;
   linkColorLight?: string;
   linkColorDark?: string;
+  secretRevealEnabled?: boolean;
 }
 
 const DEFAULT_METRICS_REFRESH_INTERVAL_MS = 5000;
@@ -214,6 +218,7 @@ const DEFAULT_PREFERENCES: AppPreferences = {
   exclusiveNamespaces: true,
   autoRefreshEnabled: true,
   refreshBackgroundClustersEnabled: true,
+  refreshBinaryEncodingEnabled: false,
   metricsRefreshIntervalMs: DEFAULT_METRICS_REFRESH_INTERVAL_MS,
   kubernetesClientQPS: KUBERNETES_CLIENT_QPS_DEFAULT,
   kubernetesClientBurst: KUBERNETES_CLIENT_BURST_DEFAULT,
@@ -233,6 +238,7 @@ const DEFAULT_PREFERENCES: AppPreferences = {
   accentColorDark: '',
   linkColorLight: '',
   linkColorDark: '',
+  secretRevealEnabled: false,
 
   // Used only before backend schema metadata is available.
   gridTablePersistenceMode: 'shared',
@@ -289,6 +295,9 @@ const FALLBACK_PREFERENCE_METADATA: {
     'boolean',
     { runtimeSideEffect: true }
   ),
+  refreshBinaryEncodingEnabled: createPreferenceMetadata('refreshBinaryEncodingEnabled', 'boolean', {
+    runtimeSideEffect: false,
+  }),
   metricsRefreshIntervalMs: createPreferenceMetadata('metricsRefreshIntervalMs', 'integer', {
     min: 1,
     runtimeSideEffect: true,
@@ -438,6 +447,9 @@ const FALLBACK_PREFERENCE_METADATA: {
     validation: '#rrggbb-or-empty',
     runtimeSideEffect: false,
   }),
+  secretRevealEnabled: createPreferenceMetadata('secretRevealEnabled', 'boolean', {
+    runtimeSideEffect: false,
+  }),
 };
 
 let preferenceCache: AppPreferences = { ...DEFAULT_PREFERENCES };
@@ -698,6 +710,9 @@ const emitPreferenceChanges = (previous: AppPreferences, next: AppPreferences):
       next.objPanelLogsTargetGlobalLimit
     );
   }
+  if (previous.secretRevealEnabled !== next.secretRevealEnabled) {
+    eventBus.emit('settings:secret-reveal-enabled', next.secretRevealEnabled);
+  }
   if (previous.gridTablePersistenceMode !== next.gridTablePersistenceMode) {
     eventBus.emit('gridtable:persistence-mode', next.gridTablePersistenceMode);
   }
@@ -954,6 +969,10 @@ export const hydrateAppPreferences = async (options?: {
       'refreshBackgroundClustersEnabled',
       backendSettings?.refreshBackgroundClustersEnabled
     ),
+    refreshBinaryEncodingEnabled: normalizeBooleanPreferenceValue(
+      'refreshBinaryEncodingEnabled',
+      backendSettings?.refreshBinaryEncodingEnabled
+    ),
     metricsRefreshIntervalMs: normalizeMetricsIntervalMs(backendSettings?.metricsRefreshIntervalMs),
     kubernetesClientQPS: normalizeKubernetesClientQPS(backendSettings?.kubernetesClientQPS),
     kubernetesClientBurst: normalizeKubernetesClientBurst(backendSettings?.kubernetesClientBurst),
@@ -1048,6 +1067,10 @@ export const hydrateAppPreferences = async (options?: {
       backendSettings?.linkColorLight
     ),
     linkColorDark: normalizeColorPreferenceValue('linkColorDark', backendSettings?.linkColorDark),
+    secretRevealEnabled: normalizeBooleanPreferenceValue(
+      'secretRevealEnabled',
+      backendSettings?.secretRevealEnabled
+    ),
   };
 
   hydrated = true;
@@ -1082,6 +1105,10 @@ export const getBackgroundRefreshEnabled = (): boolean => {
   return preferenceCache.refreshBackgroundClustersEnabled;
 };
 
+export const getRefreshBinaryEncodingEnabled = (): boolean => {
+  return preferenceCache.refreshBinaryEncodingEnabled;
+};
+
 export const getKubernetesClientQPS = (): number => {
   return preferenceCache.kubernetesClientQPS;
 };
@@ -1114,6 +1141,10 @@ export const getObjPanelLogsTargetGlobalLimit = (): number => {
   return preferenceCache.objPanelLogsTargetGlobalLimit;
 };
 
+export const getSecretRevealEnabled = (): boolean => {
+  return preferenceCache.secretRevealEnabled;
+};
+
 export const getGridTablePersistenceMode = (): GridTablePersistenceMode => {
   return preferenceCache.gridTablePersistenceMode;
 };
@@ -1239,6 +1270,11 @@ export const setExclusiveNamespaces = async (enabled: boolean): Promise<void> =>
   await optimisticPreferenceUpdate(mutation.updates, mutation.changes, mutation.options);
 };
 
+export const setSecretRevealEnabled = async (enabled: boolean): Promise<void> => {
+  const mutation = singlePreferenceMutation('secretRevealEnabled', enabled);
+  await optimisticPreferenceUpdate(mutation.updates, mutation.changes, mutation.options);
+};
+
 export const setAutoRefreshEnabled = (enabled: boolean): void => {
   commitPreferenceMutation(
     'Failed to persist auto-refresh preference:',
@@ -1253,6 +1289,13 @@ export const setBackgroundRefreshEnabled = (enabled: boolean): void => {
   );
 };
 
+export const setRefreshBinaryEncodingEnabled = (enabled: boolean): void => {
+  commitPreferenceMutation(
+    'Failed to persist binary refresh encoding preference:',
+    singlePreferenceMutation('refreshBinaryEncodingEnabled', enabled)
+  );
+};
+
 export const setObjPanelLogsBufferMaxSize = (size: number): void => {
   const normalized = normalizeObjPanelLogsBufferMaxSize(size);
   commitPreferenceMutation(
@@ -0,0 +1,29 @@
+/**
+ * frontend/src/core/settings/clusterClientLimits.ts
+ *
+ * Typed access to the per-cluster Kubernetes client limits (QPS, burst and
+ * request timeout). Zero values fall back to the app-wide preferences; the
+ * backend validates ranges and applies changes to the live clients.
+ */
+
+import type { backend } from '@wailsjs/go/models';
+import { GetClusterClientLimits, SetClusterClientLimits } from '@/core/backend-api';
+
+export type ClusterClientLimits = backend.ClusterClientLimits;
+
+export async function getClusterClientLimits(clusterId: string): Promise<ClusterClientLimits> {
+  if (!clusterId) {
+    throw new Error('clusterId is required');
+  }
+  return GetClusterClientLimits(clusterId);
+}
+
+export async function setClusterClientLimits(
+  clusterId: string,
+  limits: Pick<ClusterClientLimits, 'qps' | 'burst' | 'requestTimeoutSeconds'>
+): Promise<ClusterClientLimits> {
+  if (!clusterId) {
+    throw new Error('clusterId is required');
+  }
+  return SetClusterClientLimits(clusterId, limits.qps, limits.burst, limits.requestTimeoutSeconds);
+}
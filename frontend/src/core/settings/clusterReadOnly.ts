@@ -0,0 +1,23 @@
+/**
+ * frontend/src/core/settings/clusterReadOnly.ts
+ *
+ * Typed access to the per-cluster read-only flag. Enforcement is
+ * backend-owned: every mutating backend method rejects writes for a
+ * read-only cluster, so these wrappers only add the clusterId guard.
+ */
+
+import { GetClusterReadOnly, SetClusterReadOnly } from '@/core/backend-api';
+
+export async function getClusterReadOnly(clusterId: string): Promise<boolean> {
+  if (!clusterId) {
+    throw new Error('clusterId is required');
+  }
+  return (await GetClusterReadOnly(clusterId)) ?? false;
+}
+
+export async function setClusterReadOnly(clusterId: string, readOnly: boolean): Promise<void> {
+  if (!clusterId) {
+    throw new Error('clusterId is required');
+  }
+  await SetClusterReadOnly(clusterId, readOnly);
+}
@@ -0,0 +1,40 @@
+/**
+ * frontend/src/core/settings/protectedNamespaces.ts
+ *
+ * Typed access to the protected-namespaces check. Enforcement is
+ * backend-owned: destructive actions in a protected namespace are refused
+ * unless the request repeats the target's name as a typed confirmation, so
+ * the UI only uses this to ask for that confirmation.
+ */
+
+import { IsNamespaceProtected } from '@/core/backend-api';
+
+export interface ProtectedNamespaceTarget {
+  kind: string;
+  group?: string;
+  namespace?: string | null;
+  name: string;
+}
+
+/**
+ * The namespace whose protection guards the target: its own namespace, or
+ * its name when the target is a Namespace.
+ */
+export const guardingNamespaceFor = (target: ProtectedNamespaceTarget): string => {
+  const namespace = target.namespace?.trim() ?? '';
+  if (!namespace && !target.group && target.kind === 'Namespace') {
+    return target.name;
+  }
+  return namespace;
+};
+
+export async function isNamespaceProtected(namespace: string): Promise<boolean> {
+  if (!namespace) {
+    return false;
+  }
+  return (await IsNamespaceProtected(namespace)) ?? false;
+}
+
+/** Reports whether the backend refused an action for a missing typed confirmation. */
+export const isConfirmationRequiredError = (error: unknown): boolean =>
+  (error instanceof Error ? error.message : String(error)).includes('typed confirmation required');
@@ -0,0 +1,160 @@
+/**
+ * frontend/src/hooks/useDeepLinks.test.tsx
+ *
+ * Test suite for useDeepLinks.
+ * Covers taking the pending link at startup and on deeplink:open.
+ */
+
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { useDeepLinks } from './useDeepLinks';
+
+const mocks = vi.hoisted(() => ({
+  takePendingDeepLink: vi.fn(),
+  openKubeconfig: vi.fn(),
+  setSelectedNamespace: vi.fn(),
+  onNamespaceSelect: vi.fn(),
+  openWithObject: vi.fn(),
+  handleError: vi.fn(),
+  listeners: new Map<string, () => void>(),
+  kubeconfigsLoading: false,
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  TakePendingDeepLink: mocks.takePendingDeepLink,
+}));
+
+vi.mock('@wailsjs/runtime/runtime', () => ({
+  EventsOn: (event: string, callback: () => void) => {
+    mocks.listeners.set(event, callback);
+    return () => mocks.listeners.delete(event);
+  },
+}));
+
+vi.mock('@modules/kubernetes/config/KubeconfigContext', () => ({
+  useKubeconfig: () => ({
+    kubeconfigs: [{ path: '/kube/config', context: 'prod' }],
+    kubeconfigsLoading: mocks.kubeconfigsLoading,
+    openKubeconfig: mocks.openKubeconfig,
+  }),
+}));
+
+vi.mock('@modules/namespace/contexts/NamespaceContext', () => ({
+  useNamespace: () => ({ setSelectedNamespace: mocks.setSelectedNamespace }),
+}));
+
+vi.mock('@/core/contexts/ViewStateContext', () => ({
+  useViewState: () => ({ onNamespaceSelect: mocks.onNamespaceSelect }),
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => ({ openWithObject: mocks.openWithObject }),
+}));
+
+vi.mock('@utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handleError },
+}));
+
+const prodTarget = {
+  url: 'luxury-yacht://cluster/prod/namespace/web/Deployment/api',
+  link: { context: 'prod', namespace: 'web', kind: 'Deployment', name: 'api' },
+  clusterId: 'config:prod',
+  selection: '/kube/config:prod',
+  connected: true,
+  ref: {
+    clusterId: 'config:prod',
+    group: 'apps',
+    version: 'v1',
+    kind: 'Deployment',
+    namespace: 'web',
+    name: 'api',
+  },
+};
+
+describe('useDeepLinks', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  const Host = () => {
+    useDeepLinks();
+    return null;
+  };
+
+  const render = async () => {
+    await act(async () => {
+      root.render(<Host />);
+    });
+  };
+
+  beforeEach(() => {
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+    mocks.takePendingDeepLink.mockReset().mockResolvedValue(null);
+    mocks.openKubeconfig.mockReset().mockResolvedValue(undefined);
+    mocks.setSelectedNamespace.mockReset();
+    mocks.onNamespaceSelect.mockReset();
+    mocks.openWithObject.mockReset();
+    mocks.handleError.mockReset();
+    mocks.listeners.clear();
+    mocks.kubeconfigsLoading = false;
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  it('opens the link that launched the app', async () => {
+    mocks.takePendingDeepLink.mockResolvedValueOnce(prodTarget);
+    await render();
+
+    expect(mocks.openKubeconfig).toHaveBeenCalledWith('/kube/config:prod');
+    expect(mocks.setSelectedNamespace).toHaveBeenCalledWith('web', 'config:prod');
+    expect(mocks.onNamespaceSelect).toHaveBeenCalledWith('web');
+    expect(mocks.openWithObject).toHaveBeenCalledWith(
+      expect.objectContaining({ clusterId: 'config:prod', kind: 'Deployment', name: 'api' })
+    );
+  });
+
+  it('takes the pending link on each deeplink:open event', async () => {
+    await render();
+    expect(mocks.takePendingDeepLink).toHaveBeenCalledTimes(1);
+    expect(mocks.openKubeconfig).not.toHaveBeenCalled();
+
+    mocks.takePendingDeepLink.mockResolvedValueOnce({
+      ...prodTarget,
+      link: { context: 'prod' },
+      ref: undefined,
+    });
+    await act(async () => {
+      mocks.listeners.get('deeplink:open')?.();
+    });
+
+    expect(mocks.takePendingDeepLink).toHaveBeenCalledTimes(2);
+    expect(mocks.openKubeconfig).toHaveBeenCalledWith('/kube/config:prod');
+    expect(mocks.onNamespaceSelect).not.toHaveBeenCalled();
+    expect(mocks.openWithObject).not.toHaveBeenCalled();
+  });
+
+  it('reports a link that cannot be resolved', async () => {
+    mocks.takePendingDeepLink.mockRejectedValueOnce('kubeconfig context "gone" not found');
+    await render();
+
+    expect(mocks.handleError).toHaveBeenCalledWith(
+      'kubeconfig context "gone" not found',
+      { context: 'openDeepLink' },
+      'Failed to open link'
+    );
+    expect(mocks.openKubeconfig).not.toHaveBeenCalled();
+  });
+
+  it('waits for the kubeconfigs to load', async () => {
+    mocks.kubeconfigsLoading = true;
+    await render();
+
+    expect(mocks.takePendingDeepLink).not.toHaveBeenCalled();
+    expect(mocks.listeners.has('deeplink:open')).toBe(false);
+  });
+});
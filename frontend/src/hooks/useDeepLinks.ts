@@ -0,0 +1,61 @@
+/**
+ * frontend/src/hooks/useDeepLinks.ts
+ *
+ * Hook for useDeepLinks.
+ * Opens luxury-yacht:// links the backend queued: one that launched the app,
+ * and each later one announced with the deeplink:open event.
+ */
+
+import { useKubeconfig } from '@modules/kubernetes/config/KubeconfigContext';
+import { useNamespace } from '@modules/namespace/contexts/NamespaceContext';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import { buildRequiredObjectReference } from '@shared/utils/objectIdentity';
+import { errorHandler } from '@utils/errorHandler';
+import { EventsOn } from '@wailsjs/runtime/runtime';
+import { useEffect, useEffectEvent } from 'react';
+import { TakePendingDeepLink } from '@/core/backend-api';
+import { useViewState } from '@/core/contexts/ViewStateContext';
+
+/**
+ * Takes the pending deep link and navigates to it: opens (or switches to) the
+ * linked cluster, selects the namespace, and opens the object when the
+ * backend found it in the catalog. The backend holds the link until it is
+ * taken, so waiting for the kubeconfigs to load loses nothing.
+ */
+export function useDeepLinks(): void {
+  const { kubeconfigs, kubeconfigsLoading, openKubeconfig } = useKubeconfig();
+  const { setSelectedNamespace } = useNamespace();
+  const { onNamespaceSelect } = useViewState();
+  const { openWithObject } = useObjectPanel();
+  const ready = !kubeconfigsLoading && kubeconfigs.length > 0;
+
+  const openPendingDeepLink = useEffectEvent(async () => {
+    try {
+      const target = await TakePendingDeepLink();
+      if (!target) {
+        return;
+      }
+      await openKubeconfig(target.selection);
+      const namespace = target.link.namespace;
+      if (namespace) {
+        setSelectedNamespace(namespace, target.clusterId);
+        onNamespaceSelect(namespace);
+      }
+      if (target.ref) {
+        openWithObject(buildRequiredObjectReference(target.ref));
+      }
+    } catch (error) {
+      errorHandler.handle(error, { context: 'openDeepLink' }, 'Failed to open link');
+    }
+  });
+
+  useEffect(() => {
+    if (!ready) {
+      return;
+    }
+    void openPendingDeepLink();
+    return EventsOn('deeplink:open', () => {
+      void openPendingDeepLink();
+    });
+  }, [ready]);
+}
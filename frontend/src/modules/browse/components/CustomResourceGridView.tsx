@@ -39,9 +39,24 @@ export type CustomResourceGridRow = CatalogBackedCustomResourceRow;
 
 type CatalogRowsResult = ReturnType<typeof useCatalogBackedCustomResourceRows>;
 
+// The backend infers a custom resource's health from its conditions, phase and
+// status printer columns; these map it onto the shared status text styles.
+const HEALTH_LABELS: Record<string, string> = {
+  healthy: 'Healthy',
+  progressing: 'Progressing',
+  degraded: 'Degraded',
+  unknown: 'Unknown',
+};
+const HEALTH_PRESENTATIONS: Record<string, string> = {
+  healthy: 'ready',
+  progressing: 'progressing',
+  degraded: 'error',
+  unknown: 'unknown',
+};
+
 /**
  * useCustomResourceGridParts wires the scope-independent pieces: row/CRD click
- * handlers, the row key, the kind/name/CRD/status/age columns, object actions,
+ * handlers, the row key, the kind/name/CRD/status/health/age columns, object actions,
  * and the context menu. `kindFallback` reproduces NsViewCustom's display
  * fallback for rows with no kind; the cluster view passes none.
  */
@@ -172,6 +187,17 @@ export function useCustomResourceGridParts({ kindFallback }: { kindFallback?: st
           getClassName: (resource) => backendStatusTextClass(resource.statusPresentation),
         }
       ),
+      cf.createTextColumn<CustomResourceGridRow>(
+        'health',
+        'Health',
+        (resource) => HEALTH_LABELS[resource.health ?? ''] ?? '-',
+        {
+          sortable: false,
+          getClassName: (resource) =>
+            backendStatusTextClass(HEALTH_PRESENTATIONS[resource.health ?? '']),
+          getTitle: (resource) => resource.healthReason || undefined,
+        }
+      ),
       cf.createAgeColumn(),
     ],
     [
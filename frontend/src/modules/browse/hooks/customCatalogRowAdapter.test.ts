@@ -128,11 +128,15 @@ describe('customCatalogRowAdapter', () => {
         name: 'shared-pg',
       },
       status: 'Ready',
+      health: 'degraded',
+      healthReason: 'Ready=False (ReconcileFailed)',
       labels: { tier: 'shared' },
     });
 
     expect(normalized).toMatchObject({
       status: 'Ready',
+      health: 'degraded',
+      healthReason: 'Ready=False (ReconcileFailed)',
       labels: { tier: 'shared' },
       ref: {
         clusterId: 'cluster-a',
@@ -147,6 +147,8 @@ export const normalizeHydratedCustomRow = (row: unknown): CatalogBackedCustomRes
     conditions: Array.isArray(record.conditions)
       ? (record.conditions as CatalogBackedCustomResourceRow['conditions'])
       : undefined,
+    health: optionalString(record.health),
+    healthReason: optionalString(record.healthReason),
     age: optionalString(record.age),
     ageTimestamp: optionalNumber(record.ageTimestamp),
     creationTimestamp: optionalString(record.creationTimestamp),
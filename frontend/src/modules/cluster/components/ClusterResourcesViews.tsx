@@ -10,10 +10,13 @@ import ClusterViewConfig from '@modules/cluster/components/ClusterViewConfig';
 import ClusterViewCRDs from '@modules/cluster/components/ClusterViewCRDs';
 import ClusterViewCustom from '@modules/cluster/components/ClusterViewCustom';
 import ClusterViewEvents from '@modules/cluster/components/ClusterViewEvents';
+import ClusterViewFlux from '@modules/cluster/components/ClusterViewFlux';
+import ClusterViewGitDrift from '@modules/cluster/components/ClusterViewGitDrift';
 import ClusterViewNamespaces from '@modules/cluster/components/ClusterViewNamespaces';
 import ClusterViewNodes from '@modules/cluster/components/ClusterViewNodes';
 import ClusterViewRBAC from '@modules/cluster/components/ClusterViewRBAC';
 import ClusterViewStorage from '@modules/cluster/components/ClusterViewStorage';
+import ClusterViewVelero from '@modules/cluster/components/ClusterViewVelero';
 import type { ClusterViewType } from '@ui/navigation/types';
 import React from 'react';
 
@@ -87,6 +90,12 @@ function ClusterResourcesViews({
         return <ClusterViewRBAC error={rbacError} />;
       case 'storage':
         return <ClusterViewStorage error={storageError} />;
+      case 'git-drift':
+        return <ClusterViewGitDrift />;
+      case 'flux':
+        return <ClusterViewFlux />;
+      case 'velero':
+        return <ClusterViewVelero />;
       default:
         return null;
     }
@@ -44,6 +44,7 @@ const configSpec: AggregatedResourceGridViewSpec<ConfigData> = {
       onAltClick: identity.navigate,
       getClassName: () => 'object-panel-link',
     }),
+    cf.createCertificateExpiryColumn<ConfigData>(),
     cf.createAgeColumn(),
   ],
 };
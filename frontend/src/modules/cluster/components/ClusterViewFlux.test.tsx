@@ -0,0 +1,230 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewFlux.test.tsx
+ */
+
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import type { FluxSnapshotPayload } from '@/core/refresh/types';
+import ClusterViewFlux, { buildFluxRows, type FluxRow, fluxStatus } from './ClusterViewFlux';
+
+const mocks = vi.hoisted(() => ({
+  reconcile: vi.fn(),
+  suspend: vi.fn(),
+  resume: vi.fn(),
+  refresh: vi.fn(),
+  handleError: vi.fn(),
+  domainParams: { current: null as Record<string, unknown> | null },
+  domainState: { current: { status: 'ready', data: null } as Record<string, unknown> },
+  tableParams: { current: null as Record<string, unknown> | null },
+  tableProps: { current: null as Record<string, unknown> | null },
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  ReconcileFluxObject: mocks.reconcile,
+  SuspendFluxObject: mocks.suspend,
+  ResumeFluxObject: mocks.resume,
+}));
+
+vi.mock('@/core/data-access', () => ({
+  useRefreshDomainHandle: (params: Record<string, unknown>) => {
+    mocks.domainParams.current = params;
+    return { state: mocks.domainState.current, refresh: mocks.refresh };
+  },
+}));
+
+vi.mock('@/utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handleError },
+}));
+
+vi.mock('@modules/kubernetes/config/KubeconfigContext', () => ({
+  useKubeconfig: () => ({ selectedClusterId: 'cluster-a', selectedClusterName: 'Cluster A' }),
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => ({ openWithObject: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useNavigateToView', () => ({
+  useNavigateToView: () => ({ navigateToView: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useObjectActionController', () => ({
+  useObjectActionController: () => ({
+    getMenuItems: () => [{ actionId: 'object-open', label: 'Open' }],
+    modals: null,
+  }),
+}));
+
+vi.mock('@/hooks/useShortNames', () => ({ useShortNames: () => false }));
+
+vi.mock('@shared/components/tables/persistence/useGridTablePersistence', () => ({
+  useGridTablePersistence: () => ({ hydrated: true }),
+}));
+
+vi.mock('@modules/resource-grid/useResourceGridTable', () => ({
+  useClusterResourceGridTable: (params: Record<string, unknown>) => {
+    mocks.tableParams.current = params;
+    return { gridTableProps: { keyExtractor: params.keyExtractor }, favModal: null };
+  },
+}));
+
+vi.mock('@modules/resource-grid/ResourceInventoryTable', () => ({
+  default: (props: Record<string, unknown>) => {
+    mocks.tableProps.current = props;
+    return <div data-testid="flux-table" />;
+  },
+}));
+
+const ref = (kind: string, name: string) => ({
+  clusterId: 'cluster-a',
+  group: kind === 'HelmRelease' ? 'helm.toolkit.fluxcd.io' : 'kustomize.toolkit.fluxcd.io',
+  version: 'v2',
+  kind,
+  namespace: 'flux-system',
+  name,
+});
+
+const payload: FluxSnapshotPayload = {
+  clusterId: 'cluster-a',
+  clusterName: 'Cluster A',
+  sources: { detected: true, helmReleases: true, kustomizations: true },
+  helmReleases: [
+    {
+      ref: ref('HelmRelease', 'podinfo'),
+      ready: 'True',
+      chart: 'podinfo',
+      chartVersion: '6.5.0',
+      source: 'HelmRepository/podinfo',
+      lastAppliedRevision: '6.5.0',
+    },
+  ],
+  kustomizations: [
+    {
+      ref: ref('Kustomization', 'apps'),
+      ready: 'False',
+      reason: 'BuildFailed',
+      suspended: true,
+      path: './apps',
+      source: 'GitRepository/flux-system',
+      failures: ['kustomization path not found'],
+    },
+  ],
+  notReady: 1,
+  suspended: 1,
+};
+
+const rows = () => (mocks.tableParams.current?.data ?? []) as FluxRow[];
+const flushPromises = async () => {
+  for (let i = 0; i < 5; i += 1) {
+    await Promise.resolve();
+  }
+};
+const menuFor = (row: FluxRow) =>
+  (mocks.tableProps.current?.getCustomContextMenuItems as (row: FluxRow) => ContextMenuItem[])(
+    row
+  );
+
+describe('ClusterViewFlux', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.reconcile.mockReset().mockResolvedValue(undefined);
+    mocks.suspend.mockReset().mockResolvedValue(undefined);
+    mocks.resume.mockReset().mockResolvedValue(undefined);
+    mocks.refresh.mockReset().mockResolvedValue({ status: 'executed' });
+    mocks.handleError.mockReset();
+    mocks.domainState.current = { status: 'ready', data: payload };
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async () => {
+    await act(async () => {
+      root.render(<ClusterViewFlux />);
+      await Promise.resolve();
+    });
+  };
+
+  it('lists releases and kustomizations with their chart or path', () => {
+    const built = buildFluxRows(payload.helmReleases, payload.kustomizations);
+    expect(built.map(({ kind, name, target }) => `${kind}/${name} ${target}`)).toEqual([
+      'HelmRelease/podinfo podinfo@6.5.0',
+      'Kustomization/apps ./apps',
+    ]);
+    expect(built[1].message).toBe('kustomization path not found');
+    expect(built.map((row) => fluxStatus(row).label)).toEqual(['Ready', 'Suspended']);
+  });
+
+  it('reads the cluster-flux domain for the selected cluster', async () => {
+    await render();
+
+    expect(mocks.domainParams.current).toMatchObject({
+      domain: 'cluster-flux',
+      scope: 'cluster-a|',
+      enabled: true,
+    });
+    expect(rows().map((row) => row.name)).toEqual(['podinfo', 'apps']);
+  });
+
+  it('says when Flux is not installed', async () => {
+    mocks.domainState.current = {
+      status: 'ready',
+      data: { ...payload, sources: { detected: false }, helmReleases: null, kustomizations: null },
+    };
+    await render();
+
+    expect(mocks.tableProps.current?.emptyMessage).toBe('Flux is not installed in this cluster');
+  });
+
+  it('reconciles, suspends and resumes through the backend, then refreshes', async () => {
+    await render();
+    const [release, kustomization] = rows();
+
+    const releaseMenu = menuFor(release);
+    expect(releaseMenu.slice(0, 2).map((item) => item.label)).toEqual(['Reconcile', 'Suspend']);
+    expect(releaseMenu.map((item) => item.label)).toContain('Open');
+    await act(async () => {
+      releaseMenu[0].onClick?.();
+      await flushPromises();
+    });
+    expect(mocks.reconcile).toHaveBeenCalledWith(
+      'cluster-a',
+      'HelmRelease',
+      'flux-system',
+      'podinfo'
+    );
+    expect(mocks.refresh).toHaveBeenCalledWith('user');
+
+    await act(async () => {
+      releaseMenu[1].onClick?.();
+      await flushPromises();
+    });
+    expect(mocks.suspend).toHaveBeenCalledWith(
+      'cluster-a',
+      'HelmRelease',
+      'flux-system',
+      'podinfo'
+    );
+
+    const kustomizationMenu = menuFor(kustomization);
+    expect(kustomizationMenu[0]).toMatchObject({ label: 'Reconcile', disabled: true });
+    mocks.resume.mockRejectedValue(new Error('forbidden'));
+    await act(async () => {
+      kustomizationMenu[1].onClick?.();
+      await flushPromises();
+    });
+    expect(mocks.resume).toHaveBeenCalledWith('cluster-a', 'Kustomization', 'flux-system', 'apps');
+    expect(mocks.handleError).toHaveBeenCalledWith(expect.any(Error), {
+      action: 'resumeFluxObject',
+    });
+  });
+});
@@ -0,0 +1,313 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewFlux.tsx
+ *
+ * Flux HelmReleases and Kustomizations from the cluster-flux refresh domain, with their Ready
+ * state and last applied revision. Each can be reconciled, suspended or resumed from its menu.
+ */
+
+import { buildClusterScope } from '@core/refresh/clusterScope';
+import { useKubeconfig } from '@modules/kubernetes/config/KubeconfigContext';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import { boundedRowsSource } from '@modules/resource-grid/boundedRowsSource';
+import ResourceInventoryTable from '@modules/resource-grid/ResourceInventoryTable';
+import { useResourceGridObjectIdentity } from '@modules/resource-grid/useResourceGridObjectIdentity';
+import { useClusterResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import * as cf from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import { useGridTablePersistence } from '@shared/components/tables/persistence/useGridTablePersistence';
+import { useNavigateToView } from '@shared/hooks/useNavigateToView';
+import { useObjectActionController } from '@shared/hooks/useObjectActionController';
+import { useCallback, useMemo } from 'react';
+import { ReconcileFluxObject, ResumeFluxObject, SuspendFluxObject } from '@/core/backend-api';
+import { useRefreshDomainHandle } from '@/core/data-access';
+import type { FluxHelmRelease, FluxKustomization, ResourceRef } from '@/core/refresh/types';
+import { useShortNames } from '@/hooks/useShortNames';
+import { errorHandler } from '@/utils/errorHandler';
+import { getDisplayKind } from '@/utils/kindAliasMap';
+
+export interface FluxRow {
+  ref: ResourceRef;
+  kind: string;
+  name: string;
+  namespace: string;
+  ready: string;
+  reason?: string;
+  message?: string;
+  suspended: boolean;
+  reconciling: boolean;
+  stalled: boolean;
+  revision: string;
+  source: string;
+  target: string;
+  age?: string;
+  ageTimestamp?: number;
+}
+
+interface FluxStatus {
+  label: string;
+  variant: StatusChipVariant;
+}
+
+const toRow = (object: FluxHelmRelease | FluxKustomization, target: string): FluxRow => {
+  const since = object.lastTransitionTime ? Date.parse(object.lastTransitionTime) : Number.NaN;
+  return {
+    ref: object.ref,
+    kind: object.ref.kind,
+    name: object.ref.name ?? '',
+    namespace: object.ref.namespace ?? '',
+    ready: object.ready,
+    reason: object.reason,
+    message: object.message || object.failures?.join('; '),
+    suspended: Boolean(object.suspended),
+    reconciling: Boolean(object.reconciling),
+    stalled: Boolean(object.stalled),
+    revision: object.lastAppliedRevision || object.lastAttemptedRevision || '',
+    source: object.source ?? '',
+    target,
+    ageTimestamp: Number.isFinite(since) ? since : undefined,
+  };
+};
+
+// buildFluxRows lists HelmReleases then Kustomizations. A release's target is its chart and
+// version; a Kustomization's is its path.
+export const buildFluxRows = (
+  helmReleases: FluxHelmRelease[] | null | undefined,
+  kustomizations: FluxKustomization[] | null | undefined
+): FluxRow[] => [
+  ...(helmReleases ?? []).map((release) =>
+    toRow(release, [release.chart, release.chartVersion].filter(Boolean).join('@'))
+  ),
+  ...(kustomizations ?? []).map((kustomization) => toRow(kustomization, kustomization.path ?? '')),
+];
+
+// fluxStatus follows the flux CLI: suspension wins, then a stalled or in-progress reconcile, then
+// the Ready condition.
+export const fluxStatus = (row: FluxRow): FluxStatus => {
+  if (row.suspended) {
+    return { label: 'Suspended', variant: 'info' };
+  }
+  if (row.stalled) {
+    return { label: 'Stalled', variant: 'unhealthy' };
+  }
+  if (row.reconciling) {
+    return { label: 'Reconciling', variant: 'info' };
+  }
+  if (row.ready === 'True') {
+    return { label: 'Ready', variant: 'healthy' };
+  }
+  if (row.ready === 'False') {
+    return { label: row.reason || 'Not ready', variant: 'unhealthy' };
+  }
+  return { label: 'Unknown', variant: 'warning' };
+};
+
+export default function ClusterViewFlux() {
+  const { selectedClusterId, selectedClusterName } = useKubeconfig();
+  const { openWithObject } = useObjectPanel();
+  const { navigateToView } = useNavigateToView();
+  const useShortResourceNames = useShortNames();
+  const scope = selectedClusterId ? buildClusterScope(selectedClusterId, '') : null;
+
+  const handleFetchError = useCallback((error: unknown) => {
+    errorHandler.handle(error instanceof Error ? error : new Error(String(error)), {
+      source: 'cluster-flux-fetch',
+    });
+  }, []);
+  const { state, refresh } = useRefreshDomainHandle({
+    domain: 'cluster-flux',
+    scope,
+    enabled: Boolean(scope),
+    preserveState: true,
+    fetchOnEnable: scope ? 'startup' : false,
+    onFetchError: handleFetchError,
+  });
+  const payload = state.data;
+  const rows = useMemo(
+    () => buildFluxRows(payload?.helmReleases, payload?.kustomizations),
+    [payload]
+  );
+
+  const getObject = useCallback(
+    (row: FluxRow) => ({ ...row.ref, clusterName: selectedClusterName }),
+    [selectedClusterName]
+  );
+  const identity = useResourceGridObjectIdentity<FluxRow>({
+    fallbackClusterId: selectedClusterId,
+    getObject,
+    openWithObject,
+    navigateToView,
+  });
+
+  const columns = useMemo<GridColumnDefinition<FluxRow>[]>(() => {
+    const result: GridColumnDefinition<FluxRow>[] = [
+      cf.createKindColumn<FluxRow>({
+        getKind: (row) => row.kind,
+        getDisplayText: (row) => getDisplayKind(row.kind, useShortResourceNames),
+        onClick: identity.open,
+        onAltClick: identity.navigate,
+      }),
+      cf.createTextColumn('name', 'Name', (row) => row.name, {
+        onClick: identity.open,
+        onAltClick: identity.navigate,
+        getClassName: () => 'object-panel-link',
+      }),
+      cf.createTextColumn('namespace', 'Namespace', (row) => row.namespace || '-'),
+      {
+        key: 'status',
+        header: 'Status',
+        sortable: true,
+        sortValue: (row) => fluxStatus(row).label,
+        render: (row) => {
+          const status = fluxStatus(row);
+          return (
+            <StatusChip variant={status.variant} tooltip={row.message || undefined}>
+              {status.label}
+            </StatusChip>
+          );
+        },
+      },
+      cf.createTextColumn('revision', 'Revision', (row) => row.revision || '-'),
+      cf.createTextColumn('source', 'Source', (row) => row.source || '-'),
+      cf.createTextColumn('target', 'Chart / Path', (row) => row.target || '-'),
+      cf.createTextColumn('message', 'Message', (row) => row.message || '-', {
+        getTitle: (row) => row.message ?? '',
+      }),
+      cf.createAgeColumn<FluxRow>('age', 'Since'),
+    ];
+    cf.applyColumnSizing(result, {
+      kind: { autoWidth: true },
+      name: { width: 220 },
+      namespace: { width: 160 },
+      status: { autoWidth: true },
+      revision: { width: 200 },
+      source: { width: 200 },
+      target: { width: 200 },
+      message: { width: 320 },
+      age: { autoWidth: true },
+    });
+    return result;
+  }, [identity.navigate, identity.open, useShortResourceNames]);
+
+  const objectActions = useObjectActionController({
+    context: 'gridtable',
+    onOpen: (object) => openWithObject(object),
+    onOpenObjectMap: (object) => openWithObject(object, { initialTab: 'map' }),
+  });
+
+  const runFluxAction = useCallback(
+    async (
+      action: 'reconcile' | 'suspend' | 'resume',
+      run: typeof ReconcileFluxObject,
+      row: FluxRow
+    ) => {
+      try {
+        await run(selectedClusterId, row.kind, row.namespace, row.name);
+        await refresh('user');
+      } catch (error) {
+        errorHandler.handle(error, { action: `${action}FluxObject` });
+      }
+    },
+    [refresh, selectedClusterId]
+  );
+
+  const getCustomContextMenuItems = useCallback(
+    (row: FluxRow): ContextMenuItem[] => [
+      {
+        actionId: 'flux-reconcile',
+        label: 'Reconcile',
+        disabled: row.suspended,
+        disabledReason: row.suspended ? 'Resume it first' : undefined,
+        onClick: () => void runFluxAction('reconcile', ReconcileFluxObject, row),
+      },
+      row.suspended
+        ? {
+            actionId: 'flux-resume',
+            label: 'Resume',
+            onClick: () => void runFluxAction('resume', ResumeFluxObject, row),
+          }
+        : {
+            actionId: 'flux-suspend',
+            label: 'Suspend',
+            onClick: () => void runFluxAction('suspend', SuspendFluxObject, row),
+          },
+      { divider: true },
+      ...objectActions.getMenuItems(identity.ref(row)),
+    ],
+    [identity, objectActions, runFluxAction]
+  );
+
+  const persistence = useGridTablePersistence({
+    viewId: 'cluster-flux',
+    clusterIdentity: selectedClusterId,
+    isNamespaceScoped: false,
+    columns,
+    data: rows,
+    keyExtractor: identity.key,
+    enabled: Boolean(selectedClusterId),
+  });
+
+  const warnings = payload?.warnings ?? [];
+  const mode = warnings.length > 0 ? 'Local Partial' : 'Local Complete';
+  const { gridTableProps, favModal } = useClusterResourceGridTable({
+    viewId: 'cluster-flux',
+    tableMode: mode,
+    data: rows,
+    columns,
+    keyExtractor: identity.key,
+    objectIdentity: identity,
+    persistenceOverride: persistence,
+    defaultSortKey: 'status',
+    defaultSortDirection: 'asc',
+    diagnosticsLabel: 'Flux',
+    showKindDropdown: true,
+    showNamespaceFilters: true,
+    filterAccessors: {
+      getSearchText: (row) => [
+        row.kind,
+        row.name,
+        row.namespace,
+        fluxStatus(row).label,
+        row.revision,
+        row.source,
+        row.target,
+        row.message ?? '',
+      ],
+    },
+  });
+
+  const source = boundedRowsSource({
+    rows,
+    loading: !payload && (state.status === 'loading' || state.status === 'initialising'),
+    loaded: Boolean(payload),
+    error: payload ? null : (state.error ?? null),
+    mode,
+    partialLabel: warnings.join('\n'),
+    cacheKey: `cluster-flux:${selectedClusterId}`,
+  });
+
+  return (
+    <>
+      <ResourceInventoryTable
+        source={source}
+        gridTableProps={gridTableProps}
+        columns={columns}
+        spinnerMessage="Loading Flux objects..."
+        emptyMessage={
+          payload && !payload.sources.detected
+            ? 'Flux is not installed in this cluster'
+            : 'No HelmReleases or Kustomizations found'
+        }
+        diagnosticsLabel="Flux"
+        diagnosticsMode="local"
+        onRowClick={identity.open}
+        enableContextMenu
+        getCustomContextMenuItems={getCustomContextMenuItems}
+        favModal={favModal}
+        useShortNames={useShortResourceNames}
+      />
+      {objectActions.modals}
+    </>
+  );
+}
@@ -0,0 +1,218 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewGitDrift.test.tsx
+ */
+
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import ClusterViewGitDrift, { buildGitDriftRows, type GitDriftRow } from './ClusterViewGitDrift';
+
+const mocks = vi.hoisted(() => ({
+  getReports: vi.fn(),
+  getSources: vi.fn(),
+  checkGitDrift: vi.fn(),
+  handleError: vi.fn(),
+  getMenuItems: vi.fn(() => [{ actionId: 'object-open', label: 'Open' }]),
+  eventHandlers: new Map<string, (...args: unknown[]) => void>(),
+  tableParams: { current: null as Record<string, unknown> | null },
+  tableProps: { current: null as Record<string, unknown> | null },
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  GetGitDriftReports: mocks.getReports,
+  GetGitDriftSources: mocks.getSources,
+  CheckGitDrift: mocks.checkGitDrift,
+  SetGitDriftSources: vi.fn(),
+}));
+
+vi.mock('@wailsjs/runtime/runtime', () => ({
+  EventsOn: (name: string, handler: (...args: unknown[]) => void) => {
+    mocks.eventHandlers.set(name, handler);
+    return () => mocks.eventHandlers.delete(name);
+  },
+}));
+
+vi.mock('@/utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handleError },
+}));
+
+vi.mock('@modules/kubernetes/config/KubeconfigContext', () => ({
+  useKubeconfig: () => ({ selectedClusterId: 'cluster-a', selectedClusterName: 'Cluster A' }),
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => ({ openWithObject: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useNavigateToView', () => ({
+  useNavigateToView: () => ({ navigateToView: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useObjectActionController', () => ({
+  useObjectActionController: () => ({ getMenuItems: mocks.getMenuItems, modals: null }),
+}));
+
+vi.mock('@/hooks/useShortNames', () => ({ useShortNames: () => false }));
+
+vi.mock('@shared/components/tables/persistence/useGridTablePersistence', () => ({
+  useGridTablePersistence: () => ({ hydrated: true }),
+}));
+
+vi.mock('@modules/resource-grid/useResourceGridTable', () => ({
+  useClusterResourceGridTable: (params: Record<string, unknown>) => {
+    mocks.tableParams.current = params;
+    return { gridTableProps: { keyExtractor: params.keyExtractor }, favModal: null };
+  },
+}));
+
+vi.mock('@modules/resource-grid/ResourceInventoryTable', () => ({
+  default: (props: Record<string, unknown>) => {
+    mocks.tableProps.current = props;
+    return <div data-testid="git-drift-table" />;
+  },
+}));
+
+const report = {
+  namespace: 'shop',
+  repo: '/src/deploy',
+  path: 'shop',
+  commit: '0123456789abcdef',
+  checkedAt: new Date().toISOString(),
+  counts: { 'in-sync': 1, drifted: 1, missing: 1 },
+  objects: [
+    {
+      source: 'shop/deployment.yaml',
+      apiVersion: 'apps/v1',
+      kind: 'Deployment',
+      namespace: 'shop',
+      name: 'api',
+      status: 'drifted',
+      diff: '--- live\n+++ git\n-  replicas: 2\n+  replicas: 3',
+    },
+    {
+      source: 'shop/service.yaml',
+      apiVersion: 'v1',
+      kind: 'Service',
+      namespace: 'shop',
+      name: 'api',
+      status: 'in-sync',
+    },
+    {
+      source: 'shop/configmap.yaml',
+      apiVersion: 'v1',
+      kind: 'ConfigMap',
+      namespace: 'shop',
+      name: 'settings',
+      status: 'missing',
+    },
+  ],
+};
+
+const flushPromises = async () => {
+  for (let i = 0; i < 5; i += 1) {
+    await Promise.resolve();
+  }
+};
+const rows = () => (mocks.tableParams.current?.data ?? []) as GitDriftRow[];
+const menuFor = (row: GitDriftRow) =>
+  (mocks.tableProps.current?.getCustomContextMenuItems as (row: GitDriftRow) => ContextMenuItem[])(
+    row
+  );
+const postAction = (id: string) =>
+  (
+    mocks.tableParams.current?.filterOptionOverrides as {
+      postActions: Array<{ id?: string; onClick?: () => void }>;
+    }
+  ).postActions.find((action) => action.id === id);
+
+describe('ClusterViewGitDrift', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.getReports.mockReset().mockResolvedValue([report]);
+    mocks.getSources.mockReset().mockResolvedValue([{ namespace: 'shop', repo: '/src/deploy' }]);
+    mocks.checkGitDrift.mockReset().mockResolvedValue([report]);
+    mocks.handleError.mockReset();
+    mocks.getMenuItems.mockClear();
+    mocks.eventHandlers.clear();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async () => {
+    await act(async () => {
+      root.render(<ClusterViewGitDrift />);
+      await flushPromises();
+    });
+  };
+
+  it('flattens reports into one row per object with the group split from apiVersion', () => {
+    expect(
+      buildGitDriftRows([report as never]).map(({ kind, group, version, sourceNamespace }) => ({
+        kind,
+        group,
+        version,
+        sourceNamespace,
+      }))
+    ).toEqual([
+      { kind: 'Deployment', group: 'apps', version: 'v1', sourceNamespace: 'shop' },
+      { kind: 'Service', group: '', version: 'v1', sourceNamespace: 'shop' },
+      { kind: 'ConfigMap', group: '', version: 'v1', sourceNamespace: 'shop' },
+    ]);
+  });
+
+  it('loads the cluster reports and reloads when the cluster reports change', async () => {
+    await render();
+
+    expect(mocks.getReports).toHaveBeenCalledWith('cluster-a');
+    expect(rows().map((row) => `${row.kind}/${row.name}:${row.status}`)).toEqual([
+      'Deployment/api:drifted',
+      'Service/api:in-sync',
+      'ConfigMap/settings:missing',
+    ]);
+    expect(container.textContent).toContain('/src/deploy/shop @ 0123456');
+
+    await act(async () => {
+      mocks.eventHandlers.get('git-drift:changed')?.('cluster-b');
+      await flushPromises();
+    });
+    expect(mocks.getReports).toHaveBeenCalledTimes(1);
+
+    await act(async () => {
+      mocks.eventHandlers.get('git-drift:changed')?.('cluster-a');
+      await flushPromises();
+    });
+    expect(mocks.getReports).toHaveBeenCalledTimes(2);
+  });
+
+  it('checks on demand and shows a drifted object diff', async () => {
+    await render();
+
+    await act(async () => {
+      postAction('git-drift-check')?.onClick?.();
+      await flushPromises();
+    });
+    expect(mocks.checkGitDrift).toHaveBeenCalledWith('cluster-a');
+
+    const [drifted, , missing] = rows();
+    const driftedMenu = menuFor(drifted);
+    expect(driftedMenu[0]).toMatchObject({ label: 'Show diff', disabled: false });
+    expect(driftedMenu.map((item) => item.label)).toContain('Open');
+    expect(menuFor(missing).map((item) => item.label)).toEqual(['Show diff']);
+
+    await act(async () => {
+      driftedMenu[0].onClick?.();
+    });
+    const diff = document.body.querySelector('.git-drift-diff');
+    expect(diff?.textContent).toContain('+  replicas: 3');
+    expect(diff?.querySelector('.added')?.textContent).toContain('replicas: 3');
+  });
+});
@@ -0,0 +1,368 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewGitDrift.tsx
+ *
+ * Compares the cluster with the manifests of the namespaces' git sources. One row per declared
+ * object; drifted rows carry the diff from the live object to git. Reports come from the
+ * backend's periodic check and reload on git-drift:changed.
+ */
+
+import { useKubeconfig } from '@modules/kubernetes/config/KubeconfigContext';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import { boundedRowsSource } from '@modules/resource-grid/boundedRowsSource';
+import ResourceInventoryTable from '@modules/resource-grid/ResourceInventoryTable';
+import { useResourceGridObjectIdentity } from '@modules/resource-grid/useResourceGridObjectIdentity';
+import { useClusterResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { RefreshIcon, SettingsIcon } from '@shared/components/icons/SharedIcons';
+import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import * as cf from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import { useGridTablePersistence } from '@shared/components/tables/persistence/useGridTablePersistence';
+import { useNavigateToView } from '@shared/hooks/useNavigateToView';
+import { useObjectActionController } from '@shared/hooks/useObjectActionController';
+import type { gitdrift } from '@wailsjs/go/models';
+import { EventsOn } from '@wailsjs/runtime/runtime';
+import { useCallback, useEffect, useMemo, useState } from 'react';
+import { CheckGitDrift, GetGitDriftReports, GetGitDriftSources } from '@/core/backend-api';
+import { useShortNames } from '@/hooks/useShortNames';
+import { formatAge } from '@/utils/ageFormatter';
+import { errorHandler } from '@/utils/errorHandler';
+import { getDisplayKind } from '@/utils/kindAliasMap';
+import GitDriftDiffModal from './GitDriftDiffModal';
+import GitDriftSourcesModal from './GitDriftSourcesModal';
+import './ClusterViewGitDrift.css';
+
+export interface GitDriftRow {
+  kind: string;
+  name: string;
+  namespace: string;
+  group: string;
+  version: string;
+  status: string;
+  source: string;
+  sourceNamespace: string;
+  diff?: string;
+  error?: string;
+}
+
+const statusChipVariants: Record<string, StatusChipVariant> = {
+  'in-sync': 'healthy',
+  drifted: 'warning',
+  missing: 'unhealthy',
+  error: 'unhealthy',
+};
+
+const splitAPIVersion = (apiVersion = ''): { group: string; version: string } => {
+  const slash = apiVersion.indexOf('/');
+  return slash < 0
+    ? { group: '', version: apiVersion }
+    : { group: apiVersion.slice(0, slash), version: apiVersion.slice(slash + 1) };
+};
+
+// buildGitDriftRows flattens the reports into one row per declared object.
+export const buildGitDriftRows = (reports: gitdrift.Report[]): GitDriftRow[] =>
+  reports.flatMap((report) =>
+    (report.objects ?? []).map((object) => ({
+      ...splitAPIVersion(object.apiVersion),
+      kind: object.kind ?? '',
+      name: object.name ?? '',
+      namespace: object.namespace ?? '',
+      status: object.status,
+      source: object.source ?? '',
+      sourceNamespace: report.namespace,
+      diff: object.diff,
+      error: object.error,
+    }))
+  );
+
+const rowKey = (row: GitDriftRow) =>
+  [row.sourceNamespace, row.source, row.group, row.version, row.kind, row.namespace, row.name].join(
+    '/'
+  );
+
+// Missing objects and manifests that failed to parse have nothing live to open.
+const isLive = (row: GitDriftRow) =>
+  Boolean(row.kind && row.name) && row.status !== 'missing' && row.status !== 'error';
+
+const describeSource = (report: gitdrift.Report) =>
+  report.path && report.path !== '.' ? `${report.repo}/${report.path}` : report.repo;
+
+export default function ClusterViewGitDrift() {
+  const { selectedClusterId, selectedClusterName } = useKubeconfig();
+  const { openWithObject } = useObjectPanel();
+  const { navigateToView } = useNavigateToView();
+  const useShortResourceNames = useShortNames();
+  const [reports, setReports] = useState<gitdrift.Report[]>([]);
+  const [sources, setSources] = useState<gitdrift.Source[]>([]);
+  const [loaded, setLoaded] = useState(false);
+  const [checking, setChecking] = useState(false);
+  const [sourcesOpen, setSourcesOpen] = useState(false);
+  const [diffRow, setDiffRow] = useState<GitDriftRow | null>(null);
+
+  const loadReports = useCallback(async () => {
+    if (!selectedClusterId) {
+      return;
+    }
+    try {
+      const [nextReports, nextSources] = await Promise.all([
+        GetGitDriftReports(selectedClusterId),
+        GetGitDriftSources(selectedClusterId),
+      ]);
+      setReports(nextReports ?? []);
+      setSources(nextSources ?? []);
+    } catch (error) {
+      errorHandler.handle(error, { action: 'getGitDriftReports' });
+    } finally {
+      setLoaded(true);
+    }
+  }, [selectedClusterId]);
+
+  useEffect(() => {
+    setReports([]);
+    setSources([]);
+    setLoaded(false);
+    void loadReports();
+  }, [loadReports]);
+
+  useEffect(() => {
+    const cancel = EventsOn('git-drift:changed', (clusterId: string) => {
+      if (clusterId === selectedClusterId) {
+        void loadReports();
+      }
+    });
+    return () => {
+      if (typeof cancel === 'function') {
+        cancel();
+      }
+    };
+  }, [loadReports, selectedClusterId]);
+
+  const checkNow = useCallback(async () => {
+    if (!selectedClusterId) {
+      return;
+    }
+    setChecking(true);
+    try {
+      setReports((await CheckGitDrift(selectedClusterId)) ?? []);
+    } catch (error) {
+      errorHandler.handle(error, { action: 'checkGitDrift' });
+    } finally {
+      setChecking(false);
+    }
+  }, [selectedClusterId]);
+
+  const rows = useMemo(() => buildGitDriftRows(reports), [reports]);
+
+  const getObject = useCallback(
+    (row: GitDriftRow) => ({
+      kind: row.kind,
+      name: row.name,
+      namespace: row.namespace,
+      group: row.group,
+      version: row.version,
+      clusterId: selectedClusterId,
+      clusterName: selectedClusterName,
+    }),
+    [selectedClusterId, selectedClusterName]
+  );
+  const identity = useResourceGridObjectIdentity<GitDriftRow>({
+    fallbackClusterId: selectedClusterId,
+    getObject,
+    openWithObject,
+    navigateToView,
+  });
+  const { open: identityOpen, navigate: identityNavigate, ref: identityRef } = identity;
+  const openObject = useCallback(
+    (row: GitDriftRow) => (isLive(row) ? identityOpen(row) : setDiffRow(row)),
+    [identityOpen]
+  );
+  const navigateObject = useCallback(
+    (row: GitDriftRow) => {
+      if (isLive(row)) {
+        identityNavigate(row);
+      }
+    },
+    [identityNavigate]
+  );
+
+  const columns = useMemo<GridColumnDefinition<GitDriftRow>[]>(() => {
+    const result: GridColumnDefinition<GitDriftRow>[] = [
+      cf.createKindColumn<GitDriftRow>({
+        getKind: (row) => row.kind,
+        getDisplayText: (row) => getDisplayKind(row.kind, useShortResourceNames),
+        onClick: openObject,
+        onAltClick: navigateObject,
+      }),
+      cf.createTextColumn('name', 'Name', (row) => row.name || '-', {
+        onClick: openObject,
+        onAltClick: navigateObject,
+        getClassName: () => 'object-panel-link',
+      }),
+      cf.createTextColumn('namespace', 'Namespace', (row) => row.namespace || '-'),
+      {
+        key: 'status',
+        header: 'Status',
+        sortable: true,
+        sortValue: (row) => row.status,
+        render: (row) => (
+          <StatusChip
+            variant={statusChipVariants[row.status] ?? 'info'}
+            tooltip={row.error || undefined}
+          >
+            {row.status}
+          </StatusChip>
+        ),
+      },
+      cf.createTextColumn('source', 'Manifest', (row) => row.source || '-', {
+        getTitle: (row) => `${row.sourceNamespace} source: ${row.source}`,
+      }),
+    ];
+    cf.applyColumnSizing(result, {
+      kind: { autoWidth: true },
+      name: { width: 240 },
+      namespace: { width: 180 },
+      status: { autoWidth: true },
+      source: { width: 320 },
+    });
+    return result;
+  }, [navigateObject, openObject, useShortResourceNames]);
+
+  const objectActions = useObjectActionController({
+    context: 'gridtable',
+    onOpen: (object) => openWithObject(object),
+    onOpenObjectMap: (object) => openWithObject(object, { initialTab: 'map' }),
+  });
+
+  const getCustomContextMenuItems = useCallback(
+    (row: GitDriftRow): ContextMenuItem[] => {
+      const items: ContextMenuItem[] = [
+        {
+          actionId: 'git-drift-show-diff',
+          label: 'Show diff',
+          disabled: !row.diff && !row.error,
+          disabledReason: !row.diff && !row.error ? 'The object matches git' : undefined,
+          onClick: () => setDiffRow(row),
+        },
+      ];
+      if (isLive(row)) {
+        items.push({ divider: true }, ...objectActions.getMenuItems(identityRef(row)));
+      }
+      return items;
+    },
+    [identityRef, objectActions]
+  );
+
+  const persistence = useGridTablePersistence({
+    viewId: 'cluster-git-drift',
+    clusterIdentity: selectedClusterId,
+    isNamespaceScoped: false,
+    columns,
+    data: rows,
+    keyExtractor: rowKey,
+    enabled: Boolean(selectedClusterId),
+  });
+
+  const filterOptionOverrides = useMemo(
+    () => ({
+      postActions: [
+        { type: 'separator' as const },
+        {
+          type: 'action' as const,
+          id: 'git-drift-check',
+          icon: <RefreshIcon width={18} height={18} />,
+          title: checking ? 'Checking git sources...' : 'Check git sources now',
+          disabled: checking || sources.length === 0,
+          onClick: () => void checkNow(),
+        },
+        {
+          type: 'action' as const,
+          id: 'git-drift-sources',
+          icon: <SettingsIcon width={18} height={18} />,
+          title: 'Manage git sources',
+          onClick: () => setSourcesOpen(true),
+        },
+      ],
+    }),
+    [checkNow, checking, sources.length]
+  );
+
+  const { gridTableProps, favModal } = useClusterResourceGridTable({
+    viewId: 'cluster-git-drift',
+    tableMode: 'Local Complete',
+    data: rows,
+    columns,
+    keyExtractor: rowKey,
+    persistenceOverride: persistence,
+    defaultSortKey: 'status',
+    defaultSortDirection: 'asc',
+    diagnosticsLabel: 'Git Drift',
+    showKindDropdown: true,
+    showNamespaceFilters: true,
+    filterOptionOverrides,
+    filterAccessors: {
+      getSearchText: (row) => [row.kind, row.name, row.namespace, row.status, row.source],
+    },
+  });
+
+  const source = boundedRowsSource({
+    rows,
+    loading: !loaded,
+    loaded,
+    mode: 'Local Complete',
+    cacheKey: `cluster-git-drift:${selectedClusterId}`,
+  });
+
+  return (
+    <div className="git-drift-view">
+      {reports.length > 0 && (
+        <ul className="git-drift-reports">
+          {reports.map((report) => (
+            <li key={report.namespace}>
+              <span className="git-drift-report-namespace">{report.namespace}</span>
+              <span className="git-drift-report-source" title={describeSource(report)}>
+                {describeSource(report)}
+                {report.commit ? ` @ ${report.commit.slice(0, 7)}` : ''}
+              </span>
+              {report.error ? (
+                <span className="status-text error">{report.error}</span>
+              ) : (
+                <span className="git-drift-report-checked">
+                  checked {formatAge(report.checkedAt)} ago
+                </span>
+              )}
+            </li>
+          ))}
+        </ul>
+      )}
+      <ResourceInventoryTable
+        source={source}
+        gridTableProps={gridTableProps}
+        columns={columns}
+        spinnerMessage="Loading git drift..."
+        emptyMessage={
+          sources.length === 0
+            ? 'No git sources are configured for this cluster'
+            : 'No objects have been checked yet'
+        }
+        diagnosticsLabel="Git Drift"
+        diagnosticsMode="local"
+        enableContextMenu
+        getCustomContextMenuItems={getCustomContextMenuItems}
+        favModal={favModal}
+        useShortNames={useShortResourceNames}
+      />
+      {objectActions.modals}
+      <GitDriftDiffModal row={diffRow} onClose={() => setDiffRow(null)} />
+      <GitDriftSourcesModal
+        isOpen={sourcesOpen}
+        clusterId={selectedClusterId}
+        sources={sources}
+        onSaved={(saved) => {
+          setSources(saved);
+          void loadReports();
+        }}
+        onClose={() => setSourcesOpen(false)}
+      />
+    </div>
+  );
+}
@@ -0,0 +1,276 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewVelero.test.tsx
+ */
+
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import type { VeleroSnapshotPayload } from '@/core/refresh/types';
+import ClusterViewVelero, { buildVeleroRows, type VeleroRow } from './ClusterViewVelero';
+
+const mocks = vi.hoisted(() => ({
+  restore: vi.fn(),
+  scheduleBackup: vi.fn(),
+  namespaceBackup: vi.fn(),
+  refresh: vi.fn(),
+  handleError: vi.fn(),
+  domainParams: { current: null as Record<string, unknown> | null },
+  domainState: { current: { status: 'ready', data: null } as Record<string, unknown> },
+  tableParams: { current: null as Record<string, unknown> | null },
+  tableProps: { current: null as Record<string, unknown> | null },
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  RestoreVeleroBackup: mocks.restore,
+  TriggerVeleroScheduleBackup: mocks.scheduleBackup,
+  TriggerVeleroNamespaceBackup: mocks.namespaceBackup,
+}));
+
+vi.mock('@/core/data-access', () => ({
+  useRefreshDomainHandle: (params: Record<string, unknown>) => {
+    mocks.domainParams.current = params;
+    return { state: mocks.domainState.current, refresh: mocks.refresh };
+  },
+}));
+
+vi.mock('@/utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handleError },
+}));
+
+vi.mock('@modules/kubernetes/config/KubeconfigContext', () => ({
+  useKubeconfig: () => ({ selectedClusterId: 'cluster-a', selectedClusterName: 'Cluster A' }),
+}));
+
+vi.mock('@modules/namespace/contexts/NamespaceContext', () => ({
+  useNamespace: () => ({
+    namespaces: [{ name: 'shop', scope: 'shop' }],
+    selectedNamespace: 'shop',
+  }),
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => ({ openWithObject: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useNavigateToView', () => ({
+  useNavigateToView: () => ({ navigateToView: vi.fn() }),
+}));
+
+vi.mock('@shared/hooks/useObjectActionController', () => ({
+  useObjectActionController: () => ({
+    getMenuItems: () => [{ actionId: 'object-open', label: 'Open' }],
+    modals: null,
+  }),
+}));
+
+vi.mock('@/hooks/useShortNames', () => ({ useShortNames: () => false }));
+
+vi.mock('@shared/components/tables/persistence/useGridTablePersistence', () => ({
+  useGridTablePersistence: () => ({ hydrated: true }),
+}));
+
+vi.mock('@modules/resource-grid/useResourceGridTable', () => ({
+  useClusterResourceGridTable: (params: Record<string, unknown>) => {
+    mocks.tableParams.current = params;
+    return { gridTableProps: { keyExtractor: params.keyExtractor }, favModal: null };
+  },
+}));
+
+vi.mock('@modules/resource-grid/ResourceInventoryTable', () => ({
+  default: (props: Record<string, unknown>) => {
+    mocks.tableProps.current = props;
+    return <div data-testid="velero-table" />;
+  },
+}));
+
+const ref = (kind: string, name: string) => ({
+  clusterId: 'cluster-a',
+  group: 'velero.io',
+  version: 'v1',
+  kind,
+  namespace: 'velero',
+  name,
+});
+
+const payload: VeleroSnapshotPayload = {
+  clusterId: 'cluster-a',
+  clusterName: 'Cluster A',
+  sources: { detected: true, namespace: 'velero' },
+  backups: [
+    {
+      ref: ref('Backup', 'nightly-20261015'),
+      phase: 'Completed',
+      schedule: 'nightly',
+      includedNamespaces: ['shop'],
+      storageLocation: 'default',
+      startTime: '2026-10-15T02:00:00Z',
+      itemsBackedUp: 40,
+      totalItems: 40,
+    },
+    { ref: ref('Backup', 'manual'), phase: 'InProgress' },
+  ],
+  restores: [
+    {
+      ref: ref('Restore', 'nightly-20261015-restore'),
+      backupName: 'nightly-20261015',
+      phase: 'PartiallyFailed',
+      errors: 2,
+      warnings: 1,
+    },
+  ],
+  schedules: [{ ref: ref('Schedule', 'nightly'), cron: '0 2 * * *', phase: 'Enabled' }],
+  storageLocations: null,
+  failedBackups: 0,
+  failedRestores: 1,
+};
+
+const rows = () => (mocks.tableParams.current?.data ?? []) as VeleroRow[];
+const row = (name: string) => rows().find((entry) => entry.name === name) as VeleroRow;
+const flushPromises = async () => {
+  for (let i = 0; i < 5; i += 1) {
+    await Promise.resolve();
+  }
+};
+const menuFor = (target: VeleroRow) =>
+  (mocks.tableProps.current?.getCustomContextMenuItems as (row: VeleroRow) => ContextMenuItem[])(
+    target
+  );
+const postAction = (id: string) =>
+  (
+    mocks.tableParams.current?.filterOptionOverrides as {
+      postActions: Array<{ id?: string; disabled?: boolean; onClick?: () => void }>;
+    }
+  ).postActions.find((action) => action.id === id);
+const button = (text: string) =>
+  Array.from(document.body.querySelectorAll('button')).find(
+    (element) => element.textContent === text
+  ) as HTMLButtonElement;
+
+describe('ClusterViewVelero', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.restore.mockReset().mockResolvedValue(ref('Restore', 'nightly-20261015-x'));
+    mocks.scheduleBackup.mockReset().mockResolvedValue(ref('Backup', 'nightly-x'));
+    mocks.namespaceBackup.mockReset().mockResolvedValue(ref('Backup', 'shop-x'));
+    mocks.refresh.mockReset().mockResolvedValue({ status: 'executed' });
+    mocks.handleError.mockReset();
+    mocks.domainState.current = { status: 'ready', data: payload };
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async () => {
+    await act(async () => {
+      root.render(<ClusterViewVelero />);
+      await Promise.resolve();
+    });
+  };
+
+  it('lists backups, restores and schedules with their details', () => {
+    const built = buildVeleroRows(
+      payload.backups,
+      payload.restores,
+      payload.schedules,
+      payload.storageLocations
+    );
+    expect(built.map(({ kind, name, phase }) => `${kind}/${name}:${phase}`)).toEqual([
+      'Backup/nightly-20261015:Completed',
+      'Backup/manual:InProgress',
+      'Restore/nightly-20261015-restore:PartiallyFailed',
+      'Schedule/nightly:Enabled',
+    ]);
+    expect(built[0]).toMatchObject({
+      includedNamespaces: 'shop',
+      details: 'Schedule nightly, 40/40 items, default',
+    });
+    expect(built[1].includedNamespaces).toBe('*');
+    expect(built[2]).toMatchObject({ details: 'Backup nightly-20261015', errors: 2, warnings: 1 });
+    expect(built[3].details).toBe('0 2 * * *');
+  });
+
+  it('reads the cluster-velero domain for the selected cluster', async () => {
+    await render();
+
+    expect(mocks.domainParams.current).toMatchObject({
+      domain: 'cluster-velero',
+      scope: 'cluster-a|',
+      enabled: true,
+    });
+    expect(rows()).toHaveLength(4);
+  });
+
+  it('says when Velero is not installed and disables the namespace backup', async () => {
+    mocks.domainState.current = {
+      status: 'ready',
+      data: { ...payload, sources: { detected: false }, backups: null, schedules: null },
+    };
+    await render();
+
+    expect(mocks.tableProps.current?.emptyMessage).toBe('Velero is not installed in this cluster');
+    expect(postAction('velero-backup-namespace')?.disabled).toBe(true);
+  });
+
+  it('restores a completed backup after confirmation', async () => {
+    await render();
+
+    expect(menuFor(row('manual'))[0]).toMatchObject({ label: 'Restore', disabled: true });
+    const menu = menuFor(row('nightly-20261015'));
+    expect(menu[0]).toMatchObject({ label: 'Restore', disabled: false });
+    expect(menu.map((item) => item.label)).toContain('Open');
+
+    await act(async () => {
+      menu[0].onClick?.();
+    });
+    expect(mocks.restore).not.toHaveBeenCalled();
+
+    await act(async () => {
+      button('Restore').click();
+      await flushPromises();
+    });
+    expect(mocks.restore).toHaveBeenCalledWith('cluster-a', 'velero', 'nightly-20261015');
+    expect(mocks.refresh).toHaveBeenCalledWith('user');
+  });
+
+  it('backs up a schedule now and reports failures', async () => {
+    await render();
+
+    const menu = menuFor(row('nightly'));
+    expect(menu[0].label).toBe('Back up now');
+    expect(menuFor(row('nightly-20261015-restore')).map((item) => item.label)).toEqual(['Open']);
+
+    mocks.scheduleBackup.mockRejectedValue(new Error('forbidden'));
+    await act(async () => {
+      menu[0].onClick?.();
+      await flushPromises();
+    });
+    expect(mocks.scheduleBackup).toHaveBeenCalledWith('cluster-a', 'velero', 'nightly');
+    expect(mocks.handleError).toHaveBeenCalledWith(expect.any(Error), {
+      action: 'triggerVeleroScheduleBackup',
+    });
+  });
+
+  it('backs up the chosen namespace from the toolbar', async () => {
+    await render();
+
+    await act(async () => {
+      postAction('velero-backup-namespace')?.onClick?.();
+    });
+    expect(document.body.textContent).toContain('Back up a namespace');
+
+    await act(async () => {
+      button('Back up').click();
+      await flushPromises();
+    });
+    expect(mocks.namespaceBackup).toHaveBeenCalledWith('cluster-a', 'shop');
+    expect(mocks.refresh).toHaveBeenCalledWith('user');
+  });
+});
@@ -0,0 +1,415 @@
+/**
+ * frontend/src/modules/cluster/components/ClusterViewVelero.tsx
+ *
+ * Velero Backups, Restores, Schedules and storage locations from the cluster-velero refresh
+ * domain. A Backup can be restored and a Schedule backed up now from their menus; the toolbar
+ * starts a backup of one namespace.
+ */
+
+import { buildClusterScope } from '@core/refresh/clusterScope';
+import { useKubeconfig } from '@modules/kubernetes/config/KubeconfigContext';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import { boundedRowsSource } from '@modules/resource-grid/boundedRowsSource';
+import ResourceInventoryTable from '@modules/resource-grid/ResourceInventoryTable';
+import { useResourceGridObjectIdentity } from '@modules/resource-grid/useResourceGridObjectIdentity';
+import { useClusterResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import type { ContextMenuItem } from '@shared/components/ContextMenu';
+import { PlusIcon } from '@shared/components/icons/SharedIcons';
+import ConfirmationModal from '@shared/components/modals/ConfirmationModal';
+import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import * as cf from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import { useGridTablePersistence } from '@shared/components/tables/persistence/useGridTablePersistence';
+import { useNavigateToView } from '@shared/hooks/useNavigateToView';
+import { useObjectActionController } from '@shared/hooks/useObjectActionController';
+import { useCallback, useMemo, useState } from 'react';
+import { RestoreVeleroBackup, TriggerVeleroScheduleBackup } from '@/core/backend-api';
+import { useRefreshDomainHandle } from '@/core/data-access';
+import type {
+  ResourceRef,
+  VeleroBackup,
+  VeleroRestore,
+  VeleroSchedule,
+  VeleroStorageLocation,
+} from '@/core/refresh/types';
+import { useShortNames } from '@/hooks/useShortNames';
+import { errorHandler } from '@/utils/errorHandler';
+import { getDisplayKind } from '@/utils/kindAliasMap';
+import VeleroBackupModal from './VeleroBackupModal';
+import './ClusterViewVelero.css';
+
+export interface VeleroRow {
+  ref: ResourceRef;
+  kind: string;
+  name: string;
+  namespace: string;
+  phase: string;
+  includedNamespaces: string;
+  details: string;
+  errors: number;
+  warnings: number;
+  message?: string;
+  age?: string;
+  ageTimestamp?: number;
+}
+
+const timestamp = (value?: string) => {
+  const parsed = value ? Date.parse(value) : Number.NaN;
+  return Number.isFinite(parsed) ? parsed : undefined;
+};
+
+// An empty include list means every namespace, as in the velero CLI.
+const namespaceList = (namespaces?: string[]) =>
+  namespaces && namespaces.length > 0 ? namespaces.join(', ') : '*';
+
+const failureMessage = (object: { failureReason?: string; validationErrors?: string[] }) =>
+  object.failureReason || object.validationErrors?.join('; ') || undefined;
+
+const baseRow = (ref: ResourceRef) => ({
+  ref,
+  kind: ref.kind,
+  name: ref.name ?? '',
+  namespace: ref.namespace ?? '',
+});
+
+const backupRow = (backup: VeleroBackup): VeleroRow => ({
+  ...baseRow(backup.ref),
+  phase: backup.phase || 'New',
+  includedNamespaces: namespaceList(backup.includedNamespaces),
+  details: [
+    backup.schedule ? `Schedule ${backup.schedule}` : '',
+    backup.totalItems ? `${backup.itemsBackedUp ?? 0}/${backup.totalItems} items` : '',
+    backup.storageLocation ?? '',
+  ]
+    .filter(Boolean)
+    .join(', '),
+  errors: backup.errors ?? 0,
+  warnings: backup.warnings ?? 0,
+  message: failureMessage(backup),
+  ageTimestamp: timestamp(backup.startTime),
+});
+
+const restoreRow = (restore: VeleroRestore): VeleroRow => ({
+  ...baseRow(restore.ref),
+  phase: restore.phase || 'New',
+  includedNamespaces: namespaceList(restore.includedNamespaces),
+  details: restore.backupName ? `Backup ${restore.backupName}` : '',
+  errors: restore.errors ?? 0,
+  warnings: restore.warnings ?? 0,
+  message: failureMessage(restore),
+  ageTimestamp: timestamp(restore.startTime),
+});
+
+const scheduleRow = (schedule: VeleroSchedule): VeleroRow => ({
+  ...baseRow(schedule.ref),
+  phase: schedule.paused ? 'Paused' : schedule.phase || 'New',
+  includedNamespaces: namespaceList(schedule.includedNamespaces),
+  details: schedule.cron,
+  errors: 0,
+  warnings: 0,
+  message: schedule.validationErrors?.join('; ') || undefined,
+  ageTimestamp: timestamp(schedule.lastBackup),
+});
+
+const storageLocationRow = (location: VeleroStorageLocation): VeleroRow => ({
+  ...baseRow(location.ref),
+  phase: location.phase || 'Unknown',
+  includedNamespaces: '-',
+  details: [
+    location.provider && location.bucket
+      ? `${location.provider}/${location.bucket}`
+      : (location.provider ?? ''),
+    location.default ? 'default' : '',
+  ]
+    .filter(Boolean)
+    .join(', '),
+  errors: 0,
+  warnings: 0,
+  message: location.message,
+  ageTimestamp: timestamp(location.lastValidationTime),
+});
+
+// buildVeleroRows lists Backups, Restores, Schedules, then storage locations. The Since column is
+// a Backup or Restore's start, a Schedule's last backup and a location's last validation.
+export const buildVeleroRows = (
+  backups: VeleroBackup[] | null | undefined,
+  restores: VeleroRestore[] | null | undefined,
+  schedules: VeleroSchedule[] | null | undefined,
+  storageLocations: VeleroStorageLocation[] | null | undefined
+): VeleroRow[] => [
+  ...(backups ?? []).map(backupRow),
+  ...(restores ?? []).map(restoreRow),
+  ...(schedules ?? []).map(scheduleRow),
+  ...(storageLocations ?? []).map(storageLocationRow),
+];
+
+export const veleroPhaseVariant = (phase: string): StatusChipVariant => {
+  switch (phase) {
+    case 'Completed':
+    case 'Enabled':
+    case 'Available':
+      return 'healthy';
+    case 'PartiallyFailed':
+      return 'warning';
+    case 'Failed':
+    case 'FailedValidation':
+    case 'Unavailable':
+      return 'unhealthy';
+    default:
+      return 'info';
+  }
+};
+
+// Velero refuses to restore from a Backup that has not finished uploading.
+const restorable = (row: VeleroRow) =>
+  row.kind === 'Backup' && (row.phase === 'Completed' || row.phase === 'PartiallyFailed');
+
+export default function ClusterViewVelero() {
+  const { selectedClusterId, selectedClusterName } = useKubeconfig();
+  const { openWithObject } = useObjectPanel();
+  const { navigateToView } = useNavigateToView();
+  const useShortResourceNames = useShortNames();
+  const scope = selectedClusterId ? buildClusterScope(selectedClusterId, '') : null;
+  const [restoreTarget, setRestoreTarget] = useState<VeleroRow | null>(null);
+  const [backupModalOpen, setBackupModalOpen] = useState(false);
+
+  const handleFetchError = useCallback((error: unknown) => {
+    errorHandler.handle(error instanceof Error ? error : new Error(String(error)), {
+      source: 'cluster-velero-fetch',
+    });
+  }, []);
+  const { state, refresh } = useRefreshDomainHandle({
+    domain: 'cluster-velero',
+    scope,
+    enabled: Boolean(scope),
+    preserveState: true,
+    fetchOnEnable: scope ? 'startup' : false,
+    onFetchError: handleFetchError,
+  });
+  const payload = state.data;
+  const detected = Boolean(payload?.sources.detected);
+  const rows = useMemo(
+    () =>
+      buildVeleroRows(
+        payload?.backups,
+        payload?.restores,
+        payload?.schedules,
+        payload?.storageLocations
+      ),
+    [payload]
+  );
+
+  const getObject = useCallback(
+    (row: VeleroRow) => ({ ...row.ref, clusterName: selectedClusterName }),
+    [selectedClusterName]
+  );
+  const identity = useResourceGridObjectIdentity<VeleroRow>({
+    fallbackClusterId: selectedClusterId,
+    getObject,
+    openWithObject,
+    navigateToView,
+  });
+
+  const columns = useMemo<GridColumnDefinition<VeleroRow>[]>(() => {
+    const result: GridColumnDefinition<VeleroRow>[] = [
+      cf.createKindColumn<VeleroRow>({
+        getKind: (row) => row.kind,
+        getDisplayText: (row) => getDisplayKind(row.kind, useShortResourceNames),
+        onClick: identity.open,
+        onAltClick: identity.navigate,
+      }),
+      cf.createTextColumn('name', 'Name', (row) => row.name, {
+        onClick: identity.open,
+        onAltClick: identity.navigate,
+        getClassName: () => 'object-panel-link',
+      }),
+      {
+        key: 'phase',
+        header: 'Phase',
+        sortable: true,
+        sortValue: (row) => row.phase,
+        render: (row) => (
+          <StatusChip variant={veleroPhaseVariant(row.phase)} tooltip={row.message}>
+            {row.phase}
+          </StatusChip>
+        ),
+      },
+      cf.createTextColumn('includedNamespaces', 'Namespaces', (row) => row.includedNamespaces),
+      cf.createTextColumn('details', 'Details', (row) => row.details || '-'),
+      cf.createTextColumn('problems', 'Errors / Warnings', (row) =>
+        row.errors || row.warnings ? `${row.errors} / ${row.warnings}` : '-'
+      ),
+      cf.createTextColumn('message', 'Message', (row) => row.message || '-', {
+        getTitle: (row) => row.message ?? '',
+      }),
+      cf.createAgeColumn<VeleroRow>('age', 'Since'),
+    ];
+    cf.applyColumnSizing(result, {
+      kind: { autoWidth: true },
+      name: { width: 240 },
+      phase: { autoWidth: true },
+      includedNamespaces: { width: 180 },
+      details: { width: 240 },
+      problems: { autoWidth: true },
+      message: { width: 320 },
+      age: { autoWidth: true },
+    });
+    return result;
+  }, [identity.navigate, identity.open, useShortResourceNames]);
+
+  const objectActions = useObjectActionController({
+    context: 'gridtable',
+    onOpen: (object) => openWithObject(object),
+    onOpenObjectMap: (object) => openWithObject(object, { initialTab: 'map' }),
+  });
+
+  const runVeleroAction = useCallback(
+    async (action: 'restoreVeleroBackup' | 'triggerVeleroScheduleBackup', row: VeleroRow) => {
+      const run =
+        action === 'restoreVeleroBackup' ? RestoreVeleroBackup : TriggerVeleroScheduleBackup;
+      try {
+        await run(selectedClusterId, row.namespace, row.name);
+        await refresh('user');
+      } catch (error) {
+        errorHandler.handle(error, { action });
+      }
+    },
+    [refresh, selectedClusterId]
+  );
+
+  const getCustomContextMenuItems = useCallback(
+    (row: VeleroRow): ContextMenuItem[] => {
+      const items: ContextMenuItem[] = [];
+      if (row.kind === 'Backup') {
+        items.push({
+          actionId: 'velero-restore',
+          label: 'Restore',
+          disabled: !restorable(row),
+          disabledReason: restorable(row) ? undefined : 'The backup has not completed',
+          onClick: () => setRestoreTarget(row),
+        });
+      }
+      if (row.kind === 'Schedule') {
+        items.push({
+          actionId: 'velero-backup-now',
+          label: 'Back up now',
+          onClick: () => void runVeleroAction('triggerVeleroScheduleBackup', row),
+        });
+      }
+      if (items.length > 0) {
+        items.push({ divider: true });
+      }
+      return [...items, ...objectActions.getMenuItems(identity.ref(row))];
+    },
+    [identity, objectActions, runVeleroAction]
+  );
+
+  const persistence = useGridTablePersistence({
+    viewId: 'cluster-velero',
+    clusterIdentity: selectedClusterId,
+    isNamespaceScoped: false,
+    columns,
+    data: rows,
+    keyExtractor: identity.key,
+    enabled: Boolean(selectedClusterId),
+  });
+
+  const warnings = payload?.warnings ?? [];
+  const mode = warnings.length > 0 ? 'Local Partial' : 'Local Complete';
+  const { gridTableProps, favModal } = useClusterResourceGridTable({
+    viewId: 'cluster-velero',
+    tableMode: mode,
+    data: rows,
+    columns,
+    keyExtractor: identity.key,
+    objectIdentity: identity,
+    persistenceOverride: persistence,
+    defaultSortKey: 'age',
+    defaultSortDirection: 'desc',
+    diagnosticsLabel: 'Velero',
+    showKindDropdown: true,
+    showNamespaceFilters: true,
+    filterAccessors: {
+      getSearchText: (row) => [
+        row.kind,
+        row.name,
+        row.namespace,
+        row.phase,
+        row.includedNamespaces,
+        row.details,
+        row.message ?? '',
+      ],
+    },
+    filterOptionOverrides: {
+      postActions: [
+        { type: 'separator' },
+        {
+          type: 'action',
+          id: 'velero-backup-namespace',
+          icon: <PlusIcon width={18} height={18} />,
+          title: detected ? 'Back up a namespace' : 'Velero is not installed in this cluster',
+          disabled: !detected,
+          onClick: () => setBackupModalOpen(true),
+        },
+      ],
+    },
+  });
+
+  const source = boundedRowsSource({
+    rows,
+    loading: !payload && (state.status === 'loading' || state.status === 'initialising'),
+    loaded: Boolean(payload),
+    error: payload ? null : (state.error ?? null),
+    mode,
+    partialLabel: warnings.join('\n'),
+    cacheKey: `cluster-velero:${selectedClusterId}`,
+  });
+
+  return (
+    <>
+      <ResourceInventoryTable
+        source={source}
+        gridTableProps={gridTableProps}
+        columns={columns}
+        spinnerMessage="Loading Velero objects..."
+        emptyMessage={
+          payload && !detected
+            ? 'Velero is not installed in this cluster'
+            : 'No Backups, Restores or Schedules found'
+        }
+        diagnosticsLabel="Velero"
+        diagnosticsMode="local"
+        onRowClick={identity.open}
+        enableContextMenu
+        getCustomContextMenuItems={getCustomContextMenuItems}
+        favModal={favModal}
+        useShortNames={useShortResourceNames}
+      />
+      {objectActions.modals}
+      <ConfirmationModal
+        isOpen={restoreTarget !== null}
+        title="Restore backup"
+        message={`Restore everything in backup ${restoreTarget?.name ?? ''} (${
+          restoreTarget?.includedNamespaces ?? ''
+        })?`}
+        warning="Velero skips objects that already exist; it does not overwrite them."
+        confirmText="Restore"
+        confirmButtonClass="generic"
+        onConfirm={() => {
+          const target = restoreTarget;
+          setRestoreTarget(null);
+          if (target) {
+            void runVeleroAction('restoreVeleroBackup', target);
+          }
+        }}
+        onCancel={() => setRestoreTarget(null)}
+      />
+      <VeleroBackupModal
+        isOpen={backupModalOpen}
+        clusterId={selectedClusterId}
+        onStarted={() => void refresh('user')}
+        onClose={() => setBackupModalOpen(false)}
+      />
+    </>
+  );
+}
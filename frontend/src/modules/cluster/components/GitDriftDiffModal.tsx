@@ -0,0 +1,85 @@
+import { DiffIcon } from '@shared/components/icons/SharedIcons';
+import ModalHeader from '@shared/components/modals/ModalHeader';
+import ModalSurface from '@shared/components/modals/ModalSurface';
+import { useModalFocusTrap } from '@shared/components/modals/useModalFocusTrap';
+import { withStableListKeys } from '@shared/utils/stableListKeys';
+import { useRef } from 'react';
+import type { GitDriftRow } from './ClusterViewGitDrift';
+
+interface GitDriftDiffModalProps {
+  row: GitDriftRow | null;
+  onClose: () => void;
+}
+
+const lineClassName = (line: string) => {
+  if (line.startsWith('+++') || line.startsWith('---') || line.startsWith('@@')) {
+    return 'git-drift-diff-line meta';
+  }
+  if (line.startsWith('+')) {
+    return 'git-drift-diff-line added';
+  }
+  if (line.startsWith('-')) {
+    return 'git-drift-diff-line removed';
+  }
+  return 'git-drift-diff-line';
+};
+
+// GitDriftDiffModal shows one object's unified diff from the live object to git.
+export default function GitDriftDiffModal({ row, onClose }: GitDriftDiffModalProps) {
+  const modalRef = useRef<HTMLDivElement>(null);
+  useModalFocusTrap({
+    ref: modalRef,
+    disabled: !row,
+    onEscape: () => {
+      onClose();
+      return true;
+    },
+  });
+
+  if (!row) {
+    return null;
+  }
+
+  const name = row.namespace ? `${row.namespace}/${row.name}` : row.name;
+  const lines = (row.diff ?? '').split('\n');
+
+  return (
+    <ModalSurface
+      modalRef={modalRef}
+      labelledBy="git-drift-diff-title"
+      onClose={onClose}
+      containerClassName="git-drift-modal git-drift-diff-modal"
+      closeOnBackdrop
+    >
+      <ModalHeader
+        title={`${row.kind} ${name}`}
+        titleId="git-drift-diff-title"
+        icon={DiffIcon}
+        onClose={onClose}
+      />
+      <div className="git-drift-modal-body">
+        {row.error && <p className="status-text error">{row.error}</p>}
+        {row.status === 'missing' && (
+          <p className="git-drift-modal-note">Git declares this object; the cluster does not.</p>
+        )}
+        {row.diff ? (
+          <pre className="git-drift-diff">
+            {withStableListKeys(lines, (line) => line).map(({ key, value: line }) => (
+              <span key={key} className={lineClassName(line)}>
+                {line}
+                {'\n'}
+              </span>
+            ))}
+          </pre>
+        ) : (
+          !row.error && <p className="git-drift-modal-note">The live object matches git.</p>
+        )}
+      </div>
+      <div className="git-drift-modal-footer">
+        <button type="button" className="button generic" onClick={onClose} data-modal-initial-focus>
+          Close
+        </button>
+      </div>
+    </ModalSurface>
+  );
+}
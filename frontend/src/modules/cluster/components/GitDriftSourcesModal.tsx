@@ -0,0 +1,189 @@
+import { DeleteIcon, PlusIcon, SettingsIcon } from '@shared/components/icons/SharedIcons';
+import ModalHeader from '@shared/components/modals/ModalHeader';
+import ModalSurface from '@shared/components/modals/ModalSurface';
+import { useModalFocusTrap } from '@shared/components/modals/useModalFocusTrap';
+import type { gitdrift } from '@wailsjs/go/models';
+import { useEffect, useRef, useState } from 'react';
+import { SetGitDriftSources } from '@/core/backend-api';
+
+interface GitDriftSourcesModalProps {
+  isOpen: boolean;
+  clusterId: string;
+  sources: gitdrift.Source[];
+  onSaved: (sources: gitdrift.Source[]) => void;
+  onClose: () => void;
+}
+
+interface SourceDraft {
+  id: number;
+  namespace: string;
+  repo: string;
+  path: string;
+  pull: boolean;
+}
+
+let nextDraftId = 0;
+
+const toDraft = (source?: gitdrift.Source): SourceDraft => ({
+  id: nextDraftId++,
+  namespace: source?.namespace ?? '',
+  repo: source?.repo ?? '',
+  path: source?.path ?? '',
+  pull: source?.pull ?? false,
+});
+
+// GitDriftSourcesModal edits the cluster's git sources: one working copy directory per namespace.
+// The backend validates each source on save and its message is shown in place.
+export default function GitDriftSourcesModal({
+  isOpen,
+  clusterId,
+  sources,
+  onSaved,
+  onClose,
+}: GitDriftSourcesModalProps) {
+  const modalRef = useRef<HTMLDivElement>(null);
+  const [drafts, setDrafts] = useState<SourceDraft[]>([]);
+  const [saving, setSaving] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  useEffect(() => {
+    if (isOpen) {
+      setDrafts(sources.length > 0 ? sources.map(toDraft) : [toDraft()]);
+      setError(null);
+    }
+  }, [isOpen, sources]);
+
+  useModalFocusTrap({
+    ref: modalRef,
+    disabled: !isOpen,
+    onEscape: () => {
+      if (saving) {
+        return false;
+      }
+      onClose();
+      return true;
+    },
+  });
+
+  if (!isOpen) {
+    return null;
+  }
+
+  const update = (id: number, patch: Partial<SourceDraft>) =>
+    setDrafts((current) =>
+      current.map((draft) => (draft.id === id ? { ...draft, ...patch } : draft))
+    );
+
+  const save = async () => {
+    const next = drafts
+      .filter((draft) => draft.namespace.trim() || draft.repo.trim())
+      .map(({ namespace, repo, path, pull }) => ({ namespace, repo, path, pull }));
+    setSaving(true);
+    setError(null);
+    try {
+      onSaved((await SetGitDriftSources(clusterId, next as gitdrift.Source[])) ?? []);
+      onClose();
+    } catch (saveError) {
+      setError(saveError instanceof Error ? saveError.message : String(saveError));
+    } finally {
+      setSaving(false);
+    }
+  };
+
+  return (
+    <ModalSurface
+      modalRef={modalRef}
+      labelledBy="git-drift-sources-title"
+      onClose={onClose}
+      containerClassName="git-drift-modal git-drift-sources-modal"
+      closeOnBackdrop={!saving}
+    >
+      <ModalHeader
+        title="Git sources"
+        titleId="git-drift-sources-title"
+        icon={SettingsIcon}
+        onClose={onClose}
+        closeDisabled={saving}
+      />
+      <div className="git-drift-modal-body">
+        <p className="git-drift-modal-note">
+          Each namespace is compared with the manifests in a directory of a local git working copy.
+        </p>
+        <fieldset className="git-drift-sources" disabled={saving}>
+          <div className="git-drift-sources-header">
+            <span>Namespace</span>
+            <span>Working copy</span>
+            <span>Directory</span>
+            <span>Pull</span>
+            <span />
+          </div>
+          {drafts.map((draft) => (
+            <div key={draft.id} className="git-drift-sources-row">
+              <input
+                type="text"
+                value={draft.namespace}
+                placeholder="default"
+                aria-label="Namespace"
+                onChange={(event) => update(draft.id, { namespace: event.target.value })}
+              />
+              <input
+                type="text"
+                value={draft.repo}
+                placeholder="/home/me/src/deploy"
+                aria-label="Working copy"
+                onChange={(event) => update(draft.id, { repo: event.target.value })}
+              />
+              <input
+                type="text"
+                value={draft.path}
+                placeholder="."
+                aria-label="Directory"
+                onChange={(event) => update(draft.id, { path: event.target.value })}
+              />
+              <input
+                type="checkbox"
+                checked={draft.pull}
+                aria-label="Pull before each check"
+                title="Fast-forward the working copy before each check"
+                onChange={(event) => update(draft.id, { pull: event.target.checked })}
+              />
+              <button
+                type="button"
+                className="button cancel"
+                aria-label="Remove source"
+                title="Remove source"
+                onClick={() =>
+                  setDrafts((current) => current.filter((entry) => entry.id !== draft.id))
+                }
+              >
+                <DeleteIcon width={14} height={14} />
+              </button>
+            </div>
+          ))}
+          <button
+            type="button"
+            className="button generic small"
+            onClick={() => setDrafts((current) => [...current, toDraft()])}
+          >
+            <PlusIcon width={12} height={12} /> Add source
+          </button>
+        </fieldset>
+        {error && <p className="status-text error">{error}</p>}
+      </div>
+      <div className="git-drift-modal-footer">
+        <button type="button" className="button cancel" onClick={onClose} disabled={saving}>
+          Cancel
+        </button>
+        <button
+          type="button"
+          className="button generic"
+          onClick={() => void save()}
+          disabled={saving}
+          data-modal-initial-focus
+        >
+          {saving ? 'Saving...' : 'Save'}
+        </button>
+      </div>
+    </ModalSurface>
+  );
+}
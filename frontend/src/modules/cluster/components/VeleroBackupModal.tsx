@@ -0,0 +1,125 @@
+import { isAllNamespaces } from '@modules/namespace/constants';
+import { useNamespace } from '@modules/namespace/contexts/NamespaceContext';
+import { Dropdown, type DropdownOption } from '@shared/components/dropdowns/Dropdown';
+import { PlusIcon } from '@shared/components/icons/SharedIcons';
+import ModalHeader from '@shared/components/modals/ModalHeader';
+import ModalSurface from '@shared/components/modals/ModalSurface';
+import { useModalFocusTrap } from '@shared/components/modals/useModalFocusTrap';
+import { useEffect, useMemo, useRef, useState } from 'react';
+import { TriggerVeleroNamespaceBackup } from '@/core/backend-api';
+
+interface VeleroBackupModalProps {
+  isOpen: boolean;
+  clusterId: string;
+  onStarted: () => void;
+  onClose: () => void;
+}
+
+// VeleroBackupModal starts a backup of one namespace. The Backup object is created in Velero's
+// install namespace; the backend's message is shown in place when it refuses.
+export default function VeleroBackupModal({
+  isOpen,
+  clusterId,
+  onStarted,
+  onClose,
+}: VeleroBackupModalProps) {
+  const modalRef = useRef<HTMLDivElement>(null);
+  const { namespaces, selectedNamespace } = useNamespace();
+  const [namespace, setNamespace] = useState('');
+  const [starting, setStarting] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const options = useMemo<DropdownOption[]>(
+    () =>
+      namespaces
+        .filter((entry) => !isAllNamespaces(entry.scope))
+        .map((entry) => ({ value: entry.name, label: entry.name })),
+    [namespaces]
+  );
+
+  useEffect(() => {
+    if (isOpen) {
+      setNamespace(
+        selectedNamespace && !isAllNamespaces(selectedNamespace) ? selectedNamespace : ''
+      );
+      setError(null);
+    }
+  }, [isOpen, selectedNamespace]);
+
+  useModalFocusTrap({
+    ref: modalRef,
+    disabled: !isOpen,
+    onEscape: () => {
+      if (starting) {
+        return false;
+      }
+      onClose();
+      return true;
+    },
+  });
+
+  if (!isOpen) {
+    return null;
+  }
+
+  const start = async () => {
+    setStarting(true);
+    setError(null);
+    try {
+      await TriggerVeleroNamespaceBackup(clusterId, namespace);
+      onStarted();
+      onClose();
+    } catch (startError) {
+      setError(startError instanceof Error ? startError.message : String(startError));
+    } finally {
+      setStarting(false);
+    }
+  };
+
+  return (
+    <ModalSurface
+      modalRef={modalRef}
+      labelledBy="velero-backup-title"
+      onClose={onClose}
+      containerClassName="velero-modal"
+      closeOnBackdrop={!starting}
+    >
+      <ModalHeader
+        title="Back up a namespace"
+        titleId="velero-backup-title"
+        icon={PlusIcon}
+        onClose={onClose}
+        closeDisabled={starting}
+      />
+      <div className="velero-modal-body">
+        <p className="velero-modal-note">
+          Velero backs up every object in the namespace to its default storage location.
+        </p>
+        <Dropdown
+          options={options}
+          value={namespace}
+          onChange={(value) => setNamespace((Array.isArray(value) ? value[0] : value) ?? '')}
+          placeholder="Select a namespace"
+          searchable
+          disabled={starting}
+          ariaLabel="Namespace"
+        />
+        {error && <p className="status-text error">{error}</p>}
+      </div>
+      <div className="velero-modal-footer">
+        <button type="button" className="button cancel" onClick={onClose} disabled={starting}>
+          Cancel
+        </button>
+        <button
+          type="button"
+          className="button generic"
+          onClick={() => void start()}
+          disabled={starting || !namespace}
+          data-modal-initial-focus
+        >
+          {starting ? 'Starting...' : 'Back up'}
+        </button>
+      </div>
+    </ModalSurface>
+  );
+}
@@ -206,4 +206,42 @@ describe('NsViewAutoscaling', () => {
       { initialTab: 'map' }
     );
   });
+
+  it('shows KEDA min/max, triggers and paused state', async () => {
+    await act(async () => {
+      root.render(<NsViewAutoscaling namespace="team-a" showNamespaceColumn={true} />);
+      await Promise.resolve();
+    });
+
+    const props = requireValue(gridTablePropsRef.current, 'expected GridTable props');
+    const cellText = (key: string, row: AutoscalingData) => {
+      const column = requireValue(
+        props.columns.find((entry) => entry.key === key),
+        `expected the ${key} column`
+      );
+      const cell = document.createElement('div');
+      const cellRoot = ReactDOM.createRoot(cell);
+      act(() => {
+        cellRoot.render(<>{column.render(row)}</>);
+      });
+      const text = cell.textContent;
+      act(() => {
+        cellRoot.unmount();
+      });
+      return text;
+    };
+
+    const scaledObject = baseHpa({
+      ref: { ...baseHpa().ref, group: 'keda.sh', version: 'v1alpha1', kind: 'ScaledObject' },
+      min: 0,
+      max: 20,
+      minReplicas: 0,
+      maxReplicas: 20,
+      triggers: ['prometheus', 'cron'],
+    });
+    expect(cellText('replicas', scaledObject)).toBe('0/20');
+    expect(cellText('triggers', scaledObject)).toBe('prometheus, cron');
+    expect(cellText('current', { ...scaledObject, paused: true })).toBe('Paused');
+    expect(cellText('triggers', baseHpa())).toBe('-');
+  });
 });
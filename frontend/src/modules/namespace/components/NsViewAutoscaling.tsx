@@ -32,6 +32,12 @@ export type AutoscalingData = NamespaceAutoscalingSummary & {
   status?: string;
 };
 
+// KEDA kinds report their own min/max and trigger types alongside HPAs.
+const KEDA_KINDS = new Set(['ScaledObject', 'ScaledJob']);
+
+const hasReplicaRange = (resource: AutoscalingData) =>
+  resource.ref.kind === 'HorizontalPodAutoscaler' || KEDA_KINDS.has(resource.ref.kind);
+
 interface AutoscalingViewProps {
   namespace: string;
   showNamespaceColumn?: boolean;
@@ -150,7 +156,7 @@ const autoscalingSpec: AggregatedResourceGridViewSpec<AutoscalingData> = {
         'replicas',
         'Min/Max',
         (resource) => {
-          if (resource.ref.kind === 'HorizontalPodAutoscaler') {
+          if (hasReplicaRange(resource)) {
             const minValue = resource.minReplicas ?? resource.min;
             const min = minValue !== undefined && minValue !== null ? minValue : 1;
             const maxValue = resource.maxReplicas ?? resource.max;
@@ -161,15 +167,17 @@ const autoscalingSpec: AggregatedResourceGridViewSpec<AutoscalingData> = {
         {
           alignHeader: 'center',
           alignData: 'center',
-          getClassName: (resource) =>
-            resource.ref.kind === 'HorizontalPodAutoscaler' ? 'replica-range' : undefined,
+          getClassName: (resource) => (hasReplicaRange(resource) ? 'replica-range' : undefined),
         }
       ),
       cf.createTextColumn<AutoscalingData>(
         'current',
         'Current',
         (resource) => {
-          if (resource.ref.kind === 'HorizontalPodAutoscaler') {
+          if (resource.paused) {
+            return 'Paused';
+          }
+          if (resource.ref.kind === 'HorizontalPodAutoscaler' || resource.ref.kind === 'ScaledJob') {
             const current = resource.currentReplicas ?? resource.current;
             return `${current !== undefined && current !== null ? current : 0}`;
           }
@@ -182,7 +190,10 @@ const autoscalingSpec: AggregatedResourceGridViewSpec<AutoscalingData> = {
           alignHeader: 'center',
           alignData: 'center',
           getClassName: (resource) => {
-            if (resource.ref.kind === 'HorizontalPodAutoscaler') {
+            if (resource.paused) {
+              return 'keda-paused';
+            }
+            if (resource.ref.kind === 'HorizontalPodAutoscaler' || resource.ref.kind === 'ScaledJob') {
               return 'current-replicas';
             }
             if (resource.ref.kind === 'VerticalPodAutoscaler') {
@@ -193,6 +204,12 @@ const autoscalingSpec: AggregatedResourceGridViewSpec<AutoscalingData> = {
           },
         }
       ),
+      cf.createTextColumn<AutoscalingData>(
+        'triggers',
+        'Triggers',
+        (resource) => (resource.triggers?.length ? resource.triggers.join(', ') : '-'),
+        { sortable: false }
+      ),
       cf.createAgeColumn(),
     ];
   },
@@ -200,7 +217,8 @@ const autoscalingSpec: AggregatedResourceGridViewSpec<AutoscalingData> = {
 
 /**
  * GridTable component for namespace autoscaling resources
- * Aggregates HorizontalPodAutoscalers and VerticalPodAutoscalers
+ * Aggregates HorizontalPodAutoscalers, VerticalPodAutoscalers and KEDA
+ * ScaledObjects/ScaledJobs
  */
 const AutoscalingViewGrid: React.FC<AutoscalingViewProps> = React.memo(
   ({ namespace, showNamespaceColumn = false }) => (
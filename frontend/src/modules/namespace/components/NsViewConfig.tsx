@@ -59,6 +59,7 @@ const configSpec: AggregatedResourceGridViewSpec<ConfigData> = {
         getClassName: () => 'data-count',
       }
     ),
+    cf.createCertificateExpiryColumn<ConfigData>(),
     cf.createAgeColumn(),
   ],
 };
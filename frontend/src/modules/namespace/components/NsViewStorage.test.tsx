@@ -446,6 +446,30 @@ describe('NsViewStorage', () => {
     expect(typeof noCapacity).toBe('string');
   });
 
+  it('appends in-flight volume expansion to the status', async () => {
+    const pendingResize = baseStorage({
+      resizeState: 'FileSystemResizePending',
+      resizeMessage: 'Waiting for user to (re-)start a pod to finish file system resize',
+    });
+    const failedResize = baseStorage({ resizeState: 'Failed', resizeMessage: 'quota exceeded' });
+    await renderStorageView();
+    const statusColumn = getColumn('status');
+
+    const pendingStatus = requireReactElement<{ className?: string }>(
+      statusColumn.render(pendingResize),
+      'expected the resizing storage status element'
+    );
+    expect(renderOutputToText(pendingStatus)).toContain('Bound (restart pod to finish resize)');
+    expect(pendingStatus.props.className).toContain('ready');
+
+    const failedStatus = requireReactElement<{ className?: string }>(
+      statusColumn.render(failedResize),
+      'expected the failed resize status element'
+    );
+    expect(renderOutputToText(failedStatus)).toContain('Bound (resize failed)');
+    expect(failedStatus.props.className).toContain('error');
+  });
+
   it('renders default storage class when absent without triggering navigation', async () => {
     const entry = baseStorage({ storageClass: undefined });
     await renderStorageView();
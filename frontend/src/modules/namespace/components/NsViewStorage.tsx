@@ -20,6 +20,22 @@ import { getDisplayKind } from '@/utils/kindAliasMap';
 
 export type StorageData = NamespaceStorageSummary & { kindAlias?: string };
 
+// Short labels for an in-flight volume expansion, shown after the PVC status.
+const RESIZE_LABELS: Record<string, string> = {
+  Requested: 'resize requested',
+  Resizing: 'resizing',
+  FileSystemResizePending: 'restart pod to finish resize',
+  Failed: 'resize failed',
+};
+
+export const storageStatusText = (resource: StorageData): string => {
+  const status = resource.status || 'Unknown';
+  const resize = resource.resizeState
+    ? (RESIZE_LABELS[resource.resizeState] ?? resource.resizeState)
+    : '';
+  return resize ? `${status} (${resize})` : status;
+};
+
 interface StorageViewProps {
   namespace: string;
   showNamespaceColumn?: boolean;
@@ -71,9 +87,13 @@ const storageSpec: AggregatedResourceGridViewSpec<StorageData> = {
       cf.createTextColumn<StorageData>(
         'status',
         'Status',
-        (resource) => resource.status || 'Unknown',
+        storageStatusText,
         {
-          getClassName: (resource) => backendStatusTextClass(resource.statusPresentation),
+          getTitle: (resource) => resource.resizeMessage || undefined,
+          getClassName: (resource) =>
+            resource.resizeState === 'Failed'
+              ? backendStatusTextClass('error')
+              : backendStatusTextClass(resource.statusPresentation),
         }
       ),
       cf.createTextColumn<StorageData>(
@@ -20,6 +20,15 @@ const utilizationMock = vi.fn();
 const containersMock = vi.fn();
 const rbacRulesMock = vi.fn();
 const dataMock = vi.fn();
+const getConfigMapValueMock = vi.hoisted(() => vi.fn());
+const revealSecretValuesMock = vi.hoisted(() => vi.fn());
+const revealSecretValueRangeMock = vi.hoisted(() => vi.fn());
+
+vi.mock('@/core/backend-api', () => ({
+  GetConfigMapValue: getConfigMapValueMock,
+  RevealSecretValues: revealSecretValuesMock,
+  RevealSecretValueRange: revealSecretValueRangeMock,
+}));
 
 vi.mock('@ui/shortcuts', () => ({
   useShortcut: (options: unknown) => useShortcutMock(options),
@@ -212,17 +221,54 @@ describe('DetailsTab', () => {
     const cfg = await renderDetailsTab(
       createBaseProps(
         { kind: 'ConfigMap', name: 'cfg', namespace: 'default' },
-        { data: { key: 'value' }, binaryData: {} }
+        { keys: [{ key: 'key', size: 5 }] }
       )
     );
-    expect(dataMock).toHaveBeenCalledWith(expect.objectContaining({ isSecret: false }));
+    expect(dataMock).toHaveBeenCalledWith(
+      expect.objectContaining({ isSecret: false, keys: [{ key: 'key', size: 5 }] })
+    );
+    const { loadValue } = dataMock.mock.calls.at(-1)?.[0] as {
+      loadValue: (key: string, range: { offset: number; limit: number }) => Promise<unknown>;
+    };
+    await loadValue('key', { offset: 0, limit: 10 });
+    expect(getConfigMapValueMock).toHaveBeenCalledWith(
+      'test-cluster',
+      'default',
+      'cfg',
+      'key',
+      expect.objectContaining({ offset: 0, limit: 10 })
+    );
     cfg.cleanup();
 
     dataMock.mockClear();
     const sec = await renderDetailsTab(
-      createBaseProps({ kind: 'Secret', name: 's', namespace: 'default' }, { data: { t: 'x' } })
+      createBaseProps(
+        { kind: 'Secret', name: 's', namespace: 'default' },
+        { keys: [{ key: 't', size: 1 }] }
+      )
+    );
+    expect(dataMock).toHaveBeenCalledWith(
+      expect.objectContaining({
+        isSecret: true,
+        keys: [{ key: 't', size: 1 }],
+        loadValue: undefined,
+        revealEnabled: false,
+      })
+    );
+    const { revealValues, revealValueRange } = dataMock.mock.calls.at(-1)?.[0] as {
+      revealValues: (keys: string[]) => Promise<unknown>;
+      revealValueRange: (key: string, range: { offset: number; limit: number }) => Promise<unknown>;
+    };
+    await revealValues(['t']);
+    expect(revealSecretValuesMock).toHaveBeenCalledWith('test-cluster', 'default', 's', ['t']);
+    await revealValueRange('t', { offset: 0, limit: 10 });
+    expect(revealSecretValueRangeMock).toHaveBeenCalledWith(
+      'test-cluster',
+      'default',
+      's',
+      't',
+      expect.objectContaining({ offset: 0, limit: 10 })
     );
-    expect(dataMock).toHaveBeenCalledWith(expect.objectContaining({ isSecret: true }));
     sec.cleanup();
   });
 
@@ -2,13 +2,27 @@
  * frontend/src/modules/object-panel/components/ObjectPanel/Details/DetailsTab.tsx
  */
 
+import {
+  GetConfigMapValue,
+  RevealSecretValueRange,
+  RevealSecretValues,
+} from '@/core/backend-api';
+import { eventBus } from '@/core/events';
+import { getSecretRevealEnabled } from '@/core/settings/appPreferences';
 import Containers from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabContainers';
-import DataSection from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabData';
+import DataSection, {
+  type DataValueLoader,
+  type SecretRevealer,
+} from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabData';
 import RBACRules from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabRBACRules';
+import References from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabReferences';
+import StorageChain from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabStorageChain';
 import Utilization from '@modules/object-panel/components/ObjectPanel/Details/DetailsTabUtilization';
 import Overview from '@modules/object-panel/components/ObjectPanel/Details/Overview';
 import { WarningIcon } from '@shared/components/icons/SharedIcons';
+import { types } from '@wailsjs/go/models';
 import type React from 'react';
+import { useEffect, useMemo, useState } from 'react';
 import './DetailsTab.css';
 import './DetailsTabData.css';
 
@@ -29,6 +43,9 @@ export type { DetailsTabProps } from './detailsTabTypes';
 const DetailsTabContent: React.FC<DetailsTabProps> = ({
   objectData,
   detailModel,
+  isActive,
+  referencesScope = null,
+  storageChainScope = null,
   detailsLoading,
   detailsError,
   resourceDeleted = false,
@@ -40,6 +57,53 @@ const DetailsTabContent: React.FC<DetailsTabProps> = ({
   const hasUtilization = useHasUtilization(objectData);
 
   const dataInfo = model.dataSection;
+  const clusterId = objectData?.clusterId;
+  const objectNamespace = objectData?.namespace;
+  const objectName = objectData?.name;
+  const hasDataKeys = !!dataInfo;
+  const isSecretData = !!dataInfo?.isSecret;
+  const [secretRevealEnabled, setSecretRevealEnabled] = useState(() => getSecretRevealEnabled());
+
+  useEffect(() => eventBus.on('settings:secret-reveal-enabled', setSecretRevealEnabled), []);
+
+  // ConfigMap details carry keys and sizes only; values load per key from the backend. The
+  // loader is keyed on the object alone so a details refresh keeps already-loaded values.
+  const loadDataValue = useMemo<DataValueLoader | undefined>(() => {
+    if (!hasDataKeys || isSecretData || !clusterId || !objectNamespace || !objectName) {
+      return undefined;
+    }
+    return (key, range) =>
+      GetConfigMapValue(
+        clusterId,
+        objectNamespace,
+        objectName,
+        key,
+        types.DataValueRange.createFrom(range)
+      );
+  }, [hasDataKeys, isSecretData, clusterId, objectNamespace, objectName]);
+
+  // Secret values leave the backend only through the gated, audited reveal.
+  const revealSecretValues = useMemo<SecretRevealer | undefined>(() => {
+    if (!isSecretData || !clusterId || !objectNamespace || !objectName) {
+      return undefined;
+    }
+    return (keys) => RevealSecretValues(clusterId, objectNamespace, objectName, keys);
+  }, [isSecretData, clusterId, objectNamespace, objectName]);
+
+  // Large Secret keys are revealed a range at a time, each range audited on its own.
+  const revealSecretValueRange = useMemo<DataValueLoader | undefined>(() => {
+    if (!isSecretData || !clusterId || !objectNamespace || !objectName) {
+      return undefined;
+    }
+    return (key, range) =>
+      RevealSecretValueRange(
+        clusterId,
+        objectNamespace,
+        objectName,
+        key,
+        types.DataValueRange.createFrom(range)
+      );
+  }, [isSecretData, clusterId, objectNamespace, objectName]);
 
   const utilizationData = useUtilizationData({
     objectData,
@@ -145,12 +209,27 @@ const DetailsTabContent: React.FC<DetailsTabProps> = ({
         {!!dataInfo && (
           <div className="details-section-spaced">
             <DataSection
-              data={dataInfo.data}
-              binaryData={dataInfo.binaryData}
+              keys={dataInfo.keys}
+              loadValue={loadDataValue}
               isSecret={dataInfo.isSecret}
+              revealValues={revealSecretValues}
+              revealValueRange={revealSecretValueRange}
+              revealEnabled={secretRevealEnabled}
             />
           </div>
         )}
+
+        {!!storageChainScope && (
+          <div className="details-section-spaced">
+            <StorageChain scope={storageChainScope} isActive={isActive} />
+          </div>
+        )}
+
+        {!!referencesScope && (
+          <div className="details-section-spaced">
+            <References scope={referencesScope} isActive={isActive} />
+          </div>
+        )}
       </div>
     </div>
   );
@@ -30,6 +30,13 @@ const render = async (ui: React.ReactElement) => {
   };
 };
 
+// Lets pending value loads settle inside act.
+const flushLoads = async () => {
+  await act(async () => {
+    await new Promise((resolve) => setTimeout(resolve, 0));
+  });
+};
+
 describe('DetailsTabData', () => {
   const writeTextMock = vi.fn();
 
@@ -107,4 +114,201 @@ describe('DetailsTabData', () => {
     expect(container.textContent).not.toContain('Copied');
     cleanup();
   });
+
+  it('loads small values immediately and large values on demand, a chunk at a time', async () => {
+    const loadValue = vi.fn(async (key: string, range: { offset: number; limit: number }) => {
+      if (key === 'small') {
+        return { value: 'tiny', nextOffset: 4, truncated: false };
+      }
+      return range.offset === 0
+        ? { value: 'first-', nextOffset: 6, truncated: true }
+        : { value: 'second', nextOffset: 12, truncated: false };
+    });
+
+    const { container, cleanup } = await render(
+      <DataSection
+        keys={[
+          { key: 'small', size: 4 },
+          { key: 'large', size: 5 * 1024 * 1024 },
+        ]}
+        loadValue={loadValue}
+      />
+    );
+    await flushLoads();
+
+    expect(loadValue).toHaveBeenCalledTimes(1);
+    expect(container.textContent).toContain('tiny');
+    expect(container.textContent).toContain('Load value (5.0Mi)');
+
+    const buttonByText = (text: string) =>
+      requireValue(
+        Array.from(container.querySelectorAll('button')).find((button) =>
+          button.textContent?.startsWith(text)
+        ),
+        `expected a "${text}" button in DetailsTabData.test.tsx`
+      );
+
+    await act(async () => {
+      buttonByText('Load value').dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await flushLoads();
+    expect(loadValue).toHaveBeenLastCalledWith('large', expect.objectContaining({ offset: 0 }));
+    expect(container.textContent).toContain('first-');
+
+    await act(async () => {
+      buttonByText('Load more').dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await flushLoads();
+    expect(loadValue).toHaveBeenLastCalledWith('large', expect.objectContaining({ offset: 6 }));
+    expect(container.textContent).toContain('first-second');
+    expect(container.textContent).not.toContain('Load more');
+    cleanup();
+  });
+
+  it('keeps secret keys masked until revealed and shows redacted keys', async () => {
+    const revealValues = vi.fn().mockResolvedValue({
+      values: { password: 'super-secret', cert: 'AAEC' },
+      redactedKeys: ['api_token'],
+      binaryKeys: ['cert'],
+    });
+
+    const { container, cleanup } = await render(
+      <DataSection
+        keys={[
+          { key: 'password', size: 12 },
+          { key: 'api_token', size: 8 },
+          { key: 'cert', size: 3, binary: true },
+        ]}
+        isSecret
+        revealValues={revealValues}
+        revealEnabled
+      />
+    );
+
+    expect(revealValues).not.toHaveBeenCalled();
+    expect(container.textContent).not.toContain('super-secret');
+    expect(container.textContent).toContain('Hidden (12B)');
+
+    const revealButton = requireValue(
+      Array.from(container.querySelectorAll('button')).find(
+        (button) => button.textContent === 'Reveal'
+      ),
+      'expected a Reveal button in DetailsTabData.test.tsx'
+    );
+    await act(async () => {
+      revealButton.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await flushLoads();
+
+    expect(revealValues).toHaveBeenCalledWith(['password', 'api_token', 'cert']);
+    expect(container.textContent).toContain('super-secret');
+    expect(container.textContent).toContain('Redacted by a key pattern');
+    expect(container.querySelector('.data-value.binary-data')?.textContent).toBe('AAEC');
+
+    const hideButton = requireValue(
+      Array.from(container.querySelectorAll('button')).find(
+        (button) => button.textContent === 'Hide'
+      ),
+      'expected a Hide button in DetailsTabData.test.tsx'
+    );
+    await act(async () => {
+      hideButton.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    expect(container.textContent).not.toContain('super-secret');
+    cleanup();
+  });
+
+  it('reveals large secret keys by range instead of in the batched reveal', async () => {
+    const revealValues = vi.fn().mockResolvedValue({ values: { password: 'super-secret' } });
+    const revealValueRange = vi.fn().mockResolvedValue({
+      value: 'bundle-start',
+      nextOffset: 12,
+      truncated: true,
+    });
+
+    const { container, cleanup } = await render(
+      <DataSection
+        keys={[
+          { key: 'password', size: 12 },
+          { key: 'bundle', size: 5 * 1024 * 1024 },
+        ]}
+        isSecret
+        revealValues={revealValues}
+        revealValueRange={revealValueRange}
+        revealEnabled
+      />
+    );
+
+    const buttonByText = (text: string) =>
+      requireValue(
+        Array.from(container.querySelectorAll('button')).find((button) =>
+          button.textContent?.startsWith(text)
+        ),
+        `expected a "${text}" button in DetailsTabData.test.tsx`
+      );
+
+    expect(container.textContent).toContain('Hidden (5.0Mi)');
+    await act(async () => {
+      buttonByText('Reveal').dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await flushLoads();
+
+    expect(revealValues).toHaveBeenCalledWith(['password']);
+    expect(revealValueRange).not.toHaveBeenCalled();
+    expect(container.textContent).toContain('super-secret');
+    expect(container.textContent).toContain('Load value (5.0Mi)');
+
+    await act(async () => {
+      buttonByText('Load value').dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await flushLoads();
+    expect(revealValueRange).toHaveBeenCalledWith('bundle', expect.objectContaining({ offset: 0 }));
+    expect(container.textContent).toContain('bundle-start');
+
+    await act(async () => {
+      buttonByText('Hide').dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    expect(container.textContent).not.toContain('bundle-start');
+    cleanup();
+  });
+
+  it('disables secret reveal when it is turned off in settings', async () => {
+    const revealValues = vi.fn();
+
+    const { container, cleanup } = await render(
+      <DataSection
+        keys={[{ key: 'password', size: 12 }]}
+        isSecret
+        revealValues={revealValues}
+        revealEnabled={false}
+      />
+    );
+
+    const revealButton = requireValue(
+      Array.from(container.querySelectorAll('button')).find(
+        (button) => button.textContent === 'Reveal'
+      ),
+      'expected a Reveal button in DetailsTabData.test.tsx'
+    );
+    expect(revealButton.disabled).toBe(true);
+    expect(container.textContent).toContain('Settings → Object Panel');
+    await act(async () => {
+      revealButton.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    expect(revealValues).not.toHaveBeenCalled();
+    cleanup();
+  });
+
+  it('shows a per-key error when a value fails to load', async () => {
+    const loadValue = vi.fn().mockRejectedValue(new Error('forbidden'));
+
+    const { container, cleanup } = await render(
+      <DataSection keys={[{ key: 'cfg', size: 10 }]} loadValue={loadValue} />
+    );
+    await flushLoads();
+
+    expect(container.textContent).toContain('forbidden');
+    expect(container.textContent).toContain('Load value');
+    cleanup();
+  });
 });
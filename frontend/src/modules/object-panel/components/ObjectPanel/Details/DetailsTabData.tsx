@@ -4,20 +4,173 @@
 
 import { useShortcut } from '@ui/shortcuts';
 import type React from 'react';
-import { useEffect, useMemo, useState } from 'react';
+import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
+import { formatFileSize } from '../Files/filesModel';
 import DetailsTabDataErrorBoundary from './DetailsTabDataErrorBoundary';
+import type { DetailDataKey } from './objectDetailModel';
 import '../shared.css';
 import './DetailsTabData.css';
 
+/** Values at or below this size load as soon as their key renders. */
+const DATA_VALUE_AUTOLOAD_BYTES = 16 * 1024;
+/** Bytes fetched per on-demand load; a multiple of three so base64 chunks join. */
+const DATA_VALUE_CHUNK_BYTES = 192 * 1024;
+
+export interface DataValueChunk {
+  value: string;
+  nextOffset: number;
+  truncated: boolean;
+}
+
+export type DataValueLoader = (
+  key: string,
+  range: { offset: number; limit: number }
+) => Promise<DataValueChunk>;
+
+/** Decoded values from an audited Secret reveal; binary values arrive base64-encoded. */
+export interface SecretReveal {
+  values: Record<string, string>;
+  redactedKeys?: string[];
+  missingKeys?: string[];
+  binaryKeys?: string[];
+}
+
+export type SecretRevealer = (keys: string[]) => Promise<SecretReveal>;
+
 interface DataSectionProps {
   data?: Record<string, string>;
   binaryData?: Record<string, string>;
+  /** Keys with sizes only; values are fetched through loadValue. */
+  keys?: DetailDataKey[];
+  loadValue?: DataValueLoader;
   isSecret?: boolean;
+  /** Secret keys stay masked until revealed through this audited call. */
+  revealValues?: SecretRevealer;
+  /** Loads a range of one large Secret key; each load is audited like revealValues. */
+  revealValueRange?: DataValueLoader;
+  /** Whether Secret reveal is enabled in Settings. */
+  revealEnabled?: boolean;
+}
+
+interface LazyDataItemProps {
+  entry: DetailDataKey;
+  loadValue: DataValueLoader;
+  copied: boolean;
+  onCopy: (copyKey: string, value: string) => void;
 }
 
-const DataSectionInner: React.FC<DataSectionProps> = ({ data, binaryData, isSecret = false }) => {
+/**
+ * One key whose value is fetched on demand. Small values load immediately; large ones wait for
+ * the user and then arrive a chunk at a time so a multi-MB value never blocks the panel.
+ */
+const LazyDataItem: React.FC<LazyDataItemProps> = ({ entry, loadValue, copied, onCopy }) => {
+  const [value, setValue] = useState<string | null>(null);
+  const [nextOffset, setNextOffset] = useState(0);
+  const [truncated, setTruncated] = useState(false);
+  const [loading, setLoading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+  // Drops responses that arrive after the key changed or a newer load started.
+  const requestRef = useRef(0);
+
+  const loadChunk = useCallback(
+    async (offset: number) => {
+      const request = ++requestRef.current;
+      setLoading(true);
+      setError(null);
+      try {
+        const chunk = await loadValue(entry.key, { offset, limit: DATA_VALUE_CHUNK_BYTES });
+        if (request !== requestRef.current) {
+          return;
+        }
+        setValue((prev) => (offset === 0 ? chunk.value : (prev ?? '') + chunk.value));
+        setNextOffset(chunk.nextOffset);
+        setTruncated(chunk.truncated);
+      } catch (err) {
+        if (request === requestRef.current) {
+          setError(err instanceof Error ? err.message : String(err));
+        }
+      } finally {
+        if (request === requestRef.current) {
+          setLoading(false);
+        }
+      }
+    },
+    [entry.key, loadValue]
+  );
+
+  useEffect(() => {
+    requestRef.current += 1;
+    setValue(null);
+    setNextOffset(0);
+    setTruncated(false);
+    setLoading(false);
+    setError(null);
+    if (entry.size <= DATA_VALUE_AUTOLOAD_BYTES) {
+      void loadChunk(0);
+    }
+  }, [entry.size, loadChunk]);
+
+  const copyKey = entry.binary ? `binary-${entry.key}` : entry.key;
+
+  return (
+    <div className="data-item">
+      <span className="data-label">{entry.key}</span>
+      <div className="data-value-container">
+        {value !== null && (
+          <button
+            type="button"
+            className={`data-value ${entry.binary ? 'binary-data' : ''} ${copied ? 'copied' : ''}`}
+            onClick={() => onCopy(copyKey, value)}
+            title="Click to copy"
+          >
+            {value}
+          </button>
+        )}
+        {copied && <span className="copy-feedback">Copied</span>}
+        <div className="data-value-actions">
+          {value === null && !loading && (
+            <button
+              type="button"
+              className="button generic small"
+              onClick={() => void loadChunk(0)}
+            >
+              Load value ({formatFileSize(entry.size)})
+            </button>
+          )}
+          {value !== null && truncated && !loading && (
+            <button
+              type="button"
+              className="button generic small"
+              onClick={() => void loadChunk(nextOffset)}
+            >
+              Load more ({formatFileSize(nextOffset)} of {formatFileSize(entry.size)})
+            </button>
+          )}
+          {loading && <span className="data-value-status">Loading…</span>}
+          {!!error && <span className="data-value-status error">{error}</span>}
+        </div>
+      </div>
+    </div>
+  );
+};
+
+const DataSectionInner: React.FC<DataSectionProps> = ({
+  data,
+  binaryData,
+  keys,
+  loadValue,
+  isSecret = false,
+  revealValues,
+  revealValueRange,
+  revealEnabled = false,
+}) => {
   const [showDecoded, setShowDecoded] = useState(false);
   const [copiedKey, setCopiedKey] = useState<string | null>(null);
+  const [revealed, setRevealed] = useState<SecretReveal | null>(null);
+  const [revealing, setRevealing] = useState(false);
+  const [revealError, setRevealError] = useState<string | null>(null);
+  // Drops a reveal that resolves after the Secret changed or the values were hidden.
+  const revealRequestRef = useRef(0);
 
   // Reset showDecoded when data changes (switching to a different secret)
   useEffect(() => {
@@ -25,6 +178,17 @@ const DataSectionInner: React.FC<DataSectionProps> = ({ data, binaryData, isSecr
     setShowDecoded(false);
   }, [data]);
 
+  // Hide revealed values when switching Secrets or when reveal is turned off.
+  useEffect(() => {
+    void revealValues;
+    void revealValueRange;
+    void revealEnabled;
+    revealRequestRef.current += 1;
+    setRevealed(null);
+    setRevealing(false);
+    setRevealError(null);
+  }, [revealValues, revealValueRange, revealEnabled]);
+
   // Handle copying value to clipboard
   const handleCopyValue = (key: string, value: string) => {
     navigator.clipboard
@@ -42,7 +206,50 @@ const DataSectionInner: React.FC<DataSectionProps> = ({ data, binaryData, isSecr
   // Safely check if we have any data to display
   const dataKeys = useMemo(() => (data ? Object.keys(data) : []), [data]);
   const binaryKeys = useMemo(() => (binaryData ? Object.keys(binaryData) : []), [binaryData]);
-  const hasData = dataKeys.length > 0 || binaryKeys.length > 0;
+  const lazyKeys = useMemo(() => (loadValue ? (keys ?? []) : []), [keys, loadValue]);
+  const secretKeys = useMemo(
+    () => (isSecret && revealValues ? (keys ?? []) : []),
+    [isSecret, keys, revealValues]
+  );
+  const hasData =
+    dataKeys.length > 0 || binaryKeys.length > 0 || lazyKeys.length > 0 || secretKeys.length > 0;
+  // Large Secret keys load by range after Reveal instead of riding in the batched reveal.
+  const isRangeSecretKey = useCallback(
+    (entry: DetailDataKey) => !!revealValueRange && entry.size > DATA_VALUE_AUTOLOAD_BYTES,
+    [revealValueRange]
+  );
+
+  const toggleReveal = useCallback(async () => {
+    if (revealed) {
+      revealRequestRef.current += 1;
+      setRevealed(null);
+      return;
+    }
+    if (!revealValues || !revealEnabled) {
+      return;
+    }
+    const request = ++revealRequestRef.current;
+    setRevealing(true);
+    setRevealError(null);
+    try {
+      const revealKeys = secretKeys
+        .filter((entry) => !isRangeSecretKey(entry))
+        .map((entry) => entry.key);
+      // An empty key list reveals every key, so skip the call when only large keys remain.
+      const result = revealKeys.length > 0 ? await revealValues(revealKeys) : { values: {} };
+      if (request === revealRequestRef.current) {
+        setRevealed(result);
+      }
+    } catch (err) {
+      if (request === revealRequestRef.current) {
+        setRevealError(err instanceof Error ? err.message : String(err));
+      }
+    } finally {
+      if (request === revealRequestRef.current) {
+        setRevealing(false);
+      }
+    }
+  }, [revealed, revealValues, revealEnabled, secretKeys, isRangeSecretKey]);
 
   // Compute displayed data based on isSecret and showDecoded state
   const displayData = useMemo(() => {
@@ -75,13 +282,17 @@ const DataSectionInner: React.FC<DataSectionProps> = ({ data, binaryData, isSecr
   useShortcut({
     key: 's',
     handler: () => {
+      if (secretKeys.length > 0) {
+        void toggleReveal();
+        return true;
+      }
       if (isSecret) {
         setShowDecoded((prev) => !prev);
         return true;
       }
       return false;
     },
-    description: 'Toggle encode/decode (when viewing secret data)',
+    description: 'Reveal or hide values (when viewing secret data)',
     category: 'Object Panel',
     enabled: hasData, // Only active when data is available
     priority: isSecret ? 20 : 0,
@@ -91,6 +302,126 @@ const DataSectionInner: React.FC<DataSectionProps> = ({ data, binaryData, isSecr
     return null;
   }
 
+  if (secretKeys.length > 0) {
+    const redactedKeys = new Set(revealed?.redactedKeys ?? []);
+    const missingKeys = new Set(revealed?.missingKeys ?? []);
+    const revealedBinaryKeys = new Set(revealed?.binaryKeys ?? []);
+    const textEntries = secretKeys.filter((entry) => !entry.binary);
+    const binaryEntries = secretKeys.filter((entry) => entry.binary);
+    const renderEntry = (entry: DetailDataKey) => {
+      const copyKey = `${entry.binary ? 'binary-' : ''}${entry.key}`;
+      if (revealed && revealValueRange && isRangeSecretKey(entry)) {
+        return (
+          <LazyDataItem
+            key={copyKey}
+            entry={entry}
+            loadValue={revealValueRange}
+            copied={copiedKey === copyKey}
+            onCopy={handleCopyValue}
+          />
+        );
+      }
+      const value = revealed?.values[entry.key];
+      let status = `Hidden (${formatFileSize(entry.size)})`;
+      if (redactedKeys.has(entry.key)) {
+        status = 'Redacted by a key pattern';
+      } else if (missingKeys.has(entry.key)) {
+        status = 'No longer in the Secret';
+      }
+      return (
+        <div key={copyKey} className="data-item">
+          <span className="data-label">{entry.key}</span>
+          <div className="data-value-container">
+            {value !== undefined ? (
+              <button
+                type="button"
+                className={`data-value ${revealedBinaryKeys.has(entry.key) ? 'binary-data' : ''} ${copiedKey === copyKey ? 'copied' : ''}`}
+                onClick={() => handleCopyValue(copyKey, value)}
+                title="Click to copy"
+              >
+                {value}
+              </button>
+            ) : (
+              <div className="data-value-actions">
+                <span className="data-value-status">{status}</span>
+              </div>
+            )}
+            {copiedKey === copyKey && <span className="copy-feedback">Copied</span>}
+          </div>
+        </div>
+      );
+    };
+    let revealTitle = 'Reveal values (recorded in the audit log)';
+    if (revealed) {
+      revealTitle = 'Hide values';
+    } else if (!revealEnabled) {
+      revealTitle = 'Revealing Secret values is disabled in Settings';
+    }
+    return (
+      <div className="object-panel-section">
+        <div className="data-section-header">
+          <div className="object-panel-section-title">Data</div>
+          <button
+            type="button"
+            className="button generic small"
+            onClick={() => void toggleReveal()}
+            disabled={!revealed && (!revealEnabled || revealing)}
+            title={revealTitle}
+          >
+            {revealed ? 'Hide' : 'Reveal'}
+          </button>
+        </div>
+        {!revealEnabled && (
+          <div className="data-section-note">
+            Secret values are hidden. Enable Reveal Secret values in Settings → Object Panel to
+            show them.
+          </div>
+        )}
+        {revealing && <span className="data-value-status">Revealing…</span>}
+        {!!revealError && <span className="data-value-status error">{revealError}</span>}
+        <div className="object-panel-section-grid">
+          {textEntries.map(renderEntry)}
+          {binaryEntries.length > 0 && (
+            <>
+              {textEntries.length > 0 && <div className="data-section-divider">Binary Data</div>}
+              {binaryEntries.map(renderEntry)}
+            </>
+          )}
+        </div>
+      </div>
+    );
+  }
+
+  if (loadValue && lazyKeys.length > 0) {
+    const textEntries = lazyKeys.filter((entry) => !entry.binary);
+    const binaryEntries = lazyKeys.filter((entry) => entry.binary);
+    const renderEntry = (entry: DetailDataKey) => (
+      <LazyDataItem
+        key={`${entry.binary ? 'binary-' : ''}${entry.key}`}
+        entry={entry}
+        loadValue={loadValue}
+        copied={copiedKey === `${entry.binary ? 'binary-' : ''}${entry.key}`}
+        onCopy={handleCopyValue}
+      />
+    );
+    return (
+      <div className="object-panel-section">
+        <div className="data-section-header">
+          <div className="object-panel-section-title">Data</div>
+        </div>
+        <div className="object-panel-section-grid">
+          {textEntries.map(renderEntry)}
+          {binaryEntries.length > 0 && (
+            <>
+              {textEntries.length > 0 && <div className="data-section-divider">Binary Data</div>}
+              {binaryEntries.map(renderEntry)}
+            </>
+          )}
+        </div>
+      </div>
+    );
+  }
+
   return (
     <div className="object-panel-section">
       <div className="data-section-header">
@@ -0,0 +1,148 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/DetailsTabReferences.test.tsx
+ *
+ * Verifies the Referenced By section renders object-references referrers and
+ * opens a referrer in the object panel.
+ */
+
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+
+const referencesState = vi.hoisted(() => ({
+  useRefreshDomainHandle: vi.fn(),
+  data: null as unknown,
+}));
+
+const objectPanelMocks = vi.hoisted(() => ({
+  openWithObject: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  useRefreshDomainHandle: (options: unknown) => {
+    referencesState.useRefreshDomainHandle(options);
+    return { state: { status: 'ready', data: referencesState.data, error: null } };
+  },
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => objectPanelMocks,
+}));
+
+vi.mock('@/utils/errorHandler', () => ({
+  errorHandler: { handle: vi.fn() },
+}));
+
+import References from './DetailsTabReferences';
+
+const scope = 'cluster-a|default:/v1:ConfigMap:app-config';
+
+const payload = {
+  clusterId: 'cluster-a',
+  clusterName: 'Cluster A',
+  target: {
+    clusterId: 'cluster-a',
+    group: '',
+    version: 'v1',
+    kind: 'ConfigMap',
+    namespace: 'default',
+    name: 'app-config',
+  },
+  referrers: [
+    {
+      ref: {
+        clusterId: 'cluster-a',
+        group: 'apps',
+        version: 'v1',
+        kind: 'Deployment',
+        resource: 'deployments',
+        namespace: 'default',
+        name: 'web',
+      },
+      type: 'uses',
+      label: 'uses',
+      tracedBy: 'volume.configMap',
+    },
+    {
+      ref: {
+        clusterId: 'cluster-a',
+        group: '',
+        version: 'v1',
+        kind: 'Pod',
+        resource: 'pods',
+        namespace: 'default',
+        name: 'web-pod',
+      },
+      type: 'uses',
+      label: 'uses',
+      tracedBy: 'envFrom.configMapRef',
+    },
+  ],
+};
+
+describe('DetailsTabReferences', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+    referencesState.data = null;
+    referencesState.useRefreshDomainHandle.mockClear();
+    objectPanelMocks.openWithObject.mockClear();
+  });
+
+  afterEach(() => {
+    act(() => {
+      root.unmount();
+    });
+    container.remove();
+  });
+
+  it('subscribes to the object-references domain while active', () => {
+    act(() => {
+      root.render(<References scope={scope} isActive />);
+    });
+
+    expect(referencesState.useRefreshDomainHandle).toHaveBeenCalledWith(
+      expect.objectContaining({
+        domain: 'object-references',
+        scope,
+        enabled: true,
+        preserveState: true,
+        fetchOnEnable: 'startup',
+      })
+    );
+  });
+
+  it('renders nothing when no object references the target', () => {
+    referencesState.data = { ...payload, referrers: [] };
+    act(() => {
+      root.render(<References scope={scope} isActive />);
+    });
+
+    expect(container.textContent).toBe('');
+  });
+
+  it('lists referrers and opens one in the panel', () => {
+    referencesState.data = payload;
+    act(() => {
+      root.render(<References scope={scope} isActive />);
+    });
+
+    const rows = container.querySelectorAll('.references-row');
+    expect(rows).toHaveLength(2);
+    expect(rows[0].textContent).toContain('Deployment');
+    expect(rows[0].textContent).toContain('default/web');
+    expect(rows[0].textContent).toContain('uses via volume.configMap');
+
+    const link = rows[1].querySelector<HTMLButtonElement>('.references-row-link');
+    act(() => {
+      link?.click();
+    });
+    expect(objectPanelMocks.openWithObject).toHaveBeenCalledWith(
+      expect.objectContaining({ kind: 'Pod', name: 'web-pod', clusterId: 'cluster-a' })
+    );
+  });
+});
@@ -0,0 +1,94 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/DetailsTabReferences.tsx
+ *
+ * "Referenced By" section: every object in the cluster that references this one
+ * (pods mounting a ConfigMap, ingresses routing to a Service, bindings granting a
+ * Role, workloads using a ServiceAccount). Backed by the object-references scoped
+ * domain, which the backend builds from the informer caches, so the list follows
+ * the watch streams while the Details tab is active.
+ */
+
+import { buildResolvedFromMapRef } from '@modules/object-map/objectMapNavigation';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import type React from 'react';
+import { useCallback } from 'react';
+import { useRefreshDomainHandle } from '@/core/data-access';
+import type {
+  ObjectMapReference,
+  ObjectReferencesSnapshotPayload,
+  ObjectReferrer,
+} from '@/core/refresh/types';
+import { errorHandler } from '@/utils/errorHandler';
+import '../shared.css';
+import './DetailsTabReferences.css';
+
+interface ReferencesProps {
+  // Same object scope as the Map tab, owned by ObjectPanel via getObjectPanelScopes.
+  scope: string | null;
+  isActive?: boolean;
+}
+
+const describeRef = (ref: ObjectMapReference): string =>
+  ref.namespace ? `${ref.namespace}/${ref.name}` : ref.name;
+
+const referrerKey = (referrer: ObjectReferrer): string =>
+  [referrer.ref.kind, describeRef(referrer.ref), referrer.type, referrer.tracedBy ?? ''].join('|');
+
+const References: React.FC<ReferencesProps> = ({ scope, isActive }) => {
+  const { openWithObject } = useObjectPanel();
+  const handleFetchError = useCallback((error: unknown) => {
+    errorHandler.handle(error instanceof Error ? error : new Error(String(error)), {
+      source: 'object-references-fetch',
+    });
+  }, []);
+  const { state: snapshot } = useRefreshDomainHandle({
+    domain: 'object-references',
+    scope,
+    enabled: Boolean(isActive && scope),
+    preserveState: true,
+    fetchOnEnable: isActive && scope ? 'startup' : false,
+    onFetchError: handleFetchError,
+  });
+
+  const handleOpen = useCallback(
+    (ref: ObjectMapReference) => {
+      const resolved = buildResolvedFromMapRef(ref);
+      if (resolved) {
+        openWithObject(resolved);
+      }
+    },
+    [openWithObject]
+  );
+
+  const payload = snapshot.data as ObjectReferencesSnapshotPayload | null;
+  const referrers = payload?.referrers ?? [];
+  if (referrers.length === 0) {
+    return null;
+  }
+
+  return (
+    <div className="object-panel-section">
+      <div className="object-panel-section-title">Referenced By</div>
+      <ul className="references-list">
+        {referrers.map((referrer) => (
+          <li key={referrerKey(referrer)} className="references-row">
+            <span className="references-row-kind">{referrer.ref.kind}</span>
+            <button
+              type="button"
+              className="references-row-link"
+              onClick={() => handleOpen(referrer.ref)}
+            >
+              {describeRef(referrer.ref)}
+            </button>
+            <span className="references-row-meta">
+              {referrer.label}
+              {referrer.tracedBy ? ` via ${referrer.tracedBy}` : ''}
+            </span>
+          </li>
+        ))}
+      </ul>
+    </div>
+  );
+};
+
+export default References;
@@ -0,0 +1,152 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/DetailsTabStorageChain.test.tsx
+ *
+ * Verifies the Storage Chain section renders the object-storage-chain payload and
+ * opens a chain member in the object panel.
+ */
+
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+
+const chainState = vi.hoisted(() => ({
+  useRefreshDomainHandle: vi.fn(),
+  data: null as unknown,
+}));
+
+const objectPanelMocks = vi.hoisted(() => ({
+  openWithObject: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  useRefreshDomainHandle: (options: unknown) => {
+    chainState.useRefreshDomainHandle(options);
+    return { state: { status: 'ready', data: chainState.data, error: null } };
+  },
+}));
+
+vi.mock('@modules/object-panel/hooks/useObjectPanel', () => ({
+  useObjectPanel: () => objectPanelMocks,
+}));
+
+vi.mock('@/utils/errorHandler', () => ({
+  errorHandler: { handle: vi.fn() },
+}));
+
+import StorageChain from './DetailsTabStorageChain';
+
+const scope = 'cluster-a|default:/v1:PersistentVolumeClaim:data';
+
+const ref = (kind: string, name: string, namespace?: string, group = '') => ({
+  clusterId: 'cluster-a',
+  group,
+  version: 'v1',
+  kind,
+  namespace,
+  name,
+});
+
+const payload = {
+  clusterId: 'cluster-a',
+  clusterName: 'Cluster A',
+  target: ref('PersistentVolumeClaim', 'data', 'default'),
+  claim: {
+    ref: ref('PersistentVolumeClaim', 'data', 'default'),
+    status: { state: 'Bound', label: 'Bound' },
+  },
+  volume: { ref: ref('PersistentVolume', 'pv-data'), status: { state: 'Bound', label: 'Bound' } },
+  storageClass: { ref: ref('StorageClass', 'fast', undefined, 'storage.k8s.io') },
+  pods: [
+    {
+      ref: ref('Pod', 'db-0', 'default'),
+      status: { state: 'Running', label: 'Running' },
+      node: 'node-a',
+    },
+    { ref: ref('Pod', 'db-1', 'default') },
+  ],
+  nodes: [{ ref: ref('Node', 'node-a') }],
+};
+
+describe('DetailsTabStorageChain', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+    chainState.data = null;
+    chainState.useRefreshDomainHandle.mockClear();
+    objectPanelMocks.openWithObject.mockClear();
+  });
+
+  afterEach(() => {
+    act(() => {
+      root.unmount();
+    });
+    container.remove();
+  });
+
+  it('subscribes to the object-storage-chain domain while active', () => {
+    act(() => {
+      root.render(<StorageChain scope={scope} isActive />);
+    });
+
+    expect(chainState.useRefreshDomainHandle).toHaveBeenCalledWith(
+      expect.objectContaining({
+        domain: 'object-storage-chain',
+        scope,
+        enabled: true,
+        preserveState: true,
+        fetchOnEnable: 'startup',
+      })
+    );
+  });
+
+  it('lists the chain and opens a member in the panel', () => {
+    chainState.data = payload;
+    act(() => {
+      root.render(<StorageChain scope={scope} isActive />);
+    });
+
+    const rows = Array.from(container.querySelectorAll('.storage-chain-row'));
+    expect(rows.map((row) => row.querySelector('.storage-chain-row-label')?.textContent)).toEqual([
+      'Claim',
+      'Volume',
+      'Storage Class',
+      'Mounted By',
+      'Mounted By',
+      'Node',
+    ]);
+    expect(rows[3].textContent).toContain('default/db-0');
+    expect(rows[3].textContent).toContain('Running');
+    expect(rows[3].textContent).toContain('on node-a');
+    expect(rows[4].textContent).toContain('not scheduled');
+
+    const link = rows[1].querySelector<HTMLButtonElement>('.storage-chain-row-link');
+    act(() => {
+      link?.click();
+    });
+    expect(objectPanelMocks.openWithObject).toHaveBeenCalledWith(
+      expect.objectContaining({ kind: 'PersistentVolume', name: 'pv-data', clusterId: 'cluster-a' })
+    );
+  });
+
+  it('shows warnings for an unbound claim', () => {
+    chainState.data = {
+      ...payload,
+      volume: undefined,
+      pods: [],
+      nodes: [],
+      warnings: ['PersistentVolumeClaim default/data is not bound to a volume'],
+    };
+    act(() => {
+      root.render(<StorageChain scope={scope} isActive />);
+    });
+
+    expect(container.querySelectorAll('.storage-chain-row')).toHaveLength(2);
+    expect(container.querySelector('.storage-chain-warning')?.textContent).toBe(
+      'PersistentVolumeClaim default/data is not bound to a volume'
+    );
+  });
+});
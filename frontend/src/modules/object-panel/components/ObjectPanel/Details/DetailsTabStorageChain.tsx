@@ -0,0 +1,137 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/DetailsTabStorageChain.tsx
+ *
+ * "Storage Chain" section for PersistentVolumeClaims and PersistentVolumes: the
+ * claim, the volume it binds, the StorageClass, the pods mounting the claim, and
+ * the nodes those pods run on, each opening in a panel. Backed by the
+ * object-storage-chain scoped domain, which the backend resolves from the same
+ * caches as the object map.
+ */
+
+import { buildResolvedFromMapRef } from '@modules/object-map/objectMapNavigation';
+import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
+import type React from 'react';
+import { useCallback } from 'react';
+import { useRefreshDomainHandle } from '@/core/data-access';
+import type {
+  ObjectMapReference,
+  ObjectMapStatus,
+  ObjectStorageChainSnapshotPayload,
+} from '@/core/refresh/types';
+import { errorHandler } from '@/utils/errorHandler';
+import '../shared.css';
+import './DetailsTabStorageChain.css';
+
+interface StorageChainProps {
+  // Same object scope as the Map tab, owned by ObjectPanel via getObjectPanelScopes.
+  scope: string | null;
+  isActive?: boolean;
+}
+
+interface ChainRow {
+  key: string;
+  label: string;
+  ref: ObjectMapReference;
+  status?: ObjectMapStatus;
+  meta?: string;
+}
+
+const describeRef = (ref: ObjectMapReference): string =>
+  ref.namespace ? `${ref.namespace}/${ref.name}` : ref.name;
+
+const buildRows = (payload: ObjectStorageChainSnapshotPayload): ChainRow[] => {
+  const rows: ChainRow[] = [];
+  const links = [
+    ['Claim', payload.claim],
+    ['Volume', payload.volume],
+    ['Storage Class', payload.storageClass],
+  ] as const;
+  links.forEach(([label, link]) => {
+    if (link) {
+      rows.push({ key: label, label, ref: link.ref, status: link.status });
+    }
+  });
+  (payload.pods ?? []).forEach((pod) => {
+    rows.push({
+      key: `pod|${describeRef(pod.ref)}`,
+      label: 'Mounted By',
+      ref: pod.ref,
+      status: pod.status,
+      meta: pod.node ? `on ${pod.node}` : 'not scheduled',
+    });
+  });
+  (payload.nodes ?? []).forEach((node) => {
+    rows.push({
+      key: `node|${node.ref.name}`,
+      label: 'Node',
+      ref: node.ref,
+      status: node.status,
+    });
+  });
+  return rows;
+};
+
+const StorageChain: React.FC<StorageChainProps> = ({ scope, isActive }) => {
+  const { openWithObject } = useObjectPanel();
+  const handleFetchError = useCallback((error: unknown) => {
+    errorHandler.handle(error instanceof Error ? error : new Error(String(error)), {
+      source: 'object-storage-chain-fetch',
+    });
+  }, []);
+  const { state: snapshot } = useRefreshDomainHandle({
+    domain: 'object-storage-chain',
+    scope,
+    enabled: Boolean(isActive && scope),
+    preserveState: true,
+    fetchOnEnable: isActive && scope ? 'startup' : false,
+    onFetchError: handleFetchError,
+  });
+
+  const handleOpen = useCallback(
+    (ref: ObjectMapReference) => {
+      const resolved = buildResolvedFromMapRef(ref);
+      if (resolved) {
+        openWithObject(resolved);
+      }
+    },
+    [openWithObject]
+  );
+
+  const payload = snapshot.data as ObjectStorageChainSnapshotPayload | null;
+  if (!payload) {
+    return null;
+  }
+  const rows = buildRows(payload);
+  const warnings = payload.warnings ?? [];
+
+  return (
+    <div className="object-panel-section">
+      <div className="object-panel-section-title">Storage Chain</div>
+      <ul className="storage-chain-list">
+        {rows.map((row) => (
+          <li key={row.key} className="storage-chain-row">
+            <span className="storage-chain-row-label">{row.label}</span>
+            <button
+              type="button"
+              className="storage-chain-row-link"
+              onClick={() => handleOpen(row.ref)}
+            >
+              {describeRef(row.ref)}
+            </button>
+            {row.status?.label && (
+              <span className="storage-chain-row-meta">{row.status.label}</span>
+            )}
+            {row.meta && <span className="storage-chain-row-meta">{row.meta}</span>}
+          </li>
+        ))}
+      </ul>
+      {warnings.map((warning) => (
+        <div key={warning} className="storage-chain-warning">
+          {warning}
+        </div>
+      ))}
+    </div>
+  );
+};
+
+export default StorageChain;
@@ -93,8 +93,11 @@ describe('ConfigMapOverview', () => {
       configMapDetails: {
         name: 'app-config',
         namespace: 'default',
-        data: { key1: 'value', key2: 'value' },
-        binaryData: { bin: 'AAAA' },
+        keys: [
+          { key: 'key1', size: 5 },
+          { key: 'key2', size: 5 },
+          { key: 'bin', size: 3, binary: true },
+        ],
         usedBy: [podRef('pod-a', 'default'), podRef('pod-b', 'default')],
         labels: {},
         annotations: {},
@@ -128,8 +131,7 @@ describe('ConfigMapOverview', () => {
       configMapDetails: {
         name: 'unused-config',
         namespace: 'team',
-        data: {},
-        binaryData: {},
+        keys: [],
         usedBy: [],
         labels: {},
         annotations: {},
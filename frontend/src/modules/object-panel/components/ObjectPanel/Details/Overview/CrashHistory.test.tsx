@@ -0,0 +1,103 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/CrashHistory.test.tsx
+ */
+
+import type { crashhistory } from '@wailsjs/go/models';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { buildDayBuckets, CrashHistory } from './CrashHistory';
+
+const mocks = vi.hoisted(() => ({
+  readWorkloadCrashHistory: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  readWorkloadCrashHistory: mocks.readWorkloadCrashHistory,
+  requestData: async ({ read }: { read: () => Promise<unknown> }) => ({
+    status: 'executed',
+    data: await read(),
+  }),
+}));
+
+const event = (finishedAt: string, reason: string, extra: Partial<crashhistory.Event> = {}) =>
+  ({
+    namespace: 'prod',
+    pod: 'api-1',
+    container: 'app',
+    restartCount: 1,
+    exitCode: reason === 'OOMKilled' ? 137 : 1,
+    reason,
+    startedAt: finishedAt,
+    finishedAt,
+    ...extra,
+  }) as crashhistory.Event;
+
+describe('CrashHistory', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.readWorkloadCrashHistory.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  it('counts terminations per day, including missed restarts', () => {
+    const now = new Date(2024, 5, 8, 12, 0, 0);
+    const buckets = buildDayBuckets(
+      [
+        event(new Date(2024, 5, 8, 9, 0, 0).toISOString(), 'OOMKilled', { missed: 2 }),
+        event(new Date(2024, 5, 6, 9, 0, 0).toISOString(), 'Error'),
+        event(new Date(2024, 4, 20, 9, 0, 0).toISOString(), 'OOMKilled'),
+      ],
+      now
+    );
+    expect(buckets).toHaveLength(7);
+    expect(buckets[6]).toMatchObject({ total: 3, oomKilled: 1 });
+    expect(buckets[4]).toMatchObject({ total: 1, oomKilled: 0 });
+    expect(buckets.reduce((sum, bucket) => sum + bucket.total, 0)).toBe(4);
+  });
+
+  it('renders the recorded terminations for the workload', async () => {
+    const finished = new Date(Date.now() - 60 * 60 * 1000).toISOString();
+    mocks.readWorkloadCrashHistory.mockResolvedValue({
+      clusterId: 'c1',
+      namespace: 'prod',
+      kind: 'Deployment',
+      name: 'api',
+      events: [event(finished, 'OOMKilled'), event(finished, 'Error', { pod: 'api-2' })],
+      observedSince: finished,
+    });
+
+    await act(async () => {
+      root.render(<CrashHistory clusterId="c1" namespace="prod" kind="Deployment" name="api" />);
+      await Promise.resolve();
+    });
+
+    expect(mocks.readWorkloadCrashHistory).toHaveBeenCalledWith('c1', 'prod', 'Deployment', 'api');
+    expect(container.querySelector('.crash-history-summary')?.textContent).toContain(
+      '2 container terminations, 1 OOMKilled'
+    );
+    const rows = container.querySelectorAll('.crash-history-event');
+    expect(rows).toHaveLength(2);
+    expect(rows[0].classList.contains('crash-history-event--oom')).toBe(true);
+    expect(rows[0].textContent).toContain('OOMKilled (exit 137)');
+    expect(rows[1].textContent).toContain('api-2/app');
+  });
+
+  it('renders nothing when no termination is recorded', async () => {
+    mocks.readWorkloadCrashHistory.mockResolvedValue({ events: [] });
+    await act(async () => {
+      root.render(<CrashHistory clusterId="c1" namespace="prod" kind="Pod" name="web" />);
+      await Promise.resolve();
+    });
+    expect(container.querySelector('[data-testid="crash-history"]')).toBeNull();
+  });
+});
@@ -0,0 +1,166 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/CrashHistory.tsx
+ *
+ * Container termination history for a workload or pod, as recorded from the pod stream this
+ * session. A seven-day strip answers "has this been OOMing all week?" at a glance; the list
+ * below shows each termination with its reason, exit code and pod/container.
+ */
+
+import { readWorkloadCrashHistory, requestData } from '@/core/data-access';
+import { formatAge, formatFullDate } from '@/utils/ageFormatter';
+import type { crashhistory } from '@wailsjs/go/models';
+import { useEffect, useState } from 'react';
+import './CrashHistory.css';
+
+interface CrashHistoryProps {
+  clusterId?: string;
+  namespace: string;
+  kind: string;
+  name: string;
+}
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+const STRIP_DAYS = 7;
+const LIST_LIMIT = 10;
+
+interface DayBucket {
+  label: string;
+  total: number;
+  oomKilled: number;
+}
+
+const isOOMKilled = (event: crashhistory.Event) => event.reason === 'OOMKilled';
+
+// buildDayBuckets counts terminations per local day, oldest day first, ending today.
+export const buildDayBuckets = (events: crashhistory.Event[], now: Date): DayBucket[] => {
+  const today = new Date(now.getFullYear(), now.getMonth(), now.getDate()).getTime();
+  const buckets: DayBucket[] = [];
+  for (let i = STRIP_DAYS - 1; i >= 0; i--) {
+    const day = new Date(today - i * DAY_MS);
+    buckets.push({
+      label: day.toLocaleDateString(undefined, { weekday: 'short' }),
+      total: 0,
+      oomKilled: 0,
+    });
+  }
+  for (const event of events) {
+    const finished = new Date(event.finishedAt);
+    const dayStart = new Date(
+      finished.getFullYear(),
+      finished.getMonth(),
+      finished.getDate()
+    ).getTime();
+    const index = STRIP_DAYS - 1 - Math.round((today - dayStart) / DAY_MS);
+    if (index < 0 || index >= STRIP_DAYS) {
+      continue;
+    }
+    buckets[index].total += 1 + (event.missed ?? 0);
+    if (isOOMKilled(event)) {
+      buckets[index].oomKilled += 1;
+    }
+  }
+  return buckets;
+};
+
+const describeTermination = (event: crashhistory.Event): string => {
+  const reason = event.reason || 'Terminated';
+  const signal = event.signal ? `, signal ${event.signal}` : '';
+  return `${reason} (exit ${event.exitCode}${signal})`;
+};
+
+export const CrashHistory = ({ clusterId, namespace, kind, name }: CrashHistoryProps) => {
+  const [timeline, setTimeline] = useState<crashhistory.Timeline | null>(null);
+
+  useEffect(() => {
+    if (!clusterId || !name) {
+      setTimeline(null);
+      return;
+    }
+    let cancelled = false;
+    requestData({
+      resource: 'workload-crash-history',
+      reason: 'startup',
+      read: () => readWorkloadCrashHistory(clusterId, namespace, kind, name),
+    })
+      .then((result) => {
+        if (!cancelled) {
+          setTimeline(result.status === 'executed' ? (result.data ?? null) : null);
+        }
+      })
+      .catch(() => {
+        if (!cancelled) {
+          setTimeline(null);
+        }
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [clusterId, namespace, kind, name]);
+
+  const events = timeline?.events ?? [];
+  if (events.length === 0) {
+    return null;
+  }
+
+  const oomKilled = events.filter(isOOMKilled).length;
+  const buckets = buildDayBuckets(events, new Date());
+  const peak = Math.max(1, ...buckets.map((bucket) => bucket.total));
+  const shown = events.slice(0, LIST_LIMIT);
+  const rest = events.slice(LIST_LIMIT);
+
+  const renderEvent = (event: crashhistory.Event) => (
+    <div
+      key={`${event.pod}/${event.container}/${event.finishedAt}`}
+      className={`crash-history-event${isOOMKilled(event) ? ' crash-history-event--oom' : ''}`}
+    >
+      <span className="crash-history-event-time" title={formatFullDate(event.finishedAt)}>
+        {formatAge(event.finishedAt)} ago
+      </span>
+      <span className="crash-history-event-reason">{describeTermination(event)}</span>
+      <span className="crash-history-event-target">
+        {kind === 'Pod' ? event.container : `${event.pod}/${event.container}`}
+        {event.missed ? ` (+${event.missed} more restarts)` : ''}
+      </span>
+    </div>
+  );
+
+  return (
+    <div className="crash-history" data-testid="crash-history">
+      <div className="crash-history-summary">
+        {events.length} container termination{events.length === 1 ? '' : 's'}
+        {oomKilled > 0 ? `, ${oomKilled} OOMKilled` : ''}
+        {timeline?.observedSince
+          ? ` (watching since ${formatFullDate(timeline.observedSince)})`
+          : ''}
+      </div>
+      <div className="crash-history-strip">
+        {buckets.map((bucket) => (
+          <div
+            key={bucket.label}
+            className="crash-history-day"
+            title={`${bucket.total} termination(s), ${bucket.oomKilled} OOMKilled`}
+          >
+            <div className="crash-history-day-bar-track">
+              <div
+                className={
+                  bucket.oomKilled > 0
+                    ? 'crash-history-day-bar crash-history-day-bar--oom'
+                    : 'crash-history-day-bar'
+                }
+                style={{ height: `${(bucket.total / peak) * 100}%` }}
+              />
+            </div>
+            <span className="crash-history-day-label">{bucket.label}</span>
+          </div>
+        ))}
+      </div>
+      <div className="crash-history-events">{shown.map(renderEvent)}</div>
+      {rest.length > 0 ? (
+        <details className="crash-history-more">
+          <summary>{rest.length} more</summary>
+          <div className="crash-history-events">{rest.map(renderEvent)}</div>
+        </details>
+      ) : null}
+    </div>
+  );
+};
@@ -0,0 +1,130 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/HpaScalingHistory.test.tsx
+ */
+
+import type { hpahistory } from '@wailsjs/go/models';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { buildEntries, HpaScalingHistory } from './HpaScalingHistory';
+
+const mocks = vi.hoisted(() => ({
+  readHPAScalingHistory: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  readHPAScalingHistory: mocks.readHPAScalingHistory,
+  requestData: async ({ read }: { read: () => Promise<unknown> }) => ({
+    status: 'executed',
+    data: await read(),
+  }),
+}));
+
+const minutesAgo = (minutes: number) => new Date(Date.now() - minutes * 60 * 1000).toISOString();
+
+const sample = (minutes: number, current: number, desired: number, cpu: string) =>
+  ({
+    time: minutesAgo(minutes),
+    currentReplicas: current,
+    desiredReplicas: desired,
+    minReplicas: 2,
+    maxReplicas: 10,
+    metrics: [{ kind: 'Resource', current: { resource: 'cpu', averageUtilization: cpu } }],
+  }) as hpahistory.Sample;
+
+const timeline = (overrides: Partial<hpahistory.Timeline> = {}) =>
+  ({
+    clusterId: 'c1',
+    namespace: 'prod',
+    name: 'api',
+    samples: [sample(5, 4, 4, '60%'), sample(10, 2, 4, '95%'), sample(20, 2, 2, '40%')],
+    events: [
+      {
+        time: minutesAgo(11),
+        type: 'Normal',
+        reason: 'SuccessfulRescale',
+        message: 'New size: 4; reason: cpu resource utilization above target',
+        count: 1,
+      },
+      {
+        time: minutesAgo(2),
+        type: 'Warning',
+        reason: 'FailedGetResourceMetric',
+        message: 'failed to get cpu utilization',
+        count: 3,
+      },
+    ],
+    rescales: 1,
+    directionChanges: 0,
+    observedSince: minutesAgo(30),
+    ...overrides,
+  }) as hpahistory.Timeline;
+
+describe('HpaScalingHistory', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.readHPAScalingHistory.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async () => {
+    await act(async () => {
+      root.render(<HpaScalingHistory clusterId="c1" namespace="prod" name="api" />);
+      await Promise.resolve();
+    });
+  };
+
+  it('interleaves samples and events newest first', () => {
+    const entries = buildEntries(timeline());
+    expect(entries.map((entry) => entry.type)).toEqual([
+      'event',
+      'sample',
+      'sample',
+      'event',
+      'sample',
+    ]);
+    const rescale = entries[2];
+    expect(rescale.type === 'sample' && rescale.previous?.desiredReplicas).toBe(2);
+  });
+
+  it('renders the recorded scaling timeline', async () => {
+    mocks.readHPAScalingHistory.mockResolvedValue(timeline());
+    await render();
+
+    expect(mocks.readHPAScalingHistory).toHaveBeenCalledWith('c1', 'prod', 'api');
+    expect(container.querySelector('.hpa-history-summary')?.textContent).toContain(
+      '1 rescale, 0 reversals'
+    );
+    expect(container.querySelectorAll('.hpa-history-bar')).toHaveLength(3);
+    const rows = container.querySelectorAll('.hpa-history-entry');
+    expect(rows).toHaveLength(5);
+    expect(rows[0].classList.contains('hpa-history-entry--warning')).toBe(true);
+    expect(rows[0].textContent).toContain('FailedGetResourceMetric (x3)');
+    expect(rows[2].textContent).toContain('Desired 2 → 4');
+    expect(rows[2].textContent).toContain('cpu 95%');
+    expect(container.textContent).not.toContain('Flapping');
+  });
+
+  it('flags an oscillating autoscaler', async () => {
+    mocks.readHPAScalingHistory.mockResolvedValue(timeline({ rescales: 6, directionChanges: 5 }));
+    await render();
+    expect(container.textContent).toContain('Flapping');
+  });
+
+  it('renders nothing until the status has changed', async () => {
+    mocks.readHPAScalingHistory.mockResolvedValue(
+      timeline({ samples: [sample(1, 2, 2, '40%')], events: [] })
+    );
+    await render();
+    expect(container.querySelector('[data-testid="hpa-scaling-history"]')).toBeNull();
+  });
+});
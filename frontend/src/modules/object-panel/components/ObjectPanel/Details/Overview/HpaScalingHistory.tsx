@@ -0,0 +1,193 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/HpaScalingHistory.tsx
+ *
+ * Scaling timeline for a HorizontalPodAutoscaler, as recorded from the HPA and event informers
+ * this session. A strip of desired replicas over time makes oscillation visible at a glance; the
+ * list below interleaves each recorded status change with the events the controller reported.
+ */
+
+import { readHPAScalingHistory, requestData } from '@/core/data-access';
+import { formatAge, formatFullDate } from '@/utils/ageFormatter';
+import { StatusChip } from '@shared/components/StatusChip';
+import type { hpa, hpahistory } from '@wailsjs/go/models';
+import { useEffect, useState } from 'react';
+import './HpaScalingHistory.css';
+
+interface HpaScalingHistoryProps {
+  clusterId?: string;
+  namespace: string;
+  name: string;
+}
+
+const LIST_LIMIT = 10;
+// Scaling reversals within the retention window at which the HPA is flagged as flapping.
+const FLAPPING_REVERSALS = 3;
+
+type TimelineEntry =
+  | { type: 'sample'; time: string; sample: hpahistory.Sample; previous?: hpahistory.Sample }
+  | { type: 'event'; time: string; event: hpahistory.Event };
+
+const describeMetric = (metric: hpa.MetricStatusFacts): string => {
+  const current = metric.current ?? {};
+  const subject = current.resource || current.metric || current.container || metric.kind;
+  const value = current.averageUtilization || current.averageValue || current.value;
+  return value ? `${subject} ${value}` : subject;
+};
+
+const describeSample = (sample: hpahistory.Sample, previous?: hpahistory.Sample): string => {
+  if (previous && previous.desiredReplicas !== sample.desiredReplicas) {
+    return `Desired ${previous.desiredReplicas} → ${sample.desiredReplicas}`;
+  }
+  if (sample.currentReplicas !== sample.desiredReplicas) {
+    return `${sample.currentReplicas} of ${sample.desiredReplicas} replicas`;
+  }
+  return `${sample.currentReplicas} replica${sample.currentReplicas === 1 ? '' : 's'}`;
+};
+
+// buildEntries interleaves samples and events, newest first. Samples arrive newest first, so the
+// previous sample of samples[i] is samples[i + 1].
+export const buildEntries = (timeline: hpahistory.Timeline): TimelineEntry[] => {
+  const samples = timeline.samples ?? [];
+  const entries: TimelineEntry[] = [
+    ...samples.map(
+      (sample, index): TimelineEntry => ({
+        type: 'sample',
+        time: sample.time,
+        sample,
+        previous: samples[index + 1],
+      })
+    ),
+    ...(timeline.events ?? []).map(
+      (event): TimelineEntry => ({ type: 'event', time: event.time, event })
+    ),
+  ];
+  return entries.sort((a, b) => new Date(b.time).getTime() - new Date(a.time).getTime());
+};
+
+export const HpaScalingHistory = ({ clusterId, namespace, name }: HpaScalingHistoryProps) => {
+  const [timeline, setTimeline] = useState<hpahistory.Timeline | null>(null);
+
+  useEffect(() => {
+    if (!clusterId || !name) {
+      setTimeline(null);
+      return;
+    }
+    let cancelled = false;
+    requestData({
+      resource: 'hpa-scaling-history',
+      reason: 'startup',
+      read: () => readHPAScalingHistory(clusterId, namespace, name),
+    })
+      .then((result) => {
+        if (!cancelled) {
+          setTimeline(result.status === 'executed' ? (result.data ?? null) : null);
+        }
+      })
+      .catch(() => {
+        if (!cancelled) {
+          setTimeline(null);
+        }
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [clusterId, namespace, name]);
+
+  if (!timeline) {
+    return null;
+  }
+  const samples = timeline.samples ?? [];
+  const entries = buildEntries(timeline);
+  // A single sample is just the current status, already shown above.
+  if (samples.length < 2 && (timeline.events ?? []).length === 0) {
+    return null;
+  }
+
+  const strip = [...samples].reverse();
+  const peak = Math.max(
+    1,
+    ...strip.map((sample) => Math.max(sample.maxReplicas, sample.desiredReplicas))
+  );
+  const shown = entries.slice(0, LIST_LIMIT);
+  const rest = entries.slice(LIST_LIMIT);
+
+  const renderEntry = (entry: TimelineEntry, index: number) => {
+    if (entry.type === 'event') {
+      const { event } = entry;
+      return (
+        <div
+          key={`event-${entry.time}-${index}`}
+          className={
+            event.type === 'Warning'
+              ? 'hpa-history-entry hpa-history-entry--warning'
+              : 'hpa-history-entry'
+          }
+        >
+          <span className="hpa-history-entry-time" title={formatFullDate(event.time)}>
+            {formatAge(event.time)} ago
+          </span>
+          <span className="hpa-history-entry-what">
+            {event.reason}
+            {event.count > 1 ? ` (x${event.count})` : ''}
+          </span>
+          <span className="hpa-history-entry-detail">{event.message}</span>
+        </div>
+      );
+    }
+    const { sample, previous } = entry;
+    return (
+      <div key={`sample-${entry.time}-${index}`} className="hpa-history-entry">
+        <span className="hpa-history-entry-time" title={formatFullDate(sample.time)}>
+          {formatAge(sample.time)} ago
+        </span>
+        <span className="hpa-history-entry-what">{describeSample(sample, previous)}</span>
+        <span className="hpa-history-entry-detail">
+          {(sample.metrics ?? []).map(describeMetric).join(', ')}
+        </span>
+      </div>
+    );
+  };
+
+  return (
+    <div className="hpa-history" data-testid="hpa-scaling-history">
+      <div className="hpa-history-summary">
+        <span>
+          {timeline.rescales} rescale{timeline.rescales === 1 ? '' : 's'},{' '}
+          {timeline.directionChanges} reversal{timeline.directionChanges === 1 ? '' : 's'}
+          {timeline.observedSince
+            ? ` (watching since ${formatFullDate(timeline.observedSince)})`
+            : ''}
+        </span>
+        {timeline.directionChanges >= FLAPPING_REVERSALS ? (
+          <StatusChip
+            variant="warning"
+            tooltip="Scaling keeps reversing. Consider a longer scale-down stabilization window or a wider target."
+          >
+            Flapping
+          </StatusChip>
+        ) : null}
+      </div>
+      {strip.length > 1 ? (
+        <div className="hpa-history-strip" aria-label="Desired replicas over time">
+          {strip.map((sample, index) => (
+            <div
+              key={`${sample.time}-${index}`}
+              className="hpa-history-bar"
+              title={`${formatFullDate(sample.time)}: desired ${sample.desiredReplicas}, current ${sample.currentReplicas}`}
+              style={{ height: `${(sample.desiredReplicas / peak) * 100}%` }}
+            />
+          ))}
+        </div>
+      ) : null}
+      <div className="hpa-history-entries">{shown.map(renderEntry)}</div>
+      {rest.length > 0 ? (
+        <details className="hpa-history-more">
+          <summary>{rest.length} more</summary>
+          <div className="hpa-history-entries">
+            {rest.map((entry, index) => renderEntry(entry, index + LIST_LIMIT))}
+          </div>
+        </details>
+      ) : null}
+    </div>
+  );
+};
@@ -0,0 +1,162 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/ImageScan.test.tsx
+ */
+
+import type React from 'react';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { describeCounts, ImageScan } from './ImageScan';
+
+const mocks = vi.hoisted(() => ({
+  readWorkloadImageScanForRef: vi.fn(),
+  scanWorkloadImages: vi.fn(),
+  handleError: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  readWorkloadImageScanForRef: mocks.readWorkloadImageScanForRef,
+  requestData: async ({ read }: { read: () => Promise<unknown> }) => ({
+    status: 'executed',
+    data: await read(),
+  }),
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  ScanWorkloadImages: mocks.scanWorkloadImages,
+}));
+
+vi.mock('@utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handleError },
+}));
+
+vi.mock('@shared/components/StatusChip', () => ({
+  StatusChip: ({ children }: React.PropsWithChildren) => (
+    <span data-testid="severity">{children}</span>
+  ),
+}));
+
+const counts = (critical: number, high: number) => ({
+  critical,
+  high,
+  medium: 0,
+  low: 0,
+  unknown: 0,
+});
+
+const notScanned = {
+  containers: [{ container: 'app', image: 'nginx:1.27', scanned: false, counts: counts(0, 0) }],
+  totals: counts(0, 0),
+};
+
+const scanned = {
+  containers: [
+    { container: 'app', image: 'nginx:1.27', scanned: true, counts: counts(2, 5) },
+    { container: 'setup', init: true, image: 'busybox', scanned: false, error: 'pull denied' },
+  ],
+  totals: counts(2, 5),
+};
+
+describe('ImageScan', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.readWorkloadImageScanForRef.mockReset();
+    mocks.scanWorkloadImages.mockReset();
+    mocks.handleError.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async () => {
+    await act(async () => {
+      root.render(
+        <ImageScan
+          clusterId="c1"
+          namespace="prod"
+          group="apps"
+          version="v1"
+          kind="Deployment"
+          name="api"
+        />
+      );
+      await Promise.resolve();
+    });
+  };
+
+  const clickScan = async () => {
+    await act(async () => {
+      container.querySelector('button')?.click();
+      await Promise.resolve();
+    });
+  };
+
+  it('describes the non-zero severities, most severe first', () => {
+    expect(describeCounts(counts(1, 3))).toBe('1 critical, 3 high');
+    expect(describeCounts(counts(0, 0))).toBe('No known vulnerabilities');
+  });
+
+  it('shows cached counts and scans on demand', async () => {
+    mocks.readWorkloadImageScanForRef.mockResolvedValue(notScanned);
+    mocks.scanWorkloadImages.mockResolvedValue(scanned);
+    await render();
+
+    expect(mocks.readWorkloadImageScanForRef).toHaveBeenCalledWith({
+      clusterId: 'c1',
+      namespace: 'prod',
+      group: 'apps',
+      version: 'v1',
+      kind: 'Deployment',
+      name: 'api',
+    });
+    expect(container.textContent).toContain('Not scanned');
+    expect(container.querySelector('button')?.textContent).toBe('Scan images');
+
+    await clickScan();
+
+    expect(mocks.scanWorkloadImages).toHaveBeenCalledWith(
+      'c1',
+      'apps',
+      'v1',
+      'Deployment',
+      'prod',
+      'api',
+      false
+    );
+    const chips = Array.from(container.querySelectorAll('[data-testid="severity"]'));
+    expect(chips.map((chip) => chip.textContent)).toEqual(['2 Critical', '5 High']);
+    expect(container.textContent).toContain('2 critical, 5 high');
+    expect(container.textContent).toContain('setup (init)');
+    expect(container.textContent).toContain('pull denied');
+    expect(container.querySelector('button')?.textContent).toBe('Rescan images');
+  });
+
+  it('forces a rescan once results exist and reports scan failures', async () => {
+    mocks.readWorkloadImageScanForRef.mockResolvedValue(scanned);
+    mocks.scanWorkloadImages.mockRejectedValue(new Error('trivy not found'));
+    await render();
+
+    await clickScan();
+
+    expect(mocks.scanWorkloadImages).toHaveBeenLastCalledWith(
+      'c1',
+      'apps',
+      'v1',
+      'Deployment',
+      'prod',
+      'api',
+      true
+    );
+    expect(mocks.handleError).toHaveBeenCalledWith(expect.any(Error), {
+      action: 'scanWorkloadImages',
+    });
+    expect(container.textContent).toContain('2 critical, 5 high');
+  });
+});
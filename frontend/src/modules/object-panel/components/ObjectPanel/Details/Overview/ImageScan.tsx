@@ -0,0 +1,147 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/ImageScan.tsx
+ *
+ * Image vulnerability counts for a Pod or workload. The last cached result shows on open; the
+ * Trivy scan itself runs only when asked for, since a cold scan can take minutes.
+ */
+
+import { ScanWorkloadImages } from '@/core/backend-api';
+import { readWorkloadImageScanForRef, requestData } from '@/core/data-access';
+import { formatAge, formatFullDate } from '@/utils/ageFormatter';
+import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import { errorHandler } from '@utils/errorHandler';
+import type { backend, vulnscan } from '@wailsjs/go/models';
+import { useEffect, useState } from 'react';
+import './ImageScan.css';
+
+interface ImageScanProps {
+  clusterId?: string;
+  namespace: string;
+  group: string;
+  version: string;
+  kind: string;
+  name: string;
+}
+
+interface Severity {
+  key: keyof vulnscan.Counts;
+  label: string;
+  variant: StatusChipVariant;
+}
+
+const SEVERITIES: Severity[] = [
+  { key: 'critical', label: 'Critical', variant: 'unhealthy' },
+  { key: 'high', label: 'High', variant: 'unhealthy' },
+  { key: 'medium', label: 'Medium', variant: 'warning' },
+  { key: 'low', label: 'Low', variant: 'info' },
+  { key: 'unknown', label: 'Unknown', variant: 'info' },
+];
+
+// describeCounts lists the non-zero severities, most severe first.
+export const describeCounts = (counts: vulnscan.Counts | undefined): string => {
+  const parts = SEVERITIES.filter(({ key }) => (counts?.[key] ?? 0) > 0).map(
+    ({ key, label }) => `${counts?.[key]} ${label.toLowerCase()}`
+  );
+  return parts.length > 0 ? parts.join(', ') : 'No known vulnerabilities';
+};
+
+export const ImageScan = ({ clusterId, namespace, group, version, kind, name }: ImageScanProps) => {
+  const [scan, setScan] = useState<backend.WorkloadImageScan | null>(null);
+  const [scanning, setScanning] = useState(false);
+
+  useEffect(() => {
+    setScan(null);
+    if (!clusterId || !name) {
+      return;
+    }
+    let cancelled = false;
+    requestData({
+      resource: 'workload-image-scan',
+      reason: 'startup',
+      read: () => readWorkloadImageScanForRef({ clusterId, namespace, group, version, kind, name }),
+    })
+      .then((result) => {
+        if (!cancelled && result.status === 'executed') {
+          setScan(result.data ?? null);
+        }
+      })
+      .catch(() => {
+        // No cached result; the scan button still works.
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [clusterId, namespace, group, version, kind, name]);
+
+  const containers = scan?.containers ?? [];
+  const scanned = containers.some((entry) => entry.scanned);
+
+  const handleScan = async () => {
+    if (!clusterId) {
+      return;
+    }
+    setScanning(true);
+    try {
+      setScan(await ScanWorkloadImages(clusterId, group, version, kind, namespace, name, scanned));
+    } catch (error) {
+      errorHandler.handle(error, { action: 'scanWorkloadImages' });
+    } finally {
+      setScanning(false);
+    }
+  };
+
+  const totals = scan?.totals;
+  const flagged = SEVERITIES.filter(({ key }) => (totals?.[key] ?? 0) > 0);
+
+  return (
+    <div className="image-scan" data-testid="image-scan">
+      <div className="image-scan-header">
+        {scanned ? (
+          <div className="overview-condition-list">
+            {flagged.length > 0 ? (
+              flagged.map(({ key, label, variant }) => (
+                <StatusChip key={key} variant={variant}>
+                  {totals?.[key]} {label}
+                </StatusChip>
+              ))
+            ) : (
+              <StatusChip variant="healthy">No known vulnerabilities</StatusChip>
+            )}
+          </div>
+        ) : null}
+        <button
+          type="button"
+          className="button generic small"
+          onClick={() => void handleScan()}
+          disabled={scanning || !clusterId}
+        >
+          {scanning ? 'Scanning images...' : scanned ? 'Rescan images' : 'Scan images'}
+        </button>
+      </div>
+      {containers.length > 0 ? (
+        <div className="overview-row-list">
+          {containers.map((entry) => (
+            <div key={`${entry.init ? 'init:' : ''}${entry.container}`} className="overview-row">
+              <span className="overview-row-label" title={entry.image}>
+                {entry.container}
+                {entry.init ? ' (init)' : ''}
+              </span>
+              <span className="overview-row-value">
+                {entry.error ? (
+                  <span className="status-text error">{entry.error}</span>
+                ) : entry.scanned ? (
+                  <span title={entry.scannedAt ? formatFullDate(entry.scannedAt) : undefined}>
+                    {describeCounts(entry.counts)}
+                    {entry.scannedAt ? ` (scanned ${formatAge(entry.scannedAt)} ago)` : ''}
+                  </span>
+                ) : (
+                  <span className="image-scan-pending">Not scanned</span>
+                )}
+              </span>
+            </div>
+          ))}
+        </div>
+      ) : null}
+    </div>
+  );
+};
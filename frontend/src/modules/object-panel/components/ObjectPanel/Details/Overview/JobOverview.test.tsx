@@ -142,7 +142,12 @@ describe('JobOverview', () => {
       kind: 'CronJob',
       name: 'cron',
       schedule: '0 * * * *',
-      nextScheduleTime: '2099-01-01T00:00:00Z',
+      schedulePreview: {
+        description: 'Every hour',
+        timeZone: 'UTC',
+        defaultTimeZone: true,
+        nextRuns: ['2099-01-01T00:00:00Z', '2099-01-01T01:00:00Z'],
+      },
       lastSuccessfulTime: '2024-01-01T00:00:00Z',
       concurrencyPolicy: 'Forbid',
     });
@@ -153,6 +158,44 @@ describe('JobOverview', () => {
     expect(getValueForLabel(container, 'Concurrency')?.textContent).toContain('Forbid');
   });
 
+  it('describes the cronjob schedule and lists upcoming runs in its time zone', async () => {
+    await renderCronJob({
+      kind: 'CronJob',
+      name: 'cron',
+      schedule: '30 2 * * 1-5',
+      schedulePreview: {
+        description: 'At 02:30, on Monday through Friday',
+        timeZone: 'Europe/Berlin',
+        nextRuns: ['2099-01-01T02:30:00+01:00', '2099-01-02T02:30:00+01:00'],
+      },
+    });
+
+    const schedule = getValueForLabel(container, 'Schedule')?.textContent ?? '';
+    expect(schedule).toContain('30 2 * * 1-5');
+    expect(schedule).toContain('At 02:30, on Monday through Friday (Europe/Berlin)');
+    const upcoming = getValueForLabel(container, 'Upcoming');
+    expect(upcoming?.querySelectorAll('.run-summary-row')).toHaveLength(2);
+    expect(upcoming?.textContent).toContain('2099-01-02 02:30 Europe/Berlin');
+  });
+
+  it('shows why a cronjob schedule cannot be evaluated', async () => {
+    await renderCronJob({
+      kind: 'CronJob',
+      name: 'cron',
+      schedule: '0 0 * * *',
+      schedulePreview: {
+        description: 'At 00:00',
+        timeZone: 'Mars/Olympus_Mons',
+        error: 'unknown time zone "Mars/Olympus_Mons"',
+      },
+    });
+
+    expect(getValueForLabel(container, 'Schedule')?.textContent).toContain(
+      'unknown time zone "Mars/Olympus_Mons"'
+    );
+    expect(getValueForLabel(container, 'Upcoming')).toBeNull();
+  });
+
   // Note: CronJob trigger/suspend actions are tested in ActionsMenu.test.tsx
   // since they now appear in the triple-dot menu rather than inline buttons
 });
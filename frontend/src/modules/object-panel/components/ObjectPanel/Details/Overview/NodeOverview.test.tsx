@@ -158,6 +158,39 @@ describe('NodeOverview', () => {
     expect(chips[3].className).toContain('status-chip--warning');
   });
 
+  it('renders the resource breakdown and cached images', async () => {
+    await renderNode(
+      nodes.NodeDetails.createFrom({
+        name: 'node-d',
+        resources: [
+          { name: 'cpu', capacity: '4', allocatable: '3800m', reserved: '200m' },
+          { name: 'pods', capacity: '110', allocatable: '110' },
+        ],
+        images: [
+          { names: ['registry/app@sha256:abc', 'registry/app:v2'], sizeBytes: 2 * 1024 ** 3 },
+          ...Array.from({ length: 6 }, (_, index) => ({
+            names: [`registry/small-${index}:latest`],
+            sizeBytes: 1024 ** 2,
+          })),
+        ],
+        imagesSizeBytes: 2 * 1024 ** 3 + 6 * 1024 ** 2,
+      })
+    );
+
+    const resources = getValueForLabel(container, 'Resources');
+    expect(resources?.textContent).toContain('3800m allocatable of 4 (200m reserved)');
+    expect(resources?.textContent).toContain('110 allocatable of 110');
+    expect(resources?.textContent).not.toContain('110 (');
+
+    const images = getValueForLabel(container, 'Images');
+    expect(images?.textContent).toContain('7 images, 2.0Gi total');
+    expect(images?.textContent).toContain('registry/app:v2');
+    expect(images?.textContent).not.toContain('sha256');
+    expect(images?.querySelector('.node-overview-images-more summary')?.textContent).toBe(
+      '2 more'
+    );
+  });
+
   it('renders the inline drain affordance when a drain is in progress', async () => {
     const onOpenDrain = vi.fn();
     await renderNode(nodes.NodeDetails.createFrom({ name: 'node-c', status: 'Ready' }), {
@@ -0,0 +1,88 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/PodSchedulingExplainer.test.tsx
+ */
+
+import type React from 'react';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { PodSchedulingExplainer } from './PodSchedulingExplainer';
+
+const mocks = vi.hoisted(() => ({
+  readPodSchedulingExplanation: vi.fn(),
+}));
+
+vi.mock('@/core/data-access', () => ({
+  readPodSchedulingExplanation: mocks.readPodSchedulingExplanation,
+  requestData: async ({ read }: { read: () => Promise<unknown> }) => ({
+    status: 'executed',
+    data: await read(),
+  }),
+}));
+
+vi.mock('@shared/components/StatusChip', () => ({
+  StatusChip: ({ children }: React.PropsWithChildren) => (
+    <span data-testid="fitting-node">{children}</span>
+  ),
+}));
+
+describe('PodSchedulingExplainer', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.readPodSchedulingExplanation.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  it('loads the explanation on demand and lists blocked and fitting nodes', async () => {
+    mocks.readPodSchedulingExplanation.mockResolvedValue({
+      namespace: 'team-a',
+      name: 'web',
+      phase: 'Pending',
+      events: [{ message: '0/2 nodes are available', count: 3 }],
+      nodes: [
+        { name: 'busy', fits: false, reasons: ['node is cordoned', 'insufficient cpu'] },
+        { name: 'free', fits: true },
+      ],
+      fittingNodes: 1,
+    });
+
+    await act(async () => {
+      root.render(<PodSchedulingExplainer clusterId="c1" namespace="team-a" name="web" />);
+    });
+    expect(mocks.readPodSchedulingExplanation).not.toHaveBeenCalled();
+
+    const button = container.querySelector('button');
+    expect(button?.textContent).toBe('Why is this pod Pending?');
+    await act(async () => {
+      button?.click();
+      await Promise.resolve();
+    });
+
+    expect(mocks.readPodSchedulingExplanation).toHaveBeenCalledWith('c1', 'team-a', 'web');
+    expect(container.querySelector('.pod-scheduling-summary')?.textContent).toContain(
+      '1 of 2 nodes fit'
+    );
+    expect(container.querySelector('.pod-scheduling-event')?.textContent).toContain('3x');
+    const row = container.querySelector('.overview-row');
+    expect(row?.textContent).toContain('busy');
+    expect(row?.textContent).toContain('node is cordoned; insufficient cpu');
+    expect(container.querySelector('[data-testid="fitting-node"]')?.textContent).toBe('free');
+    expect(container.querySelector('button')?.textContent).toBe('Check again');
+  });
+
+  it('disables the check without a cluster', async () => {
+    await act(async () => {
+      root.render(<PodSchedulingExplainer namespace="team-a" name="web" />);
+    });
+    expect(container.querySelector('button')?.disabled).toBe(true);
+  });
+});
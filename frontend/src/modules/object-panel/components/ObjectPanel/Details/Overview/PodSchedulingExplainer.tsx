@@ -0,0 +1,115 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/PodSchedulingExplainer.tsx
+ *
+ * "Why is this pod Pending" block for unscheduled pods. Loads on demand: the backend lists every
+ * node and pod to re-evaluate fit, so the check runs only when asked for.
+ */
+
+import { readPodSchedulingExplanation, requestData } from '@/core/data-access';
+import { formatAge } from '@/utils/ageFormatter';
+import { StatusChip } from '@shared/components/StatusChip';
+import { errorHandler } from '@utils/errorHandler';
+import type { pods } from '@wailsjs/go/models';
+import { useState } from 'react';
+import './PodSchedulingExplainer.css';
+
+interface PodSchedulingExplainerProps {
+  clusterId?: string;
+  namespace: string;
+  name: string;
+}
+
+const fitSummary = (explanation: pods.SchedulingExplanation): string => {
+  const total = explanation.nodes?.length ?? 0;
+  if (total === 0) {
+    return 'The cluster has no nodes';
+  }
+  if (explanation.fittingNodes === 0) {
+    return `None of ${total} nodes fit this pod`;
+  }
+  return (
+    `${explanation.fittingNodes} of ${total} nodes fit; the scheduler may be blocked by volumes, ` +
+    'ports or pod affinity, or has not retried yet'
+  );
+};
+
+export const PodSchedulingExplainer = ({
+  clusterId,
+  namespace,
+  name,
+}: PodSchedulingExplainerProps) => {
+  const [explanation, setExplanation] = useState<pods.SchedulingExplanation | null>(null);
+  const [loading, setLoading] = useState(false);
+
+  const handleExplain = async () => {
+    if (!clusterId) {
+      return;
+    }
+    setLoading(true);
+    try {
+      const result = await requestData({
+        resource: 'pod-scheduling-explanation',
+        reason: 'user',
+        read: () => readPodSchedulingExplanation(clusterId, namespace, name),
+      });
+      if (result.status === 'executed' && result.data) {
+        setExplanation(result.data);
+      }
+    } catch (error) {
+      errorHandler.handle(error, { action: 'explainPodScheduling' });
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  const blocked = (explanation?.nodes ?? []).filter((node) => !node.fits);
+  const fitting = (explanation?.nodes ?? []).filter((node) => node.fits);
+
+  return (
+    <div className="pod-scheduling">
+      <button
+        type="button"
+        className="button generic small"
+        onClick={() => void handleExplain()}
+        disabled={loading || !clusterId}
+      >
+        {loading ? 'Checking nodes...' : explanation ? 'Check again' : 'Why is this pod Pending?'}
+      </button>
+      {explanation ? (
+        <>
+          <div className="pod-scheduling-summary">{fitSummary(explanation)}</div>
+          {explanation.events.length > 0 ? (
+            <div className="pod-scheduling-events">
+              {explanation.events.map((event) => (
+                <div key={`${event.lastSeen}:${event.message}`} className="pod-scheduling-event">
+                  <span className="pod-scheduling-event-meta">
+                    {event.count > 1 ? `${event.count}x, ` : ''}
+                    {event.lastSeen ? `${formatAge(event.lastSeen)} ago` : 'scheduler'}
+                  </span>
+                  <span>{event.message}</span>
+                </div>
+              ))}
+            </div>
+          ) : null}
+          <div className="overview-row-list">
+            {blocked.map((node) => (
+              <div key={node.name} className="overview-row">
+                <span className="overview-row-label">{node.name}</span>
+                <span className="overview-row-value">{(node.reasons ?? []).join('; ')}</span>
+              </div>
+            ))}
+          </div>
+          {fitting.length > 0 ? (
+            <div className="overview-condition-list">
+              {fitting.map((node) => (
+                <StatusChip key={node.name} variant="healthy" tooltip="No blocking reason found">
+                  {node.name}
+                </StatusChip>
+              ))}
+            </div>
+          ) : null}
+        </>
+      ) : null}
+    </div>
+  );
+};
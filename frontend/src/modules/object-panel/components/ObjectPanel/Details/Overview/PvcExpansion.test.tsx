@@ -0,0 +1,128 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/PvcExpansion.test.tsx
+ */
+
+import { persistentvolumeclaim } from '@wailsjs/go/models';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { PvcExpansion } from './PvcExpansion';
+
+const mocks = vi.hoisted(() => ({
+  resize: vi.fn(),
+  handle: vi.fn(),
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  ResizePersistentVolumeClaim: mocks.resize,
+}));
+
+vi.mock('@utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handle },
+}));
+
+describe('PvcExpansion', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.resize.mockReset();
+    mocks.handle.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const typeSize = (value: string) => {
+    const input = container.querySelector<HTMLInputElement>('input');
+    const setter = Object.getOwnPropertyDescriptor(HTMLInputElement.prototype, 'value')?.set;
+    act(() => {
+      setter?.call(input, value);
+      input?.dispatchEvent(new Event('input', { bubbles: true }));
+    });
+  };
+
+  it('requests a larger size when the StorageClass allows expansion', async () => {
+    mocks.resize.mockResolvedValue({ requested: '20Gi', previous: '10Gi' });
+    await act(async () => {
+      root.render(
+        <PvcExpansion
+          clusterId="c1"
+          namespace="team-a"
+          name="data"
+          capacity="10Gi"
+          expansion={persistentvolumeclaim.ExpansionInfo.createFrom({ allowed: true })}
+        />
+      );
+    });
+
+    const button = container.querySelector<HTMLButtonElement>('button');
+    expect(button?.disabled).toBe(true);
+    typeSize('20Gi');
+    await act(async () => {
+      button?.click();
+      await Promise.resolve();
+    });
+
+    expect(mocks.resize).toHaveBeenCalledWith('c1', 'team-a', 'data', '20Gi');
+    expect(container.textContent).toContain('Requested 20Gi');
+  });
+
+  it('shows the resize in flight and why a claim cannot be resized', async () => {
+    await act(async () => {
+      root.render(
+        <PvcExpansion
+          clusterId="c1"
+          namespace="team-a"
+          name="data"
+          capacity="10Gi"
+          expansion={persistentvolumeclaim.ExpansionInfo.createFrom({
+            allowed: false,
+            reason: 'StorageClass "fixed" does not allow volume expansion',
+            progress: {
+              state: 'FileSystemResizePending',
+              requested: '20Gi',
+              capacity: '10Gi',
+              message: 'Waiting for user to (re-)start a pod',
+            },
+          })}
+        />
+      );
+    });
+
+    expect(container.textContent).toContain('Restart a pod to finish resize');
+    expect(container.textContent).toContain('10Gi → 20Gi');
+    expect(container.textContent).toContain('does not allow volume expansion');
+    expect(container.querySelector('input')).toBeNull();
+  });
+
+  it('reports resize failures through the error handler', async () => {
+    mocks.resize.mockRejectedValue(new Error('must be larger'));
+    await act(async () => {
+      root.render(
+        <PvcExpansion
+          clusterId="c1"
+          namespace="team-a"
+          name="data"
+          capacity="10Gi"
+          expansion={persistentvolumeclaim.ExpansionInfo.createFrom({ allowed: true })}
+        />
+      );
+    });
+
+    typeSize('1Gi');
+    await act(async () => {
+      container.querySelector<HTMLButtonElement>('button')?.click();
+      await Promise.resolve();
+    });
+
+    expect(mocks.handle).toHaveBeenCalledWith(expect.any(Error), {
+      action: 'resizePersistentVolumeClaim',
+    });
+  });
+});
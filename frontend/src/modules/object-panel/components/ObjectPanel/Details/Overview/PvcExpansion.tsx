@@ -0,0 +1,114 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/PvcExpansion.tsx
+ *
+ * Volume expansion block for a PersistentVolumeClaim: the resize in flight, if any, and a
+ * size field to request a larger volume when the StorageClass allows expansion. The backend
+ * repeats every pre-check; the panel's next refresh shows the resize progressing.
+ */
+
+import { ResizePersistentVolumeClaim } from '@/core/backend-api';
+import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import { errorHandler } from '@utils/errorHandler';
+import type { persistentvolumeclaim } from '@wailsjs/go/models';
+import { useState } from 'react';
+import './PvcExpansion.css';
+
+interface PvcExpansionProps {
+  clusterId?: string;
+  namespace: string;
+  name: string;
+  capacity: string;
+  expansion?: persistentvolumeclaim.ExpansionInfo;
+}
+
+const PROGRESS_LABELS: Record<string, { label: string; variant: StatusChipVariant }> = {
+  Requested: { label: 'Resize requested', variant: 'info' },
+  Resizing: { label: 'Resizing volume', variant: 'info' },
+  FileSystemResizePending: { label: 'Restart a pod to finish resize', variant: 'warning' },
+  Failed: { label: 'Resize failed', variant: 'unhealthy' },
+};
+
+export const PvcExpansion = ({
+  clusterId,
+  namespace,
+  name,
+  capacity,
+  expansion,
+}: PvcExpansionProps) => {
+  const [size, setSize] = useState('');
+  const [submitting, setSubmitting] = useState(false);
+  const [requested, setRequested] = useState<string | null>(null);
+
+  if (!expansion) {
+    return null;
+  }
+  const progress = expansion.progress;
+  const progressLabel = progress ? PROGRESS_LABELS[progress.state] : undefined;
+
+  const handleResize = async () => {
+    const target = size.trim();
+    if (!clusterId || !target) {
+      return;
+    }
+    setSubmitting(true);
+    try {
+      const result = await ResizePersistentVolumeClaim(clusterId, namespace, name, target);
+      setRequested(result.requested);
+      setSize('');
+    } catch (error) {
+      errorHandler.handle(error, { action: 'resizePersistentVolumeClaim' });
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  return (
+    <div className="pvc-expansion" data-testid="pvc-expansion">
+      {progress ? (
+        <div className="pvc-expansion-progress">
+          <StatusChip variant={progressLabel?.variant ?? 'info'}>
+            {progressLabel?.label ?? progress.state}
+          </StatusChip>
+          <span className="pvc-expansion-sizes">
+            {progress.capacity ? `${progress.capacity} → ` : ''}
+            {progress.requested}
+          </span>
+          {progress.message ? (
+            <span className="pvc-expansion-message">{progress.message}</span>
+          ) : null}
+        </div>
+      ) : null}
+      {expansion.allowed ? (
+        <div className="pvc-expansion-form">
+          <input
+            type="text"
+            className="pvc-expansion-input"
+            aria-label="New size"
+            placeholder={`Larger than ${capacity || 'current size'}, e.g. 20Gi`}
+            value={size}
+            onChange={(event) => setSize(event.target.value)}
+            onKeyDown={(event) => {
+              if (event.key === 'Enter') {
+                void handleResize();
+              }
+            }}
+            disabled={submitting}
+          />
+          <button
+            type="button"
+            className="button generic small"
+            onClick={() => void handleResize()}
+            disabled={submitting || !clusterId || !size.trim()}
+          >
+            {submitting ? 'Resizing...' : 'Resize'}
+          </button>
+          {requested ? (
+            <span className="pvc-expansion-message">Requested {requested}</span>
+          ) : null}
+        </div>
+      ) : (
+        <span className="pvc-expansion-message">Not resizable: {expansion.reason}</span>
+      )}
+    </div>
+  );
+};
@@ -0,0 +1,182 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/StatefulSetOrdinals.test.tsx
+ */
+
+import { statefulset } from '@wailsjs/go/models';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { StatefulSetOrdinals } from './StatefulSetOrdinals';
+
+const mocks = vi.hoisted(() => ({
+  deleteOrdinal: vi.fn(),
+  forceDeleteOrdinal: vi.fn(),
+  setPartition: vi.fn(),
+  isNamespaceProtected: vi.fn(),
+  handle: vi.fn(),
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  DeleteStatefulSetOrdinal: mocks.deleteOrdinal,
+  ForceDeleteStatefulSetOrdinal: mocks.forceDeleteOrdinal,
+  SetStatefulSetPartition: mocks.setPartition,
+  IsNamespaceProtected: mocks.isNamespaceProtected,
+}));
+
+vi.mock('@utils/errorHandler', () => ({
+  errorHandler: { handle: mocks.handle },
+}));
+
+vi.mock('@shared/components/modals/ConfirmationModal', () => ({
+  default: ({
+    isOpen,
+    confirmText,
+    typedConfirmation,
+    onConfirm,
+  }: {
+    isOpen: boolean;
+    confirmText: string;
+    typedConfirmation?: string;
+    onConfirm: (confirmation?: string) => void;
+  }) =>
+    // Confirming stands in for typing the requested text correctly.
+    isOpen ? (
+      <button type="button" data-testid="confirm" onClick={() => onConfirm(typedConfirmation)}>
+        {confirmText}
+      </button>
+    ) : null,
+}));
+
+const ordinals = [
+  statefulset.OrdinalStatus.createFrom({
+    ordinal: 0,
+    podName: 'db-0',
+    ready: true,
+    revision: 'db-old',
+    upToDate: false,
+    partitioned: true,
+  }),
+  statefulset.OrdinalStatus.createFrom({
+    ordinal: 1,
+    podName: 'db-1',
+    ready: false,
+    revision: 'db-old',
+    upToDate: false,
+    terminating: true,
+    stuck: true,
+  }),
+  statefulset.OrdinalStatus.createFrom({
+    ordinal: 2,
+    podName: 'db-2',
+    ready: true,
+    revision: 'db-new',
+    upToDate: true,
+  }),
+];
+
+describe('StatefulSetOrdinals', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    mocks.deleteOrdinal.mockReset();
+    mocks.forceDeleteOrdinal.mockReset();
+    mocks.setPartition.mockReset();
+    mocks.isNamespaceProtected.mockReset().mockResolvedValue(false);
+    mocks.handle.mockReset();
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const render = async (updateStrategy = 'RollingUpdate') => {
+    await act(async () => {
+      root.render(
+        <StatefulSetOrdinals
+          clusterId="c1"
+          namespace="team-a"
+          name="db"
+          ordinals={ordinals}
+          updateStrategy={updateStrategy}
+          partition={1}
+        />
+      );
+    });
+  };
+
+  const clickButton = async (text: string, scope: ParentNode = container) => {
+    const button = Array.from(scope.querySelectorAll<HTMLButtonElement>('button')).find(
+      (candidate) => candidate.textContent === text
+    );
+    await act(async () => {
+      button?.click();
+      await Promise.resolve();
+    });
+  };
+
+  it('shows the revision and state of each ordinal', async () => {
+    await render();
+
+    const row = (ordinal: number) =>
+      container.querySelector(`[data-testid="statefulset-ordinal-${ordinal}"]`)?.textContent;
+    expect(row(0)).toContain('Held');
+    expect(row(1)).toContain('Stuck terminating');
+    expect(row(2)).toContain('Updated');
+    expect(row(2)).toContain('db-new');
+  });
+
+  it('deletes and force-deletes an ordinal after confirmation', async () => {
+    mocks.deleteOrdinal.mockResolvedValue('db-0');
+    mocks.forceDeleteOrdinal.mockResolvedValue('db-1');
+    await render();
+
+    const first = container.querySelector('[data-testid="statefulset-ordinal-0"]') as ParentNode;
+    await clickButton('Delete pod', first);
+    expect(mocks.deleteOrdinal).not.toHaveBeenCalled();
+    await clickButton('Delete');
+    expect(mocks.deleteOrdinal).toHaveBeenCalledWith('c1', 'team-a', 'db', 0, '');
+    expect(container.textContent).toContain('Deleted db-0');
+
+    await clickButton('Force delete');
+    await act(async () => {
+      container.querySelector<HTMLButtonElement>('[data-testid="confirm"]')?.click();
+      await Promise.resolve();
+    });
+    expect(mocks.forceDeleteOrdinal).toHaveBeenCalledWith('c1', 'team-a', 'db', 1, '');
+  });
+
+  it('passes the typed pod name in a protected namespace', async () => {
+    mocks.isNamespaceProtected.mockResolvedValue(true);
+    mocks.deleteOrdinal.mockResolvedValue('db-0');
+    await render();
+
+    expect(mocks.isNamespaceProtected).toHaveBeenCalledWith('team-a');
+    const first = container.querySelector('[data-testid="statefulset-ordinal-0"]') as ParentNode;
+    await clickButton('Delete pod', first);
+    await clickButton('Delete');
+    expect(mocks.deleteOrdinal).toHaveBeenCalledWith('c1', 'team-a', 'db', 0, 'db-0');
+  });
+
+  it('sets the partition and hides it for OnDelete', async () => {
+    mocks.setPartition.mockResolvedValue(undefined);
+    await render();
+
+    const input = container.querySelector<HTMLInputElement>('input');
+    const setter = Object.getOwnPropertyDescriptor(HTMLInputElement.prototype, 'value')?.set;
+    act(() => {
+      setter?.call(input, '0');
+      input?.dispatchEvent(new Event('input', { bubbles: true }));
+    });
+    await clickButton('Set partition');
+    expect(mocks.setPartition).toHaveBeenCalledWith('c1', 'team-a', 'db', 0);
+    expect(container.textContent).toContain('Partition set to 0');
+
+    await render('OnDelete');
+    expect(container.querySelector('input')).toBeNull();
+  });
+});
@@ -0,0 +1,236 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Details/Overview/StatefulSetOrdinals.tsx
+ *
+ * Per-ordinal view of a StatefulSet: each ordinal's pod, the revision it runs, and whether the
+ * rolling update partition holds it back. Offers deleting one ordinal's pod, force-deleting a pod
+ * stuck terminating, and moving the partition. The backend repeats every check; the panel's next
+ * refresh shows the result.
+ */
+
+import {
+  DeleteStatefulSetOrdinal,
+  ForceDeleteStatefulSetOrdinal,
+  SetStatefulSetPartition,
+} from '@/core/backend-api';
+import ConfirmationModal from '@shared/components/modals/ConfirmationModal';
+import { StatusChip } from '@shared/components/StatusChip';
+import { useProtectedNamespace } from '@shared/hooks/useProtectedNamespace';
+import { errorHandler } from '@utils/errorHandler';
+import type { statefulset } from '@wailsjs/go/models';
+import { useState } from 'react';
+import './StatefulSetOrdinals.css';
+
+interface StatefulSetOrdinalsProps {
+  clusterId?: string;
+  namespace: string;
+  name: string;
+  ordinals?: statefulset.OrdinalStatus[];
+  updateStrategy?: string;
+  partition?: number;
+}
+
+interface PendingDelete {
+  ordinal: statefulset.OrdinalStatus;
+  force: boolean;
+}
+
+const revisionChip = (ordinal: statefulset.OrdinalStatus) => {
+  if (ordinal.missing) {
+    return <StatusChip variant="warning">No pod</StatusChip>;
+  }
+  if (ordinal.upToDate) {
+    return <StatusChip variant="healthy">Updated</StatusChip>;
+  }
+  if (ordinal.partitioned) {
+    return (
+      <StatusChip
+        variant="info"
+        tooltip="Below the partition: keeps the current revision until the partition is lowered."
+      >
+        Held
+      </StatusChip>
+    );
+  }
+  return <StatusChip variant="warning">Outdated</StatusChip>;
+};
+
+const stateChip = (ordinal: statefulset.OrdinalStatus) => {
+  if (ordinal.stuck) {
+    return (
+      <StatusChip
+        variant="unhealthy"
+        tooltip="Terminating past its grace period. The ordinal is not replaced until the pod is gone."
+      >
+        Stuck terminating
+      </StatusChip>
+    );
+  }
+  if (ordinal.terminating) {
+    return <StatusChip variant="warning">Terminating</StatusChip>;
+  }
+  if (ordinal.missing) {
+    return null;
+  }
+  return (
+    <StatusChip variant={ordinal.ready ? 'healthy' : 'warning'}>
+      {ordinal.ready ? 'Ready' : ordinal.phase || 'Not ready'}
+    </StatusChip>
+  );
+};
+
+export const StatefulSetOrdinals = ({
+  clusterId,
+  namespace,
+  name,
+  ordinals,
+  updateStrategy,
+  partition,
+}: StatefulSetOrdinalsProps) => {
+  const [pending, setPending] = useState<PendingDelete | null>(null);
+  const [partitionInput, setPartitionInput] = useState('');
+  const [submitting, setSubmitting] = useState(false);
+  const [message, setMessage] = useState<string | null>(null);
+  // Protected namespaces need the pod name typed before it is deleted.
+  const namespaceProtected = useProtectedNamespace({ kind: 'StatefulSet', namespace, name });
+
+  if (!ordinals || ordinals.length === 0) {
+    return null;
+  }
+  const partitionEditable = updateStrategy !== 'OnDelete';
+
+  const handleDelete = async (typedConfirmation?: string) => {
+    const target = pending;
+    setPending(null);
+    if (!clusterId || !target) {
+      return;
+    }
+    setSubmitting(true);
+    try {
+      const run = target.force ? ForceDeleteStatefulSetOrdinal : DeleteStatefulSetOrdinal;
+      const podName = await run(
+        clusterId,
+        namespace,
+        name,
+        target.ordinal.ordinal,
+        typedConfirmation ?? ''
+      );
+      setMessage(`${target.force ? 'Force deleted' : 'Deleted'} ${podName}`);
+    } catch (error) {
+      errorHandler.handle(error, {
+        action: target.force ? 'forceDeleteStatefulSetOrdinal' : 'deleteStatefulSetOrdinal',
+      });
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  const handleSetPartition = async () => {
+    const value = Number(partitionInput.trim());
+    if (!clusterId || partitionInput.trim() === '' || !Number.isInteger(value) || value < 0) {
+      return;
+    }
+    setSubmitting(true);
+    try {
+      await SetStatefulSetPartition(clusterId, namespace, name, value);
+      setMessage(`Partition set to ${value}`);
+      setPartitionInput('');
+    } catch (error) {
+      errorHandler.handle(error, { action: 'setStatefulSetPartition' });
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  return (
+    <div className="statefulset-ordinals" data-testid="statefulset-ordinals">
+      <div className="statefulset-ordinals-list">
+        {ordinals.map((ordinal) => (
+          <div
+            key={ordinal.ordinal}
+            className="statefulset-ordinal"
+            data-testid={`statefulset-ordinal-${ordinal.ordinal}`}
+          >
+            <span className="statefulset-ordinal-index">{ordinal.ordinal}</span>
+            <span className="statefulset-ordinal-pod">{ordinal.podName}</span>
+            {revisionChip(ordinal)}
+            {stateChip(ordinal)}
+            {ordinal.extra ? <StatusChip variant="info">Scaling down</StatusChip> : null}
+            {ordinal.node ? <span className="statefulset-ordinal-meta">{ordinal.node}</span> : null}
+            {ordinal.revision ? (
+              <span className="statefulset-ordinal-meta">{ordinal.revision}</span>
+            ) : null}
+            {!ordinal.missing ? (
+              <span className="statefulset-ordinal-actions">
+                {ordinal.terminating ? (
+                  <button
+                    type="button"
+                    className="button danger small"
+                    onClick={() => setPending({ ordinal, force: true })}
+                    disabled={submitting || !clusterId}
+                  >
+                    Force delete
+                  </button>
+                ) : (
+                  <button
+                    type="button"
+                    className="button generic small"
+                    onClick={() => setPending({ ordinal, force: false })}
+                    disabled={submitting || !clusterId}
+                  >
+                    Delete pod
+                  </button>
+                )}
+              </span>
+            ) : null}
+          </div>
+        ))}
+      </div>
+      {partitionEditable ? (
+        <div className="statefulset-ordinals-partition">
+          <input
+            type="number"
+            min={0}
+            className="statefulset-ordinals-input"
+            aria-label="Partition"
+            placeholder={`Partition (now ${partition ?? 0})`}
+            value={partitionInput}
+            onChange={(event) => setPartitionInput(event.target.value)}
+            onKeyDown={(event) => {
+              if (event.key === 'Enter') {
+                void handleSetPartition();
+              }
+            }}
+            disabled={submitting}
+          />
+          <button
+            type="button"
+            className="button generic small"
+            onClick={() => void handleSetPartition()}
+            disabled={submitting || !clusterId || partitionInput.trim() === ''}
+          >
+            Set partition
+          </button>
+        </div>
+      ) : null}
+      {message ? <span className="statefulset-ordinals-message">{message}</span> : null}
+      <ConfirmationModal
+        isOpen={Boolean(pending)}
+        title={pending?.force ? 'Force delete pod?' : 'Delete pod?'}
+        message={
+          pending?.force
+            ? `Remove ${pending.ordinal.podName} without waiting for it to terminate?`
+            : `Delete ${pending?.ordinal.podName ?? ''}? The StatefulSet recreates it with the same identity.`
+        }
+        warning={
+          pending?.force
+            ? 'Only force delete when the node is gone. If the old pod is still running, two pods share the same identity and storage.'
+            : undefined
+        }
+        confirmText={pending?.force ? 'Force delete' : 'Delete'}
+        typedConfirmation={namespaceProtected ? pending?.ordinal.podName : undefined}
+        onConfirm={(typedConfirmation) => void handleDelete(typedConfirmation)}
+        onCancel={() => setPending(null)}
+      />
+    </div>
+  );
+};
@@ -24,7 +24,7 @@ export const configMapDescriptor: OverviewDescriptor<ConfigMapDetails> = {
       },
     ],
   },
-  // data/binaryData are surfaced by the derived DataSection (not the Overview); details/dataCount
-  // are summary fields intentionally not surfaced here (the DataSection shows the actual keys).
-  coveredElsewhere: ['data', 'binaryData', 'details', 'dataCount'],
+  // keys are surfaced by the derived DataSection (not the Overview), which loads values per key;
+  // details/dataCount/dataSize are summary fields intentionally not surfaced here.
+  coveredElsewhere: ['keys', 'details', 'dataCount', 'dataSize'],
 };
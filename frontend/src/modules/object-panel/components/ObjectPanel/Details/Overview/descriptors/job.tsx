@@ -192,6 +192,53 @@ const RunSummary: React.FC<RunSummaryProps> = ({
   );
 };
 
+/** Time zone note for the schedule. An unset zone means the controller's
+ *  local zone, which the backend assumes is UTC. */
+const scheduleZoneLabel = (preview: cronjob.SchedulePreview): string =>
+  preview.defaultTimeZone ? 'UTC, controller default' : preview.timeZone;
+
+/** Schedule expression with its description and time zone, or the reason the
+ *  backend could not evaluate it. */
+const ScheduleDescription: React.FC<{
+  schedule: string;
+  preview?: cronjob.SchedulePreview;
+}> = ({ schedule, preview }) => (
+  <div className="cronjob-schedule">
+    <code>{schedule}</code>
+    {preview?.description ? (
+      <span className="cronjob-schedule-description">
+        {preview.description} ({scheduleZoneLabel(preview)})
+      </span>
+    ) : null}
+    {preview?.error ? (
+      <span className="cronjob-schedule-error" title={preview.error}>
+        {preview.error}
+      </span>
+    ) : null}
+  </div>
+);
+
+/** Wall-clock time of an RFC3339 timestamp in the offset it was written with,
+ *  i.e. the CronJob's time zone. */
+const scheduleWallTime = (iso: string): string => iso.slice(0, 16).replace('T', ' ');
+
+/** Next runs as relative times. The tooltip gives local time; when the
+ *  schedule runs in another zone its wall time is shown alongside. */
+const UpcomingRuns: React.FC<{ preview: cronjob.SchedulePreview }> = ({ preview }) => (
+  <div className="run-summary">
+    {(preview.nextRuns ?? []).map((run) => (
+      <div key={run} className="run-summary-row">
+        <span className="run-summary-label">{formatRelative(run)}</span>
+        <Tooltip content={formatLocalDateTime(run)} className="run-summary-tooltip">
+          <span className="run-summary-value">
+            {scheduleWallTime(run)} {preview.timeZone}
+          </span>
+        </Tooltip>
+      </div>
+    ))}
+  </div>
+);
+
 /** Concurrency policy tooltips — these change what happens when a run
  *  is still active at the next schedule tick, so worth explaining. */
 const concurrencyTooltip = (policy: string): string | undefined => {
@@ -396,8 +443,22 @@ export const cronJobDescriptor: OverviewDescriptor<CronJobDetails> = {
       },
       {
         field: 'schedule',
+        derivedFrom: ['schedulePreview'],
         label: 'Schedule',
-        render: (d) => <code>{d.schedule}</code>,
+        render: (d) => <ScheduleDescription schedule={d.schedule} preview={d.schedulePreview} />,
+      },
+      // Upcoming runs, computed by the backend in the CronJob's time zone.
+      {
+        kind: 'widget',
+        consumes: ['schedulePreview'],
+        render: (d) =>
+          !d.suspend && d.schedulePreview?.nextRuns && d.schedulePreview.nextRuns.length > 0 ? (
+            <OverviewItem
+              label="Upcoming"
+              fullWidth
+              value={<UpcomingRuns preview={d.schedulePreview} />}
+            />
+          ) : null,
       },
       // Concurrency policy — chip with tooltip when non-default.
       {
@@ -451,7 +512,7 @@ export const cronJobDescriptor: OverviewDescriptor<CronJobDetails> = {
         kind: 'widget',
         consumes: [
           'suspend',
-          'nextScheduleTime',
+          'schedulePreview',
           'lastScheduleTime',
           'lastManualTime',
           'lastSuccessfulTime',
@@ -464,7 +525,7 @@ export const cronJobDescriptor: OverviewDescriptor<CronJobDetails> = {
             value={
               <RunSummary
                 suspend={d.suspend}
-                nextScheduleTime={d.nextScheduleTime}
+                nextScheduleTime={d.schedulePreview?.nextRuns?.[0]}
                 lastScheduleTime={d.lastScheduleTime}
                 lastManualTime={d.lastManualTime}
                 lastSuccessfulTime={d.lastSuccessfulTime}
@@ -494,7 +555,6 @@ export const cronJobDescriptor: OverviewDescriptor<CronJobDetails> = {
   // Not surfaced in the CronJob Overview by design (matches JobOverview.tsx):
   // - status/statusState/statusPresentation/statusReason -> only the suspend chip is shown
   // - details -> table-summary string
-  // - timeUntilNextSchedule -> Runs block derives "in X" from nextScheduleTime instead
   // - jobTemplate -> not surfaced
   // - pods/podMetricsSummary -> CronJobs have no Containers/Utilization section
   coveredElsewhere: [
@@ -503,7 +563,6 @@ export const cronJobDescriptor: OverviewDescriptor<CronJobDetails> = {
     'statusPresentation',
     'statusReason',
     'details',
-    'timeUntilNextSchedule',
     'jobTemplate',
     'pods',
     'podMetricsSummary',
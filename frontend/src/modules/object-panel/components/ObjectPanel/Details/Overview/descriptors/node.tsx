@@ -10,7 +10,9 @@
 
 import { DrainIcon } from '@shared/components/icons/SharedIcons';
 import { StatusChip, type StatusChipVariant } from '@shared/components/StatusChip';
+import { formatMemoryValue } from '@shared/utils/resourceCalculations';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
+import { formatAge } from '@/utils/ageFormatter';
 import { nodes } from '@wailsjs/go/models';
 import type React from 'react';
 import type { OverviewContext, OverviewDescriptor } from '../schema';
@@ -64,6 +66,84 @@ const renderDrainIcon = (_d: NodeDetails, context: OverviewContext): React.React
 
 const conditionList = (d: NodeDetails): nodes.NodeCondition[] => d.conditions ?? [];
 
+// Tooltip lines for a condition chip: why it is in its state and how long it has been there.
+const conditionTooltip = (condition: nodes.NodeCondition): React.ReactNode => {
+  const lines = [
+    condition.reason,
+    condition.message,
+    condition.lastTransitionTime ? `Changed ${formatAge(condition.lastTransitionTime)} ago` : '',
+    condition.lastHeartbeatTime ? `Heartbeat ${formatAge(condition.lastHeartbeatTime)} ago` : '',
+  ].filter((line): line is string => Boolean(line));
+  if (lines.length === 0) {
+    return undefined;
+  }
+  return (
+    <>
+      {lines.map((line) => (
+        <div key={line}>{line}</div>
+      ))}
+    </>
+  );
+};
+
+const formatImageSize = (bytes: number | undefined): string =>
+  formatMemoryValue((bytes ?? 0) / (1024 * 1024));
+
+// Images beyond this count are folded behind a <details> toggle.
+const VISIBLE_IMAGE_COUNT = 5;
+
+const imageName = (image: nodes.NodeImage): string => {
+  const names = image.names ?? [];
+  // Prefer a tagged reference over the digest form the kubelet also reports.
+  return names.find((name) => !name.includes('@')) ?? names[0] ?? '<unnamed>';
+};
+
+const renderImageRows = (images: nodes.NodeImage[]): React.ReactNode =>
+  withStableListKeys(images, (image) => (image.names ?? []).join(',')).map(
+    ({ key, value: image }) => (
+      <div key={key} className="overview-row">
+        <span className="overview-row-label node-overview-image-name">{imageName(image)}</span>
+        <span className="overview-row-value">{formatImageSize(image.sizeBytes)}</span>
+      </div>
+    )
+  );
+
+const renderImages = (d: NodeDetails): React.ReactNode => {
+  const images = d.images ?? [];
+  const hidden = images.slice(VISIBLE_IMAGE_COUNT);
+  return (
+    <div className="overview-row-list">
+      <div className="node-overview-images-summary">
+        {images.length} {images.length === 1 ? 'image' : 'images'},{' '}
+        {formatImageSize(d.imagesSizeBytes)} total
+      </div>
+      {renderImageRows(images.slice(0, VISIBLE_IMAGE_COUNT))}
+      {hidden.length > 0 ? (
+        <details className="node-overview-images-more">
+          <summary>{hidden.length} more</summary>
+          {renderImageRows(hidden)}
+        </details>
+      ) : null}
+    </div>
+  );
+};
+
+const renderResources = (d: NodeDetails): React.ReactNode => (
+  <div className="overview-row-list">
+    {(d.resources ?? []).map((resource) => (
+      <div key={resource.name} className="overview-row">
+        <span className="overview-row-label">{resource.name}</span>
+        <span className="overview-row-value">
+          {resource.allocatable ?? resource.capacity}
+          {' allocatable of '}
+          {resource.capacity}
+          {resource.reserved ? ` (${resource.reserved} reserved)` : ''}
+        </span>
+      </div>
+    ))}
+  </div>
+);
+
 const renderConditions = (d: NodeDetails): React.ReactNode => (
   <div className="overview-condition-list">
     {conditionList(d)
@@ -72,7 +152,7 @@ const renderConditions = (d: NodeDetails): React.ReactNode => (
         <StatusChip
           key={condition.kind}
           variant={nodeConditionVariant(condition.kind, condition.status)}
-          tooltip={condition.message || condition.reason || undefined}
+          tooltip={conditionTooltip(condition)}
         >
           {condition.kind}
         </StatusChip>
@@ -155,6 +235,16 @@ export const nodeDescriptor: OverviewDescriptor<NodeDetails> = {
       // Storage capacity if available.
       { field: 'storageCapacity', label: 'Storage', hidden: (d) => !d.storageCapacity },
 
+      // Allocatable vs capacity for every resource the node advertises, with the reserved
+      // difference held back for system daemons and eviction thresholds.
+      {
+        field: 'resources',
+        label: 'Resources',
+        fullWidth: true,
+        hidden: (d) => (d.resources ?? []).length === 0,
+        render: renderResources,
+      },
+
       // System info group — visually separated from surrounding rows.
       {
         kind: 'widget',
@@ -184,6 +274,16 @@ export const nodeDescriptor: OverviewDescriptor<NodeDetails> = {
         hidden: (d) => (d.taints ?? []).length === 0,
         render: renderTaints,
       },
+
+      // Images cached on the node, largest first.
+      {
+        field: 'images',
+        derivedFrom: ['imagesSizeBytes'],
+        label: 'Images',
+        fullWidth: true,
+        hidden: (d) => (d.images ?? []).length === 0,
+        render: renderImages,
+      },
     ],
   },
   // Consumed by the separate Utilization section (CPU/memory/pods/storage metrics + pod list), not
@@ -13,6 +13,9 @@ import {
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { types } from '@wailsjs/go/models';
 import type React from 'react';
+import { CrashHistory } from '../CrashHistory';
+import { ImageScan } from '../ImageScan';
+import { PodSchedulingExplainer } from '../PodSchedulingExplainer';
 import type { OverviewContext, OverviewDescriptor } from '../schema';
 import {
   DEFAULT_TOLERATION_RE,
@@ -131,6 +134,47 @@ export const podDescriptor: OverviewDescriptor<PodDetailInfo> = {
           return d.ready;
         },
       },
+      // A Pending pod with no node yet gets the scheduling explainer under its status.
+      {
+        kind: 'widget',
+        render: (d, context) =>
+          d.status === 'Pending' && !d.node ? (
+            <PodSchedulingExplainer
+              clusterId={context.clusterId}
+              namespace={d.namespace}
+              name={d.name}
+            />
+          ) : null,
+        consumes: [],
+      },
+      // Container termination history; renders nothing until one is recorded.
+      {
+        kind: 'widget',
+        render: (d, context) => (
+          <CrashHistory
+            clusterId={context.clusterId}
+            namespace={d.namespace}
+            kind="Pod"
+            name={d.name}
+          />
+        ),
+        consumes: [],
+      },
+      // Vulnerability counts for the pod's images; the scan runs on demand.
+      {
+        kind: 'widget',
+        render: (d, context) => (
+          <ImageScan
+            clusterId={context.clusterId}
+            namespace={d.namespace}
+            group=""
+            version="v1"
+            kind="Pod"
+            name={d.name}
+          />
+        ),
+        consumes: [],
+      },
       // Separator before the identity group (Restarts / Owner / Node / IPs).
       {
         kind: 'widget',
@@ -12,6 +12,7 @@ import { buildRequiredRelatedObjectReference } from '@shared/utils/objectIdentit
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { hpa, limitrange, poddisruptionbudget, resourcequota } from '@wailsjs/go/models';
 import type React from 'react';
+import { HpaScalingHistory } from '../HpaScalingHistory';
 import type { OverviewContext, OverviewDescriptor } from '../schema';
 import '../PolicyOverview.css';
 
@@ -336,6 +337,14 @@ export const hpaDescriptor: OverviewDescriptor<HorizontalPodAutoscalerDetails> =
         label: 'Scale Down',
         render: (d) => renderBehaviorRules(d.behavior?.scaleDown, 'down'),
       },
+      {
+        // Recorded this session from the HPA and event informers, not from the details DTO.
+        kind: 'widget',
+        consumes: [],
+        render: (d, context) => (
+          <HpaScalingHistory clusterId={context.clusterId} namespace={d.namespace} name={d.name} />
+        ),
+      },
     ],
   },
   // Not surfaced in the Overview: `details` (table-summary string), `conditions`, and
@@ -58,6 +58,6 @@ export const secretDescriptor: OverviewDescriptor<SecretDetails> = {
       },
     ],
   },
-  // data → DataSection (masked); dataKeys/dataCount/details not surfaced in the Overview.
-  coveredElsewhere: ['data', 'dataKeys', 'dataCount', 'details'],
+  // data → DataSection (masked); dataKeys/keys/dataCount/details not surfaced in the Overview.
+  coveredElsewhere: ['data', 'dataKeys', 'keys', 'dataCount', 'details'],
 };
@@ -13,6 +13,7 @@ import { buildRequiredObjectReference } from '@shared/utils/objectIdentity';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { persistentvolume, persistentvolumeclaim, storageclass } from '@wailsjs/go/models';
 import type React from 'react';
+import { PvcExpansion } from '../PvcExpansion';
 import type { OverviewContext, OverviewDescriptor } from '../schema';
 import '../shared/OverviewBlocks.css';
 
@@ -155,6 +156,20 @@ export const pvcDescriptor: OverviewDescriptor<PersistentVolumeClaimDetails> = {
           ),
       },
       { field: 'capacity', label: 'Capacity' },
+      // Resize in flight and the resize form; hidden when the StorageClass could not be read.
+      {
+        kind: 'widget',
+        render: (d, context) => (
+          <PvcExpansion
+            clusterId={context.clusterId}
+            namespace={d.namespace}
+            name={d.name}
+            capacity={d.capacity}
+            expansion={d.expansion}
+          />
+        ),
+        consumes: ['expansion'],
+      },
       {
         field: 'accessModes',
         label: 'Access Modes',
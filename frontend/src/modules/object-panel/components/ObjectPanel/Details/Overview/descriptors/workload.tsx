@@ -18,13 +18,21 @@ import { buildRequiredObjectReference } from '@shared/utils/objectIdentity';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { daemonset, deployment, replicaset, statefulset } from '@wailsjs/go/models';
 import type React from 'react';
-import type { OverviewContext, OverviewDescriptor, OverviewItemSpec } from '../schema';
+import { CrashHistory } from '../CrashHistory';
+import { ImageScan } from '../ImageScan';
+import type {
+  OverviewContext,
+  OverviewDescriptor,
+  OverviewItemSpec,
+  OverviewWidget,
+} from '../schema';
 import { OverviewItem } from '../shared/OverviewItem';
 import {
   DEFAULT_TOLERATION_RE,
   type ParsedToleration,
   parseToleration,
 } from '../shared/tolerations';
+import { StatefulSetOrdinals } from '../StatefulSetOrdinals';
 import '../shared/OverviewBlocks.css';
 import '../WorkloadOverview.css';
 
@@ -415,6 +423,35 @@ const nonDefaultTolerations = (tolerations: string[] | undefined): ParsedTolerat
     .map(parseToleration)
     .filter((p): p is ParsedToleration => p !== null) ?? [];
 
+// Container termination history of the workload's pods; renders nothing until one is recorded.
+const crashHistoryWidget = <T extends { name: string; namespace: string }>(
+  kind: string
+): OverviewWidget<T> => ({
+  kind: 'widget',
+  consumes: [],
+  render: (d, context) => (
+    <CrashHistory clusterId={context.clusterId} namespace={d.namespace} kind={kind} name={d.name} />
+  ),
+});
+
+// Vulnerability counts for the pod template's images; the scan runs on demand.
+const imageScanWidget = <T extends { name: string; namespace: string }>(
+  kind: string
+): OverviewWidget<T> => ({
+  kind: 'widget',
+  consumes: [],
+  render: (d, context) => (
+    <ImageScan
+      clusterId={context.clusterId}
+      namespace={d.namespace}
+      group="apps"
+      version="v1"
+      kind={kind}
+      name={d.name}
+    />
+  ),
+});
+
 const renderPodTemplateGroup = (d: PodTemplate, context: OverviewContext): React.ReactNode => {
   const tolerations = nonDefaultTolerations(d.tolerations);
   const serviceAccount = d.serviceAccount !== 'default' ? d.serviceAccount : undefined;
@@ -526,6 +563,8 @@ const deploymentItems: OverviewItemSpec<DeploymentDetails>[] = [
         context
       ),
   },
+  crashHistoryWidget('Deployment'),
+  imageScanWidget('Deployment'),
   // Up-to-date — only surface when there's revision drift (rollout in progress).
   {
     field: 'upToDate',
@@ -740,6 +779,8 @@ const daemonSetItems: OverviewItemSpec<DaemonSetDetails>[] = [
         context
       ),
   },
+  crashHistoryWidget('DaemonSet'),
+  imageScanWidget('DaemonSet'),
   // Up-to-date — only surface when there's revision drift.
   {
     field: 'upToDate',
@@ -838,6 +879,8 @@ const statefulSetItems: OverviewItemSpec<StatefulSetDetails>[] = [
         context
       ),
   },
+  crashHistoryWidget('StatefulSet'),
+  imageScanWidget('StatefulSet'),
   // Up-to-date — only surface when there's revision drift.
   {
     field: 'upToDate',
@@ -897,6 +940,28 @@ const statefulSetItems: OverviewItemSpec<StatefulSetDetails>[] = [
     hidden: (d) => !(d.minReadySeconds && d.minReadySeconds > 0),
     render: (d) => (d.minReadySeconds && d.minReadySeconds > 0 ? `${d.minReadySeconds}s` : null),
   },
+  // Per-ordinal revision status with the delete / force-delete / partition actions.
+  {
+    kind: 'widget',
+    consumes: ['ordinals'],
+    render: (d, context) =>
+      d.ordinals && d.ordinals.length > 0 ? (
+        <OverviewItem
+          label="Ordinals"
+          fullWidth
+          value={
+            <StatefulSetOrdinals
+              clusterId={context.clusterId}
+              namespace={d.namespace}
+              name={d.name}
+              ordinals={d.ordinals}
+              updateStrategy={d.updateStrategy}
+              partition={d.partition}
+            />
+          }
+        />
+      ) : null,
+  },
   // Volume claim templates + PVC retention. The leading separator is emitted by
   // the widget only when there's at least one volume-related row to render.
   {
@@ -1039,6 +1104,8 @@ const replicaSetItems: OverviewItemSpec<ReplicaSetDetails>[] = [
         context
       ),
   },
+  crashHistoryWidget('ReplicaSet'),
+  imageScanWidget('ReplicaSet'),
   // Min-ready when configured.
   {
     field: 'minReadySeconds',
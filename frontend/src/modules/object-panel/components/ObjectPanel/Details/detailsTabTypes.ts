@@ -12,6 +12,10 @@ export interface DetailsTabProps {
   objectData?: ObjectPanelRef | null;
   detailModel: ObjectDetailModel;
   isActive?: boolean;
+  /** object-references scope for the Referenced By section; null hides it. */
+  referencesScope?: string | null;
+  /** object-storage-chain scope for PVCs and PVs; null hides the Storage Chain section. */
+  storageChainScope?: string | null;
   detailsLoading: boolean;
   detailsError: string | null;
   resourceDeleted?: boolean;
@@ -55,21 +55,24 @@ describe('objectDetailModel', () => {
 
   it('selects data sections for configmaps and secrets', () => {
     const configMapModel = buildObjectDetailModel(null, 'configmap', {
-      data: { key: 'value' },
-      binaryData: { cert: 'base64' },
+      keys: [
+        { key: 'key', size: 5 },
+        { key: 'cert', size: 4, binary: true },
+      ],
     } as unknown as configmap.ConfigMapDetails);
     const secretModel = buildObjectDetailModel(null, 'secret', {
-      data: { token: 'masked' },
+      keys: [{ key: 'token', size: 6 }],
     } as unknown as secret.SecretDetails);
 
     expect(configMapModel.dataSection).toEqual({
-      data: { key: 'value' },
-      binaryData: { cert: 'base64' },
+      keys: [
+        { key: 'key', size: 5 },
+        { key: 'cert', size: 4, binary: true },
+      ],
       isSecret: false,
     });
     expect(secretModel.dataSection).toEqual({
-      data: { token: 'masked' },
-      binaryData: undefined,
+      keys: [{ key: 'token', size: 6 }],
       isSecret: true,
     });
   });
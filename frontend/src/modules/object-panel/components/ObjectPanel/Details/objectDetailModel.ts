@@ -23,9 +23,18 @@ type PolicyRule = {
   nonResourceURLs?: string[];
 };
 
+/**
+ * One data key and its size. ConfigMap values are fetched per key on demand; Secret values
+ * only through an audited reveal, a range at a time for large keys.
+ */
+export interface DetailDataKey {
+  key: string;
+  size: number;
+  binary?: boolean;
+}
+
 export interface DetailDataSection {
-  data?: Record<string, string>;
-  binaryData?: Record<string, string>;
+  keys: DetailDataKey[];
   isSecret: boolean;
 }
 
@@ -99,8 +108,7 @@ interface DerivableDetail {
   pods?: Array<{ name?: string | null }> | null;
   desiredReplicas?: number;
   suspend?: boolean;
-  data?: Record<string, string>;
-  binaryData?: Record<string, string>;
+  keys?: DetailDataKey[] | null;
   rules?: PolicyRule[];
   ports?: Array<{ protocol?: string }> | null;
 }
@@ -172,14 +180,7 @@ function selectDataSection(
   if (!config?.dataSection || !detail) {
     return null;
   }
-  if (config.dataSection === 'plain') {
-    return {
-      data: detail.data ?? undefined,
-      binaryData: detail.binaryData ?? undefined,
-      isSecret: false,
-    };
-  }
-  return { data: detail.data ?? undefined, binaryData: undefined, isSecret: true };
+  return { keys: detail.keys ?? [], isSecret: config.dataSection === 'secret' };
 }
 
 function selectPortForwardAvailable(
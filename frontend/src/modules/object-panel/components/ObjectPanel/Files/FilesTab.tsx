@@ -0,0 +1,322 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Files/FilesTab.tsx
+ *
+ * Browses a container's filesystem: walk directories, preview text files and
+ * download a selection, without a shell session or kubectl cp.
+ */
+
+import { DownloadPodFiles } from '@/core/backend-api';
+import { readPodDirectory, readPodFilePreview, requestData } from '@/core/data-access';
+import { PodInspectorToolbar } from '@modules/object-panel/components/ObjectPanel/PodInspector/PodInspectorToolbar';
+import { usePodInspectorRead } from '@modules/object-panel/components/ObjectPanel/PodInspector/usePodInspectorRead';
+import { ObjectPanelResourceGridTableSurface } from '@modules/resource-grid/ObjectPanelResourceGridTableSurface';
+import { useObjectPanelResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import {
+  applyColumnSizing,
+  type ColumnSizingMap,
+  createTextColumn,
+} from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import { errorHandler } from '@utils/errorHandler';
+import type { pods } from '@wailsjs/go/models';
+import type React from 'react';
+import { useCallback, useEffect, useMemo, useState } from 'react';
+import '../shared.css';
+import './FilesTab.css';
+import {
+  buildFileRows,
+  containerPathSegments,
+  type FileRow,
+  formatFileSize,
+  joinContainerPath,
+  parentContainerPath,
+} from './filesModel';
+
+interface FilesTabProps {
+  namespace: string;
+  resourceName: string;
+  isActive: boolean;
+  availableContainers: string[];
+  clusterId?: string | null;
+}
+
+const COLUMN_SIZING: ColumnSizingMap = {
+  select: { width: 36, autoWidth: false },
+  type: { autoWidth: true },
+  size: { autoWidth: true },
+  mode: { autoWidth: true },
+  modified: { autoWidth: true },
+};
+
+const formatModified = (value: string): string => {
+  const date = new Date(value);
+  return Number.isNaN(date.getTime()) || date.getTime() <= 0 ? '—' : date.toLocaleString();
+};
+
+const FilesTab: React.FC<FilesTabProps> = ({
+  namespace,
+  resourceName,
+  isActive,
+  availableContainers,
+  clusterId,
+}) => {
+  const [path, setPath] = useState('/');
+  const [selected, setSelected] = useState<Set<string>>(() => new Set());
+  const [preview, setPreview] = useState<pods.FilePreview | null>(null);
+  const [downloading, setDownloading] = useState(false);
+
+  const readDirectory = useCallback(
+    (cluster: string, ns: string, podName: string, container: string) =>
+      readPodDirectory(cluster, {
+        namespace: ns,
+        podName,
+        container: container || undefined,
+        path,
+      }),
+    [path]
+  );
+
+  const {
+    data: listing,
+    error,
+    loading,
+    container,
+    setContainer,
+    refresh,
+  } = usePodInspectorRead({
+    resource: 'pod-files',
+    clusterId,
+    namespace,
+    podName: resourceName,
+    isActive,
+    availableContainers,
+    read: readDirectory,
+    // Directories are read on navigation and on Refresh only.
+    pollIntervalMs: 0,
+  });
+
+  // Another pod or container has its own filesystem: start again at the root.
+  useEffect(() => {
+    setPath('/');
+  }, [clusterId, namespace, resourceName, container]);
+
+  useEffect(() => {
+    setSelected(new Set());
+    setPreview(null);
+  }, [clusterId, namespace, resourceName, container, path]);
+
+  // Entries belong to the listing that was read, which lags path until it lands.
+  const directory = listing?.path ?? path;
+
+  const openEntry = useCallback(
+    (row: FileRow) => {
+      if (row.navigable) {
+        setPath(joinContainerPath(directory, row.name));
+        return;
+      }
+      if (!clusterId) {
+        return;
+      }
+      const request = {
+        namespace,
+        podName: resourceName,
+        container: container || undefined,
+        path: joinContainerPath(directory, row.name),
+      };
+      void requestData({
+        resource: 'pod-file-preview',
+        reason: 'user',
+        read: () => readPodFilePreview(clusterId, request),
+      })
+        .then((result) => {
+          if (result.status === 'executed' && result.data) {
+            setPreview(result.data);
+          }
+        })
+        .catch((previewError) => {
+          errorHandler.handle(previewError, { action: 'previewPodFile' });
+        });
+    },
+    [clusterId, container, directory, namespace, resourceName]
+  );
+
+  const toggleSelected = useCallback((name: string) => {
+    setSelected((current) => {
+      const next = new Set(current);
+      if (next.has(name)) {
+        next.delete(name);
+      } else {
+        next.add(name);
+      }
+      return next;
+    });
+  }, []);
+
+  const handleDownload = useCallback(async () => {
+    if (!clusterId || selected.size === 0) {
+      return;
+    }
+    setDownloading(true);
+    try {
+      await DownloadPodFiles(clusterId, {
+        namespace,
+        podName: resourceName,
+        container: container || undefined,
+        directory,
+        names: Array.from(selected).sort(),
+      });
+    } catch (downloadError) {
+      // Dismissing the save dialog is not a failure worth reporting.
+      if (!String(downloadError).endsWith('canceled')) {
+        errorHandler.handle(downloadError, { action: 'downloadPodFiles' });
+      }
+    } finally {
+      setDownloading(false);
+    }
+  }, [clusterId, container, directory, namespace, resourceName, selected]);
+
+  const rows = useMemo<FileRow[]>(
+    () => buildFileRows(listing).map((row) => ({ ...row, clusterId: clusterId ?? null })),
+    [clusterId, listing]
+  );
+
+  const columns = useMemo<GridColumnDefinition<FileRow>[]>(() => {
+    const base: GridColumnDefinition<FileRow>[] = [
+      {
+        key: 'select',
+        header: '',
+        sortable: false,
+        render: (row) => (
+          <input
+            type="checkbox"
+            aria-label={`Select ${row.name}`}
+            checked={selected.has(row.name)}
+            onChange={() => toggleSelected(row.name)}
+          />
+        ),
+      },
+      createTextColumn<FileRow>(
+        'name',
+        'Name',
+        (row) => (row.navigable ? `${row.name}/` : row.name),
+        {
+          onClick: openEntry,
+          getClassName: () => 'object-panel-link',
+          getTitle: (row) => (row.navigable ? 'Open directory' : 'Preview file'),
+          // Directories first, as the backend lists them.
+          sortValue: (row) => `${row.navigable ? 0 : 1}${row.name}`,
+        }
+      ),
+      createTextColumn<FileRow>('type', 'Type', (row) => row.type),
+      createTextColumn<FileRow>(
+        'size',
+        'Size',
+        (row) => (row.navigable ? '—' : formatFileSize(row.size)),
+        { sortValue: (row) => row.size, alignData: 'right' }
+      ),
+      createTextColumn<FileRow>('mode', 'Mode', (row) => row.mode),
+      createTextColumn<FileRow>('modified', 'Modified', (row) => formatModified(row.modifiedAt), {
+        sortValue: (row) => row.modifiedAt,
+      }),
+    ];
+    applyColumnSizing(base, COLUMN_SIZING);
+    return base;
+  }, [openEntry, selected, toggleSelected]);
+
+  const keyExtractor = useCallback((row: FileRow) => row.key, []);
+  const getSearchTokens = useCallback((row: FileRow) => [row.name, row.type], []);
+
+  const { gridTableProps } = useObjectPanelResourceGridTable<FileRow>({
+    tableMode: 'Local Complete',
+    viewId: 'object-panel-files',
+    clusterIdentity: clusterId ?? '',
+    enabled: Boolean(clusterId),
+    data: rows,
+    columns,
+    keyExtractor,
+    diagnosticsLabel: 'Object Panel Files',
+    defaultSort: { key: 'name', direction: 'asc' },
+    filterAccessors: {
+      getSearchText: getSearchTokens,
+    },
+  });
+
+  return (
+    <div className="object-panel-pods">
+      <PodInspectorToolbar
+        availableContainers={availableContainers}
+        container={container}
+        onContainerChange={setContainer}
+        error={error}
+      />
+      <div className="pod-files__toolbar">
+        <button
+          type="button"
+          className="button generic small"
+          onClick={() => setPath(parentContainerPath(path))}
+          disabled={path === '/'}
+        >
+          Up
+        </button>
+        <nav className="pod-files__breadcrumb" aria-label="Container path">
+          {containerPathSegments(path).map((segment) => (
+            <button
+              key={segment.path}
+              type="button"
+              className="object-panel-link"
+              onClick={() => setPath(segment.path)}
+            >
+              {segment.label}
+            </button>
+          ))}
+        </nav>
+        <button type="button" className="button generic small" onClick={refresh}>
+          Refresh
+        </button>
+        <button
+          type="button"
+          className="button generic small"
+          onClick={() => void handleDownload()}
+          disabled={selected.size === 0 || downloading}
+        >
+          {downloading ? 'Downloading...' : `Download${selected.size ? ` (${selected.size})` : ''}`}
+        </button>
+      </div>
+      <div className="object-panel-pods__table">
+        <ObjectPanelResourceGridTableSurface<FileRow>
+          gridTableProps={{
+            ...gridTableProps,
+            // Local-complete table: "all matching rows" is the local row set.
+            fetchAllRows: () => Promise.resolve(rows),
+            exportFilename: 'object-panel-files',
+          }}
+          columns={columns}
+          diagnosticsLabel="Object Panel Files"
+          tableClassName="gridtable-files"
+          loading={loading}
+          spinnerMessage="Loading files..."
+          updatingMessage="Updating files..."
+          hideHeader={!isActive}
+        />
+      </div>
+      {preview && (
+        <div className="pod-files__preview">
+          <div className="pod-files__preview-header">
+            <span className="pod-files__preview-path">{preview.path}</span>
+            {preview.truncated && <span className="pod-files__preview-note">Truncated</span>}
+            <button type="button" className="button generic small" onClick={() => setPreview(null)}>
+              Close
+            </button>
+          </div>
+          {preview.binary ? (
+            <div className="pod-files__preview-note">Binary file; download it to inspect.</div>
+          ) : (
+            <pre className="pod-files__preview-content">{preview.content}</pre>
+          )}
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default FilesTab;
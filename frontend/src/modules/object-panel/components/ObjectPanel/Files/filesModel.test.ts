@@ -0,0 +1,56 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Files/filesModel.test.ts
+ */
+
+import type { pods } from '@wailsjs/go/models';
+import { describe, expect, it } from 'vitest';
+import {
+  buildFileRows,
+  containerPathSegments,
+  formatFileSize,
+  joinContainerPath,
+  parentContainerPath,
+} from './filesModel';
+
+describe('filesModel', () => {
+  it('marks directories and directory symlinks as navigable', () => {
+    const rows = buildFileRows({
+      container: 'app',
+      path: '/',
+      entries: [
+        { name: 'etc', type: 'dir', size: 4096, mode: '755', modifiedAt: '2026-01-01T00:00:00Z' },
+        { name: 'lib', type: 'symlink', size: 7, mode: '777', modifiedAt: '', linkToDir: true },
+        { name: 'app.conf', type: 'file', size: 12, mode: '644', modifiedAt: '' },
+      ],
+    } as unknown as pods.DirectoryListing);
+    expect(rows.map((row) => [row.name, row.type, row.navigable])).toEqual([
+      ['etc', 'dir', true],
+      ['lib', 'symlink → dir', true],
+      ['app.conf', 'file', false],
+    ]);
+    expect(buildFileRows(null)).toEqual([]);
+  });
+
+  it('joins and walks up container paths', () => {
+    expect(joinContainerPath('/', 'etc')).toBe('/etc');
+    expect(joinContainerPath('/etc', 'nginx')).toBe('/etc/nginx');
+    expect(parentContainerPath('/etc/nginx')).toBe('/etc');
+    expect(parentContainerPath('/etc')).toBe('/');
+    expect(parentContainerPath('/')).toBe('/');
+  });
+
+  it('builds breadcrumb segments from the root', () => {
+    expect(containerPathSegments('/var/log')).toEqual([
+      { label: '/', path: '/' },
+      { label: 'var', path: '/var' },
+      { label: 'log', path: '/var/log' },
+    ]);
+    expect(containerPathSegments('/')).toEqual([{ label: '/', path: '/' }]);
+  });
+
+  it('formats sizes in binary units', () => {
+    expect(formatFileSize(512)).toBe('512B');
+    expect(formatFileSize(1536)).toBe('1.5Ki');
+    expect(formatFileSize(3 * 1024 * 1024)).toBe('3.0Mi');
+  });
+});
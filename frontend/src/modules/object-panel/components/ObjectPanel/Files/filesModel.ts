@@ -0,0 +1,73 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Files/filesModel.ts
+ *
+ * Row shaping and path helpers for the pod Files tab. Container paths are
+ * always absolute POSIX paths.
+ */
+
+import type { pods } from '@wailsjs/go/models';
+
+export interface FileRow {
+  key: string;
+  name: string;
+  type: string;
+  // Directories and symlinks to directories open on click; the rest preview.
+  navigable: boolean;
+  size: number;
+  mode: string;
+  modifiedAt: string;
+  clusterId?: string | null;
+}
+
+export const buildFileRows = (listing: pods.DirectoryListing | null): FileRow[] =>
+  (listing?.entries ?? []).map((entry) => ({
+    key: entry.name,
+    name: entry.name,
+    type: entry.linkToDir ? 'symlink → dir' : entry.type,
+    navigable: entry.type === 'dir' || Boolean(entry.linkToDir),
+    size: entry.size,
+    mode: entry.mode,
+    modifiedAt: String(entry.modifiedAt ?? ''),
+  }));
+
+export const joinContainerPath = (dir: string, name: string): string =>
+  dir === '/' ? `/${name}` : `${dir}/${name}`;
+
+export const parentContainerPath = (dir: string): string => {
+  const index = dir.lastIndexOf('/');
+  return index <= 0 ? '/' : dir.slice(0, index);
+};
+
+export interface PathSegment {
+  label: string;
+  path: string;
+}
+
+// Breadcrumb segments from the root down to dir.
+export const containerPathSegments = (dir: string): PathSegment[] => {
+  const segments: PathSegment[] = [{ label: '/', path: '/' }];
+  let current = '';
+  dir
+    .split('/')
+    .filter(Boolean)
+    .forEach((part) => {
+      current = `${current}/${part}`;
+      segments.push({ label: part, path: current });
+    });
+  return segments;
+};
+
+const UNITS = ['Ki', 'Mi', 'Gi', 'Ti'];
+
+export const formatFileSize = (bytes: number): string => {
+  if (!Number.isFinite(bytes) || bytes < 1024) {
+    return `${Math.max(0, bytes || 0)}B`;
+  }
+  let value = bytes;
+  let unit = -1;
+  while (value >= 1024 && unit < UNITS.length - 1) {
+    value /= 1024;
+    unit += 1;
+  }
+  return `${value.toFixed(1)}${UNITS[unit]}`;
+};
@@ -2548,6 +2548,51 @@ describe('LogViewer active pod synchronisation', () => {
     await waitForText(container, 'No previous logs found');
   });
 
+  it('fetches every attempt of a Job and labels lines with their attempt', async () => {
+    const jobScope = buildContainerLogsScope('team-a:batch/v1:job:migrate');
+    seedLogSnapshot([], jobScope);
+    (FetchContainerLogs as unknown as ViMock).mockResolvedValue({
+      entries: [
+        {
+          pod: 'migrate-abc',
+          container: 'app',
+          line: 'out of memory',
+          timestamp: '2024-05-01T10:00:00Z',
+          isInit: false,
+          attempt: 'attempt 1 (Failed)',
+        },
+      ],
+    });
+
+    await renderViewer({
+      resourceKind: 'job',
+      resourceName: 'migrate',
+      activePodNames: ['migrate-abc'],
+      containerLogsScope: jobScope,
+    });
+    (FetchContainerLogs as unknown as ViMock).mockClear();
+
+    const attemptsButton = container.querySelector<HTMLButtonElement>(
+      'button[aria-label="Show all attempts (V)"]'
+    );
+    expect(attemptsButton).toBeTruthy();
+    await act(async () => {
+      attemptsButton?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+      await Promise.resolve();
+    });
+    await waitForMockCalls(FetchContainerLogs as unknown as ViMock, 1);
+
+    expect((FetchContainerLogs as unknown as ViMock).mock.calls[0][1]).toMatchObject({
+      scope: jobScope,
+      previous: false,
+      attempts: true,
+    });
+    await waitForText(container, 'attempt 1 (Failed)');
+    expect(container.querySelector('[aria-label="Active log filters"]')?.textContent).toContain(
+      'Showing all attempts'
+    );
+  });
+
   it('renders loading state when resource metadata is missing', async () => {
     // Mirror what getObjectPanelScopes would produce upstream when the
     // panel is in its empty state: a null containerLogsScope. The component
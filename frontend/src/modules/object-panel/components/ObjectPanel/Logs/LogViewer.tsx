@@ -231,7 +231,8 @@ const CONTAINER_FILTER_PREFIX = 'container:';
 const DEBUG_FILTER_PREFIX = 'debug:';
 const TARGET_LIMIT_WARNING_PATTERN =
   /^Logs are hidden for (\d+) containers because the (per-tab|global) limit of (\d+) was reached\. Using filters to reduce the number of containers may clear this message\.$/;
-const WORKLOAD_RAW_LOG_PREFIX_PATTERN = /^(?:(\[[^\]]+\]\s*))?\[([^/]+)\/([^\]]+)\]\s*(.*)/;
+const WORKLOAD_RAW_LOG_PREFIX_PATTERN =
+  /^(?:(\[[^\]]+\]\s*))?\[([^/]+)\/([^\]]+?)(?: · ([^\]]+))?\]\s*(.*)/;
 const EMPTY_CONTAINER_LOG_PLACEHOLDER = '[container emitted an empty log]';
 
 const mergeTargetLimitWarnings = (warnings: string[]): string[] => {
@@ -460,7 +461,10 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
   );
   const resourceKindKey = resourceKind?.toLowerCase() ?? '';
   const isWorkload = resourceKindKey !== 'pod';
-  const supportsPreviousContainerLogs = resourceKindKey === 'pod';
+  // Jobs and CronJobs reuse the one-shot previous-logs mode to show every
+  // attempt: failed and retried pods plus restarted containers' previous runs.
+  const supportsJobAttemptLogs = resourceKindKey === 'job' || resourceKindKey === 'cronjob';
+  const supportsPreviousContainerLogs = resourceKindKey === 'pod' || supportsJobAttemptLogs;
   const selectedFilterValues = useMemo(
     () => filterSelectionValues(selectedFilters),
     [selectedFilters]
@@ -743,7 +747,8 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
           container: backendLogSelection.container,
           includeInit: backendLogSelection.includeInit,
           includeEphemeral: backendLogSelection.includeEphemeral,
-          previous,
+          previous: previous && !supportsJobAttemptLogs,
+          attempts: previous && supportsJobAttemptLogs,
           tailLines: getObjPanelLogsBufferMaxSize(),
           sinceSeconds: 0,
         };
@@ -752,7 +757,11 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
           resource: 'container-logs-fallback',
           reason: isManual ? 'user' : 'background',
           adapter: 'rpc-read',
-          label: previous ? 'Previous Container Logs' : 'Container Logs Fallback',
+          label: !previous
+            ? 'Container Logs Fallback'
+            : supportsJobAttemptLogs
+              ? 'Job Attempt Logs'
+              : 'Previous Container Logs',
           scope: containerLogsScope,
           read: () => readContainerLogs(resolvedClusterId, request),
         });
@@ -776,6 +785,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
           container: entry.container ?? '',
           line: entry.line ?? '',
           isInit: Boolean(entry.isInit),
+          attempt: entry.attempt || undefined,
           _seq: ++seqCounterRef.current,
         }));
 
@@ -808,6 +818,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
       backendLogSelection.selectedFilters,
       backendLogSelection.matchNone,
       resolvedClusterId,
+      supportsJobAttemptLogs,
     ]
   );
 
@@ -1133,7 +1144,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
     if (showPreviousContainerLogs) {
       chips.push({
         key: 'previous-logs',
-        label: 'Showing previous logs',
+        label: supportsJobAttemptLogs ? 'Showing all attempts' : 'Showing previous logs',
         removeLabel: 'Return to live logs',
         onRemove: () => {
           dispatch({ type: 'STOP_PREVIOUS_LOGS' });
@@ -1207,6 +1218,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
     selectedFilterValues,
     selectorOptionLabelsByValue,
     showPreviousContainerLogs,
+    supportsJobAttemptLogs,
     textFilter,
   ]);
   const handleClearAllFilters = useCallback(() => {
@@ -1300,7 +1312,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
       case 'unavailable':
         return unavailableLogMessage ?? 'Logs are unavailable right now';
       case 'no_previous_logs':
-        return 'No previous logs found';
+        return supportsJobAttemptLogs ? 'No logs found for any attempt' : 'No previous logs found';
       case 'no_filter_matches':
         return 'No logs match the current filters';
       case 'no_logs_yet':
@@ -1308,7 +1320,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
       default:
         return '';
     }
-  }, [logEmptyState, unavailableLogMessage]);
+  }, [logEmptyState, supportsJobAttemptLogs, unavailableLogMessage]);
   const shouldShowPausedLogsEmptyState =
     logsLoadingState.suppressPassiveLoading &&
     logEmptyState === 'no_logs_yet' &&
@@ -1341,7 +1353,10 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
           entry.isInit,
           Boolean(entry.isEphemeral)
         );
-        const formatted = `[${entry.pod}/${containerLabel}] ${displayContent}`;
+        const source = entry.attempt
+          ? `${entry.pod}/${containerLabel} · ${entry.attempt}`
+          : `${entry.pod}/${containerLabel}`;
+        const formatted = `[${source}] ${displayContent}`;
         return timestampPrefix + formatted;
       }
 
@@ -1445,7 +1460,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
       if (isWorkload && line.includes('[') && line.includes('/')) {
         const match = line.match(WORKLOAD_RAW_LOG_PREFIX_PATTERN);
         if (match) {
-          const [, timestamp = '', pod, container, logLine] = match;
+          const [, timestamp = '', pod, container, attempt, logLine] = match;
           const podColor = podColors[pod] || podColors.__fallback__;
 
           return (
@@ -1493,7 +1508,7 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
                 >
                   {container}
                 </button>
-                ]
+                {attempt ? ` · ${attempt}` : null}]
               </span>
               <span> {renderMessageContent(logLine, `workload-${row.key}`)}</span>
             </div>
@@ -2054,8 +2069,12 @@ const LogViewerInner: React.FC<LogViewerProps> = ({
                             icon: <PreviousLogsIcon width={18} height={18} />,
                             active: showPreviousContainerLogs,
                             onClick: handleTogglePreviousContainerLogs,
-                            title: 'Show previous logs (V)',
-                            ariaLabel: 'Show previous logs (V)',
+                            title: supportsJobAttemptLogs
+                              ? 'Show all attempts (V)'
+                              : 'Show previous logs (V)',
+                            ariaLabel: supportsJobAttemptLogs
+                              ? 'Show all attempts (V)'
+                              : 'Show previous logs (V)',
                           },
                         ]
                       : []),
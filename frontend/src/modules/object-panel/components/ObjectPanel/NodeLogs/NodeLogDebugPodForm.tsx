@@ -0,0 +1,92 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/NodeLogs/NodeLogDebugPodForm.tsx
+ *
+ * Offered when the kubelet does not serve node logs: starts a debug pod on the
+ * node that reads them from the host filesystem instead.
+ */
+
+import { useState } from 'react';
+import {
+  type NodeLogDebugPodRequest,
+  type NodeLogSource,
+  startNodeLogDebugPod,
+} from './nodeLogsApi';
+
+export const NODE_LOG_DEBUG_POD_DEFAULTS: NodeLogDebugPodRequest = {
+  namespace: 'default',
+  image: 'busybox:latest',
+};
+
+interface NodeLogDebugPodFormProps {
+  clusterId?: string | null;
+  nodeName: string;
+  onStarted: (request: NodeLogDebugPodRequest, sources: NodeLogSource[]) => void;
+}
+
+const NodeLogDebugPodForm = ({ clusterId, nodeName, onStarted }: NodeLogDebugPodFormProps) => {
+  const [namespace, setNamespace] = useState(NODE_LOG_DEBUG_POD_DEFAULTS.namespace);
+  const [image, setImage] = useState(NODE_LOG_DEBUG_POD_DEFAULTS.image);
+  const [starting, setStarting] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const handleStart = async () => {
+    if (!clusterId) {
+      return;
+    }
+    const request = { namespace: namespace.trim(), image: image.trim() };
+    setStarting(true);
+    setError(null);
+    try {
+      const response = await startNodeLogDebugPod(clusterId, nodeName, request);
+      if (response.supported && response.sources.length > 0) {
+        onStarted(request, response.sources);
+        return;
+      }
+      setError(response.reason || 'The debug pod found no readable node logs');
+    } catch (startError) {
+      setError(startError instanceof Error ? startError.message : String(startError));
+    } finally {
+      setStarting(false);
+    }
+  };
+
+  return (
+    <div className="node-log-debug-pod">
+      <div>
+        Read the logs through a debug pod on this node instead. It mounts the host filesystem
+        read-only and is removed after 30 minutes.
+      </div>
+      <div className="node-log-debug-pod-fields">
+        <label>
+          <span>Namespace</span>
+          <input
+            type="text"
+            value={namespace}
+            onChange={(event) => setNamespace(event.target.value)}
+            aria-label="Debug pod namespace"
+          />
+        </label>
+        <label>
+          <span>Image</span>
+          <input
+            type="text"
+            value={image}
+            onChange={(event) => setImage(event.target.value)}
+            aria-label="Debug pod image"
+          />
+        </label>
+        <button
+          type="button"
+          className="button generic small"
+          onClick={() => void handleStart()}
+          disabled={starting || !namespace.trim() || !image.trim()}
+        >
+          {starting ? 'Starting...' : 'Start debug pod'}
+        </button>
+      </div>
+      {error ? <div className="node-log-debug-pod-error">Error: {error}</div> : null}
+    </div>
+  );
+};
+
+export default NodeLogDebugPodForm;
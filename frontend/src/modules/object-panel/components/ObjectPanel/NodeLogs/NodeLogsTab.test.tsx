@@ -7,9 +7,13 @@ import { resetLogViewerPrefsCacheForTesting } from '../Logs/logViewerPrefsCache'
 import NodeLogsTab from './NodeLogsTab';
 
 const mockFetchNodeLogs = vi.fn();
+const mockStartNodeLogDebugPod = vi.fn();
+const mockStopNodeLogDebugPod = vi.fn();
 
 vi.mock('./nodeLogsApi', () => ({
   fetchNodeLogs: (...args: unknown[]) => mockFetchNodeLogs(...args),
+  startNodeLogDebugPod: (...args: unknown[]) => mockStartNodeLogDebugPod(...args),
+  stopNodeLogDebugPod: (...args: unknown[]) => mockStopNodeLogDebugPod(...args),
 }));
 
 vi.mock('@core/contexts/ZoomContext', () => ({
@@ -46,6 +50,8 @@ describe('NodeLogsTab', () => {
     document.body.appendChild(container);
     root = ReactDOM.createRoot(container);
     mockFetchNodeLogs.mockReset();
+    mockStartNodeLogDebugPod.mockReset();
+    mockStopNodeLogDebugPod.mockReset();
     resetLogViewerPrefsCacheForTesting();
     Object.defineProperty(globalThis.navigator, 'clipboard', {
       configurable: true,
@@ -526,6 +532,70 @@ describe('NodeLogsTab', () => {
     );
   });
 
+  it('reads logs through a debug pod when the kubelet serves none', async () => {
+    const debugSource = {
+      id: 'debug:default/node-logs-abc/service:kubelet',
+      label: 'debug pod / services / kubelet',
+      kind: 'service' as const,
+      path: 'debug:default/node-logs-abc/service:kubelet',
+    };
+    mockStartNodeLogDebugPod.mockResolvedValue({ supported: true, sources: [debugSource] });
+    mockStopNodeLogDebugPod.mockResolvedValue(undefined);
+    await renderTab({
+      availability: { allowed: false, pending: false, reason: 'node logs are not supported' },
+      sources: [],
+    });
+
+    const start = Array.from(container.querySelectorAll('button')).find(
+      (button) => button.textContent === 'Start debug pod'
+    );
+    await act(async () => {
+      requireValue(start, 'expected debug pod start button').click();
+      await Promise.resolve();
+      await Promise.resolve();
+    });
+
+    expect(mockStartNodeLogDebugPod).toHaveBeenCalledWith('alpha:ctx', 'node-a', {
+      namespace: 'default',
+      image: 'busybox:latest',
+    });
+    expect(container.textContent).not.toContain('Logs are not available on this node');
+
+    const stop = Array.from(container.querySelectorAll('button')).find(
+      (button) => button.textContent === 'Stop debug pod'
+    );
+    await act(async () => {
+      requireValue(stop, 'expected debug pod stop button').click();
+      await Promise.resolve();
+    });
+
+    expect(mockStopNodeLogDebugPod).toHaveBeenCalledWith('alpha:ctx', 'node-a', {
+      namespace: 'default',
+      image: 'busybox:latest',
+    });
+    expect(container.textContent).toContain('Logs are not available on this node');
+  });
+
+  it('shows why the debug pod could not read logs', async () => {
+    mockStartNodeLogDebugPod.mockResolvedValue({
+      supported: false,
+      sources: [],
+      reason: 'pods is forbidden',
+    });
+    await renderTab({ availability: { allowed: false, pending: false }, sources: [] });
+
+    const start = Array.from(container.querySelectorAll('button')).find(
+      (button) => button.textContent === 'Start debug pod'
+    );
+    await act(async () => {
+      requireValue(start, 'expected debug pod start button').click();
+      await Promise.resolve();
+      await Promise.resolve();
+    });
+
+    expect(container.textContent).toContain('Error: pods is forbidden');
+  });
+
   it('defaults raw node logs to the newest visible content', async () => {
     const originalScrollHeight = Object.getOwnPropertyDescriptor(
       HTMLElement.prototype,
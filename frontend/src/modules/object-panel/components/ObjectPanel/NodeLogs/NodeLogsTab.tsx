@@ -13,6 +13,7 @@ import {
 } from '@shared/components/icons/LogIcons';
 import { CaseSensitiveIcon } from '@shared/components/icons/SharedIcons';
 import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import { errorHandler } from '@utils/errorHandler';
 import {
   startTransition,
   useCallback,
@@ -35,7 +36,14 @@ import {
   tryParseJSONObject,
 } from '../Logs/parsedLogUtils';
 import type { CapabilityState, LogDisplayMode } from '../types';
-import { fetchNodeLogs, type NodeLogFetchResponse, type NodeLogSource } from './nodeLogsApi';
+import NodeLogDebugPodForm from './NodeLogDebugPodForm';
+import {
+  fetchNodeLogs,
+  type NodeLogDebugPodRequest,
+  type NodeLogFetchResponse,
+  type NodeLogSource,
+  stopNodeLogDebugPod,
+} from './nodeLogsApi';
 import '../Logs/LogViewer.css';
 import './NodeLogsTab.css';
 import { useKeyboardSurface } from '@ui/shortcuts';
@@ -175,6 +183,11 @@ const buildNodeLogSourceOptions = (sources: NodeLogSource[]): DropdownOption[] =
   return options;
 };
 
+interface NodeLogDebugPodState {
+  request: NodeLogDebugPodRequest;
+  sources: NodeLogSource[];
+}
+
 interface NodeLogsTabProps {
   panelId: string;
   nodeName: string;
@@ -190,8 +203,14 @@ const NodeLogsTab = ({
   clusterId,
   isActive,
   availability,
-  sources,
+  sources: discoveredSources,
 }: NodeLogsTabProps) => {
+  const [debugPod, setDebugPod] = useState<NodeLogDebugPodState | null>(null);
+  // Kubelet sources win; the debug pod's only stand in when there are none.
+  const sources = useMemo(
+    () => (discoveredSources.length === 0 && debugPod ? debugPod.sources : discoveredSources),
+    [debugPod, discoveredSources]
+  );
   const [selectedSourcePath, setSelectedSourcePath] = useState('');
   const [textFilter, setTextFilter] = useState('');
   const [content, setContent] = useState('');
@@ -222,6 +241,20 @@ const NodeLogsTab = ({
     [sources]
   );
 
+  useEffect(() => {
+    setDebugPod(null);
+  }, [clusterId, nodeName]);
+
+  const handleStopDebugPod = useCallback(() => {
+    if (!clusterId || !debugPod) {
+      return;
+    }
+    setDebugPod(null);
+    void stopNodeLogDebugPod(clusterId, nodeName, debugPod.request).catch((stopError) => {
+      errorHandler.handle(stopError, { action: 'stopNodeLogDebugPod' });
+    });
+  }, [clusterId, debugPod, nodeName]);
+
   useEffect(() => {
     if (sources.length === 0) {
       setSelectedSourcePath('');
@@ -630,6 +663,13 @@ const NodeLogsTab = ({
               <div className="node-log-unavailable-message">
                 <div>Logs are not available on this node</div>
                 {availability.reason ? <div>Error: {availability.reason}</div> : null}
+                <NodeLogDebugPodForm
+                  clusterId={clusterId}
+                  nodeName={nodeName}
+                  onStarted={(request, debugSources) =>
+                    setDebugPod({ request, sources: debugSources })
+                  }
+                />
               </div>
             </div>
           </div>
@@ -686,6 +726,11 @@ const NodeLogsTab = ({
                     : 'Select log source'
                 }
               />
+              {sources === debugPod?.sources ? (
+                <button type="button" className="button generic small" onClick={handleStopDebugPod}>
+                  Stop debug pod
+                </button>
+              ) : null}
             </div>
 
             <div className="logs-viewer-control-group logs-viewer-filter-group">
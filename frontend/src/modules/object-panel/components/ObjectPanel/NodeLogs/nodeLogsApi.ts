@@ -1,4 +1,5 @@
 import type { types } from '@wailsjs/go/models';
+import { StartNodeLogDebugPod, StopNodeLogDebugPod } from '@/core/backend-api';
 import {
   type DataRequestReason,
   readNodeLogDiscovery,
@@ -33,6 +34,13 @@ export interface NodeLogFetchResponse {
   truncated?: boolean;
 }
 
+// Where to run the debug pod that reads logs on nodes whose kubelet does not
+// serve them.
+export interface NodeLogDebugPodRequest {
+  namespace: string;
+  image: string;
+}
+
 export interface NodeLogFetchResult {
   status: 'executed' | 'blocked';
   data?: NodeLogFetchResponse;
@@ -148,3 +156,18 @@ export const fetchNodeLogs = async (
     data: result.data as NodeLogFetchResponse,
   };
 };
+
+// Starts (or reuses) the node's log debug pod; the sources it returns are read
+// with fetchNodeLogs like any other.
+export const startNodeLogDebugPod = async (
+  clusterId: string,
+  nodeName: string,
+  request: NodeLogDebugPodRequest
+): Promise<NodeLogDiscoveryResponse> =>
+  cloneNodeLogDiscoveryResponse(await StartNodeLogDebugPod(clusterId, nodeName, request));
+
+export const stopNodeLogDebugPod = (
+  clusterId: string,
+  nodeName: string,
+  request: NodeLogDebugPodRequest
+): Promise<void> => StopNodeLogDebugPod(clusterId, nodeName, request);
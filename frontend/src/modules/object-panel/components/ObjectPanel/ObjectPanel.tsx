@@ -35,7 +35,7 @@ import { ObjectPanelHeader } from '@modules/object-panel/components/ObjectPanel/
 import { ObjectPanelTabs } from '@modules/object-panel/components/ObjectPanel/ObjectPanelTabs';
 import type { ViewType } from '@modules/object-panel/components/ObjectPanel/types';
 import type { ObjectPanelRef } from '@modules/object-panel/objectPanelRef';
-import { getObjectPanelScopes } from '@modules/object-panel/objectPanelRef';
+import { getObjectPanelScopes, hasStorageChain } from '@modules/object-panel/objectPanelRef';
 import { getKindColorClass } from '@shared/utils/kindBadgeColors';
 import type { DockPosition } from '@ui/dockable';
 import { getGroupForPanel, getGroupTabs } from '@ui/dockable/tabGroupState';
@@ -281,6 +281,8 @@ function ObjectPanel({ panelId, objectRef }: ObjectPanelProps) {
         objectData,
         detailModel,
         isActive: isOpen && visibleActiveTab === 'details',
+        referencesScope: mapScope,
+        storageChainScope: hasStorageChain(objectKind) ? mapScope : null,
         detailsLoading,
         detailsError,
         resourceDeleted,
@@ -18,6 +18,9 @@ const hoistedRefs = vi.hoisted(() => ({
   manifestTabProps: { current: null as unknown },
   valuesTabProps: { current: null as unknown },
   shellTabProps: { current: null as unknown },
+  processesTabProps: { current: null as unknown },
+  socketsTabProps: { current: null as unknown },
+  filesTabProps: { current: null as unknown },
   nodeLogsTabProps: { current: null as unknown },
   podsTabProps: { current: null as unknown },
   setScopedDomainEnabled: vi.fn(),
@@ -78,6 +81,27 @@ vi.mock('@modules/object-panel/components/ObjectPanel/Shell/ShellTab', () => ({
   },
 }));
 
+vi.mock('@modules/object-panel/components/ObjectPanel/Processes/ProcessesTab', () => ({
+  default: (props: unknown) => {
+    hoistedRefs.processesTabProps.current = props;
+    return <div data-testid="processes-tab" />;
+  },
+}));
+
+vi.mock('@modules/object-panel/components/ObjectPanel/Sockets/SocketsTab', () => ({
+  default: (props: unknown) => {
+    hoistedRefs.socketsTabProps.current = props;
+    return <div data-testid="sockets-tab" />;
+  },
+}));
+
+vi.mock('@modules/object-panel/components/ObjectPanel/Files/FilesTab', () => ({
+  default: (props: unknown) => {
+    hoistedRefs.filesTabProps.current = props;
+    return <div data-testid="files-tab" />;
+  },
+}));
+
 vi.mock('@modules/object-panel/components/ObjectPanel/NodeLogs/NodeLogsTab', () => ({
   default: (props: unknown) => {
     hoistedRefs.nodeLogsTabProps.current = props;
@@ -248,6 +272,49 @@ describe('ObjectPanelContent', () => {
     expect(hoistedRefs.shellTabProps.current).toBeNull();
   });
 
+  it('renders processes tab only with the shell capability', () => {
+    renderContent({
+      activeTab: 'processes',
+      capabilities: { ...baseProps.capabilities, hasShell: false },
+    });
+    expect(hoistedRefs.processesTabProps.current).toBeNull();
+
+    renderContent({
+      activeTab: 'processes',
+      capabilities: { ...baseProps.capabilities, hasShell: true },
+    });
+    expect(hoistedRefs.processesTabProps.current).toMatchObject({
+      namespace: 'team-a',
+      resourceName: 'api',
+      isActive: true,
+      availableContainers: [],
+    });
+  });
+
+  it('renders sockets tab when active and capability present', () => {
+    renderContent({
+      activeTab: 'sockets',
+      capabilities: { ...baseProps.capabilities, hasShell: true },
+    });
+    expect(hoistedRefs.socketsTabProps.current).toMatchObject({
+      namespace: 'team-a',
+      resourceName: 'api',
+      isActive: true,
+    });
+  });
+
+  it('renders files tab when active and capability present', () => {
+    renderContent({
+      activeTab: 'files',
+      capabilities: { ...baseProps.capabilities, hasShell: true },
+    });
+    expect(hoistedRefs.filesTabProps.current).toMatchObject({
+      namespace: 'team-a',
+      resourceName: 'api',
+      isActive: true,
+    });
+  });
+
   it('passes capability information to YAML tab', () => {
     renderContent({
       activeTab: 'yaml',
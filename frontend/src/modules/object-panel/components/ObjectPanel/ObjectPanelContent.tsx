@@ -9,6 +9,7 @@
 import type { DetailsTabProps } from '@modules/object-panel/components/ObjectPanel/Details/DetailsTab';
 import DetailsTab from '@modules/object-panel/components/ObjectPanel/Details/DetailsTab';
 import EventsTab from '@modules/object-panel/components/ObjectPanel/Events/EventsTab';
+import FilesTab from '@modules/object-panel/components/ObjectPanel/Files/FilesTab';
 import ManifestTab from '@modules/object-panel/components/ObjectPanel/Helm/ManifestTab';
 import ValuesTab from '@modules/object-panel/components/ObjectPanel/Helm/ValuesTab';
 import {
@@ -21,7 +22,9 @@ import MapTab from '@modules/object-panel/components/ObjectPanel/Map/MapTab';
 import NodeLogsTab from '@modules/object-panel/components/ObjectPanel/NodeLogs/NodeLogsTab';
 import type { NodeLogSource } from '@modules/object-panel/components/ObjectPanel/NodeLogs/nodeLogsApi';
 import { PodsTab } from '@modules/object-panel/components/ObjectPanel/Pods/PodsTab';
+import ProcessesTab from '@modules/object-panel/components/ObjectPanel/Processes/ProcessesTab';
 import ShellTab from '@modules/object-panel/components/ObjectPanel/Shell/ShellTab';
+import SocketsTab from '@modules/object-panel/components/ObjectPanel/Sockets/SocketsTab';
 import type {
   CapabilityReasons,
   CapabilityState,
@@ -111,6 +114,9 @@ export function ObjectPanelContent({
   const showDetails = activeTab === 'details' && detailTabProps;
   const showLogs = activeTab === 'logs' && capabilities.hasObjPanelLogs && objectData;
   const showShell = activeTab === 'shell' && capabilities.hasShell && objectData;
+  const showProcesses = activeTab === 'processes' && capabilities.hasShell && objectData;
+  const showSockets = activeTab === 'sockets' && capabilities.hasShell && objectData;
+  const showFiles = activeTab === 'files' && capabilities.hasShell && objectData;
   const showPods = activeTab === 'pods';
   const showJobs = activeTab === 'jobs';
   const showEvents = activeTab === 'events';
@@ -119,6 +125,7 @@ export function ObjectPanelContent({
   const showManifest = activeTab === 'manifest';
   const showValues = activeTab === 'values';
 
+  const storageChainScope = detailTabProps?.storageChainScope ?? null;
   const scopedDomainCleanups = useMemo<readonly ObjectPanelScopedDomainRef[]>(
     () => [
       { domain: 'object-events', scope: eventsScope },
@@ -127,8 +134,10 @@ export function ObjectPanelContent({
       { domain: 'object-helm-values', scope: helmScope },
       { domain: 'container-logs', scope: containerLogsScope },
       { domain: 'object-map', scope: mapScope },
+      { domain: 'object-references', scope: mapScope },
+      { domain: 'object-storage-chain', scope: storageChainScope },
     ],
-    [containerLogsScope, detailScope, eventsScope, helmScope, mapScope]
+    [containerLogsScope, detailScope, eventsScope, helmScope, mapScope, storageChainScope]
   );
 
   // Stops panel-owned scoped refresh domains during transient unmounts while
@@ -210,6 +219,54 @@ export function ObjectPanelContent({
         </ErrorBoundary>
       )}
 
+      {!!showProcesses && (
+        <ErrorBoundary
+          scope="panel-processes"
+          resetKeys={[objectData?.name ?? '', objectData?.namespace ?? ''].filter(Boolean)}
+          fallback={(_, reset) => <TabErrorFallback tabName="Processes" reset={reset} />}
+        >
+          <ProcessesTab
+            namespace={objectData?.namespace || ''}
+            resourceName={objectData?.name || ''}
+            isActive={isPanelOpen && activeTab === 'processes'}
+            availableContainers={availableContainers}
+            clusterId={objectData?.clusterId ?? null}
+          />
+        </ErrorBoundary>
+      )}
+
+      {!!showSockets && (
+        <ErrorBoundary
+          scope="panel-sockets"
+          resetKeys={[objectData?.name ?? '', objectData?.namespace ?? ''].filter(Boolean)}
+          fallback={(_, reset) => <TabErrorFallback tabName="Sockets" reset={reset} />}
+        >
+          <SocketsTab
+            namespace={objectData?.namespace || ''}
+            resourceName={objectData?.name || ''}
+            isActive={isPanelOpen && activeTab === 'sockets'}
+            availableContainers={availableContainers}
+            clusterId={objectData?.clusterId ?? null}
+          />
+        </ErrorBoundary>
+      )}
+
+      {!!showFiles && (
+        <ErrorBoundary
+          scope="panel-files"
+          resetKeys={[objectData?.name ?? '', objectData?.namespace ?? ''].filter(Boolean)}
+          fallback={(_, reset) => <TabErrorFallback tabName="Files" reset={reset} />}
+        >
+          <FilesTab
+            namespace={objectData?.namespace || ''}
+            resourceName={objectData?.name || ''}
+            isActive={isPanelOpen && activeTab === 'files'}
+            availableContainers={availableContainers}
+            clusterId={objectData?.clusterId ?? null}
+          />
+        </ErrorBoundary>
+      )}
+
       {showLogs && objectKind === 'node' && (
         <ErrorBoundary
           scope="panel-node-logs"
@@ -0,0 +1,56 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/PodInspector/PodInspectorToolbar.tsx
+ *
+ * Container picker and error line shared by the exec-backed pod inspectors.
+ */
+
+import type { DropdownOption } from '@shared/components/dropdowns/Dropdown';
+import { Dropdown } from '@shared/components/dropdowns/Dropdown';
+import type React from 'react';
+import { useCallback, useMemo } from 'react';
+import './PodInspector.css';
+
+interface PodInspectorToolbarProps {
+  availableContainers: string[];
+  container: string;
+  onContainerChange: (container: string) => void;
+  error: string | null;
+}
+
+export const PodInspectorToolbar: React.FC<PodInspectorToolbarProps> = ({
+  availableContainers,
+  container,
+  onContainerChange,
+  error,
+}) => {
+  const options = useMemo<DropdownOption[]>(
+    () => availableContainers.map((name) => ({ value: name, label: name })),
+    [availableContainers]
+  );
+
+  const handleChange = useCallback(
+    (value: string | string[]) => {
+      const nextValue = Array.isArray(value) ? value[0] : value;
+      onContainerChange(nextValue ?? '');
+    },
+    [onContainerChange]
+  );
+
+  return (
+    <>
+      {options.length > 1 && (
+        <div className="pod-inspector__toolbar">
+          <span className="pod-inspector__label">Container</span>
+          <Dropdown
+            options={options}
+            value={container}
+            onChange={handleChange}
+            size="compact"
+            ariaLabel="Container"
+          />
+        </div>
+      )}
+      {error && <div className="pod-inspector__message pod-inspector__message--error">{error}</div>}
+    </>
+  );
+};
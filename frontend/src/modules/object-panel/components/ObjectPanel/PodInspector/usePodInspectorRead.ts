@@ -0,0 +1,119 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/PodInspector/usePodInspectorRead.ts
+ *
+ * Shared reads for the exec-backed pod inspectors (Processes, Sockets, Files):
+ * one read per container selection (and read function), repeated while the
+ * tab is active unless polling is turned off.
+ */
+
+import { requestData } from '@/core/data-access';
+import { useCallback, useEffect, useState } from 'react';
+
+export const POD_INSPECTOR_REFRESH_MS = 5000;
+
+interface UsePodInspectorReadArgs<T> {
+  resource: string;
+  clusterId?: string | null;
+  namespace: string;
+  podName: string;
+  isActive: boolean;
+  availableContainers: string[];
+  read: (clusterId: string, namespace: string, podName: string, container: string) => Promise<T>;
+  // Polling interval; 0 reads only on demand.
+  pollIntervalMs?: number;
+}
+
+interface PodInspectorReadResult<T> {
+  data: T | null;
+  error: string | null;
+  loading: boolean;
+  container: string;
+  setContainer: (container: string) => void;
+  refresh: () => void;
+}
+
+export const usePodInspectorRead = <T>({
+  resource,
+  clusterId,
+  namespace,
+  podName,
+  isActive,
+  availableContainers,
+  read,
+  pollIntervalMs = POD_INSPECTOR_REFRESH_MS,
+}: UsePodInspectorReadArgs<T>): PodInspectorReadResult<T> => {
+  const [requestedContainer, setRequestedContainer] = useState('');
+  const [data, setData] = useState<T | null>(null);
+  const [error, setError] = useState<string | null>(null);
+  const [loading, setLoading] = useState(false);
+  const [refreshNonce, setRefreshNonce] = useState(0);
+
+  const container = requestedContainer || availableContainers[0] || '';
+
+  // A different pod or container starts over.
+  useEffect(() => {
+    setData(null);
+    setError(null);
+  }, [clusterId, namespace, podName, container]);
+
+  useEffect(() => {
+    if (!isActive || !clusterId || !namespace || !podName) {
+      return;
+    }
+    let cancelled = false;
+    setLoading(true);
+    void requestData({
+      resource,
+      // Timer re-reads are background work; anything else is the user's doing.
+      reason: pollIntervalMs > 0 && refreshNonce > 0 ? 'background' : 'user',
+      read: () => read(clusterId, namespace, podName, container),
+    })
+      .then((result) => {
+        if (cancelled || result.status !== 'executed' || !result.data) {
+          return;
+        }
+        setData(result.data);
+        setError(null);
+      })
+      .catch((fetchError) => {
+        if (!cancelled) {
+          setError(fetchError instanceof Error ? fetchError.message : String(fetchError));
+        }
+      })
+      .finally(() => {
+        if (!cancelled) {
+          setLoading(false);
+        }
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [
+    clusterId,
+    container,
+    isActive,
+    namespace,
+    podName,
+    pollIntervalMs,
+    read,
+    refreshNonce,
+    resource,
+  ]);
+
+  useEffect(() => {
+    if (!isActive || pollIntervalMs <= 0) {
+      return;
+    }
+    const timerId = window.setInterval(() => {
+      setRefreshNonce((value) => value + 1);
+    }, pollIntervalMs);
+    return () => {
+      window.clearInterval(timerId);
+    };
+  }, [isActive, pollIntervalMs]);
+
+  const setContainer = useCallback((next: string) => setRequestedContainer(next), []);
+  const refresh = useCallback(() => setRefreshNonce((value) => value + 1), []);
+
+  return { data, error, loading: loading && data === null, container, setContainer, refresh };
+};
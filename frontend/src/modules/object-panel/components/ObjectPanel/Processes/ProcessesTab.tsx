@@ -0,0 +1,159 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Processes/ProcessesTab.tsx
+ *
+ * Lists the processes running in a pod container (the `kubectl exec ... ps aux`
+ * view) and re-samples them while the tab is active so CPU reads like top.
+ */
+
+import { readPodProcesses } from '@/core/data-access';
+import { PodInspectorToolbar } from '@modules/object-panel/components/ObjectPanel/PodInspector/PodInspectorToolbar';
+import { usePodInspectorRead } from '@modules/object-panel/components/ObjectPanel/PodInspector/usePodInspectorRead';
+import { ObjectPanelResourceGridTableSurface } from '@modules/resource-grid/ObjectPanelResourceGridTableSurface';
+import type { ResourceGridTableRow } from '@modules/resource-grid/resourceGridTableTypes';
+import { useObjectPanelResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import {
+  applyColumnSizing,
+  type ColumnSizingMap,
+  createTextColumn,
+} from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import type React from 'react';
+import { useCallback, useMemo } from 'react';
+import '../shared.css';
+import { formatProcessCpu, formatProcessMemory, processCpuWindowLabel } from './processesModel';
+
+interface ProcessesTabProps {
+  namespace: string;
+  resourceName: string;
+  isActive: boolean;
+  availableContainers: string[];
+  clusterId?: string | null;
+}
+
+interface ProcessRow extends ResourceGridTableRow {
+  pid: number;
+  ppid: number;
+  state: string;
+  cpuPercent: number;
+  rssBytes: number;
+  command: string;
+}
+
+const COLUMN_SIZING: ColumnSizingMap = {
+  pid: { autoWidth: true },
+  ppid: { autoWidth: true },
+  state: { autoWidth: true },
+  cpu: { autoWidth: true },
+  memory: { autoWidth: true },
+};
+
+const readProcesses = (clusterId: string, namespace: string, podName: string, container: string) =>
+  readPodProcesses(clusterId, { namespace, podName, container: container || undefined });
+
+const ProcessesTab: React.FC<ProcessesTabProps> = ({
+  namespace,
+  resourceName,
+  isActive,
+  availableContainers,
+  clusterId,
+}) => {
+  const {
+    data: processList,
+    error,
+    loading,
+    container,
+    setContainer,
+  } = usePodInspectorRead({
+    resource: 'pod-processes',
+    clusterId,
+    namespace,
+    podName: resourceName,
+    isActive,
+    availableContainers,
+    read: readProcesses,
+  });
+
+  const rows = useMemo<ProcessRow[]>(
+    () =>
+      (processList?.processes ?? []).map((process) => ({
+        ...process,
+        clusterId: clusterId ?? null,
+      })),
+    [clusterId, processList]
+  );
+
+  const columns = useMemo<GridColumnDefinition<ProcessRow>[]>(() => {
+    const cpuTitle = processCpuWindowLabel(processList?.cpuWindowSeconds ?? 0);
+    const base: GridColumnDefinition<ProcessRow>[] = [
+      createTextColumn<ProcessRow>('pid', 'PID', (row) => row.pid, { alignData: 'right' }),
+      createTextColumn<ProcessRow>('ppid', 'PPID', (row) => row.ppid, { alignData: 'right' }),
+      createTextColumn<ProcessRow>('state', 'State', (row) => row.state || '—'),
+      createTextColumn<ProcessRow>('cpu', 'CPU', (row) => formatProcessCpu(row.cpuPercent), {
+        alignData: 'right',
+        sortValue: (row) => row.cpuPercent,
+        getTitle: () => cpuTitle,
+      }),
+      createTextColumn<ProcessRow>('memory', 'Memory', (row) => formatProcessMemory(row.rssBytes), {
+        alignData: 'right',
+        sortValue: (row) => row.rssBytes,
+        getTitle: () => 'Resident set size',
+      }),
+      createTextColumn<ProcessRow>('command', 'Command', (row) => row.command, {
+        getTitle: (row) => row.command,
+      }),
+    ];
+    applyColumnSizing(base, COLUMN_SIZING);
+    return base;
+  }, [processList?.cpuWindowSeconds]);
+
+  const keyExtractor = useCallback((row: ProcessRow) => String(row.pid), []);
+  const getSearchTokens = useCallback(
+    (row: ProcessRow) => [String(row.pid), row.command].filter(Boolean),
+    []
+  );
+
+  const { gridTableProps } = useObjectPanelResourceGridTable<ProcessRow>({
+    tableMode: 'Local Complete',
+    viewId: 'object-panel-processes',
+    clusterIdentity: clusterId ?? '',
+    enabled: Boolean(clusterId),
+    data: rows,
+    columns,
+    keyExtractor,
+    diagnosticsLabel: 'Object Panel Processes',
+    defaultSort: { key: 'cpu', direction: 'desc' },
+    filterAccessors: {
+      getSearchText: getSearchTokens,
+    },
+  });
+
+  return (
+    <div className="object-panel-pods">
+      <PodInspectorToolbar
+        availableContainers={availableContainers}
+        container={container}
+        onContainerChange={setContainer}
+        error={error}
+      />
+      <div className="object-panel-pods__table">
+        <ObjectPanelResourceGridTableSurface<ProcessRow>
+          gridTableProps={{
+            ...gridTableProps,
+            // Local-complete table: "all matching rows" is the local row set.
+            fetchAllRows: () => Promise.resolve(rows),
+            exportFilename: 'object-panel-processes',
+          }}
+          columns={columns}
+          diagnosticsLabel="Object Panel Processes"
+          tableClassName="gridtable-processes"
+          loading={loading}
+          spinnerMessage="Loading processes..."
+          updatingMessage="Updating processes..."
+          hideHeader={!isActive}
+        />
+      </div>
+    </div>
+  );
+};
+
+export default ProcessesTab;
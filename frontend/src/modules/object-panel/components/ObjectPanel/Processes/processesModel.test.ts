@@ -0,0 +1,25 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Processes/processesModel.test.ts
+ */
+
+import { describe, expect, it } from 'vitest';
+import { formatProcessCpu, formatProcessMemory, processCpuWindowLabel } from './processesModel';
+
+describe('processesModel', () => {
+  it('formats resident memory in binary units', () => {
+    expect(formatProcessMemory(0)).toBe('0');
+    expect(formatProcessMemory(512 * 1024)).toBe('512Ki');
+    expect(formatProcessMemory(5 * 1024 * 1024 + 512 * 1024)).toBe('5.5Mi');
+    expect(formatProcessMemory(2 * 1024 * 1024 * 1024)).toBe('2.0Gi');
+  });
+
+  it('formats cpu as a percentage of one core', () => {
+    expect(formatProcessCpu(12.34)).toBe('12.3%');
+    expect(formatProcessCpu(Number.NaN)).toBe('—');
+  });
+
+  it('describes the cpu sampling window', () => {
+    expect(processCpuWindowLabel(0)).toBe('CPU averaged since each process started');
+    expect(processCpuWindowLabel(3.04)).toBe('CPU over the last 3.0s');
+  });
+});
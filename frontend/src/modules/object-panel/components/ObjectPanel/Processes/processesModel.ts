@@ -0,0 +1,36 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Processes/processesModel.ts
+ *
+ * Display helpers for the pod Processes tab.
+ */
+
+const KIB = 1024;
+const MIB = KIB * 1024;
+const GIB = MIB * 1024;
+
+// Resident memory in the Ki/Mi/Gi units the rest of the app uses.
+export const formatProcessMemory = (bytes: number): string => {
+  if (!Number.isFinite(bytes) || bytes <= 0) {
+    return '0';
+  }
+  if (bytes >= GIB) {
+    return `${(bytes / GIB).toFixed(1)}Gi`;
+  }
+  if (bytes >= MIB) {
+    return `${(bytes / MIB).toFixed(1)}Mi`;
+  }
+  return `${Math.round(bytes / KIB)}Ki`;
+};
+
+// CPU as a percentage of one core, the way top reports it.
+export const formatProcessCpu = (percent: number): string =>
+  Number.isFinite(percent) ? `${percent.toFixed(1)}%` : '—';
+
+// Explains what the CPU column measured: the interval since the previous
+// sample, or each process's lifetime average on the first load.
+export const processCpuWindowLabel = (windowSeconds: number): string => {
+  if (!windowSeconds || windowSeconds <= 0) {
+    return 'CPU averaged since each process started';
+  }
+  return `CPU over the last ${windowSeconds.toFixed(1)}s`;
+};
@@ -0,0 +1,139 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Sockets/SocketsTab.tsx
+ *
+ * Shows what a pod is listening on and connected to, as seen from one of its
+ * containers, so "which port is this actually on" needs no ss in the image.
+ */
+
+import { readPodSockets } from '@/core/data-access';
+import { PodInspectorToolbar } from '@modules/object-panel/components/ObjectPanel/PodInspector/PodInspectorToolbar';
+import { usePodInspectorRead } from '@modules/object-panel/components/ObjectPanel/PodInspector/usePodInspectorRead';
+import { ObjectPanelResourceGridTableSurface } from '@modules/resource-grid/ObjectPanelResourceGridTableSurface';
+import { useObjectPanelResourceGridTable } from '@modules/resource-grid/useResourceGridTable';
+import {
+  applyColumnSizing,
+  type ColumnSizingMap,
+  createTextColumn,
+} from '@shared/components/tables/columnFactories';
+import type { GridColumnDefinition } from '@shared/components/tables/GridTable';
+import type React from 'react';
+import { useCallback, useMemo } from 'react';
+import '../shared.css';
+import { buildSocketRows, type SocketRow } from './socketsModel';
+
+interface SocketsTabProps {
+  namespace: string;
+  resourceName: string;
+  isActive: boolean;
+  availableContainers: string[];
+  clusterId?: string | null;
+}
+
+const COLUMN_SIZING: ColumnSizingMap = {
+  protocol: { autoWidth: true },
+  state: { autoWidth: true },
+  local: { autoWidth: true },
+  remote: { autoWidth: true },
+};
+
+const readSockets = (clusterId: string, namespace: string, podName: string, container: string) =>
+  readPodSockets(clusterId, { namespace, podName, container: container || undefined });
+
+const SocketsTab: React.FC<SocketsTabProps> = ({
+  namespace,
+  resourceName,
+  isActive,
+  availableContainers,
+  clusterId,
+}) => {
+  const {
+    data: socketList,
+    error,
+    loading,
+    container,
+    setContainer,
+  } = usePodInspectorRead({
+    resource: 'pod-sockets',
+    clusterId,
+    namespace,
+    podName: resourceName,
+    isActive,
+    availableContainers,
+    read: readSockets,
+  });
+
+  const rows = useMemo<SocketRow[]>(
+    () => buildSocketRows(socketList).map((row) => ({ ...row, clusterId: clusterId ?? null })),
+    [clusterId, socketList]
+  );
+
+  const columns = useMemo<GridColumnDefinition<SocketRow>[]>(() => {
+    const base: GridColumnDefinition<SocketRow>[] = [
+      createTextColumn<SocketRow>('protocol', 'Protocol', (row) => row.protocol),
+      createTextColumn<SocketRow>('state', 'State', (row) => row.state, {
+        // Listeners sort ahead of connections.
+        sortValue: (row) => `${row.listening ? 0 : 1}${row.state}`,
+      }),
+      createTextColumn<SocketRow>('local', 'Local', (row) => row.local, {
+        sortValue: (row) => row.localPort,
+      }),
+      createTextColumn<SocketRow>('remote', 'Remote', (row) => row.remote || '—'),
+      createTextColumn<SocketRow>('owner', 'Process', (row) => row.owner || '—', {
+        getTitle: (row) => (row.owner ? undefined : 'Owner not visible from this container'),
+      }),
+    ];
+    applyColumnSizing(base, COLUMN_SIZING);
+    return base;
+  }, []);
+
+  const keyExtractor = useCallback((row: SocketRow) => row.key, []);
+  const getSearchTokens = useCallback(
+    (row: SocketRow) => [row.protocol, row.state, row.local, row.remote, row.owner].filter(Boolean),
+    []
+  );
+
+  const { gridTableProps } = useObjectPanelResourceGridTable<SocketRow>({
+    tableMode: 'Local Complete',
+    viewId: 'object-panel-sockets',
+    clusterIdentity: clusterId ?? '',
+    enabled: Boolean(clusterId),
+    data: rows,
+    columns,
+    keyExtractor,
+    diagnosticsLabel: 'Object Panel Sockets',
+    defaultSort: { key: 'state', direction: 'asc' },
+    filterAccessors: {
+      getSearchText: getSearchTokens,
+    },
+  });
+
+  return (
+    <div className="object-panel-pods">
+      <PodInspectorToolbar
+        availableContainers={availableContainers}
+        container={container}
+        onContainerChange={setContainer}
+        error={error}
+      />
+      <div className="object-panel-pods__table">
+        <ObjectPanelResourceGridTableSurface<SocketRow>
+          gridTableProps={{
+            ...gridTableProps,
+            // Local-complete table: "all matching rows" is the local row set.
+            fetchAllRows: () => Promise.resolve(rows),
+            exportFilename: 'object-panel-sockets',
+          }}
+          columns={columns}
+          diagnosticsLabel="Object Panel Sockets"
+          tableClassName="gridtable-sockets"
+          loading={loading}
+          spinnerMessage="Loading sockets..."
+          updatingMessage="Updating sockets..."
+          hideHeader={!isActive}
+        />
+      </div>
+    </div>
+  );
+};
+
+export default SocketsTab;
@@ -0,0 +1,49 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Sockets/socketsModel.test.ts
+ */
+
+import type { pods } from '@wailsjs/go/models';
+import { describe, expect, it } from 'vitest';
+import { buildSocketRows, formatSocketEndpoint } from './socketsModel';
+
+describe('socketsModel', () => {
+  it('formats endpoints and brackets IPv6 hosts', () => {
+    expect(formatSocketEndpoint('0.0.0.0', 8080)).toBe('0.0.0.0:8080');
+    expect(formatSocketEndpoint('::1', 9090)).toBe('[::1]:9090');
+    expect(formatSocketEndpoint('10.0.0.1', 0)).toBe('10.0.0.1:*');
+    expect(formatSocketEndpoint(undefined, undefined)).toBe('');
+  });
+
+  it('lists listeners before connections with their owners', () => {
+    const rows = buildSocketRows({
+      container: 'web',
+      listening: [
+        {
+          protocol: 'tcp',
+          state: 'LISTEN',
+          localAddress: '0.0.0.0',
+          localPort: 80,
+          pid: 1,
+          process: 'nginx',
+        },
+      ],
+      connections: [
+        {
+          protocol: 'tcp',
+          state: 'ESTABLISHED',
+          localAddress: '10.0.0.2',
+          localPort: 80,
+          remoteAddress: '10.0.0.9',
+          remotePort: 51000,
+        },
+      ],
+      sampledAt: null,
+    } as unknown as pods.SocketList);
+
+    expect(rows.map((row) => [row.listening, row.local, row.remote, row.owner])).toEqual([
+      [true, '0.0.0.0:80', '', 'nginx (1)'],
+      [false, '10.0.0.2:80', '10.0.0.9:51000', ''],
+    ]);
+    expect(buildSocketRows(null)).toEqual([]);
+  });
+});
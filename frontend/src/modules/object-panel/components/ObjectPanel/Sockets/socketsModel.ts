@@ -0,0 +1,54 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Sockets/socketsModel.ts
+ *
+ * Row shaping for the pod Sockets tab.
+ */
+
+import type { pods } from '@wailsjs/go/models';
+
+export interface SocketRow {
+  key: string;
+  listening: boolean;
+  protocol: string;
+  state: string;
+  local: string;
+  localPort: number;
+  remote: string;
+  owner: string;
+  clusterId?: string | null;
+}
+
+// address:port, with IPv6 addresses bracketed the way URLs and ss print them.
+export const formatSocketEndpoint = (address?: string, port?: number): string => {
+  if (!address) {
+    return '';
+  }
+  const host = address.includes(':') ? `[${address}]` : address;
+  return port ? `${host}:${port}` : `${host}:*`;
+};
+
+const toRow = (socket: pods.Socket, listening: boolean, index: number): SocketRow => {
+  const local = formatSocketEndpoint(socket.localAddress, socket.localPort);
+  const remote = formatSocketEndpoint(socket.remoteAddress, socket.remotePort);
+  return {
+    key: `${socket.protocol}|${local}|${remote}|${socket.state}|${index}`,
+    listening,
+    protocol: socket.protocol,
+    state: socket.state,
+    local,
+    localPort: socket.localPort,
+    remote,
+    owner: socket.pid ? `${socket.process || 'pid'} (${socket.pid})` : '',
+  };
+};
+
+// Listeners first, then connections, each in the backend's port order.
+export const buildSocketRows = (list: pods.SocketList | null): SocketRow[] => {
+  if (!list) {
+    return [];
+  }
+  return [
+    ...(list.listening ?? []).map((socket, index) => toRow(socket, true, index)),
+    ...(list.connections ?? []).map((socket, index) => toRow(socket, false, index)),
+  ];
+};
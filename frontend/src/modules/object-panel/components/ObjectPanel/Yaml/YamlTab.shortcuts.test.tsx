@@ -19,6 +19,13 @@ vi.mock('@ui/shortcuts', () => ({
   useSearchShortcutTarget: () => undefined,
 }));
 
+const keybindingOverrides = vi.hoisted(() => new Map<string, unknown>());
+
+vi.mock('@ui/shortcuts/keybindings', () => ({
+  useKeybinding: (action: string, fallback: unknown) =>
+    keybindingOverrides.has(action) ? keybindingOverrides.get(action) : fallback,
+}));
+
 vi.mock('@uiw/react-codemirror', () => ({
   __esModule: true,
   default: vi.fn(() => <div data-testid="code-editor" />),
@@ -49,6 +56,7 @@ vi.mock('@wailsjs/go/backend/App', () => ({
     resourceVersion: '123',
   }),
   MergeObjectYamlWithLatest: vi.fn(),
+  ThreeWayMergeObjectYaml: vi.fn(),
 }));
 
 describe('YamlTab shortcuts', () => {
@@ -56,6 +64,7 @@ describe('YamlTab shortcuts', () => {
   let root: ReactDOM.Root;
 
   beforeEach(async () => {
+    keybindingOverrides.clear();
     shortcutMocks.useShortcut.mockClear();
     shortcutMocks.useKeyboardSurface.mockClear();
     container = document.createElement('div');
@@ -96,24 +105,45 @@ describe('YamlTab shortcuts', () => {
     expect(managedFieldsShortcut).toBeTruthy();
     expect(managedFieldsShortcut?.enabled).toBe(true);
 
-    const saveMeta = shortcutMocks.useShortcut.mock.calls
-      .map(
-        ([config]) => config as { key: string; modifiers?: { meta?: boolean }; enabled?: boolean }
-      )
-      .find((config) => config.key === 's' && config.modifiers?.meta);
-    expect(saveMeta).toBeTruthy();
-    expect(saveMeta?.enabled).toBe(false); // disabled until editing begins
-
-    const saveCtrl = shortcutMocks.useShortcut.mock.calls
-      .map(
-        ([config]) => config as { key: string; modifiers?: { ctrl?: boolean }; enabled?: boolean }
-      )
-      .find((config) => config.key === 's' && config.modifiers?.ctrl);
-    expect(saveCtrl).toBeTruthy();
-    expect(saveCtrl?.enabled).toBe(false);
+    const save = getShortcut('s');
+    expect(save).toBeTruthy();
+    expect(save?.modifiers?.meta || save?.modifiers?.ctrl).toBe(true);
+    expect(save?.enabled).toBe(false); // disabled until editing begins
 
     const escapeShortcut = getShortcut('Escape');
     expect(escapeShortcut).toBeTruthy();
     expect(escapeShortcut?.enabled).toBe(false);
   });
 });
+
+describe('YamlTab remapped shortcuts', () => {
+  it('uses the keybinding settings for managedFields and save', async () => {
+    keybindingOverrides.set('yaml.toggleManagedFields', { key: 'f' });
+    keybindingOverrides.set('yaml.save', { key: 'w', modifiers: { alt: true } });
+    shortcutMocks.useShortcut.mockClear();
+    const container = document.createElement('div');
+    document.body.appendChild(container);
+    const root = ReactDOM.createRoot(container);
+
+    await act(async () => {
+      root.render(
+        <YamlTab scope="alpha:ctx|team-a:/v1:pod:demo" isActive canEdit clusterId="alpha:ctx" />
+      );
+      await Promise.resolve();
+    });
+
+    const keys = shortcutMocks.useShortcut.mock.calls.map(
+      ([config]) => (config as { key: string }).key
+    );
+    expect(keys).toContain('f');
+    expect(keys).toContain('w');
+    expect(keys).not.toContain('m');
+    expect(keys).not.toContain('s');
+
+    act(() => {
+      root.unmount();
+    });
+    container.remove();
+    keybindingOverrides.clear();
+  });
+});
@@ -116,6 +116,13 @@ const wailsMocks = vi.hoisted(() => ({
   CheckObjectYamlOwnership: vi.fn(),
   GetObjectYAMLByGVK: vi.fn(),
   MergeObjectYamlWithLatest: vi.fn(),
+  ThreeWayMergeObjectYaml: vi.fn(),
+  OpenInExternalEditor: vi.fn(),
+  StopExternalEdit: vi.fn(),
+}));
+
+const runtimeMocks = vi.hoisted(() => ({
+  handlers: new Map<string, (...args: unknown[]) => void>(),
 }));
 
 const errorHandlerMock = vi.hoisted(() => ({
@@ -269,6 +276,18 @@ vi.mock('@wailsjs/go/backend/App', () => ({
   CheckObjectYamlOwnership: wailsMocks.CheckObjectYamlOwnership,
   GetObjectYAMLByGVK: wailsMocks.GetObjectYAMLByGVK,
   MergeObjectYamlWithLatest: wailsMocks.MergeObjectYamlWithLatest,
+  ThreeWayMergeObjectYaml: wailsMocks.ThreeWayMergeObjectYaml,
+  OpenInExternalEditor: wailsMocks.OpenInExternalEditor,
+  StopExternalEdit: wailsMocks.StopExternalEdit,
+}));
+
+vi.mock('@wailsjs/runtime/runtime', () => ({
+  EventsOnMultiple: () => undefined,
+  EventsOff: () => undefined,
+  EventsOn: (name: string, handler: (...args: unknown[]) => void) => {
+    runtimeMocks.handlers.set(name, handler);
+    return () => runtimeMocks.handlers.delete(name);
+  },
 }));
 
 vi.mock('@/core/settings/appPreferences', () => ({
@@ -523,6 +542,9 @@ describe('YamlTab', () => {
     wailsMocks.CheckObjectYamlOwnership.mockResolvedValue({ conflicts: [] });
     wailsMocks.GetObjectYAMLByGVK.mockReset();
     wailsMocks.MergeObjectYamlWithLatest.mockReset();
+    wailsMocks.ThreeWayMergeObjectYaml.mockReset();
+    wailsMocks.OpenInExternalEditor.mockReset();
+    wailsMocks.StopExternalEdit.mockReset().mockResolvedValue(undefined);
     yamlErrorsMocks.parseObjectYamlError.mockReset();
     errorHandlerMock.handle.mockClear();
   });
@@ -626,6 +648,58 @@ describe('YamlTab', () => {
     await unmount();
   });
 
+  it('opens the object in an external editor and shows each save outcome', async () => {
+    wailsMocks.OpenInExternalEditor.mockResolvedValue({
+      id: 'session-1',
+      target: {},
+      path: '/tmp/pod-demo.yaml',
+      editor: 'code --wait',
+    });
+    const { container, unmount } = await renderYamlTab();
+
+    await act(async () => {
+      getIconButton(container, 'Edit in external editor')?.click();
+      await Promise.resolve();
+    });
+    expect(wailsMocks.OpenInExternalEditor).toHaveBeenCalledWith({
+      clusterId: 'alpha:ctx',
+      group: '',
+      version: 'v1',
+      kind: 'Pod',
+      namespace: 'default',
+      name: 'demo',
+    });
+    expect(container.textContent).toContain('Editing in code --wait.');
+
+    const emitStatus = (event: Record<string, unknown>) =>
+      act(async () => {
+        runtimeMocks.handlers.get('external-edit:status')?.(event);
+      });
+    await emitStatus({ sessionId: 'other', state: 'applied', resourceVersion: '1' });
+    expect(container.textContent).not.toContain('Applied');
+
+    await emitStatus({ sessionId: 'session-1', state: 'applied', resourceVersion: '124' });
+    expect(container.textContent).toContain('Applied the saved file (resourceVersion 124).');
+
+    await emitStatus({
+      sessionId: 'session-1',
+      state: 'failed',
+      message: 'Pod "demo" is invalid',
+      causes: ['spec.containers[0].image: Required value'],
+    });
+    expect(container.querySelector('.yaml-external-edit-failed')?.textContent).toContain(
+      'spec.containers[0].image: Required value'
+    );
+
+    await act(async () => {
+      getIconButton(container, 'Stop external edit')?.click();
+    });
+    expect(wailsMocks.StopExternalEdit).toHaveBeenCalledWith('session-1');
+    expect(container.querySelector('.yaml-external-edit-failed')).toBeNull();
+
+    await unmount();
+  });
+
   it('registers the CodeMirror region as an editor surface', async () => {
     const { unmount } = await renderYamlTab();
 
@@ -1854,6 +1928,79 @@ describe('YamlTab', () => {
     await unmount();
   });
 
+  it('merges a conflicting draft with conflict markers after an apply conflict', async () => {
+    const conflictCurrentYaml = LATER_MUTATED_YAML.replace('image: demo:v2', 'image: demo:v3');
+    yamlErrorsMocks.parseObjectYamlError.mockReturnValue({
+      code: 'MergeConflict',
+      message: 'The object was changed by another writer while applying.',
+      currentYaml: conflictCurrentYaml,
+      currentResourceVersion: '790',
+      causes: [],
+    });
+    wailsMocks.ApplyObjectYaml.mockRejectedValue(new Error('conflict'));
+    const markedYaml = [
+      'apiVersion: v1',
+      'kind: Pod',
+      'metadata:',
+      '  name: demo',
+      '  namespace: default',
+      '  resourceVersion: "790"',
+      'spec:',
+      '  containers:',
+      '  - name: demo',
+      '<<<<<<< draft',
+      '    image: demo:v2',
+      '=======',
+      '    image: demo:v3',
+      '>>>>>>> live',
+      '  restartPolicy: Always',
+      '',
+    ].join('\n');
+    wailsMocks.ThreeWayMergeObjectYaml.mockResolvedValue({
+      mergedYAML: markedYaml,
+      currentYAML: conflictCurrentYaml,
+      resourceVersion: '790',
+      conflicts: [{ path: 'spec.containers[name=demo].image' }],
+    });
+
+    const { container, unmount } = await renderYamlTab();
+
+    const editButton = getIconButton(container, 'Edit YAML');
+    await act(async () => {
+      editButton?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+
+    await act(async () => {
+      codeMirrorState.latestProps.current.onChange(UPDATED_YAML);
+    });
+
+    const saveButton = getIconButton(container, 'Save YAML');
+    await act(async () => {
+      saveButton?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await waitForUpdates();
+
+    const markersButton = Array.from(container.querySelectorAll('button')).find((btn) =>
+      btn.textContent?.includes('Merge with conflict markers')
+    );
+    expect(markersButton).toBeTruthy();
+
+    await act(async () => {
+      markersButton?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    await waitForUpdates();
+
+    expect(wailsMocks.ThreeWayMergeObjectYaml).toHaveBeenCalledTimes(1);
+    expect(wailsMocks.ThreeWayMergeObjectYaml.mock.calls[0]?.[1]?.draftYAML).toContain(
+      'image: demo:v2'
+    );
+    expect(codeMirrorState.value).toContain('<<<<<<< draft');
+    expect(container.textContent).toContain('Resolve the conflict markers before saving');
+    expect(container.textContent).toContain('spec.containers[name=demo].image');
+
+    await unmount();
+  });
+
   it('shows generic error when apply fails without parser details', async () => {
     wailsMocks.ApplyObjectYaml.mockRejectedValue(new Error('network down'));
     yamlErrorsMocks.parseObjectYamlError.mockReturnValue(null);
@@ -13,8 +13,10 @@ import { CloseIcon } from '@shared/components/icons/SharedIcons';
 import LoadingSpinner from '@shared/components/LoadingSpinner';
 import ConfirmationModal from '@shared/components/modals/ConfirmationModal';
 import { YamlEditor, type YamlEditorHandle } from '@shared/components/yaml';
+import { parseApiVersion } from '@shared/constants/builtinGroupVersions';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { useShortcut } from '@ui/shortcuts';
+import { useKeybinding } from '@ui/shortcuts/keybindings';
 import { errorHandler } from '@utils/errorHandler';
 import type React from 'react';
 import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
@@ -22,17 +24,21 @@ import * as YAML from 'yaml';
 import { useAutoRefreshLoadingState } from '@/core/refresh/hooks/useAutoRefreshLoadingState';
 import { applyPassiveLoadingPolicy } from '@/core/refresh/loadingPolicy';
 import { useRefreshScopedDomain } from '@/core/refresh/store';
+import { isMacPlatform } from '@/utils/platform';
 import './YamlTab.css';
 import {
   YamlCancelIcon,
   YamlEditIcon,
+  YamlExternalEditIcon,
   YamlManagedFieldsIcon,
   YamlSaveIcon,
 } from '@shared/components/icons/YamlIcons';
+import { useExternalEdit } from './useExternalEdit';
 import { resolveProtectedYamlRanges } from './yamlFieldPolicy';
 import { INACTIVE_SCOPE, LARGE_MANIFEST_THRESHOLD, YAML_STRINGIFY_OPTIONS } from './yamlTabConfig';
 import type { YamlTabProps } from './yamlTabTypes';
 import { prepareDraftYaml } from './yamlTabUtils';
+import { parseObjectIdentity } from './yamlValidation';
 import {
   buildYamlTransactionDiff,
   useYamlTransaction,
@@ -174,6 +180,7 @@ const YamlTab: React.FC<YamlTabProps> = ({
     handleEnterEdit,
     handleCancelClick,
     handleReloadAndMerge,
+    handleMergeWithConflictMarkers,
     handleSaveClick,
     pendingOwnershipConflicts,
     confirmOwnershipAndSave,
@@ -256,8 +263,16 @@ const YamlTab: React.FC<YamlTabProps> = ({
     window.requestAnimationFrame(() => yamlEditorRef.current?.focus());
   }, [isActive, isEditing]);
 
+  // Effective bindings from the user's keybinding settings; null when unbound.
+  const toggleManagedFieldsBinding = useKeybinding('yaml.toggleManagedFields', { key: 'm' });
+  const saveBinding = useKeybinding('yaml.save', {
+    key: 's',
+    modifiers: isMacPlatform() ? { meta: true } : { ctrl: true },
+  });
+
   useShortcut({
-    key: 'm',
+    key: toggleManagedFieldsBinding?.key ?? 'm',
+    modifiers: toggleManagedFieldsBinding?.modifiers,
     handler: useCallback(() => {
       if (!isActive || isEditing) {
         return false;
@@ -267,13 +282,13 @@ const YamlTab: React.FC<YamlTabProps> = ({
     }, [isActive, isEditing]),
     description: 'Toggle managedFields',
     category: 'YAML Tab',
-    enabled: true,
+    enabled: !!toggleManagedFieldsBinding,
     priority: 20,
   });
 
   useShortcut({
-    key: 's',
-    modifiers: { meta: true },
+    key: saveBinding?.key ?? 's',
+    modifiers: saveBinding?.modifiers,
     handler: () => {
       if (!isEditing || isSaving) {
         return false;
@@ -283,23 +298,7 @@ const YamlTab: React.FC<YamlTabProps> = ({
     },
     description: 'Save YAML changes',
     category: 'YAML Tab',
-    enabled: isEditing && !isSaving,
-    priority: 30,
-  });
-
-  useShortcut({
-    key: 's',
-    modifiers: { ctrl: true },
-    handler: () => {
-      if (!isEditing || isSaving) {
-        return false;
-      }
-      handleSaveClick();
-      return true;
-    },
-    description: 'Save YAML changes',
-    category: 'YAML Tab',
-    enabled: isEditing && !isSaving,
+    enabled: !!saveBinding && isEditing && !isSaving,
     priority: 30,
   });
 
@@ -318,6 +317,30 @@ const YamlTab: React.FC<YamlTabProps> = ({
     priority: 30,
   });
 
+  // The external editor opens the object this tab shows, named by its YAML.
+  const externalEditTarget = useMemo(() => {
+    const identity = clusterId ? parseObjectIdentity(yamlContent) : null;
+    if (!clusterId || !identity) {
+      return null;
+    }
+    const { group = '', version = '' } = parseApiVersion(identity.apiVersion);
+    return {
+      clusterId,
+      group,
+      version,
+      kind: identity.kind,
+      namespace: identity.namespace ?? '',
+      name: identity.name,
+    };
+  }, [clusterId, yamlContent]);
+  const {
+    session: externalEditSession,
+    status: externalEditStatus,
+    open: openExternalEdit,
+    stop: stopExternalEdit,
+    dismissStatus: dismissExternalEditStatus,
+  } = useExternalEdit(externalEditTarget);
+
   const hasYamlError = Boolean(lintError) || hasServerYamlError;
   const disableSave = isSaving || hasYamlError;
   const yamlToolbarItems = useMemo<IconBarItem[]>(
@@ -380,6 +403,16 @@ const YamlTab: React.FC<YamlTabProps> = ({
                 title: 'Edit YAML',
                 ariaLabel: 'Edit YAML',
               },
+              {
+                type: 'toggle' as const,
+                id: 'external-edit',
+                icon: <YamlExternalEditIcon width={16} height={16} />,
+                active: Boolean(externalEditSession),
+                onClick: externalEditSession ? stopExternalEdit : () => void openExternalEdit(),
+                title: externalEditSession ? 'Stop external edit' : 'Edit in external editor',
+                ariaLabel: externalEditSession ? 'Stop external edit' : 'Edit in external editor',
+                disabled: !externalEditTarget,
+              },
             ]
           : editDisabledReason
             ? [
@@ -399,6 +432,8 @@ const YamlTab: React.FC<YamlTabProps> = ({
       canEdit,
       disableSave,
       editDisabledReason,
+      externalEditSession,
+      externalEditTarget,
       handleCancelClick,
       handleEnterEditClick,
       handleSaveClick,
@@ -406,7 +441,9 @@ const YamlTab: React.FC<YamlTabProps> = ({
       handleToggleManagedFields,
       isEditing,
       isSaving,
+      openExternalEdit,
       showManagedFields,
+      stopExternalEdit,
       wrapLines,
     ]
   );
@@ -468,7 +505,8 @@ const YamlTab: React.FC<YamlTabProps> = ({
                   <p>
                     Reload &amp; merge could not reconcile your draft with the latest YAML. Your
                     draft is unchanged. Save will still patch your edited fields onto the live
-                    object, like kubectl edit.
+                    object, like kubectl edit, or merge with conflict markers to resolve the
+                    overlapping fields by hand.
                   </p>
                   {driftDiff &&
                     renderYamlDiffToggle(
@@ -503,6 +541,36 @@ const YamlTab: React.FC<YamlTabProps> = ({
             )}
           </div>
         )}
+        {!isEditing && externalEditStatus && (
+          <div
+            className={`yaml-post-apply-notice yaml-external-edit-${externalEditStatus.kind}`}
+            role="status"
+            aria-live="polite"
+          >
+            <div className="yaml-notice-header">
+              <p>{externalEditStatus.message}</p>
+              <div className="yaml-notice-actions">
+                <button
+                  className="yaml-notice-close"
+                  type="button"
+                  aria-label="Close external edit notice"
+                  onClick={dismissExternalEditStatus}
+                >
+                  <CloseIcon width={14} height={14} />
+                </button>
+              </div>
+            </div>
+            {externalEditStatus.causes.length > 0 && (
+              <ul className="yaml-error-details">
+                {withStableListKeys(externalEditStatus.causes, (cause) => cause).map(
+                  ({ key, value: cause }) => (
+                    <li key={key}>{cause}</li>
+                  )
+                )}
+              </ul>
+            )}
+          </div>
+        )}
         {!isEditing && postApplyNotice && (
           <div
             className={`yaml-post-apply-notice yaml-post-apply-notice-${postApplyNotice.kind}`}
@@ -577,6 +645,16 @@ const YamlTab: React.FC<YamlTabProps> = ({
                   Reload &amp; merge
                 </button>
               )}
+              {!!(isEditing && showReloadMergeConflict) && (
+                <button
+                  className="button secondary"
+                  type="button"
+                  onClick={handleMergeWithConflictMarkers}
+                  disabled={isSaving}
+                >
+                  Merge with conflict markers
+                </button>
+              )}
             </>
           }
           onEscape={() => {
@@ -0,0 +1,121 @@
+/**
+ * frontend/src/modules/object-panel/components/ObjectPanel/Yaml/useExternalEdit.ts
+ *
+ * Opens the YAML tab's object in the user's editor and follows the session's
+ * external-edit:status events. The backend applies each save; this hook only
+ * reports the outcome.
+ */
+
+import { errorHandler } from '@utils/errorHandler';
+import type { backend, resourcemodel } from '@wailsjs/go/models';
+import { EventsOn } from '@wailsjs/runtime/runtime';
+import { useCallback, useEffect, useRef, useState } from 'react';
+import { OpenInExternalEditor, StopExternalEdit } from '@/core/backend-api';
+
+// ExternalEditEvent mirrors backend.ExternalEditEvent, which is only emitted
+// as an event and so has no generated model.
+interface ExternalEditEvent {
+  sessionId: string;
+  state: 'applied' | 'failed' | 'closed';
+  resourceVersion?: string;
+  code?: string;
+  message?: string;
+  causes?: string[];
+}
+
+export interface ExternalEditStatus {
+  kind: 'editing' | 'applied' | 'failed';
+  message: string;
+  causes: string[];
+}
+
+const targetKey = (target: resourcemodel.ResourceRef | null) =>
+  target
+    ? [target.clusterId, target.group, target.version, target.kind, target.namespace, target.name]
+        .map((part) => part ?? '')
+        .join('|')
+    : '';
+
+export const useExternalEdit = (target: resourcemodel.ResourceRef | null) => {
+  const [session, setSession] = useState<backend.ExternalEditSession | null>(null);
+  const [status, setStatus] = useState<ExternalEditStatus | null>(null);
+  const sessionIdRef = useRef<string | null>(null);
+
+  useEffect(() => {
+    const cancel = EventsOn('external-edit:status', (event: ExternalEditEvent) => {
+      if (!event || event.sessionId !== sessionIdRef.current) {
+        return;
+      }
+      if (event.state === 'applied') {
+        setStatus({
+          kind: 'applied',
+          message: event.resourceVersion
+            ? `Applied the saved file (resourceVersion ${event.resourceVersion}).`
+            : 'Applied the saved file.',
+          causes: [],
+        });
+      } else if (event.state === 'failed') {
+        setStatus({
+          kind: 'failed',
+          message: event.message || 'The saved file could not be applied.',
+          causes: event.causes ?? [],
+        });
+      } else if (event.state === 'closed') {
+        sessionIdRef.current = null;
+        setSession(null);
+        setStatus(null);
+      }
+    });
+    return () => {
+      if (typeof cancel === 'function') {
+        cancel();
+      }
+    };
+  }, []);
+
+  const stopSession = useCallback(() => {
+    const sessionId = sessionIdRef.current;
+    sessionIdRef.current = null;
+    setSession(null);
+    setStatus(null);
+    if (sessionId) {
+      StopExternalEdit(sessionId).catch((error) =>
+        errorHandler.handle(error, { action: 'stopExternalEdit' })
+      );
+    }
+  }, []);
+
+  // A session belongs to the object on screen: it ends when the tab closes or
+  // shows another object, so saves are never applied out of sight.
+  const key = targetKey(target);
+  useEffect(() => {
+    if (!key) {
+      return undefined;
+    }
+    return stopSession;
+  }, [key, stopSession]);
+
+  const open = useCallback(async () => {
+    if (!target || sessionIdRef.current) {
+      return;
+    }
+    try {
+      const next = await OpenInExternalEditor(target);
+      sessionIdRef.current = next.id;
+      setSession(next);
+      setStatus({
+        kind: 'editing',
+        message:
+          `Editing in ${next.editor}. ` +
+          'Each save is applied to the cluster while this tab is open.',
+        causes: [],
+      });
+    } catch (error) {
+      errorHandler.handle(error, { action: 'openInExternalEditor' });
+    }
+  }, [target]);
+
+  const dismissStatus = useCallback(() => setStatus(null), []);
+
+  return { session, status, open, stop: stopSession, dismissStatus };
+};
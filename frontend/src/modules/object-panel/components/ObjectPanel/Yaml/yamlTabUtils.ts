@@ -10,6 +10,7 @@ import {
   ApplyObjectYaml,
   CheckObjectYamlOwnership,
   MergeObjectYamlWithLatest,
+  ThreeWayMergeObjectYaml,
 } from '@/core/backend-api';
 import type { ObjectYamlMutationResponse } from './yamlErrors';
 import { YAML_STRINGIFY_OPTIONS } from './yamlTabConfig';
@@ -130,3 +131,24 @@ export const mergeYamlWithLatestOnServer = async (
     uid: identity.uid ?? '',
   });
 };
+
+export interface ObjectYamlThreeWayMergeResponse extends ObjectYamlReloadMergeResponse {
+  conflicts: Array<{ path: string; base?: string; live?: string; draft?: string }>;
+}
+
+export const threeWayMergeYamlOnServer = async (
+  clusterId: string,
+  baseYAML: string,
+  draftYAML: string,
+  identity: ObjectIdentity
+): Promise<ObjectYamlThreeWayMergeResponse> => {
+  return ThreeWayMergeObjectYaml(clusterId, {
+    baseYAML,
+    draftYAML,
+    kind: identity.kind,
+    apiVersion: identity.apiVersion,
+    namespace: identity.namespace ?? '',
+    name: identity.name,
+    uid: identity.uid ?? '',
+  });
+};
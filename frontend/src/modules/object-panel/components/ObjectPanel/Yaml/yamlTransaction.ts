@@ -27,6 +27,7 @@ import {
   normalizeYamlString,
   type ObjectYamlOwnershipConflict,
   sanitizeYamlForSemanticCompare,
+  threeWayMergeYamlOnServer,
 } from './yamlTabUtils';
 import {
   type ObjectIdentity,
@@ -102,6 +103,7 @@ export interface UseYamlTransactionResult {
   handleEnterEdit: () => void;
   handleCancelClick: () => void;
   handleReloadAndMerge: () => Promise<void>;
+  handleMergeWithConflictMarkers: () => Promise<void>;
   handleSaveClick: () => Promise<void>;
   pendingOwnershipConflicts: ObjectYamlOwnershipConflict[] | null;
   confirmOwnershipAndSave: () => Promise<void>;
@@ -588,99 +590,128 @@ export const useYamlTransaction = ({
     exitEditMode();
   }, [exitEditMode, isSaving]);
 
-  const handleReloadAndMerge = useCallback(async () => {
-    if (isSaving || !effectiveIdentity) {
-      return;
-    }
-
-    try {
-      const mergeBaseYaml =
-        baselineMergeYaml ||
-        prepareVisibleDraftYaml(normalizeYamlString(manualYamlOverride?.yaml ?? yamlContent));
-      const mergeResult = await mergeYamlWithLatestOnServer(
-        resolvedClusterId,
-        mergeBaseYaml,
-        draftYaml,
-        effectiveIdentity
-      );
-      const normalizedLatestYaml = normalizeYamlString(mergeResult.currentYAML);
-      const preparedLatestYaml = prepareVisibleDraftYaml(normalizedLatestYaml);
-      const mergedDraftYaml = prepareVisibleDraftYaml(normalizeYamlString(mergeResult.mergedYAML));
-      const parsedIdentity = parseObjectIdentity(normalizedLatestYaml);
-      const latestIdentity: ObjectIdentity = parsedIdentity
-        ? {
-            ...parsedIdentity,
-            resourceVersion: parsedIdentity.resourceVersion ?? mergeResult.resourceVersion ?? null,
-          }
-        : {
-            apiVersion: effectiveIdentity.apiVersion,
-            kind: effectiveIdentity.kind,
-            name: effectiveIdentity.name,
-            namespace: effectiveIdentity.namespace ?? null,
-            uid: effectiveIdentity.uid ?? null,
-            resourceVersion: mergeResult.resourceVersion ?? null,
-          };
-
-      skipNextOverrideDraftSyncRef.current = true;
-      setBaselineIdentity(latestIdentity);
-      setBaselineResourceVersion(latestIdentity.resourceVersion ?? null);
-      setBaselineMergeYaml(preparedLatestYaml);
-      setDraftYaml(mergedDraftYaml);
-      setLatestObjectIdentity(latestIdentity);
-      setManualYamlOverride({
-        yaml: normalizedLatestYaml,
-        resourceVersion: latestIdentity.resourceVersion ?? null,
-      });
-      setLintError(null);
-      setActionError(null);
-      setActionDetails([]);
-      setProtectedEditMessage(null);
-      setHasRemoteDrift(false);
-      setDriftForced(false);
-      setBackendDriftCurrentYaml(null);
-      setPostApplyNotice(null);
-      setVerifiedPostApply(null);
-      setPendingSnapshotAdoptionYaml(null);
-      setHasServerYamlError(false);
+  // Reload & merge rejects drafts whose edits overlap live changes; with
+  // conflict markers the overlaps are left in the draft for the user to resolve.
+  const reloadAndMerge = useCallback(
+    async (withConflictMarkers: boolean) => {
+      if (isSaving || !effectiveIdentity) {
+        return;
+      }
 
-      if (scope) {
-        await requestRefreshDomain({
-          domain: 'object-yaml',
-          scope,
-          reason: 'user',
+      try {
+        const mergeBaseYaml =
+          baselineMergeYaml ||
+          prepareVisibleDraftYaml(normalizeYamlString(manualYamlOverride?.yaml ?? yamlContent));
+        const mergeResult = withConflictMarkers
+          ? await threeWayMergeYamlOnServer(
+              resolvedClusterId,
+              mergeBaseYaml,
+              draftYaml,
+              effectiveIdentity
+            )
+          : await mergeYamlWithLatestOnServer(
+              resolvedClusterId,
+              mergeBaseYaml,
+              draftYaml,
+              effectiveIdentity
+            );
+        const conflicts = 'conflicts' in mergeResult ? (mergeResult.conflicts ?? []) : [];
+        const normalizedLatestYaml = normalizeYamlString(mergeResult.currentYAML);
+        const preparedLatestYaml = prepareVisibleDraftYaml(normalizedLatestYaml);
+        const mergedDraftYaml = prepareVisibleDraftYaml(
+          normalizeYamlString(mergeResult.mergedYAML)
+        );
+        const parsedIdentity = parseObjectIdentity(normalizedLatestYaml);
+        const latestIdentity: ObjectIdentity = parsedIdentity
+          ? {
+              ...parsedIdentity,
+              resourceVersion:
+                parsedIdentity.resourceVersion ?? mergeResult.resourceVersion ?? null,
+            }
+          : {
+              apiVersion: effectiveIdentity.apiVersion,
+              kind: effectiveIdentity.kind,
+              name: effectiveIdentity.name,
+              namespace: effectiveIdentity.namespace ?? null,
+              uid: effectiveIdentity.uid ?? null,
+              resourceVersion: mergeResult.resourceVersion ?? null,
+            };
+
+        skipNextOverrideDraftSyncRef.current = true;
+        setBaselineIdentity(latestIdentity);
+        setBaselineResourceVersion(latestIdentity.resourceVersion ?? null);
+        setBaselineMergeYaml(preparedLatestYaml);
+        setDraftYaml(mergedDraftYaml);
+        setLatestObjectIdentity(latestIdentity);
+        setManualYamlOverride({
+          yaml: normalizedLatestYaml,
+          resourceVersion: latestIdentity.resourceVersion ?? null,
         });
-      }
-    } catch (err) {
-      const objectYamlError = parseObjectYamlError(err);
-      if (objectYamlError) {
-        setActionError(objectYamlError.message);
-        setActionDetails(objectYamlError.causes ?? []);
-        setHasRemoteDrift(true);
-        setDriftForced(true);
-        setHasServerYamlError(false);
-        if (objectYamlError.currentYaml) {
-          setBackendDriftCurrentYaml(
-            prepareVisibleDraftYaml(normalizeYamlString(objectYamlError.currentYaml))
+        setLintError(null);
+        if (conflicts.length > 0) {
+          const changed =
+            conflicts.length === 1 ? 'One field was' : `${conflicts.length} fields were`;
+          setActionError(
+            `${changed} changed both in your draft and on the live object. ` +
+              'Resolve the conflict markers before saving.'
           );
+          setActionDetails(conflicts.map((conflict) => conflict.path));
+        } else {
+          setActionError(null);
+          setActionDetails([]);
         }
-      } else {
-        const message = err instanceof Error ? err.message : 'Failed to reload latest YAML.';
-        setActionError(message);
-        setActionDetails([]);
+        setProtectedEditMessage(null);
+        setHasRemoteDrift(false);
+        setDriftForced(false);
+        setBackendDriftCurrentYaml(null);
+        setPostApplyNotice(null);
+        setVerifiedPostApply(null);
+        setPendingSnapshotAdoptionYaml(null);
+        setHasServerYamlError(false);
+
+        if (scope) {
+          await requestRefreshDomain({
+            domain: 'object-yaml',
+            scope,
+            reason: 'user',
+          });
+        }
+      } catch (err) {
+        const objectYamlError = parseObjectYamlError(err);
+        if (objectYamlError) {
+          setActionError(objectYamlError.message);
+          setActionDetails(objectYamlError.causes ?? []);
+          setHasRemoteDrift(true);
+          setDriftForced(true);
+          setHasServerYamlError(false);
+          if (objectYamlError.currentYaml) {
+            setBackendDriftCurrentYaml(
+              prepareVisibleDraftYaml(normalizeYamlString(objectYamlError.currentYaml))
+            );
+          }
+        } else {
+          const message = err instanceof Error ? err.message : 'Failed to reload latest YAML.';
+          setActionError(message);
+          setActionDetails([]);
+        }
+        errorHandler.handle(err, { action: 'reloadAndMerge' });
       }
-      errorHandler.handle(err, { action: 'reloadAndMerge' });
-    }
-  }, [
-    baselineMergeYaml,
-    draftYaml,
-    effectiveIdentity,
-    isSaving,
-    manualYamlOverride,
-    prepareVisibleDraftYaml,
-    resolvedClusterId,
-    scope,
-    yamlContent,
-  ]);
+    },
+    [
+      baselineMergeYaml,
+      draftYaml,
+      effectiveIdentity,
+      isSaving,
+      manualYamlOverride,
+      prepareVisibleDraftYaml,
+      resolvedClusterId,
+      scope,
+      yamlContent,
+    ]
+  );
+
+  const handleReloadAndMerge = useCallback(() => reloadAndMerge(false), [reloadAndMerge]);
+  const handleMergeWithConflictMarkers = useCallback(() => reloadAndMerge(true), [reloadAndMerge]);
 
   const performSave = useCallback(
     async (identity: ObjectIdentity, validation: ValidationSuccess, baselineYaml: string) => {
@@ -776,6 +807,15 @@ export const useYamlTransaction = ({
           setActionError(parsed.message);
           setActionDetails(parsed.causes ?? []);
           setHasServerYamlError(true);
+          // Apply re-bases onto concurrent writes itself; a merge conflict means
+          // they overlap the draft, so show the latest object like a reload does.
+          if (parsed.code === 'MergeConflict' && parsed.currentYaml) {
+            setHasRemoteDrift(true);
+            setDriftForced(true);
+            setBackendDriftCurrentYaml(
+              prepareVisibleDraftYaml(normalizeYamlString(parsed.currentYaml))
+            );
+          }
           errorHandler.handle(err, { action: 'saveObjectYAML' });
           setPendingSnapshotAdoptionYaml(null);
           setIsSaving(false);
@@ -798,6 +838,7 @@ export const useYamlTransaction = ({
       baselineResourceVersion,
       exitEditMode,
       hydrateLatestObject,
+      prepareVisibleDraftYaml,
       resolvedClusterId,
       scope,
       yamlContent,
@@ -906,6 +947,7 @@ export const useYamlTransaction = ({
     handleEnterEdit,
     handleCancelClick,
     handleReloadAndMerge,
+    handleMergeWithConflictMarkers,
     handleSaveClick,
     pendingOwnershipConflicts: pendingOwnershipWarning?.conflicts ?? null,
     confirmOwnershipAndSave,
@@ -118,4 +118,22 @@ export const TABS = {
     requiresCapability: 'hasShell',
     onlyForKinds: ['pod'],
   },
+  PROCESSES: {
+    id: 'processes',
+    label: 'Processes',
+    requiresCapability: 'hasShell',
+    onlyForKinds: ['pod'],
+  },
+  SOCKETS: {
+    id: 'sockets',
+    label: 'Sockets',
+    requiresCapability: 'hasShell',
+    onlyForKinds: ['pod'],
+  },
+  FILES: {
+    id: 'files',
+    label: 'Files',
+    requiresCapability: 'hasShell',
+    onlyForKinds: ['pod'],
+  },
 } as const;
@@ -25,6 +25,8 @@ export type ObjectPanelLifecycleDomain = Extract<
   | 'object-helm-manifest'
   | 'object-helm-values'
   | 'object-map'
+  | 'object-references'
+  | 'object-storage-chain'
   | 'object-yaml'
 >;
 
@@ -124,7 +124,7 @@ describe('useObjectPanelTabs', () => {
     ]);
   });
 
-  it('includes the Shell tab for pods when capability is available', async () => {
+  it('includes the Shell, Processes, Sockets and Files tabs for pods when capability is available', async () => {
     const { availableTabs } = await renderHook({
       objectData: {
         kind: 'Pod',
@@ -143,6 +143,9 @@ describe('useObjectPanelTabs', () => {
       'Events',
       'YAML',
       'Shell',
+      'Processes',
+      'Sockets',
+      'Files',
     ]);
   });
 
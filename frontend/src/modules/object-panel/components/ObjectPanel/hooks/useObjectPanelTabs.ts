@@ -55,6 +55,9 @@ export const useObjectPanelTabs = ({
       TABS.EVENTS,
       TABS.YAML,
       TABS.SHELL,
+      TABS.PROCESSES,
+      TABS.SOCKETS,
+      TABS.FILES,
       TABS.MANIFEST,
       TABS.VALUES,
     ];
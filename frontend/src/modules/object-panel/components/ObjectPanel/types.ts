@@ -128,6 +128,9 @@ export type ViewType =
   | 'details'
   | 'logs'
   | 'shell'
+  | 'processes'
+  | 'sockets'
+  | 'files'
   | 'pods'
   | 'jobs'
   | 'events'
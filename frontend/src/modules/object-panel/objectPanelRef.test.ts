@@ -63,6 +63,33 @@ describe('getObjectPanelScopeEvictions pods window', () => {
   });
 });
 
+describe('getObjectPanelScopeEvictions storage chain', () => {
+  it('evicts the storage chain for a PVC panel only', () => {
+    const evictions = getObjectPanelScopeEvictions({
+      clusterId: 'cluster-a',
+      group: '',
+      kind: 'PersistentVolumeClaim',
+      version: 'v1',
+      name: 'data',
+      namespace: 'team-a',
+    });
+
+    expect(evictions).toContainEqual({
+      domain: 'object-storage-chain',
+      scope: 'cluster-a|team-a:/v1:PersistentVolumeClaim:data',
+    });
+    expect(
+      getObjectPanelScopeEvictions({
+        clusterId: 'cluster-a',
+        kind: 'ConfigMap',
+        version: 'v1',
+        name: 'settings',
+        namespace: 'team-a',
+      }).some((eviction) => eviction.domain === 'object-storage-chain')
+    ).toBe(false);
+  });
+});
+
 describe('getObjectPanelScopes', () => {
   it('normalises kind casing and builds scopes for standard resources', () => {
     const result = getObjectPanelScopes({
@@ -49,6 +49,8 @@ export interface ObjectPanelScopeEviction {
     | 'object-events'
     | 'object-yaml'
     | 'object-map'
+    | 'object-references'
+    | 'object-storage-chain'
     | 'object-helm-manifest'
     | 'object-helm-values'
     | 'container-logs'
@@ -57,6 +59,10 @@ export interface ObjectPanelScopeEviction {
 }
 
 const DEFAULT_CLUSTER_SCOPE = '__cluster__';
+
+/** Kinds the Details tab shows the PV/PVC/Pod storage chain for. */
+export const hasStorageChain = (objectKind: string | null): boolean =>
+  objectKind === 'persistentvolumeclaim' || objectKind === 'persistentvolume';
 const HELM_RELEASE_GVK = { group: 'helm.sh', version: 'v3' };
 
 const MAP_SUPPORTED_KINDS = new Set([
@@ -347,6 +353,10 @@ export const getObjectPanelScopeEvictions = (
   }
   if (scopes.mapScope) {
     evictions.push({ domain: 'object-map', scope: scopes.mapScope });
+    evictions.push({ domain: 'object-references', scope: scopes.mapScope });
+    if (hasStorageChain(scopes.objectKind)) {
+      evictions.push({ domain: 'object-storage-chain', scope: scopes.mapScope });
+    }
   }
   if (scopes.helmScope) {
     evictions.push({ domain: 'object-helm-manifest', scope: scopes.helmScope });
@@ -1172,6 +1172,7 @@ describe('query-backed leaf first load', () => {
       payload: { events: [] },
       expected: [
         'age',
+        'count',
         'kind',
         'message',
         'namespace',
@@ -1252,7 +1253,17 @@ describe('query-backed leaf first load', () => {
       label: 'cluster events',
       element: <ClusterViewEvents />,
       payload: { events: [] },
-      expected: ['age', 'kind', 'message', 'objectName', 'objectType', 'reason', 'source', 'type'],
+      expected: [
+        'age',
+        'count',
+        'kind',
+        'message',
+        'objectName',
+        'objectType',
+        'reason',
+        'source',
+        'type',
+      ],
     },
     {
       label: 'cluster nodes',
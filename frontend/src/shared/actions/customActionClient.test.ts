@@ -0,0 +1,76 @@
+/**
+ * frontend/src/shared/actions/customActionClient.test.ts
+ *
+ * Verifies custom action listing, target matching and invocation.
+ */
+
+import { beforeEach, describe, expect, it, vi } from 'vitest';
+
+const mocks = vi.hoisted(() => ({
+  listActions: vi.fn(),
+  invokeAction: vi.fn(),
+}));
+
+vi.mock('@/core/backend-api', () => ({
+  ListActions: mocks.listActions,
+  InvokeAction: mocks.invokeAction,
+}));
+
+import { customActionApplies, listCustomActions, runCustomAction } from './customActionClient';
+
+const action = (target: { group?: string; version?: string; kind: string }) =>
+  ({
+    id: 'custom:annotate',
+    label: 'Annotate',
+    category: 'custom',
+    mutating: true,
+    target,
+  }) as never;
+
+describe('customActionClient', () => {
+  beforeEach(() => {
+    mocks.listActions.mockReset();
+    mocks.invokeAction.mockReset();
+  });
+
+  it('lists only the custom registry entries', async () => {
+    mocks.listActions.mockResolvedValue([
+      { id: 'restart', label: 'Restart', category: 'object', mutating: true },
+      { id: 'custom:annotate', label: 'Annotate', category: 'custom', mutating: true },
+    ]);
+
+    const actions = await listCustomActions();
+
+    expect(actions.map((entry) => entry.id)).toEqual(['custom:annotate']);
+  });
+
+  it('matches kind case-insensitively and treats an empty group or version as any', () => {
+    const deployment = { kind: 'Deployment', group: 'apps', version: 'v1' };
+
+    expect(customActionApplies(action({ kind: 'deployment' }), deployment)).toBe(true);
+    expect(customActionApplies(action({ kind: 'Deployment', group: 'apps' }), deployment)).toBe(
+      true
+    );
+    expect(customActionApplies(action({ kind: 'Deployment', version: 'v2' }), deployment)).toBe(
+      false
+    );
+    expect(customActionApplies(action({ kind: 'StatefulSet' }), deployment)).toBe(false);
+  });
+
+  it('runs the action through the registry and returns its output', async () => {
+    mocks.invokeAction.mockResolvedValue({ custom: { output: 'annotated' } });
+    const target = {
+      clusterId: 'cluster-a',
+      group: 'apps',
+      version: 'v1',
+      kind: 'Deployment',
+      namespace: 'team-a',
+      name: 'api',
+    };
+
+    const result = await runCustomAction('custom:annotate', target);
+
+    expect(mocks.invokeAction).toHaveBeenCalledWith({ id: 'custom:annotate', target });
+    expect(result).toEqual({ output: 'annotated' });
+  });
+});
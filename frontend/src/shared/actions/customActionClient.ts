@@ -0,0 +1,47 @@
+/**
+ * frontend/src/shared/actions/customActionClient.ts
+ *
+ * Lists the user's custom actions from actions.yaml and runs them through the
+ * backend action registry, so they get the same read-only and permission
+ * checks as the built-in actions.
+ */
+
+import type { backend } from '@wailsjs/go/models';
+import { InvokeAction, ListActions } from '@/core/backend-api';
+import type { ObjectActionIdentitySource, ObjectActionTargetRef } from './objectActionClient';
+
+export const CUSTOM_ACTION_CATEGORY = 'custom';
+
+export type CustomActionDescriptor = backend.ActionDescriptor;
+export type CustomActionResult = backend.CustomActionResult;
+
+// listCustomActions returns the custom entries of the action registry. A file
+// that fails to load simply contributes none.
+export const listCustomActions = async (): Promise<CustomActionDescriptor[]> => {
+  const actions = await ListActions();
+  return (actions ?? []).filter((action) => action.category === CUSTOM_ACTION_CATEGORY);
+};
+
+// customActionApplies mirrors the backend target match: kind compares
+// case-insensitively, and an empty group or version matches any.
+export const customActionApplies = (
+  action: CustomActionDescriptor,
+  object: ObjectActionIdentitySource
+): boolean => {
+  const target = action.target;
+  if (!target?.kind || !object.kind || target.kind.toLowerCase() !== object.kind.toLowerCase()) {
+    return false;
+  }
+  return (
+    (!target.group || target.group === (object.group ?? '')) &&
+    (!target.version || target.version === (object.version ?? ''))
+  );
+};
+
+export const runCustomAction = async (
+  id: string,
+  target: ObjectActionTargetRef
+): Promise<CustomActionResult | undefined> => {
+  const response = await InvokeAction({ id, target } as never);
+  return response?.custom;
+};
@@ -38,6 +38,8 @@ export interface ObjectActionRequest {
     targetContainer?: string;
   };
   revision?: number;
+  // Typed target name; required for destructive actions in protected namespaces.
+  confirmation?: string;
 }
 
 export interface ObjectActionResponse {
@@ -121,17 +123,24 @@ const runObjectAction = async (request: ObjectActionRequest): Promise<ObjectActi
   return (await RunObjectAction(request as never)) as ObjectActionResponse;
 };
 
-export const runObjectDelete = (target: ObjectActionTargetRef): Promise<ObjectActionResponse> =>
-  runObjectAction({ action: OBJECT_ACTIONS.delete, target });
+export const runObjectDelete = (
+  target: ObjectActionTargetRef,
+  confirmation?: string
+): Promise<ObjectActionResponse> =>
+  runObjectAction({ action: OBJECT_ACTIONS.delete, target, confirmation });
 
-export const runObjectRestart = (target: ObjectActionTargetRef): Promise<ObjectActionResponse> =>
-  runObjectAction({ action: OBJECT_ACTIONS.restart, target });
+export const runObjectRestart = (
+  target: ObjectActionTargetRef,
+  confirmation?: string
+): Promise<ObjectActionResponse> =>
+  runObjectAction({ action: OBJECT_ACTIONS.restart, target, confirmation });
 
 export const runObjectScale = (
   target: ObjectActionTargetRef,
-  replicas: number
+  replicas: number,
+  confirmation?: string
 ): Promise<ObjectActionResponse> =>
-  runObjectAction({ action: OBJECT_ACTIONS.scale, target, replicas });
+  runObjectAction({ action: OBJECT_ACTIONS.scale, target, replicas, confirmation });
 
 export const runCronJobTrigger = (target: ObjectActionTargetRef): Promise<ObjectActionResponse> =>
   runObjectAction({ action: OBJECT_ACTIONS.trigger, target });
@@ -168,6 +177,7 @@ export const runCreateDebugContainer = (
 
 export const runObjectRollback = (
   target: ObjectActionTargetRef,
-  revision: number
+  revision: number,
+  confirmation?: string
 ): Promise<ObjectActionResponse> =>
-  runObjectAction({ action: OBJECT_ACTIONS.rollback, target, revision });
+  runObjectAction({ action: OBJECT_ACTIONS.rollback, target, revision, confirmation });
@@ -0,0 +1,98 @@
+/**
+ * frontend/src/shared/components/drain/DrainSimulationCard.test.tsx
+ */
+
+import type { nodes } from '@wailsjs/go/models';
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { DrainSimulationCard } from './DrainSimulationCard';
+
+const buildSimulation = (overrides: Partial<nodes.DrainSimulation>): nodes.DrainSimulation =>
+  ({
+    nodeName: 'node-1',
+    pods: [],
+    evicted: 0,
+    skipped: 0,
+    blocked: 0,
+    ...overrides,
+  }) as nodes.DrainSimulation;
+
+describe('DrainSimulationCard', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+  });
+
+  afterEach(() => {
+    act(() => {
+      root.unmount();
+    });
+    container.remove();
+  });
+
+  const render = (simulation: nodes.DrainSimulation, disableEviction = false) => {
+    act(() => {
+      root.render(
+        <DrainSimulationCard simulation={simulation} disableEviction={disableEviction} />
+      );
+    });
+  };
+
+  it('summarises blocking pods and lists each outcome with its reason', () => {
+    render(
+      buildSimulation({
+        evicted: 1,
+        skipped: 1,
+        blocked: 1,
+        pods: [
+          {
+            namespace: 'default',
+            name: 'web-1',
+            outcome: 'block',
+            reasons: ['PodDisruptionBudget web-pdb allows 0 disruption(s)'],
+          },
+          {
+            namespace: 'default',
+            name: 'api-0',
+            outcome: 'evict',
+            reasons: ['all 1 ready replica(s) of Deployment/api run on this node'],
+          },
+          { namespace: 'kube-system', name: 'agent', outcome: 'skip' },
+        ] as nodes.DrainSimulationPod[],
+      })
+    );
+
+    const summary = container.querySelector('[data-test="drain-simulation-summary"]');
+    expect(summary?.textContent).toBe('1 pod blocks the drain; 1 evicted, 1 skipped');
+    expect(summary?.classList.contains('blocked')).toBe(true);
+
+    const rows = Array.from(container.querySelectorAll('tbody tr'));
+    expect(rows).toHaveLength(3);
+    expect(rows[0].querySelector('.status-text')?.className).toContain('error');
+    expect(rows[0].querySelector('.reason')?.textContent).toContain('web-pdb');
+    expect(rows[1].querySelector('.status-text')?.className).toContain('warning');
+    expect(rows[2].querySelector('.status-text')?.textContent).toBe('Skipped');
+  });
+
+  it('reports a clean drain and uses delete wording when eviction is disabled', () => {
+    render(
+      buildSimulation({
+        evicted: 1,
+        pods: [
+          { namespace: 'default', name: 'web-0', outcome: 'evict' },
+        ] as nodes.DrainSimulationPod[],
+      }),
+      true
+    );
+
+    expect(container.querySelector('[data-test="drain-simulation-summary"]')?.textContent).toBe(
+      'Drain would succeed: 1 deleted, 0 skipped'
+    );
+    expect(container.querySelector('.status-text')?.textContent).toBe('Deleted');
+  });
+});
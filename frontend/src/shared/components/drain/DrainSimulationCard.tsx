@@ -0,0 +1,99 @@
+/**
+ * frontend/src/shared/components/drain/DrainSimulationCard.tsx
+ *
+ * Renders the result of a drain simulation inside DrainNodeModal: a summary
+ * line and a per-pod table of predicted outcomes, blocked pods first, with the
+ * reason each pod would block, be skipped, or lose something when evicted.
+ */
+
+import type { nodes } from '@wailsjs/go/models';
+import './DrainSimulationCard.css';
+
+interface DrainSimulationCardProps {
+  simulation: nodes.DrainSimulation;
+  disableEviction: boolean;
+}
+
+const outcomeLabel = (outcome: string, disableEviction: boolean): string => {
+  switch (outcome) {
+    case 'block':
+      return 'Blocks';
+    case 'skip':
+      return 'Skipped';
+    default:
+      return disableEviction ? 'Deleted' : 'Evicted';
+  }
+};
+
+const outcomeClass = (pod: nodes.DrainSimulationPod): string => {
+  if (pod.outcome === 'block') {
+    return 'error';
+  }
+  if (pod.outcome === 'evict' && (pod.reasons?.length ?? 0) > 0) {
+    return 'warning';
+  }
+  return pod.outcome === 'skip' ? 'inactive' : 'success';
+};
+
+const summaryLine = (simulation: nodes.DrainSimulation, disableEviction: boolean): string => {
+  const verb = disableEviction ? 'deleted' : 'evicted';
+  const counts = `${simulation.evicted} ${verb}, ${simulation.skipped} skipped`;
+  if (simulation.blocked === 0) {
+    return `Drain would succeed: ${counts}`;
+  }
+  const noun = simulation.blocked === 1 ? 'pod blocks' : 'pods block';
+  return `${simulation.blocked} ${noun} the drain; ${counts}`;
+};
+
+export function DrainSimulationCard({ simulation, disableEviction }: DrainSimulationCardProps) {
+  return (
+    <div className="drain-simulation-card" data-test="drain-simulation">
+      <div
+        className={`drain-simulation-summary${simulation.blocked > 0 ? ' blocked' : ''}`}
+        data-test="drain-simulation-summary"
+      >
+        {summaryLine(simulation, disableEviction)}
+      </div>
+      {(simulation.warnings ?? []).map((warning) => (
+        <div key={warning} className="drain-simulation-warning">
+          {warning}
+        </div>
+      ))}
+      {simulation.pods.length > 0 ? (
+        <table className="drain-simulation-pod-table">
+          <colgroup>
+            <col className="col-pod" />
+            <col className="col-outcome" />
+            <col className="col-reason" />
+          </colgroup>
+          <thead>
+            <tr>
+              <th>Pod</th>
+              <th>Outcome</th>
+              <th>Reason</th>
+            </tr>
+          </thead>
+          <tbody>
+            {simulation.pods.map((pod) => (
+              <tr key={`${pod.namespace}/${pod.name}`}>
+                <td className="pod-name" title={pod.owner}>
+                  <span className="namespace">{pod.namespace}</span>
+                  <span className="separator">/</span>
+                  <span className="name">{pod.name}</span>
+                </td>
+                <td>
+                  <span className={`status-text ${outcomeClass(pod)}`}>
+                    {outcomeLabel(pod.outcome, disableEviction)}
+                  </span>
+                </td>
+                <td className="reason">{(pod.reasons ?? []).join('; ')}</td>
+              </tr>
+            ))}
+          </tbody>
+        </table>
+      ) : (
+        <p className="drain-simulation-empty">No pods run on this node.</p>
+      )}
+    </div>
+  );
+}
@@ -827,3 +827,28 @@ export const SearchIcon: React.FC<IconProps> = ({ width = 24, height = 24, class
     <line x1="21.9" y1="21.9" x2="16.3" y2="16.3" />
   </svg>
 );
+
+/** Padlock; drawn open when `open` is set. */
+export const LockIcon: React.FC<IconProps & { open?: boolean }> = ({
+  width = 24,
+  height = 24,
+  className,
+  open = false,
+}) => (
+  <svg
+    viewBox="0 0 24 24"
+    width={width}
+    height={height}
+    className={className}
+    aria-hidden="true"
+    fill="none"
+    stroke="currentColor"
+    strokeWidth={2}
+    strokeLinecap="round"
+    strokeLinejoin="round"
+    focusable="false"
+  >
+    <rect x="4" y="11" width="16" height="10" rx="2" />
+    <path d={open ? 'M8 11V7a4 4 0 0 1 7.7-1.5' : 'M8 11V7a4 4 0 0 1 8 0v4'} />
+  </svg>
+);
@@ -43,6 +43,26 @@ export const YamlEditIcon: React.FC<IconProps> = ({ width = 24, height = 24 }) =
   </svg>
 );
 
+export const YamlExternalEditIcon: React.FC<IconProps> = ({ width = 24, height = 24 }) => (
+  <svg
+    aria-hidden="true"
+    focusable="false"
+    xmlns="http://www.w3.org/2000/svg"
+    width={width}
+    height={height}
+    viewBox="0 0 24 24"
+  >
+    <path
+      fill="none"
+      stroke="currentColor"
+      strokeLinecap="round"
+      strokeLinejoin="round"
+      strokeWidth="2"
+      d="M12 6H6a2 2 0 0 0-2 2v10a2 2 0 0 0 2 2h10a2 2 0 0 0 2-2v-6m-7 1l9-9m-5 0h5v5"
+    />
+  </svg>
+);
+
 export const YamlCancelIcon: React.FC<IconProps> = ({ width = 24, height = 24 }) => (
   <svg
     aria-hidden="true"
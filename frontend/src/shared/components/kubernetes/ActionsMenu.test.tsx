@@ -26,6 +26,7 @@ const runObjectDeleteMock = vi.hoisted(() => vi.fn().mockResolvedValue(undefined
 const runObjectScaleMock = vi.hoisted(() => vi.fn().mockResolvedValue(undefined));
 const runCronJobTriggerMock = vi.hoisted(() => vi.fn().mockResolvedValue(undefined));
 const runCronJobSuspendMock = vi.hoisted(() => vi.fn().mockResolvedValue(undefined));
+const isNamespaceProtectedMock = vi.hoisted(() => vi.fn().mockResolvedValue(false));
 
 vi.mock('@shared/actions/objectActionClient', () => ({
   buildObjectActionTarget: (object: ObjectActionData, action: string) => ({
@@ -44,6 +45,14 @@ vi.mock('@shared/actions/objectActionClient', () => ({
   runCronJobSuspend: (...args: unknown[]) => runCronJobSuspendMock(...args),
 }));
 
+vi.mock('@/core/settings/protectedNamespaces', async (importOriginal) => {
+  const original = await importOriginal<typeof import('@/core/settings/protectedNamespaces')>();
+  return {
+    ...original,
+    isNamespaceProtected: (namespace: string) => isNamespaceProtectedMock(namespace),
+  };
+});
+
 // Spy-backed permission mock so tests can assert that getPermissionKey is
 // called with the full GVK (regression: PR #139 made the backend reject
 // queries without apiVersion, and CRD lookups in the shared action path
@@ -183,6 +192,7 @@ describe('ActionsMenu', () => {
     runObjectScaleMock.mockClear();
     runCronJobTriggerMock.mockClear();
     runCronJobSuspendMock.mockClear();
+    isNamespaceProtectedMock.mockReset().mockResolvedValue(false);
   });
 
   afterEach(() => {
@@ -267,7 +277,8 @@ describe('ActionsMenu', () => {
     await confirmModal('Restart');
 
     expect(runObjectRestartMock).toHaveBeenCalledWith(
-      expect.objectContaining({ kind: 'Deployment', name: 'test-resource', action: 'restart' })
+      expect.objectContaining({ kind: 'Deployment', name: 'test-resource', action: 'restart' }),
+      undefined
     );
     expect(onAfterAction).toHaveBeenCalled();
   });
@@ -286,11 +297,74 @@ describe('ActionsMenu', () => {
     await confirmModal('Delete');
 
     expect(runObjectDeleteMock).toHaveBeenCalledWith(
-      expect.objectContaining({ kind: 'Deployment', name: 'test-resource', action: 'delete' })
+      expect.objectContaining({ kind: 'Deployment', name: 'test-resource', action: 'delete' }),
+      undefined
     );
     expect(onAfterDelete).toHaveBeenCalled();
   });
 
+  it('asks for the typed name in a protected namespace and passes it to the delete', async () => {
+    isNamespaceProtectedMock.mockResolvedValue(true);
+    await renderMenu({ object: makeDeployment({ namespace: 'prod' }) });
+
+    openMenu(container);
+    const deleteItem = document.body.querySelector<HTMLElement>('.context-menu-item.danger');
+    await act(async () => {
+      deleteItem?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+    });
+    // Let the protection check settle.
+    await act(async () => {
+      await Promise.resolve();
+    });
+    expect(isNamespaceProtectedMock).toHaveBeenCalledWith('prod');
+
+    const input = document.querySelector<HTMLInputElement>('.confirmation-modal-typed-input');
+    const confirmButton = Array.from(
+      document.querySelectorAll<HTMLButtonElement>('.confirmation-modal button')
+    ).find((button) => button.textContent === 'Delete');
+    expect(input).toBeTruthy();
+    expect(confirmButton?.disabled).toBe(true);
+
+    await act(async () => {
+      const setter = Object.getOwnPropertyDescriptor(HTMLInputElement.prototype, 'value')?.set;
+      setter?.call(input, 'test-resource');
+      input?.dispatchEvent(new Event('input', { bubbles: true }));
+      await Promise.resolve();
+    });
+    await confirmModal('Delete');
+
+    expect(runObjectDeleteMock).toHaveBeenCalledWith(
+      expect.objectContaining({ kind: 'Deployment', name: 'test-resource', action: 'delete' }),
+      'test-resource'
+    );
+  });
+
+  it('routes a scale refused for a missing confirmation through the typed confirmation', async () => {
+    isNamespaceProtectedMock.mockResolvedValue(true);
+    runObjectScaleMock.mockRejectedValueOnce(
+      'typed confirmation required: namespace prod is protected; type "test-resource" to confirm scale'
+    );
+    await renderMenu({
+      object: makeDeployment({ namespace: 'prod', ready: '0/0', hpaManaged: true }),
+    });
+
+    openMenu(container);
+    const resumeItem = Array.from(
+      document.body.querySelectorAll<HTMLElement>('.context-menu-item')
+    ).find((item) => item.textContent?.includes('Resume from 0'));
+    await act(async () => {
+      resumeItem?.dispatchEvent(new MouseEvent('click', { bubbles: true }));
+      await Promise.resolve();
+    });
+    await act(async () => {
+      await Promise.resolve();
+    });
+
+    const confirmation = document.querySelector<HTMLElement>('.confirmation-modal');
+    expect(confirmation?.textContent).toContain('Scale to 1');
+    expect(document.querySelector('.confirmation-modal-typed-input')).toBeTruthy();
+  });
+
   it('opens the scale modal, updates replicas, and applies the change', async () => {
     const onAfterAction = vi.fn();
 
@@ -376,7 +450,8 @@ describe('ActionsMenu', () => {
 
     expect(runObjectScaleMock).toHaveBeenCalledWith(
       expect.objectContaining({ kind: 'Deployment', action: 'scale' }),
-      0
+      0,
+      undefined
     );
   });
 
@@ -416,7 +491,8 @@ describe('ActionsMenu', () => {
 
     expect(runObjectScaleMock).toHaveBeenCalledWith(
       expect.objectContaining({ kind: 'Deployment', action: 'scale' }),
-      0
+      0,
+      undefined
     );
   });
 
@@ -216,6 +216,52 @@ describe('ConfirmationModal', () => {
     expect(document.querySelector('.confirmation-modal-secondary-action')).toBeNull();
   });
 
+  it('requires the typed confirmation and passes it to onConfirm', async () => {
+    const onConfirm = vi.fn();
+    await renderModal({ onConfirm, typedConfirmation: 'api' });
+
+    const input = document.querySelector<HTMLInputElement>('.confirmation-modal-typed-input');
+    const confirmButton = document.querySelector<HTMLButtonElement>(
+      '.confirmation-modal-footer .button.danger'
+    );
+    expect(document.activeElement).toBe(input);
+    expect(document.querySelector('.confirmation-modal-typed')?.textContent).toContain('api');
+    expect(confirmButton?.disabled).toBe(true);
+
+    const type = async (value: string) => {
+      await act(async () => {
+        const setter = Object.getOwnPropertyDescriptor(HTMLInputElement.prototype, 'value')?.set;
+        setter?.call(input, value);
+        input?.dispatchEvent(new Event('input', { bubbles: true }));
+        await Promise.resolve();
+      });
+    };
+
+    await type('ap');
+    expect(confirmButton?.disabled).toBe(true);
+    await type('api ');
+    expect(confirmButton?.disabled).toBe(false);
+
+    act(() => {
+      confirmButton?.click();
+    });
+    expect(onConfirm).toHaveBeenCalledWith('api');
+  });
+
+  it('confirms without a typed value when none is required', async () => {
+    const onConfirm = vi.fn();
+    await renderModal({ onConfirm });
+
+    expect(document.querySelector('.confirmation-modal-typed-input')).toBeNull();
+    const confirmButton = document.querySelector<HTMLButtonElement>(
+      '.confirmation-modal-footer .button.danger'
+    );
+    act(() => {
+      confirmButton?.click();
+    });
+    expect(onConfirm).toHaveBeenCalledWith(undefined);
+  });
+
   it('returns null when modal is closed', async () => {
     await act(async () => {
       root.render(
@@ -8,7 +8,7 @@
 import { WarningTriangleIcon } from '@shared/components/icons/SharedIcons';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import type React from 'react';
-import { useRef } from 'react';
+import { useId, useRef, useState } from 'react';
 import ModalHeader from './ModalHeader';
 import ModalSurface from './ModalSurface';
 import { useModalFocusTrap } from './useModalFocusTrap';
@@ -40,7 +40,13 @@ interface ConfirmationModalProps {
   secondaryActionText?: string;
   secondaryActionButtonClass?: string;
   onSecondaryAction?: () => void;
-  onConfirm: () => void;
+  /**
+   * Text the user must type before confirming, for destructive actions in a
+   * protected namespace. The typed value is passed to onConfirm, which sends
+   * it to the backend as the action's confirmation.
+   */
+  typedConfirmation?: string;
+  onConfirm: (confirmation?: string) => void;
   onCancel: () => void;
 }
 
@@ -55,10 +61,19 @@ const ConfirmationModalContent: React.FC<Omit<ConfirmationModalProps, 'isOpen'>>
   secondaryActionText,
   secondaryActionButtonClass,
   onSecondaryAction,
+  typedConfirmation,
   onConfirm,
   onCancel,
 }) => {
   const modalRef = useRef<HTMLDivElement>(null);
+  const typedInputId = useId();
+  const [typed, setTyped] = useState('');
+  const canConfirm = typedConfirmation === undefined || typed.trim() === typedConfirmation;
+  const confirm = () => {
+    if (canConfirm) {
+      onConfirm(typedConfirmation === undefined ? undefined : typed.trim());
+    }
+  };
 
   useModalFocusTrap({
     ref: modalRef,
@@ -116,6 +131,28 @@ const ConfirmationModalContent: React.FC<Omit<ConfirmationModalProps, 'isOpen'>>
           </div>
         )}
         {!!warning && <p className="confirmation-modal-warning">{warning}</p>}
+        {typedConfirmation !== undefined && (
+          <div className="confirmation-modal-typed">
+            <label htmlFor={typedInputId}>
+              This namespace is protected. Type <strong>{typedConfirmation}</strong> to confirm.
+            </label>
+            <input
+              id={typedInputId}
+              type="text"
+              className="confirmation-modal-typed-input"
+              value={typed}
+              autoComplete="off"
+              spellCheck={false}
+              onChange={(event) => setTyped(event.target.value)}
+              onKeyDown={(event) => {
+                if (event.key === 'Enter') {
+                  confirm();
+                }
+              }}
+              data-modal-initial-focus
+            />
+          </div>
+        )}
       </div>
       <div className="confirmation-modal-footer">
         {!!(secondaryActionText && onSecondaryAction) && (
@@ -130,7 +167,12 @@ const ConfirmationModalContent: React.FC<Omit<ConfirmationModalProps, 'isOpen'>>
         <button type="button" className="button cancel" onClick={onCancel} data-modal-initial-focus>
           {cancelText}
         </button>
-        <button type="button" className={`button ${confirmButtonClass}`} onClick={onConfirm}>
+        <button
+          type="button"
+          className={`button ${confirmButtonClass}`}
+          onClick={confirm}
+          disabled={!canConfirm}
+        >
           {confirmText}
         </button>
       </div>
@@ -150,6 +192,7 @@ function ConfirmationModal({
   secondaryActionText,
   secondaryActionButtonClass = 'secondary',
   onSecondaryAction,
+  typedConfirmation,
   onConfirm,
   onCancel,
 }: ConfirmationModalProps) {
@@ -166,6 +209,7 @@ function ConfirmationModal({
       secondaryActionText={secondaryActionText}
       secondaryActionButtonClass={secondaryActionButtonClass}
       onSecondaryAction={onSecondaryAction}
+      typedConfirmation={typedConfirmation}
       confirmText={confirmText}
       cancelText={cancelText}
       confirmButtonClass={confirmButtonClass}
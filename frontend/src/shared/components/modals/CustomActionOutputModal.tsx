@@ -0,0 +1,76 @@
+/**
+ * frontend/src/shared/components/modals/CustomActionOutputModal.tsx
+ *
+ * Shows what a custom action from actions.yaml printed, with the equivalent
+ * kubectl command for patch actions.
+ */
+
+import { InfoIcon } from '@shared/components/icons/SharedIcons';
+import { useRef } from 'react';
+import ModalHeader from './ModalHeader';
+import ModalSurface from './ModalSurface';
+import { useModalFocusTrap } from './useModalFocusTrap';
+import './CustomActionOutputModal.css';
+
+export interface CustomActionOutput {
+  label: string;
+  output: string;
+  truncated?: boolean;
+  kubectlCommand?: string;
+}
+
+interface CustomActionOutputModalProps {
+  result: CustomActionOutput | null;
+  onClose: () => void;
+}
+
+const CustomActionOutputModal = ({ result, onClose }: CustomActionOutputModalProps) => {
+  const modalRef = useRef<HTMLDivElement>(null);
+
+  useModalFocusTrap({
+    ref: modalRef,
+    disabled: !result,
+    onEscape: () => {
+      onClose();
+      return true;
+    },
+  });
+
+  if (!result) {
+    return null;
+  }
+
+  return (
+    <ModalSurface
+      modalRef={modalRef}
+      labelledBy="custom-action-output-title"
+      onClose={onClose}
+      containerClassName="custom-action-output-modal"
+    >
+      <ModalHeader
+        title={result.label}
+        titleId="custom-action-output-title"
+        icon={InfoIcon}
+        onClose={onClose}
+      />
+      <div className="custom-action-output-body">
+        {result.kubectlCommand ? (
+          <pre className="custom-action-output-command">{result.kubectlCommand}</pre>
+        ) : null}
+        <pre className="custom-action-output-text" data-testid="custom-action-output">
+          {result.output || 'The action finished without output.'}
+        </pre>
+        {result.truncated ? (
+          <p className="custom-action-output-note">Output was truncated.</p>
+        ) : null}
+      </div>
+      <div className="custom-action-output-footer">
+        <button type="button" className="button generic" onClick={onClose} data-modal-initial-focus>
+          Close
+        </button>
+      </div>
+    </ModalSurface>
+  );
+};
+
+export default CustomActionOutputModal;
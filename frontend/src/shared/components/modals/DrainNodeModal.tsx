@@ -9,16 +9,22 @@
 
 import { buildObjectActionTarget, runStartDrain } from '@shared/actions/objectActionClient';
 import { DrainProgressCard } from '@shared/components/drain/DrainProgressCard';
+import { DrainSimulationCard } from '@shared/components/drain/DrainSimulationCard';
 import { DrainIcon } from '@shared/components/icons/SharedIcons';
 import Tooltip from '@shared/components/Tooltip';
 import {
   type NodeDrainOperationPermissions,
   resolveDrainStartPermissionStatus,
 } from '@shared/hooks/nodeActionPermissions';
-import type { types } from '@wailsjs/go/models';
+import type { nodes, types } from '@wailsjs/go/models';
 import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
 import { CancelDrainNodeJob } from '@/core/backend-api';
-import { requestRefreshDomain, setRefreshDomainEnabled } from '@/core/data-access';
+import {
+  readNodeDrainSimulation,
+  requestData,
+  requestRefreshDomain,
+  setRefreshDomainEnabled,
+} from '@/core/data-access';
 import { useRefreshScopedDomain } from '@/core/refresh';
 import { buildClusterScope } from '@/core/refresh/clusterScope';
 import { useAutoRefreshLoadingState } from '@/core/refresh/hooks/useAutoRefreshLoadingState';
@@ -79,6 +85,27 @@ const normalizeTimeoutSeconds = (value: number): number => {
   return Math.max(1, Math.floor(value));
 };
 
+const buildDrainPayload = (drainOptions: DrainOptionsState): types.DrainNodeOptions => {
+  const payload: types.DrainNodeOptions = {
+    ignoreDaemonSets: drainOptions.ignoreDaemonSets,
+    deleteEmptyDirData: drainOptions.deleteEmptyDirData,
+    force: drainOptions.force,
+    disableEviction: drainOptions.disableEviction,
+    skipWaitForPodsToTerminate: drainOptions.skipWaitForPodsToTerminate,
+  };
+  if (drainOptions.gracePeriodSeconds !== null && drainOptions.gracePeriodSeconds !== undefined) {
+    payload.gracePeriodSeconds = normalizeGraceSeconds(drainOptions.gracePeriodSeconds);
+  }
+  if (
+    drainOptions.timeoutSeconds !== null &&
+    drainOptions.timeoutSeconds !== undefined &&
+    drainOptions.timeoutSeconds > 0
+  ) {
+    payload.timeoutSeconds = normalizeTimeoutSeconds(drainOptions.timeoutSeconds);
+  }
+  return payload;
+};
+
 const toScope = (nodeName: string): string =>
   `${NODE_SCOPE_PREFIX}${nodeName.trim().toLowerCase()}`;
 
@@ -111,6 +138,9 @@ const DrainNodeModal = ({
   const [drainPending, setDrainPending] = useState(false);
   const [drainError, setDrainError] = useState<string | null>(null);
   const [cancelDrainPending, setCancelDrainPending] = useState(false);
+  // The simulation describes one set of options; changing any option clears it.
+  const [simulation, setSimulation] = useState<nodes.DrainSimulation | null>(null);
+  const [simulationPending, setSimulationPending] = useState(false);
 
   const scope = useMemo(() => {
     const trimmedNode = nodeName.trim();
@@ -204,6 +234,7 @@ const DrainNodeModal = ({
     <K extends keyof DrainOptionsState>(field: K, value: DrainOptionsState[K]) => {
       setDrainOptions((previous) => ({ ...previous, [field]: value }));
       setDrainError(null);
+      setSimulation(null);
     },
     []
   );
@@ -269,30 +300,11 @@ const DrainNodeModal = ({
     setDrainError(null);
     setDrainPending(true);
     try {
-      const payload: types.DrainNodeOptions = {
-        ignoreDaemonSets: drainOptions.ignoreDaemonSets,
-        deleteEmptyDirData: drainOptions.deleteEmptyDirData,
-        force: drainOptions.force,
-        disableEviction: drainOptions.disableEviction,
-        skipWaitForPodsToTerminate: drainOptions.skipWaitForPodsToTerminate,
-      };
-      if (
-        drainOptions.gracePeriodSeconds !== null &&
-        drainOptions.gracePeriodSeconds !== undefined
-      ) {
-        payload.gracePeriodSeconds = normalizeGraceSeconds(drainOptions.gracePeriodSeconds);
-      }
-      if (
-        drainOptions.timeoutSeconds !== null &&
-        drainOptions.timeoutSeconds !== undefined &&
-        drainOptions.timeoutSeconds > 0
-      ) {
-        payload.timeoutSeconds = normalizeTimeoutSeconds(drainOptions.timeoutSeconds);
-      }
       await runStartDrain(
         buildObjectActionTarget({ clusterId, kind: 'Node', name: nodeName }, 'drain'),
-        payload
+        buildDrainPayload(drainOptions)
       );
+      setSimulation(null);
       await refreshMaintenance();
     } catch (error) {
       const message =
@@ -311,6 +323,33 @@ const DrainNodeModal = ({
     }
   }, [clusterId, drainOptions, nodeName, refreshMaintenance, startDisabled]);
 
+  const simulateDrain = useCallback(async () => {
+    if (!nodeName || !clusterId || simulationPending) {
+      return;
+    }
+    setDrainError(null);
+    setSimulationPending(true);
+    try {
+      const result = await requestData({
+        resource: 'node-drain-simulation',
+        reason: 'user',
+        read: () => readNodeDrainSimulation(clusterId, nodeName, buildDrainPayload(drainOptions)),
+      });
+      if (result.status === 'executed' && result.data) {
+        setSimulation(result.data);
+      }
+    } catch (error) {
+      const message = error instanceof Error ? error.message : String(error);
+      setDrainError(message);
+      errorHandler.handle(error instanceof Error ? error : new Error(message), {
+        source: 'drain-modal',
+        context: { action: 'simulate-drain', nodeName },
+      });
+    } finally {
+      setSimulationPending(false);
+    }
+  }, [clusterId, drainOptions, nodeName, simulationPending]);
+
   const cancelActiveDrain = useCallback(async () => {
     if (!clusterId || !activeDrainJob || cancelDrainPending) {
       return;
@@ -530,6 +569,13 @@ const DrainNodeModal = ({
           </details>
         )}
 
+        {!activeDrainJob && simulation && (
+          <DrainSimulationCard
+            simulation={simulation}
+            disableEviction={Boolean(drainOptions.disableEviction)}
+          />
+        )}
+
         {drainsLoadingState.loading && !primaryDrainJob && drains.length === 0 && (
           <div className="drain-node-modal-helper">Loading drain status…</div>
         )}
@@ -559,6 +605,17 @@ const DrainNodeModal = ({
         <button type="button" className="button cancel" onClick={onClose}>
           {activeDrainJob ? 'Close' : (closeLabel ?? '')}
         </button>
+        {!activeDrainJob && (
+          <button
+            type="button"
+            className="button generic"
+            onClick={() => void simulateDrain()}
+            disabled={simulationPending || drainPending}
+            data-test="drain-modal-simulate"
+          >
+            {simulationPending ? 'Simulating…' : 'Simulate'}
+          </button>
+        )}
         {!activeDrainJob && (
           <button
             type="button"
@@ -18,6 +18,7 @@ import {
 } from '@shared/components/diff/diffUtils';
 import { computeBudgetedLineDiff } from '@shared/components/diff/lineDiff';
 import { RollbackIcon } from '@shared/components/icons/SharedIcons';
+import { useProtectedNamespace } from '@shared/hooks/useProtectedNamespace';
 import type { backend } from '@wailsjs/go/models';
 import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
 import { readRevisionHistoryForRef, requestData } from '@/core/data-access';
@@ -220,30 +221,39 @@ const RollbackModal = ({
     return ROLLBACK_DIFF_TOO_LARGE_MESSAGE;
   }, [diffOnly, diffResult, renderTooLarge]);
 
-  // Handle rollback confirmation.
-  const handleRollback = useCallback(() => {
-    if (selectedRevision === null) {
-      return;
-    }
-    setRollbackLoading(true);
-    setRollbackError(null);
+  // Protected namespaces need the workload name typed before rolling back.
+  const namespaceProtected = useProtectedNamespace(
+    isOpen ? { kind, group, namespace, name } : null
+  );
 
-    runObjectRollback(
-      buildObjectActionTarget({ clusterId, namespace, group, version, kind, name }, 'rollback'),
-      selectedRevision
-    )
-      .then(() => {
-        setConfirmOpen(false);
-        onClose();
-      })
-      .catch((err) => {
-        setRollbackError(String(err));
-        setConfirmOpen(false);
-      })
-      .finally(() => {
-        setRollbackLoading(false);
-      });
-  }, [clusterId, namespace, group, version, name, kind, selectedRevision, onClose]);
+  // Handle rollback confirmation.
+  const handleRollback = useCallback(
+    (typedConfirmation?: string) => {
+      if (selectedRevision === null) {
+        return;
+      }
+      setRollbackLoading(true);
+      setRollbackError(null);
+
+      runObjectRollback(
+        buildObjectActionTarget({ clusterId, namespace, group, version, kind, name }, 'rollback'),
+        selectedRevision,
+        typedConfirmation
+      )
+        .then(() => {
+          setConfirmOpen(false);
+          onClose();
+        })
+        .catch((err) => {
+          setRollbackError(String(err));
+          setConfirmOpen(false);
+        })
+        .finally(() => {
+          setRollbackLoading(false);
+        });
+    },
+    [clusterId, namespace, group, version, name, kind, selectedRevision, onClose]
+  );
 
   // Return null when the modal is not open.
   if (!isOpen) {
@@ -397,6 +407,7 @@ const RollbackModal = ({
         confirmText="Rollback"
         cancelText="Cancel"
         confirmButtonClass="warning"
+        typedConfirmation={namespaceProtected ? name : undefined}
         onConfirm={handleRollback}
         onCancel={() => setConfirmOpen(false)}
       />
@@ -14,6 +14,7 @@ import type {
 } from '@shared/components/tables/GridTable';
 import type React from 'react';
 import { getUseShortResourceNames } from '@/core/settings/appPreferences';
+import { certificateExpiryBadge } from '@/shared/utils/certificateExpiry';
 
 /**
  * Column factory functions for GridTable
@@ -54,6 +55,33 @@ export const createAgeColumn = <T extends AgeColumnRow>(
       : getValue(item),
 });
 
+type CertificateExpiryColumnRow = { certificateNotAfter?: number };
+
+/**
+ * Creates a column badging rows whose certificate has expired or expires soon. Sorting puts
+ * the earliest expiry first; rows without a certificate sort last.
+ */
+export const createCertificateExpiryColumn = <T extends CertificateExpiryColumnRow>(
+  key: string = 'certificate',
+  header: string = 'Certificate'
+): GridColumnDefinition<T> => ({
+  key,
+  header,
+  render: (item) => {
+    const badge = certificateExpiryBadge(item.certificateNotAfter);
+    if (!badge) {
+      return '-';
+    }
+    return (
+      <span className={badge.className} title={badge.title}>
+        {badge.label}
+      </span>
+    );
+  },
+  sortable: true,
+  sortValue: (item) => item.certificateNotAfter || Number.POSITIVE_INFINITY,
+});
+
 export interface CreateResourceBarColumnOptions<T> extends GridColumnAlignmentOptions {
   key?: string;
   header: string;
@@ -19,6 +19,9 @@ const VIEW_IDS = new Set<string>([
   'cluster-crds',
   'cluster-events',
   'cluster-custom',
+  'cluster-git-drift',
+  'cluster-flux',
+  'cluster-velero',
   'namespace-workloads',
   'namespace-pods',
   'namespace-events',
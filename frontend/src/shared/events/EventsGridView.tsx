@@ -19,6 +19,7 @@ import {
   eventGridActionReference,
   eventGridCanOpenRelatedObject,
   eventGridObjectReference,
+  eventGridRecurrenceTitle,
   eventGridSearchText,
   eventGridStableKey,
   resolveEventGridRelatedObject,
@@ -49,6 +50,11 @@ export interface EventGridRow {
   involvedObject?: ResourceLink;
   age?: string;
   ageTimestamp?: number;
+  /** Recurring-event aggregate: events sharing object, type and reason fold into one row. */
+  count?: number;
+  firstSeen?: number;
+  lastSeen?: number;
+  ratePerMinute?: number;
 }
 
 /**
@@ -174,6 +180,17 @@ export function useEventsGridParts({ defaultNamespace }: { defaultNamespace?: st
         ),
         cf.createTextColumn('reason', EVENT_LABELS.reason, (event) => event.reason || '-'),
         cf.createTextColumn('message', EVENT_LABELS.message, (event) => event.message || '-'),
+        cf.createTextColumn<EventGridRow>(
+          'count',
+          EVENT_LABELS.count,
+          (event) => String(event.count ?? 1),
+          {
+            alignHeader: 'center',
+            alignData: 'center',
+            sortValue: (event) => event.count ?? 1,
+            getTitle: (event) => eventGridRecurrenceTitle(event),
+          }
+        ),
         cf.createAgeColumn<EventGridRow>('age', EVENT_LABELS.lastSeen, (event) => event.age)
       );
 
@@ -186,6 +203,7 @@ export function useEventsGridParts({ defaultNamespace }: { defaultNamespace?: st
         objectName: { width: 200 },
         reason: { width: 200 },
         message: { width: 250 },
+        count: { autoWidth: true },
         age: { autoWidth: true },
       };
       cf.applyColumnSizing(baseColumns, sizing);
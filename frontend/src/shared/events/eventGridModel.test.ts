@@ -12,6 +12,7 @@ import {
   eventGridCanOpenRelatedObject,
   eventGridObjectNamespace,
   eventGridObjectReference,
+  eventGridRecurrenceTitle,
   eventGridRelatedObjectInput,
   eventGridSearchText,
   eventGridStableKey,
@@ -239,4 +240,13 @@ describe('eventGridModel', () => {
       })
     );
   });
+
+  it('describes folded recurring events for the count tooltip', () => {
+    expect(eventGridRecurrenceTitle({ count: 1 })).toBeUndefined();
+    const firstSeen = Date.UTC(2026, 2, 1, 10, 0, 0);
+    expect(eventGridRecurrenceTitle({ count: 12, firstSeen, ratePerMinute: 2.5 })).toBe(
+      `12 times since ${new Date(firstSeen).toLocaleString()} (2.5/min)`
+    );
+    expect(eventGridRecurrenceTitle({ count: 3 })).toBe('3 times');
+  });
 });
@@ -118,6 +118,29 @@ export const resolveEventGridRelatedObject = (
 ): Promise<ResolvedObjectReference | undefined> =>
   resolveEventObjectReference(eventGridRelatedObjectInput(event, options));
 
+/**
+ * eventGridRecurrenceTitle describes a folded recurring-event row for the Count
+ * cell's tooltip, e.g. "12 times since 3/1/2026, 10:00:00 AM (2.5/min)".
+ */
+export const eventGridRecurrenceTitle = (event: {
+  count?: number | null;
+  firstSeen?: number | null;
+  ratePerMinute?: number | null;
+}): string | undefined => {
+  const count = event.count ?? 1;
+  if (count < 2) {
+    return undefined;
+  }
+  const parts = [`${count} times`];
+  if (event.firstSeen) {
+    parts.push(`since ${new Date(event.firstSeen).toLocaleString()}`);
+  }
+  if (event.ratePerMinute) {
+    parts.push(`(${event.ratePerMinute}/min)`);
+  }
+  return parts.join(' ');
+};
+
 export const eventGridStableKey = (
   event: EventGridRowIdentity,
   index: number,
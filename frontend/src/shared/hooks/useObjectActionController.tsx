@@ -3,12 +3,18 @@
  *
  * Coordinates shared Kubernetes object actions for table rows and object-panel
  * headers, including permission-aware menus, modals, object-map navigation,
- * port-forward setup, and destructive action confirmation.
+ * port-forward setup, custom actions, and destructive action confirmation.
  */
 
 import { useObjectPanel } from '@modules/object-panel/hooks/useObjectPanel';
 import { isObjectMapSupportedKind } from '@modules/object-panel/objectPanelRef';
 import { PortForwardModal, type PortForwardTarget } from '@modules/port-forward';
+import {
+  type CustomActionDescriptor,
+  customActionApplies,
+  listCustomActions,
+  runCustomAction,
+} from '@shared/actions/customActionClient';
 import {
   buildObjectActionTarget,
   runCronJobSuspend,
@@ -25,6 +31,9 @@ import {
 } from '@shared/actions/objectActionContract';
 import type { ContextMenuItem } from '@shared/components/ContextMenu';
 import ConfirmationModal from '@shared/components/modals/ConfirmationModal';
+import CustomActionOutputModal, {
+  type CustomActionOutput,
+} from '@shared/components/modals/CustomActionOutputModal';
 import RollbackModal from '@shared/components/modals/RollbackModal';
 import ScaleModal from '@shared/components/modals/ScaleModal';
 import { resolveNodeActionPermissionStatuses } from '@shared/hooks/nodeActionPermissions';
@@ -35,8 +44,10 @@ import {
   type ObjectActionData,
   type ObjectActionHandlers,
 } from '@shared/hooks/useObjectActions';
-import { useCallback, useMemo, useState } from 'react';
+import { useProtectedNamespace } from '@shared/hooks/useProtectedNamespace';
+import { useCallback, useEffect, useMemo, useState } from 'react';
 import { getPermissionKey, queryKindPermissions, useUserPermissions } from '@/core/capabilities';
+import { isConfirmationRequiredError } from '@/core/settings/protectedNamespaces';
 import type { KubernetesObjectReference } from '@/types/view-state';
 import { errorHandler } from '@/utils/errorHandler';
 
@@ -80,6 +91,11 @@ interface ScaleConfirmationState {
   replicas: number;
 }
 
+interface CustomActionTarget {
+  object: ObjectActionData;
+  action: CustomActionDescriptor;
+}
+
 const clampReplicas = (value: number): number => Math.max(0, Math.min(9999, value));
 
 const extractDesiredReplicas = (object: ObjectActionData): number => {
@@ -148,6 +164,9 @@ export const useObjectActionController = ({
   const [rollbackTarget, setRollbackTarget] = useState<ObjectActionData | null>(null);
   const [portForwardTarget, setPortForwardTarget] = useState<PortForwardTarget | null>(null);
   const [scaleConfirmation, setScaleConfirmation] = useState<ScaleConfirmationState | null>(null);
+  const [customActions, setCustomActions] = useState<CustomActionDescriptor[]>([]);
+  const [customActionTarget, setCustomActionTarget] = useState<CustomActionTarget | null>(null);
+  const [customActionOutput, setCustomActionOutput] = useState<CustomActionOutput | null>(null);
   const [scaleState, setScaleState] = useState<ScaleState>({
     object: null,
     value: 1,
@@ -155,6 +174,44 @@ export const useObjectActionController = ({
     error: null,
   });
 
+  // actions.yaml is edited outside the app, so reload it when the window
+  // regains focus.
+  useEffect(() => {
+    let cancelled = false;
+    const load = () => {
+      listCustomActions()
+        .then((actions) => {
+          if (!cancelled) {
+            setCustomActions(actions);
+          }
+        })
+        .catch(() => {
+          // Without the registry the menu simply has no custom actions.
+        });
+    };
+    load();
+    window.addEventListener('focus', load);
+    return () => {
+      cancelled = true;
+      window.removeEventListener('focus', load);
+    };
+  }, []);
+
+  const executeCustomAction = useCallback(
+    async ({ object, action }: CustomActionTarget) => {
+      try {
+        const result = await runCustomAction(action.id, actionTargetFor(object, action.label));
+        onAfterAction?.(object, action.id);
+        if (result) {
+          setCustomActionOutput({ label: action.label, ...result });
+        }
+      } catch (error) {
+        errorHandler.handle(error, { action: action.id, kind: object.kind, name: object.name });
+      }
+    },
+    [onAfterAction]
+  );
+
   const closeScale = useCallback(() => {
     if (scaleState.loading) {
       return;
@@ -304,6 +361,11 @@ export const useObjectActionController = ({
                     await runObjectScale(actionTargetFor(object, 'scale'), 1);
                     onAfterAction?.(object, 'scale');
                   } catch (error) {
+                    // A protected namespace wants the name typed first.
+                    if (isConfirmationRequiredError(error)) {
+                      setScaleConfirmation({ object, replicas: 1 });
+                      return;
+                    }
                     errorHandler.handle(error, {
                       action: 'scale',
                       kind: object.kind,
@@ -372,11 +434,27 @@ export const useObjectActionController = ({
           drain: nodeActionPermissions.drain,
         },
         actionLoading,
+        // Mutating custom actions are confirmed first; read-only ones run on click.
+        customActions: customActions
+          .filter((action) => customActionApplies(action, object))
+          .map((action) => ({
+            id: action.id,
+            label: action.label,
+            onClick: () => {
+              if (action.mutating) {
+                setCustomActionTarget({ object, action });
+                return;
+              }
+              void executeCustomAction({ object, action });
+            },
+          })),
       });
     },
     [
       actionLoading,
       context,
+      customActions,
+      executeCustomAction,
       handlerOverrides,
       perObjectHandlers,
       onAfterAction,
@@ -392,36 +470,42 @@ export const useObjectActionController = ({
     ]
   );
 
-  const confirmRestart = useCallback(async () => {
-    const object = restartTarget;
-    if (!object) {
-      return;
-    }
-    try {
-      await runObjectRestart(actionTargetFor(object, 'restart'));
-      onAfterAction?.(object, 'restart');
-    } catch (error) {
-      errorHandler.handle(error, { action: 'restart', kind: object.kind, name: object.name });
-    } finally {
-      setRestartTarget(null);
-    }
-  }, [onAfterAction, restartTarget]);
+  const confirmRestart = useCallback(
+    async (typedConfirmation?: string) => {
+      const object = restartTarget;
+      if (!object) {
+        return;
+      }
+      try {
+        await runObjectRestart(actionTargetFor(object, 'restart'), typedConfirmation);
+        onAfterAction?.(object, 'restart');
+      } catch (error) {
+        errorHandler.handle(error, { action: 'restart', kind: object.kind, name: object.name });
+      } finally {
+        setRestartTarget(null);
+      }
+    },
+    [onAfterAction, restartTarget]
+  );
 
-  const confirmDelete = useCallback(async () => {
-    const object = deleteTarget;
-    if (!object) {
-      return;
-    }
-    try {
-      await runObjectDelete(actionTargetFor(object, 'delete'));
-      onAfterDelete?.(object);
-      onAfterAction?.(object, 'delete');
-    } catch (error) {
-      errorHandler.handle(error, { action: 'delete', kind: object.kind, name: object.name });
-    } finally {
-      setDeleteTarget(null);
-    }
-  }, [deleteTarget, onAfterAction, onAfterDelete]);
+  const confirmDelete = useCallback(
+    async (typedConfirmation?: string) => {
+      const object = deleteTarget;
+      if (!object) {
+        return;
+      }
+      try {
+        await runObjectDelete(actionTargetFor(object, 'delete'), typedConfirmation);
+        onAfterDelete?.(object);
+        onAfterAction?.(object, 'delete');
+      } catch (error) {
+        errorHandler.handle(error, { action: 'delete', kind: object.kind, name: object.name });
+      } finally {
+        setDeleteTarget(null);
+      }
+    },
+    [deleteTarget, onAfterAction, onAfterDelete]
+  );
 
   const confirmTrigger = useCallback(async () => {
     const object = triggerTarget;
@@ -450,6 +534,12 @@ export const useObjectActionController = ({
         onAfterAction?.(object, 'scale');
         setScaleState({ object: null, value: 1, loading: false, error: null });
       } catch (error) {
+        // A protected namespace wants the name typed first.
+        if (isConfirmationRequiredError(error)) {
+          setScaleConfirmation({ object, replicas });
+          setScaleState({ object: null, value: 1, loading: false, error: null });
+          return;
+        }
         const message = error instanceof Error ? error.message : String(error);
         setScaleState((previous) => ({ ...previous, loading: false, error: message }));
         errorHandler.handle(error, { action: 'scale', kind: object.kind, name: object.name });
@@ -462,21 +552,38 @@ export const useObjectActionController = ({
     await applyScaleValue(scaleState.value);
   }, [applyScaleValue, scaleState.value]);
 
-  const confirmScaleToZero = useCallback(async () => {
-    const confirmation = scaleConfirmation;
-    if (!confirmation) {
+  const confirmScaleConfirmation = useCallback(
+    async (typedConfirmation?: string) => {
+      const confirmation = scaleConfirmation;
+      if (!confirmation) {
+        return;
+      }
+      const { object, replicas } = confirmation;
+      try {
+        await runObjectScale(actionTargetFor(object, 'scale'), replicas, typedConfirmation);
+        onAfterAction?.(object, 'scale');
+      } catch (error) {
+        errorHandler.handle(error, { action: 'scale', kind: object.kind, name: object.name });
+      } finally {
+        setScaleConfirmation(null);
+      }
+    },
+    [onAfterAction, scaleConfirmation]
+  );
+
+  const confirmCustomAction = useCallback(async () => {
+    const target = customActionTarget;
+    if (!target) {
       return;
     }
-    const { object, replicas } = confirmation;
-    try {
-      await runObjectScale(actionTargetFor(object, 'scale'), replicas);
-      onAfterAction?.(object, 'scale');
-    } catch (error) {
-      errorHandler.handle(error, { action: 'scale', kind: object.kind, name: object.name });
-    } finally {
-      setScaleConfirmation(null);
-    }
-  }, [onAfterAction, scaleConfirmation]);
+    setCustomActionTarget(null);
+    await executeCustomAction(target);
+  }, [customActionTarget, executeCustomAction]);
+
+  const confirmingObject = restartTarget ?? deleteTarget ?? scaleConfirmation?.object ?? null;
+  const confirmingProtected = useProtectedNamespace(confirmingObject);
+  const typedConfirmation =
+    confirmingObject && confirmingProtected ? confirmingObject.name : undefined;
 
   const confirmation = useMemo(() => {
     if (restartTarget) {
@@ -515,23 +622,40 @@ export const useObjectActionController = ({
       };
     }
     if (scaleConfirmation) {
-      const object = scaleConfirmation.object;
+      const { object, replicas } = scaleConfirmation;
       return {
-        title: 'Scale to 0',
-        message: `Scale ${object.kind.toLowerCase()} "${object.name}" to 0 replicas?`,
-        warning: 'This will stop currently running pods for this workload.',
-        confirmText: 'Scale to 0',
-        confirmButtonClass: 'danger',
-        onConfirm: confirmScaleToZero,
+        title: `Scale to ${replicas}`,
+        message: `Scale ${object.kind.toLowerCase()} "${object.name}" to ${replicas} replica${replicas === 1 ? '' : 's'}?`,
+        warning:
+          replicas === 0 ? 'This will stop currently running pods for this workload.' : undefined,
+        confirmText: `Scale to ${replicas}`,
+        confirmButtonClass: replicas === 0 ? 'danger' : 'warning',
+        onConfirm: confirmScaleConfirmation,
         onCancel: () => setScaleConfirmation(null),
       };
     }
+    if (customActionTarget) {
+      const { object, action } = customActionTarget;
+      return {
+        title: action.label,
+        message: `Run "${action.label}" on ${object.kind.toLowerCase()} "${object.name}"?${
+          action.permissionSummary ? `\n\nScope: ${action.permissionSummary}.` : ''
+        }`,
+        warning: 'This custom action changes cluster state.',
+        confirmText: 'Run',
+        confirmButtonClass: 'warning',
+        onConfirm: confirmCustomAction,
+        onCancel: () => setCustomActionTarget(null),
+      };
+    }
     return null;
   }, [
+    confirmCustomAction,
     confirmDelete,
     confirmRestart,
-    confirmScaleToZero,
+    confirmScaleConfirmation,
     confirmTrigger,
+    customActionTarget,
     deleteTarget,
     restartTarget,
     scaleConfirmation,
@@ -549,6 +673,7 @@ export const useObjectActionController = ({
           confirmText={confirmation?.confirmText ?? 'Confirm'}
           cancelText="Cancel"
           confirmButtonClass={confirmation?.confirmButtonClass}
+          typedConfirmation={typedConfirmation}
           onConfirm={confirmation?.onConfirm ?? (() => undefined)}
           onCancel={confirmation?.onCancel ?? (() => undefined)}
         />
@@ -574,6 +699,10 @@ export const useObjectActionController = ({
           }
         />
         <PortForwardModal target={portForwardTarget} onClose={() => setPortForwardTarget(null)} />
+        <CustomActionOutputModal
+          result={customActionOutput}
+          onClose={() => setCustomActionOutput(null)}
+        />
         {!!(rollbackTarget?.clusterId && rollbackTarget.namespace && rollbackTarget.version) && (
           <RollbackModal
             isOpen={true}
@@ -592,12 +721,14 @@ export const useObjectActionController = ({
       closeScale,
       confirmation,
       confirmScale,
+      customActionOutput,
       portForwardTarget,
       rollbackTarget,
       scaleState.error,
       scaleState.loading,
       scaleState.object,
       scaleState.value,
+      typedConfirmation,
     ]
   );
 
@@ -691,4 +691,35 @@ describe('buildObjectActionItems', () => {
       )
     ).toMatchObject({ actionId: OBJECT_ACTION_IDS.suspend });
   });
+
+  it('lists custom actions before Delete and runs them on click', () => {
+    const clicked: string[] = [];
+    const items = buildObjectActionItems({
+      object: {
+        kind: 'Deployment',
+        group: 'apps',
+        version: 'v1',
+        name: 'api',
+        namespace: 'apps',
+        clusterId: 'cluster-a',
+      },
+      context: 'gridtable',
+      handlers: {
+        onDelete: () => undefined,
+      },
+      permissions: {
+        delete: { allowed: true, pending: false },
+      },
+      customActions: [
+        { id: 'custom:open-dashboard', label: 'Open dashboard', onClick: () => clicked.push('a') },
+        { id: 'custom:annotate', label: 'Annotate', onClick: () => clicked.push('b') },
+      ],
+    });
+
+    const labels = items.map((item) => (item.divider ? '---' : item.label));
+    expect(labels.slice(-4)).toEqual(['Open dashboard', 'Annotate', '---', 'Delete']);
+    const custom = items.find((item) => item.actionId === 'custom:annotate');
+    custom?.onClick?.();
+    expect(clicked).toEqual(['b']);
+  });
 });
@@ -60,6 +60,13 @@ export interface ObjectActionHandlers {
   onObjectMap?: () => void;
 }
 
+// A custom action from actions.yaml that applies to the object.
+export interface CustomActionMenuEntry {
+  id: string;
+  label: string;
+  onClick: () => void;
+}
+
 let nextObjectDiffRequestId = 1;
 
 export type { ObjectActionData, PermissionStatus };
@@ -82,6 +89,7 @@ export interface BuildObjectActionsOptions {
     drain?: PermissionStatus | null;
   };
   actionLoading?: boolean;
+  customActions?: CustomActionMenuEntry[];
 }
 
 /**
@@ -94,6 +102,7 @@ export function buildObjectActionItems({
   handlers,
   permissions,
   actionLoading = false,
+  customActions = [],
 }: BuildObjectActionsOptions): ContextMenuItem[] {
   const menuItems: ContextMenuItem[] = [];
   const diffSelection =
@@ -292,6 +301,23 @@ export function buildObjectActionItems({
     });
   }
 
+  // Custom actions, in actions.yaml order
+  if (customActions.length > 0) {
+    const lastItem = menuItems[menuItems.length - 1];
+    if (menuItems.length > 0 && !(lastItem && 'divider' in lastItem && lastItem.divider)) {
+      menuItems.push({ divider: true });
+    }
+    for (const action of customActions) {
+      menuItems.push({
+        actionId: action.id,
+        label: action.label,
+        icon: '▶',
+        onClick: action.onClick,
+        disabled: actionLoading,
+      });
+    }
+  }
+
   // Delete (with divider if there are other items)
   if (policy.deleteEnabled && handlers.onDelete) {
     // Add divider before Delete if there are other action items
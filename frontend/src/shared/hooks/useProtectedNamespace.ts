@@ -0,0 +1,47 @@
+/**
+ * frontend/src/shared/hooks/useProtectedNamespace.ts
+ *
+ * Resolves whether a destructive action on the target needs a typed
+ * confirmation, because the target's namespace is protected.
+ */
+
+import { useEffect, useState } from 'react';
+import {
+  guardingNamespaceFor,
+  isNamespaceProtected,
+  type ProtectedNamespaceTarget,
+} from '@/core/settings/protectedNamespaces';
+
+/**
+ * Returns true once the target's namespace is known to be protected. It is
+ * false while the check runs or when it fails: the backend still refuses the
+ * action then, and its error names the confirmation it expects.
+ */
+export const useProtectedNamespace = (target: ProtectedNamespaceTarget | null): boolean => {
+  const namespace = target ? guardingNamespaceFor(target) : '';
+  const [protectedNamespace, setProtectedNamespace] = useState<string | null>(null);
+
+  useEffect(() => {
+    if (!namespace) {
+      return;
+    }
+    let cancelled = false;
+    isNamespaceProtected(namespace).then(
+      (value) => {
+        if (!cancelled) {
+          setProtectedNamespace(value ? namespace : null);
+        }
+      },
+      () => {
+        if (!cancelled) {
+          setProtectedNamespace(null);
+        }
+      }
+    );
+    return () => {
+      cancelled = true;
+    };
+  }, [namespace]);
+
+  return namespace !== '' && protectedNamespace === namespace;
+};
@@ -0,0 +1,32 @@
+/**
+ * frontend/src/shared/utils/certificateExpiry.test.ts
+ */
+
+import { describe, expect, it } from 'vitest';
+import { certificateExpiryBadge } from './certificateExpiry';
+
+const NOW = Date.UTC(2026, 5, 1);
+const DAY = 24 * 60 * 60 * 1000;
+
+describe('certificateExpiryBadge', () => {
+  it('marks expired certificates as errors', () => {
+    expect(certificateExpiryBadge(NOW - DAY, NOW)).toMatchObject({
+      label: 'Expired',
+      className: 'status-text error',
+    });
+  });
+
+  it('warns about certificates inside the warning window', () => {
+    expect(certificateExpiryBadge(NOW + 5 * DAY + 1, NOW)).toMatchObject({
+      label: 'Expires in 5d',
+      className: 'status-text warning',
+    });
+    expect(certificateExpiryBadge(NOW + DAY / 2, NOW)?.label).toBe('Expires today');
+  });
+
+  it('shows nothing for distant or missing expiries', () => {
+    expect(certificateExpiryBadge(NOW + 31 * DAY, NOW)).toBeNull();
+    expect(certificateExpiryBadge(undefined, NOW)).toBeNull();
+    expect(certificateExpiryBadge(0, NOW)).toBeNull();
+  });
+});
@@ -0,0 +1,46 @@
+/**
+ * frontend/src/shared/utils/certificateExpiry.ts
+ *
+ * Expiry badges for rows that carry a certificate's earliest expiry (TLS Secrets and webhook
+ * caBundles). Rows hold the timestamp rather than a status so the badge stays correct as time
+ * passes without the object changing.
+ */
+
+/** Matches the backend certificate expiry report's default warning window. */
+export const CERTIFICATE_EXPIRY_WARNING_DAYS = 30;
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+
+export interface CertificateExpiryBadge {
+  label: string;
+  className: string;
+  title: string;
+}
+
+/** Returns the badge for an expired or soon-expiring certificate, or null when none applies. */
+export const certificateExpiryBadge = (
+  notAfter: number | null | undefined,
+  now: number = Date.now()
+): CertificateExpiryBadge | null => {
+  if (typeof notAfter !== 'number' || !Number.isFinite(notAfter) || notAfter <= 0) {
+    return null;
+  }
+  const date = new Date(notAfter).toISOString();
+  if (notAfter <= now) {
+    return {
+      label: 'Expired',
+      className: 'status-text error',
+      title: `Certificate expired ${date}`,
+    };
+  }
+  const remaining = notAfter - now;
+  if (remaining >= CERTIFICATE_EXPIRY_WARNING_DAYS * DAY_MS) {
+    return null;
+  }
+  const days = Math.floor(remaining / DAY_MS);
+  return {
+    label: days === 0 ? 'Expires today' : `Expires in ${days}d`,
+    className: 'status-text warning',
+    title: `Certificate expires ${date}`,
+  };
+};
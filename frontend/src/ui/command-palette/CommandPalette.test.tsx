@@ -150,6 +150,8 @@ let registeredGlobalShortcuts: Array<{
   key: string;
   modifiers?: Record<string, boolean>;
   handler: () => boolean | undefined;
+  description?: string;
+  enabled?: boolean;
 }> = [];
 let registeredPaletteShortcuts: Array<{
   key: string;
@@ -188,11 +190,20 @@ vi.mock('@wailsjs/runtime/runtime', () => ({
   },
 }));
 
+const keybindingOverrides = vi.hoisted(() => new Map<string, unknown>());
+
+vi.mock('@ui/shortcuts/keybindings', () => ({
+  useKeybinding: (action: string, fallback: unknown) =>
+    keybindingOverrides.has(action) ? keybindingOverrides.get(action) : fallback,
+}));
+
 vi.mock('@ui/shortcuts', () => ({
   useShortcut: (options: {
     key: string;
     modifiers?: Record<string, boolean>;
     handler: () => boolean | undefined;
+    description?: string;
+    enabled?: boolean;
   }) => {
     registeredGlobalShortcuts.push(options);
   },
@@ -293,6 +304,7 @@ describe('CommandPalette component behaviour', () => {
   });
 
   beforeEach(() => {
+    keybindingOverrides.clear();
     registeredGlobalShortcuts = [];
     registeredPaletteShortcuts = [];
     wailsEventHandlers.clear();
@@ -398,6 +410,21 @@ describe('CommandPalette component behaviour', () => {
     expect(container.querySelector('.command-palette')).toBeNull();
   });
 
+  it('registers the open and namespace shortcuts from the keybinding settings', async () => {
+    keybindingOverrides.set('app.commandPalette', { key: 'k', modifiers: { alt: true } });
+    keybindingOverrides.set('app.selectNamespace', null);
+    await renderPalette([
+      { id: 'ns-prod', label: 'prod', category: 'Namespaces', action: vi.fn() },
+    ]);
+
+    expect(findGlobalShortcut({ alt: true }, 'k')).toBeTruthy();
+    expect(findGlobalShortcut(defaultOpenShortcut)).toBeUndefined();
+    const namespaceShortcut = registeredGlobalShortcuts.find(
+      (shortcut) => shortcut.description === 'Select namespace'
+    );
+    expect(namespaceShortcut?.enabled).toBe(false);
+  });
+
   it('leaves namespace mode fully when kubeconfig mode opens over it', async () => {
     const commands: Command[] = [
       {
@@ -12,6 +12,7 @@ import { getKindColorClass } from '@shared/utils/kindBadgeColors';
 import { buildRequiredObjectReference } from '@shared/utils/objectIdentity';
 import { withStableListKeys } from '@shared/utils/stableListKeys';
 import { useKeyboardContext, useShortcut, useShortcuts } from '@ui/shortcuts';
+import { useKeybinding } from '@ui/shortcuts/keybindings';
 import { KeyboardShortcutPriority } from '@ui/shortcuts/priorities';
 import { useKeyboardSurface } from '@ui/shortcuts/surfaces';
 import { EventsOn } from '@wailsjs/runtime/runtime';
@@ -771,14 +772,23 @@ export const CommandPalette = memo(function CommandPaletteComponent({
     return false;
   }, [hasActiveBlockingSurface, isOpen, open]);
 
-  // Register shortcuts for opening the command palette
-  useShortcut({
+  // Register shortcuts for opening the command palette, using the user's
+  // keybinding settings; null when the action is unbound.
+  const commandPaletteBinding = useKeybinding('app.commandPalette', {
     key: 'p',
     modifiers: macPlatform ? { meta: true, shift: true } : { ctrl: true, shift: true },
+  });
+  const selectNamespaceBinding = useKeybinding('app.selectNamespace', {
+    key: 'n',
+    modifiers: macPlatform ? { meta: true, shift: true } : { ctrl: true, shift: true },
+  });
+  useShortcut({
+    key: commandPaletteBinding?.key ?? 'p',
+    modifiers: commandPaletteBinding?.modifiers,
     handler: handleGlobalOpenShortcut,
     description: 'Open command palette',
     category: 'Global',
-    enabled: true,
+    enabled: !!commandPaletteBinding,
     priority: 100,
   });
 
@@ -826,12 +836,12 @@ export const CommandPalette = memo(function CommandPaletteComponent({
   // Open the palette straight into namespace selection. Registered in the
   // frontend shortcut system (not the native menu), like ⌘⇧P above.
   useShortcut({
-    key: 'n',
-    modifiers: macPlatform ? { meta: true, shift: true } : { ctrl: true, shift: true },
+    key: selectNamespaceBinding?.key ?? 'n',
+    modifiers: selectNamespaceBinding?.modifiers,
     handler: openInNamespaceMode,
     description: 'Select namespace',
     category: 'Global',
-    enabled: true,
+    enabled: !!selectNamespaceBinding,
     priority: 100,
   });
   // The header search button opens the palette in its normal (search) mode via
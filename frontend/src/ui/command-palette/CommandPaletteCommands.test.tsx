@@ -238,6 +238,9 @@ describe('CommandPaletteCommands', () => {
       'cluster-crds',
       'cluster-custom',
       'cluster-rbac',
+      'cluster-git-drift',
+      'cluster-flux',
+      'cluster-velero',
       'namespace-browse',
       'namespace-map',
       'namespace-events',
@@ -0,0 +1,76 @@
+/**
+ * frontend/src/ui/layout/ClusterReadOnlyToggle.test.tsx
+ *
+ * Test suite for ClusterReadOnlyToggle.
+ * Covers loading the active cluster's flag and flipping it.
+ */
+
+import { act } from 'react';
+import * as ReactDOM from 'react-dom/client';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { ClusterReadOnlyToggle } from './ClusterReadOnlyToggle';
+
+const readOnlyMocks = vi.hoisted(() => ({
+  getClusterReadOnly: vi.fn<(clusterId: string) => Promise<boolean>>(),
+  setClusterReadOnly: vi.fn<(clusterId: string, readOnly: boolean) => Promise<void>>(),
+}));
+
+vi.mock('@/core/settings/clusterReadOnly', () => readOnlyMocks);
+
+describe('ClusterReadOnlyToggle', () => {
+  let container: HTMLDivElement;
+  let root: ReactDOM.Root;
+
+  beforeEach(() => {
+    container = document.createElement('div');
+    document.body.appendChild(container);
+    root = ReactDOM.createRoot(container);
+    readOnlyMocks.getClusterReadOnly.mockReset();
+    readOnlyMocks.setClusterReadOnly.mockReset().mockResolvedValue(undefined);
+  });
+
+  afterEach(() => {
+    act(() => root.unmount());
+    container.remove();
+  });
+
+  const renderToggle = async (clusterId: string | undefined) => {
+    await act(async () => {
+      root.render(<ClusterReadOnlyToggle clusterId={clusterId} />);
+    });
+    return container.querySelector<HTMLButtonElement>('button');
+  };
+
+  it('shows the persisted flag and turns read-only mode off', async () => {
+    readOnlyMocks.getClusterReadOnly.mockResolvedValue(true);
+    const button = await renderToggle('kc:prod');
+
+    expect(readOnlyMocks.getClusterReadOnly).toHaveBeenCalledWith('kc:prod');
+    expect(button?.getAttribute('aria-pressed')).toBe('true');
+    expect(button?.classList.contains('active')).toBe(true);
+
+    await act(async () => {
+      button?.click();
+    });
+    expect(readOnlyMocks.setClusterReadOnly).toHaveBeenCalledWith('kc:prod', false);
+    expect(button?.getAttribute('aria-pressed')).toBe('false');
+  });
+
+  it('keeps the flag when the save fails', async () => {
+    readOnlyMocks.getClusterReadOnly.mockResolvedValue(false);
+    readOnlyMocks.setClusterReadOnly.mockRejectedValue(new Error('settings unwritable'));
+    const button = await renderToggle('kc:prod');
+
+    await act(async () => {
+      button?.click();
+    });
+    expect(readOnlyMocks.setClusterReadOnly).toHaveBeenCalledWith('kc:prod', true);
+    expect(button?.getAttribute('aria-pressed')).toBe('false');
+    expect(button?.disabled).toBe(false);
+  });
+
+  it('renders nothing without an active cluster', async () => {
+    expect(await renderToggle(undefined)).toBeNull();
+    expect(readOnlyMocks.getClusterReadOnly).not.toHaveBeenCalled();
+  });
+});
@@ -0,0 +1,95 @@
+/**
+ * frontend/src/ui/layout/ClusterReadOnlyToggle.tsx
+ *
+ * The sidebar's per-cluster read-only switch, shown in the Cluster section
+ * header. The backend enforces the mode on every mutating call; this only
+ * reads and flips the persisted flag for the active cluster.
+ */
+
+import { LockIcon } from '@shared/components/icons/SharedIcons';
+import { useCallback, useEffect, useState } from 'react';
+import { logAppLogsError } from '@/core/logging/appLogsClient';
+import { getClusterReadOnly, setClusterReadOnly } from '@/core/settings/clusterReadOnly';
+
+export interface ClusterReadOnlyState {
+  readOnly: boolean;
+  /** True while the flag loads or saves; the switch is disabled meanwhile. */
+  busy: boolean;
+  toggle: () => void;
+}
+
+export function useClusterReadOnly(clusterId: string | undefined): ClusterReadOnlyState {
+  const [readOnly, setReadOnly] = useState(false);
+  const [busy, setBusy] = useState(false);
+
+  useEffect(() => {
+    let cancelled = false;
+    setReadOnly(false);
+    if (!clusterId) {
+      return;
+    }
+    setBusy(true);
+    getClusterReadOnly(clusterId).then(
+      (value) => {
+        if (!cancelled) {
+          setReadOnly(value);
+          setBusy(false);
+        }
+      },
+      (error) => {
+        logAppLogsError(`read-only: load failed for cluster "${clusterId}": ${String(error)}`);
+        if (!cancelled) {
+          setBusy(false);
+        }
+      }
+    );
+    return () => {
+      cancelled = true;
+    };
+  }, [clusterId]);
+
+  const toggle = useCallback(() => {
+    if (!clusterId) {
+      return;
+    }
+    const next = !readOnly;
+    setBusy(true);
+    setClusterReadOnly(clusterId, next).then(
+      () => {
+        setReadOnly(next);
+        setBusy(false);
+      },
+      (error) => {
+        logAppLogsError(`read-only: save failed for cluster "${clusterId}": ${String(error)}`);
+        setBusy(false);
+      }
+    );
+  }, [clusterId, readOnly]);
+
+  return { readOnly, busy, toggle };
+}
+
+interface ClusterReadOnlyToggleProps {
+  clusterId: string | undefined;
+}
+
+export function ClusterReadOnlyToggle({ clusterId }: ClusterReadOnlyToggleProps) {
+  const { readOnly, busy, toggle } = useClusterReadOnly(clusterId);
+  if (!clusterId) {
+    return null;
+  }
+  const label = readOnly ? 'Read-only mode on: writes are blocked' : 'Read-only mode off';
+  return (
+    <button
+      type="button"
+      className={`sidebar-header-action cluster-read-only-toggle${readOnly ? ' active' : ''}`}
+      title={`${label}. Click to turn ${readOnly ? 'off' : 'on'}.`}
+      aria-label="Read-only mode"
+      aria-pressed={readOnly}
+      disabled={busy}
+      onClick={toggle}
+    >
+      <LockIcon width={12} height={12} open={!readOnly} />
+    </button>
+  );
+}
@@ -41,6 +41,7 @@ import { useDimInactiveNamespaces } from '@/hooks/useDimInactiveNamespaces';
 import { useExclusiveNamespaces } from '@/hooks/useExclusiveNamespaces';
 import type { ClusterViewType, GlobalViewType, NamespaceViewType } from '@/types/navigation/views';
 import { isMacPlatform } from '@/utils/platform';
+import { ClusterReadOnlyToggle } from './ClusterReadOnlyToggle';
 import { NamespaceScopeAddRow, useNamespaceScope } from './NamespaceScopeEditor';
 import { type SidebarCursorTarget, useSidebarKeyboardControls } from './SidebarKeys';
 
@@ -398,7 +399,10 @@ function Sidebar() {
             ) : null}
 
             <div className="sidebar-section" hidden={viewState.viewType === 'global'}>
-              <h3>Cluster</h3>
+              <h3>
+                Cluster
+                <ClusterReadOnlyToggle clusterId={selectedClusterId || undefined} />
+              </h3>
               <div className="cluster-items">
                 <button
                   type="button"
@@ -24,10 +24,12 @@ import {
   getKubernetesClientBurst,
   getKubernetesClientQPS,
   getPermissionSSRRFetchConcurrency,
+  getRefreshBinaryEncodingEnabled,
   hydrateAppPreferences,
   setKubernetesClientBurst,
   setKubernetesClientQPS,
   setPermissionSSRRFetchConcurrency,
+  setRefreshBinaryEncodingEnabled,
 } from '@/core/settings/appPreferences';
 import { PreferenceNumberInput, SettingRow } from './SettingsControls';
 
@@ -43,6 +45,9 @@ function AdvancedSection() {
   );
   const [permissionSSRRFetchConcurrencyInput, setPermissionSSRRFetchConcurrencyInput] =
     useState<string>(() => String(getPermissionSSRRFetchConcurrency()));
+  const [binaryEncodingEnabled, setBinaryEncodingEnabled] = useState<boolean>(() =>
+    getRefreshBinaryEncodingEnabled()
+  );
   const [persistenceMode, setPersistenceMode] = useState<GridTablePersistenceMode>(() =>
     getGridTablePersistenceMode()
   );
@@ -58,6 +63,7 @@ function AdvancedSection() {
           setKubernetesClientQPSInput(String(prefs.kubernetesClientQPS));
           setKubernetesClientBurstInput(String(prefs.kubernetesClientBurst));
           setPermissionSSRRFetchConcurrencyInput(String(prefs.permissionSSRRFetchConcurrency));
+          setBinaryEncodingEnabled(prefs.refreshBinaryEncodingEnabled);
           setPersistenceMode(getGridTablePersistenceMode());
         }
       } catch (error) {
@@ -71,6 +77,11 @@ function AdvancedSection() {
 
   const handleRefreshToggle = (enabled: boolean) => setAutoRefresh(enabled);
 
+  const handleBinaryEncodingToggle = (enabled: boolean) => {
+    setBinaryEncodingEnabled(enabled);
+    setRefreshBinaryEncodingEnabled(enabled);
+  };
+
   const handlePersistenceModeToggle = (checked: boolean) => {
     const mode: GridTablePersistenceMode = checked ? 'namespaced' : 'shared';
     setPersistenceMode(mode);
@@ -175,6 +186,18 @@ function AdvancedSection() {
         />
       </SettingRow>
 
+      <SettingRow
+        title="Binary refresh encoding"
+        help="Request snapshots and stream updates as CBOR instead of JSON. Experimental: it lowers backend encoding cost for large tables, but decoding in the app may be slower. Applies to new requests and stream connections."
+      >
+        <ToggleSwitch
+          id={`${elementIdPrefix}-refresh-binary-encoding`}
+          checked={binaryEncodingEnabled}
+          onChange={handleBinaryEncodingToggle}
+          ariaLabel="Binary refresh encoding"
+        />
+      </SettingRow>
+
       <div className="settings-subgroup-label">Kubernetes API</div>
       <hr className="settings-subgroup-divider" />
 
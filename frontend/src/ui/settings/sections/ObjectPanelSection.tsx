@@ -2,7 +2,7 @@
  * frontend/src/ui/settings/sections/ObjectPanelSection.tsx
  *
  * Object panel tab content: default position and dimensions for the object
- * detail panel.
+ * detail panel, and whether Secret values may be revealed in it.
  */
 
 import {
@@ -10,11 +10,13 @@ import {
   getDefaultObjectPanelPosition,
   getIntegerPreferenceMetadata,
   getObjectPanelLayoutDefaults,
+  getSecretRevealEnabled,
   normalizeIntegerPreferenceValue,
   type ObjectPanelLayoutDefaults,
   type ObjectPanelPosition,
   setDefaultObjectPanelPosition,
   setObjectPanelLayoutDefaults,
+  setSecretRevealEnabled,
 } from '@core/settings/appPreferences';
 import {
   DockBottomIcon,
@@ -24,7 +26,8 @@ import {
 import { useDockablePanelContext } from '@ui/dockable';
 import { getContentBounds } from '@ui/dockable/dockablePanelLayout';
 import React, { type FC, useId, useMemo, useState } from 'react';
-import { SettingRow } from './SettingsControls';
+import ToggleSwitch from '@/shared/components/ToggleSwitch';
+import { SettingRow, useOptimisticPreferenceToggle } from './SettingsControls';
 
 const objectPanelPositionOptions = [
   { value: 'right', label: 'Right', icon: DockRightIcon },
@@ -118,6 +121,9 @@ function ObjectPanelSection() {
   const [panelLayout, setPanelLayout] = useState<ObjectPanelLayoutDefaults>(() =>
     getObjectPanelLayoutDefaults()
   );
+  const [secretRevealEnabled, setSecretRevealEnabledState] = useState<boolean>(() =>
+    getSecretRevealEnabled()
+  );
 
   // Track raw input strings so users can freely backspace/clear without
   // values snapping back to 0 on every keystroke.
@@ -133,6 +139,13 @@ function ObjectPanelSection() {
     };
   });
 
+  const handleSecretRevealToggle = useOptimisticPreferenceToggle({
+    action: 'setSecretRevealEnabled',
+    valueKey: 'enabled',
+    persist: setSecretRevealEnabled,
+    setState: setSecretRevealEnabledState,
+  });
+
   const handleObjectPanelPositionChange = (position: ObjectPanelPosition) => {
     setObjectPanelPositionState(position);
     setDefaultObjectPanelPosition(position);
@@ -261,6 +274,21 @@ function ObjectPanelSection() {
           </div>
         </SettingRow>
       ))}
+
+      <div className="settings-subgroup-label">Secrets</div>
+      <hr className="settings-subgroup-divider" />
+
+      <SettingRow
+        title="Reveal Secret values"
+        help="Allow decoded Secret values to be revealed in the Details tab. Every reveal is recorded in a local audit log; keys matching a redaction pattern are never shown. The YAML tab always withholds Secret values."
+      >
+        <ToggleSwitch
+          id={`${elementIdPrefix}-secret-reveal`}
+          checked={secretRevealEnabled}
+          onChange={handleSecretRevealToggle}
+          ariaLabel="Reveal Secret values"
+        />
+      </SettingRow>
     </div>
   );
 }
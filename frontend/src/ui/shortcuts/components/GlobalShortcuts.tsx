@@ -18,6 +18,7 @@ import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
 import { isMacPlatform } from '@/utils/platform';
 import { KeyCodes } from '../constants';
 import { useShortcut } from '../hooks';
+import { useKeybinding } from '../keybindings';
 import { ShortcutHelpModal } from './ShortcutHelpModal';
 
 interface GlobalShortcutsProps {
@@ -208,103 +209,157 @@ export function GlobalShortcuts({
     return undefined;
   }, [onToggleSettings, onToggleAppLogsPanel]);
 
-  // Register all shortcuts individually to avoid hooks in loops
-  useShortcut({
+  // Effective bindings from the user's keybinding settings; null when unbound.
+  const showShortcutsBinding = useKeybinding('app.showShortcuts', {
     key: '?',
     modifiers: { shift: true },
+  });
+  const toggleSidebarBinding = useKeybinding('app.toggleSidebar', {
+    key: 'b',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const toggleAppLogsPanelBinding = useKeybinding('app.toggleAppLogsPanel', {
+    key: 'l',
+    modifiers: { shift: true, ctrl: true },
+  });
+  const toggleSettingsBinding = useKeybinding('app.toggleSettings', {
+    key: ',',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const toggleObjectDiffBinding = useKeybinding('app.toggleObjectDiff', {
+    key: 'd',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const refreshBinding = useKeybinding('view.refresh', {
+    key: 'r',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const toggleDiagnosticsBinding = useKeybinding('app.toggleDiagnostics', {
+    key: 'd',
+    modifiers: { ctrl: true, shift: true },
+  });
+  const zoomInBinding = useKeybinding('view.zoomIn', {
+    key: '=',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const zoomOutBinding = useKeybinding('view.zoomOut', {
+    key: '-',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const resetZoomBinding = useKeybinding('view.resetZoom', {
+    key: '0',
+    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+  });
+  const previousTabBinding = useKeybinding('cluster.previousTab', {
+    key: KeyCodes.ARROW_LEFT,
+    modifiers: macPlatform ? { meta: true, alt: true } : { ctrl: true, alt: true },
+  });
+  const nextTabBinding = useKeybinding('cluster.nextTab', {
+    key: KeyCodes.ARROW_RIGHT,
+    modifiers: macPlatform ? { meta: true, alt: true } : { ctrl: true, alt: true },
+  });
+
+  // Register all shortcuts individually to avoid hooks in loops
+  useShortcut({
+    key: showShortcutsBinding?.key ?? '?',
+    modifiers: showShortcutsBinding?.modifiers,
     handler: toggleHelp,
     description: 'Show keyboard shortcuts help',
     category: 'Global',
+    enabled: !!showShortcutsBinding,
   });
 
   useShortcut({
-    key: 'b',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: toggleSidebarBinding?.key ?? 'b',
+    modifiers: toggleSidebarBinding?.modifiers,
     handler: handleToggleSidebar,
     description: 'Toggle sidebar',
     category: 'Global',
-    enabled: !!onToggleSidebar,
+    enabled: !!toggleSidebarBinding && !!onToggleSidebar,
   });
 
   useShortcut({
-    key: 'l',
-    modifiers: { shift: true, ctrl: true },
+    key: toggleAppLogsPanelBinding?.key ?? 'l',
+    modifiers: toggleAppLogsPanelBinding?.modifiers,
     handler: handleToggleAppLogsPanel,
     description: 'Toggle Application Logs Panel',
     category: 'Global',
-    enabled: !!onToggleAppLogsPanel,
+    enabled: !!toggleAppLogsPanelBinding && !!onToggleAppLogsPanel,
   });
 
   useShortcut({
-    key: ',',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: toggleSettingsBinding?.key ?? ',',
+    modifiers: toggleSettingsBinding?.modifiers,
     handler: handleToggleSettings,
     description: 'Toggle settings',
     category: 'Global',
-    enabled: !!onToggleSettings,
+    enabled: !!toggleSettingsBinding && !!onToggleSettings,
   });
 
   useShortcut({
-    key: 'd',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: toggleObjectDiffBinding?.key ?? 'd',
+    modifiers: toggleObjectDiffBinding?.modifiers,
     handler: handleToggleObjectDiff,
     description: 'Toggle object diff viewer',
     category: 'Global',
-    enabled: !!onToggleObjectDiff,
+    enabled: !!toggleObjectDiffBinding && !!onToggleObjectDiff,
   });
 
   useShortcut({
-    key: 'r',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: refreshBinding?.key ?? 'r',
+    modifiers: refreshBinding?.modifiers,
     handler: handleRefresh,
     description: 'Refresh current view',
     category: 'Navigation',
-    enabled: !!onRefresh,
+    enabled: !!refreshBinding && !!onRefresh,
   });
 
   useShortcut({
-    key: 'd',
-    modifiers: { ctrl: true, shift: true },
+    key: toggleDiagnosticsBinding?.key ?? 'd',
+    modifiers: toggleDiagnosticsBinding?.modifiers,
     handler: handleToggleDiagnostics,
     description: 'Toggle diagnostics panel',
     category: 'Global',
-    enabled: !!onToggleDiagnostics,
+    enabled: !!toggleDiagnosticsBinding && !!onToggleDiagnostics,
   });
 
   // Zoom shortcuts — the Wails native menu accelerators for +/- don't work on
   // Windows (the keys are missing from the Windows keyMap), so we register them
   // here in the frontend shortcut system where they work on all platforms.
   useShortcut({
-    key: '=',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: zoomInBinding?.key ?? '=',
+    modifiers: zoomInBinding?.modifiers,
     handler: () => {
       zoomIn();
       return undefined;
     },
     description: 'Zoom in',
     category: 'View',
+    enabled: !!zoomInBinding,
   });
 
   useShortcut({
-    key: '-',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: zoomOutBinding?.key ?? '-',
+    modifiers: zoomOutBinding?.modifiers,
     handler: () => {
       zoomOut();
       return undefined;
     },
     description: 'Zoom out',
     category: 'View',
+    enabled: !!zoomOutBinding,
   });
 
   useShortcut({
-    key: '0',
-    modifiers: macPlatform ? { meta: true } : { ctrl: true },
+    key: resetZoomBinding?.key ?? '0',
+    modifiers: resetZoomBinding?.modifiers,
     handler: () => {
       resetZoom();
       return undefined;
     },
     description: 'Reset zoom',
     category: 'View',
+    enabled: !!resetZoomBinding,
   });
 
   // Handle menu:close event from the backend (Cmd/Ctrl+W via native menu).
@@ -323,27 +378,27 @@ export function GlobalShortcuts({
   }, [handleCloseClusterTab]);
 
   useShortcut({
-    key: KeyCodes.ARROW_LEFT,
-    modifiers: macPlatform ? { meta: true, alt: true } : { ctrl: true, alt: true },
+    key: previousTabBinding?.key ?? KeyCodes.ARROW_LEFT,
+    modifiers: previousTabBinding?.modifiers,
     handler: () => {
       handleSwitchClusterTab('prev');
       return undefined;
     },
     description: 'Switch to previous cluster tab',
     category: 'Navigation',
-    enabled: selectedKubeconfigs.length > 1,
+    enabled: !!previousTabBinding && selectedKubeconfigs.length > 1,
   });
 
   useShortcut({
-    key: KeyCodes.ARROW_RIGHT,
-    modifiers: macPlatform ? { meta: true, alt: true } : { ctrl: true, alt: true },
+    key: nextTabBinding?.key ?? KeyCodes.ARROW_RIGHT,
+    modifiers: nextTabBinding?.modifiers,
     handler: () => {
       handleSwitchClusterTab('next');
       return undefined;
     },
     description: 'Switch to next cluster tab',
     category: 'Navigation',
-    enabled: selectedKubeconfigs.length > 1,
+    enabled: !!nextTabBinding && selectedKubeconfigs.length > 1,
   });
 
   useShortcut({
@@ -0,0 +1,111 @@
+/**
+ * frontend/src/ui/shortcuts/keybindings.ts
+ *
+ * User-configurable keybindings.
+ * Caches the effective bindings from the backend registry (which validates
+ * and persists them) and resolves them to shortcut keys and modifiers.
+ */
+
+import type { types } from '@wailsjs/go/models';
+import { useEffect, useState } from 'react';
+import { readKeybindings, requestAppState } from '@/core/app-state-access';
+import { ImportKeybindings, ResetKeybindings, SetKeybindings } from '@/core/backend-api';
+import { eventBus } from '@/core/events';
+import type { ShortcutModifiers } from '@/types/shortcuts';
+import { isMacPlatform } from '@/utils/platform';
+
+export interface ResolvedKeybinding {
+  key: string;
+  modifiers?: ShortcutModifiers;
+}
+
+let cachedBindings = new Map<string, types.KeyBinding>();
+let hydrated = false;
+let hydrationPromise: Promise<void> | null = null;
+
+const updateKeybindingCache = (actions: types.KeyBindingAction[]) => {
+  cachedBindings = new Map(actions.map((entry) => [entry.action, entry.binding]));
+  hydrated = true;
+  eventBus.emit('keybindings:changed', undefined);
+};
+
+/**
+ * Resolve a stored binding for the current platform. `mod` is Cmd on macOS
+ * and Ctrl elsewhere. Returns null for an unbound action.
+ */
+export const resolveKeybinding = (
+  binding: types.KeyBinding,
+  mac: boolean = isMacPlatform()
+): ResolvedKeybinding | null => {
+  if (!binding.key) {
+    return null;
+  }
+  const modifiers: ShortcutModifiers = {
+    ctrl: !!binding.ctrl || (!!binding.mod && !mac),
+    meta: !!binding.meta || (!!binding.mod && mac),
+    shift: !!binding.shift,
+    alt: !!binding.alt,
+  };
+  const hasModifier = modifiers.ctrl || modifiers.meta || modifiers.shift || modifiers.alt;
+  return { key: binding.key, modifiers: hasModifier ? modifiers : undefined };
+};
+
+export const hydrateKeybindings = async (options?: { force?: boolean }): Promise<void> => {
+  if (hydrated && !options?.force) {
+    return;
+  }
+  if (!hydrationPromise) {
+    hydrationPromise = (async () => {
+      if (typeof window === 'undefined' || !window.go?.backend?.App?.GetKeybindings) {
+        hydrated = true;
+        return;
+      }
+      try {
+        const actions = await requestAppState({
+          resource: 'keybindings',
+          adapter: 'persistence-read',
+          read: () => readKeybindings(),
+        });
+        updateKeybindingCache(Array.isArray(actions) ? actions : []);
+      } catch (error) {
+        console.error('Failed to hydrate keybindings:', error);
+      } finally {
+        hydrationPromise = null;
+      }
+    })();
+  }
+  await hydrationPromise;
+};
+
+export const saveKeybindings = async (bindings: types.KeyBinding[]): Promise<void> => {
+  updateKeybindingCache(await SetKeybindings(bindings));
+};
+
+export const resetKeybindings = async (actions: string[] = []): Promise<void> => {
+  updateKeybindingCache(await ResetKeybindings(actions));
+};
+
+export const importKeybindings = async (data: string): Promise<void> => {
+  updateKeybindingCache(await ImportKeybindings(data));
+};
+
+/**
+ * Hook returning the effective binding for an action. `fallback` is used
+ * until the backend bindings load; null means the user unbound the action.
+ */
+export function useKeybinding(
+  action: string,
+  fallback: ResolvedKeybinding
+): ResolvedKeybinding | null {
+  const [binding, setBinding] = useState(() => cachedBindings.get(action));
+
+  useEffect(() => {
+    const sync = () => setBinding(cachedBindings.get(action));
+    const unsubscribe = eventBus.on('keybindings:changed', sync);
+    sync();
+    void hydrateKeybindings();
+    return unsubscribe;
+  }, [action]);
+
+  return binding ? resolveKeybinding(binding) : fallback;
+}
@@ -1,10 +1,12 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {backend} from '../models';
+import {networkpolicy} from '../models';
 import {context} from '../models';
 import {types} from '../models';
 import {objectcatalog} from '../models';
 import {backendtlspolicy} from '../models';
+import {certexpiry} from '../models';
 import {snapshot} from '../models';
 import {clusterrole} from '../models';
 import {clusterrolebinding} from '../models';
@@ -27,11 +29,11 @@ import {limitrange} from '../models';
 import {listenerset} from '../models';
 import {admission} from '../models';
 import {namespaces} from '../models';
-import {networkpolicy} from '../models';
 import {nodes} from '../models';
 import {persistentvolume} from '../models';
 import {persistentvolumeclaim} from '../models';
 import {poddisruptionbudget} from '../models';
+import {podsecurity} from '../models';
 import {referencegrant} from '../models';
 import {replicaset} from '../models';
 import {resourcequota} from '../models';
@@ -44,17 +46,50 @@ import {statefulset} from '../models';
 import {storageclass} from '../models';
 import {resourcemodel} from '../models';
 import {capabilities} from '../models';
+import {notifications} from '../models';
+import {alerts} from '../models';
+import {istio} from '../models';
+import {pods} from '../models';
+import {crashhistory} from '../models';
+import {confighistory} from '../models';
+import {imageindex} from '../models';
+import {pullsecretcheck} from '../models';
+import {idleworkloads} from '../models';
+import {finishedcleanup} from '../models';
+import {manifestapply} from '../models';
+import {gitdrift} from '../models';
+import {clusterinfo} from '../models';
+import {volumesnapshot} from '../models';
+import {hpahistory} from '../models';
+
+export function AcknowledgeAlert(arg1:string):Promise<alerts.Alert>;
 
 export function AddFavorite(arg1:backend.Favorite):Promise<backend.Favorite>;
 
+export function AnalyzeNetworkPolicyConnectivity(arg1:string,arg2:networkpolicy.ConnectivityQuery):Promise<networkpolicy.ConnectivityResult>;
+
 export function ApplyClusterWorkspace(arg1:backend.ClusterWorkspaceCommand):Promise<backend.ClusterWorkspaceResult>;
 
+export function ApplyKustomization(arg1:string,arg2:string,arg3:manifestapply.Options):Promise<manifestapply.Report>;
+
+export function ApplyManifestDirectory(arg1:string,arg2:string,arg3:manifestapply.Options):Promise<manifestapply.Report>;
+
 export function ApplyObjectYaml(arg1:string,arg2:backend.ObjectYAMLMutationRequest):Promise<backend.ObjectYAMLMutationResponse>;
 
 export function ApplyTheme(arg1:string):Promise<void>;
 
+export function BackupNamespace(arg1:backend.NamespaceBackupRequest):Promise<backend.NamespaceBackupResult>;
+
+export function BuildKustomization(arg1:string):Promise<backend.KustomizeBuildResult>;
+
 export function CancelDrainNodeJob(arg1:string,arg2:string):Promise<void>;
 
+export function CancelFinishedCleanup(arg1:string):Promise<void>;
+
+export function CheckForUpdates():Promise<backend.UpdateInfo>;
+
+export function CheckGitDrift(arg1:string):Promise<Array<gitdrift.Report>>;
+
 export function CheckObjectYamlOwnership(arg1:string,arg2:backend.ObjectYAMLMutationRequest):Promise<backend.ObjectYAMLOwnershipCheckResponse>;
 
 export function ClearAllSSRRCaches():Promise<void>;
@@ -65,12 +100,18 @@ export function ClearAppState():Promise<void>;
 
 export function ClearGridTablePersistence():Promise<number>;
 
+export function ClearResolvedAlerts():Promise<void>;
+
 export function ClearSSRRCache(arg1:string):Promise<void>;
 
 export function CloseCluster(arg1:string):Promise<void>;
 
+export function CloseLocalTerminal(arg1:string):Promise<void>;
+
 export function CloseShellSession(arg1:string):Promise<void>;
 
+export function CreateVolumeSnapshot(arg1:string,arg2:volumesnapshot.CreateRequest):Promise<volumesnapshot.ActionResult>;
+
 export function CtxOrBackground():Promise<context.Context>;
 
 export function DeleteFavorite(arg1:string):Promise<void>;
@@ -79,10 +120,38 @@ export function DeleteGridTablePersistence(arg1:string):Promise<void>;
 
 export function DeleteGridTablePersistenceEntries(arg1:Array<string>):Promise<void>;
 
+export function DeleteStatefulSetOrdinal(arg1:string,arg2:string,arg3:string,arg4:number,arg5:string):Promise<string>;
+
 export function DeleteTheme(arg1:string):Promise<void>;
 
+export function DetectIdleWorkloads(arg1:string,arg2:idleworkloads.Options):Promise<idleworkloads.Report>;
+
+export function DiffConfigRevisions(arg1:string,arg2:string,arg3:string,arg4:string,arg5:number,arg6:number):Promise<confighistory.Diff>;
+
+export function DiffObjectAgainstLastApplied(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string):Promise<backend.ObjectAppliedDiff>;
+
+export function DiscardRecycleBinEntry(arg1:string):Promise<void>;
+
 export function DiscoverNodeLogs(arg1:string,arg2:string):Promise<types.NodeLogDiscoveryResponse>;
 
+export function DownloadPodFiles(arg1:string,arg2:types.PodFilesDownloadRequest):Promise<types.PodFilesDownloadResult>;
+
+export function DownloadUpdate():Promise<backend.UpdateInfo>;
+
+export function EditSecretValues(arg1:string,arg2:secret.SecretEditRequest):Promise<secret.SecretEditResult>;
+
+export function ExplainPodScheduling(arg1:string,arg2:string,arg3:string):Promise<pods.SchedulingExplanation>;
+
+export function ExportAppSettings():Promise<types.SettingsTransferResult>;
+
+export function ExportImageInventoryCSV(arg1:string):Promise<backend.CatalogQueryCSVExport>;
+
+export function ExportKeybindings():Promise<string>;
+
+export function ExportTableView(arg1:backend.TableExportRequest):Promise<backend.TableExportResult>;
+
+export function ExportYAMLBundle(arg1:backend.YAMLBundleExportRequest):Promise<backend.YAMLBundleExportResult>;
+
 export function FetchContainerLogs(arg1:string,arg2:types.ContainerLogsFetchRequest):Promise<types.ContainerLogsFetchResponse>;
 
 export function FetchNodeLogs(arg1:string,arg2:string,arg3:types.NodeLogFetchRequest):Promise<types.NodeLogFetchResponse>;
@@ -91,6 +160,14 @@ export function FindCatalogObjectByUID(arg1:string,arg2:string):Promise<objectca
 
 export function FindCatalogObjectMatch(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<objectcatalog.Summary>;
 
+export function ForceDeleteStatefulSetOrdinal(arg1:string,arg2:string,arg3:string,arg4:number,arg5:string):Promise<string>;
+
+export function GenerateDiagnosticBundle():Promise<backend.DiagnosticBundleResult>;
+
+export function GetAlertHistory():Promise<Array<alerts.Alert>>;
+
+export function GetAlertRules():Promise<Array<alerts.Rule>>;
+
 export function GetAppInfo():Promise<backend.AppInfo>;
 
 export function GetAppLogs():Promise<Array<backend.LogEntry>>;
@@ -105,16 +182,30 @@ export function GetAppearanceModeInfo():Promise<types.AppearanceModeInfo>;
 
 export function GetBackendTLSPolicy(arg1:string,arg2:string,arg3:string):Promise<backendtlspolicy.BackendTLSPolicyDetails>;
 
+export function GetCacheIntrospection(arg1:string):Promise<backend.CacheIntrospection>;
+
 export function GetCatalogDiagnostics():Promise<backend.CatalogDiagnostics>;
 
+export function GetCertificateExpiryReport(arg1:string,arg2:number):Promise<certexpiry.Report>;
+
 export function GetClusterAllowedNamespaces(arg1:string):Promise<Array<string>>;
 
 export function GetClusterAttentionIgnoreRules(arg1:string):Promise<snapshot.AttentionIgnoreRules>;
 
 export function GetClusterAuthState(arg1:string):Promise<string|string>;
 
+export function GetClusterClientLimits(arg1:string):Promise<backend.ClusterClientLimits>;
+
+export function GetClusterHealthSummary():Promise<Array<backend.ClusterHealthSummary>>;
+
+export function GetClusterInfo(arg1:string):Promise<clusterinfo.Info>;
+
+export function GetClusterNotificationsEnabled(arg1:string):Promise<boolean>;
+
 export function GetClusterPortForwardCount(arg1:string):Promise<number>;
 
+export function GetClusterReadOnly(arg1:string):Promise<boolean>;
+
 export function GetClusterRole(arg1:string,arg2:string):Promise<clusterrole.ClusterRoleDetails>;
 
 export function GetClusterRoleBinding(arg1:string,arg2:string):Promise<clusterrolebinding.ClusterRoleBindingDetails>;
@@ -125,14 +216,24 @@ export function GetClusterTabOrder():Promise<Array<string>>;
 
 export function GetClusterWorkspaceState():Promise<backend.ClusterWorkspaceState>;
 
+export function GetConfigHistory(arg1:string,arg2:string,arg3:string,arg4:string):Promise<confighistory.History>;
+
 export function GetConfigMap(arg1:string,arg2:string,arg3:string):Promise<configmap.ConfigMapDetails>;
 
+export function GetConfigMapValue(arg1:string,arg2:string,arg3:string,arg4:string,arg5:types.DataValueRange):Promise<types.DataValue>;
+
 export function GetContainerLogsScopeContainers(arg1:string,arg2:string):Promise<Array<string>>;
 
 export function GetCronJob(arg1:string,arg2:string,arg3:string):Promise<cronjob.CronJobDetails>;
 
+export function GetCustomActionsFile():Promise<backend.CustomActionsFileStatus>;
+
 export function GetCustomResourceDefinition(arg1:string,arg2:string):Promise<apiextensions.CustomResourceDefinitionDetails>;
 
+export function GetCustomResourceDefinitionSchema(arg1:string,arg2:string,arg3:string):Promise<apiextensions.CRDSchema>;
+
+export function GetCustomResourceSkeleton(arg1:string,arg2:string,arg3:string,arg4:string):Promise<string>;
+
 export function GetDaemonSet(arg1:string,arg2:string,arg3:string):Promise<daemonset.DaemonSetDetails>;
 
 export function GetDeployment(arg1:string,arg2:string,arg3:string):Promise<deployment.DeploymentDetails>;
@@ -149,8 +250,14 @@ export function GetGateway(arg1:string,arg2:string,arg3:string):Promise<gateway.
 
 export function GetGatewayClass(arg1:string,arg2:string):Promise<gatewayclass.GatewayClassDetails>;
 
+export function GetGitDriftReports(arg1:string):Promise<Array<gitdrift.Report>>;
+
+export function GetGitDriftSources(arg1:string):Promise<Array<gitdrift.Source>>;
+
 export function GetGridTablePersistence():Promise<Record<string, json.RawMessage>>;
 
+export function GetHPAScalingHistory(arg1:string,arg2:string,arg3:string):Promise<hpahistory.Timeline>;
+
 export function GetHTTPRoute(arg1:string,arg2:string,arg3:string):Promise<types.RouteDetails>;
 
 export function GetHelmManifest(arg1:string,arg2:string,arg3:string):Promise<string>;
@@ -161,12 +268,18 @@ export function GetHelmValues(arg1:string,arg2:string,arg3:string):Promise<Recor
 
 export function GetHorizontalPodAutoscaler(arg1:string,arg2:string,arg3:string):Promise<hpa.HorizontalPodAutoscalerDetails>;
 
+export function GetImageInventory(arg1:string):Promise<imageindex.Inventory>;
+
 export function GetIngress(arg1:string,arg2:string,arg3:string):Promise<ingress.IngressDetails>;
 
 export function GetIngressClass(arg1:string,arg2:string):Promise<ingressclass.IngressClassDetails>;
 
+export function GetIstioDetails(arg1:resourcemodel.ResourceRef):Promise<istio.Details>;
+
 export function GetJob(arg1:string,arg2:string,arg3:string):Promise<job.JobDetails>;
 
+export function GetKeybindings():Promise<Array<types.KeyBindingAction>>;
+
 export function GetKubeconfigSearchPaths():Promise<Array<string>>;
 
 export function GetKubeconfigs():Promise<Array<types.KubeconfigInfo>>;
@@ -177,6 +290,8 @@ export function GetLimitRange(arg1:string,arg2:string,arg3:string):Promise<limit
 
 export function GetListenerSet(arg1:string,arg2:string,arg3:string):Promise<listenerset.ListenerSetDetails>;
 
+export function GetLocalAPIStatus():Promise<backend.LocalAPIStatus>;
+
 export function GetMutatingWebhookConfiguration(arg1:string,arg2:string):Promise<admission.MutatingWebhookConfigurationDetails>;
 
 export function GetNamespace(arg1:string,arg2:string):Promise<namespaces.NamespaceDetails>;
@@ -185,26 +300,46 @@ export function GetNetworkPolicy(arg1:string,arg2:string,arg3:string):Promise<ne
 
 export function GetNode(arg1:string,arg2:string):Promise<nodes.NodeDetails>;
 
+export function GetNotificationSettings():Promise<notifications.Settings>;
+
+export function GetObjectFieldOwnership(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string):Promise<backend.ObjectFieldOwnership>;
+
 export function GetObjectYAMLByGVK(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string):Promise<string>;
 
 export function GetPersistentVolume(arg1:string,arg2:string):Promise<persistentvolume.PersistentVolumeDetails>;
 
 export function GetPersistentVolumeClaim(arg1:string,arg2:string,arg3:string):Promise<persistentvolumeclaim.PersistentVolumeClaimDetails>;
 
+export function GetPinnedSummaries(arg1:string):Promise<backend.PinnedSummaries>;
+
+export function GetPins(arg1:string):Promise<backend.ClusterPins>;
+
 export function GetPod(arg1:string,arg2:string,arg3:string,arg4:boolean):Promise<types.PodDetailInfo>;
 
 export function GetPodContainers(arg1:string,arg2:string,arg3:string):Promise<Array<string>>;
 
 export function GetPodDisruptionBudget(arg1:string,arg2:string,arg3:string):Promise<poddisruptionbudget.PodDisruptionBudgetDetails>;
 
+export function GetPodProcesses(arg1:string,arg2:types.PodContainerRequest):Promise<pods.ProcessList>;
+
+export function GetPodSecurityReport(arg1:string,arg2:string):Promise<podsecurity.Report>;
+
+export function GetPodSockets(arg1:string,arg2:types.PodContainerRequest):Promise<pods.SocketList>;
+
+export function GetRecycleBin():Promise<Array<backend.RecycleBinEntry>>;
+
 export function GetReferenceGrant(arg1:string,arg2:string,arg3:string):Promise<referencegrant.ReferenceGrantDetails>;
 
 export function GetRefreshBaseURL():Promise<string>;
 
+export function GetRelatedEvents(arg1:resourcemodel.ResourceRef,arg2:number):Promise<Array<events.Event>>;
+
 export function GetReplicaSet(arg1:string,arg2:string,arg3:string):Promise<replicaset.ReplicaSetDetails>;
 
 export function GetResourceQuota(arg1:string,arg2:string,arg3:string):Promise<resourcequota.ResourceQuotaDetails>;
 
+export function GetRevisionDiff(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string,arg7:number,arg8:number):Promise<backend.RevisionDiff>;
+
 export function GetRevisionHistory(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<Array<backend.RevisionEntry>>;
 
 export function GetRole(arg1:string,arg2:string,arg3:string):Promise<role.RoleDetails>;
@@ -213,6 +348,8 @@ export function GetRoleBinding(arg1:string,arg2:string,arg3:string):Promise<role
 
 export function GetSecret(arg1:string,arg2:string,arg3:string):Promise<secret.SecretDetails>;
 
+export function GetSecretRevealAuditLog(arg1:number):Promise<Array<backend.SecretRevealAuditRecord>>;
+
 export function GetSelectedKubeconfigs():Promise<Array<string>>;
 
 export function GetSelectionDiagnostics():Promise<backend.SelectionDiagnostics>;
@@ -221,6 +358,10 @@ export function GetService(arg1:string,arg2:string,arg3:string):Promise<service.
 
 export function GetServiceAccount(arg1:string,arg2:string,arg3:string):Promise<serviceaccount.ServiceAccountDetails>;
 
+export function GetSettingsSync():Promise<types.SettingsSyncStatus>;
+
+export function GetShellCommandRules():Promise<Array<backend.ShellCommandRule>>;
+
 export function GetShellSessionBacklog(arg1:string):Promise<string>;
 
 export function GetStatefulSet(arg1:string,arg2:string,arg3:string):Promise<statefulset.StatefulSetDetails>;
@@ -235,6 +376,14 @@ export function GetThemes():Promise<Array<types.Theme>>;
 
 export function GetValidatingWebhookConfiguration(arg1:string,arg2:string):Promise<admission.ValidatingWebhookConfigurationDetails>;
 
+export function GetVolumeSnapshotDetails(arg1:resourcemodel.ResourceRef):Promise<volumesnapshot.Details>;
+
+export function GetVolumeSnapshotSupport(arg1:string):Promise<volumesnapshot.Support>;
+
+export function GetWorkloadCrashHistory(arg1:string,arg2:string,arg3:string,arg4:string):Promise<crashhistory.Timeline>;
+
+export function GetWorkloadImageScan(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<backend.WorkloadImageScan>;
+
 export function GetZoomLevel():Promise<number>;
 
 export function HydrateCatalogCustomRows(arg1:string,arg2:Array<snapshot.ResourceQueryRow>):Promise<Array<snapshot.CustomResourceSummary>>;
@@ -245,14 +394,28 @@ export function IgnoreClusterAttentionObjectFinding(arg1:string,arg2:resourcemod
 
 export function IgnoreGlobalAttentionFindingType(arg1:string,arg2:string):Promise<snapshot.AttentionIgnoreRules>;
 
+export function ImportAppSettings():Promise<types.SettingsTransferResult>;
+
+export function ImportKeybindings(arg1:string):Promise<Array<types.KeyBindingAction>>;
+
+export function InvokeAction(arg1:backend.ActionInvokeRequest):Promise<backend.ActionInvokeResponse>;
+
 export function IsAppLogsPanelVisible():Promise<boolean>;
 
 export function IsDiagnosticsPanelVisible():Promise<boolean>;
 
+export function IsNamespaceProtected(arg1:string):Promise<boolean>;
+
 export function IsSidebarVisible():Promise<boolean>;
 
 export function IsWorkloadHPAManaged(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string):Promise<boolean>;
 
+export function ListActions():Promise<Array<backend.ActionDescriptor>>;
+
+export function ListCustomActions(arg1:resourcemodel.ResourceRef):Promise<Array<backend.ActionDescriptor>>;
+
+export function ListPodDirectory(arg1:string,arg2:types.PodFileRequest):Promise<pods.DirectoryListing>;
+
 export function ListPortForwards():Promise<Array<backend.PortForwardSession>>;
 
 export function ListRuntimeOperations():Promise<Array<backend.RuntimeOperation>>;
@@ -265,28 +428,74 @@ export function LogAppLogsFromFrontend(arg1:string,arg2:string,arg3:string):Prom
 
 export function LogAppLogsFromFrontendWithCluster(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string):Promise<void>;
 
+export function LookupClusterDNS(arg1:string,arg2:types.DNSLookupRequest):Promise<pods.DNSLookup>;
+
 export function MatchThemeForCluster(arg1:string):Promise<types.Theme>;
 
 export function MergeObjectYamlWithLatest(arg1:string,arg2:backend.ObjectYAMLReloadMergeRequest):Promise<backend.ObjectYAMLReloadMergeResponse>;
 
+export function OpenInExternalEditor(arg1:resourcemodel.ResourceRef):Promise<backend.ExternalEditSession>;
+
 export function OpenKubeconfigSearchPathDialog():Promise<string>;
 
+export function PreviewFinishedCleanup(arg1:string,arg2:finishedcleanup.Filter):Promise<backend.FinishedCleanupPreview>;
+
+export function PreviewKustomization(arg1:string,arg2:string,arg3:manifestapply.Options):Promise<manifestapply.Report>;
+
+export function PreviewManifestDirectory(arg1:string,arg2:string,arg3:manifestapply.Options):Promise<manifestapply.Report>;
+
+export function PreviewObjectActionCommand(arg1:backend.ObjectActionRequest):Promise<string>;
+
+export function PreviewPodFile(arg1:string,arg2:types.PodFileRequest):Promise<pods.FilePreview>;
+
 export function QueryPermissions(arg1:Array<capabilities.PermissionQuery>):Promise<capabilities.QueryPermissionsResponse>;
 
+export function ReconcileFluxObject(arg1:string,arg2:string,arg3:string,arg4:string):Promise<void>;
+
+export function RefreshExternalSecret(arg1:string,arg2:string,arg3:string):Promise<void>;
+
+export function RegenerateLocalAPIToken():Promise<backend.LocalAPIStatus>;
+
 export function ReorderThemes(arg1:Array<string>):Promise<void>;
 
+export function ResetKeybindings(arg1:Array<string>):Promise<Array<types.KeyBindingAction>>;
+
+export function ResizeLocalTerminal(arg1:string,arg2:number,arg3:number):Promise<void>;
+
+export function ResizePersistentVolumeClaim(arg1:string,arg2:string,arg3:string,arg4:string):Promise<persistentvolumeclaim.ResizeResult>;
+
 export function ResizeShellSession(arg1:string,arg2:number,arg3:number):Promise<void>;
 
+export function ResolveDeepLink(arg1:string):Promise<backend.DeepLinkTarget>;
+
+export function RestartToUpdate():Promise<void>;
+
 export function RestoreClusterAttentionFindingType(arg1:string,arg2:string):Promise<snapshot.AttentionIgnoreRules>;
 
 export function RestoreClusterAttentionObjectFinding(arg1:string,arg2:resourcemodel.ResourceRef,arg3:string):Promise<snapshot.AttentionIgnoreRules>;
 
+export function RestoreDeletedObject(arg1:string):Promise<backend.RecycleBinEntry>;
+
 export function RestoreGlobalAttentionFindingType(arg1:string,arg2:string):Promise<snapshot.AttentionIgnoreRules>;
 
+export function RestoreNamespaceBackup(arg1:backend.NamespaceRestoreRequest):Promise<backend.NamespaceRestoreResult>;
+
+export function RestoreVeleroBackup(arg1:string,arg2:string,arg3:string):Promise<resourcemodel.ResourceRef>;
+
+export function RestoreVolumeSnapshot(arg1:string,arg2:volumesnapshot.RestoreRequest):Promise<volumesnapshot.ActionResult>;
+
+export function ResumeFluxObject(arg1:string,arg2:string,arg3:string,arg4:string):Promise<void>;
+
 export function RetryAuth():Promise<void>;
 
 export function RetryClusterAuth(arg1:string):Promise<void>;
 
+export function RevealSecretValueRange(arg1:string,arg2:string,arg3:string,arg4:string,arg5:types.DataValueRange):Promise<types.DataValue>;
+
+export function RevealSecretValues(arg1:string,arg2:string,arg3:string,arg4:Array<string>):Promise<secret.SecretRevealResult>;
+
+export function RunBulkObjectAction(arg1:backend.BulkObjectActionRequest):Promise<backend.BulkObjectActionResponse>;
+
 export function RunObjectAction(arg1:backend.ObjectActionRequest):Promise<backend.ObjectActionResponse>;
 
 export function SaveCsvFile(arg1:string,arg2:string):Promise<backend.CatalogQueryCSVExport>;
@@ -295,10 +504,24 @@ export function SaveTheme(arg1:types.Theme):Promise<void>;
 
 export function SaveWindowSettings():Promise<void>;
 
+export function ScanWorkloadImages(arg1:string,arg2:string,arg3:string,arg4:string,arg5:string,arg6:string,arg7:boolean):Promise<backend.WorkloadImageScan>;
+
+export function SearchContainerImages(arg1:string,arg2:string):Promise<imageindex.Result>;
+
+export function SearchObjects(arg1:string,arg2:number):Promise<Array<backend.ObjectSearchResult>>;
+
+export function SelectKustomizationDirectory():Promise<string>;
+
+export function SelectManifestDirectory():Promise<string>;
+
+export function SendLocalTerminalInput(arg1:string,arg2:string):Promise<void>;
+
 export function SendShellInput(arg1:string,arg2:string):Promise<void>;
 
 export function SetAccentColor(arg1:string,arg2:string):Promise<void>;
 
+export function SetAlertRules(arg1:Array<alerts.Rule>):Promise<Array<alerts.Rule>>;
+
 export function SetAppLogsPanelVisible(arg1:boolean):Promise<void>;
 
 export function SetAppearanceMode(arg1:string):Promise<void>;
@@ -309,6 +532,12 @@ export function SetBackgroundRefreshEnabled(arg1:boolean):Promise<void>;
 
 export function SetClusterAllowedNamespaces(arg1:string,arg2:Array<string>):Promise<Array<string>>;
 
+export function SetClusterClientLimits(arg1:string,arg2:number,arg3:number,arg4:number):Promise<backend.ClusterClientLimits>;
+
+export function SetClusterNotificationsEnabled(arg1:string,arg2:boolean):Promise<void>;
+
+export function SetClusterReadOnly(arg1:string,arg2:boolean):Promise<void>;
+
 export function SetClusterTabOrder(arg1:Array<string>):Promise<void>;
 
 export function SetDefaultObjectPanelPosition(arg1:string):Promise<void>;
@@ -319,10 +548,14 @@ export function SetExclusiveNamespaces(arg1:boolean):Promise<void>;
 
 export function SetFavoriteOrder(arg1:Array<string>):Promise<void>;
 
+export function SetGitDriftSources(arg1:string,arg2:Array<gitdrift.Source>):Promise<Array<gitdrift.Source>>;
+
 export function SetGridTablePersistence(arg1:string,arg2:json.RawMessage):Promise<void>;
 
 export function SetGridTablePersistenceMode(arg1:string):Promise<void>;
 
+export function SetKeybindings(arg1:Array<types.KeyBinding>):Promise<Array<types.KeyBindingAction>>;
+
 export function SetKubeconfig(arg1:string):Promise<void>;
 
 export function SetKubeconfigSearchPaths(arg1:Array<string>):Promise<void>;
@@ -333,6 +566,14 @@ export function SetKubernetesClientQPS(arg1:number):Promise<void>;
 
 export function SetLinkColor(arg1:string,arg2:string):Promise<void>;
 
+export function SetLocalAPIEnabled(arg1:boolean,arg2:number):Promise<backend.LocalAPIStatus>;
+
+export function SetLocalAPIMetricsEnabled(arg1:boolean):Promise<backend.LocalAPIStatus>;
+
+export function SetNamespacePinned(arg1:string,arg2:string,arg3:boolean):Promise<backend.ClusterPins>;
+
+export function SetNotificationSettings(arg1:notifications.Settings):Promise<notifications.Settings>;
+
 export function SetObjPanelLogsAPITimestampFormat(arg1:string):Promise<void>;
 
 export function SetObjPanelLogsAPITimestampUseLocalTimeZone(arg1:boolean):Promise<void>;
@@ -349,10 +590,18 @@ export function SetPaletteTint(arg1:string,arg2:number,arg3:number,arg4:number):
 
 export function SetPermissionSSRRFetchConcurrency(arg1:number):Promise<void>;
 
+export function SetResourcePinned(arg1:string,arg2:backend.PinnedResource,arg3:boolean):Promise<backend.ClusterPins>;
+
 export function SetSelectedKubeconfigs(arg1:Array<string>):Promise<void>;
 
+export function SetSettingsSyncDirectory(arg1:string):Promise<types.SettingsSyncStatus>;
+
+export function SetShellCommandRules(arg1:Array<backend.ShellCommandRule>):Promise<Array<backend.ShellCommandRule>>;
+
 export function SetSidebarVisible(arg1:boolean):Promise<void>;
 
+export function SetStatefulSetPartition(arg1:string,arg2:string,arg3:string,arg4:number):Promise<void>;
+
 export function SetUseShortResourceNames(arg1:boolean):Promise<void>;
 
 export function SetVisibleCluster(arg1:string):Promise<void>;
@@ -363,6 +612,16 @@ export function ShowAbout():Promise<void>;
 
 export function ShowSettings():Promise<void>;
 
+export function SimulateNodeDrain(arg1:string,arg2:string,arg3:types.DrainNodeOptions):Promise<nodes.DrainSimulation>;
+
+export function StartConnectivityCheck(arg1:string,arg2:types.ConnectivityCheckRequest):Promise<types.ShellSession>;
+
+export function StartFinishedCleanup(arg1:string,arg2:finishedcleanup.Filter):Promise<string>;
+
+export function StartLocalTerminal(arg1:backend.LocalTerminalRequest):Promise<backend.LocalTerminalSession>;
+
+export function StartNodeLogDebugPod(arg1:string,arg2:string,arg3:types.NodeLogDebugPodRequest):Promise<types.NodeLogDiscoveryResponse>;
+
 export function StartShellSession(arg1:string,arg2:types.ShellSessionRequest):Promise<types.ShellSession>;
 
 export function Startup(arg1:context.Context):Promise<void>;
@@ -371,8 +630,18 @@ export function StopClusterPortForwards(arg1:string):Promise<void>;
 
 export function StopClusterShellSessions(arg1:string):Promise<void>;
 
+export function StopExternalEdit(arg1:string):Promise<void>;
+
+export function StopNodeLogDebugPod(arg1:string,arg2:string,arg3:types.NodeLogDebugPodRequest):Promise<void>;
+
 export function StopPortForward(arg1:string):Promise<void>;
 
+export function SuspendFluxObject(arg1:string,arg2:string,arg3:string,arg4:string):Promise<void>;
+
+export function TakePendingDeepLink():Promise<backend.DeepLinkTarget>;
+
+export function ThreeWayMergeObjectYaml(arg1:string,arg2:backend.ObjectYAMLReloadMergeRequest):Promise<backend.ObjectYAMLThreeWayMergeResponse>;
+
 export function ToggleAppLogsPanel():Promise<void>;
 
 export function ToggleDiagnosticsPanel():Promise<void>;
@@ -381,6 +650,10 @@ export function ToggleObjectDiff():Promise<void>;
 
 export function ToggleSidebar():Promise<void>;
 
+export function TriggerVeleroNamespaceBackup(arg1:string,arg2:string):Promise<resourcemodel.ResourceRef>;
+
+export function TriggerVeleroScheduleBackup(arg1:string,arg2:string,arg3:string):Promise<resourcemodel.ResourceRef>;
+
 export function UpdateAppPreferences(arg1:types.UpdateAppPreferencesRequest):Promise<types.UpdateAppPreferencesResponse>;
 
 export function UpdateFavorite(arg1:backend.Favorite):Promise<void>;
@@ -392,3 +665,5 @@ export function ValidateObjectYaml(arg1:string,arg2:backend.ObjectYAMLMutationRe
 export function ValidatePortForwardURL(arg1:string):Promise<boolean|string>;
 
 export function ValidateThemeClusterPattern(arg1:string):Promise<types.ThemeClusterPatternValidationResult>;
+
+export function VerifyImagePullSecrets(arg1:string,arg2:pullsecretcheck.Request):Promise<pullsecretcheck.Report>;
@@ -2,14 +2,30 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcknowledgeAlert(arg1) {
+  return window['go']['backend']['App']['AcknowledgeAlert'](arg1);
+}
+
 export function AddFavorite(arg1) {
   return window['go']['backend']['App']['AddFavorite'](arg1);
 }
 
+export function AnalyzeNetworkPolicyConnectivity(arg1, arg2) {
+  return window['go']['backend']['App']['AnalyzeNetworkPolicyConnectivity'](arg1, arg2);
+}
+
 export function ApplyClusterWorkspace(arg1) {
   return window['go']['backend']['App']['ApplyClusterWorkspace'](arg1);
 }
 
+export function ApplyKustomization(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['ApplyKustomization'](arg1, arg2, arg3);
+}
+
+export function ApplyManifestDirectory(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['ApplyManifestDirectory'](arg1, arg2, arg3);
+}
+
 export function ApplyObjectYaml(arg1, arg2) {
   return window['go']['backend']['App']['ApplyObjectYaml'](arg1, arg2);
 }
@@ -18,10 +34,30 @@ export function ApplyTheme(arg1) {
   return window['go']['backend']['App']['ApplyTheme'](arg1);
 }
 
+export function BackupNamespace(arg1) {
+  return window['go']['backend']['App']['BackupNamespace'](arg1);
+}
+
+export function BuildKustomization(arg1) {
+  return window['go']['backend']['App']['BuildKustomization'](arg1);
+}
+
 export function CancelDrainNodeJob(arg1, arg2) {
   return window['go']['backend']['App']['CancelDrainNodeJob'](arg1, arg2);
 }
 
+export function CancelFinishedCleanup(arg1) {
+  return window['go']['backend']['App']['CancelFinishedCleanup'](arg1);
+}
+
+export function CheckForUpdates() {
+  return window['go']['backend']['App']['CheckForUpdates']();
+}
+
+export function CheckGitDrift(arg1) {
+  return window['go']['backend']['App']['CheckGitDrift'](arg1);
+}
+
 export function CheckObjectYamlOwnership(arg1, arg2) {
   return window['go']['backend']['App']['CheckObjectYamlOwnership'](arg1, arg2);
 }
@@ -42,6 +78,10 @@ export function ClearGridTablePersistence() {
   return window['go']['backend']['App']['ClearGridTablePersistence']();
 }
 
+export function ClearResolvedAlerts() {
+  return window['go']['backend']['App']['ClearResolvedAlerts']();
+}
+
 export function ClearSSRRCache(arg1) {
   return window['go']['backend']['App']['ClearSSRRCache'](arg1);
 }
@@ -50,10 +90,18 @@ export function CloseCluster(arg1) {
   return window['go']['backend']['App']['CloseCluster'](arg1);
 }
 
+export function CloseLocalTerminal(arg1) {
+  return window['go']['backend']['App']['CloseLocalTerminal'](arg1);
+}
+
 export function CloseShellSession(arg1) {
   return window['go']['backend']['App']['CloseShellSession'](arg1);
 }
 
+export function CreateVolumeSnapshot(arg1, arg2) {
+  return window['go']['backend']['App']['CreateVolumeSnapshot'](arg1, arg2);
+}
+
 export function CtxOrBackground() {
   return window['go']['backend']['App']['CtxOrBackground']();
 }
@@ -70,14 +118,70 @@ export function DeleteGridTablePersistenceEntries(arg1) {
   return window['go']['backend']['App']['DeleteGridTablePersistenceEntries'](arg1);
 }
 
+export function DeleteStatefulSetOrdinal(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['DeleteStatefulSetOrdinal'](arg1, arg2, arg3, arg4, arg5);
+}
+
 export function DeleteTheme(arg1) {
   return window['go']['backend']['App']['DeleteTheme'](arg1);
 }
 
+export function DetectIdleWorkloads(arg1, arg2) {
+  return window['go']['backend']['App']['DetectIdleWorkloads'](arg1, arg2);
+}
+
+export function DiffConfigRevisions(arg1, arg2, arg3, arg4, arg5, arg6) {
+  return window['go']['backend']['App']['DiffConfigRevisions'](arg1, arg2, arg3, arg4, arg5, arg6);
+}
+
+export function DiffObjectAgainstLastApplied(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['DiffObjectAgainstLastApplied'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function DiscardRecycleBinEntry(arg1) {
+  return window['go']['backend']['App']['DiscardRecycleBinEntry'](arg1);
+}
+
 export function DiscoverNodeLogs(arg1, arg2) {
   return window['go']['backend']['App']['DiscoverNodeLogs'](arg1, arg2);
 }
 
+export function DownloadPodFiles(arg1, arg2) {
+  return window['go']['backend']['App']['DownloadPodFiles'](arg1, arg2);
+}
+
+export function DownloadUpdate() {
+  return window['go']['backend']['App']['DownloadUpdate']();
+}
+
+export function EditSecretValues(arg1, arg2) {
+  return window['go']['backend']['App']['EditSecretValues'](arg1, arg2);
+}
+
+export function ExplainPodScheduling(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['ExplainPodScheduling'](arg1, arg2, arg3);
+}
+
+export function ExportAppSettings() {
+  return window['go']['backend']['App']['ExportAppSettings']();
+}
+
+export function ExportImageInventoryCSV(arg1) {
+  return window['go']['backend']['App']['ExportImageInventoryCSV'](arg1);
+}
+
+export function ExportKeybindings() {
+  return window['go']['backend']['App']['ExportKeybindings']();
+}
+
+export function ExportTableView(arg1) {
+  return window['go']['backend']['App']['ExportTableView'](arg1);
+}
+
+export function ExportYAMLBundle(arg1) {
+  return window['go']['backend']['App']['ExportYAMLBundle'](arg1);
+}
+
 export function FetchContainerLogs(arg1, arg2) {
   return window['go']['backend']['App']['FetchContainerLogs'](arg1, arg2);
 }
@@ -94,6 +198,22 @@ export function FindCatalogObjectMatch(arg1, arg2, arg3, arg4, arg5, arg6) {
   return window['go']['backend']['App']['FindCatalogObjectMatch'](arg1, arg2, arg3, arg4, arg5, arg6);
 }
 
+export function ForceDeleteStatefulSetOrdinal(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['ForceDeleteStatefulSetOrdinal'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function GenerateDiagnosticBundle() {
+  return window['go']['backend']['App']['GenerateDiagnosticBundle']();
+}
+
+export function GetAlertHistory() {
+  return window['go']['backend']['App']['GetAlertHistory']();
+}
+
+export function GetAlertRules() {
+  return window['go']['backend']['App']['GetAlertRules']();
+}
+
 export function GetAppInfo() {
   return window['go']['backend']['App']['GetAppInfo']();
 }
@@ -122,10 +242,18 @@ export function GetBackendTLSPolicy(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetBackendTLSPolicy'](arg1, arg2, arg3);
 }
 
+export function GetCacheIntrospection(arg1) {
+  return window['go']['backend']['App']['GetCacheIntrospection'](arg1);
+}
+
 export function GetCatalogDiagnostics() {
   return window['go']['backend']['App']['GetCatalogDiagnostics']();
 }
 
+export function GetCertificateExpiryReport(arg1, arg2) {
+  return window['go']['backend']['App']['GetCertificateExpiryReport'](arg1, arg2);
+}
+
 export function GetClusterAllowedNamespaces(arg1) {
   return window['go']['backend']['App']['GetClusterAllowedNamespaces'](arg1);
 }
@@ -138,10 +266,30 @@ export function GetClusterAuthState(arg1) {
   return window['go']['backend']['App']['GetClusterAuthState'](arg1);
 }
 
+export function GetClusterClientLimits(arg1) {
+  return window['go']['backend']['App']['GetClusterClientLimits'](arg1);
+}
+
+export function GetClusterHealthSummary() {
+  return window['go']['backend']['App']['GetClusterHealthSummary']();
+}
+
+export function GetClusterInfo(arg1) {
+  return window['go']['backend']['App']['GetClusterInfo'](arg1);
+}
+
+export function GetClusterNotificationsEnabled(arg1) {
+  return window['go']['backend']['App']['GetClusterNotificationsEnabled'](arg1);
+}
+
 export function GetClusterPortForwardCount(arg1) {
   return window['go']['backend']['App']['GetClusterPortForwardCount'](arg1);
 }
 
+export function GetClusterReadOnly(arg1) {
+  return window['go']['backend']['App']['GetClusterReadOnly'](arg1);
+}
+
 export function GetClusterRole(arg1, arg2) {
   return window['go']['backend']['App']['GetClusterRole'](arg1, arg2);
 }
@@ -162,10 +310,18 @@ export function GetClusterWorkspaceState() {
   return window['go']['backend']['App']['GetClusterWorkspaceState']();
 }
 
+export function GetConfigHistory(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['GetConfigHistory'](arg1, arg2, arg3, arg4);
+}
+
 export function GetConfigMap(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetConfigMap'](arg1, arg2, arg3);
 }
 
+export function GetConfigMapValue(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['GetConfigMapValue'](arg1, arg2, arg3, arg4, arg5);
+}
+
 export function GetContainerLogsScopeContainers(arg1, arg2) {
   return window['go']['backend']['App']['GetContainerLogsScopeContainers'](arg1, arg2);
 }
@@ -174,10 +330,22 @@ export function GetCronJob(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetCronJob'](arg1, arg2, arg3);
 }
 
+export function GetCustomActionsFile() {
+  return window['go']['backend']['App']['GetCustomActionsFile']();
+}
+
 export function GetCustomResourceDefinition(arg1, arg2) {
   return window['go']['backend']['App']['GetCustomResourceDefinition'](arg1, arg2);
 }
 
+export function GetCustomResourceDefinitionSchema(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['GetCustomResourceDefinitionSchema'](arg1, arg2, arg3);
+}
+
+export function GetCustomResourceSkeleton(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['GetCustomResourceSkeleton'](arg1, arg2, arg3, arg4);
+}
+
 export function GetDaemonSet(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetDaemonSet'](arg1, arg2, arg3);
 }
@@ -210,10 +378,22 @@ export function GetGatewayClass(arg1, arg2) {
   return window['go']['backend']['App']['GetGatewayClass'](arg1, arg2);
 }
 
+export function GetGitDriftReports(arg1) {
+  return window['go']['backend']['App']['GetGitDriftReports'](arg1);
+}
+
+export function GetGitDriftSources(arg1) {
+  return window['go']['backend']['App']['GetGitDriftSources'](arg1);
+}
+
 export function GetGridTablePersistence() {
   return window['go']['backend']['App']['GetGridTablePersistence']();
 }
 
+export function GetHPAScalingHistory(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['GetHPAScalingHistory'](arg1, arg2, arg3);
+}
+
 export function GetHTTPRoute(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetHTTPRoute'](arg1, arg2, arg3);
 }
@@ -234,6 +414,10 @@ export function GetHorizontalPodAutoscaler(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetHorizontalPodAutoscaler'](arg1, arg2, arg3);
 }
 
+export function GetImageInventory(arg1) {
+  return window['go']['backend']['App']['GetImageInventory'](arg1);
+}
+
 export function GetIngress(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetIngress'](arg1, arg2, arg3);
 }
@@ -242,10 +426,18 @@ export function GetIngressClass(arg1, arg2) {
   return window['go']['backend']['App']['GetIngressClass'](arg1, arg2);
 }
 
+export function GetIstioDetails(arg1) {
+  return window['go']['backend']['App']['GetIstioDetails'](arg1);
+}
+
 export function GetJob(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetJob'](arg1, arg2, arg3);
 }
 
+export function GetKeybindings() {
+  return window['go']['backend']['App']['GetKeybindings']();
+}
+
 export function GetKubeconfigSearchPaths() {
   return window['go']['backend']['App']['GetKubeconfigSearchPaths']();
 }
@@ -266,6 +458,10 @@ export function GetListenerSet(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetListenerSet'](arg1, arg2, arg3);
 }
 
+export function GetLocalAPIStatus() {
+  return window['go']['backend']['App']['GetLocalAPIStatus']();
+}
+
 export function GetMutatingWebhookConfiguration(arg1, arg2) {
   return window['go']['backend']['App']['GetMutatingWebhookConfiguration'](arg1, arg2);
 }
@@ -282,6 +478,14 @@ export function GetNode(arg1, arg2) {
   return window['go']['backend']['App']['GetNode'](arg1, arg2);
 }
 
+export function GetNotificationSettings() {
+  return window['go']['backend']['App']['GetNotificationSettings']();
+}
+
+export function GetObjectFieldOwnership(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['GetObjectFieldOwnership'](arg1, arg2, arg3, arg4, arg5);
+}
+
 export function GetObjectYAMLByGVK(arg1, arg2, arg3, arg4, arg5) {
   return window['go']['backend']['App']['GetObjectYAMLByGVK'](arg1, arg2, arg3, arg4, arg5);
 }
@@ -294,6 +498,14 @@ export function GetPersistentVolumeClaim(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetPersistentVolumeClaim'](arg1, arg2, arg3);
 }
 
+export function GetPinnedSummaries(arg1) {
+  return window['go']['backend']['App']['GetPinnedSummaries'](arg1);
+}
+
+export function GetPins(arg1) {
+  return window['go']['backend']['App']['GetPins'](arg1);
+}
+
 export function GetPod(arg1, arg2, arg3, arg4) {
   return window['go']['backend']['App']['GetPod'](arg1, arg2, arg3, arg4);
 }
@@ -306,6 +518,22 @@ export function GetPodDisruptionBudget(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetPodDisruptionBudget'](arg1, arg2, arg3);
 }
 
+export function GetPodProcesses(arg1, arg2) {
+  return window['go']['backend']['App']['GetPodProcesses'](arg1, arg2);
+}
+
+export function GetPodSecurityReport(arg1, arg2) {
+  return window['go']['backend']['App']['GetPodSecurityReport'](arg1, arg2);
+}
+
+export function GetPodSockets(arg1, arg2) {
+  return window['go']['backend']['App']['GetPodSockets'](arg1, arg2);
+}
+
+export function GetRecycleBin() {
+  return window['go']['backend']['App']['GetRecycleBin']();
+}
+
 export function GetReferenceGrant(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetReferenceGrant'](arg1, arg2, arg3);
 }
@@ -314,6 +542,10 @@ export function GetRefreshBaseURL() {
   return window['go']['backend']['App']['GetRefreshBaseURL']();
 }
 
+export function GetRelatedEvents(arg1, arg2) {
+  return window['go']['backend']['App']['GetRelatedEvents'](arg1, arg2);
+}
+
 export function GetReplicaSet(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetReplicaSet'](arg1, arg2, arg3);
 }
@@ -322,6 +554,10 @@ export function GetResourceQuota(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetResourceQuota'](arg1, arg2, arg3);
 }
 
+export function GetRevisionDiff(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8) {
+  return window['go']['backend']['App']['GetRevisionDiff'](arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8);
+}
+
 export function GetRevisionHistory(arg1, arg2, arg3, arg4, arg5, arg6) {
   return window['go']['backend']['App']['GetRevisionHistory'](arg1, arg2, arg3, arg4, arg5, arg6);
 }
@@ -338,6 +574,10 @@ export function GetSecret(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetSecret'](arg1, arg2, arg3);
 }
 
+export function GetSecretRevealAuditLog(arg1) {
+  return window['go']['backend']['App']['GetSecretRevealAuditLog'](arg1);
+}
+
 export function GetSelectedKubeconfigs() {
   return window['go']['backend']['App']['GetSelectedKubeconfigs']();
 }
@@ -354,6 +594,14 @@ export function GetServiceAccount(arg1, arg2, arg3) {
   return window['go']['backend']['App']['GetServiceAccount'](arg1, arg2, arg3);
 }
 
+export function GetSettingsSync() {
+  return window['go']['backend']['App']['GetSettingsSync']();
+}
+
+export function GetShellCommandRules() {
+  return window['go']['backend']['App']['GetShellCommandRules']();
+}
+
 export function GetShellSessionBacklog(arg1) {
   return window['go']['backend']['App']['GetShellSessionBacklog'](arg1);
 }
@@ -382,6 +630,22 @@ export function GetValidatingWebhookConfiguration(arg1, arg2) {
   return window['go']['backend']['App']['GetValidatingWebhookConfiguration'](arg1, arg2);
 }
 
+export function GetVolumeSnapshotDetails(arg1) {
+  return window['go']['backend']['App']['GetVolumeSnapshotDetails'](arg1);
+}
+
+export function GetVolumeSnapshotSupport(arg1) {
+  return window['go']['backend']['App']['GetVolumeSnapshotSupport'](arg1);
+}
+
+export function GetWorkloadCrashHistory(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['GetWorkloadCrashHistory'](arg1, arg2, arg3, arg4);
+}
+
+export function GetWorkloadImageScan(arg1, arg2, arg3, arg4, arg5, arg6) {
+  return window['go']['backend']['App']['GetWorkloadImageScan'](arg1, arg2, arg3, arg4, arg5, arg6);
+}
+
 export function GetZoomLevel() {
   return window['go']['backend']['App']['GetZoomLevel']();
 }
@@ -402,6 +666,18 @@ export function IgnoreGlobalAttentionFindingType(arg1, arg2) {
   return window['go']['backend']['App']['IgnoreGlobalAttentionFindingType'](arg1, arg2);
 }
 
+export function ImportAppSettings() {
+  return window['go']['backend']['App']['ImportAppSettings']();
+}
+
+export function ImportKeybindings(arg1) {
+  return window['go']['backend']['App']['ImportKeybindings'](arg1);
+}
+
+export function InvokeAction(arg1) {
+  return window['go']['backend']['App']['InvokeAction'](arg1);
+}
+
 export function IsAppLogsPanelVisible() {
   return window['go']['backend']['App']['IsAppLogsPanelVisible']();
 }
@@ -410,6 +686,10 @@ export function IsDiagnosticsPanelVisible() {
   return window['go']['backend']['App']['IsDiagnosticsPanelVisible']();
 }
 
+export function IsNamespaceProtected(arg1) {
+  return window['go']['backend']['App']['IsNamespaceProtected'](arg1);
+}
+
 export function IsSidebarVisible() {
   return window['go']['backend']['App']['IsSidebarVisible']();
 }
@@ -418,6 +698,18 @@ export function IsWorkloadHPAManaged(arg1, arg2, arg3, arg4, arg5, arg6) {
   return window['go']['backend']['App']['IsWorkloadHPAManaged'](arg1, arg2, arg3, arg4, arg5, arg6);
 }
 
+export function ListActions() {
+  return window['go']['backend']['App']['ListActions']();
+}
+
+export function ListCustomActions(arg1) {
+  return window['go']['backend']['App']['ListCustomActions'](arg1);
+}
+
+export function ListPodDirectory(arg1, arg2) {
+  return window['go']['backend']['App']['ListPodDirectory'](arg1, arg2);
+}
+
 export function ListPortForwards() {
   return window['go']['backend']['App']['ListPortForwards']();
 }
@@ -442,6 +734,10 @@ export function LogAppLogsFromFrontendWithCluster(arg1, arg2, arg3, arg4, arg5)
   return window['go']['backend']['App']['LogAppLogsFromFrontendWithCluster'](arg1, arg2, arg3, arg4, arg5);
 }
 
+export function LookupClusterDNS(arg1, arg2) {
+  return window['go']['backend']['App']['LookupClusterDNS'](arg1, arg2);
+}
+
 export function MatchThemeForCluster(arg1) {
   return window['go']['backend']['App']['MatchThemeForCluster'](arg1);
 }
@@ -450,22 +746,78 @@ export function MergeObjectYamlWithLatest(arg1, arg2) {
   return window['go']['backend']['App']['MergeObjectYamlWithLatest'](arg1, arg2);
 }
 
+export function OpenInExternalEditor(arg1) {
+  return window['go']['backend']['App']['OpenInExternalEditor'](arg1);
+}
+
 export function OpenKubeconfigSearchPathDialog() {
   return window['go']['backend']['App']['OpenKubeconfigSearchPathDialog']();
 }
 
+export function PreviewFinishedCleanup(arg1, arg2) {
+  return window['go']['backend']['App']['PreviewFinishedCleanup'](arg1, arg2);
+}
+
+export function PreviewKustomization(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['PreviewKustomization'](arg1, arg2, arg3);
+}
+
+export function PreviewManifestDirectory(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['PreviewManifestDirectory'](arg1, arg2, arg3);
+}
+
+export function PreviewObjectActionCommand(arg1) {
+  return window['go']['backend']['App']['PreviewObjectActionCommand'](arg1);
+}
+
+export function PreviewPodFile(arg1, arg2) {
+  return window['go']['backend']['App']['PreviewPodFile'](arg1, arg2);
+}
+
 export function QueryPermissions(arg1) {
   return window['go']['backend']['App']['QueryPermissions'](arg1);
 }
 
+export function ReconcileFluxObject(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['ReconcileFluxObject'](arg1, arg2, arg3, arg4);
+}
+
+export function RefreshExternalSecret(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['RefreshExternalSecret'](arg1, arg2, arg3);
+}
+
+export function RegenerateLocalAPIToken() {
+  return window['go']['backend']['App']['RegenerateLocalAPIToken']();
+}
+
 export function ReorderThemes(arg1) {
   return window['go']['backend']['App']['ReorderThemes'](arg1);
 }
 
+export function ResetKeybindings(arg1) {
+  return window['go']['backend']['App']['ResetKeybindings'](arg1);
+}
+
+export function ResizeLocalTerminal(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['ResizeLocalTerminal'](arg1, arg2, arg3);
+}
+
+export function ResizePersistentVolumeClaim(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['ResizePersistentVolumeClaim'](arg1, arg2, arg3, arg4);
+}
+
 export function ResizeShellSession(arg1, arg2, arg3) {
   return window['go']['backend']['App']['ResizeShellSession'](arg1, arg2, arg3);
 }
 
+export function ResolveDeepLink(arg1) {
+  return window['go']['backend']['App']['ResolveDeepLink'](arg1);
+}
+
+export function RestartToUpdate() {
+  return window['go']['backend']['App']['RestartToUpdate']();
+}
+
 export function RestoreClusterAttentionFindingType(arg1, arg2) {
   return window['go']['backend']['App']['RestoreClusterAttentionFindingType'](arg1, arg2);
 }
@@ -474,10 +826,30 @@ export function RestoreClusterAttentionObjectFinding(arg1, arg2, arg3) {
   return window['go']['backend']['App']['RestoreClusterAttentionObjectFinding'](arg1, arg2, arg3);
 }
 
+export function RestoreDeletedObject(arg1) {
+  return window['go']['backend']['App']['RestoreDeletedObject'](arg1);
+}
+
 export function RestoreGlobalAttentionFindingType(arg1, arg2) {
   return window['go']['backend']['App']['RestoreGlobalAttentionFindingType'](arg1, arg2);
 }
 
+export function RestoreNamespaceBackup(arg1) {
+  return window['go']['backend']['App']['RestoreNamespaceBackup'](arg1);
+}
+
+export function RestoreVeleroBackup(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['RestoreVeleroBackup'](arg1, arg2, arg3);
+}
+
+export function RestoreVolumeSnapshot(arg1, arg2) {
+  return window['go']['backend']['App']['RestoreVolumeSnapshot'](arg1, arg2);
+}
+
+export function ResumeFluxObject(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['ResumeFluxObject'](arg1, arg2, arg3, arg4);
+}
+
 export function RetryAuth() {
   return window['go']['backend']['App']['RetryAuth']();
 }
@@ -486,6 +858,18 @@ export function RetryClusterAuth(arg1) {
   return window['go']['backend']['App']['RetryClusterAuth'](arg1);
 }
 
+export function RevealSecretValueRange(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['backend']['App']['RevealSecretValueRange'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function RevealSecretValues(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['RevealSecretValues'](arg1, arg2, arg3, arg4);
+}
+
+export function RunBulkObjectAction(arg1) {
+  return window['go']['backend']['App']['RunBulkObjectAction'](arg1);
+}
+
 export function RunObjectAction(arg1) {
   return window['go']['backend']['App']['RunObjectAction'](arg1);
 }
@@ -502,6 +886,30 @@ export function SaveWindowSettings() {
   return window['go']['backend']['App']['SaveWindowSettings']();
 }
 
+export function ScanWorkloadImages(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
+  return window['go']['backend']['App']['ScanWorkloadImages'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
+}
+
+export function SearchContainerImages(arg1, arg2) {
+  return window['go']['backend']['App']['SearchContainerImages'](arg1, arg2);
+}
+
+export function SearchObjects(arg1, arg2) {
+  return window['go']['backend']['App']['SearchObjects'](arg1, arg2);
+}
+
+export function SelectKustomizationDirectory() {
+  return window['go']['backend']['App']['SelectKustomizationDirectory']();
+}
+
+export function SelectManifestDirectory() {
+  return window['go']['backend']['App']['SelectManifestDirectory']();
+}
+
+export function SendLocalTerminalInput(arg1, arg2) {
+  return window['go']['backend']['App']['SendLocalTerminalInput'](arg1, arg2);
+}
+
 export function SendShellInput(arg1, arg2) {
   return window['go']['backend']['App']['SendShellInput'](arg1, arg2);
 }
@@ -510,6 +918,10 @@ export function SetAccentColor(arg1, arg2) {
   return window['go']['backend']['App']['SetAccentColor'](arg1, arg2);
 }
 
+export function SetAlertRules(arg1) {
+  return window['go']['backend']['App']['SetAlertRules'](arg1);
+}
+
 export function SetAppLogsPanelVisible(arg1) {
   return window['go']['backend']['App']['SetAppLogsPanelVisible'](arg1);
 }
@@ -530,6 +942,18 @@ export function SetClusterAllowedNamespaces(arg1, arg2) {
   return window['go']['backend']['App']['SetClusterAllowedNamespaces'](arg1, arg2);
 }
 
+export function SetClusterClientLimits(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['SetClusterClientLimits'](arg1, arg2, arg3, arg4);
+}
+
+export function SetClusterNotificationsEnabled(arg1, arg2) {
+  return window['go']['backend']['App']['SetClusterNotificationsEnabled'](arg1, arg2);
+}
+
+export function SetClusterReadOnly(arg1, arg2) {
+  return window['go']['backend']['App']['SetClusterReadOnly'](arg1, arg2);
+}
+
 export function SetClusterTabOrder(arg1) {
   return window['go']['backend']['App']['SetClusterTabOrder'](arg1);
 }
@@ -550,6 +974,10 @@ export function SetFavoriteOrder(arg1) {
   return window['go']['backend']['App']['SetFavoriteOrder'](arg1);
 }
 
+export function SetGitDriftSources(arg1, arg2) {
+  return window['go']['backend']['App']['SetGitDriftSources'](arg1, arg2);
+}
+
 export function SetGridTablePersistence(arg1, arg2) {
   return window['go']['backend']['App']['SetGridTablePersistence'](arg1, arg2);
 }
@@ -558,6 +986,10 @@ export function SetGridTablePersistenceMode(arg1) {
   return window['go']['backend']['App']['SetGridTablePersistenceMode'](arg1);
 }
 
+export function SetKeybindings(arg1) {
+  return window['go']['backend']['App']['SetKeybindings'](arg1);
+}
+
 export function SetKubeconfig(arg1) {
   return window['go']['backend']['App']['SetKubeconfig'](arg1);
 }
@@ -578,6 +1010,22 @@ export function SetLinkColor(arg1, arg2) {
   return window['go']['backend']['App']['SetLinkColor'](arg1, arg2);
 }
 
+export function SetLocalAPIEnabled(arg1, arg2) {
+  return window['go']['backend']['App']['SetLocalAPIEnabled'](arg1, arg2);
+}
+
+export function SetLocalAPIMetricsEnabled(arg1) {
+  return window['go']['backend']['App']['SetLocalAPIMetricsEnabled'](arg1);
+}
+
+export function SetNamespacePinned(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['SetNamespacePinned'](arg1, arg2, arg3);
+}
+
+export function SetNotificationSettings(arg1) {
+  return window['go']['backend']['App']['SetNotificationSettings'](arg1);
+}
+
 export function SetObjPanelLogsAPITimestampFormat(arg1) {
   return window['go']['backend']['App']['SetObjPanelLogsAPITimestampFormat'](arg1);
 }
@@ -610,14 +1058,30 @@ export function SetPermissionSSRRFetchConcurrency(arg1) {
   return window['go']['backend']['App']['SetPermissionSSRRFetchConcurrency'](arg1);
 }
 
+export function SetResourcePinned(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['SetResourcePinned'](arg1, arg2, arg3);
+}
+
 export function SetSelectedKubeconfigs(arg1) {
   return window['go']['backend']['App']['SetSelectedKubeconfigs'](arg1);
 }
 
+export function SetSettingsSyncDirectory(arg1) {
+  return window['go']['backend']['App']['SetSettingsSyncDirectory'](arg1);
+}
+
+export function SetShellCommandRules(arg1) {
+  return window['go']['backend']['App']['SetShellCommandRules'](arg1);
+}
+
 export function SetSidebarVisible(arg1) {
   return window['go']['backend']['App']['SetSidebarVisible'](arg1);
 }
 
+export function SetStatefulSetPartition(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['SetStatefulSetPartition'](arg1, arg2, arg3, arg4);
+}
+
 export function SetUseShortResourceNames(arg1) {
   return window['go']['backend']['App']['SetUseShortResourceNames'](arg1);
 }
@@ -638,6 +1102,26 @@ export function ShowSettings() {
   return window['go']['backend']['App']['ShowSettings']();
 }
 
+export function SimulateNodeDrain(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['SimulateNodeDrain'](arg1, arg2, arg3);
+}
+
+export function StartConnectivityCheck(arg1, arg2) {
+  return window['go']['backend']['App']['StartConnectivityCheck'](arg1, arg2);
+}
+
+export function StartFinishedCleanup(arg1, arg2) {
+  return window['go']['backend']['App']['StartFinishedCleanup'](arg1, arg2);
+}
+
+export function StartLocalTerminal(arg1) {
+  return window['go']['backend']['App']['StartLocalTerminal'](arg1);
+}
+
+export function StartNodeLogDebugPod(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['StartNodeLogDebugPod'](arg1, arg2, arg3);
+}
+
 export function StartShellSession(arg1, arg2) {
   return window['go']['backend']['App']['StartShellSession'](arg1, arg2);
 }
@@ -654,10 +1138,30 @@ export function StopClusterShellSessions(arg1) {
   return window['go']['backend']['App']['StopClusterShellSessions'](arg1);
 }
 
+export function StopExternalEdit(arg1) {
+  return window['go']['backend']['App']['StopExternalEdit'](arg1);
+}
+
+export function StopNodeLogDebugPod(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['StopNodeLogDebugPod'](arg1, arg2, arg3);
+}
+
 export function StopPortForward(arg1) {
   return window['go']['backend']['App']['StopPortForward'](arg1);
 }
 
+export function SuspendFluxObject(arg1, arg2, arg3, arg4) {
+  return window['go']['backend']['App']['SuspendFluxObject'](arg1, arg2, arg3, arg4);
+}
+
+export function TakePendingDeepLink() {
+  return window['go']['backend']['App']['TakePendingDeepLink']();
+}
+
+export function ThreeWayMergeObjectYaml(arg1, arg2) {
+  return window['go']['backend']['App']['ThreeWayMergeObjectYaml'](arg1, arg2);
+}
+
 export function ToggleAppLogsPanel() {
   return window['go']['backend']['App']['ToggleAppLogsPanel']();
 }
@@ -674,6 +1178,14 @@ export function ToggleSidebar() {
   return window['go']['backend']['App']['ToggleSidebar']();
 }
 
+export function TriggerVeleroNamespaceBackup(arg1, arg2) {
+  return window['go']['backend']['App']['TriggerVeleroNamespaceBackup'](arg1, arg2);
+}
+
+export function TriggerVeleroScheduleBackup(arg1, arg2, arg3) {
+  return window['go']['backend']['App']['TriggerVeleroScheduleBackup'](arg1, arg2, arg3);
+}
+
 export function UpdateAppPreferences(arg1) {
   return window['go']['backend']['App']['UpdateAppPreferences'](arg1);
 }
@@ -697,3 +1209,7 @@ export function ValidatePortForwardURL(arg1) {
 export function ValidateThemeClusterPattern(arg1) {
   return window['go']['backend']['App']['ValidateThemeClusterPattern'](arg1);
 }
+
+export function VerifyImagePullSecrets(arg1, arg2) {
+  return window['go']['backend']['App']['VerifyImagePullSecrets'](arg1, arg2);
+}
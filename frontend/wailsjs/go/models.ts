@@ -254,6 +254,94 @@ export namespace admission {
 	
 	
 
+}
+
+export namespace alerts {
+	
+	export class Alert {
+	    id: string;
+	    ruleId: string;
+	    ruleName: string;
+	    clusterId: string;
+	    ref: resourcemodel.ResourceRef;
+	    value: number;
+	    message: string;
+	    // Go type: time
+	    firedAt: any;
+	    // Go type: time
+	    resolvedAt?: any;
+	    // Go type: time
+	    acknowledgedAt?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Alert(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.ruleId = source["ruleId"];
+	        this.ruleName = source["ruleName"];
+	        this.clusterId = source["clusterId"];
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.value = source["value"];
+	        this.message = source["message"];
+	        this.firedAt = this.convertValues(source["firedAt"], null);
+	        this.resolvedAt = this.convertValues(source["resolvedAt"], null);
+	        this.acknowledgedAt = this.convertValues(source["acknowledgedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Rule {
+	    id: string;
+	    name: string;
+	    enabled: boolean;
+	    kind: string;
+	    metric: string;
+	    operator: string;
+	    threshold: number;
+	    window?: string;
+	    namespaces?: string[];
+	    clusterIds?: string[];
+	    notify: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Rule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.enabled = source["enabled"];
+	        this.kind = source["kind"];
+	        this.metric = source["metric"];
+	        this.operator = source["operator"];
+	        this.threshold = source["threshold"];
+	        this.window = source["window"];
+	        this.namespaces = source["namespaces"];
+	        this.clusterIds = source["clusterIds"];
+	        this.notify = source["notify"];
+	    }
+	}
+
 }
 
 export namespace apiextensions {
@@ -318,6 +406,108 @@ export namespace apiextensions {
 	        this.categories = source["categories"];
 	    }
 	}
+	export class SchemaField {
+	    name: string;
+	    path: string;
+	    type: string;
+	    format?: string;
+	    description?: string;
+	    required?: boolean;
+	    nullable?: boolean;
+	    default?: string;
+	    enum?: string[];
+	    pattern?: string;
+	    itemType?: string;
+	    valueType?: string;
+	    preserveUnknownFields?: boolean;
+	    children?: SchemaField[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SchemaField(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.path = source["path"];
+	        this.type = source["type"];
+	        this.format = source["format"];
+	        this.description = source["description"];
+	        this.required = source["required"];
+	        this.nullable = source["nullable"];
+	        this.default = source["default"];
+	        this.enum = source["enum"];
+	        this.pattern = source["pattern"];
+	        this.itemType = source["itemType"];
+	        this.valueType = source["valueType"];
+	        this.preserveUnknownFields = source["preserveUnknownFields"];
+	        this.children = this.convertValues(source["children"], SchemaField);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CRDSchema {
+	    name: string;
+	    group: string;
+	    kind: string;
+	    scope: string;
+	    version: string;
+	    versions: string[];
+	    description?: string;
+	    hasSchema: boolean;
+	    fields: SchemaField[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CRDSchema(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.group = source["group"];
+	        this.kind = source["kind"];
+	        this.scope = source["scope"];
+	        this.version = source["version"];
+	        this.versions = source["versions"];
+	        this.description = source["description"];
+	        this.hasSchema = source["hasSchema"];
+	        this.fields = this.convertValues(source["fields"], SchemaField);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class CRDVersion {
 	    name: string;
 	    served: boolean;
@@ -393,56 +583,84 @@ export namespace apiextensions {
 
 export namespace backend {
 	
-	export class UpdateInfo {
-	    currentVersion: string;
-	    latestVersion: string;
-	    releaseUrl: string;
-	    releaseName?: string;
-	    publishedAt?: string;
-	    currentPublishedAt?: string;
-	    checkedAt?: string;
-	    isUpdateAvailable: boolean;
-	    releaseNotes?: string;
-	    error?: string;
+	export class ActionArgument {
+	    name: string;
+	    type: string;
+	    required: boolean;
+	    description?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateInfo(source);
+	        return new ActionArgument(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.currentVersion = source["currentVersion"];
-	        this.latestVersion = source["latestVersion"];
-	        this.releaseUrl = source["releaseUrl"];
-	        this.releaseName = source["releaseName"];
-	        this.publishedAt = source["publishedAt"];
-	        this.currentPublishedAt = source["currentPublishedAt"];
-	        this.checkedAt = source["checkedAt"];
-	        this.isUpdateAvailable = source["isUpdateAvailable"];
-	        this.releaseNotes = source["releaseNotes"];
-	        this.error = source["error"];
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.required = source["required"];
+	        this.description = source["description"];
 	    }
 	}
-	export class AppInfo {
-	    version: string;
-	    buildTime: string;
-	    gitCommit: string;
-	    isBeta: boolean;
-	    expiryDate?: string;
-	    update?: UpdateInfo;
+	export class ActionKindSelector {
+	    group?: string;
+	    version?: string;
+	    kind: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new AppInfo(source);
+	        return new ActionKindSelector(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.group = source["group"];
 	        this.version = source["version"];
-	        this.buildTime = source["buildTime"];
-	        this.gitCommit = source["gitCommit"];
-	        this.isBeta = source["isBeta"];
-	        this.expiryDate = source["expiryDate"];
-	        this.update = this.convertValues(source["update"], UpdateInfo);
+	        this.kind = source["kind"];
+	    }
+	}
+	export class ActionPermission {
+	    verb: string;
+	    group?: string;
+	    version?: string;
+	    kind?: string;
+	    subresource?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionPermission(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.verb = source["verb"];
+	        this.group = source["group"];
+	        this.version = source["version"];
+	        this.kind = source["kind"];
+	        this.subresource = source["subresource"];
+	    }
+	}
+	export class ActionDescriptor {
+	    id: string;
+	    label: string;
+	    category: string;
+	    mutating: boolean;
+	    arguments?: ActionArgument[];
+	    permissions?: ActionPermission[];
+	    permissionSummary?: string;
+	    target?: ActionKindSelector;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionDescriptor(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.label = source["label"];
+	        this.category = source["category"];
+	        this.mutating = source["mutating"];
+	        this.arguments = this.convertValues(source["arguments"], ActionArgument);
+	        this.permissions = this.convertValues(source["permissions"], ActionPermission);
+	        this.permissionSummary = source["permissionSummary"];
+	        this.target = this.convertValues(source["target"], ActionKindSelector);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -463,104 +681,104 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class CatalogDomainDiagnostics {
-	    domain: string;
-	    scope?: string;
-	    lastStatus: string;
-	    lastError?: string;
-	    lastWarning?: string;
-	    lastDurationMs: number;
-	    averageDurationMs?: number;
-	    successCount?: number;
-	    failureCount?: number;
-	    totalItems?: number;
-	    truncated?: boolean;
-	    fallbackCount?: number;
-	    hydrationCount?: number;
+	export class ActionInvokeRequest {
+	    id: string;
+	    target: resourcemodel.ResourceRef;
+	    arguments?: Record<string, any>;
+	    confirmation?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new CatalogDomainDiagnostics(source);
+	        return new ActionInvokeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.domain = source["domain"];
-	        this.scope = source["scope"];
-	        this.lastStatus = source["lastStatus"];
-	        this.lastError = source["lastError"];
-	        this.lastWarning = source["lastWarning"];
-	        this.lastDurationMs = source["lastDurationMs"];
-	        this.averageDurationMs = source["averageDurationMs"];
-	        this.successCount = source["successCount"];
-	        this.failureCount = source["failureCount"];
-	        this.totalItems = source["totalItems"];
-	        this.truncated = source["truncated"];
-	        this.fallbackCount = source["fallbackCount"];
-	        this.hydrationCount = source["hydrationCount"];
-	    }
-	}
-	export class CatalogHealth {
-	    status: string;
-	    consecutiveFailures: number;
-	    lastSyncMs: number;
-	    lastSuccessMs?: number;
-	    lastError?: string;
-	    stale: boolean;
-	    failedResources?: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new CatalogHealth(source);
+	        this.id = source["id"];
+	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
+	        this.arguments = source["arguments"];
+	        this.confirmation = source["confirmation"];
 	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.status = source["status"];
-	        this.consecutiveFailures = source["consecutiveFailures"];
-	        this.lastSyncMs = source["lastSyncMs"];
-	        this.lastSuccessMs = source["lastSuccessMs"];
-	        this.lastError = source["lastError"];
-	        this.stale = source["stale"];
-	        this.failedResources = source["failedResources"];
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ActionInvokeResponse {
+	    navigate?: resourcemodel.ResourceRef;
+	    objectAction?: ObjectActionResponse;
+	    shellSession?: types.ShellSession;
+	    apply?: ObjectYAMLMutationResponse;
+	    custom?: CustomActionResult;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionInvokeResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.navigate = this.convertValues(source["navigate"], resourcemodel.ResourceRef);
+	        this.objectAction = this.convertValues(source["objectAction"], ObjectActionResponse);
+	        this.shellSession = this.convertValues(source["shellSession"], types.ShellSession);
+	        this.apply = this.convertValues(source["apply"], ObjectYAMLMutationResponse);
+	        this.custom = this.convertValues(source["custom"], CustomActionResult);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class CatalogDiagnostics {
-	    enabled: boolean;
-	    itemCount: number;
-	    resourceCount: number;
-	    lastSyncMs: number;
-	    lastUpdated: number;
-	    lastError?: string;
-	    lastSuccessMs?: number;
-	    status?: string;
-	    consecutiveFailures?: number;
-	    stale?: boolean;
-	    failedResources?: number;
-	    fallbackCount?: number;
-	    hydrationCount?: number;
-	    health?: CatalogHealth;
-	    domains?: CatalogDomainDiagnostics[];
+	export class CacheIntrospection {
+	    clusterId: string;
+	    clusterName: string;
+	    cooled?: boolean;
+	    informers: informer.CacheStatus[];
+	    reflectors: ingest.ReflectorStatus[];
+	    estimatedBytes: number;
+	    heapBytes: number;
+	    generatedAt: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CatalogDiagnostics(source);
+	        return new CacheIntrospection(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.enabled = source["enabled"];
-	        this.itemCount = source["itemCount"];
-	        this.resourceCount = source["resourceCount"];
-	        this.lastSyncMs = source["lastSyncMs"];
-	        this.lastUpdated = source["lastUpdated"];
-	        this.lastError = source["lastError"];
-	        this.lastSuccessMs = source["lastSuccessMs"];
-	        this.status = source["status"];
-	        this.consecutiveFailures = source["consecutiveFailures"];
-	        this.stale = source["stale"];
-	        this.failedResources = source["failedResources"];
-	        this.fallbackCount = source["fallbackCount"];
-	        this.hydrationCount = source["hydrationCount"];
-	        this.health = this.convertValues(source["health"], CatalogHealth);
-	        this.domains = this.convertValues(source["domains"], CatalogDomainDiagnostics);
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.cooled = source["cooled"];
+	        this.informers = this.convertValues(source["informers"], informer.CacheStatus);
+	        this.reflectors = this.convertValues(source["reflectors"], ingest.ReflectorStatus);
+	        this.estimatedBytes = source["estimatedBytes"];
+	        this.heapBytes = source["heapBytes"];
+	        this.generatedAt = source["generatedAt"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -581,68 +799,122 @@ export namespace backend {
 		    return a;
 		}
 	}
+	export class ClusterClientLimits {
+	    qps: number;
+	    burst: number;
+	    requestTimeoutSeconds: number;
+	    defaultQPS: number;
+	    defaultBurst: number;
 	
+	    static createFrom(source: any = {}) {
+	        return new ClusterClientLimits(source);
+	    }
 	
-	export class CatalogQueryCSVExport {
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.qps = source["qps"];
+	        this.burst = source["burst"];
+	        this.requestTimeoutSeconds = source["requestTimeoutSeconds"];
+	        this.defaultQPS = source["defaultQPS"];
+	        this.defaultBurst = source["defaultBurst"];
+	    }
+	}
+	export class ClusterHealthSummary {
+	    clusterId: string;
+	    clusterName: string;
+	    context: string;
+	    health: string;
+	    reachable: boolean;
+	    warningEvents: number;
+	    failingWorkloads: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterHealthSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.context = source["context"];
+	        this.health = source["health"];
+	        this.reachable = source["reachable"];
+	        this.warningEvents = source["warningEvents"];
+	        this.failingWorkloads = source["failingWorkloads"];
+	    }
+	}
+	export class CustomActionResult {
+	    output: string;
+	    truncated?: boolean;
+	    kubectlCommand?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CustomActionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.output = source["output"];
+	        this.truncated = source["truncated"];
+	        this.kubectlCommand = source["kubectlCommand"];
+	    }
+	}
+	export class CustomActionsFileStatus {
 	    path: string;
-	    bytes: number;
+	    exists: boolean;
+	    actions: number;
+	    errors?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new CatalogQueryCSVExport(source);
+	        return new CustomActionsFileStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.path = source["path"];
-	        this.bytes = source["bytes"];
+	        this.exists = source["exists"];
+	        this.actions = source["actions"];
+	        this.errors = source["errors"];
 	    }
 	}
-	export class ClusterWorkspaceAuthState {
-	    state: string;
-	    reason: string;
-	    errorClass: string;
-	    secondsUntilRetry: number;
-	    class: string;
-	    kind: string;
-	    summary: string;
-	    execCommand: string;
+	export class DeepLink {
+	    context: string;
+	    namespace?: string;
+	    kind?: string;
+	    name?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterWorkspaceAuthState(source);
+	        return new DeepLink(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.state = source["state"];
-	        this.reason = source["reason"];
-	        this.errorClass = source["errorClass"];
-	        this.secondsUntilRetry = source["secondsUntilRetry"];
-	        this.class = source["class"];
+	        this.context = source["context"];
+	        this.namespace = source["namespace"];
 	        this.kind = source["kind"];
-	        this.summary = source["summary"];
-	        this.execCommand = source["execCommand"];
+	        this.name = source["name"];
 	    }
 	}
-	export class ClusterWorkspaceClusterState {
+	export class DeepLinkTarget {
+	    url: string;
+	    link: DeepLink;
 	    clusterId: string;
-	    clusterName: string;
-	    lifecycle: string;
-	    auth: ClusterWorkspaceAuthState;
-	    health: string;
-	    scopeRevision: number;
+	    selection: string;
+	    connected: boolean;
+	    ref?: resourcemodel.ResourceRef;
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterWorkspaceClusterState(source);
+	        return new DeepLinkTarget(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.url = source["url"];
+	        this.link = this.convertValues(source["link"], DeepLink);
 	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	        this.lifecycle = source["lifecycle"];
-	        this.auth = this.convertValues(source["auth"], ClusterWorkspaceAuthState);
-	        this.health = source["health"];
-	        this.scopeRevision = source["scopeRevision"];
+	        this.selection = source["selection"];
+	        this.connected = source["connected"];
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -663,36 +935,38 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class ClusterWorkspaceCommand {
-	    selectedKubeconfigs: string[];
-	    updateSelectedKubeconfigs: boolean;
-	    visibleClusterId: string;
+	export class DiagnosticBundleResult {
+	    path: string;
+	    bytes: number;
+	    files: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterWorkspaceCommand(source);
+	        return new DiagnosticBundleResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.selectedKubeconfigs = source["selectedKubeconfigs"];
-	        this.updateSelectedKubeconfigs = source["updateSelectedKubeconfigs"];
-	        this.visibleClusterId = source["visibleClusterId"];
+	        this.path = source["path"];
+	        this.bytes = source["bytes"];
+	        this.files = source["files"];
 	    }
 	}
-	export class ClusterWorkspaceState {
-	    selectedKubeconfigs: string[];
-	    visibleClusterId: string;
-	    clusters: Record<string, ClusterWorkspaceClusterState>;
+	export class ExternalEditSession {
+	    id: string;
+	    target: resourcemodel.ResourceRef;
+	    path: string;
+	    editor: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterWorkspaceState(source);
+	        return new ExternalEditSession(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.selectedKubeconfigs = source["selectedKubeconfigs"];
-	        this.visibleClusterId = source["visibleClusterId"];
-	        this.clusters = this.convertValues(source["clusters"], ClusterWorkspaceClusterState, true);
+	        this.id = source["id"];
+	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
+	        this.path = source["path"];
+	        this.editor = source["editor"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -713,18 +987,20 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class ClusterWorkspaceResult {
-	    state: ClusterWorkspaceState;
-	    error?: string;
+	export class FinishedCleanupPreview {
+	    clusterId: string;
+	    candidates: finishedcleanup.Candidate[];
+	    protected?: finishedcleanup.Candidate[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterWorkspaceResult(source);
+	        return new FinishedCleanupPreview(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.state = this.convertValues(source["state"], ClusterWorkspaceState);
-	        this.error = source["error"];
+	        this.clusterId = source["clusterId"];
+	        this.candidates = this.convertValues(source["candidates"], finishedcleanup.Candidate);
+	        this.protected = this.convertValues(source["protected"], finishedcleanup.Candidate);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -745,75 +1021,3636 @@ export namespace backend {
 		    return a;
 		}
 	}
+	export class KustomizeBuildResult {
+	    dir: string;
+	    manifests: string;
+	    objects: number;
 	
-	export class ContainerPortInfo {
+	    static createFrom(source: any = {}) {
+	        return new KustomizeBuildResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.dir = source["dir"];
+	        this.manifests = source["manifests"];
+	        this.objects = source["objects"];
+	    }
+	}
+	export class LocalAPIStatus {
+	    enabled: boolean;
+	    headless: boolean;
+	    running: boolean;
 	    port: number;
-	    name?: string;
-	    protocol?: string;
+	    url?: string;
+	    metrics: boolean;
+	    metricsUrl?: string;
+	    tokenPath: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ContainerPortInfo(source);
+	        return new LocalAPIStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.headless = source["headless"];
+	        this.running = source["running"];
 	        this.port = source["port"];
-	        this.name = source["name"];
-	        this.protocol = source["protocol"];
+	        this.url = source["url"];
+	        this.metrics = source["metrics"];
+	        this.metricsUrl = source["metricsUrl"];
+	        this.tokenPath = source["tokenPath"];
+	        this.error = source["error"];
 	    }
 	}
-	export class FavoriteTableState {
-	    sortColumn: string;
-	    sortDirection: string;
-	    columnVisibility: Record<string, boolean>;
+	export class LocalTerminalRequest {
+	    clusterId: string;
+	    namespace?: string;
+	    columns?: number;
+	    rows?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new FavoriteTableState(source);
+	        return new LocalTerminalRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.sortColumn = source["sortColumn"];
-	        this.sortDirection = source["sortDirection"];
-	        this.columnVisibility = source["columnVisibility"];
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.columns = source["columns"];
+	        this.rows = source["rows"];
 	    }
 	}
-	export class FavoriteFilterSelection {
-	    mode: string;
-	    values?: string[];
+	export class LocalTerminalSession {
+	    sessionId: string;
+	    clusterId: string;
+	    clusterName: string;
+	    context: string;
+	    namespace?: string;
+	    shell: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new FavoriteFilterSelection(source);
+	        return new LocalTerminalSession(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.mode = source["mode"];
-	        this.values = source["values"];
+	        this.sessionId = source["sessionId"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.context = source["context"];
+	        this.namespace = source["namespace"];
+	        this.shell = source["shell"];
 	    }
 	}
-	export class FavoriteFilters {
-	    search: string;
-	    kinds: FavoriteFilterSelection;
-	    namespaces: FavoriteFilterSelection;
-	    clusters: FavoriteFilterSelection;
-	    queryFacets?: Record<string, FavoriteFilterSelection>;
-	    caseSensitive: boolean;
-	    includeMetadata: boolean;
+	export class RevisionChange {
+	    category: string;
+	    container?: string;
+	    field?: string;
+	    from?: string;
+	    to?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new FavoriteFilters(source);
+	        return new RevisionChange(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.search = source["search"];
-	        this.kinds = this.convertValues(source["kinds"], FavoriteFilterSelection);
-	        this.namespaces = this.convertValues(source["namespaces"], FavoriteFilterSelection);
-	        this.clusters = this.convertValues(source["clusters"], FavoriteFilterSelection);
-	        this.queryFacets = this.convertValues(source["queryFacets"], FavoriteFilterSelection, true);
-	        this.caseSensitive = source["caseSensitive"];
-	        this.includeMetadata = source["includeMetadata"];
+	        this.category = source["category"];
+	        this.container = source["container"];
+	        this.field = source["field"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	    }
+	}
+	export class RevisionDiff {
+	    fromRevision: number;
+	    toRevision: number;
+	    changes: RevisionChange[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RevisionDiff(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.fromRevision = source["fromRevision"];
+	        this.toRevision = source["toRevision"];
+	        this.changes = this.convertValues(source["changes"], RevisionChange);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateInfo {
+	    currentVersion: string;
+	    channel?: string;
+	    latestVersion: string;
+	    releaseUrl: string;
+	    releaseName?: string;
+	    publishedAt?: string;
+	    currentPublishedAt?: string;
+	    checkedAt?: string;
+	    isUpdateAvailable: boolean;
+	    releaseNotes?: string;
+	    packageName?: string;
+	    staged: boolean;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currentVersion = source["currentVersion"];
+	        this.channel = source["channel"];
+	        this.latestVersion = source["latestVersion"];
+	        this.releaseUrl = source["releaseUrl"];
+	        this.releaseName = source["releaseName"];
+	        this.publishedAt = source["publishedAt"];
+	        this.currentPublishedAt = source["currentPublishedAt"];
+	        this.checkedAt = source["checkedAt"];
+	        this.isUpdateAvailable = source["isUpdateAvailable"];
+	        this.releaseNotes = source["releaseNotes"];
+	        this.packageName = source["packageName"];
+	        this.staged = source["staged"];
+	        this.error = source["error"];
+	    }
+	}
+	export class AppInfo {
+	    version: string;
+	    buildTime: string;
+	    gitCommit: string;
+	    isBeta: boolean;
+	    expiryDate?: string;
+	    update?: UpdateInfo;
+	
+	    static createFrom(source: any = {}) {
+	        return new AppInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.buildTime = source["buildTime"];
+	        this.gitCommit = source["gitCommit"];
+	        this.isBeta = source["isBeta"];
+	        this.expiryDate = source["expiryDate"];
+	        this.update = this.convertValues(source["update"], UpdateInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CatalogDomainDiagnostics {
+	    domain: string;
+	    scope?: string;
+	    lastStatus: string;
+	    lastError?: string;
+	    lastWarning?: string;
+	    lastDurationMs: number;
+	    averageDurationMs?: number;
+	    successCount?: number;
+	    failureCount?: number;
+	    totalItems?: number;
+	    truncated?: boolean;
+	    fallbackCount?: number;
+	    hydrationCount?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogDomainDiagnostics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.domain = source["domain"];
+	        this.scope = source["scope"];
+	        this.lastStatus = source["lastStatus"];
+	        this.lastError = source["lastError"];
+	        this.lastWarning = source["lastWarning"];
+	        this.lastDurationMs = source["lastDurationMs"];
+	        this.averageDurationMs = source["averageDurationMs"];
+	        this.successCount = source["successCount"];
+	        this.failureCount = source["failureCount"];
+	        this.totalItems = source["totalItems"];
+	        this.truncated = source["truncated"];
+	        this.fallbackCount = source["fallbackCount"];
+	        this.hydrationCount = source["hydrationCount"];
+	    }
+	}
+	export class CatalogHealth {
+	    status: string;
+	    consecutiveFailures: number;
+	    lastSyncMs: number;
+	    lastSuccessMs?: number;
+	    lastError?: string;
+	    stale: boolean;
+	    failedResources?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.status = source["status"];
+	        this.consecutiveFailures = source["consecutiveFailures"];
+	        this.lastSyncMs = source["lastSyncMs"];
+	        this.lastSuccessMs = source["lastSuccessMs"];
+	        this.lastError = source["lastError"];
+	        this.stale = source["stale"];
+	        this.failedResources = source["failedResources"];
+	    }
+	}
+	export class CatalogDiagnostics {
+	    enabled: boolean;
+	    itemCount: number;
+	    resourceCount: number;
+	    lastSyncMs: number;
+	    lastUpdated: number;
+	    lastError?: string;
+	    lastSuccessMs?: number;
+	    status?: string;
+	    consecutiveFailures?: number;
+	    stale?: boolean;
+	    failedResources?: number;
+	    fallbackCount?: number;
+	    hydrationCount?: number;
+	    health?: CatalogHealth;
+	    domains?: CatalogDomainDiagnostics[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogDiagnostics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.itemCount = source["itemCount"];
+	        this.resourceCount = source["resourceCount"];
+	        this.lastSyncMs = source["lastSyncMs"];
+	        this.lastUpdated = source["lastUpdated"];
+	        this.lastError = source["lastError"];
+	        this.lastSuccessMs = source["lastSuccessMs"];
+	        this.status = source["status"];
+	        this.consecutiveFailures = source["consecutiveFailures"];
+	        this.stale = source["stale"];
+	        this.failedResources = source["failedResources"];
+	        this.fallbackCount = source["fallbackCount"];
+	        this.hydrationCount = source["hydrationCount"];
+	        this.health = this.convertValues(source["health"], CatalogHealth);
+	        this.domains = this.convertValues(source["domains"], CatalogDomainDiagnostics);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class CatalogQueryCSVExport {
+	    path: string;
+	    bytes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogQueryCSVExport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.bytes = source["bytes"];
+	    }
+	}
+	export class ClusterWorkspaceAuthState {
+	    state: string;
+	    reason: string;
+	    errorClass: string;
+	    secondsUntilRetry: number;
+	    class: string;
+	    kind: string;
+	    summary: string;
+	    execCommand: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterWorkspaceAuthState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = source["state"];
+	        this.reason = source["reason"];
+	        this.errorClass = source["errorClass"];
+	        this.secondsUntilRetry = source["secondsUntilRetry"];
+	        this.class = source["class"];
+	        this.kind = source["kind"];
+	        this.summary = source["summary"];
+	        this.execCommand = source["execCommand"];
+	    }
+	}
+	export class ClusterWorkspaceClusterState {
+	    clusterId: string;
+	    clusterName: string;
+	    lifecycle: string;
+	    auth: ClusterWorkspaceAuthState;
+	    health: string;
+	    scopeRevision: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterWorkspaceClusterState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.lifecycle = source["lifecycle"];
+	        this.auth = this.convertValues(source["auth"], ClusterWorkspaceAuthState);
+	        this.health = source["health"];
+	        this.scopeRevision = source["scopeRevision"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ClusterWorkspaceCommand {
+	    selectedKubeconfigs: string[];
+	    updateSelectedKubeconfigs: boolean;
+	    visibleClusterId: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterWorkspaceCommand(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.selectedKubeconfigs = source["selectedKubeconfigs"];
+	        this.updateSelectedKubeconfigs = source["updateSelectedKubeconfigs"];
+	        this.visibleClusterId = source["visibleClusterId"];
+	    }
+	}
+	export class ClusterWorkspaceState {
+	    selectedKubeconfigs: string[];
+	    visibleClusterId: string;
+	    clusters: Record<string, ClusterWorkspaceClusterState>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterWorkspaceState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.selectedKubeconfigs = source["selectedKubeconfigs"];
+	        this.visibleClusterId = source["visibleClusterId"];
+	        this.clusters = this.convertValues(source["clusters"], ClusterWorkspaceClusterState, true);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ClusterWorkspaceResult {
+	    state: ClusterWorkspaceState;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterWorkspaceResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = this.convertValues(source["state"], ClusterWorkspaceState);
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ContainerImageScan {
+	    container: string;
+	    init?: boolean;
+	    image: string;
+	    digest?: string;
+	    scanned: boolean;
+	    counts: vulnscan.Counts;
+	    // Go type: time
+	    scannedAt?: any;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContainerImageScan(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.container = source["container"];
+	        this.init = source["init"];
+	        this.image = source["image"];
+	        this.digest = source["digest"];
+	        this.scanned = source["scanned"];
+	        this.counts = this.convertValues(source["counts"], vulnscan.Counts);
+	        this.scannedAt = this.convertValues(source["scannedAt"], null);
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ContainerPortInfo {
+	    port: number;
+	    name?: string;
+	    protocol?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContainerPortInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.port = source["port"];
+	        this.name = source["name"];
+	        this.protocol = source["protocol"];
+	    }
+	}
+	export class FavoriteTableState {
+	    sortColumn: string;
+	    sortDirection: string;
+	    columnVisibility: Record<string, boolean>;
+	
+	    static createFrom(source: any = {}) {
+	        return new FavoriteTableState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sortColumn = source["sortColumn"];
+	        this.sortDirection = source["sortDirection"];
+	        this.columnVisibility = source["columnVisibility"];
+	    }
+	}
+	export class FavoriteFilterSelection {
+	    mode: string;
+	    values?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FavoriteFilterSelection(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.mode = source["mode"];
+	        this.values = source["values"];
+	    }
+	}
+	export class FavoriteFilters {
+	    search: string;
+	    kinds: FavoriteFilterSelection;
+	    namespaces: FavoriteFilterSelection;
+	    clusters: FavoriteFilterSelection;
+	    queryFacets?: Record<string, FavoriteFilterSelection>;
+	    caseSensitive: boolean;
+	    includeMetadata: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new FavoriteFilters(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.search = source["search"];
+	        this.kinds = this.convertValues(source["kinds"], FavoriteFilterSelection);
+	        this.namespaces = this.convertValues(source["namespaces"], FavoriteFilterSelection);
+	        this.clusters = this.convertValues(source["clusters"], FavoriteFilterSelection);
+	        this.queryFacets = this.convertValues(source["queryFacets"], FavoriteFilterSelection, true);
+	        this.caseSensitive = source["caseSensitive"];
+	        this.includeMetadata = source["includeMetadata"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FavoritePaneState {
+	    filters: FavoriteFilters;
+	    tableState: FavoriteTableState;
+	
+	    static createFrom(source: any = {}) {
+	        return new FavoritePaneState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filters = this.convertValues(source["filters"], FavoriteFilters);
+	        this.tableState = this.convertValues(source["tableState"], FavoriteTableState);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Favorite {
+	    id: string;
+	    name: string;
+	    clusterSelection: string;
+	    clusterId?: string;
+	    clusterName?: string;
+	    viewType: string;
+	    view: string;
+	    namespace: string;
+	    panes: Record<string, FavoritePaneState>;
+	    order: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Favorite(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.clusterSelection = source["clusterSelection"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.viewType = source["viewType"];
+	        this.view = source["view"];
+	        this.namespace = source["namespace"];
+	        this.panes = this.convertValues(source["panes"], FavoritePaneState, true);
+	        this.order = source["order"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
+	export class KubernetesAPIClientDiagnostics {
+	    clusterId: string;
+	    clusterName: string;
+	    configuredQPS: number;
+	    configuredBurst: number;
+	    qps1s: number;
+	    qps10s: number;
+	    qps60s: number;
+	    peakQPS1s: number;
+	    totalRequests: number;
+	    status2xx: number;
+	    status3xx: number;
+	    status4xx: number;
+	    status5xx: number;
+	    status429: number;
+	    errors: number;
+	    lastRequestMs?: number;
+	    throttledRequests: number;
+	    throttleWaitMs: number;
+	    maxThrottleWaitMs: number;
+	    lastThrottledMs?: number;
+	    operations: KubernetesAPIOperationStats[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KubernetesAPIClientDiagnostics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.configuredQPS = source["configuredQPS"];
+	        this.configuredBurst = source["configuredBurst"];
+	        this.qps1s = source["qps1s"];
+	        this.qps10s = source["qps10s"];
+	        this.qps60s = source["qps60s"];
+	        this.peakQPS1s = source["peakQPS1s"];
+	        this.totalRequests = source["totalRequests"];
+	        this.status2xx = source["status2xx"];
+	        this.status3xx = source["status3xx"];
+	        this.status4xx = source["status4xx"];
+	        this.status5xx = source["status5xx"];
+	        this.status429 = source["status429"];
+	        this.errors = source["errors"];
+	        this.lastRequestMs = source["lastRequestMs"];
+	        this.throttledRequests = source["throttledRequests"];
+	        this.throttleWaitMs = source["throttleWaitMs"];
+	        this.maxThrottleWaitMs = source["maxThrottleWaitMs"];
+	        this.lastThrottledMs = source["lastThrottledMs"];
+	        this.operations = this.convertValues(source["operations"], KubernetesAPIOperationStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class KubernetesAPIOperationStats {
+	    verb: string;
+	    group: string;
+	    resource: string;
+	    requests: number;
+	    errors: number;
+	    avgLatencyMs: number;
+	    maxLatencyMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new KubernetesAPIOperationStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.verb = source["verb"];
+	        this.group = source["group"];
+	        this.resource = source["resource"];
+	        this.requests = source["requests"];
+	        this.errors = source["errors"];
+	        this.avgLatencyMs = source["avgLatencyMs"];
+	        this.maxLatencyMs = source["maxLatencyMs"];
+	    }
+	}
+	export class LogEntry {
+	    sequence: number;
+	    timestamp: string;
+	    level: string;
+	    message: string;
+	    source?: string;
+	    clusterId?: string;
+	    clusterName?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sequence = source["sequence"];
+	        this.timestamp = source["timestamp"];
+	        this.level = source["level"];
+	        this.message = source["message"];
+	        this.source = source["source"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	    }
+	}
+	export class ObjectActionDebugContainerOptions {
+	    image: string;
+	    targetContainer?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectActionDebugContainerOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.image = source["image"];
+	        this.targetContainer = source["targetContainer"];
+	    }
+	}
+	export class ObjectActionPortForwardOptions {
+	    containerPort: number;
+	    localPort: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectActionPortForwardOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.containerPort = source["containerPort"];
+	        this.localPort = source["localPort"];
+	    }
+	}
+	export class BulkObjectActionRequest {
+	    action: string;
+	    targets: resourcemodel.ResourceRef[];
+	    set?: Record<string, string>;
+	    remove?: string[];
+	    dryRun?: boolean;
+	    concurrency?: number;
+	    confirmation?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkObjectActionRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.targets = this.convertValues(source["targets"], resourcemodel.ResourceRef);
+	        this.set = source["set"];
+	        this.remove = source["remove"];
+	        this.dryRun = source["dryRun"];
+	        this.concurrency = source["concurrency"];
+	        this.confirmation = source["confirmation"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BulkObjectActionResult {
+	    target: resourcemodel.ResourceRef;
+	    status: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkObjectActionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
+	        this.status = source["status"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BulkObjectActionResponse {
+	    action: string;
+	    dryRun?: boolean;
+	    results: BulkObjectActionResult[];
+	    counts: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkObjectActionResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.dryRun = source["dryRun"];
+	        this.results = this.convertValues(source["results"], BulkObjectActionResult);
+	        this.counts = source["counts"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectActionRequest {
+	    action: string;
+	    target: resourcemodel.ResourceRef;
+	    replicas?: number;
+	    suspend?: boolean;
+	    drainOptions?: types.DrainNodeOptions;
+	    portForward?: ObjectActionPortForwardOptions;
+	    debugContainer?: ObjectActionDebugContainerOptions;
+	    revision?: number;
+	    confirmation?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectActionRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
+	        this.replicas = source["replicas"];
+	        this.suspend = source["suspend"];
+	        this.drainOptions = this.convertValues(source["drainOptions"], types.DrainNodeOptions);
+	        this.portForward = this.convertValues(source["portForward"], ObjectActionPortForwardOptions);
+	        this.debugContainer = this.convertValues(source["debugContainer"], ObjectActionDebugContainerOptions);
+	        this.revision = source["revision"];
+	        this.confirmation = source["confirmation"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectActionResponse {
+	    name?: string;
+	    jobId?: string;
+	    sessionId?: string;
+	    debugContainer?: types.DebugContainerResponse;
+	    kubectlCommand?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectActionResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.jobId = source["jobId"];
+	        this.sessionId = source["sessionId"];
+	        this.debugContainer = this.convertValues(source["debugContainer"], types.DebugContainerResponse);
+	        this.kubectlCommand = source["kubectlCommand"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectAppliedDiffChange {
+	    path: string;
+	    type: string;
+	    declared?: string;
+	    live?: string;
+	    managers?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectAppliedDiffChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.type = source["type"];
+	        this.declared = source["declared"];
+	        this.live = source["live"];
+	        this.managers = source["managers"];
+	    }
+	}
+	export class ObjectAppliedDiff {
+	    source: string;
+	    appliers?: string[];
+	    declared?: string;
+	    live: string;
+	    changes: ObjectAppliedDiffChange[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectAppliedDiff(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source = source["source"];
+	        this.appliers = source["appliers"];
+	        this.declared = source["declared"];
+	        this.live = source["live"];
+	        this.changes = this.convertValues(source["changes"], ObjectAppliedDiffChange);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectFieldManager {
+	    manager: string;
+	    operation: string;
+	    subresource?: string;
+	    apiVersion?: string;
+	    // Go type: time
+	    time?: any;
+	    fields: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectFieldManager(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.manager = source["manager"];
+	        this.operation = source["operation"];
+	        this.subresource = source["subresource"];
+	        this.apiVersion = source["apiVersion"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.fields = source["fields"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectFieldOwner {
+	    field: string;
+	    managers: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectFieldOwner(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.managers = source["managers"];
+	    }
+	}
+	export class ObjectFieldOwnership {
+	    managers: ObjectFieldManager[];
+	    fields: ObjectFieldOwner[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectFieldOwnership(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.managers = this.convertValues(source["managers"], ObjectFieldManager);
+	        this.fields = this.convertValues(source["fields"], ObjectFieldOwner);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectSearchResult {
+	    ref: resourcemodel.ResourceRef;
+	    clusterName: string;
+	    score: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectSearchResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.clusterName = source["clusterName"];
+	        this.score = source["score"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ObjectYAMLMutationRequest {
+	    baseYAML: string;
+	    yaml: string;
+	    kind: string;
+	    apiVersion: string;
+	    namespace: string;
+	    name: string;
+	    uid: string;
+	    resourceVersion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLMutationRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.baseYAML = source["baseYAML"];
+	        this.yaml = source["yaml"];
+	        this.kind = source["kind"];
+	        this.apiVersion = source["apiVersion"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.uid = source["uid"];
+	        this.resourceVersion = source["resourceVersion"];
+	    }
+	}
+	export class ObjectYAMLMutationResponse {
+	    resourceVersion: string;
+	    kubectlCommand?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLMutationResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resourceVersion = source["resourceVersion"];
+	        this.kubectlCommand = source["kubectlCommand"];
+	    }
+	}
+	export class ObjectYAMLOwnershipConflict {
+	    field: string;
+	    manager: string;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLOwnershipConflict(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.manager = source["manager"];
+	        this.message = source["message"];
+	    }
+	}
+	export class ObjectYAMLOwnershipCheckResponse {
+	    conflicts: ObjectYAMLOwnershipConflict[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLOwnershipCheckResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.conflicts = this.convertValues(source["conflicts"], ObjectYAMLOwnershipConflict);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ObjectYAMLReloadMergeRequest {
+	    baseYAML: string;
+	    draftYAML: string;
+	    kind: string;
+	    apiVersion: string;
+	    namespace: string;
+	    name: string;
+	    uid: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLReloadMergeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.baseYAML = source["baseYAML"];
+	        this.draftYAML = source["draftYAML"];
+	        this.kind = source["kind"];
+	        this.apiVersion = source["apiVersion"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.uid = source["uid"];
+	    }
+	}
+	export class ObjectYAMLReloadMergeResponse {
+	    mergedYAML: string;
+	    currentYAML: string;
+	    resourceVersion: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLReloadMergeResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.mergedYAML = source["mergedYAML"];
+	        this.currentYAML = source["currentYAML"];
+	        this.resourceVersion = source["resourceVersion"];
+	    }
+	}
+	export class ObjectYAMLThreeWayMergeResponse {
+	    mergedYAML: string;
+	    currentYAML: string;
+	    resourceVersion: string;
+	    conflicts: objectyaml.MergeConflict[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ObjectYAMLThreeWayMergeResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.mergedYAML = source["mergedYAML"];
+	        this.currentYAML = source["currentYAML"];
+	        this.resourceVersion = source["resourceVersion"];
+	        this.conflicts = this.convertValues(source["conflicts"], objectyaml.MergeConflict);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PinnedResource {
+	    group?: string;
+	    version: string;
+	    kind: string;
+	    namespace?: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PinnedResource(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.group = source["group"];
+	        this.version = source["version"];
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	    }
+	}
+	export class ClusterPins {
+	    namespaces: string[];
+	    resources: PinnedResource[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterPins(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespaces = source["namespaces"];
+	        this.resources = this.convertValues(source["resources"], PinnedResource);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PinnedSummary {
+	    namespace?: string;
+	    // Go type: PinnedResource
+	    resource?: PinnedResource;
+	    // Go type: objectcatalog.Summary
+	    summary?: objectcatalog.Summary;
+	    missing?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PinnedSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.resource = this.convertValues(source["resource"], PinnedResource);
+	        this.summary = this.convertValues(source["summary"], objectcatalog.Summary);
+	        this.missing = source["missing"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PinnedSummaries {
+	    namespaces: PinnedSummary[];
+	    resources: PinnedSummary[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PinnedSummaries(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespaces = this.convertValues(source["namespaces"], PinnedSummary);
+	        this.resources = this.convertValues(source["resources"], PinnedSummary);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PortForwardSession {
+	    id: string;
+	    clusterId: string;
+	    clusterName: string;
+	    namespace: string;
+	    podName: string;
+	    containerPort: number;
+	    localPort: number;
+	    targetKind: string;
+	    targetGroup: string;
+	    targetVersion: string;
+	    targetName: string;
+	    status: string;
+	    statusReason?: string;
+	    startedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PortForwardSession(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.namespace = source["namespace"];
+	        this.podName = source["podName"];
+	        this.containerPort = source["containerPort"];
+	        this.localPort = source["localPort"];
+	        this.targetKind = source["targetKind"];
+	        this.targetGroup = source["targetGroup"];
+	        this.targetVersion = source["targetVersion"];
+	        this.targetName = source["targetName"];
+	        this.status = source["status"];
+	        this.statusReason = source["statusReason"];
+	        this.startedAt = source["startedAt"];
+	    }
+	}
+	export class RecycleBinEntry {
+	    id: string;
+	    clusterId: string;
+	    clusterName?: string;
+	    group: string;
+	    version: string;
+	    kind: string;
+	    namespace?: string;
+	    name: string;
+	    // Go type: time
+	    deletedAt: any;
+	    // Go type: time
+	    expiresAt: any;
+	    manifest: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecycleBinEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.group = source["group"];
+	        this.version = source["version"];
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.deletedAt = this.convertValues(source["deletedAt"], null);
+	        this.expiresAt = this.convertValues(source["expiresAt"], null);
+	        this.manifest = source["manifest"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RevisionEntry {
+	    revision: number;
+	    createdAt: string;
+	    changeCause: string;
+	    current: boolean;
+	    podTemplate: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RevisionEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.revision = source["revision"];
+	        this.createdAt = source["createdAt"];
+	        this.changeCause = source["changeCause"];
+	        this.current = source["current"];
+	        this.podTemplate = source["podTemplate"];
+	    }
+	}
+	export class RuntimeOperation {
+	    id: string;
+	    type: string;
+	    clusterId: string;
+	    clusterName?: string;
+	    target?: resourcemodel.ResourceRef;
+	    status: string;
+	    statusReason?: string;
+	    startedAt: string;
+	    displayName?: string;
+	    summary?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new RuntimeOperation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.type = source["type"];
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
+	        this.status = source["status"];
+	        this.statusReason = source["statusReason"];
+	        this.startedAt = source["startedAt"];
+	        this.displayName = source["displayName"];
+	        this.summary = source["summary"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SecretRevealAuditRecord {
+	    // Go type: time
+	    timestamp: any;
+	    clusterId: string;
+	    clusterName?: string;
+	    namespace: string;
+	    name: string;
+	    revealedKeys: string[];
+	    redactedKeys?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretRevealAuditRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.clusterId = source["clusterId"];
+	        this.clusterName = source["clusterName"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.revealedKeys = source["revealedKeys"];
+	        this.redactedKeys = source["redactedKeys"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SelectionDiagnostics {
+	    activeQueueDepth: number;
+	    maxQueueDepth: number;
+	    sampleCount: number;
+	    totalMutations: number;
+	    completedMutations: number;
+	    failedMutations: number;
+	    canceledMutations: number;
+	    supersededMutations: number;
+	    lastUpdatedMs?: number;
+	    lastReason?: string;
+	    lastError?: string;
+	    lastQueueMs?: number;
+	    lastTotalMs?: number;
+	    queueP50Ms?: number;
+	    queueP95Ms?: number;
+	    totalP50Ms?: number;
+	    totalP95Ms?: number;
+	    intentP50Ms?: number;
+	    intentP95Ms?: number;
+	    commitP50Ms?: number;
+	    commitP95Ms?: number;
+	    clientSyncP50Ms?: number;
+	    clientSyncP95Ms?: number;
+	    refreshP50Ms?: number;
+	    refreshP95Ms?: number;
+	    catalogP50Ms?: number;
+	    catalogP95Ms?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SelectionDiagnostics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.activeQueueDepth = source["activeQueueDepth"];
+	        this.maxQueueDepth = source["maxQueueDepth"];
+	        this.sampleCount = source["sampleCount"];
+	        this.totalMutations = source["totalMutations"];
+	        this.completedMutations = source["completedMutations"];
+	        this.failedMutations = source["failedMutations"];
+	        this.canceledMutations = source["canceledMutations"];
+	        this.supersededMutations = source["supersededMutations"];
+	        this.lastUpdatedMs = source["lastUpdatedMs"];
+	        this.lastReason = source["lastReason"];
+	        this.lastError = source["lastError"];
+	        this.lastQueueMs = source["lastQueueMs"];
+	        this.lastTotalMs = source["lastTotalMs"];
+	        this.queueP50Ms = source["queueP50Ms"];
+	        this.queueP95Ms = source["queueP95Ms"];
+	        this.totalP50Ms = source["totalP50Ms"];
+	        this.totalP95Ms = source["totalP95Ms"];
+	        this.intentP50Ms = source["intentP50Ms"];
+	        this.intentP95Ms = source["intentP95Ms"];
+	        this.commitP50Ms = source["commitP50Ms"];
+	        this.commitP95Ms = source["commitP95Ms"];
+	        this.clientSyncP50Ms = source["clientSyncP50Ms"];
+	        this.clientSyncP95Ms = source["clientSyncP95Ms"];
+	        this.refreshP50Ms = source["refreshP50Ms"];
+	        this.refreshP95Ms = source["refreshP95Ms"];
+	        this.catalogP50Ms = source["catalogP50Ms"];
+	        this.catalogP95Ms = source["catalogP95Ms"];
+	    }
+	}
+	
+	export class ShellCommandRule {
+	    image?: string;
+	    namespace?: string;
+	    command?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ShellCommandRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.image = source["image"];
+	        this.namespace = source["namespace"];
+	        this.command = source["command"];
+	    }
+	}
+	export class TableExportColumn {
+	    key: string;
+	    label?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TableExportColumn(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.label = source["label"];
+	    }
+	}
+	export class TableExportRequest {
+	    clusterId: string;
+	    domain: string;
+	    scope?: string;
+	    format: string;
+	    columns?: TableExportColumn[];
+	    defaultFilename?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TableExportRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.domain = source["domain"];
+	        this.scope = source["scope"];
+	        this.format = source["format"];
+	        this.columns = this.convertValues(source["columns"], TableExportColumn);
+	        this.defaultFilename = source["defaultFilename"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TableExportResult {
+	    path: string;
+	    format: string;
+	    rows: number;
+	    bytes: number;
+	    truncated?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new TableExportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.format = source["format"];
+	        this.rows = source["rows"];
+	        this.bytes = source["bytes"];
+	        this.truncated = source["truncated"];
+	    }
+	}
+	export class WorkloadImageScan {
+	    ref: resourcemodel.ResourceRef;
+	    containers: ContainerImageScan[];
+	    totals: vulnscan.Counts;
+	
+	    static createFrom(source: any = {}) {
+	        return new WorkloadImageScan(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.containers = this.convertValues(source["containers"], ContainerImageScan);
+	        this.totals = this.convertValues(source["totals"], vulnscan.Counts);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class YAMLBundleQuery {
+	    kinds?: string[];
+	    namespaces?: string[];
+	    groups?: string[];
+	    search?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new YAMLBundleQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kinds = source["kinds"];
+	        this.namespaces = source["namespaces"];
+	        this.groups = source["groups"];
+	        this.search = source["search"];
+	    }
+	}
+	export class YAMLBundleExportRequest {
+	    clusterId: string;
+	    objects?: resourcemodel.ResourceRef[];
+	    query?: YAMLBundleQuery;
+	    stripServerFields: boolean;
+	    includeSecretValues: boolean;
+	    layout: string;
+	    defaultFilename?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new YAMLBundleExportRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.objects = this.convertValues(source["objects"], resourcemodel.ResourceRef);
+	        this.query = this.convertValues(source["query"], YAMLBundleQuery);
+	        this.stripServerFields = source["stripServerFields"];
+	        this.includeSecretValues = source["includeSecretValues"];
+	        this.layout = source["layout"];
+	        this.defaultFilename = source["defaultFilename"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class YAMLBundleSkippedObject {
+	    ref: resourcemodel.ResourceRef;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new YAMLBundleSkippedObject(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class YAMLBundleExportResult {
+	    path: string;
+	    layout: string;
+	    objects: number;
+	    bytes: number;
+	    skipped?: YAMLBundleSkippedObject[];
+	
+	    static createFrom(source: any = {}) {
+	        return new YAMLBundleExportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.layout = source["layout"];
+	        this.objects = source["objects"];
+	        this.bytes = source["bytes"];
+	        this.skipped = this.convertValues(source["skipped"], YAMLBundleSkippedObject);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NamespaceBackupRequest {
+	    clusterId: string;
+	    namespace: string;
+	    includeSecrets: boolean;
+	    includeEvents: boolean;
+	    excludeKinds?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NamespaceBackupRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.includeSecrets = source["includeSecrets"];
+	        this.includeEvents = source["includeEvents"];
+	        this.excludeKinds = source["excludeKinds"];
+	    }
+	}
+	export class NamespaceBackupResult {
+	    path: string;
+	    namespace: string;
+	    objects: number;
+	    bytes: number;
+	    owned?: number;
+	    skipped?: YAMLBundleSkippedObject[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NamespaceBackupResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.namespace = source["namespace"];
+	        this.objects = source["objects"];
+	        this.bytes = source["bytes"];
+	        this.owned = source["owned"];
+	        this.skipped = this.convertValues(source["skipped"], YAMLBundleSkippedObject);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NamespaceRestoreRequest {
+	    clusterId: string;
+	    namespace?: string;
+	    createNamespace: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new NamespaceRestoreRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.createNamespace = source["createNamespace"];
+	    }
+	}
+	export class NamespaceRestoreResult {
+	    path: string;
+	    namespace: string;
+	    created: number;
+	    existing?: resourcemodel.ResourceRef[];
+	    failed?: YAMLBundleSkippedObject[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NamespaceRestoreResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.namespace = source["namespace"];
+	        this.created = source["created"];
+	        this.existing = this.convertValues(source["existing"], resourcemodel.ResourceRef);
+	        this.failed = this.convertValues(source["failed"], YAMLBundleSkippedObject);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+
+}
+
+export namespace backendtlspolicy {
+	
+	export class BackendTLSPolicyDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    details: string;
+	    targetRefs?: types.RefOrDisplay[];
+	    conditions?: types.ConditionState[];
+	    summary: types.ConditionsSummary;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new BackendTLSPolicyDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.details = source["details"];
+	        this.targetRefs = this.convertValues(source["targetRefs"], types.RefOrDisplay);
+	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
+	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace capabilities {
+	
+	export class NamespaceDiagnostics {
+	    key: string;
+	    clusterId: string;
+	    namespace?: string;
+	    method: string;
+	    ssrrIncomplete: boolean;
+	    ssrrRuleCount: number;
+	    ssarFallbackCount: number;
+	    checkCount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new NamespaceDiagnostics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.method = source["method"];
+	        this.ssrrIncomplete = source["ssrrIncomplete"];
+	        this.ssrrRuleCount = source["ssrrRuleCount"];
+	        this.ssarFallbackCount = source["ssarFallbackCount"];
+	        this.checkCount = source["checkCount"];
+	    }
+	}
+	export class PermissionQuery {
+	    id: string;
+	    clusterId: string;
+	    group?: string;
+	    version?: string;
+	    resourceKind: string;
+	    verb: string;
+	    namespace?: string;
+	    subresource?: string;
+	    name?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PermissionQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.clusterId = source["clusterId"];
+	        this.group = source["group"];
+	        this.version = source["version"];
+	        this.resourceKind = source["resourceKind"];
+	        this.verb = source["verb"];
+	        this.namespace = source["namespace"];
+	        this.subresource = source["subresource"];
+	        this.name = source["name"];
+	    }
+	}
+	export class PermissionResult {
+	    id: string;
+	    clusterId: string;
+	    group?: string;
+	    version?: string;
+	    resourceKind: string;
+	    verb: string;
+	    namespace?: string;
+	    subresource?: string;
+	    name?: string;
+	    allowed: boolean;
+	    source: string;
+	    reason?: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PermissionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.clusterId = source["clusterId"];
+	        this.group = source["group"];
+	        this.version = source["version"];
+	        this.resourceKind = source["resourceKind"];
+	        this.verb = source["verb"];
+	        this.namespace = source["namespace"];
+	        this.subresource = source["subresource"];
+	        this.name = source["name"];
+	        this.allowed = source["allowed"];
+	        this.source = source["source"];
+	        this.reason = source["reason"];
+	        this.error = source["error"];
+	    }
+	}
+	export class QueryPermissionsResponse {
+	    results: PermissionResult[];
+	    diagnostics: NamespaceDiagnostics[];
+	
+	    static createFrom(source: any = {}) {
+	        return new QueryPermissionsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], PermissionResult);
+	        this.diagnostics = this.convertValues(source["diagnostics"], NamespaceDiagnostics);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace certexpiry {
+	
+	export class Finding {
+	    ref: resourcemodel.ResourceRef;
+	    source: string;
+	    location: string;
+	    subject?: string;
+	    issuer?: string;
+	    // Go type: time
+	    notAfter: any;
+	    daysRemaining: number;
+	    status: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Finding(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.source = source["source"];
+	        this.location = source["location"];
+	        this.subject = source["subject"];
+	        this.issuer = source["issuer"];
+	        this.notAfter = this.convertValues(source["notAfter"], null);
+	        this.daysRemaining = source["daysRemaining"];
+	        this.status = source["status"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Report {
+	    clusterId: string;
+	    // Go type: time
+	    generatedAt: any;
+	    warningWindowDays: number;
+	    expiredCount: number;
+	    expiringCount: number;
+	    findings: Finding[];
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.generatedAt = this.convertValues(source["generatedAt"], null);
+	        this.warningWindowDays = source["warningWindowDays"];
+	        this.expiredCount = source["expiredCount"];
+	        this.expiringCount = source["expiringCount"];
+	        this.findings = this.convertValues(source["findings"], Finding);
+	        this.warnings = source["warnings"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace clusterinfo {
+	
+	export class APIGroup {
+	    name: string;
+	    preferredVersion: string;
+	    versions: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new APIGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.preferredVersion = source["preferredVersion"];
+	        this.versions = source["versions"];
+	    }
+	}
+	export class Info {
+	    clusterId: string;
+	    // Go type: time
+	    generatedAt: any;
+	    server: ServerVersion;
+	    apiGroups: APIGroup[];
+	    nodeCount: number;
+	    kubeletVersions: KubeletVersion[];
+	    skew: SkewFinding[];
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Info(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.generatedAt = this.convertValues(source["generatedAt"], null);
+	        this.server = this.convertValues(source["server"], ServerVersion);
+	        this.apiGroups = this.convertValues(source["apiGroups"], APIGroup);
+	        this.nodeCount = source["nodeCount"];
+	        this.kubeletVersions = this.convertValues(source["kubeletVersions"], KubeletVersion);
+	        this.skew = this.convertValues(source["skew"], SkewFinding);
+	        this.warnings = source["warnings"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class KubeletVersion {
+	    version: string;
+	    count: number;
+	    nodes: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KubeletVersion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.count = source["count"];
+	        this.nodes = source["nodes"];
+	    }
+	}
+	export class ServerVersion {
+	    gitVersion: string;
+	    major: string;
+	    minor: string;
+	    platform: string;
+	    goVersion?: string;
+	    buildDate?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerVersion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.gitVersion = source["gitVersion"];
+	        this.major = source["major"];
+	        this.minor = source["minor"];
+	        this.platform = source["platform"];
+	        this.goVersion = source["goVersion"];
+	        this.buildDate = source["buildDate"];
+	    }
+	}
+	export class SkewFinding {
+	    severity: string;
+	    kubeletVersion: string;
+	    minorsBehind: number;
+	    nodes: string[];
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SkewFinding(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.severity = source["severity"];
+	        this.kubeletVersion = source["kubeletVersion"];
+	        this.minorsBehind = source["minorsBehind"];
+	        this.nodes = source["nodes"];
+	        this.message = source["message"];
+	    }
+	}
+}
+
+export namespace clusterrole {
+	
+	export class AggregationRule {
+	    clusterRoleSelectors?: any[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AggregationRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterRoleSelectors = source["clusterRoleSelectors"];
+	    }
+	}
+	export class ClusterRoleDetails {
+	    kind: string;
+	    name: string;
+	    details: string;
+	    rules: types.PolicyRule[];
+	    aggregationRule?: AggregationRule;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    clusterRoleBindings?: resourcemodel.ResourceRef[];
+	    roleBindings?: resourcemodel.ResourceRef[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterRoleDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.details = source["details"];
+	        this.rules = this.convertValues(source["rules"], types.PolicyRule);
+	        this.aggregationRule = this.convertValues(source["aggregationRule"], AggregationRule);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.clusterRoleBindings = this.convertValues(source["clusterRoleBindings"], resourcemodel.ResourceRef);
+	        this.roleBindings = this.convertValues(source["roleBindings"], resourcemodel.ResourceRef);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace clusterrolebinding {
+	
+	export class ClusterRoleBindingDetails {
+	    kind: string;
+	    name: string;
+	    details: string;
+	    roleRef: types.RoleRef;
+	    subjects: types.Subject[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClusterRoleBindingDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.details = source["details"];
+	        this.roleRef = this.convertValues(source["roleRef"], types.RoleRef);
+	        this.subjects = this.convertValues(source["subjects"], types.Subject);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace confighistory {
+	
+	export class Diff {
+	    clusterId: string;
+	    namespace: string;
+	    kind: string;
+	    name: string;
+	    fromRevision: number;
+	    toRevision: number;
+	    changes: KeyChange[];
+	    valuesAvailable: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Diff(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.fromRevision = source["fromRevision"];
+	        this.toRevision = source["toRevision"];
+	        this.changes = this.convertValues(source["changes"], KeyChange);
+	        this.valuesAvailable = source["valuesAvailable"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class History {
+	    clusterId: string;
+	    namespace: string;
+	    kind: string;
+	    name: string;
+	    revisions: Revision[];
+	    // Go type: time
+	    observedSince: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new History(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.revisions = this.convertValues(source["revisions"], Revision);
+	        this.observedSince = this.convertValues(source["observedSince"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class KeyChange {
+	    key: string;
+	    change: string;
+	    from?: string;
+	    to?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeyChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.change = source["change"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	    }
+	}
+	export class Revision {
+	    revision: number;
+	    // Go type: time
+	    observedAt: any;
+	    baseline?: boolean;
+	    deleted?: boolean;
+	    added?: string[];
+	    removed?: string[];
+	    changed?: string[];
+	    hasValues?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Revision(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.revision = source["revision"];
+	        this.observedAt = this.convertValues(source["observedAt"], null);
+	        this.baseline = source["baseline"];
+	        this.deleted = source["deleted"];
+	        this.added = source["added"];
+	        this.removed = source["removed"];
+	        this.changed = source["changed"];
+	        this.hasValues = source["hasValues"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+}
+
+export namespace configmap {
+	
+	export class ConfigMapDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    details: string;
+	    keys: types.DataKeyInfo[];
+	    dataCount: number;
+	    dataSize: number;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    usedBy?: resourcemodel.ResourceRef[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ConfigMapDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.details = source["details"];
+	        this.keys = this.convertValues(source["keys"], types.DataKeyInfo);
+	        this.dataCount = source["dataCount"];
+	        this.dataSize = source["dataSize"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.usedBy = this.convertValues(source["usedBy"], resourcemodel.ResourceRef);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace crashhistory {
+	
+	export class Event {
+	    namespace: string;
+	    pod: string;
+	    container: string;
+	    init?: boolean;
+	    restartCount: number;
+	    exitCode: number;
+	    signal?: number;
+	    reason?: string;
+	    // Go type: time
+	    startedAt: any;
+	    // Go type: time
+	    finishedAt: any;
+	    missed?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Event(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.pod = source["pod"];
+	        this.container = source["container"];
+	        this.init = source["init"];
+	        this.restartCount = source["restartCount"];
+	        this.exitCode = source["exitCode"];
+	        this.signal = source["signal"];
+	        this.reason = source["reason"];
+	        this.startedAt = this.convertValues(source["startedAt"], null);
+	        this.finishedAt = this.convertValues(source["finishedAt"], null);
+	        this.missed = source["missed"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Timeline {
+	    clusterId: string;
+	    namespace: string;
+	    kind: string;
+	    name: string;
+	    events: Event[];
+	    // Go type: time
+	    observedSince: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Timeline(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.events = this.convertValues(source["events"], Event);
+	        this.observedSince = this.convertValues(source["observedSince"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+}
+
+export namespace cronjob {
+	
+	export class SchedulePreview {
+	    description?: string;
+	    timeZone: string;
+	    defaultTimeZone?: boolean;
+	    nextRuns?: string[];
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulePreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.description = source["description"];
+	        this.timeZone = source["timeZone"];
+	        this.defaultTimeZone = source["defaultTimeZone"];
+	        this.nextRuns = source["nextRuns"];
+	        this.error = source["error"];
+	    }
+	}
+	export class CronJobDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    details: string;
+	    schedule: string;
+	    suspend: boolean;
+	    lastScheduleTime?: v1.Time;
+	    lastSuccessfulTime?: v1.Time;
+	    schedulePreview?: SchedulePreview;
+	    lastManualTime?: v1.Time;
+	    lastFailureTime?: v1.Time;
+	    concurrencyPolicy: string;
+	    startingDeadlineSeconds?: number;
+	    successfulJobsHistory: number;
+	    failedJobsHistory: number;
+	    activeJobs?: types.JobReference[];
+	    jobs?: types.JobSimpleInfo[];
+	    jobTemplate: types.JobTemplateDetails;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    pods?: types.PodSimpleInfo[];
+	    podMetricsSummary?: types.PodMetricsSummary;
+	
+	    static createFrom(source: any = {}) {
+	        return new CronJobDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.details = source["details"];
+	        this.schedule = source["schedule"];
+	        this.suspend = source["suspend"];
+	        this.lastScheduleTime = this.convertValues(source["lastScheduleTime"], v1.Time);
+	        this.lastSuccessfulTime = this.convertValues(source["lastSuccessfulTime"], v1.Time);
+	        this.schedulePreview = this.convertValues(source["schedulePreview"], SchedulePreview);
+	        this.lastManualTime = this.convertValues(source["lastManualTime"], v1.Time);
+	        this.lastFailureTime = this.convertValues(source["lastFailureTime"], v1.Time);
+	        this.concurrencyPolicy = source["concurrencyPolicy"];
+	        this.startingDeadlineSeconds = source["startingDeadlineSeconds"];
+	        this.successfulJobsHistory = source["successfulJobsHistory"];
+	        this.failedJobsHistory = source["failedJobsHistory"];
+	        this.activeJobs = this.convertValues(source["activeJobs"], types.JobReference);
+	        this.jobs = this.convertValues(source["jobs"], types.JobSimpleInfo);
+	        this.jobTemplate = this.convertValues(source["jobTemplate"], types.JobTemplateDetails);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
+	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace daemonset {
+	
+	export class DaemonSetDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    details: string;
+	    desired: number;
+	    current: number;
+	    ready: number;
+	    upToDate?: number;
+	    available: number;
+	    updated?: number;
+	    cpuRequest?: string;
+	    cpuLimit?: string;
+	    cpuUsage?: string;
+	    memRequest?: string;
+	    memLimit?: string;
+	    memUsage?: string;
+	    updateStrategy?: string;
+	    maxUnavailable?: string;
+	    maxSurge?: string;
+	    minReadySeconds?: number;
+	    revisionHistoryLimit?: number;
+	    serviceAccount?: string;
+	    selector?: Record<string, string>;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    nodeSelector?: Record<string, string>;
+	    tolerations?: string[];
+	    conditions?: string[];
+	    containers?: types.PodDetailInfoContainer[];
+	    initContainers?: types.PodDetailInfoContainer[];
+	    pods?: types.PodSimpleInfo[];
+	    podMetricsSummary?: types.PodMetricsSummary;
+	    observedGeneration?: number;
+	    numberMisscheduled?: number;
+	    collisionCount?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DaemonSetDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.details = source["details"];
+	        this.desired = source["desired"];
+	        this.current = source["current"];
+	        this.ready = source["ready"];
+	        this.upToDate = source["upToDate"];
+	        this.available = source["available"];
+	        this.updated = source["updated"];
+	        this.cpuRequest = source["cpuRequest"];
+	        this.cpuLimit = source["cpuLimit"];
+	        this.cpuUsage = source["cpuUsage"];
+	        this.memRequest = source["memRequest"];
+	        this.memLimit = source["memLimit"];
+	        this.memUsage = source["memUsage"];
+	        this.updateStrategy = source["updateStrategy"];
+	        this.maxUnavailable = source["maxUnavailable"];
+	        this.maxSurge = source["maxSurge"];
+	        this.minReadySeconds = source["minReadySeconds"];
+	        this.revisionHistoryLimit = source["revisionHistoryLimit"];
+	        this.serviceAccount = source["serviceAccount"];
+	        this.selector = source["selector"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.nodeSelector = source["nodeSelector"];
+	        this.tolerations = source["tolerations"];
+	        this.conditions = source["conditions"];
+	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
+	        this.initContainers = this.convertValues(source["initContainers"], types.PodDetailInfoContainer);
+	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
+	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
+	        this.observedGeneration = source["observedGeneration"];
+	        this.numberMisscheduled = source["numberMisscheduled"];
+	        this.collisionCount = source["collisionCount"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace deployment {
+	
+	export class DeploymentDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    details: string;
+	    replicas: string;
+	    ready: string;
+	    updated?: string;
+	    upToDate?: number;
+	    available: number;
+	    desiredReplicas: number;
+	    cpuRequest?: string;
+	    cpuLimit?: string;
+	    cpuUsage?: string;
+	    memRequest?: string;
+	    memLimit?: string;
+	    memUsage?: string;
+	    strategy?: string;
+	    maxSurge?: string;
+	    maxUnavailable?: string;
+	    minReadySeconds?: number;
+	    revisionHistory?: number;
+	    progressDeadline?: number;
+	    serviceAccount?: string;
+	    nodeSelector?: Record<string, string>;
+	    tolerations?: string[];
+	    selector?: Record<string, string>;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    conditions?: string[];
+	    containers?: types.PodDetailInfoContainer[];
+	    initContainers?: types.PodDetailInfoContainer[];
+	    pods?: types.PodSimpleInfo[];
+	    podMetricsSummary?: types.PodMetricsSummary;
+	    currentRevision?: string;
+	    currentReplicaSet?: string;
+	    replicaSets?: string[];
+	    replicaSetSummaries?: types.ReplicaSetSummary[];
+	    observedGeneration?: number;
+	    paused?: boolean;
+	    rolloutStatus?: string;
+	    rolloutMessage?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeploymentDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.details = source["details"];
+	        this.replicas = source["replicas"];
+	        this.ready = source["ready"];
+	        this.updated = source["updated"];
+	        this.upToDate = source["upToDate"];
+	        this.available = source["available"];
+	        this.desiredReplicas = source["desiredReplicas"];
+	        this.cpuRequest = source["cpuRequest"];
+	        this.cpuLimit = source["cpuLimit"];
+	        this.cpuUsage = source["cpuUsage"];
+	        this.memRequest = source["memRequest"];
+	        this.memLimit = source["memLimit"];
+	        this.memUsage = source["memUsage"];
+	        this.strategy = source["strategy"];
+	        this.maxSurge = source["maxSurge"];
+	        this.maxUnavailable = source["maxUnavailable"];
+	        this.minReadySeconds = source["minReadySeconds"];
+	        this.revisionHistory = source["revisionHistory"];
+	        this.progressDeadline = source["progressDeadline"];
+	        this.serviceAccount = source["serviceAccount"];
+	        this.nodeSelector = source["nodeSelector"];
+	        this.tolerations = source["tolerations"];
+	        this.selector = source["selector"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.conditions = source["conditions"];
+	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
+	        this.initContainers = this.convertValues(source["initContainers"], types.PodDetailInfoContainer);
+	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
+	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
+	        this.currentRevision = source["currentRevision"];
+	        this.currentReplicaSet = source["currentReplicaSet"];
+	        this.replicaSets = source["replicaSets"];
+	        this.replicaSetSummaries = this.convertValues(source["replicaSetSummaries"], types.ReplicaSetSummary);
+	        this.observedGeneration = source["observedGeneration"];
+	        this.paused = source["paused"];
+	        this.rolloutStatus = source["rolloutStatus"];
+	        this.rolloutMessage = source["rolloutMessage"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace endpointslice {
+	
+	export class EndpointSliceAddress {
+	    ip: string;
+	    hostname?: string;
+	    nodeName?: string;
+	    targetRef?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EndpointSliceAddress(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ip = source["ip"];
+	        this.hostname = source["hostname"];
+	        this.nodeName = source["nodeName"];
+	        this.targetRef = source["targetRef"];
+	    }
+	}
+	export class EndpointSlicePort {
+	    name?: string;
+	    port: number;
+	    protocol: string;
+	    appProtocol?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EndpointSlicePort(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
+	        this.appProtocol = source["appProtocol"];
+	    }
+	}
+	export class EndpointSliceDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    details: string;
+	    addressType: string;
+	    readyAddresses?: EndpointSliceAddress[];
+	    notReadyAddresses?: EndpointSliceAddress[];
+	    ports?: EndpointSlicePort[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new EndpointSliceDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.details = source["details"];
+	        this.addressType = source["addressType"];
+	        this.readyAddresses = this.convertValues(source["readyAddresses"], EndpointSliceAddress);
+	        this.notReadyAddresses = this.convertValues(source["notReadyAddresses"], EndpointSliceAddress);
+	        this.ports = this.convertValues(source["ports"], EndpointSlicePort);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace events {
+	
+	export class Event {
+	    kind: string;
+	    name: string;
+	    eventType: string;
+	    reason: string;
+	    message: string;
+	    count: number;
+	    // Go type: time
+	    firstTimestamp: any;
+	    // Go type: time
+	    lastTimestamp: any;
+	    source: string;
+	    involvedObjectName: string;
+	    involvedObjectKind: string;
+	    namespace: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Event(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.eventType = source["eventType"];
+	        this.reason = source["reason"];
+	        this.message = source["message"];
+	        this.count = source["count"];
+	        this.firstTimestamp = this.convertValues(source["firstTimestamp"], null);
+	        this.lastTimestamp = this.convertValues(source["lastTimestamp"], null);
+	        this.source = source["source"];
+	        this.involvedObjectName = source["involvedObjectName"];
+	        this.involvedObjectKind = source["involvedObjectKind"];
+	        this.namespace = source["namespace"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EventDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    eventType: string;
+	    reason?: string;
+	    message?: string;
+	    count: number;
+	    firstTimestamp: v1.Time;
+	    lastTimestamp: v1.Time;
+	    eventTime?: v1.Time;
+	    seriesCount?: number;
+	    seriesLastObservedTime?: v1.Time;
+	    source?: string;
+	    action?: string;
+	    reportingController?: string;
+	    reportingInstance?: string;
+	    involvedObject?: resourcemodel.ResourceLink;
+	    involvedObjectFieldPath?: string;
+	    relatedObject?: resourcemodel.ResourceLink;
+	    relatedObjectFieldPath?: string;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new EventDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.eventType = source["eventType"];
+	        this.reason = source["reason"];
+	        this.message = source["message"];
+	        this.count = source["count"];
+	        this.firstTimestamp = this.convertValues(source["firstTimestamp"], v1.Time);
+	        this.lastTimestamp = this.convertValues(source["lastTimestamp"], v1.Time);
+	        this.eventTime = this.convertValues(source["eventTime"], v1.Time);
+	        this.seriesCount = source["seriesCount"];
+	        this.seriesLastObservedTime = this.convertValues(source["seriesLastObservedTime"], v1.Time);
+	        this.source = source["source"];
+	        this.action = source["action"];
+	        this.reportingController = source["reportingController"];
+	        this.reportingInstance = source["reportingInstance"];
+	        this.involvedObject = this.convertValues(source["involvedObject"], resourcemodel.ResourceLink);
+	        this.involvedObjectFieldPath = source["involvedObjectFieldPath"];
+	        this.relatedObject = this.convertValues(source["relatedObject"], resourcemodel.ResourceLink);
+	        this.relatedObjectFieldPath = source["relatedObjectFieldPath"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace finishedcleanup {
+	
+	export class Candidate {
+	    kind: string;
+	    namespace: string;
+	    name: string;
+	    outcome: string;
+	    reason?: string;
+	    owner?: string;
+	    // Go type: time
+	    finishedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Candidate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.outcome = source["outcome"];
+	        this.reason = source["reason"];
+	        this.owner = source["owner"];
+	        this.finishedAt = this.convertValues(source["finishedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Filter {
+	    namespaces?: string[];
+	    minAgeMinutes?: number;
+	    pods: boolean;
+	    jobs: boolean;
+	    outcomes?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Filter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespaces = source["namespaces"];
+	        this.minAgeMinutes = source["minAgeMinutes"];
+	        this.pods = source["pods"];
+	        this.jobs = source["jobs"];
+	        this.outcomes = source["outcomes"];
+	    }
+	}
+}
+
+export namespace gateway {
+	
+	export class GatewayDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    details: string;
+	    gatewayClassRef: resourcemodel.ResourceRef;
+	    addresses?: string[];
+	    listeners?: types.GatewayListenerDetails[];
+	    conditions?: types.ConditionState[];
+	    summary: types.ConditionsSummary;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new GatewayDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.details = source["details"];
+	        this.gatewayClassRef = this.convertValues(source["gatewayClassRef"], resourcemodel.ResourceRef);
+	        this.addresses = source["addresses"];
+	        this.listeners = this.convertValues(source["listeners"], types.GatewayListenerDetails);
+	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
+	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -834,18 +4671,39 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class FavoritePaneState {
-	    filters: FavoriteFilters;
-	    tableState: FavoriteTableState;
+
+}
+
+export namespace gatewayclass {
+	
+	export class GatewayClassDetails {
+	    kind: string;
+	    name: string;
+	    controller: string;
+	    details: string;
+	    conditions?: types.ConditionState[];
+	    summary: types.ConditionsSummary;
+	    parameters?: types.RefOrDisplay;
+	    usedBy?: resourcemodel.ResourceRef[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new FavoritePaneState(source);
+	        return new GatewayClassDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.filters = this.convertValues(source["filters"], FavoriteFilters);
-	        this.tableState = this.convertValues(source["tableState"], FavoriteTableState);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.controller = source["controller"];
+	        this.details = source["details"];
+	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
+	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
+	        this.parameters = this.convertValues(source["parameters"], types.RefOrDisplay);
+	        this.usedBy = this.convertValues(source["usedBy"], resourcemodel.ResourceRef);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -866,34 +4724,64 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class Favorite {
-	    id: string;
-	    name: string;
-	    clusterSelection: string;
-	    clusterId?: string;
-	    clusterName?: string;
-	    viewType: string;
-	    view: string;
-	    namespace: string;
-	    panes: Record<string, FavoritePaneState>;
-	    order: number;
+
+}
+
+export namespace gitdrift {
+	
+	export class Object {
+	    source?: string;
+	    apiVersion?: string;
+	    kind?: string;
+	    namespace?: string;
+	    name?: string;
+	    status: string;
+	    diff?: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new Favorite(source);
+	        return new Object(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
+	        this.source = source["source"];
+	        this.apiVersion = source["apiVersion"];
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
 	        this.name = source["name"];
-	        this.clusterSelection = source["clusterSelection"];
-	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	        this.viewType = source["viewType"];
-	        this.view = source["view"];
+	        this.status = source["status"];
+	        this.diff = source["diff"];
+	        this.error = source["error"];
+	    }
+	}
+	export class Report {
+	    namespace: string;
+	    repo: string;
+	    path?: string;
+	    pull?: boolean;
+	    commit?: string;
+	    // Go type: time
+	    checkedAt: any;
+	    error?: string;
+	    objects: Object[];
+	    counts: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.namespace = source["namespace"];
-	        this.panes = this.convertValues(source["panes"], FavoritePaneState, true);
-	        this.order = source["order"];
+	        this.repo = source["repo"];
+	        this.path = source["path"];
+	        this.pull = source["pull"];
+	        this.commit = source["commit"];
+	        this.checkedAt = this.convertValues(source["checkedAt"], null);
+	        this.error = source["error"];
+	        this.objects = this.convertValues(source["objects"], Object);
+	        this.counts = source["counts"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -914,128 +4802,124 @@ export namespace backend {
 		    return a;
 		}
 	}
-	
-	
-	
-	
-	export class KubernetesAPIClientDiagnostics {
-	    clusterId: string;
-	    clusterName: string;
-	    configuredQPS: number;
-	    configuredBurst: number;
-	    qps1s: number;
-	    qps10s: number;
-	    qps60s: number;
-	    peakQPS1s: number;
-	    totalRequests: number;
-	    status2xx: number;
-	    status3xx: number;
-	    status4xx: number;
-	    status5xx: number;
-	    status429: number;
-	    errors: number;
-	    lastRequestMs?: number;
+	export class Source {
+	    namespace: string;
+	    repo: string;
+	    path?: string;
+	    pull?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new KubernetesAPIClientDiagnostics(source);
+	        return new Source(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	        this.configuredQPS = source["configuredQPS"];
-	        this.configuredBurst = source["configuredBurst"];
-	        this.qps1s = source["qps1s"];
-	        this.qps10s = source["qps10s"];
-	        this.qps60s = source["qps60s"];
-	        this.peakQPS1s = source["peakQPS1s"];
-	        this.totalRequests = source["totalRequests"];
-	        this.status2xx = source["status2xx"];
-	        this.status3xx = source["status3xx"];
-	        this.status4xx = source["status4xx"];
-	        this.status5xx = source["status5xx"];
-	        this.status429 = source["status429"];
-	        this.errors = source["errors"];
-	        this.lastRequestMs = source["lastRequestMs"];
+	        this.namespace = source["namespace"];
+	        this.repo = source["repo"];
+	        this.path = source["path"];
+	        this.pull = source["pull"];
 	    }
 	}
-	export class LogEntry {
-	    sequence: number;
-	    timestamp: string;
-	    level: string;
-	    message: string;
-	    source?: string;
-	    clusterId?: string;
-	    clusterName?: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new LogEntry(source);
-	    }
+}
+
+export namespace helm {
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.sequence = source["sequence"];
-	        this.timestamp = source["timestamp"];
-	        this.level = source["level"];
-	        this.message = source["message"];
-	        this.source = source["source"];
-	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	    }
-	}
-	export class ObjectActionDebugContainerOptions {
-	    image: string;
-	    targetContainer?: string;
+	export class HelmResource {
+	    kind: string;
+	    apiVersion?: string;
+	    name: string;
+	    namespace: string;
+	    scope?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectActionDebugContainerOptions(source);
+	        return new HelmResource(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.image = source["image"];
-	        this.targetContainer = source["targetContainer"];
+	        this.kind = source["kind"];
+	        this.apiVersion = source["apiVersion"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.scope = source["scope"];
 	    }
 	}
-	export class ObjectActionPortForwardOptions {
-	    containerPort: number;
-	    localPort: number;
+	export class HelmRevision {
+	    revision: number;
+	    updated: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    chart: string;
+	    appVersion?: string;
+	    description?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectActionPortForwardOptions(source);
+	        return new HelmRevision(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.containerPort = source["containerPort"];
-	        this.localPort = source["localPort"];
+	        this.revision = source["revision"];
+	        this.updated = source["updated"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.chart = source["chart"];
+	        this.appVersion = source["appVersion"];
+	        this.description = source["description"];
 	    }
 	}
-	export class ObjectActionRequest {
-	    action: string;
-	    target: resourcemodel.ResourceRef;
-	    replicas?: number;
-	    suspend?: boolean;
-	    drainOptions?: types.DrainNodeOptions;
-	    portForward?: ObjectActionPortForwardOptions;
-	    debugContainer?: ObjectActionDebugContainerOptions;
-	    revision?: number;
+	export class HelmReleaseDetails {
+	    kind: string;
+	    typeAlias: string;
+	    name: string;
+	    namespace: string;
+	    chart: string;
+	    version: string;
+	    appVersion: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    revision: number;
+	    updated: string;
+	    description?: string;
+	    notes?: string;
+	    values?: Record<string, any>;
+	    history?: HelmRevision[];
+	    resources?: HelmResource[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectActionRequest(source);
+	        return new HelmReleaseDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.action = source["action"];
-	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
-	        this.replicas = source["replicas"];
-	        this.suspend = source["suspend"];
-	        this.drainOptions = this.convertValues(source["drainOptions"], types.DrainNodeOptions);
-	        this.portForward = this.convertValues(source["portForward"], ObjectActionPortForwardOptions);
-	        this.debugContainer = this.convertValues(source["debugContainer"], ObjectActionDebugContainerOptions);
+	        this.kind = source["kind"];
+	        this.typeAlias = source["typeAlias"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.chart = source["chart"];
+	        this.version = source["version"];
+	        this.appVersion = source["appVersion"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
 	        this.revision = source["revision"];
+	        this.updated = source["updated"];
+	        this.description = source["description"];
+	        this.notes = source["notes"];
+	        this.values = source["values"];
+	        this.history = this.convertValues(source["history"], HelmRevision);
+	        this.resources = this.convertValues(source["resources"], HelmResource);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1056,22 +4940,40 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class ObjectActionResponse {
-	    name?: string;
-	    jobId?: string;
-	    sessionId?: string;
-	    debugContainer?: types.DebugContainerResponse;
+	
+
+}
+
+export namespace hpa {
+	
+	export class ScalingRules {
+	    stabilizationWindowSeconds?: number;
+	    selectPolicy?: string;
+	    policies?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectActionResponse(source);
+	        return new ScalingRules(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.jobId = source["jobId"];
-	        this.sessionId = source["sessionId"];
-	        this.debugContainer = this.convertValues(source["debugContainer"], types.DebugContainerResponse);
+	        this.stabilizationWindowSeconds = source["stabilizationWindowSeconds"];
+	        this.selectPolicy = source["selectPolicy"];
+	        this.policies = source["policies"];
+	    }
+	}
+	export class ScalingBehavior {
+	    scaleUp?: ScalingRules;
+	    scaleDown?: ScalingRules;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScalingBehavior(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.scaleUp = this.convertValues(source["scaleUp"], ScalingRules);
+	        this.scaleDown = this.convertValues(source["scaleDown"], ScalingRules);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1092,70 +4994,104 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class ObjectYAMLMutationRequest {
-	    baseYAML: string;
-	    yaml: string;
+	export class MetricStatus {
 	    kind: string;
-	    apiVersion: string;
-	    namespace: string;
-	    name: string;
-	    uid: string;
-	    resourceVersion: string;
+	    current: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLMutationRequest(source);
+	        return new MetricStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.baseYAML = source["baseYAML"];
-	        this.yaml = source["yaml"];
 	        this.kind = source["kind"];
-	        this.apiVersion = source["apiVersion"];
-	        this.namespace = source["namespace"];
-	        this.name = source["name"];
-	        this.uid = source["uid"];
-	        this.resourceVersion = source["resourceVersion"];
+	        this.current = source["current"];
 	    }
 	}
-	export class ObjectYAMLMutationResponse {
-	    resourceVersion: string;
+	export class MetricStatusFacts {
+	    kind: string;
+	    current?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLMutationResponse(source);
+	        return new MetricStatusFacts(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.resourceVersion = source["resourceVersion"];
+	        this.kind = source["kind"];
+	        this.current = source["current"];
 	    }
 	}
-	export class ObjectYAMLOwnershipConflict {
-	    field: string;
-	    manager: string;
-	    message: string;
+	export class MetricSpec {
+	    kind: string;
+	    target: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLOwnershipConflict(source);
+	        return new MetricSpec(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.field = source["field"];
-	        this.manager = source["manager"];
-	        this.message = source["message"];
+	        this.kind = source["kind"];
+	        this.target = source["target"];
 	    }
 	}
-	export class ObjectYAMLOwnershipCheckResponse {
-	    conflicts: ObjectYAMLOwnershipConflict[];
+	export class ScaleTargetReference {
+	    kind: string;
+	    name: string;
+	    apiVersion?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLOwnershipCheckResponse(source);
+	        return new ScaleTargetReference(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.conflicts = this.convertValues(source["conflicts"], ObjectYAMLOwnershipConflict);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.apiVersion = source["apiVersion"];
+	    }
+	}
+	export class HorizontalPodAutoscalerDetails {
+	    kind: string;
+	    name: string;
+	    namespace: string;
+	    details: string;
+	    scaleTargetRef: ScaleTargetReference;
+	    minReplicas?: number;
+	    maxReplicas: number;
+	    currentReplicas: number;
+	    desiredReplicas: number;
+	    metrics: MetricSpec[];
+	    currentMetrics?: MetricStatus[];
+	    behavior?: ScalingBehavior;
+	    conditions?: string[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    lastScaleTime?: v1.Time;
+	
+	    static createFrom(source: any = {}) {
+	        return new HorizontalPodAutoscalerDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.details = source["details"];
+	        this.scaleTargetRef = this.convertValues(source["scaleTargetRef"], ScaleTargetReference);
+	        this.minReplicas = source["minReplicas"];
+	        this.maxReplicas = source["maxReplicas"];
+	        this.currentReplicas = source["currentReplicas"];
+	        this.desiredReplicas = source["desiredReplicas"];
+	        this.metrics = this.convertValues(source["metrics"], MetricSpec);
+	        this.currentMetrics = this.convertValues(source["currentMetrics"], MetricStatus);
+	        this.behavior = this.convertValues(source["behavior"], ScalingBehavior);
+	        this.conditions = source["conditions"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.lastScaleTime = this.convertValues(source["lastScaleTime"], v1.Time);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1177,132 +5113,168 @@ export namespace backend {
 		}
 	}
 	
-	export class ObjectYAMLReloadMergeRequest {
-	    baseYAML: string;
-	    draftYAML: string;
-	    kind: string;
-	    apiVersion: string;
-	    namespace: string;
-	    name: string;
-	    uid: string;
 	
-	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLReloadMergeRequest(source);
-	    }
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.baseYAML = source["baseYAML"];
-	        this.draftYAML = source["draftYAML"];
-	        this.kind = source["kind"];
-	        this.apiVersion = source["apiVersion"];
-	        this.namespace = source["namespace"];
-	        this.name = source["name"];
-	        this.uid = source["uid"];
-	    }
-	}
-	export class ObjectYAMLReloadMergeResponse {
-	    mergedYAML: string;
-	    currentYAML: string;
-	    resourceVersion: string;
+	
+
+}
+
+export namespace hpahistory {
+	
+	export class Event {
+	    // Go type: time
+	    time: any;
+	    type: string;
+	    reason: string;
+	    message: string;
+	    count: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ObjectYAMLReloadMergeResponse(source);
+	        return new Event(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.mergedYAML = source["mergedYAML"];
-	        this.currentYAML = source["currentYAML"];
-	        this.resourceVersion = source["resourceVersion"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.type = source["type"];
+	        this.reason = source["reason"];
+	        this.message = source["message"];
+	        this.count = source["count"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PortForwardSession {
-	    id: string;
-	    clusterId: string;
-	    clusterName: string;
-	    namespace: string;
-	    podName: string;
-	    containerPort: number;
-	    localPort: number;
-	    targetKind: string;
-	    targetGroup: string;
-	    targetVersion: string;
-	    targetName: string;
-	    status: string;
-	    statusReason?: string;
-	    startedAt: string;
+	export class Sample {
+	    // Go type: time
+	    time: any;
+	    currentReplicas: number;
+	    desiredReplicas: number;
+	    minReplicas: number;
+	    maxReplicas: number;
+	    metrics?: hpa.MetricStatusFacts[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PortForwardSession(source);
+	        return new Sample(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	        this.namespace = source["namespace"];
-	        this.podName = source["podName"];
-	        this.containerPort = source["containerPort"];
-	        this.localPort = source["localPort"];
-	        this.targetKind = source["targetKind"];
-	        this.targetGroup = source["targetGroup"];
-	        this.targetVersion = source["targetVersion"];
-	        this.targetName = source["targetName"];
-	        this.status = source["status"];
-	        this.statusReason = source["statusReason"];
-	        this.startedAt = source["startedAt"];
+	        this.time = this.convertValues(source["time"], null);
+	        this.currentReplicas = source["currentReplicas"];
+	        this.desiredReplicas = source["desiredReplicas"];
+	        this.minReplicas = source["minReplicas"];
+	        this.maxReplicas = source["maxReplicas"];
+	        this.metrics = this.convertValues(source["metrics"], hpa.MetricStatusFacts);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class RevisionEntry {
-	    revision: number;
-	    createdAt: string;
-	    changeCause: string;
-	    current: boolean;
-	    podTemplate: string;
+	export class Timeline {
+	    clusterId: string;
+	    namespace: string;
+	    name: string;
+	    samples: Sample[];
+	    events: Event[];
+	    rescales: number;
+	    directionChanges: number;
+	    // Go type: time
+	    observedSince: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new RevisionEntry(source);
+	        return new Timeline(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.revision = source["revision"];
-	        this.createdAt = source["createdAt"];
-	        this.changeCause = source["changeCause"];
-	        this.current = source["current"];
-	        this.podTemplate = source["podTemplate"];
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.samples = this.convertValues(source["samples"], Sample);
+	        this.events = this.convertValues(source["events"], Event);
+	        this.rescales = source["rescales"];
+	        this.directionChanges = source["directionChanges"];
+	        this.observedSince = this.convertValues(source["observedSince"], null);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class RuntimeOperation {
-	    id: string;
-	    type: string;
-	    clusterId: string;
-	    clusterName?: string;
-	    target?: resourcemodel.ResourceRef;
-	    status: string;
-	    statusReason?: string;
-	    startedAt: string;
-	    displayName?: string;
-	    summary?: Record<string, string>;
+}
+
+export namespace idleworkloads {
+	
+	export class Candidate {
+	    kind: string;
+	    namespace: string;
+	    name: string;
+	    action: string;
+	    pods: number;
+	    peakCpuMilli: number;
+	    peakMemoryBytes: number;
+	    // Go type: time
+	    idleSince: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new RuntimeOperation(source);
+	        return new Candidate(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.type = source["type"];
-	        this.clusterId = source["clusterId"];
-	        this.clusterName = source["clusterName"];
-	        this.target = this.convertValues(source["target"], resourcemodel.ResourceRef);
-	        this.status = source["status"];
-	        this.statusReason = source["statusReason"];
-	        this.startedAt = source["startedAt"];
-	        this.displayName = source["displayName"];
-	        this.summary = source["summary"];
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.action = source["action"];
+	        this.pods = source["pods"];
+	        this.peakCpuMilli = source["peakCpuMilli"];
+	        this.peakMemoryBytes = source["peakMemoryBytes"];
+	        this.idleSince = this.convertValues(source["idleSince"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1323,101 +5295,43 @@ export namespace backend {
 		    return a;
 		}
 	}
-	export class SelectionDiagnostics {
-	    activeQueueDepth: number;
-	    maxQueueDepth: number;
-	    sampleCount: number;
-	    totalMutations: number;
-	    completedMutations: number;
-	    failedMutations: number;
-	    canceledMutations: number;
-	    supersededMutations: number;
-	    lastUpdatedMs?: number;
-	    lastReason?: string;
-	    lastError?: string;
-	    lastQueueMs?: number;
-	    lastTotalMs?: number;
-	    queueP50Ms?: number;
-	    queueP95Ms?: number;
-	    totalP50Ms?: number;
-	    totalP95Ms?: number;
-	    intentP50Ms?: number;
-	    intentP95Ms?: number;
-	    commitP50Ms?: number;
-	    commitP95Ms?: number;
-	    clientSyncP50Ms?: number;
-	    clientSyncP95Ms?: number;
-	    refreshP50Ms?: number;
-	    refreshP95Ms?: number;
-	    catalogP50Ms?: number;
-	    catalogP95Ms?: number;
+	export class Options {
+	    windowMinutes?: number;
+	    cpuThresholdMilli?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new SelectionDiagnostics(source);
+	        return new Options(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.activeQueueDepth = source["activeQueueDepth"];
-	        this.maxQueueDepth = source["maxQueueDepth"];
-	        this.sampleCount = source["sampleCount"];
-	        this.totalMutations = source["totalMutations"];
-	        this.completedMutations = source["completedMutations"];
-	        this.failedMutations = source["failedMutations"];
-	        this.canceledMutations = source["canceledMutations"];
-	        this.supersededMutations = source["supersededMutations"];
-	        this.lastUpdatedMs = source["lastUpdatedMs"];
-	        this.lastReason = source["lastReason"];
-	        this.lastError = source["lastError"];
-	        this.lastQueueMs = source["lastQueueMs"];
-	        this.lastTotalMs = source["lastTotalMs"];
-	        this.queueP50Ms = source["queueP50Ms"];
-	        this.queueP95Ms = source["queueP95Ms"];
-	        this.totalP50Ms = source["totalP50Ms"];
-	        this.totalP95Ms = source["totalP95Ms"];
-	        this.intentP50Ms = source["intentP50Ms"];
-	        this.intentP95Ms = source["intentP95Ms"];
-	        this.commitP50Ms = source["commitP50Ms"];
-	        this.commitP95Ms = source["commitP95Ms"];
-	        this.clientSyncP50Ms = source["clientSyncP50Ms"];
-	        this.clientSyncP95Ms = source["clientSyncP95Ms"];
-	        this.refreshP50Ms = source["refreshP50Ms"];
-	        this.refreshP95Ms = source["refreshP95Ms"];
-	        this.catalogP50Ms = source["catalogP50Ms"];
-	        this.catalogP95Ms = source["catalogP95Ms"];
+	        this.windowMinutes = source["windowMinutes"];
+	        this.cpuThresholdMilli = source["cpuThresholdMilli"];
 	    }
 	}
-
-}
-
-export namespace backendtlspolicy {
-	
-	export class BackendTLSPolicyDetails {
-	    kind: string;
-	    name: string;
-	    namespace: string;
-	    details: string;
-	    targetRefs?: types.RefOrDisplay[];
-	    conditions?: types.ConditionState[];
-	    summary: types.ConditionsSummary;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	export class Report {
+	    clusterId: string;
+	    windowMinutes: number;
+	    cpuThresholdMilli: number;
+	    // Go type: time
+	    historyStart: any;
+	    coveragePercent: number;
+	    unobserved: number;
+	    candidates: Candidate[];
 	
 	    static createFrom(source: any = {}) {
-	        return new BackendTLSPolicyDetails(source);
+	        return new Report(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.targetRefs = this.convertValues(source["targetRefs"], types.RefOrDisplay);
-	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
-	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.clusterId = source["clusterId"];
+	        this.windowMinutes = source["windowMinutes"];
+	        this.cpuThresholdMilli = source["cpuThresholdMilli"];
+	        this.historyStart = this.convertValues(source["historyStart"], null);
+	        this.coveragePercent = source["coveragePercent"];
+	        this.unobserved = source["unobserved"];
+	        this.candidates = this.convertValues(source["candidates"], Candidate);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1438,113 +5352,142 @@ export namespace backendtlspolicy {
 		    return a;
 		}
 	}
-
 }
 
-export namespace capabilities {
+export namespace imageindex {
 	
-	export class NamespaceDiagnostics {
-	    key: string;
-	    clusterId: string;
-	    namespace?: string;
-	    method: string;
-	    ssrrIncomplete: boolean;
-	    ssrrRuleCount: number;
-	    ssarFallbackCount: number;
-	    checkCount: number;
+	export class ContainerMatch {
+	    container: string;
+	    init?: boolean;
+	    image: string;
+	    imageId?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NamespaceDiagnostics(source);
+	        return new ContainerMatch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.key = source["key"];
-	        this.clusterId = source["clusterId"];
-	        this.namespace = source["namespace"];
-	        this.method = source["method"];
-	        this.ssrrIncomplete = source["ssrrIncomplete"];
-	        this.ssrrRuleCount = source["ssrrRuleCount"];
-	        this.ssarFallbackCount = source["ssarFallbackCount"];
-	        this.checkCount = source["checkCount"];
+	        this.container = source["container"];
+	        this.init = source["init"];
+	        this.image = source["image"];
+	        this.imageId = source["imageId"];
 	    }
 	}
-	export class PermissionQuery {
-	    id: string;
+	export class Inventory {
 	    clusterId: string;
-	    group?: string;
-	    version?: string;
-	    resourceKind: string;
-	    verb: string;
-	    namespace?: string;
-	    subresource?: string;
-	    name?: string;
+	    images: InventoryImage[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PermissionQuery(source);
+	        return new Inventory(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
 	        this.clusterId = source["clusterId"];
-	        this.group = source["group"];
-	        this.version = source["version"];
-	        this.resourceKind = source["resourceKind"];
-	        this.verb = source["verb"];
-	        this.namespace = source["namespace"];
-	        this.subresource = source["subresource"];
-	        this.name = source["name"];
+	        this.images = this.convertValues(source["images"], InventoryImage);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PermissionResult {
-	    id: string;
-	    clusterId: string;
-	    group?: string;
-	    version?: string;
-	    resourceKind: string;
-	    verb: string;
-	    namespace?: string;
-	    subresource?: string;
-	    name?: string;
-	    allowed: boolean;
-	    source: string;
-	    reason?: string;
-	    error?: string;
+	export class InventoryImage {
+	    image: string;
+	    containers: number;
+	    pods: number;
+	    namespaces: string[];
+	    pullPolicies: string[];
+	    digests: string[];
+	    mutableTag: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new PermissionResult(source);
+	        return new InventoryImage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.image = source["image"];
+	        this.containers = source["containers"];
+	        this.pods = source["pods"];
+	        this.namespaces = source["namespaces"];
+	        this.pullPolicies = source["pullPolicies"];
+	        this.digests = source["digests"];
+	        this.mutableTag = source["mutableTag"];
+	    }
+	}
+	export class PodMatch {
+	    namespace: string;
+	    name: string;
+	    nodeName?: string;
+	    workloadKind?: string;
+	    workloadName?: string;
+	    containers: ContainerMatch[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PodMatch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.clusterId = source["clusterId"];
-	        this.group = source["group"];
-	        this.version = source["version"];
-	        this.resourceKind = source["resourceKind"];
-	        this.verb = source["verb"];
 	        this.namespace = source["namespace"];
-	        this.subresource = source["subresource"];
 	        this.name = source["name"];
-	        this.allowed = source["allowed"];
-	        this.source = source["source"];
-	        this.reason = source["reason"];
-	        this.error = source["error"];
+	        this.nodeName = source["nodeName"];
+	        this.workloadKind = source["workloadKind"];
+	        this.workloadName = source["workloadName"];
+	        this.containers = this.convertValues(source["containers"], ContainerMatch);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class QueryPermissionsResponse {
-	    results: PermissionResult[];
-	    diagnostics: NamespaceDiagnostics[];
+	export class Result {
+	    clusterId: string;
+	    query: string;
+	    pods: PodMatch[];
+	    workloads: WorkloadMatch[];
+	    truncated?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new QueryPermissionsResponse(source);
+	        return new Result(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.results = this.convertValues(source["results"], PermissionResult);
-	        this.diagnostics = this.convertValues(source["diagnostics"], NamespaceDiagnostics);
+	        this.clusterId = source["clusterId"];
+	        this.query = source["query"];
+	        this.pods = this.convertValues(source["pods"], PodMatch);
+	        this.workloads = this.convertValues(source["workloads"], WorkloadMatch);
+	        this.truncated = source["truncated"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1565,49 +5508,142 @@ export namespace capabilities {
 		    return a;
 		}
 	}
-
+	export class WorkloadMatch {
+	    namespace: string;
+	    kind: string;
+	    name: string;
+	    pods: number;
+	    images: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new WorkloadMatch(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.pods = source["pods"];
+	        this.images = source["images"];
+	    }
+	}
 }
 
-export namespace clusterrole {
+export namespace informer {
 	
-	export class AggregationRule {
-	    clusterRoleSelectors?: any[];
+	export class CacheStatus {
+	    resource: string;
+	    objects: number;
+	    estimatedBytes: number;
+	    lastEvent?: number;
+	    synced: boolean;
+	    terminal?: boolean;
+	    degraded?: boolean;
+	    watchErrors?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new AggregationRule(source);
+	        return new CacheStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.clusterRoleSelectors = source["clusterRoleSelectors"];
+	        this.resource = source["resource"];
+	        this.objects = source["objects"];
+	        this.estimatedBytes = source["estimatedBytes"];
+	        this.lastEvent = source["lastEvent"];
+	        this.synced = source["synced"];
+	        this.terminal = source["terminal"];
+	        this.degraded = source["degraded"];
+	        this.watchErrors = source["watchErrors"];
 	    }
 	}
-	export class ClusterRoleDetails {
+}
+
+export namespace ingest {
+	
+	export class ReflectorStatus {
+	    group?: string;
+	    version: string;
 	    kind: string;
-	    name: string;
-	    details: string;
-	    rules: types.PolicyRule[];
-	    aggregationRule?: AggregationRule;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    clusterRoleBindings?: resourcemodel.ResourceRef[];
-	    roleBindings?: resourcemodel.ResourceRef[];
+	    resource: string;
+	    namespaces?: string[];
+	    objects: number;
+	    estimatedBytes: number;
+	    lastEvent?: number;
+	    synced: boolean;
+	    degraded?: boolean;
+	    onDemand?: boolean;
+	    permissionSkipped?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterRoleDetails(source);
+	        return new ReflectorStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.group = source["group"];
+	        this.version = source["version"];
 	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.details = source["details"];
-	        this.rules = this.convertValues(source["rules"], types.PolicyRule);
-	        this.aggregationRule = this.convertValues(source["aggregationRule"], AggregationRule);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.clusterRoleBindings = this.convertValues(source["clusterRoleBindings"], resourcemodel.ResourceRef);
-	        this.roleBindings = this.convertValues(source["roleBindings"], resourcemodel.ResourceRef);
+	        this.resource = source["resource"];
+	        this.namespaces = source["namespaces"];
+	        this.objects = source["objects"];
+	        this.estimatedBytes = source["estimatedBytes"];
+	        this.lastEvent = source["lastEvent"];
+	        this.synced = source["synced"];
+	        this.degraded = source["degraded"];
+	        this.onDemand = source["onDemand"];
+	        this.permissionSkipped = source["permissionSkipped"];
+	    }
+	}
+}
+
+export namespace ingress {
+	
+	export class IngressBackendDetails {
+	    serviceName?: string;
+	    servicePort?: string;
+	    resource?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IngressBackendDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.serviceName = source["serviceName"];
+	        this.servicePort = source["servicePort"];
+	        this.resource = source["resource"];
+	    }
+	}
+	export class IngressTLSDetails {
+	    hosts: string[];
+	    secretName?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IngressTLSDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hosts = source["hosts"];
+	        this.secretName = source["secretName"];
+	    }
+	}
+	export class IngressPathDetails {
+	    path: string;
+	    pathType: string;
+	    backend: IngressBackendDetails;
+	
+	    static createFrom(source: any = {}) {
+	        return new IngressPathDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.pathType = source["pathType"];
+	        this.backend = this.convertValues(source["backend"], IngressBackendDetails);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1628,33 +5664,18 @@ export namespace clusterrole {
 		    return a;
 		}
 	}
-
-}
-
-export namespace clusterrolebinding {
-	
-	export class ClusterRoleBindingDetails {
-	    kind: string;
-	    name: string;
-	    details: string;
-	    roleRef: types.RoleRef;
-	    subjects: types.Subject[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	export class IngressRuleDetails {
+	    host?: string;
+	    paths: IngressPathDetails[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ClusterRoleBindingDetails(source);
+	        return new IngressRuleDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.details = source["details"];
-	        this.roleRef = this.convertValues(source["roleRef"], types.RoleRef);
-	        this.subjects = this.convertValues(source["subjects"], types.Subject);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.host = source["host"];
+	        this.paths = this.convertValues(source["paths"], IngressPathDetails);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1675,25 +5696,21 @@ export namespace clusterrolebinding {
 		    return a;
 		}
 	}
-
-}
-
-export namespace configmap {
-	
-	export class ConfigMapDetails {
+	export class IngressDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
 	    details: string;
-	    data?: Record<string, string>;
-	    binaryData?: Record<string, string>;
-	    dataCount: number;
+	    ingressClassName?: string;
+	    rules: IngressRuleDetails[];
+	    tls?: IngressTLSDetails[];
+	    loadBalancerStatus?: string[];
+	    defaultBackend?: IngressBackendDetails;
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
-	    usedBy?: resourcemodel.ResourceRef[];
 	
 	    static createFrom(source: any = {}) {
-	        return new ConfigMapDetails(source);
+	        return new IngressDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1702,12 +5719,13 @@ export namespace configmap {
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
 	        this.details = source["details"];
-	        this.data = source["data"];
-	        this.binaryData = source["binaryData"];
-	        this.dataCount = source["dataCount"];
+	        this.ingressClassName = source["ingressClassName"];
+	        this.rules = this.convertValues(source["rules"], IngressRuleDetails);
+	        this.tls = this.convertValues(source["tls"], IngressTLSDetails);
+	        this.loadBalancerStatus = source["loadBalancerStatus"];
+	        this.defaultBackend = this.convertValues(source["defaultBackend"], IngressBackendDetails);
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.usedBy = this.convertValues(source["usedBy"], resourcemodel.ResourceRef);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1728,73 +5746,59 @@ export namespace configmap {
 		    return a;
 		}
 	}
+	
+	
 
 }
 
-export namespace cronjob {
+export namespace ingressclass {
 	
-	export class CronJobDetails {
+	export class IngressClassParameters {
+	    apiGroup?: string;
 	    kind: string;
 	    name: string;
-	    namespace: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    details: string;
-	    schedule: string;
-	    suspend: boolean;
-	    lastScheduleTime?: v1.Time;
-	    lastSuccessfulTime?: v1.Time;
-	    nextScheduleTime?: string;
-	    timeUntilNextSchedule?: string;
-	    lastManualTime?: v1.Time;
-	    lastFailureTime?: v1.Time;
-	    concurrencyPolicy: string;
-	    startingDeadlineSeconds?: number;
-	    successfulJobsHistory: number;
-	    failedJobsHistory: number;
-	    activeJobs?: types.JobReference[];
-	    jobs?: types.JobSimpleInfo[];
-	    jobTemplate: types.JobTemplateDetails;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    pods?: types.PodSimpleInfo[];
-	    podMetricsSummary?: types.PodMetricsSummary;
+	    namespace?: string;
+	    scope?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new CronJobDetails(source);
+	        return new IngressClassParameters(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.apiGroup = source["apiGroup"];
 	        this.kind = source["kind"];
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.details = source["details"];
-	        this.schedule = source["schedule"];
-	        this.suspend = source["suspend"];
-	        this.lastScheduleTime = this.convertValues(source["lastScheduleTime"], v1.Time);
-	        this.lastSuccessfulTime = this.convertValues(source["lastSuccessfulTime"], v1.Time);
-	        this.nextScheduleTime = source["nextScheduleTime"];
-	        this.timeUntilNextSchedule = source["timeUntilNextSchedule"];
-	        this.lastManualTime = this.convertValues(source["lastManualTime"], v1.Time);
-	        this.lastFailureTime = this.convertValues(source["lastFailureTime"], v1.Time);
-	        this.concurrencyPolicy = source["concurrencyPolicy"];
-	        this.startingDeadlineSeconds = source["startingDeadlineSeconds"];
-	        this.successfulJobsHistory = source["successfulJobsHistory"];
-	        this.failedJobsHistory = source["failedJobsHistory"];
-	        this.activeJobs = this.convertValues(source["activeJobs"], types.JobReference);
-	        this.jobs = this.convertValues(source["jobs"], types.JobSimpleInfo);
-	        this.jobTemplate = this.convertValues(source["jobTemplate"], types.JobTemplateDetails);
+	        this.scope = source["scope"];
+	    }
+	}
+	export class IngressClassDetails {
+	    kind: string;
+	    name: string;
+	    controller: string;
+	    isDefault: boolean;
+	    details: string;
+	    parameters?: IngressClassParameters;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    ingresses?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new IngressClassDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.controller = source["controller"];
+	        this.isDefault = source["isDefault"];
+	        this.details = source["details"];
+	        this.parameters = this.convertValues(source["parameters"], IngressClassParameters);
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
-	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
+	        this.ingresses = source["ingresses"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1818,51 +5822,42 @@ export namespace cronjob {
 
 }
 
-export namespace daemonset {
+export namespace istio {
 	
-	export class DaemonSetDetails {
+	export class Destination {
+	    host: string;
+	    subset?: string;
+	    port?: number;
+	    weight?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Destination(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.host = source["host"];
+	        this.subset = source["subset"];
+	        this.port = source["port"];
+	        this.weight = source["weight"];
+	    }
+	}
+	export class Details {
 	    kind: string;
 	    name: string;
 	    namespace: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    details: string;
-	    desired: number;
-	    current: number;
-	    ready: number;
-	    upToDate?: number;
-	    available: number;
-	    updated?: number;
-	    cpuRequest?: string;
-	    cpuLimit?: string;
-	    cpuUsage?: string;
-	    memRequest?: string;
-	    memLimit?: string;
-	    memUsage?: string;
-	    updateStrategy?: string;
-	    maxUnavailable?: string;
-	    maxSurge?: string;
-	    minReadySeconds?: number;
-	    revisionHistoryLimit?: number;
-	    serviceAccount?: string;
+	    summary: streamrows.IstioSummary;
 	    selector?: Record<string, string>;
+	    exportTo?: string[];
+	    routes?: Route[];
+	    subsets?: Subset[];
+	    servers?: Server[];
+	    portMtls?: Record<string, string>;
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
-	    nodeSelector?: Record<string, string>;
-	    tolerations?: string[];
-	    conditions?: string[];
-	    containers?: types.PodDetailInfoContainer[];
-	    initContainers?: types.PodDetailInfoContainer[];
-	    pods?: types.PodSimpleInfo[];
-	    podMetricsSummary?: types.PodMetricsSummary;
-	    observedGeneration?: number;
-	    numberMisscheduled?: number;
-	    collisionCount?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new DaemonSetDetails(source);
+	        return new Details(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1870,42 +5865,15 @@ export namespace daemonset {
 	        this.kind = source["kind"];
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.details = source["details"];
-	        this.desired = source["desired"];
-	        this.current = source["current"];
-	        this.ready = source["ready"];
-	        this.upToDate = source["upToDate"];
-	        this.available = source["available"];
-	        this.updated = source["updated"];
-	        this.cpuRequest = source["cpuRequest"];
-	        this.cpuLimit = source["cpuLimit"];
-	        this.cpuUsage = source["cpuUsage"];
-	        this.memRequest = source["memRequest"];
-	        this.memLimit = source["memLimit"];
-	        this.memUsage = source["memUsage"];
-	        this.updateStrategy = source["updateStrategy"];
-	        this.maxUnavailable = source["maxUnavailable"];
-	        this.maxSurge = source["maxSurge"];
-	        this.minReadySeconds = source["minReadySeconds"];
-	        this.revisionHistoryLimit = source["revisionHistoryLimit"];
-	        this.serviceAccount = source["serviceAccount"];
+	        this.summary = this.convertValues(source["summary"], streamrows.IstioSummary);
 	        this.selector = source["selector"];
+	        this.exportTo = source["exportTo"];
+	        this.routes = this.convertValues(source["routes"], Route);
+	        this.subsets = this.convertValues(source["subsets"], Subset);
+	        this.servers = this.convertValues(source["servers"], Server);
+	        this.portMtls = source["portMtls"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.nodeSelector = source["nodeSelector"];
-	        this.tolerations = source["tolerations"];
-	        this.conditions = source["conditions"];
-	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
-	        this.initContainers = this.convertValues(source["initContainers"], types.PodDetailInfoContainer);
-	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
-	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
-	        this.observedGeneration = source["observedGeneration"];
-	        this.numberMisscheduled = source["numberMisscheduled"];
-	        this.collisionCount = source["collisionCount"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1926,109 +5894,28 @@ export namespace daemonset {
 		    return a;
 		}
 	}
-
-}
-
-export namespace deployment {
-	
-	export class DeploymentDetails {
-	    kind: string;
-	    name: string;
-	    namespace: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    details: string;
-	    replicas: string;
-	    ready: string;
-	    updated?: string;
-	    upToDate?: number;
-	    available: number;
-	    desiredReplicas: number;
-	    cpuRequest?: string;
-	    cpuLimit?: string;
-	    cpuUsage?: string;
-	    memRequest?: string;
-	    memLimit?: string;
-	    memUsage?: string;
-	    strategy?: string;
-	    maxSurge?: string;
-	    maxUnavailable?: string;
-	    minReadySeconds?: number;
-	    revisionHistory?: number;
-	    progressDeadline?: number;
-	    serviceAccount?: string;
-	    nodeSelector?: Record<string, string>;
-	    tolerations?: string[];
-	    selector?: Record<string, string>;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    conditions?: string[];
-	    containers?: types.PodDetailInfoContainer[];
-	    initContainers?: types.PodDetailInfoContainer[];
-	    pods?: types.PodSimpleInfo[];
-	    podMetricsSummary?: types.PodMetricsSummary;
-	    currentRevision?: string;
-	    currentReplicaSet?: string;
-	    replicaSets?: string[];
-	    replicaSetSummaries?: types.ReplicaSetSummary[];
-	    observedGeneration?: number;
-	    paused?: boolean;
-	    rolloutStatus?: string;
-	    rolloutMessage?: string;
+	export class Route {
+	    name?: string;
+	    protocol: string;
+	    matches?: string[];
+	    destinations?: Destination[];
+	    redirect?: string;
+	    timeout?: string;
+	    retries?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new DeploymentDetails(source);
+	        return new Route(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
 	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.details = source["details"];
-	        this.replicas = source["replicas"];
-	        this.ready = source["ready"];
-	        this.updated = source["updated"];
-	        this.upToDate = source["upToDate"];
-	        this.available = source["available"];
-	        this.desiredReplicas = source["desiredReplicas"];
-	        this.cpuRequest = source["cpuRequest"];
-	        this.cpuLimit = source["cpuLimit"];
-	        this.cpuUsage = source["cpuUsage"];
-	        this.memRequest = source["memRequest"];
-	        this.memLimit = source["memLimit"];
-	        this.memUsage = source["memUsage"];
-	        this.strategy = source["strategy"];
-	        this.maxSurge = source["maxSurge"];
-	        this.maxUnavailable = source["maxUnavailable"];
-	        this.minReadySeconds = source["minReadySeconds"];
-	        this.revisionHistory = source["revisionHistory"];
-	        this.progressDeadline = source["progressDeadline"];
-	        this.serviceAccount = source["serviceAccount"];
-	        this.nodeSelector = source["nodeSelector"];
-	        this.tolerations = source["tolerations"];
-	        this.selector = source["selector"];
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.conditions = source["conditions"];
-	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
-	        this.initContainers = this.convertValues(source["initContainers"], types.PodDetailInfoContainer);
-	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
-	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
-	        this.currentRevision = source["currentRevision"];
-	        this.currentReplicaSet = source["currentReplicaSet"];
-	        this.replicaSets = source["replicaSets"];
-	        this.replicaSetSummaries = this.convertValues(source["replicaSetSummaries"], types.ReplicaSetSummary);
-	        this.observedGeneration = source["observedGeneration"];
-	        this.paused = source["paused"];
-	        this.rolloutStatus = source["rolloutStatus"];
-	        this.rolloutMessage = source["rolloutMessage"];
+	        this.protocol = source["protocol"];
+	        this.matches = source["matches"];
+	        this.destinations = this.convertValues(source["destinations"], Destination);
+	        this.redirect = source["redirect"];
+	        this.timeout = source["timeout"];
+	        this.retries = source["retries"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2049,61 +5936,80 @@ export namespace deployment {
 		    return a;
 		}
 	}
-
-}
-
-export namespace endpointslice {
-	
-	export class EndpointSliceAddress {
-	    ip: string;
-	    hostname?: string;
-	    nodeName?: string;
-	    targetRef?: string;
+	export class Server {
+	    name?: string;
+	    port: number;
+	    protocol?: string;
+	    hosts?: string[];
+	    tlsMode?: string;
+	    credentialName?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EndpointSliceAddress(source);
+	        return new Server(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ip = source["ip"];
-	        this.hostname = source["hostname"];
-	        this.nodeName = source["nodeName"];
-	        this.targetRef = source["targetRef"];
+	        this.name = source["name"];
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
+	        this.hosts = source["hosts"];
+	        this.tlsMode = source["tlsMode"];
+	        this.credentialName = source["credentialName"];
 	    }
 	}
-	export class EndpointSlicePort {
-	    name?: string;
-	    port: number;
-	    protocol: string;
-	    appProtocol?: string;
+	export class Subset {
+	    name: string;
+	    labels?: Record<string, string>;
+	    tlsMode?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EndpointSlicePort(source);
+	        return new Subset(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.name = source["name"];
-	        this.port = source["port"];
-	        this.protocol = source["protocol"];
-	        this.appProtocol = source["appProtocol"];
+	        this.labels = source["labels"];
+	        this.tlsMode = source["tlsMode"];
 	    }
 	}
-	export class EndpointSliceDetails {
+}
+
+export namespace job {
+	
+	export class JobDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
 	    details: string;
-	    addressType: string;
-	    readyAddresses?: EndpointSliceAddress[];
-	    notReadyAddresses?: EndpointSliceAddress[];
-	    ports?: EndpointSlicePort[];
+	    completions?: number;
+	    parallelism?: number;
+	    succeeded?: number;
+	    failed?: number;
+	    active?: number;
+	    startTime?: v1.Time;
+	    completionTime?: v1.Time;
+	    duration?: string;
+	    backoffLimit?: number;
+	    activeDeadlineSeconds?: number;
+	    ttlSecondsAfterFinished?: number;
+	    completionMode?: string;
+	    suspend?: boolean;
+	    selector?: Record<string, string>;
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
+	    containers?: types.PodDetailInfoContainer[];
+	    conditions?: string[];
+	    pods?: types.PodSimpleInfo[];
+	    podMetricsSummary?: types.PodMetricsSummary;
 	
 	    static createFrom(source: any = {}) {
-	        return new EndpointSliceDetails(source);
+	        return new JobDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -2111,13 +6017,31 @@ export namespace endpointslice {
 	        this.kind = source["kind"];
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
 	        this.details = source["details"];
-	        this.addressType = source["addressType"];
-	        this.readyAddresses = this.convertValues(source["readyAddresses"], EndpointSliceAddress);
-	        this.notReadyAddresses = this.convertValues(source["notReadyAddresses"], EndpointSliceAddress);
-	        this.ports = this.convertValues(source["ports"], EndpointSlicePort);
+	        this.completions = source["completions"];
+	        this.parallelism = source["parallelism"];
+	        this.succeeded = source["succeeded"];
+	        this.failed = source["failed"];
+	        this.active = source["active"];
+	        this.startTime = this.convertValues(source["startTime"], v1.Time);
+	        this.completionTime = this.convertValues(source["completionTime"], v1.Time);
+	        this.duration = source["duration"];
+	        this.backoffLimit = source["backoffLimit"];
+	        this.activeDeadlineSeconds = source["activeDeadlineSeconds"];
+	        this.ttlSecondsAfterFinished = source["ttlSecondsAfterFinished"];
+	        this.completionMode = source["completionMode"];
+	        this.suspend = source["suspend"];
+	        this.selector = source["selector"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
+	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
+	        this.conditions = source["conditions"];
+	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
+	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2141,38 +6065,41 @@ export namespace endpointslice {
 
 }
 
-export namespace events {
+export namespace limitrange {
 	
-	export class EventDetails {
+	export class LimitRangeItem {
+	    kind: string;
+	    max?: Record<string, string>;
+	    min?: Record<string, string>;
+	    default?: Record<string, string>;
+	    defaultRequest?: Record<string, string>;
+	    maxLimitRequestRatio?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new LimitRangeItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.max = source["max"];
+	        this.min = source["min"];
+	        this.default = source["default"];
+	        this.defaultRequest = source["defaultRequest"];
+	        this.maxLimitRequestRatio = source["maxLimitRequestRatio"];
+	    }
+	}
+	export class LimitRangeDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    eventType: string;
-	    reason?: string;
-	    message?: string;
-	    count: number;
-	    firstTimestamp: v1.Time;
-	    lastTimestamp: v1.Time;
-	    eventTime?: v1.Time;
-	    seriesCount?: number;
-	    seriesLastObservedTime?: v1.Time;
-	    source?: string;
-	    action?: string;
-	    reportingController?: string;
-	    reportingInstance?: string;
-	    involvedObject?: resourcemodel.ResourceLink;
-	    involvedObjectFieldPath?: string;
-	    relatedObject?: resourcemodel.ResourceLink;
-	    relatedObjectFieldPath?: string;
+	    details: string;
+	    limits: LimitRangeItem[];
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new EventDetails(source);
+	        return new LimitRangeDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -2180,27 +6107,8 @@ export namespace events {
 	        this.kind = source["kind"];
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.eventType = source["eventType"];
-	        this.reason = source["reason"];
-	        this.message = source["message"];
-	        this.count = source["count"];
-	        this.firstTimestamp = this.convertValues(source["firstTimestamp"], v1.Time);
-	        this.lastTimestamp = this.convertValues(source["lastTimestamp"], v1.Time);
-	        this.eventTime = this.convertValues(source["eventTime"], v1.Time);
-	        this.seriesCount = source["seriesCount"];
-	        this.seriesLastObservedTime = this.convertValues(source["seriesLastObservedTime"], v1.Time);
-	        this.source = source["source"];
-	        this.action = source["action"];
-	        this.reportingController = source["reportingController"];
-	        this.reportingInstance = source["reportingInstance"];
-	        this.involvedObject = this.convertValues(source["involvedObject"], resourcemodel.ResourceLink);
-	        this.involvedObjectFieldPath = source["involvedObjectFieldPath"];
-	        this.relatedObject = this.convertValues(source["relatedObject"], resourcemodel.ResourceLink);
-	        this.relatedObjectFieldPath = source["relatedObjectFieldPath"];
+	        this.details = source["details"];
+	        this.limits = this.convertValues(source["limits"], LimitRangeItem);
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
 	    }
@@ -2226,15 +6134,14 @@ export namespace events {
 
 }
 
-export namespace gateway {
+export namespace listenerset {
 	
-	export class GatewayDetails {
+	export class ListenerSetDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
 	    details: string;
-	    gatewayClassRef: resourcemodel.ResourceRef;
-	    addresses?: string[];
+	    parentRef: types.RefOrDisplay;
 	    listeners?: types.GatewayListenerDetails[];
 	    conditions?: types.ConditionState[];
 	    summary: types.ConditionsSummary;
@@ -2242,7 +6149,7 @@ export namespace gateway {
 	    annotations?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new GatewayDetails(source);
+	        return new ListenerSetDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -2251,8 +6158,7 @@ export namespace gateway {
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
 	        this.details = source["details"];
-	        this.gatewayClassRef = this.convertValues(source["gatewayClassRef"], resourcemodel.ResourceRef);
-	        this.addresses = source["addresses"];
+	        this.parentRef = this.convertValues(source["parentRef"], types.RefOrDisplay);
 	        this.listeners = this.convertValues(source["listeners"], types.GatewayListenerDetails);
 	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
 	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
@@ -2281,36 +6187,38 @@ export namespace gateway {
 
 }
 
-export namespace gatewayclass {
+export namespace manifestapply {
 	
-	export class GatewayClassDetails {
-	    kind: string;
-	    name: string;
-	    controller: string;
-	    details: string;
-	    conditions?: types.ConditionState[];
-	    summary: types.ConditionsSummary;
-	    parameters?: types.RefOrDisplay;
-	    usedBy?: resourcemodel.ResourceRef[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	export class Options {
+	    namespace?: string;
+	    force?: boolean;
+	    dryRun?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new GatewayClassDetails(source);
+	        return new Options(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.controller = source["controller"];
-	        this.details = source["details"];
-	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
-	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
-	        this.parameters = this.convertValues(source["parameters"], types.RefOrDisplay);
-	        this.usedBy = this.convertValues(source["usedBy"], resourcemodel.ResourceRef);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.namespace = source["namespace"];
+	        this.force = source["force"];
+	        this.dryRun = source["dryRun"];
+	    }
+	}
+	export class Report {
+	    dryRun?: boolean;
+	    results: Result[];
+	    counts: Record<string, number>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.dryRun = source["dryRun"];
+	        this.results = this.convertValues(source["results"], Result);
+	        this.counts = source["counts"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2331,107 +6239,107 @@ export namespace gatewayclass {
 		    return a;
 		}
 	}
-
-}
-
-export namespace helm {
-	
-	export class HelmResource {
+	export class Result {
+	    source?: string;
+	    apiVersion: string;
 	    kind: string;
-	    apiVersion?: string;
+	    namespace?: string;
 	    name: string;
-	    namespace: string;
-	    scope?: string;
+	    action: string;
+	    diff?: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new HelmResource(source);
+	        return new Result(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
+	        this.source = source["source"];
 	        this.apiVersion = source["apiVersion"];
-	        this.name = source["name"];
+	        this.kind = source["kind"];
 	        this.namespace = source["namespace"];
-	        this.scope = source["scope"];
+	        this.name = source["name"];
+	        this.action = source["action"];
+	        this.diff = source["diff"];
+	        this.error = source["error"];
 	    }
 	}
-	export class HelmRevision {
-	    revision: number;
-	    updated: string;
+}
+
+export namespace namespaces {
+	
+	export class NamespaceDetails {
+	    kind: string;
+	    name: string;
+	    details: string;
 	    status: string;
 	    statusState?: string;
 	    statusPresentation?: string;
 	    statusReason?: string;
-	    chart: string;
-	    appVersion?: string;
-	    description?: string;
+	    hasWorkloads: boolean;
+	    workloadsUnknown?: boolean;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    resourceQuotas?: resourcemodel.ResourceRef[];
+	    limitRanges?: resourcemodel.ResourceRef[];
 	
 	    static createFrom(source: any = {}) {
-	        return new HelmRevision(source);
+	        return new NamespaceDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.revision = source["revision"];
-	        this.updated = source["updated"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.details = source["details"];
 	        this.status = source["status"];
 	        this.statusState = source["statusState"];
 	        this.statusPresentation = source["statusPresentation"];
 	        this.statusReason = source["statusReason"];
-	        this.chart = source["chart"];
-	        this.appVersion = source["appVersion"];
-	        this.description = source["description"];
+	        this.hasWorkloads = source["hasWorkloads"];
+	        this.workloadsUnknown = source["workloadsUnknown"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.resourceQuotas = this.convertValues(source["resourceQuotas"], resourcemodel.ResourceRef);
+	        this.limitRanges = this.convertValues(source["limitRanges"], resourcemodel.ResourceRef);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class HelmReleaseDetails {
-	    kind: string;
-	    typeAlias: string;
-	    name: string;
-	    namespace: string;
-	    chart: string;
-	    version: string;
-	    appVersion: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    revision: number;
-	    updated: string;
-	    description?: string;
-	    notes?: string;
-	    values?: Record<string, any>;
-	    history?: HelmRevision[];
-	    resources?: HelmResource[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+
+}
+
+export namespace networkpolicy {
+	
+	export class ConnectivityRuleMatch {
+	    policy: resourcemodel.ResourceRef;
+	    ruleIndex: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new HelmReleaseDetails(source);
+	        return new ConnectivityRuleMatch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.typeAlias = source["typeAlias"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.chart = source["chart"];
-	        this.version = source["version"];
-	        this.appVersion = source["appVersion"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.revision = source["revision"];
-	        this.updated = source["updated"];
-	        this.description = source["description"];
-	        this.notes = source["notes"];
-	        this.values = source["values"];
-	        this.history = this.convertValues(source["history"], HelmRevision);
-	        this.resources = this.convertValues(source["resources"], HelmResource);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.policy = this.convertValues(source["policy"], resourcemodel.ResourceRef);
+	        this.ruleIndex = source["ruleIndex"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2452,40 +6360,84 @@ export namespace helm {
 		    return a;
 		}
 	}
+	export class ConnectivityDecision {
+	    isolated: boolean;
+	    allowed: boolean;
+	    selectingPolicies: resourcemodel.ResourceRef[];
+	    matchingRules: ConnectivityRuleMatch[];
+	    reason: string;
 	
-
-}
-
-export namespace hpa {
+	    static createFrom(source: any = {}) {
+	        return new ConnectivityDecision(source);
+	    }
 	
-	export class ScalingRules {
-	    stabilizationWindowSeconds?: number;
-	    selectPolicy?: string;
-	    policies?: string[];
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.isolated = source["isolated"];
+	        this.allowed = source["allowed"];
+	        this.selectingPolicies = this.convertValues(source["selectingPolicies"], resourcemodel.ResourceRef);
+	        this.matchingRules = this.convertValues(source["matchingRules"], ConnectivityRuleMatch);
+	        this.reason = source["reason"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConnectivityQuery {
+	    sourceNamespace: string;
+	    sourcePod?: string;
+	    destinationNamespace: string;
+	    destinationPod?: string;
+	    port: number;
+	    protocol?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ScalingRules(source);
+	        return new ConnectivityQuery(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.stabilizationWindowSeconds = source["stabilizationWindowSeconds"];
-	        this.selectPolicy = source["selectPolicy"];
-	        this.policies = source["policies"];
+	        this.sourceNamespace = source["sourceNamespace"];
+	        this.sourcePod = source["sourcePod"];
+	        this.destinationNamespace = source["destinationNamespace"];
+	        this.destinationPod = source["destinationPod"];
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
 	    }
 	}
-	export class ScalingBehavior {
-	    scaleUp?: ScalingRules;
-	    scaleDown?: ScalingRules;
+	export class ConnectivityResult {
+	    clusterId: string;
+	    query: ConnectivityQuery;
+	    allowed: boolean;
+	    egress: ConnectivityDecision;
+	    ingress: ConnectivityDecision;
 	
 	    static createFrom(source: any = {}) {
-	        return new ScalingBehavior(source);
+	        return new ConnectivityResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.scaleUp = this.convertValues(source["scaleUp"], ScalingRules);
-	        this.scaleDown = this.convertValues(source["scaleDown"], ScalingRules);
+	        this.clusterId = source["clusterId"];
+	        this.query = this.convertValues(source["query"], ConnectivityQuery);
+	        this.allowed = source["allowed"];
+	        this.egress = this.convertValues(source["egress"], ConnectivityDecision);
+	        this.ingress = this.convertValues(source["ingress"], ConnectivityDecision);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2506,70 +6458,119 @@ export namespace hpa {
 		    return a;
 		}
 	}
-	export class MetricStatus {
-	    kind: string;
-	    current: Record<string, string>;
+	
+	export class IPBlock {
+	    cidr: string;
+	    except?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new MetricStatus(source);
+	        return new IPBlock(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.current = source["current"];
+	        this.cidr = source["cidr"];
+	        this.except = source["except"];
 	    }
 	}
-	export class MetricSpec {
-	    kind: string;
-	    target: Record<string, string>;
+	export class NetworkPolicyPort {
+	    protocol?: string;
+	    port?: string;
+	    endPort?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new MetricSpec(source);
+	        return new NetworkPolicyPort(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.target = source["target"];
+	        this.protocol = source["protocol"];
+	        this.port = source["port"];
+	        this.endPort = source["endPort"];
 	    }
 	}
-	export class ScaleTargetReference {
-	    kind: string;
-	    name: string;
-	    apiVersion?: string;
+	export class NetworkPolicyPeer {
+	    podSelector?: Record<string, string>;
+	    namespaceSelector?: Record<string, string>;
+	    ipBlock?: IPBlock;
 	
 	    static createFrom(source: any = {}) {
-	        return new ScaleTargetReference(source);
+	        return new NetworkPolicyPeer(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.apiVersion = source["apiVersion"];
+	        this.podSelector = source["podSelector"];
+	        this.namespaceSelector = source["namespaceSelector"];
+	        this.ipBlock = this.convertValues(source["ipBlock"], IPBlock);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class NetworkPolicyRule {
+	    from?: NetworkPolicyPeer[];
+	    to?: NetworkPolicyPeer[];
+	    ports?: NetworkPolicyPort[];
+	
+	    static createFrom(source: any = {}) {
+	        return new NetworkPolicyRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = this.convertValues(source["from"], NetworkPolicyPeer);
+	        this.to = this.convertValues(source["to"], NetworkPolicyPeer);
+	        this.ports = this.convertValues(source["ports"], NetworkPolicyPort);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class HorizontalPodAutoscalerDetails {
+	export class NetworkPolicyDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
 	    details: string;
-	    scaleTargetRef: ScaleTargetReference;
-	    minReplicas?: number;
-	    maxReplicas: number;
-	    currentReplicas: number;
-	    desiredReplicas: number;
-	    metrics: MetricSpec[];
-	    currentMetrics?: MetricStatus[];
-	    behavior?: ScalingBehavior;
-	    conditions?: string[];
+	    podSelector: Record<string, string>;
+	    policyTypes: string[];
+	    ingressRules?: NetworkPolicyRule[];
+	    egressRules?: NetworkPolicyRule[];
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
-	    lastScaleTime?: v1.Time;
 	
 	    static createFrom(source: any = {}) {
-	        return new HorizontalPodAutoscalerDetails(source);
+	        return new NetworkPolicyDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -2578,18 +6579,12 @@ export namespace hpa {
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
 	        this.details = source["details"];
-	        this.scaleTargetRef = this.convertValues(source["scaleTargetRef"], ScaleTargetReference);
-	        this.minReplicas = source["minReplicas"];
-	        this.maxReplicas = source["maxReplicas"];
-	        this.currentReplicas = source["currentReplicas"];
-	        this.desiredReplicas = source["desiredReplicas"];
-	        this.metrics = this.convertValues(source["metrics"], MetricSpec);
-	        this.currentMetrics = this.convertValues(source["currentMetrics"], MetricStatus);
-	        this.behavior = this.convertValues(source["behavior"], ScalingBehavior);
-	        this.conditions = source["conditions"];
+	        this.podSelector = source["podSelector"];
+	        this.policyTypes = source["policyTypes"];
+	        this.ingressRules = this.convertValues(source["ingressRules"], NetworkPolicyRule);
+	        this.egressRules = this.convertValues(source["egressRules"], NetworkPolicyRule);
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.lastScaleTime = this.convertValues(source["lastScaleTime"], v1.Time);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2612,57 +6607,235 @@ export namespace hpa {
 	}
 	
 	
+
+}
+
+export namespace nodes {
+	
+	export class DrainSimulation {
+	    nodeName: string;
+	    pods: DrainSimulationPod[];
+	    evicted: number;
+	    skipped: number;
+	    blocked: number;
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DrainSimulation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nodeName = source["nodeName"];
+	        this.pods = this.convertValues(source["pods"], DrainSimulationPod);
+	        this.evicted = source["evicted"];
+	        this.skipped = source["skipped"];
+	        this.blocked = source["blocked"];
+	        this.warnings = source["warnings"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DrainSimulationPod {
+	    namespace: string;
+	    name: string;
+	    owner?: string;
+	    outcome: string;
+	    reasons?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DrainSimulationPod(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.owner = source["owner"];
+	        this.outcome = source["outcome"];
+	        this.reasons = source["reasons"];
+	    }
+	}
+	export class NodeCondition {
+	    kind: string;
+	    status: string;
+	    reason?: string;
+	    message?: string;
+	    lastTransitionTime?: string;
+	    lastHeartbeatTime?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NodeCondition(source);
+	    }
 	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.status = source["status"];
+	        this.reason = source["reason"];
+	        this.message = source["message"];
+	        this.lastTransitionTime = source["lastTransitionTime"];
+	        this.lastHeartbeatTime = source["lastHeartbeatTime"];
+	    }
+	}
+	export class NodeImage {
+	    names: string[];
+	    sizeBytes: number;
 	
-
-}
-
-export namespace ingress {
+	    static createFrom(source: any = {}) {
+	        return new NodeImage(source);
+	    }
 	
-	export class IngressBackendDetails {
-	    serviceName?: string;
-	    servicePort?: string;
-	    resource?: string;
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.names = source["names"];
+	        this.sizeBytes = source["sizeBytes"];
+	    }
+	}
+	export class NodeResource {
+	    name: string;
+	    capacity: string;
+	    allocatable?: string;
+	    reserved?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressBackendDetails(source);
+	        return new NodeResource(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.serviceName = source["serviceName"];
-	        this.servicePort = source["servicePort"];
-	        this.resource = source["resource"];
+	        this.name = source["name"];
+	        this.capacity = source["capacity"];
+	        this.allocatable = source["allocatable"];
+	        this.reserved = source["reserved"];
 	    }
 	}
-	export class IngressTLSDetails {
-	    hosts: string[];
-	    secretName?: string;
+	export class NodeTaint {
+	    key: string;
+	    value?: string;
+	    effect: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressTLSDetails(source);
+	        return new NodeTaint(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.hosts = source["hosts"];
-	        this.secretName = source["secretName"];
+	        this.key = source["key"];
+	        this.value = source["value"];
+	        this.effect = source["effect"];
 	    }
 	}
-	export class IngressPathDetails {
-	    path: string;
-	    pathType: string;
-	    backend: IngressBackendDetails;
+	export class NodeDetails {
+	    name: string;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    unschedulable: boolean;
+	    roles: string;
+	    internalIP: string;
+	    externalIP?: string;
+	    hostname: string;
+	    architecture: string;
+	    os: string;
+	    osImage: string;
+	    kernelVersion: string;
+	    containerRuntime: string;
+	    kubeletVersion: string;
+	    cpuCapacity: string;
+	    cpuAllocatable: string;
+	    memoryCapacity: string;
+	    memoryAllocatable: string;
+	    podsCapacity: string;
+	    podsAllocatable: string;
+	    storageCapacity?: string;
+	    podsCount: number;
+	    restarts: number;
+	    cpuRequests: string;
+	    cpuLimits: string;
+	    memRequests: string;
+	    memLimits: string;
+	    cpuUsage?: string;
+	    memoryUsage?: string;
+	    kind: string;
+	    cpu: string;
+	    memory: string;
+	    pods: string;
+	    conditions: NodeCondition[];
+	    resources?: NodeResource[];
+	    images?: NodeImage[];
+	    imagesSizeBytes?: number;
+	    taints?: NodeTaint[];
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	    podsList?: types.PodSimpleInfo[];
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressPathDetails(source);
+	        return new NodeDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.path = source["path"];
-	        this.pathType = source["pathType"];
-	        this.backend = this.convertValues(source["backend"], IngressBackendDetails);
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.unschedulable = source["unschedulable"];
+	        this.roles = source["roles"];
+	        this.internalIP = source["internalIP"];
+	        this.externalIP = source["externalIP"];
+	        this.hostname = source["hostname"];
+	        this.architecture = source["architecture"];
+	        this.os = source["os"];
+	        this.osImage = source["osImage"];
+	        this.kernelVersion = source["kernelVersion"];
+	        this.containerRuntime = source["containerRuntime"];
+	        this.kubeletVersion = source["kubeletVersion"];
+	        this.cpuCapacity = source["cpuCapacity"];
+	        this.cpuAllocatable = source["cpuAllocatable"];
+	        this.memoryCapacity = source["memoryCapacity"];
+	        this.memoryAllocatable = source["memoryAllocatable"];
+	        this.podsCapacity = source["podsCapacity"];
+	        this.podsAllocatable = source["podsAllocatable"];
+	        this.storageCapacity = source["storageCapacity"];
+	        this.podsCount = source["podsCount"];
+	        this.restarts = source["restarts"];
+	        this.cpuRequests = source["cpuRequests"];
+	        this.cpuLimits = source["cpuLimits"];
+	        this.memRequests = source["memRequests"];
+	        this.memLimits = source["memLimits"];
+	        this.cpuUsage = source["cpuUsage"];
+	        this.memoryUsage = source["memoryUsage"];
+	        this.kind = source["kind"];
+	        this.cpu = source["cpu"];
+	        this.memory = source["memory"];
+	        this.pods = source["pods"];
+	        this.conditions = this.convertValues(source["conditions"], NodeCondition);
+	        this.resources = this.convertValues(source["resources"], NodeResource);
+	        this.images = this.convertValues(source["images"], NodeImage);
+	        this.imagesSizeBytes = source["imagesSizeBytes"];
+	        this.taints = this.convertValues(source["taints"], NodeTaint);
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	        this.podsList = this.convertValues(source["podsList"], types.PodSimpleInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2683,68 +6856,61 @@ export namespace ingress {
 		    return a;
 		}
 	}
-	export class IngressRuleDetails {
-	    host?: string;
-	    paths: IngressPathDetails[];
+
+}
+
+export namespace notifications {
+	
+	export class QuietHours {
+	    enabled: boolean;
+	    start: string;
+	    end: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressRuleDetails(source);
+	        return new QuietHours(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.host = source["host"];
-	        this.paths = this.convertValues(source["paths"], IngressPathDetails);
+	        this.enabled = source["enabled"];
+	        this.start = source["start"];
+	        this.end = source["end"];
 	    }
+	}
+	export class Rules {
+	    warningEvents: boolean;
+	    crashLoopBackOff: boolean;
+	    failedJobs: boolean;
+	    nodeNotReady: boolean;
+	    eventReasons?: string[];
+	    namespaces?: string[];
 	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
+	    static createFrom(source: any = {}) {
+	        return new Rules(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.warningEvents = source["warningEvents"];
+	        this.crashLoopBackOff = source["crashLoopBackOff"];
+	        this.failedJobs = source["failedJobs"];
+	        this.nodeNotReady = source["nodeNotReady"];
+	        this.eventReasons = source["eventReasons"];
+	        this.namespaces = source["namespaces"];
+	    }
 	}
-	export class IngressDetails {
-	    kind: string;
-	    name: string;
-	    namespace: string;
-	    details: string;
-	    ingressClassName?: string;
-	    rules: IngressRuleDetails[];
-	    tls?: IngressTLSDetails[];
-	    loadBalancerStatus?: string[];
-	    defaultBackend?: IngressBackendDetails;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	export class Settings {
+	    rules: Rules;
+	    quietHours: QuietHours;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressDetails(source);
+	        return new Settings(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.ingressClassName = source["ingressClassName"];
-	        this.rules = this.convertValues(source["rules"], IngressRuleDetails);
-	        this.tls = this.convertValues(source["tls"], IngressTLSDetails);
-	        this.loadBalancerStatus = source["loadBalancerStatus"];
-	        this.defaultBackend = this.convertValues(source["defaultBackend"], IngressBackendDetails);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.rules = this.convertValues(source["rules"], Rules);
+	        this.quietHours = this.convertValues(source["quietHours"], QuietHours);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2765,59 +6931,71 @@ export namespace ingress {
 		    return a;
 		}
 	}
-	
-	
 
 }
 
-export namespace ingressclass {
+export namespace objectcatalog {
 	
-	export class IngressClassParameters {
-	    apiGroup?: string;
-	    kind: string;
-	    name: string;
-	    namespace?: string;
-	    scope?: string;
+	export class ActionFacts {
+	    status?: string;
+	    unschedulable?: boolean;
+	    portForwardAvailable?: boolean;
+	    hpaManaged?: boolean;
+	    desiredReplicas?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressClassParameters(source);
+	        return new ActionFacts(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.apiGroup = source["apiGroup"];
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.scope = source["scope"];
+	        this.status = source["status"];
+	        this.unschedulable = source["unschedulable"];
+	        this.portForwardAvailable = source["portForwardAvailable"];
+	        this.hpaManaged = source["hpaManaged"];
+	        this.desiredReplicas = source["desiredReplicas"];
 	    }
 	}
-	export class IngressClassDetails {
-	    kind: string;
-	    name: string;
-	    controller: string;
-	    isDefault: boolean;
-	    details: string;
-	    parameters?: IngressClassParameters;
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    ingresses?: string[];
+	export class ActionScaleTarget {
+	    Group: string;
+	    Version: string;
+	    Kind: string;
+	    Namespace: string;
+	    Name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionScaleTarget(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Group = source["Group"];
+	        this.Version = source["Version"];
+	        this.Kind = source["Kind"];
+	        this.Namespace = source["Namespace"];
+	        this.Name = source["Name"];
+	    }
+	}
+	export class Summary {
+	    ref: resourcemodel.ResourceRef;
+	    resourceVersion: string;
+	    creationTimestamp: string;
+	    scope: string;
+	    labelsDigest?: string;
+	    actionFacts?: ActionFacts;
 	
 	    static createFrom(source: any = {}) {
-	        return new IngressClassDetails(source);
+	        return new Summary(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.controller = source["controller"];
-	        this.isDefault = source["isDefault"];
-	        this.details = source["details"];
-	        this.parameters = this.convertValues(source["parameters"], IngressClassParameters);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.ingresses = source["ingresses"];
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.resourceVersion = source["resourceVersion"];
+	        this.creationTimestamp = source["creationTimestamp"];
+	        this.scope = source["scope"];
+	        this.labelsDigest = source["labelsDigest"];
+	        this.actionFacts = this.convertValues(source["actionFacts"], ActionFacts);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2841,72 +7019,104 @@ export namespace ingressclass {
 
 }
 
-export namespace job {
+export namespace objectyaml {
 	
-	export class JobDetails {
+	export class MergeConflict {
+	    path: string;
+	    base?: string;
+	    live?: string;
+	    draft?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MergeConflict(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.base = source["base"];
+	        this.live = source["live"];
+	        this.draft = source["draft"];
+	    }
+	}
+}
+
+export namespace persistentvolume {
+	
+	export class ClaimReference {
+	    namespace: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClaimReference(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	    }
+	}
+	export class VolumeSourceInfo {
+	    type: string;
+	    details?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new VolumeSourceInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.details = source["details"];
+	    }
+	}
+	export class PersistentVolumeDetails {
 	    kind: string;
 	    name: string;
-	    namespace: string;
+	    details: string;
 	    status: string;
 	    statusState?: string;
 	    statusPresentation?: string;
 	    statusReason?: string;
-	    details: string;
-	    completions?: number;
-	    parallelism?: number;
-	    succeeded?: number;
-	    failed?: number;
-	    active?: number;
-	    startTime?: v1.Time;
-	    completionTime?: v1.Time;
-	    duration?: string;
-	    backoffLimit?: number;
-	    activeDeadlineSeconds?: number;
-	    ttlSecondsAfterFinished?: number;
-	    completionMode?: string;
-	    suspend?: boolean;
-	    selector?: Record<string, string>;
+	    storageClass: string;
+	    capacity: string;
+	    accessModes: string[];
+	    volumeMode: string;
+	    reclaimPolicy: string;
+	    claimRef?: ClaimReference;
+	    mountOptions?: string[];
+	    volumeSource: VolumeSourceInfo;
+	    nodeAffinity?: string[];
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
-	    containers?: types.PodDetailInfoContainer[];
 	    conditions?: string[];
-	    pods?: types.PodSimpleInfo[];
-	    podMetricsSummary?: types.PodMetricsSummary;
 	
 	    static createFrom(source: any = {}) {
-	        return new JobDetails(source);
+	        return new PersistentVolumeDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.kind = source["kind"];
 	        this.name = source["name"];
-	        this.namespace = source["namespace"];
+	        this.details = source["details"];
 	        this.status = source["status"];
 	        this.statusState = source["statusState"];
 	        this.statusPresentation = source["statusPresentation"];
 	        this.statusReason = source["statusReason"];
-	        this.details = source["details"];
-	        this.completions = source["completions"];
-	        this.parallelism = source["parallelism"];
-	        this.succeeded = source["succeeded"];
-	        this.failed = source["failed"];
-	        this.active = source["active"];
-	        this.startTime = this.convertValues(source["startTime"], v1.Time);
-	        this.completionTime = this.convertValues(source["completionTime"], v1.Time);
-	        this.duration = source["duration"];
-	        this.backoffLimit = source["backoffLimit"];
-	        this.activeDeadlineSeconds = source["activeDeadlineSeconds"];
-	        this.ttlSecondsAfterFinished = source["ttlSecondsAfterFinished"];
-	        this.completionMode = source["completionMode"];
-	        this.suspend = source["suspend"];
-	        this.selector = source["selector"];
+	        this.storageClass = source["storageClass"];
+	        this.capacity = source["capacity"];
+	        this.accessModes = source["accessModes"];
+	        this.volumeMode = source["volumeMode"];
+	        this.reclaimPolicy = source["reclaimPolicy"];
+	        this.claimRef = this.convertValues(source["claimRef"], ClaimReference);
+	        this.mountOptions = source["mountOptions"];
+	        this.volumeSource = this.convertValues(source["volumeSource"], VolumeSourceInfo);
+	        this.nodeAffinity = source["nodeAffinity"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.containers = this.convertValues(source["containers"], types.PodDetailInfoContainer);
 	        this.conditions = source["conditions"];
-	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
-	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2930,52 +7140,36 @@ export namespace job {
 
 }
 
-export namespace limitrange {
+export namespace persistentvolumeclaim {
 	
-	export class LimitRangeItem {
+	export class DataSourceInfo {
 	    kind: string;
-	    max?: Record<string, string>;
-	    min?: Record<string, string>;
-	    default?: Record<string, string>;
-	    defaultRequest?: Record<string, string>;
-	    maxLimitRequestRatio?: Record<string, string>;
+	    name: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LimitRangeItem(source);
+	        return new DataSourceInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.kind = source["kind"];
-	        this.max = source["max"];
-	        this.min = source["min"];
-	        this.default = source["default"];
-	        this.defaultRequest = source["defaultRequest"];
-	        this.maxLimitRequestRatio = source["maxLimitRequestRatio"];
+	        this.name = source["name"];
 	    }
 	}
-	export class LimitRangeDetails {
-	    kind: string;
-	    name: string;
-	    namespace: string;
-	    details: string;
-	    limits: LimitRangeItem[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	export class ExpansionInfo {
+	    allowed: boolean;
+	    reason?: string;
+	    progress?: ResizeProgress;
 	
 	    static createFrom(source: any = {}) {
-	        return new LimitRangeDetails(source);
+	        return new ExpansionInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.limits = this.convertValues(source["limits"], LimitRangeItem);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.allowed = source["allowed"];
+	        this.reason = source["reason"];
+	        this.progress = this.convertValues(source["progress"], ResizeProgress);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2996,25 +7190,30 @@ export namespace limitrange {
 		    return a;
 		}
 	}
-
-}
-
-export namespace listenerset {
-	
-	export class ListenerSetDetails {
+	export class PersistentVolumeClaimDetails {
 	    kind: string;
 	    name: string;
 	    namespace: string;
 	    details: string;
-	    parentRef: types.RefOrDisplay;
-	    listeners?: types.GatewayListenerDetails[];
-	    conditions?: types.ConditionState[];
-	    summary: types.ConditionsSummary;
+	    status: string;
+	    statusState?: string;
+	    statusPresentation?: string;
+	    statusReason?: string;
+	    volumeName?: string;
+	    storageClass?: string;
+	    accessModes: string[];
+	    capacity: string;
+	    volumeMode: string;
+	    selector?: Record<string, string>;
+	    dataSource?: DataSourceInfo;
+	    conditions?: string[];
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
+	    mountedBy?: resourcemodel.ResourceRef[];
+	    expansion?: ExpansionInfo;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListenerSetDetails(source);
+	        return new PersistentVolumeClaimDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -3023,12 +7222,22 @@ export namespace listenerset {
 	        this.name = source["name"];
 	        this.namespace = source["namespace"];
 	        this.details = source["details"];
-	        this.parentRef = this.convertValues(source["parentRef"], types.RefOrDisplay);
-	        this.listeners = this.convertValues(source["listeners"], types.GatewayListenerDetails);
-	        this.conditions = this.convertValues(source["conditions"], types.ConditionState);
-	        this.summary = this.convertValues(source["summary"], types.ConditionsSummary);
+	        this.status = source["status"];
+	        this.statusState = source["statusState"];
+	        this.statusPresentation = source["statusPresentation"];
+	        this.statusReason = source["statusReason"];
+	        this.volumeName = source["volumeName"];
+	        this.storageClass = source["storageClass"];
+	        this.accessModes = source["accessModes"];
+	        this.capacity = source["capacity"];
+	        this.volumeMode = source["volumeMode"];
+	        this.selector = source["selector"];
+	        this.dataSource = this.convertValues(source["dataSource"], DataSourceInfo);
+	        this.conditions = source["conditions"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
+	        this.mountedBy = this.convertValues(source["mountedBy"], resourcemodel.ResourceRef);
+	        this.expansion = this.convertValues(source["expansion"], ExpansionInfo);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3049,45 +7258,89 @@ export namespace listenerset {
 		    return a;
 		}
 	}
+	export class ResizeProgress {
+	    state: string;
+	    message?: string;
+	    requested: string;
+	    capacity?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResizeProgress(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = source["state"];
+	        this.message = source["message"];
+	        this.requested = source["requested"];
+	        this.capacity = source["capacity"];
+	    }
+	}
+	export class ResizeResult {
+	    clusterId: string;
+	    namespace: string;
+	    name: string;
+	    previous: string;
+	    requested: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResizeResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.previous = source["previous"];
+	        this.requested = source["requested"];
+	    }
+	}
 
 }
 
-export namespace namespaces {
+export namespace poddisruptionbudget {
 	
-	export class NamespaceDetails {
+	export class PodDisruptionBudgetDetails {
 	    kind: string;
 	    name: string;
+	    namespace: string;
 	    details: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    hasWorkloads: boolean;
-	    workloadsUnknown?: boolean;
+	    minAvailable?: string;
+	    maxUnavailable?: string;
+	    selector?: Record<string, string>;
+	    currentHealthy: number;
+	    desiredHealthy: number;
+	    disruptionsAllowed: number;
+	    expectedPods: number;
+	    observedGeneration: number;
+	    disruptedPods?: Record<string, v1.Time>;
+	    conditions?: string[];
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
-	    resourceQuotas?: resourcemodel.ResourceRef[];
-	    limitRanges?: resourcemodel.ResourceRef[];
 	
 	    static createFrom(source: any = {}) {
-	        return new NamespaceDetails(source);
+	        return new PodDisruptionBudgetDetails(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.kind = source["kind"];
 	        this.name = source["name"];
+	        this.namespace = source["namespace"];
 	        this.details = source["details"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.hasWorkloads = source["hasWorkloads"];
-	        this.workloadsUnknown = source["workloadsUnknown"];
+	        this.minAvailable = source["minAvailable"];
+	        this.maxUnavailable = source["maxUnavailable"];
+	        this.selector = source["selector"];
+	        this.currentHealthy = source["currentHealthy"];
+	        this.desiredHealthy = source["desiredHealthy"];
+	        this.disruptionsAllowed = source["disruptionsAllowed"];
+	        this.expectedPods = source["expectedPods"];
+	        this.observedGeneration = source["observedGeneration"];
+	        this.disruptedPods = this.convertValues(source["disruptedPods"], v1.Time, true);
+	        this.conditions = source["conditions"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
-	        this.resourceQuotas = this.convertValues(source["resourceQuotas"], resourcemodel.ResourceRef);
-	        this.limitRanges = this.convertValues(source["limitRanges"], resourcemodel.ResourceRef);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3111,52 +7364,52 @@ export namespace namespaces {
 
 }
 
-export namespace networkpolicy {
-	
-	export class IPBlock {
-	    cidr: string;
-	    except?: string[];
-	
-	    static createFrom(source: any = {}) {
-	        return new IPBlock(source);
-	    }
+export namespace pods {
 	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.cidr = source["cidr"];
-	        this.except = source["except"];
-	    }
-	}
-	export class NetworkPolicyPort {
-	    protocol?: string;
-	    port?: string;
-	    endPort?: number;
+	export class DNSAnswer {
+	    name: string;
+	    type: string;
+	    value: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NetworkPolicyPort(source);
+	        return new DNSAnswer(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.protocol = source["protocol"];
-	        this.port = source["port"];
-	        this.endPort = source["endPort"];
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.value = source["value"];
 	    }
 	}
-	export class NetworkPolicyPeer {
-	    podSelector?: Record<string, string>;
-	    namespaceSelector?: Record<string, string>;
-	    ipBlock?: IPBlock;
+	export class DNSLookup {
+	    name: string;
+	    nameservers: string[];
+	    search: string[];
+	    ndots: number;
+	    candidates: string[];
+	    server?: string;
+	    answers: DNSAnswer[];
+	    resolved: boolean;
+	    error?: string;
+	    output: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NetworkPolicyPeer(source);
+	        return new DNSLookup(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.podSelector = source["podSelector"];
-	        this.namespaceSelector = source["namespaceSelector"];
-	        this.ipBlock = this.convertValues(source["ipBlock"], IPBlock);
+	        this.name = source["name"];
+	        this.nameservers = source["nameservers"];
+	        this.search = source["search"];
+	        this.ndots = source["ndots"];
+	        this.candidates = source["candidates"];
+	        this.server = source["server"];
+	        this.answers = this.convertValues(source["answers"], DNSAnswer);
+	        this.resolved = source["resolved"];
+	        this.error = source["error"];
+	        this.output = source["output"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3177,20 +7430,20 @@ export namespace networkpolicy {
 		    return a;
 		}
 	}
-	export class NetworkPolicyRule {
-	    from?: NetworkPolicyPeer[];
-	    to?: NetworkPolicyPeer[];
-	    ports?: NetworkPolicyPort[];
+	export class DirectoryListing {
+	    container: string;
+	    path: string;
+	    entries: FileEntry[];
 	
 	    static createFrom(source: any = {}) {
-	        return new NetworkPolicyRule(source);
+	        return new DirectoryListing(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.from = this.convertValues(source["from"], NetworkPolicyPeer);
-	        this.to = this.convertValues(source["to"], NetworkPolicyPeer);
-	        this.ports = this.convertValues(source["ports"], NetworkPolicyPort);
+	        this.container = source["container"];
+	        this.path = source["path"];
+	        this.entries = this.convertValues(source["entries"], FileEntry);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3211,34 +7464,27 @@ export namespace networkpolicy {
 		    return a;
 		}
 	}
-	export class NetworkPolicyDetails {
-	    kind: string;
+	export class FileEntry {
 	    name: string;
-	    namespace: string;
-	    details: string;
-	    podSelector: Record<string, string>;
-	    policyTypes: string[];
-	    ingressRules?: NetworkPolicyRule[];
-	    egressRules?: NetworkPolicyRule[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	    type: string;
+	    size: number;
+	    mode: string;
+	    // Go type: time
+	    modifiedAt: any;
+	    linkToDir?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new NetworkPolicyDetails(source);
+	        return new FileEntry(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
 	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.podSelector = source["podSelector"];
-	        this.policyTypes = source["policyTypes"];
-	        this.ingressRules = this.convertValues(source["ingressRules"], NetworkPolicyRule);
-	        this.egressRules = this.convertValues(source["egressRules"], NetworkPolicyRule);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.type = source["type"];
+	        this.size = source["size"];
+	        this.mode = source["mode"];
+	        this.modifiedAt = this.convertValues(source["modifiedAt"], null);
+	        this.linkToDir = source["linkToDir"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3259,135 +7505,141 @@ export namespace networkpolicy {
 		    return a;
 		}
 	}
+	export class FilePreview {
+	    container: string;
+	    path: string;
+	    content: string;
+	    truncated: boolean;
+	    binary: boolean;
 	
+	    static createFrom(source: any = {}) {
+	        return new FilePreview(source);
+	    }
 	
-
-}
-
-export namespace nodes {
-	
-	export class NodeCondition {
-	    kind: string;
-	    status: string;
-	    reason?: string;
-	    message?: string;
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.container = source["container"];
+	        this.path = source["path"];
+	        this.content = source["content"];
+	        this.truncated = source["truncated"];
+	        this.binary = source["binary"];
+	    }
+	}
+	export class NodeFit {
+	    name: string;
+	    fits: boolean;
+	    reasons?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new NodeCondition(source);
+	        return new NodeFit(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.status = source["status"];
-	        this.reason = source["reason"];
-	        this.message = source["message"];
+	        this.name = source["name"];
+	        this.fits = source["fits"];
+	        this.reasons = source["reasons"];
 	    }
 	}
-	export class NodeTaint {
-	    key: string;
-	    value?: string;
-	    effect: string;
+	export class Process {
+	    pid: number;
+	    ppid: number;
+	    state: string;
+	    cpuPercent: number;
+	    rssBytes: number;
+	    command: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new NodeTaint(source);
+	        return new Process(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.key = source["key"];
-	        this.value = source["value"];
-	        this.effect = source["effect"];
+	        this.pid = source["pid"];
+	        this.ppid = source["ppid"];
+	        this.state = source["state"];
+	        this.cpuPercent = source["cpuPercent"];
+	        this.rssBytes = source["rssBytes"];
+	        this.command = source["command"];
 	    }
 	}
-	export class NodeDetails {
-	    name: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    unschedulable: boolean;
-	    roles: string;
-	    internalIP: string;
-	    externalIP?: string;
-	    hostname: string;
-	    architecture: string;
-	    os: string;
-	    osImage: string;
-	    kernelVersion: string;
-	    containerRuntime: string;
-	    kubeletVersion: string;
-	    cpuCapacity: string;
-	    cpuAllocatable: string;
-	    memoryCapacity: string;
-	    memoryAllocatable: string;
-	    podsCapacity: string;
-	    podsAllocatable: string;
-	    storageCapacity?: string;
-	    podsCount: number;
-	    restarts: number;
-	    cpuRequests: string;
-	    cpuLimits: string;
-	    memRequests: string;
-	    memLimits: string;
-	    cpuUsage?: string;
-	    memoryUsage?: string;
-	    kind: string;
-	    cpu: string;
-	    memory: string;
-	    pods: string;
-	    conditions: NodeCondition[];
-	    taints?: NodeTaint[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    podsList?: types.PodSimpleInfo[];
+	export class ProcessList {
+	    container: string;
+	    processes: Process[];
+	    cpuWindowSeconds: number;
+	    // Go type: time
+	    sampledAt: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new NodeDetails(source);
+	        return new ProcessList(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.unschedulable = source["unschedulable"];
-	        this.roles = source["roles"];
-	        this.internalIP = source["internalIP"];
-	        this.externalIP = source["externalIP"];
-	        this.hostname = source["hostname"];
-	        this.architecture = source["architecture"];
-	        this.os = source["os"];
-	        this.osImage = source["osImage"];
-	        this.kernelVersion = source["kernelVersion"];
-	        this.containerRuntime = source["containerRuntime"];
-	        this.kubeletVersion = source["kubeletVersion"];
-	        this.cpuCapacity = source["cpuCapacity"];
-	        this.cpuAllocatable = source["cpuAllocatable"];
-	        this.memoryCapacity = source["memoryCapacity"];
-	        this.memoryAllocatable = source["memoryAllocatable"];
-	        this.podsCapacity = source["podsCapacity"];
-	        this.podsAllocatable = source["podsAllocatable"];
-	        this.storageCapacity = source["storageCapacity"];
-	        this.podsCount = source["podsCount"];
-	        this.restarts = source["restarts"];
-	        this.cpuRequests = source["cpuRequests"];
-	        this.cpuLimits = source["cpuLimits"];
-	        this.memRequests = source["memRequests"];
-	        this.memLimits = source["memLimits"];
-	        this.cpuUsage = source["cpuUsage"];
-	        this.memoryUsage = source["memoryUsage"];
-	        this.kind = source["kind"];
-	        this.cpu = source["cpu"];
-	        this.memory = source["memory"];
-	        this.pods = source["pods"];
-	        this.conditions = this.convertValues(source["conditions"], NodeCondition);
-	        this.taints = this.convertValues(source["taints"], NodeTaint);
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.podsList = this.convertValues(source["podsList"], types.PodSimpleInfo);
+	        this.container = source["container"];
+	        this.processes = this.convertValues(source["processes"], Process);
+	        this.cpuWindowSeconds = source["cpuWindowSeconds"];
+	        this.sampledAt = this.convertValues(source["sampledAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SchedulingEvent {
+	    message: string;
+	    count: number;
+	    lastSeen?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulingEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.message = source["message"];
+	        this.count = source["count"];
+	        this.lastSeen = source["lastSeen"];
+	    }
+	}
+	export class SchedulingExplanation {
+	    namespace: string;
+	    name: string;
+	    phase: string;
+	    nodeName?: string;
+	    requests?: Record<string, string>;
+	    events: SchedulingEvent[];
+	    nodes: NodeFit[];
+	    fittingNodes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulingExplanation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.phase = source["phase"];
+	        this.nodeName = source["nodeName"];
+	        this.requests = source["requests"];
+	        this.events = this.convertValues(source["events"], SchedulingEvent);
+	        this.nodes = this.convertValues(source["nodes"], NodeFit);
+	        this.fittingNodes = source["fittingNodes"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3408,71 +7660,49 @@ export namespace nodes {
 		    return a;
 		}
 	}
-
-}
-
-export namespace objectcatalog {
-	
-	export class ActionFacts {
-	    status?: string;
-	    unschedulable?: boolean;
-	    portForwardAvailable?: boolean;
-	    hpaManaged?: boolean;
-	    desiredReplicas?: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new ActionFacts(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.status = source["status"];
-	        this.unschedulable = source["unschedulable"];
-	        this.portForwardAvailable = source["portForwardAvailable"];
-	        this.hpaManaged = source["hpaManaged"];
-	        this.desiredReplicas = source["desiredReplicas"];
-	    }
-	}
-	export class ActionScaleTarget {
-	    Group: string;
-	    Version: string;
-	    Kind: string;
-	    Namespace: string;
-	    Name: string;
+	export class Socket {
+	    protocol: string;
+	    state: string;
+	    localAddress: string;
+	    localPort: number;
+	    remoteAddress?: string;
+	    remotePort?: number;
+	    pid?: number;
+	    process?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ActionScaleTarget(source);
+	        return new Socket(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.Group = source["Group"];
-	        this.Version = source["Version"];
-	        this.Kind = source["Kind"];
-	        this.Namespace = source["Namespace"];
-	        this.Name = source["Name"];
+	        this.protocol = source["protocol"];
+	        this.state = source["state"];
+	        this.localAddress = source["localAddress"];
+	        this.localPort = source["localPort"];
+	        this.remoteAddress = source["remoteAddress"];
+	        this.remotePort = source["remotePort"];
+	        this.pid = source["pid"];
+	        this.process = source["process"];
 	    }
 	}
-	export class Summary {
-	    ref: resourcemodel.ResourceRef;
-	    resourceVersion: string;
-	    creationTimestamp: string;
-	    scope: string;
-	    labelsDigest?: string;
-	    actionFacts?: ActionFacts;
+	export class SocketList {
+	    container: string;
+	    listening: Socket[];
+	    connections: Socket[];
+	    // Go type: time
+	    sampledAt: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new Summary(source);
+	        return new SocketList(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
-	        this.resourceVersion = source["resourceVersion"];
-	        this.creationTimestamp = source["creationTimestamp"];
-	        this.scope = source["scope"];
-	        this.labelsDigest = source["labelsDigest"];
-	        this.actionFacts = this.convertValues(source["actionFacts"], ActionFacts);
+	        this.container = source["container"];
+	        this.listening = this.convertValues(source["listening"], Socket);
+	        this.connections = this.convertValues(source["connections"], Socket);
+	        this.sampledAt = this.convertValues(source["sampledAt"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3493,85 +7723,60 @@ export namespace objectcatalog {
 		    return a;
 		}
 	}
-
 }
 
-export namespace persistentvolume {
+export namespace podsecurity {
 	
-	export class ClaimReference {
-	    namespace: string;
-	    name: string;
+	export class ModeLabel {
+	    level?: string;
+	    version?: string;
+	    invalid?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ClaimReference(source);
+	        return new ModeLabel(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.namespace = source["namespace"];
-	        this.name = source["name"];
+	        this.level = source["level"];
+	        this.version = source["version"];
+	        this.invalid = source["invalid"];
 	    }
 	}
-	export class VolumeSourceInfo {
-	    type: string;
-	    details?: Record<string, string>;
+	export class Violation {
+	    check: string;
+	    level: string;
+	    container?: string;
+	    detail: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new VolumeSourceInfo(source);
+	        return new Violation(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.type = source["type"];
-	        this.details = source["details"];
+	        this.check = source["check"];
+	        this.level = source["level"];
+	        this.container = source["container"];
+	        this.detail = source["detail"];
 	    }
 	}
-	export class PersistentVolumeDetails {
-	    kind: string;
-	    name: string;
-	    details: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    storageClass: string;
-	    capacity: string;
-	    accessModes: string[];
-	    volumeMode: string;
-	    reclaimPolicy: string;
-	    claimRef?: ClaimReference;
-	    mountOptions?: string[];
-	    volumeSource: VolumeSourceInfo;
-	    nodeAffinity?: string[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    conditions?: string[];
+	export class WorkloadResult {
+	    ref: resourcemodel.ResourceRef;
+	    level: string;
+	    violations: Violation[];
+	    violatesEnforced?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new PersistentVolumeDetails(source);
+	        return new WorkloadResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.details = source["details"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.storageClass = source["storageClass"];
-	        this.capacity = source["capacity"];
-	        this.accessModes = source["accessModes"];
-	        this.volumeMode = source["volumeMode"];
-	        this.reclaimPolicy = source["reclaimPolicy"];
-	        this.claimRef = this.convertValues(source["claimRef"], ClaimReference);
-	        this.mountOptions = source["mountOptions"];
-	        this.volumeSource = this.convertValues(source["volumeSource"], VolumeSourceInfo);
-	        this.nodeAffinity = source["nodeAffinity"];
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.conditions = source["conditions"];
+	        this.ref = this.convertValues(source["ref"], resourcemodel.ResourceRef);
+	        this.level = source["level"];
+	        this.violations = this.convertValues(source["violations"], Violation);
+	        this.violatesEnforced = source["violatesEnforced"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3592,71 +7797,60 @@ export namespace persistentvolume {
 		    return a;
 		}
 	}
-
-}
-
-export namespace persistentvolumeclaim {
-	
-	export class DataSourceInfo {
-	    kind: string;
-	    name: string;
+	export class NamespaceReport {
+	    namespace: string;
+	    labels: Record<string, ModeLabel>;
+	    workloads: WorkloadResult[];
+	    baselineViolations: number;
+	    restrictedViolations: number;
+	    enforcedViolations: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new DataSourceInfo(source);
+	        return new NamespaceReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.labels = this.convertValues(source["labels"], ModeLabel, true);
+	        this.workloads = this.convertValues(source["workloads"], WorkloadResult);
+	        this.baselineViolations = source["baselineViolations"];
+	        this.restrictedViolations = source["restrictedViolations"];
+	        this.enforcedViolations = source["enforcedViolations"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PersistentVolumeClaimDetails {
-	    kind: string;
-	    name: string;
-	    namespace: string;
-	    details: string;
-	    status: string;
-	    statusState?: string;
-	    statusPresentation?: string;
-	    statusReason?: string;
-	    volumeName?: string;
-	    storageClass?: string;
-	    accessModes: string[];
-	    capacity: string;
-	    volumeMode: string;
-	    selector?: Record<string, string>;
-	    dataSource?: DataSourceInfo;
-	    conditions?: string[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
-	    mountedBy?: resourcemodel.ResourceRef[];
+	export class Report {
+	    clusterId: string;
+	    namespaces: NamespaceReport[];
+	    warnings?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PersistentVolumeClaimDetails(source);
+	        return new Report(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.kind = source["kind"];
-	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.status = source["status"];
-	        this.statusState = source["statusState"];
-	        this.statusPresentation = source["statusPresentation"];
-	        this.statusReason = source["statusReason"];
-	        this.volumeName = source["volumeName"];
-	        this.storageClass = source["storageClass"];
-	        this.accessModes = source["accessModes"];
-	        this.capacity = source["capacity"];
-	        this.volumeMode = source["volumeMode"];
-	        this.selector = source["selector"];
-	        this.dataSource = this.convertValues(source["dataSource"], DataSourceInfo);
-	        this.conditions = source["conditions"];
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
-	        this.mountedBy = this.convertValues(source["mountedBy"], resourcemodel.ResourceRef);
+	        this.clusterId = source["clusterId"];
+	        this.namespaces = this.convertValues(source["namespaces"], NamespaceReport);
+	        this.warnings = source["warnings"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3677,51 +7871,60 @@ export namespace persistentvolumeclaim {
 		    return a;
 		}
 	}
+	
 
 }
 
-export namespace poddisruptionbudget {
+export namespace pullsecretcheck {
 	
-	export class PodDisruptionBudgetDetails {
-	    kind: string;
-	    name: string;
+	export class ImageCheck {
+	    image: string;
+	    containers?: string[];
+	    registry: string;
+	    status: string;
+	    secret?: string;
+	    message?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImageCheck(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.image = source["image"];
+	        this.containers = source["containers"];
+	        this.registry = source["registry"];
+	        this.status = source["status"];
+	        this.secret = source["secret"];
+	        this.message = source["message"];
+	    }
+	}
+	export class Report {
+	    clusterId: string;
 	    namespace: string;
-	    details: string;
-	    minAvailable?: string;
-	    maxUnavailable?: string;
-	    selector?: Record<string, string>;
-	    currentHealthy: number;
-	    desiredHealthy: number;
-	    disruptionsAllowed: number;
-	    expectedPods: number;
-	    observedGeneration: number;
-	    disruptedPods?: Record<string, v1.Time>;
-	    conditions?: string[];
-	    labels?: Record<string, string>;
-	    annotations?: Record<string, string>;
+	    kind?: string;
+	    name?: string;
+	    serviceAccount: string;
+	    secrets: SecretCheck[];
+	    images: ImageCheck[];
+	    failed: number;
+	    warnings?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PodDisruptionBudgetDetails(source);
+	        return new Report(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
 	        this.kind = source["kind"];
 	        this.name = source["name"];
-	        this.namespace = source["namespace"];
-	        this.details = source["details"];
-	        this.minAvailable = source["minAvailable"];
-	        this.maxUnavailable = source["maxUnavailable"];
-	        this.selector = source["selector"];
-	        this.currentHealthy = source["currentHealthy"];
-	        this.desiredHealthy = source["desiredHealthy"];
-	        this.disruptionsAllowed = source["disruptionsAllowed"];
-	        this.expectedPods = source["expectedPods"];
-	        this.observedGeneration = source["observedGeneration"];
-	        this.disruptedPods = this.convertValues(source["disruptedPods"], v1.Time, true);
-	        this.conditions = source["conditions"];
-	        this.labels = source["labels"];
-	        this.annotations = source["annotations"];
+	        this.serviceAccount = source["serviceAccount"];
+	        this.secrets = this.convertValues(source["secrets"], SecretCheck);
+	        this.images = this.convertValues(source["images"], ImageCheck);
+	        this.failed = source["failed"];
+	        this.warnings = source["warnings"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3742,7 +7945,44 @@ export namespace poddisruptionbudget {
 		    return a;
 		}
 	}
-
+	export class Request {
+	    namespace: string;
+	    kind?: string;
+	    name?: string;
+	    images?: string[];
+	    serviceAccount?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Request(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.images = source["images"];
+	        this.serviceAccount = source["serviceAccount"];
+	    }
+	}
+	export class SecretCheck {
+	    name: string;
+	    source: string;
+	    registries: string[];
+	    problem?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretCheck(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.source = source["source"];
+	        this.registries = source["registries"];
+	        this.problem = source["problem"];
+	    }
+	}
 }
 
 export namespace referencegrant {
@@ -4220,8 +8460,8 @@ export namespace secret {
 	    namespace: string;
 	    details: string;
 	    secretType: string;
-	    data?: Record<string, string>;
 	    dataKeys: string[];
+	    keys: types.DataKeyInfo[];
 	    dataCount: number;
 	    labels?: Record<string, string>;
 	    annotations?: Record<string, string>;
@@ -4238,8 +8478,8 @@ export namespace secret {
 	        this.namespace = source["namespace"];
 	        this.details = source["details"];
 	        this.secretType = source["secretType"];
-	        this.data = source["data"];
 	        this.dataKeys = source["dataKeys"];
+	        this.keys = this.convertValues(source["keys"], types.DataKeyInfo);
 	        this.dataCount = source["dataCount"];
 	        this.labels = source["labels"];
 	        this.annotations = source["annotations"];
@@ -4264,6 +8504,72 @@ export namespace secret {
 		    return a;
 		}
 	}
+	export class SecretEditRequest {
+	    namespace: string;
+	    name: string;
+	    resourceVersion?: string;
+	    set?: Record<string, string>;
+	    remove?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretEditRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.resourceVersion = source["resourceVersion"];
+	        this.set = source["set"];
+	        this.remove = source["remove"];
+	    }
+	}
+	export class SecretEditResult {
+	    clusterId: string;
+	    namespace: string;
+	    name: string;
+	    secretType: string;
+	    resourceVersion: string;
+	    dataKeys: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretEditResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.secretType = source["secretType"];
+	        this.resourceVersion = source["resourceVersion"];
+	        this.dataKeys = source["dataKeys"];
+	    }
+	}
+	export class SecretRevealResult {
+	    clusterId: string;
+	    namespace: string;
+	    name: string;
+	    values: Record<string, string>;
+	    redactedKeys?: string[];
+	    missingKeys?: string[];
+	    binaryKeys?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SecretRevealResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	        this.values = source["values"];
+	        this.redactedKeys = source["redactedKeys"];
+	        this.missingKeys = source["missingKeys"];
+	        this.binaryKeys = source["binaryKeys"];
+	    }
+	}
 
 }
 
@@ -4641,6 +8947,40 @@ export namespace statefulset {
 	        this.volumeMode = source["volumeMode"];
 	    }
 	}
+	export class OrdinalStatus {
+	    ordinal: number;
+	    podName: string;
+	    missing?: boolean;
+	    extra?: boolean;
+	    phase?: string;
+	    ready: boolean;
+	    node?: string;
+	    revision?: string;
+	    upToDate: boolean;
+	    partitioned?: boolean;
+	    terminating?: boolean;
+	    stuck?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new OrdinalStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ordinal = source["ordinal"];
+	        this.podName = source["podName"];
+	        this.missing = source["missing"];
+	        this.extra = source["extra"];
+	        this.phase = source["phase"];
+	        this.ready = source["ready"];
+	        this.node = source["node"];
+	        this.revision = source["revision"];
+	        this.upToDate = source["upToDate"];
+	        this.partitioned = source["partitioned"];
+	        this.terminating = source["terminating"];
+	        this.stuck = source["stuck"];
+	    }
+	}
 	export class StatefulSetDetails {
 	    kind: string;
 	    name: string;
@@ -4681,6 +9021,7 @@ export namespace statefulset {
 	    volumeClaimTemplates?: VolumeClaimTemplateSummary[];
 	    pods?: types.PodSimpleInfo[];
 	    podMetricsSummary?: types.PodMetricsSummary;
+	    ordinals?: OrdinalStatus[];
 	    currentRevision?: string;
 	    updateRevision?: string;
 	    currentReplicas?: number;
@@ -4733,6 +9074,7 @@ export namespace statefulset {
 	        this.volumeClaimTemplates = this.convertValues(source["volumeClaimTemplates"], VolumeClaimTemplateSummary);
 	        this.pods = this.convertValues(source["pods"], types.PodSimpleInfo);
 	        this.podMetricsSummary = this.convertValues(source["podMetricsSummary"], types.PodMetricsSummary);
+	        this.ordinals = this.convertValues(source["ordinals"], OrdinalStatus);
 	        this.currentRevision = source["currentRevision"];
 	        this.updateRevision = source["updateRevision"];
 	        this.currentReplicas = source["currentReplicas"];
@@ -4876,6 +9218,70 @@ export namespace storageclass {
 
 }
 
+export namespace streamrows {
+	
+	export class IstioSummary {
+	    hosts?: string[];
+	    gateways?: string[];
+	    routes?: string[];
+	    subsets?: string[];
+	    servers?: string[];
+	    tlsMode?: string;
+	    mtlsMode?: string;
+	    details: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new IstioSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.hosts = source["hosts"];
+	        this.gateways = source["gateways"];
+	        this.routes = source["routes"];
+	        this.subsets = source["subsets"];
+	        this.servers = source["servers"];
+	        this.tlsMode = source["tlsMode"];
+	        this.mtlsMode = source["mtlsMode"];
+	        this.details = source["details"];
+	    }
+	}
+	export class VolumeSnapshotSummary {
+	    sourcePvc?: string;
+	    sourceContent?: string;
+	    snapshotClass?: string;
+	    boundContent?: string;
+	    readyToUse?: boolean;
+	    restoreSize?: string;
+	    creationTime?: string;
+	    error?: string;
+	    driver?: string;
+	    deletionPolicy?: string;
+	    isDefault?: boolean;
+	    details: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new VolumeSnapshotSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sourcePvc = source["sourcePvc"];
+	        this.sourceContent = source["sourceContent"];
+	        this.snapshotClass = source["snapshotClass"];
+	        this.boundContent = source["boundContent"];
+	        this.readyToUse = source["readyToUse"];
+	        this.restoreSize = source["restoreSize"];
+	        this.creationTime = source["creationTime"];
+	        this.error = source["error"];
+	        this.driver = source["driver"];
+	        this.deletionPolicy = source["deletionPolicy"];
+	        this.isDefault = source["isDefault"];
+	        this.details = source["details"];
+	    }
+	}
+}
+
 export namespace types {
 	
 	export class AppPreferenceChange {
@@ -4883,41 +9289,163 @@ export namespace types {
 	    value: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new AppPreferenceChange(source);
+	        return new AppPreferenceChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.value = source["value"];
+	    }
+	}
+	export class AppPreferenceSchema {
+	    key: string;
+	    type: string;
+	    defaultValue: any;
+	    currentValue: any;
+	    min?: number;
+	    max?: number;
+	    enumOptions?: string[];
+	    validation?: string;
+	    runtimeSideEffect: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new AppPreferenceSchema(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.type = source["type"];
+	        this.defaultValue = source["defaultValue"];
+	        this.currentValue = source["currentValue"];
+	        this.min = source["min"];
+	        this.max = source["max"];
+	        this.enumOptions = source["enumOptions"];
+	        this.validation = source["validation"];
+	        this.runtimeSideEffect = source["runtimeSideEffect"];
+	    }
+	}
+	export class ConnectivityCheckRequest {
+	    namespace: string;
+	    image: string;
+	    host: string;
+	    port: number;
+	    protocol?: string;
+	    path?: string;
+	    timeoutSeconds?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectivityCheckRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.image = source["image"];
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.protocol = source["protocol"];
+	        this.path = source["path"];
+	        this.timeoutSeconds = source["timeoutSeconds"];
+	    }
+	}
+	export class DNSLookupRequest {
+	    namespace: string;
+	    image: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DNSLookupRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.image = source["image"];
+	        this.name = source["name"];
+	    }
+	}
+	export class NodeLogDebugPodRequest {
+	    namespace: string;
+	    image: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NodeLogDebugPodRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.image = source["image"];
+	    }
+	}
+	export class PodContainerRequest {
+	    namespace: string;
+	    podName: string;
+	    container?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PodContainerRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.podName = source["podName"];
+	        this.container = source["container"];
+	    }
+	}
+	export class PodFileRequest {
+	    namespace: string;
+	    podName: string;
+	    container?: string;
+	    path: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PodFileRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.podName = source["podName"];
+	        this.container = source["container"];
+	        this.path = source["path"];
+	    }
+	}
+	export class PodFilesDownloadRequest {
+	    namespace: string;
+	    podName: string;
+	    container?: string;
+	    directory: string;
+	    names: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PodFilesDownloadRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.key = source["key"];
-	        this.value = source["value"];
+	        this.namespace = source["namespace"];
+	        this.podName = source["podName"];
+	        this.container = source["container"];
+	        this.directory = source["directory"];
+	        this.names = source["names"];
 	    }
 	}
-	export class AppPreferenceSchema {
-	    key: string;
-	    type: string;
-	    defaultValue: any;
-	    currentValue: any;
-	    min?: number;
-	    max?: number;
-	    enumOptions?: string[];
-	    validation?: string;
-	    runtimeSideEffect: boolean;
+	export class PodFilesDownloadResult {
+	    path: string;
+	    bytes: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new AppPreferenceSchema(source);
+	        return new PodFilesDownloadResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.key = source["key"];
-	        this.type = source["type"];
-	        this.defaultValue = source["defaultValue"];
-	        this.currentValue = source["currentValue"];
-	        this.min = source["min"];
-	        this.max = source["max"];
-	        this.enumOptions = source["enumOptions"];
-	        this.validation = source["validation"];
-	        this.runtimeSideEffect = source["runtimeSideEffect"];
+	        this.path = source["path"];
+	        this.bytes = source["bytes"];
 	    }
 	}
 	export class Theme {
@@ -4964,6 +9492,7 @@ export namespace types {
 	    exclusiveNamespaces: boolean;
 	    autoRefreshEnabled: boolean;
 	    refreshBackgroundClustersEnabled: boolean;
+	    refreshBinaryEncodingEnabled: boolean;
 	    metricsRefreshIntervalMs: number;
 	    kubernetesClientQPS: number;
 	    kubernetesClientBurst: number;
@@ -4992,6 +9521,22 @@ export namespace types {
 	    accentColorDark: string;
 	    linkColorLight: string;
 	    linkColorDark: string;
+	    secretRevealEnabled: boolean;
+	    secretRedactedKeyPatterns: string[];
+	    trivyPath: string;
+	    trivyServerURL: string;
+	    informerResyncIntervalMs: number;
+	    objectCatalogResyncIntervalMs: number;
+	    objectCatalogPageSize: number;
+	    objectCatalogPromotionThreshold: number;
+	    objectCatalogStreamingBatchSize: number;
+	    objectCatalogStreamingFlushIntervalMs: number;
+	    resourceStreamSubscriberBufferSize: number;
+	    resourceStreamResumeBufferSize: number;
+	    customInformerIdleTimeoutMs: number;
+	    protectedNamespaces: string[];
+	    updateChannel: string;
+	    externalEditor: string;
 	    themes: Theme[];
 	
 	    static createFrom(source: any = {}) {
@@ -5007,6 +9552,7 @@ export namespace types {
 	        this.exclusiveNamespaces = source["exclusiveNamespaces"];
 	        this.autoRefreshEnabled = source["autoRefreshEnabled"];
 	        this.refreshBackgroundClustersEnabled = source["refreshBackgroundClustersEnabled"];
+	        this.refreshBinaryEncodingEnabled = source["refreshBinaryEncodingEnabled"];
 	        this.metricsRefreshIntervalMs = source["metricsRefreshIntervalMs"];
 	        this.kubernetesClientQPS = source["kubernetesClientQPS"];
 	        this.kubernetesClientBurst = source["kubernetesClientBurst"];
@@ -5035,6 +9581,22 @@ export namespace types {
 	        this.accentColorDark = source["accentColorDark"];
 	        this.linkColorLight = source["linkColorLight"];
 	        this.linkColorDark = source["linkColorDark"];
+	        this.secretRevealEnabled = source["secretRevealEnabled"];
+	        this.secretRedactedKeyPatterns = source["secretRedactedKeyPatterns"];
+	        this.trivyPath = source["trivyPath"];
+	        this.trivyServerURL = source["trivyServerURL"];
+	        this.informerResyncIntervalMs = source["informerResyncIntervalMs"];
+	        this.objectCatalogResyncIntervalMs = source["objectCatalogResyncIntervalMs"];
+	        this.objectCatalogPageSize = source["objectCatalogPageSize"];
+	        this.objectCatalogPromotionThreshold = source["objectCatalogPromotionThreshold"];
+	        this.objectCatalogStreamingBatchSize = source["objectCatalogStreamingBatchSize"];
+	        this.objectCatalogStreamingFlushIntervalMs = source["objectCatalogStreamingFlushIntervalMs"];
+	        this.resourceStreamSubscriberBufferSize = source["resourceStreamSubscriberBufferSize"];
+	        this.resourceStreamResumeBufferSize = source["resourceStreamResumeBufferSize"];
+	        this.customInformerIdleTimeoutMs = source["customInformerIdleTimeoutMs"];
+	        this.protectedNamespaces = source["protectedNamespaces"];
+	        this.updateChannel = source["updateChannel"];
+	        this.externalEditor = source["externalEditor"];
 	        this.themes = this.convertValues(source["themes"], Theme);
 	    }
 	
@@ -5163,6 +9725,7 @@ export namespace types {
 	    line: string;
 	    isInit: boolean;
 	    isEphemeral?: boolean;
+	    attempt?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new ContainerLogsEntry(source);
@@ -5176,6 +9739,7 @@ export namespace types {
 	        this.line = source["line"];
 	        this.isInit = source["isInit"];
 	        this.isEphemeral = source["isEphemeral"];
+	        this.attempt = source["attempt"];
 	    }
 	}
 	export class ContainerLogsFetchRequest {
@@ -5194,6 +9758,7 @@ export namespace types {
 	    previous: boolean;
 	    tailLines: number;
 	    sinceSeconds?: number;
+	    attempts?: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new ContainerLogsFetchRequest(source);
@@ -5216,6 +9781,7 @@ export namespace types {
 	        this.previous = source["previous"];
 	        this.tailLines = source["tailLines"];
 	        this.sinceSeconds = source["sinceSeconds"];
+	        this.attempts = source["attempts"];
 	    }
 	}
 	export class ContainerLogsFetchResponse {
@@ -5252,6 +9818,60 @@ export namespace types {
 		    return a;
 		}
 	}
+	export class DataKeyInfo {
+	    key: string;
+	    size: number;
+	    binary?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new DataKeyInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.size = source["size"];
+	        this.binary = source["binary"];
+	    }
+	}
+	export class DataValue {
+	    key: string;
+	    binary?: boolean;
+	    size: number;
+	    offset: number;
+	    nextOffset: number;
+	    value: string;
+	    truncated: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new DataValue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.binary = source["binary"];
+	        this.size = source["size"];
+	        this.offset = source["offset"];
+	        this.nextOffset = source["nextOffset"];
+	        this.value = source["value"];
+	        this.truncated = source["truncated"];
+	    }
+	}
+	export class DataValueRange {
+	    offset?: number;
+	    limit?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DataValueRange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
+	    }
+	}
 	export class DebugContainerResponse {
 	    containerName: string;
 	    podName: string;
@@ -5512,6 +10132,74 @@ export namespace types {
 		    return a;
 		}
 	}
+	export class KeyBinding {
+	    action: string;
+	    key: string;
+	    mod?: boolean;
+	    ctrl?: boolean;
+	    shift?: boolean;
+	    alt?: boolean;
+	    meta?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeyBinding(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.key = source["key"];
+	        this.mod = source["mod"];
+	        this.ctrl = source["ctrl"];
+	        this.shift = source["shift"];
+	        this.alt = source["alt"];
+	        this.meta = source["meta"];
+	    }
+	}
+	export class KeyBindingAction {
+	    action: string;
+	    description: string;
+	    category: string;
+	    global: boolean;
+	    destructive?: boolean;
+	    default: KeyBinding;
+	    binding: KeyBinding;
+	    customized: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeyBindingAction(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.description = source["description"];
+	        this.category = source["category"];
+	        this.global = source["global"];
+	        this.destructive = source["destructive"];
+	        this.default = this.convertValues(source["default"], KeyBinding);
+	        this.binding = this.convertValues(source["binding"], KeyBinding);
+	        this.customized = source["customized"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class KubeconfigInfo {
 	    name: string;
 	    path: string;
@@ -6019,6 +10707,38 @@ export namespace types {
 		}
 	}
 	
+	export class SettingsSyncStatus {
+	    directory: string;
+	    filePath?: string;
+	    lastSyncedAt?: string;
+	    lastError?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SettingsSyncStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.directory = source["directory"];
+	        this.filePath = source["filePath"];
+	        this.lastSyncedAt = source["lastSyncedAt"];
+	        this.lastError = source["lastError"];
+	    }
+	}
+	export class SettingsTransferResult {
+	    path: string;
+	    bytes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SettingsTransferResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.bytes = source["bytes"];
+	    }
+	}
 	export class ShellSession {
 	    sessionId: string;
 	    namespace: string;
@@ -6238,3 +10958,180 @@ export namespace v1 {
 
 }
 
+export namespace volumesnapshot {
+	
+	export class ActionResult {
+	    clusterId: string;
+	    kind: string;
+	    namespace: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.clusterId = source["clusterId"];
+	        this.kind = source["kind"];
+	        this.namespace = source["namespace"];
+	        this.name = source["name"];
+	    }
+	}
+	export class ClassOption {
+	    name: string;
+	    driver: string;
+	    isDefault?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ClassOption(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.driver = source["driver"];
+	        this.isDefault = source["isDefault"];
+	    }
+	}
+	export class CreateRequest {
+	    namespace: string;
+	    claimName: string;
+	    name?: string;
+	    snapshotClass?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CreateRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.claimName = source["claimName"];
+	        this.name = source["name"];
+	        this.snapshotClass = source["snapshotClass"];
+	    }
+	}
+	export class Details {
+	    kind: string;
+	    name: string;
+	    namespace?: string;
+	    summary: streamrows.VolumeSnapshotSummary;
+	    parameters?: Record<string, string>;
+	    labels?: Record<string, string>;
+	    annotations?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Details(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.name = source["name"];
+	        this.namespace = source["namespace"];
+	        this.summary = this.convertValues(source["summary"], streamrows.VolumeSnapshotSummary);
+	        this.parameters = source["parameters"];
+	        this.labels = source["labels"];
+	        this.annotations = source["annotations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RestoreRequest {
+	    namespace: string;
+	    snapshotName: string;
+	    claimName: string;
+	    storageClass?: string;
+	    size?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RestoreRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.namespace = source["namespace"];
+	        this.snapshotName = source["snapshotName"];
+	        this.claimName = source["claimName"];
+	        this.storageClass = source["storageClass"];
+	        this.size = source["size"];
+	    }
+	}
+	export class Support {
+	    installed: boolean;
+	    apiVersion?: string;
+	    classes: ClassOption[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Support(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.installed = source["installed"];
+	        this.apiVersion = source["apiVersion"];
+	        this.classes = this.convertValues(source["classes"], ClassOption);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+}
+
+export namespace vulnscan {
+	
+	export class Counts {
+	    critical: number;
+	    high: number;
+	    medium: number;
+	    low: number;
+	    unknown: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Counts(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.critical = source["critical"];
+	        this.high = source["high"];
+	        this.medium = source["medium"];
+	        this.low = source["low"];
+	        this.unknown = source["unknown"];
+	    }
+	}
+
+}
+
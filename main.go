@@ -30,6 +30,17 @@ func main() {
 	// Store the initial menu
 	appMenu := backend.CreateMenu(app)
 
+	// Linux and Windows start the app with a clicked luxury-yacht:// link as an
+	// argument. The link is held until the frontend asks for it.
+	backend.OpenLaunchDeepLinks(app, os.Args[1:])
+
+	// --headless keeps the window hidden and serves the local API, for
+	// scripts that want the app's caches without the UI.
+	headless := backend.IsHeadlessLaunch(os.Args[1:])
+	if headless {
+		backend.EnableHeadless(app)
+	}
+
 	// Custom startup that sets up menu updates
 	onStartup := func(ctx context.Context) {
 		app.Startup(ctx)
@@ -61,6 +72,21 @@ func main() {
 		}
 	}
 
+	if headless {
+		startHidden = true
+	}
+
+	// A second launch (for example a clicked link) is forwarded to the
+	// running instance instead of opening another app. File > New Window
+	// deliberately starts another instance, so it skips the lock.
+	var singleInstanceLock *options.SingleInstanceLock
+	if !backend.IsNewWindowLaunch(os.Args[1:]) {
+		singleInstanceLock = &options.SingleInstanceLock{
+			UniqueId:               "app.luxury-yacht.desktop",
+			OnSecondInstanceLaunch: backend.NewSecondInstanceHandler(app),
+		}
+	}
+
 	err := wails.Run(&options.App{
 		Title:     "Luxury Yacht",
 		Height:    800,
@@ -72,11 +98,12 @@ func main() {
 		AssetServer: &assetserver.Options{
 			Assets: assets,
 		},
-		BackgroundColour: &options.RGBA{R: 30, G: 30, B: 30, A: 255},
-		OnStartup:        onStartup,
-		OnBeforeClose:    backend.NewBeforeCloseHandler(app),
-		OnShutdown:       app.Shutdown,
-		Menu:             appMenu,
+		BackgroundColour:   &options.RGBA{R: 30, G: 30, B: 30, A: 255},
+		OnStartup:          onStartup,
+		OnBeforeClose:      backend.NewBeforeCloseHandler(app),
+		OnShutdown:         app.Shutdown,
+		Menu:               appMenu,
+		SingleInstanceLock: singleInstanceLock,
 		Bind: []any{
 			app,
 		},
@@ -89,6 +116,7 @@ func main() {
 				HideToolbarSeparator:       true,
 			},
 			WebviewIsTransparent: true,
+			OnUrlOpen:            backend.NewURLOpenHandler(app),
 		},
 		Windows: &windows.Options{
 			Theme:                windows.SystemDefault,